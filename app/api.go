@@ -79,6 +79,7 @@ func generateLossesRequest(
 	functionMethod string,
 	topicId uint64,
 	topicAllowsNegative bool,
+	horizon uint32,
 	blockHeight emissionstypes.Nonce,
 	blockHeightEval emissionstypes.Nonce,
 	blocktime uint64) {
@@ -114,6 +115,10 @@ func generateLossesRequest(
 					Name:  "ALLORA_BLOCK_HEIGHT_EVAL",
 					Value: strconv.FormatInt(blockHeightEval.BlockHeight, 10),
 				},
+				{
+					Name:  "ALLORA_HORIZON",
+					Value: strconv.FormatUint(uint64(horizon), 10),
+				},
 				{
 					Name:  "LOSS_FUNCTION_ALLOWS_NEGATIVE",
 					Value: strconv.FormatBool(topicAllowsNegative),
@@ -30,11 +30,11 @@ func NewTopicsHandler(emissionsKeeper emissionskeeper.Keeper) *TopicsHandler {
 
 // Calculate approximate time for the previous block as epoch timestamp
 func (th *TopicsHandler) calculatePreviousBlockApproxTime(ctx sdk.Context, inferenceBlockHeight, groundTruthLag int64) (uint64, error) {
-	emissionsParams, err := th.emissionsKeeper.GetParams(ctx)
+	blocksPerMonth, err := th.emissionsKeeper.GetBlocksPerMonth(ctx)
 	if err != nil {
 		return 0, err
 	}
-	approximateTimePerBlockSeconds := secondsInAMonth / emissionsParams.BlocksPerMonth
+	approximateTimePerBlockSeconds := secondsInAMonth / blocksPerMonth
 	timeDifferenceInBlocks := ctx.BlockHeight() - inferenceBlockHeight
 	// Ensure no time in the future is calculated because of ground truth lag
 	if groundTruthLag > timeDifferenceInBlocks {
@@ -99,7 +99,7 @@ func (th *TopicsHandler) requestTopicReputers(ctx sdk.Context, topic emissionsty
 	} else {
 		maxRetriesToFulfilNoncesReputer = emissionsParams.MaxRetriesToFulfilNoncesReputer
 	}
-	topNReputerNonces := synth.SelectTopNReputerNonces(&reputerNonces, int(maxRetriesToFulfilNoncesReputer), currentBlockHeight, topic.GroundTruthLag)
+	topNReputerNonces := synth.SelectTopNReputerNoncesForHorizons(&reputerNonces, int(maxRetriesToFulfilNoncesReputer), currentBlockHeight, topic.GetHorizonLags())
 	if len(topNReputerNonces) == 0 {
 		Logger(ctx).Debug("No eligible reputer nonces found: ", topic.Id)
 		return
@@ -107,16 +107,15 @@ func (th *TopicsHandler) requestTopicReputers(ctx sdk.Context, topic emissionsty
 		Logger(ctx).Debug(fmt.Sprintf("Iterating Top N Reputer Nonces: %v", len(topNReputerNonces)))
 	}
 
-	lastCommit, err := th.emissionsKeeper.GetTopicLastCommit(ctx, topic.Id, emissionstypes.ActorType_REPUTER)
-	if err != nil {
-		Logger(ctx).Warn("Error getting reputer last commit: "+err.Error(), ", first reputer commit?")
-	}
 	// iterate over all the reputer nonces to find if this is unfulfilled
 	for _, nonce := range topNReputerNonces {
 		nonceCopy := nonce
-		// Get previous losses from keeper, default: reputationTime (reputerBlock - epochLength)
+		// Get previous losses of the horizon from keeper, default: reputationTime (reputerBlock - epochLength)
 		lastReputerCommitBlockHeight := max(0, nonceCopy.ReputerNonce.BlockHeight-topic.EpochLength)
-		if lastCommit.Nonce != nil {
+		lastCommit, err := th.emissionsKeeper.GetTopicLastReputerPayloadForHorizon(ctx, topic.Id, nonceCopy.Horizon)
+		if err != nil {
+			Logger(ctx).Warn(fmt.Sprintf("Error getting reputer last commit of horizon %d: %s, first reputer commit?", nonceCopy.Horizon, err.Error()))
+		} else if lastCommit.Nonce != nil {
 			Logger(ctx).Debug(fmt.Sprintf("Reputer last commit found, setting: %v", lastCommit))
 			lastReputerCommitBlockHeight = lastCommit.Nonce.BlockHeight
 		}
@@ -128,6 +127,7 @@ func (th *TopicsHandler) requestTopicReputers(ctx sdk.Context, topic emissionsty
 			topic.Id,
 			nonceCopy.ReputerNonce.BlockHeight,
 			lastReputerCommitBlockHeight,
+			nonceCopy.Horizon,
 		)
 		if err != nil {
 			Logger(ctx).Error(fmt.Sprintf("Error getting latest inferences at block: %d  error: %s", nonceCopy.ReputerNonce.BlockHeight, err.Error()))
@@ -138,7 +138,12 @@ func (th *TopicsHandler) requestTopicReputers(ctx sdk.Context, topic emissionsty
 			continue
 		}
 
-		previousBlockApproxTime, err := th.calculatePreviousBlockApproxTime(ctx, nonceCopy.ReputerNonce.BlockHeight, topic.GroundTruthLag)
+		horizonLag, err := topic.GetHorizonLag(nonceCopy.Horizon)
+		if err != nil {
+			Logger(ctx).Error("Error getting horizon lag: " + err.Error())
+			continue
+		}
+		previousBlockApproxTime, err := th.calculatePreviousBlockApproxTime(ctx, nonceCopy.ReputerNonce.BlockHeight, horizonLag)
 		if err != nil {
 			Logger(ctx).Error("Error calculating previous block approx time: " + err.Error())
 			continue
@@ -147,9 +152,9 @@ func (th *TopicsHandler) requestTopicReputers(ctx sdk.Context, topic emissionsty
 		previousLossNonce := &emissionstypes.Nonce{
 			BlockHeight: lastReputerCommitBlockHeight,
 		}
-		Logger(ctx).Info(fmt.Sprintf("Requesting losses for topic: %d reputer nonce: %d worker nonce: %d previous block approx time: %d",
-			topic.Id, nonceCopy.ReputerNonce, previousLossNonce, previousBlockApproxTime))
-		go generateLossesRequest(ctx, reputerValueBundle, topic.LossLogic, topic.LossMethod, topic.Id, topic.AllowNegative, *nonceCopy.ReputerNonce, *previousLossNonce, previousBlockApproxTime)
+		Logger(ctx).Info(fmt.Sprintf("Requesting losses for topic: %d horizon: %d reputer nonce: %d worker nonce: %d previous block approx time: %d",
+			topic.Id, nonceCopy.Horizon, nonceCopy.ReputerNonce, previousLossNonce, previousBlockApproxTime))
+		go generateLossesRequest(ctx, reputerValueBundle, topic.LossLogic, topic.LossMethod, topic.Id, topic.AllowNegative, nonceCopy.Horizon, *nonceCopy.ReputerNonce, *previousLossNonce, previousBlockApproxTime)
 	}
 }
 
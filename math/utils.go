@@ -374,3 +374,95 @@ func WeightedPercentile(data, weights, percentiles []Dec) ([]Dec, error) {
 
 	return result, nil
 }
+
+// WeightedValue is a value along with the weight it holds, such as the stake of the actor that reported it
+type WeightedValue struct {
+	Value  Dec
+	Weight Dec
+}
+
+// SortWeightedValues sorts weighted values by increasing value. Equal values keep their order.
+func SortWeightedValues(values []WeightedValue) {
+	sort.SliceStable(values, func(i, j int) bool {
+		return values[i].Value.Lt(values[j].Value)
+	})
+}
+
+// SumWeights returns the total weight of the weighted values
+func SumWeights(values []WeightedValue) (Dec, error) {
+	sum := ZeroDec()
+	for _, v := range values {
+		var err error
+		sum, err = sum.Add(v.Weight)
+		if err != nil {
+			return ZeroDec(), err
+		}
+	}
+	return sum, nil
+}
+
+// WeightedMedian returns the first of the sorted weighted values at which the cumulative weight
+// reaches half of the total weight. The weighted median of no values is zero.
+func WeightedMedian(sorted []WeightedValue) (Dec, error) {
+	if len(sorted) == 0 {
+		return ZeroDec(), nil
+	}
+	totalWeight, err := SumWeights(sorted)
+	if err != nil {
+		return ZeroDec(), err
+	}
+	halfTotalWeight, err := totalWeight.Quo(NewDecFromInt64(2))
+	if err != nil {
+		return ZeroDec(), err
+	}
+	cumulativeWeight := ZeroDec()
+	for _, v := range sorted {
+		cumulativeWeight, err = cumulativeWeight.Add(v.Weight)
+		if err != nil {
+			return ZeroDec(), err
+		}
+		if cumulativeWeight.Gte(halfTotalWeight) {
+			return v.Value, nil
+		}
+	}
+	return sorted[len(sorted)-1].Value, nil
+}
+
+// TrimWeightedValues drops the lowest and the highest of the sorted weighted values holding trimFraction
+// of the total weight each, keeping the part of the weight of the values at the boundaries that lies
+// within the kept range. Returns false if no weight would be kept.
+func TrimWeightedValues(sorted []WeightedValue, trimFraction Dec) ([]WeightedValue, bool, error) {
+	totalWeight, err := SumWeights(sorted)
+	if err != nil {
+		return nil, false, err
+	}
+	lowerBound, err := totalWeight.Mul(trimFraction)
+	if err != nil {
+		return nil, false, err
+	}
+	upperBound, err := totalWeight.Sub(lowerBound)
+	if err != nil {
+		return nil, false, err
+	}
+	if !upperBound.Gt(lowerBound) {
+		return nil, false, nil
+	}
+
+	kept := make([]WeightedValue, 0, len(sorted))
+	cumulativeWeight := ZeroDec()
+	for _, v := range sorted {
+		start := cumulativeWeight
+		cumulativeWeight, err = cumulativeWeight.Add(v.Weight)
+		if err != nil {
+			return nil, false, err
+		}
+		keptWeight, err := Min(cumulativeWeight, upperBound).Sub(Max(start, lowerBound))
+		if err != nil {
+			return nil, false, err
+		}
+		if keptWeight.IsPositive() {
+			kept = append(kept, WeightedValue{Value: v.Value, Weight: keptWeight})
+		}
+	}
+	return kept, true, nil
+}
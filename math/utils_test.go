@@ -311,3 +311,43 @@ func TestWeightedInferences2(t *testing.T) {
 		require.True(t, alloraMath.InDelta(expected[i], r, alloraMath.MustNewDecFromString("0.000001")))
 	}
 }
+
+func TestWeightedMedian(t *testing.T) {
+	values := []alloraMath.WeightedValue{
+		{Value: alloraMath.MustNewDecFromString("100"), Weight: alloraMath.MustNewDecFromString("30")},
+		{Value: alloraMath.MustNewDecFromString("1"), Weight: alloraMath.MustNewDecFromString("10")},
+		{Value: alloraMath.MustNewDecFromString("2"), Weight: alloraMath.MustNewDecFromString("10")},
+	}
+	alloraMath.SortWeightedValues(values)
+	require.True(t, alloraMath.MustNewDecFromString("1").Equal(values[0].Value))
+
+	median, err := alloraMath.WeightedMedian(values)
+	require.NoError(t, err)
+	require.True(t, alloraMath.MustNewDecFromString("100").Equal(median), "got %v", median)
+
+	median, err = alloraMath.WeightedMedian(nil)
+	require.NoError(t, err)
+	require.True(t, median.IsZero())
+}
+
+func TestTrimWeightedValues(t *testing.T) {
+	sorted := []alloraMath.WeightedValue{
+		{Value: alloraMath.MustNewDecFromString("-40"), Weight: alloraMath.MustNewDecFromString("5")},
+		{Value: alloraMath.MustNewDecFromString("-1"), Weight: alloraMath.MustNewDecFromString("10")},
+		{Value: alloraMath.MustNewDecFromString("1"), Weight: alloraMath.MustNewDecFromString("10")},
+		{Value: alloraMath.MustNewDecFromString("40"), Weight: alloraMath.MustNewDecFromString("5")},
+	}
+
+	// 6 of the 30 weight is trimmed on each end: the outliers and 1 of the weight of the values next to them
+	kept, ok, err := alloraMath.TrimWeightedValues(sorted, alloraMath.MustNewDecFromString("0.2"))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Len(t, kept, 2)
+	require.True(t, alloraMath.MustNewDecFromString("-1").Equal(kept[0].Value))
+	require.True(t, alloraMath.MustNewDecFromString("9").Equal(kept[0].Weight), "got %v", kept[0].Weight)
+	require.True(t, alloraMath.MustNewDecFromString("9").Equal(kept[1].Weight), "got %v", kept[1].Weight)
+
+	_, ok, err = alloraMath.TrimWeightedValues(sorted, alloraMath.MustNewDecFromString("0.5"))
+	require.NoError(t, err)
+	require.False(t, ok, "nothing is left when half of the weight is trimmed on each end")
+}
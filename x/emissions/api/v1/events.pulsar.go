@@ -1514,6 +1514,7 @@ var (
 	fd_EventNetworkLossSet_topic_id     protoreflect.FieldDescriptor
 	fd_EventNetworkLossSet_block_height protoreflect.FieldDescriptor
 	fd_EventNetworkLossSet_value_bundle protoreflect.FieldDescriptor
+	fd_EventNetworkLossSet_horizon      protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1522,6 +1523,7 @@ func init() {
 	fd_EventNetworkLossSet_topic_id = md_EventNetworkLossSet.Fields().ByName("topic_id")
 	fd_EventNetworkLossSet_block_height = md_EventNetworkLossSet.Fields().ByName("block_height")
 	fd_EventNetworkLossSet_value_bundle = md_EventNetworkLossSet.Fields().ByName("value_bundle")
+	fd_EventNetworkLossSet_horizon = md_EventNetworkLossSet.Fields().ByName("horizon")
 }
 
 var _ protoreflect.Message = (*fastReflection_EventNetworkLossSet)(nil)
@@ -1607,6 +1609,12 @@ func (x *fastReflection_EventNetworkLossSet) Range(f func(protoreflect.FieldDesc
 			return
 		}
 	}
+	if x.Horizon != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Horizon)
+		if !f(fd_EventNetworkLossSet_horizon, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1628,6 +1636,8 @@ func (x *fastReflection_EventNetworkLossSet) Has(fd protoreflect.FieldDescriptor
 		return x.BlockHeight != int64(0)
 	case "emissions.v1.EventNetworkLossSet.value_bundle":
 		return x.ValueBundle != nil
+	case "emissions.v1.EventNetworkLossSet.horizon":
+		return x.Horizon != uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventNetworkLossSet"))
@@ -1650,6 +1660,8 @@ func (x *fastReflection_EventNetworkLossSet) Clear(fd protoreflect.FieldDescript
 		x.BlockHeight = int64(0)
 	case "emissions.v1.EventNetworkLossSet.value_bundle":
 		x.ValueBundle = nil
+	case "emissions.v1.EventNetworkLossSet.horizon":
+		x.Horizon = uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventNetworkLossSet"))
@@ -1675,6 +1687,9 @@ func (x *fastReflection_EventNetworkLossSet) Get(descriptor protoreflect.FieldDe
 	case "emissions.v1.EventNetworkLossSet.value_bundle":
 		value := x.ValueBundle
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "emissions.v1.EventNetworkLossSet.horizon":
+		value := x.Horizon
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventNetworkLossSet"))
@@ -1701,6 +1716,8 @@ func (x *fastReflection_EventNetworkLossSet) Set(fd protoreflect.FieldDescriptor
 		x.BlockHeight = value.Int()
 	case "emissions.v1.EventNetworkLossSet.value_bundle":
 		x.ValueBundle = value.Message().Interface().(*ValueBundle)
+	case "emissions.v1.EventNetworkLossSet.horizon":
+		x.Horizon = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventNetworkLossSet"))
@@ -1730,6 +1747,8 @@ func (x *fastReflection_EventNetworkLossSet) Mutable(fd protoreflect.FieldDescri
 		panic(fmt.Errorf("field topic_id of message emissions.v1.EventNetworkLossSet is not mutable"))
 	case "emissions.v1.EventNetworkLossSet.block_height":
 		panic(fmt.Errorf("field block_height of message emissions.v1.EventNetworkLossSet is not mutable"))
+	case "emissions.v1.EventNetworkLossSet.horizon":
+		panic(fmt.Errorf("field horizon of message emissions.v1.EventNetworkLossSet is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventNetworkLossSet"))
@@ -1750,6 +1769,8 @@ func (x *fastReflection_EventNetworkLossSet) NewField(fd protoreflect.FieldDescr
 	case "emissions.v1.EventNetworkLossSet.value_bundle":
 		m := new(ValueBundle)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "emissions.v1.EventNetworkLossSet.horizon":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventNetworkLossSet"))
@@ -1829,6 +1850,9 @@ func (x *fastReflection_EventNetworkLossSet) ProtoMethods() *protoiface.Methods
 			l = options.Size(x.ValueBundle)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Horizon != 0 {
+			n += 1 + runtime.Sov(uint64(x.Horizon))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1858,6 +1882,11 @@ func (x *fastReflection_EventNetworkLossSet) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Horizon != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Horizon))
+			i--
+			dAtA[i] = 0x20
+		}
 		if x.ValueBundle != nil {
 			encoded, err := options.Marshal(x.ValueBundle)
 			if err != nil {
@@ -2005,6 +2034,25 @@ func (x *fastReflection_EventNetworkLossSet) ProtoMethods() *protoiface.Methods
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Horizon", wireType)
+				}
+				x.Horizon = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Horizon |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2040,259 +2088,3158 @@ func (x *fastReflection_EventNetworkLossSet) ProtoMethods() *protoiface.Methods
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: emissions/v1/events.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-type ActorType int32
-
-const (
-	ActorType_INFERER    ActorType = 0
-	ActorType_FORECASTER ActorType = 1
-	ActorType_REPUTER    ActorType = 2
-)
-
-// Enum value maps for ActorType.
-var (
-	ActorType_name = map[int32]string{
-		0: "INFERER",
-		1: "FORECASTER",
-		2: "REPUTER",
-	}
-	ActorType_value = map[string]int32{
-		"INFERER":    0,
-		"FORECASTER": 1,
-		"REPUTER":    2,
-	}
-)
+var _ protoreflect.List = (*_EventNodeInfoUpdated_3_list)(nil)
 
-func (x ActorType) Enum() *ActorType {
-	p := new(ActorType)
-	*p = x
-	return p
+type _EventNodeInfoUpdated_3_list struct {
+	list *[]string
 }
 
-func (x ActorType) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x *_EventNodeInfoUpdated_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
 }
 
-func (ActorType) Descriptor() protoreflect.EnumDescriptor {
-	return file_emissions_v1_events_proto_enumTypes[0].Descriptor()
+func (x *_EventNodeInfoUpdated_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
 }
 
-func (ActorType) Type() protoreflect.EnumType {
-	return &file_emissions_v1_events_proto_enumTypes[0]
+func (x *_EventNodeInfoUpdated_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
 }
 
-func (x ActorType) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *_EventNodeInfoUpdated_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
 }
 
-// Deprecated: Use ActorType.Descriptor instead.
-func (ActorType) EnumDescriptor() ([]byte, []int) {
-	return file_emissions_v1_events_proto_rawDescGZIP(), []int{0}
+func (x *_EventNodeInfoUpdated_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EventNodeInfoUpdated at list field PreviousLibP2PKeys as it is not of Message kind"))
 }
 
-type EventScoresSet struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	ActorType   ActorType `protobuf:"varint,1,opt,name=actor_type,json=actorType,proto3,enum=emissions.v1.ActorType" json:"actor_type,omitempty"`
-	TopicId     uint64    `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	BlockHeight int64     `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	Addresses   []string  `protobuf:"bytes,4,rep,name=addresses,proto3" json:"addresses,omitempty"`
-	Scores      []string  `protobuf:"bytes,5,rep,name=scores,proto3" json:"scores,omitempty"`
+func (x *_EventNodeInfoUpdated_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
 }
 
-func (x *EventScoresSet) Reset() {
-	*x = EventScoresSet{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v1_events_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x *_EventNodeInfoUpdated_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
 }
 
-func (x *EventScoresSet) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *_EventNodeInfoUpdated_3_list) IsValid() bool {
+	return x.list != nil
 }
 
-func (*EventScoresSet) ProtoMessage() {}
+var _ protoreflect.List = (*_EventNodeInfoUpdated_7_list)(nil)
 
-// Deprecated: Use EventScoresSet.ProtoReflect.Descriptor instead.
-func (*EventScoresSet) Descriptor() ([]byte, []int) {
-	return file_emissions_v1_events_proto_rawDescGZIP(), []int{0}
+type _EventNodeInfoUpdated_7_list struct {
+	list *[]uint64
 }
 
-func (x *EventScoresSet) GetActorType() ActorType {
-	if x != nil {
-		return x.ActorType
+func (x *_EventNodeInfoUpdated_7_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	return ActorType_INFERER
+	return len(*x.list)
 }
 
-func (x *EventScoresSet) GetTopicId() uint64 {
-	if x != nil {
-		return x.TopicId
-	}
-	return 0
+func (x *_EventNodeInfoUpdated_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
 }
 
-func (x *EventScoresSet) GetBlockHeight() int64 {
-	if x != nil {
-		return x.BlockHeight
-	}
-	return 0
+func (x *_EventNodeInfoUpdated_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
 }
 
-func (x *EventScoresSet) GetAddresses() []string {
-	if x != nil {
-		return x.Addresses
-	}
-	return nil
+func (x *_EventNodeInfoUpdated_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *EventScoresSet) GetScores() []string {
-	if x != nil {
-		return x.Scores
-	}
-	return nil
+func (x *_EventNodeInfoUpdated_7_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EventNodeInfoUpdated at list field TopicIds as it is not of Message kind"))
 }
 
-type EventRewardsSettled struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	ActorType   ActorType `protobuf:"varint,1,opt,name=actor_type,json=actorType,proto3,enum=emissions.v1.ActorType" json:"actor_type,omitempty"`
-	TopicId     uint64    `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	BlockHeight int64     `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	Addresses   []string  `protobuf:"bytes,4,rep,name=addresses,proto3" json:"addresses,omitempty"`
-	Rewards     []string  `protobuf:"bytes,5,rep,name=rewards,proto3" json:"rewards,omitempty"`
+func (x *_EventNodeInfoUpdated_7_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
 }
 
-func (x *EventRewardsSettled) Reset() {
-	*x = EventRewardsSettled{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v1_events_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x *_EventNodeInfoUpdated_7_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
 }
 
-func (x *EventRewardsSettled) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *_EventNodeInfoUpdated_7_list) IsValid() bool {
+	return x.list != nil
 }
 
-func (*EventRewardsSettled) ProtoMessage() {}
+var (
+	md_EventNodeInfoUpdated                       protoreflect.MessageDescriptor
+	fd_EventNodeInfoUpdated_is_reputer            protoreflect.FieldDescriptor
+	fd_EventNodeInfoUpdated_node_address          protoreflect.FieldDescriptor
+	fd_EventNodeInfoUpdated_previous_lib_p2p_keys protoreflect.FieldDescriptor
+	fd_EventNodeInfoUpdated_lib_p2p_key           protoreflect.FieldDescriptor
+	fd_EventNodeInfoUpdated_multi_address         protoreflect.FieldDescriptor
+	fd_EventNodeInfoUpdated_owner                 protoreflect.FieldDescriptor
+	fd_EventNodeInfoUpdated_topic_ids             protoreflect.FieldDescriptor
+)
 
-// Deprecated: Use EventRewardsSettled.ProtoReflect.Descriptor instead.
-func (*EventRewardsSettled) Descriptor() ([]byte, []int) {
-	return file_emissions_v1_events_proto_rawDescGZIP(), []int{1}
+func init() {
+	file_emissions_v1_events_proto_init()
+	md_EventNodeInfoUpdated = File_emissions_v1_events_proto.Messages().ByName("EventNodeInfoUpdated")
+	fd_EventNodeInfoUpdated_is_reputer = md_EventNodeInfoUpdated.Fields().ByName("is_reputer")
+	fd_EventNodeInfoUpdated_node_address = md_EventNodeInfoUpdated.Fields().ByName("node_address")
+	fd_EventNodeInfoUpdated_previous_lib_p2p_keys = md_EventNodeInfoUpdated.Fields().ByName("previous_lib_p2p_keys")
+	fd_EventNodeInfoUpdated_lib_p2p_key = md_EventNodeInfoUpdated.Fields().ByName("lib_p2p_key")
+	fd_EventNodeInfoUpdated_multi_address = md_EventNodeInfoUpdated.Fields().ByName("multi_address")
+	fd_EventNodeInfoUpdated_owner = md_EventNodeInfoUpdated.Fields().ByName("owner")
+	fd_EventNodeInfoUpdated_topic_ids = md_EventNodeInfoUpdated.Fields().ByName("topic_ids")
 }
 
-func (x *EventRewardsSettled) GetActorType() ActorType {
-	if x != nil {
-		return x.ActorType
-	}
-	return ActorType_INFERER
-}
+var _ protoreflect.Message = (*fastReflection_EventNodeInfoUpdated)(nil)
 
-func (x *EventRewardsSettled) GetTopicId() uint64 {
-	if x != nil {
-		return x.TopicId
-	}
-	return 0
-}
+type fastReflection_EventNodeInfoUpdated EventNodeInfoUpdated
 
-func (x *EventRewardsSettled) GetBlockHeight() int64 {
-	if x != nil {
-		return x.BlockHeight
-	}
-	return 0
+func (x *EventNodeInfoUpdated) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventNodeInfoUpdated)(x)
 }
 
-func (x *EventRewardsSettled) GetAddresses() []string {
-	if x != nil {
-		return x.Addresses
+func (x *EventNodeInfoUpdated) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v1_events_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *EventRewardsSettled) GetRewards() []string {
-	if x != nil {
-		return x.Rewards
-	}
-	return nil
-}
+var _fastReflection_EventNodeInfoUpdated_messageType fastReflection_EventNodeInfoUpdated_messageType
+var _ protoreflect.MessageType = fastReflection_EventNodeInfoUpdated_messageType{}
 
-type EventNetworkLossSet struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type fastReflection_EventNodeInfoUpdated_messageType struct{}
 
-	TopicId     uint64       `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	BlockHeight int64        `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	ValueBundle *ValueBundle `protobuf:"bytes,3,opt,name=value_bundle,json=valueBundle,proto3" json:"value_bundle,omitempty"`
+func (x fastReflection_EventNodeInfoUpdated_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventNodeInfoUpdated)(nil)
 }
-
-func (x *EventNetworkLossSet) Reset() {
-	*x = EventNetworkLossSet{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v1_events_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x fastReflection_EventNodeInfoUpdated_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventNodeInfoUpdated)
 }
-
-func (x *EventNetworkLossSet) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x fastReflection_EventNodeInfoUpdated_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventNodeInfoUpdated
 }
 
-func (*EventNetworkLossSet) ProtoMessage() {}
-
-// Deprecated: Use EventNetworkLossSet.ProtoReflect.Descriptor instead.
-func (*EventNetworkLossSet) Descriptor() ([]byte, []int) {
-	return file_emissions_v1_events_proto_rawDescGZIP(), []int{2}
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventNodeInfoUpdated) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventNodeInfoUpdated
 }
 
-func (x *EventNetworkLossSet) GetTopicId() uint64 {
-	if x != nil {
-		return x.TopicId
-	}
-	return 0
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventNodeInfoUpdated) Type() protoreflect.MessageType {
+	return _fastReflection_EventNodeInfoUpdated_messageType
 }
 
-func (x *EventNetworkLossSet) GetBlockHeight() int64 {
-	if x != nil {
-		return x.BlockHeight
-	}
-	return 0
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventNodeInfoUpdated) New() protoreflect.Message {
+	return new(fastReflection_EventNodeInfoUpdated)
 }
 
-func (x *EventNetworkLossSet) GetValueBundle() *ValueBundle {
-	if x != nil {
-		return x.ValueBundle
-	}
-	return nil
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventNodeInfoUpdated) Interface() protoreflect.ProtoMessage {
+	return (*EventNodeInfoUpdated)(x)
 }
 
-var File_emissions_v1_events_proto protoreflect.FileDescriptor
-
-var file_emissions_v1_events_proto_rawDesc = []byte{
-	0x0a, 0x19, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x65, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70,
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventNodeInfoUpdated) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.IsReputer != false {
+		value := protoreflect.ValueOfBool(x.IsReputer)
+		if !f(fd_EventNodeInfoUpdated_is_reputer, value) {
+			return
+		}
+	}
+	if x.NodeAddress != "" {
+		value := protoreflect.ValueOfString(x.NodeAddress)
+		if !f(fd_EventNodeInfoUpdated_node_address, value) {
+			return
+		}
+	}
+	if len(x.PreviousLibP2PKeys) != 0 {
+		value := protoreflect.ValueOfList(&_EventNodeInfoUpdated_3_list{list: &x.PreviousLibP2PKeys})
+		if !f(fd_EventNodeInfoUpdated_previous_lib_p2p_keys, value) {
+			return
+		}
+	}
+	if x.LibP2PKey != "" {
+		value := protoreflect.ValueOfString(x.LibP2PKey)
+		if !f(fd_EventNodeInfoUpdated_lib_p2p_key, value) {
+			return
+		}
+	}
+	if x.MultiAddress != "" {
+		value := protoreflect.ValueOfString(x.MultiAddress)
+		if !f(fd_EventNodeInfoUpdated_multi_address, value) {
+			return
+		}
+	}
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_EventNodeInfoUpdated_owner, value) {
+			return
+		}
+	}
+	if len(x.TopicIds) != 0 {
+		value := protoreflect.ValueOfList(&_EventNodeInfoUpdated_7_list{list: &x.TopicIds})
+		if !f(fd_EventNodeInfoUpdated_topic_ids, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventNodeInfoUpdated) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v1.EventNodeInfoUpdated.is_reputer":
+		return x.IsReputer != false
+	case "emissions.v1.EventNodeInfoUpdated.node_address":
+		return x.NodeAddress != ""
+	case "emissions.v1.EventNodeInfoUpdated.previous_lib_p2p_keys":
+		return len(x.PreviousLibP2PKeys) != 0
+	case "emissions.v1.EventNodeInfoUpdated.lib_p2p_key":
+		return x.LibP2PKey != ""
+	case "emissions.v1.EventNodeInfoUpdated.multi_address":
+		return x.MultiAddress != ""
+	case "emissions.v1.EventNodeInfoUpdated.owner":
+		return x.Owner != ""
+	case "emissions.v1.EventNodeInfoUpdated.topic_ids":
+		return len(x.TopicIds) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventNodeInfoUpdated"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventNodeInfoUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventNodeInfoUpdated) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v1.EventNodeInfoUpdated.is_reputer":
+		x.IsReputer = false
+	case "emissions.v1.EventNodeInfoUpdated.node_address":
+		x.NodeAddress = ""
+	case "emissions.v1.EventNodeInfoUpdated.previous_lib_p2p_keys":
+		x.PreviousLibP2PKeys = nil
+	case "emissions.v1.EventNodeInfoUpdated.lib_p2p_key":
+		x.LibP2PKey = ""
+	case "emissions.v1.EventNodeInfoUpdated.multi_address":
+		x.MultiAddress = ""
+	case "emissions.v1.EventNodeInfoUpdated.owner":
+		x.Owner = ""
+	case "emissions.v1.EventNodeInfoUpdated.topic_ids":
+		x.TopicIds = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventNodeInfoUpdated"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventNodeInfoUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventNodeInfoUpdated) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v1.EventNodeInfoUpdated.is_reputer":
+		value := x.IsReputer
+		return protoreflect.ValueOfBool(value)
+	case "emissions.v1.EventNodeInfoUpdated.node_address":
+		value := x.NodeAddress
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.EventNodeInfoUpdated.previous_lib_p2p_keys":
+		if len(x.PreviousLibP2PKeys) == 0 {
+			return protoreflect.ValueOfList(&_EventNodeInfoUpdated_3_list{})
+		}
+		listValue := &_EventNodeInfoUpdated_3_list{list: &x.PreviousLibP2PKeys}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v1.EventNodeInfoUpdated.lib_p2p_key":
+		value := x.LibP2PKey
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.EventNodeInfoUpdated.multi_address":
+		value := x.MultiAddress
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.EventNodeInfoUpdated.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.EventNodeInfoUpdated.topic_ids":
+		if len(x.TopicIds) == 0 {
+			return protoreflect.ValueOfList(&_EventNodeInfoUpdated_7_list{})
+		}
+		listValue := &_EventNodeInfoUpdated_7_list{list: &x.TopicIds}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventNodeInfoUpdated"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventNodeInfoUpdated does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventNodeInfoUpdated) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v1.EventNodeInfoUpdated.is_reputer":
+		x.IsReputer = value.Bool()
+	case "emissions.v1.EventNodeInfoUpdated.node_address":
+		x.NodeAddress = value.Interface().(string)
+	case "emissions.v1.EventNodeInfoUpdated.previous_lib_p2p_keys":
+		lv := value.List()
+		clv := lv.(*_EventNodeInfoUpdated_3_list)
+		x.PreviousLibP2PKeys = *clv.list
+	case "emissions.v1.EventNodeInfoUpdated.lib_p2p_key":
+		x.LibP2PKey = value.Interface().(string)
+	case "emissions.v1.EventNodeInfoUpdated.multi_address":
+		x.MultiAddress = value.Interface().(string)
+	case "emissions.v1.EventNodeInfoUpdated.owner":
+		x.Owner = value.Interface().(string)
+	case "emissions.v1.EventNodeInfoUpdated.topic_ids":
+		lv := value.List()
+		clv := lv.(*_EventNodeInfoUpdated_7_list)
+		x.TopicIds = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventNodeInfoUpdated"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventNodeInfoUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventNodeInfoUpdated) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.EventNodeInfoUpdated.previous_lib_p2p_keys":
+		if x.PreviousLibP2PKeys == nil {
+			x.PreviousLibP2PKeys = []string{}
+		}
+		value := &_EventNodeInfoUpdated_3_list{list: &x.PreviousLibP2PKeys}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v1.EventNodeInfoUpdated.topic_ids":
+		if x.TopicIds == nil {
+			x.TopicIds = []uint64{}
+		}
+		value := &_EventNodeInfoUpdated_7_list{list: &x.TopicIds}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v1.EventNodeInfoUpdated.is_reputer":
+		panic(fmt.Errorf("field is_reputer of message emissions.v1.EventNodeInfoUpdated is not mutable"))
+	case "emissions.v1.EventNodeInfoUpdated.node_address":
+		panic(fmt.Errorf("field node_address of message emissions.v1.EventNodeInfoUpdated is not mutable"))
+	case "emissions.v1.EventNodeInfoUpdated.lib_p2p_key":
+		panic(fmt.Errorf("field lib_p2p_key of message emissions.v1.EventNodeInfoUpdated is not mutable"))
+	case "emissions.v1.EventNodeInfoUpdated.multi_address":
+		panic(fmt.Errorf("field multi_address of message emissions.v1.EventNodeInfoUpdated is not mutable"))
+	case "emissions.v1.EventNodeInfoUpdated.owner":
+		panic(fmt.Errorf("field owner of message emissions.v1.EventNodeInfoUpdated is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventNodeInfoUpdated"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventNodeInfoUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventNodeInfoUpdated) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.EventNodeInfoUpdated.is_reputer":
+		return protoreflect.ValueOfBool(false)
+	case "emissions.v1.EventNodeInfoUpdated.node_address":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.EventNodeInfoUpdated.previous_lib_p2p_keys":
+		list := []string{}
+		return protoreflect.ValueOfList(&_EventNodeInfoUpdated_3_list{list: &list})
+	case "emissions.v1.EventNodeInfoUpdated.lib_p2p_key":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.EventNodeInfoUpdated.multi_address":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.EventNodeInfoUpdated.owner":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.EventNodeInfoUpdated.topic_ids":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_EventNodeInfoUpdated_7_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventNodeInfoUpdated"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventNodeInfoUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventNodeInfoUpdated) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v1.EventNodeInfoUpdated", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventNodeInfoUpdated) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventNodeInfoUpdated) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventNodeInfoUpdated) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventNodeInfoUpdated) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventNodeInfoUpdated)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.IsReputer {
+			n += 2
+		}
+		l = len(x.NodeAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.PreviousLibP2PKeys) > 0 {
+			for _, s := range x.PreviousLibP2PKeys {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.LibP2PKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MultiAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.TopicIds) > 0 {
+			l = 0
+			for _, e := range x.TopicIds {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventNodeInfoUpdated)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.TopicIds) > 0 {
+			var pksize2 int
+			for _, num := range x.TopicIds {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.TopicIds {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.MultiAddress) > 0 {
+			i -= len(x.MultiAddress)
+			copy(dAtA[i:], x.MultiAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MultiAddress)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.LibP2PKey) > 0 {
+			i -= len(x.LibP2PKey)
+			copy(dAtA[i:], x.LibP2PKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LibP2PKey)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.PreviousLibP2PKeys) > 0 {
+			for iNdEx := len(x.PreviousLibP2PKeys) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.PreviousLibP2PKeys[iNdEx])
+				copy(dAtA[i:], x.PreviousLibP2PKeys[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PreviousLibP2PKeys[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.NodeAddress) > 0 {
+			i -= len(x.NodeAddress)
+			copy(dAtA[i:], x.NodeAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NodeAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.IsReputer {
+			i--
+			if x.IsReputer {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventNodeInfoUpdated)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventNodeInfoUpdated: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventNodeInfoUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsReputer", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.IsReputer = bool(v != 0)
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NodeAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NodeAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousLibP2PKeys", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PreviousLibP2PKeys = append(x.PreviousLibP2PKeys, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LibP2PKey", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LibP2PKey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MultiAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MultiAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.TopicIds = append(x.TopicIds, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.TopicIds) == 0 {
+						x.TopicIds = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.TopicIds = append(x.TopicIds, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicIds", wireType)
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EventInactiveActorsRemoved_3_list)(nil)
+
+type _EventInactiveActorsRemoved_3_list struct {
+	list *[]string
+}
+
+func (x *_EventInactiveActorsRemoved_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventInactiveActorsRemoved_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_EventInactiveActorsRemoved_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventInactiveActorsRemoved_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventInactiveActorsRemoved_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EventInactiveActorsRemoved at list field Workers as it is not of Message kind"))
+}
+
+func (x *_EventInactiveActorsRemoved_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventInactiveActorsRemoved_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_EventInactiveActorsRemoved_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_EventInactiveActorsRemoved_4_list)(nil)
+
+type _EventInactiveActorsRemoved_4_list struct {
+	list *[]string
+}
+
+func (x *_EventInactiveActorsRemoved_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventInactiveActorsRemoved_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_EventInactiveActorsRemoved_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventInactiveActorsRemoved_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventInactiveActorsRemoved_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EventInactiveActorsRemoved at list field Reputers as it is not of Message kind"))
+}
+
+func (x *_EventInactiveActorsRemoved_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventInactiveActorsRemoved_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_EventInactiveActorsRemoved_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EventInactiveActorsRemoved              protoreflect.MessageDescriptor
+	fd_EventInactiveActorsRemoved_topic_id     protoreflect.FieldDescriptor
+	fd_EventInactiveActorsRemoved_block_height protoreflect.FieldDescriptor
+	fd_EventInactiveActorsRemoved_workers      protoreflect.FieldDescriptor
+	fd_EventInactiveActorsRemoved_reputers     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v1_events_proto_init()
+	md_EventInactiveActorsRemoved = File_emissions_v1_events_proto.Messages().ByName("EventInactiveActorsRemoved")
+	fd_EventInactiveActorsRemoved_topic_id = md_EventInactiveActorsRemoved.Fields().ByName("topic_id")
+	fd_EventInactiveActorsRemoved_block_height = md_EventInactiveActorsRemoved.Fields().ByName("block_height")
+	fd_EventInactiveActorsRemoved_workers = md_EventInactiveActorsRemoved.Fields().ByName("workers")
+	fd_EventInactiveActorsRemoved_reputers = md_EventInactiveActorsRemoved.Fields().ByName("reputers")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventInactiveActorsRemoved)(nil)
+
+type fastReflection_EventInactiveActorsRemoved EventInactiveActorsRemoved
+
+func (x *EventInactiveActorsRemoved) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventInactiveActorsRemoved)(x)
+}
+
+func (x *EventInactiveActorsRemoved) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v1_events_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventInactiveActorsRemoved_messageType fastReflection_EventInactiveActorsRemoved_messageType
+var _ protoreflect.MessageType = fastReflection_EventInactiveActorsRemoved_messageType{}
+
+type fastReflection_EventInactiveActorsRemoved_messageType struct{}
+
+func (x fastReflection_EventInactiveActorsRemoved_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventInactiveActorsRemoved)(nil)
+}
+func (x fastReflection_EventInactiveActorsRemoved_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventInactiveActorsRemoved)
+}
+func (x fastReflection_EventInactiveActorsRemoved_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventInactiveActorsRemoved
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventInactiveActorsRemoved) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventInactiveActorsRemoved
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventInactiveActorsRemoved) Type() protoreflect.MessageType {
+	return _fastReflection_EventInactiveActorsRemoved_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventInactiveActorsRemoved) New() protoreflect.Message {
+	return new(fastReflection_EventInactiveActorsRemoved)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventInactiveActorsRemoved) Interface() protoreflect.ProtoMessage {
+	return (*EventInactiveActorsRemoved)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventInactiveActorsRemoved) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TopicId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TopicId)
+		if !f(fd_EventInactiveActorsRemoved_topic_id, value) {
+			return
+		}
+	}
+	if x.BlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockHeight)
+		if !f(fd_EventInactiveActorsRemoved_block_height, value) {
+			return
+		}
+	}
+	if len(x.Workers) != 0 {
+		value := protoreflect.ValueOfList(&_EventInactiveActorsRemoved_3_list{list: &x.Workers})
+		if !f(fd_EventInactiveActorsRemoved_workers, value) {
+			return
+		}
+	}
+	if len(x.Reputers) != 0 {
+		value := protoreflect.ValueOfList(&_EventInactiveActorsRemoved_4_list{list: &x.Reputers})
+		if !f(fd_EventInactiveActorsRemoved_reputers, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventInactiveActorsRemoved) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v1.EventInactiveActorsRemoved.topic_id":
+		return x.TopicId != uint64(0)
+	case "emissions.v1.EventInactiveActorsRemoved.block_height":
+		return x.BlockHeight != int64(0)
+	case "emissions.v1.EventInactiveActorsRemoved.workers":
+		return len(x.Workers) != 0
+	case "emissions.v1.EventInactiveActorsRemoved.reputers":
+		return len(x.Reputers) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventInactiveActorsRemoved"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventInactiveActorsRemoved does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventInactiveActorsRemoved) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v1.EventInactiveActorsRemoved.topic_id":
+		x.TopicId = uint64(0)
+	case "emissions.v1.EventInactiveActorsRemoved.block_height":
+		x.BlockHeight = int64(0)
+	case "emissions.v1.EventInactiveActorsRemoved.workers":
+		x.Workers = nil
+	case "emissions.v1.EventInactiveActorsRemoved.reputers":
+		x.Reputers = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventInactiveActorsRemoved"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventInactiveActorsRemoved does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventInactiveActorsRemoved) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v1.EventInactiveActorsRemoved.topic_id":
+		value := x.TopicId
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.EventInactiveActorsRemoved.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v1.EventInactiveActorsRemoved.workers":
+		if len(x.Workers) == 0 {
+			return protoreflect.ValueOfList(&_EventInactiveActorsRemoved_3_list{})
+		}
+		listValue := &_EventInactiveActorsRemoved_3_list{list: &x.Workers}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v1.EventInactiveActorsRemoved.reputers":
+		if len(x.Reputers) == 0 {
+			return protoreflect.ValueOfList(&_EventInactiveActorsRemoved_4_list{})
+		}
+		listValue := &_EventInactiveActorsRemoved_4_list{list: &x.Reputers}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventInactiveActorsRemoved"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventInactiveActorsRemoved does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventInactiveActorsRemoved) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v1.EventInactiveActorsRemoved.topic_id":
+		x.TopicId = value.Uint()
+	case "emissions.v1.EventInactiveActorsRemoved.block_height":
+		x.BlockHeight = value.Int()
+	case "emissions.v1.EventInactiveActorsRemoved.workers":
+		lv := value.List()
+		clv := lv.(*_EventInactiveActorsRemoved_3_list)
+		x.Workers = *clv.list
+	case "emissions.v1.EventInactiveActorsRemoved.reputers":
+		lv := value.List()
+		clv := lv.(*_EventInactiveActorsRemoved_4_list)
+		x.Reputers = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventInactiveActorsRemoved"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventInactiveActorsRemoved does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventInactiveActorsRemoved) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.EventInactiveActorsRemoved.workers":
+		if x.Workers == nil {
+			x.Workers = []string{}
+		}
+		value := &_EventInactiveActorsRemoved_3_list{list: &x.Workers}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v1.EventInactiveActorsRemoved.reputers":
+		if x.Reputers == nil {
+			x.Reputers = []string{}
+		}
+		value := &_EventInactiveActorsRemoved_4_list{list: &x.Reputers}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v1.EventInactiveActorsRemoved.topic_id":
+		panic(fmt.Errorf("field topic_id of message emissions.v1.EventInactiveActorsRemoved is not mutable"))
+	case "emissions.v1.EventInactiveActorsRemoved.block_height":
+		panic(fmt.Errorf("field block_height of message emissions.v1.EventInactiveActorsRemoved is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventInactiveActorsRemoved"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventInactiveActorsRemoved does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventInactiveActorsRemoved) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.EventInactiveActorsRemoved.topic_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.EventInactiveActorsRemoved.block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v1.EventInactiveActorsRemoved.workers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_EventInactiveActorsRemoved_3_list{list: &list})
+	case "emissions.v1.EventInactiveActorsRemoved.reputers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_EventInactiveActorsRemoved_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventInactiveActorsRemoved"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventInactiveActorsRemoved does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventInactiveActorsRemoved) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v1.EventInactiveActorsRemoved", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventInactiveActorsRemoved) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventInactiveActorsRemoved) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventInactiveActorsRemoved) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventInactiveActorsRemoved) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventInactiveActorsRemoved)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TopicId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TopicId))
+		}
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		}
+		if len(x.Workers) > 0 {
+			for _, s := range x.Workers {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Reputers) > 0 {
+			for _, s := range x.Reputers {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventInactiveActorsRemoved)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Reputers) > 0 {
+			for iNdEx := len(x.Reputers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Reputers[iNdEx])
+				copy(dAtA[i:], x.Reputers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reputers[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.Workers) > 0 {
+			for iNdEx := len(x.Workers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Workers[iNdEx])
+				copy(dAtA[i:], x.Workers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Workers[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.TopicId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TopicId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventInactiveActorsRemoved)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventInactiveActorsRemoved: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventInactiveActorsRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				}
+				x.TopicId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TopicId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Workers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Workers = append(x.Workers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reputers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reputers = append(x.Reputers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EventNetworkInferenceWeightsSet_4_list)(nil)
+
+type _EventNetworkInferenceWeightsSet_4_list struct {
+	list *[]string
+}
+
+func (x *_EventNetworkInferenceWeightsSet_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventNetworkInferenceWeightsSet_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_EventNetworkInferenceWeightsSet_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventNetworkInferenceWeightsSet_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventNetworkInferenceWeightsSet_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EventNetworkInferenceWeightsSet at list field Inferers as it is not of Message kind"))
+}
+
+func (x *_EventNetworkInferenceWeightsSet_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventNetworkInferenceWeightsSet_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_EventNetworkInferenceWeightsSet_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_EventNetworkInferenceWeightsSet_5_list)(nil)
+
+type _EventNetworkInferenceWeightsSet_5_list struct {
+	list *[]string
+}
+
+func (x *_EventNetworkInferenceWeightsSet_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventNetworkInferenceWeightsSet_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_EventNetworkInferenceWeightsSet_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventNetworkInferenceWeightsSet_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventNetworkInferenceWeightsSet_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EventNetworkInferenceWeightsSet at list field InfererWeights as it is not of Message kind"))
+}
+
+func (x *_EventNetworkInferenceWeightsSet_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventNetworkInferenceWeightsSet_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_EventNetworkInferenceWeightsSet_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_EventNetworkInferenceWeightsSet_6_list)(nil)
+
+type _EventNetworkInferenceWeightsSet_6_list struct {
+	list *[]string
+}
+
+func (x *_EventNetworkInferenceWeightsSet_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventNetworkInferenceWeightsSet_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_EventNetworkInferenceWeightsSet_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventNetworkInferenceWeightsSet_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventNetworkInferenceWeightsSet_6_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EventNetworkInferenceWeightsSet at list field Forecasters as it is not of Message kind"))
+}
+
+func (x *_EventNetworkInferenceWeightsSet_6_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventNetworkInferenceWeightsSet_6_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_EventNetworkInferenceWeightsSet_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_EventNetworkInferenceWeightsSet_7_list)(nil)
+
+type _EventNetworkInferenceWeightsSet_7_list struct {
+	list *[]string
+}
+
+func (x *_EventNetworkInferenceWeightsSet_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventNetworkInferenceWeightsSet_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_EventNetworkInferenceWeightsSet_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventNetworkInferenceWeightsSet_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventNetworkInferenceWeightsSet_7_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EventNetworkInferenceWeightsSet at list field ForecasterWeights as it is not of Message kind"))
+}
+
+func (x *_EventNetworkInferenceWeightsSet_7_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventNetworkInferenceWeightsSet_7_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_EventNetworkInferenceWeightsSet_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EventNetworkInferenceWeightsSet                    protoreflect.MessageDescriptor
+	fd_EventNetworkInferenceWeightsSet_topic_id           protoreflect.FieldDescriptor
+	fd_EventNetworkInferenceWeightsSet_block_height       protoreflect.FieldDescriptor
+	fd_EventNetworkInferenceWeightsSet_horizon            protoreflect.FieldDescriptor
+	fd_EventNetworkInferenceWeightsSet_inferers           protoreflect.FieldDescriptor
+	fd_EventNetworkInferenceWeightsSet_inferer_weights    protoreflect.FieldDescriptor
+	fd_EventNetworkInferenceWeightsSet_forecasters        protoreflect.FieldDescriptor
+	fd_EventNetworkInferenceWeightsSet_forecaster_weights protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v1_events_proto_init()
+	md_EventNetworkInferenceWeightsSet = File_emissions_v1_events_proto.Messages().ByName("EventNetworkInferenceWeightsSet")
+	fd_EventNetworkInferenceWeightsSet_topic_id = md_EventNetworkInferenceWeightsSet.Fields().ByName("topic_id")
+	fd_EventNetworkInferenceWeightsSet_block_height = md_EventNetworkInferenceWeightsSet.Fields().ByName("block_height")
+	fd_EventNetworkInferenceWeightsSet_horizon = md_EventNetworkInferenceWeightsSet.Fields().ByName("horizon")
+	fd_EventNetworkInferenceWeightsSet_inferers = md_EventNetworkInferenceWeightsSet.Fields().ByName("inferers")
+	fd_EventNetworkInferenceWeightsSet_inferer_weights = md_EventNetworkInferenceWeightsSet.Fields().ByName("inferer_weights")
+	fd_EventNetworkInferenceWeightsSet_forecasters = md_EventNetworkInferenceWeightsSet.Fields().ByName("forecasters")
+	fd_EventNetworkInferenceWeightsSet_forecaster_weights = md_EventNetworkInferenceWeightsSet.Fields().ByName("forecaster_weights")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventNetworkInferenceWeightsSet)(nil)
+
+type fastReflection_EventNetworkInferenceWeightsSet EventNetworkInferenceWeightsSet
+
+func (x *EventNetworkInferenceWeightsSet) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventNetworkInferenceWeightsSet)(x)
+}
+
+func (x *EventNetworkInferenceWeightsSet) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v1_events_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventNetworkInferenceWeightsSet_messageType fastReflection_EventNetworkInferenceWeightsSet_messageType
+var _ protoreflect.MessageType = fastReflection_EventNetworkInferenceWeightsSet_messageType{}
+
+type fastReflection_EventNetworkInferenceWeightsSet_messageType struct{}
+
+func (x fastReflection_EventNetworkInferenceWeightsSet_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventNetworkInferenceWeightsSet)(nil)
+}
+func (x fastReflection_EventNetworkInferenceWeightsSet_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventNetworkInferenceWeightsSet)
+}
+func (x fastReflection_EventNetworkInferenceWeightsSet_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventNetworkInferenceWeightsSet
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventNetworkInferenceWeightsSet) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventNetworkInferenceWeightsSet
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventNetworkInferenceWeightsSet) Type() protoreflect.MessageType {
+	return _fastReflection_EventNetworkInferenceWeightsSet_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventNetworkInferenceWeightsSet) New() protoreflect.Message {
+	return new(fastReflection_EventNetworkInferenceWeightsSet)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventNetworkInferenceWeightsSet) Interface() protoreflect.ProtoMessage {
+	return (*EventNetworkInferenceWeightsSet)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventNetworkInferenceWeightsSet) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TopicId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TopicId)
+		if !f(fd_EventNetworkInferenceWeightsSet_topic_id, value) {
+			return
+		}
+	}
+	if x.BlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockHeight)
+		if !f(fd_EventNetworkInferenceWeightsSet_block_height, value) {
+			return
+		}
+	}
+	if x.Horizon != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Horizon)
+		if !f(fd_EventNetworkInferenceWeightsSet_horizon, value) {
+			return
+		}
+	}
+	if len(x.Inferers) != 0 {
+		value := protoreflect.ValueOfList(&_EventNetworkInferenceWeightsSet_4_list{list: &x.Inferers})
+		if !f(fd_EventNetworkInferenceWeightsSet_inferers, value) {
+			return
+		}
+	}
+	if len(x.InfererWeights) != 0 {
+		value := protoreflect.ValueOfList(&_EventNetworkInferenceWeightsSet_5_list{list: &x.InfererWeights})
+		if !f(fd_EventNetworkInferenceWeightsSet_inferer_weights, value) {
+			return
+		}
+	}
+	if len(x.Forecasters) != 0 {
+		value := protoreflect.ValueOfList(&_EventNetworkInferenceWeightsSet_6_list{list: &x.Forecasters})
+		if !f(fd_EventNetworkInferenceWeightsSet_forecasters, value) {
+			return
+		}
+	}
+	if len(x.ForecasterWeights) != 0 {
+		value := protoreflect.ValueOfList(&_EventNetworkInferenceWeightsSet_7_list{list: &x.ForecasterWeights})
+		if !f(fd_EventNetworkInferenceWeightsSet_forecaster_weights, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventNetworkInferenceWeightsSet) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v1.EventNetworkInferenceWeightsSet.topic_id":
+		return x.TopicId != uint64(0)
+	case "emissions.v1.EventNetworkInferenceWeightsSet.block_height":
+		return x.BlockHeight != int64(0)
+	case "emissions.v1.EventNetworkInferenceWeightsSet.horizon":
+		return x.Horizon != uint32(0)
+	case "emissions.v1.EventNetworkInferenceWeightsSet.inferers":
+		return len(x.Inferers) != 0
+	case "emissions.v1.EventNetworkInferenceWeightsSet.inferer_weights":
+		return len(x.InfererWeights) != 0
+	case "emissions.v1.EventNetworkInferenceWeightsSet.forecasters":
+		return len(x.Forecasters) != 0
+	case "emissions.v1.EventNetworkInferenceWeightsSet.forecaster_weights":
+		return len(x.ForecasterWeights) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventNetworkInferenceWeightsSet"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventNetworkInferenceWeightsSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventNetworkInferenceWeightsSet) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v1.EventNetworkInferenceWeightsSet.topic_id":
+		x.TopicId = uint64(0)
+	case "emissions.v1.EventNetworkInferenceWeightsSet.block_height":
+		x.BlockHeight = int64(0)
+	case "emissions.v1.EventNetworkInferenceWeightsSet.horizon":
+		x.Horizon = uint32(0)
+	case "emissions.v1.EventNetworkInferenceWeightsSet.inferers":
+		x.Inferers = nil
+	case "emissions.v1.EventNetworkInferenceWeightsSet.inferer_weights":
+		x.InfererWeights = nil
+	case "emissions.v1.EventNetworkInferenceWeightsSet.forecasters":
+		x.Forecasters = nil
+	case "emissions.v1.EventNetworkInferenceWeightsSet.forecaster_weights":
+		x.ForecasterWeights = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventNetworkInferenceWeightsSet"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventNetworkInferenceWeightsSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventNetworkInferenceWeightsSet) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v1.EventNetworkInferenceWeightsSet.topic_id":
+		value := x.TopicId
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.EventNetworkInferenceWeightsSet.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v1.EventNetworkInferenceWeightsSet.horizon":
+		value := x.Horizon
+		return protoreflect.ValueOfUint32(value)
+	case "emissions.v1.EventNetworkInferenceWeightsSet.inferers":
+		if len(x.Inferers) == 0 {
+			return protoreflect.ValueOfList(&_EventNetworkInferenceWeightsSet_4_list{})
+		}
+		listValue := &_EventNetworkInferenceWeightsSet_4_list{list: &x.Inferers}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v1.EventNetworkInferenceWeightsSet.inferer_weights":
+		if len(x.InfererWeights) == 0 {
+			return protoreflect.ValueOfList(&_EventNetworkInferenceWeightsSet_5_list{})
+		}
+		listValue := &_EventNetworkInferenceWeightsSet_5_list{list: &x.InfererWeights}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v1.EventNetworkInferenceWeightsSet.forecasters":
+		if len(x.Forecasters) == 0 {
+			return protoreflect.ValueOfList(&_EventNetworkInferenceWeightsSet_6_list{})
+		}
+		listValue := &_EventNetworkInferenceWeightsSet_6_list{list: &x.Forecasters}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v1.EventNetworkInferenceWeightsSet.forecaster_weights":
+		if len(x.ForecasterWeights) == 0 {
+			return protoreflect.ValueOfList(&_EventNetworkInferenceWeightsSet_7_list{})
+		}
+		listValue := &_EventNetworkInferenceWeightsSet_7_list{list: &x.ForecasterWeights}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventNetworkInferenceWeightsSet"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventNetworkInferenceWeightsSet does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventNetworkInferenceWeightsSet) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v1.EventNetworkInferenceWeightsSet.topic_id":
+		x.TopicId = value.Uint()
+	case "emissions.v1.EventNetworkInferenceWeightsSet.block_height":
+		x.BlockHeight = value.Int()
+	case "emissions.v1.EventNetworkInferenceWeightsSet.horizon":
+		x.Horizon = uint32(value.Uint())
+	case "emissions.v1.EventNetworkInferenceWeightsSet.inferers":
+		lv := value.List()
+		clv := lv.(*_EventNetworkInferenceWeightsSet_4_list)
+		x.Inferers = *clv.list
+	case "emissions.v1.EventNetworkInferenceWeightsSet.inferer_weights":
+		lv := value.List()
+		clv := lv.(*_EventNetworkInferenceWeightsSet_5_list)
+		x.InfererWeights = *clv.list
+	case "emissions.v1.EventNetworkInferenceWeightsSet.forecasters":
+		lv := value.List()
+		clv := lv.(*_EventNetworkInferenceWeightsSet_6_list)
+		x.Forecasters = *clv.list
+	case "emissions.v1.EventNetworkInferenceWeightsSet.forecaster_weights":
+		lv := value.List()
+		clv := lv.(*_EventNetworkInferenceWeightsSet_7_list)
+		x.ForecasterWeights = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventNetworkInferenceWeightsSet"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventNetworkInferenceWeightsSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventNetworkInferenceWeightsSet) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.EventNetworkInferenceWeightsSet.inferers":
+		if x.Inferers == nil {
+			x.Inferers = []string{}
+		}
+		value := &_EventNetworkInferenceWeightsSet_4_list{list: &x.Inferers}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v1.EventNetworkInferenceWeightsSet.inferer_weights":
+		if x.InfererWeights == nil {
+			x.InfererWeights = []string{}
+		}
+		value := &_EventNetworkInferenceWeightsSet_5_list{list: &x.InfererWeights}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v1.EventNetworkInferenceWeightsSet.forecasters":
+		if x.Forecasters == nil {
+			x.Forecasters = []string{}
+		}
+		value := &_EventNetworkInferenceWeightsSet_6_list{list: &x.Forecasters}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v1.EventNetworkInferenceWeightsSet.forecaster_weights":
+		if x.ForecasterWeights == nil {
+			x.ForecasterWeights = []string{}
+		}
+		value := &_EventNetworkInferenceWeightsSet_7_list{list: &x.ForecasterWeights}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v1.EventNetworkInferenceWeightsSet.topic_id":
+		panic(fmt.Errorf("field topic_id of message emissions.v1.EventNetworkInferenceWeightsSet is not mutable"))
+	case "emissions.v1.EventNetworkInferenceWeightsSet.block_height":
+		panic(fmt.Errorf("field block_height of message emissions.v1.EventNetworkInferenceWeightsSet is not mutable"))
+	case "emissions.v1.EventNetworkInferenceWeightsSet.horizon":
+		panic(fmt.Errorf("field horizon of message emissions.v1.EventNetworkInferenceWeightsSet is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventNetworkInferenceWeightsSet"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventNetworkInferenceWeightsSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventNetworkInferenceWeightsSet) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.EventNetworkInferenceWeightsSet.topic_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.EventNetworkInferenceWeightsSet.block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v1.EventNetworkInferenceWeightsSet.horizon":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "emissions.v1.EventNetworkInferenceWeightsSet.inferers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_EventNetworkInferenceWeightsSet_4_list{list: &list})
+	case "emissions.v1.EventNetworkInferenceWeightsSet.inferer_weights":
+		list := []string{}
+		return protoreflect.ValueOfList(&_EventNetworkInferenceWeightsSet_5_list{list: &list})
+	case "emissions.v1.EventNetworkInferenceWeightsSet.forecasters":
+		list := []string{}
+		return protoreflect.ValueOfList(&_EventNetworkInferenceWeightsSet_6_list{list: &list})
+	case "emissions.v1.EventNetworkInferenceWeightsSet.forecaster_weights":
+		list := []string{}
+		return protoreflect.ValueOfList(&_EventNetworkInferenceWeightsSet_7_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventNetworkInferenceWeightsSet"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventNetworkInferenceWeightsSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventNetworkInferenceWeightsSet) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v1.EventNetworkInferenceWeightsSet", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventNetworkInferenceWeightsSet) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventNetworkInferenceWeightsSet) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventNetworkInferenceWeightsSet) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventNetworkInferenceWeightsSet) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventNetworkInferenceWeightsSet)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TopicId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TopicId))
+		}
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		}
+		if x.Horizon != 0 {
+			n += 1 + runtime.Sov(uint64(x.Horizon))
+		}
+		if len(x.Inferers) > 0 {
+			for _, s := range x.Inferers {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.InfererWeights) > 0 {
+			for _, s := range x.InfererWeights {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Forecasters) > 0 {
+			for _, s := range x.Forecasters {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ForecasterWeights) > 0 {
+			for _, s := range x.ForecasterWeights {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventNetworkInferenceWeightsSet)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ForecasterWeights) > 0 {
+			for iNdEx := len(x.ForecasterWeights) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.ForecasterWeights[iNdEx])
+				copy(dAtA[i:], x.ForecasterWeights[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ForecasterWeights[iNdEx])))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.Forecasters) > 0 {
+			for iNdEx := len(x.Forecasters) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Forecasters[iNdEx])
+				copy(dAtA[i:], x.Forecasters[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Forecasters[iNdEx])))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.InfererWeights) > 0 {
+			for iNdEx := len(x.InfererWeights) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.InfererWeights[iNdEx])
+				copy(dAtA[i:], x.InfererWeights[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InfererWeights[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.Inferers) > 0 {
+			for iNdEx := len(x.Inferers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Inferers[iNdEx])
+				copy(dAtA[i:], x.Inferers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Inferers[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.Horizon != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Horizon))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.TopicId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TopicId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventNetworkInferenceWeightsSet)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventNetworkInferenceWeightsSet: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventNetworkInferenceWeightsSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				}
+				x.TopicId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TopicId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Horizon", wireType)
+				}
+				x.Horizon = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Horizon |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Inferers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Inferers = append(x.Inferers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InfererWeights", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InfererWeights = append(x.InfererWeights, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Forecasters", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Forecasters = append(x.Forecasters, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ForecasterWeights", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ForecasterWeights = append(x.ForecasterWeights, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: emissions/v1/events.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ActorType int32
+
+const (
+	ActorType_INFERER    ActorType = 0
+	ActorType_FORECASTER ActorType = 1
+	ActorType_REPUTER    ActorType = 2
+)
+
+// Enum value maps for ActorType.
+var (
+	ActorType_name = map[int32]string{
+		0: "INFERER",
+		1: "FORECASTER",
+		2: "REPUTER",
+	}
+	ActorType_value = map[string]int32{
+		"INFERER":    0,
+		"FORECASTER": 1,
+		"REPUTER":    2,
+	}
+)
+
+func (x ActorType) Enum() *ActorType {
+	p := new(ActorType)
+	*p = x
+	return p
+}
+
+func (x ActorType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ActorType) Descriptor() protoreflect.EnumDescriptor {
+	return file_emissions_v1_events_proto_enumTypes[0].Descriptor()
+}
+
+func (ActorType) Type() protoreflect.EnumType {
+	return &file_emissions_v1_events_proto_enumTypes[0]
+}
+
+func (x ActorType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ActorType.Descriptor instead.
+func (ActorType) EnumDescriptor() ([]byte, []int) {
+	return file_emissions_v1_events_proto_rawDescGZIP(), []int{0}
+}
+
+type EventScoresSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActorType   ActorType `protobuf:"varint,1,opt,name=actor_type,json=actorType,proto3,enum=emissions.v1.ActorType" json:"actor_type,omitempty"`
+	TopicId     uint64    `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight int64     `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Addresses   []string  `protobuf:"bytes,4,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Scores      []string  `protobuf:"bytes,5,rep,name=scores,proto3" json:"scores,omitempty"`
+}
+
+func (x *EventScoresSet) Reset() {
+	*x = EventScoresSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v1_events_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventScoresSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventScoresSet) ProtoMessage() {}
+
+// Deprecated: Use EventScoresSet.ProtoReflect.Descriptor instead.
+func (*EventScoresSet) Descriptor() ([]byte, []int) {
+	return file_emissions_v1_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EventScoresSet) GetActorType() ActorType {
+	if x != nil {
+		return x.ActorType
+	}
+	return ActorType_INFERER
+}
+
+func (x *EventScoresSet) GetTopicId() uint64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *EventScoresSet) GetBlockHeight() int64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *EventScoresSet) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *EventScoresSet) GetScores() []string {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+type EventRewardsSettled struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActorType   ActorType `protobuf:"varint,1,opt,name=actor_type,json=actorType,proto3,enum=emissions.v1.ActorType" json:"actor_type,omitempty"`
+	TopicId     uint64    `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight int64     `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Addresses   []string  `protobuf:"bytes,4,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Rewards     []string  `protobuf:"bytes,5,rep,name=rewards,proto3" json:"rewards,omitempty"`
+}
+
+func (x *EventRewardsSettled) Reset() {
+	*x = EventRewardsSettled{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v1_events_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventRewardsSettled) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventRewardsSettled) ProtoMessage() {}
+
+// Deprecated: Use EventRewardsSettled.ProtoReflect.Descriptor instead.
+func (*EventRewardsSettled) Descriptor() ([]byte, []int) {
+	return file_emissions_v1_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EventRewardsSettled) GetActorType() ActorType {
+	if x != nil {
+		return x.ActorType
+	}
+	return ActorType_INFERER
+}
+
+func (x *EventRewardsSettled) GetTopicId() uint64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *EventRewardsSettled) GetBlockHeight() int64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *EventRewardsSettled) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *EventRewardsSettled) GetRewards() []string {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+type EventNetworkLossSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TopicId     uint64       `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight int64        `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	ValueBundle *ValueBundle `protobuf:"bytes,3,opt,name=value_bundle,json=valueBundle,proto3" json:"value_bundle,omitempty"`
+	Horizon     uint32       `protobuf:"varint,4,opt,name=horizon,proto3" json:"horizon,omitempty"`
+}
+
+func (x *EventNetworkLossSet) Reset() {
+	*x = EventNetworkLossSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v1_events_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventNetworkLossSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventNetworkLossSet) ProtoMessage() {}
+
+// Deprecated: Use EventNetworkLossSet.ProtoReflect.Descriptor instead.
+func (*EventNetworkLossSet) Descriptor() ([]byte, []int) {
+	return file_emissions_v1_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EventNetworkLossSet) GetTopicId() uint64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *EventNetworkLossSet) GetBlockHeight() int64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *EventNetworkLossSet) GetValueBundle() *ValueBundle {
+	if x != nil {
+		return x.ValueBundle
+	}
+	return nil
+}
+
+func (x *EventNetworkLossSet) GetHorizon() uint32 {
+	if x != nil {
+		return x.Horizon
+	}
+	return 0
+}
+
+type EventNodeInfoUpdated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsReputer          bool     `protobuf:"varint,1,opt,name=is_reputer,json=isReputer,proto3" json:"is_reputer,omitempty"`
+	NodeAddress        string   `protobuf:"bytes,2,opt,name=node_address,json=nodeAddress,proto3" json:"node_address,omitempty"`
+	PreviousLibP2PKeys []string `protobuf:"bytes,3,rep,name=previous_lib_p2p_keys,json=previousLibP2pKeys,proto3" json:"previous_lib_p2p_keys,omitempty"`
+	LibP2PKey          string   `protobuf:"bytes,4,opt,name=lib_p2p_key,json=libP2pKey,proto3" json:"lib_p2p_key,omitempty"`
+	MultiAddress       string   `protobuf:"bytes,5,opt,name=multi_address,json=multiAddress,proto3" json:"multi_address,omitempty"`
+	Owner              string   `protobuf:"bytes,6,opt,name=owner,proto3" json:"owner,omitempty"`
+	TopicIds           []uint64 `protobuf:"varint,7,rep,packed,name=topic_ids,json=topicIds,proto3" json:"topic_ids,omitempty"`
+}
+
+func (x *EventNodeInfoUpdated) Reset() {
+	*x = EventNodeInfoUpdated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v1_events_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventNodeInfoUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventNodeInfoUpdated) ProtoMessage() {}
+
+// Deprecated: Use EventNodeInfoUpdated.ProtoReflect.Descriptor instead.
+func (*EventNodeInfoUpdated) Descriptor() ([]byte, []int) {
+	return file_emissions_v1_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EventNodeInfoUpdated) GetIsReputer() bool {
+	if x != nil {
+		return x.IsReputer
+	}
+	return false
+}
+
+func (x *EventNodeInfoUpdated) GetNodeAddress() string {
+	if x != nil {
+		return x.NodeAddress
+	}
+	return ""
+}
+
+func (x *EventNodeInfoUpdated) GetPreviousLibP2PKeys() []string {
+	if x != nil {
+		return x.PreviousLibP2PKeys
+	}
+	return nil
+}
+
+func (x *EventNodeInfoUpdated) GetLibP2PKey() string {
+	if x != nil {
+		return x.LibP2PKey
+	}
+	return ""
+}
+
+func (x *EventNodeInfoUpdated) GetMultiAddress() string {
+	if x != nil {
+		return x.MultiAddress
+	}
+	return ""
+}
+
+func (x *EventNodeInfoUpdated) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *EventNodeInfoUpdated) GetTopicIds() []uint64 {
+	if x != nil {
+		return x.TopicIds
+	}
+	return nil
+}
+
+type EventInactiveActorsRemoved struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TopicId     uint64   `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight int64    `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Workers     []string `protobuf:"bytes,3,rep,name=workers,proto3" json:"workers,omitempty"`
+	Reputers    []string `protobuf:"bytes,4,rep,name=reputers,proto3" json:"reputers,omitempty"`
+}
+
+func (x *EventInactiveActorsRemoved) Reset() {
+	*x = EventInactiveActorsRemoved{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v1_events_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventInactiveActorsRemoved) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventInactiveActorsRemoved) ProtoMessage() {}
+
+// Deprecated: Use EventInactiveActorsRemoved.ProtoReflect.Descriptor instead.
+func (*EventInactiveActorsRemoved) Descriptor() ([]byte, []int) {
+	return file_emissions_v1_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EventInactiveActorsRemoved) GetTopicId() uint64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *EventInactiveActorsRemoved) GetBlockHeight() int64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *EventInactiveActorsRemoved) GetWorkers() []string {
+	if x != nil {
+		return x.Workers
+	}
+	return nil
+}
+
+func (x *EventInactiveActorsRemoved) GetReputers() []string {
+	if x != nil {
+		return x.Reputers
+	}
+	return nil
+}
+
+type EventNetworkInferenceWeightsSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TopicId           uint64   `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight       int64    `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Horizon           uint32   `protobuf:"varint,3,opt,name=horizon,proto3" json:"horizon,omitempty"`
+	Inferers          []string `protobuf:"bytes,4,rep,name=inferers,proto3" json:"inferers,omitempty"`
+	InfererWeights    []string `protobuf:"bytes,5,rep,name=inferer_weights,json=infererWeights,proto3" json:"inferer_weights,omitempty"`
+	Forecasters       []string `protobuf:"bytes,6,rep,name=forecasters,proto3" json:"forecasters,omitempty"`
+	ForecasterWeights []string `protobuf:"bytes,7,rep,name=forecaster_weights,json=forecasterWeights,proto3" json:"forecaster_weights,omitempty"`
+}
+
+func (x *EventNetworkInferenceWeightsSet) Reset() {
+	*x = EventNetworkInferenceWeightsSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v1_events_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventNetworkInferenceWeightsSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventNetworkInferenceWeightsSet) ProtoMessage() {}
+
+// Deprecated: Use EventNetworkInferenceWeightsSet.ProtoReflect.Descriptor instead.
+func (*EventNetworkInferenceWeightsSet) Descriptor() ([]byte, []int) {
+	return file_emissions_v1_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EventNetworkInferenceWeightsSet) GetTopicId() uint64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *EventNetworkInferenceWeightsSet) GetBlockHeight() int64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *EventNetworkInferenceWeightsSet) GetHorizon() uint32 {
+	if x != nil {
+		return x.Horizon
+	}
+	return 0
+}
+
+func (x *EventNetworkInferenceWeightsSet) GetInferers() []string {
+	if x != nil {
+		return x.Inferers
+	}
+	return nil
+}
+
+func (x *EventNetworkInferenceWeightsSet) GetInfererWeights() []string {
+	if x != nil {
+		return x.InfererWeights
+	}
+	return nil
+}
+
+func (x *EventNetworkInferenceWeightsSet) GetForecasters() []string {
+	if x != nil {
+		return x.Forecasters
+	}
+	return nil
+}
+
+func (x *EventNetworkInferenceWeightsSet) GetForecasterWeights() []string {
+	if x != nil {
+		return x.ForecasterWeights
+	}
+	return nil
+}
+
+var File_emissions_v1_events_proto protoreflect.FileDescriptor
+
+var file_emissions_v1_events_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x65, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x1a, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65,
 	0x70, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf5, 0x01, 0x0a, 0x0e,
@@ -2327,7 +5274,7 @@ var file_emissions_v1_events_proto_rawDesc = []byte{
 	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77,
 	0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e,
 	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x73, 0x22, 0x91, 0x01, 0x0a, 0x13, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x65, 0x74, 0x77,
+	0x64, 0x73, 0x22, 0xab, 0x01, 0x0a, 0x13, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x65, 0x74, 0x77,
 	0x6f, 0x72, 0x6b, 0x4c, 0x6f, 0x73, 0x73, 0x53, 0x65, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f,
 	0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f,
 	0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68,
@@ -2336,23 +5283,74 @@ var file_emissions_v1_events_proto_rawDesc = []byte{
 	0x65, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
 	0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61,
 	0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x0b, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x2a, 0x35, 0x0a, 0x09, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x4e, 0x46, 0x45, 0x52, 0x45, 0x52, 0x10, 0x00,
-	0x12, 0x0e, 0x0a, 0x0a, 0x46, 0x4f, 0x52, 0x45, 0x43, 0x41, 0x53, 0x54, 0x45, 0x52, 0x10, 0x01,
-	0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x50, 0x55, 0x54, 0x45, 0x52, 0x10, 0x02, 0x42, 0xc1, 0x01,
-	0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
-	0x76, 0x31, 0x42, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c,
-	0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c,
-	0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f, 0x65, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x58, 0x58, 0xaa, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x18, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x0d, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x3a, 0x56,
-	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e,
+	0x22, 0x83, 0x02, 0x0a, 0x14, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f,
+	0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69,
+	0x73, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x6e, 0x6f, 0x64, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x31, 0x0a, 0x15, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x6c, 0x69, 0x62, 0x5f, 0x70, 0x32, 0x70, 0x5f,
+	0x6b, 0x65, 0x79, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x4c, 0x69, 0x62, 0x50, 0x32, 0x70, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x1e,
+	0x0a, 0x0b, 0x6c, 0x69, 0x62, 0x5f, 0x70, 0x32, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x69, 0x62, 0x50, 0x32, 0x70, 0x4b, 0x65, 0x79, 0x12, 0x23,
+	0x0a, 0x0d, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x04, 0x52, 0x08, 0x74, 0x6f,
+	0x70, 0x69, 0x63, 0x49, 0x64, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x1a, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x49, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x73, 0x22, 0x81, 0x03, 0x0a, 0x1f, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x53, 0x65, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72,
+	0x73, 0x12, 0x60, 0x0a, 0x0f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x5f, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61,
+	0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c,
+	0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x44, 0x65, 0x63, 0x52, 0x0e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x57, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x66, 0x0a, 0x12, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73,
+	0x74, 0x65, 0x72, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x11, 0x66, 0x6f, 0x72, 0x65,
+	0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x2a, 0x35, 0x0a,
+	0x09, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x4e,
+	0x46, 0x45, 0x52, 0x45, 0x52, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x46, 0x4f, 0x52, 0x45, 0x43,
+	0x41, 0x53, 0x54, 0x45, 0x52, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x50, 0x55, 0x54,
+	0x45, 0x52, 0x10, 0x02, 0x42, 0xc1, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x78, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x58, 0x58, 0xaa,
+	0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02,
+	0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x18,
+	0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x45, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2368,18 +5366,21 @@ func file_emissions_v1_events_proto_rawDescGZIP() []byte {
 }
 
 var file_emissions_v1_events_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_emissions_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_emissions_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_emissions_v1_events_proto_goTypes = []interface{}{
-	(ActorType)(0),              // 0: emissions.v1.ActorType
-	(*EventScoresSet)(nil),      // 1: emissions.v1.EventScoresSet
-	(*EventRewardsSettled)(nil), // 2: emissions.v1.EventRewardsSettled
-	(*EventNetworkLossSet)(nil), // 3: emissions.v1.EventNetworkLossSet
-	(*ValueBundle)(nil),         // 4: emissions.v1.ValueBundle
+	(ActorType)(0),                          // 0: emissions.v1.ActorType
+	(*EventScoresSet)(nil),                  // 1: emissions.v1.EventScoresSet
+	(*EventRewardsSettled)(nil),             // 2: emissions.v1.EventRewardsSettled
+	(*EventNetworkLossSet)(nil),             // 3: emissions.v1.EventNetworkLossSet
+	(*EventNodeInfoUpdated)(nil),            // 4: emissions.v1.EventNodeInfoUpdated
+	(*EventInactiveActorsRemoved)(nil),      // 5: emissions.v1.EventInactiveActorsRemoved
+	(*EventNetworkInferenceWeightsSet)(nil), // 6: emissions.v1.EventNetworkInferenceWeightsSet
+	(*ValueBundle)(nil),                     // 7: emissions.v1.ValueBundle
 }
 var file_emissions_v1_events_proto_depIdxs = []int32{
 	0, // 0: emissions.v1.EventScoresSet.actor_type:type_name -> emissions.v1.ActorType
 	0, // 1: emissions.v1.EventRewardsSettled.actor_type:type_name -> emissions.v1.ActorType
-	4, // 2: emissions.v1.EventNetworkLossSet.value_bundle:type_name -> emissions.v1.ValueBundle
+	7, // 2: emissions.v1.EventNetworkLossSet.value_bundle:type_name -> emissions.v1.ValueBundle
 	3, // [3:3] is the sub-list for method output_type
 	3, // [3:3] is the sub-list for method input_type
 	3, // [3:3] is the sub-list for extension type_name
@@ -2430,6 +5431,42 @@ func file_emissions_v1_events_proto_init() {
 				return nil
 			}
 		}
+		file_emissions_v1_events_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventNodeInfoUpdated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_emissions_v1_events_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventInactiveActorsRemoved); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_emissions_v1_events_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventNetworkInferenceWeightsSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2437,7 +5474,7 @@ func file_emissions_v1_events_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_emissions_v1_events_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   3,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
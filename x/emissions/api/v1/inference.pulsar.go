@@ -497,6 +497,1414 @@ func (x *fastReflection_RegretInformedWeight) ProtoMethods() *protoiface.Methods
 	}
 }
 
+var _ protoreflect.List = (*_NetworkInferenceWeights_4_list)(nil)
+
+type _NetworkInferenceWeights_4_list struct {
+	list *[]*RegretInformedWeight
+}
+
+func (x *_NetworkInferenceWeights_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_NetworkInferenceWeights_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_NetworkInferenceWeights_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RegretInformedWeight)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_NetworkInferenceWeights_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RegretInformedWeight)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_NetworkInferenceWeights_4_list) AppendMutable() protoreflect.Value {
+	v := new(RegretInformedWeight)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_NetworkInferenceWeights_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_NetworkInferenceWeights_4_list) NewElement() protoreflect.Value {
+	v := new(RegretInformedWeight)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_NetworkInferenceWeights_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_NetworkInferenceWeights_5_list)(nil)
+
+type _NetworkInferenceWeights_5_list struct {
+	list *[]*RegretInformedWeight
+}
+
+func (x *_NetworkInferenceWeights_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_NetworkInferenceWeights_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_NetworkInferenceWeights_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RegretInformedWeight)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_NetworkInferenceWeights_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RegretInformedWeight)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_NetworkInferenceWeights_5_list) AppendMutable() protoreflect.Value {
+	v := new(RegretInformedWeight)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_NetworkInferenceWeights_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_NetworkInferenceWeights_5_list) NewElement() protoreflect.Value {
+	v := new(RegretInformedWeight)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_NetworkInferenceWeights_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_NetworkInferenceWeights_6_list)(nil)
+
+type _NetworkInferenceWeights_6_list struct {
+	list *[]*WorkerAttributedValue
+}
+
+func (x *_NetworkInferenceWeights_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_NetworkInferenceWeights_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_NetworkInferenceWeights_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*WorkerAttributedValue)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_NetworkInferenceWeights_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*WorkerAttributedValue)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_NetworkInferenceWeights_6_list) AppendMutable() protoreflect.Value {
+	v := new(WorkerAttributedValue)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_NetworkInferenceWeights_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_NetworkInferenceWeights_6_list) NewElement() protoreflect.Value {
+	v := new(WorkerAttributedValue)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_NetworkInferenceWeights_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_NetworkInferenceWeights                             protoreflect.MessageDescriptor
+	fd_NetworkInferenceWeights_topic_id                    protoreflect.FieldDescriptor
+	fd_NetworkInferenceWeights_block_height                protoreflect.FieldDescriptor
+	fd_NetworkInferenceWeights_horizon                     protoreflect.FieldDescriptor
+	fd_NetworkInferenceWeights_inferer_weights             protoreflect.FieldDescriptor
+	fd_NetworkInferenceWeights_forecaster_weights          protoreflect.FieldDescriptor
+	fd_NetworkInferenceWeights_forecast_implied_inferences protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v1_inference_proto_init()
+	md_NetworkInferenceWeights = File_emissions_v1_inference_proto.Messages().ByName("NetworkInferenceWeights")
+	fd_NetworkInferenceWeights_topic_id = md_NetworkInferenceWeights.Fields().ByName("topic_id")
+	fd_NetworkInferenceWeights_block_height = md_NetworkInferenceWeights.Fields().ByName("block_height")
+	fd_NetworkInferenceWeights_horizon = md_NetworkInferenceWeights.Fields().ByName("horizon")
+	fd_NetworkInferenceWeights_inferer_weights = md_NetworkInferenceWeights.Fields().ByName("inferer_weights")
+	fd_NetworkInferenceWeights_forecaster_weights = md_NetworkInferenceWeights.Fields().ByName("forecaster_weights")
+	fd_NetworkInferenceWeights_forecast_implied_inferences = md_NetworkInferenceWeights.Fields().ByName("forecast_implied_inferences")
+}
+
+var _ protoreflect.Message = (*fastReflection_NetworkInferenceWeights)(nil)
+
+type fastReflection_NetworkInferenceWeights NetworkInferenceWeights
+
+func (x *NetworkInferenceWeights) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_NetworkInferenceWeights)(x)
+}
+
+func (x *NetworkInferenceWeights) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v1_inference_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_NetworkInferenceWeights_messageType fastReflection_NetworkInferenceWeights_messageType
+var _ protoreflect.MessageType = fastReflection_NetworkInferenceWeights_messageType{}
+
+type fastReflection_NetworkInferenceWeights_messageType struct{}
+
+func (x fastReflection_NetworkInferenceWeights_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_NetworkInferenceWeights)(nil)
+}
+func (x fastReflection_NetworkInferenceWeights_messageType) New() protoreflect.Message {
+	return new(fastReflection_NetworkInferenceWeights)
+}
+func (x fastReflection_NetworkInferenceWeights_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_NetworkInferenceWeights
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_NetworkInferenceWeights) Descriptor() protoreflect.MessageDescriptor {
+	return md_NetworkInferenceWeights
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_NetworkInferenceWeights) Type() protoreflect.MessageType {
+	return _fastReflection_NetworkInferenceWeights_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_NetworkInferenceWeights) New() protoreflect.Message {
+	return new(fastReflection_NetworkInferenceWeights)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_NetworkInferenceWeights) Interface() protoreflect.ProtoMessage {
+	return (*NetworkInferenceWeights)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_NetworkInferenceWeights) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TopicId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TopicId)
+		if !f(fd_NetworkInferenceWeights_topic_id, value) {
+			return
+		}
+	}
+	if x.BlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockHeight)
+		if !f(fd_NetworkInferenceWeights_block_height, value) {
+			return
+		}
+	}
+	if x.Horizon != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Horizon)
+		if !f(fd_NetworkInferenceWeights_horizon, value) {
+			return
+		}
+	}
+	if len(x.InfererWeights) != 0 {
+		value := protoreflect.ValueOfList(&_NetworkInferenceWeights_4_list{list: &x.InfererWeights})
+		if !f(fd_NetworkInferenceWeights_inferer_weights, value) {
+			return
+		}
+	}
+	if len(x.ForecasterWeights) != 0 {
+		value := protoreflect.ValueOfList(&_NetworkInferenceWeights_5_list{list: &x.ForecasterWeights})
+		if !f(fd_NetworkInferenceWeights_forecaster_weights, value) {
+			return
+		}
+	}
+	if len(x.ForecastImpliedInferences) != 0 {
+		value := protoreflect.ValueOfList(&_NetworkInferenceWeights_6_list{list: &x.ForecastImpliedInferences})
+		if !f(fd_NetworkInferenceWeights_forecast_implied_inferences, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_NetworkInferenceWeights) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v1.NetworkInferenceWeights.topic_id":
+		return x.TopicId != uint64(0)
+	case "emissions.v1.NetworkInferenceWeights.block_height":
+		return x.BlockHeight != int64(0)
+	case "emissions.v1.NetworkInferenceWeights.horizon":
+		return x.Horizon != uint32(0)
+	case "emissions.v1.NetworkInferenceWeights.inferer_weights":
+		return len(x.InfererWeights) != 0
+	case "emissions.v1.NetworkInferenceWeights.forecaster_weights":
+		return len(x.ForecasterWeights) != 0
+	case "emissions.v1.NetworkInferenceWeights.forecast_implied_inferences":
+		return len(x.ForecastImpliedInferences) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.NetworkInferenceWeights"))
+		}
+		panic(fmt.Errorf("message emissions.v1.NetworkInferenceWeights does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NetworkInferenceWeights) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v1.NetworkInferenceWeights.topic_id":
+		x.TopicId = uint64(0)
+	case "emissions.v1.NetworkInferenceWeights.block_height":
+		x.BlockHeight = int64(0)
+	case "emissions.v1.NetworkInferenceWeights.horizon":
+		x.Horizon = uint32(0)
+	case "emissions.v1.NetworkInferenceWeights.inferer_weights":
+		x.InfererWeights = nil
+	case "emissions.v1.NetworkInferenceWeights.forecaster_weights":
+		x.ForecasterWeights = nil
+	case "emissions.v1.NetworkInferenceWeights.forecast_implied_inferences":
+		x.ForecastImpliedInferences = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.NetworkInferenceWeights"))
+		}
+		panic(fmt.Errorf("message emissions.v1.NetworkInferenceWeights does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_NetworkInferenceWeights) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v1.NetworkInferenceWeights.topic_id":
+		value := x.TopicId
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.NetworkInferenceWeights.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v1.NetworkInferenceWeights.horizon":
+		value := x.Horizon
+		return protoreflect.ValueOfUint32(value)
+	case "emissions.v1.NetworkInferenceWeights.inferer_weights":
+		if len(x.InfererWeights) == 0 {
+			return protoreflect.ValueOfList(&_NetworkInferenceWeights_4_list{})
+		}
+		listValue := &_NetworkInferenceWeights_4_list{list: &x.InfererWeights}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v1.NetworkInferenceWeights.forecaster_weights":
+		if len(x.ForecasterWeights) == 0 {
+			return protoreflect.ValueOfList(&_NetworkInferenceWeights_5_list{})
+		}
+		listValue := &_NetworkInferenceWeights_5_list{list: &x.ForecasterWeights}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v1.NetworkInferenceWeights.forecast_implied_inferences":
+		if len(x.ForecastImpliedInferences) == 0 {
+			return protoreflect.ValueOfList(&_NetworkInferenceWeights_6_list{})
+		}
+		listValue := &_NetworkInferenceWeights_6_list{list: &x.ForecastImpliedInferences}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.NetworkInferenceWeights"))
+		}
+		panic(fmt.Errorf("message emissions.v1.NetworkInferenceWeights does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NetworkInferenceWeights) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v1.NetworkInferenceWeights.topic_id":
+		x.TopicId = value.Uint()
+	case "emissions.v1.NetworkInferenceWeights.block_height":
+		x.BlockHeight = value.Int()
+	case "emissions.v1.NetworkInferenceWeights.horizon":
+		x.Horizon = uint32(value.Uint())
+	case "emissions.v1.NetworkInferenceWeights.inferer_weights":
+		lv := value.List()
+		clv := lv.(*_NetworkInferenceWeights_4_list)
+		x.InfererWeights = *clv.list
+	case "emissions.v1.NetworkInferenceWeights.forecaster_weights":
+		lv := value.List()
+		clv := lv.(*_NetworkInferenceWeights_5_list)
+		x.ForecasterWeights = *clv.list
+	case "emissions.v1.NetworkInferenceWeights.forecast_implied_inferences":
+		lv := value.List()
+		clv := lv.(*_NetworkInferenceWeights_6_list)
+		x.ForecastImpliedInferences = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.NetworkInferenceWeights"))
+		}
+		panic(fmt.Errorf("message emissions.v1.NetworkInferenceWeights does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NetworkInferenceWeights) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.NetworkInferenceWeights.inferer_weights":
+		if x.InfererWeights == nil {
+			x.InfererWeights = []*RegretInformedWeight{}
+		}
+		value := &_NetworkInferenceWeights_4_list{list: &x.InfererWeights}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v1.NetworkInferenceWeights.forecaster_weights":
+		if x.ForecasterWeights == nil {
+			x.ForecasterWeights = []*RegretInformedWeight{}
+		}
+		value := &_NetworkInferenceWeights_5_list{list: &x.ForecasterWeights}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v1.NetworkInferenceWeights.forecast_implied_inferences":
+		if x.ForecastImpliedInferences == nil {
+			x.ForecastImpliedInferences = []*WorkerAttributedValue{}
+		}
+		value := &_NetworkInferenceWeights_6_list{list: &x.ForecastImpliedInferences}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v1.NetworkInferenceWeights.topic_id":
+		panic(fmt.Errorf("field topic_id of message emissions.v1.NetworkInferenceWeights is not mutable"))
+	case "emissions.v1.NetworkInferenceWeights.block_height":
+		panic(fmt.Errorf("field block_height of message emissions.v1.NetworkInferenceWeights is not mutable"))
+	case "emissions.v1.NetworkInferenceWeights.horizon":
+		panic(fmt.Errorf("field horizon of message emissions.v1.NetworkInferenceWeights is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.NetworkInferenceWeights"))
+		}
+		panic(fmt.Errorf("message emissions.v1.NetworkInferenceWeights does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_NetworkInferenceWeights) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.NetworkInferenceWeights.topic_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.NetworkInferenceWeights.block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v1.NetworkInferenceWeights.horizon":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "emissions.v1.NetworkInferenceWeights.inferer_weights":
+		list := []*RegretInformedWeight{}
+		return protoreflect.ValueOfList(&_NetworkInferenceWeights_4_list{list: &list})
+	case "emissions.v1.NetworkInferenceWeights.forecaster_weights":
+		list := []*RegretInformedWeight{}
+		return protoreflect.ValueOfList(&_NetworkInferenceWeights_5_list{list: &list})
+	case "emissions.v1.NetworkInferenceWeights.forecast_implied_inferences":
+		list := []*WorkerAttributedValue{}
+		return protoreflect.ValueOfList(&_NetworkInferenceWeights_6_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.NetworkInferenceWeights"))
+		}
+		panic(fmt.Errorf("message emissions.v1.NetworkInferenceWeights does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_NetworkInferenceWeights) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v1.NetworkInferenceWeights", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_NetworkInferenceWeights) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NetworkInferenceWeights) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_NetworkInferenceWeights) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_NetworkInferenceWeights) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*NetworkInferenceWeights)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TopicId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TopicId))
+		}
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		}
+		if x.Horizon != 0 {
+			n += 1 + runtime.Sov(uint64(x.Horizon))
+		}
+		if len(x.InfererWeights) > 0 {
+			for _, e := range x.InfererWeights {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ForecasterWeights) > 0 {
+			for _, e := range x.ForecasterWeights {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ForecastImpliedInferences) > 0 {
+			for _, e := range x.ForecastImpliedInferences {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*NetworkInferenceWeights)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ForecastImpliedInferences) > 0 {
+			for iNdEx := len(x.ForecastImpliedInferences) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ForecastImpliedInferences[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.ForecasterWeights) > 0 {
+			for iNdEx := len(x.ForecasterWeights) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ForecasterWeights[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.InfererWeights) > 0 {
+			for iNdEx := len(x.InfererWeights) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.InfererWeights[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.Horizon != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Horizon))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.TopicId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TopicId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*NetworkInferenceWeights)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: NetworkInferenceWeights: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: NetworkInferenceWeights: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				}
+				x.TopicId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TopicId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Horizon", wireType)
+				}
+				x.Horizon = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Horizon |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InfererWeights", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InfererWeights = append(x.InfererWeights, &RegretInformedWeight{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.InfererWeights[len(x.InfererWeights)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ForecasterWeights", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ForecasterWeights = append(x.ForecasterWeights, &RegretInformedWeight{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ForecasterWeights[len(x.ForecasterWeights)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ForecastImpliedInferences", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ForecastImpliedInferences = append(x.ForecastImpliedInferences, &WorkerAttributedValue{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ForecastImpliedInferences[len(x.ForecastImpliedInferences)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_NetworkInferenceWeightsAtBlock_1_list)(nil)
+
+type _NetworkInferenceWeightsAtBlock_1_list struct {
+	list *[]*NetworkInferenceWeights
+}
+
+func (x *_NetworkInferenceWeightsAtBlock_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_NetworkInferenceWeightsAtBlock_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_NetworkInferenceWeightsAtBlock_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*NetworkInferenceWeights)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_NetworkInferenceWeightsAtBlock_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*NetworkInferenceWeights)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_NetworkInferenceWeightsAtBlock_1_list) AppendMutable() protoreflect.Value {
+	v := new(NetworkInferenceWeights)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_NetworkInferenceWeightsAtBlock_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_NetworkInferenceWeightsAtBlock_1_list) NewElement() protoreflect.Value {
+	v := new(NetworkInferenceWeights)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_NetworkInferenceWeightsAtBlock_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_NetworkInferenceWeightsAtBlock          protoreflect.MessageDescriptor
+	fd_NetworkInferenceWeightsAtBlock_horizons protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v1_inference_proto_init()
+	md_NetworkInferenceWeightsAtBlock = File_emissions_v1_inference_proto.Messages().ByName("NetworkInferenceWeightsAtBlock")
+	fd_NetworkInferenceWeightsAtBlock_horizons = md_NetworkInferenceWeightsAtBlock.Fields().ByName("horizons")
+}
+
+var _ protoreflect.Message = (*fastReflection_NetworkInferenceWeightsAtBlock)(nil)
+
+type fastReflection_NetworkInferenceWeightsAtBlock NetworkInferenceWeightsAtBlock
+
+func (x *NetworkInferenceWeightsAtBlock) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_NetworkInferenceWeightsAtBlock)(x)
+}
+
+func (x *NetworkInferenceWeightsAtBlock) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v1_inference_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_NetworkInferenceWeightsAtBlock_messageType fastReflection_NetworkInferenceWeightsAtBlock_messageType
+var _ protoreflect.MessageType = fastReflection_NetworkInferenceWeightsAtBlock_messageType{}
+
+type fastReflection_NetworkInferenceWeightsAtBlock_messageType struct{}
+
+func (x fastReflection_NetworkInferenceWeightsAtBlock_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_NetworkInferenceWeightsAtBlock)(nil)
+}
+func (x fastReflection_NetworkInferenceWeightsAtBlock_messageType) New() protoreflect.Message {
+	return new(fastReflection_NetworkInferenceWeightsAtBlock)
+}
+func (x fastReflection_NetworkInferenceWeightsAtBlock_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_NetworkInferenceWeightsAtBlock
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_NetworkInferenceWeightsAtBlock) Descriptor() protoreflect.MessageDescriptor {
+	return md_NetworkInferenceWeightsAtBlock
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_NetworkInferenceWeightsAtBlock) Type() protoreflect.MessageType {
+	return _fastReflection_NetworkInferenceWeightsAtBlock_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_NetworkInferenceWeightsAtBlock) New() protoreflect.Message {
+	return new(fastReflection_NetworkInferenceWeightsAtBlock)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_NetworkInferenceWeightsAtBlock) Interface() protoreflect.ProtoMessage {
+	return (*NetworkInferenceWeightsAtBlock)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_NetworkInferenceWeightsAtBlock) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Horizons) != 0 {
+		value := protoreflect.ValueOfList(&_NetworkInferenceWeightsAtBlock_1_list{list: &x.Horizons})
+		if !f(fd_NetworkInferenceWeightsAtBlock_horizons, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_NetworkInferenceWeightsAtBlock) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v1.NetworkInferenceWeightsAtBlock.horizons":
+		return len(x.Horizons) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.NetworkInferenceWeightsAtBlock"))
+		}
+		panic(fmt.Errorf("message emissions.v1.NetworkInferenceWeightsAtBlock does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NetworkInferenceWeightsAtBlock) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v1.NetworkInferenceWeightsAtBlock.horizons":
+		x.Horizons = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.NetworkInferenceWeightsAtBlock"))
+		}
+		panic(fmt.Errorf("message emissions.v1.NetworkInferenceWeightsAtBlock does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_NetworkInferenceWeightsAtBlock) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v1.NetworkInferenceWeightsAtBlock.horizons":
+		if len(x.Horizons) == 0 {
+			return protoreflect.ValueOfList(&_NetworkInferenceWeightsAtBlock_1_list{})
+		}
+		listValue := &_NetworkInferenceWeightsAtBlock_1_list{list: &x.Horizons}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.NetworkInferenceWeightsAtBlock"))
+		}
+		panic(fmt.Errorf("message emissions.v1.NetworkInferenceWeightsAtBlock does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NetworkInferenceWeightsAtBlock) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v1.NetworkInferenceWeightsAtBlock.horizons":
+		lv := value.List()
+		clv := lv.(*_NetworkInferenceWeightsAtBlock_1_list)
+		x.Horizons = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.NetworkInferenceWeightsAtBlock"))
+		}
+		panic(fmt.Errorf("message emissions.v1.NetworkInferenceWeightsAtBlock does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NetworkInferenceWeightsAtBlock) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.NetworkInferenceWeightsAtBlock.horizons":
+		if x.Horizons == nil {
+			x.Horizons = []*NetworkInferenceWeights{}
+		}
+		value := &_NetworkInferenceWeightsAtBlock_1_list{list: &x.Horizons}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.NetworkInferenceWeightsAtBlock"))
+		}
+		panic(fmt.Errorf("message emissions.v1.NetworkInferenceWeightsAtBlock does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_NetworkInferenceWeightsAtBlock) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.NetworkInferenceWeightsAtBlock.horizons":
+		list := []*NetworkInferenceWeights{}
+		return protoreflect.ValueOfList(&_NetworkInferenceWeightsAtBlock_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.NetworkInferenceWeightsAtBlock"))
+		}
+		panic(fmt.Errorf("message emissions.v1.NetworkInferenceWeightsAtBlock does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_NetworkInferenceWeightsAtBlock) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v1.NetworkInferenceWeightsAtBlock", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_NetworkInferenceWeightsAtBlock) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NetworkInferenceWeightsAtBlock) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_NetworkInferenceWeightsAtBlock) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_NetworkInferenceWeightsAtBlock) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*NetworkInferenceWeightsAtBlock)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Horizons) > 0 {
+			for _, e := range x.Horizons {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*NetworkInferenceWeightsAtBlock)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Horizons) > 0 {
+			for iNdEx := len(x.Horizons) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Horizons[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*NetworkInferenceWeightsAtBlock)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: NetworkInferenceWeightsAtBlock: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: NetworkInferenceWeightsAtBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Horizons", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Horizons = append(x.Horizons, &NetworkInferenceWeights{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Horizons[len(x.Horizons)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.27.0
@@ -553,6 +1961,118 @@ func (x *RegretInformedWeight) GetWeight() string {
 	return ""
 }
 
+// Regret-informed weights that the network inference of a horizon of a topic was combined with
+type NetworkInferenceWeights struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TopicId                   uint64                   `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight               int64                    `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Horizon                   uint32                   `protobuf:"varint,3,opt,name=horizon,proto3" json:"horizon,omitempty"`
+	InfererWeights            []*RegretInformedWeight  `protobuf:"bytes,4,rep,name=inferer_weights,json=infererWeights,proto3" json:"inferer_weights,omitempty"`
+	ForecasterWeights         []*RegretInformedWeight  `protobuf:"bytes,5,rep,name=forecaster_weights,json=forecasterWeights,proto3" json:"forecaster_weights,omitempty"`
+	ForecastImpliedInferences []*WorkerAttributedValue `protobuf:"bytes,6,rep,name=forecast_implied_inferences,json=forecastImpliedInferences,proto3" json:"forecast_implied_inferences,omitempty"`
+}
+
+func (x *NetworkInferenceWeights) Reset() {
+	*x = NetworkInferenceWeights{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v1_inference_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetworkInferenceWeights) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkInferenceWeights) ProtoMessage() {}
+
+// Deprecated: Use NetworkInferenceWeights.ProtoReflect.Descriptor instead.
+func (*NetworkInferenceWeights) Descriptor() ([]byte, []int) {
+	return file_emissions_v1_inference_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NetworkInferenceWeights) GetTopicId() uint64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *NetworkInferenceWeights) GetBlockHeight() int64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *NetworkInferenceWeights) GetHorizon() uint32 {
+	if x != nil {
+		return x.Horizon
+	}
+	return 0
+}
+
+func (x *NetworkInferenceWeights) GetInfererWeights() []*RegretInformedWeight {
+	if x != nil {
+		return x.InfererWeights
+	}
+	return nil
+}
+
+func (x *NetworkInferenceWeights) GetForecasterWeights() []*RegretInformedWeight {
+	if x != nil {
+		return x.ForecasterWeights
+	}
+	return nil
+}
+
+func (x *NetworkInferenceWeights) GetForecastImpliedInferences() []*WorkerAttributedValue {
+	if x != nil {
+		return x.ForecastImpliedInferences
+	}
+	return nil
+}
+
+// Weights of the network inferences of every horizon of a topic at a block
+type NetworkInferenceWeightsAtBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Horizons []*NetworkInferenceWeights `protobuf:"bytes,1,rep,name=horizons,proto3" json:"horizons,omitempty"`
+}
+
+func (x *NetworkInferenceWeightsAtBlock) Reset() {
+	*x = NetworkInferenceWeightsAtBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v1_inference_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetworkInferenceWeightsAtBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkInferenceWeightsAtBlock) ProtoMessage() {}
+
+// Deprecated: Use NetworkInferenceWeightsAtBlock.ProtoReflect.Descriptor instead.
+func (*NetworkInferenceWeightsAtBlock) Descriptor() ([]byte, []int) {
+	return file_emissions_v1_inference_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *NetworkInferenceWeightsAtBlock) GetHorizons() []*NetworkInferenceWeights {
+	if x != nil {
+		return x.Horizons
+	}
+	return nil
+}
+
 var File_emissions_v1_inference_proto protoreflect.FileDescriptor
 
 var file_emissions_v1_inference_proto_rawDesc = []byte{
@@ -561,29 +2081,60 @@ var file_emissions_v1_inference_proto_rawDesc = []byte{
 	0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x67, 0x6f,
 	0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x18, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x31,
-	0x2f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x85, 0x01, 0x0a,
-	0x14, 0x52, 0x65, 0x67, 0x72, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x64, 0x57,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x4f, 0x0a,
-	0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8,
-	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x2f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x65, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x75, 0x74,
+	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x85, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x67,
+	0x72, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x4f, 0x0a, 0x06, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c,
+	0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c,
+	0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44,
+	0x65, 0x63, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01,
+	0x22, 0xf6, 0x02, 0x0a, 0x17, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x08,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x6f, 0x6e, 0x12, 0x4b, 0x0a, 0x0f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x5f,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67,
+	0x72, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x52, 0x0e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x73, 0x12, 0x51, 0x0a, 0x12, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67,
+	0x72, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x52, 0x11, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x57, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x73, 0x12, 0x63, 0x0a, 0x1b, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74,
+	0x5f, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x19,
+	0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x49, 0x6d, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x49,
+	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x63, 0x0a, 0x1e, 0x4e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x57, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x73, 0x41, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x41, 0x0a, 0x08, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x57, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x73, 0x52, 0x08, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x73, 0x42, 0xc4,
+	0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x76, 0x31, 0x42, 0x0e, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
 	0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61,
-	0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x3a, 0x04,
-	0xe8, 0xa0, 0x1f, 0x01, 0x42, 0xc4, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0e, 0x49, 0x6e, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x31,
-	0x3b, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45,
-	0x58, 0x58, 0xaa, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x56,
-	0x31, 0xca, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x31,
-	0xe2, 0x02, 0x18, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x31, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x45, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f,
+	0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x58, 0x58, 0xaa, 0x02, 0x0c, 0x45,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c, 0x45, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x18, 0x45, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -598,16 +2149,23 @@ func file_emissions_v1_inference_proto_rawDescGZIP() []byte {
 	return file_emissions_v1_inference_proto_rawDescData
 }
 
-var file_emissions_v1_inference_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_emissions_v1_inference_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_emissions_v1_inference_proto_goTypes = []interface{}{
-	(*RegretInformedWeight)(nil), // 0: emissions.v1.RegretInformedWeight
+	(*RegretInformedWeight)(nil),           // 0: emissions.v1.RegretInformedWeight
+	(*NetworkInferenceWeights)(nil),        // 1: emissions.v1.NetworkInferenceWeights
+	(*NetworkInferenceWeightsAtBlock)(nil), // 2: emissions.v1.NetworkInferenceWeightsAtBlock
+	(*WorkerAttributedValue)(nil),          // 3: emissions.v1.WorkerAttributedValue
 }
 var file_emissions_v1_inference_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0, // 0: emissions.v1.NetworkInferenceWeights.inferer_weights:type_name -> emissions.v1.RegretInformedWeight
+	0, // 1: emissions.v1.NetworkInferenceWeights.forecaster_weights:type_name -> emissions.v1.RegretInformedWeight
+	3, // 2: emissions.v1.NetworkInferenceWeights.forecast_implied_inferences:type_name -> emissions.v1.WorkerAttributedValue
+	1, // 3: emissions.v1.NetworkInferenceWeightsAtBlock.horizons:type_name -> emissions.v1.NetworkInferenceWeights
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_emissions_v1_inference_proto_init() }
@@ -616,6 +2174,7 @@ func file_emissions_v1_inference_proto_init() {
 		return
 	}
 	file_emissions_v1_nonce_proto_init()
+	file_emissions_v1_reputer_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_emissions_v1_inference_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RegretInformedWeight); i {
@@ -629,6 +2188,30 @@ func file_emissions_v1_inference_proto_init() {
 				return nil
 			}
 		}
+		file_emissions_v1_inference_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetworkInferenceWeights); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_emissions_v1_inference_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetworkInferenceWeightsAtBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -636,7 +2219,7 @@ func file_emissions_v1_inference_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_emissions_v1_inference_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
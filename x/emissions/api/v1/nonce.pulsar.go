@@ -914,12 +914,14 @@ func (x *fastReflection_Nonces) ProtoMethods() *protoiface.Methods {
 var (
 	md_ReputerRequestNonce               protoreflect.MessageDescriptor
 	fd_ReputerRequestNonce_reputer_nonce protoreflect.FieldDescriptor
+	fd_ReputerRequestNonce_horizon       protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_emissions_v1_nonce_proto_init()
 	md_ReputerRequestNonce = File_emissions_v1_nonce_proto.Messages().ByName("ReputerRequestNonce")
 	fd_ReputerRequestNonce_reputer_nonce = md_ReputerRequestNonce.Fields().ByName("reputer_nonce")
+	fd_ReputerRequestNonce_horizon = md_ReputerRequestNonce.Fields().ByName("horizon")
 }
 
 var _ protoreflect.Message = (*fastReflection_ReputerRequestNonce)(nil)
@@ -993,6 +995,12 @@ func (x *fastReflection_ReputerRequestNonce) Range(f func(protoreflect.FieldDesc
 			return
 		}
 	}
+	if x.Horizon != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Horizon)
+		if !f(fd_ReputerRequestNonce_horizon, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1010,6 +1018,8 @@ func (x *fastReflection_ReputerRequestNonce) Has(fd protoreflect.FieldDescriptor
 	switch fd.FullName() {
 	case "emissions.v1.ReputerRequestNonce.reputer_nonce":
 		return x.ReputerNonce != nil
+	case "emissions.v1.ReputerRequestNonce.horizon":
+		return x.Horizon != uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.ReputerRequestNonce"))
@@ -1028,6 +1038,8 @@ func (x *fastReflection_ReputerRequestNonce) Clear(fd protoreflect.FieldDescript
 	switch fd.FullName() {
 	case "emissions.v1.ReputerRequestNonce.reputer_nonce":
 		x.ReputerNonce = nil
+	case "emissions.v1.ReputerRequestNonce.horizon":
+		x.Horizon = uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.ReputerRequestNonce"))
@@ -1047,6 +1059,9 @@ func (x *fastReflection_ReputerRequestNonce) Get(descriptor protoreflect.FieldDe
 	case "emissions.v1.ReputerRequestNonce.reputer_nonce":
 		value := x.ReputerNonce
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "emissions.v1.ReputerRequestNonce.horizon":
+		value := x.Horizon
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.ReputerRequestNonce"))
@@ -1069,6 +1084,8 @@ func (x *fastReflection_ReputerRequestNonce) Set(fd protoreflect.FieldDescriptor
 	switch fd.FullName() {
 	case "emissions.v1.ReputerRequestNonce.reputer_nonce":
 		x.ReputerNonce = value.Message().Interface().(*Nonce)
+	case "emissions.v1.ReputerRequestNonce.horizon":
+		x.Horizon = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.ReputerRequestNonce"))
@@ -1094,6 +1111,8 @@ func (x *fastReflection_ReputerRequestNonce) Mutable(fd protoreflect.FieldDescri
 			x.ReputerNonce = new(Nonce)
 		}
 		return protoreflect.ValueOfMessage(x.ReputerNonce.ProtoReflect())
+	case "emissions.v1.ReputerRequestNonce.horizon":
+		panic(fmt.Errorf("field horizon of message emissions.v1.ReputerRequestNonce is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.ReputerRequestNonce"))
@@ -1110,6 +1129,8 @@ func (x *fastReflection_ReputerRequestNonce) NewField(fd protoreflect.FieldDescr
 	case "emissions.v1.ReputerRequestNonce.reputer_nonce":
 		m := new(Nonce)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "emissions.v1.ReputerRequestNonce.horizon":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.ReputerRequestNonce"))
@@ -1183,6 +1204,9 @@ func (x *fastReflection_ReputerRequestNonce) ProtoMethods() *protoiface.Methods
 			l = options.Size(x.ReputerNonce)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Horizon != 0 {
+			n += 1 + runtime.Sov(uint64(x.Horizon))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1212,6 +1236,11 @@ func (x *fastReflection_ReputerRequestNonce) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Horizon != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Horizon))
+			i--
+			dAtA[i] = 0x10
+		}
 		if x.ReputerNonce != nil {
 			encoded, err := options.Marshal(x.ReputerNonce)
 			if err != nil {
@@ -1311,6 +1340,25 @@ func (x *fastReflection_ReputerRequestNonce) ProtoMethods() *protoiface.Methods
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Horizon", wireType)
+				}
+				x.Horizon = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Horizon |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1931,6 +1979,8 @@ type ReputerRequestNonce struct {
 	// the reputers should respond to the reputer request with losses for work
 	// found at this worker nonce
 	ReputerNonce *Nonce `protobuf:"bytes,1,opt,name=reputer_nonce,json=reputerNonce,proto3" json:"reputer_nonce,omitempty"`
+	// index of the topic horizon whose ground truth the losses are computed against
+	Horizon uint32 `protobuf:"varint,2,opt,name=horizon,proto3" json:"horizon,omitempty"`
 }
 
 func (x *ReputerRequestNonce) Reset() {
@@ -1960,6 +2010,13 @@ func (x *ReputerRequestNonce) GetReputerNonce() *Nonce {
 	return nil
 }
 
+func (x *ReputerRequestNonce) GetHorizon() uint32 {
+	if x != nil {
+		return x.Horizon
+	}
+	return 0
+}
+
 type ReputerRequestNonces struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2008,30 +2065,32 @@ var file_emissions_v1_nonce_proto_rawDesc = []byte{
 	0x22, 0x35, 0x0a, 0x06, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x06, 0x6e, 0x6f,
 	0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x6d, 0x69,
 	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52,
-	0x06, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x55, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x75, 0x74,
+	0x06, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x6f, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x75, 0x74,
 	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x38,
 	0x0a, 0x0d, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
 	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x75,
-	0x74, 0x65, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x51,
-	0x0a, 0x14, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x06, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
-	0x73, 0x42, 0xc0, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f,
-	0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f, 0x65,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x58, 0x58, 0xaa, 0x02, 0x0c, 0x45, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c, 0x45, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x18, 0x45, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x65, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x6f, 0x6e, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x51, 0x0a, 0x14, 0x52, 0x65, 0x70, 0x75,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x73,
+	0x12, 0x39, 0x0a, 0x06, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4e, 0x6f,
+	0x6e, 0x63, 0x65, 0x52, 0x06, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x42, 0xc0, 0x01, 0x0a, 0x10,
+	0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31,
+	0x42, 0x0a, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4f,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72,
+	0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61,
+	0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x76, 0x31, 0xa2,
+	0x02, 0x03, 0x45, 0x58, 0x58, 0xaa, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x18, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c,
+	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x0d, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
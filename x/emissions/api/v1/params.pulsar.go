@@ -58,6 +58,16 @@ var (
 	fd_Params_epsilon_reputer                           protoreflect.FieldDescriptor
 	fd_Params_min_effective_topic_revenue               protoreflect.FieldDescriptor
 	fd_Params_half_max_process_stake_removals_end_block protoreflect.FieldDescriptor
+	fd_Params_permissioned_topic_creation               protoreflect.FieldDescriptor
+	fd_Params_multi_registration_fee_discount           protoreflect.FieldDescriptor
+	fd_Params_max_topic_output_dimension                protoreflect.FieldDescriptor
+	fd_Params_max_topic_horizons                        protoreflect.FieldDescriptor
+	fd_Params_actor_history_retention                   protoreflect.FieldDescriptor
+	fd_Params_blocks_per_month_calibration_enabled      protoreflect.FieldDescriptor
+	fd_Params_min_blocks_per_month                      protoreflect.FieldDescriptor
+	fd_Params_max_blocks_per_month                      protoreflect.FieldDescriptor
+	fd_Params_max_blocks_per_month_change               protoreflect.FieldDescriptor
+	fd_Params_block_time_smoothing_degree               protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -104,6 +114,16 @@ func init() {
 	fd_Params_epsilon_reputer = md_Params.Fields().ByName("epsilon_reputer")
 	fd_Params_min_effective_topic_revenue = md_Params.Fields().ByName("min_effective_topic_revenue")
 	fd_Params_half_max_process_stake_removals_end_block = md_Params.Fields().ByName("half_max_process_stake_removals_end_block")
+	fd_Params_permissioned_topic_creation = md_Params.Fields().ByName("permissioned_topic_creation")
+	fd_Params_multi_registration_fee_discount = md_Params.Fields().ByName("multi_registration_fee_discount")
+	fd_Params_max_topic_output_dimension = md_Params.Fields().ByName("max_topic_output_dimension")
+	fd_Params_max_topic_horizons = md_Params.Fields().ByName("max_topic_horizons")
+	fd_Params_actor_history_retention = md_Params.Fields().ByName("actor_history_retention")
+	fd_Params_blocks_per_month_calibration_enabled = md_Params.Fields().ByName("blocks_per_month_calibration_enabled")
+	fd_Params_min_blocks_per_month = md_Params.Fields().ByName("min_blocks_per_month")
+	fd_Params_max_blocks_per_month = md_Params.Fields().ByName("max_blocks_per_month")
+	fd_Params_max_blocks_per_month_change = md_Params.Fields().ByName("max_blocks_per_month_change")
+	fd_Params_block_time_smoothing_degree = md_Params.Fields().ByName("block_time_smoothing_degree")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -417,6 +437,66 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.PermissionedTopicCreation != false {
+		value := protoreflect.ValueOfBool(x.PermissionedTopicCreation)
+		if !f(fd_Params_permissioned_topic_creation, value) {
+			return
+		}
+	}
+	if x.MultiRegistrationFeeDiscount != "" {
+		value := protoreflect.ValueOfString(x.MultiRegistrationFeeDiscount)
+		if !f(fd_Params_multi_registration_fee_discount, value) {
+			return
+		}
+	}
+	if x.MaxTopicOutputDimension != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxTopicOutputDimension)
+		if !f(fd_Params_max_topic_output_dimension, value) {
+			return
+		}
+	}
+	if x.MaxTopicHorizons != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxTopicHorizons)
+		if !f(fd_Params_max_topic_horizons, value) {
+			return
+		}
+	}
+	if x.ActorHistoryRetention != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ActorHistoryRetention)
+		if !f(fd_Params_actor_history_retention, value) {
+			return
+		}
+	}
+	if x.BlocksPerMonthCalibrationEnabled != false {
+		value := protoreflect.ValueOfBool(x.BlocksPerMonthCalibrationEnabled)
+		if !f(fd_Params_blocks_per_month_calibration_enabled, value) {
+			return
+		}
+	}
+	if x.MinBlocksPerMonth != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MinBlocksPerMonth)
+		if !f(fd_Params_min_blocks_per_month, value) {
+			return
+		}
+	}
+	if x.MaxBlocksPerMonth != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxBlocksPerMonth)
+		if !f(fd_Params_max_blocks_per_month, value) {
+			return
+		}
+	}
+	if x.MaxBlocksPerMonthChange != "" {
+		value := protoreflect.ValueOfString(x.MaxBlocksPerMonthChange)
+		if !f(fd_Params_max_blocks_per_month_change, value) {
+			return
+		}
+	}
+	if x.BlockTimeSmoothingDegree != "" {
+		value := protoreflect.ValueOfString(x.BlockTimeSmoothingDegree)
+		if !f(fd_Params_block_time_smoothing_degree, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -514,6 +594,26 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.MinEffectiveTopicRevenue != ""
 	case "emissions.v1.Params.half_max_process_stake_removals_end_block":
 		return x.HalfMaxProcessStakeRemovalsEndBlock != uint64(0)
+	case "emissions.v1.Params.permissioned_topic_creation":
+		return x.PermissionedTopicCreation != false
+	case "emissions.v1.Params.multi_registration_fee_discount":
+		return x.MultiRegistrationFeeDiscount != ""
+	case "emissions.v1.Params.max_topic_output_dimension":
+		return x.MaxTopicOutputDimension != uint64(0)
+	case "emissions.v1.Params.max_topic_horizons":
+		return x.MaxTopicHorizons != uint64(0)
+	case "emissions.v1.Params.actor_history_retention":
+		return x.ActorHistoryRetention != int64(0)
+	case "emissions.v1.Params.blocks_per_month_calibration_enabled":
+		return x.BlocksPerMonthCalibrationEnabled != false
+	case "emissions.v1.Params.min_blocks_per_month":
+		return x.MinBlocksPerMonth != uint64(0)
+	case "emissions.v1.Params.max_blocks_per_month":
+		return x.MaxBlocksPerMonth != uint64(0)
+	case "emissions.v1.Params.max_blocks_per_month_change":
+		return x.MaxBlocksPerMonthChange != ""
+	case "emissions.v1.Params.block_time_smoothing_degree":
+		return x.BlockTimeSmoothingDegree != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.Params"))
@@ -612,6 +712,26 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.MinEffectiveTopicRevenue = ""
 	case "emissions.v1.Params.half_max_process_stake_removals_end_block":
 		x.HalfMaxProcessStakeRemovalsEndBlock = uint64(0)
+	case "emissions.v1.Params.permissioned_topic_creation":
+		x.PermissionedTopicCreation = false
+	case "emissions.v1.Params.multi_registration_fee_discount":
+		x.MultiRegistrationFeeDiscount = ""
+	case "emissions.v1.Params.max_topic_output_dimension":
+		x.MaxTopicOutputDimension = uint64(0)
+	case "emissions.v1.Params.max_topic_horizons":
+		x.MaxTopicHorizons = uint64(0)
+	case "emissions.v1.Params.actor_history_retention":
+		x.ActorHistoryRetention = int64(0)
+	case "emissions.v1.Params.blocks_per_month_calibration_enabled":
+		x.BlocksPerMonthCalibrationEnabled = false
+	case "emissions.v1.Params.min_blocks_per_month":
+		x.MinBlocksPerMonth = uint64(0)
+	case "emissions.v1.Params.max_blocks_per_month":
+		x.MaxBlocksPerMonth = uint64(0)
+	case "emissions.v1.Params.max_blocks_per_month_change":
+		x.MaxBlocksPerMonthChange = ""
+	case "emissions.v1.Params.block_time_smoothing_degree":
+		x.BlockTimeSmoothingDegree = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.Params"))
@@ -751,6 +871,36 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "emissions.v1.Params.half_max_process_stake_removals_end_block":
 		value := x.HalfMaxProcessStakeRemovalsEndBlock
 		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.Params.permissioned_topic_creation":
+		value := x.PermissionedTopicCreation
+		return protoreflect.ValueOfBool(value)
+	case "emissions.v1.Params.multi_registration_fee_discount":
+		value := x.MultiRegistrationFeeDiscount
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.Params.max_topic_output_dimension":
+		value := x.MaxTopicOutputDimension
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.Params.max_topic_horizons":
+		value := x.MaxTopicHorizons
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.Params.actor_history_retention":
+		value := x.ActorHistoryRetention
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v1.Params.blocks_per_month_calibration_enabled":
+		value := x.BlocksPerMonthCalibrationEnabled
+		return protoreflect.ValueOfBool(value)
+	case "emissions.v1.Params.min_blocks_per_month":
+		value := x.MinBlocksPerMonth
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.Params.max_blocks_per_month":
+		value := x.MaxBlocksPerMonth
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.Params.max_blocks_per_month_change":
+		value := x.MaxBlocksPerMonthChange
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.Params.block_time_smoothing_degree":
+		value := x.BlockTimeSmoothingDegree
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.Params"))
@@ -853,6 +1003,26 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.MinEffectiveTopicRevenue = value.Interface().(string)
 	case "emissions.v1.Params.half_max_process_stake_removals_end_block":
 		x.HalfMaxProcessStakeRemovalsEndBlock = value.Uint()
+	case "emissions.v1.Params.permissioned_topic_creation":
+		x.PermissionedTopicCreation = value.Bool()
+	case "emissions.v1.Params.multi_registration_fee_discount":
+		x.MultiRegistrationFeeDiscount = value.Interface().(string)
+	case "emissions.v1.Params.max_topic_output_dimension":
+		x.MaxTopicOutputDimension = value.Uint()
+	case "emissions.v1.Params.max_topic_horizons":
+		x.MaxTopicHorizons = value.Uint()
+	case "emissions.v1.Params.actor_history_retention":
+		x.ActorHistoryRetention = value.Int()
+	case "emissions.v1.Params.blocks_per_month_calibration_enabled":
+		x.BlocksPerMonthCalibrationEnabled = value.Bool()
+	case "emissions.v1.Params.min_blocks_per_month":
+		x.MinBlocksPerMonth = value.Uint()
+	case "emissions.v1.Params.max_blocks_per_month":
+		x.MaxBlocksPerMonth = value.Uint()
+	case "emissions.v1.Params.max_blocks_per_month_change":
+		x.MaxBlocksPerMonthChange = value.Interface().(string)
+	case "emissions.v1.Params.block_time_smoothing_degree":
+		x.BlockTimeSmoothingDegree = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.Params"))
@@ -955,6 +1125,26 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field min_effective_topic_revenue of message emissions.v1.Params is not mutable"))
 	case "emissions.v1.Params.half_max_process_stake_removals_end_block":
 		panic(fmt.Errorf("field half_max_process_stake_removals_end_block of message emissions.v1.Params is not mutable"))
+	case "emissions.v1.Params.permissioned_topic_creation":
+		panic(fmt.Errorf("field permissioned_topic_creation of message emissions.v1.Params is not mutable"))
+	case "emissions.v1.Params.multi_registration_fee_discount":
+		panic(fmt.Errorf("field multi_registration_fee_discount of message emissions.v1.Params is not mutable"))
+	case "emissions.v1.Params.max_topic_output_dimension":
+		panic(fmt.Errorf("field max_topic_output_dimension of message emissions.v1.Params is not mutable"))
+	case "emissions.v1.Params.max_topic_horizons":
+		panic(fmt.Errorf("field max_topic_horizons of message emissions.v1.Params is not mutable"))
+	case "emissions.v1.Params.actor_history_retention":
+		panic(fmt.Errorf("field actor_history_retention of message emissions.v1.Params is not mutable"))
+	case "emissions.v1.Params.blocks_per_month_calibration_enabled":
+		panic(fmt.Errorf("field blocks_per_month_calibration_enabled of message emissions.v1.Params is not mutable"))
+	case "emissions.v1.Params.min_blocks_per_month":
+		panic(fmt.Errorf("field min_blocks_per_month of message emissions.v1.Params is not mutable"))
+	case "emissions.v1.Params.max_blocks_per_month":
+		panic(fmt.Errorf("field max_blocks_per_month of message emissions.v1.Params is not mutable"))
+	case "emissions.v1.Params.max_blocks_per_month_change":
+		panic(fmt.Errorf("field max_blocks_per_month_change of message emissions.v1.Params is not mutable"))
+	case "emissions.v1.Params.block_time_smoothing_degree":
+		panic(fmt.Errorf("field block_time_smoothing_degree of message emissions.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.Params"))
@@ -1050,6 +1240,26 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfString("")
 	case "emissions.v1.Params.half_max_process_stake_removals_end_block":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.Params.permissioned_topic_creation":
+		return protoreflect.ValueOfBool(false)
+	case "emissions.v1.Params.multi_registration_fee_discount":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.Params.max_topic_output_dimension":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.Params.max_topic_horizons":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.Params.actor_history_retention":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v1.Params.blocks_per_month_calibration_enabled":
+		return protoreflect.ValueOfBool(false)
+	case "emissions.v1.Params.min_blocks_per_month":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.Params.max_blocks_per_month":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.Params.max_blocks_per_month_change":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.Params.block_time_smoothing_degree":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.Params"))
@@ -1265,6 +1475,39 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.HalfMaxProcessStakeRemovalsEndBlock != 0 {
 			n += 2 + runtime.Sov(uint64(x.HalfMaxProcessStakeRemovalsEndBlock))
 		}
+		if x.PermissionedTopicCreation {
+			n += 3
+		}
+		l = len(x.MultiRegistrationFeeDiscount)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxTopicOutputDimension != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxTopicOutputDimension))
+		}
+		if x.MaxTopicHorizons != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxTopicHorizons))
+		}
+		if x.ActorHistoryRetention != 0 {
+			n += 2 + runtime.Sov(uint64(x.ActorHistoryRetention))
+		}
+		if x.BlocksPerMonthCalibrationEnabled {
+			n += 3
+		}
+		if x.MinBlocksPerMonth != 0 {
+			n += 2 + runtime.Sov(uint64(x.MinBlocksPerMonth))
+		}
+		if x.MaxBlocksPerMonth != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxBlocksPerMonth))
+		}
+		l = len(x.MaxBlocksPerMonthChange)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BlockTimeSmoothingDegree)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1294,6 +1537,92 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.BlockTimeSmoothingDegree) > 0 {
+			i -= len(x.BlockTimeSmoothingDegree)
+			copy(dAtA[i:], x.BlockTimeSmoothingDegree)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlockTimeSmoothingDegree)))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xa2
+		}
+		if len(x.MaxBlocksPerMonthChange) > 0 {
+			i -= len(x.MaxBlocksPerMonthChange)
+			copy(dAtA[i:], x.MaxBlocksPerMonthChange)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxBlocksPerMonthChange)))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x9a
+		}
+		if x.MaxBlocksPerMonth != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxBlocksPerMonth))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x90
+		}
+		if x.MinBlocksPerMonth != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinBlocksPerMonth))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x88
+		}
+		if x.BlocksPerMonthCalibrationEnabled {
+			i--
+			if x.BlocksPerMonthCalibrationEnabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x80
+		}
+		if x.ActorHistoryRetention != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ActorHistoryRetention))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xf8
+		}
+		if x.MaxTopicHorizons != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxTopicHorizons))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xf0
+		}
+		if x.MaxTopicOutputDimension != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxTopicOutputDimension))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xe8
+		}
+		if len(x.MultiRegistrationFeeDiscount) > 0 {
+			i -= len(x.MultiRegistrationFeeDiscount)
+			copy(dAtA[i:], x.MultiRegistrationFeeDiscount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MultiRegistrationFeeDiscount)))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xe2
+		}
+		if x.PermissionedTopicCreation {
+			i--
+			if x.PermissionedTopicCreation {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xd8
+		}
 		if x.HalfMaxProcessStakeRemovalsEndBlock != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.HalfMaxProcessStakeRemovalsEndBlock))
 			i--
@@ -2726,6 +3055,237 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 43:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PermissionedTopicCreation", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.PermissionedTopicCreation = bool(v != 0)
+			case 44:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MultiRegistrationFeeDiscount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MultiRegistrationFeeDiscount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 45:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTopicOutputDimension", wireType)
+				}
+				x.MaxTopicOutputDimension = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxTopicOutputDimension |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 46:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTopicHorizons", wireType)
+				}
+				x.MaxTopicHorizons = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxTopicHorizons |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 47:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActorHistoryRetention", wireType)
+				}
+				x.ActorHistoryRetention = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ActorHistoryRetention |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 48:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlocksPerMonthCalibrationEnabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.BlocksPerMonthCalibrationEnabled = bool(v != 0)
+			case 49:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinBlocksPerMonth", wireType)
+				}
+				x.MinBlocksPerMonth = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinBlocksPerMonth |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 50:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxBlocksPerMonth", wireType)
+				}
+				x.MaxBlocksPerMonth = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxBlocksPerMonth |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 51:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxBlocksPerMonthChange", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxBlocksPerMonthChange = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 52:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockTimeSmoothingDegree", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BlockTimeSmoothingDegree = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2834,7 +3394,22 @@ type Params struct {
 	// close proximities
 	MinEffectiveTopicRevenue string `protobuf:"bytes,41,opt,name=min_effective_topic_revenue,json=minEffectiveTopicRevenue,proto3" json:"min_effective_topic_revenue,omitempty"` // we no stop dripping from the topic's effective revenue when the topic's
 	// effective revenue is below this
-	HalfMaxProcessStakeRemovalsEndBlock uint64 `protobuf:"varint,42,opt,name=half_max_process_stake_removals_end_block,json=halfMaxProcessStakeRemovalsEndBlock,proto3" json:"half_max_process_stake_removals_end_block,omitempty"` // max amount of stake removals to process in an ABCI end block. Applied twice once for stakeRemovals and once for DelegateStakeRemovals, so actual max is this number times two
+	HalfMaxProcessStakeRemovalsEndBlock uint64 `protobuf:"varint,42,opt,name=half_max_process_stake_removals_end_block,json=halfMaxProcessStakeRemovalsEndBlock,proto3" json:"half_max_process_stake_removals_end_block,omitempty"` // max amount of stake removals to process in an ABCI end block. Applied twice once for stakeRemovals and
+	// once for DelegateStakeRemovals, so actual max is this number times two
+	PermissionedTopicCreation    bool   `protobuf:"varint,43,opt,name=permissioned_topic_creation,json=permissionedTopicCreation,proto3" json:"permissioned_topic_creation,omitempty"`           // if true, only addresses with the TOPIC_CREATOR role may create topics
+	MultiRegistrationFeeDiscount string `protobuf:"bytes,44,opt,name=multi_registration_fee_discount,json=multiRegistrationFeeDiscount,proto3" json:"multi_registration_fee_discount,omitempty"` // discount on the registration fee of every topic after the first one when registering in many topics at once.
+	// 0 charges the flat registration fee per topic
+	MaxTopicOutputDimension uint64 `protobuf:"varint,45,opt,name=max_topic_output_dimension,json=maxTopicOutputDimension,proto3" json:"max_topic_output_dimension,omitempty"` // max number of components of the values of a vector-valued topic.
+	// 0 only allows scalar-valued topics to be created, at most 256
+	MaxTopicHorizons      uint64 `protobuf:"varint,46,opt,name=max_topic_horizons,json=maxTopicHorizons,proto3" json:"max_topic_horizons,omitempty"`                // max number of horizons predicted in a topic, at most 32
+	ActorHistoryRetention int64  `protobuf:"varint,47,opt,name=actor_history_retention,json=actorHistoryRetention,proto3" json:"actor_history_retention,omitempty"` // number of blocks the score and reward history of an actor is kept for.
+	// 0 keeps no history
+	BlocksPerMonthCalibrationEnabled bool `protobuf:"varint,48,opt,name=blocks_per_month_calibration_enabled,json=blocksPerMonthCalibrationEnabled,proto3" json:"blocks_per_month_calibration_enabled,omitempty"` // if true, the effective blocks per month is derived from the
+	// observed average block time instead of blocks_per_month
+	MinBlocksPerMonth        uint64 `protobuf:"varint,49,opt,name=min_blocks_per_month,json=minBlocksPerMonth,proto3" json:"min_blocks_per_month,omitempty"`                     // lower bound of the calibrated blocks per month
+	MaxBlocksPerMonth        uint64 `protobuf:"varint,50,opt,name=max_blocks_per_month,json=maxBlocksPerMonth,proto3" json:"max_blocks_per_month,omitempty"`                     // upper bound of the calibrated blocks per month
+	MaxBlocksPerMonthChange  string `protobuf:"bytes,51,opt,name=max_blocks_per_month_change,json=maxBlocksPerMonthChange,proto3" json:"max_blocks_per_month_change,omitempty"`  // max relative change of the calibrated blocks per month in a single recalibration
+	BlockTimeSmoothingDegree string `protobuf:"bytes,52,opt,name=block_time_smoothing_degree,json=blockTimeSmoothingDegree,proto3" json:"block_time_smoothing_degree,omitempty"` // weight of the latest block time in the moving average of the block time
 }
 
 func (x *Params) Reset() {
@@ -3144,6 +3719,76 @@ func (x *Params) GetHalfMaxProcessStakeRemovalsEndBlock() uint64 {
 	return 0
 }
 
+func (x *Params) GetPermissionedTopicCreation() bool {
+	if x != nil {
+		return x.PermissionedTopicCreation
+	}
+	return false
+}
+
+func (x *Params) GetMultiRegistrationFeeDiscount() string {
+	if x != nil {
+		return x.MultiRegistrationFeeDiscount
+	}
+	return ""
+}
+
+func (x *Params) GetMaxTopicOutputDimension() uint64 {
+	if x != nil {
+		return x.MaxTopicOutputDimension
+	}
+	return 0
+}
+
+func (x *Params) GetMaxTopicHorizons() uint64 {
+	if x != nil {
+		return x.MaxTopicHorizons
+	}
+	return 0
+}
+
+func (x *Params) GetActorHistoryRetention() int64 {
+	if x != nil {
+		return x.ActorHistoryRetention
+	}
+	return 0
+}
+
+func (x *Params) GetBlocksPerMonthCalibrationEnabled() bool {
+	if x != nil {
+		return x.BlocksPerMonthCalibrationEnabled
+	}
+	return false
+}
+
+func (x *Params) GetMinBlocksPerMonth() uint64 {
+	if x != nil {
+		return x.MinBlocksPerMonth
+	}
+	return 0
+}
+
+func (x *Params) GetMaxBlocksPerMonth() uint64 {
+	if x != nil {
+		return x.MaxBlocksPerMonth
+	}
+	return 0
+}
+
+func (x *Params) GetMaxBlocksPerMonthChange() string {
+	if x != nil {
+		return x.MaxBlocksPerMonthChange
+	}
+	return ""
+}
+
+func (x *Params) GetBlockTimeSmoothingDegree() string {
+	if x != nil {
+		return x.BlockTimeSmoothingDegree
+	}
+	return ""
+}
+
 var File_emissions_v1_params_proto protoreflect.FileDescriptor
 
 var file_emissions_v1_params_proto_rawDesc = []byte{
@@ -3153,7 +3798,7 @@ var file_emissions_v1_params_proto_rawDesc = []byte{
 	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e,
 	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf6, 0x1a,
+	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfa, 0x20,
 	0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
 	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
 	0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x19, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c,
@@ -3369,20 +4014,68 @@ var file_emissions_v1_params_proto_rawDesc = []byte{
 	0x73, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x2a, 0x20, 0x01, 0x28,
 	0x04, 0x52, 0x23, 0x68, 0x61, 0x6c, 0x66, 0x4d, 0x61, 0x78, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
 	0x73, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x45, 0x6e,
-	0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0xc1, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x65,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x2f, 0x78, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x31, 0x3b,
-	0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x58,
-	0x58, 0xaa, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x56, 0x31,
-	0xca, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x31, 0xe2,
-	0x02, 0x18, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x45, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x3e, 0x0a, 0x1b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x19, 0x70, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x7e, 0x0a, 0x1f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65,
+	0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x1c, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x44, 0x69,
+	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x1a, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f,
+	0x70, 0x69, 0x63, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x64, 0x69, 0x6d, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x6d, 0x61, 0x78, 0x54,
+	0x6f, 0x70, 0x69, 0x63, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63,
+	0x5f, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x73, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x10, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x48, 0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e,
+	0x73, 0x12, 0x36, 0x0a, 0x17, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x2f, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x15, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4e, 0x0a, 0x24, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x5f, 0x63, 0x61,
+	0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x18, 0x30, 0x20, 0x01, 0x28, 0x08, 0x52, 0x20, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x50,
+	0x65, 0x72, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x69, 0x6e,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6d, 0x6f, 0x6e, 0x74,
+	0x68, 0x18, 0x31, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x69, 0x6e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x50, 0x65, 0x72, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61,
+	0x78, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6d, 0x6f, 0x6e,
+	0x74, 0x68, 0x18, 0x32, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x50, 0x65, 0x72, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x75, 0x0a, 0x1b, 0x6d,
+	0x61, 0x78, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6d, 0x6f,
+	0x6e, 0x74, 0x68, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x33, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x17, 0x6d, 0x61, 0x78, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x50, 0x65, 0x72, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x76, 0x0a, 0x1b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x73, 0x6d, 0x6f, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x67, 0x72, 0x65,
+	0x65, 0x18, 0x34, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f,
+	0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72,
+	0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63,
+	0x52, 0x18, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6d, 0x6f, 0x6f, 0x74,
+	0x68, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0x42, 0xc1, 0x01, 0x0a, 0x10, 0x63,
+	0x6f, 0x6d, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x42,
+	0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4f,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72,
+	0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61,
+	0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x76, 0x31, 0xa2,
+	0x02, 0x03, 0x45, 0x58, 0x58, 0xaa, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x18, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c,
+	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x0d, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
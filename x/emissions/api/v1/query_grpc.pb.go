@@ -47,6 +47,12 @@ const (
 	Query_IsWorkerRegisteredInTopicId_FullMethodName                 = "/emissions.v1.Query/IsWorkerRegisteredInTopicId"
 	Query_IsReputerRegisteredInTopicId_FullMethodName                = "/emissions.v1.Query/IsReputerRegisteredInTopicId"
 	Query_GetNetworkInferencesAtBlock_FullMethodName                 = "/emissions.v1.Query/GetNetworkInferencesAtBlock"
+	Query_GetNetworkInferenceWeightsAtBlock_FullMethodName           = "/emissions.v1.Query/GetNetworkInferenceWeightsAtBlock"
+	Query_GetRewardBreakdown_FullMethodName                          = "/emissions.v1.Query/GetRewardBreakdown"
+	Query_GetActorScoreHistory_FullMethodName                        = "/emissions.v1.Query/GetActorScoreHistory"
+	Query_GetActorRewardHistory_FullMethodName                       = "/emissions.v1.Query/GetActorRewardHistory"
+	Query_SimulateTopicRewards_FullMethodName                        = "/emissions.v1.Query/SimulateTopicRewards"
+	Query_GetWhatIfNetworkInference_FullMethodName                   = "/emissions.v1.Query/GetWhatIfNetworkInference"
 	Query_GetLatestNetworkInference_FullMethodName                   = "/emissions.v1.Query/GetLatestNetworkInference"
 	Query_GetLatestAvailableNetworkInference_FullMethodName          = "/emissions.v1.Query/GetLatestAvailableNetworkInference"
 	Query_IsWorkerNonceUnfulfilled_FullMethodName                    = "/emissions.v1.Query/IsWorkerNonceUnfulfilled"
@@ -58,6 +64,7 @@ const (
 	Query_GetOneInForecasterNetworkRegret_FullMethodName             = "/emissions.v1.Query/GetOneInForecasterNetworkRegret"
 	Query_GetOneInForecasterSelfNetworkRegret_FullMethodName         = "/emissions.v1.Query/GetOneInForecasterSelfNetworkRegret"
 	Query_IsWhitelistAdmin_FullMethodName                            = "/emissions.v1.Query/IsWhitelistAdmin"
+	Query_GetActorRoles_FullMethodName                               = "/emissions.v1.Query/GetActorRoles"
 	Query_GetTopicLastWorkerCommitInfo_FullMethodName                = "/emissions.v1.Query/GetTopicLastWorkerCommitInfo"
 	Query_GetTopicLastReputerCommitInfo_FullMethodName               = "/emissions.v1.Query/GetTopicLastReputerCommitInfo"
 	Query_GetTopicRewardNonce_FullMethodName                         = "/emissions.v1.Query/GetTopicRewardNonce"
@@ -87,9 +94,14 @@ const (
 	Query_GetPreviousInferenceRewardFraction_FullMethodName          = "/emissions.v1.Query/GetPreviousInferenceRewardFraction"
 	Query_GetPreviousForecastRewardFraction_FullMethodName           = "/emissions.v1.Query/GetPreviousForecastRewardFraction"
 	Query_GetPreviousPercentageRewardToStakedReputers_FullMethodName = "/emissions.v1.Query/GetPreviousPercentageRewardToStakedReputers"
+	Query_GetBlocksPerMonth_FullMethodName                           = "/emissions.v1.Query/GetBlocksPerMonth"
 	Query_GetTotalRewardToDistribute_FullMethodName                  = "/emissions.v1.Query/GetTotalRewardToDistribute"
 	Query_GetTopicLastWorkerPayload_FullMethodName                   = "/emissions.v1.Query/GetTopicLastWorkerPayload"
 	Query_GetTopicLastReputerPayload_FullMethodName                  = "/emissions.v1.Query/GetTopicLastReputerPayload"
+	Query_GetTopicWorkerAllowlist_FullMethodName                     = "/emissions.v1.Query/GetTopicWorkerAllowlist"
+	Query_GetTopicReputerAllowlist_FullMethodName                    = "/emissions.v1.Query/GetTopicReputerAllowlist"
+	Query_GetActorsAtRiskOfRemoval_FullMethodName                    = "/emissions.v1.Query/GetActorsAtRiskOfRemoval"
+	Query_GetModuleAccounting_FullMethodName                         = "/emissions.v1.Query/GetModuleAccounting"
 )
 
 // QueryClient is the client API for Query service.
@@ -125,6 +137,12 @@ type QueryClient interface {
 	IsWorkerRegisteredInTopicId(ctx context.Context, in *QueryIsWorkerRegisteredInTopicIdRequest, opts ...grpc.CallOption) (*QueryIsWorkerRegisteredInTopicIdResponse, error)
 	IsReputerRegisteredInTopicId(ctx context.Context, in *QueryIsReputerRegisteredInTopicIdRequest, opts ...grpc.CallOption) (*QueryIsReputerRegisteredInTopicIdResponse, error)
 	GetNetworkInferencesAtBlock(ctx context.Context, in *QueryNetworkInferencesAtBlockRequest, opts ...grpc.CallOption) (*QueryNetworkInferencesAtBlockResponse, error)
+	GetNetworkInferenceWeightsAtBlock(ctx context.Context, in *QueryNetworkInferenceWeightsAtBlockRequest, opts ...grpc.CallOption) (*QueryNetworkInferenceWeightsAtBlockResponse, error)
+	GetRewardBreakdown(ctx context.Context, in *QueryRewardBreakdownRequest, opts ...grpc.CallOption) (*QueryRewardBreakdownResponse, error)
+	GetActorScoreHistory(ctx context.Context, in *QueryActorScoreHistoryRequest, opts ...grpc.CallOption) (*QueryActorScoreHistoryResponse, error)
+	GetActorRewardHistory(ctx context.Context, in *QueryActorRewardHistoryRequest, opts ...grpc.CallOption) (*QueryActorRewardHistoryResponse, error)
+	SimulateTopicRewards(ctx context.Context, in *QuerySimulateTopicRewardsRequest, opts ...grpc.CallOption) (*QuerySimulateTopicRewardsResponse, error)
+	GetWhatIfNetworkInference(ctx context.Context, in *QueryWhatIfNetworkInferenceRequest, opts ...grpc.CallOption) (*QueryWhatIfNetworkInferenceResponse, error)
 	GetLatestNetworkInference(ctx context.Context, in *QueryLatestNetworkInferencesRequest, opts ...grpc.CallOption) (*QueryLatestNetworkInferencesResponse, error)
 	GetLatestAvailableNetworkInference(ctx context.Context, in *QueryLatestNetworkInferencesRequest, opts ...grpc.CallOption) (*QueryLatestNetworkInferencesResponse, error)
 	IsWorkerNonceUnfulfilled(ctx context.Context, in *QueryIsWorkerNonceUnfulfilledRequest, opts ...grpc.CallOption) (*QueryIsWorkerNonceUnfulfilledResponse, error)
@@ -136,6 +154,7 @@ type QueryClient interface {
 	GetOneInForecasterNetworkRegret(ctx context.Context, in *QueryOneInForecasterNetworkRegretRequest, opts ...grpc.CallOption) (*QueryOneInForecasterNetworkRegretResponse, error)
 	GetOneInForecasterSelfNetworkRegret(ctx context.Context, in *QueryOneInForecasterSelfNetworkRegretRequest, opts ...grpc.CallOption) (*QueryOneInForecasterSelfNetworkRegretResponse, error)
 	IsWhitelistAdmin(ctx context.Context, in *QueryIsWhitelistAdminRequest, opts ...grpc.CallOption) (*QueryIsWhitelistAdminResponse, error)
+	GetActorRoles(ctx context.Context, in *QueryActorRolesRequest, opts ...grpc.CallOption) (*QueryActorRolesResponse, error)
 	GetTopicLastWorkerCommitInfo(ctx context.Context, in *QueryTopicLastCommitRequest, opts ...grpc.CallOption) (*QueryTopicLastCommitResponse, error)
 	GetTopicLastReputerCommitInfo(ctx context.Context, in *QueryTopicLastCommitRequest, opts ...grpc.CallOption) (*QueryTopicLastCommitResponse, error)
 	GetTopicRewardNonce(ctx context.Context, in *QueryTopicRewardNonceRequest, opts ...grpc.CallOption) (*QueryTopicRewardNonceResponse, error)
@@ -165,9 +184,14 @@ type QueryClient interface {
 	GetPreviousInferenceRewardFraction(ctx context.Context, in *QueryPreviousInferenceRewardFractionRequest, opts ...grpc.CallOption) (*QueryPreviousInferenceRewardFractionResponse, error)
 	GetPreviousForecastRewardFraction(ctx context.Context, in *QueryPreviousForecastRewardFractionRequest, opts ...grpc.CallOption) (*QueryPreviousForecastRewardFractionResponse, error)
 	GetPreviousPercentageRewardToStakedReputers(ctx context.Context, in *QueryPreviousPercentageRewardToStakedReputersRequest, opts ...grpc.CallOption) (*QueryPreviousPercentageRewardToStakedReputersResponse, error)
+	GetBlocksPerMonth(ctx context.Context, in *QueryBlocksPerMonthRequest, opts ...grpc.CallOption) (*QueryBlocksPerMonthResponse, error)
 	GetTotalRewardToDistribute(ctx context.Context, in *QueryTotalRewardToDistributeRequest, opts ...grpc.CallOption) (*QueryTotalRewardToDistributeResponse, error)
 	GetTopicLastWorkerPayload(ctx context.Context, in *QueryTopicLastWorkerPayloadRequest, opts ...grpc.CallOption) (*QueryTopicLastWorkerPayloadResponse, error)
 	GetTopicLastReputerPayload(ctx context.Context, in *QueryTopicLastReputerPayloadRequest, opts ...grpc.CallOption) (*QueryTopicLastReputerPayloadResponse, error)
+	GetTopicWorkerAllowlist(ctx context.Context, in *QueryTopicAllowlistRequest, opts ...grpc.CallOption) (*QueryTopicAllowlistResponse, error)
+	GetTopicReputerAllowlist(ctx context.Context, in *QueryTopicAllowlistRequest, opts ...grpc.CallOption) (*QueryTopicAllowlistResponse, error)
+	GetActorsAtRiskOfRemoval(ctx context.Context, in *QueryActorsAtRiskOfRemovalRequest, opts ...grpc.CallOption) (*QueryActorsAtRiskOfRemovalResponse, error)
+	GetModuleAccounting(ctx context.Context, in *QueryModuleAccountingRequest, opts ...grpc.CallOption) (*QueryModuleAccountingResponse, error)
 }
 
 type queryClient struct {
@@ -430,6 +454,60 @@ func (c *queryClient) GetNetworkInferencesAtBlock(ctx context.Context, in *Query
 	return out, nil
 }
 
+func (c *queryClient) GetNetworkInferenceWeightsAtBlock(ctx context.Context, in *QueryNetworkInferenceWeightsAtBlockRequest, opts ...grpc.CallOption) (*QueryNetworkInferenceWeightsAtBlockResponse, error) {
+	out := new(QueryNetworkInferenceWeightsAtBlockResponse)
+	err := c.cc.Invoke(ctx, Query_GetNetworkInferenceWeightsAtBlock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetRewardBreakdown(ctx context.Context, in *QueryRewardBreakdownRequest, opts ...grpc.CallOption) (*QueryRewardBreakdownResponse, error) {
+	out := new(QueryRewardBreakdownResponse)
+	err := c.cc.Invoke(ctx, Query_GetRewardBreakdown_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetActorScoreHistory(ctx context.Context, in *QueryActorScoreHistoryRequest, opts ...grpc.CallOption) (*QueryActorScoreHistoryResponse, error) {
+	out := new(QueryActorScoreHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_GetActorScoreHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetActorRewardHistory(ctx context.Context, in *QueryActorRewardHistoryRequest, opts ...grpc.CallOption) (*QueryActorRewardHistoryResponse, error) {
+	out := new(QueryActorRewardHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_GetActorRewardHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateTopicRewards(ctx context.Context, in *QuerySimulateTopicRewardsRequest, opts ...grpc.CallOption) (*QuerySimulateTopicRewardsResponse, error) {
+	out := new(QuerySimulateTopicRewardsResponse)
+	err := c.cc.Invoke(ctx, Query_SimulateTopicRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetWhatIfNetworkInference(ctx context.Context, in *QueryWhatIfNetworkInferenceRequest, opts ...grpc.CallOption) (*QueryWhatIfNetworkInferenceResponse, error) {
+	out := new(QueryWhatIfNetworkInferenceResponse)
+	err := c.cc.Invoke(ctx, Query_GetWhatIfNetworkInference_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) GetLatestNetworkInference(ctx context.Context, in *QueryLatestNetworkInferencesRequest, opts ...grpc.CallOption) (*QueryLatestNetworkInferencesResponse, error) {
 	out := new(QueryLatestNetworkInferencesResponse)
 	err := c.cc.Invoke(ctx, Query_GetLatestNetworkInference_FullMethodName, in, out, opts...)
@@ -529,6 +607,15 @@ func (c *queryClient) IsWhitelistAdmin(ctx context.Context, in *QueryIsWhitelist
 	return out, nil
 }
 
+func (c *queryClient) GetActorRoles(ctx context.Context, in *QueryActorRolesRequest, opts ...grpc.CallOption) (*QueryActorRolesResponse, error) {
+	out := new(QueryActorRolesResponse)
+	err := c.cc.Invoke(ctx, Query_GetActorRoles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) GetTopicLastWorkerCommitInfo(ctx context.Context, in *QueryTopicLastCommitRequest, opts ...grpc.CallOption) (*QueryTopicLastCommitResponse, error) {
 	out := new(QueryTopicLastCommitResponse)
 	err := c.cc.Invoke(ctx, Query_GetTopicLastWorkerCommitInfo_FullMethodName, in, out, opts...)
@@ -790,6 +877,15 @@ func (c *queryClient) GetPreviousPercentageRewardToStakedReputers(ctx context.Co
 	return out, nil
 }
 
+func (c *queryClient) GetBlocksPerMonth(ctx context.Context, in *QueryBlocksPerMonthRequest, opts ...grpc.CallOption) (*QueryBlocksPerMonthResponse, error) {
+	out := new(QueryBlocksPerMonthResponse)
+	err := c.cc.Invoke(ctx, Query_GetBlocksPerMonth_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) GetTotalRewardToDistribute(ctx context.Context, in *QueryTotalRewardToDistributeRequest, opts ...grpc.CallOption) (*QueryTotalRewardToDistributeResponse, error) {
 	out := new(QueryTotalRewardToDistributeResponse)
 	err := c.cc.Invoke(ctx, Query_GetTotalRewardToDistribute_FullMethodName, in, out, opts...)
@@ -817,6 +913,42 @@ func (c *queryClient) GetTopicLastReputerPayload(ctx context.Context, in *QueryT
 	return out, nil
 }
 
+func (c *queryClient) GetTopicWorkerAllowlist(ctx context.Context, in *QueryTopicAllowlistRequest, opts ...grpc.CallOption) (*QueryTopicAllowlistResponse, error) {
+	out := new(QueryTopicAllowlistResponse)
+	err := c.cc.Invoke(ctx, Query_GetTopicWorkerAllowlist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetTopicReputerAllowlist(ctx context.Context, in *QueryTopicAllowlistRequest, opts ...grpc.CallOption) (*QueryTopicAllowlistResponse, error) {
+	out := new(QueryTopicAllowlistResponse)
+	err := c.cc.Invoke(ctx, Query_GetTopicReputerAllowlist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetActorsAtRiskOfRemoval(ctx context.Context, in *QueryActorsAtRiskOfRemovalRequest, opts ...grpc.CallOption) (*QueryActorsAtRiskOfRemovalResponse, error) {
+	out := new(QueryActorsAtRiskOfRemovalResponse)
+	err := c.cc.Invoke(ctx, Query_GetActorsAtRiskOfRemoval_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetModuleAccounting(ctx context.Context, in *QueryModuleAccountingRequest, opts ...grpc.CallOption) (*QueryModuleAccountingResponse, error) {
+	out := new(QueryModuleAccountingResponse)
+	err := c.cc.Invoke(ctx, Query_GetModuleAccounting_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -850,6 +982,12 @@ type QueryServer interface {
 	IsWorkerRegisteredInTopicId(context.Context, *QueryIsWorkerRegisteredInTopicIdRequest) (*QueryIsWorkerRegisteredInTopicIdResponse, error)
 	IsReputerRegisteredInTopicId(context.Context, *QueryIsReputerRegisteredInTopicIdRequest) (*QueryIsReputerRegisteredInTopicIdResponse, error)
 	GetNetworkInferencesAtBlock(context.Context, *QueryNetworkInferencesAtBlockRequest) (*QueryNetworkInferencesAtBlockResponse, error)
+	GetNetworkInferenceWeightsAtBlock(context.Context, *QueryNetworkInferenceWeightsAtBlockRequest) (*QueryNetworkInferenceWeightsAtBlockResponse, error)
+	GetRewardBreakdown(context.Context, *QueryRewardBreakdownRequest) (*QueryRewardBreakdownResponse, error)
+	GetActorScoreHistory(context.Context, *QueryActorScoreHistoryRequest) (*QueryActorScoreHistoryResponse, error)
+	GetActorRewardHistory(context.Context, *QueryActorRewardHistoryRequest) (*QueryActorRewardHistoryResponse, error)
+	SimulateTopicRewards(context.Context, *QuerySimulateTopicRewardsRequest) (*QuerySimulateTopicRewardsResponse, error)
+	GetWhatIfNetworkInference(context.Context, *QueryWhatIfNetworkInferenceRequest) (*QueryWhatIfNetworkInferenceResponse, error)
 	GetLatestNetworkInference(context.Context, *QueryLatestNetworkInferencesRequest) (*QueryLatestNetworkInferencesResponse, error)
 	GetLatestAvailableNetworkInference(context.Context, *QueryLatestNetworkInferencesRequest) (*QueryLatestNetworkInferencesResponse, error)
 	IsWorkerNonceUnfulfilled(context.Context, *QueryIsWorkerNonceUnfulfilledRequest) (*QueryIsWorkerNonceUnfulfilledResponse, error)
@@ -861,6 +999,7 @@ type QueryServer interface {
 	GetOneInForecasterNetworkRegret(context.Context, *QueryOneInForecasterNetworkRegretRequest) (*QueryOneInForecasterNetworkRegretResponse, error)
 	GetOneInForecasterSelfNetworkRegret(context.Context, *QueryOneInForecasterSelfNetworkRegretRequest) (*QueryOneInForecasterSelfNetworkRegretResponse, error)
 	IsWhitelistAdmin(context.Context, *QueryIsWhitelistAdminRequest) (*QueryIsWhitelistAdminResponse, error)
+	GetActorRoles(context.Context, *QueryActorRolesRequest) (*QueryActorRolesResponse, error)
 	GetTopicLastWorkerCommitInfo(context.Context, *QueryTopicLastCommitRequest) (*QueryTopicLastCommitResponse, error)
 	GetTopicLastReputerCommitInfo(context.Context, *QueryTopicLastCommitRequest) (*QueryTopicLastCommitResponse, error)
 	GetTopicRewardNonce(context.Context, *QueryTopicRewardNonceRequest) (*QueryTopicRewardNonceResponse, error)
@@ -890,9 +1029,14 @@ type QueryServer interface {
 	GetPreviousInferenceRewardFraction(context.Context, *QueryPreviousInferenceRewardFractionRequest) (*QueryPreviousInferenceRewardFractionResponse, error)
 	GetPreviousForecastRewardFraction(context.Context, *QueryPreviousForecastRewardFractionRequest) (*QueryPreviousForecastRewardFractionResponse, error)
 	GetPreviousPercentageRewardToStakedReputers(context.Context, *QueryPreviousPercentageRewardToStakedReputersRequest) (*QueryPreviousPercentageRewardToStakedReputersResponse, error)
+	GetBlocksPerMonth(context.Context, *QueryBlocksPerMonthRequest) (*QueryBlocksPerMonthResponse, error)
 	GetTotalRewardToDistribute(context.Context, *QueryTotalRewardToDistributeRequest) (*QueryTotalRewardToDistributeResponse, error)
 	GetTopicLastWorkerPayload(context.Context, *QueryTopicLastWorkerPayloadRequest) (*QueryTopicLastWorkerPayloadResponse, error)
 	GetTopicLastReputerPayload(context.Context, *QueryTopicLastReputerPayloadRequest) (*QueryTopicLastReputerPayloadResponse, error)
+	GetTopicWorkerAllowlist(context.Context, *QueryTopicAllowlistRequest) (*QueryTopicAllowlistResponse, error)
+	GetTopicReputerAllowlist(context.Context, *QueryTopicAllowlistRequest) (*QueryTopicAllowlistResponse, error)
+	GetActorsAtRiskOfRemoval(context.Context, *QueryActorsAtRiskOfRemovalRequest) (*QueryActorsAtRiskOfRemovalResponse, error)
+	GetModuleAccounting(context.Context, *QueryModuleAccountingRequest) (*QueryModuleAccountingResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -984,6 +1128,24 @@ func (UnimplementedQueryServer) IsReputerRegisteredInTopicId(context.Context, *Q
 func (UnimplementedQueryServer) GetNetworkInferencesAtBlock(context.Context, *QueryNetworkInferencesAtBlockRequest) (*QueryNetworkInferencesAtBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNetworkInferencesAtBlock not implemented")
 }
+func (UnimplementedQueryServer) GetNetworkInferenceWeightsAtBlock(context.Context, *QueryNetworkInferenceWeightsAtBlockRequest) (*QueryNetworkInferenceWeightsAtBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNetworkInferenceWeightsAtBlock not implemented")
+}
+func (UnimplementedQueryServer) GetRewardBreakdown(context.Context, *QueryRewardBreakdownRequest) (*QueryRewardBreakdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRewardBreakdown not implemented")
+}
+func (UnimplementedQueryServer) GetActorScoreHistory(context.Context, *QueryActorScoreHistoryRequest) (*QueryActorScoreHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActorScoreHistory not implemented")
+}
+func (UnimplementedQueryServer) GetActorRewardHistory(context.Context, *QueryActorRewardHistoryRequest) (*QueryActorRewardHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActorRewardHistory not implemented")
+}
+func (UnimplementedQueryServer) SimulateTopicRewards(context.Context, *QuerySimulateTopicRewardsRequest) (*QuerySimulateTopicRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateTopicRewards not implemented")
+}
+func (UnimplementedQueryServer) GetWhatIfNetworkInference(context.Context, *QueryWhatIfNetworkInferenceRequest) (*QueryWhatIfNetworkInferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWhatIfNetworkInference not implemented")
+}
 func (UnimplementedQueryServer) GetLatestNetworkInference(context.Context, *QueryLatestNetworkInferencesRequest) (*QueryLatestNetworkInferencesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLatestNetworkInference not implemented")
 }
@@ -1017,6 +1179,9 @@ func (UnimplementedQueryServer) GetOneInForecasterSelfNetworkRegret(context.Cont
 func (UnimplementedQueryServer) IsWhitelistAdmin(context.Context, *QueryIsWhitelistAdminRequest) (*QueryIsWhitelistAdminResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsWhitelistAdmin not implemented")
 }
+func (UnimplementedQueryServer) GetActorRoles(context.Context, *QueryActorRolesRequest) (*QueryActorRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActorRoles not implemented")
+}
 func (UnimplementedQueryServer) GetTopicLastWorkerCommitInfo(context.Context, *QueryTopicLastCommitRequest) (*QueryTopicLastCommitResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTopicLastWorkerCommitInfo not implemented")
 }
@@ -1104,6 +1269,9 @@ func (UnimplementedQueryServer) GetPreviousForecastRewardFraction(context.Contex
 func (UnimplementedQueryServer) GetPreviousPercentageRewardToStakedReputers(context.Context, *QueryPreviousPercentageRewardToStakedReputersRequest) (*QueryPreviousPercentageRewardToStakedReputersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPreviousPercentageRewardToStakedReputers not implemented")
 }
+func (UnimplementedQueryServer) GetBlocksPerMonth(context.Context, *QueryBlocksPerMonthRequest) (*QueryBlocksPerMonthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlocksPerMonth not implemented")
+}
 func (UnimplementedQueryServer) GetTotalRewardToDistribute(context.Context, *QueryTotalRewardToDistributeRequest) (*QueryTotalRewardToDistributeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTotalRewardToDistribute not implemented")
 }
@@ -1113,6 +1281,18 @@ func (UnimplementedQueryServer) GetTopicLastWorkerPayload(context.Context, *Quer
 func (UnimplementedQueryServer) GetTopicLastReputerPayload(context.Context, *QueryTopicLastReputerPayloadRequest) (*QueryTopicLastReputerPayloadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTopicLastReputerPayload not implemented")
 }
+func (UnimplementedQueryServer) GetTopicWorkerAllowlist(context.Context, *QueryTopicAllowlistRequest) (*QueryTopicAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicWorkerAllowlist not implemented")
+}
+func (UnimplementedQueryServer) GetTopicReputerAllowlist(context.Context, *QueryTopicAllowlistRequest) (*QueryTopicAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicReputerAllowlist not implemented")
+}
+func (UnimplementedQueryServer) GetActorsAtRiskOfRemoval(context.Context, *QueryActorsAtRiskOfRemovalRequest) (*QueryActorsAtRiskOfRemovalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActorsAtRiskOfRemoval not implemented")
+}
+func (UnimplementedQueryServer) GetModuleAccounting(context.Context, *QueryModuleAccountingRequest) (*QueryModuleAccountingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModuleAccounting not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -1630,6 +1810,114 @@ func _Query_GetNetworkInferencesAtBlock_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetNetworkInferenceWeightsAtBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNetworkInferenceWeightsAtBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetNetworkInferenceWeightsAtBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetNetworkInferenceWeightsAtBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetNetworkInferenceWeightsAtBlock(ctx, req.(*QueryNetworkInferenceWeightsAtBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetRewardBreakdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardBreakdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRewardBreakdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetRewardBreakdown_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRewardBreakdown(ctx, req.(*QueryRewardBreakdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetActorScoreHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActorScoreHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetActorScoreHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetActorScoreHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetActorScoreHistory(ctx, req.(*QueryActorScoreHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetActorRewardHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActorRewardHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetActorRewardHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetActorRewardHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetActorRewardHistory(ctx, req.(*QueryActorRewardHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateTopicRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateTopicRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateTopicRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SimulateTopicRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateTopicRewards(ctx, req.(*QuerySimulateTopicRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetWhatIfNetworkInference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWhatIfNetworkInferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetWhatIfNetworkInference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetWhatIfNetworkInference_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetWhatIfNetworkInference(ctx, req.(*QueryWhatIfNetworkInferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_GetLatestNetworkInference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryLatestNetworkInferencesRequest)
 	if err := dec(in); err != nil {
@@ -1828,6 +2116,24 @@ func _Query_IsWhitelistAdmin_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetActorRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActorRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetActorRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetActorRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetActorRoles(ctx, req.(*QueryActorRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_GetTopicLastWorkerCommitInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTopicLastCommitRequest)
 	if err := dec(in); err != nil {
@@ -2350,6 +2656,24 @@ func _Query_GetPreviousPercentageRewardToStakedReputers_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetBlocksPerMonth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlocksPerMonthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetBlocksPerMonth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetBlocksPerMonth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetBlocksPerMonth(ctx, req.(*QueryBlocksPerMonthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_GetTotalRewardToDistribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTotalRewardToDistributeRequest)
 	if err := dec(in); err != nil {
@@ -2404,6 +2728,78 @@ func _Query_GetTopicLastReputerPayload_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetTopicWorkerAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopicAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTopicWorkerAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetTopicWorkerAllowlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTopicWorkerAllowlist(ctx, req.(*QueryTopicAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetTopicReputerAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopicAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTopicReputerAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetTopicReputerAllowlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTopicReputerAllowlist(ctx, req.(*QueryTopicAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetActorsAtRiskOfRemoval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActorsAtRiskOfRemovalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetActorsAtRiskOfRemoval(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetActorsAtRiskOfRemoval_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetActorsAtRiskOfRemoval(ctx, req.(*QueryActorsAtRiskOfRemovalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetModuleAccounting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleAccountingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetModuleAccounting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetModuleAccounting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetModuleAccounting(ctx, req.(*QueryModuleAccountingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2523,6 +2919,30 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetNetworkInferencesAtBlock",
 			Handler:    _Query_GetNetworkInferencesAtBlock_Handler,
 		},
+		{
+			MethodName: "GetNetworkInferenceWeightsAtBlock",
+			Handler:    _Query_GetNetworkInferenceWeightsAtBlock_Handler,
+		},
+		{
+			MethodName: "GetRewardBreakdown",
+			Handler:    _Query_GetRewardBreakdown_Handler,
+		},
+		{
+			MethodName: "GetActorScoreHistory",
+			Handler:    _Query_GetActorScoreHistory_Handler,
+		},
+		{
+			MethodName: "GetActorRewardHistory",
+			Handler:    _Query_GetActorRewardHistory_Handler,
+		},
+		{
+			MethodName: "SimulateTopicRewards",
+			Handler:    _Query_SimulateTopicRewards_Handler,
+		},
+		{
+			MethodName: "GetWhatIfNetworkInference",
+			Handler:    _Query_GetWhatIfNetworkInference_Handler,
+		},
 		{
 			MethodName: "GetLatestNetworkInference",
 			Handler:    _Query_GetLatestNetworkInference_Handler,
@@ -2567,6 +2987,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IsWhitelistAdmin",
 			Handler:    _Query_IsWhitelistAdmin_Handler,
 		},
+		{
+			MethodName: "GetActorRoles",
+			Handler:    _Query_GetActorRoles_Handler,
+		},
 		{
 			MethodName: "GetTopicLastWorkerCommitInfo",
 			Handler:    _Query_GetTopicLastWorkerCommitInfo_Handler,
@@ -2683,6 +3107,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPreviousPercentageRewardToStakedReputers",
 			Handler:    _Query_GetPreviousPercentageRewardToStakedReputers_Handler,
 		},
+		{
+			MethodName: "GetBlocksPerMonth",
+			Handler:    _Query_GetBlocksPerMonth_Handler,
+		},
 		{
 			MethodName: "GetTotalRewardToDistribute",
 			Handler:    _Query_GetTotalRewardToDistribute_Handler,
@@ -2695,6 +3123,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTopicLastReputerPayload",
 			Handler:    _Query_GetTopicLastReputerPayload_Handler,
 		},
+		{
+			MethodName: "GetTopicWorkerAllowlist",
+			Handler:    _Query_GetTopicWorkerAllowlist_Handler,
+		},
+		{
+			MethodName: "GetTopicReputerAllowlist",
+			Handler:    _Query_GetTopicReputerAllowlist_Handler,
+		},
+		{
+			MethodName: "GetActorsAtRiskOfRemoval",
+			Handler:    _Query_GetActorsAtRiskOfRemoval_Handler,
+		},
+		{
+			MethodName: "GetModuleAccounting",
+			Handler:    _Query_GetModuleAccounting_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "emissions/v1/query.proto",
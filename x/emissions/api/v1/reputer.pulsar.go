@@ -13,10 +13,57 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_WorkerAttributedValue_3_list)(nil)
+
+type _WorkerAttributedValue_3_list struct {
+	list *[]string
+}
+
+func (x *_WorkerAttributedValue_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_WorkerAttributedValue_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_WorkerAttributedValue_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_WorkerAttributedValue_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_WorkerAttributedValue_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message WorkerAttributedValue at list field Values as it is not of Message kind"))
+}
+
+func (x *_WorkerAttributedValue_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_WorkerAttributedValue_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_WorkerAttributedValue_3_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_WorkerAttributedValue        protoreflect.MessageDescriptor
 	fd_WorkerAttributedValue_worker protoreflect.FieldDescriptor
 	fd_WorkerAttributedValue_value  protoreflect.FieldDescriptor
+	fd_WorkerAttributedValue_values protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -24,6 +71,7 @@ func init() {
 	md_WorkerAttributedValue = File_emissions_v1_reputer_proto.Messages().ByName("WorkerAttributedValue")
 	fd_WorkerAttributedValue_worker = md_WorkerAttributedValue.Fields().ByName("worker")
 	fd_WorkerAttributedValue_value = md_WorkerAttributedValue.Fields().ByName("value")
+	fd_WorkerAttributedValue_values = md_WorkerAttributedValue.Fields().ByName("values")
 }
 
 var _ protoreflect.Message = (*fastReflection_WorkerAttributedValue)(nil)
@@ -103,6 +151,12 @@ func (x *fastReflection_WorkerAttributedValue) Range(f func(protoreflect.FieldDe
 			return
 		}
 	}
+	if len(x.Values) != 0 {
+		value := protoreflect.ValueOfList(&_WorkerAttributedValue_3_list{list: &x.Values})
+		if !f(fd_WorkerAttributedValue_values, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -122,6 +176,8 @@ func (x *fastReflection_WorkerAttributedValue) Has(fd protoreflect.FieldDescript
 		return x.Worker != ""
 	case "emissions.v1.WorkerAttributedValue.value":
 		return x.Value != ""
+	case "emissions.v1.WorkerAttributedValue.values":
+		return len(x.Values) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.WorkerAttributedValue"))
@@ -142,6 +198,8 @@ func (x *fastReflection_WorkerAttributedValue) Clear(fd protoreflect.FieldDescri
 		x.Worker = ""
 	case "emissions.v1.WorkerAttributedValue.value":
 		x.Value = ""
+	case "emissions.v1.WorkerAttributedValue.values":
+		x.Values = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.WorkerAttributedValue"))
@@ -164,6 +222,12 @@ func (x *fastReflection_WorkerAttributedValue) Get(descriptor protoreflect.Field
 	case "emissions.v1.WorkerAttributedValue.value":
 		value := x.Value
 		return protoreflect.ValueOfString(value)
+	case "emissions.v1.WorkerAttributedValue.values":
+		if len(x.Values) == 0 {
+			return protoreflect.ValueOfList(&_WorkerAttributedValue_3_list{})
+		}
+		listValue := &_WorkerAttributedValue_3_list{list: &x.Values}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.WorkerAttributedValue"))
@@ -188,6 +252,10 @@ func (x *fastReflection_WorkerAttributedValue) Set(fd protoreflect.FieldDescript
 		x.Worker = value.Interface().(string)
 	case "emissions.v1.WorkerAttributedValue.value":
 		x.Value = value.Interface().(string)
+	case "emissions.v1.WorkerAttributedValue.values":
+		lv := value.List()
+		clv := lv.(*_WorkerAttributedValue_3_list)
+		x.Values = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.WorkerAttributedValue"))
@@ -208,6 +276,12 @@ func (x *fastReflection_WorkerAttributedValue) Set(fd protoreflect.FieldDescript
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_WorkerAttributedValue) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "emissions.v1.WorkerAttributedValue.values":
+		if x.Values == nil {
+			x.Values = []string{}
+		}
+		value := &_WorkerAttributedValue_3_list{list: &x.Values}
+		return protoreflect.ValueOfList(value)
 	case "emissions.v1.WorkerAttributedValue.worker":
 		panic(fmt.Errorf("field worker of message emissions.v1.WorkerAttributedValue is not mutable"))
 	case "emissions.v1.WorkerAttributedValue.value":
@@ -229,6 +303,9 @@ func (x *fastReflection_WorkerAttributedValue) NewField(fd protoreflect.FieldDes
 		return protoreflect.ValueOfString("")
 	case "emissions.v1.WorkerAttributedValue.value":
 		return protoreflect.ValueOfString("")
+	case "emissions.v1.WorkerAttributedValue.values":
+		list := []string{}
+		return protoreflect.ValueOfList(&_WorkerAttributedValue_3_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.WorkerAttributedValue"))
@@ -306,6 +383,12 @@ func (x *fastReflection_WorkerAttributedValue) ProtoMethods() *protoiface.Method
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.Values) > 0 {
+			for _, s := range x.Values {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -335,6 +418,15 @@ func (x *fastReflection_WorkerAttributedValue) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Values) > 0 {
+			for iNdEx := len(x.Values) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Values[iNdEx])
+				copy(dAtA[i:], x.Values[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Values[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
 		if len(x.Value) > 0 {
 			i -= len(x.Value)
 			copy(dAtA[i:], x.Value)
@@ -462,6 +554,38 @@ func (x *fastReflection_WorkerAttributedValue) ProtoMethods() *protoiface.Method
 				}
 				x.Value = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Values = append(x.Values, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -497,10 +621,57 @@ func (x *fastReflection_WorkerAttributedValue) ProtoMethods() *protoiface.Method
 	}
 }
 
+var _ protoreflect.List = (*_WithheldWorkerAttributedValue_3_list)(nil)
+
+type _WithheldWorkerAttributedValue_3_list struct {
+	list *[]string
+}
+
+func (x *_WithheldWorkerAttributedValue_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_WithheldWorkerAttributedValue_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_WithheldWorkerAttributedValue_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_WithheldWorkerAttributedValue_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_WithheldWorkerAttributedValue_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message WithheldWorkerAttributedValue at list field Values as it is not of Message kind"))
+}
+
+func (x *_WithheldWorkerAttributedValue_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_WithheldWorkerAttributedValue_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_WithheldWorkerAttributedValue_3_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_WithheldWorkerAttributedValue        protoreflect.MessageDescriptor
 	fd_WithheldWorkerAttributedValue_worker protoreflect.FieldDescriptor
 	fd_WithheldWorkerAttributedValue_value  protoreflect.FieldDescriptor
+	fd_WithheldWorkerAttributedValue_values protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -508,6 +679,7 @@ func init() {
 	md_WithheldWorkerAttributedValue = File_emissions_v1_reputer_proto.Messages().ByName("WithheldWorkerAttributedValue")
 	fd_WithheldWorkerAttributedValue_worker = md_WithheldWorkerAttributedValue.Fields().ByName("worker")
 	fd_WithheldWorkerAttributedValue_value = md_WithheldWorkerAttributedValue.Fields().ByName("value")
+	fd_WithheldWorkerAttributedValue_values = md_WithheldWorkerAttributedValue.Fields().ByName("values")
 }
 
 var _ protoreflect.Message = (*fastReflection_WithheldWorkerAttributedValue)(nil)
@@ -587,6 +759,12 @@ func (x *fastReflection_WithheldWorkerAttributedValue) Range(f func(protoreflect
 			return
 		}
 	}
+	if len(x.Values) != 0 {
+		value := protoreflect.ValueOfList(&_WithheldWorkerAttributedValue_3_list{list: &x.Values})
+		if !f(fd_WithheldWorkerAttributedValue_values, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -606,6 +784,8 @@ func (x *fastReflection_WithheldWorkerAttributedValue) Has(fd protoreflect.Field
 		return x.Worker != ""
 	case "emissions.v1.WithheldWorkerAttributedValue.value":
 		return x.Value != ""
+	case "emissions.v1.WithheldWorkerAttributedValue.values":
+		return len(x.Values) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.WithheldWorkerAttributedValue"))
@@ -626,6 +806,8 @@ func (x *fastReflection_WithheldWorkerAttributedValue) Clear(fd protoreflect.Fie
 		x.Worker = ""
 	case "emissions.v1.WithheldWorkerAttributedValue.value":
 		x.Value = ""
+	case "emissions.v1.WithheldWorkerAttributedValue.values":
+		x.Values = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.WithheldWorkerAttributedValue"))
@@ -648,6 +830,12 @@ func (x *fastReflection_WithheldWorkerAttributedValue) Get(descriptor protorefle
 	case "emissions.v1.WithheldWorkerAttributedValue.value":
 		value := x.Value
 		return protoreflect.ValueOfString(value)
+	case "emissions.v1.WithheldWorkerAttributedValue.values":
+		if len(x.Values) == 0 {
+			return protoreflect.ValueOfList(&_WithheldWorkerAttributedValue_3_list{})
+		}
+		listValue := &_WithheldWorkerAttributedValue_3_list{list: &x.Values}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.WithheldWorkerAttributedValue"))
@@ -672,6 +860,10 @@ func (x *fastReflection_WithheldWorkerAttributedValue) Set(fd protoreflect.Field
 		x.Worker = value.Interface().(string)
 	case "emissions.v1.WithheldWorkerAttributedValue.value":
 		x.Value = value.Interface().(string)
+	case "emissions.v1.WithheldWorkerAttributedValue.values":
+		lv := value.List()
+		clv := lv.(*_WithheldWorkerAttributedValue_3_list)
+		x.Values = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.WithheldWorkerAttributedValue"))
@@ -692,6 +884,12 @@ func (x *fastReflection_WithheldWorkerAttributedValue) Set(fd protoreflect.Field
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_WithheldWorkerAttributedValue) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "emissions.v1.WithheldWorkerAttributedValue.values":
+		if x.Values == nil {
+			x.Values = []string{}
+		}
+		value := &_WithheldWorkerAttributedValue_3_list{list: &x.Values}
+		return protoreflect.ValueOfList(value)
 	case "emissions.v1.WithheldWorkerAttributedValue.worker":
 		panic(fmt.Errorf("field worker of message emissions.v1.WithheldWorkerAttributedValue is not mutable"))
 	case "emissions.v1.WithheldWorkerAttributedValue.value":
@@ -713,6 +911,9 @@ func (x *fastReflection_WithheldWorkerAttributedValue) NewField(fd protoreflect.
 		return protoreflect.ValueOfString("")
 	case "emissions.v1.WithheldWorkerAttributedValue.value":
 		return protoreflect.ValueOfString("")
+	case "emissions.v1.WithheldWorkerAttributedValue.values":
+		list := []string{}
+		return protoreflect.ValueOfList(&_WithheldWorkerAttributedValue_3_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.WithheldWorkerAttributedValue"))
@@ -790,6 +991,12 @@ func (x *fastReflection_WithheldWorkerAttributedValue) ProtoMethods() *protoifac
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.Values) > 0 {
+			for _, s := range x.Values {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -819,6 +1026,15 @@ func (x *fastReflection_WithheldWorkerAttributedValue) ProtoMethods() *protoifac
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Values) > 0 {
+			for iNdEx := len(x.Values) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Values[iNdEx])
+				copy(dAtA[i:], x.Values[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Values[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
 		if len(x.Value) > 0 {
 			i -= len(x.Value)
 			copy(dAtA[i:], x.Value)
@@ -946,6 +1162,38 @@ func (x *fastReflection_WithheldWorkerAttributedValue) ProtoMethods() *protoifac
 				}
 				x.Value = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Values = append(x.Values, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1236,6 +1484,98 @@ func (x *_ValueBundle_11_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_ValueBundle_12_list)(nil)
+
+type _ValueBundle_12_list struct {
+	list *[]string
+}
+
+func (x *_ValueBundle_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ValueBundle_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_ValueBundle_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ValueBundle_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ValueBundle_12_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ValueBundle at list field CombinedValues as it is not of Message kind"))
+}
+
+func (x *_ValueBundle_12_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ValueBundle_12_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ValueBundle_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_ValueBundle_13_list)(nil)
+
+type _ValueBundle_13_list struct {
+	list *[]string
+}
+
+func (x *_ValueBundle_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ValueBundle_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_ValueBundle_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ValueBundle_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ValueBundle_13_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ValueBundle at list field NaiveValues as it is not of Message kind"))
+}
+
+func (x *_ValueBundle_13_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ValueBundle_13_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ValueBundle_13_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_ValueBundle                           protoreflect.MessageDescriptor
 	fd_ValueBundle_topic_id                  protoreflect.FieldDescriptor
@@ -1249,6 +1589,8 @@ var (
 	fd_ValueBundle_one_out_inferer_values    protoreflect.FieldDescriptor
 	fd_ValueBundle_one_out_forecaster_values protoreflect.FieldDescriptor
 	fd_ValueBundle_one_in_forecaster_values  protoreflect.FieldDescriptor
+	fd_ValueBundle_combined_values           protoreflect.FieldDescriptor
+	fd_ValueBundle_naive_values              protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1265,6 +1607,8 @@ func init() {
 	fd_ValueBundle_one_out_inferer_values = md_ValueBundle.Fields().ByName("one_out_inferer_values")
 	fd_ValueBundle_one_out_forecaster_values = md_ValueBundle.Fields().ByName("one_out_forecaster_values")
 	fd_ValueBundle_one_in_forecaster_values = md_ValueBundle.Fields().ByName("one_in_forecaster_values")
+	fd_ValueBundle_combined_values = md_ValueBundle.Fields().ByName("combined_values")
+	fd_ValueBundle_naive_values = md_ValueBundle.Fields().ByName("naive_values")
 }
 
 var _ protoreflect.Message = (*fastReflection_ValueBundle)(nil)
@@ -1398,6 +1742,18 @@ func (x *fastReflection_ValueBundle) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.CombinedValues) != 0 {
+		value := protoreflect.ValueOfList(&_ValueBundle_12_list{list: &x.CombinedValues})
+		if !f(fd_ValueBundle_combined_values, value) {
+			return
+		}
+	}
+	if len(x.NaiveValues) != 0 {
+		value := protoreflect.ValueOfList(&_ValueBundle_13_list{list: &x.NaiveValues})
+		if !f(fd_ValueBundle_naive_values, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1435,6 +1791,10 @@ func (x *fastReflection_ValueBundle) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.OneOutForecasterValues) != 0
 	case "emissions.v1.ValueBundle.one_in_forecaster_values":
 		return len(x.OneInForecasterValues) != 0
+	case "emissions.v1.ValueBundle.combined_values":
+		return len(x.CombinedValues) != 0
+	case "emissions.v1.ValueBundle.naive_values":
+		return len(x.NaiveValues) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.ValueBundle"))
@@ -1473,6 +1833,10 @@ func (x *fastReflection_ValueBundle) Clear(fd protoreflect.FieldDescriptor) {
 		x.OneOutForecasterValues = nil
 	case "emissions.v1.ValueBundle.one_in_forecaster_values":
 		x.OneInForecasterValues = nil
+	case "emissions.v1.ValueBundle.combined_values":
+		x.CombinedValues = nil
+	case "emissions.v1.ValueBundle.naive_values":
+		x.NaiveValues = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.ValueBundle"))
@@ -1537,6 +1901,18 @@ func (x *fastReflection_ValueBundle) Get(descriptor protoreflect.FieldDescriptor
 		}
 		listValue := &_ValueBundle_11_list{list: &x.OneInForecasterValues}
 		return protoreflect.ValueOfList(listValue)
+	case "emissions.v1.ValueBundle.combined_values":
+		if len(x.CombinedValues) == 0 {
+			return protoreflect.ValueOfList(&_ValueBundle_12_list{})
+		}
+		listValue := &_ValueBundle_12_list{list: &x.CombinedValues}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v1.ValueBundle.naive_values":
+		if len(x.NaiveValues) == 0 {
+			return protoreflect.ValueOfList(&_ValueBundle_13_list{})
+		}
+		listValue := &_ValueBundle_13_list{list: &x.NaiveValues}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.ValueBundle"))
@@ -1589,6 +1965,14 @@ func (x *fastReflection_ValueBundle) Set(fd protoreflect.FieldDescriptor, value
 		lv := value.List()
 		clv := lv.(*_ValueBundle_11_list)
 		x.OneInForecasterValues = *clv.list
+	case "emissions.v1.ValueBundle.combined_values":
+		lv := value.List()
+		clv := lv.(*_ValueBundle_12_list)
+		x.CombinedValues = *clv.list
+	case "emissions.v1.ValueBundle.naive_values":
+		lv := value.List()
+		clv := lv.(*_ValueBundle_13_list)
+		x.NaiveValues = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.ValueBundle"))
@@ -1644,6 +2028,18 @@ func (x *fastReflection_ValueBundle) Mutable(fd protoreflect.FieldDescriptor) pr
 		}
 		value := &_ValueBundle_11_list{list: &x.OneInForecasterValues}
 		return protoreflect.ValueOfList(value)
+	case "emissions.v1.ValueBundle.combined_values":
+		if x.CombinedValues == nil {
+			x.CombinedValues = []string{}
+		}
+		value := &_ValueBundle_12_list{list: &x.CombinedValues}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v1.ValueBundle.naive_values":
+		if x.NaiveValues == nil {
+			x.NaiveValues = []string{}
+		}
+		value := &_ValueBundle_13_list{list: &x.NaiveValues}
+		return protoreflect.ValueOfList(value)
 	case "emissions.v1.ValueBundle.topic_id":
 		panic(fmt.Errorf("field topic_id of message emissions.v1.ValueBundle is not mutable"))
 	case "emissions.v1.ValueBundle.reputer":
@@ -1695,6 +2091,12 @@ func (x *fastReflection_ValueBundle) NewField(fd protoreflect.FieldDescriptor) p
 	case "emissions.v1.ValueBundle.one_in_forecaster_values":
 		list := []*WorkerAttributedValue{}
 		return protoreflect.ValueOfList(&_ValueBundle_11_list{list: &list})
+	case "emissions.v1.ValueBundle.combined_values":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ValueBundle_12_list{list: &list})
+	case "emissions.v1.ValueBundle.naive_values":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ValueBundle_13_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.ValueBundle"))
@@ -1817,6 +2219,18 @@ func (x *fastReflection_ValueBundle) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if len(x.CombinedValues) > 0 {
+			for _, s := range x.CombinedValues {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.NaiveValues) > 0 {
+			for _, s := range x.NaiveValues {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1846,6 +2260,24 @@ func (x *fastReflection_ValueBundle) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.NaiveValues) > 0 {
+			for iNdEx := len(x.NaiveValues) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.NaiveValues[iNdEx])
+				copy(dAtA[i:], x.NaiveValues[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NaiveValues[iNdEx])))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if len(x.CombinedValues) > 0 {
+			for iNdEx := len(x.CombinedValues) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.CombinedValues[iNdEx])
+				copy(dAtA[i:], x.CombinedValues[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CombinedValues[iNdEx])))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
 		if len(x.OneInForecasterValues) > 0 {
 			for iNdEx := len(x.OneInForecasterValues) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.OneInForecasterValues[iNdEx])
@@ -2377,6 +2809,70 @@ func (x *fastReflection_ValueBundle) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CombinedValues", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CombinedValues = append(x.CombinedValues, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NaiveValues", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NaiveValues = append(x.NaiveValues, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3491,6 +3987,8 @@ type WorkerAttributedValue struct {
 
 	Worker string `protobuf:"bytes,1,opt,name=worker,proto3" json:"worker,omitempty"` // worker who created the value
 	Value  string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// components of the value in topics with an output dimension above 1
+	Values []string `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
 }
 
 func (x *WorkerAttributedValue) Reset() {
@@ -3527,6 +4025,13 @@ func (x *WorkerAttributedValue) GetValue() string {
 	return ""
 }
 
+func (x *WorkerAttributedValue) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
 type WithheldWorkerAttributedValue struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3534,6 +4039,8 @@ type WithheldWorkerAttributedValue struct {
 
 	Worker string `protobuf:"bytes,1,opt,name=worker,proto3" json:"worker,omitempty"`
 	Value  string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// components of the value in topics with an output dimension above 1
+	Values []string `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
 }
 
 func (x *WithheldWorkerAttributedValue) Reset() {
@@ -3570,6 +4077,13 @@ func (x *WithheldWorkerAttributedValue) GetValue() string {
 	return ""
 }
 
+func (x *WithheldWorkerAttributedValue) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
 // These losses and inferences are calculated per reputer or by the network
 // The `m`s in the comments below exist for when they're made by reputers,
 // but they may be absent implying that they were made by the network
@@ -3600,6 +4114,10 @@ type ValueBundle struct {
 	// aka one_in_forecaster_values because equivalent to using only one
 	// forecast-implied inference
 	OneInForecasterValues []*WorkerAttributedValue `protobuf:"bytes,11,rep,name=one_in_forecaster_values,json=oneInForecasterValues,proto3" json:"one_in_forecaster_values,omitempty"`
+	// Components of combined_value and naive_value in topics with an output dimension above 1.
+	// The scalar fields then hold the first component
+	CombinedValues []string `protobuf:"bytes,12,rep,name=combined_values,json=combinedValues,proto3" json:"combined_values,omitempty"`
+	NaiveValues    []string `protobuf:"bytes,13,rep,name=naive_values,json=naiveValues,proto3" json:"naive_values,omitempty"`
 }
 
 func (x *ValueBundle) Reset() {
@@ -3699,6 +4217,20 @@ func (x *ValueBundle) GetOneInForecasterValues() []*WorkerAttributedValue {
 	return nil
 }
 
+func (x *ValueBundle) GetCombinedValues() []string {
+	if x != nil {
+		return x.CombinedValues
+	}
+	return nil
+}
+
+func (x *ValueBundle) GetNaiveValues() []string {
+	if x != nil {
+		return x.NaiveValues
+	}
+	return nil
+}
+
 // For when the bundle is computed on a per-reputer basis (ie.. if there is an
 // index `m` in the above)
 type ReputerValueBundle struct {
@@ -3795,7 +4327,7 @@ var file_emissions_v1_reputer_proto_rawDesc = []byte{
 	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x18, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x6e,
-	0x6f, 0x6e, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x84, 0x01, 0x0a, 0x15, 0x57,
+	0x6f, 0x6e, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd5, 0x01, 0x0a, 0x15, 0x57,
 	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x56,
 	0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x4d, 0x0a, 0x05,
@@ -3803,68 +4335,90 @@ var file_emissions_v1_reputer_proto_rawDesc = []byte{
 	0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
 	0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61,
 	0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68,
-	0x2e, 0x44, 0x65, 0x63, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f,
-	0x01, 0x22, 0x8c, 0x01, 0x0a, 0x1d, 0x57, 0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64, 0x57, 0x6f,
-	0x72, 0x6b, 0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x4d, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00,
-	0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61,
-	0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c,
-	0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
-	0x44, 0x65, 0x63, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01,
-	0x22, 0xbe, 0x06, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65,
-	0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x55, 0x0a, 0x15, 0x72,
-	0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x6e,
-	0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x72,
-	0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4e, 0x6f, 0x6e,
-	0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a,
-	0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x5e, 0x0a, 0x0e, 0x63,
-	0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0d, 0x63, 0x6f,
-	0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4a, 0x0a, 0x0e, 0x69,
-	0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x06, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
-	0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0d, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65,
-	0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x11, 0x66, 0x6f, 0x72, 0x65, 0x63,
-	0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
-	0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x10, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73,
-	0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x58, 0x0a, 0x0b, 0x6e, 0x61, 0x69,
-	0x76, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37,
-	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d,
-	0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0a, 0x6e, 0x61, 0x69, 0x76, 0x65, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x12, 0x60, 0x0a, 0x16, 0x6f, 0x6e, 0x65, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x69,
-	0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x09, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x65,
-	0x72, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x13, 0x6f, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x66, 0x0a, 0x19, 0x6f, 0x6e, 0x65, 0x5f, 0x6f, 0x75, 0x74,
-	0x5f, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64,
-	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x16, 0x6f, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x46, 0x6f, 0x72,
-	0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x5c, 0x0a,
-	0x18, 0x6f, 0x6e, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74,
-	0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x23, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57,
+	0x2e, 0x44, 0x65, 0x63, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4f, 0x0a, 0x06, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61,
+	0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x44, 0x65, 0x63, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x3a, 0x04, 0xe8, 0xa0,
+	0x1f, 0x01, 0x22, 0xdd, 0x01, 0x0a, 0x1d, 0x57, 0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64, 0x57,
 	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x52, 0x15, 0x6f, 0x6e, 0x65, 0x49, 0x6e, 0x46, 0x6f, 0x72, 0x65, 0x63,
-	0x61, 0x73, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x4d, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61,
+	0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x44, 0x65, 0x63, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4f, 0x0a, 0x06, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61,
+	0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x44, 0x65, 0x63, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x3a, 0x04, 0xe8, 0xa0,
+	0x1f, 0x01, 0x22, 0xfc, 0x07, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64,
+	0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x55, 0x0a,
+	0x15, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x75,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52,
+	0x13, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4e,
+	0x6f, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x12, 0x1d,
+	0x0a, 0x0a, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x5e, 0x0a,
+	0x0e, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61,
+	0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0d,
+	0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4a, 0x0a,
+	0x0e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0d, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x11, 0x66, 0x6f, 0x72,
+	0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x10, 0x66, 0x6f, 0x72, 0x65, 0x63,
+	0x61, 0x73, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x58, 0x0a, 0x0b, 0x6e,
+	0x61, 0x69, 0x76, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0a, 0x6e, 0x61, 0x69, 0x76, 0x65,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x60, 0x0a, 0x16, 0x6f, 0x6e, 0x65, 0x5f, 0x6f, 0x75, 0x74,
+	0x5f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64, 0x57, 0x6f, 0x72,
+	0x6b, 0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x13, 0x6f, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65,
+	0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x66, 0x0a, 0x19, 0x6f, 0x6e, 0x65, 0x5f, 0x6f,
+	0x75, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x65, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x68, 0x65,
+	0x6c, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x16, 0x6f, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x46,
+	0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12,
+	0x5c, 0x0a, 0x18, 0x6f, 0x6e, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x15, 0x6f, 0x6e, 0x65, 0x49, 0x6e, 0x46, 0x6f, 0x72,
+	0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x60, 0x0a,
+	0x0f, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72,
+	0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61,
+	0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52,
+	0x0e, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12,
+	0x5a, 0x0a, 0x0c, 0x6e, 0x61, 0x69, 0x76, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18,
+	0x0d, 0x20, 0x03, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61,
+	0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0b,
+	0x6e, 0x61, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f,
 	0x01, 0x22, 0x8e, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c,
 	0x75, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x76, 0x61, 0x6c, 0x75,
 	0x65, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
@@ -15,24 +15,130 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Topic_24_list)(nil)
+
+type _Topic_24_list struct {
+	list *[]int64
+}
+
+func (x *_Topic_24_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Topic_24_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfInt64((*x.list)[i])
+}
+
+func (x *_Topic_24_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Topic_24_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Topic_24_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Topic at list field Horizons as it is not of Message kind"))
+}
+
+func (x *_Topic_24_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Topic_24_list) NewElement() protoreflect.Value {
+	v := int64(0)
+	return protoreflect.ValueOfInt64(v)
+}
+
+func (x *_Topic_24_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Topic_25_list)(nil)
+
+type _Topic_25_list struct {
+	list *[]string
+}
+
+func (x *_Topic_25_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Topic_25_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Topic_25_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Topic_25_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Topic_25_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Topic at list field HorizonWeights as it is not of Message kind"))
+}
+
+func (x *_Topic_25_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Topic_25_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Topic_25_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Topic                  protoreflect.MessageDescriptor
-	fd_Topic_id               protoreflect.FieldDescriptor
-	fd_Topic_creator          protoreflect.FieldDescriptor
-	fd_Topic_metadata         protoreflect.FieldDescriptor
-	fd_Topic_loss_logic       protoreflect.FieldDescriptor
-	fd_Topic_loss_method      protoreflect.FieldDescriptor
-	fd_Topic_inference_logic  protoreflect.FieldDescriptor
-	fd_Topic_inference_method protoreflect.FieldDescriptor
-	fd_Topic_epoch_last_ended protoreflect.FieldDescriptor
-	fd_Topic_epoch_length     protoreflect.FieldDescriptor
-	fd_Topic_ground_truth_lag protoreflect.FieldDescriptor
-	fd_Topic_default_arg      protoreflect.FieldDescriptor
-	fd_Topic_p_norm           protoreflect.FieldDescriptor
-	fd_Topic_alpha_regret     protoreflect.FieldDescriptor
-	fd_Topic_allow_negative   protoreflect.FieldDescriptor
-	fd_Topic_epsilon          protoreflect.FieldDescriptor
-	fd_Topic_initial_regret   protoreflect.FieldDescriptor
+	md_Topic                              protoreflect.MessageDescriptor
+	fd_Topic_id                           protoreflect.FieldDescriptor
+	fd_Topic_creator                      protoreflect.FieldDescriptor
+	fd_Topic_metadata                     protoreflect.FieldDescriptor
+	fd_Topic_loss_logic                   protoreflect.FieldDescriptor
+	fd_Topic_loss_method                  protoreflect.FieldDescriptor
+	fd_Topic_inference_logic              protoreflect.FieldDescriptor
+	fd_Topic_inference_method             protoreflect.FieldDescriptor
+	fd_Topic_epoch_last_ended             protoreflect.FieldDescriptor
+	fd_Topic_epoch_length                 protoreflect.FieldDescriptor
+	fd_Topic_ground_truth_lag             protoreflect.FieldDescriptor
+	fd_Topic_default_arg                  protoreflect.FieldDescriptor
+	fd_Topic_p_norm                       protoreflect.FieldDescriptor
+	fd_Topic_alpha_regret                 protoreflect.FieldDescriptor
+	fd_Topic_allow_negative               protoreflect.FieldDescriptor
+	fd_Topic_epsilon                      protoreflect.FieldDescriptor
+	fd_Topic_initial_regret               protoreflect.FieldDescriptor
+	fd_Topic_allowlist_mode               protoreflect.FieldDescriptor
+	fd_Topic_worker_inactivity_threshold  protoreflect.FieldDescriptor
+	fd_Topic_reputer_inactivity_threshold protoreflect.FieldDescriptor
+	fd_Topic_combiner                     protoreflect.FieldDescriptor
+	fd_Topic_output_dimension             protoreflect.FieldDescriptor
+	fd_Topic_output_type                  protoreflect.FieldDescriptor
+	fd_Topic_distribution_pooling         protoreflect.FieldDescriptor
+	fd_Topic_horizons                     protoreflect.FieldDescriptor
+	fd_Topic_horizon_weights              protoreflect.FieldDescriptor
+	fd_Topic_loss_aggregator              protoreflect.FieldDescriptor
+	fd_Topic_trimmed_mean_trim_fraction   protoreflect.FieldDescriptor
+	fd_Topic_loss_trim_fraction           protoreflect.FieldDescriptor
+	fd_Topic_huber_loss_tuning_constant   protoreflect.FieldDescriptor
+	fd_Topic_huber_loss_max_iterations    protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -54,6 +160,20 @@ func init() {
 	fd_Topic_allow_negative = md_Topic.Fields().ByName("allow_negative")
 	fd_Topic_epsilon = md_Topic.Fields().ByName("epsilon")
 	fd_Topic_initial_regret = md_Topic.Fields().ByName("initial_regret")
+	fd_Topic_allowlist_mode = md_Topic.Fields().ByName("allowlist_mode")
+	fd_Topic_worker_inactivity_threshold = md_Topic.Fields().ByName("worker_inactivity_threshold")
+	fd_Topic_reputer_inactivity_threshold = md_Topic.Fields().ByName("reputer_inactivity_threshold")
+	fd_Topic_combiner = md_Topic.Fields().ByName("combiner")
+	fd_Topic_output_dimension = md_Topic.Fields().ByName("output_dimension")
+	fd_Topic_output_type = md_Topic.Fields().ByName("output_type")
+	fd_Topic_distribution_pooling = md_Topic.Fields().ByName("distribution_pooling")
+	fd_Topic_horizons = md_Topic.Fields().ByName("horizons")
+	fd_Topic_horizon_weights = md_Topic.Fields().ByName("horizon_weights")
+	fd_Topic_loss_aggregator = md_Topic.Fields().ByName("loss_aggregator")
+	fd_Topic_trimmed_mean_trim_fraction = md_Topic.Fields().ByName("trimmed_mean_trim_fraction")
+	fd_Topic_loss_trim_fraction = md_Topic.Fields().ByName("loss_trim_fraction")
+	fd_Topic_huber_loss_tuning_constant = md_Topic.Fields().ByName("huber_loss_tuning_constant")
+	fd_Topic_huber_loss_max_iterations = md_Topic.Fields().ByName("huber_loss_max_iterations")
 }
 
 var _ protoreflect.Message = (*fastReflection_Topic)(nil)
@@ -217,6 +337,90 @@ func (x *fastReflection_Topic) Range(f func(protoreflect.FieldDescriptor, protor
 			return
 		}
 	}
+	if x.AllowlistMode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.AllowlistMode))
+		if !f(fd_Topic_allowlist_mode, value) {
+			return
+		}
+	}
+	if x.WorkerInactivityThreshold != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.WorkerInactivityThreshold)
+		if !f(fd_Topic_worker_inactivity_threshold, value) {
+			return
+		}
+	}
+	if x.ReputerInactivityThreshold != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ReputerInactivityThreshold)
+		if !f(fd_Topic_reputer_inactivity_threshold, value) {
+			return
+		}
+	}
+	if x.Combiner != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Combiner))
+		if !f(fd_Topic_combiner, value) {
+			return
+		}
+	}
+	if x.OutputDimension != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OutputDimension)
+		if !f(fd_Topic_output_dimension, value) {
+			return
+		}
+	}
+	if x.OutputType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.OutputType))
+		if !f(fd_Topic_output_type, value) {
+			return
+		}
+	}
+	if x.DistributionPooling != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.DistributionPooling))
+		if !f(fd_Topic_distribution_pooling, value) {
+			return
+		}
+	}
+	if len(x.Horizons) != 0 {
+		value := protoreflect.ValueOfList(&_Topic_24_list{list: &x.Horizons})
+		if !f(fd_Topic_horizons, value) {
+			return
+		}
+	}
+	if len(x.HorizonWeights) != 0 {
+		value := protoreflect.ValueOfList(&_Topic_25_list{list: &x.HorizonWeights})
+		if !f(fd_Topic_horizon_weights, value) {
+			return
+		}
+	}
+	if x.LossAggregator != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.LossAggregator))
+		if !f(fd_Topic_loss_aggregator, value) {
+			return
+		}
+	}
+	if x.TrimmedMeanTrimFraction != "" {
+		value := protoreflect.ValueOfString(x.TrimmedMeanTrimFraction)
+		if !f(fd_Topic_trimmed_mean_trim_fraction, value) {
+			return
+		}
+	}
+	if x.LossTrimFraction != "" {
+		value := protoreflect.ValueOfString(x.LossTrimFraction)
+		if !f(fd_Topic_loss_trim_fraction, value) {
+			return
+		}
+	}
+	if x.HuberLossTuningConstant != "" {
+		value := protoreflect.ValueOfString(x.HuberLossTuningConstant)
+		if !f(fd_Topic_huber_loss_tuning_constant, value) {
+			return
+		}
+	}
+	if x.HuberLossMaxIterations != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.HuberLossMaxIterations)
+		if !f(fd_Topic_huber_loss_max_iterations, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -264,6 +468,34 @@ func (x *fastReflection_Topic) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.Epsilon != ""
 	case "emissions.v1.Topic.initial_regret":
 		return x.InitialRegret != ""
+	case "emissions.v1.Topic.allowlist_mode":
+		return x.AllowlistMode != 0
+	case "emissions.v1.Topic.worker_inactivity_threshold":
+		return x.WorkerInactivityThreshold != uint64(0)
+	case "emissions.v1.Topic.reputer_inactivity_threshold":
+		return x.ReputerInactivityThreshold != uint64(0)
+	case "emissions.v1.Topic.combiner":
+		return x.Combiner != 0
+	case "emissions.v1.Topic.output_dimension":
+		return x.OutputDimension != uint64(0)
+	case "emissions.v1.Topic.output_type":
+		return x.OutputType != 0
+	case "emissions.v1.Topic.distribution_pooling":
+		return x.DistributionPooling != 0
+	case "emissions.v1.Topic.horizons":
+		return len(x.Horizons) != 0
+	case "emissions.v1.Topic.horizon_weights":
+		return len(x.HorizonWeights) != 0
+	case "emissions.v1.Topic.loss_aggregator":
+		return x.LossAggregator != 0
+	case "emissions.v1.Topic.trimmed_mean_trim_fraction":
+		return x.TrimmedMeanTrimFraction != ""
+	case "emissions.v1.Topic.loss_trim_fraction":
+		return x.LossTrimFraction != ""
+	case "emissions.v1.Topic.huber_loss_tuning_constant":
+		return x.HuberLossTuningConstant != ""
+	case "emissions.v1.Topic.huber_loss_max_iterations":
+		return x.HuberLossMaxIterations != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.Topic"))
@@ -312,6 +544,34 @@ func (x *fastReflection_Topic) Clear(fd protoreflect.FieldDescriptor) {
 		x.Epsilon = ""
 	case "emissions.v1.Topic.initial_regret":
 		x.InitialRegret = ""
+	case "emissions.v1.Topic.allowlist_mode":
+		x.AllowlistMode = 0
+	case "emissions.v1.Topic.worker_inactivity_threshold":
+		x.WorkerInactivityThreshold = uint64(0)
+	case "emissions.v1.Topic.reputer_inactivity_threshold":
+		x.ReputerInactivityThreshold = uint64(0)
+	case "emissions.v1.Topic.combiner":
+		x.Combiner = 0
+	case "emissions.v1.Topic.output_dimension":
+		x.OutputDimension = uint64(0)
+	case "emissions.v1.Topic.output_type":
+		x.OutputType = 0
+	case "emissions.v1.Topic.distribution_pooling":
+		x.DistributionPooling = 0
+	case "emissions.v1.Topic.horizons":
+		x.Horizons = nil
+	case "emissions.v1.Topic.horizon_weights":
+		x.HorizonWeights = nil
+	case "emissions.v1.Topic.loss_aggregator":
+		x.LossAggregator = 0
+	case "emissions.v1.Topic.trimmed_mean_trim_fraction":
+		x.TrimmedMeanTrimFraction = ""
+	case "emissions.v1.Topic.loss_trim_fraction":
+		x.LossTrimFraction = ""
+	case "emissions.v1.Topic.huber_loss_tuning_constant":
+		x.HuberLossTuningConstant = ""
+	case "emissions.v1.Topic.huber_loss_max_iterations":
+		x.HuberLossMaxIterations = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.Topic"))
@@ -376,6 +636,54 @@ func (x *fastReflection_Topic) Get(descriptor protoreflect.FieldDescriptor) prot
 	case "emissions.v1.Topic.initial_regret":
 		value := x.InitialRegret
 		return protoreflect.ValueOfString(value)
+	case "emissions.v1.Topic.allowlist_mode":
+		value := x.AllowlistMode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "emissions.v1.Topic.worker_inactivity_threshold":
+		value := x.WorkerInactivityThreshold
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.Topic.reputer_inactivity_threshold":
+		value := x.ReputerInactivityThreshold
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.Topic.combiner":
+		value := x.Combiner
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "emissions.v1.Topic.output_dimension":
+		value := x.OutputDimension
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.Topic.output_type":
+		value := x.OutputType
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "emissions.v1.Topic.distribution_pooling":
+		value := x.DistributionPooling
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "emissions.v1.Topic.horizons":
+		if len(x.Horizons) == 0 {
+			return protoreflect.ValueOfList(&_Topic_24_list{})
+		}
+		listValue := &_Topic_24_list{list: &x.Horizons}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v1.Topic.horizon_weights":
+		if len(x.HorizonWeights) == 0 {
+			return protoreflect.ValueOfList(&_Topic_25_list{})
+		}
+		listValue := &_Topic_25_list{list: &x.HorizonWeights}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v1.Topic.loss_aggregator":
+		value := x.LossAggregator
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "emissions.v1.Topic.trimmed_mean_trim_fraction":
+		value := x.TrimmedMeanTrimFraction
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.Topic.loss_trim_fraction":
+		value := x.LossTrimFraction
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.Topic.huber_loss_tuning_constant":
+		value := x.HuberLossTuningConstant
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.Topic.huber_loss_max_iterations":
+		value := x.HuberLossMaxIterations
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.Topic"))
@@ -428,6 +736,38 @@ func (x *fastReflection_Topic) Set(fd protoreflect.FieldDescriptor, value protor
 		x.Epsilon = value.Interface().(string)
 	case "emissions.v1.Topic.initial_regret":
 		x.InitialRegret = value.Interface().(string)
+	case "emissions.v1.Topic.allowlist_mode":
+		x.AllowlistMode = (TopicAllowlistMode)(value.Enum())
+	case "emissions.v1.Topic.worker_inactivity_threshold":
+		x.WorkerInactivityThreshold = value.Uint()
+	case "emissions.v1.Topic.reputer_inactivity_threshold":
+		x.ReputerInactivityThreshold = value.Uint()
+	case "emissions.v1.Topic.combiner":
+		x.Combiner = (CombinerType)(value.Enum())
+	case "emissions.v1.Topic.output_dimension":
+		x.OutputDimension = value.Uint()
+	case "emissions.v1.Topic.output_type":
+		x.OutputType = (TopicOutputType)(value.Enum())
+	case "emissions.v1.Topic.distribution_pooling":
+		x.DistributionPooling = (DistributionPooling)(value.Enum())
+	case "emissions.v1.Topic.horizons":
+		lv := value.List()
+		clv := lv.(*_Topic_24_list)
+		x.Horizons = *clv.list
+	case "emissions.v1.Topic.horizon_weights":
+		lv := value.List()
+		clv := lv.(*_Topic_25_list)
+		x.HorizonWeights = *clv.list
+	case "emissions.v1.Topic.loss_aggregator":
+		x.LossAggregator = (LossAggregatorType)(value.Enum())
+	case "emissions.v1.Topic.trimmed_mean_trim_fraction":
+		x.TrimmedMeanTrimFraction = value.Interface().(string)
+	case "emissions.v1.Topic.loss_trim_fraction":
+		x.LossTrimFraction = value.Interface().(string)
+	case "emissions.v1.Topic.huber_loss_tuning_constant":
+		x.HuberLossTuningConstant = value.Interface().(string)
+	case "emissions.v1.Topic.huber_loss_max_iterations":
+		x.HuberLossMaxIterations = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.Topic"))
@@ -448,6 +788,18 @@ func (x *fastReflection_Topic) Set(fd protoreflect.FieldDescriptor, value protor
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Topic) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "emissions.v1.Topic.horizons":
+		if x.Horizons == nil {
+			x.Horizons = []int64{}
+		}
+		value := &_Topic_24_list{list: &x.Horizons}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v1.Topic.horizon_weights":
+		if x.HorizonWeights == nil {
+			x.HorizonWeights = []string{}
+		}
+		value := &_Topic_25_list{list: &x.HorizonWeights}
+		return protoreflect.ValueOfList(value)
 	case "emissions.v1.Topic.id":
 		panic(fmt.Errorf("field id of message emissions.v1.Topic is not mutable"))
 	case "emissions.v1.Topic.creator":
@@ -480,6 +832,30 @@ func (x *fastReflection_Topic) Mutable(fd protoreflect.FieldDescriptor) protoref
 		panic(fmt.Errorf("field epsilon of message emissions.v1.Topic is not mutable"))
 	case "emissions.v1.Topic.initial_regret":
 		panic(fmt.Errorf("field initial_regret of message emissions.v1.Topic is not mutable"))
+	case "emissions.v1.Topic.allowlist_mode":
+		panic(fmt.Errorf("field allowlist_mode of message emissions.v1.Topic is not mutable"))
+	case "emissions.v1.Topic.worker_inactivity_threshold":
+		panic(fmt.Errorf("field worker_inactivity_threshold of message emissions.v1.Topic is not mutable"))
+	case "emissions.v1.Topic.reputer_inactivity_threshold":
+		panic(fmt.Errorf("field reputer_inactivity_threshold of message emissions.v1.Topic is not mutable"))
+	case "emissions.v1.Topic.combiner":
+		panic(fmt.Errorf("field combiner of message emissions.v1.Topic is not mutable"))
+	case "emissions.v1.Topic.output_dimension":
+		panic(fmt.Errorf("field output_dimension of message emissions.v1.Topic is not mutable"))
+	case "emissions.v1.Topic.output_type":
+		panic(fmt.Errorf("field output_type of message emissions.v1.Topic is not mutable"))
+	case "emissions.v1.Topic.distribution_pooling":
+		panic(fmt.Errorf("field distribution_pooling of message emissions.v1.Topic is not mutable"))
+	case "emissions.v1.Topic.loss_aggregator":
+		panic(fmt.Errorf("field loss_aggregator of message emissions.v1.Topic is not mutable"))
+	case "emissions.v1.Topic.trimmed_mean_trim_fraction":
+		panic(fmt.Errorf("field trimmed_mean_trim_fraction of message emissions.v1.Topic is not mutable"))
+	case "emissions.v1.Topic.loss_trim_fraction":
+		panic(fmt.Errorf("field loss_trim_fraction of message emissions.v1.Topic is not mutable"))
+	case "emissions.v1.Topic.huber_loss_tuning_constant":
+		panic(fmt.Errorf("field huber_loss_tuning_constant of message emissions.v1.Topic is not mutable"))
+	case "emissions.v1.Topic.huber_loss_max_iterations":
+		panic(fmt.Errorf("field huber_loss_max_iterations of message emissions.v1.Topic is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.Topic"))
@@ -525,6 +901,36 @@ func (x *fastReflection_Topic) NewField(fd protoreflect.FieldDescriptor) protore
 		return protoreflect.ValueOfString("")
 	case "emissions.v1.Topic.initial_regret":
 		return protoreflect.ValueOfString("")
+	case "emissions.v1.Topic.allowlist_mode":
+		return protoreflect.ValueOfEnum(0)
+	case "emissions.v1.Topic.worker_inactivity_threshold":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.Topic.reputer_inactivity_threshold":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.Topic.combiner":
+		return protoreflect.ValueOfEnum(0)
+	case "emissions.v1.Topic.output_dimension":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.Topic.output_type":
+		return protoreflect.ValueOfEnum(0)
+	case "emissions.v1.Topic.distribution_pooling":
+		return protoreflect.ValueOfEnum(0)
+	case "emissions.v1.Topic.horizons":
+		list := []int64{}
+		return protoreflect.ValueOfList(&_Topic_24_list{list: &list})
+	case "emissions.v1.Topic.horizon_weights":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Topic_25_list{list: &list})
+	case "emissions.v1.Topic.loss_aggregator":
+		return protoreflect.ValueOfEnum(0)
+	case "emissions.v1.Topic.trimmed_mean_trim_fraction":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.Topic.loss_trim_fraction":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.Topic.huber_loss_tuning_constant":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.Topic.huber_loss_max_iterations":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.Topic"))
@@ -653,6 +1059,58 @@ func (x *fastReflection_Topic) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 2 + l + runtime.Sov(uint64(l))
 		}
+		if x.AllowlistMode != 0 {
+			n += 2 + runtime.Sov(uint64(x.AllowlistMode))
+		}
+		if x.WorkerInactivityThreshold != 0 {
+			n += 2 + runtime.Sov(uint64(x.WorkerInactivityThreshold))
+		}
+		if x.ReputerInactivityThreshold != 0 {
+			n += 2 + runtime.Sov(uint64(x.ReputerInactivityThreshold))
+		}
+		if x.Combiner != 0 {
+			n += 2 + runtime.Sov(uint64(x.Combiner))
+		}
+		if x.OutputDimension != 0 {
+			n += 2 + runtime.Sov(uint64(x.OutputDimension))
+		}
+		if x.OutputType != 0 {
+			n += 2 + runtime.Sov(uint64(x.OutputType))
+		}
+		if x.DistributionPooling != 0 {
+			n += 2 + runtime.Sov(uint64(x.DistributionPooling))
+		}
+		if len(x.Horizons) > 0 {
+			l = 0
+			for _, e := range x.Horizons {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 2 + runtime.Sov(uint64(l)) + l
+		}
+		if len(x.HorizonWeights) > 0 {
+			for _, s := range x.HorizonWeights {
+				l = len(s)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.LossAggregator != 0 {
+			n += 2 + runtime.Sov(uint64(x.LossAggregator))
+		}
+		l = len(x.TrimmedMeanTrimFraction)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LossTrimFraction)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.HuberLossTuningConstant)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.HuberLossMaxIterations != 0 {
+			n += 2 + runtime.Sov(uint64(x.HuberLossMaxIterations))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -682,6 +1140,130 @@ func (x *fastReflection_Topic) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.HuberLossMaxIterations != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.HuberLossMaxIterations))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf0
+		}
+		if len(x.HuberLossTuningConstant) > 0 {
+			i -= len(x.HuberLossTuningConstant)
+			copy(dAtA[i:], x.HuberLossTuningConstant)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HuberLossTuningConstant)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
+		if len(x.LossTrimFraction) > 0 {
+			i -= len(x.LossTrimFraction)
+			copy(dAtA[i:], x.LossTrimFraction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LossTrimFraction)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
+		if len(x.TrimmedMeanTrimFraction) > 0 {
+			i -= len(x.TrimmedMeanTrimFraction)
+			copy(dAtA[i:], x.TrimmedMeanTrimFraction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TrimmedMeanTrimFraction)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+		if x.LossAggregator != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LossAggregator))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd0
+		}
+		if len(x.HorizonWeights) > 0 {
+			for iNdEx := len(x.HorizonWeights) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.HorizonWeights[iNdEx])
+				copy(dAtA[i:], x.HorizonWeights[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HorizonWeights[iNdEx])))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xca
+			}
+		}
+		if len(x.Horizons) > 0 {
+			var pksize2 int
+			for _, num := range x.Horizons {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num1 := range x.Horizons {
+				num := uint64(num1)
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+		if x.DistributionPooling != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DistributionPooling))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb8
+		}
+		if x.OutputType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutputType))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb0
+		}
+		if x.OutputDimension != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutputDimension))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa8
+		}
+		if x.Combiner != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Combiner))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa0
+		}
+		if x.ReputerInactivityThreshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReputerInactivityThreshold))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x98
+		}
+		if x.WorkerInactivityThreshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WorkerInactivityThreshold))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x90
+		}
+		if x.AllowlistMode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AllowlistMode))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x88
+		}
 		if len(x.InitialRegret) > 0 {
 			i -= len(x.InitialRegret)
 			copy(dAtA[i:], x.InitialRegret)
@@ -1089,11 +1671,324 @@ func (x *fastReflection_Topic) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
-			case 10:
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroundTruthLag", wireType)
+				}
+				x.GroundTruthLag = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GroundTruthLag |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DefaultArg", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DefaultArg = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PNorm", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PNorm = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AlphaRegret", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AlphaRegret = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowNegative", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.AllowNegative = bool(v != 0)
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epsilon", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Epsilon = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InitialRegret", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InitialRegret = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 17:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowlistMode", wireType)
+				}
+				x.AllowlistMode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AllowlistMode |= TopicAllowlistMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 18:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WorkerInactivityThreshold", wireType)
+				}
+				x.WorkerInactivityThreshold = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WorkerInactivityThreshold |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 19:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReputerInactivityThreshold", wireType)
+				}
+				x.ReputerInactivityThreshold = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReputerInactivityThreshold |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 20:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Combiner", wireType)
+				}
+				x.Combiner = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Combiner |= CombinerType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 21:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutputDimension", wireType)
+				}
+				x.OutputDimension = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OutputDimension |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 22:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutputType", wireType)
+				}
+				x.OutputType = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OutputType |= TopicOutputType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 23:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroundTruthLag", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DistributionPooling", wireType)
 				}
-				x.GroundTruthLag = 0
+				x.DistributionPooling = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1103,14 +1998,90 @@ func (x *fastReflection_Topic) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.GroundTruthLag |= int64(b&0x7F) << shift
+					x.DistributionPooling |= DistributionPooling(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 11:
+			case 24:
+				if wireType == 0 {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.Horizons = append(x.Horizons, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.Horizons) == 0 {
+						x.Horizons = make([]int64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v int64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= int64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.Horizons = append(x.Horizons, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Horizons", wireType)
+				}
+			case 25:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DefaultArg", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HorizonWeights", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1138,13 +2109,13 @@ func (x *fastReflection_Topic) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.DefaultArg = string(dAtA[iNdEx:postIndex])
+				x.HorizonWeights = append(x.HorizonWeights, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
-			case 12:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PNorm", wireType)
+			case 26:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LossAggregator", wireType)
 				}
-				var stringLen uint64
+				x.LossAggregator = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1154,27 +2125,14 @@ func (x *fastReflection_Topic) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.LossAggregator |= LossAggregatorType(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.PNorm = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 13:
+			case 27:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AlphaRegret", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TrimmedMeanTrimFraction", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1202,31 +2160,11 @@ func (x *fastReflection_Topic) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.AlphaRegret = string(dAtA[iNdEx:postIndex])
+				x.TrimmedMeanTrimFraction = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 14:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowNegative", wireType)
-				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				x.AllowNegative = bool(v != 0)
-			case 15:
+			case 28:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epsilon", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LossTrimFraction", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1254,11 +2192,11 @@ func (x *fastReflection_Topic) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Epsilon = string(dAtA[iNdEx:postIndex])
+				x.LossTrimFraction = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 16:
+			case 29:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InitialRegret", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HuberLossTuningConstant", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1286,8 +2224,27 @@ func (x *fastReflection_Topic) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.InitialRegret = string(dAtA[iNdEx:postIndex])
+				x.HuberLossTuningConstant = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 30:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HuberLossMaxIterations", wireType)
+				}
+				x.HuberLossMaxIterations = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.HuberLossMaxIterations |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2377,27 +3334,319 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Controls which actors may participate in a topic. Actors that are not on
+// the topic allowlist of a restricted role cannot register or submit payloads
+// in that role.
+type TopicAllowlistMode int32
+
+const (
+	TopicAllowlistMode_ALLOWLIST_MODE_OPEN                 TopicAllowlistMode = 0
+	TopicAllowlistMode_ALLOWLIST_MODE_WORKERS              TopicAllowlistMode = 1
+	TopicAllowlistMode_ALLOWLIST_MODE_REPUTERS             TopicAllowlistMode = 2
+	TopicAllowlistMode_ALLOWLIST_MODE_WORKERS_AND_REPUTERS TopicAllowlistMode = 3
+)
+
+// Enum value maps for TopicAllowlistMode.
+var (
+	TopicAllowlistMode_name = map[int32]string{
+		0: "ALLOWLIST_MODE_OPEN",
+		1: "ALLOWLIST_MODE_WORKERS",
+		2: "ALLOWLIST_MODE_REPUTERS",
+		3: "ALLOWLIST_MODE_WORKERS_AND_REPUTERS",
+	}
+	TopicAllowlistMode_value = map[string]int32{
+		"ALLOWLIST_MODE_OPEN":                 0,
+		"ALLOWLIST_MODE_WORKERS":              1,
+		"ALLOWLIST_MODE_REPUTERS":             2,
+		"ALLOWLIST_MODE_WORKERS_AND_REPUTERS": 3,
+	}
+)
+
+func (x TopicAllowlistMode) Enum() *TopicAllowlistMode {
+	p := new(TopicAllowlistMode)
+	*p = x
+	return p
+}
+
+func (x TopicAllowlistMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TopicAllowlistMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_emissions_v1_topic_proto_enumTypes[0].Descriptor()
+}
+
+func (TopicAllowlistMode) Type() protoreflect.EnumType {
+	return &file_emissions_v1_topic_proto_enumTypes[0]
+}
+
+func (x TopicAllowlistMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TopicAllowlistMode.Descriptor instead.
+func (TopicAllowlistMode) EnumDescriptor() ([]byte, []int) {
+	return file_emissions_v1_topic_proto_rawDescGZIP(), []int{0}
+}
+
+// Selects the strategy used to combine worker inferences and forecast-implied
+// inferences into network inferences for a topic.
+type CombinerType int32
+
+const (
+	CombinerType_COMBINER_REGRET          CombinerType = 0
+	CombinerType_COMBINER_WEIGHTED_MEDIAN CombinerType = 1
+	CombinerType_COMBINER_TRIMMED_MEAN    CombinerType = 2
+	CombinerType_COMBINER_SCORE_SOFTMAX   CombinerType = 3
+)
+
+// Enum value maps for CombinerType.
+var (
+	CombinerType_name = map[int32]string{
+		0: "COMBINER_REGRET",
+		1: "COMBINER_WEIGHTED_MEDIAN",
+		2: "COMBINER_TRIMMED_MEAN",
+		3: "COMBINER_SCORE_SOFTMAX",
+	}
+	CombinerType_value = map[string]int32{
+		"COMBINER_REGRET":          0,
+		"COMBINER_WEIGHTED_MEDIAN": 1,
+		"COMBINER_TRIMMED_MEAN":    2,
+		"COMBINER_SCORE_SOFTMAX":   3,
+	}
+)
+
+func (x CombinerType) Enum() *CombinerType {
+	p := new(CombinerType)
+	*p = x
+	return p
+}
+
+func (x CombinerType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CombinerType) Descriptor() protoreflect.EnumDescriptor {
+	return file_emissions_v1_topic_proto_enumTypes[1].Descriptor()
+}
+
+func (CombinerType) Type() protoreflect.EnumType {
+	return &file_emissions_v1_topic_proto_enumTypes[1]
+}
+
+func (x CombinerType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CombinerType.Descriptor instead.
+func (CombinerType) EnumDescriptor() ([]byte, []int) {
+	return file_emissions_v1_topic_proto_rawDescGZIP(), []int{1}
+}
+
+// What the values inferred in a topic represent
+type TopicOutputType int32
+
+const (
+	// real numbers, or vectors of real numbers
+	TopicOutputType_OUTPUT_TYPE_REAL TopicOutputType = 0
+	// probability distributions over output_dimension classes
+	TopicOutputType_OUTPUT_TYPE_PROBABILITY_DISTRIBUTION TopicOutputType = 1
+)
+
+// Enum value maps for TopicOutputType.
+var (
+	TopicOutputType_name = map[int32]string{
+		0: "OUTPUT_TYPE_REAL",
+		1: "OUTPUT_TYPE_PROBABILITY_DISTRIBUTION",
+	}
+	TopicOutputType_value = map[string]int32{
+		"OUTPUT_TYPE_REAL":                     0,
+		"OUTPUT_TYPE_PROBABILITY_DISTRIBUTION": 1,
+	}
+)
+
+func (x TopicOutputType) Enum() *TopicOutputType {
+	p := new(TopicOutputType)
+	*p = x
+	return p
+}
+
+func (x TopicOutputType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TopicOutputType) Descriptor() protoreflect.EnumDescriptor {
+	return file_emissions_v1_topic_proto_enumTypes[2].Descriptor()
+}
+
+func (TopicOutputType) Type() protoreflect.EnumType {
+	return &file_emissions_v1_topic_proto_enumTypes[2]
+}
+
+func (x TopicOutputType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TopicOutputType.Descriptor instead.
+func (TopicOutputType) EnumDescriptor() ([]byte, []int) {
+	return file_emissions_v1_topic_proto_rawDescGZIP(), []int{2}
+}
+
+// How the probability distributions of a topic are combined into network inferences
+type DistributionPooling int32
+
+const (
+	// weighted average of the probabilities, renormalised
+	DistributionPooling_POOLING_LINEAR DistributionPooling = 0
+	// weighted average of the log-probabilities, exponentiated and renormalised
+	DistributionPooling_POOLING_LOGARITHMIC DistributionPooling = 1
+)
+
+// Enum value maps for DistributionPooling.
+var (
+	DistributionPooling_name = map[int32]string{
+		0: "POOLING_LINEAR",
+		1: "POOLING_LOGARITHMIC",
+	}
+	DistributionPooling_value = map[string]int32{
+		"POOLING_LINEAR":      0,
+		"POOLING_LOGARITHMIC": 1,
+	}
+)
+
+func (x DistributionPooling) Enum() *DistributionPooling {
+	p := new(DistributionPooling)
+	*p = x
+	return p
+}
+
+func (x DistributionPooling) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DistributionPooling) Descriptor() protoreflect.EnumDescriptor {
+	return file_emissions_v1_topic_proto_enumTypes[3].Descriptor()
+}
+
+func (DistributionPooling) Type() protoreflect.EnumType {
+	return &file_emissions_v1_topic_proto_enumTypes[3]
+}
+
+func (x DistributionPooling) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DistributionPooling.Descriptor instead.
+func (DistributionPooling) EnumDescriptor() ([]byte, []int) {
+	return file_emissions_v1_topic_proto_rawDescGZIP(), []int{3}
+}
+
+// Selects how the losses reported by reputers are aggregated, weighting each by the reputer's stake,
+// into the consensus losses reputers are scored against
+type LossAggregatorType int32
+
+const (
+	LossAggregatorType_LOSS_AGGREGATOR_STAKE_WEIGHTED_MEAN   LossAggregatorType = 0
+	LossAggregatorType_LOSS_AGGREGATOR_STAKE_WEIGHTED_MEDIAN LossAggregatorType = 1
+	LossAggregatorType_LOSS_AGGREGATOR_TRIMMED_MEAN          LossAggregatorType = 2
+	LossAggregatorType_LOSS_AGGREGATOR_HUBER                 LossAggregatorType = 3
+)
+
+// Enum value maps for LossAggregatorType.
+var (
+	LossAggregatorType_name = map[int32]string{
+		0: "LOSS_AGGREGATOR_STAKE_WEIGHTED_MEAN",
+		1: "LOSS_AGGREGATOR_STAKE_WEIGHTED_MEDIAN",
+		2: "LOSS_AGGREGATOR_TRIMMED_MEAN",
+		3: "LOSS_AGGREGATOR_HUBER",
+	}
+	LossAggregatorType_value = map[string]int32{
+		"LOSS_AGGREGATOR_STAKE_WEIGHTED_MEAN":   0,
+		"LOSS_AGGREGATOR_STAKE_WEIGHTED_MEDIAN": 1,
+		"LOSS_AGGREGATOR_TRIMMED_MEAN":          2,
+		"LOSS_AGGREGATOR_HUBER":                 3,
+	}
+)
+
+func (x LossAggregatorType) Enum() *LossAggregatorType {
+	p := new(LossAggregatorType)
+	*p = x
+	return p
+}
+
+func (x LossAggregatorType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LossAggregatorType) Descriptor() protoreflect.EnumDescriptor {
+	return file_emissions_v1_topic_proto_enumTypes[4].Descriptor()
+}
+
+func (LossAggregatorType) Type() protoreflect.EnumType {
+	return &file_emissions_v1_topic_proto_enumTypes[4]
+}
+
+func (x LossAggregatorType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LossAggregatorType.Descriptor instead.
+func (LossAggregatorType) EnumDescriptor() ([]byte, []int) {
+	return file_emissions_v1_topic_proto_rawDescGZIP(), []int{4}
+}
+
 type Topic struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id              uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Creator         string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
-	Metadata        string `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	LossLogic       string `protobuf:"bytes,4,opt,name=loss_logic,json=lossLogic,proto3" json:"loss_logic,omitempty"`
-	LossMethod      string `protobuf:"bytes,5,opt,name=loss_method,json=lossMethod,proto3" json:"loss_method,omitempty"`
-	InferenceLogic  string `protobuf:"bytes,6,opt,name=inference_logic,json=inferenceLogic,proto3" json:"inference_logic,omitempty"`
-	InferenceMethod string `protobuf:"bytes,7,opt,name=inference_method,json=inferenceMethod,proto3" json:"inference_method,omitempty"`
-	EpochLastEnded  int64  `protobuf:"varint,8,opt,name=epoch_last_ended,json=epochLastEnded,proto3" json:"epoch_last_ended,omitempty"`
-	EpochLength     int64  `protobuf:"varint,9,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
-	GroundTruthLag  int64  `protobuf:"varint,10,opt,name=ground_truth_lag,json=groundTruthLag,proto3" json:"ground_truth_lag,omitempty"`
-	DefaultArg      string `protobuf:"bytes,11,opt,name=default_arg,json=defaultArg,proto3" json:"default_arg,omitempty"`
-	PNorm           string `protobuf:"bytes,12,opt,name=p_norm,json=pNorm,proto3" json:"p_norm,omitempty"`
-	AlphaRegret     string `protobuf:"bytes,13,opt,name=alpha_regret,json=alphaRegret,proto3" json:"alpha_regret,omitempty"`
-	AllowNegative   bool   `protobuf:"varint,14,opt,name=allow_negative,json=allowNegative,proto3" json:"allow_negative,omitempty"`
-	Epsilon         string `protobuf:"bytes,15,opt,name=epsilon,proto3" json:"epsilon,omitempty"`
-	InitialRegret   string `protobuf:"bytes,16,opt,name=initial_regret,json=initialRegret,proto3" json:"initial_regret,omitempty"`
+	Id              uint64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Creator         string             `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Metadata        string             `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	LossLogic       string             `protobuf:"bytes,4,opt,name=loss_logic,json=lossLogic,proto3" json:"loss_logic,omitempty"`
+	LossMethod      string             `protobuf:"bytes,5,opt,name=loss_method,json=lossMethod,proto3" json:"loss_method,omitempty"`
+	InferenceLogic  string             `protobuf:"bytes,6,opt,name=inference_logic,json=inferenceLogic,proto3" json:"inference_logic,omitempty"`
+	InferenceMethod string             `protobuf:"bytes,7,opt,name=inference_method,json=inferenceMethod,proto3" json:"inference_method,omitempty"`
+	EpochLastEnded  int64              `protobuf:"varint,8,opt,name=epoch_last_ended,json=epochLastEnded,proto3" json:"epoch_last_ended,omitempty"`
+	EpochLength     int64              `protobuf:"varint,9,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	GroundTruthLag  int64              `protobuf:"varint,10,opt,name=ground_truth_lag,json=groundTruthLag,proto3" json:"ground_truth_lag,omitempty"`
+	DefaultArg      string             `protobuf:"bytes,11,opt,name=default_arg,json=defaultArg,proto3" json:"default_arg,omitempty"`
+	PNorm           string             `protobuf:"bytes,12,opt,name=p_norm,json=pNorm,proto3" json:"p_norm,omitempty"`
+	AlphaRegret     string             `protobuf:"bytes,13,opt,name=alpha_regret,json=alphaRegret,proto3" json:"alpha_regret,omitempty"`
+	AllowNegative   bool               `protobuf:"varint,14,opt,name=allow_negative,json=allowNegative,proto3" json:"allow_negative,omitempty"`
+	Epsilon         string             `protobuf:"bytes,15,opt,name=epsilon,proto3" json:"epsilon,omitempty"`
+	InitialRegret   string             `protobuf:"bytes,16,opt,name=initial_regret,json=initialRegret,proto3" json:"initial_regret,omitempty"`
+	AllowlistMode   TopicAllowlistMode `protobuf:"varint,17,opt,name=allowlist_mode,json=allowlistMode,proto3,enum=emissions.v1.TopicAllowlistMode" json:"allowlist_mode,omitempty"`
+	// number of consecutive epochs without a submission after which a worker is deregistered, 0 disables
+	WorkerInactivityThreshold uint64 `protobuf:"varint,18,opt,name=worker_inactivity_threshold,json=workerInactivityThreshold,proto3" json:"worker_inactivity_threshold,omitempty"`
+	// number of consecutive epochs without a submission after which a reputer is deregistered, 0 disables
+	ReputerInactivityThreshold uint64 `protobuf:"varint,19,opt,name=reputer_inactivity_threshold,json=reputerInactivityThreshold,proto3" json:"reputer_inactivity_threshold,omitempty"`
+	// strategy used to combine inferences into network inferences
+	Combiner CombinerType `protobuf:"varint,20,opt,name=combiner,proto3,enum=emissions.v1.CombinerType" json:"combiner,omitempty"`
+	// number of components of the values inferred in the topic. 0 or 1 means the topic is scalar-valued and
+	// only the scalar `value` fields are used. Above 1, inferences carry a vector of exactly that many `values`
+	// and network inferences are synthesized component-wise
+	OutputDimension uint64          `protobuf:"varint,21,opt,name=output_dimension,json=outputDimension,proto3" json:"output_dimension,omitempty"`
+	OutputType      TopicOutputType `protobuf:"varint,22,opt,name=output_type,json=outputType,proto3,enum=emissions.v1.TopicOutputType" json:"output_type,omitempty"`
+	// only used by topics whose output type is a probability distribution
+	DistributionPooling DistributionPooling `protobuf:"varint,23,opt,name=distribution_pooling,json=distributionPooling,proto3,enum=emissions.v1.DistributionPooling" json:"distribution_pooling,omitempty"`
+	// ground truth lags, in blocks, of every horizon predicted in the topic, starting with ground_truth_lag.
+	// Empty means the topic has a single horizon whose lag is ground_truth_lag
+	Horizons []int64 `protobuf:"varint,24,rep,packed,name=horizons,proto3" json:"horizons,omitempty"`
+	// share of the topic reward paid for each horizon. Empty means every horizon is rewarded equally
+	HorizonWeights []string `protobuf:"bytes,25,rep,name=horizon_weights,json=horizonWeights,proto3" json:"horizon_weights,omitempty"`
+	// aggregator of the consensus losses reputers are scored against
+	LossAggregator LossAggregatorType `protobuf:"varint,26,opt,name=loss_aggregator,json=lossAggregator,proto3,enum=emissions.v1.LossAggregatorType" json:"loss_aggregator,omitempty"`
+	// fraction of the lowest and of the highest values dropped by the trimmed mean combiner, in [0, 0.5) and positive
+	// when that combiner is selected
+	TrimmedMeanTrimFraction string `protobuf:"bytes,27,opt,name=trimmed_mean_trim_fraction,json=trimmedMeanTrimFraction,proto3" json:"trimmed_mean_trim_fraction,omitempty"`
+	// fraction of the total stake trimmed from each end of the reported losses by the trimmed mean loss aggregator,
+	// in [0, 0.5) and positive when that aggregator is selected
+	LossTrimFraction string `protobuf:"bytes,28,opt,name=loss_trim_fraction,json=lossTrimFraction,proto3" json:"loss_trim_fraction,omitempty"`
+	// multiple of the scaled median absolute deviation beyond which the Huber loss aggregator downweights losses
+	HuberLossTuningConstant string `protobuf:"bytes,29,opt,name=huber_loss_tuning_constant,json=huberLossTuningConstant,proto3" json:"huber_loss_tuning_constant,omitempty"`
+	// maximum number of reweighting iterations of the Huber loss aggregator, at most 100
+	HuberLossMaxIterations uint64 `protobuf:"varint,30,opt,name=huber_loss_max_iterations,json=huberLossMaxIterations,proto3" json:"huber_loss_max_iterations,omitempty"`
 }
 
 func (x *Topic) Reset() {
@@ -2532,6 +3781,104 @@ func (x *Topic) GetInitialRegret() string {
 	return ""
 }
 
+func (x *Topic) GetAllowlistMode() TopicAllowlistMode {
+	if x != nil {
+		return x.AllowlistMode
+	}
+	return TopicAllowlistMode_ALLOWLIST_MODE_OPEN
+}
+
+func (x *Topic) GetWorkerInactivityThreshold() uint64 {
+	if x != nil {
+		return x.WorkerInactivityThreshold
+	}
+	return 0
+}
+
+func (x *Topic) GetReputerInactivityThreshold() uint64 {
+	if x != nil {
+		return x.ReputerInactivityThreshold
+	}
+	return 0
+}
+
+func (x *Topic) GetCombiner() CombinerType {
+	if x != nil {
+		return x.Combiner
+	}
+	return CombinerType_COMBINER_REGRET
+}
+
+func (x *Topic) GetOutputDimension() uint64 {
+	if x != nil {
+		return x.OutputDimension
+	}
+	return 0
+}
+
+func (x *Topic) GetOutputType() TopicOutputType {
+	if x != nil {
+		return x.OutputType
+	}
+	return TopicOutputType_OUTPUT_TYPE_REAL
+}
+
+func (x *Topic) GetDistributionPooling() DistributionPooling {
+	if x != nil {
+		return x.DistributionPooling
+	}
+	return DistributionPooling_POOLING_LINEAR
+}
+
+func (x *Topic) GetHorizons() []int64 {
+	if x != nil {
+		return x.Horizons
+	}
+	return nil
+}
+
+func (x *Topic) GetHorizonWeights() []string {
+	if x != nil {
+		return x.HorizonWeights
+	}
+	return nil
+}
+
+func (x *Topic) GetLossAggregator() LossAggregatorType {
+	if x != nil {
+		return x.LossAggregator
+	}
+	return LossAggregatorType_LOSS_AGGREGATOR_STAKE_WEIGHTED_MEAN
+}
+
+func (x *Topic) GetTrimmedMeanTrimFraction() string {
+	if x != nil {
+		return x.TrimmedMeanTrimFraction
+	}
+	return ""
+}
+
+func (x *Topic) GetLossTrimFraction() string {
+	if x != nil {
+		return x.LossTrimFraction
+	}
+	return ""
+}
+
+func (x *Topic) GetHuberLossTuningConstant() string {
+	if x != nil {
+		return x.HuberLossTuningConstant
+	}
+	return ""
+}
+
+func (x *Topic) GetHuberLossMaxIterations() uint64 {
+	if x != nil {
+		return x.HuberLossMaxIterations
+	}
+	return 0
+}
+
 type TopicList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2629,7 +3976,7 @@ var file_emissions_v1_topic_proto_rawDesc = []byte{
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x65, 0x6d,
 	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xff, 0x05, 0x0a, 0x05, 0x54, 0x6f, 0x70, 0x69, 0x63,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9a, 0x0e, 0x0a, 0x05, 0x54, 0x6f, 0x70, 0x69, 0x63,
 	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
 	0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65,
@@ -2677,32 +4024,134 @@ var file_emissions_v1_topic_proto_rawDesc = []byte{
 	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77,
 	0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e,
 	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x69,
-	0x61, 0x6c, 0x52, 0x65, 0x67, 0x72, 0x65, 0x74, 0x22, 0x38, 0x0a, 0x09, 0x54, 0x6f, 0x70, 0x69,
-	0x63, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69,
-	0x63, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x15, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x65, 0x64, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x29, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
-	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x42, 0xc0, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x65,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x54, 0x6f, 0x70,
-	0x69, 0x63, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x61, 0x6c, 0x52, 0x65, 0x67, 0x72, 0x65, 0x74, 0x12, 0x47, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x20, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x6f, 0x70, 0x69, 0x63, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x4d, 0x6f,
+	0x64, 0x65, 0x52, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x3e, 0x0a, 0x1b, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x52, 0x19, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x6e,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x12, 0x40, 0x0a, 0x1c, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1a, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72,
+	0x49, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x12, 0x36, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x72, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x15, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x44, 0x69, 0x6d,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x65, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x54, 0x0a, 0x14, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x17,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x6f, 0x6f, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x13, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08,
+	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x73, 0x18, 0x18, 0x20, 0x03, 0x28, 0x03, 0x52, 0x08,
+	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x73, 0x12, 0x60, 0x0a, 0x0f, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x6f, 0x6e, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x19, 0x20, 0x03, 0x28,
+	0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75,
 	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74,
 	0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x2f, 0x78, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x58, 0x58,
-	0xaa, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x56, 0x31, 0xca,
-	0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02,
-	0x18, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x45, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0e, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x6f, 0x6e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x49, 0x0a, 0x0f, 0x6c, 0x6f,
+	0x73, 0x73, 0x5f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x1a, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x73, 0x73, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x6f,
+	0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0e, 0x6c, 0x6f, 0x73, 0x73, 0x41, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x74, 0x0a, 0x1a, 0x74, 0x72, 0x69, 0x6d, 0x6d, 0x65, 0x64,
+	0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x5f, 0x74, 0x72, 0x69, 0x6d, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c,
+	0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c,
+	0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44,
+	0x65, 0x63, 0x52, 0x17, 0x74, 0x72, 0x69, 0x6d, 0x6d, 0x65, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x54,
+	0x72, 0x69, 0x6d, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x65, 0x0a, 0x12, 0x6c,
+	0x6f, 0x73, 0x73, 0x5f, 0x74, 0x72, 0x69, 0x6d, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f,
+	0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72,
+	0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63,
+	0x52, 0x10, 0x6c, 0x6f, 0x73, 0x73, 0x54, 0x72, 0x69, 0x6d, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x74, 0x0a, 0x1a, 0x68, 0x75, 0x62, 0x65, 0x72, 0x5f, 0x6c, 0x6f, 0x73, 0x73,
+	0x5f, 0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74,
+	0x18, 0x1d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72,
+	0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61,
+	0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52,
+	0x17, 0x68, 0x75, 0x62, 0x65, 0x72, 0x4c, 0x6f, 0x73, 0x73, 0x54, 0x75, 0x6e, 0x69, 0x6e, 0x67,
+	0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x19, 0x68, 0x75, 0x62, 0x65,
+	0x72, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x74, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x68, 0x75, 0x62,
+	0x65, 0x72, 0x4c, 0x6f, 0x73, 0x73, 0x4d, 0x61, 0x78, 0x49, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x38, 0x0a, 0x09, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x2b, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x22, 0x81, 0x01,
+	0x0a, 0x15, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x65, 0x64, 0x41, 0x63, 0x74,
+	0x6f, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x12, 0x29, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4e,
+	0x6f, 0x6e, 0x63, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f,
+	0x01, 0x2a, 0x8f, 0x01, 0x0a, 0x12, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
+	0x6c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x4c, 0x4c, 0x4f,
+	0x57, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10,
+	0x00, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x4d,
+	0x4f, 0x44, 0x45, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x45, 0x52, 0x53, 0x10, 0x01, 0x12, 0x1b, 0x0a,
+	0x17, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f,
+	0x52, 0x45, 0x50, 0x55, 0x54, 0x45, 0x52, 0x53, 0x10, 0x02, 0x12, 0x27, 0x0a, 0x23, 0x41, 0x4c,
+	0x4c, 0x4f, 0x57, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x57, 0x4f, 0x52,
+	0x4b, 0x45, 0x52, 0x53, 0x5f, 0x41, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x50, 0x55, 0x54, 0x45, 0x52,
+	0x53, 0x10, 0x03, 0x2a, 0x78, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x72, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x4f, 0x4d, 0x42, 0x49, 0x4e, 0x45, 0x52, 0x5f,
+	0x52, 0x45, 0x47, 0x52, 0x45, 0x54, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4f, 0x4d, 0x42,
+	0x49, 0x4e, 0x45, 0x52, 0x5f, 0x57, 0x45, 0x49, 0x47, 0x48, 0x54, 0x45, 0x44, 0x5f, 0x4d, 0x45,
+	0x44, 0x49, 0x41, 0x4e, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x4f, 0x4d, 0x42, 0x49, 0x4e,
+	0x45, 0x52, 0x5f, 0x54, 0x52, 0x49, 0x4d, 0x4d, 0x45, 0x44, 0x5f, 0x4d, 0x45, 0x41, 0x4e, 0x10,
+	0x02, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x4f, 0x4d, 0x42, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x53, 0x43,
+	0x4f, 0x52, 0x45, 0x5f, 0x53, 0x4f, 0x46, 0x54, 0x4d, 0x41, 0x58, 0x10, 0x03, 0x2a, 0x51, 0x0a,
+	0x0f, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x14, 0x0a, 0x10, 0x4f, 0x55, 0x54, 0x50, 0x55, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x52, 0x45, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x28, 0x0a, 0x24, 0x4f, 0x55, 0x54, 0x50, 0x55, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x42, 0x41, 0x42, 0x49, 0x4c, 0x49, 0x54,
+	0x59, 0x5f, 0x44, 0x49, 0x53, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01,
+	0x2a, 0x42, 0x0a, 0x13, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x6f, 0x6f, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x4f, 0x4c, 0x49,
+	0x4e, 0x47, 0x5f, 0x4c, 0x49, 0x4e, 0x45, 0x41, 0x52, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x50,
+	0x4f, 0x4f, 0x4c, 0x49, 0x4e, 0x47, 0x5f, 0x4c, 0x4f, 0x47, 0x41, 0x52, 0x49, 0x54, 0x48, 0x4d,
+	0x49, 0x43, 0x10, 0x01, 0x2a, 0xa5, 0x01, 0x0a, 0x12, 0x4c, 0x6f, 0x73, 0x73, 0x41, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x23, 0x4c,
+	0x4f, 0x53, 0x53, 0x5f, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x53,
+	0x54, 0x41, 0x4b, 0x45, 0x5f, 0x57, 0x45, 0x49, 0x47, 0x48, 0x54, 0x45, 0x44, 0x5f, 0x4d, 0x45,
+	0x41, 0x4e, 0x10, 0x00, 0x12, 0x29, 0x0a, 0x25, 0x4c, 0x4f, 0x53, 0x53, 0x5f, 0x41, 0x47, 0x47,
+	0x52, 0x45, 0x47, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x4b, 0x45, 0x5f, 0x57, 0x45,
+	0x49, 0x47, 0x48, 0x54, 0x45, 0x44, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x4e, 0x10, 0x01, 0x12,
+	0x20, 0x0a, 0x1c, 0x4c, 0x4f, 0x53, 0x53, 0x5f, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54,
+	0x4f, 0x52, 0x5f, 0x54, 0x52, 0x49, 0x4d, 0x4d, 0x45, 0x44, 0x5f, 0x4d, 0x45, 0x41, 0x4e, 0x10,
+	0x02, 0x12, 0x19, 0x0a, 0x15, 0x4c, 0x4f, 0x53, 0x53, 0x5f, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47,
+	0x41, 0x54, 0x4f, 0x52, 0x5f, 0x48, 0x55, 0x42, 0x45, 0x52, 0x10, 0x03, 0x42, 0xc0, 0x01, 0x0a,
+	0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76,
+	0x31, 0x42, 0x0a, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f,
+	0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72,
+	0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x76, 0x31,
+	0xa2, 0x02, 0x03, 0x45, 0x58, 0x58, 0xaa, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x18, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x0d, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2717,21 +4166,32 @@ func file_emissions_v1_topic_proto_rawDescGZIP() []byte {
 	return file_emissions_v1_topic_proto_rawDescData
 }
 
+var file_emissions_v1_topic_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
 var file_emissions_v1_topic_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_emissions_v1_topic_proto_goTypes = []interface{}{
-	(*Topic)(nil),                 // 0: emissions.v1.Topic
-	(*TopicList)(nil),             // 1: emissions.v1.TopicList
-	(*TimestampedActorNonce)(nil), // 2: emissions.v1.TimestampedActorNonce
-	(*Nonce)(nil),                 // 3: emissions.v1.Nonce
+	(TopicAllowlistMode)(0),       // 0: emissions.v1.TopicAllowlistMode
+	(CombinerType)(0),             // 1: emissions.v1.CombinerType
+	(TopicOutputType)(0),          // 2: emissions.v1.TopicOutputType
+	(DistributionPooling)(0),      // 3: emissions.v1.DistributionPooling
+	(LossAggregatorType)(0),       // 4: emissions.v1.LossAggregatorType
+	(*Topic)(nil),                 // 5: emissions.v1.Topic
+	(*TopicList)(nil),             // 6: emissions.v1.TopicList
+	(*TimestampedActorNonce)(nil), // 7: emissions.v1.TimestampedActorNonce
+	(*Nonce)(nil),                 // 8: emissions.v1.Nonce
 }
 var file_emissions_v1_topic_proto_depIdxs = []int32{
-	0, // 0: emissions.v1.TopicList.topics:type_name -> emissions.v1.Topic
-	3, // 1: emissions.v1.TimestampedActorNonce.nonce:type_name -> emissions.v1.Nonce
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	0, // 0: emissions.v1.Topic.allowlist_mode:type_name -> emissions.v1.TopicAllowlistMode
+	1, // 1: emissions.v1.Topic.combiner:type_name -> emissions.v1.CombinerType
+	2, // 2: emissions.v1.Topic.output_type:type_name -> emissions.v1.TopicOutputType
+	3, // 3: emissions.v1.Topic.distribution_pooling:type_name -> emissions.v1.DistributionPooling
+	4, // 4: emissions.v1.Topic.loss_aggregator:type_name -> emissions.v1.LossAggregatorType
+	5, // 5: emissions.v1.TopicList.topics:type_name -> emissions.v1.Topic
+	8, // 6: emissions.v1.TimestampedActorNonce.nonce:type_name -> emissions.v1.Nonce
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_emissions_v1_topic_proto_init() }
@@ -2783,13 +4243,14 @@ func file_emissions_v1_topic_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_emissions_v1_topic_proto_rawDesc,
-			NumEnums:      0,
+			NumEnums:      5,
 			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_emissions_v1_topic_proto_goTypes,
 		DependencyIndexes: file_emissions_v1_topic_proto_depIdxs,
+		EnumInfos:         file_emissions_v1_topic_proto_enumTypes,
 		MessageInfos:      file_emissions_v1_topic_proto_msgTypes,
 	}.Build()
 	File_emissions_v1_topic_proto = out.File
@@ -19,22 +19,31 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName              = "/emissions.v1.Msg/UpdateParams"
-	Msg_InsertBulkWorkerPayload_FullMethodName   = "/emissions.v1.Msg/InsertBulkWorkerPayload"
-	Msg_CreateNewTopic_FullMethodName            = "/emissions.v1.Msg/CreateNewTopic"
-	Msg_Register_FullMethodName                  = "/emissions.v1.Msg/Register"
-	Msg_RemoveRegistration_FullMethodName        = "/emissions.v1.Msg/RemoveRegistration"
-	Msg_InsertBulkReputerPayload_FullMethodName  = "/emissions.v1.Msg/InsertBulkReputerPayload"
-	Msg_AddStake_FullMethodName                  = "/emissions.v1.Msg/AddStake"
-	Msg_RemoveStake_FullMethodName               = "/emissions.v1.Msg/RemoveStake"
-	Msg_CancelRemoveStake_FullMethodName         = "/emissions.v1.Msg/CancelRemoveStake"
-	Msg_DelegateStake_FullMethodName             = "/emissions.v1.Msg/DelegateStake"
-	Msg_RewardDelegateStake_FullMethodName       = "/emissions.v1.Msg/RewardDelegateStake"
-	Msg_RemoveDelegateStake_FullMethodName       = "/emissions.v1.Msg/RemoveDelegateStake"
-	Msg_CancelRemoveDelegateStake_FullMethodName = "/emissions.v1.Msg/CancelRemoveDelegateStake"
-	Msg_FundTopic_FullMethodName                 = "/emissions.v1.Msg/FundTopic"
-	Msg_AddToWhitelistAdmin_FullMethodName       = "/emissions.v1.Msg/AddToWhitelistAdmin"
-	Msg_RemoveFromWhitelistAdmin_FullMethodName  = "/emissions.v1.Msg/RemoveFromWhitelistAdmin"
+	Msg_UpdateParams_FullMethodName                    = "/emissions.v1.Msg/UpdateParams"
+	Msg_InsertBulkWorkerPayload_FullMethodName         = "/emissions.v1.Msg/InsertBulkWorkerPayload"
+	Msg_CreateNewTopic_FullMethodName                  = "/emissions.v1.Msg/CreateNewTopic"
+	Msg_Register_FullMethodName                        = "/emissions.v1.Msg/Register"
+	Msg_RemoveRegistration_FullMethodName              = "/emissions.v1.Msg/RemoveRegistration"
+	Msg_RegisterMulti_FullMethodName                   = "/emissions.v1.Msg/RegisterMulti"
+	Msg_UpdateNodeInfo_FullMethodName                  = "/emissions.v1.Msg/UpdateNodeInfo"
+	Msg_InsertBulkReputerPayload_FullMethodName        = "/emissions.v1.Msg/InsertBulkReputerPayload"
+	Msg_AddStake_FullMethodName                        = "/emissions.v1.Msg/AddStake"
+	Msg_RemoveStake_FullMethodName                     = "/emissions.v1.Msg/RemoveStake"
+	Msg_CancelRemoveStake_FullMethodName               = "/emissions.v1.Msg/CancelRemoveStake"
+	Msg_DelegateStake_FullMethodName                   = "/emissions.v1.Msg/DelegateStake"
+	Msg_RewardDelegateStake_FullMethodName             = "/emissions.v1.Msg/RewardDelegateStake"
+	Msg_RemoveDelegateStake_FullMethodName             = "/emissions.v1.Msg/RemoveDelegateStake"
+	Msg_CancelRemoveDelegateStake_FullMethodName       = "/emissions.v1.Msg/CancelRemoveDelegateStake"
+	Msg_FundTopic_FullMethodName                       = "/emissions.v1.Msg/FundTopic"
+	Msg_AddToWhitelistAdmin_FullMethodName             = "/emissions.v1.Msg/AddToWhitelistAdmin"
+	Msg_RemoveFromWhitelistAdmin_FullMethodName        = "/emissions.v1.Msg/RemoveFromWhitelistAdmin"
+	Msg_GrantRole_FullMethodName                       = "/emissions.v1.Msg/GrantRole"
+	Msg_RevokeRole_FullMethodName                      = "/emissions.v1.Msg/RevokeRole"
+	Msg_UpdateTopicAllowlistMode_FullMethodName        = "/emissions.v1.Msg/UpdateTopicAllowlistMode"
+	Msg_AddToTopicAllowlist_FullMethodName             = "/emissions.v1.Msg/AddToTopicAllowlist"
+	Msg_RemoveFromTopicAllowlist_FullMethodName        = "/emissions.v1.Msg/RemoveFromTopicAllowlist"
+	Msg_UpdateTopicInactivityThresholds_FullMethodName = "/emissions.v1.Msg/UpdateTopicInactivityThresholds"
+	Msg_UpdateTopicLossAggregator_FullMethodName       = "/emissions.v1.Msg/UpdateTopicLossAggregator"
 )
 
 // MsgClient is the client API for Msg service.
@@ -46,6 +55,8 @@ type MsgClient interface {
 	CreateNewTopic(ctx context.Context, in *MsgCreateNewTopic, opts ...grpc.CallOption) (*MsgCreateNewTopicResponse, error)
 	Register(ctx context.Context, in *MsgRegister, opts ...grpc.CallOption) (*MsgRegisterResponse, error)
 	RemoveRegistration(ctx context.Context, in *MsgRemoveRegistration, opts ...grpc.CallOption) (*MsgRemoveRegistrationResponse, error)
+	RegisterMulti(ctx context.Context, in *MsgRegisterMulti, opts ...grpc.CallOption) (*MsgRegisterMultiResponse, error)
+	UpdateNodeInfo(ctx context.Context, in *MsgUpdateNodeInfo, opts ...grpc.CallOption) (*MsgUpdateNodeInfoResponse, error)
 	InsertBulkReputerPayload(ctx context.Context, in *MsgInsertBulkReputerPayload, opts ...grpc.CallOption) (*MsgInsertBulkReputerPayloadResponse, error)
 	AddStake(ctx context.Context, in *MsgAddStake, opts ...grpc.CallOption) (*MsgAddStakeResponse, error)
 	RemoveStake(ctx context.Context, in *MsgRemoveStake, opts ...grpc.CallOption) (*MsgRemoveStakeResponse, error)
@@ -57,6 +68,13 @@ type MsgClient interface {
 	FundTopic(ctx context.Context, in *MsgFundTopic, opts ...grpc.CallOption) (*MsgFundTopicResponse, error)
 	AddToWhitelistAdmin(ctx context.Context, in *MsgAddToWhitelistAdmin, opts ...grpc.CallOption) (*MsgAddToWhitelistAdminResponse, error)
 	RemoveFromWhitelistAdmin(ctx context.Context, in *MsgRemoveFromWhitelistAdmin, opts ...grpc.CallOption) (*MsgRemoveFromWhitelistAdminResponse, error)
+	GrantRole(ctx context.Context, in *MsgGrantRole, opts ...grpc.CallOption) (*MsgGrantRoleResponse, error)
+	RevokeRole(ctx context.Context, in *MsgRevokeRole, opts ...grpc.CallOption) (*MsgRevokeRoleResponse, error)
+	UpdateTopicAllowlistMode(ctx context.Context, in *MsgUpdateTopicAllowlistMode, opts ...grpc.CallOption) (*MsgUpdateTopicAllowlistModeResponse, error)
+	AddToTopicAllowlist(ctx context.Context, in *MsgAddToTopicAllowlist, opts ...grpc.CallOption) (*MsgAddToTopicAllowlistResponse, error)
+	RemoveFromTopicAllowlist(ctx context.Context, in *MsgRemoveFromTopicAllowlist, opts ...grpc.CallOption) (*MsgRemoveFromTopicAllowlistResponse, error)
+	UpdateTopicInactivityThresholds(ctx context.Context, in *MsgUpdateTopicInactivityThresholds, opts ...grpc.CallOption) (*MsgUpdateTopicInactivityThresholdsResponse, error)
+	UpdateTopicLossAggregator(ctx context.Context, in *MsgUpdateTopicLossAggregator, opts ...grpc.CallOption) (*MsgUpdateTopicLossAggregatorResponse, error)
 }
 
 type msgClient struct {
@@ -112,6 +130,24 @@ func (c *msgClient) RemoveRegistration(ctx context.Context, in *MsgRemoveRegistr
 	return out, nil
 }
 
+func (c *msgClient) RegisterMulti(ctx context.Context, in *MsgRegisterMulti, opts ...grpc.CallOption) (*MsgRegisterMultiResponse, error) {
+	out := new(MsgRegisterMultiResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterMulti_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateNodeInfo(ctx context.Context, in *MsgUpdateNodeInfo, opts ...grpc.CallOption) (*MsgUpdateNodeInfoResponse, error) {
+	out := new(MsgUpdateNodeInfoResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateNodeInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) InsertBulkReputerPayload(ctx context.Context, in *MsgInsertBulkReputerPayload, opts ...grpc.CallOption) (*MsgInsertBulkReputerPayloadResponse, error) {
 	out := new(MsgInsertBulkReputerPayloadResponse)
 	err := c.cc.Invoke(ctx, Msg_InsertBulkReputerPayload_FullMethodName, in, out, opts...)
@@ -211,6 +247,69 @@ func (c *msgClient) RemoveFromWhitelistAdmin(ctx context.Context, in *MsgRemoveF
 	return out, nil
 }
 
+func (c *msgClient) GrantRole(ctx context.Context, in *MsgGrantRole, opts ...grpc.CallOption) (*MsgGrantRoleResponse, error) {
+	out := new(MsgGrantRoleResponse)
+	err := c.cc.Invoke(ctx, Msg_GrantRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeRole(ctx context.Context, in *MsgRevokeRole, opts ...grpc.CallOption) (*MsgRevokeRoleResponse, error) {
+	out := new(MsgRevokeRoleResponse)
+	err := c.cc.Invoke(ctx, Msg_RevokeRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateTopicAllowlistMode(ctx context.Context, in *MsgUpdateTopicAllowlistMode, opts ...grpc.CallOption) (*MsgUpdateTopicAllowlistModeResponse, error) {
+	out := new(MsgUpdateTopicAllowlistModeResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateTopicAllowlistMode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddToTopicAllowlist(ctx context.Context, in *MsgAddToTopicAllowlist, opts ...grpc.CallOption) (*MsgAddToTopicAllowlistResponse, error) {
+	out := new(MsgAddToTopicAllowlistResponse)
+	err := c.cc.Invoke(ctx, Msg_AddToTopicAllowlist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveFromTopicAllowlist(ctx context.Context, in *MsgRemoveFromTopicAllowlist, opts ...grpc.CallOption) (*MsgRemoveFromTopicAllowlistResponse, error) {
+	out := new(MsgRemoveFromTopicAllowlistResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveFromTopicAllowlist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateTopicInactivityThresholds(ctx context.Context, in *MsgUpdateTopicInactivityThresholds, opts ...grpc.CallOption) (*MsgUpdateTopicInactivityThresholdsResponse, error) {
+	out := new(MsgUpdateTopicInactivityThresholdsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateTopicInactivityThresholds_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateTopicLossAggregator(ctx context.Context, in *MsgUpdateTopicLossAggregator, opts ...grpc.CallOption) (*MsgUpdateTopicLossAggregatorResponse, error) {
+	out := new(MsgUpdateTopicLossAggregatorResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateTopicLossAggregator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -220,6 +319,8 @@ type MsgServer interface {
 	CreateNewTopic(context.Context, *MsgCreateNewTopic) (*MsgCreateNewTopicResponse, error)
 	Register(context.Context, *MsgRegister) (*MsgRegisterResponse, error)
 	RemoveRegistration(context.Context, *MsgRemoveRegistration) (*MsgRemoveRegistrationResponse, error)
+	RegisterMulti(context.Context, *MsgRegisterMulti) (*MsgRegisterMultiResponse, error)
+	UpdateNodeInfo(context.Context, *MsgUpdateNodeInfo) (*MsgUpdateNodeInfoResponse, error)
 	InsertBulkReputerPayload(context.Context, *MsgInsertBulkReputerPayload) (*MsgInsertBulkReputerPayloadResponse, error)
 	AddStake(context.Context, *MsgAddStake) (*MsgAddStakeResponse, error)
 	RemoveStake(context.Context, *MsgRemoveStake) (*MsgRemoveStakeResponse, error)
@@ -231,6 +332,13 @@ type MsgServer interface {
 	FundTopic(context.Context, *MsgFundTopic) (*MsgFundTopicResponse, error)
 	AddToWhitelistAdmin(context.Context, *MsgAddToWhitelistAdmin) (*MsgAddToWhitelistAdminResponse, error)
 	RemoveFromWhitelistAdmin(context.Context, *MsgRemoveFromWhitelistAdmin) (*MsgRemoveFromWhitelistAdminResponse, error)
+	GrantRole(context.Context, *MsgGrantRole) (*MsgGrantRoleResponse, error)
+	RevokeRole(context.Context, *MsgRevokeRole) (*MsgRevokeRoleResponse, error)
+	UpdateTopicAllowlistMode(context.Context, *MsgUpdateTopicAllowlistMode) (*MsgUpdateTopicAllowlistModeResponse, error)
+	AddToTopicAllowlist(context.Context, *MsgAddToTopicAllowlist) (*MsgAddToTopicAllowlistResponse, error)
+	RemoveFromTopicAllowlist(context.Context, *MsgRemoveFromTopicAllowlist) (*MsgRemoveFromTopicAllowlistResponse, error)
+	UpdateTopicInactivityThresholds(context.Context, *MsgUpdateTopicInactivityThresholds) (*MsgUpdateTopicInactivityThresholdsResponse, error)
+	UpdateTopicLossAggregator(context.Context, *MsgUpdateTopicLossAggregator) (*MsgUpdateTopicLossAggregatorResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -253,6 +361,12 @@ func (UnimplementedMsgServer) Register(context.Context, *MsgRegister) (*MsgRegis
 func (UnimplementedMsgServer) RemoveRegistration(context.Context, *MsgRemoveRegistration) (*MsgRemoveRegistrationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveRegistration not implemented")
 }
+func (UnimplementedMsgServer) RegisterMulti(context.Context, *MsgRegisterMulti) (*MsgRegisterMultiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterMulti not implemented")
+}
+func (UnimplementedMsgServer) UpdateNodeInfo(context.Context, *MsgUpdateNodeInfo) (*MsgUpdateNodeInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNodeInfo not implemented")
+}
 func (UnimplementedMsgServer) InsertBulkReputerPayload(context.Context, *MsgInsertBulkReputerPayload) (*MsgInsertBulkReputerPayloadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InsertBulkReputerPayload not implemented")
 }
@@ -286,6 +400,27 @@ func (UnimplementedMsgServer) AddToWhitelistAdmin(context.Context, *MsgAddToWhit
 func (UnimplementedMsgServer) RemoveFromWhitelistAdmin(context.Context, *MsgRemoveFromWhitelistAdmin) (*MsgRemoveFromWhitelistAdminResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromWhitelistAdmin not implemented")
 }
+func (UnimplementedMsgServer) GrantRole(context.Context, *MsgGrantRole) (*MsgGrantRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantRole not implemented")
+}
+func (UnimplementedMsgServer) RevokeRole(context.Context, *MsgRevokeRole) (*MsgRevokeRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeRole not implemented")
+}
+func (UnimplementedMsgServer) UpdateTopicAllowlistMode(context.Context, *MsgUpdateTopicAllowlistMode) (*MsgUpdateTopicAllowlistModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTopicAllowlistMode not implemented")
+}
+func (UnimplementedMsgServer) AddToTopicAllowlist(context.Context, *MsgAddToTopicAllowlist) (*MsgAddToTopicAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToTopicAllowlist not implemented")
+}
+func (UnimplementedMsgServer) RemoveFromTopicAllowlist(context.Context, *MsgRemoveFromTopicAllowlist) (*MsgRemoveFromTopicAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromTopicAllowlist not implemented")
+}
+func (UnimplementedMsgServer) UpdateTopicInactivityThresholds(context.Context, *MsgUpdateTopicInactivityThresholds) (*MsgUpdateTopicInactivityThresholdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTopicInactivityThresholds not implemented")
+}
+func (UnimplementedMsgServer) UpdateTopicLossAggregator(context.Context, *MsgUpdateTopicLossAggregator) (*MsgUpdateTopicLossAggregatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTopicLossAggregator not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -389,6 +524,42 @@ func _Msg_RemoveRegistration_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RegisterMulti_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterMulti)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterMulti(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterMulti_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterMulti(ctx, req.(*MsgRegisterMulti))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateNodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateNodeInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateNodeInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateNodeInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateNodeInfo(ctx, req.(*MsgUpdateNodeInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_InsertBulkReputerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgInsertBulkReputerPayload)
 	if err := dec(in); err != nil {
@@ -587,6 +758,132 @@ func _Msg_RemoveFromWhitelistAdmin_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_GrantRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_GrantRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantRole(ctx, req.(*MsgGrantRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevokeRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeRole(ctx, req.(*MsgRevokeRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateTopicAllowlistMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTopicAllowlistMode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTopicAllowlistMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateTopicAllowlistMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTopicAllowlistMode(ctx, req.(*MsgUpdateTopicAllowlistMode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddToTopicAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddToTopicAllowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddToTopicAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddToTopicAllowlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddToTopicAllowlist(ctx, req.(*MsgAddToTopicAllowlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveFromTopicAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveFromTopicAllowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveFromTopicAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveFromTopicAllowlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveFromTopicAllowlist(ctx, req.(*MsgRemoveFromTopicAllowlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateTopicInactivityThresholds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTopicInactivityThresholds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTopicInactivityThresholds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateTopicInactivityThresholds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTopicInactivityThresholds(ctx, req.(*MsgUpdateTopicInactivityThresholds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateTopicLossAggregator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTopicLossAggregator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTopicLossAggregator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateTopicLossAggregator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTopicLossAggregator(ctx, req.(*MsgUpdateTopicLossAggregator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -614,6 +911,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveRegistration",
 			Handler:    _Msg_RemoveRegistration_Handler,
 		},
+		{
+			MethodName: "RegisterMulti",
+			Handler:    _Msg_RegisterMulti_Handler,
+		},
+		{
+			MethodName: "UpdateNodeInfo",
+			Handler:    _Msg_UpdateNodeInfo_Handler,
+		},
 		{
 			MethodName: "InsertBulkReputerPayload",
 			Handler:    _Msg_InsertBulkReputerPayload_Handler,
@@ -658,6 +963,34 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveFromWhitelistAdmin",
 			Handler:    _Msg_RemoveFromWhitelistAdmin_Handler,
 		},
+		{
+			MethodName: "GrantRole",
+			Handler:    _Msg_GrantRole_Handler,
+		},
+		{
+			MethodName: "RevokeRole",
+			Handler:    _Msg_RevokeRole_Handler,
+		},
+		{
+			MethodName: "UpdateTopicAllowlistMode",
+			Handler:    _Msg_UpdateTopicAllowlistMode_Handler,
+		},
+		{
+			MethodName: "AddToTopicAllowlist",
+			Handler:    _Msg_AddToTopicAllowlist_Handler,
+		},
+		{
+			MethodName: "RemoveFromTopicAllowlist",
+			Handler:    _Msg_RemoveFromTopicAllowlist_Handler,
+		},
+		{
+			MethodName: "UpdateTopicInactivityThresholds",
+			Handler:    _Msg_UpdateTopicInactivityThresholds_Handler,
+		},
+		{
+			MethodName: "UpdateTopicLossAggregator",
+			Handler:    _Msg_UpdateTopicLossAggregator_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "emissions/v1/tx.proto",
@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+	minttypes "github.com/allora-network/allora-chain/x/mint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// returns the address of a module account and its balance of the bond denom
+func (k *Keeper) GetModuleAccountBalance(ctx context.Context, accountName string) (sdk.AccAddress, cosmosMath.Int) {
+	addr := k.authKeeper.GetModuleAccount(ctx, accountName).GetAddress()
+	return addr, k.GetBankBalance(ctx, addr, params.DefaultBondDenom).Amount
+}
+
+// returns the sum of the fee revenue of every topic that has not dripped yet
+func (k *Keeper) GetTotalTopicFeeRevenue(ctx context.Context) (cosmosMath.Int, error) {
+	iter, err := k.topicFeeRevenue.Iterate(ctx, nil)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	revenues, err := iter.Values()
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	total := cosmosMath.ZeroInt()
+	for _, revenue := range revenues {
+		total = total.Add(revenue)
+	}
+	return total, nil
+}
+
+// returns the rewards owed to delegators that they have not claimed yet, i.e. for every reputer of every topic
+// the stake delegated to it times its accumulated reward per share, minus the reward debt of its delegators.
+// This is what the pending reward for delegator account should hold
+func (k *Keeper) GetAccumulatedPendingRewardForDelegators(ctx context.Context) (alloraMath.Dec, error) {
+	// get the sum of all accumulated debts that happend to be staked upon a reputer
+	iter, err := k.delegatedStakes.Iterate(ctx, nil)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	defer iter.Close()
+	type TopicAndReputer struct {
+		topicId uint64
+		reputer string
+	}
+	rewardDebtSums := make(map[TopicAndReputer]alloraMath.Dec)
+	reputerStakeSums := make(map[TopicAndReputer]alloraMath.Dec)
+	for ; iter.Valid(); iter.Next() {
+		keyValue, err := iter.KeyValue()
+		if err != nil {
+			return alloraMath.Dec{}, err
+		}
+		topicAndReputer := TopicAndReputer{topicId: keyValue.Key.K1(), reputer: keyValue.Key.K3()}
+		rewardDebtSum, has := rewardDebtSums[topicAndReputer]
+		if !has {
+			rewardDebtSum = alloraMath.ZeroDec()
+		}
+		rewardDebtSums[topicAndReputer], err = rewardDebtSum.Add(keyValue.Value.RewardDebt)
+		if err != nil {
+			return alloraMath.Dec{}, err
+		}
+		delegatedStakeSum, has := reputerStakeSums[topicAndReputer]
+		if !has {
+			delegatedStakeSum = alloraMath.ZeroDec()
+		}
+		reputerStakeSums[topicAndReputer], err = delegatedStakeSum.Add(keyValue.Value.Amount)
+		if err != nil {
+			return alloraMath.Dec{}, err
+		}
+	}
+	// now for each reputer and topic, get the accumulated reward per share
+	// then multiply it by the stake sum of the reputer
+	iter2, err := k.delegateRewardPerShare.Iterate(ctx, nil)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	defer iter2.Close()
+	accumulatedRewardsBeyondRewardDebt := alloraMath.ZeroDec()
+	for ; iter2.Valid(); iter2.Next() {
+		keyValue, err := iter2.KeyValue()
+		if err != nil {
+			return alloraMath.Dec{}, err
+		}
+		topicAndReputer := TopicAndReputer{topicId: keyValue.Key.K1(), reputer: keyValue.Key.K2()}
+		stakeSum, has := reputerStakeSums[topicAndReputer]
+		if !has {
+			stakeSum = alloraMath.ZeroDec()
+		}
+		rewardDebt, has := rewardDebtSums[topicAndReputer]
+		if !has {
+			rewardDebt = alloraMath.ZeroDec()
+		}
+		stakeTimesRewardPerShare, err := stakeSum.Mul(keyValue.Value)
+		if err != nil {
+			return alloraMath.Dec{}, err
+		}
+		stakeTimeRewardPerShareMinusRewardDebt, err := stakeTimesRewardPerShare.Sub(rewardDebt)
+		if err != nil {
+			return alloraMath.Dec{}, err
+		}
+		if stakeTimeRewardPerShareMinusRewardDebt.IsNegative() {
+			return alloraMath.Dec{}, fmt.Errorf(
+				"reward per share minus reward debt negative: stakeSum: %s, rewardPerShare: %s, rewardDebt: %s",
+				stakeSum.String(), keyValue.Value.String(), rewardDebt.String(),
+			)
+		}
+		accumulatedRewardsBeyondRewardDebt, err = accumulatedRewardsBeyondRewardDebt.Add(stakeTimeRewardPerShareMinusRewardDebt)
+		if err != nil {
+			return alloraMath.Dec{}, err
+		}
+	}
+	return accumulatedRewardsBeyondRewardDebt, nil
+}
+
+// returns the bank balance of every module account holding emissions related funds next to
+// the balance the keeper bookkeeping expects it to hold, and the discrepancy between the two.
+// The ecosystem and rewards accounts are reported without an expected balance, as their
+// balances are not tracked by the bookkeeping
+func (k *Keeper) GetModuleAccounting(ctx context.Context) ([]emissionstypes.ModuleAccountAccounting, error) {
+	totalStake, err := k.GetTotalStake(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pendingRewardForDelegators, err := k.GetAccumulatedPendingRewardForDelegators(ctx)
+	if err != nil {
+		return nil, err
+	}
+	expectedBalances := []struct {
+		accountName        string
+		hasExpectedBalance bool
+		expected           cosmosMath.Int
+	}{
+		{minttypes.EcosystemModuleName, false, cosmosMath.ZeroInt()},
+		{minttypes.ModuleName, true, cosmosMath.ZeroInt()},
+		{emissionstypes.AlloraRewardsAccountName, false, cosmosMath.ZeroInt()},
+		{emissionstypes.AlloraStakingAccountName, true, totalStake},
+		{emissionstypes.AlloraPendingRewardForDelegatorAccountName, true, pendingRewardForDelegators.SdkIntTrim()},
+	}
+	accounting := make([]emissionstypes.ModuleAccountAccounting, 0, len(expectedBalances))
+	for _, account := range expectedBalances {
+		addr, balance := k.GetModuleAccountBalance(ctx, account.accountName)
+		discrepancy := cosmosMath.ZeroInt()
+		if account.hasExpectedBalance {
+			discrepancy = balance.Sub(account.expected)
+		}
+		accounting = append(accounting, emissionstypes.ModuleAccountAccounting{
+			AccountName:        account.accountName,
+			Address:            addr.String(),
+			BankBalance:        balance,
+			ExpectedBalance:    account.expected,
+			Discrepancy:        discrepancy,
+			HasExpectedBalance: account.hasExpectedBalance,
+		})
+	}
+	return accounting, nil
+}
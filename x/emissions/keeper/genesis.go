@@ -432,6 +432,10 @@ func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) erro
 		}
 
 	}
+	// The by-actor indexes of registrations and node entries are not exported, they are rebuilt
+	if err := k.IndexRegistrations(ctx); err != nil {
+		return errors.Wrap(err, "error indexing registrations")
+	}
 
 	// TopicFeeRevenue []*TopicIdAndInt
 	if len(data.TopicFeeRevenue) != 0 {
@@ -581,6 +585,16 @@ func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) erro
 			return errors.Wrap(err, "error adding core team addresses to whitelists")
 		}
 	}
+	//ActorRoles []*ActorIdAndRole
+	if len(data.ActorRoles) != 0 {
+		for _, actorIdAndRole := range data.ActorRoles {
+			if actorIdAndRole != nil {
+				if err := k.GrantRole(ctx, actorIdAndRole.ActorId, actorIdAndRole.Role); err != nil {
+					return errors.Wrap(err, "error setting actorRoles")
+				}
+			}
+		}
+	}
 	//TopicLastWorkerCommit   []*TopicIdTimestampedActorNonce
 	if len(data.TopicLastWorkerCommit) != 0 {
 		for _, topicIdTimestampedActorNonce := range data.TopicLastWorkerCommit {
@@ -612,6 +626,223 @@ func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) erro
 			}
 		}
 	}
+	//TopicWorkerAllowlist []*TopicAndActorId
+	if len(data.TopicWorkerAllowlist) != 0 {
+		for _, topicAndActorId := range data.TopicWorkerAllowlist {
+			if topicAndActorId != nil {
+				if err := k.topicWorkerAllowlist.Set(ctx, collections.Join(topicAndActorId.TopicId, topicAndActorId.ActorId)); err != nil {
+					return errors.Wrap(err, "error setting topicWorkerAllowlist")
+				}
+			}
+		}
+	}
+	//TopicReputerAllowlist []*TopicAndActorId
+	if len(data.TopicReputerAllowlist) != 0 {
+		for _, topicAndActorId := range data.TopicReputerAllowlist {
+			if topicAndActorId != nil {
+				if err := k.topicReputerAllowlist.Set(ctx, collections.Join(topicAndActorId.TopicId, topicAndActorId.ActorId)); err != nil {
+					return errors.Wrap(err, "error setting topicReputerAllowlist")
+				}
+			}
+		}
+	}
+	//WorkerLastActiveHeight []*ActorIdTopicIdBlockHeight
+	if len(data.WorkerLastActiveHeight) != 0 {
+		for _, actorIdTopicIdBlockHeight := range data.WorkerLastActiveHeight {
+			if actorIdTopicIdBlockHeight != nil {
+				if err := k.workerLastActiveHeight.Set(ctx,
+					collections.Join(actorIdTopicIdBlockHeight.TopicId, actorIdTopicIdBlockHeight.ActorId),
+					actorIdTopicIdBlockHeight.BlockHeight); err != nil {
+					return errors.Wrap(err, "error setting workerLastActiveHeight")
+				}
+			}
+		}
+	}
+	//ReputerLastActiveHeight []*ActorIdTopicIdBlockHeight
+	if len(data.ReputerLastActiveHeight) != 0 {
+		for _, actorIdTopicIdBlockHeight := range data.ReputerLastActiveHeight {
+			if actorIdTopicIdBlockHeight != nil {
+				if err := k.reputerLastActiveHeight.Set(ctx,
+					collections.Join(actorIdTopicIdBlockHeight.TopicId, actorIdTopicIdBlockHeight.ActorId),
+					actorIdTopicIdBlockHeight.BlockHeight); err != nil {
+					return errors.Wrap(err, "error setting reputerLastActiveHeight")
+				}
+			}
+		}
+	}
+	//TopicHorizonRewardNonce []*TopicIdHorizonBlockHeight
+	if len(data.TopicHorizonRewardNonce) != 0 {
+		for _, topicIdHorizonBlockHeight := range data.TopicHorizonRewardNonce {
+			if topicIdHorizonBlockHeight != nil {
+				if err := k.topicHorizonRewardNonce.Set(ctx,
+					collections.Join(topicIdHorizonBlockHeight.TopicId, topicIdHorizonBlockHeight.Horizon),
+					topicIdHorizonBlockHeight.BlockHeight); err != nil {
+					return errors.Wrap(err, "error setting topicHorizonRewardNonce")
+				}
+			}
+		}
+	}
+	//HorizonLastReputerPayload []*TopicIdHorizonTimestampedActorNonce
+	if len(data.HorizonLastReputerPayload) != 0 {
+		for _, topicIdHorizonTimestampedActorNonce := range data.HorizonLastReputerPayload {
+			if topicIdHorizonTimestampedActorNonce != nil && topicIdHorizonTimestampedActorNonce.TimestampedActorNonce != nil {
+				if err := k.horizonLastReputerPayload.Set(ctx,
+					collections.Join(topicIdHorizonTimestampedActorNonce.TopicId, topicIdHorizonTimestampedActorNonce.Horizon),
+					*topicIdHorizonTimestampedActorNonce.TimestampedActorNonce); err != nil {
+					return errors.Wrap(err, "error setting horizonLastReputerPayload")
+				}
+			}
+		}
+	}
+	//HorizonLossBundles []*TopicIdHorizonBlockHeightReputerValueBundles
+	if len(data.HorizonLossBundles) != 0 {
+		for _, topicIdHorizonBlockHeightReputerValueBundles := range data.HorizonLossBundles {
+			if topicIdHorizonBlockHeightReputerValueBundles != nil && topicIdHorizonBlockHeightReputerValueBundles.ReputerValueBundles != nil {
+				if err := k.horizonLossBundles.Set(ctx,
+					collections.Join(
+						collections.Join(topicIdHorizonBlockHeightReputerValueBundles.TopicId, topicIdHorizonBlockHeightReputerValueBundles.Horizon),
+						topicIdHorizonBlockHeightReputerValueBundles.BlockHeight),
+					*topicIdHorizonBlockHeightReputerValueBundles.ReputerValueBundles); err != nil {
+					return errors.Wrap(err, "error setting horizonLossBundles")
+				}
+			}
+		}
+	}
+	//HorizonNetworkLossBundles []*TopicIdHorizonBlockHeightValueBundles
+	if len(data.HorizonNetworkLossBundles) != 0 {
+		for _, topicIdHorizonBlockHeightValueBundles := range data.HorizonNetworkLossBundles {
+			if topicIdHorizonBlockHeightValueBundles != nil && topicIdHorizonBlockHeightValueBundles.ValueBundle != nil {
+				if err := k.horizonNetworkLossBundles.Set(ctx,
+					collections.Join(
+						collections.Join(topicIdHorizonBlockHeightValueBundles.TopicId, topicIdHorizonBlockHeightValueBundles.Horizon),
+						topicIdHorizonBlockHeightValueBundles.BlockHeight),
+					*topicIdHorizonBlockHeightValueBundles.ValueBundle); err != nil {
+					return errors.Wrap(err, "error setting horizonNetworkLossBundles")
+				}
+			}
+		}
+	}
+	//HorizonInfererScoresByBlock []*TopicIdHorizonBlockHeightScores
+	if err := importHorizonScoresByBlock(ctx, k.horizonInfererScoresByBlock, data.HorizonInfererScoresByBlock); err != nil {
+		return errors.Wrap(err, "error setting horizonInfererScoresByBlock")
+	}
+	//HorizonForecasterScoresByBlock []*TopicIdHorizonBlockHeightScores
+	if err := importHorizonScoresByBlock(ctx, k.horizonForecasterScoresByBlock, data.HorizonForecasterScoresByBlock); err != nil {
+		return errors.Wrap(err, "error setting horizonForecasterScoresByBlock")
+	}
+	//HorizonReputerScoresByBlock []*TopicIdHorizonBlockHeightScores
+	if err := importHorizonScoresByBlock(ctx, k.horizonReputerScoresByBlock, data.HorizonReputerScoresByBlock); err != nil {
+		return errors.Wrap(err, "error setting horizonReputerScoresByBlock")
+	}
+	//NetworkInferenceWeights []*TopicIdBlockHeightNetworkInferenceWeights
+	if len(data.NetworkInferenceWeights) != 0 {
+		for _, topicIdBlockHeightNetworkInferenceWeights := range data.NetworkInferenceWeights {
+			if topicIdBlockHeightNetworkInferenceWeights != nil && topicIdBlockHeightNetworkInferenceWeights.Weights != nil {
+				if err := k.networkInferenceWeights.Set(ctx,
+					collections.Join(topicIdBlockHeightNetworkInferenceWeights.TopicId, topicIdBlockHeightNetworkInferenceWeights.BlockHeight),
+					*topicIdBlockHeightNetworkInferenceWeights.Weights); err != nil {
+					return errors.Wrap(err, "error setting networkInferenceWeights")
+				}
+			}
+		}
+	}
+	//RewardBreakdowns []*TopicIdBlockHeightRewardBreakdowns
+	if len(data.RewardBreakdowns) != 0 {
+		for _, topicIdBlockHeightRewardBreakdowns := range data.RewardBreakdowns {
+			if topicIdBlockHeightRewardBreakdowns != nil && topicIdBlockHeightRewardBreakdowns.RewardBreakdowns != nil {
+				if err := k.rewardBreakdowns.Set(ctx,
+					collections.Join(topicIdBlockHeightRewardBreakdowns.TopicId, topicIdBlockHeightRewardBreakdowns.BlockHeight),
+					*topicIdBlockHeightRewardBreakdowns.RewardBreakdowns); err != nil {
+					return errors.Wrap(err, "error setting rewardBreakdowns")
+				}
+			}
+		}
+	}
+	//InfererScoreHistory []*Score
+	for _, score := range data.InfererScoreHistory {
+		if score != nil {
+			if err := k.infererScoreHistory.Set(ctx, collections.Join3(score.TopicId, score.Address, score.BlockHeight), *score); err != nil {
+				return errors.Wrap(err, "error setting infererScoreHistory")
+			}
+		}
+	}
+	//ForecasterScoreHistory []*Score
+	for _, score := range data.ForecasterScoreHistory {
+		if score != nil {
+			if err := k.forecasterScoreHistory.Set(ctx, collections.Join3(score.TopicId, score.Address, score.BlockHeight), *score); err != nil {
+				return errors.Wrap(err, "error setting forecasterScoreHistory")
+			}
+		}
+	}
+	//ReputerScoreHistory []*Score
+	for _, score := range data.ReputerScoreHistory {
+		if score != nil {
+			if err := k.reputerScoreHistory.Set(ctx, collections.Join3(score.TopicId, score.Address, score.BlockHeight), *score); err != nil {
+				return errors.Wrap(err, "error setting reputerScoreHistory")
+			}
+		}
+	}
+	//RewardHistory []*PaidReward
+	for _, reward := range data.RewardHistory {
+		if reward != nil {
+			key := collections.Join3(reward.Address, reward.BlockHeight, reward.TopicId)
+			rewards, err := k.rewardHistory.Get(ctx, key)
+			if err != nil {
+				if !errors.IsOf(err, collections.ErrNotFound) {
+					return errors.Wrap(err, "error getting rewardHistory")
+				}
+				rewards = types.PaidRewards{}
+			}
+			rewards.Rewards = append(rewards.Rewards, reward)
+			if err := k.rewardHistory.Set(ctx, key, rewards); err != nil {
+				return errors.Wrap(err, "error setting rewardHistory")
+			}
+		}
+	}
+	if err := k.IndexActorHistory(ctx); err != nil {
+		return errors.Wrap(err, "error indexing actor history")
+	}
+	//LastBlockTime int64
+	if data.LastBlockTime != 0 {
+		if err := k.lastBlockTime.Set(ctx, data.LastBlockTime); err != nil {
+			return errors.Wrap(err, "error setting lastBlockTime")
+		}
+	}
+	//AverageBlockTime github_com_allora_network_allora_chain_math.Dec
+	if !data.AverageBlockTime.IsZero() {
+		if err := k.averageBlockTime.Set(ctx, data.AverageBlockTime); err != nil {
+			return errors.Wrap(err, "error setting averageBlockTime")
+		}
+	}
+	//CalibratedBlocksPerMonth uint64
+	if data.CalibratedBlocksPerMonth != 0 {
+		if err := k.calibratedBlocksPerMonth.Set(ctx, data.CalibratedBlocksPerMonth); err != nil {
+			return errors.Wrap(err, "error setting calibratedBlocksPerMonth")
+		}
+	}
+	//TopicEpochHeights []*TopicIdAndBlockHeight
+	if len(data.TopicEpochHeights) != 0 {
+		for _, topicIdAndBlockHeight := range data.TopicEpochHeights {
+			if topicIdAndBlockHeight != nil {
+				if err := k.topicEpochHeights.Set(ctx,
+					collections.Join(topicIdAndBlockHeight.TopicId, topicIdAndBlockHeight.BlockHeight)); err != nil {
+					return errors.Wrap(err, "error setting topicEpochHeights")
+				}
+			}
+		}
+	}
+	//LastBlocksPerMonthCalibrationHeight int64
+	if data.LastBlocksPerMonthCalibrationHeight != 0 {
+		if err := k.lastBlocksPerMonthCalibrationHeight.Set(ctx, data.LastBlocksPerMonthCalibrationHeight); err != nil {
+			return errors.Wrap(err, "error setting lastBlocksPerMonthCalibrationHeight")
+		}
+	}
+	//LastPercentageRewardToStakedReputersHeight int64
+	if data.LastPercentageRewardToStakedReputersHeight != 0 {
+		if err := k.lastPercentageRewardToStakedReputersHeight.Set(ctx, data.LastPercentageRewardToStakedReputersHeight); err != nil {
+			return errors.Wrap(err, "error setting lastPercentageRewardToStakedReputersHeight")
+		}
+	}
 	return nil
 }
 
@@ -1441,6 +1672,23 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		coreTeamAddresses = append(coreTeamAddresses, key)
 	}
 
+	actorRoles := make([]*types.ActorIdAndRole, 0)
+	actorRolesIter, err := k.actorRoles.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate actor roles")
+	}
+	for ; actorRolesIter.Valid(); actorRolesIter.Next() {
+		key, err := actorRolesIter.Key()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key: actorRolesIter")
+		}
+		actorIdAndRole := types.ActorIdAndRole{
+			ActorId: key.K1(),
+			Role:    types.Role(key.K2()),
+		}
+		actorRoles = append(actorRoles, &actorIdAndRole)
+	}
+
 	topicLastWorkerCommit := make([]*types.TopicIdTimestampedActorNonce, 0)
 	topicLastWorkerCommitIter, err := k.topicLastWorkerCommit.Iterate(ctx, nil)
 	if err != nil {
@@ -1509,65 +1757,414 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		topicLastReputerPayload = append(topicLastReputerPayload, &topicIdTimestampedActorNonce)
 	}
 
+	topicWorkerAllowlist := make([]*types.TopicAndActorId, 0)
+	topicWorkerAllowlistIter, err := k.topicWorkerAllowlist.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate topic worker allowlist")
+	}
+	for ; topicWorkerAllowlistIter.Valid(); topicWorkerAllowlistIter.Next() {
+		key, err := topicWorkerAllowlistIter.Key()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key: topicWorkerAllowlistIter")
+		}
+		topicIdAndActorId := types.TopicAndActorId{
+			TopicId: key.K1(),
+			ActorId: key.K2(),
+		}
+		topicWorkerAllowlist = append(topicWorkerAllowlist, &topicIdAndActorId)
+	}
+
+	topicReputerAllowlist := make([]*types.TopicAndActorId, 0)
+	topicReputerAllowlistIter, err := k.topicReputerAllowlist.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate topic reputer allowlist")
+	}
+	for ; topicReputerAllowlistIter.Valid(); topicReputerAllowlistIter.Next() {
+		key, err := topicReputerAllowlistIter.Key()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key: topicReputerAllowlistIter")
+		}
+		topicIdAndActorId := types.TopicAndActorId{
+			TopicId: key.K1(),
+			ActorId: key.K2(),
+		}
+		topicReputerAllowlist = append(topicReputerAllowlist, &topicIdAndActorId)
+	}
+
+	workerLastActiveHeight := make([]*types.ActorIdTopicIdBlockHeight, 0)
+	workerLastActiveHeightIter, err := k.workerLastActiveHeight.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate worker last active height")
+	}
+	for ; workerLastActiveHeightIter.Valid(); workerLastActiveHeightIter.Next() {
+		keyValue, err := workerLastActiveHeightIter.KeyValue()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key value: workerLastActiveHeightIter")
+		}
+		actorIdTopicIdBlockHeight := types.ActorIdTopicIdBlockHeight{
+			ActorId:     keyValue.Key.K2(),
+			TopicId:     keyValue.Key.K1(),
+			BlockHeight: keyValue.Value,
+		}
+		workerLastActiveHeight = append(workerLastActiveHeight, &actorIdTopicIdBlockHeight)
+	}
+
+	reputerLastActiveHeight := make([]*types.ActorIdTopicIdBlockHeight, 0)
+	reputerLastActiveHeightIter, err := k.reputerLastActiveHeight.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate reputer last active height")
+	}
+	for ; reputerLastActiveHeightIter.Valid(); reputerLastActiveHeightIter.Next() {
+		keyValue, err := reputerLastActiveHeightIter.KeyValue()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key value: reputerLastActiveHeightIter")
+		}
+		actorIdTopicIdBlockHeight := types.ActorIdTopicIdBlockHeight{
+			ActorId:     keyValue.Key.K2(),
+			TopicId:     keyValue.Key.K1(),
+			BlockHeight: keyValue.Value,
+		}
+		reputerLastActiveHeight = append(reputerLastActiveHeight, &actorIdTopicIdBlockHeight)
+	}
+
+	topicEpochHeights := make([]*types.TopicIdAndBlockHeight, 0)
+	topicEpochHeightsIter, err := k.topicEpochHeights.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate topic epoch heights")
+	}
+	for ; topicEpochHeightsIter.Valid(); topicEpochHeightsIter.Next() {
+		key, err := topicEpochHeightsIter.Key()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key: topicEpochHeightsIter")
+		}
+		topicIdAndBlockHeight := types.TopicIdAndBlockHeight{
+			TopicId:     key.K1(),
+			BlockHeight: key.K2(),
+		}
+		topicEpochHeights = append(topicEpochHeights, &topicIdAndBlockHeight)
+	}
+
+	topicHorizonRewardNonce := make([]*types.TopicIdHorizonBlockHeight, 0)
+	topicHorizonRewardNonceIter, err := k.topicHorizonRewardNonce.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate topic horizon reward nonce")
+	}
+	for ; topicHorizonRewardNonceIter.Valid(); topicHorizonRewardNonceIter.Next() {
+		keyValue, err := topicHorizonRewardNonceIter.KeyValue()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key value: topicHorizonRewardNonceIter")
+		}
+		topicIdHorizonBlockHeight := types.TopicIdHorizonBlockHeight{
+			TopicId:     keyValue.Key.K1(),
+			Horizon:     keyValue.Key.K2(),
+			BlockHeight: keyValue.Value,
+		}
+		topicHorizonRewardNonce = append(topicHorizonRewardNonce, &topicIdHorizonBlockHeight)
+	}
+
+	horizonLastReputerPayload := make([]*types.TopicIdHorizonTimestampedActorNonce, 0)
+	horizonLastReputerPayloadIter, err := k.horizonLastReputerPayload.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate horizon last reputer payload")
+	}
+	for ; horizonLastReputerPayloadIter.Valid(); horizonLastReputerPayloadIter.Next() {
+		keyValue, err := horizonLastReputerPayloadIter.KeyValue()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key value: horizonLastReputerPayloadIter")
+		}
+		timestampedActorNonce := keyValue.Value
+		topicIdHorizonTimestampedActorNonce := types.TopicIdHorizonTimestampedActorNonce{
+			TopicId:               keyValue.Key.K1(),
+			Horizon:               keyValue.Key.K2(),
+			TimestampedActorNonce: &timestampedActorNonce,
+		}
+		horizonLastReputerPayload = append(horizonLastReputerPayload, &topicIdHorizonTimestampedActorNonce)
+	}
+
+	horizonLossBundles := make([]*types.TopicIdHorizonBlockHeightReputerValueBundles, 0)
+	horizonLossBundlesIter, err := k.horizonLossBundles.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate horizon loss bundles")
+	}
+	for ; horizonLossBundlesIter.Valid(); horizonLossBundlesIter.Next() {
+		keyValue, err := horizonLossBundlesIter.KeyValue()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key value: horizonLossBundlesIter")
+		}
+		value := keyValue.Value
+		topicIdHorizonBlockHeightReputerValueBundles := types.TopicIdHorizonBlockHeightReputerValueBundles{
+			TopicId:             keyValue.Key.K1().K1(),
+			Horizon:             keyValue.Key.K1().K2(),
+			BlockHeight:         keyValue.Key.K2(),
+			ReputerValueBundles: &value,
+		}
+		horizonLossBundles = append(horizonLossBundles, &topicIdHorizonBlockHeightReputerValueBundles)
+	}
+
+	horizonNetworkLossBundles := make([]*types.TopicIdHorizonBlockHeightValueBundles, 0)
+	horizonNetworkLossBundlesIter, err := k.horizonNetworkLossBundles.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate horizon network loss bundles")
+	}
+	for ; horizonNetworkLossBundlesIter.Valid(); horizonNetworkLossBundlesIter.Next() {
+		keyValue, err := horizonNetworkLossBundlesIter.KeyValue()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key value: horizonNetworkLossBundlesIter")
+		}
+		value := keyValue.Value
+		topicIdHorizonBlockHeightValueBundles := types.TopicIdHorizonBlockHeightValueBundles{
+			TopicId:     keyValue.Key.K1().K1(),
+			Horizon:     keyValue.Key.K1().K2(),
+			BlockHeight: keyValue.Key.K2(),
+			ValueBundle: &value,
+		}
+		horizonNetworkLossBundles = append(horizonNetworkLossBundles, &topicIdHorizonBlockHeightValueBundles)
+	}
+
+	networkInferenceWeights := make([]*types.TopicIdBlockHeightNetworkInferenceWeights, 0)
+	networkInferenceWeightsIter, err := k.networkInferenceWeights.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate network inference weights")
+	}
+	for ; networkInferenceWeightsIter.Valid(); networkInferenceWeightsIter.Next() {
+		keyValue, err := networkInferenceWeightsIter.KeyValue()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key value: networkInferenceWeightsIter")
+		}
+		value := keyValue.Value
+		networkInferenceWeights = append(networkInferenceWeights, &types.TopicIdBlockHeightNetworkInferenceWeights{
+			TopicId:     keyValue.Key.K1(),
+			BlockHeight: keyValue.Key.K2(),
+			Weights:     &value,
+		})
+	}
+
+	rewardBreakdowns := make([]*types.TopicIdBlockHeightRewardBreakdowns, 0)
+	rewardBreakdownsIter, err := k.rewardBreakdowns.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate reward breakdowns")
+	}
+	for ; rewardBreakdownsIter.Valid(); rewardBreakdownsIter.Next() {
+		keyValue, err := rewardBreakdownsIter.KeyValue()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key value: rewardBreakdownsIter")
+		}
+		value := keyValue.Value
+		rewardBreakdowns = append(rewardBreakdowns, &types.TopicIdBlockHeightRewardBreakdowns{
+			TopicId:          keyValue.Key.K1(),
+			BlockHeight:      keyValue.Key.K2(),
+			RewardBreakdowns: &value,
+		})
+	}
+
+	horizonInfererScoresByBlock, err := exportHorizonScoresByBlock(ctx, k.horizonInfererScoresByBlock)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to export horizon inferer scores by block")
+	}
+	horizonForecasterScoresByBlock, err := exportHorizonScoresByBlock(ctx, k.horizonForecasterScoresByBlock)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to export horizon forecaster scores by block")
+	}
+	horizonReputerScoresByBlock, err := exportHorizonScoresByBlock(ctx, k.horizonReputerScoresByBlock)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to export horizon reputer scores by block")
+	}
+
+	infererScoreHistory, err := exportScoreHistory(ctx, k.infererScoreHistory)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to export inferer score history")
+	}
+	forecasterScoreHistory, err := exportScoreHistory(ctx, k.forecasterScoreHistory)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to export forecaster score history")
+	}
+	reputerScoreHistory, err := exportScoreHistory(ctx, k.reputerScoreHistory)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to export reputer score history")
+	}
+
+	rewardHistory := make([]*types.PaidReward, 0)
+	rewardHistoryIter, err := k.rewardHistory.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate reward history")
+	}
+	for ; rewardHistoryIter.Valid(); rewardHistoryIter.Next() {
+		value, err := rewardHistoryIter.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get value: rewardHistoryIter")
+		}
+		rewardHistory = append(rewardHistory, value.Rewards...)
+	}
+
+	lastBlockTime, err := k.GetLastBlockTime(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get last block time")
+	}
+	averageBlockTime, err := k.GetAverageBlockTime(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get average block time")
+	}
+	calibratedBlocksPerMonth, err := k.GetCalibratedBlocksPerMonth(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get calibrated blocks per month")
+	}
+	lastBlocksPerMonthCalibrationHeight, err := k.GetLastBlocksPerMonthCalibrationHeight(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get last blocks per month calibration height")
+	}
+	lastPercentageRewardToStakedReputersHeight, err := k.GetLastPercentageRewardToStakedReputersHeight(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get last percentage reward to staked reputers height")
+	}
+
 	return &types.GenesisState{
-		Params:                                   moduleParams,
-		NextTopicId:                              nextTopicId,
-		Topics:                                   topics,
-		ActiveTopics:                             activeTopics,
-		ChurnableTopics:                          churnableTopics,
-		RewardableTopics:                         rewardableTopics,
-		TopicWorkers:                             topicWorkers,
-		TopicReputers:                            topicReputers,
-		TopicRewardNonce:                         topicRewardNonce,
-		InfererScoresByBlock:                     infererScoresByBlock,
-		ForecasterScoresByBlock:                  forecasterScoresByBlock,
-		ReputerScoresByBlock:                     reputerScoresByBlock,
-		LatestInfererScoresByWorker:              latestInfererScoresByWorker,
-		LatestForecasterScoresByWorker:           latestForecasterScoresByWorker,
-		LatestReputerScoresByReputer:             latestReputerScoresByReputer,
-		ReputerListeningCoefficient:              reputerListeningCoefficient,
-		PreviousReputerRewardFraction:            previousReputerRewardFraction,
-		PreviousInferenceRewardFraction:          previousInferenceRewardFraction,
-		PreviousForecastRewardFraction:           previousForecastRewardFraction,
-		TotalStake:                               totalStake,
-		TopicStake:                               topicStake,
-		StakeReputerAuthority:                    stakeReputerAuthority,
-		StakeSumFromDelegator:                    stakeSumFromDelegator,
-		DelegatedStakes:                          delegatedStakes,
-		StakeFromDelegatorsUponReputer:           stakeFromDelegatorsUponReputer,
-		DelegateRewardPerShare:                   delegateRewardPerShare,
-		StakeRemovalsByBlock:                     stakeRemovalsByBlock,
-		StakeRemovalsByActor:                     stakeRemovalsByActor,
-		DelegateStakeRemovalsByBlock:             delegateStakeRemovalsByBlock,
-		DelegateStakeRemovalsByActor:             delegateStakeRemovalsByActor,
-		Inferences:                               inferences,
-		Forecasts:                                forecasts,
-		Workers:                                  workers,
-		Reputers:                                 reputers,
-		TopicFeeRevenue:                          topicFeeRevenue,
-		PreviousTopicWeight:                      previousTopicWeight,
-		AllInferences:                            allInferences,
-		AllForecasts:                             allForecasts,
-		AllLossBundles:                           allLossBundles,
-		NetworkLossBundles:                       networkLossBundles,
-		PreviousPercentageRewardToStakedReputers: previousPercentageRewardToStakedReputers,
-		UnfulfilledWorkerNonces:                  unfulfilledWorkerNonces,
-		UnfulfilledReputerNonces:                 unfulfilledReputerNonces,
-		LatestInfererNetworkRegrets:              latestInfererNetworkRegrets,
-		LatestForecasterNetworkRegrets:           latestForecasterNetworkRegrets,
-		LatestOneInForecasterNetworkRegrets:      latestOneInForecasterNetworkRegrets,
-		LatestOneInForecasterSelfNetworkRegrets:  latestOneInForecasterSelfNetworkRegrets,
-		CoreTeamAddresses:                        coreTeamAddresses,
-		TopicLastWorkerCommit:                    topicLastWorkerCommit,
-		TopicLastReputerCommit:                   topicLastReputerCommit,
-		TopicLastWorkerPayload:                   topicLastWorkerPayload,
-		TopicLastReputerPayload:                  topicLastReputerPayload,
+		Params:                                     moduleParams,
+		NextTopicId:                                nextTopicId,
+		Topics:                                     topics,
+		ActiveTopics:                               activeTopics,
+		ChurnableTopics:                            churnableTopics,
+		RewardableTopics:                           rewardableTopics,
+		TopicWorkers:                               topicWorkers,
+		TopicReputers:                              topicReputers,
+		TopicRewardNonce:                           topicRewardNonce,
+		InfererScoresByBlock:                       infererScoresByBlock,
+		ForecasterScoresByBlock:                    forecasterScoresByBlock,
+		ReputerScoresByBlock:                       reputerScoresByBlock,
+		LatestInfererScoresByWorker:                latestInfererScoresByWorker,
+		LatestForecasterScoresByWorker:             latestForecasterScoresByWorker,
+		LatestReputerScoresByReputer:               latestReputerScoresByReputer,
+		ReputerListeningCoefficient:                reputerListeningCoefficient,
+		PreviousReputerRewardFraction:              previousReputerRewardFraction,
+		PreviousInferenceRewardFraction:            previousInferenceRewardFraction,
+		PreviousForecastRewardFraction:             previousForecastRewardFraction,
+		TotalStake:                                 totalStake,
+		TopicStake:                                 topicStake,
+		StakeReputerAuthority:                      stakeReputerAuthority,
+		StakeSumFromDelegator:                      stakeSumFromDelegator,
+		DelegatedStakes:                            delegatedStakes,
+		StakeFromDelegatorsUponReputer:             stakeFromDelegatorsUponReputer,
+		DelegateRewardPerShare:                     delegateRewardPerShare,
+		StakeRemovalsByBlock:                       stakeRemovalsByBlock,
+		StakeRemovalsByActor:                       stakeRemovalsByActor,
+		DelegateStakeRemovalsByBlock:               delegateStakeRemovalsByBlock,
+		DelegateStakeRemovalsByActor:               delegateStakeRemovalsByActor,
+		Inferences:                                 inferences,
+		Forecasts:                                  forecasts,
+		Workers:                                    workers,
+		Reputers:                                   reputers,
+		TopicFeeRevenue:                            topicFeeRevenue,
+		PreviousTopicWeight:                        previousTopicWeight,
+		AllInferences:                              allInferences,
+		AllForecasts:                               allForecasts,
+		AllLossBundles:                             allLossBundles,
+		NetworkLossBundles:                         networkLossBundles,
+		PreviousPercentageRewardToStakedReputers:   previousPercentageRewardToStakedReputers,
+		UnfulfilledWorkerNonces:                    unfulfilledWorkerNonces,
+		UnfulfilledReputerNonces:                   unfulfilledReputerNonces,
+		LatestInfererNetworkRegrets:                latestInfererNetworkRegrets,
+		LatestForecasterNetworkRegrets:             latestForecasterNetworkRegrets,
+		LatestOneInForecasterNetworkRegrets:        latestOneInForecasterNetworkRegrets,
+		LatestOneInForecasterSelfNetworkRegrets:    latestOneInForecasterSelfNetworkRegrets,
+		CoreTeamAddresses:                          coreTeamAddresses,
+		ActorRoles:                                 actorRoles,
+		TopicLastWorkerCommit:                      topicLastWorkerCommit,
+		TopicLastReputerCommit:                     topicLastReputerCommit,
+		TopicLastWorkerPayload:                     topicLastWorkerPayload,
+		TopicLastReputerPayload:                    topicLastReputerPayload,
+		TopicWorkerAllowlist:                       topicWorkerAllowlist,
+		TopicReputerAllowlist:                      topicReputerAllowlist,
+		WorkerLastActiveHeight:                     workerLastActiveHeight,
+		ReputerLastActiveHeight:                    reputerLastActiveHeight,
+		TopicHorizonRewardNonce:                    topicHorizonRewardNonce,
+		HorizonLossBundles:                         horizonLossBundles,
+		HorizonNetworkLossBundles:                  horizonNetworkLossBundles,
+		NetworkInferenceWeights:                    networkInferenceWeights,
+		RewardBreakdowns:                           rewardBreakdowns,
+		InfererScoreHistory:                        infererScoreHistory,
+		ForecasterScoreHistory:                     forecasterScoreHistory,
+		ReputerScoreHistory:                        reputerScoreHistory,
+		RewardHistory:                              rewardHistory,
+		LastBlockTime:                              lastBlockTime,
+		AverageBlockTime:                           averageBlockTime,
+		CalibratedBlocksPerMonth:                   calibratedBlocksPerMonth,
+		TopicEpochHeights:                          topicEpochHeights,
+		LastBlocksPerMonthCalibrationHeight:        lastBlocksPerMonthCalibrationHeight,
+		LastPercentageRewardToStakedReputersHeight: lastPercentageRewardToStakedReputersHeight,
+		HorizonLastReputerPayload:                  horizonLastReputerPayload,
+		HorizonInfererScoresByBlock:                horizonInfererScoresByBlock,
+		HorizonForecasterScoresByBlock:             horizonForecasterScoresByBlock,
+		HorizonReputerScoresByBlock:                horizonReputerScoresByBlock,
 	}, nil
 }
 
+func exportScoreHistory(
+	ctx context.Context,
+	history collections.Map[collections.Triple[TopicId, ActorId, BlockHeight], types.Score],
+) ([]*types.Score, error) {
+	scores := make([]*types.Score, 0)
+	iter, err := history.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	for ; iter.Valid(); iter.Next() {
+		score, err := iter.Value()
+		if err != nil {
+			return nil, err
+		}
+		scores = append(scores, &score)
+	}
+	return scores, nil
+}
+
+func importHorizonScoresByBlock(
+	ctx context.Context,
+	scoresByBlock collections.Map[collections.Pair[collections.Pair[TopicId, Horizon], BlockHeight], types.Scores],
+	data []*types.TopicIdHorizonBlockHeightScores,
+) error {
+	for _, topicIdHorizonBlockHeightScores := range data {
+		if topicIdHorizonBlockHeightScores != nil && topicIdHorizonBlockHeightScores.Scores != nil {
+			if err := scoresByBlock.Set(ctx,
+				collections.Join(
+					collections.Join(topicIdHorizonBlockHeightScores.TopicId, topicIdHorizonBlockHeightScores.Horizon),
+					topicIdHorizonBlockHeightScores.BlockHeight),
+				*topicIdHorizonBlockHeightScores.Scores); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func exportHorizonScoresByBlock(
+	ctx context.Context,
+	scoresByBlock collections.Map[collections.Pair[collections.Pair[TopicId, Horizon], BlockHeight], types.Scores],
+) ([]*types.TopicIdHorizonBlockHeightScores, error) {
+	horizonScores := make([]*types.TopicIdHorizonBlockHeightScores, 0)
+	iter, err := scoresByBlock.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	for ; iter.Valid(); iter.Next() {
+		keyValue, err := iter.KeyValue()
+		if err != nil {
+			return nil, err
+		}
+		scores := keyValue.Value
+		horizonScores = append(horizonScores, &types.TopicIdHorizonBlockHeightScores{
+			TopicId:     keyValue.Key.K1().K1(),
+			Horizon:     keyValue.Key.K1().K2(),
+			BlockHeight: keyValue.Key.K2(),
+			Scores:      &scores,
+		})
+	}
+	return horizonScores, nil
+}
+
 func (k *Keeper) addCoreTeamToWhitelists(ctx context.Context, coreTeamAddresses []string) error {
 	for _, addr := range coreTeamAddresses {
-		k.AddWhitelistAdmin(ctx, addr)
+		if err := k.AddWhitelistAdmin(ctx, addr); err != nil {
+			return err
+		}
 	}
 	return nil
 }
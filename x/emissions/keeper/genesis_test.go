@@ -2,6 +2,8 @@ package keeper_test
 
 import (
 	cosmossdk_io_math "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
 )
 
 // at minimum test that an import can be done from an export without error
@@ -13,3 +15,51 @@ func (s *KeeperTestSuite) TestImportExportGenesisNoError() {
 	err = s.emissionsKeeper.InitGenesis(s.ctx, genesisState)
 	s.Require().NoError(err)
 }
+
+func (s *KeeperTestSuite) TestImportExportGenesisHorizonLastReputerPayload() {
+	topicId := uint64(1)
+	horizon := uint32(2)
+	nonce := &types.Nonce{BlockHeight: 10}
+	err := s.emissionsKeeper.SetTopicLastReputerPayloadForHorizon(s.ctx, topicId, horizon, 12, nonce, "reputer1")
+	s.Require().NoError(err)
+
+	genesisState, err := s.emissionsKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Len(genesisState.HorizonLastReputerPayload, 1)
+	s.Require().Equal(topicId, genesisState.HorizonLastReputerPayload[0].TopicId)
+	s.Require().Equal(horizon, genesisState.HorizonLastReputerPayload[0].Horizon)
+
+	genesisState.HorizonLastReputerPayload[0].TimestampedActorNonce.Actor = "reputer2"
+	err = s.emissionsKeeper.InitGenesis(s.ctx, genesisState)
+	s.Require().NoError(err)
+	payload, err := s.emissionsKeeper.GetTopicLastReputerPayloadForHorizon(s.ctx, topicId, horizon)
+	s.Require().NoError(err)
+	s.Require().Equal("reputer2", payload.Actor)
+	s.Require().Equal(int64(12), payload.BlockHeight)
+	s.Require().Equal(nonce.BlockHeight, payload.Nonce.BlockHeight)
+}
+
+func (s *KeeperTestSuite) TestImportExportGenesisHorizonScoresByBlock() {
+	topicId := uint64(1)
+	horizon := uint32(2)
+	block := int64(10)
+	score := types.Score{TopicId: topicId, BlockHeight: block, Address: "reputer1", Score: alloraMath.NewDecFromInt64(3)}
+	err := s.emissionsKeeper.InsertReputerScoreForHorizon(s.ctx, topicId, horizon, block, score)
+	s.Require().NoError(err)
+
+	genesisState, err := s.emissionsKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(genesisState.ReputerScoresByBlock)
+	s.Require().Len(genesisState.HorizonReputerScoresByBlock, 1)
+	s.Require().Equal(topicId, genesisState.HorizonReputerScoresByBlock[0].TopicId)
+	s.Require().Equal(horizon, genesisState.HorizonReputerScoresByBlock[0].Horizon)
+	s.Require().Equal(block, genesisState.HorizonReputerScoresByBlock[0].BlockHeight)
+
+	genesisState.HorizonReputerScoresByBlock[0].Scores.Scores[0].Address = "reputer2"
+	err = s.emissionsKeeper.InitGenesis(s.ctx, genesisState)
+	s.Require().NoError(err)
+	scores, err := s.emissionsKeeper.GetReputersScoresAtBlockForHorizon(s.ctx, topicId, horizon, block)
+	s.Require().NoError(err)
+	s.Require().Len(scores.Scores, 1)
+	s.Require().Equal("reputer2", scores.Scores[0].Address)
+}
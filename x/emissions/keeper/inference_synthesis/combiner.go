@@ -0,0 +1,328 @@
+package inference_synthesis
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	alloraMath "github.com/allora-network/allora-chain/math"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// A Combiner reduces the inferences and forecast-implied inferences held in a palette to a
+// single network inference. CalcWeights is always called first and its result handed to
+// Combine, so that the weights a strategy used can be reported alongside the network inference.
+// As with the regret-informed weighted average, the built-in combiners yield zero when no worker
+// has a usable value, e.g. when computing a one-out inference for a lone worker.
+type Combiner interface {
+	CalcWeights(p *SynthPalette) (RegretInformedWeights, error)
+	Combine(p *SynthPalette, weights RegretInformedWeights) (InferenceValue, error)
+}
+
+// Returns the built-in combiner selected by the topic, set up with the topic's settings.
+// Unknown types fall back to the regret-informed combiner.
+func NewCombiner(topic emissionstypes.Topic) Combiner {
+	switch topic.Combiner {
+	case emissionstypes.CombinerType_COMBINER_WEIGHTED_MEDIAN:
+		return WeightedMedianCombiner{}
+	case emissionstypes.CombinerType_COMBINER_TRIMMED_MEAN:
+		return TrimmedMeanCombiner{TrimFraction: topic.TrimmedMeanTrimFraction}
+	case emissionstypes.CombinerType_COMBINER_SCORE_SOFTMAX:
+		return ScoreSoftmaxCombiner{}
+	default:
+		return RegretCombiner{}
+	}
+}
+
+func (p *SynthPalette) GetCombiner() Combiner {
+	if p.Combiner == nil {
+		return RegretCombiner{}
+	}
+	return p.Combiner
+}
+
+// Calculates the weights of the inferers and forecasters in the palette using the palette combiner
+func (p *SynthPalette) CalcCombinerWeights() (RegretInformedWeights, error) {
+	return p.GetCombiner().CalcWeights(p)
+}
+
+// Combines the inferences and forecast-implied inferences in the palette using the palette combiner
+func (p *SynthPalette) CalcCombinedInference(weights RegretInformedWeights) (InferenceValue, error) {
+	return p.GetCombiner().Combine(p, weights)
+}
+
+// RegretCombiner is the default combiner: a regret-informed weighted average as described in the litepaper
+type RegretCombiner struct{}
+
+func (RegretCombiner) CalcWeights(p *SynthPalette) (RegretInformedWeights, error) {
+	return p.CalcWeightsGivenWorkers()
+}
+
+func (RegretCombiner) Combine(p *SynthPalette, weights RegretInformedWeights) (InferenceValue, error) {
+	return p.CalcWeightedInference(weights)
+}
+
+// WeightedMedianCombiner takes the median of the values weighted by the regret-informed weight of each worker,
+// scaled up by the stake it holds in the topic. It is robust to a minority of the weight submitting outlying values.
+type WeightedMedianCombiner struct{}
+
+func (WeightedMedianCombiner) CalcWeights(p *SynthPalette) (RegretInformedWeights, error) {
+	return p.CalcStakeWeights()
+}
+
+func (WeightedMedianCombiner) Combine(p *SynthPalette, weights RegretInformedWeights) (InferenceValue, error) {
+	values := p.getValuesGivenWeights(weights)
+	alloraMath.SortWeightedValues(values)
+	median, err := alloraMath.WeightedMedian(values)
+	if err != nil {
+		return InferenceValue{}, errorsmod.Wrapf(err, "Error calculating weighted median")
+	}
+	return median, nil
+}
+
+// TrimmedMeanCombiner drops the lowest and the highest values holding TrimFraction of the total weight
+// each, partially dropping the weight of the values at the boundaries, then takes the regret-informed
+// weighted average of the values that remain. If no weight would remain, it takes their weighted median.
+type TrimmedMeanCombiner struct {
+	TrimFraction alloraMath.Dec
+}
+
+func (TrimmedMeanCombiner) CalcWeights(p *SynthPalette) (RegretInformedWeights, error) {
+	return p.CalcWeightsGivenWorkers()
+}
+
+func (c TrimmedMeanCombiner) Combine(p *SynthPalette, weights RegretInformedWeights) (InferenceValue, error) {
+	values := p.getWeightedValues(weights)
+	if len(values) == 0 {
+		return alloraMath.ZeroDec(), nil
+	}
+	alloraMath.SortWeightedValues(values)
+
+	kept, ok, err := alloraMath.TrimWeightedValues(values, c.TrimFraction)
+	if err != nil {
+		return InferenceValue{}, errorsmod.Wrapf(err, "Error trimming values")
+	}
+	if !ok {
+		median, err := alloraMath.WeightedMedian(values)
+		if err != nil {
+			return InferenceValue{}, errorsmod.Wrapf(err, "Error calculating weighted median")
+		}
+		return median, nil
+	}
+	return weightedMeanOfValues(kept, p.Epsilon)
+}
+
+// ScoreSoftmaxCombiner weights each worker by the softmax of their latest inferer or forecaster score,
+// then takes the weighted average of their values
+type ScoreSoftmaxCombiner struct{}
+
+func (ScoreSoftmaxCombiner) CalcWeights(p *SynthPalette) (RegretInformedWeights, error) {
+	infererScores := make(map[Worker]alloraMath.Dec, len(p.Inferers))
+	forecasterScores := make(map[Worker]alloraMath.Dec, len(p.Forecasters))
+	maxScore := alloraMath.ZeroDec()
+	maxScoreInitialized := false
+	updateMaxScore := func(score alloraMath.Dec) {
+		if !maxScoreInitialized || score.Gt(maxScore) {
+			maxScoreInitialized = true
+			maxScore = score
+		}
+	}
+
+	for _, inferer := range p.Inferers {
+		score, err := p.K.GetLatestInfererScore(p.Ctx, p.TopicId, inferer)
+		if err != nil {
+			return RegretInformedWeights{}, errorsmod.Wrapf(err, "Error getting latest inferer score")
+		}
+		infererScores[inferer] = score.Score
+		updateMaxScore(score.Score)
+	}
+	for _, forecaster := range p.Forecasters {
+		score, err := p.K.GetLatestForecasterScore(p.Ctx, p.TopicId, forecaster)
+		if err != nil {
+			return RegretInformedWeights{}, errorsmod.Wrapf(err, "Error getting latest forecaster score")
+		}
+		forecasterScores[forecaster] = score.Score
+		updateMaxScore(score.Score)
+	}
+	if !maxScoreInitialized {
+		return RegretInformedWeights{}, errorsmod.Wrapf(emissionstypes.ErrEmptyArray, "No scores to calculate weights")
+	}
+
+	// Shift scores by the max score before exponentiating to keep the weights in (0, 1]
+	softmaxWeight := func(score alloraMath.Dec) (Weight, error) {
+		shifted, err := score.Sub(maxScore)
+		if err != nil {
+			return Weight{}, errorsmod.Wrapf(err, "Error shifting score")
+		}
+		return alloraMath.Exp(shifted)
+	}
+
+	infererWeights := make(map[Worker]Weight, len(infererScores))
+	for _, inferer := range p.Inferers {
+		weight, err := softmaxWeight(infererScores[inferer])
+		if err != nil {
+			return RegretInformedWeights{}, errorsmod.Wrapf(err, "Error calculating inferer weight")
+		}
+		infererWeights[inferer] = weight
+	}
+	forecasterWeights := make(map[Worker]Weight, len(forecasterScores))
+	for _, forecaster := range p.Forecasters {
+		weight, err := softmaxWeight(forecasterScores[forecaster])
+		if err != nil {
+			return RegretInformedWeights{}, errorsmod.Wrapf(err, "Error calculating forecaster weight")
+		}
+		forecasterWeights[forecaster] = weight
+	}
+
+	return RegretInformedWeights{
+		inferers:    infererWeights,
+		forecasters: forecasterWeights,
+	}, nil
+}
+
+func (ScoreSoftmaxCombiner) Combine(p *SynthPalette, weights RegretInformedWeights) (InferenceValue, error) {
+	values := p.getWeightedValues(weights)
+	if len(values) == 0 {
+		return alloraMath.ZeroDec(), nil
+	}
+	return weightedMeanOfValues(values, p.Epsilon)
+}
+
+// Weights each inferer and forecaster of the palette by its regret-informed weight, scaled by the share
+// of the stake of the palette workers it holds as a reputer of the topic. Workers need not stake, so the
+// multiplier is floored at 1 for workers without stake and reaches 2 for a worker holding all of it:
+// stake favours a worker without silencing the others.
+func (p *SynthPalette) CalcStakeWeights() (RegretInformedWeights, error) {
+	weights, err := p.CalcWeightsGivenWorkers()
+	if err != nil {
+		return RegretInformedWeights{}, err
+	}
+
+	stakes := make(map[Worker]alloraMath.Dec, len(p.Inferers)+len(p.Forecasters))
+	totalStake := alloraMath.ZeroDec()
+	for _, worker := range append(append([]Worker{}, p.Inferers...), p.Forecasters...) {
+		if _, ok := stakes[worker]; ok {
+			continue
+		}
+		stakeInt, err := p.K.GetStakeReputerAuthority(p.Ctx, p.TopicId, worker)
+		if err != nil {
+			return RegretInformedWeights{}, errorsmod.Wrapf(err, "Error getting worker stake")
+		}
+		stake, err := alloraMath.NewDecFromSdkInt(stakeInt)
+		if err != nil {
+			return RegretInformedWeights{}, errorsmod.Wrapf(err, "Error converting worker stake")
+		}
+		stakes[worker] = stake
+		totalStake, err = totalStake.Add(stake)
+		if err != nil {
+			return RegretInformedWeights{}, errorsmod.Wrapf(err, "Error adding worker stake")
+		}
+	}
+	if !totalStake.IsPositive() {
+		return weights, nil
+	}
+
+	scaleByStake := func(workerWeights map[Worker]Weight) error {
+		for worker, weight := range workerWeights {
+			stake, ok := stakes[worker]
+			if !ok {
+				continue
+			}
+			stakeShare, err := stake.Quo(totalStake)
+			if err != nil {
+				return errorsmod.Wrapf(err, "Error calculating stake share")
+			}
+			multiplier, err := alloraMath.OneDec().Add(stakeShare)
+			if err != nil {
+				return errorsmod.Wrapf(err, "Error calculating stake multiplier")
+			}
+			workerWeights[worker], err = weight.Mul(multiplier)
+			if err != nil {
+				return errorsmod.Wrapf(err, "Error applying stake multiplier")
+			}
+		}
+		return nil
+	}
+	if err := scaleByStake(weights.inferers); err != nil {
+		return RegretInformedWeights{}, err
+	}
+	if err := scaleByStake(weights.forecasters); err != nil {
+		return RegretInformedWeights{}, err
+	}
+	return weights, nil
+}
+
+// Collects the values of the palette workers that have a usable weight.
+// If all inferers are new, only inferences are considered and each is given a weight of 1,
+// consistently with CalcWeightedInference.
+func (p *SynthPalette) getWeightedValues(weights RegretInformedWeights) []alloraMath.WeightedValue {
+	values := make([]alloraMath.WeightedValue, 0, len(p.Inferers)+len(p.Forecasters))
+	if p.AllInferersAreNew {
+		for _, inferer := range p.Inferers {
+			inference, ok := p.InferenceByWorker[inferer]
+			if !ok || inference == nil {
+				p.Logger.Debug(fmt.Sprintf("Cannot find inferer in InferenceByWorker in getWeightedValues %v", inferer))
+				continue
+			}
+			values = append(values, alloraMath.WeightedValue{Value: inference.Value, Weight: alloraMath.OneDec()})
+		}
+		return values
+	}
+	return p.getValuesGivenWeights(weights)
+}
+
+// Collects the values of the palette workers that have a usable weight, whether or not inferers are new
+func (p *SynthPalette) getValuesGivenWeights(weights RegretInformedWeights) []alloraMath.WeightedValue {
+	values := make([]alloraMath.WeightedValue, 0, len(p.Inferers)+len(p.Forecasters))
+	for _, inferer := range p.Inferers {
+		inference, ok := p.InferenceByWorker[inferer]
+		if !ok || inference == nil {
+			p.Logger.Debug(fmt.Sprintf("Cannot find inferer in InferenceByWorker in getWeightedValues %v", inferer))
+			continue
+		}
+		weight, ok := weights.inferers[inferer]
+		if !ok || weight.IsNaN() || !weight.IsPositive() {
+			continue
+		}
+		values = append(values, alloraMath.WeightedValue{Value: inference.Value, Weight: weight})
+	}
+	for _, forecaster := range p.Forecasters {
+		inference, ok := p.ForecastImpliedInferenceByWorker[forecaster]
+		if !ok || inference == nil {
+			p.Logger.Debug(fmt.Sprintf("Cannot find forecaster in ForecastImpliedInferenceByWorker in getWeightedValues %v", forecaster))
+			continue
+		}
+		weight, ok := weights.forecasters[forecaster]
+		if !ok || weight.IsNaN() || !weight.IsPositive() {
+			continue
+		}
+		values = append(values, alloraMath.WeightedValue{Value: inference.Value, Weight: weight})
+	}
+	return values
+}
+
+func weightedMeanOfValues(values []alloraMath.WeightedValue, epsilon alloraMath.Dec) (InferenceValue, error) {
+	runningUnnormalized := alloraMath.ZeroDec()
+	for _, v := range values {
+		weightTimesValue, err := v.Weight.Mul(v.Value)
+		if err != nil {
+			return InferenceValue{}, errorsmod.Wrapf(err, "Error calculating weight by worker value")
+		}
+		runningUnnormalized, err = runningUnnormalized.Add(weightTimesValue)
+		if err != nil {
+			return InferenceValue{}, errorsmod.Wrapf(err, "Error adding weight by worker value")
+		}
+	}
+	sumWeights, err := alloraMath.SumWeights(values)
+	if err != nil {
+		return InferenceValue{}, errorsmod.Wrapf(err, "Error adding weights")
+	}
+	if sumWeights.Lt(epsilon) {
+		sumWeights = epsilon
+	}
+	ret, err := runningUnnormalized.Quo(sumWeights)
+	if err != nil {
+		return InferenceValue{}, errorsmod.Wrapf(err, "Error normalizing network inference")
+	}
+	return ret, nil
+}
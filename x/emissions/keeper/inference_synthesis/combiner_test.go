@@ -0,0 +1,177 @@
+package inference_synthesis_test
+
+import (
+	"cosmossdk.io/log"
+	cosmosMath "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	alloratestutil "github.com/allora-network/allora-chain/test/testutil"
+	synth "github.com/allora-network/allora-chain/x/emissions/keeper/inference_synthesis"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// Builds a palette of inferers with equal regrets, hence equal regret-informed weights
+func (s *InferenceSynthesisTestSuite) getCombinerTestPalette(values map[synth.Worker]string, combiner synth.Combiner) synth.SynthPalette {
+	inferers := alloraMath.GetSortedKeys(values)
+	inferenceByWorker := make(map[synth.Worker]*emissionstypes.Inference, len(values))
+	infererRegrets := make(map[synth.Worker]*alloraMath.Dec, len(values))
+	for _, inferer := range inferers {
+		inferenceByWorker[inferer] = &emissionstypes.Inference{
+			TopicId: uint64(1),
+			Inferer: inferer,
+			Value:   alloraMath.MustNewDecFromString(values[inferer]),
+		}
+		regret := alloraMath.MustNewDecFromString("0.5")
+		infererRegrets[inferer] = &regret
+	}
+
+	return synth.SynthPalette{
+		Ctx:                              s.ctx,
+		K:                                s.emissionsKeeper,
+		Logger:                           log.NewNopLogger(),
+		TopicId:                          uint64(1),
+		Inferers:                         inferers,
+		InferenceByWorker:                inferenceByWorker,
+		InfererRegrets:                   infererRegrets,
+		ForecastByWorker:                 make(map[synth.Worker]*emissionstypes.Forecast),
+		ForecastImpliedInferenceByWorker: make(map[synth.Worker]*emissionstypes.Inference),
+		ForecasterRegrets:                make(map[synth.Worker]*alloraMath.Dec),
+		Epsilon:                          alloraMath.MustNewDecFromString("0.0001"),
+		PNorm:                            alloraMath.MustNewDecFromString("3"),
+		CNorm:                            alloraMath.MustNewDecFromString("0.75"),
+		Combiner:                         combiner,
+	}
+}
+
+func (s *InferenceSynthesisTestSuite) combineWithPalette(palette synth.SynthPalette) alloraMath.Dec {
+	weights, err := palette.CalcCombinerWeights()
+	s.Require().NoError(err)
+	value, err := palette.CalcCombinedInference(weights)
+	s.Require().NoError(err)
+	return value
+}
+
+func (s *InferenceSynthesisTestSuite) TestNewCombinerDefaultsToRegret() {
+	newCombiner := func(combinerType emissionstypes.CombinerType) synth.Combiner {
+		return synth.NewCombiner(emissionstypes.Topic{Combiner: combinerType})
+	}
+	s.Require().IsType(synth.RegretCombiner{}, newCombiner(emissionstypes.CombinerType_COMBINER_REGRET))
+	s.Require().IsType(synth.RegretCombiner{}, newCombiner(emissionstypes.CombinerType(42)))
+	s.Require().IsType(synth.WeightedMedianCombiner{}, newCombiner(emissionstypes.CombinerType_COMBINER_WEIGHTED_MEDIAN))
+	s.Require().IsType(synth.TrimmedMeanCombiner{}, newCombiner(emissionstypes.CombinerType_COMBINER_TRIMMED_MEAN))
+	s.Require().IsType(synth.ScoreSoftmaxCombiner{}, newCombiner(emissionstypes.CombinerType_COMBINER_SCORE_SOFTMAX))
+
+	trimFraction := alloraMath.MustNewDecFromString("0.3")
+	s.Require().Equal(synth.TrimmedMeanCombiner{TrimFraction: trimFraction}, synth.NewCombiner(emissionstypes.Topic{
+		Combiner:                emissionstypes.CombinerType_COMBINER_TRIMMED_MEAN,
+		TrimmedMeanTrimFraction: trimFraction,
+	}))
+}
+
+func (s *InferenceSynthesisTestSuite) TestCombinersWithOutlier() {
+	values := map[synth.Worker]string{
+		"worker0": "1",
+		"worker1": "2",
+		"worker2": "3",
+		"worker3": "4",
+		"worker4": "100",
+	}
+
+	// nil combiner behaves as the regret-informed weighted average
+	alloratestutil.InEpsilon5(s.T(), s.combineWithPalette(s.getCombinerTestPalette(values, nil)), "22")
+	alloratestutil.InEpsilon5(s.T(), s.combineWithPalette(s.getCombinerTestPalette(values, synth.RegretCombiner{})), "22")
+	// without stake every worker weighs the same in the median
+	alloratestutil.InEpsilon5(s.T(), s.combineWithPalette(s.getCombinerTestPalette(values, synth.WeightedMedianCombiner{})), "3")
+	// 20% of 5 values trims the lowest and the highest value
+	alloratestutil.InEpsilon5(s.T(), s.combineWithPalette(s.getCombinerTestPalette(values,
+		synth.NewCombiner(emissionstypes.Topic{
+			Combiner:                emissionstypes.CombinerType_COMBINER_TRIMMED_MEAN,
+			TrimmedMeanTrimFraction: alloraMath.MustNewDecFromString("0.2"),
+		}))), "3")
+}
+
+func (s *InferenceSynthesisTestSuite) TestWeightedMedianWeighsByStake() {
+	values := map[synth.Worker]string{
+		"worker0": "1",
+		"worker1": "2",
+		"worker2": "3",
+		"worker3": "100",
+	}
+	palette := s.getCombinerTestPalette(values, synth.WeightedMedianCombiner{})
+	for worker, stake := range map[synth.Worker]int64{"worker0": 10, "worker1": 10, "worker3": 30} {
+		err := s.emissionsKeeper.SetStakeReputerAuthority(s.ctx, palette.TopicId, worker, cosmosMath.NewInt(stake))
+		s.Require().NoError(err)
+	}
+
+	// worker2 holds no stake but keeps its regret-informed weight, while holding 10, 10 and 30 of the
+	// 50 staked scales the others by 1.2, 1.2 and 1.6. Half of the total weight of 5 is reached at worker2
+	alloratestutil.InEpsilon5(s.T(), s.combineWithPalette(palette), "3")
+
+	// More stake moves the median towards the staked workers
+	s.Require().NoError(s.emissionsKeeper.SetStakeReputerAuthority(s.ctx, palette.TopicId, "worker1", cosmosMath.NewInt(60)))
+	alloratestutil.InEpsilon5(s.T(), s.combineWithPalette(palette), "2")
+}
+
+func (s *InferenceSynthesisTestSuite) TestWeightedMedianLoneStakedWorkerDoesNotDecide() {
+	values := map[synth.Worker]string{
+		"worker0": "1",
+		"worker1": "2",
+		"worker2": "3",
+		"worker3": "100",
+	}
+	palette := s.getCombinerTestPalette(values, synth.WeightedMedianCombiner{})
+	err := s.emissionsKeeper.SetStakeReputerAuthority(s.ctx, palette.TopicId, "worker3", cosmosMath.NewInt(1000000))
+	s.Require().NoError(err)
+	// holding all of the stake at most doubles the weight of worker3, which is 2 out of 5
+	alloratestutil.InEpsilon5(s.T(), s.combineWithPalette(palette), "3")
+}
+
+func (s *InferenceSynthesisTestSuite) TestTrimmedMeanKeepsAtLeastOneValue() {
+	values := map[synth.Worker]string{
+		"worker0": "1",
+		"worker1": "2",
+		"worker2": "9",
+	}
+	combiner := synth.TrimmedMeanCombiner{TrimFraction: alloraMath.MustNewDecFromString("0.9")}
+	alloratestutil.InEpsilon5(s.T(), s.combineWithPalette(s.getCombinerTestPalette(values, combiner)), "2")
+}
+
+func (s *InferenceSynthesisTestSuite) TestWeightedMedianAllInferersNew() {
+	palette := s.getCombinerTestPalette(map[synth.Worker]string{
+		"worker0": "5",
+		"worker1": "1",
+		"worker2": "3",
+		"worker3": "7",
+	}, synth.WeightedMedianCombiner{})
+	palette.AllInferersAreNew = true
+	// Half of the total weight is reached at the lower middle value
+	alloratestutil.InEpsilon5(s.T(), s.combineWithPalette(palette), "3")
+}
+
+func (s *InferenceSynthesisTestSuite) TestScoreSoftmaxCombiner() {
+	palette := s.getCombinerTestPalette(map[synth.Worker]string{
+		"worker0": "1",
+		"worker1": "2",
+	}, synth.ScoreSoftmaxCombiner{})
+
+	// Without scores every worker has the same weight
+	alloratestutil.InEpsilon5(s.T(), s.combineWithPalette(palette), "1.5")
+
+	// softmax(0, ln 3) = (0.25, 0.75)
+	ln3, err := alloraMath.Ln(alloraMath.NewDecFromInt64(3))
+	s.Require().NoError(err)
+	err = s.emissionsKeeper.SetLatestInfererScore(s.ctx, palette.TopicId, "worker1", emissionstypes.Score{
+		TopicId:     palette.TopicId,
+		BlockHeight: 1,
+		Address:     "worker1",
+		Score:       ln3,
+	})
+	s.Require().NoError(err)
+	alloratestutil.InEpsilon5(s.T(), s.combineWithPalette(palette), "1.75")
+}
+
+func (s *InferenceSynthesisTestSuite) TestCombinerWithoutValuesYieldsZero() {
+	palette := s.getCombinerTestPalette(map[synth.Worker]string{"worker0": "1"}, synth.WeightedMedianCombiner{})
+	value, err := palette.CalcCombinedInference(synth.RegretInformedWeights{})
+	s.Require().NoError(err)
+	s.Require().True(value.IsZero())
+}
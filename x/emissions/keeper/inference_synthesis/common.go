@@ -52,7 +52,11 @@ func ConvertForecastImpliedInferencesToArrays(
 	for _, worker := range workers {
 		forecastImpliedInferences = append(
 			forecastImpliedInferences,
-			&types.WorkerAttributedValue{Worker: worker, Value: forecastImpliedInferenceByWorker[worker].Value},
+			&types.WorkerAttributedValue{
+				Worker: worker,
+				Value:  forecastImpliedInferenceByWorker[worker].Value,
+				Values: forecastImpliedInferenceByWorker[worker].Values,
+			},
 		)
 	}
 	return forecastImpliedInferences
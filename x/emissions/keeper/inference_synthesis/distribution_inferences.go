@@ -0,0 +1,283 @@
+package inference_synthesis
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	emissions "github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// Probabilities are floored at this value before taking their logarithm when pooling logarithmically,
+// so that a single worker assigning probability 0 to a class does not veto it entirely
+var MinLogPoolingProbability = alloraMath.MustNewDecFromString("0.000000001")
+
+// Synthesizes network inferences of a topic whose values are probability distributions.
+// Distributions are combined component-wise, linearly or in log-space, and every resulting
+// vector is mapped back to probabilities and renormalised so that it sums to one.
+func buildDistributionNetworkInferences(req SynthRequest, topic emissions.Topic) (
+	*emissions.ValueBundle,
+	map[string]*emissions.Inference,
+	RegretInformedWeights,
+	error,
+) {
+	logPooling := topic.DistributionPooling == emissions.DistributionPooling_POOLING_LOGARITHMIC
+	submittedValuesByInferer := make(map[string][]alloraMath.Dec, len(req.Inferences.Inferences))
+	for _, inference := range req.Inferences.Inferences {
+		submittedValuesByInferer[inference.Inferer] = inference.Values
+	}
+
+	if logPooling {
+		logInferences, err := logInferences(req.Inferences)
+		if err != nil {
+			return nil, nil, RegretInformedWeights{}, err
+		}
+		req.Inferences = logInferences
+	}
+
+	networkInferences, forecastImpliedInferences, weights, err := buildComponentWiseNetworkInferences(req, topic.OutputDimension)
+	if err != nil {
+		return nil, nil, RegretInformedWeights{}, err
+	}
+
+	toDistribution := func(values []alloraMath.Dec) ([]alloraMath.Dec, error) {
+		if logPooling {
+			exps := make([]alloraMath.Dec, 0, len(values))
+			for _, value := range values {
+				exp, err := alloraMath.Exp(value)
+				if err != nil {
+					return nil, errorsmod.Wrapf(err, "Error exponentiating log-probability")
+				}
+				exps = append(exps, exp)
+			}
+			values = exps
+		}
+		return NormalizeDistribution(values)
+	}
+
+	if networkInferences.CombinedValues, err = toDistribution(networkInferences.CombinedValues); err != nil {
+		return nil, nil, RegretInformedWeights{}, err
+	}
+	networkInferences.CombinedValue = firstProbability(networkInferences.CombinedValues)
+	if networkInferences.NaiveValues, err = toDistribution(networkInferences.NaiveValues); err != nil {
+		return nil, nil, RegretInformedWeights{}, err
+	}
+	networkInferences.NaiveValue = firstProbability(networkInferences.NaiveValues)
+
+	// Inferer values are reported as submitted rather than round-tripped through the log-space
+	for _, value := range networkInferences.InfererValues {
+		if submitted, ok := submittedValuesByInferer[value.Worker]; ok {
+			value.Values = submitted
+			value.Value = firstProbability(submitted)
+		}
+	}
+	for _, values := range [][]*emissions.WorkerAttributedValue{networkInferences.ForecasterValues, networkInferences.OneInForecasterValues} {
+		for _, value := range values {
+			if value.Values, err = toDistribution(value.Values); err != nil {
+				return nil, nil, RegretInformedWeights{}, err
+			}
+			value.Value = firstProbability(value.Values)
+		}
+	}
+	for _, values := range [][]*emissions.WithheldWorkerAttributedValue{networkInferences.OneOutInfererValues, networkInferences.OneOutForecasterValues} {
+		for _, value := range values {
+			if value.Values, err = toDistribution(value.Values); err != nil {
+				return nil, nil, RegretInformedWeights{}, err
+			}
+			value.Value = firstProbability(value.Values)
+		}
+	}
+	for _, inference := range forecastImpliedInferences {
+		if inference.Values, err = toDistribution(inference.Values); err != nil {
+			return nil, nil, RegretInformedWeights{}, err
+		}
+		inference.Value = firstProbability(inference.Values)
+	}
+
+	return networkInferences, forecastImpliedInferences, weights, nil
+}
+
+func logInferences(inferences *emissions.Inferences) (*emissions.Inferences, error) {
+	logged := &emissions.Inferences{
+		Inferences: make([]*emissions.Inference, 0, len(inferences.Inferences)),
+	}
+	for _, inference := range inferences.Inferences {
+		logValues := make([]alloraMath.Dec, 0, len(inference.Values))
+		for _, value := range inference.Values {
+			logValue, err := alloraMath.Ln(alloraMath.Max(value, MinLogPoolingProbability))
+			if err != nil {
+				return nil, errorsmod.Wrapf(err, "Error calculating log-probability")
+			}
+			logValues = append(logValues, logValue)
+		}
+		loggedInference := *inference
+		loggedInference.Values = logValues
+		logged.Inferences = append(logged.Inferences, &loggedInference)
+	}
+	return logged, nil
+}
+
+// Rescales non-negative weights into a probability distribution.
+// Negative weights are treated as zero, and if no weight is positive the distribution is uniform.
+func NormalizeDistribution(values []alloraMath.Dec) ([]alloraMath.Dec, error) {
+	if len(values) == 0 {
+		return values, nil
+	}
+	clamped := make([]alloraMath.Dec, 0, len(values))
+	sum := alloraMath.ZeroDec()
+	for _, value := range values {
+		value = alloraMath.Max(value, alloraMath.ZeroDec())
+		clamped = append(clamped, value)
+		var err error
+		sum, err = sum.Add(value)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "Error summing probabilities")
+		}
+	}
+
+	normalized := make([]alloraMath.Dec, 0, len(values))
+	if sum.IsZero() {
+		uniform, err := alloraMath.OneDec().Quo(alloraMath.NewDecFromInt64(int64(len(values))))
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "Error calculating uniform probability")
+		}
+		for range values {
+			normalized = append(normalized, uniform)
+		}
+		return normalized, nil
+	}
+	for _, value := range clamped {
+		probability, err := value.Quo(sum)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "Error normalizing probability")
+		}
+		normalized = append(normalized, probability)
+	}
+	return normalized, nil
+}
+
+// The scalar value of a distribution is its first probability
+func firstProbability(values []alloraMath.Dec) alloraMath.Dec {
+	if len(values) == 0 {
+		return alloraMath.ZeroDec()
+	}
+	return values[0]
+}
+
+// Summarises how confident the network is in its combined distribution: the entropy of the distribution,
+// its most likely class, and how far the distributions of the workers are from it on average
+func CalcDistributionConfidence(
+	networkInferences *emissions.ValueBundle,
+	forecastImpliedInferenceByWorker map[string]*emissions.Inference,
+	infererWeights map[string]alloraMath.Dec,
+	forecasterWeights map[string]alloraMath.Dec,
+) (*emissions.DistributionConfidence, error) {
+	combined := networkInferences.CombinedValues
+	if len(combined) == 0 {
+		// Nothing to report until a combined distribution is available
+		return nil, nil
+	}
+
+	entropy := alloraMath.ZeroDec()
+	mostLikelyClass := 0
+	for class, probability := range combined {
+		if probability.Gt(combined[mostLikelyClass]) {
+			mostLikelyClass = class
+		}
+		if !probability.IsPositive() {
+			continue
+		}
+		logProbability, err := alloraMath.Ln(probability)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "Error calculating log-probability")
+		}
+		term, err := probability.Mul(logProbability)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "Error calculating entropy term")
+		}
+		entropy, err = entropy.Sub(term)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "Error accumulating entropy")
+		}
+	}
+
+	var distributions [][]alloraMath.Dec
+	var weights []alloraMath.Dec
+	for _, inference := range networkInferences.InfererValues {
+		if weight, ok := infererWeights[inference.Worker]; ok {
+			distributions = append(distributions, inference.Values)
+			weights = append(weights, weight)
+		}
+	}
+	for _, forecast := range networkInferences.ForecasterValues {
+		weight, ok := forecasterWeights[forecast.Worker]
+		inference, hasInference := forecastImpliedInferenceByWorker[forecast.Worker]
+		if ok && hasInference && inference != nil {
+			distributions = append(distributions, inference.Values)
+			weights = append(weights, weight)
+		}
+	}
+
+	meanDistance, err := weightedMeanTotalVariationDistance(combined, distributions, weights)
+	if err != nil {
+		return nil, err
+	}
+
+	return &emissions.DistributionConfidence{
+		Entropy:                    entropy,
+		MostLikelyClass:            uint64(mostLikelyClass),
+		MaxProbability:             combined[mostLikelyClass],
+		MeanTotalVariationDistance: meanDistance,
+	}, nil
+}
+
+// Total variation distance between two distributions: half the sum of the absolute differences of their probabilities
+func TotalVariationDistance(p, q []alloraMath.Dec) (alloraMath.Dec, error) {
+	if len(p) != len(q) {
+		return alloraMath.ZeroDec(), errorsmod.Wrapf(emissions.ErrInvalidOutputDimension, "distributions of %d and %d classes", len(p), len(q))
+	}
+	sum := alloraMath.ZeroDec()
+	for i := range p {
+		diff, err := p[i].Sub(q[i])
+		if err != nil {
+			return alloraMath.ZeroDec(), err
+		}
+		sum, err = sum.Add(diff.Abs())
+		if err != nil {
+			return alloraMath.ZeroDec(), err
+		}
+	}
+	return sum.Quo(alloraMath.NewDecFromInt64(2))
+}
+
+func weightedMeanTotalVariationDistance(
+	combined []alloraMath.Dec,
+	distributions [][]alloraMath.Dec,
+	weights []alloraMath.Dec,
+) (alloraMath.Dec, error) {
+	runningUnnormalized := alloraMath.ZeroDec()
+	sumWeights := alloraMath.ZeroDec()
+	for i, distribution := range distributions {
+		if len(distribution) != len(combined) || !weights[i].IsPositive() {
+			continue
+		}
+		distance, err := TotalVariationDistance(combined, distribution)
+		if err != nil {
+			return alloraMath.ZeroDec(), err
+		}
+		weightedDistance, err := weights[i].Mul(distance)
+		if err != nil {
+			return alloraMath.ZeroDec(), err
+		}
+		runningUnnormalized, err = runningUnnormalized.Add(weightedDistance)
+		if err != nil {
+			return alloraMath.ZeroDec(), err
+		}
+		sumWeights, err = sumWeights.Add(weights[i])
+		if err != nil {
+			return alloraMath.ZeroDec(), err
+		}
+	}
+	if sumWeights.IsZero() {
+		return alloraMath.ZeroDec(), nil
+	}
+	return runningUnnormalized.Quo(sumWeights)
+}
@@ -0,0 +1,168 @@
+package inference_synthesis_test
+
+import (
+	alloraMath "github.com/allora-network/allora-chain/math"
+	alloratestutil "github.com/allora-network/allora-chain/test/testutil"
+	inferencesynthesis "github.com/allora-network/allora-chain/x/emissions/keeper/inference_synthesis"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+func decsFromStrings(values ...string) []alloraMath.Dec {
+	ret := make([]alloraMath.Dec, 0, len(values))
+	for _, value := range values {
+		ret = append(ret, alloraMath.MustNewDecFromString(value))
+	}
+	return ret
+}
+
+func (s *InferenceSynthesisTestSuite) getDistributionSynthRequest() inferencesynthesis.SynthRequest {
+	k := s.emissionsKeeper
+	ctx := s.ctx
+	topicId := uint64(1)
+
+	err := k.SetInfererNetworkRegret(ctx, topicId, "worker1", emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.2")})
+	s.Require().NoError(err)
+	err = k.SetInfererNetworkRegret(ctx, topicId, "worker2", emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.3")})
+	s.Require().NoError(err)
+	err = k.SetForecasterNetworkRegret(ctx, topicId, "worker3", emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.4")})
+	s.Require().NoError(err)
+
+	return inferencesynthesis.SynthRequest{
+		Ctx:     ctx,
+		K:       k,
+		TopicId: topicId,
+		Inferences: &emissionstypes.Inferences{
+			Inferences: []*emissionstypes.Inference{
+				{Inferer: "worker1", Values: decsFromStrings("0.7", "0.2", "0.1")},
+				{Inferer: "worker2", Values: decsFromStrings("0.1", "0.3", "0.6")},
+			},
+		},
+		Forecasts: &emissionstypes.Forecasts{
+			Forecasts: []*emissionstypes.Forecast{
+				{
+					Forecaster: "worker3",
+					ForecastElements: []*emissionstypes.ForecastElement{
+						{Inferer: "worker1", Value: alloraMath.MustNewDecFromString("0.6")},
+						{Inferer: "worker2", Value: alloraMath.MustNewDecFromString("0.8")},
+					},
+				},
+			},
+		},
+		NetworkCombinedLoss: alloraMath.MustNewDecFromString("0.2"),
+		Epsilon:             alloraMath.MustNewDecFromString("0.0001"),
+		PNorm:               alloraMath.MustNewDecFromString("2"),
+		CNorm:               alloraMath.MustNewDecFromString("0.75"),
+	}
+}
+
+func (s *InferenceSynthesisTestSuite) requireProbabilityDistribution(values []alloraMath.Dec, dimension int) {
+	s.Require().Len(values, dimension)
+	s.Require().NoError(emissionstypes.ValidateProbabilityDistribution(values))
+}
+
+func (s *InferenceSynthesisTestSuite) TestBuildDistributionNetworkInferencesSumToOne() {
+	for _, pooling := range []emissionstypes.DistributionPooling{
+		emissionstypes.DistributionPooling_POOLING_LINEAR,
+		emissionstypes.DistributionPooling_POOLING_LOGARITHMIC,
+	} {
+		req := s.getDistributionSynthRequest()
+		topic := emissionstypes.Topic{
+			Id:                  req.TopicId,
+			OutputDimension:     3,
+			OutputType:          emissionstypes.TopicOutputType_OUTPUT_TYPE_PROBABILITY_DISTRIBUTION,
+			DistributionPooling: pooling,
+		}
+		valueBundle, forecastImpliedInferences, _, err := inferencesynthesis.BuildNetworkInferences(req, topic)
+		s.Require().NoError(err)
+
+		s.requireProbabilityDistribution(valueBundle.CombinedValues, 3)
+		s.requireProbabilityDistribution(valueBundle.NaiveValues, 3)
+		s.Require().Equal(valueBundle.CombinedValues[0], valueBundle.CombinedValue)
+		for _, value := range valueBundle.ForecasterValues {
+			s.requireProbabilityDistribution(value.Values, 3)
+		}
+		for _, value := range valueBundle.OneOutInfererValues {
+			s.requireProbabilityDistribution(value.Values, 3)
+		}
+		for _, inference := range forecastImpliedInferences {
+			s.requireProbabilityDistribution(inference.Values, 3)
+		}
+		// Inferer values are reported as submitted
+		s.Require().Equal(req.Inferences.Inferences[0].Values, valueBundle.InfererValues[0].Values)
+	}
+}
+
+func (s *InferenceSynthesisTestSuite) TestLogPoolingFavoursConsensusClasses() {
+	req := s.getDistributionSynthRequest()
+	req.Forecasts = &emissionstypes.Forecasts{}
+	req.Inferences = &emissionstypes.Inferences{
+		Inferences: []*emissionstypes.Inference{
+			{Inferer: "worker1", Values: decsFromStrings("0.5", "0.5")},
+			{Inferer: "worker2", Values: decsFromStrings("0", "1")},
+		},
+	}
+	topic := emissionstypes.Topic{
+		Id:              req.TopicId,
+		OutputDimension: 2,
+		OutputType:      emissionstypes.TopicOutputType_OUTPUT_TYPE_PROBABILITY_DISTRIBUTION,
+	}
+
+	linear, _, _, err := inferencesynthesis.BuildNetworkInferences(req, topic)
+	s.Require().NoError(err)
+	topic.DistributionPooling = emissionstypes.DistributionPooling_POOLING_LOGARITHMIC
+	logarithmic, _, _, err := inferencesynthesis.BuildNetworkInferences(req, topic)
+	s.Require().NoError(err)
+
+	// A class ruled out by one worker keeps more probability under linear pooling
+	s.Require().True(linear.CombinedValues[0].Gt(logarithmic.CombinedValues[0]))
+	s.requireProbabilityDistribution(logarithmic.CombinedValues, 2)
+}
+
+func (s *InferenceSynthesisTestSuite) TestNormalizeDistribution() {
+	normalized, err := inferencesynthesis.NormalizeDistribution(decsFromStrings("1", "3", "-2"))
+	s.Require().NoError(err)
+	alloratestutil.InEpsilon5(s.T(), normalized[0], "0.25")
+	alloratestutil.InEpsilon5(s.T(), normalized[1], "0.75")
+	s.Require().True(normalized[2].IsZero())
+
+	normalized, err = inferencesynthesis.NormalizeDistribution(decsFromStrings("0", "-1"))
+	s.Require().NoError(err)
+	alloratestutil.InEpsilon5(s.T(), normalized[0], "0.5")
+	alloratestutil.InEpsilon5(s.T(), normalized[1], "0.5")
+}
+
+func (s *InferenceSynthesisTestSuite) TestCalcDistributionConfidence() {
+	valueBundle := &emissionstypes.ValueBundle{
+		CombinedValues: decsFromStrings("0.5", "0.5"),
+		InfererValues: []*emissionstypes.WorkerAttributedValue{
+			{Worker: "worker1", Values: decsFromStrings("1", "0")},
+			{Worker: "worker2", Values: decsFromStrings("0.5", "0.5")},
+		},
+		ForecasterValues: []*emissionstypes.WorkerAttributedValue{
+			{Worker: "worker3"},
+		},
+	}
+	forecastImpliedInferences := map[string]*emissionstypes.Inference{
+		"worker3": {Inferer: "worker3", Values: decsFromStrings("0.2", "0.8")},
+	}
+	infererWeights := map[string]alloraMath.Dec{
+		"worker1": alloraMath.NewDecFromInt64(1),
+		"worker2": alloraMath.NewDecFromInt64(2),
+	}
+	forecasterWeights := map[string]alloraMath.Dec{
+		"worker3": alloraMath.NewDecFromInt64(1),
+	}
+
+	confidence, err := inferencesynthesis.CalcDistributionConfidence(valueBundle, forecastImpliedInferences, infererWeights, forecasterWeights)
+	s.Require().NoError(err)
+	// ln 2
+	alloratestutil.InEpsilon5(s.T(), confidence.Entropy, "0.6931471805599453")
+	s.Require().Equal(uint64(0), confidence.MostLikelyClass)
+	alloratestutil.InEpsilon5(s.T(), confidence.MaxProbability, "0.5")
+	// (1 * 0.5 + 2 * 0 + 1 * 0.3) / 4
+	alloratestutil.InEpsilon5(s.T(), confidence.MeanTotalVariationDistance, "0.2")
+
+	confidence, err = inferencesynthesis.CalcDistributionConfidence(&emissionstypes.ValueBundle{}, nil, nil, nil)
+	s.Require().NoError(err)
+	s.Require().Nil(confidence)
+}
@@ -23,6 +23,28 @@ type NetworkInferenceBuilder struct {
 	oneOutInfererInferences    []*emissions.WithheldWorkerAttributedValue
 	oneOutForecasterInferences []*emissions.WithheldWorkerAttributedValue
 	oneInInferences            []*emissions.WorkerAttributedValue
+	// Weights calculated for each network inference, keyed by inference, so that the other
+	// components of a vector-valued topic can be combined without calculating them again
+	weightsByInference map[string]RegretInformedWeights
+	// Weights reused from the first component of a vector-valued topic, nil when weights are calculated
+	reusedWeights map[string]RegretInformedWeights
+}
+
+const (
+	combinedInferenceWeightsKey = "combined"
+	naiveInferenceWeightsKey    = "naive"
+)
+
+func oneOutInfererWeightsKey(inferer Worker) string {
+	return "one-out-inferer/" + inferer
+}
+
+func oneOutForecasterWeightsKey(forecaster Worker) string {
+	return "one-out-forecaster/" + forecaster
+}
+
+func oneInWeightsKey(forecaster Worker) string {
+	return "one-in/" + forecaster
 }
 
 func NewNetworkInferenceBuilderFromSynthRequest(
@@ -34,24 +56,61 @@ func NewNetworkInferenceBuilderFromSynthRequest(
 		return nil, errorsmod.Wrapf(err, "Error building palette from request")
 	}
 	return &NetworkInferenceBuilder{
-		ctx:     req.Ctx,
-		logger:  Logger(req.Ctx),
-		palette: palette,
+		ctx:                req.Ctx,
+		logger:             Logger(req.Ctx),
+		palette:            palette,
+		weightsByInference: make(map[string]RegretInformedWeights),
 	}, nil
 }
 
+// Returns a builder of the network inferences of the given palette that combines values with the weights
+// already calculated by this builder. Weights depend on regrets, stakes or scores, never on the values,
+// so they are the same for every component of a vector-valued topic.
+func (b *NetworkInferenceBuilder) withPaletteReusingWeights(palette SynthPalette) *NetworkInferenceBuilder {
+	return &NetworkInferenceBuilder{
+		ctx:                b.ctx,
+		logger:             b.logger,
+		palette:            palette,
+		weightsByInference: make(map[string]RegretInformedWeights),
+		reusedWeights:      b.weightsByInference,
+	}
+}
+
+// Returns the weights reused for the given inference, if any
+func (b *NetworkInferenceBuilder) getReusedWeights(key string) (RegretInformedWeights, bool) {
+	if b.reusedWeights == nil {
+		return RegretInformedWeights{}, false
+	}
+	weights, ok := b.reusedWeights[key]
+	return weights, ok
+}
+
+// Returns the weights reused for the given inference if any, else calculates them from the palette
+func (b *NetworkInferenceBuilder) calcWeights(key string, palette *SynthPalette) (RegretInformedWeights, error) {
+	weights, ok := b.getReusedWeights(key)
+	if !ok {
+		var err error
+		weights, err = palette.CalcCombinerWeights()
+		if err != nil {
+			return RegretInformedWeights{}, err
+		}
+	}
+	b.weightsByInference[key] = weights
+	return weights, nil
+}
+
 // Calculates the network combined inference I_i, Equation 9
 func (b *NetworkInferenceBuilder) SetCombinedValue() *NetworkInferenceBuilder {
 	b.logger.Debug(fmt.Sprintf("Calculating combined inference for topic %v", b.palette.TopicId))
 	palette := b.palette.Clone()
 
-	weights, err := palette.CalcWeightsGivenWorkers()
+	weights, err := b.calcWeights(combinedInferenceWeightsKey, &palette)
 	if err != nil {
 		b.logger.Warn(fmt.Sprintf("Error calculating weights for combined inference: %s", err.Error()))
 		return b
 	}
 
-	combinedInference, err := palette.CalcWeightedInference(weights)
+	combinedInference, err := palette.CalcCombinedInference(weights)
 	if err != nil {
 		b.logger.Warn(fmt.Sprintf("Error calculating combined inference: %s", err.Error()))
 		return b
@@ -100,13 +159,13 @@ func (b *NetworkInferenceBuilder) SetNaiveValue() *NetworkInferenceBuilder {
 
 	palette.Forecasters = nil
 	palette.ForecasterRegrets = make(map[string]*alloraMath.Dec, 0)
-	weights, err := palette.CalcWeightsGivenWorkers()
+	weights, err := b.calcWeights(naiveInferenceWeightsKey, &palette)
 	if err != nil {
 		b.logger.Warn(fmt.Sprintf("Error calculating weights for naive inference: %s", err.Error()))
 		return b
 	}
 
-	naiveInference, err := palette.CalcWeightedInference(weights)
+	naiveInference, err := palette.CalcCombinedInference(weights)
 	if err != nil {
 		b.logger.Warn(fmt.Sprintf("Error calculating naive inference: %s", err.Error()))
 		return b
@@ -144,12 +203,12 @@ func (b *NetworkInferenceBuilder) calcOneOutInfererInference(withheldInferer Wor
 	}
 
 	paletteCopy.ForecastImpliedInferenceByWorker = palette.ForecastImpliedInferenceByWorker
-	weights, err := paletteCopy.CalcWeightsGivenWorkers()
+	weights, err := b.calcWeights(oneOutInfererWeightsKey(withheldInferer), &paletteCopy)
 	if err != nil {
 		return alloraMath.Dec{}, errorsmod.Wrapf(err, "Error calculating one-out inference for forecaster")
 	}
 
-	oneOutNetworkInferenceWithoutInferer, err := paletteCopy.CalcWeightedInference(weights)
+	oneOutNetworkInferenceWithoutInferer, err := paletteCopy.CalcCombinedInference(weights)
 	if err != nil {
 		return alloraMath.Dec{}, errorsmod.Wrapf(err, "Error calculating one-out inference for inferer")
 	}
@@ -203,12 +262,12 @@ func (b *NetworkInferenceBuilder) calcOneOutForecasterInference(withheldForecast
 		return alloraMath.Dec{}, errorsmod.Wrapf(err, "Error updating forecasters")
 	}
 
-	weights, err := palette.CalcWeightsGivenWorkers()
+	weights, err := b.calcWeights(oneOutForecasterWeightsKey(withheldForecaster), &palette)
 	if err != nil {
 		return alloraMath.Dec{}, errorsmod.Wrapf(err, "Error calculating one-out inference for forecaster")
 	}
 
-	oneOutNetworkInferenceWithoutInferer, err := palette.CalcWeightedInference(weights)
+	oneOutNetworkInferenceWithoutInferer, err := palette.CalcCombinedInference(weights)
 	if err != nil {
 		return alloraMath.Dec{}, errorsmod.Wrapf(err, "Error calculating one-out inference for inferer")
 	}
@@ -253,6 +312,15 @@ func (b *NetworkInferenceBuilder) calcOneInValue(oneInForecaster Worker) (allora
 	forecastImpliedInferencesWithForecaster[oneInForecaster] = palette.ForecastImpliedInferenceByWorker[oneInForecaster]
 	palette.ForecastImpliedInferenceByWorker = forecastImpliedInferencesWithForecaster
 
+	// The one-in regrets only matter to the weights, so they need not be read again to reuse them
+	if weights, ok := b.getReusedWeights(oneInWeightsKey(oneInForecaster)); ok {
+		if err := palette.UpdateForecastersInfo([]Worker{oneInForecaster}); err != nil {
+			return alloraMath.Dec{}, errorsmod.Wrapf(err, "Error updating forecasters")
+		}
+		b.weightsByInference[oneInWeightsKey(oneInForecaster)] = weights
+		return palette.CalcCombinedInference(weights)
+	}
+
 	regret, _, err := palette.K.GetOneInForecasterSelfNetworkRegret(palette.Ctx, palette.TopicId, oneInForecaster)
 	if err != nil {
 		return alloraMath.Dec{}, errorsmod.Wrapf(err, "Error getting one-in forecaster regret")
@@ -281,12 +349,12 @@ func (b *NetworkInferenceBuilder) calcOneInValue(oneInForecaster Worker) (allora
 		return alloraMath.Dec{}, errorsmod.Wrapf(err, "Error updating inferers")
 	}
 
-	weights, err := palette.CalcWeightsGivenWorkers()
+	weights, err := b.calcWeights(oneInWeightsKey(oneInForecaster), &palette)
 	if err != nil {
 		return alloraMath.Dec{}, errorsmod.Wrapf(err, "Error calculating weights for one-in inferences")
 	}
 	// Calculate the network inference with just this forecaster's forecast-implied inference
-	oneInInference, err := palette.CalcWeightedInference(weights)
+	oneInInference, err := palette.CalcCombinedInference(weights)
 	if err != nil {
 		return alloraMath.Dec{}, errorsmod.Wrapf(err, "Error calculating one-in inference")
 	}
@@ -0,0 +1,152 @@
+package inference_synthesis
+
+import (
+	"fmt"
+
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	emissions "github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Calculates the regret-informed weights the network inference of every horizon of the topic is combined with
+// at a block, then stores and emits them, so that moves of the network value can be explained.
+// Only the weights of the combined inference are calculated, as they depend on regrets that move on. Forecast-implied
+// inferences only depend on the stored work and losses, so they are recalculated when queried.
+// Horizons whose weights cannot be calculated, or that are not combined from several workers, are skipped.
+func GetCalcSetNetworkInferenceWeights(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	topic emissions.Topic,
+	inferencesNonce BlockHeight,
+) error {
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	for horizon := Horizon(0); horizon < topic.NumHorizons(); horizon++ {
+		weights, err := calcNetworkInferenceWeightsAtBlock(ctx, k, topic, inferencesNonce, horizon, moduleParams.CNorm)
+		if err != nil {
+			Logger(ctx).Debug(fmt.Sprintf("Skipping network inference weights of topic %v at block %v and horizon %v: %s", topic.Id, inferencesNonce, horizon, err.Error()))
+			continue
+		}
+		if len(weights.inferers) == 0 && len(weights.forecasters) == 0 {
+			continue
+		}
+
+		networkInferenceWeights := NewNetworkInferenceWeights(topic.Id, inferencesNonce, horizon, nil, weights.inferers, weights.forecasters)
+		if err := k.SetNetworkInferenceWeights(ctx, networkInferenceWeights); err != nil {
+			return err
+		}
+		emissions.EmitNewNetworkInferenceWeightsSetEvent(ctx, networkInferenceWeights)
+	}
+	return nil
+}
+
+// Calculates the weights of the combined network inference of a horizon of the topic at a block.
+// Weights are the same for every component of a vector-valued topic, so they are calculated from the first one.
+func calcNetworkInferenceWeightsAtBlock(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	topic emissions.Topic,
+	inferencesNonce BlockHeight,
+	horizon Horizon,
+	cNorm alloraMath.Dec,
+) (RegretInformedWeights, error) {
+	inferences, forecasts, err := getWorkAtBlockForHorizon(ctx, k, topic.Id, inferencesNonce, horizon)
+	if err != nil {
+		return RegretInformedWeights{}, err
+	}
+	// A single inference is not combined
+	if len(inferences.Inferences) <= 1 {
+		return RegretInformedWeights{}, nil
+	}
+	previousLossNonce, err := latestLossBlockHeightOfHorizon(topic, horizon, inferencesNonce)
+	if err != nil {
+		return RegretInformedWeights{}, err
+	}
+	networkLosses, err := k.GetNetworkLossBundleAtBlockForHorizon(ctx, topic.Id, horizon, previousLossNonce)
+	if err != nil {
+		return RegretInformedWeights{}, err
+	}
+	if topic.OutputDimension > 1 {
+		inferences = projectInferencesOntoComponent(inferences, 0)
+		forecasts = projectForecastsOntoComponent(forecasts, 0)
+	}
+
+	paletteFactory := SynthPaletteFactory{}
+	palette, err := paletteFactory.BuildPaletteFromRequest(SynthRequest{
+		Ctx:                 ctx,
+		K:                   k,
+		TopicId:             topic.Id,
+		Inferences:          inferences,
+		Forecasts:           forecasts,
+		NetworkCombinedLoss: networkLosses.CombinedValue,
+		Epsilon:             topic.Epsilon,
+		PNorm:               topic.PNorm,
+		CNorm:               cNorm,
+	})
+	if err != nil {
+		return RegretInformedWeights{}, err
+	}
+	return palette.CalcCombinerWeights()
+}
+
+// Recalculates the forecast-implied inferences of the network inference of a horizon of the topic at a block,
+// for the given forecasters
+func GetForecastImpliedInferencesAtBlock(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	topic emissions.Topic,
+	inferencesNonce BlockHeight,
+	horizon Horizon,
+	forecasters []string,
+) ([]*emissions.WorkerAttributedValue, error) {
+	previousLossNonce, err := latestLossBlockHeightOfHorizon(topic, horizon, inferencesNonce)
+	if err != nil {
+		return nil, err
+	}
+	_, forecastImpliedInferenceByWorker, _, _, err := GetNetworkInferencesAtBlock(ctx, k, topic.Id, inferencesNonce, previousLossNonce, horizon)
+	if err != nil {
+		return nil, err
+	}
+	return forecastImpliedInferencesOf(forecasters, forecastImpliedInferenceByWorker), nil
+}
+
+// Collects the weights and forecast-implied inferences of a network inference, sorted by worker
+func NewNetworkInferenceWeights(
+	topicId TopicId,
+	inferencesNonce BlockHeight,
+	horizon Horizon,
+	forecastImpliedInferenceByWorker map[string]*emissions.Inference,
+	infererWeights map[string]alloraMath.Dec,
+	forecasterWeights map[string]alloraMath.Dec,
+) emissions.NetworkInferenceWeights {
+	forecasters := alloraMath.GetSortedKeys(forecasterWeights)
+	return emissions.NetworkInferenceWeights{
+		TopicId:                   topicId,
+		BlockHeight:               inferencesNonce,
+		Horizon:                   horizon,
+		InfererWeights:            ConvertWeightsToArrays(alloraMath.GetSortedKeys(infererWeights), infererWeights),
+		ForecasterWeights:         ConvertWeightsToArrays(forecasters, forecasterWeights),
+		ForecastImpliedInferences: forecastImpliedInferencesOf(forecasters, forecastImpliedInferenceByWorker),
+	}
+}
+
+// Lists the forecast-implied inferences of the given forecasters, skipping those without one
+func forecastImpliedInferencesOf(
+	forecasters []string,
+	forecastImpliedInferenceByWorker map[string]*emissions.Inference,
+) []*emissions.WorkerAttributedValue {
+	forecastImpliedInferences := make([]*emissions.WorkerAttributedValue, 0, len(forecasters))
+	for _, forecaster := range forecasters {
+		if inference, ok := forecastImpliedInferenceByWorker[forecaster]; ok && inference != nil {
+			forecastImpliedInferences = append(forecastImpliedInferences, &emissions.WorkerAttributedValue{
+				Worker: forecaster,
+				Value:  inference.Value,
+				Values: inference.Values,
+			})
+		}
+	}
+	return forecastImpliedInferences
+}
@@ -0,0 +1,115 @@
+package inference_synthesis_test
+
+import (
+	"cosmossdk.io/collections"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/test/testutil"
+	inferencesynthesis "github.com/allora-network/allora-chain/x/emissions/keeper/inference_synthesis"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+func (s *InferenceSynthesisTestSuite) TestGetCalcSetNetworkInferenceWeights() {
+	k := s.emissionsKeeper
+	topicId := uint64(1)
+	blockHeight := int64(300)
+	previousLossBlockHeight := int64(200)
+
+	topic := emissionstypes.Topic{
+		Id:          topicId,
+		Creator:     "creator",
+		EpochLength: 100,
+		PNorm:       alloraMath.NewDecFromInt64(3),
+		AlphaRegret: alloraMath.MustNewDecFromString("0.1"),
+		Epsilon:     alloraMath.MustNewDecFromString("0.01"),
+	}
+	s.Require().NoError(k.SetTopic(s.ctx, topicId, topic))
+
+	worker1 := "worker1"
+	worker2 := "worker2"
+	forecaster := "forecaster"
+
+	err := k.InsertNetworkLossBundleAtBlock(s.ctx, topicId, previousLossBlockHeight, emissionstypes.ValueBundle{
+		TopicId:       topicId,
+		CombinedValue: alloraMath.MustNewDecFromString("0.5"),
+	})
+	s.Require().NoError(err)
+	err = k.InsertInferences(s.ctx, topicId, emissionstypes.Nonce{BlockHeight: blockHeight}, emissionstypes.Inferences{
+		Inferences: []*emissionstypes.Inference{
+			{TopicId: topicId, BlockHeight: blockHeight, Inferer: worker1, Value: alloraMath.MustNewDecFromString("1")},
+			{TopicId: topicId, BlockHeight: blockHeight, Inferer: worker2, Value: alloraMath.MustNewDecFromString("2")},
+		},
+	})
+	s.Require().NoError(err)
+	err = k.InsertForecasts(s.ctx, topicId, emissionstypes.Nonce{BlockHeight: blockHeight}, emissionstypes.Forecasts{
+		Forecasts: []*emissionstypes.Forecast{
+			{
+				TopicId:     topicId,
+				BlockHeight: blockHeight,
+				Forecaster:  forecaster,
+				ForecastElements: []*emissionstypes.ForecastElement{
+					{Inferer: worker1, Value: alloraMath.MustNewDecFromString("0.4")},
+					{Inferer: worker2, Value: alloraMath.MustNewDecFromString("0.6")},
+				},
+			},
+		},
+	})
+	s.Require().NoError(err)
+
+	err = k.SetInfererNetworkRegret(s.ctx, topicId, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.2")})
+	s.Require().NoError(err)
+	err = k.SetInfererNetworkRegret(s.ctx, topicId, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.3")})
+	s.Require().NoError(err)
+	err = k.SetForecasterNetworkRegret(s.ctx, topicId, forecaster, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.4")})
+	s.Require().NoError(err)
+
+	err = inferencesynthesis.GetCalcSetNetworkInferenceWeights(s.ctx, k, topic, blockHeight)
+	s.Require().NoError(err)
+
+	_, forecastImpliedInferenceByWorker, infererWeights, forecasterWeights, err :=
+		inferencesynthesis.GetNetworkInferencesAtBlock(s.ctx, k, topicId, blockHeight, previousLossBlockHeight, 0)
+	s.Require().NoError(err)
+
+	stored, err := k.GetNetworkInferenceWeightsAtBlockForHorizon(s.ctx, topicId, blockHeight, 0)
+	s.Require().NoError(err)
+	s.Require().Len(stored.InfererWeights, 2)
+	s.Require().Equal(worker1, stored.InfererWeights[0].Worker)
+	testutil.InEpsilon5(s.T(), stored.InfererWeights[0].Weight, infererWeights[worker1].String())
+	testutil.InEpsilon5(s.T(), stored.InfererWeights[1].Weight, infererWeights[worker2].String())
+	s.Require().Len(stored.ForecasterWeights, 1)
+	testutil.InEpsilon5(s.T(), stored.ForecasterWeights[0].Weight, forecasterWeights[forecaster].String())
+	// Forecast-implied inferences are not stored but recalculated from the stored work
+	s.Require().Empty(stored.ForecastImpliedInferences)
+	forecastImpliedInferences, err := inferencesynthesis.GetForecastImpliedInferencesAtBlock(s.ctx, k, topic, blockHeight, 0, []string{forecaster})
+	s.Require().NoError(err)
+	s.Require().Len(forecastImpliedInferences, 1)
+	testutil.InEpsilon5(s.T(), forecastImpliedInferences[0].Value, forecastImpliedInferenceByWorker[forecaster].Value.String())
+
+	found := false
+	for _, event := range s.ctx.EventManager().Events() {
+		if event.Type == "emissions.v1.EventNetworkInferenceWeightsSet" {
+			found = true
+		}
+	}
+	s.Require().True(found, "network inference weights event should be emitted")
+}
+
+func (s *InferenceSynthesisTestSuite) TestGetCalcSetNetworkInferenceWeightsSkipsSingleInference() {
+	k := s.emissionsKeeper
+	topicId := uint64(1)
+	blockHeight := int64(300)
+
+	topic := emissionstypes.Topic{Id: topicId, Creator: "creator", EpochLength: 100}
+	s.Require().NoError(k.SetTopic(s.ctx, topicId, topic))
+	err := k.InsertInferences(s.ctx, topicId, emissionstypes.Nonce{BlockHeight: blockHeight}, emissionstypes.Inferences{
+		Inferences: []*emissionstypes.Inference{
+			{TopicId: topicId, BlockHeight: blockHeight, Inferer: "worker1", Value: alloraMath.MustNewDecFromString("1")},
+		},
+	})
+	s.Require().NoError(err)
+
+	err = inferencesynthesis.GetCalcSetNetworkInferenceWeights(s.ctx, k, topic, blockHeight)
+	s.Require().NoError(err)
+
+	_, err = k.GetNetworkInferenceWeightsAtBlock(s.ctx, topicId, blockHeight)
+	s.Require().ErrorIs(err, collections.ErrNotFound)
+}
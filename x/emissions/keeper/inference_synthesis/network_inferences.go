@@ -15,13 +15,14 @@ import (
 
 // Calculates all network inferences in the set I_i given historical state (e.g. regrets)
 // and data from workers (e.g. inferences, forecast-implied inferences)
-// as of a specified block height
+// as of a specified block height, for the given horizon of the topic
 func GetNetworkInferencesAtBlock(
 	ctx sdk.Context,
 	k keeper.Keeper,
 	topicId TopicId,
 	inferencesNonce BlockHeight,
 	previousLossNonce BlockHeight,
+	horizon Horizon,
 ) (
 	*emissions.ValueBundle,
 	map[string]*emissions.Inference,
@@ -31,26 +32,29 @@ func GetNetworkInferencesAtBlock(
 ) {
 	Logger(ctx).Debug(fmt.Sprintf("Calculating network inferences for topic %v at inference nonce %v with previous loss nonce %v", topicId, inferencesNonce, previousLossNonce))
 
-	networkInferences := &emissions.ValueBundle{
-		TopicId:          topicId,
-		InfererValues:    make([]*emissions.WorkerAttributedValue, 0),
-		ForecasterValues: make([]*emissions.WorkerAttributedValue, 0),
+	inferences, forecasts, err := getWorkAtBlockForHorizon(ctx, k, topicId, inferencesNonce, horizon)
+	if err != nil {
+		return nil, nil, nil, nil, err
 	}
+	return calcNetworkInferencesFromWork(ctx, k, topicId, inferences, forecasts, previousLossNonce, horizon)
+}
 
-	forecastImpliedInferencesByWorker := make(map[string]*emissions.Inference, 0)
-	var infererWeights map[string]alloraMath.Dec
-	var forecasterWeights map[string]alloraMath.Dec
-
+// Gets the inferences and forecasts of a horizon of the topic stored at a block.
+// Errors if there are no inferences, while missing forecasts are returned as an empty set.
+func getWorkAtBlockForHorizon(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	topicId TopicId,
+	inferencesNonce BlockHeight,
+	horizon Horizon,
+) (*emissions.Inferences, *emissions.Forecasts, error) {
 	inferences, err := k.GetInferencesAtBlock(ctx, topicId, inferencesNonce)
 	if err != nil {
-		return nil, nil, infererWeights, forecasterWeights, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "no inferences found for topic %v at block %v", topicId, inferencesNonce)
+		return nil, nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "no inferences found for topic %v at block %v", topicId, inferencesNonce)
 	}
-	// Add inferences in the bundle -> this bundle will be used as a fallback in case of error
-	for _, infererence := range inferences.Inferences {
-		networkInferences.InfererValues = append(networkInferences.InfererValues, &emissions.WorkerAttributedValue{
-			Worker: infererence.Inferer,
-			Value:  infererence.Value,
-		})
+	inferences = inferences.AtHorizon(horizon)
+	if len(inferences.Inferences) == 0 {
+		return nil, nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "no inferences found for topic %v at block %v and horizon %v", topicId, inferencesNonce, horizon)
 	}
 
 	forecasts, err := k.GetForecastsAtBlock(ctx, topicId, inferencesNonce)
@@ -60,9 +64,47 @@ func GetNetworkInferencesAtBlock(
 				Forecasts: make([]*emissions.Forecast, 0),
 			}
 		} else {
-			return nil, nil, infererWeights, forecasterWeights, err
+			return nil, nil, err
 		}
 	}
+	return inferences, forecasts.AtHorizon(horizon), nil
+}
+
+// Calculates the network inferences of a horizon of the topic from the given inferences and forecasts,
+// weighting workers by their regrets against the network losses at previousLossNonce
+func calcNetworkInferencesFromWork(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	topicId TopicId,
+	inferences *emissions.Inferences,
+	forecasts *emissions.Forecasts,
+	previousLossNonce BlockHeight,
+	horizon Horizon,
+) (
+	*emissions.ValueBundle,
+	map[string]*emissions.Inference,
+	map[string]alloraMath.Dec,
+	map[string]alloraMath.Dec,
+	error,
+) {
+	networkInferences := &emissions.ValueBundle{
+		TopicId:          topicId,
+		InfererValues:    make([]*emissions.WorkerAttributedValue, 0),
+		ForecasterValues: make([]*emissions.WorkerAttributedValue, 0),
+	}
+
+	forecastImpliedInferencesByWorker := make(map[string]*emissions.Inference, 0)
+	var infererWeights map[string]alloraMath.Dec
+	var forecasterWeights map[string]alloraMath.Dec
+
+	// Add inferences in the bundle -> this bundle will be used as a fallback in case of error
+	for _, infererence := range inferences.Inferences {
+		networkInferences.InfererValues = append(networkInferences.InfererValues, &emissions.WorkerAttributedValue{
+			Worker: infererence.Inferer,
+			Value:  InferenceScalarValue(infererence),
+			Values: infererence.Values,
+		})
+	}
 
 	if len(inferences.Inferences) > 1 {
 		moduleParams, err := k.GetParams(ctx)
@@ -70,7 +112,7 @@ func GetNetworkInferencesAtBlock(
 			return nil, nil, infererWeights, forecasterWeights, err
 		}
 
-		networkLosses, err := k.GetNetworkLossBundleAtBlock(ctx, topicId, previousLossNonce)
+		networkLosses, err := k.GetNetworkLossBundleAtBlockForHorizon(ctx, topicId, horizon, previousLossNonce)
 		if err != nil {
 			Logger(ctx).Warn(fmt.Sprintf("Error getting network losses: %s", err.Error()))
 			return networkInferences, nil, infererWeights, forecasterWeights, nil
@@ -83,7 +125,7 @@ func GetNetworkInferencesAtBlock(
 		}
 
 		Logger(ctx).Debug(fmt.Sprintf("Creating network inferences for topic %v with %v inferences and %v forecasts", topicId, len(inferences.Inferences), len(forecasts.Forecasts)))
-		networkInferenceBuilder, err := NewNetworkInferenceBuilderFromSynthRequest(
+		builtNetworkInferences, builtForecastImpliedInferencesByWorker, weights, err := BuildNetworkInferences(
 			SynthRequest{
 				Ctx:                 ctx,
 				K:                   k,
@@ -95,45 +137,31 @@ func GetNetworkInferencesAtBlock(
 				PNorm:               topic.PNorm,
 				CNorm:               moduleParams.CNorm,
 			},
+			topic,
 		)
 		if err != nil {
 			Logger(ctx).Warn(fmt.Sprintf("Error constructing network inferences builder topic: %s", err.Error()))
 			return nil, nil, infererWeights, forecasterWeights, err
 		}
-		networkInferences = networkInferenceBuilder.CalcAndSetNetworkInferences().Build()
-
-		forecastImpliedInferencesByWorker = networkInferenceBuilder.palette.ForecastImpliedInferenceByWorker
-		infererWeights = networkInferenceBuilder.weights.inferers
-		forecasterWeights = networkInferenceBuilder.weights.forecasters
+		networkInferences = builtNetworkInferences
+		forecastImpliedInferencesByWorker = builtForecastImpliedInferencesByWorker
+		infererWeights = weights.inferers
+		forecasterWeights = weights.forecasters
 	} else {
 		// If there is only one valid inference, then the network inference is the same as the single inference
 		// For the forecasts to be meaningful, there should be at least 2 inferences
-		singleInference := inferences.Inferences[0]
-
-		networkInferences = &emissions.ValueBundle{
-			TopicId:       topicId,
-			CombinedValue: singleInference.Value,
-			InfererValues: []*emissions.WorkerAttributedValue{
-				{
-					Worker: singleInference.Inferer,
-					Value:  singleInference.Value,
-				},
-			},
-			ForecasterValues:       []*emissions.WorkerAttributedValue{},
-			NaiveValue:             singleInference.Value,
-			OneOutInfererValues:    []*emissions.WithheldWorkerAttributedValue{},
-			OneOutForecasterValues: []*emissions.WithheldWorkerAttributedValue{},
-			OneInForecasterValues:  []*emissions.WorkerAttributedValue{},
-		}
+		networkInferences = NewValueBundleFromSingleInference(topicId, inferences.Inferences[0])
 	}
 
 	return networkInferences, forecastImpliedInferencesByWorker, infererWeights, forecasterWeights, nil
 }
 
+// Calculates the network inferences of the given horizon of the topic from its latest inferences
 func GetLatestNetworkInference(
 	ctx sdk.Context,
 	k keeper.Keeper,
 	topicId TopicId,
+	horizon Horizon,
 ) (
 	*emissions.ValueBundle,
 	map[string]*emissions.Inference,
@@ -156,6 +184,9 @@ func GetLatestNetworkInference(
 	var forecasterWeights map[string]alloraMath.Dec
 
 	inferences, inferenceBlockHeight, err := k.GetLatestTopicInferences(ctx, topicId)
+	if err == nil {
+		inferences = inferences.AtHorizon(horizon)
+	}
 	if err != nil || len(inferences.Inferences) == 0 {
 		if err != nil {
 			Logger(ctx).Warn(fmt.Sprintf("Error getting inferences: %s", err.Error()))
@@ -165,7 +196,8 @@ func GetLatestNetworkInference(
 	for _, infererence := range inferences.Inferences {
 		networkInferences.InfererValues = append(networkInferences.InfererValues, &emissions.WorkerAttributedValue{
 			Worker: infererence.Inferer,
-			Value:  infererence.Value,
+			Value:  InferenceScalarValue(infererence),
+			Values: infererence.Values,
 		})
 	}
 
@@ -180,6 +212,7 @@ func GetLatestNetworkInference(
 			return networkInferences, nil, infererWeights, forecasterWeights, inferenceBlockHeight, lossBlockHeight, nil
 		}
 	}
+	forecasts = forecasts.AtHorizon(horizon)
 
 	if len(inferences.Inferences) > 1 {
 		moduleParams, err := k.GetParams(ctx)
@@ -191,18 +224,21 @@ func GetLatestNetworkInference(
 			Logger(ctx).Warn(fmt.Sprintf("Error getting topic: %s", err.Error()))
 			return networkInferences, nil, infererWeights, forecasterWeights, inferenceBlockHeight, lossBlockHeight, nil
 		}
-		lossBlockHeight = inferenceBlockHeight - topic.EpochLength
+		lossBlockHeight, err = latestLossBlockHeightOfHorizon(topic, horizon, inferenceBlockHeight)
+		if err != nil {
+			return networkInferences, nil, infererWeights, forecasterWeights, inferenceBlockHeight, lossBlockHeight, err
+		}
 		if lossBlockHeight < 0 {
 			Logger(ctx).Warn("Network inference is not available for the epoch yet")
 			return networkInferences, nil, infererWeights, forecasterWeights, inferenceBlockHeight, lossBlockHeight, nil
 		}
-		networkLosses, err := k.GetNetworkLossBundleAtBlock(ctx, topicId, lossBlockHeight)
+		networkLosses, err := k.GetNetworkLossBundleAtBlockForHorizon(ctx, topicId, horizon, lossBlockHeight)
 
 		if err != nil {
 			Logger(ctx).Warn(fmt.Sprintf("Error getting network losses: %s", err.Error()))
 			return networkInferences, nil, infererWeights, forecasterWeights, inferenceBlockHeight, lossBlockHeight, nil
 		}
-		networkInferenceBuilder, err := NewNetworkInferenceBuilderFromSynthRequest(
+		builtNetworkInferences, builtForecastImpliedInferencesByWorker, weights, err := BuildNetworkInferences(
 			SynthRequest{
 				Ctx:                 ctx,
 				K:                   k,
@@ -214,36 +250,36 @@ func GetLatestNetworkInference(
 				PNorm:               topic.PNorm,
 				CNorm:               moduleParams.CNorm,
 			},
+			topic,
 		)
 		if err != nil {
 			Logger(ctx).Warn(fmt.Sprintf("Error constructing network inferences builder topic: %s", err.Error()))
 			return networkInferences, nil, infererWeights, forecasterWeights, inferenceBlockHeight, lossBlockHeight, err
 		}
-		networkInferences = networkInferenceBuilder.CalcAndSetNetworkInferences().Build()
-		forecastImpliedInferencesByWorker = networkInferenceBuilder.palette.ForecastImpliedInferenceByWorker
-		infererWeights = networkInferenceBuilder.weights.inferers
-		forecasterWeights = networkInferenceBuilder.weights.forecasters
+		networkInferences = builtNetworkInferences
+		forecastImpliedInferencesByWorker = builtForecastImpliedInferencesByWorker
+		infererWeights = weights.inferers
+		forecasterWeights = weights.forecasters
 	} else {
 		// If there is only one valid inference, then the network inference is the same as the single inference
 		// For the forecasts to be meaningful, there should be at least 2 inferences
-		singleInference := inferences.Inferences[0]
-
-		networkInferences = &emissions.ValueBundle{
-			TopicId:       topicId,
-			CombinedValue: singleInference.Value,
-			InfererValues: []*emissions.WorkerAttributedValue{
-				{
-					Worker: singleInference.Inferer,
-					Value:  singleInference.Value,
-				},
-			},
-			ForecasterValues:       []*emissions.WorkerAttributedValue{},
-			NaiveValue:             singleInference.Value,
-			OneOutInfererValues:    []*emissions.WithheldWorkerAttributedValue{},
-			OneOutForecasterValues: []*emissions.WithheldWorkerAttributedValue{},
-			OneInForecasterValues:  []*emissions.WorkerAttributedValue{},
-		}
+		networkInferences = NewValueBundleFromSingleInference(topicId, inferences.Inferences[0])
 	}
 
 	return networkInferences, forecastImpliedInferencesByWorker, infererWeights, forecasterWeights, inferenceBlockHeight, lossBlockHeight, nil
 }
+
+// Losses of a longer horizon are revealed later: the latest available ones are those of the epoch before,
+// moved back by as many epochs as it takes to cover the extra lag of the horizon over the first one
+func latestLossBlockHeightOfHorizon(topic emissions.Topic, horizon Horizon, inferenceBlockHeight BlockHeight) (BlockHeight, error) {
+	horizonLag, err := topic.GetHorizonLag(horizon)
+	if err != nil {
+		return 0, err
+	}
+	extraLag := horizonLag - topic.GetHorizonLags()[0]
+	if extraLag <= 0 {
+		return inferenceBlockHeight - topic.EpochLength, nil
+	}
+	extraEpochs := (extraLag + topic.EpochLength - 1) / topic.EpochLength
+	return inferenceBlockHeight - topic.EpochLength*(1+extraEpochs), nil
+}
@@ -344,6 +344,7 @@ func (s *InferenceSynthesisTestSuite) TestGetNetworkInferencesAtBlock() {
 			topicId,
 			blockHeight,
 			blockHeightPreviousLosses,
+			0,
 		)
 	require.NoError(err)
 	testutil.InEpsilon5(s.T(), valueBundle.CombinedValue, epoch3Get("network_inference").String())
@@ -682,6 +683,7 @@ func (s *InferenceSynthesisTestSuite) TestGetNetworkInferencesAtBlockWithJustOne
 			topicId,
 			blockHeight,
 			blockHeightPreviousLosses,
+			0,
 		)
 	require.NoError(err)
 
@@ -948,6 +950,7 @@ func (s *InferenceSynthesisTestSuite) TestGetNetworkInferencesAtBlockWithAllInfe
 			topicId,
 			blockHeightInferences,
 			blockHeightPreviousLosses,
+			0,
 		)
 
 	require.NoError(err)
@@ -1294,6 +1297,7 @@ func (s *InferenceSynthesisTestSuite) TestGetLatestNetworkInference() {
 			s.ctx,
 			s.emissionsKeeper,
 			topicId,
+			0,
 		)
 	require.NoError(err)
 	testutil.InEpsilon5(s.T(), valueBundle.CombinedValue, epoch3Get("network_inference").String())
@@ -1365,3 +1369,48 @@ func (s *InferenceSynthesisTestSuite) TestGetLatestNetworkInference() {
 		}
 	}
 }
+
+func (s *InferenceSynthesisTestSuite) TestGetLatestNetworkInferenceLossBlockHeightOfHorizon() {
+	s.SetupTest()
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	topicId := uint64(1)
+	blockHeightInferences := int64(300)
+
+	err := keeper.SetTopic(s.ctx, topicId, emissionstypes.Topic{
+		Id:             topicId,
+		EpochLength:    100,
+		GroundTruthLag: 100,
+		Horizons:       []int64{100, 250},
+		PNorm:          alloraMath.NewDecFromInt64(3),
+		Epsilon:        alloraMath.MustNewDecFromString("0.01"),
+	})
+	require.NoError(err)
+
+	inferences := emissionstypes.Inferences{}
+	for horizon := emissionstypes.Horizon(0); horizon < 2; horizon++ {
+		for _, inferer := range []string{"allo1m5v6rgjtxh4xszrrzqacwjh4ve6r0za2gxx9qr", "allo1e7cj9839ht2xm8urynqs5279hrvqd8neusvp2x"} {
+			inferences.Inferences = append(inferences.Inferences, &emissionstypes.Inference{
+				Inferer:     inferer,
+				Value:       alloraMath.NewDecFromInt64(1),
+				TopicId:     topicId,
+				BlockHeight: blockHeightInferences,
+				Horizon:     horizon,
+			})
+		}
+	}
+	err = keeper.InsertInferences(s.ctx, topicId, emissionstypes.Nonce{BlockHeight: blockHeightInferences}, inferences)
+	require.NoError(err)
+
+	// The first horizon reads the losses of the previous epoch
+	_, _, _, _, inferenceBlockHeight, lossBlockHeight, err := inferencesynthesis.GetLatestNetworkInference(s.ctx, keeper, topicId, 0)
+	require.NoError(err)
+	require.Equal(blockHeightInferences, inferenceBlockHeight)
+	require.Equal(int64(200), lossBlockHeight)
+
+	// 150 blocks of extra lag are revealed two epochs later than those of the first horizon
+	_, _, _, _, _, lossBlockHeight, err = inferencesynthesis.GetLatestNetworkInference(s.ctx, keeper, topicId, 1)
+	require.NoError(err)
+	require.Equal(int64(0), lossBlockHeight)
+}
@@ -26,6 +26,12 @@ func SortByBlockHeight(r []*emissions.ReputerRequestNonce) {
 
 // Select the top N latest reputer nonces
 func SelectTopNReputerNonces(reputerRequestNonces *emissions.ReputerRequestNonces, N int, currentBlockHeight, groundTruthLag int64) []*emissions.ReputerRequestNonce {
+	return SelectTopNReputerNoncesForHorizons(reputerRequestNonces, N, currentBlockHeight, []int64{groundTruthLag})
+}
+
+// Select the top N latest reputer nonces whose ground truth is available,
+// given the ground truth lag of every horizon of the topic
+func SelectTopNReputerNoncesForHorizons(reputerRequestNonces *emissions.ReputerRequestNonces, N int, currentBlockHeight int64, horizonLags []int64) []*emissions.ReputerRequestNonce {
 	topN := make([]*emissions.ReputerRequestNonce, 0)
 	// sort reputerRequestNonces by reputer block height
 
@@ -37,8 +43,12 @@ func SelectTopNReputerNonces(reputerRequestNonces *emissions.ReputerRequestNonce
 	// loop reputerRequestNonces
 	for _, nonce := range sortedSlice {
 		nonceCopy := nonce
+		// Ignore nonces of horizons the topic does not predict
+		if int(nonceCopy.Horizon) >= len(horizonLags) {
+			continue
+		}
 		// Only select when the ground truth is available.
-		if currentBlockHeight >= nonceCopy.ReputerNonce.BlockHeight+groundTruthLag {
+		if currentBlockHeight >= nonceCopy.ReputerNonce.BlockHeight+horizonLags[nonceCopy.Horizon] {
 			topN = append(topN, nonceCopy)
 		}
 		if len(topN) >= N {
@@ -105,5 +105,6 @@ func (p SynthPalette) Clone() SynthPalette {
 		Epsilon:                          p.Epsilon,
 		PNorm:                            p.PNorm,
 		CNorm:                            p.CNorm,
+		Combiner:                         p.Combiner,
 	}
 }
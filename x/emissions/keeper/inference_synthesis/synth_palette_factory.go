@@ -34,6 +34,7 @@ func (f *SynthPaletteFactory) BuildPaletteFromRequest(req SynthRequest) (SynthPa
 		Epsilon:                          req.Epsilon,
 		PNorm:                            req.PNorm,
 		CNorm:                            req.CNorm,
+		Combiner:                         NewCombiner(topic),
 	}
 
 	// Populates: infererRegrets, forecasterRegrets, allInferersAreNew
@@ -12,6 +12,7 @@ import (
 type Worker = string
 type BlockHeight = int64
 type TopicId = uint64
+type Horizon = uint32
 type Regret = alloraMath.Dec
 type Loss = alloraMath.Dec
 type Weight = alloraMath.Dec
@@ -70,4 +71,6 @@ type SynthPalette struct {
 	Epsilon             alloraMath.Dec
 	PNorm               alloraMath.Dec
 	CNorm               alloraMath.Dec
+	// Strategy used to combine values into network inferences, defaults to regret-informed weighting if nil
+	Combiner Combiner
 }
@@ -0,0 +1,243 @@
+package inference_synthesis
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	emissions "github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// Builds the network inferences requested in the given topic. Vector-valued topics are synthesized
+// component-wise. Regrets are scalar, so the weights are calculated once, for the first component,
+// and every other component is combined using the same weights.
+func BuildNetworkInferences(req SynthRequest, topic emissions.Topic) (
+	*emissions.ValueBundle,
+	map[string]*emissions.Inference,
+	RegretInformedWeights,
+	error,
+) {
+	if topic.IsProbabilityDistribution() {
+		return buildDistributionNetworkInferences(req, topic)
+	}
+	return buildComponentWiseNetworkInferences(req, topic.OutputDimension)
+}
+
+func buildComponentWiseNetworkInferences(req SynthRequest, outputDimension uint64) (
+	*emissions.ValueBundle,
+	map[string]*emissions.Inference,
+	RegretInformedWeights,
+	error,
+) {
+	if outputDimension <= 1 {
+		networkInferenceBuilder, err := NewNetworkInferenceBuilderFromSynthRequest(req)
+		if err != nil {
+			return nil, nil, RegretInformedWeights{}, err
+		}
+		networkInferences := networkInferenceBuilder.CalcAndSetNetworkInferences().Build()
+		return networkInferences, networkInferenceBuilder.palette.ForecastImpliedInferenceByWorker, networkInferenceBuilder.weights, nil
+	}
+
+	firstComponentReq := req
+	firstComponentReq.Inferences = projectInferencesOntoComponent(req.Inferences, 0)
+	firstComponentReq.Forecasts = projectForecastsOntoComponent(req.Forecasts, 0)
+	firstComponentBuilder, err := NewNetworkInferenceBuilderFromSynthRequest(firstComponentReq)
+	if err != nil {
+		return nil, nil, RegretInformedWeights{}, errorsmod.Wrapf(err, "Error building network inferences of component 0")
+	}
+
+	componentBundles := make([]*emissions.ValueBundle, 0, outputDimension)
+	componentForecastImpliedInferences := make([]map[string]*emissions.Inference, 0, outputDimension)
+	componentBundles = append(componentBundles, firstComponentBuilder.CalcAndSetNetworkInferences().Build())
+	componentForecastImpliedInferences = append(componentForecastImpliedInferences, firstComponentBuilder.palette.ForecastImpliedInferenceByWorker)
+	for component := 1; component < int(outputDimension); component++ {
+		palette, err := firstComponentBuilder.palette.projectOntoComponent(req, component)
+		if err != nil {
+			return nil, nil, RegretInformedWeights{}, errorsmod.Wrapf(err, "Error building network inferences of component %d", component)
+		}
+		networkInferenceBuilder := firstComponentBuilder.withPaletteReusingWeights(palette)
+		componentBundles = append(componentBundles, networkInferenceBuilder.CalcAndSetNetworkInferences().Build())
+		componentForecastImpliedInferences = append(componentForecastImpliedInferences, networkInferenceBuilder.palette.ForecastImpliedInferenceByWorker)
+	}
+
+	return mergeComponentValueBundles(componentBundles), mergeComponentInferences(componentForecastImpliedInferences), firstComponentBuilder.weights, nil
+}
+
+// Returns a copy of the palette of the first component of a vector-valued topic holding the values of
+// another component instead. Workers and regrets are the same for every component, so they are kept.
+func (p SynthPalette) projectOntoComponent(req SynthRequest, component int) (SynthPalette, error) {
+	palette := p.Clone()
+	palette.InferenceByWorker = MakeMapFromInfererToTheirInference(projectInferencesOntoComponent(req.Inferences, component).Inferences)
+	palette.ForecastByWorker = MakeMapFromForecasterToTheirForecast(projectForecastsOntoComponent(req.Forecasts, component).Forecasts)
+	paletteCopy := palette.Clone()
+	if err := paletteCopy.UpdateForecastImpliedInferences(); err != nil {
+		return SynthPalette{}, err
+	}
+	palette.ForecastImpliedInferenceByWorker = paletteCopy.ForecastImpliedInferenceByWorker
+	return palette, nil
+}
+
+// Returns the scalar value of an inference, which is its first component in a vector-valued topic
+func InferenceScalarValue(inference *emissions.Inference) alloraMath.Dec {
+	if len(inference.Values) > 0 {
+		return inference.Values[0]
+	}
+	return inference.Value
+}
+
+// Builds the network inferences from the only inference of an epoch, which is then also the network inference
+func NewValueBundleFromSingleInference(topicId TopicId, inference *emissions.Inference) *emissions.ValueBundle {
+	value := InferenceScalarValue(inference)
+	return &emissions.ValueBundle{
+		TopicId:       topicId,
+		CombinedValue: value,
+		InfererValues: []*emissions.WorkerAttributedValue{
+			{
+				Worker: inference.Inferer,
+				Value:  value,
+				Values: inference.Values,
+			},
+		},
+		ForecasterValues:       []*emissions.WorkerAttributedValue{},
+		NaiveValue:             value,
+		OneOutInfererValues:    []*emissions.WithheldWorkerAttributedValue{},
+		OneOutForecasterValues: []*emissions.WithheldWorkerAttributedValue{},
+		OneInForecasterValues:  []*emissions.WorkerAttributedValue{},
+		CombinedValues:         inference.Values,
+		NaiveValues:            inference.Values,
+	}
+}
+
+// Replaces the value of each inference by one of its components.
+// Inferences lacking that component are left out.
+func projectInferencesOntoComponent(inferences *emissions.Inferences, component int) *emissions.Inferences {
+	projected := &emissions.Inferences{
+		Inferences: make([]*emissions.Inference, 0, len(inferences.Inferences)),
+	}
+	for _, inference := range inferences.Inferences {
+		if component >= len(inference.Values) {
+			continue
+		}
+		projectedInference := *inference
+		projectedInference.Value = inference.Values[component]
+		projectedInference.Values = nil
+		projected.Inferences = append(projected.Inferences, &projectedInference)
+	}
+	return projected
+}
+
+// Replaces the forecasted loss of each forecast element by the one of the given component, if any.
+// Elements without per-component losses keep forecasting the same loss for every component.
+func projectForecastsOntoComponent(forecasts *emissions.Forecasts, component int) *emissions.Forecasts {
+	projected := &emissions.Forecasts{
+		Forecasts: make([]*emissions.Forecast, 0, len(forecasts.Forecasts)),
+	}
+	for _, forecast := range forecasts.Forecasts {
+		projectedForecast := *forecast
+		projectedForecast.ForecastElements = make([]*emissions.ForecastElement, 0, len(forecast.ForecastElements))
+		for _, el := range forecast.ForecastElements {
+			projectedElement := *el
+			if component < len(el.Values) {
+				projectedElement.Value = el.Values[component]
+			}
+			projectedElement.Values = nil
+			projectedForecast.ForecastElements = append(projectedForecast.ForecastElements, &projectedElement)
+		}
+		projected.Forecasts = append(projected.Forecasts, &projectedForecast)
+	}
+	return projected
+}
+
+// Merges the network inferences of every component into a single bundle whose scalar fields hold
+// the first component. Workers are kept only if a value was calculated for them in every component.
+func mergeComponentValueBundles(componentBundles []*emissions.ValueBundle) *emissions.ValueBundle {
+	merged := *componentBundles[0]
+	merged.CombinedValues = make([]alloraMath.Dec, 0, len(componentBundles))
+	merged.NaiveValues = make([]alloraMath.Dec, 0, len(componentBundles))
+	infererValues := make([][]*emissions.WorkerAttributedValue, 0, len(componentBundles))
+	forecasterValues := make([][]*emissions.WorkerAttributedValue, 0, len(componentBundles))
+	oneOutInfererValues := make([][]*emissions.WithheldWorkerAttributedValue, 0, len(componentBundles))
+	oneOutForecasterValues := make([][]*emissions.WithheldWorkerAttributedValue, 0, len(componentBundles))
+	oneInForecasterValues := make([][]*emissions.WorkerAttributedValue, 0, len(componentBundles))
+	for _, bundle := range componentBundles {
+		merged.CombinedValues = append(merged.CombinedValues, bundle.CombinedValue)
+		merged.NaiveValues = append(merged.NaiveValues, bundle.NaiveValue)
+		infererValues = append(infererValues, bundle.InfererValues)
+		forecasterValues = append(forecasterValues, bundle.ForecasterValues)
+		oneOutInfererValues = append(oneOutInfererValues, bundle.OneOutInfererValues)
+		oneOutForecasterValues = append(oneOutForecasterValues, bundle.OneOutForecasterValues)
+		oneInForecasterValues = append(oneInForecasterValues, bundle.OneInForecasterValues)
+	}
+	merged.InfererValues = mergeComponentWorkerAttributedValues(infererValues)
+	merged.ForecasterValues = mergeComponentWorkerAttributedValues(forecasterValues)
+	merged.OneOutInfererValues = mergeComponentWithheldWorkerAttributedValues(oneOutInfererValues)
+	merged.OneOutForecasterValues = mergeComponentWithheldWorkerAttributedValues(oneOutForecasterValues)
+	merged.OneInForecasterValues = mergeComponentWorkerAttributedValues(oneInForecasterValues)
+	return &merged
+}
+
+func mergeComponentWorkerAttributedValues(components [][]*emissions.WorkerAttributedValue) []*emissions.WorkerAttributedValue {
+	valuesByWorker := make(map[string][]alloraMath.Dec)
+	for _, values := range components {
+		for _, value := range values {
+			valuesByWorker[value.Worker] = append(valuesByWorker[value.Worker], value.Value)
+		}
+	}
+	merged := make([]*emissions.WorkerAttributedValue, 0, len(components[0]))
+	for _, value := range components[0] {
+		values := valuesByWorker[value.Worker]
+		if len(values) != len(components) {
+			continue
+		}
+		merged = append(merged, &emissions.WorkerAttributedValue{
+			Worker: value.Worker,
+			Value:  value.Value,
+			Values: values,
+		})
+	}
+	return merged
+}
+
+func mergeComponentWithheldWorkerAttributedValues(components [][]*emissions.WithheldWorkerAttributedValue) []*emissions.WithheldWorkerAttributedValue {
+	valuesByWorker := make(map[string][]alloraMath.Dec)
+	for _, values := range components {
+		for _, value := range values {
+			valuesByWorker[value.Worker] = append(valuesByWorker[value.Worker], value.Value)
+		}
+	}
+	merged := make([]*emissions.WithheldWorkerAttributedValue, 0, len(components[0]))
+	for _, value := range components[0] {
+		values := valuesByWorker[value.Worker]
+		if len(values) != len(components) {
+			continue
+		}
+		merged = append(merged, &emissions.WithheldWorkerAttributedValue{
+			Worker: value.Worker,
+			Value:  value.Value,
+			Values: values,
+		})
+	}
+	return merged
+}
+
+func mergeComponentInferences(components []map[string]*emissions.Inference) map[string]*emissions.Inference {
+	merged := make(map[string]*emissions.Inference, len(components[0]))
+	for worker, inference := range components[0] {
+		if inference == nil {
+			continue
+		}
+		values := make([]alloraMath.Dec, 0, len(components))
+		for _, component := range components {
+			componentInference, ok := component[worker]
+			if !ok || componentInference == nil {
+				break
+			}
+			values = append(values, componentInference.Value)
+		}
+		if len(values) != len(components) {
+			continue
+		}
+		mergedInference := *inference
+		mergedInference.Values = values
+		merged[worker] = &mergedInference
+	}
+	return merged
+}
@@ -0,0 +1,145 @@
+package inference_synthesis_test
+
+import (
+	alloraMath "github.com/allora-network/allora-chain/math"
+	inferencesynthesis "github.com/allora-network/allora-chain/x/emissions/keeper/inference_synthesis"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+func (s *InferenceSynthesisTestSuite) TestBuildNetworkInferencesIsComponentWiseForVectorTopics() {
+	k := s.emissionsKeeper
+	ctx := s.ctx
+	topicId := uint64(1)
+
+	worker1 := "worker1"
+	worker2 := "worker2"
+	worker3 := "worker3"
+	worker4 := "worker4"
+
+	decs := func(values ...string) []alloraMath.Dec {
+		ret := make([]alloraMath.Dec, 0, len(values))
+		for _, value := range values {
+			ret = append(ret, alloraMath.MustNewDecFromString(value))
+		}
+		return ret
+	}
+
+	inferences := &emissionstypes.Inferences{
+		Inferences: []*emissionstypes.Inference{
+			{Inferer: worker1, Values: decs("0.5", "10")},
+			{Inferer: worker2, Values: decs("0.7", "30")},
+		},
+	}
+	forecasts := &emissionstypes.Forecasts{
+		Forecasts: []*emissionstypes.Forecast{
+			{
+				Forecaster: worker3,
+				ForecastElements: []*emissionstypes.ForecastElement{
+					{Inferer: worker1, Value: alloraMath.MustNewDecFromString("0.6"), Values: decs("0.6", "0.1")},
+					{Inferer: worker2, Value: alloraMath.MustNewDecFromString("0.8"), Values: decs("0.8", "0.9")},
+				},
+			},
+			{
+				Forecaster: worker4,
+				ForecastElements: []*emissionstypes.ForecastElement{
+					{Inferer: worker1, Value: alloraMath.MustNewDecFromString("0.4")},
+					{Inferer: worker2, Value: alloraMath.MustNewDecFromString("0.9")},
+				},
+			},
+		},
+	}
+
+	err := k.SetInfererNetworkRegret(ctx, topicId, worker1, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.2")})
+	s.Require().NoError(err)
+	err = k.SetInfererNetworkRegret(ctx, topicId, worker2, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.3")})
+	s.Require().NoError(err)
+	err = k.SetForecasterNetworkRegret(ctx, topicId, worker3, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.4")})
+	s.Require().NoError(err)
+	err = k.SetForecasterNetworkRegret(ctx, topicId, worker4, emissionstypes.TimestampedValue{Value: alloraMath.MustNewDecFromString("0.5")})
+	s.Require().NoError(err)
+
+	req := inferencesynthesis.SynthRequest{
+		Ctx:                 ctx,
+		K:                   k,
+		TopicId:             topicId,
+		Inferences:          inferences,
+		Forecasts:           forecasts,
+		NetworkCombinedLoss: alloraMath.MustNewDecFromString("0.2"),
+		Epsilon:             alloraMath.MustNewDecFromString("0.0001"),
+		PNorm:               alloraMath.MustNewDecFromString("2"),
+		CNorm:               alloraMath.MustNewDecFromString("0.75"),
+	}
+	valueBundle, forecastImpliedInferences, _, err := inferencesynthesis.BuildNetworkInferences(req, emissionstypes.Topic{Id: topicId, OutputDimension: 2})
+	s.Require().NoError(err)
+	s.Require().Len(valueBundle.CombinedValues, 2)
+	s.Require().Len(valueBundle.NaiveValues, 2)
+	s.Require().Equal(valueBundle.CombinedValues[0], valueBundle.CombinedValue)
+	s.Require().Len(forecastImpliedInferences[worker3].Values, 2)
+
+	// Each component must equal the scalar synthesis run on that component alone
+	for component := 0; component < 2; component++ {
+		componentReq := req
+		componentReq.Inferences = &emissionstypes.Inferences{}
+		for _, inference := range inferences.Inferences {
+			componentReq.Inferences.Inferences = append(componentReq.Inferences.Inferences, &emissionstypes.Inference{
+				Inferer: inference.Inferer,
+				Value:   inference.Values[component],
+			})
+		}
+		componentReq.Forecasts = &emissionstypes.Forecasts{}
+		for _, forecast := range forecasts.Forecasts {
+			componentForecast := &emissionstypes.Forecast{Forecaster: forecast.Forecaster}
+			for _, el := range forecast.ForecastElements {
+				value := el.Value
+				if len(el.Values) > 0 {
+					value = el.Values[component]
+				}
+				componentForecast.ForecastElements = append(componentForecast.ForecastElements, &emissionstypes.ForecastElement{
+					Inferer: el.Inferer,
+					Value:   value,
+				})
+			}
+			componentReq.Forecasts.Forecasts = append(componentReq.Forecasts.Forecasts, componentForecast)
+		}
+
+		scalarBundle, _, _, err := inferencesynthesis.BuildNetworkInferences(componentReq, emissionstypes.Topic{Id: topicId})
+		s.Require().NoError(err)
+		s.Require().Empty(scalarBundle.CombinedValues)
+
+		s.Require().Equal(scalarBundle.CombinedValue, valueBundle.CombinedValues[component])
+		s.Require().Equal(scalarBundle.NaiveValue, valueBundle.NaiveValues[component])
+		s.Require().Len(valueBundle.InfererValues, len(scalarBundle.InfererValues))
+		for i, value := range scalarBundle.InfererValues {
+			s.Require().Equal(value.Worker, valueBundle.InfererValues[i].Worker)
+			s.Require().Equal(value.Value, valueBundle.InfererValues[i].Values[component])
+		}
+		s.Require().Len(valueBundle.ForecasterValues, len(scalarBundle.ForecasterValues))
+		for i, value := range scalarBundle.ForecasterValues {
+			s.Require().Equal(value.Value, valueBundle.ForecasterValues[i].Values[component])
+		}
+		s.Require().Len(valueBundle.OneOutInfererValues, len(scalarBundle.OneOutInfererValues))
+		for i, value := range scalarBundle.OneOutInfererValues {
+			s.Require().Equal(value.Value, valueBundle.OneOutInfererValues[i].Values[component])
+		}
+		s.Require().Len(valueBundle.OneOutForecasterValues, len(scalarBundle.OneOutForecasterValues))
+		for i, value := range scalarBundle.OneOutForecasterValues {
+			s.Require().Equal(value.Value, valueBundle.OneOutForecasterValues[i].Values[component])
+		}
+		s.Require().Len(valueBundle.OneInForecasterValues, len(scalarBundle.OneInForecasterValues))
+		for i, value := range scalarBundle.OneInForecasterValues {
+			s.Require().Equal(value.Value, valueBundle.OneInForecasterValues[i].Values[component])
+		}
+	}
+}
+
+func (s *InferenceSynthesisTestSuite) TestNewValueBundleFromSingleVectorInference() {
+	values := []alloraMath.Dec{alloraMath.NewDecFromInt64(3), alloraMath.NewDecFromInt64(4)}
+	valueBundle := inferencesynthesis.NewValueBundleFromSingleInference(1, &emissionstypes.Inference{
+		Inferer: "worker1",
+		Values:  values,
+	})
+	s.Require().Equal(values[0], valueBundle.CombinedValue)
+	s.Require().Equal(values, valueBundle.CombinedValues)
+	s.Require().Equal(values, valueBundle.NaiveValues)
+	s.Require().Equal(values, valueBundle.InfererValues[0].Values)
+}
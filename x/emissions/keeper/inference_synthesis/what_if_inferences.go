@@ -0,0 +1,156 @@
+package inference_synthesis
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	emissions "github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Hypothetical changes to the work stored at a block, used to ask what the network inference would have been
+type WhatIfScenario struct {
+	// Inferences added to the stored ones, replacing the inference of the same inferer if any
+	ExtraInferences []*emissions.Inference
+	// Forecasts added to the stored ones, replacing the forecast of the same forecaster if any
+	ExtraForecasts []*emissions.Forecast
+	// Workers whose inferences and forecasts are left out
+	ExcludedWorkers []string
+}
+
+// Calculates the network inferences of a horizon of the topic at a block as GetNetworkInferencesAtBlock does,
+// after applying the hypothetical changes of the scenario to the inferences and forecasts stored at that block.
+// If previousLossNonce is 0, the latest losses available for the horizon at the block are used.
+func GetWhatIfNetworkInferencesAtBlock(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	topic emissions.Topic,
+	inferencesNonce BlockHeight,
+	previousLossNonce BlockHeight,
+	horizon Horizon,
+	scenario WhatIfScenario,
+) (
+	*emissions.ValueBundle,
+	map[string]*emissions.Inference,
+	map[string]alloraMath.Dec,
+	map[string]alloraMath.Dec,
+	error,
+) {
+	if err := topic.ValidateHorizon(horizon); err != nil {
+		return nil, nil, nil, nil, err
+	}
+	if err := validateWhatIfScenario(topic, inferencesNonce, horizon, scenario); err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	storedInferences, storedForecasts, err := getWorkAtBlockForHorizon(ctx, k, topic.Id, inferencesNonce, horizon)
+	if err != nil {
+		// All inferences may be hypothetical
+		if len(scenario.ExtraInferences) == 0 {
+			return nil, nil, nil, nil, err
+		}
+		storedInferences = &emissions.Inferences{}
+		storedForecasts = &emissions.Forecasts{}
+	}
+
+	inferences, forecasts := applyWhatIfScenario(storedInferences, storedForecasts, scenario)
+	if len(inferences.Inferences) == 0 {
+		return nil, nil, nil, nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "no inferences left for topic %v at block %v", topic.Id, inferencesNonce)
+	}
+
+	if previousLossNonce == 0 {
+		previousLossNonce, err = latestLossBlockHeightOfHorizon(topic, horizon, inferencesNonce)
+		if err != nil {
+			return nil, nil, nil, nil, err
+		}
+	}
+	return calcNetworkInferencesFromWork(ctx, k, topic.Id, inferences, forecasts, previousLossNonce, horizon)
+}
+
+func validateWhatIfScenario(topic emissions.Topic, inferencesNonce BlockHeight, horizon Horizon, scenario WhatIfScenario) error {
+	for _, inference := range scenario.ExtraInferences {
+		if err := inference.Validate(); err != nil {
+			return err
+		}
+		if inference.TopicId != topic.Id || inference.BlockHeight != inferencesNonce || inference.Horizon != horizon {
+			return errorsmod.Wrapf(emissions.ErrInvalidValue, "inference of %s does not match topic, block or horizon", inference.Inferer)
+		}
+		if err := emissions.ValidateValuesOutputDimension(inference.Values, topic.OutputDimension); err != nil {
+			return err
+		}
+		if topic.IsProbabilityDistribution() {
+			if err := emissions.ValidateProbabilityDistribution(inference.Values); err != nil {
+				return err
+			}
+		}
+	}
+	for _, forecast := range scenario.ExtraForecasts {
+		if err := forecast.Validate(); err != nil {
+			return err
+		}
+		if forecast.TopicId != topic.Id || forecast.BlockHeight != inferencesNonce || forecast.Horizon != horizon {
+			return errorsmod.Wrapf(emissions.ErrInvalidValue, "forecast of %s does not match topic, block or horizon", forecast.Forecaster)
+		}
+		for _, el := range forecast.ForecastElements {
+			if err := el.ValidateOutputDimension(topic.OutputDimension); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Returns the inferences and forecasts left after adding the hypothetical ones and removing the excluded workers.
+// Forecast elements about inferers that are no longer present are dropped, as are forecasts left without elements.
+func applyWhatIfScenario(
+	storedInferences *emissions.Inferences,
+	storedForecasts *emissions.Forecasts,
+	scenario WhatIfScenario,
+) (*emissions.Inferences, *emissions.Forecasts) {
+	excluded := make(map[string]bool, len(scenario.ExcludedWorkers))
+	for _, worker := range scenario.ExcludedWorkers {
+		excluded[worker] = true
+	}
+
+	inferenceByInferer := make(map[string]*emissions.Inference)
+	for _, inference := range storedInferences.Inferences {
+		inferenceByInferer[inference.Inferer] = inference
+	}
+	for _, inference := range scenario.ExtraInferences {
+		inferenceByInferer[inference.Inferer] = inference
+	}
+	inferences := &emissions.Inferences{Inferences: make([]*emissions.Inference, 0, len(inferenceByInferer))}
+	for _, inferer := range alloraMath.GetSortedKeys(inferenceByInferer) {
+		if !excluded[inferer] {
+			inferences.Inferences = append(inferences.Inferences, inferenceByInferer[inferer])
+		}
+	}
+
+	forecastByForecaster := make(map[string]*emissions.Forecast)
+	for _, forecast := range storedForecasts.Forecasts {
+		forecastByForecaster[forecast.Forecaster] = forecast
+	}
+	for _, forecast := range scenario.ExtraForecasts {
+		forecastByForecaster[forecast.Forecaster] = forecast
+	}
+	forecasts := &emissions.Forecasts{Forecasts: make([]*emissions.Forecast, 0, len(forecastByForecaster))}
+	for _, forecaster := range alloraMath.GetSortedKeys(forecastByForecaster) {
+		if excluded[forecaster] {
+			continue
+		}
+		forecast := *forecastByForecaster[forecaster]
+		forecast.ForecastElements = make([]*emissions.ForecastElement, 0, len(forecastByForecaster[forecaster].ForecastElements))
+		seenInferers := make(map[string]bool)
+		for _, el := range forecastByForecaster[forecaster].ForecastElements {
+			if _, ok := inferenceByInferer[el.Inferer]; ok && !excluded[el.Inferer] && !seenInferers[el.Inferer] {
+				forecast.ForecastElements = append(forecast.ForecastElements, el)
+				seenInferers[el.Inferer] = true
+			}
+		}
+		if len(forecast.ForecastElements) > 0 {
+			forecasts.Forecasts = append(forecasts.Forecasts, &forecast)
+		}
+	}
+	return inferences, forecasts
+}
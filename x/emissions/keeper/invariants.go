@@ -5,8 +5,6 @@ import (
 
 	"cosmossdk.io/collections"
 	cosmosMath "cosmossdk.io/math"
-	"github.com/allora-network/allora-chain/app/params"
-	alloraMath "github.com/allora-network/allora-chain/math"
 	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -51,11 +49,7 @@ func StakingInvariantTotalStakeEqualAlloraStakingBankBalance(k Keeper) sdk.Invar
 		if err != nil {
 			panic(fmt.Sprintf("failed to get total stake: %v", err))
 		}
-		alloraStakingAddr := k.authKeeper.GetModuleAccount(ctx, emissionstypes.AlloraStakingAccountName).GetAddress()
-		alloraStakingBalance := k.bankKeeper.GetBalance(
-			ctx,
-			alloraStakingAddr,
-			params.DefaultBondDenom).Amount
+		_, alloraStakingBalance := k.GetModuleAccountBalance(ctx, emissionstypes.AlloraStakingAccountName)
 		broken := !totalStake.Equal(alloraStakingBalance)
 		return sdk.FormatInvariant(
 			emissionstypes.ModuleName,
@@ -313,81 +307,13 @@ func StakingInvariantSumStakeFromStakeReputerAuthorityEqualTotalStakeAndTopicSta
 
 func StakingInvariantPendingRewardForDelegatorsEqualRewardPerShareMinusRewardDebt(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		// get the sum of all accumulated debts that happend to be staked upon a reputer
-		iter, err := k.delegatedStakes.Iterate(ctx, nil)
+		accumulatedRewardsBeyondRewardDebt, err := k.GetAccumulatedPendingRewardForDelegators(ctx)
 		if err != nil {
-			panic("failed to get delegated stakes iterator")
-		}
-		defer iter.Close()
-		type TopicAndReputer struct {
-			topicId uint64
-			reputer string
-		}
-		rewardDebtSums := make(map[TopicAndReputer]alloraMath.Dec)
-		reputerStakeSums := make(map[TopicAndReputer]alloraMath.Dec)
-		for ; iter.Valid(); iter.Next() {
-			keyValue, err := iter.KeyValue()
-			if err != nil {
-				panic("failed to get key value from delegatedStakes iterator")
-			}
-			topicAndReputer := TopicAndReputer{topicId: keyValue.Key.K1(), reputer: keyValue.Key.K3()}
-			rewardDebtSum, has := rewardDebtSums[topicAndReputer]
-			if !has {
-				rewardDebtSum = alloraMath.ZeroDec()
-			}
-			rewardDebtSumNew, err := rewardDebtSum.Add(keyValue.Value.RewardDebt)
-			if err != nil {
-				panic("failed to add reward debt sum")
-			}
-			rewardDebtSums[topicAndReputer] = rewardDebtSumNew
-			delegatedStakeSum, has := reputerStakeSums[topicAndReputer]
-			if !has {
-				delegatedStakeSum = alloraMath.ZeroDec()
-			}
-			delegatedStakeSumNew, err := delegatedStakeSum.Add(keyValue.Value.Amount)
-			if err != nil {
-				panic("failed to add stake sum")
-			}
-			reputerStakeSums[topicAndReputer] = delegatedStakeSumNew
-		}
-		// now for each reputer and topic, get the accumulated reward per share
-		// then multiply it by the stake sum of the reputer
-		iter2, err := k.delegateRewardPerShare.Iterate(ctx, nil)
-		if err != nil {
-			panic("failed to get delegate reward per share iterator")
-		}
-		defer iter2.Close()
-		accumulatedRewardsBeyondRewardDebt := alloraMath.ZeroDec()
-		for ; iter2.Valid(); iter2.Next() {
-			keyValue, err := iter2.KeyValue()
-			if err != nil {
-				panic("failed to get key value from delegateRewardPerShare iterator")
-			}
-			topicAndReputer := TopicAndReputer{topicId: keyValue.Key.K1(), reputer: keyValue.Key.K2()}
-			stakeSum := reputerStakeSums[topicAndReputer]
-			rewardDebt := rewardDebtSums[topicAndReputer]
-			stakeTimesRewardPerShare, err := stakeSum.Mul(keyValue.Value)
-			if err != nil {
-				panic("failed to multiply stake sum by reward per share")
-			}
-			stakeTimeRewardPerShareMinusRewardDebt, err := stakeTimesRewardPerShare.Sub(rewardDebt)
-			if err != nil {
-				panic("failed to subtract reward debt from stake times reward per share")
-			}
-			if stakeTimeRewardPerShareMinusRewardDebt.IsNegative() {
-				panic(fmt.Sprintf("reward per share minus reward debt negative: stakeSum: %s, rewardPerShare: %s, rewardDebt: %s",
-					stakeSum.String(), keyValue.Value.String(), rewardDebt.String(),
-				))
-			}
-			accumulatedRewardsBeyondRewardDebt, err = accumulatedRewardsBeyondRewardDebt.Add(stakeTimeRewardPerShareMinusRewardDebt)
-			if err != nil {
-				panic("failed to add stake times reward per share minus reward debt to accumulated rewards")
-			}
+			panic(fmt.Sprintf("failed to get accumulated pending reward for delegators: %v", err))
 		}
 
 		// now check the total pending rewards bank balance is equal to the accumulated rewards beyond reward debt
-		alloraPendingAddr := k.authKeeper.GetModuleAccount(ctx, emissionstypes.AlloraPendingRewardForDelegatorAccountName).GetAddress()
-		bal := k.GetBankBalance(ctx, alloraPendingAddr, params.DefaultBondDenom).Amount
+		_, bal := k.GetModuleAccountBalance(ctx, emissionstypes.AlloraPendingRewardForDelegatorAccountName)
 		rewards := accumulatedRewardsBeyondRewardDebt.SdkIntTrim()
 		// we define the invariant as holding if the rewards we think we
 		// have to pay people is equal to the balance we have earmarked to pay them
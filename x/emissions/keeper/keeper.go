@@ -5,6 +5,9 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"math"
+	"sort"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -28,6 +31,7 @@ type ActorId = string
 type BlockHeight = int64
 type Reputer = string
 type Delegator = string
+type Horizon = types.Horizon
 
 type Keeper struct {
 	cdc              codec.BinaryCodec
@@ -56,6 +60,14 @@ type Keeper struct {
 	topicWorkers collections.KeySet[collections.Pair[TopicId, ActorId]]
 	// for a topic, what is every reputer node that has registered to it?
 	topicReputers collections.KeySet[collections.Pair[TopicId, ActorId]]
+	// for a worker, what is every topic it has registered to? The reverse of topicWorkers
+	workerTopics collections.KeySet[collections.Pair[ActorId, TopicId]]
+	// for a reputer, what is every topic it has registered to? The reverse of topicReputers
+	reputerTopics collections.KeySet[collections.Pair[ActorId, TopicId]]
+	// for a worker, what are the libp2p keys of its entries in workers?
+	workerNodeKeys collections.KeySet[collections.Pair[ActorId, LibP2pKey]]
+	// for a reputer, what are the libp2p keys of its entries in reputers?
+	reputerNodeKeys collections.KeySet[collections.Pair[ActorId, LibP2pKey]]
 	// map of (topic) -> nonce/block height
 	topicRewardNonce collections.Map[TopicId, BlockHeight]
 
@@ -143,9 +155,39 @@ type Keeper struct {
 	// map of (topic, block_height) -> ValueBundle (1 network wide bundle per timestep)
 	networkLossBundles collections.Map[collections.Pair[TopicId, BlockHeight], types.ValueBundle]
 
+	// map of (topic, block_height) -> regret-informed weights of the network inferences of every horizon
+	networkInferenceWeights collections.Map[collections.Pair[TopicId, BlockHeight], types.NetworkInferenceWeightsAtBlock]
+
+	// map of (topic, block_height) -> how the reward of every horizon rewarded at that block was split
+	rewardBreakdowns collections.Map[collections.Pair[TopicId, BlockHeight], types.RewardBreakdowns]
+
+	// map of (topic, actor, block_height) -> score of the actor at that block, kept for ActorHistoryRetention blocks
+	infererScoreHistory    collections.Map[collections.Triple[TopicId, ActorId, BlockHeight], types.Score]
+	forecasterScoreHistory collections.Map[collections.Triple[TopicId, ActorId, BlockHeight], types.Score]
+	reputerScoreHistory    collections.Map[collections.Triple[TopicId, ActorId, BlockHeight], types.Score]
+
+	// map of (actor, block_height, topic) -> rewards paid to the actor for the topic at that block, kept for ActorHistoryRetention blocks
+	rewardHistory collections.Map[collections.Triple[ActorId, BlockHeight, TopicId], types.PaidRewards]
+
+	// set of (topic, block_height, actor) at which the actor has a score or reward in its history, to prune the history by height
+	actorHistoryBlocks collections.KeySet[collections.Triple[TopicId, BlockHeight, ActorId]]
+
 	// Percentage of all rewards, paid out to staked reputers, during the previous reward cadence. Used by mint module
 	previousPercentageRewardToStakedReputers collections.Item[alloraMath.Dec]
 
+	/// BLOCK TIME
+
+	// header time of the last block, in unix nanoseconds
+	lastBlockTime collections.Item[int64]
+	// moving average of the block time, in seconds
+	averageBlockTime collections.Item[alloraMath.Dec]
+	// blocks per month derived from averageBlockTime, used instead of the BlocksPerMonth param when calibration is enabled
+	calibratedBlocksPerMonth collections.Item[uint64]
+	// height of the last recalibration of the blocks per month
+	lastBlocksPerMonthCalibrationHeight collections.Item[int64]
+	// height at which previousPercentageRewardToStakedReputers was last set
+	lastPercentageRewardToStakedReputersHeight collections.Item[int64]
+
 	/// NONCES
 
 	// map of (topic) -> unfulfilled nonces
@@ -168,7 +210,44 @@ type Keeper struct {
 
 	/// WHITELISTS
 
+	// legacy admins that could do everything, superseded by actorRoles and only read during migration
 	whitelistAdmins collections.KeySet[ActorId]
+	// map of (actor, role) -> granted
+	actorRoles collections.KeySet[collections.Pair[ActorId, int32]]
+
+	/// TOPIC ALLOWLISTS
+
+	// for a topic, what workers may participate if the topic restricts workers?
+	topicWorkerAllowlist collections.KeySet[collections.Pair[TopicId, ActorId]]
+	// for a topic, what reputers may participate if the topic restricts reputers?
+	topicReputerAllowlist collections.KeySet[collections.Pair[TopicId, ActorId]]
+
+	/// INACTIVITY
+
+	// map of (topic, worker) -> block height of the last accepted submission or registration
+	workerLastActiveHeight collections.Map[collections.Pair[TopicId, ActorId], BlockHeight]
+	// map of (topic, reputer) -> block height of the last accepted submission or registration
+	reputerLastActiveHeight collections.Map[collections.Pair[TopicId, ActorId], BlockHeight]
+	// set of (topic, block height) of the latest worker nonces opened by the topic
+	topicEpochHeights collections.KeySet[collections.Pair[TopicId, BlockHeight]]
+
+	/// HORIZONS
+	// The first horizon of a topic is stored alongside topics with a single horizon
+
+	// map of (topic, horizon) -> reward nonce of the horizons after the first one
+	topicHorizonRewardNonce collections.Map[collections.Pair[TopicId, Horizon], BlockHeight]
+	// map of (topic, horizon, block_height) -> ReputerValueBundles of the horizons after the first one
+	horizonLossBundles collections.Map[collections.Pair[collections.Pair[TopicId, Horizon], BlockHeight], types.ReputerValueBundles]
+	// map of (topic, horizon, block_height) -> network ValueBundle of the horizons after the first one
+	horizonNetworkLossBundles collections.Map[collections.Pair[collections.Pair[TopicId, Horizon], BlockHeight], types.ValueBundle]
+	// map of (topic, horizon) -> last reputer payload of the horizons after the first one
+	horizonLastReputerPayload collections.Map[collections.Pair[TopicId, Horizon], types.TimestampedActorNonce]
+	// map of (topic, horizon, block_height) -> inferer scores of the horizons after the first one
+	horizonInfererScoresByBlock collections.Map[collections.Pair[collections.Pair[TopicId, Horizon], BlockHeight], types.Scores]
+	// map of (topic, horizon, block_height) -> forecaster scores of the horizons after the first one
+	horizonForecasterScoresByBlock collections.Map[collections.Pair[collections.Pair[TopicId, Horizon], BlockHeight], types.Scores]
+	// map of (topic, horizon, block_height) -> reputer scores of the horizons after the first one
+	horizonReputerScoresByBlock collections.Map[collections.Pair[collections.Pair[TopicId, Horizon], BlockHeight], types.Scores]
 
 	/// RECORD COMMITS
 
@@ -205,6 +284,10 @@ func NewKeeper(
 		rewardableTopics:                         collections.NewKeySet(sb, types.RewardableTopicsKey, "rewardable_topics", collections.Uint64Key),
 		topicWorkers:                             collections.NewKeySet(sb, types.TopicWorkersKey, "topic_workers", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey)),
 		topicReputers:                            collections.NewKeySet(sb, types.TopicReputersKey, "topic_reputers", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey)),
+		workerTopics:                             collections.NewKeySet(sb, types.WorkerTopicsKey, "worker_topics", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		reputerTopics:                            collections.NewKeySet(sb, types.ReputerTopicsKey, "reputer_topics", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		workerNodeKeys:                           collections.NewKeySet(sb, types.WorkerNodeKeysKey, "worker_node_keys", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		reputerNodeKeys:                          collections.NewKeySet(sb, types.ReputerNodeKeysKey, "reputer_node_keys", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
 		stakeReputerAuthority:                    collections.NewMap(sb, types.StakeByReputerAndTopicIdKey, "stake_by_reputer_and_TopicId", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), sdk.IntValue),
 		stakeRemovalsByBlock:                     collections.NewMap(sb, types.StakeRemovalsByBlockKey, "stake_removals_by_block", collections.TripleKeyCodec(collections.Int64Key, collections.Uint64Key, collections.StringKey), codec.CollValue[types.StakeRemovalInfo](cdc)),
 		stakeRemovalsByActor:                     collections.NewKeySet(sb, types.StakeRemovalsByActorKey, "stake_removals_by_actor", collections.TripleKeyCodec(collections.StringKey, collections.Uint64Key, collections.Int64Key)),
@@ -224,29 +307,54 @@ func NewKeeper(
 		allForecasts:                             collections.NewMap(sb, types.AllForecastsKey, "forecasts_all", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), codec.CollValue[types.Forecasts](cdc)),
 		allLossBundles:                           collections.NewMap(sb, types.AllLossBundlesKey, "value_bundles_all", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), codec.CollValue[types.ReputerValueBundles](cdc)),
 		networkLossBundles:                       collections.NewMap(sb, types.NetworkLossBundlesKey, "value_bundles_network", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), codec.CollValue[types.ValueBundle](cdc)),
+		networkInferenceWeights:                  collections.NewMap(sb, types.NetworkInferenceWeightsKey, "network_inference_weights", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), codec.CollValue[types.NetworkInferenceWeightsAtBlock](cdc)),
+		rewardBreakdowns:                         collections.NewMap(sb, types.RewardBreakdownsKey, "reward_breakdowns", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), codec.CollValue[types.RewardBreakdowns](cdc)),
+		infererScoreHistory:                      collections.NewMap(sb, types.InfererScoreHistoryKey, "inferer_score_history", collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.Int64Key), codec.CollValue[types.Score](cdc)),
+		forecasterScoreHistory:                   collections.NewMap(sb, types.ForecasterScoreHistoryKey, "forecaster_score_history", collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.Int64Key), codec.CollValue[types.Score](cdc)),
+		reputerScoreHistory:                      collections.NewMap(sb, types.ReputerScoreHistoryKey, "reputer_score_history", collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.Int64Key), codec.CollValue[types.Score](cdc)),
+		rewardHistory:                            collections.NewMap(sb, types.RewardHistoryKey, "reward_history", collections.TripleKeyCodec(collections.StringKey, collections.Int64Key, collections.Uint64Key), codec.CollValue[types.PaidRewards](cdc)),
+		actorHistoryBlocks:                       collections.NewKeySet(sb, types.ActorHistoryBlocksKey, "actor_history_blocks", collections.TripleKeyCodec(collections.Uint64Key, collections.Int64Key, collections.StringKey)),
 		previousPercentageRewardToStakedReputers: collections.NewItem(sb, types.PreviousPercentageRewardToStakedReputersKey, "previous_percentage_reward_to_staked_reputers", alloraMath.DecValue),
-		latestInfererNetworkRegrets:              collections.NewMap(sb, types.InfererNetworkRegretsKey, "inferer_network_regrets", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.TimestampedValue](cdc)),
-		latestForecasterNetworkRegrets:           collections.NewMap(sb, types.ForecasterNetworkRegretsKey, "forecaster_network_regrets", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.TimestampedValue](cdc)),
-		latestOneInForecasterNetworkRegrets:      collections.NewMap(sb, types.OneInForecasterNetworkRegretsKey, "one_in_forecaster_network_regrets", collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.StringKey), codec.CollValue[types.TimestampedValue](cdc)),
-		latestOneInForecasterSelfNetworkRegrets:  collections.NewMap(sb, types.OneInForecasterSelfNetworkRegretsKey, "one_in_forecaster_self_network_regrets", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.TimestampedValue](cdc)),
-		whitelistAdmins:                          collections.NewKeySet(sb, types.WhitelistAdminsKey, "whitelist_admins", collections.StringKey),
-		infererScoresByBlock:                     collections.NewMap(sb, types.InferenceScoresKey, "inferer_scores_by_block", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), codec.CollValue[types.Scores](cdc)),
-		forecasterScoresByBlock:                  collections.NewMap(sb, types.ForecastScoresKey, "forecaster_scores_by_block", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), codec.CollValue[types.Scores](cdc)),
-		latestInfererScoresByWorker:              collections.NewMap(sb, types.LatestInfererScoresByWorkerKey, "latest_inferer_scores_by_worker", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.Score](cdc)),
-		latestForecasterScoresByWorker:           collections.NewMap(sb, types.LatestForecasterScoresByWorkerKey, "latest_forecaster_scores_by_worker", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.Score](cdc)),
-		latestReputerScoresByReputer:             collections.NewMap(sb, types.LatestReputerScoresByReputerKey, "latest_reputer_scores_by_reputer", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.Score](cdc)),
-		previousReputerRewardFraction:            collections.NewMap(sb, types.PreviousReputerRewardFractionKey, "previous_reputer_reward_fraction", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), alloraMath.DecValue),
-		previousInferenceRewardFraction:          collections.NewMap(sb, types.PreviousInferenceRewardFractionKey, "previous_inference_reward_fraction", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), alloraMath.DecValue),
-		previousForecastRewardFraction:           collections.NewMap(sb, types.PreviousForecastRewardFractionKey, "previous_forecast_reward_fraction", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), alloraMath.DecValue),
-		reputerScoresByBlock:                     collections.NewMap(sb, types.ReputerScoresKey, "reputer_scores_by_block", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), codec.CollValue[types.Scores](cdc)),
-		reputerListeningCoefficient:              collections.NewMap(sb, types.ReputerListeningCoefficientKey, "reputer_listening_coefficient", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.ListeningCoefficient](cdc)),
-		unfulfilledWorkerNonces:                  collections.NewMap(sb, types.UnfulfilledWorkerNoncesKey, "unfulfilled_worker_nonces", collections.Uint64Key, codec.CollValue[types.Nonces](cdc)),
-		unfulfilledReputerNonces:                 collections.NewMap(sb, types.UnfulfilledReputerNoncesKey, "unfulfilled_reputer_nonces", collections.Uint64Key, codec.CollValue[types.ReputerRequestNonces](cdc)),
-		topicRewardNonce:                         collections.NewMap(sb, types.TopicRewardNonceKey, "topic_reward_nonce", collections.Uint64Key, collections.Int64Value),
-		topicLastWorkerCommit:                    collections.NewMap(sb, types.TopicLastWorkerCommitKey, "topic_last_worker_commit", collections.Uint64Key, codec.CollValue[types.TimestampedActorNonce](cdc)),
-		topicLastReputerCommit:                   collections.NewMap(sb, types.TopicLastReputerCommitKey, "topic_last_reputer_commit", collections.Uint64Key, codec.CollValue[types.TimestampedActorNonce](cdc)),
-		topicLastWorkerPayload:                   collections.NewMap(sb, types.TopicLastWorkerPayloadKey, "topic_last_worker_payload", collections.Uint64Key, codec.CollValue[types.TimestampedActorNonce](cdc)),
-		topicLastReputerPayload:                  collections.NewMap(sb, types.TopicLastReputerPayloadKey, "topic_last_reputer_payload", collections.Uint64Key, codec.CollValue[types.TimestampedActorNonce](cdc)),
+		lastBlockTime:                            collections.NewItem(sb, types.LastBlockTimeKey, "last_block_time", collections.Int64Value),
+		averageBlockTime:                         collections.NewItem(sb, types.AverageBlockTimeKey, "average_block_time", alloraMath.DecValue),
+		calibratedBlocksPerMonth:                 collections.NewItem(sb, types.CalibratedBlocksPerMonthKey, "calibrated_blocks_per_month", collections.Uint64Value),
+		lastBlocksPerMonthCalibrationHeight:      collections.NewItem(sb, types.LastBlocksPerMonthCalibrationHeightKey, "last_blocks_per_month_calibration_height", collections.Int64Value),
+		lastPercentageRewardToStakedReputersHeight: collections.NewItem(sb, types.LastPercentageRewardToStakedReputersHeightKey, "last_percentage_reward_to_staked_reputers_height", collections.Int64Value),
+		latestInfererNetworkRegrets:                collections.NewMap(sb, types.InfererNetworkRegretsKey, "inferer_network_regrets", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.TimestampedValue](cdc)),
+		latestForecasterNetworkRegrets:             collections.NewMap(sb, types.ForecasterNetworkRegretsKey, "forecaster_network_regrets", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.TimestampedValue](cdc)),
+		latestOneInForecasterNetworkRegrets:        collections.NewMap(sb, types.OneInForecasterNetworkRegretsKey, "one_in_forecaster_network_regrets", collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.StringKey), codec.CollValue[types.TimestampedValue](cdc)),
+		latestOneInForecasterSelfNetworkRegrets:    collections.NewMap(sb, types.OneInForecasterSelfNetworkRegretsKey, "one_in_forecaster_self_network_regrets", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.TimestampedValue](cdc)),
+		whitelistAdmins:                            collections.NewKeySet(sb, types.WhitelistAdminsKey, "whitelist_admins", collections.StringKey),
+		actorRoles:                                 collections.NewKeySet(sb, types.ActorRolesKey, "actor_roles", collections.PairKeyCodec(collections.StringKey, collections.Int32Key)),
+		infererScoresByBlock:                       collections.NewMap(sb, types.InferenceScoresKey, "inferer_scores_by_block", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), codec.CollValue[types.Scores](cdc)),
+		forecasterScoresByBlock:                    collections.NewMap(sb, types.ForecastScoresKey, "forecaster_scores_by_block", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), codec.CollValue[types.Scores](cdc)),
+		latestInfererScoresByWorker:                collections.NewMap(sb, types.LatestInfererScoresByWorkerKey, "latest_inferer_scores_by_worker", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.Score](cdc)),
+		latestForecasterScoresByWorker:             collections.NewMap(sb, types.LatestForecasterScoresByWorkerKey, "latest_forecaster_scores_by_worker", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.Score](cdc)),
+		latestReputerScoresByReputer:               collections.NewMap(sb, types.LatestReputerScoresByReputerKey, "latest_reputer_scores_by_reputer", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.Score](cdc)),
+		previousReputerRewardFraction:              collections.NewMap(sb, types.PreviousReputerRewardFractionKey, "previous_reputer_reward_fraction", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), alloraMath.DecValue),
+		previousInferenceRewardFraction:            collections.NewMap(sb, types.PreviousInferenceRewardFractionKey, "previous_inference_reward_fraction", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), alloraMath.DecValue),
+		previousForecastRewardFraction:             collections.NewMap(sb, types.PreviousForecastRewardFractionKey, "previous_forecast_reward_fraction", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), alloraMath.DecValue),
+		reputerScoresByBlock:                       collections.NewMap(sb, types.ReputerScoresKey, "reputer_scores_by_block", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), codec.CollValue[types.Scores](cdc)),
+		reputerListeningCoefficient:                collections.NewMap(sb, types.ReputerListeningCoefficientKey, "reputer_listening_coefficient", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.ListeningCoefficient](cdc)),
+		unfulfilledWorkerNonces:                    collections.NewMap(sb, types.UnfulfilledWorkerNoncesKey, "unfulfilled_worker_nonces", collections.Uint64Key, codec.CollValue[types.Nonces](cdc)),
+		unfulfilledReputerNonces:                   collections.NewMap(sb, types.UnfulfilledReputerNoncesKey, "unfulfilled_reputer_nonces", collections.Uint64Key, codec.CollValue[types.ReputerRequestNonces](cdc)),
+		topicRewardNonce:                           collections.NewMap(sb, types.TopicRewardNonceKey, "topic_reward_nonce", collections.Uint64Key, collections.Int64Value),
+		topicLastWorkerCommit:                      collections.NewMap(sb, types.TopicLastWorkerCommitKey, "topic_last_worker_commit", collections.Uint64Key, codec.CollValue[types.TimestampedActorNonce](cdc)),
+		topicLastReputerCommit:                     collections.NewMap(sb, types.TopicLastReputerCommitKey, "topic_last_reputer_commit", collections.Uint64Key, codec.CollValue[types.TimestampedActorNonce](cdc)),
+		topicLastWorkerPayload:                     collections.NewMap(sb, types.TopicLastWorkerPayloadKey, "topic_last_worker_payload", collections.Uint64Key, codec.CollValue[types.TimestampedActorNonce](cdc)),
+		topicLastReputerPayload:                    collections.NewMap(sb, types.TopicLastReputerPayloadKey, "topic_last_reputer_payload", collections.Uint64Key, codec.CollValue[types.TimestampedActorNonce](cdc)),
+		topicWorkerAllowlist:                       collections.NewKeySet(sb, types.TopicWorkerAllowlistKey, "topic_worker_allowlist", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey)),
+		topicReputerAllowlist:                      collections.NewKeySet(sb, types.TopicReputerAllowlistKey, "topic_reputer_allowlist", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey)),
+		workerLastActiveHeight:                     collections.NewMap(sb, types.WorkerLastActiveHeightKey, "worker_last_active_height", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), collections.Int64Value),
+		reputerLastActiveHeight:                    collections.NewMap(sb, types.ReputerLastActiveHeightKey, "reputer_last_active_height", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), collections.Int64Value),
+		topicEpochHeights:                          collections.NewKeySet(sb, types.TopicEpochHeightsKey, "topic_epoch_heights", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key)),
+		topicHorizonRewardNonce:                    collections.NewMap(sb, types.TopicHorizonRewardNonceKey, "topic_horizon_reward_nonce", collections.PairKeyCodec(collections.Uint64Key, collections.Uint32Key), collections.Int64Value),
+		horizonLossBundles:                         collections.NewMap(sb, types.HorizonLossBundlesKey, "value_bundles_horizon", collections.PairKeyCodec(collections.PairKeyCodec(collections.Uint64Key, collections.Uint32Key), collections.Int64Key), codec.CollValue[types.ReputerValueBundles](cdc)),
+		horizonNetworkLossBundles:                  collections.NewMap(sb, types.HorizonNetworkLossBundlesKey, "value_bundles_horizon_network", collections.PairKeyCodec(collections.PairKeyCodec(collections.Uint64Key, collections.Uint32Key), collections.Int64Key), codec.CollValue[types.ValueBundle](cdc)),
+		horizonLastReputerPayload:                  collections.NewMap(sb, types.HorizonLastReputerPayloadKey, "horizon_last_reputer_payload", collections.PairKeyCodec(collections.Uint64Key, collections.Uint32Key), codec.CollValue[types.TimestampedActorNonce](cdc)),
+		horizonInfererScoresByBlock:                collections.NewMap(sb, types.HorizonInfererScoresKey, "horizon_inferer_scores_by_block", collections.PairKeyCodec(collections.PairKeyCodec(collections.Uint64Key, collections.Uint32Key), collections.Int64Key), codec.CollValue[types.Scores](cdc)),
+		horizonForecasterScoresByBlock:             collections.NewMap(sb, types.HorizonForecasterScoresKey, "horizon_forecaster_scores_by_block", collections.PairKeyCodec(collections.PairKeyCodec(collections.Uint64Key, collections.Uint32Key), collections.Int64Key), codec.CollValue[types.Scores](cdc)),
+		horizonReputerScoresByBlock:                collections.NewMap(sb, types.HorizonReputerScoresKey, "horizon_reputer_scores_by_block", collections.PairKeyCodec(collections.PairKeyCodec(collections.Uint64Key, collections.Uint32Key), collections.Int64Key), codec.CollValue[types.Scores](cdc)),
 	}
 
 	schema, err := sb.Build()
@@ -291,6 +399,11 @@ func (k *Keeper) FulfillWorkerNonce(ctx context.Context, topicId TopicId, nonce
 // If the nonce is present, then it is removed from the unfulfilled nonces and this function returns true.
 // If the nonce is not present, then the function returns false.
 func (k *Keeper) FulfillReputerNonce(ctx context.Context, topicId TopicId, nonce *types.Nonce) (bool, error) {
+	return k.FulfillReputerNonceForHorizon(ctx, topicId, nonce, 0)
+}
+
+// Attempts to fulfill an unfulfilled nonce of a horizon of the topic, see FulfillReputerNonce.
+func (k *Keeper) FulfillReputerNonceForHorizon(ctx context.Context, topicId TopicId, nonce *types.Nonce, horizon Horizon) (bool, error) {
 	unfulfilledNonces, err := k.GetUnfulfilledReputerNonces(ctx, topicId)
 	if err != nil {
 		return false, err
@@ -298,7 +411,7 @@ func (k *Keeper) FulfillReputerNonce(ctx context.Context, topicId TopicId, nonce
 
 	// Check if the nonce is present in the unfulfilled nonces
 	for i, n := range unfulfilledNonces.Nonces {
-		if n.ReputerNonce.BlockHeight == nonce.BlockHeight {
+		if n.ReputerNonce.BlockHeight == nonce.BlockHeight && n.Horizon == horizon {
 			// Remove the nonce from the unfulfilled nonces
 			unfulfilledNonces.Nonces = append(unfulfilledNonces.Nonces[:i], unfulfilledNonces.Nonces[i+1:]...)
 			err := k.unfulfilledReputerNonces.Set(ctx, topicId, unfulfilledNonces)
@@ -336,6 +449,11 @@ func (k *Keeper) IsWorkerNonceUnfulfilled(ctx context.Context, topicId TopicId,
 
 // True if nonce is unfulfilled, false otherwise.
 func (k *Keeper) IsReputerNonceUnfulfilled(ctx context.Context, topicId TopicId, nonce *types.Nonce) (bool, error) {
+	return k.IsReputerNonceUnfulfilledForHorizon(ctx, topicId, nonce, 0)
+}
+
+// True if the nonce of a horizon of the topic is unfulfilled, false otherwise.
+func (k *Keeper) IsReputerNonceUnfulfilledForHorizon(ctx context.Context, topicId TopicId, nonce *types.Nonce, horizon Horizon) (bool, error) {
 	// Get the latest unfulfilled nonces
 	unfulfilledNonces, err := k.GetUnfulfilledReputerNonces(ctx, topicId)
 	if err != nil {
@@ -347,7 +465,7 @@ func (k *Keeper) IsReputerNonceUnfulfilled(ctx context.Context, topicId TopicId,
 		if n == nil {
 			continue
 		}
-		if n.ReputerNonce.BlockHeight == nonce.BlockHeight {
+		if n.ReputerNonce.BlockHeight == nonce.BlockHeight && n.Horizon == horizon {
 			return true, nil
 		}
 	}
@@ -391,6 +509,12 @@ func (k *Keeper) AddWorkerNonce(ctx context.Context, topicId TopicId, nonce *typ
 // Adds a nonce to the unfulfilled nonces for the topic if it is not yet added (idempotent).
 // If the max number of nonces is reached, then the function removes the oldest nonce and adds the new nonce.
 func (k *Keeper) AddReputerNonce(ctx context.Context, topicId TopicId, nonce *types.Nonce) error {
+	return k.AddReputerNonceForHorizon(ctx, topicId, nonce, 0)
+}
+
+// Adds a nonce of a horizon of the topic to its unfulfilled nonces, see AddReputerNonce.
+// The max number of nonces applies to each horizon separately.
+func (k *Keeper) AddReputerNonceForHorizon(ctx context.Context, topicId TopicId, nonce *types.Nonce, horizon Horizon) error {
 	nonces, err := k.GetUnfulfilledReputerNonces(ctx, topicId)
 	if err != nil {
 		return err
@@ -403,12 +527,13 @@ func (k *Keeper) AddReputerNonce(ctx context.Context, topicId TopicId, nonce *ty
 	// nor that the `associatedWorkerNonce` is already associated with a worker requeset
 	for _, n := range nonces.Nonces {
 		// Do nothing if nonce is already in the list
-		if n.ReputerNonce.BlockHeight == nonce.BlockHeight {
+		if n.ReputerNonce.BlockHeight == nonce.BlockHeight && n.Horizon == horizon {
 			return nil
 		}
 	}
 	reputerRequestNonce := &types.ReputerRequestNonce{
 		ReputerNonce: nonce,
+		Horizon:      horizon,
 	}
 	nonces.Nonces = append([]*types.ReputerRequestNonce{reputerRequestNonce}, nonces.Nonces...)
 
@@ -417,16 +542,29 @@ func (k *Keeper) AddReputerNonce(ctx context.Context, topicId TopicId, nonce *ty
 		return err
 	}
 	maxUnfulfilledRequests := moduleParams.MaxUnfulfilledReputerRequests
-	lenNonces := uint64(len(nonces.Nonces))
-	if lenNonces > maxUnfulfilledRequests {
-		diff := uint64(len(nonces.Nonces)) - maxUnfulfilledRequests
-		if diff > 0 {
-			nonces.Nonces = nonces.Nonces[:maxUnfulfilledRequests]
+	// Nonces are sorted newest first, so only the latest nonces of each horizon are kept
+	numNoncesByHorizon := make(map[Horizon]uint64)
+	keptNonces := make([]*types.ReputerRequestNonce, 0, len(nonces.Nonces))
+	for _, n := range nonces.Nonces {
+		if numNoncesByHorizon[n.Horizon] < maxUnfulfilledRequests {
+			keptNonces = append(keptNonces, n)
+			numNoncesByHorizon[n.Horizon]++
 		}
 	}
+	nonces.Nonces = keptNonces
 	return k.unfulfilledReputerNonces.Set(ctx, topicId, nonces)
 }
 
+// Schedules a reputer nonce for every horizon of the topic
+func (k *Keeper) AddReputerNoncesForTopicHorizons(ctx context.Context, topic types.Topic, nonce *types.Nonce) error {
+	for horizon := Horizon(0); horizon < topic.NumHorizons(); horizon++ {
+		if err := k.AddReputerNonceForHorizon(ctx, topic.Id, nonce, horizon); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (k *Keeper) GetUnfulfilledWorkerNonces(ctx context.Context, topicId TopicId) (types.Nonces, error) {
 	nonces, err := k.unfulfilledWorkerNonces.Get(ctx, topicId)
 	if err != nil {
@@ -578,6 +716,218 @@ func (k Keeper) GetParams(ctx context.Context) (types.Params, error) {
 	return ret, nil
 }
 
+/// BLOCK TIME
+
+// Returns the header time of the last block seen by UpdateAverageBlockTime, in unix nanoseconds.
+// Returns 0 if no block was seen yet
+func (k Keeper) GetLastBlockTime(ctx context.Context) (int64, error) {
+	ret, err := k.lastBlockTime.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return ret, nil
+}
+
+// Returns the moving average of the block time, in seconds. Returns 0 if it was never measured
+func (k Keeper) GetAverageBlockTime(ctx context.Context) (alloraMath.Dec, error) {
+	ret, err := k.averageBlockTime.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return alloraMath.ZeroDec(), nil
+		}
+		return alloraMath.Dec{}, err
+	}
+	return ret, nil
+}
+
+// Returns the blocks per month derived from the average block time. Returns 0 if it was never calibrated
+func (k Keeper) GetCalibratedBlocksPerMonth(ctx context.Context) (uint64, error) {
+	ret, err := k.calibratedBlocksPerMonth.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return ret, nil
+}
+
+// Returns the height of the last recalibration of the blocks per month. Returns 0 if it never ran
+func (k Keeper) GetLastBlocksPerMonthCalibrationHeight(ctx context.Context) (BlockHeight, error) {
+	ret, err := k.lastBlocksPerMonthCalibrationHeight.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return ret, nil
+}
+
+func (k *Keeper) SetLastBlocksPerMonthCalibrationHeight(ctx context.Context, blockHeight BlockHeight) error {
+	return k.lastBlocksPerMonthCalibrationHeight.Set(ctx, blockHeight)
+}
+
+// Whether a month passed since lastHeight, so that a monthly update is due at blockHeight.
+// Counting from the last update rather than from height 0 keeps updates monthly when the
+// blocks per month changes, e.g. after a recalibration. An update is always due if none happened yet,
+// or if the last one is ahead of blockHeight, as after a zero height genesis export
+func IsMonthElapsed(lastHeight, blockHeight BlockHeight, blocksPerMonth uint64) bool {
+	return lastHeight <= 0 || lastHeight > blockHeight || blockHeight >= lastHeight+int64(blocksPerMonth)
+}
+
+// Returns the number of blocks in a month used by the chain.
+// This is the calibrated value when calibration is enabled and has run at least once,
+// and the BlocksPerMonth param otherwise
+func (k Keeper) GetBlocksPerMonth(ctx context.Context) (uint64, error) {
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return k.getBlocksPerMonth(ctx, moduleParams)
+}
+
+func (k Keeper) getBlocksPerMonth(ctx context.Context, moduleParams types.Params) (uint64, error) {
+	if !moduleParams.BlocksPerMonthCalibrationEnabled {
+		return moduleParams.BlocksPerMonth, nil
+	}
+	calibrated, err := k.GetCalibratedBlocksPerMonth(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if calibrated == 0 {
+		return moduleParams.BlocksPerMonth, nil
+	}
+	return calibrated, nil
+}
+
+// Folds the time elapsed since the last block into the moving average of the block time:
+// average = degree * elapsed + (1 - degree) * average.
+// The first measured block time seeds the average. Blocks whose time does not move forward are skipped,
+// and the time elapsed is capped at MaxElapsedBlockTimeFactor times the average
+func (k *Keeper) UpdateAverageBlockTime(ctx context.Context, blockTime time.Time) error {
+	lastBlockTime, err := k.GetLastBlockTime(ctx)
+	if err != nil {
+		return err
+	}
+	currentBlockTime := blockTime.UnixNano()
+	if err := k.lastBlockTime.Set(ctx, currentBlockTime); err != nil {
+		return err
+	}
+	if lastBlockTime == 0 || currentBlockTime <= lastBlockTime {
+		return nil
+	}
+
+	elapsed, err := alloraMath.NewDecFromInt64(currentBlockTime - lastBlockTime).Quo(alloraMath.NewDecFromInt64(int64(time.Second)))
+	if err != nil {
+		return err
+	}
+	average, err := k.GetAverageBlockTime(ctx)
+	if err != nil {
+		return err
+	}
+	if !average.IsZero() {
+		maxElapsed, err := average.Mul(alloraMath.NewDecFromInt64(types.MaxElapsedBlockTimeFactor))
+		if err != nil {
+			return err
+		}
+		if elapsed.Gt(maxElapsed) {
+			elapsed = maxElapsed
+		}
+	}
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	average, err = alloraMath.CalcEma(moduleParams.BlockTimeSmoothingDegree, elapsed, average, average.IsZero())
+	if err != nil {
+		return err
+	}
+	return k.averageBlockTime.Set(ctx, average)
+}
+
+// Returns the number of blocks in a month implied by the average block time. Returns 0 if it was never measured
+func (k Keeper) GetObservedBlocksPerMonth(ctx context.Context) (uint64, error) {
+	average, err := k.GetAverageBlockTime(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if !average.Gt(alloraMath.ZeroDec()) {
+		return 0, nil
+	}
+	secondsPerMonth, err := alloraMath.NewDecFromUint64(types.SecondsPerMonth)
+	if err != nil {
+		return 0, err
+	}
+	observed, err := secondsPerMonth.Quo(average)
+	if err != nil {
+		return 0, err
+	}
+	observed, err = observed.Floor()
+	if err != nil {
+		return 0, err
+	}
+	return observed.UInt64()
+}
+
+// Moves the calibrated blocks per month towards the value implied by the average block time.
+// The change is capped at MaxBlocksPerMonthChange of the current value and the result is kept within
+// [MinBlocksPerMonth, MaxBlocksPerMonth]. Does nothing when calibration is disabled or no block time was measured
+func (k *Keeper) CalibrateBlocksPerMonth(ctx context.Context) error {
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if !moduleParams.BlocksPerMonthCalibrationEnabled {
+		return nil
+	}
+	observed, err := k.GetObservedBlocksPerMonth(ctx)
+	if err != nil {
+		return err
+	}
+	if observed == 0 {
+		return nil
+	}
+	current, err := k.getBlocksPerMonth(ctx, moduleParams)
+	if err != nil {
+		return err
+	}
+
+	currentDec, err := alloraMath.NewDecFromUint64(current)
+	if err != nil {
+		return err
+	}
+	maxChangeDec, err := currentDec.Mul(moduleParams.MaxBlocksPerMonthChange)
+	if err != nil {
+		return err
+	}
+	maxChangeDec, err = maxChangeDec.Floor()
+	if err != nil {
+		return err
+	}
+	maxChange, err := maxChangeDec.UInt64()
+	if err != nil {
+		return err
+	}
+
+	calibrated := observed
+	if calibrated > current+maxChange {
+		calibrated = current + maxChange
+	} else if current > maxChange && calibrated < current-maxChange {
+		calibrated = current - maxChange
+	}
+	if calibrated < moduleParams.MinBlocksPerMonth {
+		calibrated = moduleParams.MinBlocksPerMonth
+	}
+	if calibrated > moduleParams.MaxBlocksPerMonth {
+		calibrated = moduleParams.MaxBlocksPerMonth
+	}
+	return k.calibratedBlocksPerMonth.Set(ctx, calibrated)
+}
+
 /// INFERENCES, FORECASTS
 
 func (k *Keeper) GetInferencesAtBlock(ctx context.Context, topicId TopicId, block BlockHeight) (*types.Inferences, error) {
@@ -685,6 +1035,37 @@ func (k *Keeper) DeleteTopicRewardNonce(ctx context.Context, topicId TopicId) er
 	return k.topicRewardNonce.Remove(ctx, topicId)
 }
 
+// GetTopicHorizonRewardNonce retrieves the reward nonce of a horizon of a topic, 0 if there is none.
+func (k *Keeper) GetTopicHorizonRewardNonce(ctx context.Context, topicId TopicId, horizon Horizon) (BlockHeight, error) {
+	if horizon == 0 {
+		return k.GetTopicRewardNonce(ctx, topicId)
+	}
+	nonce, err := k.topicHorizonRewardNonce.Get(ctx, collections.Join(topicId, horizon))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return nonce, nil
+}
+
+// SetTopicHorizonRewardNonce sets the reward nonce of a horizon of a topic.
+func (k *Keeper) SetTopicHorizonRewardNonce(ctx context.Context, topicId TopicId, horizon Horizon, nonce BlockHeight) error {
+	if horizon == 0 {
+		return k.SetTopicRewardNonce(ctx, topicId, nonce)
+	}
+	return k.topicHorizonRewardNonce.Set(ctx, collections.Join(topicId, horizon), nonce)
+}
+
+// DeleteTopicHorizonRewardNonce removes the reward nonce of a horizon of a topic.
+func (k *Keeper) DeleteTopicHorizonRewardNonce(ctx context.Context, topicId TopicId, horizon Horizon) error {
+	if horizon == 0 {
+		return k.DeleteTopicRewardNonce(ctx, topicId)
+	}
+	return k.topicHorizonRewardNonce.Remove(ctx, collections.Join(topicId, horizon))
+}
+
 /// LOSS BUNDLES
 
 // Insert a loss bundle for a topic and timestamp. Overwrites previous ones stored at that composite index.
@@ -719,6 +1100,132 @@ func (k *Keeper) GetNetworkLossBundleAtBlock(ctx context.Context, topicId TopicI
 	return &lossBundle, nil
 }
 
+// Set the weights of the network inference of a horizon of a topic at a block,
+// replacing the ones previously stored for that horizon if any
+func (k *Keeper) SetNetworkInferenceWeights(ctx context.Context, weights types.NetworkInferenceWeights) error {
+	key := collections.Join(weights.TopicId, weights.BlockHeight)
+	weightsAtBlock, err := k.networkInferenceWeights.Get(ctx, key)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		weightsAtBlock = types.NetworkInferenceWeightsAtBlock{}
+	}
+	horizons := make([]*types.NetworkInferenceWeights, 0, len(weightsAtBlock.Horizons)+1)
+	for _, horizonWeights := range weightsAtBlock.Horizons {
+		if horizonWeights.Horizon != weights.Horizon {
+			horizons = append(horizons, horizonWeights)
+		}
+	}
+	horizons = append(horizons, &weights)
+	sort.Slice(horizons, func(i, j int) bool {
+		return horizons[i].Horizon < horizons[j].Horizon
+	})
+	weightsAtBlock.Horizons = horizons
+	return k.networkInferenceWeights.Set(ctx, key, weightsAtBlock)
+}
+
+// Get the weights of the network inferences of every horizon of a topic at a block
+func (k *Keeper) GetNetworkInferenceWeightsAtBlock(ctx context.Context, topicId TopicId, block BlockHeight) (*types.NetworkInferenceWeightsAtBlock, error) {
+	weightsAtBlock, err := k.networkInferenceWeights.Get(ctx, collections.Join(topicId, block))
+	if err != nil {
+		return nil, err
+	}
+	return &weightsAtBlock, nil
+}
+
+// Get the weights of the network inference of a horizon of a topic at a block
+func (k *Keeper) GetNetworkInferenceWeightsAtBlockForHorizon(ctx context.Context, topicId TopicId, block BlockHeight, horizon Horizon) (*types.NetworkInferenceWeights, error) {
+	weightsAtBlock, err := k.GetNetworkInferenceWeightsAtBlock(ctx, topicId, block)
+	if err != nil {
+		return nil, err
+	}
+	for _, weights := range weightsAtBlock.Horizons {
+		if weights.Horizon == horizon {
+			return weights, nil
+		}
+	}
+	return nil, collections.ErrNotFound
+}
+
+// Set how the reward of a horizon of a topic was split at a reward nonce,
+// replacing the breakdown previously stored for that horizon if any
+func (k *Keeper) SetRewardBreakdown(ctx context.Context, breakdown types.RewardBreakdown) error {
+	key := collections.Join(breakdown.TopicId, breakdown.BlockHeight)
+	breakdowns, err := k.rewardBreakdowns.Get(ctx, key)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		breakdowns = types.RewardBreakdowns{}
+	}
+	horizons := make([]*types.RewardBreakdown, 0, len(breakdowns.Horizons)+1)
+	for _, horizonBreakdown := range breakdowns.Horizons {
+		if horizonBreakdown.Horizon != breakdown.Horizon {
+			horizons = append(horizons, horizonBreakdown)
+		}
+	}
+	horizons = append(horizons, &breakdown)
+	sort.Slice(horizons, func(i, j int) bool {
+		return horizons[i].Horizon < horizons[j].Horizon
+	})
+	breakdowns.Horizons = horizons
+	return k.rewardBreakdowns.Set(ctx, key, breakdowns)
+}
+
+// Get how the reward of every horizon of a topic rewarded at a block was split
+func (k *Keeper) GetRewardBreakdowns(ctx context.Context, topicId TopicId, block BlockHeight) (*types.RewardBreakdowns, error) {
+	breakdowns, err := k.rewardBreakdowns.Get(ctx, collections.Join(topicId, block))
+	if err != nil {
+		return nil, err
+	}
+	return &breakdowns, nil
+}
+
+// Insert the loss bundles of a horizon of a topic at a block. Overwrites previous ones stored at that composite index.
+func (k *Keeper) InsertReputerLossBundlesAtBlockForHorizon(ctx context.Context, topicId TopicId, horizon Horizon, block BlockHeight, reputerLossBundles types.ReputerValueBundles) error {
+	if horizon == 0 {
+		return k.InsertReputerLossBundlesAtBlock(ctx, topicId, block, reputerLossBundles)
+	}
+	key := collections.Join(collections.Join(topicId, horizon), block)
+	return k.horizonLossBundles.Set(ctx, key, reputerLossBundles)
+}
+
+// Get the loss bundles of a horizon of a topic at a block
+func (k *Keeper) GetReputerLossBundlesAtBlockForHorizon(ctx context.Context, topicId TopicId, horizon Horizon, block BlockHeight) (*types.ReputerValueBundles, error) {
+	if horizon == 0 {
+		return k.GetReputerLossBundlesAtBlock(ctx, topicId, block)
+	}
+	key := collections.Join(collections.Join(topicId, horizon), block)
+	reputerLossBundles, err := k.horizonLossBundles.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	return &reputerLossBundles, nil
+}
+
+// Insert the network loss bundle of a horizon of a topic at a block.
+func (k *Keeper) InsertNetworkLossBundleAtBlockForHorizon(ctx context.Context, topicId TopicId, horizon Horizon, block BlockHeight, lossBundle types.ValueBundle) error {
+	if horizon == 0 {
+		return k.InsertNetworkLossBundleAtBlock(ctx, topicId, block, lossBundle)
+	}
+	key := collections.Join(collections.Join(topicId, horizon), block)
+	return k.horizonNetworkLossBundles.Set(ctx, key, lossBundle)
+}
+
+// Get the network loss bundle of a horizon of a topic at a block
+func (k *Keeper) GetNetworkLossBundleAtBlockForHorizon(ctx context.Context, topicId TopicId, horizon Horizon, block BlockHeight) (*types.ValueBundle, error) {
+	if horizon == 0 {
+		return k.GetNetworkLossBundleAtBlock(ctx, topicId, block)
+	}
+	key := collections.Join(collections.Join(topicId, horizon), block)
+	lossBundle, err := k.horizonNetworkLossBundles.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	return &lossBundle, nil
+}
+
 /// STAKING
 
 // Adds stake to the system for a given topic and reputer
@@ -1481,85 +1988,264 @@ func (k *Keeper) GetDelegateStakeRemovalForDelegatorReputerAndTopicId(
 
 // Adds a new reputer to the reputer tracking data structures, reputers and topicReputers
 func (k *Keeper) InsertReputer(ctx context.Context, topicId TopicId, reputer ActorId, reputerInfo types.OffchainNode) error {
-	topicKey := collections.Join(topicId, reputer)
-	err := k.topicReputers.Set(ctx, topicKey)
+	err := k.insertRegistration(ctx, k.topicReputers, k.reputerTopics, topicId, reputer)
 	if err != nil {
 		return err
 	}
-	err = k.reputers.Set(ctx, reputerInfo.LibP2PKey, reputerInfo)
+	err = k.setNode(ctx, k.reputers, k.reputerNodeKeys, reputer, reputerInfo)
 	if err != nil {
 		return err
 	}
-	return nil
+	// Registering counts as activity so new reputers are not removed before their first nonce
+	return k.SetReputerLastActiveHeight(ctx, topicId, reputer, sdk.UnwrapSDKContext(ctx).BlockHeight())
 }
 
 // Remove a reputer to the reputer tracking data structures and topicReputers
 func (k *Keeper) RemoveReputer(ctx context.Context, topicId TopicId, reputer ActorId) error {
-	topicKey := collections.Join(topicId, reputer)
-	err := k.topicReputers.Remove(ctx, topicKey)
+	err := k.removeRegistration(ctx, k.topicReputers, k.reputerTopics, topicId, reputer)
 	if err != nil {
 		return err
 	}
-	return nil
+	return k.reputerLastActiveHeight.Remove(ctx, collections.Join(topicId, reputer))
 }
 
 func (k *Keeper) GetReputerByLibp2pKey(ctx sdk.Context, reputerKey string) (types.OffchainNode, error) {
 	return k.reputers.Get(ctx, reputerKey)
 }
 
+// Replaces the node info of a registered reputer across all topics it is registered in.
+// Returns the topics the reputer is registered in and the libp2p keys that were replaced.
+func (k *Keeper) UpdateReputerNodeInfo(ctx context.Context, reputer ActorId, reputerInfo types.OffchainNode) ([]TopicId, []LibP2pKey, error) {
+	return k.updateNodeInfo(ctx, k.reputers, k.reputerTopics, k.reputerNodeKeys, reputer, reputerInfo)
+}
+
 /// WORKERS
 
 // Adds a new worker to the worker tracking data structures, workers and topicWorkers
 func (k *Keeper) InsertWorker(ctx context.Context, topicId TopicId, worker ActorId, workerInfo types.OffchainNode) error {
-	topickey := collections.Join(topicId, worker)
-	err := k.topicWorkers.Set(ctx, topickey)
+	err := k.insertRegistration(ctx, k.topicWorkers, k.workerTopics, topicId, worker)
 	if err != nil {
 		return err
 	}
-	err = k.workers.Set(ctx, workerInfo.LibP2PKey, workerInfo)
+	err = k.setNode(ctx, k.workers, k.workerNodeKeys, worker, workerInfo)
 	if err != nil {
 		return err
 	}
-	return nil
+	// Registering counts as activity so new workers are not removed before their first nonce
+	return k.SetWorkerLastActiveHeight(ctx, topicId, worker, sdk.UnwrapSDKContext(ctx).BlockHeight())
 }
 
 // Remove a worker to the worker tracking data structures and topicWorkers
 func (k *Keeper) RemoveWorker(ctx context.Context, topicId TopicId, worker ActorId) error {
-	topicKey := collections.Join(topicId, worker)
-	err := k.topicWorkers.Remove(ctx, topicKey)
+	err := k.removeRegistration(ctx, k.topicWorkers, k.workerTopics, topicId, worker)
 	if err != nil {
 		return err
 	}
-	return nil
+	return k.workerLastActiveHeight.Remove(ctx, collections.Join(topicId, worker))
 }
 
 func (k *Keeper) GetWorkerByLibp2pKey(ctx sdk.Context, workerKey string) (types.OffchainNode, error) {
 	return k.workers.Get(ctx, workerKey)
 }
 
-func (k *Keeper) GetWorkerAddressByP2PKey(ctx context.Context, p2pKey string) (sdk.AccAddress, error) {
-	worker, err := k.workers.Get(ctx, p2pKey)
-	if err != nil {
-		return nil, err
-	}
+// Replaces the node info of a registered worker across all topics it is registered in.
+// Returns the topics the worker is registered in and the libp2p keys that were replaced.
+func (k *Keeper) UpdateWorkerNodeInfo(ctx context.Context, worker ActorId, workerInfo types.OffchainNode) ([]TopicId, []LibP2pKey, error) {
+	return k.updateNodeInfo(ctx, k.workers, k.workerTopics, k.workerNodeKeys, worker, workerInfo)
+}
 
-	workerAddress, err := sdk.AccAddressFromBech32(worker.GetOwner())
+// Registrations are kept both by topic and by actor, so that the topics of an actor
+// can be read without going through the registrations of every other actor
+func (k *Keeper) insertRegistration(
+	ctx context.Context,
+	registrations collections.KeySet[collections.Pair[TopicId, ActorId]],
+	actorTopics collections.KeySet[collections.Pair[ActorId, TopicId]],
+	topicId TopicId,
+	actor ActorId,
+) error {
+	err := registrations.Set(ctx, collections.Join(topicId, actor))
 	if err != nil {
-		return nil, err
+		return err
 	}
-
-	return workerAddress, nil
+	return actorTopics.Set(ctx, collections.Join(actor, topicId))
 }
 
-func (k *Keeper) GetReputerAddressByP2PKey(ctx context.Context, p2pKey string) (sdk.AccAddress, error) {
-	reputer, err := k.reputers.Get(ctx, p2pKey)
+func (k *Keeper) removeRegistration(
+	ctx context.Context,
+	registrations collections.KeySet[collections.Pair[TopicId, ActorId]],
+	actorTopics collections.KeySet[collections.Pair[ActorId, TopicId]],
+	topicId TopicId,
+	actor ActorId,
+) error {
+	err := registrations.Remove(ctx, collections.Join(topicId, actor))
 	if err != nil {
-		return nil, err
+		return err
 	}
+	return actorTopics.Remove(ctx, collections.Join(actor, topicId))
+}
 
-	address, err := sdk.AccAddressFromBech32(reputer.GetOwner())
+// Nodes are keyed by libp2p key, so the keys of the entries of every actor are indexed
+// to find them again when the actor updates its node info
+func (k *Keeper) setNode(
+	ctx context.Context,
+	nodes collections.Map[LibP2pKey, types.OffchainNode],
+	actorNodeKeys collections.KeySet[collections.Pair[ActorId, LibP2pKey]],
+	actor ActorId,
+	nodeInfo types.OffchainNode,
+) error {
+	err := nodes.Set(ctx, nodeInfo.LibP2PKey, nodeInfo)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	return actorNodeKeys.Set(ctx, collections.Join(actor, nodeInfo.LibP2PKey))
+}
+
+// Entries of the actor stored under any other libp2p key than the new one are removed
+// before the new node info is written, keeping a single entry per actor.
+// Only the registrations and node entries of the actor are read.
+func (k *Keeper) updateNodeInfo(
+	ctx context.Context,
+	nodes collections.Map[LibP2pKey, types.OffchainNode],
+	actorTopics collections.KeySet[collections.Pair[ActorId, TopicId]],
+	actorNodeKeys collections.KeySet[collections.Pair[ActorId, LibP2pKey]],
+	actor ActorId,
+	nodeInfo types.OffchainNode,
+) ([]TopicId, []LibP2pKey, error) {
+	topicsIter, err := actorTopics.Iterate(ctx, collections.NewPrefixedPairRange[ActorId, TopicId](actor))
+	if err != nil {
+		return nil, nil, err
+	}
+	topicKeys, err := topicsIter.Keys()
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(topicKeys) == 0 {
+		return nil, nil, types.ErrAddressNotRegisteredInAnyTopic
+	}
+	topicIds := make([]TopicId, 0, len(topicKeys))
+	for _, key := range topicKeys {
+		topicIds = append(topicIds, key.K2())
+	}
+
+	existingNode, err := nodes.Get(ctx, nodeInfo.LibP2PKey)
+	if err == nil {
+		if existingNode.NodeAddress != actor {
+			return nil, nil, types.ErrLibP2PKeyAlreadyInUse
+		}
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return nil, nil, err
+	}
+
+	nodeKeysIter, err := actorNodeKeys.Iterate(ctx, collections.NewPrefixedPairRange[ActorId, LibP2pKey](actor))
+	if err != nil {
+		return nil, nil, err
+	}
+	nodeKeys, err := nodeKeysIter.Keys()
+	if err != nil {
+		return nil, nil, err
+	}
+	previousKeys := make([]LibP2pKey, 0)
+	for _, key := range nodeKeys {
+		libP2PKey := key.K2()
+		if libP2PKey == nodeInfo.LibP2PKey {
+			continue
+		}
+		err = actorNodeKeys.Remove(ctx, key)
+		if err != nil {
+			return nil, nil, err
+		}
+		// The entry may since have been taken over by another actor registering with the same key
+		node, err := nodes.Get(ctx, libP2PKey)
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				continue
+			}
+			return nil, nil, err
+		}
+		if node.NodeAddress != actor {
+			continue
+		}
+		err = nodes.Remove(ctx, libP2PKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		previousKeys = append(previousKeys, libP2PKey)
+	}
+
+	err = k.setNode(ctx, nodes, actorNodeKeys, actor, nodeInfo)
+	if err != nil {
+		return nil, nil, err
+	}
+	return topicIds, previousKeys, nil
+}
+
+// Builds the by-actor indexes of registrations and node entries from the by-topic
+// registrations and the nodes keyed by libp2p key
+func (k *Keeper) IndexRegistrations(ctx context.Context) error {
+	for _, index := range []struct {
+		registrations collections.KeySet[collections.Pair[TopicId, ActorId]]
+		actorTopics   collections.KeySet[collections.Pair[ActorId, TopicId]]
+		nodes         collections.Map[LibP2pKey, types.OffchainNode]
+		actorNodeKeys collections.KeySet[collections.Pair[ActorId, LibP2pKey]]
+	}{
+		{k.topicWorkers, k.workerTopics, k.workers, k.workerNodeKeys},
+		{k.topicReputers, k.reputerTopics, k.reputers, k.reputerNodeKeys},
+	} {
+		registrationsIter, err := index.registrations.Iterate(ctx, nil)
+		if err != nil {
+			return err
+		}
+		registrations, err := registrationsIter.Keys()
+		if err != nil {
+			return err
+		}
+		for _, registration := range registrations {
+			err = index.actorTopics.Set(ctx, collections.Join(registration.K2(), registration.K1()))
+			if err != nil {
+				return err
+			}
+		}
+		nodesIter, err := index.nodes.Iterate(ctx, nil)
+		if err != nil {
+			return err
+		}
+		nodes, err := nodesIter.KeyValues()
+		if err != nil {
+			return err
+		}
+		for _, node := range nodes {
+			err = index.actorNodeKeys.Set(ctx, collections.Join(node.Value.NodeAddress, node.Key))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (k *Keeper) GetWorkerAddressByP2PKey(ctx context.Context, p2pKey string) (sdk.AccAddress, error) {
+	worker, err := k.workers.Get(ctx, p2pKey)
+	if err != nil {
+		return nil, err
+	}
+
+	workerAddress, err := sdk.AccAddressFromBech32(worker.GetOwner())
+	if err != nil {
+		return nil, err
+	}
+
+	return workerAddress, nil
+}
+
+func (k *Keeper) GetReputerAddressByP2PKey(ctx context.Context, p2pKey string) (sdk.AccAddress, error) {
+	reputer, err := k.reputers.Get(ctx, p2pKey)
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := sdk.AccAddressFromBech32(reputer.GetOwner())
+	if err != nil {
+		return nil, err
 	}
 
 	return address, nil
@@ -1947,7 +2633,10 @@ func (k *Keeper) InsertWorkerInferenceScore(ctx context.Context, topicId TopicId
 	}
 
 	key := collections.Join(topicId, blockHeight)
-	return k.infererScoresByBlock.Set(ctx, key, scores)
+	if err := k.infererScoresByBlock.Set(ctx, key, scores); err != nil {
+		return err
+	}
+	return k.appendActorScoreHistory(ctx, k.infererScoreHistory, topicId, blockHeight, score, moduleParams.ActorHistoryRetention)
 }
 
 func (k *Keeper) GetInferenceScoresUntilBlock(ctx context.Context, topicId TopicId, blockHeight BlockHeight) ([]*types.Score, error) {
@@ -2017,7 +2706,10 @@ func (k *Keeper) InsertWorkerForecastScore(ctx context.Context, topicId TopicId,
 	}
 
 	key := collections.Join(topicId, blockHeight)
-	return k.forecasterScoresByBlock.Set(ctx, key, scores)
+	if err := k.forecasterScoresByBlock.Set(ctx, key, scores); err != nil {
+		return err
+	}
+	return k.appendActorScoreHistory(ctx, k.forecasterScoreHistory, topicId, blockHeight, score, moduleParams.ActorHistoryRetention)
 }
 
 func (k *Keeper) GetForecastScoresUntilBlock(ctx context.Context, topicId TopicId, blockHeight BlockHeight) ([]*types.Score, error) {
@@ -2087,7 +2779,10 @@ func (k *Keeper) InsertReputerScore(ctx context.Context, topicId TopicId, blockH
 		}
 	}
 	key := collections.Join(topicId, blockHeight)
-	return k.reputerScoresByBlock.Set(ctx, key, scores)
+	if err := k.reputerScoresByBlock.Set(ctx, key, scores); err != nil {
+		return err
+	}
+	return k.appendActorScoreHistory(ctx, k.reputerScoreHistory, topicId, blockHeight, score, moduleParams.ActorHistoryRetention)
 }
 
 func (k *Keeper) GetReputersScoresAtBlock(ctx context.Context, topicId TopicId, block BlockHeight) (types.Scores, error) {
@@ -2102,173 +2797,982 @@ func (k *Keeper) GetReputersScoresAtBlock(ctx context.Context, topicId TopicId,
 	return scores, nil
 }
 
-func (k *Keeper) SetListeningCoefficient(ctx context.Context, topicId TopicId, reputer ActorId, coefficient types.ListeningCoefficient) error {
-	key := collections.Join(topicId, reputer)
-	return k.reputerListeningCoefficient.Set(ctx, key, coefficient)
-}
-
-func (k *Keeper) GetListeningCoefficient(ctx context.Context, topicId TopicId, reputer ActorId) (types.ListeningCoefficient, error) {
-	key := collections.Join(topicId, reputer)
-	coef, err := k.reputerListeningCoefficient.Get(ctx, key)
-	if err != nil {
-		if errors.Is(err, collections.ErrNotFound) {
-			// Return a default value
-			return types.ListeningCoefficient{Coefficient: alloraMath.NewDecFromInt64(1)}, nil
-		}
-		return types.ListeningCoefficient{}, err
+// Insert the score of an inferer for a horizon of a topic at a block. Scores of the horizons after the first
+// one are kept apart from the topic scores and out of the actor history.
+func (k *Keeper) InsertWorkerInferenceScoreForHorizon(ctx context.Context, topicId TopicId, horizon Horizon, blockHeight BlockHeight, score types.Score) error {
+	if horizon == 0 {
+		return k.InsertWorkerInferenceScore(ctx, topicId, blockHeight, score)
 	}
-	return coef, nil
+	return k.insertHorizonScore(ctx, k.horizonInfererScoresByBlock, topicId, horizon, blockHeight, score)
 }
 
-/// REWARD FRACTION
-
-// Gets the previous W_{i-1,m}
-// Returns previous reward fraction, and true if it has yet to be set for the first time (else false)
-func (k *Keeper) GetPreviousReputerRewardFraction(ctx context.Context, topicId TopicId, reputer ActorId) (alloraMath.Dec, bool, error) {
-	key := collections.Join(topicId, reputer)
-	reward, err := k.previousReputerRewardFraction.Get(ctx, key)
-	if err != nil {
-		if errors.Is(err, collections.ErrNotFound) {
-			return alloraMath.ZeroDec(), true, nil
-		}
-		return alloraMath.Dec{}, false, err
+// Get the inferer scores of a horizon of a topic at a block
+func (k *Keeper) GetWorkerInferenceScoresAtBlockForHorizon(ctx context.Context, topicId TopicId, horizon Horizon, block BlockHeight) (types.Scores, error) {
+	if horizon == 0 {
+		return k.GetWorkerInferenceScoresAtBlock(ctx, topicId, block)
 	}
-	return reward, false, nil
-}
-
-// Sets the previous W_{i-1,m}
-func (k *Keeper) SetPreviousReputerRewardFraction(ctx context.Context, topicId TopicId, reputer ActorId, reward alloraMath.Dec) error {
-	key := collections.Join(topicId, reputer)
-	return k.previousReputerRewardFraction.Set(ctx, key, reward)
+	return k.getHorizonScoresAtBlock(ctx, k.horizonInfererScoresByBlock, topicId, horizon, block)
 }
 
-// Gets the previous U_{i-1,m}
-// Returns previous reward fraction, and true if it has yet to be set for the first time (else false)
-func (k *Keeper) GetPreviousInferenceRewardFraction(ctx context.Context, topicId TopicId, worker ActorId) (alloraMath.Dec, bool, error) {
-	key := collections.Join(topicId, worker)
-	reward, err := k.previousInferenceRewardFraction.Get(ctx, key)
-	if err != nil {
-		if errors.Is(err, collections.ErrNotFound) {
-			return alloraMath.ZeroDec(), true, nil
-		}
-		return alloraMath.Dec{}, false, err
+// Get the inferer scores of a horizon of a topic from the latest time steps up to a block
+func (k *Keeper) GetInferenceScoresUntilBlockForHorizon(ctx context.Context, topicId TopicId, horizon Horizon, blockHeight BlockHeight) ([]*types.Score, error) {
+	if horizon == 0 {
+		return k.GetInferenceScoresUntilBlock(ctx, topicId, blockHeight)
 	}
-	return reward, false, nil
-}
-
-// Sets the previous U_{i-1,m}
-func (k *Keeper) SetPreviousInferenceRewardFraction(ctx context.Context, topicId TopicId, worker ActorId, reward alloraMath.Dec) error {
-	key := collections.Join(topicId, worker)
-	return k.previousInferenceRewardFraction.Set(ctx, key, reward)
+	return k.getHorizonScoresUntilBlock(ctx, k.horizonInfererScoresByBlock, topicId, horizon, blockHeight)
 }
 
-// Gets the previous V_{i-1,m}
-// Returns previous reward fraction, and true if it has yet to be set for the first time (else false)
-func (k *Keeper) GetPreviousForecastRewardFraction(ctx context.Context, topicId TopicId, worker ActorId) (alloraMath.Dec, bool, error) {
-	key := collections.Join(topicId, worker)
-	reward, err := k.previousForecastRewardFraction.Get(ctx, key)
-	if err != nil {
-		if errors.Is(err, collections.ErrNotFound) {
-			return alloraMath.ZeroDec(), true, nil
-		}
-		return alloraMath.Dec{}, false, err
+// Insert the score of a forecaster for a horizon of a topic at a block. Scores of the horizons after the first
+// one are kept apart from the topic scores and out of the actor history.
+func (k *Keeper) InsertWorkerForecastScoreForHorizon(ctx context.Context, topicId TopicId, horizon Horizon, blockHeight BlockHeight, score types.Score) error {
+	if horizon == 0 {
+		return k.InsertWorkerForecastScore(ctx, topicId, blockHeight, score)
 	}
-	return reward, false, nil
-}
-
-// Sets the previous V_{i-1,m}
-func (k *Keeper) SetPreviousForecastRewardFraction(ctx context.Context, topicId TopicId, worker ActorId, reward alloraMath.Dec) error {
-	key := collections.Join(topicId, worker)
-	return k.previousForecastRewardFraction.Set(ctx, key, reward)
-}
-
-func (k *Keeper) SetPreviousPercentageRewardToStakedReputers(ctx context.Context, percentageRewardToStakedReputers alloraMath.Dec) error {
-	return k.previousPercentageRewardToStakedReputers.Set(ctx, percentageRewardToStakedReputers)
+	return k.insertHorizonScore(ctx, k.horizonForecasterScoresByBlock, topicId, horizon, blockHeight, score)
 }
 
-func (k Keeper) GetPreviousPercentageRewardToStakedReputers(ctx context.Context) (alloraMath.Dec, error) {
-	return k.previousPercentageRewardToStakedReputers.Get(ctx)
+// Get the forecaster scores of a horizon of a topic at a block
+func (k *Keeper) GetWorkerForecastScoresAtBlockForHorizon(ctx context.Context, topicId TopicId, horizon Horizon, block BlockHeight) (types.Scores, error) {
+	if horizon == 0 {
+		return k.GetWorkerForecastScoresAtBlock(ctx, topicId, block)
+	}
+	return k.getHorizonScoresAtBlock(ctx, k.horizonForecasterScoresByBlock, topicId, horizon, block)
 }
 
-/// WHITELISTS
-
-func (k Keeper) IsWhitelistAdmin(ctx context.Context, admin ActorId) (bool, error) {
-	return k.whitelistAdmins.Has(ctx, admin)
+// Get the forecaster scores of a horizon of a topic from the latest time steps up to a block
+func (k *Keeper) GetForecastScoresUntilBlockForHorizon(ctx context.Context, topicId TopicId, horizon Horizon, blockHeight BlockHeight) ([]*types.Score, error) {
+	if horizon == 0 {
+		return k.GetForecastScoresUntilBlock(ctx, topicId, blockHeight)
+	}
+	return k.getHorizonScoresUntilBlock(ctx, k.horizonForecasterScoresByBlock, topicId, horizon, blockHeight)
 }
 
-func (k *Keeper) AddWhitelistAdmin(ctx context.Context, admin ActorId) error {
-	return k.whitelistAdmins.Set(ctx, admin)
+// Insert the score of a reputer for a horizon of a topic at a block. Scores of the horizons after the first
+// one are kept apart from the topic scores and out of the actor history.
+func (k *Keeper) InsertReputerScoreForHorizon(ctx context.Context, topicId TopicId, horizon Horizon, blockHeight BlockHeight, score types.Score) error {
+	if horizon == 0 {
+		return k.InsertReputerScore(ctx, topicId, blockHeight, score)
+	}
+	return k.insertHorizonScore(ctx, k.horizonReputerScoresByBlock, topicId, horizon, blockHeight, score)
 }
 
-func (k *Keeper) RemoveWhitelistAdmin(ctx context.Context, admin ActorId) error {
-	return k.whitelistAdmins.Remove(ctx, admin)
+// Get the reputer scores of a horizon of a topic at a block
+func (k *Keeper) GetReputersScoresAtBlockForHorizon(ctx context.Context, topicId TopicId, horizon Horizon, block BlockHeight) (types.Scores, error) {
+	if horizon == 0 {
+		return k.GetReputersScoresAtBlock(ctx, topicId, block)
+	}
+	return k.getHorizonScoresAtBlock(ctx, k.horizonReputerScoresByBlock, topicId, horizon, block)
 }
 
-/// BANK KEEPER WRAPPERS
-
-// wrapper around bank keeper SendCoinsFromModuleToAccount
-func (k *Keeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipient ActorId, amt sdk.Coins) error {
-	recipientAddr, err := sdk.AccAddressFromBech32(recipient)
+func (k *Keeper) insertHorizonScore(
+	ctx context.Context,
+	scoresByBlock collections.Map[collections.Pair[collections.Pair[TopicId, Horizon], BlockHeight], types.Scores],
+	topicId TopicId,
+	horizon Horizon,
+	blockHeight BlockHeight,
+	score types.Score,
+) error {
+	scores, err := k.getHorizonScoresAtBlock(ctx, scoresByBlock, topicId, horizon, blockHeight)
 	if err != nil {
 		return err
 	}
-	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
-}
+	scores.Scores = append(scores.Scores, &score)
 
-// wrapper around bank keeper SendCoinsFromAccountToModule
-func (k *Keeper) SendCoinsFromAccountToModule(ctx context.Context, sender ActorId, recipientModule string, amt sdk.Coins) error {
-	senderAddr, err := sdk.AccAddressFromBech32(sender)
+	moduleParams, err := k.GetParams(ctx)
 	if err != nil {
 		return err
 	}
-	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
-}
+	maxNumScores := moduleParams.MaxSamplesToScaleScores
 
-// wrapper around bank keeper SendCoinsFromModuleToModule
-func (k *Keeper) SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error {
-	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, senderModule, recipientModule, amt)
-}
+	lenScores := uint64(len(scores.Scores))
+	if lenScores > maxNumScores {
+		diff := lenScores - maxNumScores
+		scores.Scores = scores.Scores[diff:]
+	}
 
-// wrapper around bank keeper GetBalance
-func (k *Keeper) GetBankBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
-	return k.bankKeeper.GetBalance(ctx, addr, denom)
+	key := collections.Join(collections.Join(topicId, horizon), blockHeight)
+	return scoresByBlock.Set(ctx, key, scores)
 }
 
-// GetTotalRewardToDistribute
-func (k *Keeper) GetTotalRewardToDistribute(ctx context.Context) (alloraMath.Dec, error) {
-	// Get Allora Rewards Account
-	alloraRewardsAccountAddr := k.authKeeper.GetModuleAccount(ctx, types.AlloraRewardsAccountName).GetAddress()
-	// Get Total Allocation
-	totalReward := k.GetBankBalance(
-		ctx,
-		alloraRewardsAccountAddr,
-		params.DefaultBondDenom).Amount
-	totalRewardDec, err := alloraMath.NewDecFromSdkInt(totalReward)
+func (k *Keeper) getHorizonScoresAtBlock(
+	ctx context.Context,
+	scoresByBlock collections.Map[collections.Pair[collections.Pair[TopicId, Horizon], BlockHeight], types.Scores],
+	topicId TopicId,
+	horizon Horizon,
+	block BlockHeight,
+) (types.Scores, error) {
+	key := collections.Join(collections.Join(topicId, horizon), block)
+	scores, err := scoresByBlock.Get(ctx, key)
 	if err != nil {
-		return alloraMath.Dec{}, err
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.Scores{}, nil
+		}
+		return types.Scores{}, err
 	}
-	return totalRewardDec, nil
+	return scores, nil
 }
 
-/// UTILS
+func (k *Keeper) getHorizonScoresUntilBlock(
+	ctx context.Context,
+	scoresByBlock collections.Map[collections.Pair[collections.Pair[TopicId, Horizon], BlockHeight], types.Scores],
+	topicId TopicId,
+	horizon Horizon,
+	blockHeight BlockHeight,
+) ([]*types.Score, error) {
+	rng := collections.
+		NewPrefixedPairRange[collections.Pair[TopicId, Horizon], BlockHeight](collections.Join(topicId, horizon)).
+		EndInclusive(blockHeight).
+		Descending()
 
-// Convert pagination.key from []bytes to uint64, if pagination is nil or [], len = 0
-// Get the limit from the pagination request, within acceptable bounds and defaulting as necessary
-func (k Keeper) CalcAppropriatePaginationForUint64Cursor(ctx context.Context, pagination *types.SimpleCursorPaginationRequest) (uint64, uint64, error) {
+	scores := make([]*types.Score, 0)
+	iter, err := scoresByBlock.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	// Get max number of time steps that should be retrieved
 	moduleParams, err := k.GetParams(ctx)
 	if err != nil {
-		return uint64(0), uint64(0), err
+		return nil, err
 	}
-	limit := moduleParams.DefaultPageLimit
-	cursor := uint64(0)
+	maxNumTimeSteps := moduleParams.MaxSamplesToScaleScores
 
-	if pagination != nil {
-		if len(pagination.Key) > 0 {
-			cursor = binary.BigEndian.Uint64(pagination.Key)
-		}
-		if pagination.Limit > 0 {
-			limit = pagination.Limit
-		}
+	count := 0
+	for ; iter.Valid() && count < int(maxNumTimeSteps); iter.Next() {
+		existingScores, err := iter.KeyValue()
+		if err != nil {
+			return nil, err
+		}
+		for _, score := range existingScores.Value.Scores {
+			scores = append(scores, score)
+			count++
+		}
+	}
+
+	return scores, nil
+}
+
+// Record a score in the history of the actor, indexed by block so that PruneActorHistory drops it
+// once it is older than the retention window. A retention of 0 keeps no history.
+func (k *Keeper) appendActorScoreHistory(
+	ctx context.Context,
+	history collections.Map[collections.Triple[TopicId, ActorId, BlockHeight], types.Score],
+	topicId TopicId,
+	blockHeight BlockHeight,
+	score types.Score,
+	retention BlockHeight,
+) error {
+	if retention <= 0 {
+		return nil
+	}
+	if err := history.Set(ctx, collections.Join3(topicId, score.Address, blockHeight), score); err != nil {
+		return err
+	}
+	return k.actorHistoryBlocks.Set(ctx, collections.Join3(topicId, blockHeight, score.Address))
+}
+
+// Get the scores of an actor in a topic for a role between two blocks, inclusive, oldest first.
+// A toBlock of 0 reads up to the latest score. Paginated by block height.
+func (k *Keeper) GetActorScoreHistory(
+	ctx context.Context,
+	topicId TopicId,
+	address ActorId,
+	actorType types.ActorType,
+	fromBlock BlockHeight,
+	toBlock BlockHeight,
+	pagination *types.SimpleCursorPaginationRequest,
+) ([]*types.Score, *types.SimpleCursorPaginationResponse, error) {
+	var history collections.Map[collections.Triple[TopicId, ActorId, BlockHeight], types.Score]
+	switch actorType {
+	case types.ActorType_INFERER:
+		history = k.infererScoreHistory
+	case types.ActorType_FORECASTER:
+		history = k.forecasterScoreHistory
+	case types.ActorType_REPUTER:
+		history = k.reputerScoreHistory
+	default:
+		return nil, nil, errorsmod.Wrapf(types.ErrInvalidActorType, "unknown actor type %v", actorType)
+	}
+
+	limit, cursor, err := k.CalcAppropriatePaginationForUint64Cursor(ctx, pagination)
+	if err != nil {
+		return nil, nil, err
+	}
+	if BlockHeight(cursor) > fromBlock {
+		fromBlock = BlockHeight(cursor)
+	}
+	if toBlock <= 0 {
+		toBlock = math.MaxInt64
+	}
+	rng := new(collections.Range[collections.Triple[TopicId, ActorId, BlockHeight]]).
+		StartInclusive(collections.Join3(topicId, address, fromBlock)).
+		EndInclusive(collections.Join3(topicId, address, toBlock))
+
+	iter, err := history.Iterate(ctx, rng)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iter.Close()
+
+	scores := make([]*types.Score, 0)
+	nextKey := make([]byte, 0)
+	for ; iter.Valid(); iter.Next() {
+		keyValue, err := iter.KeyValue()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// The cursor is the block of the first score left to read
+		if uint64(len(scores)) >= limit {
+			nextKey = binary.BigEndian.AppendUint64(nextKey, uint64(keyValue.Key.K3()))
+			break
+		}
+
+		score := keyValue.Value
+		scores = append(scores, &score)
+	}
+
+	return scores, &types.SimpleCursorPaginationResponse{
+		NextKey: nextKey,
+	}, nil
+}
+
+// Record a reward paid to an actor, indexed by block so that PruneActorHistory drops it once it
+// is older than the retention window. A retention of 0 keeps no history.
+func (k *Keeper) AddPaidReward(ctx context.Context, reward types.PaidReward) error {
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if moduleParams.ActorHistoryRetention <= 0 {
+		return nil
+	}
+
+	key := collections.Join3(reward.Address, reward.BlockHeight, reward.TopicId)
+	rewards, err := k.rewardHistory.Get(ctx, key)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		rewards = types.PaidRewards{}
+	}
+	rewards.Rewards = append(rewards.Rewards, &reward)
+	if err := k.rewardHistory.Set(ctx, key, rewards); err != nil {
+		return err
+	}
+	return k.actorHistoryBlocks.Set(ctx, collections.Join3(reward.TopicId, reward.BlockHeight, reward.Address))
+}
+
+// Prunes the scores and rewards of the actors of a topic that are older than the retention window
+// at the given block. A retention of 0 keeps no history, so all of it is pruned.
+func (k *Keeper) PruneActorHistory(ctx context.Context, topicId TopicId, blockHeight BlockHeight) error {
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	expiredUntil := blockHeight - moduleParams.ActorHistoryRetention
+	if expiredUntil < 0 {
+		return nil
+	}
+	expired := new(collections.Range[collections.Triple[TopicId, BlockHeight, ActorId]]).
+		StartInclusive(collections.Join3(topicId, BlockHeight(0), "")).
+		EndExclusive(collections.Join3(topicId, expiredUntil+1, ""))
+	iter, err := k.actorHistoryBlocks.Iterate(ctx, expired)
+	if err != nil {
+		return err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		block, actor := key.K2(), key.K3()
+		for _, history := range []collections.Map[collections.Triple[TopicId, ActorId, BlockHeight], types.Score]{
+			k.infererScoreHistory,
+			k.forecasterScoreHistory,
+			k.reputerScoreHistory,
+		} {
+			if err := history.Remove(ctx, collections.Join3(topicId, actor, block)); err != nil {
+				return err
+			}
+		}
+		if err := k.rewardHistory.Remove(ctx, collections.Join3(actor, block, topicId)); err != nil {
+			return err
+		}
+		if err := k.actorHistoryBlocks.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Indexes by block the scores and rewards held in the history of every actor, so they can be pruned by height
+func (k *Keeper) IndexActorHistory(ctx context.Context) error {
+	for _, history := range []collections.Map[collections.Triple[TopicId, ActorId, BlockHeight], types.Score]{
+		k.infererScoreHistory,
+		k.forecasterScoreHistory,
+		k.reputerScoreHistory,
+	} {
+		iter, err := history.Iterate(ctx, nil)
+		if err != nil {
+			return err
+		}
+		keys, err := iter.Keys()
+		if err != nil {
+			return err
+		}
+		for _, key := range keys {
+			if err := k.actorHistoryBlocks.Set(ctx, collections.Join3(key.K1(), key.K3(), key.K2())); err != nil {
+				return err
+			}
+		}
+	}
+
+	iter, err := k.rewardHistory.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := k.actorHistoryBlocks.Set(ctx, collections.Join3(key.K3(), key.K2(), key.K1())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Get the rewards paid to an actor between two blocks, inclusive, oldest first.
+// A topicId of 0 reads the rewards of every topic and a toBlock of 0 reads up to the latest reward.
+// Paginated by (block, topic): the limit applies to the number of topics rewarded at a block,
+// each of which can hold rewards for several tasks.
+func (k *Keeper) GetActorRewardHistory(
+	ctx context.Context,
+	address ActorId,
+	topicId TopicId,
+	fromBlock BlockHeight,
+	toBlock BlockHeight,
+	pagination *types.SimpleCursorPaginationRequest,
+) ([]*types.PaidReward, *types.SimpleCursorPaginationResponse, error) {
+	// The cursor is the block followed by the topic of the first entry left to read
+	limit, cursorBlock, err := k.CalcAppropriatePaginationForUint64Cursor(ctx, pagination)
+	if err != nil {
+		return nil, nil, err
+	}
+	fromTopic := TopicId(0)
+	if pagination != nil && len(pagination.Key) >= 16 && BlockHeight(cursorBlock) >= fromBlock {
+		fromBlock = BlockHeight(cursorBlock)
+		fromTopic = binary.BigEndian.Uint64(pagination.Key[8:16])
+	}
+	if toBlock <= 0 {
+		toBlock = math.MaxInt64
+	}
+	rng := new(collections.Range[collections.Triple[ActorId, BlockHeight, TopicId]]).
+		StartInclusive(collections.Join3(address, fromBlock, fromTopic)).
+		EndInclusive(collections.Join3(address, toBlock, TopicId(math.MaxUint64)))
+
+	iter, err := k.rewardHistory.Iterate(ctx, rng)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iter.Close()
+
+	rewards := make([]*types.PaidReward, 0)
+	entries := uint64(0)
+	nextKey := make([]byte, 0)
+	for ; iter.Valid(); iter.Next() {
+		keyValue, err := iter.KeyValue()
+		if err != nil {
+			return nil, nil, err
+		}
+		if topicId != 0 && keyValue.Key.K3() != topicId {
+			continue
+		}
+
+		if entries >= limit {
+			nextKey = binary.BigEndian.AppendUint64(nextKey, uint64(keyValue.Key.K2()))
+			nextKey = binary.BigEndian.AppendUint64(nextKey, keyValue.Key.K3())
+			break
+		}
+
+		rewards = append(rewards, keyValue.Value.Rewards...)
+		entries++
+	}
+
+	return rewards, &types.SimpleCursorPaginationResponse{
+		NextKey: nextKey,
+	}, nil
+}
+
+func (k *Keeper) SetListeningCoefficient(ctx context.Context, topicId TopicId, reputer ActorId, coefficient types.ListeningCoefficient) error {
+	key := collections.Join(topicId, reputer)
+	return k.reputerListeningCoefficient.Set(ctx, key, coefficient)
+}
+
+func (k *Keeper) GetListeningCoefficient(ctx context.Context, topicId TopicId, reputer ActorId) (types.ListeningCoefficient, error) {
+	key := collections.Join(topicId, reputer)
+	coef, err := k.reputerListeningCoefficient.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			// Return a default value
+			return types.ListeningCoefficient{Coefficient: alloraMath.NewDecFromInt64(1)}, nil
+		}
+		return types.ListeningCoefficient{}, err
+	}
+	return coef, nil
+}
+
+/// REWARD FRACTION
+
+// Gets the previous W_{i-1,m}
+// Returns previous reward fraction, and true if it has yet to be set for the first time (else false)
+func (k *Keeper) GetPreviousReputerRewardFraction(ctx context.Context, topicId TopicId, reputer ActorId) (alloraMath.Dec, bool, error) {
+	key := collections.Join(topicId, reputer)
+	reward, err := k.previousReputerRewardFraction.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return alloraMath.ZeroDec(), true, nil
+		}
+		return alloraMath.Dec{}, false, err
+	}
+	return reward, false, nil
+}
+
+// Sets the previous W_{i-1,m}
+func (k *Keeper) SetPreviousReputerRewardFraction(ctx context.Context, topicId TopicId, reputer ActorId, reward alloraMath.Dec) error {
+	key := collections.Join(topicId, reputer)
+	return k.previousReputerRewardFraction.Set(ctx, key, reward)
+}
+
+// Gets the previous U_{i-1,m}
+// Returns previous reward fraction, and true if it has yet to be set for the first time (else false)
+func (k *Keeper) GetPreviousInferenceRewardFraction(ctx context.Context, topicId TopicId, worker ActorId) (alloraMath.Dec, bool, error) {
+	key := collections.Join(topicId, worker)
+	reward, err := k.previousInferenceRewardFraction.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return alloraMath.ZeroDec(), true, nil
+		}
+		return alloraMath.Dec{}, false, err
+	}
+	return reward, false, nil
+}
+
+// Sets the previous U_{i-1,m}
+func (k *Keeper) SetPreviousInferenceRewardFraction(ctx context.Context, topicId TopicId, worker ActorId, reward alloraMath.Dec) error {
+	key := collections.Join(topicId, worker)
+	return k.previousInferenceRewardFraction.Set(ctx, key, reward)
+}
+
+// Gets the previous V_{i-1,m}
+// Returns previous reward fraction, and true if it has yet to be set for the first time (else false)
+func (k *Keeper) GetPreviousForecastRewardFraction(ctx context.Context, topicId TopicId, worker ActorId) (alloraMath.Dec, bool, error) {
+	key := collections.Join(topicId, worker)
+	reward, err := k.previousForecastRewardFraction.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return alloraMath.ZeroDec(), true, nil
+		}
+		return alloraMath.Dec{}, false, err
+	}
+	return reward, false, nil
+}
+
+// Sets the previous V_{i-1,m}
+func (k *Keeper) SetPreviousForecastRewardFraction(ctx context.Context, topicId TopicId, worker ActorId, reward alloraMath.Dec) error {
+	key := collections.Join(topicId, worker)
+	return k.previousForecastRewardFraction.Set(ctx, key, reward)
+}
+
+func (k *Keeper) SetPreviousPercentageRewardToStakedReputers(ctx context.Context, percentageRewardToStakedReputers alloraMath.Dec) error {
+	return k.previousPercentageRewardToStakedReputers.Set(ctx, percentageRewardToStakedReputers)
+}
+
+func (k Keeper) GetPreviousPercentageRewardToStakedReputers(ctx context.Context) (alloraMath.Dec, error) {
+	return k.previousPercentageRewardToStakedReputers.Get(ctx)
+}
+
+// Returns the height at which the previous percentage reward to staked reputers was last set. Returns 0 if it never was
+func (k Keeper) GetLastPercentageRewardToStakedReputersHeight(ctx context.Context) (BlockHeight, error) {
+	ret, err := k.lastPercentageRewardToStakedReputersHeight.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return ret, nil
+}
+
+func (k *Keeper) SetLastPercentageRewardToStakedReputersHeight(ctx context.Context, blockHeight BlockHeight) error {
+	return k.lastPercentageRewardToStakedReputersHeight.Set(ctx, blockHeight)
+}
+
+/// WHITELISTS
+
+func (k Keeper) HasRole(ctx context.Context, actor ActorId, role types.Role) (bool, error) {
+	return k.actorRoles.Has(ctx, collections.Join(actor, int32(role)))
+}
+
+func (k *Keeper) GrantRole(ctx context.Context, actor ActorId, role types.Role) error {
+	return k.actorRoles.Set(ctx, collections.Join(actor, int32(role)))
+}
+
+func (k *Keeper) RevokeRole(ctx context.Context, actor ActorId, role types.Role) error {
+	return k.actorRoles.Remove(ctx, collections.Join(actor, int32(role)))
+}
+
+// Get every role held by an actor, in ascending order
+func (k Keeper) GetActorRoles(ctx context.Context, actor ActorId) ([]types.Role, error) {
+	rng := collections.NewPrefixedPairRange[ActorId, int32](actor)
+	iter, err := k.actorRoles.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	roles := make([]types.Role, 0)
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, types.Role(key.K2()))
+	}
+	return roles, nil
+}
+
+func (k Keeper) IsWhitelistAdmin(ctx context.Context, admin ActorId) (bool, error) {
+	return k.HasRole(ctx, admin, types.Role_WHITELIST_ADMIN)
+}
+
+func (k Keeper) IsParamsAdmin(ctx context.Context, admin ActorId) (bool, error) {
+	return k.HasRole(ctx, admin, types.Role_PARAMS_ADMIN)
+}
+
+// Whitelist admins are granted every role, matching what a whitelist admin could do before roles existed
+func (k *Keeper) AddWhitelistAdmin(ctx context.Context, admin ActorId) error {
+	for _, role := range types.AllRoles() {
+		if err := k.GrantRole(ctx, admin, role); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Revokes every role, undoing AddWhitelistAdmin
+func (k *Keeper) RemoveWhitelistAdmin(ctx context.Context, admin ActorId) error {
+	for _, role := range types.AllRoles() {
+		if err := k.RevokeRole(ctx, admin, role); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Grant every legacy whitelist admin the full role set, then clear the legacy whitelist
+func (k *Keeper) MigrateWhitelistAdminsToRoles(ctx context.Context) error {
+	iter, err := k.whitelistAdmins.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	admins, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+	for _, admin := range admins {
+		if err := k.AddWhitelistAdmin(ctx, admin); err != nil {
+			return err
+		}
+		if err := k.whitelistAdmins.Remove(ctx, admin); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/// TOPIC ALLOWLISTS
+
+func (k Keeper) IsInTopicWorkerAllowlist(ctx context.Context, topicId TopicId, worker ActorId) (bool, error) {
+	return k.topicWorkerAllowlist.Has(ctx, collections.Join(topicId, worker))
+}
+
+func (k *Keeper) AddToTopicWorkerAllowlist(ctx context.Context, topicId TopicId, worker ActorId) error {
+	return k.topicWorkerAllowlist.Set(ctx, collections.Join(topicId, worker))
+}
+
+func (k *Keeper) RemoveFromTopicWorkerAllowlist(ctx context.Context, topicId TopicId, worker ActorId) error {
+	return k.topicWorkerAllowlist.Remove(ctx, collections.Join(topicId, worker))
+}
+
+func (k Keeper) IsInTopicReputerAllowlist(ctx context.Context, topicId TopicId, reputer ActorId) (bool, error) {
+	return k.topicReputerAllowlist.Has(ctx, collections.Join(topicId, reputer))
+}
+
+func (k *Keeper) AddToTopicReputerAllowlist(ctx context.Context, topicId TopicId, reputer ActorId) error {
+	return k.topicReputerAllowlist.Set(ctx, collections.Join(topicId, reputer))
+}
+
+func (k *Keeper) RemoveFromTopicReputerAllowlist(ctx context.Context, topicId TopicId, reputer ActorId) error {
+	return k.topicReputerAllowlist.Remove(ctx, collections.Join(topicId, reputer))
+}
+
+// Returns true if the topic allowlist mode lets the worker participate in the topic.
+// Topics in open mode or that only restrict reputers accept every worker.
+func (k Keeper) CanWorkerParticipateInTopic(ctx context.Context, topic types.Topic, worker ActorId) (bool, error) {
+	if topic.AllowlistMode != types.TopicAllowlistMode_ALLOWLIST_MODE_WORKERS &&
+		topic.AllowlistMode != types.TopicAllowlistMode_ALLOWLIST_MODE_WORKERS_AND_REPUTERS {
+		return true, nil
+	}
+	return k.IsInTopicWorkerAllowlist(ctx, topic.Id, worker)
+}
+
+// Returns true if the topic allowlist mode lets the reputer participate in the topic.
+// Topics in open mode or that only restrict workers accept every reputer.
+func (k Keeper) CanReputerParticipateInTopic(ctx context.Context, topic types.Topic, reputer ActorId) (bool, error) {
+	if topic.AllowlistMode != types.TopicAllowlistMode_ALLOWLIST_MODE_REPUTERS &&
+		topic.AllowlistMode != types.TopicAllowlistMode_ALLOWLIST_MODE_WORKERS_AND_REPUTERS {
+		return true, nil
+	}
+	return k.IsInTopicReputerAllowlist(ctx, topic.Id, reputer)
+}
+
+// Get a page of the addresses on the worker allowlist of a topic, ordered by address
+func (k Keeper) GetTopicWorkerAllowlist(ctx context.Context, topicId TopicId, pagination *types.SimpleCursorPaginationRequest) ([]ActorId, *types.SimpleCursorPaginationResponse, error) {
+	return k.getTopicAllowlist(ctx, k.topicWorkerAllowlist, topicId, pagination)
+}
+
+// Get a page of the addresses on the reputer allowlist of a topic, ordered by address
+func (k Keeper) GetTopicReputerAllowlist(ctx context.Context, topicId TopicId, pagination *types.SimpleCursorPaginationRequest) ([]ActorId, *types.SimpleCursorPaginationResponse, error) {
+	return k.getTopicAllowlist(ctx, k.topicReputerAllowlist, topicId, pagination)
+}
+
+func (k Keeper) getTopicAllowlist(
+	ctx context.Context,
+	allowlist collections.KeySet[collections.Pair[TopicId, ActorId]],
+	topicId TopicId,
+	pagination *types.SimpleCursorPaginationRequest,
+) ([]ActorId, *types.SimpleCursorPaginationResponse, error) {
+	limit, cursor, err := k.CalcAppropriatePaginationForStringCursor(ctx, pagination)
+	if err != nil {
+		return nil, nil, err
+	}
+	rng := collections.NewPrefixedPairRange[TopicId, ActorId](topicId)
+	if cursor != "" {
+		rng = rng.StartExclusive(cursor)
+	}
+
+	iter, err := allowlist.Iterate(ctx, rng)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iter.Close()
+
+	addresses := make([]ActorId, 0)
+	nextKey := make([]byte, 0)
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// Only hand out a cursor if there is something left to read
+		if uint64(len(addresses)) >= limit {
+			nextKey = []byte(addresses[len(addresses)-1])
+			break
+		}
+
+		addresses = append(addresses, key.K2())
+	}
+
+	return addresses, &types.SimpleCursorPaginationResponse{
+		NextKey: nextKey,
+	}, nil
+}
+
+/// INACTIVITY
+
+func (k *Keeper) SetWorkerLastActiveHeight(ctx context.Context, topicId TopicId, worker ActorId, blockHeight BlockHeight) error {
+	return k.workerLastActiveHeight.Set(ctx, collections.Join(topicId, worker), blockHeight)
+}
+
+func (k *Keeper) SetReputerLastActiveHeight(ctx context.Context, topicId TopicId, reputer ActorId, blockHeight BlockHeight) error {
+	return k.reputerLastActiveHeight.Set(ctx, collections.Join(topicId, reputer), blockHeight)
+}
+
+// Returns the inactivity of every worker registered in the topic as of blockHeight, ordered by address
+func (k *Keeper) GetTopicWorkersInactivity(ctx context.Context, topic types.Topic, blockHeight BlockHeight) ([]types.ActorInactivity, error) {
+	return k.getTopicActorsInactivity(ctx, k.topicWorkers, k.workerLastActiveHeight, topic, topic.WorkerInactivityThreshold, false, blockHeight)
+}
+
+// Returns the inactivity of every reputer registered in the topic as of blockHeight, ordered by address
+func (k *Keeper) GetTopicReputersInactivity(ctx context.Context, topic types.Topic, blockHeight BlockHeight) ([]types.ActorInactivity, error) {
+	return k.getTopicActorsInactivity(ctx, k.topicReputers, k.reputerLastActiveHeight, topic, topic.ReputerInactivityThreshold, true, blockHeight)
+}
+
+// Records that the topic opened a worker nonce at blockHeight, and forgets the heights of
+// nonces opened so long ago that no inactivity threshold of the topic still looks at them.
+// One more height than the highest threshold is kept, as the nonce opened in the current
+// block is not counted as missed.
+func (k *Keeper) AddTopicEpochHeight(ctx context.Context, topic types.Topic, blockHeight BlockHeight) error {
+	if err := k.topicEpochHeights.Set(ctx, collections.Join(topic.Id, blockHeight)); err != nil {
+		return err
+	}
+	kept := topic.WorkerInactivityThreshold
+	if topic.ReputerInactivityThreshold > kept {
+		kept = topic.ReputerInactivityThreshold
+	}
+	kept++
+
+	iter, err := k.topicEpochHeights.Iterate(ctx, collections.NewPrefixedPairRange[TopicId, BlockHeight](topic.Id).Descending())
+	if err != nil {
+		return err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+	for i := kept; i < uint64(len(keys)); i++ {
+		if err := k.topicEpochHeights.Remove(ctx, keys[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns the number of worker nonces the topic opened after sinceHeight and before blockHeight
+func (k *Keeper) countTopicEpochsBetween(ctx context.Context, topicId TopicId, sinceHeight, blockHeight BlockHeight) (uint64, error) {
+	rng := new(collections.Range[collections.Pair[TopicId, BlockHeight]]).
+		StartExclusive(collections.Join(topicId, sinceHeight)).
+		EndExclusive(collections.Join(topicId, blockHeight))
+	iter, err := k.topicEpochHeights.Iterate(ctx, rng)
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+	count := uint64(0)
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	return count, nil
+}
+
+// An actor misses one epoch for every worker nonce the topic opened since its last activity,
+// not counting the nonce opened in the current block, which it has not had the chance to answer yet.
+// Epochs in which the topic was inactive or not picked to churn open no nonce, so they are not missed.
+// Actors registered before activity was tracked have no record and count as active at blockHeight.
+// With a threshold of 0 actors are never removed and EpochsUntilRemoval is left at 0.
+func (k *Keeper) getTopicActorsInactivity(
+	ctx context.Context,
+	registrations collections.KeySet[collections.Pair[TopicId, ActorId]],
+	lastActiveHeights collections.Map[collections.Pair[TopicId, ActorId], BlockHeight],
+	topic types.Topic,
+	threshold uint64,
+	isReputer bool,
+	blockHeight BlockHeight,
+) ([]types.ActorInactivity, error) {
+	iter, err := registrations.Iterate(ctx, collections.NewPrefixedPairRange[TopicId, ActorId](topic.Id))
+	if err != nil {
+		return nil, err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	inactivity := make([]types.ActorInactivity, 0, len(keys))
+	for _, key := range keys {
+		lastActiveHeight, err := lastActiveHeights.Get(ctx, key)
+		if err != nil {
+			if !errors.Is(err, collections.ErrNotFound) {
+				return nil, err
+			}
+			lastActiveHeight = blockHeight
+		}
+
+		missedEpochs := uint64(0)
+		if blockHeight > lastActiveHeight {
+			missedEpochs, err = k.countTopicEpochsBetween(ctx, topic.Id, lastActiveHeight, blockHeight)
+			if err != nil {
+				return nil, err
+			}
+		}
+		epochsUntilRemoval := uint64(0)
+		if threshold > missedEpochs {
+			epochsUntilRemoval = threshold - missedEpochs
+		}
+
+		inactivity = append(inactivity, types.ActorInactivity{
+			Address:            key.K2(),
+			IsReputer:          isReputer,
+			LastActiveHeight:   lastActiveHeight,
+			MissedEpochs:       missedEpochs,
+			EpochsUntilRemoval: epochsUntilRemoval,
+		})
+	}
+	return inactivity, nil
+}
+
+// Removes the registration of every worker and reputer of the topic that has missed at least
+// the topic inactivity threshold of consecutive epochs. Returns the removed workers and reputers.
+func (k *Keeper) RemoveInactiveTopicActors(ctx context.Context, topic types.Topic, blockHeight BlockHeight) ([]ActorId, []ActorId, error) {
+	removedWorkers := make([]ActorId, 0)
+	if topic.WorkerInactivityThreshold > 0 {
+		workersInactivity, err := k.GetTopicWorkersInactivity(ctx, topic, blockHeight)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, worker := range workersInactivity {
+			if worker.EpochsUntilRemoval > 0 {
+				if err := k.startTrackingActivity(ctx, k.workerLastActiveHeight, topic.Id, worker, blockHeight); err != nil {
+					return nil, nil, err
+				}
+				continue
+			}
+			if err := k.RemoveWorker(ctx, topic.Id, worker.Address); err != nil {
+				return nil, nil, err
+			}
+			removedWorkers = append(removedWorkers, worker.Address)
+		}
+	}
+
+	removedReputers := make([]ActorId, 0)
+	if topic.ReputerInactivityThreshold > 0 {
+		reputersInactivity, err := k.GetTopicReputersInactivity(ctx, topic, blockHeight)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, reputer := range reputersInactivity {
+			if reputer.EpochsUntilRemoval > 0 {
+				if err := k.startTrackingActivity(ctx, k.reputerLastActiveHeight, topic.Id, reputer, blockHeight); err != nil {
+					return nil, nil, err
+				}
+				continue
+			}
+			if err := k.RemoveReputer(ctx, topic.Id, reputer.Address); err != nil {
+				return nil, nil, err
+			}
+			removedReputers = append(removedReputers, reputer.Address)
+		}
+	}
+
+	return removedWorkers, removedReputers, nil
+}
+
+// Actors without an activity record are reported as active at blockHeight, so persist that
+// height for them to let them be removed once they keep missing epochs.
+func (k *Keeper) startTrackingActivity(
+	ctx context.Context,
+	lastActiveHeights collections.Map[collections.Pair[TopicId, ActorId], BlockHeight],
+	topicId TopicId,
+	actor types.ActorInactivity,
+	blockHeight BlockHeight,
+) error {
+	if actor.LastActiveHeight != blockHeight {
+		return nil
+	}
+	key := collections.Join(topicId, actor.Address)
+	hasRecord, err := lastActiveHeights.Has(ctx, key)
+	if err != nil || hasRecord {
+		return err
+	}
+	return lastActiveHeights.Set(ctx, key, blockHeight)
+}
+
+/// BANK KEEPER WRAPPERS
+
+// wrapper around bank keeper SendCoinsFromModuleToAccount
+func (k *Keeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipient ActorId, amt sdk.Coins) error {
+	recipientAddr, err := sdk.AccAddressFromBech32(recipient)
+	if err != nil {
+		return err
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+}
+
+// wrapper around bank keeper SendCoinsFromAccountToModule
+func (k *Keeper) SendCoinsFromAccountToModule(ctx context.Context, sender ActorId, recipientModule string, amt sdk.Coins) error {
+	senderAddr, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return err
+	}
+	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+}
+
+// wrapper around bank keeper SendCoinsFromModuleToModule
+func (k *Keeper) SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, senderModule, recipientModule, amt)
+}
+
+// wrapper around bank keeper GetBalance
+func (k *Keeper) GetBankBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	return k.bankKeeper.GetBalance(ctx, addr, denom)
+}
+
+// GetTotalRewardToDistribute
+func (k *Keeper) GetTotalRewardToDistribute(ctx context.Context) (alloraMath.Dec, error) {
+	// Get Allora Rewards Account
+	alloraRewardsAccountAddr := k.authKeeper.GetModuleAccount(ctx, types.AlloraRewardsAccountName).GetAddress()
+	// Get Total Allocation
+	totalReward := k.GetBankBalance(
+		ctx,
+		alloraRewardsAccountAddr,
+		params.DefaultBondDenom).Amount
+	totalRewardDec, err := alloraMath.NewDecFromSdkInt(totalReward)
+	if err != nil {
+		return alloraMath.Dec{}, err
+	}
+	return totalRewardDec, nil
+}
+
+/// UTILS
+
+// Convert pagination.key from []bytes to uint64, if pagination is nil or [], len = 0
+// Get the limit from the pagination request, within acceptable bounds and defaulting as necessary
+func (k Keeper) CalcAppropriatePaginationForUint64Cursor(ctx context.Context, pagination *types.SimpleCursorPaginationRequest) (uint64, uint64, error) {
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return uint64(0), uint64(0), err
+	}
+	limit := moduleParams.DefaultPageLimit
+	cursor := uint64(0)
+
+	if pagination != nil {
+		if len(pagination.Key) > 0 {
+			cursor = binary.BigEndian.Uint64(pagination.Key)
+		}
+		if pagination.Limit > 0 {
+			limit = pagination.Limit
+		}
+		if limit > moduleParams.MaxPageLimit {
+			limit = moduleParams.MaxPageLimit
+		}
+	}
+
+	return limit, cursor, nil
+}
+
+// Interpret pagination.key as a string cursor, if pagination is nil or [], cursor = ""
+// Get the limit from the pagination request, within acceptable bounds and defaulting as necessary
+func (k Keeper) CalcAppropriatePaginationForStringCursor(ctx context.Context, pagination *types.SimpleCursorPaginationRequest) (uint64, string, error) {
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return uint64(0), "", err
+	}
+	limit := moduleParams.DefaultPageLimit
+	cursor := ""
+
+	if pagination != nil {
+		if len(pagination.Key) > 0 {
+			cursor = string(pagination.Key)
+		}
+		if pagination.Limit > 0 {
+			limit = pagination.Limit
+		}
 		if limit > moduleParams.MaxPageLimit {
 			limit = moduleParams.MaxPageLimit
 		}
@@ -2302,6 +3806,36 @@ func (k *Keeper) PruneRecordsAfterRewards(ctx context.Context, topicId TopicId,
 	if err != nil {
 		return err
 	}
+	err = k.pruneNetworkInferenceWeights(ctx, blockRange)
+	if err != nil {
+		return err
+	}
+	err = k.pruneRewardBreakdowns(ctx, blockRange)
+	if err != nil {
+		return err
+	}
+
+	// Only topics with several horizons have records of the horizons after the first one
+	topic, err := k.GetTopic(ctx, topicId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	for horizon := Horizon(1); horizon < topic.NumHorizons(); horizon++ {
+		horizonBlockRange := collections.
+			NewPrefixedPairRange[collections.Pair[TopicId, Horizon], BlockHeight](collections.Join(topicId, horizon)).
+			EndInclusive(blockHeight)
+		err = k.horizonLossBundles.Clear(ctx, horizonBlockRange)
+		if err != nil {
+			return err
+		}
+		err = k.horizonNetworkLossBundles.Clear(ctx, horizonBlockRange)
+		if err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -2322,6 +3856,14 @@ func (k *Keeper) pruneNetworkLosses(ctx context.Context, blockRange *collections
 	return k.networkLossBundles.Clear(ctx, blockRange)
 }
 
+func (k *Keeper) pruneNetworkInferenceWeights(ctx context.Context, blockRange *collections.PairRange[uint64, int64]) error {
+	return k.networkInferenceWeights.Clear(ctx, blockRange)
+}
+
+func (k *Keeper) pruneRewardBreakdowns(ctx context.Context, blockRange *collections.PairRange[uint64, int64]) error {
+	return k.rewardBreakdowns.Clear(ctx, blockRange)
+}
+
 func (k *Keeper) PruneWorkerNonces(ctx context.Context, topicId uint64, blockHeightThreshold int64) error {
 	nonces, err := k.unfulfilledWorkerNonces.Get(ctx, topicId)
 	if err != nil {
@@ -2427,3 +3969,23 @@ func (k *Keeper) SetTopicLastReputerPayload(ctx context.Context, topic types.Top
 func (k *Keeper) GetTopicLastReputerPayload(ctx context.Context, topic TopicId) (types.TimestampedActorNonce, error) {
 	return k.topicLastReputerPayload.Get(ctx, topic)
 }
+
+// SetTopicLastReputerPayloadForHorizon records the last reputer payload accepted for a horizon of a topic.
+func (k *Keeper) SetTopicLastReputerPayloadForHorizon(ctx context.Context, topic types.TopicId, horizon Horizon, blockHeight int64, nonce *types.Nonce, actor ActorId) error {
+	if horizon == 0 {
+		return k.SetTopicLastReputerPayload(ctx, topic, blockHeight, nonce, actor)
+	}
+	return k.horizonLastReputerPayload.Set(ctx, collections.Join(topic, horizon), types.TimestampedActorNonce{
+		BlockHeight: blockHeight,
+		Actor:       actor,
+		Nonce:       nonce,
+	})
+}
+
+// GetTopicLastReputerPayloadForHorizon returns the last reputer payload accepted for a horizon of a topic.
+func (k *Keeper) GetTopicLastReputerPayloadForHorizon(ctx context.Context, topic TopicId, horizon Horizon) (types.TimestampedActorNonce, error) {
+	if horizon == 0 {
+		return k.GetTopicLastReputerPayload(ctx, topic)
+	}
+	return k.horizonLastReputerPayload.Get(ctx, collections.Join(topic, horizon))
+}
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"cosmossdk.io/collections"
 	cosmosAddress "cosmossdk.io/core/address"
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/core/store"
@@ -520,6 +521,40 @@ func (s *KeeperTestSuite) TestReputerNonceLimitEnforcement() {
 	}
 }
 
+func (s *KeeperTestSuite) TestReputerNoncesOfHorizonsAreIndependent() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	topicId := uint64(1)
+
+	err := keeper.SetParams(ctx, types.Params{MaxUnfulfilledReputerRequests: 2})
+	s.Require().NoError(err)
+
+	topic := types.Topic{Id: topicId, EpochLength: 10, GroundTruthLag: 10, Horizons: []int64{10, 30}}
+	for _, block := range []int64{10, 20, 30} {
+		err = keeper.AddReputerNoncesForTopicHorizons(ctx, topic, &types.Nonce{BlockHeight: block})
+		s.Require().NoError(err)
+	}
+
+	// The latest 2 nonces of each horizon are kept
+	unfulfilledNonces, err := keeper.GetUnfulfilledReputerNonces(ctx, topicId)
+	s.Require().NoError(err)
+	s.Require().Len(unfulfilledNonces.Nonces, 4)
+	for _, nonce := range unfulfilledNonces.Nonces {
+		s.Require().GreaterOrEqual(nonce.ReputerNonce.BlockHeight, int64(20))
+	}
+
+	fulfilled, err := keeper.FulfillReputerNonceForHorizon(ctx, topicId, &types.Nonce{BlockHeight: 30}, 1)
+	s.Require().NoError(err)
+	s.Require().True(fulfilled)
+
+	isUnfulfilled, err := keeper.IsReputerNonceUnfulfilledForHorizon(ctx, topicId, &types.Nonce{BlockHeight: 30}, 1)
+	s.Require().NoError(err)
+	s.Require().False(isUnfulfilled)
+	isUnfulfilled, err = keeper.IsReputerNonceUnfulfilled(ctx, topicId, &types.Nonce{BlockHeight: 30})
+	s.Require().NoError(err)
+	s.Require().True(isUnfulfilled, "Fulfilling a horizon should leave the other horizons unfulfilled")
+}
+
 /// REGRET TESTS
 
 func (s *KeeperTestSuite) TestSetAndGetInfererNetworkRegret() {
@@ -1193,6 +1228,68 @@ func (s *KeeperTestSuite) TestInsertNetworkLossBundleAtBlock() {
 	require.Equal(&lossBundle, result, "Retrieved data should match inserted data")
 }
 
+func (s *KeeperTestSuite) TestSetNetworkInferenceWeightsKeepsOneEntryPerHorizon() {
+	topicId := uint64(1)
+	block := types.BlockHeight(100)
+	weightsOfHorizon := func(horizon types.Horizon, weight int64) types.NetworkInferenceWeights {
+		return types.NetworkInferenceWeights{
+			TopicId:     topicId,
+			BlockHeight: block,
+			Horizon:     horizon,
+			InfererWeights: []*types.RegretInformedWeight{
+				{Worker: "worker1", Weight: alloraMath.NewDecFromInt64(weight)},
+			},
+		}
+	}
+
+	s.Require().NoError(s.emissionsKeeper.SetNetworkInferenceWeights(s.ctx, weightsOfHorizon(1, 1)))
+	s.Require().NoError(s.emissionsKeeper.SetNetworkInferenceWeights(s.ctx, weightsOfHorizon(0, 2)))
+	s.Require().NoError(s.emissionsKeeper.SetNetworkInferenceWeights(s.ctx, weightsOfHorizon(1, 3)))
+
+	weightsAtBlock, err := s.emissionsKeeper.GetNetworkInferenceWeightsAtBlock(s.ctx, topicId, block)
+	s.Require().NoError(err)
+	s.Require().Len(weightsAtBlock.Horizons, 2)
+	s.Require().Equal(types.Horizon(0), weightsAtBlock.Horizons[0].Horizon)
+	s.Require().Equal(types.Horizon(1), weightsAtBlock.Horizons[1].Horizon)
+
+	weights, err := s.emissionsKeeper.GetNetworkInferenceWeightsAtBlockForHorizon(s.ctx, topicId, block, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(alloraMath.NewDecFromInt64(3), weights.InfererWeights[0].Weight)
+
+	_, err = s.emissionsKeeper.GetNetworkInferenceWeightsAtBlockForHorizon(s.ctx, topicId, block, 2)
+	s.Require().ErrorIs(err, collections.ErrNotFound)
+}
+
+func (s *KeeperTestSuite) TestSetRewardBreakdownKeepsOneEntryPerHorizon() {
+	topicId := uint64(1)
+	block := types.BlockHeight(100)
+	breakdownOfHorizon := func(horizon types.Horizon, topicReward int64) types.RewardBreakdown {
+		return types.RewardBreakdown{
+			TopicId:     topicId,
+			BlockHeight: block,
+			Horizon:     horizon,
+			TopicReward: alloraMath.NewDecFromInt64(topicReward),
+			Actors: []*types.ActorRewardBreakdown{
+				{Address: "worker1", ActorType: types.ActorType_INFERER, Reward: alloraMath.NewDecFromInt64(topicReward)},
+			},
+		}
+	}
+
+	_, err := s.emissionsKeeper.GetRewardBreakdowns(s.ctx, topicId, block)
+	s.Require().ErrorIs(err, collections.ErrNotFound)
+
+	s.Require().NoError(s.emissionsKeeper.SetRewardBreakdown(s.ctx, breakdownOfHorizon(1, 1)))
+	s.Require().NoError(s.emissionsKeeper.SetRewardBreakdown(s.ctx, breakdownOfHorizon(0, 2)))
+	s.Require().NoError(s.emissionsKeeper.SetRewardBreakdown(s.ctx, breakdownOfHorizon(1, 3)))
+
+	breakdowns, err := s.emissionsKeeper.GetRewardBreakdowns(s.ctx, topicId, block)
+	s.Require().NoError(err)
+	s.Require().Len(breakdowns.Horizons, 2)
+	s.Require().Equal(types.Horizon(0), breakdowns.Horizons[0].Horizon)
+	s.Require().Equal(types.Horizon(1), breakdowns.Horizons[1].Horizon)
+	s.Require().Equal(alloraMath.NewDecFromInt64(3), breakdowns.Horizons[1].TopicReward)
+}
+
 func (s *KeeperTestSuite) TestGetNetworkLossBundleAtBlock() {
 	ctx := s.ctx
 	require := s.Require()
@@ -2404,6 +2501,31 @@ func (s *KeeperTestSuite) TestSetGetTopicLastReputerPayload() {
 	s.Require().Equal(nonce, payload.Nonce, "Nonce should match")
 }
 
+func (s *KeeperTestSuite) TestSetGetTopicLastReputerPayloadForHorizon() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	topicId := uint64(456)
+	actor := "allo1j62tlhf5empp365vy39kgvr92uzrmglm7krt6p"
+
+	err := keeper.SetTopicLastReputerPayloadForHorizon(ctx, topicId, 0, 2000, &types.Nonce{BlockHeight: 1900}, actor)
+	s.Require().NoError(err)
+	err = keeper.SetTopicLastReputerPayloadForHorizon(ctx, topicId, 1, 2010, &types.Nonce{BlockHeight: 1700}, actor)
+	s.Require().NoError(err)
+
+	// The first horizon is stored as the last reputer payload of the topic
+	payload, err := keeper.GetTopicLastReputerPayload(ctx, topicId)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(1900), payload.Nonce.BlockHeight)
+
+	payload, err = keeper.GetTopicLastReputerPayloadForHorizon(ctx, topicId, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(2010), payload.BlockHeight)
+	s.Require().Equal(int64(1700), payload.Nonce.BlockHeight)
+
+	_, err = keeper.GetTopicLastReputerPayloadForHorizon(ctx, topicId, 2)
+	s.Require().ErrorIs(err, collections.ErrNotFound)
+}
+
 /// FEE REVENUE
 
 func (s *KeeperTestSuite) TestGetTopicFeeRevenue() {
@@ -2793,6 +2915,153 @@ func (s *KeeperTestSuite) TestGetReputersScoresAtBlock() {
 	s.Require().Len(scores.Scores, 5, "Should retrieve all scores at the block")
 }
 
+func (s *KeeperTestSuite) TestActorScoreHistoryRangeAndPagination() {
+	keeper := s.emissionsKeeper
+	topicId := uint64(1)
+	params := types.DefaultParams()
+	params.MaxPageLimit = 2
+	s.Require().NoError(keeper.SetParams(s.ctx, params))
+
+	for block := int64(100); block <= 500; block += 100 {
+		err := keeper.InsertWorkerInferenceScore(s.ctx, topicId, block, types.Score{TopicId: topicId, BlockHeight: block, Address: "worker1", Score: alloraMath.NewDecFromInt64(block)})
+		s.Require().NoError(err)
+		err = keeper.InsertWorkerInferenceScore(s.ctx, topicId, block, types.Score{TopicId: topicId, BlockHeight: block, Address: "worker2", Score: alloraMath.NewDecFromInt64(-block)})
+		s.Require().NoError(err)
+	}
+	err := keeper.InsertReputerScore(s.ctx, topicId, 300, types.Score{TopicId: topicId, BlockHeight: 300, Address: "worker1", Score: alloraMath.OneDec()})
+	s.Require().NoError(err)
+
+	// Pages of at most MaxPageLimit scores, oldest first
+	scores, pageRes, err := keeper.GetActorScoreHistory(s.ctx, topicId, "worker1", types.ActorType_INFERER, 200, 0, &types.SimpleCursorPaginationRequest{Limit: 10})
+	s.Require().NoError(err)
+	s.Require().Len(scores, 2)
+	s.Require().Equal(int64(200), scores[0].BlockHeight)
+	s.Require().Equal(int64(300), scores[1].BlockHeight)
+	s.Require().NotEmpty(pageRes.NextKey)
+
+	scores, pageRes, err = keeper.GetActorScoreHistory(s.ctx, topicId, "worker1", types.ActorType_INFERER, 200, 0, &types.SimpleCursorPaginationRequest{Key: pageRes.NextKey, Limit: 10})
+	s.Require().NoError(err)
+	s.Require().Len(scores, 2)
+	s.Require().Equal(int64(400), scores[0].BlockHeight)
+	s.Require().Equal(int64(500), scores[1].BlockHeight)
+	s.Require().Empty(pageRes.NextKey)
+
+	// Bounded range
+	scores, _, err = keeper.GetActorScoreHistory(s.ctx, topicId, "worker2", types.ActorType_INFERER, 150, 350, nil)
+	s.Require().NoError(err)
+	s.Require().Len(scores, 2)
+	s.Require().Equal(alloraMath.NewDecFromInt64(-200), scores[0].Score)
+
+	// Roles are kept apart
+	scores, _, err = keeper.GetActorScoreHistory(s.ctx, topicId, "worker1", types.ActorType_REPUTER, 0, 0, nil)
+	s.Require().NoError(err)
+	s.Require().Len(scores, 1)
+	scores, _, err = keeper.GetActorScoreHistory(s.ctx, topicId, "worker1", types.ActorType_FORECASTER, 0, 0, nil)
+	s.Require().NoError(err)
+	s.Require().Empty(scores)
+
+	_, _, err = keeper.GetActorScoreHistory(s.ctx, topicId, "worker1", types.ActorType(42), 0, 0, nil)
+	s.Require().ErrorIs(err, types.ErrInvalidActorType)
+}
+
+func (s *KeeperTestSuite) TestActorScoreHistoryRetention() {
+	keeper := s.emissionsKeeper
+	topicId := uint64(1)
+	params := types.DefaultParams()
+	params.ActorHistoryRetention = 250
+	s.Require().NoError(keeper.SetParams(s.ctx, params))
+
+	for block := int64(100); block <= 500; block += 100 {
+		err := keeper.InsertWorkerForecastScore(s.ctx, topicId, block, types.Score{TopicId: topicId, BlockHeight: block, Address: "worker1"})
+		s.Require().NoError(err)
+	}
+	err := keeper.InsertWorkerInferenceScore(s.ctx, topicId, 100, types.Score{TopicId: topicId, BlockHeight: 100, Address: "worker2"})
+	s.Require().NoError(err)
+
+	s.Require().NoError(keeper.PruneActorHistory(s.ctx, topicId, 500))
+	scores, _, err := keeper.GetActorScoreHistory(s.ctx, topicId, "worker1", types.ActorType_FORECASTER, 0, 0, nil)
+	s.Require().NoError(err)
+	s.Require().Len(scores, 3, "Scores older than the retention window should be pruned")
+	s.Require().Equal(int64(300), scores[0].BlockHeight)
+	scores, _, err = keeper.GetActorScoreHistory(s.ctx, topicId, "worker2", types.ActorType_INFERER, 0, 0, nil)
+	s.Require().NoError(err)
+	s.Require().Empty(scores, "The history of actors that are no longer scored should be pruned too")
+
+	// No history is kept with a retention of 0
+	params.ActorHistoryRetention = 0
+	s.Require().NoError(keeper.SetParams(s.ctx, params))
+	err = keeper.InsertWorkerForecastScore(s.ctx, topicId, 600, types.Score{TopicId: topicId, BlockHeight: 600, Address: "worker1"})
+	s.Require().NoError(err)
+	scores, _, err = keeper.GetActorScoreHistory(s.ctx, topicId, "worker1", types.ActorType_FORECASTER, 0, 0, nil)
+	s.Require().NoError(err)
+	s.Require().Len(scores, 3)
+	s.Require().NoError(keeper.PruneActorHistory(s.ctx, topicId, 600))
+	scores, _, err = keeper.GetActorScoreHistory(s.ctx, topicId, "worker1", types.ActorType_FORECASTER, 0, 0, nil)
+	s.Require().NoError(err)
+	s.Require().Empty(scores)
+}
+
+func (s *KeeperTestSuite) TestActorRewardHistory() {
+	keeper := s.emissionsKeeper
+	params := types.DefaultParams()
+	params.ActorHistoryRetention = 1000
+	s.Require().NoError(keeper.SetParams(s.ctx, params))
+
+	paidReward := func(topicId uint64, block int64, rewardType types.TaskRewardType) types.PaidReward {
+		return types.PaidReward{TopicId: topicId, BlockHeight: block, Address: "worker1", RewardType: string(rewardType), Reward: alloraMath.NewDecFromInt64(block)}
+	}
+	s.Require().NoError(keeper.AddPaidReward(s.ctx, paidReward(1, 100, types.WorkerInferenceRewardType)))
+	s.Require().NoError(keeper.AddPaidReward(s.ctx, paidReward(1, 100, types.WorkerForecastRewardType)))
+	s.Require().NoError(keeper.AddPaidReward(s.ctx, paidReward(2, 100, types.WorkerInferenceRewardType)))
+	s.Require().NoError(keeper.AddPaidReward(s.ctx, paidReward(1, 200, types.WorkerInferenceRewardType)))
+	s.Require().NoError(keeper.AddPaidReward(s.ctx, paidReward(2, 300, types.WorkerInferenceRewardType)))
+
+	rewards, _, err := keeper.GetActorRewardHistory(s.ctx, "worker1", 0, 0, 0, nil)
+	s.Require().NoError(err)
+	s.Require().Len(rewards, 5)
+
+	rewards, _, err = keeper.GetActorRewardHistory(s.ctx, "worker1", 1, 0, 0, nil)
+	s.Require().NoError(err)
+	s.Require().Len(rewards, 3)
+	rewards, _, err = keeper.GetActorRewardHistory(s.ctx, "worker1", 2, 150, 300, nil)
+	s.Require().NoError(err)
+	s.Require().Len(rewards, 1)
+	s.Require().Equal(int64(300), rewards[0].BlockHeight)
+
+	// Pages hold a limited number of topics rewarded at a block, resuming at the same block
+	rewards, pageRes, err := keeper.GetActorRewardHistory(s.ctx, "worker1", 0, 0, 0, &types.SimpleCursorPaginationRequest{Limit: 1})
+	s.Require().NoError(err)
+	s.Require().Len(rewards, 2)
+	s.Require().NotEmpty(pageRes.NextKey)
+	rewards, pageRes, err = keeper.GetActorRewardHistory(s.ctx, "worker1", 0, 0, 0, &types.SimpleCursorPaginationRequest{Key: pageRes.NextKey, Limit: 1})
+	s.Require().NoError(err)
+	s.Require().Len(rewards, 1)
+	s.Require().Equal(uint64(2), rewards[0].TopicId)
+	s.Require().Equal(int64(100), rewards[0].BlockHeight)
+	s.Require().NotEmpty(pageRes.NextKey)
+
+	// The cursor is applied when it points at the first block read
+	rewards, firstPageRes, err := keeper.GetActorRewardHistory(s.ctx, "worker1", 0, 100, 0, &types.SimpleCursorPaginationRequest{Limit: 1})
+	s.Require().NoError(err)
+	s.Require().Len(rewards, 2)
+	rewards, pageRes, err = keeper.GetActorRewardHistory(s.ctx, "worker1", 0, 100, 0, &types.SimpleCursorPaginationRequest{Key: firstPageRes.NextKey, Limit: 1})
+	s.Require().NoError(err)
+	s.Require().Len(rewards, 1)
+	s.Require().Equal(uint64(2), rewards[0].TopicId)
+	s.Require().Equal(int64(100), rewards[0].BlockHeight)
+	s.Require().NotEmpty(pageRes.NextKey)
+	s.Require().NotEqual(firstPageRes.NextKey, pageRes.NextKey)
+
+	// Rewards older than the retention window are pruned
+	s.Require().NoError(keeper.AddPaidReward(s.ctx, paidReward(1, 1150, types.WorkerInferenceRewardType)))
+	s.Require().NoError(keeper.PruneActorHistory(s.ctx, 1, 1150))
+	s.Require().NoError(keeper.PruneActorHistory(s.ctx, 2, 1150))
+	rewards, _, err = keeper.GetActorRewardHistory(s.ctx, "worker1", 0, 0, 0, nil)
+	s.Require().NoError(err)
+	s.Require().Len(rewards, 3)
+	s.Require().Equal(int64(200), rewards[0].BlockHeight)
+}
+
 func (s *KeeperTestSuite) TestSetListeningCoefficient() {
 	ctx := s.ctx
 	keeper := s.emissionsKeeper
@@ -2982,6 +3251,148 @@ func (s *KeeperTestSuite) TestSetGetPreviousPercentageRewardToStakedReputers() {
 	s.Require().Equal(previousPercentageReward, fetchedPercentageReward, "The fetched percentage reward should match the set value")
 }
 
+/// BLOCK TIME
+
+func (s *KeeperTestSuite) TestUpdateAverageBlockTime() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	params := types.DefaultParams()
+	params.BlockTimeSmoothingDegree = alloraMath.MustNewDecFromString("0.5")
+	s.Require().NoError(keeper.SetParams(ctx, params))
+
+	start := time.Unix(1700000000, 0)
+	// The first block only records its time
+	s.Require().NoError(keeper.UpdateAverageBlockTime(ctx, start))
+	average, err := keeper.GetAverageBlockTime(ctx)
+	s.Require().NoError(err)
+	s.Require().True(average.IsZero())
+
+	// The first measured block time seeds the average
+	s.Require().NoError(keeper.UpdateAverageBlockTime(ctx, start.Add(4*time.Second)))
+	average, err = keeper.GetAverageBlockTime(ctx)
+	s.Require().NoError(err)
+	s.Require().True(alloraMath.InDelta(alloraMath.MustNewDecFromString("4"), average, alloraMath.MustNewDecFromString("0.0001")))
+
+	// 0.5 * 6 + 0.5 * 4
+	s.Require().NoError(keeper.UpdateAverageBlockTime(ctx, start.Add(10*time.Second)))
+	average, err = keeper.GetAverageBlockTime(ctx)
+	s.Require().NoError(err)
+	s.Require().True(alloraMath.InDelta(alloraMath.MustNewDecFromString("5"), average, alloraMath.MustNewDecFromString("0.0001")))
+
+	// A block time that does not move forward leaves the average unchanged
+	s.Require().NoError(keeper.UpdateAverageBlockTime(ctx, start.Add(10*time.Second)))
+	average, err = keeper.GetAverageBlockTime(ctx)
+	s.Require().NoError(err)
+	s.Require().True(alloraMath.InDelta(alloraMath.MustNewDecFromString("5"), average, alloraMath.MustNewDecFromString("0.0001")))
+
+	observed, err := keeper.GetObservedBlocksPerMonth(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(types.SecondsPerMonth/5, observed)
+}
+
+func (s *KeeperTestSuite) TestUpdateAverageBlockTimeCapsLongGap() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	params := types.DefaultParams()
+	params.BlockTimeSmoothingDegree = alloraMath.MustNewDecFromString("0.5")
+	s.Require().NoError(keeper.SetParams(ctx, params))
+
+	start := time.Unix(1700000000, 0)
+	s.Require().NoError(keeper.UpdateAverageBlockTime(ctx, start))
+	s.Require().NoError(keeper.UpdateAverageBlockTime(ctx, start.Add(5*time.Second)))
+
+	// A day long halt counts as 10 times the average: 0.5 * 50 + 0.5 * 5
+	s.Require().NoError(keeper.UpdateAverageBlockTime(ctx, start.Add(5*time.Second+24*time.Hour)))
+	average, err := keeper.GetAverageBlockTime(ctx)
+	s.Require().NoError(err)
+	s.Require().True(alloraMath.InDelta(alloraMath.MustNewDecFromString("27.5"), average, alloraMath.MustNewDecFromString("0.0001")))
+}
+
+func (s *KeeperTestSuite) TestCalibrateBlocksPerMonth() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	params := types.DefaultParams()
+	params.BlocksPerMonth = 525960
+	params.MaxBlocksPerMonthChange = alloraMath.MustNewDecFromString("0.1")
+	params.MinBlocksPerMonth = 262980
+	params.MaxBlocksPerMonth = 1000000
+	s.Require().NoError(keeper.SetParams(ctx, params))
+
+	// Blocks every 2 seconds imply 1314900 blocks per month
+	start := time.Unix(1700000000, 0)
+	s.Require().NoError(keeper.UpdateAverageBlockTime(ctx, start))
+	s.Require().NoError(keeper.UpdateAverageBlockTime(ctx, start.Add(2*time.Second)))
+
+	// Calibration is disabled by default
+	s.Require().NoError(keeper.CalibrateBlocksPerMonth(ctx))
+	blocksPerMonth, err := keeper.GetBlocksPerMonth(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(params.BlocksPerMonth, blocksPerMonth)
+
+	params.BlocksPerMonthCalibrationEnabled = true
+	s.Require().NoError(keeper.SetParams(ctx, params))
+	// Not calibrated yet, falls back to the param
+	blocksPerMonth, err = keeper.GetBlocksPerMonth(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(params.BlocksPerMonth, blocksPerMonth)
+
+	// Moves by at most 10% per recalibration
+	s.Require().NoError(keeper.CalibrateBlocksPerMonth(ctx))
+	blocksPerMonth, err = keeper.GetBlocksPerMonth(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(578556), blocksPerMonth)
+
+	// Never goes above the max
+	for i := 0; i < 10; i++ {
+		s.Require().NoError(keeper.CalibrateBlocksPerMonth(ctx))
+	}
+	blocksPerMonth, err = keeper.GetBlocksPerMonth(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(params.MaxBlocksPerMonth, blocksPerMonth)
+
+	// Disabling calibration goes back to the param
+	params.BlocksPerMonthCalibrationEnabled = false
+	s.Require().NoError(keeper.SetParams(ctx, params))
+	blocksPerMonth, err = keeper.GetBlocksPerMonth(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(params.BlocksPerMonth, blocksPerMonth)
+}
+
+func (s *KeeperTestSuite) TestIsMonthElapsed() {
+	// Always due before the first update
+	s.Require().True(keeper.IsMonthElapsed(0, 7, 100))
+	// Due a month after the last update, whatever the alignment of the heights
+	s.Require().False(keeper.IsMonthElapsed(7, 106, 100))
+	s.Require().True(keeper.IsMonthElapsed(7, 107, 100))
+	// A smaller blocks per month after a recalibration does not skip or repeat an update
+	s.Require().True(keeper.IsMonthElapsed(107, 197, 90))
+	s.Require().False(keeper.IsMonthElapsed(107, 196, 90))
+	// Due when the chain restarted from a zero height export taken after the last update
+	s.Require().True(keeper.IsMonthElapsed(5000, 1, 100))
+	s.Require().False(keeper.IsMonthElapsed(1, 2, 100))
+}
+
+func (s *KeeperTestSuite) TestGetSetLastMonthlyUpdateHeights() {
+	ctx := s.ctx
+	k := s.emissionsKeeper
+
+	height, err := k.GetLastBlocksPerMonthCalibrationHeight(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(0), height)
+	s.Require().NoError(k.SetLastBlocksPerMonthCalibrationHeight(ctx, 42))
+	height, err = k.GetLastBlocksPerMonthCalibrationHeight(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(42), height)
+
+	height, err = k.GetLastPercentageRewardToStakedReputersHeight(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(0), height)
+	s.Require().NoError(k.SetLastPercentageRewardToStakedReputersHeight(ctx, 43))
+	height, err = k.GetLastPercentageRewardToStakedReputersHeight(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(43), height)
+}
+
 /// WHITELISTS
 
 func (s *KeeperTestSuite) TestWhitelistAdminOperations() {
@@ -3008,6 +3419,219 @@ func (s *KeeperTestSuite) TestWhitelistAdminOperations() {
 	s.Require().False(isAdmin, "Address should not be an admin after being removed")
 }
 
+func (s *KeeperTestSuite) TestRoleOperations() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	actorAddress := "actorAddressExample"
+
+	err := keeper.GrantRole(ctx, actorAddress, types.Role_TOPIC_CREATOR)
+	s.Require().NoError(err, "Granting role should not fail")
+
+	hasRole, err := keeper.HasRole(ctx, actorAddress, types.Role_TOPIC_CREATOR)
+	s.Require().NoError(err)
+	s.Require().True(hasRole, "Actor should have the granted role")
+
+	// Roles are independent of one another
+	isParamsAdmin, err := keeper.IsParamsAdmin(ctx, actorAddress)
+	s.Require().NoError(err)
+	s.Require().False(isParamsAdmin, "Actor should not be a params admin")
+	isWhitelistAdmin, err := keeper.IsWhitelistAdmin(ctx, actorAddress)
+	s.Require().NoError(err)
+	s.Require().False(isWhitelistAdmin, "Actor should not be a whitelist admin")
+
+	roles, err := keeper.GetActorRoles(ctx, actorAddress)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.Role{types.Role_TOPIC_CREATOR}, roles)
+
+	err = keeper.RevokeRole(ctx, actorAddress, types.Role_TOPIC_CREATOR)
+	s.Require().NoError(err, "Revoking role should not fail")
+
+	roles, err = keeper.GetActorRoles(ctx, actorAddress)
+	s.Require().NoError(err)
+	s.Require().Empty(roles, "Actor should have no roles after revocation")
+}
+
+func (s *KeeperTestSuite) TestMigrateWhitelistAdminsToRoles() {
+	ctx := s.ctx
+	k := s.emissionsKeeper
+	legacyAdmin := "legacyAdminExample"
+
+	// Write an admin the way version 1 of the module stored them
+	legacyWhitelistAdmins := collections.NewKeySet(
+		collections.NewSchemaBuilder(s.storeService),
+		types.WhitelistAdminsKey,
+		"whitelist_admins",
+		collections.StringKey,
+	)
+	err := legacyWhitelistAdmins.Set(ctx, legacyAdmin)
+	s.Require().NoError(err)
+
+	err = keeper.NewMigrator(k).Migrate1to2(ctx)
+	s.Require().NoError(err, "Migration should not fail")
+
+	roles, err := k.GetActorRoles(ctx, legacyAdmin)
+	s.Require().NoError(err)
+	s.Require().ElementsMatch(types.AllRoles(), roles, "Legacy admin should hold every role")
+
+	stillLegacy, err := legacyWhitelistAdmins.Has(ctx, legacyAdmin)
+	s.Require().NoError(err)
+	s.Require().False(stillLegacy, "Legacy whitelist should be cleared")
+}
+
+func (s *KeeperTestSuite) TestMigrate2to3IndexesRegistrations() {
+	ctx := s.ctx
+	k := s.emissionsKeeper
+	worker := "workerAddress"
+	otherWorker := "otherWorkerAddress"
+
+	// Write registrations the way version 2 of the module stored them, without the by-actor indexes
+	legacyTopicWorkers := collections.NewKeySet(
+		collections.NewSchemaBuilder(s.storeService),
+		types.TopicWorkersKey,
+		"topic_workers",
+		collections.PairKeyCodec(collections.Uint64Key, collections.StringKey),
+	)
+	legacyWorkers := collections.NewMap(
+		collections.NewSchemaBuilder(s.storeService),
+		types.WorkerNodesKey,
+		"worker_nodes",
+		collections.StringKey,
+		codec.CollValue[types.OffchainNode](s.codec),
+	)
+	for _, topicId := range []uint64{1, 2} {
+		s.Require().NoError(legacyTopicWorkers.Set(ctx, collections.Join(topicId, worker)))
+	}
+	s.Require().NoError(legacyTopicWorkers.Set(ctx, collections.Join(uint64(1), otherWorker)))
+	s.Require().NoError(legacyWorkers.Set(ctx, "oldKey", types.OffchainNode{NodeAddress: worker, LibP2PKey: "oldKey"}))
+	s.Require().NoError(legacyWorkers.Set(ctx, "otherKey", types.OffchainNode{NodeAddress: otherWorker, LibP2PKey: "otherKey"}))
+
+	err := keeper.NewMigrator(k).Migrate2to3(ctx)
+	s.Require().NoError(err, "Migration should not fail")
+
+	topicIds, previousKeys, err := k.UpdateWorkerNodeInfo(ctx, worker, types.OffchainNode{NodeAddress: worker, LibP2PKey: "newKey"})
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{1, 2}, topicIds)
+	s.Require().Equal([]string{"oldKey"}, previousKeys)
+
+	_, err = k.GetWorkerByLibp2pKey(ctx, "oldKey")
+	s.Require().ErrorIs(err, collections.ErrNotFound)
+	otherNode, err := k.GetWorkerByLibp2pKey(ctx, "otherKey")
+	s.Require().NoError(err)
+	s.Require().Equal(otherWorker, otherNode.NodeAddress, "Entries of other workers should be left alone")
+}
+
+func (s *KeeperTestSuite) TestMigrate3to4SetsActorHistoryRetention() {
+	ctx := s.ctx
+	k := s.emissionsKeeper
+	topicId := uint64(1)
+
+	params := types.DefaultParams()
+	params.ActorHistoryRetention = 0
+	s.Require().NoError(k.SetParams(ctx, params))
+
+	// Write history the way version 3 of the module stored it, without the by-block index
+	legacyInfererScoreHistory := collections.NewMap(
+		collections.NewSchemaBuilder(s.storeService),
+		types.InfererScoreHistoryKey,
+		"inferer_score_history",
+		collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.Int64Key),
+		codec.CollValue[types.Score](s.codec),
+	)
+	score := types.Score{TopicId: topicId, BlockHeight: 100, Address: "worker1"}
+	s.Require().NoError(legacyInfererScoreHistory.Set(ctx, collections.Join3(topicId, score.Address, score.BlockHeight), score))
+
+	err := keeper.NewMigrator(k).Migrate3to4(ctx)
+	s.Require().NoError(err, "Migration should not fail")
+
+	params, err = k.GetParams(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(types.DefaultParams().ActorHistoryRetention, params.ActorHistoryRetention)
+
+	s.Require().NoError(k.PruneActorHistory(ctx, topicId, 100+params.ActorHistoryRetention))
+	scores, _, err := k.GetActorScoreHistory(ctx, topicId, "worker1", types.ActorType_INFERER, 0, 0, nil)
+	s.Require().NoError(err)
+	s.Require().Empty(scores, "Scores written before the migration should be pruned by height")
+}
+
+func (s *KeeperTestSuite) TestMigrate4to5SetsLastMonthlyUpdateHeights() {
+	k := s.emissionsKeeper
+	params := types.DefaultParams()
+	params.BlocksPerMonth = 100
+	params.MinBlocksPerMonth = 0
+	params.MaxBlocksPerMonth = 0
+	params.MaxBlocksPerMonthChange = alloraMath.ZeroDec()
+	params.BlockTimeSmoothingDegree = alloraMath.ZeroDec()
+	s.Require().NoError(k.SetParams(s.ctx, params))
+
+	ctx := s.ctx.WithBlockHeight(251)
+	err := keeper.NewMigrator(k).Migrate4to5(ctx)
+	s.Require().NoError(err, "Migration should not fail")
+
+	params, err = k.GetParams(ctx)
+	s.Require().NoError(err)
+	defaultParams := types.DefaultParams()
+	s.Require().False(params.BlocksPerMonthCalibrationEnabled)
+	s.Require().Equal(defaultParams.MinBlocksPerMonth, params.MinBlocksPerMonth)
+	s.Require().Equal(defaultParams.MaxBlocksPerMonth, params.MaxBlocksPerMonth)
+	s.Require().True(defaultParams.MaxBlocksPerMonthChange.Equal(params.MaxBlocksPerMonthChange))
+	s.Require().True(defaultParams.BlockTimeSmoothingDegree.Equal(params.BlockTimeSmoothingDegree))
+
+	// The updates keep happening at the heights they were aligned on before the migration
+	lastCalibrationHeight, err := k.GetLastBlocksPerMonthCalibrationHeight(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(201), lastCalibrationHeight)
+	lastPercentageHeight, err := k.GetLastPercentageRewardToStakedReputersHeight(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(200), lastPercentageHeight)
+}
+
+func (s *KeeperTestSuite) TestMigrate4to5BeforeTheFirstMonth() {
+	k := s.emissionsKeeper
+	params := types.DefaultParams()
+	params.BlocksPerMonth = 100
+	s.Require().NoError(k.SetParams(s.ctx, params))
+
+	ctx := s.ctx.WithBlockHeight(51)
+	s.Require().NoError(keeper.NewMigrator(k).Migrate4to5(ctx))
+
+	lastCalibrationHeight, err := k.GetLastBlocksPerMonthCalibrationHeight(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(1), lastCalibrationHeight)
+	lastPercentageHeight, err := k.GetLastPercentageRewardToStakedReputersHeight(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(0), lastPercentageHeight, "The percentage was never set before the first month")
+}
+
+func (s *KeeperTestSuite) TestMigrate5to6SetsMaxTopicOutputDimension() {
+	ctx := s.ctx
+	k := s.emissionsKeeper
+	params := types.DefaultParams()
+	params.MaxTopicOutputDimension = 0
+	s.Require().NoError(k.SetParams(ctx, params))
+
+	err := keeper.NewMigrator(k).Migrate5to6(ctx)
+	s.Require().NoError(err, "Migration should not fail")
+
+	params, err = k.GetParams(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(types.DefaultParams().MaxTopicOutputDimension, params.MaxTopicOutputDimension)
+}
+
+func (s *KeeperTestSuite) TestMigrate6to7SetsMaxTopicHorizons() {
+	ctx := s.ctx
+	k := s.emissionsKeeper
+	params := types.DefaultParams()
+	params.MaxTopicHorizons = 0
+	s.Require().NoError(k.SetParams(ctx, params))
+
+	err := keeper.NewMigrator(k).Migrate6to7(ctx)
+	s.Require().NoError(err, "Migration should not fail")
+
+	params, err = k.GetParams(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(types.DefaultParams().MaxTopicHorizons, params.MaxTopicHorizons)
+}
+
 /// TOPIC REWARD NONCE
 
 func (s *KeeperTestSuite) TestGetSetDeleteTopicRewardNonce() {
@@ -3134,6 +3758,12 @@ func (s *KeeperTestSuite) TestPruneRecordsAfterRewards() {
 	err = s.emissionsKeeper.InsertNetworkLossBundleAtBlock(s.ctx, topicId, block, networkLosses)
 	s.Require().NoError(err, "InsertNetworkLossBundleAtBlock should not return an error")
 
+	err = s.emissionsKeeper.SetNetworkInferenceWeights(s.ctx, types.NetworkInferenceWeights{TopicId: topicId, BlockHeight: block})
+	s.Require().NoError(err, "SetNetworkInferenceWeights should not return an error")
+
+	err = s.emissionsKeeper.SetRewardBreakdown(s.ctx, types.RewardBreakdown{TopicId: topicId, BlockHeight: block})
+	s.Require().NoError(err, "SetRewardBreakdown should not return an error")
+
 	// Check if the records are set
 	_, err = s.emissionsKeeper.GetInferencesAtBlock(s.ctx, topicId, block)
 	s.Require().NoError(err, "Getting inferences should not fail")
@@ -3143,6 +3773,10 @@ func (s *KeeperTestSuite) TestPruneRecordsAfterRewards() {
 	s.Require().NoError(err, "Getting reputer loss bundles should not fail")
 	_, err = s.emissionsKeeper.GetNetworkLossBundleAtBlock(s.ctx, topicId, block)
 	s.Require().NoError(err, "Getting network loss bundle should not fail")
+	_, err = s.emissionsKeeper.GetNetworkInferenceWeightsAtBlock(s.ctx, topicId, block)
+	s.Require().NoError(err, "Getting network inference weights should not fail")
+	_, err = s.emissionsKeeper.GetRewardBreakdowns(s.ctx, topicId, block)
+	s.Require().NoError(err, "Getting reward breakdowns should not fail")
 
 	// Prune records in the subsequent block
 	err = s.emissionsKeeper.PruneRecordsAfterRewards(s.ctx, topicId, block+1)
@@ -3157,6 +3791,10 @@ func (s *KeeperTestSuite) TestPruneRecordsAfterRewards() {
 	s.Require().Error(err, "Should return error for non-existent data")
 	_, err = s.emissionsKeeper.GetNetworkLossBundleAtBlock(s.ctx, topicId, block)
 	s.Require().Error(err, "Should return error for non-existent data")
+	_, err = s.emissionsKeeper.GetNetworkInferenceWeightsAtBlock(s.ctx, topicId, block)
+	s.Require().Error(err, "Should return error for non-existent data")
+	_, err = s.emissionsKeeper.GetRewardBreakdowns(s.ctx, topicId, block)
+	s.Require().Error(err, "Should return error for non-existent data")
 }
 
 func (s *KeeperTestSuite) TestPruneWorkerNoncesLogicCorrectness() {
@@ -3506,3 +4144,117 @@ func (s *KeeperTestSuite) TestGetFirstDelegateStakeRemovalForDelegatorReputerAnd
 	s.Require().NoError(err)
 	s.Require().False(found)
 }
+
+func (s *KeeperTestSuite) TestRemoveInactiveTopicActors() {
+	ctx := s.ctx.WithBlockHeight(100)
+	keeper := s.emissionsKeeper
+	topic := types.Topic{
+		Id:                         uint64(1),
+		EpochLength:                10,
+		WorkerInactivityThreshold:  3,
+		ReputerInactivityThreshold: 2,
+	}
+	activeWorker := "activeWorker"
+	inactiveWorker := "inactiveWorker"
+	reputer := "reputer"
+
+	err := keeper.InsertWorker(ctx, topic.Id, activeWorker, types.OffchainNode{LibP2PKey: "key1", NodeAddress: activeWorker})
+	s.Require().NoError(err)
+	err = keeper.InsertWorker(ctx, topic.Id, inactiveWorker, types.OffchainNode{LibP2PKey: "key2", NodeAddress: inactiveWorker})
+	s.Require().NoError(err)
+	err = keeper.InsertReputer(ctx, topic.Id, reputer, types.OffchainNode{LibP2PKey: "key3", NodeAddress: reputer})
+	s.Require().NoError(err)
+
+	for _, epochHeight := range []int64{110, 120, 130} {
+		s.Require().NoError(keeper.AddTopicEpochHeight(ctx, topic, epochHeight))
+	}
+	err = keeper.SetWorkerLastActiveHeight(ctx, topic.Id, activeWorker, 120)
+	s.Require().NoError(err)
+
+	// 2 nonces missed since registration, so the reputer reached its threshold but the workers did not
+	workers, reputers, err := keeper.RemoveInactiveTopicActors(ctx, topic, 130)
+	s.Require().NoError(err)
+	s.Require().Empty(workers)
+	s.Require().Equal([]string{reputer}, reputers)
+
+	isRegistered, err := keeper.IsReputerRegisteredInTopic(ctx, topic.Id, reputer)
+	s.Require().NoError(err)
+	s.Require().False(isRegistered)
+
+	workersInactivity, err := keeper.GetTopicWorkersInactivity(ctx, topic, 130)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.ActorInactivity{
+		{Address: activeWorker, LastActiveHeight: 120, MissedEpochs: 0, EpochsUntilRemoval: 3},
+		{Address: inactiveWorker, LastActiveHeight: 100, MissedEpochs: 2, EpochsUntilRemoval: 1},
+	}, workersInactivity)
+
+	s.Require().NoError(keeper.AddTopicEpochHeight(ctx, topic, 140))
+	workers, reputers, err = keeper.RemoveInactiveTopicActors(ctx, topic, 140)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{inactiveWorker}, workers)
+	s.Require().Empty(reputers)
+
+	isRegistered, err = keeper.IsWorkerRegisteredInTopic(ctx, topic.Id, inactiveWorker)
+	s.Require().NoError(err)
+	s.Require().False(isRegistered)
+	isRegistered, err = keeper.IsWorkerRegisteredInTopic(ctx, topic.Id, activeWorker)
+	s.Require().NoError(err)
+	s.Require().True(isRegistered)
+
+	// the topic opened no nonce for a long while, e.g. because it was inactive,
+	// so only the nonces opened before and after the gap are missed
+	s.Require().NoError(keeper.AddTopicEpochHeight(ctx, topic, 1000))
+	workers, reputers, err = keeper.RemoveInactiveTopicActors(ctx, topic, 1000)
+	s.Require().NoError(err)
+	s.Require().Empty(workers)
+	s.Require().Empty(reputers)
+	workersInactivity, err = keeper.GetTopicWorkersInactivity(ctx, topic, 1000)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.ActorInactivity{
+		{Address: activeWorker, LastActiveHeight: 120, MissedEpochs: 2, EpochsUntilRemoval: 1},
+	}, workersInactivity)
+}
+
+func (s *KeeperTestSuite) TestAddTopicEpochHeightKeepsLatestHeights() {
+	ctx := s.ctx.WithBlockHeight(100)
+	keeper := s.emissionsKeeper
+	topic := types.Topic{
+		Id:                         uint64(1),
+		EpochLength:                10,
+		WorkerInactivityThreshold:  1,
+		ReputerInactivityThreshold: 2,
+	}
+	worker := "worker"
+	err := keeper.InsertWorker(ctx, topic.Id, worker, types.OffchainNode{LibP2PKey: "key", NodeAddress: worker})
+	s.Require().NoError(err)
+
+	for _, epochHeight := range []int64{110, 120, 130, 140, 150} {
+		s.Require().NoError(keeper.AddTopicEpochHeight(ctx, topic, epochHeight))
+	}
+
+	// only the latest 3 heights are kept, enough to count up to the highest threshold
+	workersInactivity, err := keeper.GetTopicWorkersInactivity(ctx, topic, 150)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.ActorInactivity{
+		{Address: worker, LastActiveHeight: 100, MissedEpochs: 2, EpochsUntilRemoval: 0},
+	}, workersInactivity)
+}
+
+func (s *KeeperTestSuite) TestRemoveInactiveTopicActorsDisabledThreshold() {
+	ctx := s.ctx.WithBlockHeight(100)
+	keeper := s.emissionsKeeper
+	topic := types.Topic{Id: uint64(1), EpochLength: 10}
+	worker := "worker"
+
+	err := keeper.InsertWorker(ctx, topic.Id, worker, types.OffchainNode{LibP2PKey: "key", NodeAddress: worker})
+	s.Require().NoError(err)
+
+	workers, reputers, err := keeper.RemoveInactiveTopicActors(ctx, topic, 10000)
+	s.Require().NoError(err)
+	s.Require().Empty(workers)
+	s.Require().Empty(reputers)
+
+	isRegistered, err := keeper.IsWorkerRegisteredInTopic(ctx, topic.Id, worker)
+	s.Require().NoError(err)
+	s.Require().True(isRegistered)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	v2 "github.com/allora-network/allora-chain/x/emissions/migrations/v2"
+	"github.com/allora-network/allora-chain/x/emissions/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -18,9 +19,94 @@ func NewMigrator(k Keeper) Migrator {
 }
 
 // Migrate1to2 migrates the emissions module state from the consensus version 1 to
-// version 2. For now this is a no-op since we don't actually have any state to
-// upgrade, but in the future this function or a 2to3 would be used to handle
-// state migrations between versions of the emissions module.
+// version 2. Whitelist admins from version 1 could do everything, so they are
+// granted every role of the role-based admin model.
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
-	return v2.MigrateStore(ctx)
+	if err := v2.MigrateStore(ctx); err != nil {
+		return err
+	}
+	return m.keeper.MigrateWhitelistAdminsToRoles(ctx)
+}
+
+// Migrate2to3 migrates the emissions module state from the consensus version 2 to
+// version 3. Registrations and node entries are indexed by actor, built here
+// from the existing registrations.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return m.keeper.IndexRegistrations(ctx)
+}
+
+// Migrate3to4 migrates the emissions module state from the consensus version 3 to
+// version 4. The score and reward history of actors is kept for the default retention
+// window and indexed by block, so that it is pruned by height.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	params, err := m.keeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	params.ActorHistoryRetention = types.DefaultParams().ActorHistoryRetention
+	if err := m.keeper.SetParams(ctx, params); err != nil {
+		return err
+	}
+	return m.keeper.IndexActorHistory(ctx)
+}
+
+// Migrate4to5 migrates the emissions module state from the consensus version 4 to
+// version 5. The blocks per month calibration params are set to their defaults, leaving
+// calibration disabled. Monthly updates used to run at heights aligned on the blocks per
+// month and are now scheduled from the height of the last update, which is set here to the
+// last aligned height so that the next updates happen when they would have before.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	params, err := m.keeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	defaultParams := types.DefaultParams()
+	params.BlocksPerMonthCalibrationEnabled = defaultParams.BlocksPerMonthCalibrationEnabled
+	params.MinBlocksPerMonth = defaultParams.MinBlocksPerMonth
+	params.MaxBlocksPerMonth = defaultParams.MaxBlocksPerMonth
+	params.MaxBlocksPerMonthChange = defaultParams.MaxBlocksPerMonthChange
+	params.BlockTimeSmoothingDegree = defaultParams.BlockTimeSmoothingDegree
+	if err := m.keeper.SetParams(ctx, params); err != nil {
+		return err
+	}
+
+	previousHeight := ctx.BlockHeight() - 1
+	if previousHeight < 1 || params.BlocksPerMonth == 0 {
+		return nil
+	}
+	blocksPerMonth := int64(params.BlocksPerMonth)
+	// calibration ran at heights h with h % blocksPerMonth == 1
+	if err := m.keeper.SetLastBlocksPerMonthCalibrationHeight(ctx, previousHeight-(previousHeight-1)%blocksPerMonth); err != nil {
+		return err
+	}
+	// the percentage reward to staked reputers was set at heights h with h % blocksPerMonth == 0
+	lastPercentageHeight := previousHeight - previousHeight%blocksPerMonth
+	if lastPercentageHeight < 1 {
+		return nil
+	}
+	return m.keeper.SetLastPercentageRewardToStakedReputersHeight(ctx, lastPercentageHeight)
+}
+
+// Migrate5to6 migrates the emissions module state from the consensus version 5 to
+// version 6. The max output dimension of vector-valued topics takes its default, as
+// version 5 left it at 0, which allows only scalar-valued topics.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	params, err := m.keeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	params.MaxTopicOutputDimension = types.DefaultParams().MaxTopicOutputDimension
+	return m.keeper.SetParams(ctx, params)
+}
+
+// Migrate6to7 migrates the emissions module state from the consensus version 6 to
+// version 7. The max number of horizons of a topic takes its default, as version 6
+// left it at 0, which allows no topic to predict more than its ground truth lag.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	params, err := m.keeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	params.MaxTopicHorizons = types.DefaultParams().MaxTopicHorizons
+	return m.keeper.SetParams(ctx, params)
 }
@@ -35,6 +35,10 @@ func (ms msgServer) InsertBulkReputerPayload(
 	if err != nil {
 		return nil, sdkerrors.ErrNotFound
 	}
+	horizon := msg.ReputerRequestNonce.Horizon
+	if err := topic.ValidateHorizon(horizon); err != nil {
+		return nil, err
+	}
 
 	/// Do filters upon the leader (the sender) first, then do checks on each reputer in the payload
 	/// All filters should be done in order of increasing computational complexity
@@ -54,7 +58,7 @@ func (ms msgServer) InsertBulkReputerPayload(
 	}
 
 	// Check if the reputer nonce is unfulfilled
-	reputerNonceUnfulfilled, err := ms.k.IsReputerNonceUnfulfilled(ctx, msg.TopicId, msg.ReputerRequestNonce.ReputerNonce)
+	reputerNonceUnfulfilled, err := ms.k.IsReputerNonceUnfulfilledForHorizon(ctx, msg.TopicId, msg.ReputerRequestNonce.ReputerNonce, horizon)
 	if err != nil {
 		return nil, err
 	}
@@ -89,7 +93,8 @@ func (ms msgServer) InsertBulkReputerPayload(
 			continue
 		}
 		// Check that the reputer's value bundle is for a nonce matching the leader's given nonce
-		if bundle.ValueBundle.ReputerRequestNonce.ReputerNonce.BlockHeight != msg.ReputerRequestNonce.ReputerNonce.BlockHeight {
+		if bundle.ValueBundle.ReputerRequestNonce.ReputerNonce.BlockHeight != msg.ReputerRequestNonce.ReputerNonce.BlockHeight ||
+			bundle.ValueBundle.ReputerRequestNonce.Horizon != horizon {
 			continue
 		}
 
@@ -105,6 +110,12 @@ func (ms msgServer) InsertBulkReputerPayload(
 				continue
 			}
 
+			// Check that the reputer is allowed to participate in a permissioned topic
+			canParticipate, err := ms.k.CanReputerParticipateInTopic(ctx, topic, reputer)
+			if err != nil || !canParticipate {
+				continue
+			}
+
 			// Check that the reputer enough stake in the topic
 			stake, err := ms.k.GetStakeReputerAuthority(ctx, msg.TopicId, reputer)
 			if err != nil {
@@ -164,7 +175,13 @@ func (ms msgServer) InsertBulkReputerPayload(
 	bundles := types.ReputerValueBundles{
 		ReputerValueBundles: lossBundlesFromTopReputers,
 	}
-	err = ms.k.InsertReputerLossBundlesAtBlock(ctx, msg.TopicId, msg.ReputerRequestNonce.ReputerNonce.BlockHeight, bundles)
+	err = ms.k.InsertReputerLossBundlesAtBlockForHorizon(ctx, msg.TopicId, horizon, msg.ReputerRequestNonce.ReputerNonce.BlockHeight, bundles)
+	if err != nil {
+		return nil, err
+	}
+
+	// Every reputer that passed the filters submitted for this nonce, even if not among the top
+	err = markActorsActive(ctx, msg.TopicId, latestReputerScores, ms.k.SetReputerLastActiveHeight)
 	if err != nil {
 		return nil, err
 	}
@@ -179,35 +196,38 @@ func (ms msgServer) InsertBulkReputerPayload(
 
 	networkLossBundle.ReputerRequestNonce = msg.ReputerRequestNonce
 
-	err = ms.k.InsertNetworkLossBundleAtBlock(ctx, msg.TopicId, msg.ReputerRequestNonce.ReputerNonce.BlockHeight, networkLossBundle)
+	err = ms.k.InsertNetworkLossBundleAtBlockForHorizon(ctx, msg.TopicId, horizon, msg.ReputerRequestNonce.ReputerNonce.BlockHeight, networkLossBundle)
 	if err != nil {
 		return nil, err
 	}
 
-	types.EmitNewNetworkLossSetEvent(sdkCtx, msg.TopicId, msg.ReputerRequestNonce.ReputerNonce.BlockHeight, networkLossBundle)
-
-	err = synth.GetCalcSetNetworkRegrets(
-		sdkCtx,
-		ms.k,
-		msg.TopicId,
-		networkLossBundle,
-		*msg.ReputerRequestNonce.ReputerNonce,
-		topic.AlphaRegret,
-		params.CNorm,
-		topic.PNorm,
-		topic.Epsilon)
-	if err != nil {
-		return nil, err
+	types.EmitNewNetworkLossSetEvent(sdkCtx, msg.TopicId, horizon, msg.ReputerRequestNonce.ReputerNonce.BlockHeight, networkLossBundle)
+
+	// Regrets are kept per topic, so only the ground truth horizon updates them
+	if horizon == 0 {
+		err = synth.GetCalcSetNetworkRegrets(
+			sdkCtx,
+			ms.k,
+			msg.TopicId,
+			networkLossBundle,
+			*msg.ReputerRequestNonce.ReputerNonce,
+			topic.AlphaRegret,
+			params.CNorm,
+			topic.PNorm,
+			topic.Epsilon)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// Update the unfulfilled nonces
-	_, err = ms.k.FulfillReputerNonce(ctx, msg.TopicId, msg.ReputerRequestNonce.ReputerNonce)
+	_, err = ms.k.FulfillReputerNonceForHorizon(ctx, msg.TopicId, msg.ReputerRequestNonce.ReputerNonce, horizon)
 	if err != nil {
 		return nil, err
 	}
 
-	// Update topic reward nonce
-	err = ms.k.SetTopicRewardNonce(ctx, msg.TopicId, msg.ReputerRequestNonce.ReputerNonce.BlockHeight)
+	// Update topic reward nonce of the horizon
+	err = ms.k.SetTopicHorizonRewardNonce(ctx, msg.TopicId, horizon, msg.ReputerRequestNonce.ReputerNonce.BlockHeight)
 	if err != nil {
 		return nil, err
 	}
@@ -223,7 +243,7 @@ func (ms msgServer) InsertBulkReputerPayload(
 		return nil, err
 	}
 
-	err = ms.k.SetTopicLastReputerPayload(ctx, topic.Id, blockHeight, msg.ReputerRequestNonce.ReputerNonce, msg.Sender)
+	err = ms.k.SetTopicLastReputerPayloadForHorizon(ctx, topic.Id, horizon, blockHeight, msg.ReputerRequestNonce.ReputerNonce, msg.Sender)
 	if err != nil {
 		return nil, err
 	}
@@ -250,6 +270,7 @@ func filterUnacceptedWorkersFromReputerValueBundle(
 			return nil, err
 		}
 	}
+	inferences = inferences.AtHorizon(reputerRequestNonce.Horizon)
 	acceptedInferersOfBatch := make(map[string]bool)
 	for _, inference := range inferences.Inferences {
 		acceptedInferersOfBatch[inference.Inferer] = true
@@ -265,6 +286,7 @@ func filterUnacceptedWorkersFromReputerValueBundle(
 			return nil, err
 		}
 	}
+	forecasts = forecasts.AtHorizon(reputerRequestNonce.Horizon)
 	acceptedForecastersOfBatch := make(map[string]bool)
 	for _, forecast := range forecasts.Forecasts {
 		acceptedForecastersOfBatch[forecast.Forecaster] = true
@@ -3,6 +3,7 @@ package msgserver_test
 import (
 	"encoding/hex"
 
+	"cosmossdk.io/collections"
 	alloraMath "github.com/allora-network/allora-chain/math"
 	"github.com/allora-network/allora-chain/x/emissions/keeper/inference_synthesis"
 	"github.com/allora-network/allora-chain/x/emissions/types"
@@ -302,6 +303,50 @@ func (s *MsgServerTestSuite) TestInsertingReputerPayloadWithUnregisteredReputerI
 	require.ErrorIs(err, types.ErrNoValidBundles)
 }
 
+func (s *MsgServerTestSuite) TestInsertingReputerPayloadWithReputerNotInTopicAllowlistIsIgnored() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	block := types.BlockHeight(1)
+
+	reputerPrivateKey := secp256k1.GenPrivKey()
+	reputerPublicKeyBytes := reputerPrivateKey.PubKey().Bytes()
+	reputerAddr := sdk.AccAddress(reputerPrivateKey.PubKey().Address())
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerAddr := sdk.AccAddress(workerPrivateKey.PubKey().Address())
+
+	reputerValueBundle, expectedInferences, expectedForecasts, topicId, reputerNonce, _ := s.getBasicReputerPayload(reputerAddr, workerAddr, block)
+
+	// BEGIN MODIFICATION
+	// The reputer created the topic, so it may restrict reputers without allowlisting itself
+	_, err := msgServer.UpdateTopicAllowlistMode(ctx, &types.MsgUpdateTopicAllowlistMode{
+		Sender:        reputerAddr.String(),
+		TopicId:       topicId,
+		AllowlistMode: types.TopicAllowlistMode_ALLOWLIST_MODE_REPUTERS,
+	})
+	require.NoError(err)
+	// END MODIFICATION
+
+	err = keeper.InsertForecasts(ctx, topicId, types.Nonce{BlockHeight: block}, expectedForecasts)
+	require.NoError(err)
+
+	err = keeper.InsertInferences(ctx, topicId, types.Nonce{BlockHeight: block}, expectedInferences)
+	require.NoError(err)
+
+	err = s.constructAndInsertReputerPayload(
+		reputerAddr,
+		reputerPrivateKey,
+		reputerPublicKeyBytes,
+		&reputerValueBundle,
+		topicId,
+		&reputerNonce,
+	)
+
+	require.ErrorIs(err, types.ErrNoValidBundles)
+}
+
 func (s *MsgServerTestSuite) TestInsertingReputerPayloadWithUnderstakeReputerIsIgnored() {
 	ctx, msgServer := s.ctx, s.msgServer
 	require := s.Require()
@@ -765,3 +810,59 @@ func (s *MsgServerTestSuite) TestMsgInsertBulkReputerPayloadUpdateTopicCommit()
 	require.Equal(reputerValueBundle.Reputer, lastReputerPayload.Actor, "Actor should be same")
 	require.Equal(reputerValueBundle.ReputerRequestNonce.ReputerNonce, lastReputerPayload.Nonce, "Nonce should be same")
 }
+
+func (s *MsgServerTestSuite) TestMsgInsertBulkReputerPayloadOfLaterHorizonKeepsTopicRegrets() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	keeper := s.emissionsKeeper
+	block := types.BlockHeight(1)
+
+	reputerPrivateKey := secp256k1.GenPrivKey()
+	reputerPublicKeyBytes := reputerPrivateKey.PubKey().Bytes()
+	reputerAddr := sdk.AccAddress(reputerPrivateKey.PubKey().Address())
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerAddr := sdk.AccAddress(workerPrivateKey.PubKey().Address())
+	reputerValueBundle, expectedInferences, expectedForecasts, topicId, reputerNonce, _ := s.getBasicReputerPayload(reputerAddr, workerAddr, block)
+
+	topic, err := keeper.GetTopic(ctx, topicId)
+	require.NoError(err)
+	topic.Horizons = []int64{topic.GroundTruthLag, topic.GroundTruthLag + topic.EpochLength}
+	require.NoError(keeper.SetTopic(ctx, topicId, topic))
+	require.NoError(keeper.AddReputerNonceForHorizon(ctx, topicId, &reputerNonce, 1))
+
+	for _, inference := range expectedInferences.Inferences {
+		inference.Horizon = 1
+	}
+	for _, forecast := range expectedForecasts.Forecasts {
+		forecast.Horizon = 1
+	}
+	require.NoError(keeper.InsertForecasts(ctx, topicId, types.Nonce{BlockHeight: block}, expectedForecasts))
+	require.NoError(keeper.InsertInferences(ctx, topicId, types.Nonce{BlockHeight: block}, expectedInferences))
+
+	reputerRequestNonce := &types.ReputerRequestNonce{ReputerNonce: &reputerNonce, Horizon: 1}
+	reputerValueBundle.ReputerRequestNonce = reputerRequestNonce
+	_, err = msgServer.InsertBulkReputerPayload(ctx, &types.MsgInsertBulkReputerPayload{
+		Sender:              reputerAddr.String(),
+		TopicId:             topicId,
+		ReputerRequestNonce: reputerRequestNonce,
+		ReputerValueBundles: []*types.ReputerValueBundle{
+			{
+				ValueBundle: &reputerValueBundle,
+				Signature:   s.signValueBundle(&reputerValueBundle, reputerPrivateKey),
+				Pubkey:      hex.EncodeToString(reputerPublicKeyBytes),
+			},
+		},
+	})
+	require.NoError(err)
+
+	// Regrets are only updated from the losses of the first horizon
+	_, noPrior, err := keeper.GetInfererNetworkRegret(ctx, topicId, workerAddr.String())
+	require.NoError(err)
+	require.True(noPrior)
+
+	lastReputerPayload, err := keeper.GetTopicLastReputerPayloadForHorizon(ctx, topicId, 1)
+	require.NoError(err)
+	require.Equal(&reputerNonce, lastReputerPayload.Nonce)
+	_, err = keeper.GetTopicLastReputerPayload(ctx, topicId)
+	require.ErrorIs(err, collections.ErrNotFound)
+}
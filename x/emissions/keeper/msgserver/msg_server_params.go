@@ -10,12 +10,12 @@ func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams
 	if err := ms.k.ValidateStringIsBech32(msg.Sender); err != nil {
 		return nil, err
 	}
-	isAdmin, err := ms.k.IsWhitelistAdmin(ctx, msg.Sender)
+	isAdmin, err := ms.k.IsParamsAdmin(ctx, msg.Sender)
 	if err != nil {
 		return nil, err
 	}
 	if !isAdmin {
-		return nil, types.ErrNotWhitelistAdmin
+		return nil, types.ErrNotParamsAdmin
 	}
 	existingParams, err := ms.k.GetParams(ctx)
 	if err != nil {
@@ -146,6 +146,36 @@ func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams
 	if len(newParams.HalfMaxProcessStakeRemovalsEndBlock) == 1 {
 		existingParams.HalfMaxProcessStakeRemovalsEndBlock = newParams.HalfMaxProcessStakeRemovalsEndBlock[0]
 	}
+	if len(newParams.PermissionedTopicCreation) == 1 {
+		existingParams.PermissionedTopicCreation = newParams.PermissionedTopicCreation[0]
+	}
+	if len(newParams.MultiRegistrationFeeDiscount) == 1 {
+		existingParams.MultiRegistrationFeeDiscount = newParams.MultiRegistrationFeeDiscount[0]
+	}
+	if len(newParams.MaxTopicOutputDimension) == 1 {
+		existingParams.MaxTopicOutputDimension = newParams.MaxTopicOutputDimension[0]
+	}
+	if len(newParams.MaxTopicHorizons) == 1 {
+		existingParams.MaxTopicHorizons = newParams.MaxTopicHorizons[0]
+	}
+	if len(newParams.ActorHistoryRetention) == 1 {
+		existingParams.ActorHistoryRetention = newParams.ActorHistoryRetention[0]
+	}
+	if len(newParams.BlocksPerMonthCalibrationEnabled) == 1 {
+		existingParams.BlocksPerMonthCalibrationEnabled = newParams.BlocksPerMonthCalibrationEnabled[0]
+	}
+	if len(newParams.MinBlocksPerMonth) == 1 {
+		existingParams.MinBlocksPerMonth = newParams.MinBlocksPerMonth[0]
+	}
+	if len(newParams.MaxBlocksPerMonth) == 1 {
+		existingParams.MaxBlocksPerMonth = newParams.MaxBlocksPerMonth[0]
+	}
+	if len(newParams.MaxBlocksPerMonthChange) == 1 {
+		existingParams.MaxBlocksPerMonthChange = newParams.MaxBlocksPerMonthChange[0]
+	}
+	if len(newParams.BlockTimeSmoothingDegree) == 1 {
+		existingParams.BlockTimeSmoothingDegree = newParams.BlockTimeSmoothingDegree[0]
+	}
 	err = existingParams.Validate()
 	if err != nil {
 		return nil, err
@@ -171,3 +171,93 @@ func (s *MsgServerTestSuite) TestUpdateParamsNonWhitelistedUser() {
 	require.Nil(response, "Response should be nil when access is denied")
 	require.Error(err, types.ErrNotWhitelistAdmin, "Expected an error for non-whitelisted sender")
 }
+
+func (s *MsgServerTestSuite) TestUpdateParamsBlocksPerMonthCalibration() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	adminPrivateKey := secp256k1.GenPrivKey()
+	adminAddr := sdk.AccAddress(adminPrivateKey.PubKey().Address())
+	s.emissionsKeeper.AddWhitelistAdmin(ctx, adminAddr.String())
+
+	// The min bound may not exceed the max bound when calibration is enabled
+	_, err := msgServer.UpdateParams(ctx, &types.MsgUpdateParams{
+		Sender: adminAddr.String(),
+		Params: &types.OptionalParams{
+			BlocksPerMonthCalibrationEnabled: []bool{true},
+			MinBlocksPerMonth:                []uint64{2000},
+			MaxBlocksPerMonth:                []uint64{1000},
+		},
+	})
+	require.Error(err)
+
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{
+		Sender: adminAddr.String(),
+		Params: &types.OptionalParams{
+			BlocksPerMonthCalibrationEnabled: []bool{true},
+			MinBlocksPerMonth:                []uint64{1000},
+			MaxBlocksPerMonth:                []uint64{2000},
+			MaxBlocksPerMonthChange:          []alloraMath.Dec{alloraMath.MustNewDecFromString("0.05")},
+			BlockTimeSmoothingDegree:         []alloraMath.Dec{alloraMath.MustNewDecFromString("0.2")},
+		},
+	})
+	require.NoError(err)
+
+	updatedParams, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+	require.True(updatedParams.BlocksPerMonthCalibrationEnabled)
+	require.Equal(uint64(1000), updatedParams.MinBlocksPerMonth)
+	require.Equal(uint64(2000), updatedParams.MaxBlocksPerMonth)
+	require.Equal(alloraMath.MustNewDecFromString("0.05"), updatedParams.MaxBlocksPerMonthChange)
+	require.Equal(alloraMath.MustNewDecFromString("0.2"), updatedParams.BlockTimeSmoothingDegree)
+}
+
+func (s *MsgServerTestSuite) TestUpdateParamsMaxTopicOutputDimensionIsBounded() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	adminPrivateKey := secp256k1.GenPrivKey()
+	adminAddr := sdk.AccAddress(adminPrivateKey.PubKey().Address())
+	s.emissionsKeeper.AddWhitelistAdmin(ctx, adminAddr.String())
+
+	_, err := msgServer.UpdateParams(ctx, &types.MsgUpdateParams{
+		Sender: adminAddr.String(),
+		Params: &types.OptionalParams{
+			MaxTopicOutputDimension: []uint64{types.MaxTopicOutputDimensionLimit + 1},
+		},
+	})
+	require.Error(err)
+
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{
+		Sender: adminAddr.String(),
+		Params: &types.OptionalParams{
+			MaxTopicOutputDimension: []uint64{types.MaxTopicOutputDimensionLimit},
+		},
+	})
+	require.NoError(err)
+}
+
+func (s *MsgServerTestSuite) TestUpdateParamsMaxTopicHorizonsIsBounded() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	adminPrivateKey := secp256k1.GenPrivKey()
+	adminAddr := sdk.AccAddress(adminPrivateKey.PubKey().Address())
+	s.emissionsKeeper.AddWhitelistAdmin(ctx, adminAddr.String())
+
+	_, err := msgServer.UpdateParams(ctx, &types.MsgUpdateParams{
+		Sender: adminAddr.String(),
+		Params: &types.OptionalParams{
+			MaxTopicHorizons: []uint64{types.MaxTopicHorizonsLimit + 1},
+		},
+	})
+	require.Error(err)
+
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{
+		Sender: adminAddr.String(),
+		Params: &types.OptionalParams{
+			MaxTopicHorizons: []uint64{types.MaxTopicHorizonsLimit},
+		},
+	})
+	require.NoError(err)
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
+
 	"github.com/allora-network/allora-chain/app/params"
 	"github.com/allora-network/allora-chain/x/emissions/types"
 	mintTypes "github.com/allora-network/allora-chain/x/mint/types"
@@ -16,13 +18,10 @@ func (ms msgServer) Register(ctx context.Context, msg *types.MsgRegister) (*type
 		return nil, err
 	}
 
-	topicExists, err := ms.k.TopicExists(ctx, msg.TopicId)
+	err := ms.checkCanRegisterInTopic(ctx, msg.TopicId, msg.Sender, msg.IsReputer)
 	if err != nil {
 		return nil, err
 	}
-	if !topicExists {
-		return nil, types.ErrTopicDoesNotExist
-	}
 
 	hasEnoughBal, fee, err := ms.CheckBalanceForRegistration(ctx, msg.Sender)
 	if err != nil {
@@ -46,16 +45,9 @@ func (ms msgServer) Register(ctx context.Context, msg *types.MsgRegister) (*type
 		NodeId:       msg.Owner + "|" + msg.LibP2PKey,
 	}
 
-	if msg.IsReputer {
-		err = ms.k.InsertReputer(ctx, msg.TopicId, msg.Sender, nodeInfo)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		err = ms.k.InsertWorker(ctx, msg.TopicId, msg.Sender, nodeInfo)
-		if err != nil {
-			return nil, err
-		}
+	err = ms.insertRegistration(ctx, msg.TopicId, msg.Sender, msg.IsReputer, nodeInfo)
+	if err != nil {
+		return nil, err
 	}
 
 	return &types.MsgRegisterResponse{
@@ -116,6 +108,196 @@ func (ms msgServer) RemoveRegistration(ctx context.Context, msg *types.MsgRemove
 	}, nil
 }
 
+// Update the node info of a worker or reputer in every topic it is registered in, without re-registering
+func (ms msgServer) UpdateNodeInfo(ctx context.Context, msg *types.MsgUpdateNodeInfo) (*types.MsgUpdateNodeInfoResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	nodeInfo := types.OffchainNode{
+		NodeAddress:  msg.Sender,
+		LibP2PKey:    msg.LibP2PKey,
+		MultiAddress: msg.MultiAddress,
+		Owner:        msg.Owner,
+		NodeId:       msg.Owner + "|" + msg.LibP2PKey,
+	}
+
+	var topicIds []types.TopicId
+	var previousKeys []string
+	var err error
+	if msg.IsReputer {
+		topicIds, previousKeys, err = ms.k.UpdateReputerNodeInfo(ctx, msg.Sender, nodeInfo)
+	} else {
+		topicIds, previousKeys, err = ms.k.UpdateWorkerNodeInfo(ctx, msg.Sender, nodeInfo)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	types.EmitNewNodeInfoUpdatedEvent(sdk.UnwrapSDKContext(ctx), msg.IsReputer, nodeInfo, previousKeys, topicIds)
+
+	return &types.MsgUpdateNodeInfoResponse{
+		Success: true,
+		Message: fmt.Sprintf("Node info successfully updated in %d topics", len(topicIds)),
+	}, nil
+}
+
+// Registers a node in many topics and removes its registration from others within a single transaction.
+// All topics are checked before any fee is paid or registration changed, so the whole message either applies or fails.
+func (ms msgServer) RegisterMulti(ctx context.Context, msg *types.MsgRegisterMulti) (*types.MsgRegisterMultiResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	for _, topicId := range msg.TopicIds {
+		err := ms.checkCanRegisterInTopic(ctx, topicId, msg.Sender, msg.IsReputer)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "topic %d", topicId)
+		}
+	}
+	for _, topicId := range msg.RemoveTopicIds {
+		err := ms.checkIsRegisteredInTopic(ctx, topicId, msg.Sender, msg.IsReputer)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "topic %d", topicId)
+		}
+	}
+
+	if len(msg.TopicIds) > 0 {
+		hasEnoughBal, fee, err := ms.CheckBalanceForMultiRegistration(ctx, msg.Sender, uint64(len(msg.TopicIds)))
+		if err != nil {
+			return nil, err
+		}
+		if !hasEnoughBal {
+			return nil, types.ErrTopicRegistrantNotEnoughDenom
+		}
+		if fee.IsPositive() {
+			err = ms.k.SendCoinsFromAccountToModule(ctx, msg.Sender, mintTypes.EcosystemModuleName, sdk.NewCoins(fee))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	nodeInfo := types.OffchainNode{
+		NodeAddress:  msg.Sender,
+		LibP2PKey:    msg.LibP2PKey,
+		MultiAddress: msg.MultiAddress,
+		Owner:        msg.Owner,
+		NodeId:       msg.Owner + "|" + msg.LibP2PKey,
+	}
+	for _, topicId := range msg.TopicIds {
+		err := ms.insertRegistration(ctx, topicId, msg.Sender, msg.IsReputer, nodeInfo)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, topicId := range msg.RemoveTopicIds {
+		err := ms.removeRegistration(ctx, topicId, msg.Sender, msg.IsReputer)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgRegisterMultiResponse{
+		Success: true,
+		Message: fmt.Sprintf("Node successfully registered in %d topics and removed from %d topics", len(msg.TopicIds), len(msg.RemoveTopicIds)),
+	}, nil
+}
+
+// Checks that the topic exists and that its allowlist lets the sender participate
+func (ms msgServer) checkCanRegisterInTopic(ctx context.Context, topicId types.TopicId, sender string, isReputer bool) error {
+	topicExists, err := ms.k.TopicExists(ctx, topicId)
+	if err != nil {
+		return err
+	}
+	if !topicExists {
+		return types.ErrTopicDoesNotExist
+	}
+
+	topic, err := ms.k.GetTopic(ctx, topicId)
+	if err != nil {
+		return err
+	}
+
+	// Permissioned topics only accept registrations from allowlisted actors
+	if isReputer {
+		canParticipate, err := ms.k.CanReputerParticipateInTopic(ctx, topic, sender)
+		if err != nil {
+			return err
+		}
+		if !canParticipate {
+			return types.ErrNotInTopicReputerAllowlist
+		}
+	} else {
+		canParticipate, err := ms.k.CanWorkerParticipateInTopic(ctx, topic, sender)
+		if err != nil {
+			return err
+		}
+		if !canParticipate {
+			return types.ErrNotInTopicWorkerAllowlist
+		}
+	}
+	return nil
+}
+
+// Checks that the topic exists and that the sender is registered in it
+func (ms msgServer) checkIsRegisteredInTopic(ctx context.Context, topicId types.TopicId, sender string, isReputer bool) error {
+	topicExists, err := ms.k.TopicExists(ctx, topicId)
+	if err != nil {
+		return err
+	}
+	if !topicExists {
+		return types.ErrTopicDoesNotExist
+	}
+
+	var isRegisteredInTopic bool
+	if isReputer {
+		isRegisteredInTopic, err = ms.k.IsReputerRegisteredInTopic(ctx, topicId, sender)
+	} else {
+		isRegisteredInTopic, err = ms.k.IsWorkerRegisteredInTopic(ctx, topicId, sender)
+	}
+	if err != nil {
+		return err
+	}
+	if !isRegisteredInTopic {
+		return types.ErrAddressIsNotRegisteredInThisTopic
+	}
+	return nil
+}
+
+func (ms msgServer) insertRegistration(ctx context.Context, topicId types.TopicId, sender string, isReputer bool, nodeInfo types.OffchainNode) error {
+	if isReputer {
+		return ms.k.InsertReputer(ctx, topicId, sender, nodeInfo)
+	}
+	return ms.k.InsertWorker(ctx, topicId, sender, nodeInfo)
+}
+
+func (ms msgServer) removeRegistration(ctx context.Context, topicId types.TopicId, sender string, isReputer bool) error {
+	if isReputer {
+		return ms.k.RemoveReputer(ctx, topicId, sender)
+	}
+	return ms.k.RemoveWorker(ctx, topicId, sender)
+}
+
+// The first topic pays the full registration fee and every other topic is discounted by MultiRegistrationFeeDiscount
+func (ms msgServer) CheckBalanceForMultiRegistration(ctx context.Context, address string, numTopics uint64) (bool, sdk.Coin, error) {
+	moduleParams, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return false, sdk.Coin{}, err
+	}
+	fee, err := types.CalcMultiRegistrationFee(moduleParams.RegistrationFee, moduleParams.MultiRegistrationFeeDiscount, numTopics)
+	if err != nil {
+		return false, sdk.Coin{}, err
+	}
+	feeCoin := sdk.NewCoin(params.DefaultBondDenom, fee)
+	accAddress, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return false, feeCoin, err
+	}
+	balance := ms.k.GetBankBalance(ctx, accAddress, feeCoin.Denom)
+	return balance.IsGTE(feeCoin), feeCoin, nil
+}
+
 func (ms msgServer) CheckBalanceForRegistration(ctx context.Context, address string) (bool, sdk.Coin, error) {
 	moduleParams, err := ms.k.GetParams(ctx)
 	if err != nil {
@@ -386,3 +386,205 @@ func (s *MsgServerTestSuite) TestMsgRegisterReputerInvalidTopicNotExist() {
 	_, err := msgServer.Register(ctx, registerMsg)
 	require.ErrorIs(err, types.ErrTopicDoesNotExist, "Register should return an error")
 }
+
+func (s *MsgServerTestSuite) TestMsgUpdateNodeInfo() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerAddr := sdk.AccAddress(PKS[0].Address())
+	newOwnerAddr := sdk.AccAddress(PKS[2].Address())
+	creatorAddress := sdk.AccAddress(PKS[1].Address())
+
+	mintAmount := sdk.NewCoins(sdk.NewInt64Coin(params.DefaultBondDenom, 100))
+	s.bankKeeper.MintCoins(ctx, minttypes.ModuleName, mintAmount)
+	err := s.bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, workerAddr, mintAmount)
+	require.NoError(err)
+
+	for _, topicId := range []uint64{1, 2} {
+		s.emissionsKeeper.SetTopic(ctx, topicId, types.Topic{Id: topicId, Creator: creatorAddress.String()})
+		s.emissionsKeeper.ActivateTopic(ctx, topicId)
+		_, err = msgServer.Register(ctx, &types.MsgRegister{
+			Sender:       workerAddr.String(),
+			LibP2PKey:    "oldKey",
+			MultiAddress: "oldMultiAddress",
+			TopicId:      topicId,
+			IsReputer:    false,
+			Owner:        workerAddr.String(),
+		})
+		require.NoError(err)
+	}
+
+	updateMsg := &types.MsgUpdateNodeInfo{
+		Sender:       workerAddr.String(),
+		LibP2PKey:    "newKey",
+		MultiAddress: "newMultiAddress",
+		Owner:        newOwnerAddr.String(),
+		IsReputer:    false,
+	}
+	_, err = msgServer.UpdateNodeInfo(ctx, updateMsg)
+	require.NoError(err, "Updating node info should not return an error")
+
+	_, err = s.emissionsKeeper.GetWorkerByLibp2pKey(ctx, "oldKey")
+	require.Error(err, "Previous libp2p key should no longer map to the worker")
+
+	node, err := s.emissionsKeeper.GetWorkerByLibp2pKey(ctx, "newKey")
+	require.NoError(err)
+	require.Equal(workerAddr.String(), node.NodeAddress)
+	require.Equal("newMultiAddress", node.MultiAddress)
+	require.Equal(newOwnerAddr.String(), node.Owner)
+
+	for _, topicId := range []uint64{1, 2} {
+		isRegistered, err := s.emissionsKeeper.IsWorkerRegisteredInTopic(ctx, topicId, workerAddr.String())
+		require.NoError(err)
+		require.True(isRegistered, "Worker should remain registered in topic")
+	}
+
+	events := ctx.EventManager().Events()
+	require.Equal("emissions.v1.EventNodeInfoUpdated", events[len(events)-1].Type)
+}
+
+func (s *MsgServerTestSuite) TestMsgUpdateNodeInfoNotRegistered() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	reputerAddr := sdk.AccAddress(PKS[0].Address())
+
+	_, err := msgServer.UpdateNodeInfo(ctx, &types.MsgUpdateNodeInfo{
+		Sender:       reputerAddr.String(),
+		LibP2PKey:    "newKey",
+		MultiAddress: "newMultiAddress",
+		Owner:        reputerAddr.String(),
+		IsReputer:    true,
+	})
+	require.ErrorIs(err, types.ErrAddressNotRegisteredInAnyTopic)
+}
+
+func (s *MsgServerTestSuite) TestMsgUpdateNodeInfoKeyInUse() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	reputerAddr := sdk.AccAddress(PKS[0].Address())
+	otherReputerAddr := sdk.AccAddress(PKS[2].Address())
+	topicId := uint64(1)
+
+	err := s.emissionsKeeper.InsertReputer(ctx, topicId, reputerAddr.String(), types.OffchainNode{
+		NodeAddress: reputerAddr.String(), LibP2PKey: "reputerKey", MultiAddress: "addr", Owner: reputerAddr.String(),
+	})
+	require.NoError(err)
+	err = s.emissionsKeeper.InsertReputer(ctx, topicId, otherReputerAddr.String(), types.OffchainNode{
+		NodeAddress: otherReputerAddr.String(), LibP2PKey: "otherKey", MultiAddress: "addr", Owner: otherReputerAddr.String(),
+	})
+	require.NoError(err)
+
+	_, err = msgServer.UpdateNodeInfo(ctx, &types.MsgUpdateNodeInfo{
+		Sender:       reputerAddr.String(),
+		LibP2PKey:    "otherKey",
+		MultiAddress: "newMultiAddress",
+		Owner:        reputerAddr.String(),
+		IsReputer:    true,
+	})
+	require.ErrorIs(err, types.ErrLibP2PKeyAlreadyInUse)
+}
+
+func (s *MsgServerTestSuite) TestMsgRegisterMulti() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerAddr := sdk.AccAddress(PKS[0].Address())
+	creatorAddress := sdk.AccAddress(PKS[1].Address())
+
+	moduleParams, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+	moduleParams.RegistrationFee = cosmosMath.NewInt(10)
+	moduleParams.MultiRegistrationFeeDiscount = alloraMath.MustNewDecFromString("0.5")
+	err = s.emissionsKeeper.SetParams(ctx, moduleParams)
+	require.NoError(err)
+
+	for _, topicId := range []uint64{1, 2, 3, 4} {
+		s.emissionsKeeper.SetTopic(ctx, topicId, types.Topic{Id: topicId, Creator: creatorAddress.String()})
+		s.emissionsKeeper.ActivateTopic(ctx, topicId)
+	}
+	err = s.emissionsKeeper.InsertWorker(ctx, 4, workerAddr.String(), types.OffchainNode{
+		NodeAddress: workerAddr.String(), LibP2PKey: "test", MultiAddress: "test", Owner: workerAddr.String(),
+	})
+	require.NoError(err)
+
+	// 10 for the first topic and 5 for each of the other two
+	s.MintTokensToAddress(workerAddr, cosmosMath.NewInt(20))
+
+	_, err = msgServer.RegisterMulti(ctx, &types.MsgRegisterMulti{
+		Sender:         workerAddr.String(),
+		LibP2PKey:      "test",
+		MultiAddress:   "test",
+		TopicIds:       []uint64{1, 2, 3},
+		RemoveTopicIds: []uint64{4},
+		Owner:          workerAddr.String(),
+		IsReputer:      false,
+	})
+	require.NoError(err, "Registering in many topics should not return an error")
+
+	for _, topicId := range []uint64{1, 2, 3} {
+		isRegistered, err := s.emissionsKeeper.IsWorkerRegisteredInTopic(ctx, topicId, workerAddr.String())
+		require.NoError(err)
+		require.True(isRegistered, "Worker should be registered in topic")
+	}
+	isRegistered, err := s.emissionsKeeper.IsWorkerRegisteredInTopic(ctx, 4, workerAddr.String())
+	require.NoError(err)
+	require.False(isRegistered, "Worker should have been removed from topic")
+
+	balance := s.bankKeeper.GetBalance(ctx, workerAddr, params.DefaultBondDenom)
+	require.True(balance.Amount.IsZero(), "Discounted fee should have been charged")
+}
+
+func (s *MsgServerTestSuite) TestMsgRegisterMultiIsAtomic() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	reputerAddr := sdk.AccAddress(PKS[0].Address())
+	creatorAddress := sdk.AccAddress(PKS[1].Address())
+	s.emissionsKeeper.SetTopic(ctx, 1, types.Topic{Id: 1, Creator: creatorAddress.String()})
+	s.MintTokensToAddress(reputerAddr, cosmosMath.NewInt(100))
+
+	_, err := msgServer.RegisterMulti(ctx, &types.MsgRegisterMulti{
+		Sender:       reputerAddr.String(),
+		LibP2PKey:    "test",
+		MultiAddress: "test",
+		TopicIds:     []uint64{1, 2},
+		Owner:        reputerAddr.String(),
+		IsReputer:    true,
+	})
+	require.ErrorIs(err, types.ErrTopicDoesNotExist)
+
+	isRegistered, err := s.emissionsKeeper.IsReputerRegisteredInTopic(ctx, 1, reputerAddr.String())
+	require.NoError(err)
+	require.False(isRegistered, "Reputer should not be registered in any topic")
+
+	balance := s.bankKeeper.GetBalance(ctx, reputerAddr, params.DefaultBondDenom)
+	require.Equal(cosmosMath.NewInt(100), balance.Amount, "No fee should have been charged")
+
+	_, err = msgServer.RegisterMulti(ctx, &types.MsgRegisterMulti{
+		Sender:       reputerAddr.String(),
+		LibP2PKey:    "test",
+		MultiAddress: "test",
+		TopicIds:     []uint64{1, 1},
+		Owner:        reputerAddr.String(),
+		IsReputer:    true,
+	})
+	require.ErrorIs(err, types.ErrDuplicateTopicId)
+}
+
+func (s *MsgServerTestSuite) TestCalcMultiRegistrationFee() {
+	require := s.Require()
+
+	fee, err := types.CalcMultiRegistrationFee(cosmosMath.NewInt(10), alloraMath.ZeroDec(), 3)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(30), fee, "No discount charges the flat fee per topic")
+
+	fee, err = types.CalcMultiRegistrationFee(cosmosMath.NewInt(10), alloraMath.MustNewDecFromString("0.25"), 5)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(40), fee)
+
+	fee, err = types.CalcMultiRegistrationFee(cosmosMath.NewInt(10), alloraMath.OneDec(), 5)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(10), fee, "Full discount only charges the first topic")
+}
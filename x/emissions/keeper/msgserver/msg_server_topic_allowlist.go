@@ -0,0 +1,81 @@
+package msgserver
+
+import (
+	"context"
+
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// Change which roles of a topic are restricted to allowlisted actors. Only the topic creator may do this.
+func (ms msgServer) UpdateTopicAllowlistMode(ctx context.Context, msg *types.MsgUpdateTopicAllowlistMode) (*types.MsgUpdateTopicAllowlistModeResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+	topic, err := ms.getTopicOwnedBySender(ctx, msg.TopicId, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	topic.AllowlistMode = msg.AllowlistMode
+	if err := ms.k.SetTopic(ctx, topic.Id, topic); err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdateTopicAllowlistModeResponse{}, nil
+}
+
+func (ms msgServer) AddToTopicAllowlist(ctx context.Context, msg *types.MsgAddToTopicAllowlist) (*types.MsgAddToTopicAllowlistResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+	if _, err := ms.getTopicOwnedBySender(ctx, msg.TopicId, msg.Sender); err != nil {
+		return nil, err
+	}
+	var err error
+	if msg.IsReputer {
+		err = ms.k.AddToTopicReputerAllowlist(ctx, msg.TopicId, msg.Address)
+	} else {
+		err = ms.k.AddToTopicWorkerAllowlist(ctx, msg.TopicId, msg.Address)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgAddToTopicAllowlistResponse{}, nil
+}
+
+// Removing an address from an allowlist does not unregister it, but it will no longer
+// be able to submit payloads to the topic while the role is restricted.
+func (ms msgServer) RemoveFromTopicAllowlist(ctx context.Context, msg *types.MsgRemoveFromTopicAllowlist) (*types.MsgRemoveFromTopicAllowlistResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+	if _, err := ms.getTopicOwnedBySender(ctx, msg.TopicId, msg.Sender); err != nil {
+		return nil, err
+	}
+	var err error
+	if msg.IsReputer {
+		err = ms.k.RemoveFromTopicReputerAllowlist(ctx, msg.TopicId, msg.Address)
+	} else {
+		err = ms.k.RemoveFromTopicWorkerAllowlist(ctx, msg.TopicId, msg.Address)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgRemoveFromTopicAllowlistResponse{}, nil
+}
+
+func (ms msgServer) getTopicOwnedBySender(ctx context.Context, topicId uint64, sender string) (types.Topic, error) {
+	topicExists, err := ms.k.TopicExists(ctx, topicId)
+	if err != nil {
+		return types.Topic{}, err
+	}
+	if !topicExists {
+		return types.Topic{}, types.ErrTopicDoesNotExist
+	}
+	topic, err := ms.k.GetTopic(ctx, topicId)
+	if err != nil {
+		return types.Topic{}, err
+	}
+	if topic.Creator != sender {
+		return types.Topic{}, types.ErrNotTopicCreator
+	}
+	return topic, nil
+}
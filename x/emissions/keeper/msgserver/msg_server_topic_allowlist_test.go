@@ -0,0 +1,175 @@
+package msgserver_test
+
+import (
+	"github.com/allora-network/allora-chain/app/params"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *MsgServerTestSuite) TestAddToTopicAllowlist() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	topicId := s.CreateOneTopic()
+	creatorAddr := sdk.AccAddress(PKS[0].Address()).String()
+	workerAddr := sdk.AccAddress(PKS[1].Address()).String()
+
+	isAllowlisted, err := s.emissionsKeeper.IsInTopicWorkerAllowlist(ctx, topicId, workerAddr)
+	require.NoError(err)
+	require.False(isAllowlisted, "Worker should not be in topic allowlist")
+
+	_, err = msgServer.AddToTopicAllowlist(ctx, &types.MsgAddToTopicAllowlist{
+		Sender:    creatorAddr,
+		TopicId:   topicId,
+		Address:   workerAddr,
+		IsReputer: false,
+	})
+	require.NoError(err, "Topic creator should be able to add to the allowlist")
+
+	isAllowlisted, err = s.emissionsKeeper.IsInTopicWorkerAllowlist(ctx, topicId, workerAddr)
+	require.NoError(err)
+	require.True(isAllowlisted, "Worker should be in topic allowlist")
+
+	// Being on the worker allowlist says nothing about the reputer allowlist
+	isAllowlisted, err = s.emissionsKeeper.IsInTopicReputerAllowlist(ctx, topicId, workerAddr)
+	require.NoError(err)
+	require.False(isAllowlisted, "Worker should not be in topic reputer allowlist")
+}
+
+func (s *MsgServerTestSuite) TestAddToTopicAllowlistInvalidNotTopicCreator() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	topicId := s.CreateOneTopic()
+	notCreatorAddr := sdk.AccAddress(PKS[1].Address()).String()
+
+	_, err := msgServer.AddToTopicAllowlist(ctx, &types.MsgAddToTopicAllowlist{
+		Sender:    notCreatorAddr,
+		TopicId:   topicId,
+		Address:   notCreatorAddr,
+		IsReputer: true,
+	})
+	require.ErrorIs(err, types.ErrNotTopicCreator)
+
+	_, err = msgServer.UpdateTopicAllowlistMode(ctx, &types.MsgUpdateTopicAllowlistMode{
+		Sender:        notCreatorAddr,
+		TopicId:       topicId,
+		AllowlistMode: types.TopicAllowlistMode_ALLOWLIST_MODE_WORKERS_AND_REPUTERS,
+	})
+	require.ErrorIs(err, types.ErrNotTopicCreator)
+}
+
+func (s *MsgServerTestSuite) TestAddToTopicAllowlistInvalidTopicNotExist() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	creatorAddr := sdk.AccAddress(PKS[0].Address()).String()
+
+	_, err := msgServer.AddToTopicAllowlist(ctx, &types.MsgAddToTopicAllowlist{
+		Sender:  creatorAddr,
+		TopicId: 100,
+		Address: creatorAddr,
+	})
+	require.ErrorIs(err, types.ErrTopicDoesNotExist)
+}
+
+func (s *MsgServerTestSuite) TestRemoveFromTopicAllowlist() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	topicId := s.CreateOneTopic()
+	creatorAddr := sdk.AccAddress(PKS[0].Address()).String()
+	reputerAddr := sdk.AccAddress(PKS[1].Address()).String()
+
+	err := s.emissionsKeeper.AddToTopicReputerAllowlist(ctx, topicId, reputerAddr)
+	require.NoError(err)
+
+	_, err = msgServer.RemoveFromTopicAllowlist(ctx, &types.MsgRemoveFromTopicAllowlist{
+		Sender:    creatorAddr,
+		TopicId:   topicId,
+		Address:   reputerAddr,
+		IsReputer: true,
+	})
+	require.NoError(err, "Topic creator should be able to remove from the allowlist")
+
+	isAllowlisted, err := s.emissionsKeeper.IsInTopicReputerAllowlist(ctx, topicId, reputerAddr)
+	require.NoError(err)
+	require.False(isAllowlisted, "Reputer should no longer be in topic allowlist")
+}
+
+func (s *MsgServerTestSuite) TestUpdateTopicAllowlistModeInvalidMode() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	topicId := s.CreateOneTopic()
+	creatorAddr := sdk.AccAddress(PKS[0].Address()).String()
+
+	_, err := msgServer.UpdateTopicAllowlistMode(ctx, &types.MsgUpdateTopicAllowlistMode{
+		Sender:        creatorAddr,
+		TopicId:       topicId,
+		AllowlistMode: types.TopicAllowlistMode(42),
+	})
+	require.ErrorIs(err, types.ErrInvalidTopicAllowlistMode)
+}
+
+func (s *MsgServerTestSuite) TestRegisterRespectsTopicAllowlistMode() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	topicId := s.CreateOneTopic()
+	creatorAddr := sdk.AccAddress(PKS[0].Address()).String()
+	workerAddr := sdk.AccAddress(PKS[1].Address())
+	reputerAddr := sdk.AccAddress(PKS[2].Address())
+
+	moduleParams, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+	s.MintTokensToAddress(workerAddr, moduleParams.RegistrationFee.MulRaw(2))
+	s.MintTokensToAddress(reputerAddr, moduleParams.RegistrationFee.MulRaw(2))
+
+	_, err = msgServer.UpdateTopicAllowlistMode(ctx, &types.MsgUpdateTopicAllowlistMode{
+		Sender:        creatorAddr,
+		TopicId:       topicId,
+		AllowlistMode: types.TopicAllowlistMode_ALLOWLIST_MODE_WORKERS,
+	})
+	require.NoError(err)
+
+	workerRegMsg := &types.MsgRegister{
+		Sender:       workerAddr.String(),
+		LibP2PKey:    "worker-key",
+		MultiAddress: "worker-multiaddress",
+		TopicId:      topicId,
+		Owner:        workerAddr.String(),
+		IsReputer:    false,
+	}
+	_, err = msgServer.Register(ctx, workerRegMsg)
+	require.ErrorIs(err, types.ErrNotInTopicWorkerAllowlist)
+
+	// Failed registrations must not charge the registration fee
+	balance := s.bankKeeper.GetBalance(ctx, workerAddr, params.DefaultBondDenom)
+	require.Equal(moduleParams.RegistrationFee.MulRaw(2), balance.Amount)
+
+	// Reputers are unrestricted in worker-only mode
+	_, err = msgServer.Register(ctx, &types.MsgRegister{
+		Sender:       reputerAddr.String(),
+		LibP2PKey:    "reputer-key",
+		MultiAddress: "reputer-multiaddress",
+		TopicId:      topicId,
+		Owner:        reputerAddr.String(),
+		IsReputer:    true,
+	})
+	require.NoError(err)
+
+	_, err = msgServer.AddToTopicAllowlist(ctx, &types.MsgAddToTopicAllowlist{
+		Sender:  creatorAddr,
+		TopicId: topicId,
+		Address: workerAddr.String(),
+	})
+	require.NoError(err)
+
+	_, err = msgServer.Register(ctx, workerRegMsg)
+	require.NoError(err)
+
+	isRegistered, err := s.emissionsKeeper.IsWorkerRegisteredInTopic(ctx, topicId, workerAddr.String())
+	require.NoError(err)
+	require.True(isRegistered, "Allowlisted worker should be registered in topic")
+}
@@ -0,0 +1,25 @@
+package msgserver
+
+import (
+	"context"
+
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// Change after how many consecutive epochs without a submission workers and reputers are
+// deregistered from a topic. Only the topic creator may do this.
+func (ms msgServer) UpdateTopicInactivityThresholds(ctx context.Context, msg *types.MsgUpdateTopicInactivityThresholds) (*types.MsgUpdateTopicInactivityThresholdsResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+	topic, err := ms.getTopicOwnedBySender(ctx, msg.TopicId, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	topic.WorkerInactivityThreshold = msg.WorkerInactivityThreshold
+	topic.ReputerInactivityThreshold = msg.ReputerInactivityThreshold
+	if err := ms.k.SetTopic(ctx, topic.Id, topic); err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdateTopicInactivityThresholdsResponse{}, nil
+}
@@ -0,0 +1,72 @@
+package msgserver_test
+
+import (
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *MsgServerTestSuite) TestUpdateTopicInactivityThresholds() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	topicId := s.CreateOneTopic()
+	creatorAddr := sdk.AccAddress(PKS[0].Address()).String()
+
+	_, err := msgServer.UpdateTopicInactivityThresholds(ctx, &types.MsgUpdateTopicInactivityThresholds{
+		Sender:                     creatorAddr,
+		TopicId:                    topicId,
+		WorkerInactivityThreshold:  5,
+		ReputerInactivityThreshold: 7,
+	})
+	require.NoError(err, "Topic creator should be able to update the inactivity thresholds")
+
+	topic, err := s.emissionsKeeper.GetTopic(ctx, topicId)
+	require.NoError(err)
+	require.Equal(uint64(5), topic.WorkerInactivityThreshold)
+	require.Equal(uint64(7), topic.ReputerInactivityThreshold)
+}
+
+func (s *MsgServerTestSuite) TestUpdateTopicInactivityThresholdsInvalidNotTopicCreator() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	topicId := s.CreateOneTopic()
+	notCreatorAddr := sdk.AccAddress(PKS[1].Address()).String()
+
+	_, err := msgServer.UpdateTopicInactivityThresholds(ctx, &types.MsgUpdateTopicInactivityThresholds{
+		Sender:                    notCreatorAddr,
+		TopicId:                   topicId,
+		WorkerInactivityThreshold: 1,
+	})
+	require.ErrorIs(err, types.ErrNotTopicCreator)
+}
+
+func (s *MsgServerTestSuite) TestMsgInsertBulkWorkerPayloadRecordsWorkerActivity() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg, topicId := s.setUpMsgInsertBulkWorkerPayload(workerPrivateKey)
+	workerMsg = s.signMsgInsertBulkWorkerPayload(workerMsg, workerPrivateKey)
+
+	submissionCtx := ctx.WithBlockHeight(ctx.BlockHeight() + 50)
+	_, err := msgServer.InsertBulkWorkerPayload(submissionCtx, &workerMsg)
+	require.NoError(err, "InsertBulkWorkerPayload should not return an error")
+
+	topic := types.Topic{Id: topicId, EpochLength: 10, WorkerInactivityThreshold: 3}
+	workersInactivity, err := s.emissionsKeeper.GetTopicWorkersInactivity(ctx, topic, submissionCtx.BlockHeight())
+	require.NoError(err)
+
+	inferer := workerMsg.WorkerDataBundles[0].InferenceForecastsBundle.Inference.Inferer
+	forecaster := workerMsg.WorkerDataBundles[0].InferenceForecastsBundle.Forecast.Forecaster
+	checked := 0
+	for _, worker := range workersInactivity {
+		if worker.Address == inferer || worker.Address == forecaster {
+			checked++
+			require.Equal(submissionCtx.BlockHeight(), worker.LastActiveHeight, "Submitting should count as activity")
+			require.Equal(uint64(3), worker.EpochsUntilRemoval)
+		}
+	}
+	require.Equal(2, checked)
+}
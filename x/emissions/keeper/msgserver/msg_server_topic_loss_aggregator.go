@@ -0,0 +1,27 @@
+package msgserver
+
+import (
+	"context"
+
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// Change how the losses reported by the reputers of a topic are aggregated into consensus losses.
+// Only the topic creator may do this.
+func (ms msgServer) UpdateTopicLossAggregator(ctx context.Context, msg *types.MsgUpdateTopicLossAggregator) (*types.MsgUpdateTopicLossAggregatorResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+	topic, err := ms.getTopicOwnedBySender(ctx, msg.TopicId, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	topic.LossAggregator = msg.LossAggregator
+	topic.LossTrimFraction = msg.LossTrimFraction
+	topic.HuberLossTuningConstant = msg.HuberLossTuningConstant
+	topic.HuberLossMaxIterations = msg.HuberLossMaxIterations
+	if err := ms.k.SetTopic(ctx, topic.Id, topic); err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdateTopicLossAggregatorResponse{}, nil
+}
@@ -0,0 +1,60 @@
+package msgserver_test
+
+import (
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *MsgServerTestSuite) TestUpdateTopicLossAggregator() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	topicId := s.CreateOneTopic()
+	creatorAddr := sdk.AccAddress(PKS[0].Address()).String()
+
+	_, err := msgServer.UpdateTopicLossAggregator(ctx, &types.MsgUpdateTopicLossAggregator{
+		Sender:           creatorAddr,
+		TopicId:          topicId,
+		LossAggregator:   types.LossAggregatorType_LOSS_AGGREGATOR_TRIMMED_MEAN,
+		LossTrimFraction: alloraMath.MustNewDecFromString("0.5"),
+	})
+	require.ErrorIs(err, types.ErrInvalidLossAggregator)
+
+	// trimming nothing would silently be a plain mean
+	_, err = msgServer.UpdateTopicLossAggregator(ctx, &types.MsgUpdateTopicLossAggregator{
+		Sender:           creatorAddr,
+		TopicId:          topicId,
+		LossAggregator:   types.LossAggregatorType_LOSS_AGGREGATOR_TRIMMED_MEAN,
+		LossTrimFraction: alloraMath.ZeroDec(),
+	})
+	require.ErrorIs(err, types.ErrInvalidLossAggregator)
+
+	_, err = msgServer.UpdateTopicLossAggregator(ctx, &types.MsgUpdateTopicLossAggregator{
+		Sender:           creatorAddr,
+		TopicId:          topicId,
+		LossAggregator:   types.LossAggregatorType_LOSS_AGGREGATOR_TRIMMED_MEAN,
+		LossTrimFraction: alloraMath.MustNewDecFromString("0.25"),
+	})
+	require.NoError(err, "Topic creator should be able to update the loss aggregator")
+
+	topic, err := s.emissionsKeeper.GetTopic(ctx, topicId)
+	require.NoError(err)
+	require.Equal(types.LossAggregatorType_LOSS_AGGREGATOR_TRIMMED_MEAN, topic.LossAggregator)
+	require.True(alloraMath.MustNewDecFromString("0.25").Equal(topic.LossTrimFraction))
+}
+
+func (s *MsgServerTestSuite) TestUpdateTopicLossAggregatorInvalidNotTopicCreator() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	topicId := s.CreateOneTopic()
+	notCreatorAddr := sdk.AccAddress(PKS[1].Address()).String()
+
+	_, err := msgServer.UpdateTopicLossAggregator(ctx, &types.MsgUpdateTopicLossAggregator{
+		Sender:         notCreatorAddr,
+		TopicId:        topicId,
+		LossAggregator: types.LossAggregatorType_LOSS_AGGREGATOR_STAKE_WEIGHTED_MEDIAN,
+	})
+	require.ErrorIs(err, types.ErrNotTopicCreator)
+}
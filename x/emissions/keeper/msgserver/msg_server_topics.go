@@ -17,6 +17,20 @@ func (ms msgServer) CreateNewTopic(ctx context.Context, msg *types.MsgCreateNewT
 		return nil, err
 	}
 
+	params, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if params.PermissionedTopicCreation {
+		isTopicCreator, err := ms.k.HasRole(ctx, msg.Creator, types.Role_TOPIC_CREATOR)
+		if err != nil {
+			return nil, err
+		}
+		if !isTopicCreator {
+			return nil, types.ErrNotPermittedToCreateTopic
+		}
+	}
+
 	hasEnoughBal, fee, err := checkAddressHasBalanceForTopicCreationFee(ctx, ms, msg.Creator)
 	if err != nil {
 		return nil, err
@@ -30,13 +44,15 @@ func (ms msgServer) CreateNewTopic(ctx context.Context, msg *types.MsgCreateNewT
 		return nil, err
 	}
 
-	params, err := ms.k.GetParams(ctx)
-	if err != nil {
-		return nil, err
-	}
 	if msg.EpochLength < params.MinEpochLength {
 		return nil, types.ErrTopicCadenceBelowMinimum
 	}
+	if msg.OutputDimension > 1 && msg.OutputDimension > params.MaxTopicOutputDimension {
+		return nil, errors.Wrapf(types.ErrInvalidOutputDimension, "output dimension %d above max %d", msg.OutputDimension, params.MaxTopicOutputDimension)
+	}
+	if len(msg.Horizons) > 1 && uint64(len(msg.Horizons)) > params.MaxTopicHorizons {
+		return nil, errors.Wrapf(types.ErrInvalidHorizon, "%d horizons above max %d", len(msg.Horizons), params.MaxTopicHorizons)
+	}
 
 	// Before creating topic, transfer fee amount from creator to ecosystem bucket
 	err = ms.k.SendCoinsFromAccountToModule(ctx, msg.Creator, mintTypes.EcosystemModuleName, sdk.NewCoins(fee))
@@ -61,6 +77,21 @@ func (ms msgServer) CreateNewTopic(ctx context.Context, msg *types.MsgCreateNewT
 		AllowNegative:   msg.AllowNegative,
 		Epsilon:         msg.Epsilon,
 		InitialRegret:   alloraMath.ZeroDec(),
+		AllowlistMode:   msg.AllowlistMode,
+
+		WorkerInactivityThreshold:  msg.WorkerInactivityThreshold,
+		ReputerInactivityThreshold: msg.ReputerInactivityThreshold,
+		Combiner:                   msg.Combiner,
+		OutputDimension:            msg.OutputDimension,
+		OutputType:                 msg.OutputType,
+		DistributionPooling:        msg.DistributionPooling,
+		Horizons:                   msg.Horizons,
+		HorizonWeights:             msg.HorizonWeights,
+		LossAggregator:             msg.LossAggregator,
+		LossTrimFraction:           msg.LossTrimFraction,
+		HuberLossTuningConstant:    msg.HuberLossTuningConstant,
+		HuberLossMaxIterations:     msg.HuberLossMaxIterations,
+		TrimmedMeanTrimFraction:    msg.TrimmedMeanTrimFraction,
 	}
 	_, err = ms.k.IncrementTopicId(ctx)
 	if err != nil {
@@ -4,8 +4,10 @@ import (
 	"strings"
 
 	alloraMath "github.com/allora-network/allora-chain/math"
+	alloratestutil "github.com/allora-network/allora-chain/test/testutil"
 	"github.com/allora-network/allora-chain/x/emissions/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 /// Topics tests
@@ -102,3 +104,297 @@ func (s *MsgServerTestSuite) TestUpdateTopicEpochLastEnded() {
 	s.Require().NotNil(topic)
 	s.Require().Equal(topic.EpochLastEnded, inferenceTs)
 }
+
+func (s *MsgServerTestSuite) TestCreateTopicWithPermissionedTopicCreation() {
+	ctx := s.ctx
+	require := s.Require()
+
+	moduleParams, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+	moduleParams.PermissionedTopicCreation = true
+	err = s.emissionsKeeper.SetParams(ctx, moduleParams)
+	require.NoError(err)
+
+	creator := sdk.AccAddress(PKS[0].Address())
+	newTopicMsg := &types.MsgCreateNewTopic{
+		Creator:         creator.String(),
+		Metadata:        "Some metadata for the new topic",
+		LossLogic:       "logic",
+		LossMethod:      "method",
+		EpochLength:     10800,
+		GroundTruthLag:  10800,
+		InferenceLogic:  "Ilogic",
+		InferenceMethod: "Imethod",
+		DefaultArg:      "ETH",
+		AlphaRegret:     alloraMath.NewDecFromInt64(1),
+		PNorm:           alloraMath.NewDecFromInt64(3),
+		Epsilon:         alloraMath.MustNewDecFromString("0.01"),
+	}
+	s.MintTokensToAddress(creator, moduleParams.CreateTopicFee)
+
+	err = s.emissionsKeeper.RevokeRole(ctx, creator.String(), types.Role_TOPIC_CREATOR)
+	require.NoError(err)
+
+	_, err = s.msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.ErrorIs(err, types.ErrNotPermittedToCreateTopic)
+
+	err = s.emissionsKeeper.GrantRole(ctx, creator.String(), types.Role_TOPIC_CREATOR)
+	require.NoError(err)
+
+	_, err = s.msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.NoError(err, "Topic creators should be able to create topics")
+}
+
+func (s *MsgServerTestSuite) TestCreateTopicWithCombiner() {
+	ctx := s.ctx
+	require := s.Require()
+
+	creator := sdk.AccAddress(PKS[0].Address())
+	newTopicMsg := &types.MsgCreateNewTopic{
+		Creator:         creator.String(),
+		Metadata:        "Some metadata for the new topic",
+		LossLogic:       "logic",
+		LossMethod:      "method",
+		EpochLength:     10800,
+		GroundTruthLag:  10800,
+		InferenceLogic:  "Ilogic",
+		InferenceMethod: "Imethod",
+		DefaultArg:      "ETH",
+		AlphaRegret:     alloraMath.NewDecFromInt64(1),
+		PNorm:           alloraMath.NewDecFromInt64(3),
+		Epsilon:         alloraMath.MustNewDecFromString("0.01"),
+		Combiner:        types.CombinerType(42),
+	}
+	moduleParams, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+	s.MintTokensToAddress(creator, moduleParams.CreateTopicFee)
+
+	_, err = s.msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.ErrorIs(err, types.ErrInvalidCombiner)
+
+	newTopicMsg.Combiner = types.CombinerType_COMBINER_WEIGHTED_MEDIAN
+	result, err := s.msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.NoError(err)
+
+	topic, err := s.emissionsKeeper.GetTopic(ctx, result.TopicId)
+	require.NoError(err)
+	require.Equal(types.CombinerType_COMBINER_WEIGHTED_MEDIAN, topic.Combiner)
+
+	newTopicMsg.Combiner = types.CombinerType_COMBINER_TRIMMED_MEAN
+	newTopicMsg.TrimmedMeanTrimFraction = alloraMath.MustNewDecFromString("0.5")
+	_, err = s.msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// trimming nothing would silently be a plain mean
+	newTopicMsg.TrimmedMeanTrimFraction = alloraMath.ZeroDec()
+	_, err = s.msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	newTopicMsg.TrimmedMeanTrimFraction = alloraMath.MustNewDecFromString("0.25")
+	s.MintTokensToAddress(creator, moduleParams.CreateTopicFee)
+	result, err = s.msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.NoError(err)
+
+	topic, err = s.emissionsKeeper.GetTopic(ctx, result.TopicId)
+	require.NoError(err)
+	require.Equal(types.CombinerType_COMBINER_TRIMMED_MEAN, topic.Combiner)
+	require.True(alloraMath.MustNewDecFromString("0.25").Equal(topic.TrimmedMeanTrimFraction))
+}
+
+func (s *MsgServerTestSuite) TestCreateTopicWithLossAggregator() {
+	ctx := s.ctx
+	require := s.Require()
+
+	creator := sdk.AccAddress(PKS[0].Address())
+	newTopicMsg := &types.MsgCreateNewTopic{
+		Creator:         creator.String(),
+		Metadata:        "Some metadata for the new topic",
+		LossLogic:       "logic",
+		LossMethod:      "method",
+		EpochLength:     10800,
+		GroundTruthLag:  10800,
+		InferenceLogic:  "Ilogic",
+		InferenceMethod: "Imethod",
+		DefaultArg:      "ETH",
+		AlphaRegret:     alloraMath.NewDecFromInt64(1),
+		PNorm:           alloraMath.NewDecFromInt64(3),
+		Epsilon:         alloraMath.MustNewDecFromString("0.01"),
+		LossAggregator:  types.LossAggregatorType(42),
+	}
+	moduleParams, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+	s.MintTokensToAddress(creator, moduleParams.CreateTopicFee)
+
+	_, err = s.msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.ErrorIs(err, types.ErrInvalidLossAggregator)
+
+	// The Huber estimator needs to be tuned
+	newTopicMsg.LossAggregator = types.LossAggregatorType_LOSS_AGGREGATOR_HUBER
+	_, err = s.msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.ErrorIs(err, types.ErrInvalidLossAggregator)
+
+	newTopicMsg.HuberLossTuningConstant = alloraMath.MustNewDecFromString("1.345")
+	// The iterations run in the end blocker, so they are bounded
+	newTopicMsg.HuberLossMaxIterations = types.MaxHuberLossMaxIterations + 1
+	_, err = s.msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.ErrorIs(err, types.ErrInvalidLossAggregator)
+
+	newTopicMsg.HuberLossMaxIterations = 20
+	result, err := s.msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.NoError(err)
+
+	topic, err := s.emissionsKeeper.GetTopic(ctx, result.TopicId)
+	require.NoError(err)
+	require.Equal(types.LossAggregatorType_LOSS_AGGREGATOR_HUBER, topic.LossAggregator)
+	require.True(alloraMath.MustNewDecFromString("1.345").Equal(topic.HuberLossTuningConstant))
+	require.Equal(uint64(20), topic.HuberLossMaxIterations)
+}
+
+func (s *MsgServerTestSuite) TestCreateTopicWithOutputDimension() {
+	ctx := s.ctx
+	require := s.Require()
+
+	creator := sdk.AccAddress(PKS[0].Address())
+	newTopicMsg := &types.MsgCreateNewTopic{
+		Creator:         creator.String(),
+		Metadata:        "Some metadata for the new topic",
+		LossLogic:       "logic",
+		LossMethod:      "method",
+		EpochLength:     10800,
+		GroundTruthLag:  10800,
+		InferenceLogic:  "Ilogic",
+		InferenceMethod: "Imethod",
+		DefaultArg:      "ETH",
+		AlphaRegret:     alloraMath.NewDecFromInt64(1),
+		PNorm:           alloraMath.NewDecFromInt64(3),
+		Epsilon:         alloraMath.MustNewDecFromString("0.01"),
+	}
+	moduleParams, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+	moduleParams.MaxTopicOutputDimension = 24
+	err = s.emissionsKeeper.SetParams(ctx, moduleParams)
+	require.NoError(err)
+	s.MintTokensToAddress(creator, moduleParams.CreateTopicFee.MulRaw(2))
+
+	newTopicMsg.OutputDimension = 25
+	_, err = s.msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.ErrorIs(err, types.ErrInvalidOutputDimension)
+
+	newTopicMsg.OutputDimension = 24
+	result, err := s.msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.NoError(err)
+	topic, err := s.emissionsKeeper.GetTopic(ctx, result.TopicId)
+	require.NoError(err)
+	require.True(topic.IsVectorValued())
+	require.Equal(uint64(24), topic.OutputDimension)
+
+	// Scalar topics remain allowed when vector-valued topics are disabled
+	moduleParams.MaxTopicOutputDimension = 0
+	err = s.emissionsKeeper.SetParams(ctx, moduleParams)
+	require.NoError(err)
+	newTopicMsg.OutputDimension = 1
+	_, err = s.msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.NoError(err)
+}
+
+func (s *MsgServerTestSuite) TestCreateProbabilityDistributionTopic() {
+	ctx := s.ctx
+	require := s.Require()
+
+	creator := sdk.AccAddress(PKS[0].Address())
+	newTopicMsg := &types.MsgCreateNewTopic{
+		Creator:             creator.String(),
+		Metadata:            "Some metadata for the new topic",
+		LossLogic:           "logic",
+		LossMethod:          "method",
+		EpochLength:         10800,
+		GroundTruthLag:      10800,
+		InferenceLogic:      "Ilogic",
+		InferenceMethod:     "Imethod",
+		DefaultArg:          "ETH",
+		AlphaRegret:         alloraMath.NewDecFromInt64(1),
+		PNorm:               alloraMath.NewDecFromInt64(3),
+		Epsilon:             alloraMath.MustNewDecFromString("0.01"),
+		OutputType:          types.TopicOutputType_OUTPUT_TYPE_PROBABILITY_DISTRIBUTION,
+		DistributionPooling: types.DistributionPooling_POOLING_LOGARITHMIC,
+		OutputDimension:     1,
+	}
+	moduleParams, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+	s.MintTokensToAddress(creator, moduleParams.CreateTopicFee)
+
+	// A distribution needs at least two classes
+	_, err = s.msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.ErrorIs(err, types.ErrInvalidOutputDimension)
+
+	newTopicMsg.DistributionPooling = types.DistributionPooling(7)
+	newTopicMsg.OutputDimension = 3
+	_, err = s.msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.ErrorIs(err, types.ErrInvalidTopicOutputType)
+
+	newTopicMsg.DistributionPooling = types.DistributionPooling_POOLING_LOGARITHMIC
+	result, err := s.msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.NoError(err)
+	topic, err := s.emissionsKeeper.GetTopic(ctx, result.TopicId)
+	require.NoError(err)
+	require.True(topic.IsProbabilityDistribution())
+	require.Equal(types.DistributionPooling_POOLING_LOGARITHMIC, topic.DistributionPooling)
+}
+
+func (s *MsgServerTestSuite) TestCreateTopicWithHorizons() {
+	ctx := s.ctx
+	require := s.Require()
+
+	creator := sdk.AccAddress(PKS[0].Address())
+	newTopicMsg := &types.MsgCreateNewTopic{
+		Creator:         creator.String(),
+		Metadata:        "Some metadata for the new topic",
+		LossLogic:       "logic",
+		LossMethod:      "method",
+		EpochLength:     100,
+		GroundTruthLag:  100,
+		InferenceLogic:  "Ilogic",
+		InferenceMethod: "Imethod",
+		DefaultArg:      "ETH",
+		AlphaRegret:     alloraMath.NewDecFromInt64(1),
+		PNorm:           alloraMath.NewDecFromInt64(3),
+		Epsilon:         alloraMath.MustNewDecFromString("0.01"),
+	}
+	moduleParams, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+	moduleParams.MaxTopicHorizons = 3
+	err = s.emissionsKeeper.SetParams(ctx, moduleParams)
+	require.NoError(err)
+	s.MintTokensToAddress(creator, moduleParams.CreateTopicFee)
+
+	// The first horizon must be the ground truth lag
+	newTopicMsg.Horizons = []int64{200, 400}
+	_, err = s.msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.ErrorIs(err, types.ErrInvalidHorizon)
+
+	newTopicMsg.Horizons = []int64{100, 400, 300}
+	_, err = s.msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.ErrorIs(err, types.ErrInvalidHorizon)
+
+	newTopicMsg.Horizons = []int64{100, 200, 300, 400}
+	_, err = s.msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.ErrorIs(err, types.ErrInvalidHorizon)
+
+	newTopicMsg.Horizons = []int64{100, 300}
+	newTopicMsg.HorizonWeights = []alloraMath.Dec{alloraMath.OneDec()}
+	_, err = s.msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.ErrorIs(err, types.ErrInvalidHorizon)
+
+	newTopicMsg.HorizonWeights = []alloraMath.Dec{alloraMath.NewDecFromInt64(3), alloraMath.NewDecFromInt64(1)}
+	result, err := s.msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.NoError(err)
+	topic, err := s.emissionsKeeper.GetTopic(ctx, result.TopicId)
+	require.NoError(err)
+	require.Equal(types.Horizon(2), topic.NumHorizons())
+	require.Equal(int64(300), topic.GetMaxHorizonLag())
+	weights, err := topic.GetHorizonWeights()
+	require.NoError(err)
+	require.Len(weights, 2)
+	alloratestutil.InEpsilon5(s.T(), weights[0], "0.75")
+	alloratestutil.InEpsilon5(s.T(), weights[1], "0.25")
+}
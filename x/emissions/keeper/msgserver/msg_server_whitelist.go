@@ -47,3 +47,41 @@ func (ms msgServer) RemoveFromWhitelistAdmin(ctx context.Context, msg *types.Msg
 	}
 	return &types.MsgRemoveFromWhitelistAdminResponse{}, nil
 }
+
+func (ms msgServer) GrantRole(ctx context.Context, msg *types.MsgGrantRole) (*types.MsgGrantRoleResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+	// Check that sender is a whitelist admin
+	isAdmin, err := ms.k.IsWhitelistAdmin(ctx, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin {
+		return nil, types.ErrNotWhitelistAdmin
+	}
+	err = ms.k.GrantRole(ctx, msg.Address, msg.Role)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgGrantRoleResponse{}, nil
+}
+
+func (ms msgServer) RevokeRole(ctx context.Context, msg *types.MsgRevokeRole) (*types.MsgRevokeRoleResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+	// Check that sender is a whitelist admin
+	isAdmin, err := ms.k.IsWhitelistAdmin(ctx, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin {
+		return nil, types.ErrNotWhitelistAdmin
+	}
+	err = ms.k.RevokeRole(ctx, msg.Address, msg.Role)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgRevokeRoleResponse{}, nil
+}
@@ -88,3 +88,102 @@ func (s *MsgServerTestSuite) TestRemoveWhitelistAdminInvalidUnauthorized() {
 	_, err := s.msgServer.RemoveFromWhitelistAdmin(ctx, msg)
 	require.ErrorIs(err, types.ErrNotWhitelistAdmin, "Should fail due to unauthorized access")
 }
+
+func (s *MsgServerTestSuite) TestGrantRole() {
+	ctx := s.ctx
+	require := s.Require()
+	msgServer := s.msgServer
+
+	adminAddr := sdk.AccAddress(PKS[0].Address()).String()
+	targetAddr := sdk.AccAddress(nonAdminAccounts[0]).String()
+
+	_, err := msgServer.GrantRole(ctx, &types.MsgGrantRole{
+		Sender:  adminAddr,
+		Address: targetAddr,
+		Role:    types.Role_PARAMS_ADMIN,
+	})
+	require.NoError(err, "Granting a role should succeed")
+
+	isParamsAdmin, err := s.emissionsKeeper.IsParamsAdmin(ctx, targetAddr)
+	require.NoError(err)
+	require.True(isParamsAdmin, "targetAddr should be a params admin")
+
+	isWhitelistAdmin, err := s.emissionsKeeper.IsWhitelistAdmin(ctx, targetAddr)
+	require.NoError(err)
+	require.False(isWhitelistAdmin, "targetAddr should only have been granted the params admin role")
+
+	_, err = msgServer.RevokeRole(ctx, &types.MsgRevokeRole{
+		Sender:  adminAddr,
+		Address: targetAddr,
+		Role:    types.Role_PARAMS_ADMIN,
+	})
+	require.NoError(err, "Revoking a role should succeed")
+
+	isParamsAdmin, err = s.emissionsKeeper.IsParamsAdmin(ctx, targetAddr)
+	require.NoError(err)
+	require.False(isParamsAdmin, "targetAddr should no longer be a params admin")
+}
+
+func (s *MsgServerTestSuite) TestGrantRoleInvalidUnauthorized() {
+	ctx := s.ctx
+	require := s.Require()
+
+	nonAdminAddr := nonAdminAccounts[0].String()
+
+	_, err := s.msgServer.GrantRole(ctx, &types.MsgGrantRole{
+		Sender:  nonAdminAddr,
+		Address: nonAdminAddr,
+		Role:    types.Role_WHITELIST_ADMIN,
+	})
+	require.ErrorIs(err, types.ErrNotWhitelistAdmin, "Should fail due to unauthorized access")
+
+	_, err = s.msgServer.RevokeRole(ctx, &types.MsgRevokeRole{
+		Sender:  nonAdminAddr,
+		Address: sdk.AccAddress(PKS[0].Address()).String(),
+		Role:    types.Role_WHITELIST_ADMIN,
+	})
+	require.ErrorIs(err, types.ErrNotWhitelistAdmin, "Should fail due to unauthorized access")
+}
+
+func (s *MsgServerTestSuite) TestGrantRoleInvalidRole() {
+	ctx := s.ctx
+	require := s.Require()
+
+	adminAddr := sdk.AccAddress(PKS[0].Address()).String()
+
+	_, err := s.msgServer.GrantRole(ctx, &types.MsgGrantRole{
+		Sender:  adminAddr,
+		Address: adminAddr,
+		Role:    types.Role_NO_ROLE,
+	})
+	require.ErrorIs(err, types.ErrInvalidRole)
+}
+
+func (s *MsgServerTestSuite) TestUpdateParamsRequiresParamsAdminRole() {
+	ctx := s.ctx
+	require := s.Require()
+
+	adminAddr := sdk.AccAddress(PKS[0].Address()).String()
+	updateMsg := &types.MsgUpdateParams{
+		Sender: adminAddr,
+		Params: &types.OptionalParams{
+			PermissionedTopicCreation: []bool{true},
+		},
+	}
+
+	err := s.emissionsKeeper.RevokeRole(ctx, adminAddr, types.Role_PARAMS_ADMIN)
+	require.NoError(err)
+
+	_, err = s.msgServer.UpdateParams(ctx, updateMsg)
+	require.ErrorIs(err, types.ErrNotParamsAdmin)
+
+	err = s.emissionsKeeper.GrantRole(ctx, adminAddr, types.Role_PARAMS_ADMIN)
+	require.NoError(err)
+
+	_, err = s.msgServer.UpdateParams(ctx, updateMsg)
+	require.NoError(err)
+
+	moduleParams, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+	require.True(moduleParams.PermissionedTopicCreation)
+}
@@ -4,25 +4,27 @@ import (
 	"context"
 	"sort"
 
+	synth "github.com/allora-network/allora-chain/x/emissions/keeper/inference_synthesis"
 	"github.com/allora-network/allora-chain/x/emissions/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// Output a new set of inferences where only 1 inference per registerd inferer is kept,
+// Output a new set of inferences where only 1 inference per registerd inferer and horizon is kept,
 // ignore the rest. In particular, take the first inference from each registered inferer
-// and none from any unregistered inferer.
+// for each horizon and none from any unregistered inferer.
 // Signatures, anti-synil procedures, and "skimming of only the top few workers by score
 // descending" should be done here.
 func verifyAndInsertInferencesFromTopInferers(
 	ctx context.Context,
 	ms msgServer,
-	topicId uint64,
+	topic types.Topic,
 	nonce types.Nonce,
 	// inferences []*types.Inference,
 	workerDataBundles []*types.WorkerDataBundle,
 	maxTopWorkersToReward uint64,
-) (map[string]bool, error) {
-	inferencesByInferer := make(map[string]*types.Inference)
+) (map[types.Horizon]map[string]bool, error) {
+	topicId := topic.Id
+	inferencesByHorizon := make(map[types.Horizon]map[string]*types.Inference)
 	latestInfererScores := make(map[string]types.Score)
 	errors := make(map[string]string)
 	if len(workerDataBundles) == 0 {
@@ -51,10 +53,28 @@ func verifyAndInsertInferencesFromTopInferers(
 			errors[workerDataBundle.Worker] = "Worker data bundle does not match topic or nonce"
 			continue
 		}
+		if err := topic.ValidateHorizon(inference.Horizon); err != nil {
+			errors[workerDataBundle.Worker] = "Inference horizon is not predicted in topic"
+			continue
+		}
+		if err := types.ValidateValuesOutputDimension(inference.Values, topic.OutputDimension); err != nil {
+			errors[workerDataBundle.Worker] = "Inference does not match topic output dimension"
+			continue
+		}
+		if topic.IsProbabilityDistribution() {
+			if err := types.ValidateProbabilityDistribution(inference.Values); err != nil {
+				errors[workerDataBundle.Worker] = "Inference is not a probability distribution"
+				continue
+			}
+		}
 
 		/// Now do filters on each inferer
-		// Ensure that we only have one inference per inferer. If not, we just take the first one
-		if _, ok := inferencesByInferer[inference.Inferer]; !ok {
+		// Ensure that we only have one inference per inferer and horizon. If not, we just take the first one
+		if _, ok := inferencesByHorizon[inference.Horizon][inference.Inferer]; ok {
+			continue
+		}
+		// The inferer only needs to pass the filters once, whatever the horizon
+		if _, ok := latestInfererScores[inference.Inferer]; !ok {
 			// Check if the inferer is registered
 			isInfererRegistered, err := ms.k.IsWorkerRegisteredInTopic(ctx, topicId, inference.Inferer)
 			if err != nil {
@@ -66,16 +86,30 @@ func verifyAndInsertInferencesFromTopInferers(
 				continue
 			}
 
+			// Check if the inferer is allowed to participate in a permissioned topic
+			canParticipate, err := ms.k.CanWorkerParticipateInTopic(ctx, topic, inference.Inferer)
+			if err != nil {
+				errors[workerDataBundle.Worker] = "Err to check if worker is in topic allowlist"
+				continue
+			}
+			if !canParticipate {
+				errors[workerDataBundle.Worker] = "Inferer is not in topic allowlist"
+				continue
+			}
+
 			// Get the latest score for each inferer => only take top few by score descending
 			latestScore, err := ms.k.GetLatestInfererScore(ctx, topicId, inference.Inferer)
 			if err != nil {
 				errors[workerDataBundle.Worker] = "Latest score not found"
 				continue
 			}
-			/// Filtering done now, now write what we must for inclusion
 			latestInfererScores[inference.Inferer] = latestScore
-			inferencesByInferer[inference.Inferer] = inference
 		}
+		/// Filtering done now, now write what we must for inclusion
+		if _, ok := inferencesByHorizon[inference.Horizon]; !ok {
+			inferencesByHorizon[inference.Horizon] = make(map[string]*types.Inference)
+		}
+		inferencesByHorizon[inference.Horizon][inference.Inferer] = inference
 	}
 
 	/// If we pseudo-random sample from the non-sybil set of reputers, we would do it here
@@ -84,10 +118,19 @@ func verifyAndInsertInferencesFromTopInferers(
 	// Build list of inferences that pass all filters
 	// AND are from top performing inferers among those who have submitted inferences in this batch
 	inferencesFromTopInferers := make([]*types.Inference, 0)
-	acceptedInferers := make(map[string]bool, 0)
+	acceptedInferers := make(map[types.Horizon]map[string]bool, 0)
 	for _, worker := range topInferers {
-		acceptedInferers[worker] = true
-		inferencesFromTopInferers = append(inferencesFromTopInferers, inferencesByInferer[worker])
+		for horizon := types.Horizon(0); horizon < topic.NumHorizons(); horizon++ {
+			inference, ok := inferencesByHorizon[horizon][worker]
+			if !ok {
+				continue
+			}
+			if _, ok := acceptedInferers[horizon]; !ok {
+				acceptedInferers[horizon] = make(map[string]bool)
+			}
+			acceptedInferers[horizon][worker] = true
+			inferencesFromTopInferers = append(inferencesFromTopInferers, inference)
+		}
 	}
 
 	if len(inferencesFromTopInferers) == 0 {
@@ -96,7 +139,10 @@ func verifyAndInsertInferencesFromTopInferers(
 
 	// Ensure deterministic ordering of inferences
 	sort.Slice(inferencesFromTopInferers, func(i, j int) bool {
-		return inferencesFromTopInferers[i].Inferer < inferencesFromTopInferers[j].Inferer
+		if inferencesFromTopInferers[i].Inferer != inferencesFromTopInferers[j].Inferer {
+			return inferencesFromTopInferers[i].Inferer < inferencesFromTopInferers[j].Inferer
+		}
+		return inferencesFromTopInferers[i].Horizon < inferencesFromTopInferers[j].Horizon
 	})
 
 	// Store the final list of inferences
@@ -108,25 +154,32 @@ func verifyAndInsertInferencesFromTopInferers(
 		return nil, err
 	}
 
+	// Every inferer that passed the filters submitted for this nonce, even if not among the top
+	err = markActorsActive(ctx, topicId, latestInfererScores, ms.k.SetWorkerLastActiveHeight)
+	if err != nil {
+		return nil, err
+	}
+
 	return acceptedInferers, nil
 }
 
-// Output a new set of forecasts where only 1 forecast per registerd forecaster is kept,
+// Output a new set of forecasts where only 1 forecast per registerd forecaster and horizon is kept,
 // ignore the rest. In particular, take the first forecast from each registered forecaster
-// and none from any unregistered forecaster.
+// for each horizon and none from any unregistered forecaster.
 // Signatures, anti-synil procedures, and "skimming of only the top few workers by score
 // descending" should be done here.
 func verifyAndInsertForecastsFromTopForecasters(
 	ctx context.Context,
 	ms msgServer,
-	topicId uint64,
+	topic types.Topic,
 	nonce types.Nonce,
 	workerDataBundle []*types.WorkerDataBundle,
-	// Inferers in the current batch, assumed to have passed VerifyAndInsertInferencesFromTopInferers() filters
-	acceptedInferersOfBatch map[string]bool,
+	// Inferers in the current batch by horizon, assumed to have passed VerifyAndInsertInferencesFromTopInferers() filters
+	acceptedInferersOfBatch map[types.Horizon]map[string]bool,
 	maxTopWorkersToReward uint64,
 ) error {
-	forecastsByForecaster := make(map[string]*types.Forecast)
+	topicId := topic.Id
+	forecastsByHorizon := make(map[types.Horizon]map[string]*types.Forecast)
 	latestForecasterScores := make(map[string]types.Score)
 	for _, workerDataBundle := range workerDataBundle {
 		/// Do filters first, then consider the inferenes for inclusion
@@ -143,52 +196,66 @@ func verifyAndInsertForecastsFromTopForecasters(
 		// Check that the forecast exist, is for the correct topic, and is for the correct nonce
 		if forecast == nil ||
 			forecast.TopicId != topicId ||
-			forecast.BlockHeight != nonce.BlockHeight {
+			forecast.BlockHeight != nonce.BlockHeight ||
+			topic.ValidateHorizon(forecast.Horizon) != nil {
 			continue
 		}
 
 		/// Now do filters on each forecaster
-		// Ensure that we only have one forecast per forecaster. If not, we just take the first one
-		if _, ok := forecastsByForecaster[forecast.Forecaster]; !ok {
-			// Check if the forecaster is registered
-			isForecasterRegistered, err := ms.k.IsWorkerRegisteredInTopic(ctx, topicId, forecast.Forecaster)
-			if err != nil {
-				continue
-			}
-			if !isForecasterRegistered {
-				continue
-			}
+		// Ensure that we only have one forecast per forecaster and horizon. If not, we just take the first one
+		if _, ok := forecastsByHorizon[forecast.Horizon][forecast.Forecaster]; ok {
+			continue
+		}
+		// Check if the forecaster is registered
+		isForecasterRegistered, err := ms.k.IsWorkerRegisteredInTopic(ctx, topicId, forecast.Forecaster)
+		if err != nil {
+			continue
+		}
+		if !isForecasterRegistered {
+			continue
+		}
 
-			// Examine forecast elements to verify that they're for inferers in the current set.
-			// We assume that set of inferers has been verified above.
-			// We keep what we can, ignoring the forecaster and their contribution (forecast) entirely
-			// if they're left with no valid forecast elements.
-			acceptedForecastElements := make([]*types.ForecastElement, 0)
-			seenInferers := make(map[string]bool)
-			for _, el := range forecast.ForecastElements {
-				if _, ok := acceptedInferersOfBatch[el.Inferer]; ok && !seenInferers[el.Inferer] {
-					acceptedForecastElements = append(acceptedForecastElements, el)
-					seenInferers[el.Inferer] = true
-				}
-			}
+		// Check if the forecaster is allowed to participate in a permissioned topic
+		canParticipate, err := ms.k.CanWorkerParticipateInTopic(ctx, topic, forecast.Forecaster)
+		if err != nil || !canParticipate {
+			continue
+		}
 
-			// Discard if empty
-			if len(acceptedForecastElements) == 0 {
+		// Examine forecast elements to verify that they're for inferers in the current set at the same horizon.
+		// We assume that set of inferers has been verified above.
+		// We keep what we can, ignoring the forecaster and their contribution (forecast) entirely
+		// if they're left with no valid forecast elements.
+		acceptedForecastElements := make([]*types.ForecastElement, 0)
+		seenInferers := make(map[string]bool)
+		for _, el := range forecast.ForecastElements {
+			if el.ValidateOutputDimension(topic.OutputDimension) != nil {
 				continue
 			}
+			if _, ok := acceptedInferersOfBatch[forecast.Horizon][el.Inferer]; ok && !seenInferers[el.Inferer] {
+				acceptedForecastElements = append(acceptedForecastElements, el)
+				seenInferers[el.Inferer] = true
+			}
+		}
 
-			/// Filtering done now, now write what we must for inclusion
-			// Update the forecast with the filtered elements
-			forecast.ForecastElements = acceptedForecastElements
+		// Discard if empty
+		if len(acceptedForecastElements) == 0 {
+			continue
+		}
 
-			// Get the latest score for each forecaster => only take top few by score descending
-			latestScore, err := ms.k.GetLatestForecasterScore(ctx, topicId, forecast.Forecaster)
-			if err != nil {
-				continue
-			}
-			latestForecasterScores[forecast.Forecaster] = latestScore
-			forecastsByForecaster[forecast.Forecaster] = forecast
+		/// Filtering done now, now write what we must for inclusion
+		// Update the forecast with the filtered elements
+		forecast.ForecastElements = acceptedForecastElements
+
+		// Get the latest score for each forecaster => only take top few by score descending
+		latestScore, err := ms.k.GetLatestForecasterScore(ctx, topicId, forecast.Forecaster)
+		if err != nil {
+			continue
+		}
+		latestForecasterScores[forecast.Forecaster] = latestScore
+		if _, ok := forecastsByHorizon[forecast.Horizon]; !ok {
+			forecastsByHorizon[forecast.Horizon] = make(map[string]*types.Forecast)
 		}
+		forecastsByHorizon[forecast.Horizon][forecast.Forecaster] = forecast
 	}
 
 	/// If we pseudo-random sample from the non-sybil set of reputers, we would do it here
@@ -198,7 +265,11 @@ func verifyAndInsertForecastsFromTopForecasters(
 	// AND are from top performing forecasters among those who have submitted forecasts in this batch
 	forecastsFromTopForecasters := make([]*types.Forecast, 0)
 	for _, worker := range topForecasters {
-		forecastsFromTopForecasters = append(forecastsFromTopForecasters, forecastsByForecaster[worker])
+		for horizon := types.Horizon(0); horizon < topic.NumHorizons(); horizon++ {
+			if forecast, ok := forecastsByHorizon[horizon][worker]; ok {
+				forecastsFromTopForecasters = append(forecastsFromTopForecasters, forecast)
+			}
+		}
 	}
 
 	// Though less than ideal because it produces less-acurate network inferences,
@@ -207,7 +278,10 @@ func verifyAndInsertForecastsFromTopForecasters(
 
 	// Ensure deterministic ordering
 	sort.Slice(forecastsFromTopForecasters, func(i, j int) bool {
-		return forecastsFromTopForecasters[i].Forecaster < forecastsFromTopForecasters[j].Forecaster
+		if forecastsFromTopForecasters[i].Forecaster != forecastsFromTopForecasters[j].Forecaster {
+			return forecastsFromTopForecasters[i].Forecaster < forecastsFromTopForecasters[j].Forecaster
+		}
+		return forecastsFromTopForecasters[i].Horizon < forecastsFromTopForecasters[j].Horizon
 	})
 	// Store the final list of forecasts
 	forecastsToInsert := types.Forecasts{
@@ -218,6 +292,28 @@ func verifyAndInsertForecastsFromTopForecasters(
 		return err
 	}
 
+	return markActorsActive(ctx, topicId, latestForecasterScores, ms.k.SetWorkerLastActiveHeight)
+}
+
+// Record the current block as the last activity of the actors, in address order
+func markActorsActive(
+	ctx context.Context,
+	topicId types.TopicId,
+	actors map[string]types.Score,
+	setLastActiveHeight func(ctx context.Context, topicId types.TopicId, actor string, blockHeight types.BlockHeight) error,
+) error {
+	addresses := make([]string, 0, len(actors))
+	for address := range actors {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	blockHeight := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	for _, address := range addresses {
+		if err := setLastActiveHeight(ctx, topicId, address, blockHeight); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -242,6 +338,11 @@ func (ms msgServer) InsertBulkWorkerPayload(ctx context.Context, msg *types.MsgI
 		return nil, types.ErrInvalidTopicId
 	}
 
+	topic, err := ms.k.GetTopic(ctx, msg.TopicId)
+	if err != nil {
+		return nil, types.ErrInvalidTopicId
+	}
+
 	// Check if the nonce is unfulfilled
 	nonceUnfulfilled, err := ms.k.IsWorkerNonceUnfulfilled(ctx, msg.TopicId, msg.Nonce)
 	if err != nil {
@@ -260,7 +361,7 @@ func (ms msgServer) InsertBulkWorkerPayload(ctx context.Context, msg *types.MsgI
 	acceptedInferers, err := verifyAndInsertInferencesFromTopInferers(
 		ctx,
 		ms,
-		msg.TopicId,
+		topic,
 		*msg.Nonce,
 		msg.WorkerDataBundles,
 		moduleParams.MaxTopInferersToReward,
@@ -272,7 +373,7 @@ func (ms msgServer) InsertBulkWorkerPayload(ctx context.Context, msg *types.MsgI
 	err = verifyAndInsertForecastsFromTopForecasters(
 		ctx,
 		ms,
-		msg.TopicId,
+		topic,
 		*msg.Nonce,
 		msg.WorkerDataBundles,
 		acceptedInferers,
@@ -287,13 +388,14 @@ func (ms msgServer) InsertBulkWorkerPayload(ctx context.Context, msg *types.MsgI
 		return nil, err
 	}
 
-	topic, err := ms.k.GetTopic(ctx, msg.TopicId)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	err = ms.k.AddReputerNoncesForTopicHorizons(ctx, topic, msg.Nonce)
 	if err != nil {
-		return nil, types.ErrInvalidTopicId
+		return nil, err
 	}
 
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	err = ms.k.AddReputerNonce(ctx, topic.Id, msg.Nonce)
+	// Keep the weights the network inferences of this nonce are combined with, to explain them later
+	err = synth.GetCalcSetNetworkInferenceWeights(sdkCtx, ms.k, topic, msg.Nonce.BlockHeight)
 	if err != nil {
 		return nil, err
 	}
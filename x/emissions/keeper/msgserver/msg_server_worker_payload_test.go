@@ -605,3 +605,176 @@ func (s *MsgServerTestSuite) TestMsgInsertBulkWorkerPayloadUpdateTopicCommit() {
 	require.Equal(workerMsg.Sender, lastWorkerPayload.Actor, "Actor should be same")
 	require.Equal(workerMsg.Nonce, lastWorkerPayload.Nonce, "Nonce should be same")
 }
+
+func (s *MsgServerTestSuite) TestMsgInsertBulkWorkerPayloadFiltersInferersNotInTopicAllowlist() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+
+	workerMsg, topicId := s.setUpMsgInsertBulkWorkerPayload(workerPrivateKey)
+
+	// BEGIN MODIFICATION
+	topic, err := s.emissionsKeeper.GetTopic(ctx, topicId)
+	require.NoError(err)
+	topic.AllowlistMode = types.TopicAllowlistMode_ALLOWLIST_MODE_WORKERS
+	err = s.emissionsKeeper.SetTopic(ctx, topicId, topic)
+	require.NoError(err)
+	// END MODIFICATION
+
+	workerMsg = s.signMsgInsertBulkWorkerPayload(workerMsg, workerPrivateKey)
+
+	_, err = msgServer.InsertBulkWorkerPayload(ctx, &workerMsg)
+	require.ErrorIs(err, types.ErrNoValidBundles)
+
+	// Once allowlisted, the inferer's bundle is accepted
+	inferer := workerMsg.WorkerDataBundles[0].InferenceForecastsBundle.Inference.Inferer
+	err = s.emissionsKeeper.AddToTopicWorkerAllowlist(ctx, topicId, inferer)
+	require.NoError(err)
+
+	_, err = msgServer.InsertBulkWorkerPayload(ctx, &workerMsg)
+	require.NoError(err)
+}
+
+func (s *MsgServerTestSuite) TestMsgInsertBulkWorkerPayloadFiltersInferencesNotMatchingOutputDimension() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+
+	workerMsg, topicId := s.setUpMsgInsertBulkWorkerPayload(workerPrivateKey)
+
+	// BEGIN MODIFICATION
+	err := s.emissionsKeeper.SetTopic(ctx, topicId, types.Topic{
+		Id:              topicId,
+		OutputDimension: 3,
+	})
+	require.NoError(err)
+	bundle := workerMsg.WorkerDataBundles[0].InferenceForecastsBundle
+	bundle.Inference.Values = []alloraMath.Dec{alloraMath.NewDecFromInt64(1), alloraMath.NewDecFromInt64(2)}
+	// END MODIFICATION
+
+	signedMsg := s.signMsgInsertBulkWorkerPayload(workerMsg, workerPrivateKey)
+	_, err = msgServer.InsertBulkWorkerPayload(ctx, &signedMsg)
+	require.ErrorIs(err, types.ErrNoValidBundles)
+
+	// Forecast elements with per-component losses of the wrong dimension are dropped
+	bundle.Inference.Values = append(bundle.Inference.Values, alloraMath.NewDecFromInt64(3))
+	bundle.Forecast.ForecastElements[0].Values = []alloraMath.Dec{alloraMath.NewDecFromInt64(1)}
+	signedMsg = s.signMsgInsertBulkWorkerPayload(workerMsg, workerPrivateKey)
+	_, err = msgServer.InsertBulkWorkerPayload(ctx, &signedMsg)
+	require.NoError(err)
+
+	inferences, err := s.emissionsKeeper.GetInferencesAtBlock(ctx, topicId, bundle.Inference.BlockHeight)
+	require.NoError(err)
+	require.Len(inferences.Inferences, 1)
+	require.Len(inferences.Inferences[0].Values, 3)
+	require.Equal(0, s.getCountForecastsAtBlock(topicId, bundle.Forecast.BlockHeight))
+}
+
+func (s *MsgServerTestSuite) TestMsgInsertBulkWorkerPayloadFiltersInferencesNotProbabilityDistributions() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+
+	workerMsg, topicId := s.setUpMsgInsertBulkWorkerPayload(workerPrivateKey)
+
+	// BEGIN MODIFICATION
+	err := s.emissionsKeeper.SetTopic(ctx, topicId, types.Topic{
+		Id:              topicId,
+		OutputDimension: 2,
+		OutputType:      types.TopicOutputType_OUTPUT_TYPE_PROBABILITY_DISTRIBUTION,
+	})
+	require.NoError(err)
+	bundle := workerMsg.WorkerDataBundles[0].InferenceForecastsBundle
+	bundle.Inference.Values = []alloraMath.Dec{alloraMath.MustNewDecFromString("0.5"), alloraMath.MustNewDecFromString("0.6")}
+	// END MODIFICATION
+
+	signedMsg := s.signMsgInsertBulkWorkerPayload(workerMsg, workerPrivateKey)
+	_, err = msgServer.InsertBulkWorkerPayload(ctx, &signedMsg)
+	require.ErrorIs(err, types.ErrNoValidBundles)
+
+	bundle.Inference.Values = []alloraMath.Dec{alloraMath.MustNewDecFromString("1.2"), alloraMath.MustNewDecFromString("-0.2")}
+	signedMsg = s.signMsgInsertBulkWorkerPayload(workerMsg, workerPrivateKey)
+	_, err = msgServer.InsertBulkWorkerPayload(ctx, &signedMsg)
+	require.ErrorIs(err, types.ErrNoValidBundles)
+
+	bundle.Inference.Values = []alloraMath.Dec{alloraMath.MustNewDecFromString("0.25"), alloraMath.MustNewDecFromString("0.75")}
+	signedMsg = s.signMsgInsertBulkWorkerPayload(workerMsg, workerPrivateKey)
+	_, err = msgServer.InsertBulkWorkerPayload(ctx, &signedMsg)
+	require.NoError(err)
+
+	inferences, err := s.emissionsKeeper.GetInferencesAtBlock(ctx, topicId, bundle.Inference.BlockHeight)
+	require.NoError(err)
+	require.Len(inferences.Inferences, 1)
+}
+
+func (s *MsgServerTestSuite) TestMsgInsertBulkWorkerPayloadKeepsOneInferencePerHorizon() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+
+	workerMsg, topicId := s.setUpMsgInsertBulkWorkerPayload(workerPrivateKey)
+
+	// BEGIN MODIFICATION
+	topic := types.Topic{
+		Id:             topicId,
+		EpochLength:    10,
+		GroundTruthLag: 10,
+		Horizons:       []int64{10, 20},
+	}
+	err := s.emissionsKeeper.SetTopic(ctx, topicId, topic)
+	require.NoError(err)
+	bundle := workerMsg.WorkerDataBundles[0].InferenceForecastsBundle
+	longHorizonInference := *bundle.Inference
+	longHorizonInference.Horizon = 1
+	longHorizonInference.Value = alloraMath.NewDecFromInt64(200)
+	unknownHorizonInference := *bundle.Inference
+	unknownHorizonInference.Horizon = 2
+	longHorizonForecast := *bundle.Forecast
+	longHorizonForecast.Horizon = 1
+	workerMsg.WorkerDataBundles = append(workerMsg.WorkerDataBundles,
+		&types.WorkerDataBundle{
+			Worker:                   workerMsg.WorkerDataBundles[0].Worker,
+			InferenceForecastsBundle: &types.InferenceForecastBundle{Inference: &longHorizonInference, Forecast: &longHorizonForecast},
+		},
+		&types.WorkerDataBundle{
+			Worker:                   workerMsg.WorkerDataBundles[0].Worker,
+			InferenceForecastsBundle: &types.InferenceForecastBundle{Inference: &unknownHorizonInference},
+		},
+	)
+	// END MODIFICATION
+
+	signedMsg := s.signMsgInsertBulkWorkerPayload(workerMsg, workerPrivateKey)
+	for i, dataBundle := range signedMsg.WorkerDataBundles[1:] {
+		src, err := dataBundle.InferenceForecastsBundle.XXX_Marshal(make([]byte, 0), true)
+		require.NoError(err)
+		sig, err := workerPrivateKey.Sign(src)
+		require.NoError(err)
+		signedMsg.WorkerDataBundles[i+1].InferencesForecastsBundleSignature = sig
+		signedMsg.WorkerDataBundles[i+1].Pubkey = signedMsg.WorkerDataBundles[0].Pubkey
+	}
+	_, err = msgServer.InsertBulkWorkerPayload(ctx, &signedMsg)
+	require.NoError(err)
+
+	inferences, err := s.emissionsKeeper.GetInferencesAtBlock(ctx, topicId, bundle.Inference.BlockHeight)
+	require.NoError(err)
+	require.Len(inferences.Inferences, 2)
+	require.Equal(types.Horizon(0), inferences.Inferences[0].Horizon)
+	require.Equal(types.Horizon(1), inferences.Inferences[1].Horizon)
+	require.Equal(alloraMath.NewDecFromInt64(200), inferences.AtHorizon(1).Inferences[0].Value)
+
+	forecasts, err := s.emissionsKeeper.GetForecastsAtBlock(ctx, topicId, bundle.Forecast.BlockHeight)
+	require.NoError(err)
+	require.Len(forecasts.Forecasts, 2)
+	require.Len(forecasts.AtHorizon(1).Forecasts, 1)
+
+	// Losses are requested for every horizon
+	for horizon := types.Horizon(0); horizon < topic.NumHorizons(); horizon++ {
+		isUnfulfilled, err := s.emissionsKeeper.IsReputerNonceUnfulfilledForHorizon(ctx, topicId, workerMsg.Nonce, horizon)
+		require.NoError(err)
+		require.True(isUnfulfilled)
+	}
+}
@@ -0,0 +1,31 @@
+package queryserver
+
+import (
+	"context"
+
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// Return the bank balance of every module account holding emissions related funds next to
+// the balance the keeper bookkeeping expects it to hold, and the discrepancy between the two,
+// along with the fee revenue of every topic not yet dripped
+func (qs queryServer) GetModuleAccounting(
+	ctx context.Context,
+	req *types.QueryModuleAccountingRequest,
+) (
+	*types.QueryModuleAccountingResponse,
+	error,
+) {
+	accounts, err := qs.k.GetModuleAccounting(ctx)
+	if err != nil {
+		return nil, err
+	}
+	totalTopicFeeRevenue, err := qs.k.GetTotalTopicFeeRevenue(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryModuleAccountingResponse{
+		Accounts:             accounts,
+		TotalTopicFeeRevenue: totalTopicFeeRevenue,
+	}, nil
+}
@@ -0,0 +1,82 @@
+package queryserver_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	mintTypes "github.com/allora-network/allora-chain/x/mint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestGetModuleAccounting() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	topicId := uint64(1)
+	reputer := s.addrsStr[0]
+	delegator := s.addrsStr[1]
+	mintTo := func(accountName string, amount int64) {
+		coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, cosmosMath.NewInt(amount)))
+		s.Require().NoError(s.bankKeeper.MintCoins(ctx, accountName, coins))
+	}
+
+	// staked and held by the staking account alike
+	s.Require().NoError(keeper.AddReputerStake(ctx, topicId, reputer, cosmosMath.NewInt(1000)))
+	mintTo(types.AlloraStakingAccountName, 1000)
+	// 42 fees of two topics not yet dripped, next to 8 other tokens
+	s.Require().NoError(keeper.AddTopicFeeRevenue(ctx, topicId, cosmosMath.NewInt(30)))
+	s.Require().NoError(keeper.AddTopicFeeRevenue(ctx, topicId+1, cosmosMath.NewInt(12)))
+	mintTo(mintTypes.EcosystemModuleName, 50)
+	// rewards not yet distributed
+	mintTo(types.AlloraRewardsAccountName, 7)
+	// 100 delegated at 0.5 reward per share with a reward debt of 10 are owed 40, one short
+	s.Require().NoError(keeper.SetDelegateStakePlacement(ctx, topicId, delegator, reputer, types.DelegatorInfo{
+		Amount:     alloraMath.NewDecFromInt64(100),
+		RewardDebt: alloraMath.NewDecFromInt64(10),
+	}))
+	s.Require().NoError(keeper.SetDelegateRewardPerShare(ctx, topicId, reputer, alloraMath.MustNewDecFromString("0.5")))
+	mintTo(types.AlloraPendingRewardForDelegatorAccountName, 39)
+
+	response, err := s.queryServer.GetModuleAccounting(ctx, &types.QueryModuleAccountingRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(response.Accounts, 5)
+	accounts := make(map[string]types.ModuleAccountAccounting)
+	for _, account := range response.Accounts {
+		s.Require().Equal(s.accountKeeper.GetModuleAddress(account.AccountName).String(), account.Address)
+		if account.HasExpectedBalance {
+			s.Require().Equal(account.BankBalance.Sub(account.ExpectedBalance), account.Discrepancy)
+		}
+		accounts[account.AccountName] = account
+	}
+
+	staking := accounts[types.AlloraStakingAccountName]
+	s.Require().True(staking.HasExpectedBalance)
+	s.Require().Equal(cosmosMath.NewInt(1000), staking.BankBalance)
+	s.Require().Equal(cosmosMath.NewInt(1000), staking.ExpectedBalance)
+	s.Require().True(staking.Discrepancy.IsZero())
+
+	// the fees may be burned or shared, so the ecosystem balance is not tracked
+	ecosystem := accounts[mintTypes.EcosystemModuleName]
+	s.Require().Equal(cosmosMath.NewInt(50), ecosystem.BankBalance)
+	s.Require().False(ecosystem.HasExpectedBalance)
+	s.Require().True(ecosystem.ExpectedBalance.IsZero())
+	s.Require().True(ecosystem.Discrepancy.IsZero())
+	// but the fees not yet dripped are still reported
+	s.Require().Equal(cosmosMath.NewInt(42), response.TotalTopicFeeRevenue)
+
+	mint := accounts[mintTypes.ModuleName]
+	s.Require().True(mint.HasExpectedBalance)
+	s.Require().True(mint.BankBalance.IsZero())
+	s.Require().True(mint.ExpectedBalance.IsZero())
+
+	rewards := accounts[types.AlloraRewardsAccountName]
+	s.Require().Equal(cosmosMath.NewInt(7), rewards.BankBalance)
+	s.Require().False(rewards.HasExpectedBalance)
+	s.Require().True(rewards.Discrepancy.IsZero())
+
+	pending := accounts[types.AlloraPendingRewardForDelegatorAccountName]
+	s.Require().True(pending.HasExpectedBalance)
+	s.Require().Equal(cosmosMath.NewInt(39), pending.BankBalance)
+	s.Require().Equal(cosmosMath.NewInt(40), pending.ExpectedBalance)
+	s.Require().Equal(cosmosMath.NewInt(-1), pending.Discrepancy)
+}
@@ -64,18 +64,128 @@ func (qs queryServer) GetNetworkInferencesAtBlock(
 		return nil, status.Errorf(codes.NotFound, "network inference not available for topic %v", req.TopicId)
 	}
 
-	networkInferences, _, _, _, err := synth.GetNetworkInferencesAtBlock(
+	networkInferences, forecastImpliedInferenceByWorker, infererWeights, forecasterWeights, err := synth.GetNetworkInferencesAtBlock(
 		sdk.UnwrapSDKContext(ctx),
 		qs.k,
 		req.TopicId,
 		req.BlockHeightLastInference,
 		req.BlockHeightLastReward,
+		req.Horizon,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	return &types.QueryNetworkInferencesAtBlockResponse{NetworkInferences: networkInferences}, nil
+	weights := synth.NewNetworkInferenceWeights(
+		req.TopicId,
+		req.BlockHeightLastInference,
+		req.Horizon,
+		forecastImpliedInferenceByWorker,
+		infererWeights,
+		forecasterWeights,
+	)
+	return &types.QueryNetworkInferencesAtBlockResponse{
+		NetworkInferences:         networkInferences,
+		InfererWeights:            weights.InfererWeights,
+		ForecasterWeights:         weights.ForecasterWeights,
+		ForecastImpliedInferences: weights.ForecastImpliedInferences,
+	}, nil
+}
+
+// Return the regret-informed weights stored for the network inference of a horizon of a topic at a block,
+// along with the forecast-implied inferences of its forecasters, which are recalculated from the stored work
+func (qs queryServer) GetNetworkInferenceWeightsAtBlock(
+	ctx context.Context,
+	req *types.QueryNetworkInferenceWeightsAtBlockRequest,
+) (*types.QueryNetworkInferenceWeightsAtBlockResponse, error) {
+	topic, err := qs.k.GetTopic(ctx, req.TopicId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "topic %v not found", req.TopicId)
+	}
+
+	weights, err := qs.k.GetNetworkInferenceWeightsAtBlockForHorizon(ctx, req.TopicId, req.BlockHeight, req.Horizon)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(weights.ForecasterWeights) > 0 {
+		forecasters := make([]string, 0, len(weights.ForecasterWeights))
+		for _, forecasterWeight := range weights.ForecasterWeights {
+			forecasters = append(forecasters, forecasterWeight.Worker)
+		}
+		weights.ForecastImpliedInferences, err = synth.GetForecastImpliedInferencesAtBlock(
+			sdk.UnwrapSDKContext(ctx),
+			qs.k,
+			topic,
+			req.BlockHeight,
+			req.Horizon,
+			forecasters,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.QueryNetworkInferenceWeightsAtBlockResponse{Weights: weights}, nil
+}
+
+// Return the network inferences of a topic at a block after hypothetical changes to its inferences and forecasts.
+// The synthesis runs in a cached context whose writes are discarded. As the query may be called from other
+// modules, the hypothetical changes are capped by the number of workers a topic rewards.
+func (qs queryServer) GetWhatIfNetworkInference(
+	ctx context.Context,
+	req *types.QueryWhatIfNetworkInferenceRequest,
+) (*types.QueryWhatIfNetworkInferenceResponse, error) {
+	topic, err := qs.k.GetTopic(ctx, req.TopicId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "topic %v not found", req.TopicId)
+	}
+
+	moduleParams, err := qs.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(req.ExtraInferences)) > moduleParams.MaxTopInferersToReward {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"cannot add more than %d inferences at once", moduleParams.MaxTopInferersToReward)
+	}
+	if uint64(len(req.ExtraForecasts)) > moduleParams.MaxTopForecastersToReward {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"cannot add more than %d forecasts at once", moduleParams.MaxTopForecastersToReward)
+	}
+	maxExcludedWorkers := moduleParams.MaxTopInferersToReward + moduleParams.MaxTopForecastersToReward
+	if uint64(len(req.ExcludedWorkers)) > maxExcludedWorkers {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"cannot exclude more than %d workers at once", maxExcludedWorkers)
+	}
+
+	cacheCtx, _ := sdk.UnwrapSDKContext(ctx).CacheContext()
+	networkInferences, forecastImpliedInferenceByWorker, infererWeights, forecasterWeights, err := synth.GetWhatIfNetworkInferencesAtBlock(
+		cacheCtx,
+		qs.k,
+		topic,
+		req.BlockHeightLastInference,
+		req.BlockHeightLastReward,
+		req.Horizon,
+		synth.WhatIfScenario{
+			ExtraInferences: req.ExtraInferences,
+			ExtraForecasts:  req.ExtraForecasts,
+			ExcludedWorkers: req.ExcludedWorkers,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	inferers := alloraMath.GetSortedKeys(infererWeights)
+	forecasters := alloraMath.GetSortedKeys(forecasterWeights)
+
+	return &types.QueryWhatIfNetworkInferenceResponse{
+		NetworkInferences:         networkInferences,
+		InfererWeights:            synth.ConvertWeightsToArrays(inferers, infererWeights),
+		ForecasterWeights:         synth.ConvertWeightsToArrays(forecasters, forecasterWeights),
+		ForecastImpliedInferences: synth.ConvertForecastImpliedInferencesToArrays(forecasters, forecastImpliedInferenceByWorker),
+	}, nil
 }
 
 // Return full set of inferences in I_i from the chain, as well as weights and forecast implied inferences
@@ -97,25 +207,23 @@ func (qs queryServer) GetLatestNetworkInference(
 		sdk.UnwrapSDKContext(ctx),
 		qs.k,
 		req.TopicId,
+		req.Horizon,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	ciRawPercentiles, ciValues, err :=
-		qs.GetConfidenceIntervalsForInferenceData(
+	ciRawPercentiles, ciValues, distributionConfidence, err :=
+		qs.getConfidenceForInferenceData(
+			ctx,
+			req.TopicId,
 			networkInferences,
 			forecastImpliedInferenceByWorker,
 			infererWeights,
 			forecasterWeights,
 		)
-
-	if ciRawPercentiles == nil {
-		ciRawPercentiles = []alloraMath.Dec{}
-	}
-
-	if ciValues == nil {
-		ciValues = []alloraMath.Dec{}
+	if err != nil {
+		return nil, err
 	}
 
 	inferers := alloraMath.GetSortedKeys(infererWeights)
@@ -130,6 +238,7 @@ func (qs queryServer) GetLatestNetworkInference(
 		LossBlockHeight:                  lossBlockHeight,
 		ConfidenceIntervalRawPercentiles: ciRawPercentiles,
 		ConfidenceIntervalValues:         ciValues,
+		DistributionConfidence:           distributionConfidence,
 	}, nil
 }
 
@@ -146,7 +255,7 @@ func (qs queryServer) GetLatestAvailableNetworkInference(
 		return nil, err
 	}
 
-	lastReputerCommit, err := qs.k.GetTopicLastReputerPayload(ctx, req.TopicId)
+	lastReputerCommit, err := qs.k.GetTopicLastReputerPayloadForHorizon(ctx, req.TopicId, req.Horizon)
 	if err != nil {
 		return nil, err
 	}
@@ -158,25 +267,23 @@ func (qs queryServer) GetLatestAvailableNetworkInference(
 			req.TopicId,
 			lastWorkerCommit.Nonce.BlockHeight,
 			lastReputerCommit.Nonce.BlockHeight,
+			req.Horizon,
 		)
 	if err != nil {
 		return nil, err
 	}
 
-	ciRawPercentiles, ciValues, err :=
-		qs.GetConfidenceIntervalsForInferenceData(
+	ciRawPercentiles, ciValues, distributionConfidence, err :=
+		qs.getConfidenceForInferenceData(
+			ctx,
+			req.TopicId,
 			networkInferences,
 			forecastImpliedInferenceByWorker,
 			infererWeights,
 			forecasterWeights,
 		)
-
-	if ciRawPercentiles == nil {
-		ciRawPercentiles = []alloraMath.Dec{}
-	}
-
-	if ciValues == nil {
-		ciValues = []alloraMath.Dec{}
+	if err != nil {
+		return nil, err
 	}
 
 	inferers := alloraMath.GetSortedKeys(infererWeights)
@@ -191,9 +298,55 @@ func (qs queryServer) GetLatestAvailableNetworkInference(
 		LossBlockHeight:                  lastReputerCommit.Nonce.BlockHeight,
 		ConfidenceIntervalRawPercentiles: ciRawPercentiles,
 		ConfidenceIntervalValues:         ciValues,
+		DistributionConfidence:           distributionConfidence,
 	}, nil
 }
 
+// Distributions are summarised by a DistributionConfidence rather than by percentiles of their first probability
+func (qs queryServer) getConfidenceForInferenceData(
+	ctx context.Context,
+	topicId uint64,
+	networkInferences *emissions.ValueBundle,
+	forecastImpliedInferenceByWorker map[string]*emissions.Inference,
+	infererWeights map[string]alloraMath.Dec,
+	forecasterWeights map[string]alloraMath.Dec,
+) ([]alloraMath.Dec, []alloraMath.Dec, *emissions.DistributionConfidence, error) {
+	topic, err := qs.k.GetTopic(ctx, topicId)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if topic.IsProbabilityDistribution() {
+		distributionConfidence, err := synth.CalcDistributionConfidence(
+			networkInferences,
+			forecastImpliedInferenceByWorker,
+			infererWeights,
+			forecasterWeights,
+		)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return []alloraMath.Dec{}, []alloraMath.Dec{}, distributionConfidence, nil
+	}
+
+	ciRawPercentiles, ciValues, _ :=
+		qs.GetConfidenceIntervalsForInferenceData(
+			networkInferences,
+			forecastImpliedInferenceByWorker,
+			infererWeights,
+			forecasterWeights,
+		)
+
+	if ciRawPercentiles == nil {
+		ciRawPercentiles = []alloraMath.Dec{}
+	}
+
+	if ciValues == nil {
+		ciValues = []alloraMath.Dec{}
+	}
+
+	return ciRawPercentiles, ciValues, nil, nil
+}
+
 func (qs queryServer) GetConfidenceIntervalsForInferenceData(
 	networkInferences *emissions.ValueBundle,
 	forecastImpliedInferenceByWorker map[string]*emissions.Inference,
@@ -5,6 +5,8 @@ import (
 	alloraMath "github.com/allora-network/allora-chain/math"
 	"github.com/allora-network/allora-chain/x/emissions/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (s *KeeperTestSuite) TestGetInferencesAtBlock() {
@@ -1006,3 +1008,115 @@ func (s *KeeperTestSuite) TestTestGetLatestAvailableNetworkInferenceWithMissingI
 	_, err = queryServer.GetLatestAvailableNetworkInference(s.ctx, req)
 	require.Error(err)
 }
+
+func (s *KeeperTestSuite) TestGetWhatIfNetworkInference() {
+	queryServer := s.queryServer
+	keeper := s.emissionsKeeper
+	require := s.Require()
+	topicId := s.CreateOneTopic()
+
+	worker0 := "allo1m5v6rgjtxh4xszrrzqacwjh4ve6r0za2gxx9qr"
+	worker1 := "allo1e7cj9839ht2xm8urynqs5279hrvqd8neusvp2x"
+	worker2 := "allo1k9ss0xfer54nyack5678frl36e5g3rj2yzxtfj"
+	newWorker := "allo18ljxewge4vqrkk09tm5heldqg25yj8d9ekgkw5"
+	blockHeight := int64(10)
+
+	inferences := types.Inferences{
+		Inferences: []*types.Inference{
+			{Inferer: worker0, Value: alloraMath.MustNewDecFromString("1"), TopicId: topicId, BlockHeight: blockHeight},
+			{Inferer: worker1, Value: alloraMath.MustNewDecFromString("2"), TopicId: topicId, BlockHeight: blockHeight},
+			{Inferer: worker2, Value: alloraMath.MustNewDecFromString("3"), TopicId: topicId, BlockHeight: blockHeight},
+		},
+	}
+	err := keeper.InsertInferences(s.ctx, topicId, types.Nonce{BlockHeight: blockHeight}, inferences)
+	require.NoError(err)
+	err = keeper.InsertNetworkLossBundleAtBlock(s.ctx, topicId, blockHeight, types.ValueBundle{
+		TopicId:       topicId,
+		CombinedValue: alloraMath.MustNewDecFromString("0.1"),
+	})
+	require.NoError(err)
+
+	workersOf := func(values []*types.WorkerAttributedValue) []string {
+		workers := make([]string, 0, len(values))
+		for _, value := range values {
+			workers = append(workers, value.Worker)
+		}
+		return workers
+	}
+
+	req := &types.QueryWhatIfNetworkInferenceRequest{
+		TopicId:                  topicId,
+		BlockHeightLastInference: blockHeight,
+		BlockHeightLastReward:    blockHeight,
+		ExcludedWorkers:          []string{worker2},
+		ExtraInferences: []*types.Inference{
+			{Inferer: newWorker, Value: alloraMath.MustNewDecFromString("4"), TopicId: topicId, BlockHeight: blockHeight},
+		},
+	}
+	response, err := queryServer.GetWhatIfNetworkInference(s.ctx, req)
+	require.NoError(err)
+	require.ElementsMatch([]string{worker0, worker1, newWorker}, workersOf(response.NetworkInferences.InfererValues))
+	require.Len(response.InfererWeights, 3)
+
+	// The hypothetical changes are not written to the chain
+	stored, err := keeper.GetInferencesAtBlock(s.ctx, topicId, blockHeight)
+	require.NoError(err)
+	require.Len(stored.Inferences, 3)
+
+	// With a single inference left it is the network inference
+	req.ExtraInferences = nil
+	req.ExcludedWorkers = []string{worker0, worker2}
+	response, err = queryServer.GetWhatIfNetworkInference(s.ctx, req)
+	require.NoError(err)
+	require.Equal(alloraMath.MustNewDecFromString("2"), response.NetworkInferences.CombinedValue)
+
+	req.ExcludedWorkers = []string{worker0, worker1, worker2}
+	_, err = queryServer.GetWhatIfNetworkInference(s.ctx, req)
+	require.Error(err)
+
+	// Hypothetical inferences must be for the requested topic and block
+	req.ExcludedWorkers = nil
+	req.ExtraInferences = []*types.Inference{
+		{Inferer: newWorker, Value: alloraMath.MustNewDecFromString("4"), TopicId: topicId, BlockHeight: blockHeight + 1},
+	}
+	_, err = queryServer.GetWhatIfNetworkInference(s.ctx, req)
+	require.ErrorIs(err, types.ErrInvalidValue)
+}
+
+func (s *KeeperTestSuite) TestGetWhatIfNetworkInferenceRejectsTooManyChanges() {
+	queryServer := s.queryServer
+	require := s.Require()
+	topicId := s.CreateOneTopic()
+	blockHeight := int64(10)
+
+	moduleParams, err := s.emissionsKeeper.GetParams(s.ctx)
+	require.NoError(err)
+	moduleParams.MaxTopInferersToReward = 2
+	moduleParams.MaxTopForecastersToReward = 1
+	err = s.emissionsKeeper.SetParams(s.ctx, moduleParams)
+	require.NoError(err)
+
+	inference := &types.Inference{Inferer: "allo1m5v6rgjtxh4xszrrzqacwjh4ve6r0za2gxx9qr", Value: alloraMath.OneDec(), TopicId: topicId, BlockHeight: blockHeight}
+	forecast := &types.Forecast{Forecaster: "allo1m5v6rgjtxh4xszrrzqacwjh4ve6r0za2gxx9qr", TopicId: topicId, BlockHeight: blockHeight}
+
+	req := &types.QueryWhatIfNetworkInferenceRequest{
+		TopicId:                  topicId,
+		BlockHeightLastInference: blockHeight,
+		ExtraInferences:          []*types.Inference{inference, inference, inference},
+	}
+	_, err = queryServer.GetWhatIfNetworkInference(s.ctx, req)
+	require.Error(err)
+	require.Equal(codes.InvalidArgument, status.Code(err))
+
+	req.ExtraInferences = nil
+	req.ExtraForecasts = []*types.Forecast{forecast, forecast}
+	_, err = queryServer.GetWhatIfNetworkInference(s.ctx, req)
+	require.Error(err)
+	require.Equal(codes.InvalidArgument, status.Code(err))
+
+	req.ExtraForecasts = nil
+	req.ExcludedWorkers = []string{"a", "b", "c", "d"}
+	_, err = queryServer.GetWhatIfNetworkInference(s.ctx, req)
+	require.Error(err)
+	require.Equal(codes.InvalidArgument, status.Code(err))
+}
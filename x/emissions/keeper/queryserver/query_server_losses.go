@@ -17,7 +17,7 @@ func (qs queryServer) GetNetworkLossBundleAtBlock(ctx context.Context, req *type
 		return nil, err
 	}
 
-	networkLoss, err := qs.k.GetNetworkLossBundleAtBlock(ctx, req.TopicId, req.BlockHeight)
+	networkLoss, err := qs.k.GetNetworkLossBundleAtBlockForHorizon(ctx, req.TopicId, req.Horizon, req.BlockHeight)
 	if err != nil {
 		return nil, err
 	}
@@ -33,7 +33,7 @@ func (qs queryServer) IsReputerNonceUnfulfilled(
 	error,
 ) {
 	isReputerNonceUnfulfilled, err :=
-		qs.k.IsReputerNonceUnfulfilled(ctx, req.TopicId, &types.Nonce{BlockHeight: req.BlockHeight})
+		qs.k.IsReputerNonceUnfulfilledForHorizon(ctx, req.TopicId, &types.Nonce{BlockHeight: req.BlockHeight}, req.Horizon)
 
 	return &types.QueryIsReputerNonceUnfulfilledResponse{IsReputerNonceUnfulfilled: isReputerNonceUnfulfilled}, err
 }
@@ -60,7 +60,7 @@ func (qs queryServer) GetReputerLossBundlesAtBlock(
 	*types.QueryReputerLossBundlesAtBlockResponse,
 	error,
 ) {
-	reputerLossBundles, err := qs.k.GetReputerLossBundlesAtBlock(ctx, req.TopicId, req.BlockHeight)
+	reputerLossBundles, err := qs.k.GetReputerLossBundlesAtBlockForHorizon(ctx, req.TopicId, req.Horizon, req.BlockHeight)
 	if err != nil {
 		return nil, err
 	}
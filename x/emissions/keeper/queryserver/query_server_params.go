@@ -18,3 +18,32 @@ func (qs queryServer) Params(ctx context.Context, req *types.QueryParamsRequest)
 
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+// GetBlocksPerMonth returns the configured and the effective number of blocks in a month,
+// along with the observed average block time they are calibrated from
+func (qs queryServer) GetBlocksPerMonth(ctx context.Context, req *types.QueryBlocksPerMonthRequest) (*types.QueryBlocksPerMonthResponse, error) {
+	params, err := qs.k.GetParams(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	effective, err := qs.k.GetBlocksPerMonth(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	observed, err := qs.k.GetObservedBlocksPerMonth(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	averageBlockTime, err := qs.k.GetAverageBlockTime(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBlocksPerMonthResponse{
+		ConfiguredBlocksPerMonth: params.BlocksPerMonth,
+		EffectiveBlocksPerMonth:  effective,
+		ObservedBlocksPerMonth:   observed,
+		AverageBlockTime:         averageBlockTime,
+		CalibrationEnabled:       params.BlocksPerMonthCalibrationEnabled,
+	}, nil
+}
@@ -1,6 +1,11 @@
 package queryserver_test
 
-import "github.com/allora-network/allora-chain/x/emissions/types"
+import (
+	"time"
+
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
 
 func (s *KeeperTestSuite) TestParams() {
 	ctx := s.ctx
@@ -20,3 +25,30 @@ func (s *KeeperTestSuite) TestParams() {
 	s.Require().NotNil(response, "The response should not be nil")
 	s.Require().Equal(expectedParams.Version, response.Params.Version)
 }
+
+func (s *KeeperTestSuite) TestGetBlocksPerMonth() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	queryServer := s.queryServer
+
+	params := types.DefaultParams()
+	params.BlocksPerMonthCalibrationEnabled = true
+	s.Require().NoError(keeper.SetParams(ctx, params))
+
+	start := time.Unix(1700000000, 0)
+	s.Require().NoError(keeper.UpdateAverageBlockTime(ctx, start))
+	s.Require().NoError(keeper.UpdateAverageBlockTime(ctx, start.Add(6*time.Second)))
+
+	response, err := queryServer.GetBlocksPerMonth(ctx, &types.QueryBlocksPerMonthRequest{})
+	s.Require().NoError(err)
+	s.Require().True(response.CalibrationEnabled)
+	s.Require().Equal(params.BlocksPerMonth, response.ConfiguredBlocksPerMonth)
+	s.Require().Equal(params.BlocksPerMonth, response.EffectiveBlocksPerMonth, "Not calibrated yet")
+	s.Require().Equal(types.SecondsPerMonth/6, response.ObservedBlocksPerMonth)
+	s.Require().True(alloraMath.InDelta(alloraMath.NewDecFromInt64(6), response.AverageBlockTime, alloraMath.MustNewDecFromString("0.0001")))
+
+	s.Require().NoError(keeper.CalibrateBlocksPerMonth(ctx))
+	response, err = queryServer.GetBlocksPerMonth(ctx, &types.QueryBlocksPerMonthRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(473364), response.EffectiveBlocksPerMonth, "Lowered by at most 10%")
+}
@@ -3,7 +3,11 @@ package queryserver
 import (
 	"context"
 
+	"github.com/allora-network/allora-chain/x/emissions/module/rewards"
 	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (qs queryServer) GetPreviousReputerRewardFraction(
@@ -80,3 +84,111 @@ func (qs queryServer) GetTotalRewardToDistribute(
 
 	return &types.QueryTotalRewardToDistributeResponse{TotalReward: totalReward}, nil
 }
+
+// Return how the reward of a topic at a reward nonce was split, for every rewarded horizon.
+// Actors are limited to the given address, or all listed if none is given.
+func (qs queryServer) GetRewardBreakdown(
+	ctx context.Context,
+	req *types.QueryRewardBreakdownRequest,
+) (
+	*types.QueryRewardBreakdownResponse,
+	error,
+) {
+	topicExists, err := qs.k.TopicExists(ctx, req.TopicId)
+	if !topicExists {
+		return nil, status.Errorf(codes.NotFound, "topic %v not found", req.TopicId)
+	} else if err != nil {
+		return nil, err
+	}
+
+	breakdowns, err := qs.k.GetRewardBreakdowns(ctx, req.TopicId, req.BlockHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*types.RewardBreakdown, 0, len(breakdowns.Horizons))
+	for _, breakdown := range breakdowns.Horizons {
+		if req.Address == "" {
+			ret = append(ret, breakdown)
+			continue
+		}
+		filtered := *breakdown
+		filtered.Actors = make([]*types.ActorRewardBreakdown, 0)
+		for _, actor := range breakdown.Actors {
+			if actor.Address == req.Address {
+				filtered.Actors = append(filtered.Actors, actor)
+			}
+		}
+		ret = append(ret, &filtered)
+	}
+
+	return &types.QueryRewardBreakdownResponse{Breakdowns: ret}, nil
+}
+
+// Return the rewards a topic would pay at the end of its current epoch.
+// The rewarding runs in a cached context whose writes are discarded, so nothing is paid.
+func (qs queryServer) SimulateTopicRewards(
+	ctx context.Context,
+	req *types.QuerySimulateTopicRewardsRequest,
+) (
+	*types.QuerySimulateTopicRewardsResponse,
+	error,
+) {
+	topicExists, err := qs.k.TopicExists(ctx, req.TopicId)
+	if !topicExists {
+		return nil, status.Errorf(codes.NotFound, "topic %v not found", req.TopicId)
+	} else if err != nil {
+		return nil, err
+	}
+
+	cacheCtx, _ := sdk.UnwrapSDKContext(ctx).CacheContext()
+	epochEnd, topicReward, horizonRewards, err := rewards.SimulateTopicRewards(cacheCtx, qs.k, req.TopicId)
+	if err != nil {
+		return nil, err
+	}
+
+	projected := make([]*types.ProjectedTaskReward, 0)
+	for _, horizon := range horizonRewards {
+		for _, reward := range horizon.Rewards {
+			projected = append(projected, &types.ProjectedTaskReward{
+				Address:     reward.Address,
+				Reward:      reward.Reward,
+				TopicId:     reward.TopicId,
+				Horizon:     horizon.Horizon,
+				RewardNonce: horizon.RewardNonce,
+				RewardType:  string(reward.Type),
+			})
+		}
+	}
+
+	return &types.QuerySimulateTopicRewardsResponse{
+		BlockHeight: epochEnd,
+		TopicReward: topicReward,
+		Rewards:     projected,
+	}, nil
+}
+
+// Return the rewards paid to an actor over a range of blocks, in one topic or in all of them. Paginated.
+func (qs queryServer) GetActorRewardHistory(
+	ctx context.Context,
+	req *types.QueryActorRewardHistoryRequest,
+) (
+	*types.QueryActorRewardHistoryResponse,
+	error,
+) {
+	if req.TopicId != 0 {
+		topicExists, err := qs.k.TopicExists(ctx, req.TopicId)
+		if !topicExists {
+			return nil, status.Errorf(codes.NotFound, "topic %v not found", req.TopicId)
+		} else if err != nil {
+			return nil, err
+		}
+	}
+
+	rewards, pageRes, err := qs.k.GetActorRewardHistory(ctx, req.Address, req.TopicId, req.FromBlockHeight, req.ToBlockHeight, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryActorRewardHistoryResponse{Rewards: rewards, Pagination: pageRes}, nil
+}
@@ -126,3 +126,54 @@ func (s *KeeperTestSuite) TestGetPreviousPercentageRewardToStakedReputers() {
 
 	s.Require().Equal(previousPercentageReward, fetchedPercentageReward, "The fetched percentage reward should match the set value")
 }
+
+func (s *KeeperTestSuite) TestGetRewardBreakdown() {
+	topicId := s.CreateOneTopic()
+	block := types.BlockHeight(100)
+
+	_, err := s.queryServer.GetRewardBreakdown(s.ctx, &types.QueryRewardBreakdownRequest{TopicId: topicId + 1, BlockHeight: block})
+	s.Require().Error(err, "Should fail for a topic that does not exist")
+
+	err = s.emissionsKeeper.SetRewardBreakdown(s.ctx, types.RewardBreakdown{
+		TopicId:     topicId,
+		BlockHeight: block,
+		TopicReward: alloraMath.NewDecFromInt64(100),
+		Actors: []*types.ActorRewardBreakdown{
+			{Address: "worker1", ActorType: types.ActorType_INFERER, Reward: alloraMath.NewDecFromInt64(10)},
+			{Address: "worker1", ActorType: types.ActorType_FORECASTER, Reward: alloraMath.NewDecFromInt64(20)},
+			{Address: "reputer1", ActorType: types.ActorType_REPUTER, Reward: alloraMath.NewDecFromInt64(30)},
+		},
+	})
+	s.Require().NoError(err)
+
+	response, err := s.queryServer.GetRewardBreakdown(s.ctx, &types.QueryRewardBreakdownRequest{
+		TopicId:     topicId,
+		BlockHeight: block,
+		Address:     "worker1",
+	})
+	s.Require().NoError(err)
+	s.Require().Len(response.Breakdowns, 1)
+	s.Require().Equal(alloraMath.NewDecFromInt64(100), response.Breakdowns[0].TopicReward)
+	s.Require().Len(response.Breakdowns[0].Actors, 2)
+	s.Require().Equal(types.ActorType_INFERER, response.Breakdowns[0].Actors[0].ActorType)
+	s.Require().Equal(types.ActorType_FORECASTER, response.Breakdowns[0].Actors[1].ActorType)
+
+	response, err = s.queryServer.GetRewardBreakdown(s.ctx, &types.QueryRewardBreakdownRequest{TopicId: topicId, BlockHeight: block})
+	s.Require().NoError(err)
+	s.Require().Len(response.Breakdowns[0].Actors, 3, "All actors should be listed without an address")
+
+	stored, err := s.emissionsKeeper.GetRewardBreakdowns(s.ctx, topicId, block)
+	s.Require().NoError(err)
+	s.Require().Len(stored.Horizons[0].Actors, 3, "Filtering should not modify the stored breakdown")
+}
+
+func (s *KeeperTestSuite) TestSimulateTopicRewardsWithoutLosses() {
+	topicId := s.CreateOneTopic()
+
+	_, err := s.queryServer.SimulateTopicRewards(s.ctx, &types.QuerySimulateTopicRewardsRequest{TopicId: topicId + 1})
+	s.Require().Error(err, "Should fail for a topic that does not exist")
+
+	response, err := s.queryServer.SimulateTopicRewards(s.ctx, &types.QuerySimulateTopicRewardsRequest{TopicId: topicId})
+	s.Require().NoError(err)
+	s.Require().Empty(response.Rewards, "A topic without reported losses has no rewards to project")
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/allora-network/allora-chain/x/emissions/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (qs queryServer) GetLatestInfererScore(
@@ -140,3 +142,26 @@ func (qs queryServer) GetListeningCoefficient(
 
 	return &types.QueryListeningCoefficientResponse{ListeningCoefficient: &listeningCoefficient}, nil
 }
+
+// Return the scores of an actor in a topic for a role over a range of blocks. Paginated.
+func (qs queryServer) GetActorScoreHistory(
+	ctx context.Context,
+	req *types.QueryActorScoreHistoryRequest,
+) (
+	*types.QueryActorScoreHistoryResponse,
+	error,
+) {
+	topicExists, err := qs.k.TopicExists(ctx, req.TopicId)
+	if !topicExists {
+		return nil, status.Errorf(codes.NotFound, "topic %v not found", req.TopicId)
+	} else if err != nil {
+		return nil, err
+	}
+
+	scores, pageRes, err := qs.k.GetActorScoreHistory(ctx, req.TopicId, req.Address, req.ActorType, req.FromBlockHeight, req.ToBlockHeight, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryActorScoreHistoryResponse{Scores: scores, Pagination: pageRes}, nil
+}
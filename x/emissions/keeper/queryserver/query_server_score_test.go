@@ -270,3 +270,26 @@ func (s *KeeperTestSuite) TestGetListeningCoefficient() {
 	s.Require().NoError(err, "Fetching coefficient should not fail after setting")
 	s.Require().Equal(setCoef.Coefficient, fetchedCoef.Coefficient, "The fetched coefficient should match the set value")
 }
+
+func (s *KeeperTestSuite) TestGetActorScoreHistory() {
+	topicId := s.CreateOneTopic()
+
+	for block := int64(100); block <= 300; block += 100 {
+		err := s.emissionsKeeper.InsertReputerScore(s.ctx, topicId, block, types.Score{TopicId: topicId, BlockHeight: block, Address: "reputer1", Score: alloraMath.NewDecFromInt64(block)})
+		s.Require().NoError(err)
+	}
+
+	response, err := s.queryServer.GetActorScoreHistory(s.ctx, &types.QueryActorScoreHistoryRequest{
+		TopicId:         topicId,
+		Address:         "reputer1",
+		ActorType:       types.ActorType_REPUTER,
+		FromBlockHeight: 200,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(response.Scores, 2)
+	s.Require().Equal(int64(200), response.Scores[0].BlockHeight)
+	s.Require().Empty(response.Pagination.NextKey)
+
+	_, err = s.queryServer.GetActorScoreHistory(s.ctx, &types.QueryActorScoreHistoryRequest{TopicId: topicId + 1, Address: "reputer1"})
+	s.Require().Error(err, "Should fail for a topic that does not exist")
+}
@@ -0,0 +1,48 @@
+package queryserver
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// Retrieves the workers allowed to participate in a topic. Paginated.
+func (qs queryServer) GetTopicWorkerAllowlist(ctx context.Context, req *types.QueryTopicAllowlistRequest) (*types.QueryTopicAllowlistResponse, error) {
+	topic, err := qs.k.GetTopic(ctx, req.TopicId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "topic %v not found", req.TopicId)
+	}
+
+	addresses, pageRes, err := qs.k.GetTopicWorkerAllowlist(ctx, req.TopicId, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTopicAllowlistResponse{
+		AllowlistMode: topic.AllowlistMode,
+		Addresses:     addresses,
+		Pagination:    pageRes,
+	}, nil
+}
+
+// Retrieves the reputers allowed to participate in a topic. Paginated.
+func (qs queryServer) GetTopicReputerAllowlist(ctx context.Context, req *types.QueryTopicAllowlistRequest) (*types.QueryTopicAllowlistResponse, error) {
+	topic, err := qs.k.GetTopic(ctx, req.TopicId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "topic %v not found", req.TopicId)
+	}
+
+	addresses, pageRes, err := qs.k.GetTopicReputerAllowlist(ctx, req.TopicId, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTopicAllowlistResponse{
+		AllowlistMode: topic.AllowlistMode,
+		Addresses:     addresses,
+		Pagination:    pageRes,
+	}, nil
+}
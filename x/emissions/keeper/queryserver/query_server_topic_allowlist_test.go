@@ -0,0 +1,58 @@
+package queryserver_test
+
+import (
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+func (s *KeeperTestSuite) TestGetTopicWorkerAllowlist() {
+	ctx := s.ctx
+	queryServer := s.queryServer
+	keeper := s.emissionsKeeper
+
+	topic := types.Topic{Id: 1, AllowlistMode: types.TopicAllowlistMode_ALLOWLIST_MODE_WORKERS}
+	_ = keeper.SetTopic(ctx, topic.Id, topic)
+
+	workers := []string{"worker1", "worker2", "worker3"}
+	for _, worker := range workers {
+		s.Require().NoError(keeper.AddToTopicWorkerAllowlist(ctx, topic.Id, worker))
+	}
+	// Allowlists of other topics and roles must not leak into the response
+	s.Require().NoError(keeper.AddToTopicWorkerAllowlist(ctx, 2, "worker4"))
+	s.Require().NoError(keeper.AddToTopicReputerAllowlist(ctx, topic.Id, "reputer1"))
+
+	req := &types.QueryTopicAllowlistRequest{
+		TopicId: topic.Id,
+		Pagination: &types.SimpleCursorPaginationRequest{
+			Limit: 2,
+		},
+	}
+	response, err := queryServer.GetTopicWorkerAllowlist(ctx, req)
+	s.Require().NoError(err, "GetTopicWorkerAllowlist should not produce an error")
+	s.Require().Equal(types.TopicAllowlistMode_ALLOWLIST_MODE_WORKERS, response.AllowlistMode)
+	s.Require().Equal([]string{"worker1", "worker2"}, response.Addresses)
+	s.Require().NotEmpty(response.Pagination.NextKey, "There should be another page")
+
+	req.Pagination.Key = response.Pagination.NextKey
+	response, err = queryServer.GetTopicWorkerAllowlist(ctx, req)
+	s.Require().NoError(err, "GetTopicWorkerAllowlist should not produce an error")
+	s.Require().Equal([]string{"worker3"}, response.Addresses)
+	s.Require().Empty(response.Pagination.NextKey, "There should be no more pages")
+}
+
+func (s *KeeperTestSuite) TestGetTopicReputerAllowlist() {
+	ctx := s.ctx
+	queryServer := s.queryServer
+	keeper := s.emissionsKeeper
+
+	topic := types.Topic{Id: 1}
+	_ = keeper.SetTopic(ctx, topic.Id, topic)
+	s.Require().NoError(keeper.AddToTopicReputerAllowlist(ctx, topic.Id, "reputer1"))
+
+	response, err := queryServer.GetTopicReputerAllowlist(ctx, &types.QueryTopicAllowlistRequest{TopicId: topic.Id})
+	s.Require().NoError(err, "GetTopicReputerAllowlist should not produce an error")
+	s.Require().Equal(types.TopicAllowlistMode_ALLOWLIST_MODE_OPEN, response.AllowlistMode)
+	s.Require().Equal([]string{"reputer1"}, response.Addresses)
+
+	_, err = queryServer.GetTopicReputerAllowlist(ctx, &types.QueryTopicAllowlistRequest{TopicId: 2})
+	s.Require().Error(err, "Querying the allowlist of a missing topic should fail")
+}
@@ -0,0 +1,48 @@
+package queryserver
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Retrieves the workers and reputers of a topic that will be deregistered for inactivity
+// if they miss at most `within_epochs` more epochs. Roles without an inactivity threshold are skipped.
+func (qs queryServer) GetActorsAtRiskOfRemoval(ctx context.Context, req *types.QueryActorsAtRiskOfRemovalRequest) (*types.QueryActorsAtRiskOfRemovalResponse, error) {
+	topic, err := qs.k.GetTopic(ctx, req.TopicId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "topic %v not found", req.TopicId)
+	}
+	blockHeight := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	actors := make([]*types.ActorInactivity, 0)
+	if topic.WorkerInactivityThreshold > 0 {
+		workers, err := qs.k.GetTopicWorkersInactivity(ctx, topic, blockHeight)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		actors = appendActorsAtRisk(actors, workers, req.WithinEpochs)
+	}
+	if topic.ReputerInactivityThreshold > 0 {
+		reputers, err := qs.k.GetTopicReputersInactivity(ctx, topic, blockHeight)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		actors = appendActorsAtRisk(actors, reputers, req.WithinEpochs)
+	}
+
+	return &types.QueryActorsAtRiskOfRemovalResponse{Actors: actors}, nil
+}
+
+func appendActorsAtRisk(atRisk []*types.ActorInactivity, actors []types.ActorInactivity, withinEpochs uint64) []*types.ActorInactivity {
+	for i := range actors {
+		if actors[i].EpochsUntilRemoval <= withinEpochs {
+			atRisk = append(atRisk, &actors[i])
+		}
+	}
+	return atRisk
+}
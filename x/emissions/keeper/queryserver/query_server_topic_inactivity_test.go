@@ -0,0 +1,40 @@
+package queryserver_test
+
+import (
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+func (s *KeeperTestSuite) TestGetActorsAtRiskOfRemoval() {
+	ctx := s.ctx.WithBlockHeight(100)
+	queryServer := s.queryServer
+	keeper := s.emissionsKeeper
+
+	topic := types.Topic{
+		Id:                         1,
+		EpochLength:                10,
+		WorkerInactivityThreshold:  5,
+		ReputerInactivityThreshold: 0,
+	}
+	_ = keeper.SetTopic(ctx, topic.Id, topic)
+
+	s.Require().NoError(keeper.InsertWorker(ctx, topic.Id, "worker1", types.OffchainNode{LibP2PKey: "key1", NodeAddress: "worker1"}))
+	s.Require().NoError(keeper.InsertWorker(ctx, topic.Id, "worker2", types.OffchainNode{LibP2PKey: "key2", NodeAddress: "worker2"}))
+	s.Require().NoError(keeper.InsertReputer(ctx, topic.Id, "reputer1", types.OffchainNode{LibP2PKey: "key3", NodeAddress: "reputer1"}))
+	s.Require().NoError(keeper.SetWorkerLastActiveHeight(ctx, topic.Id, "worker1", 60))
+	for epochHeight := int64(70); epochHeight <= 110; epochHeight += 10 {
+		s.Require().NoError(keeper.AddTopicEpochHeight(ctx, topic, epochHeight))
+	}
+
+	ctx = ctx.WithBlockHeight(110)
+	response, err := queryServer.GetActorsAtRiskOfRemoval(ctx, &types.QueryActorsAtRiskOfRemovalRequest{
+		TopicId:      topic.Id,
+		WithinEpochs: 1,
+	})
+	s.Require().NoError(err, "GetActorsAtRiskOfRemoval should not produce an error")
+	s.Require().Equal([]*types.ActorInactivity{
+		{Address: "worker1", LastActiveHeight: 60, MissedEpochs: 4, EpochsUntilRemoval: 1},
+	}, response.Actors, "Only worker1 is close to removal and reputers have no threshold")
+
+	_, err = queryServer.GetActorsAtRiskOfRemoval(ctx, &types.QueryActorsAtRiskOfRemovalRequest{TopicId: 2})
+	s.Require().Error(err, "Querying a missing topic should fail")
+}
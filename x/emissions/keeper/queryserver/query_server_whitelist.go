@@ -23,3 +23,16 @@ func (qs queryServer) IsWhitelistAdmin(ctx context.Context, req *types.QueryIsWh
 
 	return &types.QueryIsWhitelistAdminResponse{IsAdmin: isAdmin}, nil
 }
+
+func (qs queryServer) GetActorRoles(ctx context.Context, req *types.QueryActorRolesRequest) (*types.QueryActorRolesResponse, error) {
+	if err := qs.k.ValidateStringIsBech32(req.Address); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	roles, err := qs.k.GetActorRoles(ctx, req.Address)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryActorRolesResponse{Roles: roles}, nil
+}
@@ -33,3 +33,20 @@ func (s *KeeperTestSuite) TestIsWhitelistAdmin() {
 	s.Require().NotNil(response, "The response should not be nil")
 	s.Require().False(response.IsAdmin, "The IsAdmin field should be false for the anti test address")
 }
+
+func (s *KeeperTestSuite) TestGetActorRoles() {
+	ctx := s.ctx
+	queryServer := s.queryServer
+	keeper := s.emissionsKeeper
+
+	testAddress := "allo10es2a97cr7u2m3aa08tcu7yd0d300thdct45ve"
+
+	err := keeper.GrantRole(ctx, testAddress, types.Role_WHITELIST_ADMIN)
+	s.Require().NoError(err)
+	err = keeper.GrantRole(ctx, testAddress, types.Role_TOPIC_CREATOR)
+	s.Require().NoError(err)
+
+	response, err := queryServer.GetActorRoles(ctx, &types.QueryActorRolesRequest{Address: testAddress})
+	s.Require().NoError(err, "GetActorRoles should not produce an error")
+	s.Require().Equal([]types.Role{types.Role_TOPIC_CREATOR, types.Role_WHITELIST_ADMIN}, response.Roles)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"cosmossdk.io/errors"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
 	"github.com/allora-network/allora-chain/x/emissions/module/rewards"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -20,6 +21,27 @@ func EndBlocker(ctx context.Context, am AppModule) error {
 		sdkCtx.Logger().Error("Error Getting module params", err)
 		return err
 	}
+	// Track the observed block time and recalibrate the blocks per month from it once a month
+	if err := am.keeper.UpdateAverageBlockTime(sdkCtx, sdkCtx.BlockTime()); err != nil {
+		return errors.Wrapf(err, "Updating average block time error")
+	}
+	blocksPerMonth, err := am.keeper.GetBlocksPerMonth(sdkCtx)
+	if err != nil {
+		return errors.Wrapf(err, "Getting blocks per month error")
+	}
+	lastCalibrationHeight, err := am.keeper.GetLastBlocksPerMonthCalibrationHeight(sdkCtx)
+	if err != nil {
+		return errors.Wrapf(err, "Getting last blocks per month calibration height error")
+	}
+	if keeper.IsMonthElapsed(lastCalibrationHeight, blockHeight, blocksPerMonth) {
+		if err := am.keeper.CalibrateBlocksPerMonth(sdkCtx); err != nil {
+			return errors.Wrapf(err, "Calibrating blocks per month error")
+		}
+		if err := am.keeper.SetLastBlocksPerMonthCalibrationHeight(sdkCtx, blockHeight); err != nil {
+			return errors.Wrapf(err, "Setting last blocks per month calibration height error")
+		}
+	}
+
 	// Remove Stakers that have been wanting to unstake this block. They no longer get paid rewards
 	RemoveStakes(sdkCtx, blockHeight, am.keeper, moduleParams.HalfMaxProcessStakeRemovalsEndBlock)
 	RemoveDelegateStakes(sdkCtx, blockHeight, am.keeper, moduleParams.HalfMaxProcessStakeRemovalsEndBlock)
@@ -57,5 +79,8 @@ func EndBlocker(ctx context.Context, am AppModule) error {
 		return err
 	}
 
+	// Deregister actors that stopped submitting to the topics whose epoch just ended
+	RemoveInactiveActors(sdkCtx, blockHeight, am.keeper)
+
 	return nil
 }
@@ -277,6 +277,11 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "previous-percentage-reputer-reward",
 					Short:     "Return previous percent reward paid to staked reputers",
 				},
+				{
+					RpcMethod: "GetBlocksPerMonth",
+					Use:       "blocks-per-month",
+					Short:     "Return the configured and effective blocks per month and the observed average block time",
+				},
 				{
 					RpcMethod: "GetPreviousReputerRewardFraction",
 					Use:       "previous-reputer-reward-fraction [topic_id] [reputer]",
@@ -375,6 +380,11 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "total-rewards",
 					Short:     "Return total rewards to be distributed among all rewardable topics in the block",
 				},
+				{
+					RpcMethod: "GetModuleAccounting",
+					Use:       "module-accounting",
+					Short:     "Return the bank balance of every emissions related module account next to the balance the keeper bookkeeping expects",
+				},
 				{
 					RpcMethod: "GetUnfulfilledReputerNonces",
 					Use:       "unfulfilled-reputer-nonces [topic_id]",
@@ -529,6 +539,66 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "block_height_last_reward"},
 					},
 				},
+				{
+					RpcMethod: "GetNetworkInferenceWeightsAtBlock",
+					Use:       "network-inference-weights-at-block [topic_id] [block_height] [horizon]",
+					Short:     "Get the regret-informed weights the Network Inference of a horizon of a topic at a block height was combined with",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+						{ProtoField: "block_height"},
+						{ProtoField: "horizon"},
+					},
+				},
+				{
+					RpcMethod: "GetRewardBreakdown",
+					Use:       "reward-breakdown [topic_id] [block_height] [address]",
+					Short:     "Get how the reward of a topic at a reward nonce was split between tasks and actors, limited to an actor's entries",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+						{ProtoField: "block_height"},
+						{ProtoField: "address"},
+					},
+				},
+				{
+					RpcMethod: "GetActorScoreHistory",
+					Use:       "actor-score-history [topic_id] [address] [actor_type] [from_block_height] [to_block_height]",
+					Short:     "Get the scores of an actor in a topic as an inferer, forecaster or reputer over a range of blocks, to_block_height 0 reading up to the latest",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+						{ProtoField: "address"},
+						{ProtoField: "actor_type"},
+						{ProtoField: "from_block_height"},
+						{ProtoField: "to_block_height"},
+					},
+				},
+				{
+					RpcMethod: "GetActorRewardHistory",
+					Use:       "actor-reward-history [address] [topic_id] [from_block_height] [to_block_height]",
+					Short:     "Get the rewards paid to an actor over a range of blocks, topic_id 0 reading every topic and to_block_height 0 reading up to the latest",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "address"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "from_block_height"},
+						{ProtoField: "to_block_height"},
+					},
+				},
+				{
+					RpcMethod: "SimulateTopicRewards",
+					Use:       "simulate-topic-rewards [topic_id]",
+					Short:     "Get the rewards a topic would pay its participants at the end of its current epoch, without paying them",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+					},
+				},
+				{
+					RpcMethod: "GetWhatIfNetworkInference",
+					Use:       "what-if-network-inference [topic_id] [block_height_last_inference]",
+					Short:     "Get what the Network Inferences for a topic at a block height would have been with extra inferences and forecasts or without some workers",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+						{ProtoField: "block_height_last_inference"},
+					},
+				},
 				{
 					RpcMethod: "GetLatestNetworkInference",
 					Use:       "latest-network-inference [topic_id]",
@@ -554,6 +624,39 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "address"},
 					},
 				},
+				{
+					RpcMethod: "GetTopicWorkerAllowlist",
+					Use:       "topic-worker-allowlist [topic_id]",
+					Short:     "Get the allowlist mode of a topic and the workers allowed to participate in it",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+					},
+				},
+				{
+					RpcMethod: "GetTopicReputerAllowlist",
+					Use:       "topic-reputer-allowlist [topic_id]",
+					Short:     "Get the allowlist mode of a topic and the reputers allowed to participate in it",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+					},
+				},
+				{
+					RpcMethod: "GetActorRoles",
+					Use:       "actor-roles [address]",
+					Short:     "Get the roles granted to an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "address"},
+					},
+				},
+				{
+					RpcMethod: "GetActorsAtRiskOfRemoval",
+					Use:       "actors-at-risk-of-removal [topic_id] [within_epochs]",
+					Short:     "Get the workers and reputers of a topic that will be deregistered if they miss at most [within_epochs] more epochs",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+						{ProtoField: "within_epochs"},
+					},
+				},
 				{
 					RpcMethod: "GetStakeRemovalsUpUntilBlock",
 					Use:       "stake-removals-up-until-block [block_height]",
@@ -662,6 +765,31 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "is_reputer"},
 					},
 				},
+				{
+					RpcMethod: "RegisterMulti",
+					Use:       "register-multi [sender] [lib_p2p_key] [multi_address] [owner] [is_reputer] [topic_ids...]",
+					Short:     "Register a reputer or worker in many topics in one transaction, optionally removing it from --remove-topic-ids",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "lib_p2p_key"},
+						{ProtoField: "multi_address"},
+						{ProtoField: "owner"},
+						{ProtoField: "is_reputer"},
+						{ProtoField: "topic_ids", Varargs: true},
+					},
+				},
+				{
+					RpcMethod: "UpdateNodeInfo",
+					Use:       "update-node-info [sender] [lib_p2p_key] [multi_address] [owner] [is_reputer]",
+					Short:     "Update the node info of a reputer or worker in all topics it is registered in",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "lib_p2p_key"},
+						{ProtoField: "multi_address"},
+						{ProtoField: "owner"},
+						{ProtoField: "is_reputer"},
+					},
+				},
 				{
 					RpcMethod: "AddStake",
 					Use:       "add-stake [sender] [topic_id] [amount]",
@@ -761,6 +889,82 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "address"},
 					},
 				},
+				{
+					RpcMethod: "GrantRole",
+					Use:       "grant-role [sender] [address] [role]",
+					Short:     "Grant a role (PARAMS_ADMIN, TOPIC_CREATOR or WHITELIST_ADMIN) to an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "address"},
+						{ProtoField: "role"},
+					},
+				},
+				{
+					RpcMethod: "RevokeRole",
+					Use:       "revoke-role [sender] [address] [role]",
+					Short:     "Revoke a role (PARAMS_ADMIN, TOPIC_CREATOR or WHITELIST_ADMIN) from an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "address"},
+						{ProtoField: "role"},
+					},
+				},
+				{
+					RpcMethod: "UpdateTopicInactivityThresholds",
+					Use:       "update-topic-inactivity-thresholds [sender] [topic_id] [worker_inactivity_threshold] [reputer_inactivity_threshold]",
+					Short:     "Set after how many consecutive missed epochs workers and reputers are deregistered from a topic, 0 disables",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "worker_inactivity_threshold"},
+						{ProtoField: "reputer_inactivity_threshold"},
+					},
+				},
+				{
+					RpcMethod: "UpdateTopicLossAggregator",
+					Use:       "update-topic-loss-aggregator [sender] [topic_id] [loss_aggregator] [loss_trim_fraction] [huber_loss_tuning_constant] [huber_loss_max_iterations]",
+					Short:     "Set how the losses reported by reputers of a topic are aggregated into consensus losses. Only the topic creator may do this",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "loss_aggregator"},
+						{ProtoField: "loss_trim_fraction"},
+						{ProtoField: "huber_loss_tuning_constant"},
+						{ProtoField: "huber_loss_max_iterations"},
+					},
+				},
+				{
+					RpcMethod: "UpdateTopicAllowlistMode",
+					Use:       "update-topic-allowlist-mode [sender] [topic_id] [allowlist_mode]",
+					Short:     "Set which roles of a topic only accept allowlisted actors. Only the topic creator may do this",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "allowlist_mode"},
+					},
+				},
+				{
+					RpcMethod: "AddToTopicAllowlist",
+					Use:       "add-to-topic-allowlist [sender] [topic_id] [address] [is_reputer]",
+					Short:     "Allow an address to participate in a permissioned topic as a worker or reputer. Only the topic creator may do this",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "address"},
+						{ProtoField: "is_reputer"},
+					},
+				},
+				{
+					RpcMethod: "RemoveFromTopicAllowlist",
+					Use:       "remove-from-topic-allowlist [sender] [topic_id] [address] [is_reputer]",
+					Short:     "Remove an address from the worker or reputer allowlist of a topic. Only the topic creator may do this",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "address"},
+						{ProtoField: "is_reputer"},
+					},
+				},
 				{
 					RpcMethod: "InsertBulkWorkerPayload",
 					Use:       "insert-bulk-worker-payload [worker_data_bundles]",
@@ -0,0 +1,53 @@
+package module
+
+import (
+	"fmt"
+
+	emissionskeeper "github.com/allora-network/allora-chain/x/emissions/keeper"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Deregister the workers and reputers of topics whose epoch ended this block that have
+// missed too many consecutive epochs. Topics are swept at most once per epoch, bounded by
+// the number of churnable topics per block.
+func RemoveInactiveActors(
+	sdkCtx sdk.Context,
+	currentBlock int64,
+	k emissionskeeper.Keeper,
+) {
+	topicIds, err := k.GetChurnableTopics(sdkCtx)
+	if err != nil {
+		sdkCtx.Logger().Error(fmt.Sprintf(
+			"Unable to get churnable topics for block %d, skipping removing inactive actors: %v",
+			currentBlock,
+			err,
+		))
+		return
+	}
+	for _, topicId := range topicIds {
+		topic, err := k.GetTopic(sdkCtx, topicId)
+		if err != nil {
+			sdkCtx.Logger().Error(fmt.Sprintf("Error getting topic %d: %v", topicId, err))
+			continue
+		}
+		if topic.WorkerInactivityThreshold == 0 && topic.ReputerInactivityThreshold == 0 {
+			continue
+		}
+
+		// attempt writes in a cache context, only write finally if there are no errors
+		cacheSdkCtx, write := sdkCtx.CacheContext()
+		removedWorkers, removedReputers, err := k.RemoveInactiveTopicActors(cacheSdkCtx, topic, currentBlock)
+		if err != nil {
+			sdkCtx.Logger().Error(fmt.Sprintf(
+				"Error removing inactive actors from topic %d: %v",
+				topicId,
+				err,
+			))
+			continue
+		}
+		write()
+
+		emissionstypes.EmitNewInactiveActorsRemovedEvent(sdkCtx, topicId, currentBlock, removedWorkers, removedReputers)
+	}
+}
@@ -0,0 +1,187 @@
+package rewards
+
+import (
+	"cosmossdk.io/errors"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// A LossAggregator reduces the losses reported by reputers for one value of the loss bundle into a
+// consensus loss, weighting each loss by the reputer's stake. Slices have the same length and
+// contain no NaN, and the consensus of no losses is zero.
+type LossAggregator interface {
+	AggregateLosses(stakes, losses []alloraMath.Dec) (alloraMath.Dec, error)
+}
+
+// Returns the built-in loss aggregator of the topic, tuned by the topic.
+// Unknown types fall back to the stake-weighted mean.
+func NewLossAggregator(topic types.Topic) LossAggregator {
+	switch topic.LossAggregator {
+	case types.LossAggregatorType_LOSS_AGGREGATOR_STAKE_WEIGHTED_MEDIAN:
+		return StakeWeightedMedianLossAggregator{}
+	case types.LossAggregatorType_LOSS_AGGREGATOR_TRIMMED_MEAN:
+		return TrimmedMeanLossAggregator{TrimFraction: topic.LossTrimFraction}
+	case types.LossAggregatorType_LOSS_AGGREGATOR_HUBER:
+		return HuberLossAggregator{TuningConstant: topic.HuberLossTuningConstant, MaxIterations: topic.HuberLossMaxIterations}
+	default:
+		return StakeWeightedMeanLossAggregator{}
+	}
+}
+
+// StakeWeightedMeanLossAggregator is the default aggregator: the stake-weighted average of the losses
+type StakeWeightedMeanLossAggregator struct{}
+
+func (StakeWeightedMeanLossAggregator) AggregateLosses(stakes, losses []alloraMath.Dec) (alloraMath.Dec, error) {
+	return GetStakeWeightedLoss(stakes, losses)
+}
+
+// StakeWeightedMedianLossAggregator takes the loss at which the cumulative stake of the reputers,
+// sorted by reported loss, reaches half of their total stake. No reputer holding less than half
+// of the stake can move it beyond the range of the losses reported by the others.
+type StakeWeightedMedianLossAggregator struct{}
+
+func (StakeWeightedMedianLossAggregator) AggregateLosses(stakes, losses []alloraMath.Dec) (alloraMath.Dec, error) {
+	return getStakeWeightedMedianLoss(stakes, losses)
+}
+
+// TrimmedMeanLossAggregator drops the lowest and the highest losses holding TrimFraction of the
+// total stake each, partially dropping the stake of the reputers at the boundaries, then takes the
+// stake-weighted average of the losses that remain
+type TrimmedMeanLossAggregator struct {
+	TrimFraction alloraMath.Dec
+}
+
+func (a TrimmedMeanLossAggregator) AggregateLosses(stakes, losses []alloraMath.Dec) (alloraMath.Dec, error) {
+	sorted, err := sortStakedLosses(stakes, losses)
+	if err != nil || len(sorted) == 0 {
+		return alloraMath.ZeroDec(), err
+	}
+	kept, ok, err := alloraMath.TrimWeightedValues(sorted, a.TrimFraction)
+	if err != nil {
+		return alloraMath.ZeroDec(), errors.Wrapf(err, "error trimming losses")
+	}
+	// Fall back to the median if nothing would be kept
+	if !ok {
+		return alloraMath.WeightedMedian(sorted)
+	}
+	keptStakes := make([]alloraMath.Dec, len(kept))
+	keptLosses := make([]alloraMath.Dec, len(kept))
+	for i, stakedLoss := range kept {
+		keptStakes[i] = stakedLoss.Weight
+		keptLosses[i] = stakedLoss.Value
+	}
+	return GetStakeWeightedLoss(keptStakes, keptLosses)
+}
+
+// HuberLossAggregator is a Huber M-estimator of the location of the losses. Starting from the
+// stake-weighted median, it iteratively takes the stake-weighted average of the losses, shrinking
+// the weight of every loss further than TuningConstant scaled median absolute deviations from the
+// current estimate in proportion to its distance. Close losses count as in a mean, outliers only
+// linearly. Iterations stop once the estimate moves by less than a millionth of the Huber threshold,
+// and never exceed types.MaxHuberLossMaxIterations, whatever the topic asks for.
+type HuberLossAggregator struct {
+	TuningConstant alloraMath.Dec
+	MaxIterations  uint64
+}
+
+func (a HuberLossAggregator) AggregateLosses(stakes, losses []alloraMath.Dec) (alloraMath.Dec, error) {
+	estimate, err := getStakeWeightedMedianLoss(stakes, losses)
+	if err != nil || len(losses) == 0 {
+		return alloraMath.ZeroDec(), err
+	}
+
+	deviations := make([]alloraMath.Dec, len(losses))
+	for i, loss := range losses {
+		deviation, err := loss.Sub(estimate)
+		if err != nil {
+			return alloraMath.ZeroDec(), errors.Wrapf(err, "error calculating deviation")
+		}
+		deviations[i] = deviation.Abs()
+	}
+	medianAbsoluteDeviation, err := getStakeWeightedMedianLoss(stakes, deviations)
+	if err != nil {
+		return alloraMath.ZeroDec(), err
+	}
+	// The losses of over half of the stake agree, so the median is the consensus
+	if medianAbsoluteDeviation.IsZero() {
+		return estimate, nil
+	}
+	// Scales the median absolute deviation into a consistent estimator of the standard deviation of normal data
+	threshold, err := medianAbsoluteDeviation.Mul(alloraMath.MustNewDecFromString("1.4826"))
+	if err != nil {
+		return alloraMath.ZeroDec(), errors.Wrapf(err, "error scaling median absolute deviation")
+	}
+	threshold, err = threshold.Mul(a.TuningConstant)
+	if err != nil {
+		return alloraMath.ZeroDec(), errors.Wrapf(err, "error calculating Huber threshold")
+	}
+
+	tolerance, err := threshold.Mul(alloraMath.MustNewDecFromString("0.000001"))
+	if err != nil {
+		return alloraMath.ZeroDec(), errors.Wrapf(err, "error calculating Huber tolerance")
+	}
+	maxIterations := a.MaxIterations
+	if maxIterations > types.MaxHuberLossMaxIterations {
+		maxIterations = types.MaxHuberLossMaxIterations
+	}
+
+	weights := make([]alloraMath.Dec, len(losses))
+	for iteration := uint64(0); iteration < maxIterations; iteration++ {
+		for i, loss := range losses {
+			weights[i] = stakes[i]
+			distance, err := loss.Sub(estimate)
+			if err != nil {
+				return alloraMath.ZeroDec(), errors.Wrapf(err, "error calculating distance to estimate")
+			}
+			distance = distance.Abs()
+			if distance.Gt(threshold) {
+				shrink, err := threshold.Quo(distance)
+				if err != nil {
+					return alloraMath.ZeroDec(), errors.Wrapf(err, "error calculating Huber weight")
+				}
+				weights[i], err = stakes[i].Mul(shrink)
+				if err != nil {
+					return alloraMath.ZeroDec(), errors.Wrapf(err, "error calculating Huber weight")
+				}
+			}
+		}
+		newEstimate, err := GetStakeWeightedLoss(weights, losses)
+		if err != nil {
+			return alloraMath.ZeroDec(), err
+		}
+		step, err := newEstimate.Sub(estimate)
+		if err != nil {
+			return alloraMath.ZeroDec(), errors.Wrapf(err, "error calculating Huber step")
+		}
+		estimate = newEstimate
+		if step.Abs().Lte(tolerance) {
+			break
+		}
+	}
+	return estimate, nil
+}
+
+// Sorts the losses in increasing order, keeping the stake of each reputer alongside
+func sortStakedLosses(stakes, losses []alloraMath.Dec) ([]alloraMath.WeightedValue, error) {
+	if len(stakes) != len(losses) {
+		return nil, types.ErrInvalidSliceLength
+	}
+	sorted := make([]alloraMath.WeightedValue, len(losses))
+	for i, loss := range losses {
+		sorted[i] = alloraMath.WeightedValue{Value: loss, Weight: stakes[i]}
+	}
+	alloraMath.SortWeightedValues(sorted)
+	return sorted, nil
+}
+
+func getStakeWeightedMedianLoss(stakes, losses []alloraMath.Dec) (alloraMath.Dec, error) {
+	sorted, err := sortStakedLosses(stakes, losses)
+	if err != nil {
+		return alloraMath.ZeroDec(), err
+	}
+	median, err := alloraMath.WeightedMedian(sorted)
+	if err != nil {
+		return alloraMath.ZeroDec(), errors.Wrapf(err, "error calculating stake-weighted median loss")
+	}
+	return median, nil
+}
@@ -0,0 +1,159 @@
+package rewards_test
+
+import (
+	"math"
+	"testing"
+
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/module/rewards"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/stretchr/testify/require"
+)
+
+func decs(values ...string) []alloraMath.Dec {
+	ret := make([]alloraMath.Dec, 0, len(values))
+	for _, value := range values {
+		ret = append(ret, alloraMath.MustNewDecFromString(value))
+	}
+	return ret
+}
+
+func topicWithLossAggregator(aggregatorType emissionstypes.LossAggregatorType) emissionstypes.Topic {
+	return emissionstypes.Topic{
+		LossAggregator:          aggregatorType,
+		LossTrimFraction:        alloraMath.MustNewDecFromString("0.2"),
+		HuberLossTuningConstant: alloraMath.MustNewDecFromString("1.345"),
+		HuberLossMaxIterations:  20,
+	}
+}
+
+func TestNewLossAggregator(t *testing.T) {
+	require.IsType(t, rewards.StakeWeightedMeanLossAggregator{}, rewards.NewLossAggregator(topicWithLossAggregator(emissionstypes.LossAggregatorType_LOSS_AGGREGATOR_STAKE_WEIGHTED_MEAN)))
+	require.IsType(t, rewards.StakeWeightedMedianLossAggregator{}, rewards.NewLossAggregator(topicWithLossAggregator(emissionstypes.LossAggregatorType_LOSS_AGGREGATOR_STAKE_WEIGHTED_MEDIAN)))
+	require.IsType(t, rewards.TrimmedMeanLossAggregator{}, rewards.NewLossAggregator(topicWithLossAggregator(emissionstypes.LossAggregatorType_LOSS_AGGREGATOR_TRIMMED_MEAN)))
+	require.IsType(t, rewards.HuberLossAggregator{}, rewards.NewLossAggregator(topicWithLossAggregator(emissionstypes.LossAggregatorType_LOSS_AGGREGATOR_HUBER)))
+	require.IsType(t, rewards.StakeWeightedMeanLossAggregator{}, rewards.NewLossAggregator(topicWithLossAggregator(emissionstypes.LossAggregatorType(42))))
+}
+
+func TestLossAggregatorsAgreeOnUnanimousLosses(t *testing.T) {
+	stakes := decs("1", "5", "20")
+	losses := decs("-2.5", "-2.5", "-2.5")
+	for _, aggregatorType := range []emissionstypes.LossAggregatorType{
+		emissionstypes.LossAggregatorType_LOSS_AGGREGATOR_STAKE_WEIGHTED_MEAN,
+		emissionstypes.LossAggregatorType_LOSS_AGGREGATOR_STAKE_WEIGHTED_MEDIAN,
+		emissionstypes.LossAggregatorType_LOSS_AGGREGATOR_TRIMMED_MEAN,
+		emissionstypes.LossAggregatorType_LOSS_AGGREGATOR_HUBER,
+	} {
+		got, err := rewards.NewLossAggregator(topicWithLossAggregator(aggregatorType)).AggregateLosses(stakes, losses)
+		require.NoError(t, err, aggregatorType.String())
+		require.True(t, alloraMath.InDelta(alloraMath.MustNewDecFromString("-2.5"), got, alloraMath.MustNewDecFromString("0.000001")), aggregatorType.String())
+
+		got, err = rewards.NewLossAggregator(topicWithLossAggregator(aggregatorType)).AggregateLosses(nil, nil)
+		require.NoError(t, err, aggregatorType.String())
+		require.True(t, got.IsZero(), aggregatorType.String())
+	}
+}
+
+// Four honest reputers report log-losses close to -1, while a reputer holding 3/7 of the stake reports garbage
+func TestLossAggregatorsResistLargeStakeOutlier(t *testing.T) {
+	stakes := decs("10", "10", "10", "10", "30")
+	losses := decs("-1.1", "-1.05", "-1", "-0.9", "50")
+	lowestHonest := alloraMath.MustNewDecFromString("-1.1")
+	highestHonest := alloraMath.MustNewDecFromString("-0.9")
+
+	mean, err := rewards.StakeWeightedMeanLossAggregator{}.AggregateLosses(stakes, losses)
+	require.NoError(t, err)
+	require.True(t, mean.Gt(alloraMath.NewDecFromInt64(20)), "the mean follows the outlier, got %v", mean)
+
+	median, err := rewards.StakeWeightedMedianLossAggregator{}.AggregateLosses(stakes, losses)
+	require.NoError(t, err)
+	require.True(t, median.Gte(lowestHonest) && median.Lte(highestHonest), "the median stays among honest losses, got %v", median)
+
+	huber, err := rewards.NewLossAggregator(topicWithLossAggregator(emissionstypes.LossAggregatorType_LOSS_AGGREGATOR_HUBER)).AggregateLosses(stakes, losses)
+	require.NoError(t, err)
+	require.True(t, huber.Gte(lowestHonest) && huber.Lt(alloraMath.MustNewDecFromString("-0.5")), "the Huber estimate bounds the pull of the outlier, got %v", huber)
+}
+
+// A topic asking for more iterations than allowed gets the same estimate, after at most the allowed iterations
+func TestHuberLossAggregatorBoundsIterations(t *testing.T) {
+	stakes := decs("10", "10", "10", "10", "30")
+	losses := decs("-1.1", "-1.05", "-1", "-0.9", "50")
+
+	bounded, err := rewards.HuberLossAggregator{
+		TuningConstant: alloraMath.MustNewDecFromString("1.345"),
+		MaxIterations:  emissionstypes.MaxHuberLossMaxIterations,
+	}.AggregateLosses(stakes, losses)
+	require.NoError(t, err)
+	unbounded, err := rewards.HuberLossAggregator{
+		TuningConstant: alloraMath.MustNewDecFromString("1.345"),
+		MaxIterations:  math.MaxUint64,
+	}.AggregateLosses(stakes, losses)
+	require.NoError(t, err)
+	require.True(t, bounded.Equal(unbounded), "got %v, want %v", unbounded, bounded)
+}
+
+// Outliers holding no more than the trimmed stake on either end are dropped by the trimmed mean
+func TestTrimmedMeanLossAggregatorDropsOutliersOnBothEnds(t *testing.T) {
+	stakes := decs("5", "10", "10", "10", "10", "5")
+	losses := decs("-40", "-1.1", "-1", "-1", "-0.9", "40")
+
+	got, err := rewards.NewLossAggregator(topicWithLossAggregator(emissionstypes.LossAggregatorType_LOSS_AGGREGATOR_TRIMMED_MEAN)).AggregateLosses(stakes, losses)
+	require.NoError(t, err)
+	// 10 of the 50 stake is trimmed on each end: the outliers and half of the stake of the closest honest reputers
+	want := alloraMath.MustNewDecFromString("-1")
+	require.True(t, alloraMath.InDelta(want, got, alloraMath.MustNewDecFromString("0.000001")), "got %v, want %v", got, want)
+
+	mean, err := rewards.StakeWeightedMeanLossAggregator{}.AggregateLosses(stakes, decs("-40", "-1.1", "-1", "-1", "-0.9", "80"))
+	require.NoError(t, err)
+	trimmed, err := rewards.NewLossAggregator(topicWithLossAggregator(emissionstypes.LossAggregatorType_LOSS_AGGREGATOR_TRIMMED_MEAN)).AggregateLosses(stakes, decs("-40", "-1.1", "-1", "-1", "-0.9", "80"))
+	require.NoError(t, err)
+	require.True(t, mean.Gt(alloraMath.OneDec()), "the mean follows the larger outlier, got %v", mean)
+	require.True(t, alloraMath.InDelta(want, trimmed, alloraMath.MustNewDecFromString("0.000001")), "got %v, want %v", trimmed, want)
+}
+
+func TestGetConsensusLossMatrixSkipsNaNLosses(t *testing.T) {
+	stakes := decs("10", "10", "30")
+	allLosses := [][]alloraMath.Dec{
+		{alloraMath.MustNewDecFromString("-1"), alloraMath.MustNewDecFromString("-2")},
+		{alloraMath.MustNewDecFromString("-1.2"), alloraMath.NewNaN()},
+		{alloraMath.MustNewDecFromString("50"), alloraMath.MustNewDecFromString("-3")},
+	}
+
+	consensus, mostDistantValues, err := rewards.GetConsensusLossMatrix(rewards.StakeWeightedMedianLossAggregator{}, stakes, allLosses)
+	require.NoError(t, err)
+	require.Equal(t, decs("50", "-3"), consensus)
+	require.Equal(t, decs("-1.2", "-3"), mostDistantValues)
+
+	// The stake-weighted mean stays the default
+	meanConsensus, _, err := rewards.GetStakeWeightedLossMatrix(stakes, allLosses)
+	require.NoError(t, err)
+	wantMean, err := rewards.GetStakeWeightedLoss(stakes, decs("-1", "-1.2", "50"))
+	require.NoError(t, err)
+	require.True(t, alloraMath.InDelta(wantMean, meanConsensus[0], alloraMath.MustNewDecFromString("0.000001")))
+}
+
+// Scored against a robust consensus, honest reputers outscore a large-stake reputer reporting garbage
+func TestGetAllConsensusScoresWithRobustLossAggregators(t *testing.T) {
+	stakes := decs("10", "10", "10", "10", "30")
+	allLosses := [][]alloraMath.Dec{
+		decs("-1.1", "-2.1"),
+		decs("-1.05", "-2"),
+		decs("-1", "-2.05"),
+		decs("-0.9", "-1.9"),
+		decs("50", "60"),
+	}
+	coefficients := decs("1", "1", "1", "1", "1")
+	reputerEpsilon := alloraMath.MustNewDecFromString("1e-2")
+	epsilon := alloraMath.MustNewDecFromString("1e-4")
+
+	for _, aggregatorType := range []emissionstypes.LossAggregatorType{
+		emissionstypes.LossAggregatorType_LOSS_AGGREGATOR_STAKE_WEIGHTED_MEDIAN,
+		emissionstypes.LossAggregatorType_LOSS_AGGREGATOR_HUBER,
+	} {
+		scores, err := rewards.GetAllConsensusScores(allLosses, stakes, coefficients, 5, reputerEpsilon, epsilon, rewards.NewLossAggregator(topicWithLossAggregator(aggregatorType)))
+		require.NoError(t, err, aggregatorType.String())
+		for i := 0; i < 4; i++ {
+			require.True(t, scores[i].Gt(scores[4]), "%s: honest reputer %d scored %v, garbage reputer %v", aggregatorType.String(), i, scores[i], scores[4])
+		}
+	}
+}
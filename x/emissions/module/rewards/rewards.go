@@ -36,39 +36,15 @@ func EmitRewards(
 		return errors.Wrapf(err, "failed to get module params")
 	}
 
-	rewardableTopics, err := k.GetRewardableTopics(ctx)
+	sortedChurnableTopics, topicRewards, err := GetTopTopicRewards(ctx, k, weights, totalReward, moduleParams.MaxTopicsPerBlock)
 	if err != nil {
-		return errors.Wrapf(err, "failed to get rewardable topics")
+		return err
 	}
-	// Sorted, active topics by weight descending. Still need skim top N to truly be the churnable topics
-	sortedChurnableTopics := alloraMath.GetSortedElementsByDecWeightDesc(rewardableTopics, weights)
-
 	if len(sortedChurnableTopics) == 0 {
 		Logger(ctx).Warn("No churnable topics found")
 		return nil
 	}
 
-	// Top `N=MaxTopicsPerBlock` active topics of this block => the *actually* churnable topics
-	if uint64(len(sortedChurnableTopics)) > moduleParams.MaxTopicsPerBlock {
-		sortedChurnableTopics = sortedChurnableTopics[:moduleParams.MaxTopicsPerBlock]
-	}
-
-	// Get total weight of churnable topics
-	sumWeightOfChurnableTopics := alloraMath.ZeroDec()
-	for _, topicId := range sortedChurnableTopics {
-		sumWeightOfChurnableTopics, err = sumWeightOfChurnableTopics.Add(*weights[topicId])
-		if err != nil {
-			return errors.Wrapf(err, "failed to add weight of top topics")
-		}
-	}
-
-	// Revenue (above) is what was earned by topics in this timestep. Rewards are what are actually paid to topics => participants
-	// The reward and revenue calculations are coupled here to minimize excessive compute
-	topicRewards, err := CalcTopicRewards(ctx, k, weights, sortedChurnableTopics, sumWeightOfChurnableTopics, totalReward)
-	if err != nil {
-		return errors.Wrapf(err, "failed to calculate topic rewards")
-	}
-
 	// Calculate then pay out topic rewards to topic participants
 	totalRewardToStakedReputers := alloraMath.ZeroDec() // This is used to communicate with the mint module
 	for _, topicId := range sortedChurnableTopics {
@@ -77,55 +53,82 @@ func EmitRewards(
 			Logger(ctx).Warn(fmt.Sprintf("Topic %d has no reward, skipping", topicId))
 			continue
 		}
-		// Get topic reward nonce/block height
-		topicRewardNonce, err := k.GetTopicRewardNonce(ctx, topicId)
-		// If the topic has no reward nonce, skip it
-		if err != nil || topicRewardNonce == 0 {
-			continue
-		}
-
-		// Distribute rewards between topic participants
-		totalRewardsDistribution, rewardInTopicToReputers, err := GenerateRewardsDistributionByTopicParticipant(ctx, k, topicId, topicReward, topicRewardNonce, moduleParams)
+		topic, err := k.GetTopic(ctx, topicId)
 		if err != nil {
-			topicRewardString := "nil"
-			Logger(ctx).Warn(
-				fmt.Sprintf(
-					"Failed to Generate Rewards for Topic, Skipping:\nTopic Id %d\nTopic Reward Amount %s\nError:\n%s\n\n",
-					topicId,
-					topicRewardString,
-					err.Error(),
-				),
-			)
 			continue
 		}
-		totalRewardToStakedReputers, err = totalRewardToStakedReputers.Add(rewardInTopicToReputers)
+		horizonRewardShares, err := GetHorizonRewardShares(ctx, k, topic, *topicReward)
 		if err != nil {
-			return errors.Wrapf(
-				err,
-				"Error finding sum of rewards to Reputers:\n%s\n%s",
-				totalRewardToStakedReputers.String(),
-				rewardInTopicToReputers.String(),
-			)
+			Logger(ctx).Warn(fmt.Sprintf("Failed to split reward of Topic between horizons, Skipping:\nTopic Id %d\nError:\n%s\n\n", topicId, err.Error()))
+			continue
 		}
 
-		// Pay out rewards to topic participants
-		payoutErrors := payoutRewards(ctx, k, totalRewardsDistribution)
-		if len(payoutErrors) > 0 {
-			for _, err := range payoutErrors {
+		// Each horizon of the topic is rewarded its share of the topic reward at its own reward nonce
+		oldestRewardedNonce := int64(0)
+		payoutFailed := false
+		for _, share := range horizonRewardShares {
+			horizon, topicRewardNonce, horizonReward := share.Horizon, share.RewardNonce, share.Reward
+
+			// Distribute rewards between topic participants
+			totalRewardsDistribution, rewardInTopicToReputers, err := GenerateRewardsDistributionByTopicParticipantForHorizon(ctx, k, topicId, horizon, &horizonReward, topicRewardNonce, moduleParams)
+			if err != nil {
+				topicRewardString := "nil"
 				Logger(ctx).Warn(
 					fmt.Sprintf(
-						"Failed to pay out rewards to participant in Topic:\nTopic Id %d\nTopic Reward Amount %s\nError:\n%s\n\n",
+						"Failed to Generate Rewards for Topic, Skipping:\nTopic Id %d\nHorizon %d\nTopic Reward Amount %s\nError:\n%s\n\n",
 						topicId,
-						topicReward.String(),
+						horizon,
+						topicRewardString,
 						err.Error(),
 					),
 				)
+				continue
 			}
+			totalRewardToStakedReputers, err = totalRewardToStakedReputers.Add(rewardInTopicToReputers)
+			if err != nil {
+				return errors.Wrapf(
+					err,
+					"Error finding sum of rewards to Reputers:\n%s\n%s",
+					totalRewardToStakedReputers.String(),
+					rewardInTopicToReputers.String(),
+				)
+			}
+
+			// Pay out rewards to topic participants
+			payoutErrors := payoutRewards(ctx, k, totalRewardsDistribution)
+			if len(payoutErrors) > 0 {
+				for _, err := range payoutErrors {
+					Logger(ctx).Warn(
+						fmt.Sprintf(
+							"Failed to pay out rewards to participant in Topic:\nTopic Id %d\nHorizon %d\nTopic Reward Amount %s\nError:\n%s\n\n",
+							topicId,
+							horizon,
+							horizonReward.String(),
+							err.Error(),
+						),
+					)
+				}
+				payoutFailed = true
+				continue
+			}
+
+			// Delete topic reward nonce of the horizon
+			err = k.DeleteTopicHorizonRewardNonce(ctx, topicId, horizon)
+			if err != nil {
+				Logger(ctx).Warn(fmt.Sprintf("Failed to delete reward nonce of horizon %d of Topic %d: %s", horizon, topicId, err.Error()))
+				payoutFailed = true
+				continue
+			}
+			if oldestRewardedNonce == 0 || topicRewardNonce < oldestRewardedNonce {
+				oldestRewardedNonce = topicRewardNonce
+			}
+		}
+		if payoutFailed || oldestRewardedNonce == 0 {
 			continue
 		}
 
 		// Prune records after rewards have been paid out
-		err = pruneRecordsAfterRewards(ctx, k, moduleParams.MinEpochLengthRecordLimit, topicId, topicRewardNonce)
+		err = pruneRecordsAfterRewards(ctx, k, moduleParams.MinEpochLengthRecordLimit, topicId, oldestRewardedNonce)
 		if err != nil {
 			Logger(ctx).Warn(
 				fmt.Sprintf(
@@ -154,7 +157,15 @@ func EmitRewards(
 		fmt.Sprintf("Paid out %s to staked reputers over %d topics",
 			totalRewardToStakedReputers.String(),
 			len(topicRewards)))
-	if !totalReward.IsZero() && uint64(blockHeight)%moduleParams.BlocksPerMonth == 0 {
+	blocksPerMonth, err := k.GetBlocksPerMonth(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get blocks per month")
+	}
+	lastPercentageHeight, err := k.GetLastPercentageRewardToStakedReputersHeight(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get last percentage reward to staked reputers height")
+	}
+	if !totalReward.IsZero() && keeper.IsMonthElapsed(lastPercentageHeight, blockHeight, blocksPerMonth) {
 		// set the previous percentage reward to staked reputers
 		// for the mint module to be able to control the inflation rate to that actor
 		percentageToStakedReputers, err := totalRewardToStakedReputers.Quo(totalReward)
@@ -165,11 +176,95 @@ func EmitRewards(
 		if err != nil {
 			return errors.Wrapf(err, "failed to set previous percentage reward to staked reputers")
 		}
+		err = k.SetLastPercentageRewardToStakedReputersHeight(ctx, blockHeight)
+		if err != nil {
+			return errors.Wrapf(err, "failed to set last percentage reward to staked reputers height")
+		}
 	}
 
 	return nil
 }
 
+// Selects the top `N=MaxTopicsPerBlock` rewardable topics by weight, sorted by weight descending,
+// and calculates the reward each of them is paid out of the total reward
+func GetTopTopicRewards(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	weights map[TopicId]*alloraMath.Dec,
+	totalReward alloraMath.Dec,
+	maxTopicsPerBlock uint64,
+) (
+	sortedTopics []TopicId,
+	topicRewards map[TopicId]*alloraMath.Dec,
+	err error,
+) {
+	rewardableTopics, err := k.GetRewardableTopics(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to get rewardable topics")
+	}
+	// Sorted, active topics by weight descending. Still need skim top N to truly be the churnable topics
+	sortedTopics = alloraMath.GetSortedElementsByDecWeightDesc(rewardableTopics, weights)
+
+	// Top `N=MaxTopicsPerBlock` active topics of this block => the *actually* churnable topics
+	if uint64(len(sortedTopics)) > maxTopicsPerBlock {
+		sortedTopics = sortedTopics[:maxTopicsPerBlock]
+	}
+
+	// Get total weight of churnable topics
+	sumWeight := alloraMath.ZeroDec()
+	for _, topicId := range sortedTopics {
+		sumWeight, err = sumWeight.Add(*weights[topicId])
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to add weight of top topics")
+		}
+	}
+
+	// Revenue is what was earned by topics in this timestep. Rewards are what are actually paid to topics => participants
+	topicRewards, err = CalcTopicRewards(ctx, k, weights, sortedTopics, sumWeight, totalReward)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to calculate topic rewards")
+	}
+	return sortedTopics, topicRewards, nil
+}
+
+// The share of the reward of a topic a horizon is paid from the losses reported at its reward nonce
+type HorizonRewardShare struct {
+	Horizon     types.Horizon
+	RewardNonce BlockHeight
+	Reward      alloraMath.Dec
+}
+
+// Splits the reward of a topic between its horizons according to the horizon weights.
+// Horizons without a pending reward nonce have nothing to be rewarded from and are left out.
+func GetHorizonRewardShares(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	topic types.Topic,
+	topicReward alloraMath.Dec,
+) ([]HorizonRewardShare, error) {
+	horizonWeights, err := topic.GetHorizonWeights()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get horizon weights")
+	}
+	shares := make([]HorizonRewardShare, 0, topic.NumHorizons())
+	for horizon := types.Horizon(0); horizon < topic.NumHorizons(); horizon++ {
+		rewardNonce, err := k.GetTopicHorizonRewardNonce(ctx, topic.Id, horizon)
+		if err != nil || rewardNonce == 0 {
+			continue
+		}
+		horizonReward, err := topicReward.Mul(horizonWeights[horizon])
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to calculate reward of horizon %d", horizon)
+		}
+		shares = append(shares, HorizonRewardShare{
+			Horizon:     horizon,
+			RewardNonce: rewardNonce,
+			Reward:      horizonReward,
+		})
+	}
+	return shares, nil
+}
+
 func CalcTopicRewards(
 	ctx sdk.Context,
 	k keeper.Keeper,
@@ -207,34 +302,51 @@ func GenerateRewardsDistributionByTopicParticipant(
 	totalRewardsDistribution []types.TaskReward,
 	taskReputerReward alloraMath.Dec,
 	err error,
+) {
+	return GenerateRewardsDistributionByTopicParticipantForHorizon(ctx, k, topicId, 0, topicReward, blockHeight, moduleParams)
+}
+
+// Distributes the reward of a horizon of the topic between the participants, from the losses reported for that horizon
+func GenerateRewardsDistributionByTopicParticipantForHorizon(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	topicId uint64,
+	horizon types.Horizon,
+	topicReward *alloraMath.Dec,
+	blockHeight int64,
+	moduleParams types.Params,
+) (
+	totalRewardsDistribution []types.TaskReward,
+	taskReputerReward alloraMath.Dec,
+	err error,
 ) {
 	if topicReward == nil {
 		return nil, alloraMath.Dec{}, types.ErrInvalidReward
 	}
-	bundles, err := k.GetReputerLossBundlesAtBlock(ctx, topicId, blockHeight)
+	bundles, err := k.GetReputerLossBundlesAtBlockForHorizon(ctx, topicId, horizon, blockHeight)
 	if err != nil {
 		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get network loss bundle at block %d", blockHeight)
 	}
 
-	lossBundles, err := k.GetNetworkLossBundleAtBlock(ctx, topicId, blockHeight)
+	lossBundles, err := k.GetNetworkLossBundleAtBlockForHorizon(ctx, topicId, horizon, blockHeight)
 	if err != nil {
 		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get network loss bundle at block %d", blockHeight)
 	}
 
 	// Calculate and Set the reputer scores
-	reputerScores, err := GenerateReputerScores(ctx, k, topicId, blockHeight, *bundles)
+	reputerScores, err := GenerateReputerScoresForHorizon(ctx, k, topicId, horizon, blockHeight, *bundles)
 	if err != nil {
 		return nil, alloraMath.Dec{}, err
 	}
 
 	// Calculate and Set the worker scores for their inference work
-	infererScores, err := GenerateInferenceScores(ctx, k, topicId, blockHeight, *lossBundles)
+	infererScores, err := GenerateInferenceScoresForHorizon(ctx, k, topicId, horizon, blockHeight, *lossBundles)
 	if err != nil {
 		return nil, alloraMath.Dec{}, err
 	}
 
 	// Calculate and Set the worker scores for their forecast work
-	forecasterScores, err := GenerateForecastScores(ctx, k, topicId, blockHeight, *lossBundles)
+	forecasterScores, err := GenerateForecastScoresForHorizon(ctx, k, topicId, horizon, blockHeight, *lossBundles)
 	if err != nil {
 		return nil, alloraMath.Dec{}, err
 	}
@@ -260,10 +372,11 @@ func GenerateRewardsDistributionByTopicParticipant(
 	}
 
 	// Get inferer reward fractions
-	inferers, inferersRewardFractions, err := GetInferenceTaskRewardFractions(
+	inferers, inferersRewardFractions, err := GetInferenceTaskRewardFractionsForHorizon(
 		ctx,
 		k,
 		topicId,
+		horizon,
 		blockHeight,
 		moduleParams.PRewardInference,
 		moduleParams.CRewardInference,
@@ -288,10 +401,11 @@ func GenerateRewardsDistributionByTopicParticipant(
 	}
 
 	// Get forecaster reward fractions
-	forecasters, forecastersRewardFractions, err := GetForecastingTaskRewardFractions(
+	forecasters, forecastersRewardFractions, err := GetForecastingTaskRewardFractionsForHorizon(
 		ctx,
 		k,
 		topicId,
+		horizon,
 		blockHeight,
 		moduleParams.PRewardForecast,
 		moduleParams.CRewardForecast,
@@ -402,9 +516,80 @@ func GenerateRewardsDistributionByTopicParticipant(
 	}
 	totalRewardsDistribution = append(totalRewardsDistribution, forecastRewards...)
 
+	// Keep how the reward was split to be able to explain payouts
+	chi, gamma, err := getChiAndGamma(
+		lossBundles.NaiveValue,
+		lossBundles.CombinedValue,
+		inferenceEntropy,
+		forecastingEntropy,
+		infererScores,
+	)
+	if err != nil {
+		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to get chi and gamma")
+	}
+	actors := make([]*types.ActorRewardBreakdown, 0, len(inferers)+len(forecasters)+len(reputers))
+	actors = append(actors, newActorRewardBreakdowns(types.ActorType_INFERER, infererScores, inferers, inferersRewardFractions, inferenceRewards)...)
+	actors = append(actors, newActorRewardBreakdowns(types.ActorType_FORECASTER, forecasterScores, forecasters, forecastersRewardFractions, forecastRewards)...)
+	actors = append(actors, newActorRewardBreakdowns(types.ActorType_REPUTER, reputerScores, reputers, reputersRewardFractions, reputerRewards)...)
+	err = k.SetRewardBreakdown(ctx, types.RewardBreakdown{
+		TopicId:               topicId,
+		BlockHeight:           blockHeight,
+		Horizon:               horizon,
+		TopicReward:           *topicReward,
+		InferenceEntropy:      inferenceEntropy,
+		ForecastingEntropy:    forecastingEntropy,
+		ReputerEntropy:        reputerEntropy,
+		Chi:                   chi,
+		Gamma:                 gamma,
+		InferenceTaskReward:   taskInferenceReward,
+		ForecastingTaskReward: taskForecastingReward,
+		ReputerTaskReward:     taskReputerReward,
+		Actors:                actors,
+	})
+	if err != nil {
+		return []types.TaskReward{}, alloraMath.Dec{}, errors.Wrapf(err, "failed to set reward breakdown")
+	}
+
 	return totalRewardsDistribution, taskReputerReward, nil
 }
 
+// Pairs the score, reward fraction and payout of every actor rewarded for a task.
+// Actors without a score or payout are reported with zero.
+func newActorRewardBreakdowns(
+	actorType types.ActorType,
+	scores []types.Score,
+	addresses []string,
+	fractions []alloraMath.Dec,
+	rewards []types.TaskReward,
+) []*types.ActorRewardBreakdown {
+	scoreByAddress := make(map[string]alloraMath.Dec, len(scores))
+	for _, score := range scores {
+		scoreByAddress[score.Address] = score.Score
+	}
+	rewardByAddress := make(map[string]alloraMath.Dec, len(rewards))
+	for _, reward := range rewards {
+		rewardByAddress[reward.Address] = reward.Reward
+	}
+	valueOrZero := func(values map[string]alloraMath.Dec, address string) alloraMath.Dec {
+		if value, ok := values[address]; ok {
+			return value
+		}
+		return alloraMath.ZeroDec()
+	}
+
+	breakdowns := make([]*types.ActorRewardBreakdown, 0, len(addresses))
+	for i, address := range addresses {
+		breakdowns = append(breakdowns, &types.ActorRewardBreakdown{
+			Address:        address,
+			ActorType:      actorType,
+			Score:          valueOrZero(scoreByAddress, address),
+			RewardFraction: fractions[i],
+			Reward:         valueOrZero(rewardByAddress, address),
+		})
+	}
+	return breakdowns
+}
+
 // pay out the rewards to the participants
 // this function moves tokens from the rewards module to the participants
 // if it fails to pay a particular participant, it will continue to the next participant
@@ -454,6 +639,7 @@ func payoutRewards(
 			}
 
 			reputerAndDelegatorRewards = append(reputerAndDelegatorRewards, reward)
+			addPaidRewardToHistory(ctx, k, blockHeight, reward)
 		} else {
 			_, err := sdk.AccAddressFromBech32(reward.Address)
 			if err != nil {
@@ -481,6 +667,7 @@ func payoutRewards(
 			} else if reward.Type == types.WorkerForecastRewardType {
 				forecasterRewards = append(forecasterRewards, reward)
 			}
+			addPaidRewardToHistory(ctx, k, blockHeight, reward)
 		}
 	}
 
@@ -490,6 +677,21 @@ func payoutRewards(
 	return ret
 }
 
+// Records a paid reward in the history of the actor. The reward is already paid,
+// so failing to record it is only logged.
+func addPaidRewardToHistory(ctx sdk.Context, k keeper.Keeper, blockHeight BlockHeight, reward types.TaskReward) {
+	err := k.AddPaidReward(ctx, types.PaidReward{
+		TopicId:     reward.TopicId,
+		BlockHeight: blockHeight,
+		Address:     reward.Address,
+		RewardType:  string(reward.Type),
+		Reward:      reward.Reward,
+	})
+	if err != nil {
+		Logger(ctx).Warn(fmt.Sprintf("Failed to record reward paid to %s in topic %d: %s", reward.Address, reward.TopicId, err.Error()))
+	}
+}
+
 func pruneRecordsAfterRewards(
 	ctx sdk.Context,
 	k keeper.Keeper,
@@ -497,12 +699,6 @@ func pruneRecordsAfterRewards(
 	topicId uint64,
 	topicRewardNonce int64,
 ) error {
-	// Delete topic reward nonce
-	err := k.DeleteTopicRewardNonce(ctx, topicId)
-	if err != nil {
-		return errors.Wrapf(err, "failed to delete topic reward nonce")
-	}
-
 	// Get oldest unfulfilled nonce - delete everything behind it
 	unfulfilledNonces, err := k.GetUnfulfilledReputerNonces(ctx, topicId)
 	if err != nil {
@@ -537,6 +733,12 @@ func pruneRecordsAfterRewards(
 		return errors.Wrapf(err, "failed to prune records after rewards")
 	}
 
+	// Prune the history of the topic actors beyond the retention window
+	err = k.PruneActorHistory(ctx, topicId, ctx.BlockHeight())
+	if err != nil {
+		return errors.Wrapf(err, "failed to prune actor history")
+	}
+
 	return nil
 }
 
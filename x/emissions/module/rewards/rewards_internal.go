@@ -228,76 +228,62 @@ func GetStakeWeightedLoss(reputersStakes, reputersReportedLosses []alloraMath.De
 func GetStakeWeightedLossMatrix(
 	reputersAdjustedStakes []alloraMath.Dec,
 	reputersReportedLosses [][]alloraMath.Dec,
+) ([]alloraMath.Dec, []alloraMath.Dec, error) {
+	return GetConsensusLossMatrix(StakeWeightedMeanLossAggregator{}, reputersAdjustedStakes, reputersReportedLosses)
+}
+
+// GetConsensusLossMatrix aggregates the losses reported by reputers for every value of
+// the loss bundles into the consensus vector, using the given loss aggregator.
+// Also returns, for every value, the reported loss most distant from the consensus,
+// which stands in for the losses reputers did not report.
+// L_i - consensus loss vector
+func GetConsensusLossMatrix(
+	lossAggregator LossAggregator,
+	reputersAdjustedStakes []alloraMath.Dec,
+	reputersReportedLosses [][]alloraMath.Dec,
 ) ([]alloraMath.Dec, []alloraMath.Dec, error) {
 	if len(reputersAdjustedStakes) == 0 || len(reputersReportedLosses) == 0 {
 		return nil, nil, types.ErrInvalidSliceLength
 	}
-	var err error = nil
 
-	// Ensure every loss array is non-empty and calculate geometric mean
-	stakeWeightedLoss := make([]alloraMath.Dec, len(reputersReportedLosses[0]))
+	consensusLosses := make([]alloraMath.Dec, len(reputersReportedLosses[0]))
 	mostDistantValues := make([]alloraMath.Dec, len(reputersReportedLosses[0]))
 	for j := 0; j < len(reputersReportedLosses[0]); j++ {
-		// Calculate total stake to consider
 		// Skip stakes of reputers with NaN losses
-		totalStakeToConsider := alloraMath.ZeroDec()
+		stakesToConsider := make([]alloraMath.Dec, 0, len(reputersReportedLosses))
+		lossesToConsider := make([]alloraMath.Dec, 0, len(reputersReportedLosses))
 		for i, losses := range reputersReportedLosses {
-			// Skip if loss is NaN
 			if losses[j].IsNaN() {
 				continue
 			}
-
-			totalStakeToConsider, err = totalStakeToConsider.Add(reputersAdjustedStakes[i])
-			if err != nil {
-				return nil, nil, err
-			}
+			stakesToConsider = append(stakesToConsider, reputersAdjustedStakes[i])
+			lossesToConsider = append(lossesToConsider, losses[j])
 		}
 
-		sum := alloraMath.ZeroDec()
-		for i, losses := range reputersReportedLosses {
-			// Skip if loss is NaN
-			if losses[j].IsNaN() {
-				continue
-			}
-
-			lossesTimesStake, err := losses[j].Mul(reputersAdjustedStakes[i])
-			if err != nil {
-				return nil, nil, err
-			}
-			lossesTimesStakeOverTotalStake, err := lossesTimesStake.Quo(totalStakeToConsider)
-			if err != nil {
-				return nil, nil, err
-			}
-			sum, err = sum.Add(lossesTimesStakeOverTotalStake)
-			if err != nil {
-				return nil, nil, err
-			}
+		consensusLoss, err := lossAggregator.AggregateLosses(stakesToConsider, lossesToConsider)
+		if err != nil {
+			return nil, nil, err
 		}
-		stakeWeightedLoss[j] = sum
+		consensusLosses[j] = consensusLoss
 
 		// Find most distant value from consensus value
 		maxDistance, err := alloraMath.OneDec().Mul(alloraMath.MustNewDecFromString("-1")) // Initialize with an impossible value
 		if err != nil {
 			return nil, nil, err
 		}
-		for _, losses := range reputersReportedLosses {
-			// Skip if loss is NaN
-			if losses[j].IsNaN() {
-				continue
-			}
-
-			distance, err := sum.Sub(losses[j])
+		for _, loss := range lossesToConsider {
+			distance, err := consensusLoss.Sub(loss)
 			if err != nil {
 				return nil, nil, err
 			}
 			if distance.Gt(maxDistance) {
 				maxDistance = distance
-				mostDistantValues[j] = losses[j]
+				mostDistantValues[j] = loss
 			}
 		}
 	}
 
-	return stakeWeightedLoss, mostDistantValues, nil
+	return consensusLosses, mostDistantValues, nil
 }
 
 // GetConsensusScore calculates the proximity to consensus score for a reputer.
@@ -377,7 +363,7 @@ func GetConsensusScore(
 
 // GetAllConsensusScores calculates the proximity to consensus score for all reputers.
 // calculates:
-// T_i - stake weighted total consensus
+// T_i - stake weighted total consensus, aggregated by lossAggregator
 // returns:
 // T_im - reputer score (proximity to consensus)
 func GetAllConsensusScores(
@@ -387,6 +373,7 @@ func GetAllConsensusScores(
 	numReputers int64,
 	epsilonReputer alloraMath.Dec,
 	epsilon alloraMath.Dec,
+	lossAggregator LossAggregator,
 ) ([]alloraMath.Dec, error) {
 	// Get adjusted stakes
 	var adjustedStakes []alloraMath.Dec
@@ -405,9 +392,9 @@ func GetAllConsensusScores(
 	}
 
 	// Get consensus loss vector and retrieve most distant values from
-	consensus, mostDistantValues, err := GetStakeWeightedLossMatrix(adjustedStakes, allLosses)
+	consensus, mostDistantValues, err := GetConsensusLossMatrix(lossAggregator, adjustedStakes, allLosses)
 	if err != nil {
-		return nil, errors.Wrapf(err, "error in GetStakeWeightedLossMatrix")
+		return nil, errors.Wrapf(err, "error in GetConsensusLossMatrix")
 	}
 
 	// Get reputers scores
@@ -440,6 +427,7 @@ func GetAllReputersOutput(
 	epsilon alloraMath.Dec,
 	minStakeFraction alloraMath.Dec,
 	maxGradientThreshold alloraMath.Dec,
+	lossAggregator LossAggregator,
 ) ([]alloraMath.Dec, []alloraMath.Dec, error) {
 	coefficients := make([]alloraMath.Dec, len(initialCoefficients))
 	copy(coefficients, initialCoefficients)
@@ -462,7 +450,7 @@ func GetAllReputersOutput(
 			coeffs := make([]alloraMath.Dec, len(coefficients))
 			copy(coeffs, coefficients)
 
-			scores, err := GetAllConsensusScores(allLosses, stakes, coeffs, numReputers, epsilonReputer, epsilon)
+			scores, err := GetAllConsensusScores(allLosses, stakes, coeffs, numReputers, epsilonReputer, epsilon, lossAggregator)
 			if err != nil {
 				return nil, nil, errors.Wrapf(err, "error in GetAllConsensusScores")
 			}
@@ -473,7 +461,7 @@ func GetAllReputersOutput(
 				return nil, nil, err
 			}
 
-			scores2, err := GetAllConsensusScores(allLosses, stakes, coeffs2, numReputers, epsilonReputer, epsilon)
+			scores2, err := GetAllConsensusScores(allLosses, stakes, coeffs2, numReputers, epsilonReputer, epsilon, lossAggregator)
 			if err != nil {
 				return nil, nil, errors.Wrapf(err, "error in GetAllConsensusScores")
 			}
@@ -608,7 +608,7 @@ func TestGetAllConsensusScores(t *testing.T) {
 	want := []alloraMath.Dec{alloraMath.MustNewDecFromString("5.114259531"), alloraMath.MustNewDecFromString("5.339287075"), alloraMath.MustNewDecFromString("6.538380081"), alloraMath.MustNewDecFromString("2.5952235325"), alloraMath.MustNewDecFromString("3.5870524743")}
 	wantErr := false
 
-	got, err := rewards.GetAllConsensusScores(allLosses, stakes, allListeningCoefficients, numReputers, reputerEpsilon, epsilon)
+	got, err := rewards.GetAllConsensusScores(allLosses, stakes, allListeningCoefficients, numReputers, reputerEpsilon, epsilon, rewards.StakeWeightedMeanLossAggregator{})
 	if (err != nil) != wantErr {
 		t.Errorf("GetAllConsensusScores() error = %v, wantErr %v", err, wantErr)
 		return
@@ -667,6 +667,7 @@ func (s *RewardsTestSuite) TestGetAllReputersOutput() {
 		epsilon,
 		params.MinStakeFraction,
 		params.MaxGradientThreshold,
+		rewards.StakeWeightedMeanLossAggregator{},
 	)
 	require.NoError(err)
 
@@ -681,6 +682,7 @@ func (s *RewardsTestSuite) TestGetAllReputersOutput() {
 		epsilon,
 		params.MinStakeFraction,
 		params.MaxGradientThreshold,
+		rewards.StakeWeightedMeanLossAggregator{},
 	)
 	require.NoError(err)
 
@@ -695,6 +697,7 @@ func (s *RewardsTestSuite) TestGetAllReputersOutput() {
 		epsilon,
 		params.MinStakeFraction,
 		params.MaxGradientThreshold,
+		rewards.StakeWeightedMeanLossAggregator{},
 	)
 	require.NoError(err)
 
@@ -709,6 +712,7 @@ func (s *RewardsTestSuite) TestGetAllReputersOutput() {
 		epsilon,
 		params.MinStakeFraction,
 		params.MaxGradientThreshold,
+		rewards.StakeWeightedMeanLossAggregator{},
 	)
 	require.NoError(err)
 
@@ -786,7 +790,7 @@ func (s *RewardsTestSuite) TestGetAllReputersOutput() {
 	require.True(len(gotScores3) == len(wantScores))
 
 	// Verify score output matches that of GetAllConsensusScores()
-	wantScores3, err := rewards.GetAllConsensusScores(allLosses, stakes, gotCoefficients3, numReputers, params.EpsilonReputer, epsilon)
+	wantScores3, err := rewards.GetAllConsensusScores(allLosses, stakes, gotCoefficients3, numReputers, params.EpsilonReputer, epsilon, rewards.StakeWeightedMeanLossAggregator{})
 	require.NoError(err)
 	if !alloraMath.SlicesInDelta(gotScores3, wantScores3, alloraMath.MustNewDecFromString("0.01")) {
 		log.Println("GetAllConsensusScores() got", gotScores3, "want", wantScores3)
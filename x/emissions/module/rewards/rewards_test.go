@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/log"
 	cosmosMath "cosmossdk.io/math"
@@ -31,6 +32,8 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/stretchr/testify/suite"
@@ -60,13 +63,19 @@ type RewardsTestSuite struct {
 func (s *RewardsTestSuite) SetupTest() {
 	key := storetypes.NewKVStoreKey("emissions")
 	storeService := runtime.NewKVStoreService(key)
-	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
-	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+	// the distribution module has its own store, its keys would collide with those of the other modules
+	distrKey := storetypes.NewKVStoreKey(distrtypes.StoreKey)
+	ctx := testutil.DefaultContextWithKeys(
+		map[string]*storetypes.KVStoreKey{"emissions": key, distrtypes.StoreKey: distrKey},
+		map[string]*storetypes.TransientStoreKey{"transient_test": storetypes.NewTransientStoreKey("transient_test")},
+		nil,
+	).WithHeaderInfo(header.Info{Time: time.Now()})
 	encCfg := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, bank.AppModuleBasic{}, module.AppModule{})
 
 	maccPerms := map[string][]string{
 		"fee_collector":                {"minter"},
-		"mint":                         {"minter"},
+		"mint":                         {"minter", "burner"},
+		distrtypes.ModuleName:          nil,
 		types.AlloraStakingAccountName: {"burner", "minter", "staking"},
 		types.AlloraRewardsAccountName: {"minter"},
 		types.AlloraPendingRewardForDelegatorAccountName: {"minter"},
@@ -110,12 +119,22 @@ func (s *RewardsTestSuite) SetupTest() {
 		codecAddress.NewBech32Codec(sdk.Bech32PrefixValAddr),
 		codecAddress.NewBech32Codec(sdk.Bech32PrefixConsAddr),
 	)
+	distrKeeper := distrkeeper.NewKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(distrKey),
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
 	mintKeeper := mintkeeper.NewKeeper(
 		encCfg.Codec,
 		storeService,
 		stakingKeeper,
 		accountKeeper,
 		bankKeeper,
+		distrKeeper,
 		emissionsKeeper,
 		authtypes.FeeCollectorName,
 	)
@@ -1698,6 +1717,14 @@ func (s *RewardsTestSuite) TestRewardsIncreasesBalance() {
 	for i, addr := range workerAddrs {
 		s.Require().True(s.bankKeeper.GetBalance(s.ctx, addr, params.DefaultBondDenom).Amount.GT(workerBalances[i].Amount))
 	}
+
+	// Paid rewards are recorded in the history of the actors
+	for _, addr := range append(workerAddrs, reputerAddrs...) {
+		rewards, _, err := s.emissionsKeeper.GetActorRewardHistory(s.ctx, addr.String(), topicId, 0, 0, nil)
+		s.Require().NoError(err)
+		s.Require().NotEmpty(rewards, "rewards paid to %s should be recorded", addr.String())
+		s.Require().Equal(block, rewards[0].BlockHeight)
+	}
 }
 
 func (s *RewardsTestSuite) TestRewardsHandleStandardDeviationOfZero() {
@@ -3221,3 +3248,127 @@ func (s *RewardsTestSuite) TestRewardForRemainingParticipantsGoUpWhenParticipant
 	require.True(reputer2_Reward0.GT(cosmosMath.ZeroInt()))
 	require.True(reputer2_Reward1.Equal(cosmosMath.ZeroInt()))
 }
+
+func (s *RewardsTestSuite) TestGenerateRewardsDistributionStoresRewardBreakdown() {
+	require := s.Require()
+	blockHeight := int64(100)
+	s.ctx = s.ctx.WithBlockHeight(blockHeight)
+
+	workerAddrs := []sdk.AccAddress{s.addrs[0], s.addrs[1], s.addrs[2]}
+	reputerAddrs := []sdk.AccAddress{s.addrs[3], s.addrs[4], s.addrs[5]}
+	stake := cosmosMath.NewInt(1000000000000000000).Mul(inference_synthesis.CosmosIntOneE18())
+	topicId := s.setUpTopic(blockHeight, workerAddrs, reputerAddrs, stake, alloraMath.MustNewDecFromString("0.1"))
+
+	workerValues := []TestWorkerValue{
+		{Address: s.addrs[0], Value: "0.1"},
+		{Address: s.addrs[1], Value: "0.2"},
+		{Address: s.addrs[2], Value: "0.3"},
+	}
+	reputerValues := []TestWorkerValue{
+		{Address: s.addrs[3], Value: "0.1"},
+		{Address: s.addrs[4], Value: "0.2"},
+		{Address: s.addrs[5], Value: "0.3"},
+	}
+	rewardsDistribution := s.getRewardsDistribution(topicId, blockHeight, workerValues, reputerValues, workerAddrs[0], "0.1", "0.1")
+
+	breakdowns, err := s.emissionsKeeper.GetRewardBreakdowns(s.ctx, topicId, blockHeight)
+	require.NoError(err)
+	require.Len(breakdowns.Horizons, 1)
+	breakdown := breakdowns.Horizons[0]
+	require.Equal(topicId, breakdown.TopicId)
+	require.Equal(blockHeight, breakdown.BlockHeight)
+	require.Equal(types.Horizon(0), breakdown.Horizon)
+
+	taskRewards, err := alloraMath.SumDecSlice([]alloraMath.Dec{
+		breakdown.InferenceTaskReward,
+		breakdown.ForecastingTaskReward,
+		breakdown.ReputerTaskReward,
+	})
+	require.NoError(err)
+	require.True(alloraMath.InDelta(breakdown.TopicReward, taskRewards, alloraMath.MustNewDecFromString("0.0001")))
+
+	actorTypeOfRewardType := map[types.TaskRewardType]types.ActorType{
+		types.WorkerInferenceRewardType:     types.ActorType_INFERER,
+		types.WorkerForecastRewardType:      types.ActorType_FORECASTER,
+		types.ReputerAndDelegatorRewardType: types.ActorType_REPUTER,
+	}
+	require.Len(breakdown.Actors, len(rewardsDistribution))
+	for _, reward := range rewardsDistribution {
+		found := false
+		for _, actor := range breakdown.Actors {
+			if actor.Address == reward.Address && actor.ActorType == actorTypeOfRewardType[reward.Type] {
+				found = true
+				require.True(alloraMath.InDelta(reward.Reward, actor.Reward, alloraMath.MustNewDecFromString("0.00001")))
+			}
+		}
+		require.True(found, "%v reward of %v should be in the breakdown", reward.Type, reward.Address)
+	}
+}
+
+func (s *RewardsTestSuite) TestSimulateTopicRewardsMatchesRewardsWithoutWritingState() {
+	require := s.Require()
+	blockHeight := int64(100)
+
+	workerAddrs := []sdk.AccAddress{s.addrs[0], s.addrs[1], s.addrs[2]}
+	reputerAddrs := []sdk.AccAddress{s.addrs[3], s.addrs[4], s.addrs[5]}
+	stake := cosmosMath.NewInt(1000000000000000000).Mul(inference_synthesis.CosmosIntOneE18())
+	topicId := s.setUpTopic(blockHeight, workerAddrs, reputerAddrs, stake, alloraMath.MustNewDecFromString("0.1"))
+
+	workerValues := []TestWorkerValue{
+		{Address: s.addrs[0], Value: "0.1"},
+		{Address: s.addrs[1], Value: "0.2"},
+		{Address: s.addrs[2], Value: "0.3"},
+	}
+	reputerValues := []TestWorkerValue{
+		{Address: s.addrs[3], Value: "0.1"},
+		{Address: s.addrs[4], Value: "0.2"},
+		{Address: s.addrs[5], Value: "0.3"},
+	}
+
+	err := s.emissionsKeeper.AddWorkerNonce(s.ctx, topicId, &types.Nonce{BlockHeight: blockHeight})
+	require.NoError(err)
+	err = s.emissionsKeeper.AddReputerNonce(s.ctx, topicId, &types.Nonce{BlockHeight: blockHeight})
+	require.NoError(err)
+	_, err = s.msgServer.InsertBulkWorkerPayload(s.ctx, &types.MsgInsertBulkWorkerPayload{
+		Sender:            workerAddrs[0].String(),
+		Nonce:             &types.Nonce{BlockHeight: blockHeight},
+		TopicId:           topicId,
+		WorkerDataBundles: GenerateSimpleWorkerDataBundles(s, topicId, blockHeight, workerValues, reputerAddrs),
+	})
+	require.NoError(err)
+	lossBundles := GenerateSimpleLossBundles(s, topicId, blockHeight, workerValues, reputerValues, workerAddrs[0], "0.1", "0.1")
+	_, err = s.msgServer.InsertBulkReputerPayload(s.ctx, &types.MsgInsertBulkReputerPayload{
+		Sender:              reputerAddrs[0].String(),
+		TopicId:             topicId,
+		ReputerRequestNonce: &types.ReputerRequestNonce{ReputerNonce: &types.Nonce{BlockHeight: blockHeight}},
+		ReputerValueBundles: lossBundles.ReputerValueBundles,
+	})
+	require.NoError(err)
+	s.MintTokensToModule(types.AlloraRewardsAccountName, cosmosMath.NewInt(10000000000))
+
+	cacheCtx, _ := s.ctx.CacheContext()
+	epochEnd, topicReward, horizonRewards, err := rewards.SimulateTopicRewards(cacheCtx, s.emissionsKeeper, topicId)
+	require.NoError(err)
+	require.Equal(blockHeight, epochEnd, "A topic whose epoch never ended can be rewarded at the current block")
+	require.True(topicReward.IsPositive())
+	require.Len(horizonRewards, 1)
+	require.Equal(blockHeight, horizonRewards[0].RewardNonce)
+	require.NotEmpty(horizonRewards[0].Rewards)
+
+	// Nothing was written outside of the cached context
+	_, err = s.emissionsKeeper.GetRewardBreakdowns(s.ctx, topicId, blockHeight)
+	require.ErrorIs(err, collections.ErrNotFound)
+
+	params, err := s.emissionsKeeper.GetParams(s.ctx)
+	require.NoError(err)
+	rewardsDistribution, _, err := rewards.GenerateRewardsDistributionByTopicParticipant(s.ctx, s.emissionsKeeper, topicId, &topicReward, blockHeight, params)
+	require.NoError(err)
+	require.True(areTaskRewardsEqualIgnoringTopicId(s, rewardsDistribution, horizonRewards[0].Rewards))
+
+	// Once the horizon has been rewarded, the losses of the last reputer payload are not rewarded again
+	err = s.emissionsKeeper.DeleteTopicHorizonRewardNonce(cacheCtx, topicId, 0)
+	require.NoError(err)
+	_, _, horizonRewards, err = rewards.SimulateTopicRewards(cacheCtx, s.emissionsKeeper, topicId)
+	require.NoError(err)
+	require.Empty(horizonRewards)
+}
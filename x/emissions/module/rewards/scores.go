@@ -22,6 +22,20 @@ func GenerateReputerScores(
 	topicId uint64,
 	block int64,
 	reportedLosses types.ReputerValueBundles,
+) ([]types.Score, error) {
+	return GenerateReputerScoresForHorizon(ctx, keeper, topicId, 0, block, reportedLosses)
+}
+
+// GenerateReputerScoresForHorizon calculates and persists scores for reputers based on the losses reported for a horizon.
+// Listening coefficients, latest scores and the score history are kept per topic, so only the first horizon
+// updates them; the scores of the other horizons are stored apart, keyed by horizon.
+func GenerateReputerScoresForHorizon(
+	ctx sdk.Context,
+	keeper keeper.Keeper,
+	topicId uint64,
+	horizon types.Horizon,
+	block int64,
+	reportedLosses types.ReputerValueBundles,
 ) ([]types.Score, error) {
 	// Ensure all workers are present in the reported losses
 	// This is necessary to ensure that all workers are accounted for in the final scores
@@ -81,6 +95,7 @@ func GenerateReputerScores(
 		topic.Epsilon,
 		params.MinStakeFraction,
 		params.MaxGradientThreshold,
+		NewLossAggregator(topic),
 	)
 	if err != nil {
 		return []types.Score{}, errors.Wrapf(err, "Error getting GetAllReputersOutput")
@@ -89,14 +104,16 @@ func GenerateReputerScores(
 	// Insert new coeffients and scores
 	var newScores []types.Score
 	for i, reputer := range reputers {
-		err := keeper.SetListeningCoefficient(
-			ctx,
-			topicId,
-			reputer,
-			types.ListeningCoefficient{Coefficient: newCoefficients[i]},
-		)
-		if err != nil {
-			return []types.Score{}, errors.Wrapf(err, "Error setting listening coefficient")
+		if horizon == 0 {
+			err := keeper.SetListeningCoefficient(
+				ctx,
+				topicId,
+				reputer,
+				types.ListeningCoefficient{Coefficient: newCoefficients[i]},
+			)
+			if err != nil {
+				return []types.Score{}, errors.Wrapf(err, "Error setting listening coefficient")
+			}
 		}
 
 		newScore := types.Score{
@@ -105,13 +122,15 @@ func GenerateReputerScores(
 			Address:     reputer,
 			Score:       scores[i],
 		}
-		err = keeper.InsertReputerScore(ctx, topicId, block, newScore)
+		err = keeper.InsertReputerScoreForHorizon(ctx, topicId, horizon, block, newScore)
 		if err != nil {
 			return []types.Score{}, errors.Wrapf(err, "Error inserting reputer score")
 		}
-		err = keeper.SetLatestReputerScore(ctx, topicId, reputer, newScore)
-		if err != nil {
-			return []types.Score{}, errors.Wrapf(err, "Error setting latest reputer score")
+		if horizon == 0 {
+			err = keeper.SetLatestReputerScore(ctx, topicId, reputer, newScore)
+			if err != nil {
+				return []types.Score{}, errors.Wrapf(err, "Error setting latest reputer score")
+			}
 		}
 		newScores = append(newScores, newScore)
 	}
@@ -127,6 +146,19 @@ func GenerateInferenceScores(
 	topicId uint64,
 	block int64,
 	networkLosses types.ValueBundle,
+) ([]types.Score, error) {
+	return GenerateInferenceScoresForHorizon(ctx, keeper, topicId, 0, block, networkLosses)
+}
+
+// GenerateInferenceScoresForHorizon calculates and persists scores for workers based on their inference
+// task performance at a horizon. Only the first horizon updates the latest scores and the score history.
+func GenerateInferenceScoresForHorizon(
+	ctx sdk.Context,
+	keeper keeper.Keeper,
+	topicId uint64,
+	horizon types.Horizon,
+	block int64,
+	networkLosses types.ValueBundle,
 ) ([]types.Score, error) {
 	var newScores []types.Score
 
@@ -139,7 +171,7 @@ func GenerateInferenceScores(
 			Address:     networkLosses.InfererValues[0].Worker,
 			Score:       alloraMath.ZeroDec(),
 		}
-		err := keeper.InsertWorkerInferenceScore(ctx, topicId, block, newScore)
+		err := keeper.InsertWorkerInferenceScoreForHorizon(ctx, topicId, horizon, block, newScore)
 		if err != nil {
 			return []types.Score{}, errors.Wrapf(err, "Error inserting worker inference score")
 		}
@@ -159,13 +191,15 @@ func GenerateInferenceScores(
 			Address:     oneOutLoss.Worker,
 			Score:       workerNewScore,
 		}
-		err = keeper.InsertWorkerInferenceScore(ctx, topicId, block, newScore)
+		err = keeper.InsertWorkerInferenceScoreForHorizon(ctx, topicId, horizon, block, newScore)
 		if err != nil {
 			return []types.Score{}, errors.Wrapf(err, "Error inserting worker inference score")
 		}
-		err = keeper.SetLatestInfererScore(ctx, topicId, oneOutLoss.Worker, newScore)
-		if err != nil {
-			return []types.Score{}, errors.Wrapf(err, "error setting latest inferer score")
+		if horizon == 0 {
+			err = keeper.SetLatestInfererScore(ctx, topicId, oneOutLoss.Worker, newScore)
+			if err != nil {
+				return []types.Score{}, errors.Wrapf(err, "error setting latest inferer score")
+			}
 		}
 		newScores = append(newScores, newScore)
 	}
@@ -181,6 +215,19 @@ func GenerateForecastScores(
 	topicId uint64,
 	block int64,
 	networkLosses types.ValueBundle,
+) ([]types.Score, error) {
+	return GenerateForecastScoresForHorizon(ctx, keeper, topicId, 0, block, networkLosses)
+}
+
+// GenerateForecastScoresForHorizon calculates and persists scores for workers based on their forecast
+// task performance at a horizon. Only the first horizon updates the latest scores and the score history.
+func GenerateForecastScoresForHorizon(
+	ctx sdk.Context,
+	keeper keeper.Keeper,
+	topicId uint64,
+	horizon types.Horizon,
+	block int64,
+	networkLosses types.ValueBundle,
 ) ([]types.Score, error) {
 	var newScores []types.Score
 
@@ -193,7 +240,7 @@ func GenerateForecastScores(
 			Address:     networkLosses.InfererValues[0].Worker,
 			Score:       alloraMath.ZeroDec(),
 		}
-		err := keeper.InsertWorkerForecastScore(ctx, topicId, block, newScore)
+		err := keeper.InsertWorkerForecastScoreForHorizon(ctx, topicId, horizon, block, newScore)
 		if err != nil {
 			return []types.Score{}, errors.Wrapf(err, "Error inserting worker inference score")
 		}
@@ -237,13 +284,15 @@ func GenerateForecastScores(
 			Address:     oneInNaiveLoss.Worker,
 			Score:       workerFinalScore,
 		}
-		err = keeper.InsertWorkerForecastScore(ctx, topicId, block, newScore)
+		err = keeper.InsertWorkerForecastScoreForHorizon(ctx, topicId, horizon, block, newScore)
 		if err != nil {
 			return []types.Score{}, errors.Wrapf(err, "Error inserting worker forecast score")
 		}
-		err = keeper.SetLatestForecasterScore(ctx, topicId, oneInNaiveLoss.Worker, newScore)
-		if err != nil {
-			return []types.Score{}, errors.Wrapf(err, "Error setting latest forecaster score")
+		if horizon == 0 {
+			err = keeper.SetLatestForecasterScore(ctx, topicId, oneInNaiveLoss.Worker, newScore)
+			if err != nil {
+				return []types.Score{}, errors.Wrapf(err, "Error setting latest forecaster score")
+			}
 		}
 		newScores = append(newScores, newScore)
 	}
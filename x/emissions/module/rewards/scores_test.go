@@ -121,6 +121,80 @@ func (s *RewardsTestSuite) TestHigherOneOutLossesHigherInferenceScore() {
 	require.True(scores0[0].Score.Lt(scores1[0].Score))
 }
 
+// Two horizons of a topic scored at the same block must not mix their scores,
+// and only the first horizon feeds the topic scores and the actor history.
+func (s *RewardsTestSuite) TestInferenceScoresOfTwoHorizonsAtSameBlockAreKeptApart() {
+	topicId := uint64(1)
+	block := int64(1003)
+	require := s.Require()
+
+	networkLosses0, err := mockSimpleNetworkLosses(s, topicId, block, "0.1")
+	require.NoError(err)
+	networkLosses1, err := mockSimpleNetworkLosses(s, topicId, block, "0.2")
+	require.NoError(err)
+
+	scores0, err := rewards.GenerateInferenceScoresForHorizon(s.ctx, s.emissionsKeeper, topicId, 0, block, networkLosses0)
+	require.NoError(err)
+	scores1, err := rewards.GenerateInferenceScoresForHorizon(s.ctx, s.emissionsKeeper, topicId, 1, block, networkLosses1)
+	require.NoError(err)
+	require.True(scores0[0].Score.Lt(scores1[0].Score))
+
+	topicScores, err := s.emissionsKeeper.GetWorkerInferenceScoresAtBlock(s.ctx, topicId, block)
+	require.NoError(err)
+	require.Len(topicScores.Scores, len(scores0))
+	for i, score := range topicScores.Scores {
+		require.Equal(scores0[i], *score)
+	}
+
+	horizonScores, err := s.emissionsKeeper.GetWorkerInferenceScoresAtBlockForHorizon(s.ctx, topicId, 1, block)
+	require.NoError(err)
+	require.Len(horizonScores.Scores, len(scores1))
+	for i, score := range horizonScores.Scores {
+		require.Equal(scores1[i], *score)
+	}
+
+	scoresUntilBlock, err := s.emissionsKeeper.GetInferenceScoresUntilBlockForHorizon(s.ctx, topicId, 1, block)
+	require.NoError(err)
+	require.Len(scoresUntilBlock, len(scores1))
+
+	history, _, err := s.emissionsKeeper.GetActorScoreHistory(
+		s.ctx, topicId, scores0[0].Address, types.ActorType_INFERER, 0, 0, nil)
+	require.NoError(err)
+	require.Len(history, 1)
+	require.Equal(scores0[0], *history[0])
+}
+
+// Two horizons of a topic scored at the same block must not mix their forecaster scores.
+func (s *RewardsTestSuite) TestForecastScoresOfTwoHorizonsAtSameBlockAreKeptApart() {
+	topicId := uint64(1)
+	block := int64(1003)
+	require := s.Require()
+
+	networkLosses0, err := mockSimpleNetworkLosses(s, topicId, block, "0.1")
+	require.NoError(err)
+	networkLosses1, err := mockSimpleNetworkLosses(s, topicId, block, "0.2")
+	require.NoError(err)
+
+	scores0, err := rewards.GenerateForecastScoresForHorizon(s.ctx, s.emissionsKeeper, topicId, 0, block, networkLosses0)
+	require.NoError(err)
+	scores1, err := rewards.GenerateForecastScoresForHorizon(s.ctx, s.emissionsKeeper, topicId, 1, block, networkLosses1)
+	require.NoError(err)
+
+	topicScores, err := s.emissionsKeeper.GetWorkerForecastScoresAtBlock(s.ctx, topicId, block)
+	require.NoError(err)
+	require.Len(topicScores.Scores, len(scores0))
+	for i, score := range topicScores.Scores {
+		require.Equal(scores0[i], *score)
+	}
+
+	horizonScores, err := s.emissionsKeeper.GetWorkerForecastScoresAtBlockForHorizon(s.ctx, topicId, 1, block)
+	require.NoError(err)
+	require.Len(horizonScores.Scores, len(scores1))
+	for i, score := range horizonScores.Scores {
+		require.Equal(scores1[i], *score)
+	}
+}
+
 func (s *RewardsTestSuite) TestGetForecastScores() {
 	topicId := uint64(1)
 	block := int64(1003)
@@ -0,0 +1,84 @@
+package rewards
+
+import (
+	"fmt"
+
+	"cosmossdk.io/errors"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Rewards a horizon of a topic would be paid from the losses reported at a reward nonce
+type HorizonRewards struct {
+	Horizon     types.Horizon
+	RewardNonce BlockHeight
+	Rewards     []types.TaskReward
+}
+
+// Projects the rewards of a topic at the end of its current epoch, as EmitRewards would pay them.
+// The topic reward is its share of the total reward to distribute among the top rewardable topics,
+// weighted by GetAndUpdateActiveTopicWeights, and each horizon is rewarded from the losses of its
+// pending reward nonce. Horizons without a pending reward nonce are skipped.
+// Writes to the store like the rewarding it mirrors, so it must run on a context whose writes are discarded.
+func SimulateTopicRewards(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	topicId TopicId,
+) (
+	epochEnd BlockHeight,
+	topicReward alloraMath.Dec,
+	horizonRewards []HorizonRewards,
+	err error,
+) {
+	topic, err := k.GetTopic(ctx, topicId)
+	if err != nil {
+		return 0, alloraMath.Dec{}, nil, errors.Wrapf(err, "failed to get topic")
+	}
+	epochEnd = ctx.BlockHeight()
+	if topic.EpochLastEnded != 0 {
+		epochEnd = topic.EpochLastEnded + topic.EpochLength
+	}
+
+	totalReward, err := k.GetTotalRewardToDistribute(ctx)
+	if err != nil {
+		return 0, alloraMath.Dec{}, nil, errors.Wrapf(err, "failed to get total reward to distribute")
+	}
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return 0, alloraMath.Dec{}, nil, errors.Wrapf(err, "failed to get module params")
+	}
+	weights, _, _, err := GetAndUpdateActiveTopicWeights(ctx, k, epochEnd)
+	if err != nil {
+		return 0, alloraMath.Dec{}, nil, errors.Wrapf(err, "failed to get active topic weights")
+	}
+
+	_, topicRewards, err := GetTopTopicRewards(ctx, k, weights, totalReward, moduleParams.MaxTopicsPerBlock)
+	if err != nil {
+		return 0, alloraMath.Dec{}, nil, err
+	}
+	if topicRewards[topicId] == nil {
+		return epochEnd, alloraMath.ZeroDec(), []HorizonRewards{}, nil
+	}
+	topicReward = *topicRewards[topicId]
+
+	horizonRewardShares, err := GetHorizonRewardShares(ctx, k, topic, topicReward)
+	if err != nil {
+		return 0, alloraMath.Dec{}, nil, err
+	}
+	horizonRewards = make([]HorizonRewards, 0, len(horizonRewardShares))
+	for _, share := range horizonRewardShares {
+		rewards, _, err := GenerateRewardsDistributionByTopicParticipantForHorizon(ctx, k, topicId, share.Horizon, &share.Reward, share.RewardNonce, moduleParams)
+		if err != nil {
+			Logger(ctx).Debug(fmt.Sprintf("Skipping simulated rewards of topic %d at horizon %d: %s", topicId, share.Horizon, err.Error()))
+			continue
+		}
+		horizonRewards = append(horizonRewards, HorizonRewards{
+			Horizon:     share.Horizon,
+			RewardNonce: share.RewardNonce,
+			Rewards:     rewards,
+		})
+	}
+	return epochEnd, topicReward, horizonRewards, nil
+}
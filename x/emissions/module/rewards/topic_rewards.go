@@ -148,6 +148,11 @@ func PickChurnableActiveTopics(
 				continue
 			}
 			ctx.Logger().Debug(fmt.Sprintf("Added worker nonce for topic %d: %v \n", topic.Id, nextNonce.BlockHeight))
+			// Actors that do not answer the nonces opened for them miss epochs towards their removal
+			err = k.AddTopicEpochHeight(ctx, topic, block)
+			if err != nil {
+				ctx.Logger().Warn(fmt.Sprintf("Error adding topic epoch height: %s", err.Error()))
+			}
 			// To notify topic handler that the topic is ready for churn i.e. requests to be sent to workers and reputers
 			err = k.AddChurnableTopic(ctx, topic.Id)
 			if err != nil {
@@ -163,7 +168,7 @@ func PickChurnableActiveTopics(
 				MaxUnfulfilledReputerRequests = moduleParams.MaxUnfulfilledReputerRequests
 			}
 			// Adding one to cover for one extra epochLength
-			reputerPruningBlock := block - (int64(MaxUnfulfilledReputerRequests+1)*topic.EpochLength + topic.GroundTruthLag)
+			reputerPruningBlock := block - (int64(MaxUnfulfilledReputerRequests+1)*topic.EpochLength + topic.GetMaxHorizonLag())
 			if reputerPruningBlock > 0 {
 				ctx.Logger().Warn(fmt.Sprintf("Pruning reputer nonces before block: %v for topic: %d on block: %v", reputerPruningBlock, topic.Id, block))
 				k.PruneReputerNonces(ctx, topic.Id, reputerPruningBlock)
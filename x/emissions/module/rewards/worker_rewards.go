@@ -20,7 +20,21 @@ func GetInferenceTaskRewardFractions(
 	cReward alloraMath.Dec,
 	latestScores []types.Score,
 ) ([]string, []alloraMath.Dec, error) {
-	return GetWorkersRewardFractions(ctx, k, topicId, blockHeight, TASK_INFERENCE, pReward, cReward, latestScores)
+	return GetWorkersRewardFractionsForHorizon(ctx, k, topicId, 0, blockHeight, TASK_INFERENCE, pReward, cReward, latestScores)
+}
+
+// GetInferenceTaskRewardFractionsForHorizon scales the inferer scores of a horizon against the scores of that horizon
+func GetInferenceTaskRewardFractionsForHorizon(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	topicId uint64,
+	horizon types.Horizon,
+	blockHeight int64,
+	pReward alloraMath.Dec,
+	cReward alloraMath.Dec,
+	latestScores []types.Score,
+) ([]string, []alloraMath.Dec, error) {
+	return GetWorkersRewardFractionsForHorizon(ctx, k, topicId, horizon, blockHeight, TASK_INFERENCE, pReward, cReward, latestScores)
 }
 
 func GetForecastingTaskRewardFractions(
@@ -32,7 +46,21 @@ func GetForecastingTaskRewardFractions(
 	cReward alloraMath.Dec,
 	latestScores []types.Score,
 ) ([]string, []alloraMath.Dec, error) {
-	return GetWorkersRewardFractions(ctx, k, topicId, blockHeight, TASK_FORECAST, pReward, cReward, latestScores)
+	return GetWorkersRewardFractionsForHorizon(ctx, k, topicId, 0, blockHeight, TASK_FORECAST, pReward, cReward, latestScores)
+}
+
+// GetForecastingTaskRewardFractionsForHorizon scales the forecaster scores of a horizon against the scores of that horizon
+func GetForecastingTaskRewardFractionsForHorizon(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	topicId uint64,
+	horizon types.Horizon,
+	blockHeight int64,
+	pReward alloraMath.Dec,
+	cReward alloraMath.Dec,
+	latestScores []types.Score,
+) ([]string, []alloraMath.Dec, error) {
+	return GetWorkersRewardFractionsForHorizon(ctx, k, topicId, horizon, blockHeight, TASK_FORECAST, pReward, cReward, latestScores)
 }
 
 func GetWorkersRewardFractions(
@@ -44,6 +72,21 @@ func GetWorkersRewardFractions(
 	pReward alloraMath.Dec,
 	cReward alloraMath.Dec,
 	latestScores []types.Score,
+) ([]string, []alloraMath.Dec, error) {
+	return GetWorkersRewardFractionsForHorizon(ctx, k, topicId, 0, blockHeight, which, pReward, cReward, latestScores)
+}
+
+// GetWorkersRewardFractionsForHorizon reads the scores of the latest time steps of the horizon being rewarded
+func GetWorkersRewardFractionsForHorizon(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	topicId uint64,
+	horizon types.Horizon,
+	blockHeight int64,
+	which bool,
+	pReward alloraMath.Dec,
+	cReward alloraMath.Dec,
+	latestScores []types.Score,
 ) ([]string, []alloraMath.Dec, error) {
 	// Get all latest score for each worker and the scores from the latest time steps
 	// to be used in the standard deviantion
@@ -58,7 +101,7 @@ func GetWorkersRewardFractions(
 		}
 
 		// Get worker scores from the latest time steps
-		latestScoresFromLastestTimeSteps, err := k.GetInferenceScoresUntilBlock(ctx, topicId, blockHeight)
+		latestScoresFromLastestTimeSteps, err := k.GetInferenceScoresUntilBlockForHorizon(ctx, topicId, horizon, blockHeight)
 		if err != nil {
 			return []string{}, []alloraMath.Dec{}, errors.Wrapf(err, "failed to get worker inference scores from the latest time steps")
 		}
@@ -76,7 +119,7 @@ func GetWorkersRewardFractions(
 		}
 
 		// Get worker scores from the latest time steps
-		latestScoresFromLastestTimeSteps, err := k.GetForecastScoresUntilBlock(ctx, topicId, blockHeight)
+		latestScoresFromLastestTimeSteps, err := k.GetForecastScoresUntilBlockForHorizon(ctx, topicId, horizon, blockHeight)
 		if err != nil {
 			return []string{}, []alloraMath.Dec{}, errors.Wrapf(err, "failed to get worker forecast scores from the latest time steps")
 		}
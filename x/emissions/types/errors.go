@@ -67,4 +67,21 @@ var (
 	ErrStakeRemovalNotFound                     = errors.Register(ModuleName, 64, "No stake removal found")
 	ErrInvariantFailure                         = errors.Register(ModuleName, 65, "invariant failure")
 	ErrIntegerUnderflowReputerStakeAuthority    = errors.Register(ModuleName, 66, "integer underflow for reputer stake authority")
+	ErrNotTopicCreator                          = errors.Register(ModuleName, 67, "not topic creator")
+	ErrNotInTopicWorkerAllowlist                = errors.Register(ModuleName, 68, "not in topic worker allowlist")
+	ErrNotInTopicReputerAllowlist               = errors.Register(ModuleName, 69, "not in topic reputer allowlist")
+	ErrInvalidTopicAllowlistMode                = errors.Register(ModuleName, 70, "invalid topic allowlist mode")
+	ErrNotParamsAdmin                           = errors.Register(ModuleName, 71, "not params admin")
+	ErrNotPermittedToCreateTopic                = errors.Register(ModuleName, 72, "not permitted to create topic")
+	ErrInvalidRole                              = errors.Register(ModuleName, 73, "invalid role")
+	ErrAddressNotRegisteredInAnyTopic           = errors.Register(ModuleName, 74, "address is not registered in any topic")
+	ErrLibP2PKeyAlreadyInUse                    = errors.Register(ModuleName, 75, "libp2p key is already in use by another node")
+	ErrDuplicateTopicId                         = errors.Register(ModuleName, 76, "duplicate topic id")
+	ErrInvalidCombiner                          = errors.Register(ModuleName, 77, "invalid combiner")
+	ErrInvalidOutputDimension                   = errors.Register(ModuleName, 78, "values do not match topic output dimension")
+	ErrInvalidProbabilityDistribution           = errors.Register(ModuleName, 79, "values are not a probability distribution")
+	ErrInvalidTopicOutputType                   = errors.Register(ModuleName, 80, "invalid topic output type")
+	ErrInvalidHorizon                           = errors.Register(ModuleName, 81, "invalid topic horizon")
+	ErrInvalidLossAggregator                    = errors.Register(ModuleName, 82, "invalid loss aggregator")
+	ErrInvalidActorType                         = errors.Register(ModuleName, 83, "invalid actor type")
 )
@@ -29,8 +29,12 @@ func EmitNewReputerScoresSetEvent(ctx sdk.Context, scores []Score) {
 	ctx.EventManager().EmitTypedEvent(NewScoresSetEventBase(ActorType_REPUTER, scores))
 }
 
-func EmitNewNetworkLossSetEvent(ctx sdk.Context, topicId TopicId, blockHeight BlockHeight, lossBundle ValueBundle) {
-	ctx.EventManager().EmitTypedEvent(NewNetworkLossSetEventBase(topicId, blockHeight, lossBundle))
+func EmitNewNetworkLossSetEvent(ctx sdk.Context, topicId TopicId, horizon uint32, blockHeight BlockHeight, lossBundle ValueBundle) {
+	ctx.EventManager().EmitTypedEvent(NewNetworkLossSetEventBase(topicId, horizon, blockHeight, lossBundle))
+}
+
+func EmitNewNetworkInferenceWeightsSetEvent(ctx sdk.Context, weights NetworkInferenceWeights) {
+	ctx.EventManager().EmitTypedEvent(NewNetworkInferenceWeightsSetEventBase(weights))
 }
 
 func EmitNewInfererRewardsSettledEvent(ctx sdk.Context, blockHeight BlockHeight, rewards []TaskReward) {
@@ -54,6 +58,17 @@ func EmitNewReputerAndDelegatorRewardsSettledEvent(ctx sdk.Context, blockHeight
 	ctx.EventManager().EmitTypedEvent(NewRewardsSetEventBase(ActorType_REPUTER, blockHeight, rewards))
 }
 
+func EmitNewNodeInfoUpdatedEvent(ctx sdk.Context, isReputer bool, nodeInfo OffchainNode, previousLibP2PKeys []string, topicIds []TopicId) {
+	ctx.EventManager().EmitTypedEvent(NewNodeInfoUpdatedEventBase(isReputer, nodeInfo, previousLibP2PKeys, topicIds))
+}
+
+func EmitNewInactiveActorsRemovedEvent(ctx sdk.Context, topicId TopicId, blockHeight BlockHeight, workers []string, reputers []string) {
+	if len(workers) < 1 && len(reputers) < 1 {
+		return
+	}
+	ctx.EventManager().EmitTypedEvent(NewInactiveActorsRemovedEventBase(topicId, blockHeight, workers, reputers))
+}
+
 /// Utils
 
 // Assumes length of `scores` is at least 1
@@ -93,10 +108,56 @@ func NewRewardsSetEventBase(actorType ActorType, blockHeight BlockHeight, reward
 	}
 }
 
-func NewNetworkLossSetEventBase(topicId TopicId, blockHeight BlockHeight, lossValueBundle ValueBundle) proto.Message {
+func NewNetworkLossSetEventBase(topicId TopicId, horizon uint32, blockHeight BlockHeight, lossValueBundle ValueBundle) proto.Message {
 	return &EventNetworkLossSet{
 		TopicId:     topicId,
 		BlockHeight: blockHeight,
 		ValueBundle: &lossValueBundle,
+		Horizon:     horizon,
+	}
+}
+
+func NewNetworkInferenceWeightsSetEventBase(weights NetworkInferenceWeights) proto.Message {
+	inferers := make([]string, len(weights.InfererWeights))
+	infererWeights := make([]alloraMath.Dec, len(weights.InfererWeights))
+	for i, weight := range weights.InfererWeights {
+		inferers[i] = weight.Worker
+		infererWeights[i] = weight.Weight
+	}
+	forecasters := make([]string, len(weights.ForecasterWeights))
+	forecasterWeights := make([]alloraMath.Dec, len(weights.ForecasterWeights))
+	for i, weight := range weights.ForecasterWeights {
+		forecasters[i] = weight.Worker
+		forecasterWeights[i] = weight.Weight
+	}
+	return &EventNetworkInferenceWeightsSet{
+		TopicId:           weights.TopicId,
+		BlockHeight:       weights.BlockHeight,
+		Horizon:           weights.Horizon,
+		Inferers:          inferers,
+		InfererWeights:    infererWeights,
+		Forecasters:       forecasters,
+		ForecasterWeights: forecasterWeights,
+	}
+}
+
+func NewNodeInfoUpdatedEventBase(isReputer bool, nodeInfo OffchainNode, previousLibP2PKeys []string, topicIds []TopicId) proto.Message {
+	return &EventNodeInfoUpdated{
+		IsReputer:          isReputer,
+		NodeAddress:        nodeInfo.NodeAddress,
+		PreviousLibP2PKeys: previousLibP2PKeys,
+		LibP2PKey:          nodeInfo.LibP2PKey,
+		MultiAddress:       nodeInfo.MultiAddress,
+		Owner:              nodeInfo.Owner,
+		TopicIds:           topicIds,
+	}
+}
+
+func NewInactiveActorsRemovedEventBase(topicId TopicId, blockHeight BlockHeight, workers []string, reputers []string) proto.Message {
+	return &EventInactiveActorsRemoved{
+		TopicId:     topicId,
+		BlockHeight: blockHeight,
+		Workers:     workers,
+		Reputers:    reputers,
 	}
 }
@@ -194,6 +194,7 @@ type EventNetworkLossSet struct {
 	TopicId     uint64       `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
 	BlockHeight int64        `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 	ValueBundle *ValueBundle `protobuf:"bytes,3,opt,name=value_bundle,json=valueBundle,proto3" json:"value_bundle,omitempty"`
+	Horizon     uint32       `protobuf:"varint,4,opt,name=horizon,proto3" json:"horizon,omitempty"`
 }
 
 func (m *EventNetworkLossSet) Reset()         { *m = EventNetworkLossSet{} }
@@ -250,46 +251,311 @@ func (m *EventNetworkLossSet) GetValueBundle() *ValueBundle {
 	return nil
 }
 
+func (m *EventNetworkLossSet) GetHorizon() uint32 {
+	if m != nil {
+		return m.Horizon
+	}
+	return 0
+}
+
+type EventNodeInfoUpdated struct {
+	IsReputer          bool     `protobuf:"varint,1,opt,name=is_reputer,json=isReputer,proto3" json:"is_reputer,omitempty"`
+	NodeAddress        string   `protobuf:"bytes,2,opt,name=node_address,json=nodeAddress,proto3" json:"node_address,omitempty"`
+	PreviousLibP2PKeys []string `protobuf:"bytes,3,rep,name=previous_lib_p2p_keys,json=previousLibP2pKeys,proto3" json:"previous_lib_p2p_keys,omitempty"`
+	LibP2PKey          string   `protobuf:"bytes,4,opt,name=lib_p2p_key,json=libP2pKey,proto3" json:"lib_p2p_key,omitempty"`
+	MultiAddress       string   `protobuf:"bytes,5,opt,name=multi_address,json=multiAddress,proto3" json:"multi_address,omitempty"`
+	Owner              string   `protobuf:"bytes,6,opt,name=owner,proto3" json:"owner,omitempty"`
+	TopicIds           []uint64 `protobuf:"varint,7,rep,packed,name=topic_ids,json=topicIds,proto3" json:"topic_ids,omitempty"`
+}
+
+func (m *EventNodeInfoUpdated) Reset()         { *m = EventNodeInfoUpdated{} }
+func (m *EventNodeInfoUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventNodeInfoUpdated) ProtoMessage()    {}
+func (*EventNodeInfoUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cc3b6a19d61d65b, []int{3}
+}
+func (m *EventNodeInfoUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNodeInfoUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNodeInfoUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventNodeInfoUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNodeInfoUpdated.Merge(m, src)
+}
+func (m *EventNodeInfoUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNodeInfoUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNodeInfoUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNodeInfoUpdated proto.InternalMessageInfo
+
+func (m *EventNodeInfoUpdated) GetIsReputer() bool {
+	if m != nil {
+		return m.IsReputer
+	}
+	return false
+}
+
+func (m *EventNodeInfoUpdated) GetNodeAddress() string {
+	if m != nil {
+		return m.NodeAddress
+	}
+	return ""
+}
+
+func (m *EventNodeInfoUpdated) GetPreviousLibP2PKeys() []string {
+	if m != nil {
+		return m.PreviousLibP2PKeys
+	}
+	return nil
+}
+
+func (m *EventNodeInfoUpdated) GetLibP2PKey() string {
+	if m != nil {
+		return m.LibP2PKey
+	}
+	return ""
+}
+
+func (m *EventNodeInfoUpdated) GetMultiAddress() string {
+	if m != nil {
+		return m.MultiAddress
+	}
+	return ""
+}
+
+func (m *EventNodeInfoUpdated) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *EventNodeInfoUpdated) GetTopicIds() []uint64 {
+	if m != nil {
+		return m.TopicIds
+	}
+	return nil
+}
+
+type EventInactiveActorsRemoved struct {
+	TopicId     uint64   `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight int64    `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Workers     []string `protobuf:"bytes,3,rep,name=workers,proto3" json:"workers,omitempty"`
+	Reputers    []string `protobuf:"bytes,4,rep,name=reputers,proto3" json:"reputers,omitempty"`
+}
+
+func (m *EventInactiveActorsRemoved) Reset()         { *m = EventInactiveActorsRemoved{} }
+func (m *EventInactiveActorsRemoved) String() string { return proto.CompactTextString(m) }
+func (*EventInactiveActorsRemoved) ProtoMessage()    {}
+func (*EventInactiveActorsRemoved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cc3b6a19d61d65b, []int{4}
+}
+func (m *EventInactiveActorsRemoved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventInactiveActorsRemoved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventInactiveActorsRemoved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventInactiveActorsRemoved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventInactiveActorsRemoved.Merge(m, src)
+}
+func (m *EventInactiveActorsRemoved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventInactiveActorsRemoved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventInactiveActorsRemoved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventInactiveActorsRemoved proto.InternalMessageInfo
+
+func (m *EventInactiveActorsRemoved) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *EventInactiveActorsRemoved) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *EventInactiveActorsRemoved) GetWorkers() []string {
+	if m != nil {
+		return m.Workers
+	}
+	return nil
+}
+
+func (m *EventInactiveActorsRemoved) GetReputers() []string {
+	if m != nil {
+		return m.Reputers
+	}
+	return nil
+}
+
+type EventNetworkInferenceWeightsSet struct {
+	TopicId           uint64                                            `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight       int64                                             `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Horizon           uint32                                            `protobuf:"varint,3,opt,name=horizon,proto3" json:"horizon,omitempty"`
+	Inferers          []string                                          `protobuf:"bytes,4,rep,name=inferers,proto3" json:"inferers,omitempty"`
+	InfererWeights    []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,5,rep,name=inferer_weights,json=infererWeights,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"inferer_weights"`
+	Forecasters       []string                                          `protobuf:"bytes,6,rep,name=forecasters,proto3" json:"forecasters,omitempty"`
+	ForecasterWeights []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,7,rep,name=forecaster_weights,json=forecasterWeights,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"forecaster_weights"`
+}
+
+func (m *EventNetworkInferenceWeightsSet) Reset()         { *m = EventNetworkInferenceWeightsSet{} }
+func (m *EventNetworkInferenceWeightsSet) String() string { return proto.CompactTextString(m) }
+func (*EventNetworkInferenceWeightsSet) ProtoMessage()    {}
+func (*EventNetworkInferenceWeightsSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cc3b6a19d61d65b, []int{5}
+}
+func (m *EventNetworkInferenceWeightsSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNetworkInferenceWeightsSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNetworkInferenceWeightsSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventNetworkInferenceWeightsSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNetworkInferenceWeightsSet.Merge(m, src)
+}
+func (m *EventNetworkInferenceWeightsSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNetworkInferenceWeightsSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNetworkInferenceWeightsSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNetworkInferenceWeightsSet proto.InternalMessageInfo
+
+func (m *EventNetworkInferenceWeightsSet) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *EventNetworkInferenceWeightsSet) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *EventNetworkInferenceWeightsSet) GetHorizon() uint32 {
+	if m != nil {
+		return m.Horizon
+	}
+	return 0
+}
+
+func (m *EventNetworkInferenceWeightsSet) GetInferers() []string {
+	if m != nil {
+		return m.Inferers
+	}
+	return nil
+}
+
+func (m *EventNetworkInferenceWeightsSet) GetForecasters() []string {
+	if m != nil {
+		return m.Forecasters
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("emissions.v1.ActorType", ActorType_name, ActorType_value)
 	proto.RegisterType((*EventScoresSet)(nil), "emissions.v1.EventScoresSet")
 	proto.RegisterType((*EventRewardsSettled)(nil), "emissions.v1.EventRewardsSettled")
 	proto.RegisterType((*EventNetworkLossSet)(nil), "emissions.v1.EventNetworkLossSet")
+	proto.RegisterType((*EventNodeInfoUpdated)(nil), "emissions.v1.EventNodeInfoUpdated")
+	proto.RegisterType((*EventInactiveActorsRemoved)(nil), "emissions.v1.EventInactiveActorsRemoved")
+	proto.RegisterType((*EventNetworkInferenceWeightsSet)(nil), "emissions.v1.EventNetworkInferenceWeightsSet")
 }
 
 func init() { proto.RegisterFile("emissions/v1/events.proto", fileDescriptor_5cc3b6a19d61d65b) }
 
 var fileDescriptor_5cc3b6a19d61d65b = []byte{
-	// 450 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0xe3, 0x76, 0xac, 0xd4, 0xad, 0xaa, 0xc9, 0x20, 0x91, 0x56, 0x28, 0x2b, 0x3d, 0x55,
-	0x48, 0x24, 0xda, 0x10, 0x7f, 0x0e, 0x5c, 0x56, 0xc8, 0xc4, 0x24, 0xb4, 0x81, 0x3b, 0x38, 0x70,
-	0xa9, 0xdc, 0xe4, 0x55, 0x13, 0x2d, 0x8d, 0x23, 0xdb, 0xc9, 0xd8, 0xb7, 0x80, 0x6f, 0xb5, 0xe3,
-	0x8e, 0x88, 0xc3, 0x84, 0xda, 0xcf, 0xc0, 0x8d, 0x03, 0xb2, 0x43, 0xd6, 0x55, 0x48, 0x08, 0x89,
-	0xcb, 0x6e, 0x7e, 0xde, 0xe7, 0xc9, 0x1b, 0xff, 0x5e, 0xeb, 0xc5, 0x5d, 0x98, 0xc7, 0x52, 0xc6,
-	0x3c, 0x95, 0x5e, 0xb1, 0xe3, 0x41, 0x01, 0xa9, 0x92, 0x6e, 0x26, 0xb8, 0xe2, 0xa4, 0x7d, 0x65,
-	0xb9, 0xc5, 0x4e, 0xef, 0xee, 0x8c, 0xcf, 0xb8, 0x31, 0x3c, 0x7d, 0x2a, 0x33, 0xbd, 0xde, 0xda,
-	0xe7, 0x02, 0xb2, 0x5c, 0x81, 0x28, 0xbd, 0xc1, 0x0f, 0x84, 0x3b, 0xbe, 0x6e, 0x38, 0x0e, 0xb8,
-	0x00, 0x39, 0x06, 0x45, 0x9e, 0x62, 0xcc, 0x02, 0xc5, 0xc5, 0x44, 0x9d, 0x65, 0x60, 0xa3, 0x3e,
-	0x1a, 0x76, 0x76, 0xef, 0xb9, 0xd7, 0xff, 0xe3, 0xee, 0x69, 0xff, 0xf8, 0x2c, 0x03, 0xda, 0x64,
-	0xd5, 0x91, 0x74, 0xf1, 0x6d, 0xc5, 0xb3, 0x38, 0x98, 0xc4, 0xa1, 0x5d, 0xeb, 0xa3, 0xe1, 0x06,
-	0x6d, 0x18, 0x7d, 0x10, 0x92, 0x07, 0xb8, 0x3d, 0x4d, 0x78, 0x70, 0x32, 0x89, 0x20, 0x9e, 0x45,
-	0xca, 0xae, 0xf7, 0xd1, 0xb0, 0x4e, 0x5b, 0xa6, 0xf6, 0xda, 0x94, 0xc8, 0x7d, 0xdc, 0x64, 0x61,
-	0x28, 0x40, 0x4a, 0x90, 0xf6, 0x46, 0xbf, 0x3e, 0x6c, 0xd2, 0x55, 0x81, 0x1c, 0xe1, 0x4d, 0x69,
-	0x2e, 0x68, 0xdf, 0xd2, 0xd6, 0xe8, 0xd9, 0xf9, 0xe5, 0xb6, 0xf5, 0xed, 0x72, 0xdb, 0x9b, 0xc5,
-	0x2a, 0xca, 0xa7, 0x6e, 0xc0, 0xe7, 0x1e, 0x4b, 0x12, 0x2e, 0xd8, 0xa3, 0x14, 0xd4, 0x29, 0x17,
-	0x27, 0x95, 0x0c, 0x22, 0x16, 0xa7, 0xde, 0x9c, 0xa9, 0xc8, 0x7d, 0x05, 0x01, 0xfd, 0xdd, 0x66,
-	0xf0, 0x13, 0xe1, 0x3b, 0x86, 0x9b, 0xc2, 0x29, 0x13, 0xa1, 0x06, 0x57, 0x09, 0x84, 0x37, 0x12,
-	0xfe, 0x1d, 0x6e, 0x88, 0xf2, 0x96, 0xff, 0x4b, 0x5f, 0xf5, 0x19, 0x7c, 0xa9, 0xf0, 0x0f, 0xcb,
-	0xfc, 0x1b, 0x2e, 0xcd, 0xdb, 0x5f, 0xc7, 0x40, 0x7f, 0xc7, 0xa8, 0xfd, 0x89, 0xf1, 0x02, 0xb7,
-	0x0b, 0x96, 0xe4, 0x30, 0x99, 0xe6, 0x69, 0x98, 0x80, 0x21, 0x6d, 0xed, 0x76, 0xd7, 0xc7, 0xf7,
-	0x41, 0x27, 0x46, 0x26, 0x40, 0x5b, 0xc5, 0x4a, 0x3c, 0x7c, 0x82, 0x9b, 0x57, 0xa3, 0x25, 0x2d,
-	0xdc, 0x38, 0x38, 0xdc, 0xf7, 0xa9, 0x4f, 0xb7, 0x2c, 0xd2, 0xc1, 0x78, 0xff, 0x88, 0xfa, 0x2f,
-	0xf7, 0xc6, 0xc7, 0x3e, 0xdd, 0x42, 0xda, 0xa4, 0xfe, 0xdb, 0xf7, 0x5a, 0xd4, 0x46, 0xf4, 0x7c,
-	0xe1, 0xa0, 0x8b, 0x85, 0x83, 0xbe, 0x2f, 0x1c, 0xf4, 0x79, 0xe9, 0x58, 0x17, 0x4b, 0xc7, 0xfa,
-	0xba, 0x74, 0xac, 0x8f, 0xcf, 0xff, 0x71, 0x3c, 0x9f, 0xbc, 0xd5, 0x82, 0xe8, 0x67, 0x97, 0xd3,
-	0x4d, 0xb3, 0x1c, 0x8f, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0x15, 0xf0, 0x0d, 0xd2, 0x79, 0x03,
-	0x00, 0x00,
+	// 730 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x4f, 0x6f, 0x1a, 0x47,
+	0x14, 0x67, 0x01, 0x03, 0xfb, 0xc0, 0xd4, 0x9d, 0xba, 0xea, 0x9a, 0xb6, 0x98, 0xd2, 0x0b, 0xaa,
+	0x54, 0x90, 0xa9, 0xfa, 0xe7, 0xd0, 0x8b, 0xdd, 0x62, 0x15, 0xd5, 0xb2, 0xdd, 0xb1, 0xdd, 0x4a,
+	0xbd, 0x6c, 0x96, 0xdd, 0x07, 0x8c, 0xbc, 0xec, 0xac, 0x66, 0x96, 0x25, 0xe4, 0x16, 0xe5, 0x0b,
+	0xf8, 0x7b, 0xe4, 0x8b, 0xf8, 0xe8, 0x63, 0x94, 0x83, 0x15, 0xd9, 0x9f, 0x21, 0xb7, 0x1c, 0xa2,
+	0x9d, 0xdd, 0x05, 0xac, 0x48, 0x51, 0x24, 0x72, 0xc8, 0x6d, 0x7e, 0xef, 0x3d, 0xde, 0xfb, 0xfd,
+	0xde, 0xfc, 0xd8, 0x81, 0x1d, 0x9c, 0x30, 0x29, 0x19, 0xf7, 0x64, 0x27, 0xdc, 0xeb, 0x60, 0x88,
+	0x5e, 0x20, 0xdb, 0xbe, 0xe0, 0x01, 0x27, 0x95, 0x45, 0xaa, 0x1d, 0xee, 0xd5, 0xb6, 0x47, 0x7c,
+	0xc4, 0x55, 0xa2, 0x13, 0x9d, 0xe2, 0x9a, 0x5a, 0xed, 0xc1, 0xcf, 0x05, 0xfa, 0xd3, 0x00, 0x45,
+	0x9c, 0x6b, 0xbe, 0xd6, 0xa0, 0xda, 0x8b, 0x1a, 0x9e, 0xd9, 0x5c, 0xa0, 0x3c, 0xc3, 0x80, 0xfc,
+	0x02, 0x60, 0xd9, 0x01, 0x17, 0x66, 0x30, 0xf7, 0xd1, 0xd0, 0x1a, 0x5a, 0xab, 0xda, 0xfd, 0xaa,
+	0xbd, 0x3a, 0xa7, 0xbd, 0x1f, 0xe5, 0xcf, 0xe7, 0x3e, 0x52, 0xdd, 0x4a, 0x8f, 0x64, 0x07, 0x4a,
+	0x01, 0xf7, 0x99, 0x6d, 0x32, 0xc7, 0xc8, 0x36, 0xb4, 0x56, 0x9e, 0x16, 0x15, 0xee, 0x3b, 0xe4,
+	0x3b, 0xa8, 0x0c, 0x5c, 0x6e, 0x5f, 0x9a, 0x63, 0x64, 0xa3, 0x71, 0x60, 0xe4, 0x1a, 0x5a, 0x2b,
+	0x47, 0xcb, 0x2a, 0xf6, 0x97, 0x0a, 0x91, 0x6f, 0x40, 0xb7, 0x1c, 0x47, 0xa0, 0x94, 0x28, 0x8d,
+	0x7c, 0x23, 0xd7, 0xd2, 0xe9, 0x32, 0x40, 0x4e, 0xa0, 0x20, 0x15, 0x41, 0x63, 0x23, 0x4a, 0x1d,
+	0xfc, 0x7a, 0x7d, 0xbb, 0x9b, 0x79, 0x79, 0xbb, 0xdb, 0x19, 0xb1, 0x60, 0x3c, 0x1d, 0xb4, 0x6d,
+	0x3e, 0xe9, 0x58, 0xae, 0xcb, 0x85, 0xf5, 0xa3, 0x87, 0xc1, 0x8c, 0x8b, 0xcb, 0x14, 0xda, 0x63,
+	0x8b, 0x79, 0x9d, 0x89, 0x15, 0x8c, 0xdb, 0x7f, 0xa2, 0x4d, 0x93, 0x36, 0xcd, 0x37, 0x1a, 0x7c,
+	0xa1, 0x74, 0x53, 0x9c, 0x59, 0xc2, 0x89, 0x84, 0x07, 0x2e, 0x3a, 0x9f, 0xa4, 0xf8, 0x7f, 0xa0,
+	0x28, 0x62, 0x96, 0xeb, 0xaa, 0x4f, 0xfb, 0x34, 0x9f, 0xa7, 0xf2, 0x8f, 0xe3, 0xfa, 0x23, 0x2e,
+	0xd5, 0xdd, 0xaf, 0xca, 0xd0, 0xde, 0x2f, 0x23, 0xfb, 0xae, 0x8c, 0xdf, 0xa1, 0x12, 0x5a, 0xee,
+	0x14, 0xcd, 0xc1, 0xd4, 0x73, 0x5c, 0x54, 0x4a, 0xcb, 0xdd, 0x9d, 0x87, 0xeb, 0xfb, 0x37, 0xaa,
+	0x38, 0x50, 0x05, 0xb4, 0x1c, 0x2e, 0x01, 0x31, 0xa0, 0x38, 0xe6, 0x82, 0x3d, 0xe1, 0x9e, 0x91,
+	0x6f, 0x68, 0xad, 0x4d, 0x9a, 0xc2, 0xe6, 0xb3, 0x2c, 0x6c, 0xc7, 0x6c, 0xb9, 0x83, 0x7d, 0x6f,
+	0xc8, 0x2f, 0x7c, 0xc7, 0x0a, 0xd0, 0x21, 0xdf, 0x02, 0x30, 0x69, 0x26, 0x8e, 0x56, 0x84, 0x4b,
+	0x54, 0x67, 0x92, 0xc6, 0x81, 0x88, 0xb2, 0xc7, 0x1d, 0x34, 0x93, 0x55, 0x2a, 0xca, 0x3a, 0x2d,
+	0x47, 0xb1, 0xfd, 0x38, 0x44, 0xf6, 0xe0, 0x4b, 0x5f, 0x60, 0xc8, 0xf8, 0x54, 0x9a, 0x2e, 0x1b,
+	0x98, 0x7e, 0xd7, 0x37, 0x2f, 0x71, 0x2e, 0x8d, 0x9c, 0xba, 0x05, 0x92, 0x26, 0x8f, 0xd8, 0xe0,
+	0xb4, 0xeb, 0xff, 0x8d, 0x73, 0x49, 0xea, 0x50, 0x5e, 0xa9, 0x54, 0x5c, 0x75, 0xaa, 0xbb, 0x69,
+	0x01, 0xf9, 0x1e, 0x36, 0x27, 0x53, 0x37, 0x60, 0x8b, 0xb1, 0x1b, 0xaa, 0xa2, 0xa2, 0x82, 0xe9,
+	0xdc, 0x6d, 0xd8, 0xe0, 0x33, 0x0f, 0x85, 0x51, 0x50, 0xc9, 0x18, 0x90, 0xaf, 0x41, 0x4f, 0xd7,
+	0x2f, 0x8d, 0x62, 0x23, 0xd7, 0xca, 0xd3, 0x52, 0xb2, 0x7f, 0xd9, 0xbc, 0xd2, 0xa0, 0xa6, 0xb6,
+	0xd0, 0xf7, 0x2c, 0x3b, 0x60, 0x21, 0x2a, 0x23, 0x4a, 0x8a, 0x13, 0x1e, 0xa2, 0xb3, 0xe6, 0xd5,
+	0x19, 0x50, 0x8c, 0x7c, 0x80, 0x22, 0x55, 0x9e, 0x42, 0x52, 0x83, 0x52, 0xb2, 0xe0, 0xd4, 0x9a,
+	0x0b, 0xdc, 0x7c, 0x9a, 0x83, 0xdd, 0x55, 0x1b, 0xf5, 0xbd, 0x21, 0x0a, 0xf4, 0x6c, 0xfc, 0x4f,
+	0x75, 0xfd, 0x08, 0x96, 0x5a, 0x31, 0x45, 0xee, 0x81, 0x29, 0x22, 0x5e, 0x4c, 0x8d, 0x5b, 0xf2,
+	0x4a, 0x31, 0x79, 0x04, 0x9f, 0x25, 0x67, 0x73, 0x16, 0x33, 0x59, 0xf7, 0x9f, 0x53, 0x4d, 0xfa,
+	0x25, 0xc2, 0x48, 0x03, 0xca, 0x43, 0x2e, 0xd0, 0xb6, 0xa4, 0x5a, 0x4c, 0x41, 0x11, 0x58, 0x0d,
+	0x91, 0x21, 0x90, 0x25, 0x5c, 0xd0, 0x28, 0xae, 0x47, 0xe3, 0xf3, 0x65, 0xcb, 0x84, 0xc9, 0x0f,
+	0x3f, 0x83, 0xbe, 0xf8, 0x22, 0x91, 0x32, 0x14, 0xfb, 0xc7, 0x87, 0x3d, 0xda, 0xa3, 0x5b, 0x19,
+	0x52, 0x05, 0x38, 0x3c, 0xa1, 0xbd, 0x3f, 0xf6, 0xcf, 0xce, 0x7b, 0x74, 0x4b, 0x8b, 0x92, 0xb4,
+	0x77, 0x7a, 0x11, 0x81, 0xec, 0x01, 0xbd, 0xbe, 0xab, 0x6b, 0x37, 0x77, 0x75, 0xed, 0xd5, 0x5d,
+	0x5d, 0xbb, 0xba, 0xaf, 0x67, 0x6e, 0xee, 0xeb, 0x99, 0x17, 0xf7, 0xf5, 0xcc, 0xff, 0xbf, 0x7d,
+	0x20, 0xa9, 0xc7, 0x9d, 0xe5, 0xbb, 0x12, 0x7d, 0x2d, 0xe5, 0xa0, 0xa0, 0xde, 0x94, 0x9f, 0xde,
+	0x0e, 0x00, 0x2a, 0x76, 0x70, 0x9f, 0xb0, 0x06, 0x00, 0x00,
 }
 
 func (m *EventScoresSet) Marshal() (dAtA []byte, err error) {
@@ -434,6 +700,11 @@ func (m *EventNetworkLossSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Horizon != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Horizon))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.ValueBundle != nil {
 		{
 			size, err := m.ValueBundle.MarshalToSizedBuffer(dAtA[:i])
@@ -459,85 +730,308 @@ func (m *EventNetworkLossSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventNodeInfoUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventScoresSet) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventNodeInfoUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNodeInfoUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ActorType != 0 {
-		n += 1 + sovEvents(uint64(m.ActorType))
+	if len(m.TopicIds) > 0 {
+		dAtA3 := make([]byte, len(m.TopicIds)*10)
+		var j2 int
+		for _, num := range m.TopicIds {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintEvents(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.TopicId != 0 {
-		n += 1 + sovEvents(uint64(m.TopicId))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovEvents(uint64(m.BlockHeight))
+	if len(m.MultiAddress) > 0 {
+		i -= len(m.MultiAddress)
+		copy(dAtA[i:], m.MultiAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MultiAddress)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovEvents(uint64(l))
+	if len(m.LibP2PKey) > 0 {
+		i -= len(m.LibP2PKey)
+		copy(dAtA[i:], m.LibP2PKey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.LibP2PKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PreviousLibP2PKeys) > 0 {
+		for iNdEx := len(m.PreviousLibP2PKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PreviousLibP2PKeys[iNdEx])
+			copy(dAtA[i:], m.PreviousLibP2PKeys[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.PreviousLibP2PKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.Scores) > 0 {
-		for _, e := range m.Scores {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	if len(m.NodeAddress) > 0 {
+		i -= len(m.NodeAddress)
+		copy(dAtA[i:], m.NodeAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NodeAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.IsReputer {
+		i--
+		if m.IsReputer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventRewardsSettled) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventInactiveActorsRemoved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventInactiveActorsRemoved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventInactiveActorsRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ActorType != 0 {
-		n += 1 + sovEvents(uint64(m.ActorType))
-	}
-	if m.TopicId != 0 {
-		n += 1 + sovEvents(uint64(m.TopicId))
-	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovEvents(uint64(m.BlockHeight))
-	}
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovEvents(uint64(l))
+	if len(m.Reputers) > 0 {
+		for iNdEx := len(m.Reputers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Reputers[iNdEx])
+			copy(dAtA[i:], m.Reputers[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Reputers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	if len(m.Workers) > 0 {
+		for iNdEx := len(m.Workers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Workers[iNdEx])
+			copy(dAtA[i:], m.Workers[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Workers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	return n
-}
-
-func (m *EventNetworkLossSet) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	var l int
-	_ = l
 	if m.TopicId != 0 {
-		n += 1 + sovEvents(uint64(m.TopicId))
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNetworkInferenceWeightsSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNetworkInferenceWeightsSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNetworkInferenceWeightsSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ForecasterWeights) > 0 {
+		for iNdEx := len(m.ForecasterWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.ForecasterWeights[iNdEx].Size()
+				i -= size
+				if _, err := m.ForecasterWeights[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Forecasters) > 0 {
+		for iNdEx := len(m.Forecasters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Forecasters[iNdEx])
+			copy(dAtA[i:], m.Forecasters[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Forecasters[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.InfererWeights) > 0 {
+		for iNdEx := len(m.InfererWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.InfererWeights[iNdEx].Size()
+				i -= size
+				if _, err := m.InfererWeights[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Inferers) > 0 {
+		for iNdEx := len(m.Inferers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Inferers[iNdEx])
+			copy(dAtA[i:], m.Inferers[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Inferers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Horizon != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Horizon))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventScoresSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActorType != 0 {
+		n += 1 + sovEvents(uint64(m.ActorType))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.Scores) > 0 {
+		for _, e := range m.Scores {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventRewardsSettled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActorType != 0 {
+		n += 1 + sovEvents(uint64(m.ActorType))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventNetworkLossSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
 	}
 	if m.BlockHeight != 0 {
 		n += 1 + sovEvents(uint64(m.BlockHeight))
@@ -546,6 +1040,119 @@ func (m *EventNetworkLossSet) Size() (n int) {
 		l = m.ValueBundle.Size()
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if m.Horizon != 0 {
+		n += 1 + sovEvents(uint64(m.Horizon))
+	}
+	return n
+}
+
+func (m *EventNodeInfoUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsReputer {
+		n += 2
+	}
+	l = len(m.NodeAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.PreviousLibP2PKeys) > 0 {
+		for _, s := range m.PreviousLibP2PKeys {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = len(m.LibP2PKey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.MultiAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.TopicIds) > 0 {
+		l = 0
+		for _, e := range m.TopicIds {
+			l += sovEvents(uint64(e))
+		}
+		n += 1 + sovEvents(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *EventInactiveActorsRemoved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	if len(m.Workers) > 0 {
+		for _, s := range m.Workers {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.Reputers) > 0 {
+		for _, s := range m.Reputers {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventNetworkInferenceWeightsSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	if m.Horizon != 0 {
+		n += 1 + sovEvents(uint64(m.Horizon))
+	}
+	if len(m.Inferers) > 0 {
+		for _, s := range m.Inferers {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.InfererWeights) > 0 {
+		for _, e := range m.InfererWeights {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.Forecasters) > 0 {
+		for _, s := range m.Forecasters {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.ForecasterWeights) > 0 {
+		for _, e := range m.ForecasterWeights {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -555,7 +1162,500 @@ func sovEvents(x uint64) (n int) {
 func sozEvents(x uint64) (n int) {
 	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *EventScoresSet) Unmarshal(dAtA []byte) error {
+func (m *EventScoresSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScoresSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScoresSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorType", wireType)
+			}
+			m.ActorType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActorType |= ActorType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scores", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.Scores = append(m.Scores, v)
+			if err := m.Scores[len(m.Scores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRewardsSettled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRewardsSettled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRewardsSettled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorType", wireType)
+			}
+			m.ActorType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActorType |= ActorType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.Rewards = append(m.Rewards, v)
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventNetworkLossSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNetworkLossSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNetworkLossSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueBundle", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValueBundle == nil {
+				m.ValueBundle = &ValueBundle{}
+			}
+			if err := m.ValueBundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Horizon", wireType)
+			}
+			m.Horizon = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Horizon |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventNodeInfoUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -578,17 +1678,17 @@ func (m *EventScoresSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventScoresSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNodeInfoUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventScoresSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNodeInfoUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActorType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsReputer", wireType)
 			}
-			m.ActorType = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -598,16 +1698,17 @@ func (m *EventScoresSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ActorType |= ActorType(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.IsReputer = bool(v != 0)
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeAddress", wireType)
 			}
-			m.TopicId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -617,16 +1718,29 @@ func (m *EventScoresSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousLibP2PKeys", wireType)
 			}
-			m.BlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -636,14 +1750,27 @@ func (m *EventScoresSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousLibP2PKeys = append(m.PreviousLibP2PKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LibP2PKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -671,11 +1798,11 @@ func (m *EventScoresSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.LibP2PKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scores", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -703,12 +1830,116 @@ func (m *EventScoresSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.Scores = append(m.Scores, v)
-			if err := m.Scores[len(m.Scores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.MultiAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TopicIds = append(m.TopicIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvents
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvents
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.TopicIds) == 0 {
+					m.TopicIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TopicIds = append(m.TopicIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicIds", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -730,7 +1961,7 @@ func (m *EventScoresSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRewardsSettled) Unmarshal(dAtA []byte) error {
+func (m *EventInactiveActorsRemoved) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -753,32 +1984,13 @@ func (m *EventRewardsSettled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRewardsSettled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventInactiveActorsRemoved: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRewardsSettled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventInactiveActorsRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActorType", wireType)
-			}
-			m.ActorType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ActorType |= ActorType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
@@ -797,7 +2009,7 @@ func (m *EventRewardsSettled) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
@@ -816,9 +2028,9 @@ func (m *EventRewardsSettled) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Workers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -846,11 +2058,11 @@ func (m *EventRewardsSettled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.Workers = append(m.Workers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -878,11 +2090,7 @@ func (m *EventRewardsSettled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.Rewards = append(m.Rewards, v)
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reputers = append(m.Reputers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -905,7 +2113,7 @@ func (m *EventRewardsSettled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventNetworkLossSet) Unmarshal(dAtA []byte) error {
+func (m *EventNetworkInferenceWeightsSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -928,10 +2136,10 @@ func (m *EventNetworkLossSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventNetworkLossSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNetworkInferenceWeightsSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventNetworkLossSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNetworkInferenceWeightsSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -973,10 +2181,29 @@ func (m *EventNetworkLossSet) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Horizon", wireType)
+			}
+			m.Horizon = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Horizon |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueBundle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Inferers", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -986,25 +2213,125 @@ func (m *EventNetworkLossSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValueBundle == nil {
-				m.ValueBundle = &ValueBundle{}
+			m.Inferers = append(m.Inferers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfererWeights", wireType)
 			}
-			if err := m.ValueBundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.InfererWeights = append(m.InfererWeights, v)
+			if err := m.InfererWeights[len(m.InfererWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Forecasters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Forecasters = append(m.Forecasters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForecasterWeights", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.ForecasterWeights = append(m.ForecasterWeights, v)
+			if err := m.ForecasterWeights[len(m.ForecasterWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
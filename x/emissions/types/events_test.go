@@ -19,6 +19,7 @@ const (
 	AttributeKeyScores      = "scores"
 	AttributeKeyRewards     = "rewards"
 	AttributeKeyValueBundle = "value_bundle"
+	AttributeKeyHorizon     = "horizon"
 )
 
 func TestEmitNewInfererScoresSetEventWithScores(t *testing.T) {
@@ -373,17 +374,19 @@ func TestEmitNewNetworkLossSetEvent(t *testing.T) {
 	ctx := sdk.Context{}.WithEventManager(sdk.NewEventManager())
 	topicId := uint64(1)
 	blockHeight := int64(10)
+	// JSON decodes the absent vector values of scalar topics as empty rather than nil slices
+	noValues := []alloraMath.Dec{}
 	loss := types.ValueBundle{
 		CombinedValue:          alloraMath.MustNewDecFromString("10"),
 		NaiveValue:             alloraMath.MustNewDecFromString("20"),
-		InfererValues:          []*types.WorkerAttributedValue{{Worker: "TestInferer", Value: alloraMath.MustNewDecFromString("0.0112")}, {Worker: "TestInferer1", Value: alloraMath.MustNewDecFromString("0.0112")}},
-		ForecasterValues:       []*types.WorkerAttributedValue{{Worker: "TestForecaster", Value: alloraMath.MustNewDecFromString("0.0112")}, {Worker: "TestForecaster1", Value: alloraMath.MustNewDecFromString("0.0112")}},
-		OneOutInfererValues:    []*types.WithheldWorkerAttributedValue{{Worker: "TestInferer2", Value: alloraMath.MustNewDecFromString("0.0112")}, {Worker: "TestInferer3", Value: alloraMath.MustNewDecFromString("0.0112")}},
-		OneOutForecasterValues: []*types.WithheldWorkerAttributedValue{{Worker: "TestForecaster3", Value: alloraMath.MustNewDecFromString("0.0112")}, {Worker: "TestForecaster4", Value: alloraMath.MustNewDecFromString("0.0112")}},
-		OneInForecasterValues:  []*types.WorkerAttributedValue{{Worker: "TestForecaster5", Value: alloraMath.MustNewDecFromString("0.0112")}, {Worker: "TestForecaster6", Value: alloraMath.MustNewDecFromString("0.0112")}},
+		InfererValues:          []*types.WorkerAttributedValue{{Worker: "TestInferer", Value: alloraMath.MustNewDecFromString("0.0112"), Values: noValues}, {Worker: "TestInferer1", Value: alloraMath.MustNewDecFromString("0.0112"), Values: noValues}},
+		ForecasterValues:       []*types.WorkerAttributedValue{{Worker: "TestForecaster", Value: alloraMath.MustNewDecFromString("0.0112"), Values: noValues}, {Worker: "TestForecaster1", Value: alloraMath.MustNewDecFromString("0.0112"), Values: noValues}},
+		OneOutInfererValues:    []*types.WithheldWorkerAttributedValue{{Worker: "TestInferer2", Value: alloraMath.MustNewDecFromString("0.0112"), Values: noValues}, {Worker: "TestInferer3", Value: alloraMath.MustNewDecFromString("0.0112"), Values: noValues}},
+		OneOutForecasterValues: []*types.WithheldWorkerAttributedValue{{Worker: "TestForecaster3", Value: alloraMath.MustNewDecFromString("0.0112"), Values: noValues}, {Worker: "TestForecaster4", Value: alloraMath.MustNewDecFromString("0.0112"), Values: noValues}},
+		OneInForecasterValues:  []*types.WorkerAttributedValue{{Worker: "TestForecaster5", Value: alloraMath.MustNewDecFromString("0.0112"), Values: noValues}, {Worker: "TestForecaster6", Value: alloraMath.MustNewDecFromString("0.0112"), Values: noValues}},
 	}
 
-	types.EmitNewNetworkLossSetEvent(ctx, topicId, blockHeight, loss)
+	types.EmitNewNetworkLossSetEvent(ctx, topicId, 1, blockHeight, loss)
 
 	events := ctx.EventManager().Events()
 	require.Len(t, events, 1)
@@ -392,7 +395,11 @@ func TestEmitNewNetworkLossSetEvent(t *testing.T) {
 	require.Equal(t, "emissions.v1.EventNetworkLossSet", event.Type)
 
 	attributes := event.Attributes
-	require.Len(t, attributes, 3)
+	require.Len(t, attributes, 4)
+
+	horizon, exists := event.GetAttribute(AttributeKeyHorizon)
+	require.True(t, exists)
+	require.Equal(t, "1", horizon.GetValue())
 
 	var result types.ValueBundle
 	val, exists := event.GetAttribute(AttributeKeyValueBundle)
@@ -28,7 +28,33 @@ func (forecast *Forecast) Validate() error {
 		if elem.Value.IsNaN() {
 			return errors.Wrap(sdkerrors.ErrInvalidRequest, "forecast element value cannot be NaN")
 		}
+		for _, value := range elem.Values {
+			if value.IsNaN() {
+				return errors.Wrap(sdkerrors.ErrInvalidRequest, "forecast element value cannot be NaN")
+			}
+		}
 	}
 
 	return nil
 }
+
+// Per-component forecasted losses are optional, but if present must match the output dimension of a vector-valued topic
+func (elem *ForecastElement) ValidateOutputDimension(outputDimension uint64) error {
+	if len(elem.Values) == 0 {
+		return nil
+	}
+	return ValidateValuesOutputDimension(elem.Values, outputDimension)
+}
+
+// Forecasts of the losses of the inferences made for the given horizon of the topic
+func (forecasts *Forecasts) AtHorizon(horizon Horizon) *Forecasts {
+	filtered := &Forecasts{
+		Forecasts: make([]*Forecast, 0, len(forecasts.Forecasts)),
+	}
+	for _, forecast := range forecasts.Forecasts {
+		if forecast.Horizon == horizon {
+			filtered.Forecasts = append(filtered.Forecasts, forecast)
+		}
+	}
+	return filtered
+}
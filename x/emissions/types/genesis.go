@@ -41,5 +41,18 @@ func (gs *GenesisState) Validate() error {
 		}
 	}
 
+	// Ensure that role assignments are for valid addresses and known roles
+	for _, actorRole := range gs.ActorRoles {
+		if actorRole == nil {
+			continue
+		}
+		if _, err := sdk.AccAddressFromBech32(actorRole.ActorId); err != nil {
+			return err
+		}
+		if err := ValidateRole(actorRole.Role); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
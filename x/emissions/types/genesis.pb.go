@@ -134,12 +134,60 @@ type GenesisState struct {
 	// the forecaster (2nd index) regrets made under the regime of the same forecaster as a one-in forecaster
 	LatestOneInForecasterSelfNetworkRegrets []*TopicIdActorIdTimeStampedValue `protobuf:"bytes,48,rep,name=latestOneInForecasterSelfNetworkRegrets,proto3" json:"latestOneInForecasterSelfNetworkRegrets,omitempty"`
 	/// WHITELISTS
+	// addresses granted every role on import
 	CoreTeamAddresses []string `protobuf:"bytes,2,rep,name=core_team_addresses,json=coreTeamAddresses,proto3" json:"core_team_addresses,omitempty"`
+	// map of (actor, role) -> granted
+	ActorRoles []*ActorIdAndRole `protobuf:"bytes,55,rep,name=actorRoles,proto3" json:"actorRoles,omitempty"`
 	/// RECORD COMMITS
 	TopicLastWorkerCommit   []*TopicIdTimestampedActorNonce `protobuf:"bytes,49,rep,name=topicLastWorkerCommit,proto3" json:"topicLastWorkerCommit,omitempty"`
 	TopicLastReputerCommit  []*TopicIdTimestampedActorNonce `protobuf:"bytes,50,rep,name=topicLastReputerCommit,proto3" json:"topicLastReputerCommit,omitempty"`
 	TopicLastWorkerPayload  []*TopicIdTimestampedActorNonce `protobuf:"bytes,51,rep,name=topicLastWorkerPayload,proto3" json:"topicLastWorkerPayload,omitempty"`
 	TopicLastReputerPayload []*TopicIdTimestampedActorNonce `protobuf:"bytes,52,rep,name=topicLastReputerPayload,proto3" json:"topicLastReputerPayload,omitempty"`
+	/// TOPIC ALLOWLISTS
+	// for a topic, which workers are allowed to participate when the topic restricts workers
+	TopicWorkerAllowlist []*TopicAndActorId `protobuf:"bytes,53,rep,name=topicWorkerAllowlist,proto3" json:"topicWorkerAllowlist,omitempty"`
+	// for a topic, which reputers are allowed to participate when the topic restricts reputers
+	TopicReputerAllowlist []*TopicAndActorId `protobuf:"bytes,54,rep,name=topicReputerAllowlist,proto3" json:"topicReputerAllowlist,omitempty"`
+	/// INACTIVITY
+	// map of (topic, worker) -> block height of the last accepted submission or registration
+	WorkerLastActiveHeight []*ActorIdTopicIdBlockHeight `protobuf:"bytes,56,rep,name=workerLastActiveHeight,proto3" json:"workerLastActiveHeight,omitempty"`
+	// map of (topic, reputer) -> block height of the last accepted submission or registration
+	ReputerLastActiveHeight []*ActorIdTopicIdBlockHeight `protobuf:"bytes,57,rep,name=reputerLastActiveHeight,proto3" json:"reputerLastActiveHeight,omitempty"`
+	/// HORIZONS
+	// map of (topic, horizon) -> reward nonce of the horizons after the first one
+	TopicHorizonRewardNonce []*TopicIdHorizonBlockHeight `protobuf:"bytes,58,rep,name=topicHorizonRewardNonce,proto3" json:"topicHorizonRewardNonce,omitempty"`
+	// map of (topic, horizon, block) -> reputer losses of the horizons after the first one
+	HorizonLossBundles []*TopicIdHorizonBlockHeightReputerValueBundles `protobuf:"bytes,59,rep,name=horizonLossBundles,proto3" json:"horizonLossBundles,omitempty"`
+	// map of (topic, horizon, block) -> network losses of the horizons after the first one
+	HorizonNetworkLossBundles []*TopicIdHorizonBlockHeightValueBundles `protobuf:"bytes,60,rep,name=horizonNetworkLossBundles,proto3" json:"horizonNetworkLossBundles,omitempty"`
+	// map of (topic, block) -> regret-informed weights of the network inferences of every horizon
+	NetworkInferenceWeights []*TopicIdBlockHeightNetworkInferenceWeights `protobuf:"bytes,61,rep,name=networkInferenceWeights,proto3" json:"networkInferenceWeights,omitempty"`
+	// map of (topic, block) -> how the reward of every horizon rewarded at that block was split
+	RewardBreakdowns []*TopicIdBlockHeightRewardBreakdowns `protobuf:"bytes,62,rep,name=rewardBreakdowns,proto3" json:"rewardBreakdowns,omitempty"`
+	// score history of every actor for every topic
+	InfererScoreHistory    []*Score `protobuf:"bytes,63,rep,name=infererScoreHistory,proto3" json:"infererScoreHistory,omitempty"`
+	ForecasterScoreHistory []*Score `protobuf:"bytes,64,rep,name=forecasterScoreHistory,proto3" json:"forecasterScoreHistory,omitempty"`
+	ReputerScoreHistory    []*Score `protobuf:"bytes,65,rep,name=reputerScoreHistory,proto3" json:"reputerScoreHistory,omitempty"`
+	// rewards paid to every actor
+	RewardHistory []*PaidReward `protobuf:"bytes,66,rep,name=rewardHistory,proto3" json:"rewardHistory,omitempty"`
+	// header time of the last block, in unix nanoseconds
+	LastBlockTime int64 `protobuf:"varint,67,opt,name=lastBlockTime,proto3" json:"lastBlockTime,omitempty"`
+	// moving average of the block time, in seconds
+	AverageBlockTime github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,68,opt,name=averageBlockTime,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"averageBlockTime"`
+	// blocks per month derived from the average block time, 0 if never calibrated
+	CalibratedBlocksPerMonth uint64 `protobuf:"varint,69,opt,name=calibratedBlocksPerMonth,proto3" json:"calibratedBlocksPerMonth,omitempty"`
+	// heights at which topics opened their latest worker nonces, to count the epochs actors missed
+	TopicEpochHeights []*TopicIdAndBlockHeight `protobuf:"bytes,70,rep,name=topicEpochHeights,proto3" json:"topicEpochHeights,omitempty"`
+	// height of the last recalibration of the blocks per month, 0 if never calibrated
+	LastBlocksPerMonthCalibrationHeight int64 `protobuf:"varint,71,opt,name=lastBlocksPerMonthCalibrationHeight,proto3" json:"lastBlocksPerMonthCalibrationHeight,omitempty"`
+	// height at which the previous percentage reward to staked reputers was last set, 0 if never set
+	LastPercentageRewardToStakedReputersHeight int64 `protobuf:"varint,72,opt,name=lastPercentageRewardToStakedReputersHeight,proto3" json:"lastPercentageRewardToStakedReputersHeight,omitempty"`
+	// map of (topic, horizon) -> last reputer payload accepted for the horizons after the first one
+	HorizonLastReputerPayload []*TopicIdHorizonTimestampedActorNonce `protobuf:"bytes,73,rep,name=horizonLastReputerPayload,proto3" json:"horizonLastReputerPayload,omitempty"`
+	// map of (topic, horizon, block) -> scores of the horizons after the first one
+	HorizonInfererScoresByBlock    []*TopicIdHorizonBlockHeightScores `protobuf:"bytes,74,rep,name=horizonInfererScoresByBlock,proto3" json:"horizonInfererScoresByBlock,omitempty"`
+	HorizonForecasterScoresByBlock []*TopicIdHorizonBlockHeightScores `protobuf:"bytes,75,rep,name=horizonForecasterScoresByBlock,proto3" json:"horizonForecasterScoresByBlock,omitempty"`
+	HorizonReputerScoresByBlock    []*TopicIdHorizonBlockHeightScores `protobuf:"bytes,76,rep,name=horizonReputerScoresByBlock,proto3" json:"horizonReputerScoresByBlock,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -497,6 +545,13 @@ func (m *GenesisState) GetCoreTeamAddresses() []string {
 	return nil
 }
 
+func (m *GenesisState) GetActorRoles() []*ActorIdAndRole {
+	if m != nil {
+		return m.ActorRoles
+	}
+	return nil
+}
+
 func (m *GenesisState) GetTopicLastWorkerCommit() []*TopicIdTimestampedActorNonce {
 	if m != nil {
 		return m.TopicLastWorkerCommit
@@ -525,6 +580,160 @@ func (m *GenesisState) GetTopicLastReputerPayload() []*TopicIdTimestampedActorNo
 	return nil
 }
 
+func (m *GenesisState) GetTopicWorkerAllowlist() []*TopicAndActorId {
+	if m != nil {
+		return m.TopicWorkerAllowlist
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTopicReputerAllowlist() []*TopicAndActorId {
+	if m != nil {
+		return m.TopicReputerAllowlist
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWorkerLastActiveHeight() []*ActorIdTopicIdBlockHeight {
+	if m != nil {
+		return m.WorkerLastActiveHeight
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReputerLastActiveHeight() []*ActorIdTopicIdBlockHeight {
+	if m != nil {
+		return m.ReputerLastActiveHeight
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTopicHorizonRewardNonce() []*TopicIdHorizonBlockHeight {
+	if m != nil {
+		return m.TopicHorizonRewardNonce
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHorizonLossBundles() []*TopicIdHorizonBlockHeightReputerValueBundles {
+	if m != nil {
+		return m.HorizonLossBundles
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHorizonNetworkLossBundles() []*TopicIdHorizonBlockHeightValueBundles {
+	if m != nil {
+		return m.HorizonNetworkLossBundles
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNetworkInferenceWeights() []*TopicIdBlockHeightNetworkInferenceWeights {
+	if m != nil {
+		return m.NetworkInferenceWeights
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRewardBreakdowns() []*TopicIdBlockHeightRewardBreakdowns {
+	if m != nil {
+		return m.RewardBreakdowns
+	}
+	return nil
+}
+
+func (m *GenesisState) GetInfererScoreHistory() []*Score {
+	if m != nil {
+		return m.InfererScoreHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetForecasterScoreHistory() []*Score {
+	if m != nil {
+		return m.ForecasterScoreHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReputerScoreHistory() []*Score {
+	if m != nil {
+		return m.ReputerScoreHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRewardHistory() []*PaidReward {
+	if m != nil {
+		return m.RewardHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastBlockTime() int64 {
+	if m != nil {
+		return m.LastBlockTime
+	}
+	return 0
+}
+
+func (m *GenesisState) GetCalibratedBlocksPerMonth() uint64 {
+	if m != nil {
+		return m.CalibratedBlocksPerMonth
+	}
+	return 0
+}
+
+func (m *GenesisState) GetTopicEpochHeights() []*TopicIdAndBlockHeight {
+	if m != nil {
+		return m.TopicEpochHeights
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastBlocksPerMonthCalibrationHeight() int64 {
+	if m != nil {
+		return m.LastBlocksPerMonthCalibrationHeight
+	}
+	return 0
+}
+
+func (m *GenesisState) GetLastPercentageRewardToStakedReputersHeight() int64 {
+	if m != nil {
+		return m.LastPercentageRewardToStakedReputersHeight
+	}
+	return 0
+}
+
+func (m *GenesisState) GetHorizonLastReputerPayload() []*TopicIdHorizonTimestampedActorNonce {
+	if m != nil {
+		return m.HorizonLastReputerPayload
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHorizonInfererScoresByBlock() []*TopicIdHorizonBlockHeightScores {
+	if m != nil {
+		return m.HorizonInfererScoresByBlock
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHorizonForecasterScoresByBlock() []*TopicIdHorizonBlockHeightScores {
+	if m != nil {
+		return m.HorizonForecasterScoresByBlock
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHorizonReputerScoresByBlock() []*TopicIdHorizonBlockHeightScores {
+	if m != nil {
+		return m.HorizonReputerScoresByBlock
+	}
+	return nil
+}
+
 type TopicIdAndTopic struct {
 	TopicId uint64 `protobuf:"varint,1,opt,name=TopicId,proto3" json:"TopicId,omitempty"`
 	Topic   *Topic `protobuf:"bytes,2,opt,name=Topic,proto3" json:"Topic,omitempty"`
@@ -577,6 +786,58 @@ func (m *TopicIdAndTopic) GetTopic() *Topic {
 	return nil
 }
 
+type ActorIdAndRole struct {
+	ActorId string `protobuf:"bytes,1,opt,name=ActorId,proto3" json:"ActorId,omitempty"`
+	Role    Role   `protobuf:"varint,2,opt,name=Role,proto3,enum=emissions.v1.Role" json:"Role,omitempty"`
+}
+
+func (m *ActorIdAndRole) Reset()         { *m = ActorIdAndRole{} }
+func (m *ActorIdAndRole) String() string { return proto.CompactTextString(m) }
+func (*ActorIdAndRole) ProtoMessage()    {}
+func (*ActorIdAndRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8702cc38ff1a7f6a, []int{2}
+}
+func (m *ActorIdAndRole) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActorIdAndRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActorIdAndRole.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActorIdAndRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActorIdAndRole.Merge(m, src)
+}
+func (m *ActorIdAndRole) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActorIdAndRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActorIdAndRole.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActorIdAndRole proto.InternalMessageInfo
+
+func (m *ActorIdAndRole) GetActorId() string {
+	if m != nil {
+		return m.ActorId
+	}
+	return ""
+}
+
+func (m *ActorIdAndRole) GetRole() Role {
+	if m != nil {
+		return m.Role
+	}
+	return Role_NO_ROLE
+}
+
 type TopicAndActorId struct {
 	TopicId uint64 `protobuf:"varint,1,opt,name=TopicId,proto3" json:"TopicId,omitempty"`
 	ActorId string `protobuf:"bytes,2,opt,name=ActorId,proto3" json:"ActorId,omitempty"`
@@ -586,7 +847,7 @@ func (m *TopicAndActorId) Reset()         { *m = TopicAndActorId{} }
 func (m *TopicAndActorId) String() string { return proto.CompactTextString(m) }
 func (*TopicAndActorId) ProtoMessage()    {}
 func (*TopicAndActorId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{2}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{3}
 }
 func (m *TopicAndActorId) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -638,7 +899,7 @@ func (m *TopicIdAndBlockHeight) Reset()         { *m = TopicIdAndBlockHeight{} }
 func (m *TopicIdAndBlockHeight) String() string { return proto.CompactTextString(m) }
 func (*TopicIdAndBlockHeight) ProtoMessage()    {}
 func (*TopicIdAndBlockHeight) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{3}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{4}
 }
 func (m *TopicIdAndBlockHeight) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -691,7 +952,7 @@ func (m *TopicIdBlockHeightScores) Reset()         { *m = TopicIdBlockHeightScor
 func (m *TopicIdBlockHeightScores) String() string { return proto.CompactTextString(m) }
 func (*TopicIdBlockHeightScores) ProtoMessage()    {}
 func (*TopicIdBlockHeightScores) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{4}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{5}
 }
 func (m *TopicIdBlockHeightScores) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -751,7 +1012,7 @@ func (m *TopicIdActorIdScore) Reset()         { *m = TopicIdActorIdScore{} }
 func (m *TopicIdActorIdScore) String() string { return proto.CompactTextString(m) }
 func (*TopicIdActorIdScore) ProtoMessage()    {}
 func (*TopicIdActorIdScore) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{5}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{6}
 }
 func (m *TopicIdActorIdScore) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -811,7 +1072,7 @@ func (m *TopicIdActorIdListeningCoefficient) Reset()         { *m = TopicIdActor
 func (m *TopicIdActorIdListeningCoefficient) String() string { return proto.CompactTextString(m) }
 func (*TopicIdActorIdListeningCoefficient) ProtoMessage()    {}
 func (*TopicIdActorIdListeningCoefficient) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{6}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{7}
 }
 func (m *TopicIdActorIdListeningCoefficient) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -871,7 +1132,7 @@ func (m *TopicIdActorIdDec) Reset()         { *m = TopicIdActorIdDec{} }
 func (m *TopicIdActorIdDec) String() string { return proto.CompactTextString(m) }
 func (*TopicIdActorIdDec) ProtoMessage()    {}
 func (*TopicIdActorIdDec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{7}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{8}
 }
 func (m *TopicIdActorIdDec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -923,7 +1184,7 @@ func (m *TopicIdAndInt) Reset()         { *m = TopicIdAndInt{} }
 func (m *TopicIdAndInt) String() string { return proto.CompactTextString(m) }
 func (*TopicIdAndInt) ProtoMessage()    {}
 func (*TopicIdAndInt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{8}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{9}
 }
 func (m *TopicIdAndInt) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -969,7 +1230,7 @@ func (m *TopicIdActorIdInt) Reset()         { *m = TopicIdActorIdInt{} }
 func (m *TopicIdActorIdInt) String() string { return proto.CompactTextString(m) }
 func (*TopicIdActorIdInt) ProtoMessage()    {}
 func (*TopicIdActorIdInt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{9}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{10}
 }
 func (m *TopicIdActorIdInt) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1023,7 +1284,7 @@ func (m *TopicIdDelegatorReputerDelegatorInfo) Reset()         { *m = TopicIdDel
 func (m *TopicIdDelegatorReputerDelegatorInfo) String() string { return proto.CompactTextString(m) }
 func (*TopicIdDelegatorReputerDelegatorInfo) ProtoMessage()    {}
 func (*TopicIdDelegatorReputerDelegatorInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{10}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{11}
 }
 func (m *TopicIdDelegatorReputerDelegatorInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1095,7 +1356,7 @@ func (m *BlockHeightTopicIdReputerStakeRemovalInfo) String() string {
 }
 func (*BlockHeightTopicIdReputerStakeRemovalInfo) ProtoMessage() {}
 func (*BlockHeightTopicIdReputerStakeRemovalInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{11}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{12}
 }
 func (m *BlockHeightTopicIdReputerStakeRemovalInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1162,7 +1423,7 @@ func (m *ActorIdTopicIdBlockHeight) Reset()         { *m = ActorIdTopicIdBlockHe
 func (m *ActorIdTopicIdBlockHeight) String() string { return proto.CompactTextString(m) }
 func (*ActorIdTopicIdBlockHeight) ProtoMessage()    {}
 func (*ActorIdTopicIdBlockHeight) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{12}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{13}
 }
 func (m *ActorIdTopicIdBlockHeight) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1228,7 +1489,7 @@ func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) String() st
 }
 func (*BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) ProtoMessage() {}
 func (*BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{13}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{14}
 }
 func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1303,7 +1564,7 @@ func (m *DelegatorReputerTopicIdBlockHeight) Reset()         { *m = DelegatorRep
 func (m *DelegatorReputerTopicIdBlockHeight) String() string { return proto.CompactTextString(m) }
 func (*DelegatorReputerTopicIdBlockHeight) ProtoMessage()    {}
 func (*DelegatorReputerTopicIdBlockHeight) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{14}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{15}
 }
 func (m *DelegatorReputerTopicIdBlockHeight) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1370,7 +1631,7 @@ func (m *TopicIdActorIdInference) Reset()         { *m = TopicIdActorIdInference
 func (m *TopicIdActorIdInference) String() string { return proto.CompactTextString(m) }
 func (*TopicIdActorIdInference) ProtoMessage()    {}
 func (*TopicIdActorIdInference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{15}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{16}
 }
 func (m *TopicIdActorIdInference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1430,7 +1691,7 @@ func (m *TopicIdActorIdForecast) Reset()         { *m = TopicIdActorIdForecast{}
 func (m *TopicIdActorIdForecast) String() string { return proto.CompactTextString(m) }
 func (*TopicIdActorIdForecast) ProtoMessage()    {}
 func (*TopicIdActorIdForecast) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{16}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{17}
 }
 func (m *TopicIdActorIdForecast) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1489,7 +1750,7 @@ func (m *LibP2PKeyAndOffchainNode) Reset()         { *m = LibP2PKeyAndOffchainNo
 func (m *LibP2PKeyAndOffchainNode) String() string { return proto.CompactTextString(m) }
 func (*LibP2PKeyAndOffchainNode) ProtoMessage()    {}
 func (*LibP2PKeyAndOffchainNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{17}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{18}
 }
 func (m *LibP2PKeyAndOffchainNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1541,7 +1802,7 @@ func (m *TopicIdAndDec) Reset()         { *m = TopicIdAndDec{} }
 func (m *TopicIdAndDec) String() string { return proto.CompactTextString(m) }
 func (*TopicIdAndDec) ProtoMessage()    {}
 func (*TopicIdAndDec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{18}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{19}
 }
 func (m *TopicIdAndDec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1587,7 +1848,7 @@ func (m *TopicIdBlockHeightInferences) Reset()         { *m = TopicIdBlockHeight
 func (m *TopicIdBlockHeightInferences) String() string { return proto.CompactTextString(m) }
 func (*TopicIdBlockHeightInferences) ProtoMessage()    {}
 func (*TopicIdBlockHeightInferences) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{19}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{20}
 }
 func (m *TopicIdBlockHeightInferences) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1647,7 +1908,7 @@ func (m *TopicIdBlockHeightForecasts) Reset()         { *m = TopicIdBlockHeightF
 func (m *TopicIdBlockHeightForecasts) String() string { return proto.CompactTextString(m) }
 func (*TopicIdBlockHeightForecasts) ProtoMessage()    {}
 func (*TopicIdBlockHeightForecasts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{20}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{21}
 }
 func (m *TopicIdBlockHeightForecasts) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1707,7 +1968,7 @@ func (m *TopicIdBlockHeightReputerValueBundles) Reset()         { *m = TopicIdBl
 func (m *TopicIdBlockHeightReputerValueBundles) String() string { return proto.CompactTextString(m) }
 func (*TopicIdBlockHeightReputerValueBundles) ProtoMessage()    {}
 func (*TopicIdBlockHeightReputerValueBundles) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{21}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{22}
 }
 func (m *TopicIdBlockHeightReputerValueBundles) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1767,7 +2028,7 @@ func (m *TopicIdBlockHeightValueBundles) Reset()         { *m = TopicIdBlockHeig
 func (m *TopicIdBlockHeightValueBundles) String() string { return proto.CompactTextString(m) }
 func (*TopicIdBlockHeightValueBundles) ProtoMessage()    {}
 func (*TopicIdBlockHeightValueBundles) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{22}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{23}
 }
 func (m *TopicIdBlockHeightValueBundles) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1826,7 +2087,7 @@ func (m *TopicIdAndNonces) Reset()         { *m = TopicIdAndNonces{} }
 func (m *TopicIdAndNonces) String() string { return proto.CompactTextString(m) }
 func (*TopicIdAndNonces) ProtoMessage()    {}
 func (*TopicIdAndNonces) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{23}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{24}
 }
 func (m *TopicIdAndNonces) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1878,7 +2139,7 @@ func (m *TopicIdAndReputerRequestNonces) Reset()         { *m = TopicIdAndRepute
 func (m *TopicIdAndReputerRequestNonces) String() string { return proto.CompactTextString(m) }
 func (*TopicIdAndReputerRequestNonces) ProtoMessage()    {}
 func (*TopicIdAndReputerRequestNonces) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{24}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{25}
 }
 func (m *TopicIdAndReputerRequestNonces) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1931,7 +2192,7 @@ func (m *TopicIdActorIdTimeStampedValue) Reset()         { *m = TopicIdActorIdTi
 func (m *TopicIdActorIdTimeStampedValue) String() string { return proto.CompactTextString(m) }
 func (*TopicIdActorIdTimeStampedValue) ProtoMessage()    {}
 func (*TopicIdActorIdTimeStampedValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{25}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{26}
 }
 func (m *TopicIdActorIdTimeStampedValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1992,7 +2253,7 @@ func (m *TopicIdActorIdActorIdTimeStampedValue) Reset()         { *m = TopicIdAc
 func (m *TopicIdActorIdActorIdTimeStampedValue) String() string { return proto.CompactTextString(m) }
 func (*TopicIdActorIdActorIdTimeStampedValue) ProtoMessage()    {}
 func (*TopicIdActorIdActorIdTimeStampedValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{26}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{27}
 }
 func (m *TopicIdActorIdActorIdTimeStampedValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2058,7 +2319,7 @@ func (m *TopicIdTimestampedActorNonce) Reset()         { *m = TopicIdTimestamped
 func (m *TopicIdTimestampedActorNonce) String() string { return proto.CompactTextString(m) }
 func (*TopicIdTimestampedActorNonce) ProtoMessage()    {}
 func (*TopicIdTimestampedActorNonce) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{27}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{28}
 }
 func (m *TopicIdTimestampedActorNonce) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2101,388 +2362,698 @@ func (m *TopicIdTimestampedActorNonce) GetTimestampedActorNonce() *TimestampedAc
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*GenesisState)(nil), "emissions.v1.GenesisState")
-	proto.RegisterType((*TopicIdAndTopic)(nil), "emissions.v1.TopicIdAndTopic")
-	proto.RegisterType((*TopicAndActorId)(nil), "emissions.v1.TopicAndActorId")
-	proto.RegisterType((*TopicIdAndBlockHeight)(nil), "emissions.v1.TopicIdAndBlockHeight")
-	proto.RegisterType((*TopicIdBlockHeightScores)(nil), "emissions.v1.TopicIdBlockHeightScores")
-	proto.RegisterType((*TopicIdActorIdScore)(nil), "emissions.v1.TopicIdActorIdScore")
-	proto.RegisterType((*TopicIdActorIdListeningCoefficient)(nil), "emissions.v1.TopicIdActorIdListeningCoefficient")
-	proto.RegisterType((*TopicIdActorIdDec)(nil), "emissions.v1.TopicIdActorIdDec")
-	proto.RegisterType((*TopicIdAndInt)(nil), "emissions.v1.TopicIdAndInt")
-	proto.RegisterType((*TopicIdActorIdInt)(nil), "emissions.v1.TopicIdActorIdInt")
-	proto.RegisterType((*TopicIdDelegatorReputerDelegatorInfo)(nil), "emissions.v1.TopicIdDelegatorReputerDelegatorInfo")
-	proto.RegisterType((*BlockHeightTopicIdReputerStakeRemovalInfo)(nil), "emissions.v1.BlockHeightTopicIdReputerStakeRemovalInfo")
-	proto.RegisterType((*ActorIdTopicIdBlockHeight)(nil), "emissions.v1.ActorIdTopicIdBlockHeight")
-	proto.RegisterType((*BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo)(nil), "emissions.v1.BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo")
-	proto.RegisterType((*DelegatorReputerTopicIdBlockHeight)(nil), "emissions.v1.DelegatorReputerTopicIdBlockHeight")
-	proto.RegisterType((*TopicIdActorIdInference)(nil), "emissions.v1.TopicIdActorIdInference")
-	proto.RegisterType((*TopicIdActorIdForecast)(nil), "emissions.v1.TopicIdActorIdForecast")
-	proto.RegisterType((*LibP2PKeyAndOffchainNode)(nil), "emissions.v1.LibP2pKeyAndOffchainNode")
-	proto.RegisterType((*TopicIdAndDec)(nil), "emissions.v1.TopicIdAndDec")
-	proto.RegisterType((*TopicIdBlockHeightInferences)(nil), "emissions.v1.TopicIdBlockHeightInferences")
-	proto.RegisterType((*TopicIdBlockHeightForecasts)(nil), "emissions.v1.TopicIdBlockHeightForecasts")
-	proto.RegisterType((*TopicIdBlockHeightReputerValueBundles)(nil), "emissions.v1.TopicIdBlockHeightReputerValueBundles")
-	proto.RegisterType((*TopicIdBlockHeightValueBundles)(nil), "emissions.v1.TopicIdBlockHeightValueBundles")
-	proto.RegisterType((*TopicIdAndNonces)(nil), "emissions.v1.TopicIdAndNonces")
-	proto.RegisterType((*TopicIdAndReputerRequestNonces)(nil), "emissions.v1.TopicIdAndReputerRequestNonces")
-	proto.RegisterType((*TopicIdActorIdTimeStampedValue)(nil), "emissions.v1.TopicIdActorIdTimeStampedValue")
-	proto.RegisterType((*TopicIdActorIdActorIdTimeStampedValue)(nil), "emissions.v1.TopicIdActorIdActorIdTimeStampedValue")
-	proto.RegisterType((*TopicIdTimestampedActorNonce)(nil), "emissions.v1.TopicIdTimestampedActorNonce")
+type TopicIdHorizonTimestampedActorNonce struct {
+	TopicId               uint64                 `protobuf:"varint,1,opt,name=TopicId,proto3" json:"TopicId,omitempty"`
+	Horizon               uint32                 `protobuf:"varint,2,opt,name=Horizon,proto3" json:"Horizon,omitempty"`
+	TimestampedActorNonce *TimestampedActorNonce `protobuf:"bytes,3,opt,name=TimestampedActorNonce,proto3" json:"TimestampedActorNonce,omitempty"`
 }
 
-func init() { proto.RegisterFile("emissions/v1/genesis.proto", fileDescriptor_8702cc38ff1a7f6a) }
-
-var fileDescriptor_8702cc38ff1a7f6a = []byte{
-	// 2124 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0xcf, 0x6f, 0xdb, 0xc8,
-	0xf5, 0x0f, 0x2d, 0xc7, 0x89, 0x9f, 0xed, 0xb5, 0x33, 0xfe, 0x35, 0xfe, 0x11, 0x59, 0xcb, 0xfc,
-	0x52, 0xf2, 0x4d, 0xa4, 0xc4, 0xf9, 0x06, 0xbb, 0xe8, 0x02, 0x45, 0xa5, 0x24, 0xee, 0x6a, 0x9b,
-	0x4d, 0x0c, 0x2a, 0x9b, 0xb4, 0xd9, 0x05, 0xb2, 0x34, 0x39, 0x92, 0x09, 0x53, 0xa4, 0x96, 0x1c,
-	0x39, 0xf1, 0xad, 0x87, 0xb6, 0x97, 0xf6, 0xb0, 0xe8, 0xee, 0xa1, 0xbd, 0xf5, 0xd8, 0x53, 0xb1,
-	0x87, 0x1e, 0x0a, 0xf4, 0x5c, 0x60, 0x2f, 0x05, 0x16, 0x3d, 0x15, 0x3d, 0x2c, 0x8a, 0xe4, 0xd0,
-	0x7f, 0xa3, 0xe0, 0xcc, 0x90, 0x22, 0xa9, 0x21, 0x25, 0x4b, 0xbd, 0x04, 0x26, 0xe7, 0xbd, 0xcf,
-	0xe7, 0x33, 0xf3, 0x1e, 0xdf, 0xcc, 0xbc, 0x08, 0x36, 0x49, 0xc7, 0xf2, 0x7d, 0xcb, 0x75, 0xfc,
-	0xea, 0xf1, 0x9d, 0x6a, 0x9b, 0x38, 0xc4, 0xb7, 0xfc, 0x4a, 0xd7, 0x73, 0xa9, 0x8b, 0xe6, 0xa3,
-	0xb1, 0xca, 0xf1, 0x9d, 0xcd, 0x0d, 0xc3, 0xf5, 0x3b, 0xae, 0xff, 0x92, 0x8d, 0x55, 0xf9, 0x03,
-	0x37, 0xdc, 0xbc, 0xa0, 0x77, 0x2c, 0xc7, 0xad, 0xb2, 0x7f, 0xc5, 0xab, 0x95, 0xb6, 0xdb, 0x76,
-	0xb9, 0x69, 0xf0, 0x97, 0x78, 0xbb, 0x91, 0x60, 0xeb, 0xea, 0x9e, 0xde, 0x09, 0x31, 0x70, 0x62,
-	0xc8, 0x37, 0x5c, 0x8f, 0xc8, 0x47, 0xa8, 0x7e, 0x24, 0x1f, 0xa1, 0x27, 0x5d, 0x22, 0x47, 0xa3,
-	0x6e, 0xd7, 0x32, 0xa4, 0x12, 0x5e, 0xb9, 0xde, 0x11, 0xf1, 0xc4, 0xd0, 0x7a, 0x62, 0xc8, 0x71,
-	0xcd, 0x90, 0x27, 0xb9, 0x48, 0x1e, 0xe9, 0xf6, 0x68, 0xe4, 0x84, 0x53, 0x4e, 0x8e, 0x21, 0xbc,
-	0xd4, 0x3f, 0x5d, 0x86, 0xf9, 0x1f, 0xf3, 0x05, 0x6d, 0x52, 0x9d, 0x12, 0xb4, 0x0b, 0x33, 0x7c,
-	0xca, 0x58, 0x29, 0x29, 0xe5, 0xb9, 0xdd, 0x95, 0x4a, 0x7c, 0x81, 0x2b, 0xfb, 0x6c, 0xac, 0x3e,
-	0xfd, 0xed, 0xf7, 0x3b, 0x67, 0x34, 0x61, 0x89, 0x4a, 0x30, 0xe7, 0x90, 0xd7, 0xf4, 0x69, 0x30,
-	0x83, 0x86, 0x89, 0x0b, 0x25, 0xa5, 0x3c, 0xad, 0xc5, 0x5f, 0xa1, 0x7b, 0x30, 0xc3, 0xe6, 0xe7,
-	0xe3, 0xe9, 0x52, 0xa1, 0x3c, 0xb7, 0x7b, 0x31, 0x89, 0x2a, 0xcc, 0x6a, 0x8e, 0xc9, 0xfe, 0xd2,
-	0x84, 0x31, 0x52, 0x61, 0x5e, 0x37, 0xa8, 0x75, 0x4c, 0x9e, 0x72, 0xe7, 0xb3, 0xa5, 0x42, 0x79,
-	0x5a, 0x4b, 0xbc, 0x43, 0x65, 0x58, 0x34, 0x0e, 0x7b, 0x9e, 0xa3, 0x1f, 0xd8, 0xa1, 0xd9, 0x0c,
-	0x33, 0x4b, 0xbf, 0x46, 0x37, 0x60, 0xc9, 0x23, 0xaf, 0x74, 0xcf, 0x8c, 0x99, 0x9e, 0x63, 0xa6,
-	0x03, 0xef, 0x51, 0x0d, 0xe6, 0x99, 0x86, 0xe7, 0x6c, 0xed, 0x7d, 0x7c, 0x3e, 0x53, 0x76, 0xcd,
-	0x31, 0x6b, 0x06, 0x75, 0xbd, 0x86, 0xa9, 0x25, 0x5c, 0xd0, 0x7d, 0x58, 0x60, 0xcf, 0x1a, 0x0f,
-	0x85, 0x8f, 0x67, 0x47, 0xc1, 0x48, 0xfa, 0xa0, 0x27, 0xb0, 0x24, 0x5e, 0x04, 0x02, 0x1f, 0x07,
-	0x91, 0xc3, 0xc0, 0x70, 0x2e, 0x65, 0x2d, 0x61, 0xdd, 0x76, 0x8d, 0xa3, 0x0f, 0x89, 0xd5, 0x3e,
-	0xa4, 0xda, 0x80, 0x33, 0x7a, 0x01, 0x2b, 0x96, 0xd3, 0x22, 0x1e, 0xf1, 0x9a, 0x41, 0xfa, 0xfa,
-	0xf5, 0x13, 0x66, 0x8f, 0xe7, 0x18, 0xe8, 0x55, 0x29, 0x68, 0x0c, 0x91, 0x3b, 0x69, 0x52, 0x0c,
-	0xf4, 0x39, 0xac, 0xb7, 0x5c, 0x8f, 0x18, 0xba, 0x4f, 0xd3, 0xf0, 0xf3, 0xa7, 0x82, 0xcf, 0x82,
-	0x09, 0xd4, 0x8b, 0xcc, 0x4e, 0xc2, 0x2f, 0x9c, 0x4e, 0xbd, 0x0c, 0x03, 0x19, 0xb0, 0x65, 0xeb,
-	0x94, 0xf8, 0xb4, 0x91, 0x9c, 0x1b, 0x8f, 0x27, 0x7e, 0x87, 0x51, 0xbc, 0x2b, 0x5f, 0x75, 0x1e,
-	0x3c, 0xe6, 0xa1, 0xe5, 0xa1, 0x20, 0x0b, 0x8a, 0x7c, 0x78, 0x6f, 0x60, 0x86, 0x82, 0x67, 0x71,
-	0x54, 0x9e, 0x21, 0x40, 0x88, 0xc0, 0x36, 0xb7, 0xd0, 0x92, 0xb3, 0x15, 0x8f, 0x78, 0x69, 0x54,
-	0xa2, 0x5c, 0x18, 0xe4, 0xc1, 0x96, 0x58, 0xce, 0x47, 0x96, 0x4f, 0x89, 0x63, 0x39, 0xed, 0xfb,
-	0x2e, 0x69, 0xb5, 0x2c, 0xc3, 0x22, 0x0e, 0xc5, 0x17, 0x18, 0xcb, 0xed, 0x3c, 0x16, 0x99, 0x9f,
-	0x96, 0x07, 0x8a, 0x08, 0x5c, 0xec, 0x7a, 0xe4, 0xd8, 0x72, 0x7b, 0xbe, 0x90, 0xc1, 0x53, 0x7c,
-	0xcf, 0x0b, 0x4a, 0x83, 0xeb, 0x60, 0xc4, 0x58, 0x77, 0xf2, 0x58, 0x1f, 0x10, 0x43, 0xcb, 0x47,
-	0x41, 0x16, 0xec, 0x84, 0x06, 0x3c, 0x9a, 0x8e, 0x41, 0x52, 0x44, 0xcb, 0xa3, 0x11, 0x0d, 0xc3,
-	0x41, 0x6d, 0x28, 0x86, 0x26, 0x61, 0x40, 0x53, 0x4c, 0x2b, 0xa3, 0x31, 0x0d, 0x81, 0x41, 0xfb,
-	0x00, 0xd4, 0xa5, 0xba, 0xdd, 0x0c, 0xb6, 0x28, 0xbc, 0x5a, 0x52, 0xca, 0xb3, 0xf5, 0xdb, 0x41,
-	0x35, 0xff, 0xd7, 0xf7, 0x3b, 0xab, 0x7c, 0xc3, 0xf4, 0xcd, 0xa3, 0x8a, 0xe5, 0x56, 0x3b, 0x3a,
-	0x3d, 0xac, 0x34, 0x1c, 0xfa, 0x8f, 0x3f, 0xdf, 0x02, 0xb1, 0x93, 0x36, 0x1c, 0xfa, 0xc7, 0xff,
-	0x7c, 0x73, 0x43, 0xd1, 0x62, 0x18, 0xe8, 0x83, 0x00, 0xb1, 0x6b, 0x19, 0x1c, 0x71, 0x8d, 0xc9,
-	0xdc, 0xca, 0x2a, 0x4e, 0x0d, 0x87, 0x6a, 0x31, 0x73, 0xf4, 0x09, 0xac, 0xb2, 0xcd, 0x52, 0x04,
-	0xa0, 0xd6, 0xa3, 0x87, 0xae, 0x67, 0xd1, 0x13, 0xbc, 0x3e, 0x7c, 0xba, 0x01, 0x96, 0xdc, 0x3b,
-	0x82, 0x6d, 0xf6, 0x3a, 0x7b, 0x9e, 0xdb, 0x79, 0x40, 0x6c, 0xd2, 0xd6, 0xa9, 0xeb, 0x61, 0x7c,
-	0x1a, 0xd8, 0xb4, 0x37, 0xfa, 0x0c, 0x16, 0x4d, 0xfe, 0x40, 0x4c, 0xa6, 0xdf, 0xc7, 0x1b, 0x0c,
-	0x70, 0x57, 0x0a, 0x18, 0x39, 0x0a, 0x7d, 0xd1, 0x73, 0xc3, 0x69, 0xb9, 0x5a, 0x1a, 0x2a, 0xc8,
-	0x01, 0x46, 0x9b, 0xe0, 0xf4, 0x3f, 0xe9, 0xba, 0x4e, 0xf8, 0xc9, 0x6e, 0x8e, 0xa6, 0x7e, 0x08,
-	0x0c, 0x7a, 0x0e, 0x6b, 0x21, 0x37, 0xcf, 0x8e, 0x7d, 0xe2, 0x35, 0x0f, 0x75, 0x8f, 0xe0, 0xad,
-	0xd1, 0x92, 0x2c, 0xc3, 0x1d, 0x1d, 0xc1, 0x8a, 0x88, 0x47, 0xc7, 0x3d, 0xd6, 0xed, 0xa8, 0x3c,
-	0x6f, 0x33, 0xd8, 0xf7, 0x92, 0xb0, 0xb1, 0xba, 0x2c, 0x18, 0xc2, 0x0a, 0x13, 0x83, 0x60, 0x2b,
-	0x25, 0x05, 0x45, 0x9f, 0x0e, 0x90, 0x31, 0x85, 0xf8, 0x22, 0x23, 0xbb, 0x96, 0x24, 0x13, 0xe2,
-	0x07, 0xb7, 0x04, 0x4d, 0x0a, 0x82, 0x7e, 0xa3, 0xc0, 0x76, 0x38, 0xc9, 0xa6, 0x6c, 0x4a, 0x45,
-	0xc6, 0xf2, 0xe1, 0xb0, 0x29, 0x65, 0xa4, 0x00, 0x19, 0x98, 0x63, 0x2e, 0x1b, 0xa2, 0x99, 0x6a,
-	0xf8, 0x9c, 0x77, 0x64, 0x55, 0x36, 0xcd, 0x2d, 0x99, 0x7c, 0x2e, 0x2a, 0x7a, 0x08, 0x60, 0x85,
-	0xf5, 0xca, 0xc7, 0x25, 0xc6, 0x71, 0x25, 0x3f, 0xf9, 0xc2, 0xea, 0x16, 0x73, 0x44, 0x75, 0x98,
-	0x0d, 0xf7, 0x73, 0x1f, 0xbf, 0xcb, 0x50, 0x2e, 0xe7, 0xa1, 0x44, 0x95, 0xab, 0xef, 0x86, 0x7e,
-	0x04, 0xe7, 0x5e, 0x89, 0xa3, 0x98, 0x2a, 0xdb, 0xeb, 0x1f, 0x59, 0x07, 0xfb, 0xbb, 0xdd, 0x9f,
-	0x90, 0x93, 0x9a, 0x63, 0x3e, 0x69, 0xb5, 0x8c, 0x43, 0xdd, 0x72, 0x1e, 0xbb, 0x26, 0xd1, 0x42,
-	0x37, 0x54, 0x87, 0xf3, 0x5e, 0x78, 0x12, 0xbb, 0x74, 0x2a, 0x88, 0xc8, 0x0f, 0x3d, 0x84, 0x45,
-	0x56, 0xbb, 0xf6, 0x08, 0xd1, 0xc8, 0x31, 0x71, 0x7a, 0x04, 0x5f, 0x1e, 0x5e, 0xef, 0xd2, 0x3e,
-	0xe8, 0x63, 0x58, 0x0e, 0xab, 0x34, 0xb3, 0x7c, 0xce, 0x82, 0x81, 0xaf, 0xe4, 0x43, 0x05, 0x1f,
-	0x9e, 0xcc, 0x0f, 0xed, 0xc3, 0x82, 0x6e, 0xdb, 0x8d, 0x7e, 0xa4, 0xae, 0x32, 0xa0, 0x1b, 0xc3,
-	0x4e, 0x43, 0x7d, 0x0f, 0x2d, 0x09, 0x80, 0x3e, 0x86, 0x79, 0xdd, 0xb6, 0xf7, 0xa2, 0xa0, 0x5d,
-	0x63, 0x80, 0xd7, 0x87, 0x01, 0x46, 0x0e, 0x5a, 0xc2, 0x1d, 0x7d, 0x0a, 0xef, 0xe8, 0xb6, 0xfd,
-	0xc8, 0xf5, 0xfd, 0x7a, 0xcf, 0x31, 0x6d, 0xe2, 0xe3, 0x32, 0x03, 0xbc, 0x3b, 0x0c, 0x50, 0x24,
-	0xee, 0x33, 0xdd, 0xee, 0x11, 0xe1, 0xaa, 0xa5, 0xa0, 0xd0, 0x67, 0x80, 0x1c, 0x42, 0x83, 0x28,
-	0xc7, 0x09, 0xae, 0x33, 0x82, 0x9b, 0xc3, 0x08, 0x12, 0xc8, 0x12, 0x1c, 0xf4, 0x95, 0x02, 0xe5,
-	0x70, 0xcd, 0xf7, 0x89, 0x67, 0x10, 0x87, 0xea, 0x6d, 0x51, 0xf6, 0x9e, 0xba, 0xec, 0xab, 0x31,
-	0xa3, 0x03, 0xfe, 0x0d, 0xb6, 0x9b, 0xbe, 0x27, 0x76, 0xd3, 0x6a, 0xdb, 0xa2, 0x87, 0xbd, 0x83,
-	0x8a, 0xe1, 0x76, 0xaa, 0xba, 0x6d, 0xbb, 0x9e, 0x7e, 0x4b, 0x10, 0x84, 0x8f, 0x2c, 0xc5, 0xf8,
-	0x3e, 0x1b, 0x04, 0x77, 0x64, 0x22, 0xf4, 0x53, 0x58, 0xef, 0x39, 0xad, 0x9e, 0xdd, 0xb2, 0x6c,
-	0x9b, 0x98, 0xfc, 0xbc, 0xc7, 0x8e, 0xf7, 0x3e, 0xfe, 0x3f, 0x36, 0xf1, 0x62, 0x56, 0x12, 0x71,
-	0x2b, 0x2d, 0xcb, 0x1d, 0x1d, 0x02, 0x8e, 0x0d, 0x09, 0x42, 0x01, 0x7d, 0x33, 0x67, 0x4d, 0x6b,
-	0x8e, 0x19, 0x9d, 0xa1, 0xbe, 0xe8, 0x11, 0x9f, 0x0a, 0xa2, 0x4c, 0x34, 0xe4, 0xa4, 0x8e, 0xdb,
-	0x8f, 0xf9, 0xda, 0x68, 0xa4, 0xed, 0x11, 0xea, 0xe3, 0x5b, 0x79, 0x64, 0xa2, 0x98, 0x5b, 0x9d,
-	0xa0, 0x6e, 0x75, 0xba, 0xc4, 0x64, 0x71, 0xd4, 0xf2, 0x00, 0x11, 0x1d, 0x3c, 0x79, 0xa7, 0x28,
-	0x2b, 0x63, 0x50, 0x0e, 0xc1, 0x44, 0xbf, 0x54, 0xe0, 0x12, 0x37, 0x79, 0xe2, 0x90, 0x86, 0x93,
-	0xc9, 0x5d, 0xcd, 0xf9, 0x20, 0x04, 0x77, 0x96, 0x84, 0x51, 0xf0, 0xd1, 0xaf, 0x14, 0xb8, 0x26,
-	0xb5, 0x6b, 0x12, 0xbb, 0x95, 0xd2, 0x72, 0x7b, 0x8c, 0x75, 0x18, 0x15, 0x1c, 0x55, 0x60, 0x39,
-	0xb8, 0x40, 0xbc, 0xa4, 0x44, 0xef, 0xbc, 0xd4, 0x4d, 0xd3, 0x23, 0xbe, 0x4f, 0x7c, 0x3c, 0x55,
-	0x2a, 0x94, 0x67, 0xb5, 0x0b, 0xc1, 0xd0, 0x53, 0xa2, 0x77, 0x6a, 0xe1, 0x00, 0xfa, 0x1c, 0x56,
-	0x59, 0xf9, 0x7c, 0xa4, 0xfb, 0x94, 0x67, 0xea, 0x7d, 0xb7, 0xd3, 0xb1, 0x28, 0xbe, 0x93, 0x53,
-	0xe4, 0x02, 0x79, 0x3e, 0x97, 0xc7, 0x04, 0xb3, 0x94, 0xd3, 0xe4, 0x40, 0xe8, 0x00, 0xd6, 0xa2,
-	0x01, 0x91, 0xa2, 0x82, 0x62, 0xf7, 0xd4, 0x14, 0x19, 0x48, 0x09, 0x0e, 0x4e, 0xbe, 0xaf, 0x9f,
-	0xd8, 0xae, 0x6e, 0xe2, 0xbb, 0x13, 0x70, 0x24, 0x90, 0x90, 0x09, 0xeb, 0x69, 0xf6, 0x90, 0xe4,
-	0xff, 0x4f, 0x4d, 0x92, 0x05, 0xa5, 0x3e, 0x83, 0xc5, 0x54, 0xb7, 0x06, 0x61, 0x38, 0x17, 0xb6,
-	0x7e, 0x14, 0xd6, 0xfa, 0x09, 0x1f, 0xd1, 0x75, 0x38, 0xcb, 0xfe, 0xc4, 0x53, 0xac, 0x97, 0xb4,
-	0x2c, 0x11, 0xa0, 0x71, 0x0b, 0xf5, 0xa1, 0xc0, 0xed, 0xb7, 0x42, 0x72, 0x70, 0x31, 0x9c, 0x13,
-	0x46, 0x0c, 0x79, 0x56, 0x0b, 0x1f, 0xd5, 0x26, 0xac, 0x4a, 0x3b, 0x21, 0x39, 0x60, 0x25, 0x98,
-	0x8b, 0x19, 0x32, 0xc0, 0x82, 0x16, 0x7f, 0xa5, 0xfe, 0x42, 0x01, 0x9c, 0xd5, 0x4c, 0x98, 0x04,
-	0x18, 0xdd, 0x84, 0x19, 0x8e, 0xc2, 0x7a, 0x66, 0x03, 0xcd, 0x36, 0xd1, 0xae, 0x10, 0x36, 0x2a,
-	0x85, 0x65, 0xc9, 0xf5, 0x7c, 0x9c, 0x65, 0x0a, 0x02, 0xc3, 0x9c, 0x05, 0xef, 0xb2, 0x84, 0x57,
-	0xe3, 0x16, 0xea, 0x37, 0x0a, 0xa8, 0xc3, 0xef, 0xeb, 0x63, 0xa9, 0x78, 0x06, 0x2b, 0xd2, 0x9e,
-	0x01, 0x17, 0xa5, 0xa6, 0x8f, 0x67, 0x92, 0x2e, 0x81, 0xd4, 0x5f, 0xfd, 0xad, 0x02, 0x17, 0x06,
-	0x2e, 0x2d, 0x63, 0x29, 0x6c, 0x40, 0xe1, 0x01, 0x31, 0x98, 0xa0, 0x09, 0x36, 0xf6, 0x00, 0x43,
-	0xed, 0xc0, 0x42, 0xe2, 0x58, 0x98, 0xa3, 0xa7, 0x0e, 0x85, 0x86, 0xc3, 0x13, 0x66, 0x9c, 0xcb,
-	0x79, 0xe0, 0xac, 0xfe, 0x7a, 0x60, 0x0d, 0x1a, 0x63, 0x46, 0x49, 0xa8, 0x29, 0x4c, 0xa2, 0xe6,
-	0xaf, 0x0a, 0x5c, 0x1e, 0xe5, 0x52, 0x9c, 0x23, 0x70, 0x1b, 0x66, 0xfb, 0xd7, 0x78, 0x2e, 0xb1,
-	0xff, 0x22, 0xf0, 0x0b, 0x2f, 0xc9, 0x05, 0x2e, 0x3f, 0xbc, 0xec, 0xd6, 0x60, 0x21, 0x41, 0x81,
-	0xa7, 0x59, 0x76, 0x6d, 0x65, 0xdc, 0x95, 0xd8, 0x65, 0x2c, 0xe9, 0xa1, 0xfe, 0x5d, 0x81, 0xeb,
-	0x23, 0xdf, 0x56, 0xd3, 0x9f, 0xbd, 0x32, 0xf8, 0xd9, 0xc7, 0x26, 0x39, 0x35, 0x10, 0x85, 0x8c,
-	0x69, 0x7c, 0x04, 0x4b, 0x69, 0x26, 0x31, 0x93, 0xd4, 0x59, 0x6f, 0xe0, 0x66, 0x39, 0xe0, 0xa7,
-	0x7e, 0x01, 0x1b, 0x99, 0xf7, 0xe1, 0x78, 0x22, 0x28, 0xc9, 0x44, 0xc8, 0x96, 0x9d, 0x9a, 0x72,
-	0x61, 0xb0, 0x84, 0x7e, 0x3d, 0x05, 0x3f, 0x18, 0xff, 0x76, 0x3c, 0xd1, 0x9a, 0x26, 0x12, 0xa7,
-	0x90, 0x93, 0x38, 0xd3, 0xc9, 0x15, 0x3f, 0x00, 0x9c, 0xa5, 0x07, 0x9f, 0x65, 0x2b, 0x7f, 0x55,
-	0x9a, 0x43, 0x83, 0x77, 0xfb, 0x4c, 0x1c, 0xf5, 0x77, 0x0a, 0xa8, 0xc3, 0xaf, 0xe9, 0xc9, 0x29,
-	0x28, 0x39, 0x53, 0x98, 0x4a, 0x4e, 0x21, 0xb6, 0x28, 0x85, 0xdc, 0x88, 0x4d, 0x4b, 0x37, 0xbd,
-	0xf5, 0x8c, 0xdb, 0xfd, 0x58, 0x65, 0xe4, 0x1e, 0xcc, 0x46, 0x00, 0xa2, 0xc2, 0xaf, 0x27, 0xd7,
-	0xaf, 0xdf, 0x3d, 0xe8, 0x5b, 0xaa, 0x3f, 0x57, 0x60, 0x4d, 0xde, 0x1e, 0x18, 0x4b, 0xc5, 0x2e,
-	0x9c, 0x0f, 0xfd, 0x85, 0x88, 0xb5, 0xa4, 0x88, 0xa8, 0xf9, 0x10, 0xd9, 0xa9, 0xaf, 0x01, 0x67,
-	0xf5, 0x06, 0x82, 0xc8, 0x44, 0x63, 0x61, 0x64, 0xa2, 0x17, 0xe8, 0x87, 0x30, 0x1f, 0xb7, 0x16,
-	0xc7, 0xa0, 0xcd, 0x24, 0x63, 0xa2, 0xd7, 0x90, 0xb0, 0x57, 0x69, 0x7c, 0xcf, 0xc8, 0xdf, 0xc3,
-	0xc4, 0x4e, 0x35, 0xf5, 0x3f, 0xd8, 0xa9, 0xbe, 0x56, 0x60, 0x3b, 0xaf, 0x5b, 0x30, 0xd1, 0x91,
-	0xe7, 0x7d, 0x80, 0x58, 0xa7, 0x82, 0x87, 0x00, 0x67, 0xe4, 0x81, 0xaf, 0xc5, 0x6c, 0xd5, 0x2f,
-	0x15, 0xd8, 0xca, 0xe9, 0x39, 0x4c, 0xa4, 0xea, 0x1e, 0xcc, 0xf6, 0xbb, 0x1d, 0xd2, 0xe4, 0xec,
-	0xf7, 0x36, 0xfa, 0x96, 0xea, 0x5f, 0x14, 0xb8, 0x32, 0x52, 0xd7, 0x62, 0x22, 0x71, 0x4d, 0x58,
-	0x96, 0x40, 0x0a, 0x99, 0xa9, 0xff, 0xbf, 0x91, 0x75, 0x4c, 0x64, 0xde, 0xea, 0xef, 0x15, 0x28,
-	0xe6, 0xf7, 0x43, 0x26, 0xd2, 0xfc, 0x01, 0xcc, 0xc5, 0xb0, 0x84, 0xd6, 0x8d, 0xa4, 0xd6, 0x98,
-	0x81, 0x16, 0xb7, 0x56, 0x5f, 0xc0, 0x52, 0xba, 0x63, 0x91, 0x23, 0xe6, 0x26, 0xcc, 0x88, 0x06,
-	0xc5, 0x94, 0xec, 0x10, 0x2d, 0x1a, 0x11, 0xc2, 0x26, 0x38, 0x1b, 0x16, 0xf3, 0x7b, 0x16, 0x39,
-	0x54, 0xcf, 0x60, 0x45, 0xe6, 0x21, 0x88, 0x55, 0x69, 0x28, 0x92, 0xfd, 0x10, 0xa9, 0xbf, 0xfa,
-	0x87, 0x98, 0x28, 0xf9, 0x05, 0x7b, 0xac, 0x62, 0xf7, 0x11, 0x2c, 0xc5, 0x6e, 0x77, 0x0c, 0x47,
-	0x44, 0x22, 0xdd, 0x1f, 0x4a, 0x59, 0x69, 0x03, 0x7e, 0xea, 0xdf, 0xfa, 0xa9, 0x9e, 0xdf, 0x8f,
-	0xc8, 0x51, 0xba, 0x09, 0xe7, 0x85, 0xd3, 0x1d, 0x21, 0x35, 0x7a, 0x8e, 0x8d, 0xed, 0x8a, 0x4d,
-	0x3a, 0x7a, 0x96, 0xce, 0x63, 0x7a, 0xcc, 0x79, 0x7c, 0xd5, 0x2f, 0x6e, 0xd2, 0x9b, 0x6f, 0x8e,
-	0xfc, 0x9f, 0xc1, 0xaa, 0xd4, 0x45, 0x84, 0xff, 0x52, 0xa6, 0x96, 0x78, 0x0f, 0x42, 0xfa, 0xba,
-	0xae, 0x7d, 0xfb, 0xa6, 0xa8, 0x7c, 0xf7, 0xa6, 0xa8, 0xfc, 0xfb, 0x4d, 0x51, 0xf9, 0xf2, 0x6d,
-	0xf1, 0xcc, 0x77, 0x6f, 0x8b, 0x67, 0xfe, 0xf9, 0xb6, 0x78, 0xe6, 0xc5, 0xfb, 0x23, 0x96, 0xf0,
-	0xd7, 0xd5, 0xfe, 0x6f, 0x3c, 0xd8, 0x8f, 0x4c, 0x0e, 0x66, 0xd8, 0x2f, 0x3c, 0xee, 0xfe, 0x37,
-	0x00, 0x00, 0xff, 0xff, 0x00, 0xb8, 0xe3, 0x13, 0x3e, 0x23, 0x00, 0x00,
+func (m *TopicIdHorizonTimestampedActorNonce) Reset()         { *m = TopicIdHorizonTimestampedActorNonce{} }
+func (m *TopicIdHorizonTimestampedActorNonce) String() string { return proto.CompactTextString(m) }
+func (*TopicIdHorizonTimestampedActorNonce) ProtoMessage()    {}
+func (*TopicIdHorizonTimestampedActorNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8702cc38ff1a7f6a, []int{29}
 }
-
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TopicIdHorizonTimestampedActorNonce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopicIdHorizonTimestampedActorNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicIdHorizonTimestampedActorNonce.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TopicIdHorizonTimestampedActorNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdHorizonTimestampedActorNonce.Merge(m, src)
+}
+func (m *TopicIdHorizonTimestampedActorNonce) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopicIdHorizonTimestampedActorNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdHorizonTimestampedActorNonce.DiscardUnknown(m)
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TopicLastReputerPayload) > 0 {
-		for iNdEx := len(m.TopicLastReputerPayload) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TopicLastReputerPayload[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0xa2
-		}
+var xxx_messageInfo_TopicIdHorizonTimestampedActorNonce proto.InternalMessageInfo
+
+func (m *TopicIdHorizonTimestampedActorNonce) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	if len(m.TopicLastWorkerPayload) > 0 {
-		for iNdEx := len(m.TopicLastWorkerPayload) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TopicLastWorkerPayload[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0x9a
-		}
+	return 0
+}
+
+func (m *TopicIdHorizonTimestampedActorNonce) GetHorizon() uint32 {
+	if m != nil {
+		return m.Horizon
 	}
-	if len(m.TopicLastReputerCommit) > 0 {
-		for iNdEx := len(m.TopicLastReputerCommit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TopicLastReputerCommit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0x92
-		}
+	return 0
+}
+
+func (m *TopicIdHorizonTimestampedActorNonce) GetTimestampedActorNonce() *TimestampedActorNonce {
+	if m != nil {
+		return m.TimestampedActorNonce
 	}
-	if len(m.TopicLastWorkerCommit) > 0 {
-		for iNdEx := len(m.TopicLastWorkerCommit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TopicLastWorkerCommit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0x8a
+	return nil
+}
+
+type TopicIdHorizonBlockHeight struct {
+	TopicId     uint64 `protobuf:"varint,1,opt,name=TopicId,proto3" json:"TopicId,omitempty"`
+	Horizon     uint32 `protobuf:"varint,2,opt,name=Horizon,proto3" json:"Horizon,omitempty"`
+	BlockHeight int64  `protobuf:"varint,3,opt,name=BlockHeight,proto3" json:"BlockHeight,omitempty"`
+}
+
+func (m *TopicIdHorizonBlockHeight) Reset()         { *m = TopicIdHorizonBlockHeight{} }
+func (m *TopicIdHorizonBlockHeight) String() string { return proto.CompactTextString(m) }
+func (*TopicIdHorizonBlockHeight) ProtoMessage()    {}
+func (*TopicIdHorizonBlockHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8702cc38ff1a7f6a, []int{30}
+}
+func (m *TopicIdHorizonBlockHeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopicIdHorizonBlockHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicIdHorizonBlockHeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.LatestOneInForecasterSelfNetworkRegrets) > 0 {
-		for iNdEx := len(m.LatestOneInForecasterSelfNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LatestOneInForecasterSelfNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0x82
-		}
+}
+func (m *TopicIdHorizonBlockHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdHorizonBlockHeight.Merge(m, src)
+}
+func (m *TopicIdHorizonBlockHeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopicIdHorizonBlockHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdHorizonBlockHeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopicIdHorizonBlockHeight proto.InternalMessageInfo
+
+func (m *TopicIdHorizonBlockHeight) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	if len(m.LatestOneInForecasterNetworkRegrets) > 0 {
-		for iNdEx := len(m.LatestOneInForecasterNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LatestOneInForecasterNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xfa
-		}
+	return 0
+}
+
+func (m *TopicIdHorizonBlockHeight) GetHorizon() uint32 {
+	if m != nil {
+		return m.Horizon
 	}
-	if len(m.LatestForecasterNetworkRegrets) > 0 {
-		for iNdEx := len(m.LatestForecasterNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LatestForecasterNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xf2
-		}
+	return 0
+}
+
+func (m *TopicIdHorizonBlockHeight) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	if len(m.LatestInfererNetworkRegrets) > 0 {
-		for iNdEx := len(m.LatestInfererNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LatestInfererNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xea
+	return 0
+}
+
+type TopicIdHorizonBlockHeightReputerValueBundles struct {
+	TopicId             uint64               `protobuf:"varint,1,opt,name=TopicId,proto3" json:"TopicId,omitempty"`
+	Horizon             uint32               `protobuf:"varint,2,opt,name=Horizon,proto3" json:"Horizon,omitempty"`
+	BlockHeight         int64                `protobuf:"varint,3,opt,name=BlockHeight,proto3" json:"BlockHeight,omitempty"`
+	ReputerValueBundles *ReputerValueBundles `protobuf:"bytes,4,opt,name=ReputerValueBundles,proto3" json:"ReputerValueBundles,omitempty"`
+}
+
+func (m *TopicIdHorizonBlockHeightReputerValueBundles) Reset() {
+	*m = TopicIdHorizonBlockHeightReputerValueBundles{}
+}
+func (m *TopicIdHorizonBlockHeightReputerValueBundles) String() string {
+	return proto.CompactTextString(m)
+}
+func (*TopicIdHorizonBlockHeightReputerValueBundles) ProtoMessage() {}
+func (*TopicIdHorizonBlockHeightReputerValueBundles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8702cc38ff1a7f6a, []int{31}
+}
+func (m *TopicIdHorizonBlockHeightReputerValueBundles) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopicIdHorizonBlockHeightReputerValueBundles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicIdHorizonBlockHeightReputerValueBundles.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.UnfulfilledReputerNonces) > 0 {
-		for iNdEx := len(m.UnfulfilledReputerNonces) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UnfulfilledReputerNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xe2
-		}
+}
+func (m *TopicIdHorizonBlockHeightReputerValueBundles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdHorizonBlockHeightReputerValueBundles.Merge(m, src)
+}
+func (m *TopicIdHorizonBlockHeightReputerValueBundles) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopicIdHorizonBlockHeightReputerValueBundles) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdHorizonBlockHeightReputerValueBundles.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopicIdHorizonBlockHeightReputerValueBundles proto.InternalMessageInfo
+
+func (m *TopicIdHorizonBlockHeightReputerValueBundles) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	if len(m.UnfulfilledWorkerNonces) > 0 {
-		for iNdEx := len(m.UnfulfilledWorkerNonces) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UnfulfilledWorkerNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xda
-		}
+	return 0
+}
+
+func (m *TopicIdHorizonBlockHeightReputerValueBundles) GetHorizon() uint32 {
+	if m != nil {
+		return m.Horizon
 	}
-	{
-		size := m.PreviousPercentageRewardToStakedReputers.Size()
-		i -= size
-		if _, err := m.PreviousPercentageRewardToStakedReputers.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *TopicIdHorizonBlockHeightReputerValueBundles) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	i--
-	dAtA[i] = 0x2
-	i--
-	dAtA[i] = 0xd2
-	if len(m.NetworkLossBundles) > 0 {
-		for iNdEx := len(m.NetworkLossBundles) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.NetworkLossBundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xca
+	return 0
+}
+
+func (m *TopicIdHorizonBlockHeightReputerValueBundles) GetReputerValueBundles() *ReputerValueBundles {
+	if m != nil {
+		return m.ReputerValueBundles
+	}
+	return nil
+}
+
+type TopicIdHorizonBlockHeightValueBundles struct {
+	TopicId     uint64       `protobuf:"varint,1,opt,name=TopicId,proto3" json:"TopicId,omitempty"`
+	Horizon     uint32       `protobuf:"varint,2,opt,name=Horizon,proto3" json:"Horizon,omitempty"`
+	BlockHeight int64        `protobuf:"varint,3,opt,name=BlockHeight,proto3" json:"BlockHeight,omitempty"`
+	ValueBundle *ValueBundle `protobuf:"bytes,4,opt,name=ValueBundle,proto3" json:"ValueBundle,omitempty"`
+}
+
+func (m *TopicIdHorizonBlockHeightValueBundles) Reset()         { *m = TopicIdHorizonBlockHeightValueBundles{} }
+func (m *TopicIdHorizonBlockHeightValueBundles) String() string { return proto.CompactTextString(m) }
+func (*TopicIdHorizonBlockHeightValueBundles) ProtoMessage()    {}
+func (*TopicIdHorizonBlockHeightValueBundles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8702cc38ff1a7f6a, []int{32}
+}
+func (m *TopicIdHorizonBlockHeightValueBundles) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopicIdHorizonBlockHeightValueBundles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicIdHorizonBlockHeightValueBundles.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.AllLossBundles) > 0 {
-		for iNdEx := len(m.AllLossBundles) - 1; iNdEx >= 0; iNdEx-- {
+}
+func (m *TopicIdHorizonBlockHeightValueBundles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdHorizonBlockHeightValueBundles.Merge(m, src)
+}
+func (m *TopicIdHorizonBlockHeightValueBundles) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopicIdHorizonBlockHeightValueBundles) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdHorizonBlockHeightValueBundles.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopicIdHorizonBlockHeightValueBundles proto.InternalMessageInfo
+
+func (m *TopicIdHorizonBlockHeightValueBundles) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *TopicIdHorizonBlockHeightValueBundles) GetHorizon() uint32 {
+	if m != nil {
+		return m.Horizon
+	}
+	return 0
+}
+
+func (m *TopicIdHorizonBlockHeightValueBundles) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *TopicIdHorizonBlockHeightValueBundles) GetValueBundle() *ValueBundle {
+	if m != nil {
+		return m.ValueBundle
+	}
+	return nil
+}
+
+type TopicIdHorizonBlockHeightScores struct {
+	TopicId     uint64  `protobuf:"varint,1,opt,name=TopicId,proto3" json:"TopicId,omitempty"`
+	Horizon     uint32  `protobuf:"varint,2,opt,name=Horizon,proto3" json:"Horizon,omitempty"`
+	BlockHeight int64   `protobuf:"varint,3,opt,name=BlockHeight,proto3" json:"BlockHeight,omitempty"`
+	Scores      *Scores `protobuf:"bytes,4,opt,name=Scores,proto3" json:"Scores,omitempty"`
+}
+
+func (m *TopicIdHorizonBlockHeightScores) Reset()         { *m = TopicIdHorizonBlockHeightScores{} }
+func (m *TopicIdHorizonBlockHeightScores) String() string { return proto.CompactTextString(m) }
+func (*TopicIdHorizonBlockHeightScores) ProtoMessage()    {}
+func (*TopicIdHorizonBlockHeightScores) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8702cc38ff1a7f6a, []int{33}
+}
+func (m *TopicIdHorizonBlockHeightScores) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopicIdHorizonBlockHeightScores) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicIdHorizonBlockHeightScores.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TopicIdHorizonBlockHeightScores) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdHorizonBlockHeightScores.Merge(m, src)
+}
+func (m *TopicIdHorizonBlockHeightScores) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopicIdHorizonBlockHeightScores) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdHorizonBlockHeightScores.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopicIdHorizonBlockHeightScores proto.InternalMessageInfo
+
+func (m *TopicIdHorizonBlockHeightScores) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *TopicIdHorizonBlockHeightScores) GetHorizon() uint32 {
+	if m != nil {
+		return m.Horizon
+	}
+	return 0
+}
+
+func (m *TopicIdHorizonBlockHeightScores) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *TopicIdHorizonBlockHeightScores) GetScores() *Scores {
+	if m != nil {
+		return m.Scores
+	}
+	return nil
+}
+
+type TopicIdBlockHeightNetworkInferenceWeights struct {
+	TopicId     uint64                          `protobuf:"varint,1,opt,name=TopicId,proto3" json:"TopicId,omitempty"`
+	BlockHeight int64                           `protobuf:"varint,2,opt,name=BlockHeight,proto3" json:"BlockHeight,omitempty"`
+	Weights     *NetworkInferenceWeightsAtBlock `protobuf:"bytes,3,opt,name=Weights,proto3" json:"Weights,omitempty"`
+}
+
+func (m *TopicIdBlockHeightNetworkInferenceWeights) Reset() {
+	*m = TopicIdBlockHeightNetworkInferenceWeights{}
+}
+func (m *TopicIdBlockHeightNetworkInferenceWeights) String() string {
+	return proto.CompactTextString(m)
+}
+func (*TopicIdBlockHeightNetworkInferenceWeights) ProtoMessage() {}
+func (*TopicIdBlockHeightNetworkInferenceWeights) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8702cc38ff1a7f6a, []int{34}
+}
+func (m *TopicIdBlockHeightNetworkInferenceWeights) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopicIdBlockHeightNetworkInferenceWeights) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicIdBlockHeightNetworkInferenceWeights.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TopicIdBlockHeightNetworkInferenceWeights) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdBlockHeightNetworkInferenceWeights.Merge(m, src)
+}
+func (m *TopicIdBlockHeightNetworkInferenceWeights) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopicIdBlockHeightNetworkInferenceWeights) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdBlockHeightNetworkInferenceWeights.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopicIdBlockHeightNetworkInferenceWeights proto.InternalMessageInfo
+
+func (m *TopicIdBlockHeightNetworkInferenceWeights) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *TopicIdBlockHeightNetworkInferenceWeights) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *TopicIdBlockHeightNetworkInferenceWeights) GetWeights() *NetworkInferenceWeightsAtBlock {
+	if m != nil {
+		return m.Weights
+	}
+	return nil
+}
+
+type TopicIdBlockHeightRewardBreakdowns struct {
+	TopicId          uint64            `protobuf:"varint,1,opt,name=TopicId,proto3" json:"TopicId,omitempty"`
+	BlockHeight      int64             `protobuf:"varint,2,opt,name=BlockHeight,proto3" json:"BlockHeight,omitempty"`
+	RewardBreakdowns *RewardBreakdowns `protobuf:"bytes,3,opt,name=RewardBreakdowns,proto3" json:"RewardBreakdowns,omitempty"`
+}
+
+func (m *TopicIdBlockHeightRewardBreakdowns) Reset()         { *m = TopicIdBlockHeightRewardBreakdowns{} }
+func (m *TopicIdBlockHeightRewardBreakdowns) String() string { return proto.CompactTextString(m) }
+func (*TopicIdBlockHeightRewardBreakdowns) ProtoMessage()    {}
+func (*TopicIdBlockHeightRewardBreakdowns) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8702cc38ff1a7f6a, []int{35}
+}
+func (m *TopicIdBlockHeightRewardBreakdowns) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopicIdBlockHeightRewardBreakdowns) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicIdBlockHeightRewardBreakdowns.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TopicIdBlockHeightRewardBreakdowns) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdBlockHeightRewardBreakdowns.Merge(m, src)
+}
+func (m *TopicIdBlockHeightRewardBreakdowns) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopicIdBlockHeightRewardBreakdowns) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdBlockHeightRewardBreakdowns.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopicIdBlockHeightRewardBreakdowns proto.InternalMessageInfo
+
+func (m *TopicIdBlockHeightRewardBreakdowns) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *TopicIdBlockHeightRewardBreakdowns) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *TopicIdBlockHeightRewardBreakdowns) GetRewardBreakdowns() *RewardBreakdowns {
+	if m != nil {
+		return m.RewardBreakdowns
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "emissions.v1.GenesisState")
+	proto.RegisterType((*TopicIdAndTopic)(nil), "emissions.v1.TopicIdAndTopic")
+	proto.RegisterType((*ActorIdAndRole)(nil), "emissions.v1.ActorIdAndRole")
+	proto.RegisterType((*TopicAndActorId)(nil), "emissions.v1.TopicAndActorId")
+	proto.RegisterType((*TopicIdAndBlockHeight)(nil), "emissions.v1.TopicIdAndBlockHeight")
+	proto.RegisterType((*TopicIdBlockHeightScores)(nil), "emissions.v1.TopicIdBlockHeightScores")
+	proto.RegisterType((*TopicIdActorIdScore)(nil), "emissions.v1.TopicIdActorIdScore")
+	proto.RegisterType((*TopicIdActorIdListeningCoefficient)(nil), "emissions.v1.TopicIdActorIdListeningCoefficient")
+	proto.RegisterType((*TopicIdActorIdDec)(nil), "emissions.v1.TopicIdActorIdDec")
+	proto.RegisterType((*TopicIdAndInt)(nil), "emissions.v1.TopicIdAndInt")
+	proto.RegisterType((*TopicIdActorIdInt)(nil), "emissions.v1.TopicIdActorIdInt")
+	proto.RegisterType((*TopicIdDelegatorReputerDelegatorInfo)(nil), "emissions.v1.TopicIdDelegatorReputerDelegatorInfo")
+	proto.RegisterType((*BlockHeightTopicIdReputerStakeRemovalInfo)(nil), "emissions.v1.BlockHeightTopicIdReputerStakeRemovalInfo")
+	proto.RegisterType((*ActorIdTopicIdBlockHeight)(nil), "emissions.v1.ActorIdTopicIdBlockHeight")
+	proto.RegisterType((*BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo)(nil), "emissions.v1.BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo")
+	proto.RegisterType((*DelegatorReputerTopicIdBlockHeight)(nil), "emissions.v1.DelegatorReputerTopicIdBlockHeight")
+	proto.RegisterType((*TopicIdActorIdInference)(nil), "emissions.v1.TopicIdActorIdInference")
+	proto.RegisterType((*TopicIdActorIdForecast)(nil), "emissions.v1.TopicIdActorIdForecast")
+	proto.RegisterType((*LibP2PKeyAndOffchainNode)(nil), "emissions.v1.LibP2pKeyAndOffchainNode")
+	proto.RegisterType((*TopicIdAndDec)(nil), "emissions.v1.TopicIdAndDec")
+	proto.RegisterType((*TopicIdBlockHeightInferences)(nil), "emissions.v1.TopicIdBlockHeightInferences")
+	proto.RegisterType((*TopicIdBlockHeightForecasts)(nil), "emissions.v1.TopicIdBlockHeightForecasts")
+	proto.RegisterType((*TopicIdBlockHeightReputerValueBundles)(nil), "emissions.v1.TopicIdBlockHeightReputerValueBundles")
+	proto.RegisterType((*TopicIdBlockHeightValueBundles)(nil), "emissions.v1.TopicIdBlockHeightValueBundles")
+	proto.RegisterType((*TopicIdAndNonces)(nil), "emissions.v1.TopicIdAndNonces")
+	proto.RegisterType((*TopicIdAndReputerRequestNonces)(nil), "emissions.v1.TopicIdAndReputerRequestNonces")
+	proto.RegisterType((*TopicIdActorIdTimeStampedValue)(nil), "emissions.v1.TopicIdActorIdTimeStampedValue")
+	proto.RegisterType((*TopicIdActorIdActorIdTimeStampedValue)(nil), "emissions.v1.TopicIdActorIdActorIdTimeStampedValue")
+	proto.RegisterType((*TopicIdTimestampedActorNonce)(nil), "emissions.v1.TopicIdTimestampedActorNonce")
+	proto.RegisterType((*TopicIdHorizonTimestampedActorNonce)(nil), "emissions.v1.TopicIdHorizonTimestampedActorNonce")
+	proto.RegisterType((*TopicIdHorizonBlockHeight)(nil), "emissions.v1.TopicIdHorizonBlockHeight")
+	proto.RegisterType((*TopicIdHorizonBlockHeightReputerValueBundles)(nil), "emissions.v1.TopicIdHorizonBlockHeightReputerValueBundles")
+	proto.RegisterType((*TopicIdHorizonBlockHeightValueBundles)(nil), "emissions.v1.TopicIdHorizonBlockHeightValueBundles")
+	proto.RegisterType((*TopicIdHorizonBlockHeightScores)(nil), "emissions.v1.TopicIdHorizonBlockHeightScores")
+	proto.RegisterType((*TopicIdBlockHeightNetworkInferenceWeights)(nil), "emissions.v1.TopicIdBlockHeightNetworkInferenceWeights")
+	proto.RegisterType((*TopicIdBlockHeightRewardBreakdowns)(nil), "emissions.v1.TopicIdBlockHeightRewardBreakdowns")
+}
+
+func init() { proto.RegisterFile("emissions/v1/genesis.proto", fileDescriptor_8702cc38ff1a7f6a) }
+
+var fileDescriptor_8702cc38ff1a7f6a = []byte{
+	// 2726 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5b, 0xcd, 0x73, 0x1c, 0x47,
+	0x15, 0xf7, 0x68, 0x37, 0xb2, 0xf5, 0x24, 0x59, 0x72, 0xeb, 0xab, 0xf5, 0x91, 0xd5, 0x66, 0xe4,
+	0xc4, 0xb2, 0xb1, 0x25, 0x5b, 0xc6, 0xd8, 0xd8, 0xc1, 0x64, 0x65, 0x4b, 0xd1, 0xfa, 0x53, 0x69,
+	0x39, 0x36, 0x38, 0x2e, 0x9c, 0xd1, 0x6e, 0x4b, 0x1a, 0x34, 0x3b, 0x23, 0xcf, 0xcc, 0xca, 0x16,
+	0x27, 0x0e, 0xc0, 0x05, 0x0e, 0xa9, 0x24, 0x07, 0xa8, 0xe2, 0xc0, 0x85, 0x2a, 0x0e, 0x54, 0x91,
+	0x43, 0x0e, 0x14, 0x9c, 0xa9, 0xca, 0x85, 0xaa, 0x14, 0x27, 0xe0, 0x90, 0xa2, 0xec, 0x03, 0xff,
+	0x06, 0x35, 0xdd, 0x3d, 0xb3, 0xf3, 0xd1, 0x33, 0x3b, 0xda, 0xc5, 0x17, 0x97, 0xa6, 0xdf, 0x7b,
+	0xbf, 0xdf, 0x7b, 0xfd, 0x7a, 0x5e, 0xf7, 0xbc, 0x5e, 0xc3, 0x14, 0x6d, 0xe8, 0x8e, 0xa3, 0x5b,
+	0xa6, 0xb3, 0xb8, 0x7f, 0x61, 0x71, 0x9b, 0x9a, 0xd4, 0xd1, 0x9d, 0x85, 0x3d, 0xdb, 0x72, 0x2d,
+	0x34, 0x10, 0xc8, 0x16, 0xf6, 0x2f, 0x4c, 0x4d, 0xd6, 0x2c, 0xa7, 0x61, 0x39, 0x4f, 0x99, 0x6c,
+	0x91, 0x3f, 0x70, 0xc5, 0xa9, 0x13, 0x5a, 0x43, 0x37, 0xad, 0x45, 0xf6, 0xaf, 0x18, 0x1a, 0xdd,
+	0xb6, 0xb6, 0x2d, 0xae, 0xea, 0xfd, 0x25, 0x46, 0x27, 0x23, 0x6c, 0x7b, 0x9a, 0xad, 0x35, 0x7c,
+	0x0c, 0x1c, 0x11, 0x39, 0x35, 0xcb, 0xa6, 0x72, 0x89, 0xab, 0xed, 0xca, 0x25, 0xee, 0xc1, 0x1e,
+	0x95, 0xa3, 0xb9, 0xd6, 0x9e, 0x5e, 0x93, 0xba, 0xf0, 0xdc, 0xb2, 0x77, 0xa9, 0x2d, 0x44, 0x13,
+	0x11, 0x91, 0x69, 0xd5, 0x7d, 0x9e, 0xe8, 0x24, 0xd9, 0x74, 0xaf, 0xe9, 0x52, 0x5b, 0xca, 0x64,
+	0x5a, 0x66, 0xcd, 0xb7, 0x9a, 0x89, 0x48, 0x74, 0x73, 0x8b, 0xda, 0xb4, 0x25, 0x9d, 0x8c, 0x61,
+	0x3e, 0xd7, 0xec, 0x3a, 0x17, 0xa9, 0xbf, 0xbd, 0x04, 0x03, 0xef, 0xf3, 0x4c, 0x6c, 0xb8, 0x9a,
+	0x4b, 0xd1, 0x12, 0xf4, 0xf2, 0xb9, 0xc2, 0x4a, 0x59, 0x99, 0xef, 0x5f, 0x1a, 0x5d, 0x08, 0x67,
+	0x66, 0x61, 0x9d, 0xc9, 0x96, 0x8b, 0x5f, 0x7d, 0x33, 0x7b, 0x84, 0x08, 0x4d, 0x54, 0x86, 0x7e,
+	0x93, 0xbe, 0x70, 0x1f, 0x78, 0xa1, 0x57, 0xeb, 0xb8, 0x50, 0x56, 0xe6, 0x8b, 0x24, 0x3c, 0x84,
+	0x2e, 0x41, 0x2f, 0x9b, 0x18, 0x07, 0x17, 0xcb, 0x85, 0xf9, 0xfe, 0xa5, 0x37, 0xa3, 0xa8, 0x42,
+	0xad, 0x62, 0xd6, 0xd9, 0x5f, 0x44, 0x28, 0x23, 0x15, 0x06, 0xb4, 0x9a, 0xab, 0xef, 0xd3, 0x07,
+	0xdc, 0xf8, 0x8d, 0x72, 0x61, 0xbe, 0x48, 0x22, 0x63, 0x68, 0x1e, 0x86, 0x6a, 0x3b, 0x4d, 0xdb,
+	0xd4, 0x36, 0x0d, 0x5f, 0xad, 0x97, 0xa9, 0xc5, 0x87, 0xd1, 0x19, 0x18, 0xe6, 0xb1, 0x87, 0x54,
+	0x8f, 0x32, 0xd5, 0xc4, 0x38, 0xaa, 0xc0, 0x00, 0xf3, 0xe1, 0x11, 0x4b, 0x9a, 0x83, 0x8f, 0xa5,
+	0xba, 0x5d, 0x31, 0xeb, 0x95, 0x9a, 0x6b, 0xd9, 0xd5, 0x3a, 0x89, 0x98, 0xa0, 0x1b, 0x30, 0xc8,
+	0x9e, 0x09, 0xcf, 0xa1, 0x83, 0xfb, 0xf2, 0x60, 0x44, 0x6d, 0xd0, 0x7d, 0x18, 0x16, 0x03, 0x9e,
+	0x83, 0xf7, 0xbc, 0x94, 0x63, 0x60, 0x38, 0x73, 0x69, 0x53, 0xb8, 0x6c, 0x58, 0xb5, 0xdd, 0x35,
+	0xaa, 0x6f, 0xef, 0xb8, 0x24, 0x61, 0x8c, 0x1e, 0xc3, 0x28, 0x5f, 0x1e, 0xf6, 0x86, 0xb7, 0xee,
+	0x9d, 0xe5, 0x03, 0xa6, 0x8f, 0xfb, 0x19, 0xe8, 0x3b, 0x52, 0xd0, 0x10, 0x22, 0x37, 0x22, 0x52,
+	0x0c, 0xf4, 0x31, 0x4c, 0x6c, 0x59, 0x36, 0xad, 0x69, 0x8e, 0x1b, 0x87, 0x1f, 0x38, 0x14, 0x7c,
+	0x1a, 0x8c, 0xe7, 0xbd, 0x78, 0x25, 0xa2, 0xf0, 0x83, 0x87, 0xf3, 0x5e, 0x86, 0x81, 0x6a, 0x30,
+	0x6d, 0x68, 0x2e, 0x75, 0xdc, 0x6a, 0x34, 0x36, 0x9e, 0x4f, 0x7c, 0x9c, 0x51, 0xbc, 0x25, 0x9f,
+	0x75, 0x9e, 0x3c, 0x66, 0x41, 0xb2, 0x50, 0x90, 0x0e, 0x25, 0x2e, 0x5e, 0x4d, 0x44, 0x28, 0x78,
+	0x86, 0xf2, 0xf2, 0xb4, 0x01, 0x42, 0x14, 0x66, 0xb8, 0x06, 0x89, 0x46, 0x2b, 0x1e, 0xf1, 0x70,
+	0x5e, 0xa2, 0x4c, 0x18, 0x64, 0xc3, 0xb4, 0x98, 0xce, 0x3b, 0xba, 0xe3, 0x52, 0x53, 0x37, 0xb7,
+	0x6f, 0x58, 0x74, 0x6b, 0x4b, 0xaf, 0xe9, 0xd4, 0x74, 0xf1, 0x09, 0xc6, 0x72, 0x3e, 0x8b, 0x45,
+	0x66, 0x47, 0xb2, 0x40, 0x11, 0x85, 0x37, 0xf7, 0x6c, 0xba, 0xaf, 0x5b, 0x4d, 0x47, 0xb8, 0xc1,
+	0x97, 0xf8, 0xaa, 0xed, 0x95, 0x06, 0xcb, 0xc4, 0x88, 0xb1, 0xce, 0x66, 0xb1, 0xde, 0xa4, 0x35,
+	0x92, 0x8d, 0x82, 0x74, 0x98, 0xf5, 0x15, 0xaa, 0x7e, 0x49, 0x8d, 0x11, 0x8d, 0xe4, 0x23, 0x6a,
+	0x87, 0x83, 0xb6, 0xa1, 0xe4, 0xab, 0xf8, 0x09, 0x8d, 0x31, 0x8d, 0xe6, 0x63, 0x6a, 0x03, 0x83,
+	0xd6, 0x01, 0x5c, 0xcb, 0xd5, 0x8c, 0x0d, 0x6f, 0x6f, 0xc3, 0x63, 0x65, 0x65, 0xbe, 0x6f, 0xf9,
+	0xbc, 0x57, 0xcd, 0xff, 0xfd, 0xcd, 0xec, 0x18, 0xdf, 0x69, 0x9d, 0xfa, 0xee, 0x82, 0x6e, 0x2d,
+	0x36, 0x34, 0x77, 0x67, 0xa1, 0x6a, 0xba, 0xff, 0xf8, 0xf2, 0x1c, 0x70, 0x81, 0xf7, 0xf4, 0x87,
+	0xff, 0x7e, 0x71, 0x46, 0x21, 0x21, 0x0c, 0x74, 0xcd, 0x43, 0xdc, 0xd3, 0x6b, 0x1c, 0x71, 0x9c,
+	0xb9, 0x39, 0x9d, 0x56, 0x9c, 0xaa, 0xa6, 0x4b, 0x42, 0xea, 0xe8, 0x43, 0x18, 0x63, 0xbb, 0xac,
+	0x48, 0x40, 0xa5, 0xe9, 0xee, 0x58, 0xb6, 0xee, 0x1e, 0xe0, 0x89, 0xf6, 0xe1, 0x7a, 0x58, 0x72,
+	0xeb, 0x00, 0x76, 0xa3, 0xd9, 0x58, 0xb5, 0xad, 0xc6, 0x4d, 0x6a, 0xd0, 0x6d, 0xcd, 0xb5, 0x6c,
+	0x8c, 0x0f, 0x03, 0x1b, 0xb7, 0x46, 0x4f, 0x60, 0xa8, 0xce, 0x1f, 0x68, 0x9d, 0xf9, 0xef, 0xe0,
+	0x49, 0x06, 0xb8, 0x24, 0x05, 0x0c, 0x0c, 0x85, 0x7f, 0xc1, 0x73, 0xd5, 0xdc, 0xb2, 0x48, 0x1c,
+	0xca, 0x5b, 0x03, 0x8c, 0x36, 0xc2, 0xe9, 0x7c, 0xb8, 0x67, 0x99, 0xfe, 0x2b, 0x3b, 0x95, 0xcf,
+	0xfb, 0x36, 0x30, 0xe8, 0x11, 0x8c, 0xfb, 0xdc, 0x7c, 0x75, 0xac, 0x53, 0x7b, 0x63, 0x47, 0xb3,
+	0x29, 0x9e, 0xce, 0xb7, 0xc8, 0x52, 0xcc, 0xd1, 0x2e, 0x8c, 0x8a, 0x7c, 0x34, 0xac, 0x7d, 0xcd,
+	0x08, 0xca, 0xf3, 0x0c, 0x83, 0xbd, 0x1c, 0x85, 0x0d, 0xd5, 0x65, 0xc1, 0xe0, 0x57, 0x98, 0x10,
+	0x04, 0x9b, 0x29, 0x29, 0x28, 0xfa, 0x28, 0x41, 0xc6, 0x3c, 0xc4, 0x6f, 0x32, 0xb2, 0x53, 0x51,
+	0x32, 0xe1, 0x7c, 0x72, 0x4b, 0x20, 0x52, 0x10, 0xf4, 0x2b, 0x05, 0x66, 0xfc, 0x20, 0x37, 0x64,
+	0x21, 0x95, 0x18, 0xcb, 0x5a, 0xbb, 0x90, 0x52, 0x96, 0x00, 0x4d, 0xc4, 0x98, 0xc9, 0x86, 0xdc,
+	0x54, 0x6f, 0x78, 0xcc, 0xb3, 0xb2, 0x2a, 0x1b, 0xe7, 0x96, 0x04, 0x9f, 0x89, 0x8a, 0x56, 0x00,
+	0x82, 0xa3, 0xa4, 0x83, 0xcb, 0x8c, 0xe3, 0xed, 0xec, 0xc5, 0x27, 0xb4, 0x49, 0xc8, 0x10, 0x2d,
+	0x43, 0x9f, 0xbf, 0x9f, 0x3b, 0xf8, 0x2d, 0x86, 0x72, 0x32, 0x0b, 0x25, 0xa8, 0x5c, 0x2d, 0x33,
+	0xf4, 0x1e, 0x1c, 0x7d, 0x2e, 0x8e, 0x62, 0xaa, 0x6c, 0xaf, 0xbf, 0xa3, 0x6f, 0xae, 0x2f, 0xed,
+	0xdd, 0xa6, 0x07, 0x15, 0xb3, 0x7e, 0x7f, 0x6b, 0xab, 0xb6, 0xa3, 0xe9, 0xe6, 0x3d, 0xab, 0x4e,
+	0x89, 0x6f, 0x86, 0x96, 0xe1, 0x98, 0xed, 0x9f, 0xc4, 0xe6, 0x0e, 0x05, 0x11, 0xd8, 0xa1, 0x15,
+	0x18, 0x62, 0xb5, 0x6b, 0x95, 0x52, 0x42, 0xf7, 0xa9, 0xd9, 0xa4, 0xf8, 0x64, 0xfb, 0x7a, 0x17,
+	0xb7, 0x41, 0x77, 0x61, 0xc4, 0xaf, 0xd2, 0x4c, 0xf3, 0x11, 0x4b, 0x06, 0x7e, 0x3b, 0x1b, 0xca,
+	0x7b, 0xf1, 0x64, 0x76, 0x68, 0x1d, 0x06, 0x35, 0xc3, 0xa8, 0xb6, 0x32, 0xf5, 0x0e, 0x03, 0x3a,
+	0xd3, 0xee, 0x34, 0xd4, 0xb2, 0x20, 0x51, 0x00, 0x74, 0x17, 0x06, 0x34, 0xc3, 0x58, 0x0d, 0x92,
+	0x76, 0x8a, 0x01, 0x9e, 0x6e, 0x07, 0x18, 0x18, 0x90, 0x88, 0x39, 0xfa, 0x08, 0x8e, 0x6b, 0x86,
+	0x71, 0xc7, 0x72, 0x9c, 0xe5, 0xa6, 0x59, 0x37, 0xa8, 0x83, 0xe7, 0x19, 0xe0, 0xc5, 0x76, 0x80,
+	0x62, 0xe1, 0x3e, 0xd4, 0x8c, 0x26, 0x15, 0xa6, 0x24, 0x06, 0x85, 0x9e, 0x00, 0x32, 0xa9, 0xeb,
+	0x65, 0x39, 0x4c, 0x70, 0x9a, 0x11, 0x9c, 0x6d, 0x47, 0x10, 0x41, 0x96, 0xe0, 0xa0, 0xcf, 0x14,
+	0x98, 0xf7, 0xe7, 0x7c, 0x9d, 0xda, 0x35, 0x6a, 0xba, 0xda, 0xb6, 0x28, 0x7b, 0x0f, 0x2c, 0xf6,
+	0xd6, 0xd4, 0x83, 0x03, 0xfe, 0x19, 0xb6, 0x9b, 0x5e, 0x16, 0xbb, 0xe9, 0xe2, 0xb6, 0xee, 0xee,
+	0x34, 0x37, 0x17, 0x6a, 0x56, 0x63, 0x51, 0x33, 0x0c, 0xcb, 0xd6, 0xce, 0x09, 0x02, 0xff, 0x91,
+	0x2d, 0x31, 0xbe, 0xcf, 0x7a, 0xc9, 0xcd, 0x4d, 0x84, 0x7e, 0x00, 0x13, 0x4d, 0x73, 0xab, 0x69,
+	0x6c, 0xe9, 0x86, 0x41, 0xeb, 0xfc, 0xbc, 0xc7, 0x8e, 0xf7, 0x0e, 0xfe, 0x16, 0x0b, 0xbc, 0x94,
+	0xb6, 0x88, 0xb8, 0x16, 0x49, 0x33, 0x47, 0x3b, 0x80, 0x43, 0x22, 0x41, 0x28, 0xa0, 0xcf, 0x66,
+	0xcc, 0x69, 0xc5, 0xac, 0x07, 0x67, 0xa8, 0x67, 0x4d, 0xea, 0xb8, 0x82, 0x28, 0x15, 0x0d, 0x99,
+	0xb1, 0xe3, 0xf6, 0x3d, 0x3e, 0x37, 0x84, 0x6e, 0xdb, 0xd4, 0x75, 0xf0, 0xb9, 0x2c, 0x32, 0x51,
+	0xcc, 0xf5, 0x86, 0x57, 0xb7, 0x1a, 0x7b, 0xb4, 0xce, 0xf2, 0x48, 0xb2, 0x00, 0x91, 0x9b, 0x3c,
+	0x79, 0xc7, 0x28, 0x17, 0x3a, 0xa0, 0x6c, 0x83, 0x89, 0x7e, 0xae, 0xc0, 0x1c, 0x57, 0xb9, 0x6f,
+	0xd2, 0xaa, 0x99, 0xca, 0xbd, 0x98, 0xf1, 0x42, 0x08, 0xee, 0x34, 0x17, 0xf2, 0xe0, 0xa3, 0x5f,
+	0x28, 0x70, 0x4a, 0xaa, 0xb7, 0x41, 0x8d, 0xad, 0x98, 0x2f, 0xe7, 0x3b, 0x98, 0x87, 0xbc, 0xe0,
+	0x68, 0x01, 0x46, 0xbc, 0x0f, 0x88, 0xa7, 0x2e, 0xd5, 0x1a, 0x4f, 0xb5, 0x7a, 0xdd, 0xa6, 0x8e,
+	0x43, 0x1d, 0xdc, 0x53, 0x2e, 0xcc, 0xf7, 0x91, 0x13, 0x9e, 0xe8, 0x01, 0xd5, 0x1a, 0x15, 0x5f,
+	0x80, 0xde, 0x05, 0xd0, 0x3c, 0x4e, 0x62, 0x79, 0xaf, 0xf5, 0x65, 0xe6, 0xda, 0x8c, 0x74, 0x6f,
+	0xf7, 0x96, 0xa0, 0x65, 0x50, 0x12, 0xd2, 0x47, 0x1f, 0xc3, 0x18, 0x2b, 0xbe, 0x77, 0x34, 0xc7,
+	0xe5, 0xeb, 0xfc, 0x86, 0xd5, 0x68, 0xe8, 0x2e, 0xbe, 0x90, 0x51, 0x22, 0xbd, 0xe0, 0x1c, 0x1e,
+	0x1c, 0x83, 0x66, 0x0b, 0x96, 0xc8, 0x81, 0xd0, 0x26, 0x8c, 0x07, 0x02, 0xb1, 0xc0, 0x05, 0xc5,
+	0xd2, 0xa1, 0x29, 0x52, 0x90, 0x22, 0x1c, 0x9c, 0x7c, 0x5d, 0x3b, 0x30, 0x2c, 0xad, 0x8e, 0x2f,
+	0x76, 0xc1, 0x11, 0x41, 0x42, 0x75, 0x98, 0x88, 0xb3, 0xfb, 0x24, 0xdf, 0x3e, 0x34, 0x49, 0x1a,
+	0x14, 0xfa, 0x00, 0x46, 0x43, 0x3d, 0x92, 0x8a, 0x61, 0x58, 0xcf, 0x0d, 0xdd, 0x71, 0xf1, 0xa5,
+	0x3c, 0xad, 0x11, 0xa9, 0x29, 0xda, 0x10, 0x29, 0xf6, 0xbf, 0x01, 0x02, 0xcc, 0xef, 0xe4, 0xc1,
+	0x94, 0xdb, 0xa2, 0xa7, 0x30, 0xce, 0xcf, 0x0d, 0x5e, 0x0c, 0x15, 0xd6, 0x6e, 0xe2, 0x1b, 0x06,
+	0xbe, 0x72, 0xb8, 0xd3, 0x65, 0x0a, 0x0c, 0xd2, 0x60, 0xc2, 0xff, 0xc0, 0x8d, 0x33, 0x7c, 0xf7,
+	0x70, 0x0c, 0x69, 0x38, 0x1e, 0x05, 0x0b, 0x6e, 0xcd, 0xb2, 0xf5, 0x9f, 0x58, 0x26, 0xdf, 0x4a,
+	0x78, 0x07, 0xe9, 0xaa, 0x8c, 0x42, 0x60, 0x0b, 0xf5, 0x08, 0x45, 0x0a, 0x0e, 0xfa, 0x31, 0xa0,
+	0x1d, 0x3e, 0x1a, 0xde, 0x7b, 0xaf, 0x31, 0xf4, 0xab, 0x79, 0xd1, 0x25, 0x7b, 0xbc, 0x04, 0x15,
+	0x3d, 0x83, 0x49, 0x31, 0x7a, 0x2f, 0xb9, 0xdd, 0xbf, 0x9b, 0x51, 0x3e, 0x93, 0x94, 0x11, 0xae,
+	0x74, 0x54, 0xf4, 0x0c, 0x26, 0xc4, 0x8e, 0x1d, 0x9c, 0x8d, 0xf8, 0x91, 0xcb, 0xc1, 0xdf, 0x93,
+	0x7d, 0xd1, 0x24, 0xb3, 0x73, 0x4f, 0x6e, 0x4e, 0xd2, 0x70, 0xd1, 0x13, 0xbf, 0x47, 0xb9, 0x6c,
+	0x53, 0x6d, 0xb7, 0x6e, 0x3d, 0x37, 0x1d, 0x7c, 0x3d, 0xa3, 0x85, 0x12, 0x99, 0xc8, 0xa8, 0x1d,
+	0x49, 0x20, 0xa1, 0x15, 0x18, 0x09, 0x37, 0xee, 0xd6, 0x74, 0xc7, 0xb5, 0xec, 0x03, 0xfc, 0x7d,
+	0x46, 0x30, 0x12, 0x25, 0x60, 0x1a, 0x44, 0xa6, 0x8f, 0x6e, 0xc3, 0x78, 0xac, 0x41, 0xe7, 0x23,
+	0xbd, 0x97, 0x8e, 0x94, 0x62, 0xe2, 0xf9, 0x14, 0x6e, 0xc7, 0xf9, 0x48, 0x95, 0x0c, 0x9f, 0x24,
+	0xfa, 0xe8, 0x3a, 0x0c, 0xf2, 0x70, 0x7d, 0x80, 0x65, 0x06, 0x80, 0xe3, 0xed, 0x6b, 0xbd, 0xce,
+	0xe7, 0x89, 0x44, 0xd5, 0xd1, 0x49, 0x18, 0x34, 0x34, 0xc7, 0x65, 0xf3, 0xe9, 0x15, 0x35, 0x7c,
+	0xa3, 0xac, 0xcc, 0x17, 0x48, 0x74, 0x10, 0xd5, 0x60, 0x58, 0xdb, 0xa7, 0xb6, 0xb6, 0x4d, 0x5b,
+	0x8a, 0x37, 0xbb, 0x3b, 0xf5, 0x25, 0x00, 0xd1, 0x55, 0xc0, 0x35, 0xcd, 0xd0, 0x37, 0x6d, 0xcd,
+	0xa5, 0x3c, 0xc1, 0xde, 0x89, 0xf0, 0xae, 0x65, 0xba, 0x3b, 0x78, 0x85, 0xf5, 0xd6, 0x53, 0xe5,
+	0xe8, 0x03, 0x38, 0xc1, 0x5e, 0xd6, 0x95, 0x3d, 0xab, 0xb6, 0xb3, 0x26, 0x16, 0xeb, 0x6a, 0xfe,
+	0x86, 0x71, 0xd2, 0x1a, 0xad, 0xc3, 0x5c, 0x30, 0x09, 0x01, 0xd1, 0x0d, 0xe1, 0x80, 0x6e, 0x99,
+	0xa2, 0x6c, 0xbd, 0xcf, 0xe6, 0x2b, 0x8f, 0x2a, 0xfa, 0x11, 0x9c, 0xf1, 0xd4, 0xda, 0x1d, 0x73,
+	0x05, 0xf0, 0x1a, 0x03, 0x3e, 0x84, 0x05, 0xb2, 0x82, 0x52, 0x21, 0xd9, 0xcd, 0xaa, 0x6c, 0x32,
+	0x2e, 0x64, 0x95, 0x0a, 0xf9, 0xa6, 0x96, 0x8e, 0x89, 0x2c, 0x98, 0x16, 0xc2, 0xaa, 0xac, 0xb7,
+	0x7e, 0x8b, 0x51, 0x9e, 0xcb, 0x59, 0x9d, 0xb8, 0x2d, 0xc9, 0x42, 0x44, 0x4d, 0x28, 0x09, 0xf1,
+	0x6a, 0x4a, 0xc3, 0xfd, 0x76, 0x27, 0x9c, 0x6d, 0x40, 0x43, 0x71, 0x12, 0x59, 0x17, 0xfe, 0x4e,
+	0x37, 0x71, 0xca, 0x10, 0xd5, 0x87, 0x30, 0x14, 0xbb, 0x1b, 0x42, 0x18, 0x8e, 0x8a, 0x21, 0x76,
+	0x43, 0x55, 0x24, 0xfe, 0x23, 0x3a, 0x0d, 0x6f, 0xb0, 0x3f, 0x71, 0x4f, 0x59, 0x49, 0xd6, 0x0e,
+	0x26, 0x22, 0x5c, 0x43, 0x25, 0x70, 0x3c, 0x7a, 0x6a, 0xf4, 0x60, 0xc5, 0x08, 0x83, 0xed, 0x23,
+	0xfe, 0x23, 0x7a, 0x07, 0x8a, 0x9e, 0x06, 0x43, 0x3d, 0xbe, 0x84, 0xa2, 0xa8, 0x9e, 0x84, 0x30,
+	0xb9, 0xba, 0x02, 0x43, 0xb1, 0xd3, 0x45, 0x86, 0xaf, 0x21, 0xba, 0x9e, 0x08, 0x9d, 0xba, 0x01,
+	0x63, 0xd2, 0x57, 0x33, 0x03, 0xac, 0x0c, 0xfd, 0x21, 0x45, 0x06, 0x58, 0x20, 0xe1, 0x21, 0xf5,
+	0x67, 0x0a, 0xe0, 0xb4, 0xeb, 0x90, 0x6e, 0x80, 0xd1, 0x59, 0xe8, 0xe5, 0x28, 0xb8, 0x20, 0xbb,
+	0x2e, 0x14, 0x39, 0x16, 0x3a, 0xaa, 0x0b, 0x23, 0x92, 0x0b, 0x86, 0x4e, 0xa6, 0xc9, 0x4b, 0x36,
+	0x33, 0xc6, 0x05, 0x59, 0xb2, 0x99, 0x88, 0x70, 0x0d, 0xf5, 0x0b, 0x05, 0xd4, 0xf6, 0x37, 0x0e,
+	0x1d, 0x79, 0xf1, 0x10, 0x46, 0x65, 0x58, 0xc2, 0x29, 0x35, 0xde, 0x60, 0x4a, 0x6a, 0x12, 0xa9,
+	0xbd, 0xfa, 0xa9, 0x02, 0x27, 0x12, 0x6d, 0xd7, 0x8e, 0x3c, 0xac, 0x42, 0xe1, 0x26, 0xad, 0xe1,
+	0x42, 0x77, 0x9b, 0x94, 0x87, 0xa1, 0x36, 0x60, 0x30, 0xd2, 0xd8, 0xca, 0xf0, 0x67, 0x19, 0x0a,
+	0x55, 0x93, 0x2f, 0x98, 0x4e, 0xae, 0x17, 0x3c, 0x63, 0xf5, 0x97, 0x89, 0x39, 0xa8, 0x76, 0x98,
+	0x25, 0xe1, 0x4d, 0xa1, 0x1b, 0x6f, 0xfe, 0xaa, 0xc0, 0xc9, 0x3c, 0x6d, 0xfd, 0x0c, 0x07, 0x67,
+	0xa0, 0x2f, 0x50, 0x15, 0x2e, 0xb6, 0x06, 0x3c, 0x3b, 0x81, 0xc7, 0x1d, 0x25, 0xfe, 0x23, 0xaa,
+	0xc0, 0x60, 0x84, 0x02, 0x17, 0xcb, 0x4a, 0xb2, 0x51, 0x18, 0x51, 0x21, 0x51, 0x0b, 0xf5, 0xef,
+	0x0a, 0x9c, 0xce, 0xdd, 0x6f, 0x8f, 0xbf, 0xf6, 0x4a, 0xf2, 0xb5, 0x0f, 0x05, 0xd9, 0x93, 0xc8,
+	0x42, 0x4a, 0x18, 0xb7, 0x60, 0x38, 0xce, 0x24, 0x22, 0x89, 0x75, 0xab, 0xe2, 0x5a, 0x24, 0x61,
+	0xa7, 0x3e, 0x83, 0xc9, 0xd4, 0x2f, 0xa2, 0x8c, 0x52, 0x9e, 0xee, 0x76, 0x2c, 0xe4, 0x42, 0xb2,
+	0x84, 0x7e, 0xde, 0x03, 0x57, 0x3b, 0xef, 0xef, 0x77, 0x35, 0xa7, 0x91, 0x85, 0x53, 0xc8, 0x58,
+	0x38, 0xc5, 0xe8, 0x8c, 0x6f, 0x02, 0x4e, 0xf3, 0x07, 0xbf, 0x51, 0x56, 0x92, 0x2d, 0xf0, 0x34,
+	0x6d, 0x92, 0x8a, 0xa3, 0xfe, 0x5a, 0x01, 0xb5, 0xfd, 0x45, 0x43, 0x34, 0x04, 0x25, 0x23, 0x84,
+	0x9e, 0x68, 0x08, 0xa1, 0x49, 0x29, 0x64, 0x66, 0xac, 0x28, 0xdd, 0xf4, 0x26, 0x52, 0xee, 0x27,
+	0x3a, 0x2a, 0x23, 0x97, 0xa0, 0x2f, 0x00, 0x10, 0x15, 0x7e, 0x22, 0x3a, 0x7f, 0x81, 0x98, 0xb4,
+	0x34, 0xd5, 0x9f, 0x2a, 0x30, 0x2e, 0xbf, 0xe0, 0xe8, 0xc8, 0x8b, 0x25, 0x38, 0xe6, 0xdb, 0x0b,
+	0x27, 0xc6, 0xa3, 0x4e, 0xf8, 0x52, 0x12, 0xe8, 0xa9, 0x2f, 0x00, 0xa7, 0xdd, 0x6e, 0x78, 0x99,
+	0x09, 0x64, 0x7e, 0x66, 0x82, 0x01, 0x74, 0x1d, 0x06, 0xc2, 0xda, 0xe2, 0x68, 0x35, 0x15, 0x65,
+	0x0c, 0x6b, 0x90, 0x88, 0xbe, 0xea, 0x86, 0xf7, 0x8c, 0xec, 0x3d, 0x4c, 0xec, 0x54, 0x3d, 0xff,
+	0x87, 0x9d, 0xea, 0x73, 0x05, 0x66, 0xb2, 0xee, 0x3b, 0xba, 0x3a, 0xf2, 0x5c, 0x01, 0x68, 0x21,
+	0x89, 0x14, 0xe0, 0x94, 0x75, 0xe0, 0x90, 0x90, 0xae, 0xfa, 0x89, 0x02, 0xd3, 0x19, 0xb7, 0x26,
+	0x5d, 0x79, 0x75, 0x09, 0xfa, 0x02, 0x20, 0xf9, 0xe2, 0x0c, 0xc4, 0xa4, 0xa5, 0xa9, 0xfe, 0x59,
+	0x81, 0xb7, 0x73, 0xdd, 0xbb, 0x74, 0xe5, 0xdc, 0x06, 0x8c, 0x48, 0x20, 0x85, 0x9b, 0xb1, 0x5f,
+	0xa0, 0x48, 0x14, 0x89, 0xcc, 0x5a, 0xfd, 0x8d, 0x02, 0xa5, 0xec, 0x1b, 0x9d, 0xae, 0x7c, 0xbe,
+	0x06, 0xfd, 0x21, 0x2c, 0xe1, 0xeb, 0x64, 0xd4, 0xd7, 0x90, 0x02, 0x09, 0x6b, 0xab, 0x8f, 0x61,
+	0x38, 0x7e, 0xe7, 0x92, 0xe1, 0xcc, 0x59, 0xe8, 0xe5, 0x3a, 0xb8, 0x47, 0x76, 0x88, 0xe6, 0x32,
+	0x22, 0x74, 0xd4, 0x4f, 0x5b, 0x71, 0xa7, 0xdc, 0xba, 0x64, 0x50, 0x3d, 0x84, 0x51, 0x99, 0x05,
+	0xee, 0x91, 0x1d, 0x58, 0x65, 0x9a, 0x44, 0x6a, 0xaf, 0xfe, 0x2e, 0xe4, 0x94, 0xfc, 0x8a, 0xa0,
+	0xa3, 0x62, 0x77, 0x0b, 0x86, 0x43, 0x1f, 0xe3, 0x0c, 0x07, 0x17, 0x64, 0x67, 0x86, 0xb8, 0x16,
+	0x49, 0xd8, 0xa9, 0x7f, 0x6b, 0x2d, 0xf5, 0xec, 0x1b, 0x95, 0x0c, 0x4f, 0xa7, 0xe0, 0x98, 0x30,
+	0xba, 0x20, 0x5c, 0x0d, 0x9e, 0x43, 0xb2, 0x25, 0xb1, 0x49, 0x07, 0xcf, 0xd2, 0x38, 0x8a, 0x1d,
+	0xc6, 0xf1, 0x59, 0xab, 0xb8, 0x49, 0x1b, 0x15, 0x19, 0xee, 0xff, 0x10, 0xc6, 0xa4, 0x26, 0x22,
+	0xfd, 0x73, 0xa9, 0xbe, 0x84, 0xef, 0x41, 0xa4, 0xc3, 0xea, 0x97, 0x0a, 0xcc, 0xe5, 0xe8, 0xa2,
+	0x64, 0xaf, 0x02, 0x61, 0xc9, 0xdc, 0x19, 0x24, 0xfe, 0x63, 0xba, 0xdb, 0x85, 0xae, 0xdd, 0x7e,
+	0x06, 0x93, 0xa9, 0x0d, 0x8a, 0x8e, 0x7c, 0x6d, 0x7f, 0x90, 0xfc, 0x97, 0x02, 0x67, 0x0f, 0xd3,
+	0x0d, 0x7f, 0x3d, 0x6e, 0xa4, 0xd5, 0xe4, 0x62, 0x57, 0x35, 0xf9, 0x2f, 0xad, 0x77, 0x2c, 0xbb,
+	0xed, 0xfe, 0x9a, 0x82, 0x8a, 0x15, 0xed, 0xe2, 0xa1, 0x8a, 0xf6, 0xef, 0x15, 0x98, 0x6d, 0xd3,
+	0xad, 0x7a, 0x4d, 0x6e, 0xb7, 0xba, 0x28, 0xc5, 0x1c, 0x5d, 0x94, 0x3f, 0x29, 0x70, 0x3a, 0xf7,
+	0x55, 0x43, 0x57, 0x7b, 0xe0, 0x2a, 0x1c, 0x15, 0x30, 0xe2, 0x55, 0x8b, 0x5d, 0x0a, 0xa7, 0x70,
+	0x56, 0x78, 0x07, 0x98, 0xf8, 0xc6, 0xea, 0x1f, 0x5b, 0x1d, 0x98, 0x8c, 0x0b, 0x8b, 0xae, 0x5c,
+	0xbd, 0x05, 0xc3, 0x71, 0x3c, 0xf9, 0x4e, 0x91, 0xbc, 0x26, 0x89, 0x8f, 0x2c, 0x93, 0xaf, 0x5e,
+	0x96, 0x94, 0xaf, 0x5f, 0x96, 0x94, 0xff, 0xbc, 0x2c, 0x29, 0x9f, 0xbc, 0x2a, 0x1d, 0xf9, 0xfa,
+	0x55, 0xe9, 0xc8, 0x3f, 0x5f, 0x95, 0x8e, 0x3c, 0xbe, 0x92, 0xf3, 0x38, 0xfa, 0x62, 0xb1, 0xf5,
+	0x93, 0x7b, 0xf6, 0x7f, 0x05, 0x36, 0x7b, 0xd9, 0xef, 0xed, 0x2f, 0xfe, 0x6f, 0x00, 0xcf, 0xb0,
+	0x85, 0xcf, 0x05, 0x31, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HorizonReputerScoresByBlock) > 0 {
+		for iNdEx := len(m.HorizonReputerScoresByBlock) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AllLossBundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.HorizonReputerScoresByBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2490,15 +3061,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
 			i--
-			dAtA[i] = 0xc2
+			dAtA[i] = 0xe2
 		}
 	}
-	if len(m.AllForecasts) > 0 {
-		for iNdEx := len(m.AllForecasts) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.HorizonForecasterScoresByBlock) > 0 {
+		for iNdEx := len(m.HorizonForecasterScoresByBlock) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AllForecasts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.HorizonForecasterScoresByBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2506,15 +3077,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
 			i--
-			dAtA[i] = 0xba
+			dAtA[i] = 0xda
 		}
 	}
-	if len(m.AllInferences) > 0 {
-		for iNdEx := len(m.AllInferences) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.HorizonInfererScoresByBlock) > 0 {
+		for iNdEx := len(m.HorizonInfererScoresByBlock) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AllInferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.HorizonInfererScoresByBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2522,15 +3093,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
 			i--
-			dAtA[i] = 0xb2
+			dAtA[i] = 0xd2
 		}
 	}
-	if len(m.PreviousTopicWeight) > 0 {
-		for iNdEx := len(m.PreviousTopicWeight) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.HorizonLastReputerPayload) > 0 {
+		for iNdEx := len(m.HorizonLastReputerPayload) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PreviousTopicWeight[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.HorizonLastReputerPayload[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2538,15 +3109,29 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
 			i--
-			dAtA[i] = 0xaa
+			dAtA[i] = 0xca
 		}
 	}
-	if len(m.TopicFeeRevenue) > 0 {
-		for iNdEx := len(m.TopicFeeRevenue) - 1; iNdEx >= 0; iNdEx-- {
+	if m.LastPercentageRewardToStakedReputersHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastPercentageRewardToStakedReputersHeight))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.LastBlocksPerMonthCalibrationHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastBlocksPerMonthCalibrationHeight))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0xb8
+	}
+	if len(m.TopicEpochHeights) > 0 {
+		for iNdEx := len(m.TopicEpochHeights) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TopicFeeRevenue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TopicEpochHeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2554,31 +3139,41 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
 			i--
-			dAtA[i] = 0xa2
+			dAtA[i] = 0xb2
 		}
 	}
-	if len(m.Reputers) > 0 {
-		for iNdEx := len(m.Reputers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Reputers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0x9a
+	if m.CalibratedBlocksPerMonth != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CalibratedBlocksPerMonth))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0xa8
+	}
+	{
+		size := m.AverageBlockTime.Size()
+		i -= size
+		if _, err := m.AverageBlockTime.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if len(m.Workers) > 0 {
-		for iNdEx := len(m.Workers) - 1; iNdEx >= 0; iNdEx-- {
+	i--
+	dAtA[i] = 0x4
+	i--
+	dAtA[i] = 0xa2
+	if m.LastBlockTime != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastBlockTime))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.RewardHistory) > 0 {
+		for iNdEx := len(m.RewardHistory) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Workers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.RewardHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2586,15 +3181,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
 			i--
 			dAtA[i] = 0x92
 		}
 	}
-	if len(m.Forecasts) > 0 {
-		for iNdEx := len(m.Forecasts) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ReputerScoreHistory) > 0 {
+		for iNdEx := len(m.ReputerScoreHistory) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Forecasts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ReputerScoreHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2602,15 +3197,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
 			i--
 			dAtA[i] = 0x8a
 		}
 	}
-	if len(m.Inferences) > 0 {
-		for iNdEx := len(m.Inferences) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ForecasterScoreHistory) > 0 {
+		for iNdEx := len(m.ForecasterScoreHistory) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Inferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ForecasterScoreHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2618,15 +3213,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
 			i--
 			dAtA[i] = 0x82
 		}
 	}
-	if len(m.DelegateStakeRemovalsByActor) > 0 {
-		for iNdEx := len(m.DelegateStakeRemovalsByActor) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.InfererScoreHistory) > 0 {
+		for iNdEx := len(m.InfererScoreHistory) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DelegateStakeRemovalsByActor[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.InfererScoreHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2634,15 +3229,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xfa
 		}
 	}
-	if len(m.DelegateStakeRemovalsByBlock) > 0 {
-		for iNdEx := len(m.DelegateStakeRemovalsByBlock) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.RewardBreakdowns) > 0 {
+		for iNdEx := len(m.RewardBreakdowns) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DelegateStakeRemovalsByBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.RewardBreakdowns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2650,15 +3245,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xf2
 		}
 	}
-	if len(m.StakeRemovalsByActor) > 0 {
-		for iNdEx := len(m.StakeRemovalsByActor) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.NetworkInferenceWeights) > 0 {
+		for iNdEx := len(m.NetworkInferenceWeights) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.StakeRemovalsByActor[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.NetworkInferenceWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2666,15 +3261,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xea
 		}
 	}
-	if len(m.StakeRemovalsByBlock) > 0 {
-		for iNdEx := len(m.StakeRemovalsByBlock) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.HorizonNetworkLossBundles) > 0 {
+		for iNdEx := len(m.HorizonNetworkLossBundles) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.StakeRemovalsByBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.HorizonNetworkLossBundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2682,15 +3277,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xe2
 		}
 	}
-	if len(m.DelegateRewardPerShare) > 0 {
-		for iNdEx := len(m.DelegateRewardPerShare) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.HorizonLossBundles) > 0 {
+		for iNdEx := len(m.HorizonLossBundles) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DelegateRewardPerShare[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.HorizonLossBundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2698,15 +3293,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xda
 		}
 	}
-	if len(m.StakeFromDelegatorsUponReputer) > 0 {
-		for iNdEx := len(m.StakeFromDelegatorsUponReputer) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TopicHorizonRewardNonce) > 0 {
+		for iNdEx := len(m.TopicHorizonRewardNonce) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.StakeFromDelegatorsUponReputer[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TopicHorizonRewardNonce[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2714,15 +3309,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xd2
 		}
 	}
-	if len(m.DelegatedStakes) > 0 {
-		for iNdEx := len(m.DelegatedStakes) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ReputerLastActiveHeight) > 0 {
+		for iNdEx := len(m.ReputerLastActiveHeight) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DelegatedStakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ReputerLastActiveHeight[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2730,15 +3325,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xca
 		}
 	}
-	if len(m.StakeSumFromDelegator) > 0 {
-		for iNdEx := len(m.StakeSumFromDelegator) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.WorkerLastActiveHeight) > 0 {
+		for iNdEx := len(m.WorkerLastActiveHeight) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.StakeSumFromDelegator[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.WorkerLastActiveHeight[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2746,15 +3341,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xc2
 		}
 	}
-	if len(m.StakeReputerAuthority) > 0 {
-		for iNdEx := len(m.StakeReputerAuthority) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ActorRoles) > 0 {
+		for iNdEx := len(m.ActorRoles) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.StakeReputerAuthority[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ActorRoles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2762,15 +3357,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xba
 		}
 	}
-	if len(m.TopicStake) > 0 {
-		for iNdEx := len(m.TopicStake) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TopicReputerAllowlist) > 0 {
+		for iNdEx := len(m.TopicReputerAllowlist) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TopicStake[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TopicReputerAllowlist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2778,27 +3373,31 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xb2
 		}
 	}
-	{
-		size := m.TotalStake.Size()
-		i -= size
-		if _, err := m.TotalStake.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.TopicWorkerAllowlist) > 0 {
+		for iNdEx := len(m.TopicWorkerAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopicWorkerAllowlist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xaa
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0xaa
-	if len(m.PreviousForecastRewardFraction) > 0 {
-		for iNdEx := len(m.PreviousForecastRewardFraction) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TopicLastReputerPayload) > 0 {
+		for iNdEx := len(m.TopicLastReputerPayload) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PreviousForecastRewardFraction[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TopicLastReputerPayload[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2806,15 +3405,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0xa2
 		}
 	}
-	if len(m.PreviousInferenceRewardFraction) > 0 {
-		for iNdEx := len(m.PreviousInferenceRewardFraction) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TopicLastWorkerPayload) > 0 {
+		for iNdEx := len(m.TopicLastWorkerPayload) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PreviousInferenceRewardFraction[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TopicLastWorkerPayload[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2822,15 +3421,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0x9a
 		}
 	}
-	if len(m.PreviousReputerRewardFraction) > 0 {
-		for iNdEx := len(m.PreviousReputerRewardFraction) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TopicLastReputerCommit) > 0 {
+		for iNdEx := len(m.TopicLastReputerCommit) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PreviousReputerRewardFraction[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TopicLastReputerCommit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2838,15 +3437,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0x92
 		}
 	}
-	if len(m.ReputerListeningCoefficient) > 0 {
-		for iNdEx := len(m.ReputerListeningCoefficient) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TopicLastWorkerCommit) > 0 {
+		for iNdEx := len(m.TopicLastWorkerCommit) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ReputerListeningCoefficient[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TopicLastWorkerCommit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2854,15 +3453,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0x8a
 		}
 	}
-	if len(m.LatestReputerScoresByReputer) > 0 {
-		for iNdEx := len(m.LatestReputerScoresByReputer) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LatestOneInForecasterSelfNetworkRegrets) > 0 {
+		for iNdEx := len(m.LatestOneInForecasterSelfNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LatestReputerScoresByReputer[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LatestOneInForecasterSelfNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2870,15 +3469,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
 			dAtA[i] = 0x82
 		}
 	}
-	if len(m.LatestForecasterScoresByWorker) > 0 {
-		for iNdEx := len(m.LatestForecasterScoresByWorker) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LatestOneInForecasterNetworkRegrets) > 0 {
+		for iNdEx := len(m.LatestOneInForecasterNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LatestForecasterScoresByWorker[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LatestOneInForecasterNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2886,13 +3485,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x7a
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xfa
 		}
 	}
-	if len(m.LatestInfererScoresByWorker) > 0 {
-		for iNdEx := len(m.LatestInfererScoresByWorker) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LatestForecasterNetworkRegrets) > 0 {
+		for iNdEx := len(m.LatestForecasterNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LatestInfererScoresByWorker[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LatestForecasterNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2900,13 +3501,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x72
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xf2
 		}
 	}
-	if len(m.ReputerScoresByBlock) > 0 {
-		for iNdEx := len(m.ReputerScoresByBlock) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LatestInfererNetworkRegrets) > 0 {
+		for iNdEx := len(m.LatestInfererNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ReputerScoresByBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LatestInfererNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2914,13 +3517,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x6a
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xea
 		}
 	}
-	if len(m.ForecasterScoresByBlock) > 0 {
-		for iNdEx := len(m.ForecasterScoresByBlock) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.UnfulfilledReputerNonces) > 0 {
+		for iNdEx := len(m.UnfulfilledReputerNonces) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ForecasterScoresByBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.UnfulfilledReputerNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2928,13 +3533,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x62
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xe2
 		}
 	}
-	if len(m.InfererScoresByBlock) > 0 {
-		for iNdEx := len(m.InfererScoresByBlock) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.UnfulfilledWorkerNonces) > 0 {
+		for iNdEx := len(m.UnfulfilledWorkerNonces) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.InfererScoresByBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.UnfulfilledWorkerNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2942,13 +3549,27 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x5a
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xda
 		}
 	}
-	if len(m.TopicRewardNonce) > 0 {
-		for iNdEx := len(m.TopicRewardNonce) - 1; iNdEx >= 0; iNdEx-- {
+	{
+		size := m.PreviousPercentageRewardToStakedReputers.Size()
+		i -= size
+		if _, err := m.PreviousPercentageRewardToStakedReputers.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xd2
+	if len(m.NetworkLossBundles) > 0 {
+		for iNdEx := len(m.NetworkLossBundles) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TopicRewardNonce[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.NetworkLossBundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2956,13 +3577,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x52
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xca
 		}
 	}
-	if len(m.TopicReputers) > 0 {
-		for iNdEx := len(m.TopicReputers) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.AllLossBundles) > 0 {
+		for iNdEx := len(m.AllLossBundles) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TopicReputers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.AllLossBundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2970,13 +3593,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x4a
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xc2
 		}
 	}
-	if len(m.TopicWorkers) > 0 {
-		for iNdEx := len(m.TopicWorkers) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.AllForecasts) > 0 {
+		for iNdEx := len(m.AllForecasts) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TopicWorkers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.AllForecasts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2984,67 +3609,63 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x42
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xba
 		}
 	}
-	if len(m.RewardableTopics) > 0 {
-		dAtA2 := make([]byte, len(m.RewardableTopics)*10)
-		var j1 int
-		for _, num := range m.RewardableTopics {
-			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
+	if len(m.AllInferences) > 0 {
+		for iNdEx := len(m.AllInferences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllInferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xb2
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintGenesis(dAtA, i, uint64(j1))
-		i--
-		dAtA[i] = 0x3a
 	}
-	if len(m.ChurnableTopics) > 0 {
-		dAtA4 := make([]byte, len(m.ChurnableTopics)*10)
-		var j3 int
-		for _, num := range m.ChurnableTopics {
-			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j3++
+	if len(m.PreviousTopicWeight) > 0 {
+		for iNdEx := len(m.PreviousTopicWeight) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreviousTopicWeight[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			dAtA4[j3] = uint8(num)
-			j3++
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xaa
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintGenesis(dAtA, i, uint64(j3))
-		i--
-		dAtA[i] = 0x32
 	}
-	if len(m.ActiveTopics) > 0 {
-		dAtA6 := make([]byte, len(m.ActiveTopics)*10)
-		var j5 int
-		for _, num := range m.ActiveTopics {
-			for num >= 1<<7 {
-				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j5++
+	if len(m.TopicFeeRevenue) > 0 {
+		for iNdEx := len(m.TopicFeeRevenue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopicFeeRevenue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			dAtA6[j5] = uint8(num)
-			j5++
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xa2
 		}
-		i -= j5
-		copy(dAtA[i:], dAtA6[:j5])
-		i = encodeVarintGenesis(dAtA, i, uint64(j5))
-		i--
-		dAtA[i] = 0x2a
 	}
-	if len(m.Topics) > 0 {
-		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Reputers) > 0 {
+		for iNdEx := len(m.Reputers) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Topics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Reputers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3052,367 +3673,519 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x9a
 		}
 	}
-	if m.NextTopicId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.NextTopicId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.CoreTeamAddresses) > 0 {
-		for iNdEx := len(m.CoreTeamAddresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CoreTeamAddresses[iNdEx])
-			copy(dAtA[i:], m.CoreTeamAddresses[iNdEx])
-			i = encodeVarintGenesis(dAtA, i, uint64(len(m.CoreTeamAddresses[iNdEx])))
+	if len(m.Workers) > 0 {
+		for iNdEx := len(m.Workers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Workers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x92
 		}
 	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Forecasts) > 0 {
+		for iNdEx := len(m.Forecasts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Forecasts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x8a
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *TopicIdAndTopic) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.Inferences) > 0 {
+		for iNdEx := len(m.Inferences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Inferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TopicIdAndTopic) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TopicIdAndTopic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Topic != nil {
-		{
-			size, err := m.Topic.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.DelegateStakeRemovalsByActor) > 0 {
+		for iNdEx := len(m.DelegateStakeRemovalsByActor) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegateStakeRemovalsByActor[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
+	if len(m.DelegateStakeRemovalsByBlock) > 0 {
+		for iNdEx := len(m.DelegateStakeRemovalsByBlock) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegateStakeRemovalsByBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TopicAndActorId) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.StakeRemovalsByActor) > 0 {
+		for iNdEx := len(m.StakeRemovalsByActor) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakeRemovalsByActor[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TopicAndActorId) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TopicAndActorId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ActorId) > 0 {
-		i -= len(m.ActorId)
-		copy(dAtA[i:], m.ActorId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.TopicId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
+	if len(m.StakeRemovalsByBlock) > 0 {
+		for iNdEx := len(m.StakeRemovalsByBlock) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakeRemovalsByBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TopicIdAndBlockHeight) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.DelegateRewardPerShare) > 0 {
+		for iNdEx := len(m.DelegateRewardPerShare) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegateRewardPerShare[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TopicIdAndBlockHeight) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TopicIdAndBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x10
+	if len(m.StakeFromDelegatorsUponReputer) > 0 {
+		for iNdEx := len(m.StakeFromDelegatorsUponReputer) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakeFromDelegatorsUponReputer[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
+	if len(m.DelegatedStakes) > 0 {
+		for iNdEx := len(m.DelegatedStakes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatedStakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TopicIdBlockHeightScores) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.StakeSumFromDelegator) > 0 {
+		for iNdEx := len(m.StakeSumFromDelegator) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakeSumFromDelegator[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TopicIdBlockHeightScores) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TopicIdBlockHeightScores) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Scores != nil {
-		{
-			size, err := m.Scores.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.StakeReputerAuthority) > 0 {
+		for iNdEx := len(m.StakeReputerAuthority) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakeReputerAuthority[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x10
+	if len(m.TopicStake) > 0 {
+		for iNdEx := len(m.TopicStake) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopicStake[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
+	{
+		size := m.TotalStake.Size()
+		i -= size
+		if _, err := m.TotalStake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TopicIdActorIdScore) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	if len(m.PreviousForecastRewardFraction) > 0 {
+		for iNdEx := len(m.PreviousForecastRewardFraction) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreviousForecastRewardFraction[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TopicIdActorIdScore) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TopicIdActorIdScore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Score != nil {
-		{
-			size, err := m.Score.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.PreviousInferenceRewardFraction) > 0 {
+		for iNdEx := len(m.PreviousInferenceRewardFraction) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreviousInferenceRewardFraction[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	if len(m.ActorId) > 0 {
-		i -= len(m.ActorId)
-		copy(dAtA[i:], m.ActorId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.TopicId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TopicIdActorIdListeningCoefficient) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.PreviousReputerRewardFraction) > 0 {
+		for iNdEx := len(m.PreviousReputerRewardFraction) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreviousReputerRewardFraction[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TopicIdActorIdListeningCoefficient) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TopicIdActorIdListeningCoefficient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ListeningCoefficient != nil {
-		{
-			size, err := m.ListeningCoefficient.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.ReputerListeningCoefficient) > 0 {
+		for iNdEx := len(m.ReputerListeningCoefficient) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReputerListeningCoefficient[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	if len(m.ActorId) > 0 {
-		i -= len(m.ActorId)
-		copy(dAtA[i:], m.ActorId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
-		i--
-		dAtA[i] = 0x12
+	if len(m.LatestReputerScoresByReputer) > 0 {
+		for iNdEx := len(m.LatestReputerScoresByReputer) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LatestReputerScoresByReputer[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
+	if len(m.LatestForecasterScoresByWorker) > 0 {
+		for iNdEx := len(m.LatestForecasterScoresByWorker) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LatestForecasterScoresByWorker[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TopicIdActorIdDec) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.LatestInfererScoresByWorker) > 0 {
+		for iNdEx := len(m.LatestInfererScoresByWorker) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LatestInfererScoresByWorker[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TopicIdActorIdDec) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TopicIdActorIdDec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Dec.Size()
-		i -= size
-		if _, err := m.Dec.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.ReputerScoresByBlock) > 0 {
+		for iNdEx := len(m.ReputerScoresByBlock) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReputerScoresByBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.ActorId) > 0 {
-		i -= len(m.ActorId)
-		copy(dAtA[i:], m.ActorId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
-		i--
-		dAtA[i] = 0x12
+	if len(m.ForecasterScoresByBlock) > 0 {
+		for iNdEx := len(m.ForecasterScoresByBlock) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForecasterScoresByBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
+	if len(m.InfererScoresByBlock) > 0 {
+		for iNdEx := len(m.InfererScoresByBlock) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InfererScoresByBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TopicIdAndInt) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.TopicRewardNonce) > 0 {
+		for iNdEx := len(m.TopicRewardNonce) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopicRewardNonce[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TopicIdAndInt) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TopicIdAndInt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Int.Size()
-		i -= size
-		if _, err := m.Int.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.TopicReputers) > 0 {
+		for iNdEx := len(m.TopicReputers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopicReputers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.TopicId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+	if len(m.TopicWorkers) > 0 {
+		for iNdEx := len(m.TopicWorkers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopicWorkers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.RewardableTopics) > 0 {
+		dAtA2 := make([]byte, len(m.RewardableTopics)*10)
+		var j1 int
+		for _, num := range m.RewardableTopics {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGenesis(dAtA, i, uint64(j1))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x3a
+	}
+	if len(m.ChurnableTopics) > 0 {
+		dAtA4 := make([]byte, len(m.ChurnableTopics)*10)
+		var j3 int
+		for _, num := range m.ChurnableTopics {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintGenesis(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ActiveTopics) > 0 {
+		dAtA6 := make([]byte, len(m.ActiveTopics)*10)
+		var j5 int
+		for _, num := range m.ActiveTopics {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintGenesis(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Topics) > 0 {
+		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Topics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.NextTopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextTopicId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CoreTeamAddresses) > 0 {
+		for iNdEx := len(m.CoreTeamAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CoreTeamAddresses[iNdEx])
+			copy(dAtA[i:], m.CoreTeamAddresses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.CoreTeamAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdActorIdInt) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdAndTopic) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3422,30 +4195,25 @@ func (m *TopicIdActorIdInt) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdActorIdInt) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdAndTopic) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdActorIdInt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdAndTopic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Int.Size()
-		i -= size
-		if _, err := m.Int.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.Topic != nil {
+		{
+			size, err := m.Topic.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.ActorId) > 0 {
-		i -= len(m.ActorId)
-		copy(dAtA[i:], m.ActorId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -3457,7 +4225,7 @@ func (m *TopicIdActorIdInt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdDelegatorReputerDelegatorInfo) Marshal() (dAtA []byte, err error) {
+func (m *ActorIdAndRole) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3467,51 +4235,32 @@ func (m *TopicIdDelegatorReputerDelegatorInfo) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdDelegatorReputerDelegatorInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *ActorIdAndRole) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdDelegatorReputerDelegatorInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ActorIdAndRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DelegatorInfo != nil {
-		{
-			size, err := m.DelegatorInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Reputer) > 0 {
-		i -= len(m.Reputer)
-		copy(dAtA[i:], m.Reputer)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reputer)))
+	if m.Role != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Role))
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Delegator) > 0 {
-		i -= len(m.Delegator)
-		copy(dAtA[i:], m.Delegator)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Delegator)))
-		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BlockHeightTopicIdReputerStakeRemovalInfo) Marshal() (dAtA []byte, err error) {
+func (m *TopicAndActorId) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3521,49 +4270,32 @@ func (m *BlockHeightTopicIdReputerStakeRemovalInfo) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *BlockHeightTopicIdReputerStakeRemovalInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicAndActorId) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockHeightTopicIdReputerStakeRemovalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicAndActorId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.StakeRemovalInfo != nil {
-		{
-			size, err := m.StakeRemovalInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Reputer) > 0 {
-		i -= len(m.Reputer)
-		copy(dAtA[i:], m.Reputer)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reputer)))
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x12
 	}
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
 		i--
-		dAtA[i] = 0x10
-	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
-		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ActorIdTopicIdBlockHeight) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdAndBlockHeight) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3573,12 +4305,12 @@ func (m *ActorIdTopicIdBlockHeight) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ActorIdTopicIdBlockHeight) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdAndBlockHeight) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ActorIdTopicIdBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdAndBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3586,24 +4318,17 @@ func (m *ActorIdTopicIdBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	if m.BlockHeight != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x10
 	}
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
 		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.ActorId) > 0 {
-		i -= len(m.ActorId)
-		copy(dAtA[i:], m.ActorId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
-		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdBlockHeightScores) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3613,19 +4338,19 @@ func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) Marshal() (
 	return dAtA[:n], nil
 }
 
-func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdBlockHeightScores) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdBlockHeightScores) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DelegateStakeRemovalInfo != nil {
+	if m.Scores != nil {
 		{
-			size, err := m.DelegateStakeRemovalInfo.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Scores.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3633,36 +4358,22 @@ func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) MarshalToSi
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Reputer) > 0 {
-		i -= len(m.Reputer)
-		copy(dAtA[i:], m.Reputer)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reputer)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Delegator) > 0 {
-		i -= len(m.Delegator)
-		copy(dAtA[i:], m.Delegator)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Delegator)))
-		i--
 		dAtA[i] = 0x1a
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegatorReputerTopicIdBlockHeight) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdActorIdScore) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3672,66 +4383,19 @@ func (m *DelegatorReputerTopicIdBlockHeight) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *DelegatorReputerTopicIdBlockHeight) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdScore) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegatorReputerTopicIdBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.TopicId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Reputer) > 0 {
-		i -= len(m.Reputer)
-		copy(dAtA[i:], m.Reputer)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reputer)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Delegator) > 0 {
-		i -= len(m.Delegator)
-		copy(dAtA[i:], m.Delegator)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Delegator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TopicIdActorIdInference) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *TopicIdActorIdInference) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TopicIdActorIdInference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdScore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Inference != nil {
+	if m.Score != nil {
 		{
-			size, err := m.Inference.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Score.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3756,7 +4420,7 @@ func (m *TopicIdActorIdInference) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdActorIdForecast) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdActorIdListeningCoefficient) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3766,19 +4430,19 @@ func (m *TopicIdActorIdForecast) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdActorIdForecast) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdListeningCoefficient) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdActorIdForecast) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdListeningCoefficient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Forecast != nil {
+	if m.ListeningCoefficient != nil {
 		{
-			size, err := m.Forecast.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ListeningCoefficient.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3803,7 +4467,7 @@ func (m *TopicIdActorIdForecast) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *LibP2PKeyAndOffchainNode) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdActorIdDec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3813,39 +4477,42 @@ func (m *LibP2PKeyAndOffchainNode) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LibP2PKeyAndOffchainNode) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdDec) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LibP2PKeyAndOffchainNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdDec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.OffchainNode != nil {
-		{
-			size, err := m.OffchainNode.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
+	{
+		size := m.Dec.Size()
+		i -= size
+		if _, err := m.Dec.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.LibP2PKey) > 0 {
-		i -= len(m.LibP2PKey)
-		copy(dAtA[i:], m.LibP2PKey)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.LibP2PKey)))
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdAndDec) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdAndInt) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3855,20 +4522,20 @@ func (m *TopicIdAndDec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdAndDec) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdAndInt) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdAndDec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdAndInt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.Dec.Size()
+		size := m.Int.Size()
 		i -= size
-		if _, err := m.Dec.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Int.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
@@ -3883,7 +4550,7 @@ func (m *TopicIdAndDec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdBlockHeightInferences) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdActorIdInt) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3893,32 +4560,32 @@ func (m *TopicIdBlockHeightInferences) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdBlockHeightInferences) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdInt) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdBlockHeightInferences) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdInt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Inferences != nil {
-		{
-			size, err := m.Inferences.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
+	{
+		size := m.Int.Size()
+		i -= size
+		if _, err := m.Int.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
@@ -3928,7 +4595,7 @@ func (m *TopicIdBlockHeightInferences) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdBlockHeightForecasts) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdDelegatorReputerDelegatorInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3938,19 +4605,19 @@ func (m *TopicIdBlockHeightForecasts) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdBlockHeightForecasts) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdDelegatorReputerDelegatorInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdBlockHeightForecasts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdDelegatorReputerDelegatorInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Forecasts != nil {
+	if m.DelegatorInfo != nil {
 		{
-			size, err := m.Forecasts.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.DelegatorInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3958,12 +4625,21 @@ func (m *TopicIdBlockHeightForecasts) MarshalToSizedBuffer(dAtA []byte) (int, er
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reputer)))
+		i--
 		dAtA[i] = 0x1a
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Delegator)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
@@ -3973,7 +4649,7 @@ func (m *TopicIdBlockHeightForecasts) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdBlockHeightReputerValueBundles) Marshal() (dAtA []byte, err error) {
+func (m *BlockHeightTopicIdReputerStakeRemovalInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3983,19 +4659,19 @@ func (m *TopicIdBlockHeightReputerValueBundles) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdBlockHeightReputerValueBundles) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockHeightTopicIdReputerStakeRemovalInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdBlockHeightReputerValueBundles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockHeightTopicIdReputerStakeRemovalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ReputerValueBundles != nil {
+	if m.StakeRemovalInfo != nil {
 		{
-			size, err := m.ReputerValueBundles.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.StakeRemovalInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4003,22 +4679,29 @@ func (m *TopicIdBlockHeightReputerValueBundles) MarshalToSizedBuffer(dAtA []byte
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x22
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reputer)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x1a
 	}
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
 		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdBlockHeightValueBundles) Marshal() (dAtA []byte, err error) {
+func (m *ActorIdTopicIdBlockHeight) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4028,42 +4711,37 @@ func (m *TopicIdBlockHeightValueBundles) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdBlockHeightValueBundles) MarshalTo(dAtA []byte) (int, error) {
+func (m *ActorIdTopicIdBlockHeight) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdBlockHeightValueBundles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ActorIdTopicIdBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ValueBundle != nil {
-		{
-			size, err := m.ValueBundle.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
 	if m.BlockHeight != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x18
 	}
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x10
+	}
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdAndNonces) Marshal() (dAtA []byte, err error) {
+func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4073,19 +4751,19 @@ func (m *TopicIdAndNonces) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdAndNonces) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdAndNonces) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Nonces != nil {
+	if m.DelegateStakeRemovalInfo != nil {
 		{
-			size, err := m.Nonces.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.DelegateStakeRemovalInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4093,17 +4771,36 @@ func (m *TopicIdAndNonces) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x2a
+	}
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
 		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdAndReputerRequestNonces) Marshal() (dAtA []byte, err error) {
+func (m *DelegatorReputerTopicIdBlockHeight) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4113,19 +4810,66 @@ func (m *TopicIdAndReputerRequestNonces) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdAndReputerRequestNonces) MarshalTo(dAtA []byte) (int, error) {
+func (m *DelegatorReputerTopicIdBlockHeight) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdAndReputerRequestNonces) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DelegatorReputerTopicIdBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ReputerRequestNonces != nil {
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicIdActorIdInference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdActorIdInference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdActorIdInference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Inference != nil {
 		{
-			size, err := m.ReputerRequestNonces.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Inference.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4133,6 +4877,13 @@ func (m *TopicIdAndReputerRequestNonces) MarshalToSizedBuffer(dAtA []byte) (int,
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
+		i--
 		dAtA[i] = 0x12
 	}
 	if m.TopicId != 0 {
@@ -4143,7 +4894,7 @@ func (m *TopicIdAndReputerRequestNonces) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdActorIdTimeStampedValue) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdActorIdForecast) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4153,19 +4904,19 @@ func (m *TopicIdActorIdTimeStampedValue) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdActorIdTimeStampedValue) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdForecast) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdActorIdTimeStampedValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdForecast) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TimestampedValue != nil {
+	if m.Forecast != nil {
 		{
-			size, err := m.TimestampedValue.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Forecast.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4190,7 +4941,7 @@ func (m *TopicIdActorIdTimeStampedValue) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdActorIdActorIdTimeStampedValue) Marshal() (dAtA []byte, err error) {
+func (m *LibP2PKeyAndOffchainNode) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4200,19 +4951,19 @@ func (m *TopicIdActorIdActorIdTimeStampedValue) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdActorIdActorIdTimeStampedValue) MarshalTo(dAtA []byte) (int, error) {
+func (m *LibP2PKeyAndOffchainNode) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdActorIdActorIdTimeStampedValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LibP2PKeyAndOffchainNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TimestampedValue != nil {
+	if m.OffchainNode != nil {
 		{
-			size, err := m.TimestampedValue.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.OffchainNode.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4220,22 +4971,48 @@ func (m *TopicIdActorIdActorIdTimeStampedValue) MarshalToSizedBuffer(dAtA []byte
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x12
 	}
-	if len(m.ActorId2) > 0 {
-		i -= len(m.ActorId2)
-		copy(dAtA[i:], m.ActorId2)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId2)))
+	if len(m.LibP2PKey) > 0 {
+		i -= len(m.LibP2PKey)
+		copy(dAtA[i:], m.LibP2PKey)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.LibP2PKey)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0xa
 	}
-	if len(m.ActorId1) > 0 {
-		i -= len(m.ActorId1)
-		copy(dAtA[i:], m.ActorId1)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId1)))
-		i--
-		dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicIdAndDec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdAndDec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdAndDec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Dec.Size()
+		i -= size
+		if _, err := m.Dec.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x12
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
 		i--
@@ -4244,7 +5021,7 @@ func (m *TopicIdActorIdActorIdTimeStampedValue) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdTimestampedActorNonce) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdBlockHeightInferences) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4254,19 +5031,19 @@ func (m *TopicIdTimestampedActorNonce) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdTimestampedActorNonce) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdBlockHeightInferences) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdTimestampedActorNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdBlockHeightInferences) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TimestampedActorNonce != nil {
+	if m.Inferences != nil {
 		{
-			size, err := m.TimestampedActorNonce.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Inferences.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4274,7 +5051,12 @@ func (m *TopicIdTimestampedActorNonce) MarshalToSizedBuffer(dAtA []byte) (int, e
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
 	}
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
@@ -4284,619 +5066,1166 @@ func (m *TopicIdTimestampedActorNonce) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TopicIdBlockHeightForecasts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *TopicIdBlockHeightForecasts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdBlockHeightForecasts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.CoreTeamAddresses) > 0 {
-		for _, s := range m.CoreTeamAddresses {
-			l = len(s)
-			n += 1 + l + sovGenesis(uint64(l))
+	if m.Forecasts != nil {
+		{
+			size, err := m.Forecasts.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.NextTopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.NextTopicId))
-	}
-	if len(m.Topics) > 0 {
-		for _, e := range m.Topics {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.ActiveTopics) > 0 {
-		l = 0
-		for _, e := range m.ActiveTopics {
-			l += sovGenesis(uint64(e))
-		}
-		n += 1 + sovGenesis(uint64(l)) + l
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.ChurnableTopics) > 0 {
-		l = 0
-		for _, e := range m.ChurnableTopics {
-			l += sovGenesis(uint64(e))
-		}
-		n += 1 + sovGenesis(uint64(l)) + l
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicIdBlockHeightReputerValueBundles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.RewardableTopics) > 0 {
-		l = 0
-		for _, e := range m.RewardableTopics {
-			l += sovGenesis(uint64(e))
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdBlockHeightReputerValueBundles) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdBlockHeightReputerValueBundles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReputerValueBundles != nil {
+		{
+			size, err := m.ReputerValueBundles.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
-		n += 1 + sovGenesis(uint64(l)) + l
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.TopicWorkers) > 0 {
-		for _, e := range m.TopicWorkers {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.TopicReputers) > 0 {
-		for _, e := range m.TopicReputers {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.TopicRewardNonce) > 0 {
-		for _, e := range m.TopicRewardNonce {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicIdBlockHeightValueBundles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.InfererScoresByBlock) > 0 {
-		for _, e := range m.InfererScoresByBlock {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdBlockHeightValueBundles) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdBlockHeightValueBundles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValueBundle != nil {
+		{
+			size, err := m.ValueBundle.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.ForecasterScoresByBlock) > 0 {
-		for _, e := range m.ForecasterScoresByBlock {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.ReputerScoresByBlock) > 0 {
-		for _, e := range m.ReputerScoresByBlock {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.LatestInfererScoresByWorker) > 0 {
-		for _, e := range m.LatestInfererScoresByWorker {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicIdAndNonces) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.LatestForecasterScoresByWorker) > 0 {
-		for _, e := range m.LatestForecasterScoresByWorker {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdAndNonces) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdAndNonces) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonces != nil {
+		{
+			size, err := m.Nonces.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.LatestReputerScoresByReputer) > 0 {
-		for _, e := range m.LatestReputerScoresByReputer {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.ReputerListeningCoefficient) > 0 {
-		for _, e := range m.ReputerListeningCoefficient {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicIdAndReputerRequestNonces) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PreviousReputerRewardFraction) > 0 {
-		for _, e := range m.PreviousReputerRewardFraction {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdAndReputerRequestNonces) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdAndReputerRequestNonces) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReputerRequestNonces != nil {
+		{
+			size, err := m.ReputerRequestNonces.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.PreviousInferenceRewardFraction) > 0 {
-		for _, e := range m.PreviousInferenceRewardFraction {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.PreviousForecastRewardFraction) > 0 {
-		for _, e := range m.PreviousForecastRewardFraction {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicIdActorIdTimeStampedValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.TotalStake.Size()
-	n += 2 + l + sovGenesis(uint64(l))
-	if len(m.TopicStake) > 0 {
-		for _, e := range m.TopicStake {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdActorIdTimeStampedValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdActorIdTimeStampedValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimestampedValue != nil {
+		{
+			size, err := m.TimestampedValue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.StakeReputerAuthority) > 0 {
-		for _, e := range m.StakeReputerAuthority {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.StakeSumFromDelegator) > 0 {
-		for _, e := range m.StakeSumFromDelegator {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.DelegatedStakes) > 0 {
-		for _, e := range m.DelegatedStakes {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicIdActorIdActorIdTimeStampedValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.StakeFromDelegatorsUponReputer) > 0 {
-		for _, e := range m.StakeFromDelegatorsUponReputer {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdActorIdActorIdTimeStampedValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdActorIdActorIdTimeStampedValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimestampedValue != nil {
+		{
+			size, err := m.TimestampedValue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.DelegateRewardPerShare) > 0 {
-		for _, e := range m.DelegateRewardPerShare {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if len(m.ActorId2) > 0 {
+		i -= len(m.ActorId2)
+		copy(dAtA[i:], m.ActorId2)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId2)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.StakeRemovalsByBlock) > 0 {
-		for _, e := range m.StakeRemovalsByBlock {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if len(m.ActorId1) > 0 {
+		i -= len(m.ActorId1)
+		copy(dAtA[i:], m.ActorId1)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId1)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.StakeRemovalsByActor) > 0 {
-		for _, e := range m.StakeRemovalsByActor {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.DelegateStakeRemovalsByBlock) > 0 {
-		for _, e := range m.DelegateStakeRemovalsByBlock {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicIdTimestampedActorNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.DelegateStakeRemovalsByActor) > 0 {
-		for _, e := range m.DelegateStakeRemovalsByActor {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdTimestampedActorNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdTimestampedActorNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimestampedActorNonce != nil {
+		{
+			size, err := m.TimestampedActorNonce.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Inferences) > 0 {
-		for _, e := range m.Inferences {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.Forecasts) > 0 {
-		for _, e := range m.Forecasts {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicIdHorizonTimestampedActorNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Workers) > 0 {
-		for _, e := range m.Workers {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdHorizonTimestampedActorNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdHorizonTimestampedActorNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimestampedActorNonce != nil {
+		{
+			size, err := m.TimestampedActorNonce.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Reputers) > 0 {
-		for _, e := range m.Reputers {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if m.Horizon != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Horizon))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.TopicFeeRevenue) > 0 {
-		for _, e := range m.TopicFeeRevenue {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.PreviousTopicWeight) > 0 {
-		for _, e := range m.PreviousTopicWeight {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicIdHorizonBlockHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.AllInferences) > 0 {
-		for _, e := range m.AllInferences {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdHorizonBlockHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdHorizonBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.AllForecasts) > 0 {
-		for _, e := range m.AllForecasts {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if m.Horizon != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Horizon))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.AllLossBundles) > 0 {
-		for _, e := range m.AllLossBundles {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.NetworkLossBundles) > 0 {
-		for _, e := range m.NetworkLossBundles {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicIdHorizonBlockHeightReputerValueBundles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.PreviousPercentageRewardToStakedReputers.Size()
-	n += 2 + l + sovGenesis(uint64(l))
-	if len(m.UnfulfilledWorkerNonces) > 0 {
-		for _, e := range m.UnfulfilledWorkerNonces {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdHorizonBlockHeightReputerValueBundles) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdHorizonBlockHeightReputerValueBundles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReputerValueBundles != nil {
+		{
+			size, err := m.ReputerValueBundles.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.UnfulfilledReputerNonces) > 0 {
-		for _, e := range m.UnfulfilledReputerNonces {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.LatestInfererNetworkRegrets) > 0 {
-		for _, e := range m.LatestInfererNetworkRegrets {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.LatestForecasterNetworkRegrets) > 0 {
-		for _, e := range m.LatestForecasterNetworkRegrets {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.LatestOneInForecasterNetworkRegrets) > 0 {
-		for _, e := range m.LatestOneInForecasterNetworkRegrets {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.LatestOneInForecasterSelfNetworkRegrets) > 0 {
-		for _, e := range m.LatestOneInForecasterSelfNetworkRegrets {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.TopicLastWorkerCommit) > 0 {
-		for _, e := range m.TopicLastWorkerCommit {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.TopicLastReputerCommit) > 0 {
-		for _, e := range m.TopicLastReputerCommit {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.TopicLastWorkerPayload) > 0 {
-		for _, e := range m.TopicLastWorkerPayload {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if m.Horizon != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Horizon))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.TopicLastReputerPayload) > 0 {
-		for _, e := range m.TopicLastReputerPayload {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdAndTopic) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TopicIdHorizonBlockHeightValueBundles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdHorizonBlockHeightValueBundles) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdHorizonBlockHeightValueBundles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
+	if m.ValueBundle != nil {
+		{
+			size, err := m.ValueBundle.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Topic != nil {
-		l = m.Topic.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
-}
-
-func (m *TopicAndActorId) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Horizon != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Horizon))
+		i--
+		dAtA[i] = 0x10
 	}
-	var l int
-	_ = l
 	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
-	}
-	l = len(m.ActorId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdAndBlockHeight) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TopicIdHorizonBlockHeightScores) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdHorizonBlockHeightScores) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdHorizonBlockHeightScores) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
+	if m.Scores != nil {
+		{
+			size, err := m.Scores.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
 	if m.BlockHeight != 0 {
-		n += 1 + sovGenesis(uint64(m.BlockHeight))
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if m.Horizon != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Horizon))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdBlockHeightScores) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TopicIdBlockHeightNetworkInferenceWeights) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdBlockHeightNetworkInferenceWeights) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdBlockHeightNetworkInferenceWeights) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
+	if m.Weights != nil {
+		{
+			size, err := m.Weights.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.BlockHeight != 0 {
-		n += 1 + sovGenesis(uint64(m.BlockHeight))
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Scores != nil {
-		l = m.Scores.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdActorIdScore) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TopicIdBlockHeightRewardBreakdowns) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdBlockHeightRewardBreakdowns) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdBlockHeightRewardBreakdowns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
+	if m.RewardBreakdowns != nil {
+		{
+			size, err := m.RewardBreakdowns.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ActorId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Score != nil {
-		l = m.Score.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdActorIdListeningCoefficient) Size() (n int) {
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
-	}
-	l = len(m.ActorId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if m.ListeningCoefficient != nil {
-		l = m.ListeningCoefficient.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.CoreTeamAddresses) > 0 {
+		for _, s := range m.CoreTeamAddresses {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
 	}
-	return n
-}
-
-func (m *TopicIdActorIdDec) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.NextTopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextTopicId))
 	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
+	if len(m.Topics) > 0 {
+		for _, e := range m.Topics {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
 	}
-	l = len(m.ActorId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ActiveTopics) > 0 {
+		l = 0
+		for _, e := range m.ActiveTopics {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
 	}
-	l = m.Dec.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
-
-func (m *TopicIdAndInt) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.ChurnableTopics) > 0 {
+		l = 0
+		for _, e := range m.ChurnableTopics {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
 	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
+	if len(m.RewardableTopics) > 0 {
+		l = 0
+		for _, e := range m.RewardableTopics {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
 	}
-	l = m.Int.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
-
-func (m *TopicIdActorIdInt) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.TopicWorkers) > 0 {
+		for _, e := range m.TopicWorkers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
 	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
+	if len(m.TopicReputers) > 0 {
+		for _, e := range m.TopicReputers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
 	}
-	l = len(m.ActorId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TopicRewardNonce) > 0 {
+		for _, e := range m.TopicRewardNonce {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
 	}
-	l = m.Int.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
-
-func (m *TopicIdDelegatorReputerDelegatorInfo) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.InfererScoresByBlock) > 0 {
+		for _, e := range m.InfererScoresByBlock {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
 	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
+	if len(m.ForecasterScoresByBlock) > 0 {
+		for _, e := range m.ForecasterScoresByBlock {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
 	}
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ReputerScoresByBlock) > 0 {
+		for _, e := range m.ReputerScoresByBlock {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
 	}
-	l = len(m.Reputer)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.LatestInfererScoresByWorker) > 0 {
+		for _, e := range m.LatestInfererScoresByWorker {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
 	}
-	if m.DelegatorInfo != nil {
-		l = m.DelegatorInfo.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.LatestForecasterScoresByWorker) > 0 {
+		for _, e := range m.LatestForecasterScoresByWorker {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
 	}
-	return n
-}
-
-func (m *BlockHeightTopicIdReputerStakeRemovalInfo) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.LatestReputerScoresByReputer) > 0 {
+		for _, e := range m.LatestReputerScoresByReputer {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	if len(m.ReputerListeningCoefficient) > 0 {
+		for _, e := range m.ReputerListeningCoefficient {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
+	if len(m.PreviousReputerRewardFraction) > 0 {
+		for _, e := range m.PreviousReputerRewardFraction {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	l = len(m.Reputer)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PreviousInferenceRewardFraction) > 0 {
+		for _, e := range m.PreviousInferenceRewardFraction {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	if m.StakeRemovalInfo != nil {
-		l = m.StakeRemovalInfo.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PreviousForecastRewardFraction) > 0 {
+		for _, e := range m.PreviousForecastRewardFraction {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	return n
-}
-
-func (m *ActorIdTopicIdBlockHeight) Size() (n int) {
-	if m == nil {
-		return 0
+	l = m.TotalStake.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.TopicStake) > 0 {
+		for _, e := range m.TopicStake {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.ActorId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.StakeReputerAuthority) > 0 {
+		for _, e := range m.StakeReputerAuthority {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
+	if len(m.StakeSumFromDelegator) > 0 {
+		for _, e := range m.StakeSumFromDelegator {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	if len(m.DelegatedStakes) > 0 {
+		for _, e := range m.DelegatedStakes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	return n
-}
-
-func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.StakeFromDelegatorsUponReputer) > 0 {
+		for _, e := range m.StakeFromDelegatorsUponReputer {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	if len(m.DelegateRewardPerShare) > 0 {
+		for _, e := range m.DelegateRewardPerShare {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
+	if len(m.StakeRemovalsByBlock) > 0 {
+		for _, e := range m.StakeRemovalsByBlock {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.StakeRemovalsByActor) > 0 {
+		for _, e := range m.StakeRemovalsByActor {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	l = len(m.Reputer)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DelegateStakeRemovalsByBlock) > 0 {
+		for _, e := range m.DelegateStakeRemovalsByBlock {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	if m.DelegateStakeRemovalInfo != nil {
-		l = m.DelegateStakeRemovalInfo.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DelegateStakeRemovalsByActor) > 0 {
+		for _, e := range m.DelegateStakeRemovalsByActor {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Inferences) > 0 {
+		for _, e := range m.Inferences {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Forecasts) > 0 {
+		for _, e := range m.Forecasts {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Workers) > 0 {
+		for _, e := range m.Workers {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Reputers) > 0 {
+		for _, e := range m.Reputers {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TopicFeeRevenue) > 0 {
+		for _, e := range m.TopicFeeRevenue {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PreviousTopicWeight) > 0 {
+		for _, e := range m.PreviousTopicWeight {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AllInferences) > 0 {
+		for _, e := range m.AllInferences {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AllForecasts) > 0 {
+		for _, e := range m.AllForecasts {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AllLossBundles) > 0 {
+		for _, e := range m.AllLossBundles {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NetworkLossBundles) > 0 {
+		for _, e := range m.NetworkLossBundles {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.PreviousPercentageRewardToStakedReputers.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.UnfulfilledWorkerNonces) > 0 {
+		for _, e := range m.UnfulfilledWorkerNonces {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnfulfilledReputerNonces) > 0 {
+		for _, e := range m.UnfulfilledReputerNonces {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LatestInfererNetworkRegrets) > 0 {
+		for _, e := range m.LatestInfererNetworkRegrets {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LatestForecasterNetworkRegrets) > 0 {
+		for _, e := range m.LatestForecasterNetworkRegrets {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LatestOneInForecasterNetworkRegrets) > 0 {
+		for _, e := range m.LatestOneInForecasterNetworkRegrets {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LatestOneInForecasterSelfNetworkRegrets) > 0 {
+		for _, e := range m.LatestOneInForecasterSelfNetworkRegrets {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TopicLastWorkerCommit) > 0 {
+		for _, e := range m.TopicLastWorkerCommit {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TopicLastReputerCommit) > 0 {
+		for _, e := range m.TopicLastReputerCommit {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TopicLastWorkerPayload) > 0 {
+		for _, e := range m.TopicLastWorkerPayload {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TopicLastReputerPayload) > 0 {
+		for _, e := range m.TopicLastReputerPayload {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TopicWorkerAllowlist) > 0 {
+		for _, e := range m.TopicWorkerAllowlist {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TopicReputerAllowlist) > 0 {
+		for _, e := range m.TopicReputerAllowlist {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ActorRoles) > 0 {
+		for _, e := range m.ActorRoles {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WorkerLastActiveHeight) > 0 {
+		for _, e := range m.WorkerLastActiveHeight {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReputerLastActiveHeight) > 0 {
+		for _, e := range m.ReputerLastActiveHeight {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TopicHorizonRewardNonce) > 0 {
+		for _, e := range m.TopicHorizonRewardNonce {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HorizonLossBundles) > 0 {
+		for _, e := range m.HorizonLossBundles {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HorizonNetworkLossBundles) > 0 {
+		for _, e := range m.HorizonNetworkLossBundles {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NetworkInferenceWeights) > 0 {
+		for _, e := range m.NetworkInferenceWeights {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardBreakdowns) > 0 {
+		for _, e := range m.RewardBreakdowns {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.InfererScoreHistory) > 0 {
+		for _, e := range m.InfererScoreHistory {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ForecasterScoreHistory) > 0 {
+		for _, e := range m.ForecasterScoreHistory {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReputerScoreHistory) > 0 {
+		for _, e := range m.ReputerScoreHistory {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardHistory) > 0 {
+		for _, e := range m.RewardHistory {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastBlockTime != 0 {
+		n += 2 + sovGenesis(uint64(m.LastBlockTime))
+	}
+	l = m.AverageBlockTime.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.CalibratedBlocksPerMonth != 0 {
+		n += 2 + sovGenesis(uint64(m.CalibratedBlocksPerMonth))
+	}
+	if len(m.TopicEpochHeights) > 0 {
+		for _, e := range m.TopicEpochHeights {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastBlocksPerMonthCalibrationHeight != 0 {
+		n += 2 + sovGenesis(uint64(m.LastBlocksPerMonthCalibrationHeight))
+	}
+	if m.LastPercentageRewardToStakedReputersHeight != 0 {
+		n += 2 + sovGenesis(uint64(m.LastPercentageRewardToStakedReputersHeight))
+	}
+	if len(m.HorizonLastReputerPayload) > 0 {
+		for _, e := range m.HorizonLastReputerPayload {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HorizonInfererScoresByBlock) > 0 {
+		for _, e := range m.HorizonInfererScoresByBlock {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HorizonForecasterScoresByBlock) > 0 {
+		for _, e := range m.HorizonForecasterScoresByBlock {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HorizonReputerScoresByBlock) > 0 {
+		for _, e := range m.HorizonReputerScoresByBlock {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *DelegatorReputerTopicIdBlockHeight) Size() (n int) {
+func (m *TopicIdAndTopic) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	l = len(m.Reputer)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
 	if m.TopicId != 0 {
 		n += 1 + sovGenesis(uint64(m.TopicId))
 	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	if m.Topic != nil {
+		l = m.Topic.Size()
+		n += 1 + l + sovGenesis(uint64(l))
 	}
 	return n
 }
 
-func (m *TopicIdActorIdInference) Size() (n int) {
+func (m *ActorIdAndRole) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
-	}
 	l = len(m.ActorId)
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
-	if m.Inference != nil {
-		l = m.Inference.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.Role != 0 {
+		n += 1 + sovGenesis(uint64(m.Role))
 	}
 	return n
 }
 
-func (m *TopicIdActorIdForecast) Size() (n int) {
+func (m *TopicAndActorId) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -4909,31 +6238,25 @@ func (m *TopicIdActorIdForecast) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
-	if m.Forecast != nil {
-		l = m.Forecast.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
 	return n
 }
 
-func (m *LibP2PKeyAndOffchainNode) Size() (n int) {
+func (m *TopicIdAndBlockHeight) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.LibP2PKey)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
 	}
-	if m.OffchainNode != nil {
-		l = m.OffchainNode.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
 	}
 	return n
 }
 
-func (m *TopicIdAndDec) Size() (n int) {
+func (m *TopicIdBlockHeightScores) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -4942,12 +6265,17 @@ func (m *TopicIdAndDec) Size() (n int) {
 	if m.TopicId != 0 {
 		n += 1 + sovGenesis(uint64(m.TopicId))
 	}
-	l = m.Dec.Size()
-	n += 1 + l + sovGenesis(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	if m.Scores != nil {
+		l = m.Scores.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
-func (m *TopicIdBlockHeightInferences) Size() (n int) {
+func (m *TopicIdActorIdScore) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -4956,17 +6284,18 @@ func (m *TopicIdBlockHeightInferences) Size() (n int) {
 	if m.TopicId != 0 {
 		n += 1 + sovGenesis(uint64(m.TopicId))
 	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	l = len(m.ActorId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
 	}
-	if m.Inferences != nil {
-		l = m.Inferences.Size()
+	if m.Score != nil {
+		l = m.Score.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
 	return n
 }
 
-func (m *TopicIdBlockHeightForecasts) Size() (n int) {
+func (m *TopicIdActorIdListeningCoefficient) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -4975,17 +6304,18 @@ func (m *TopicIdBlockHeightForecasts) Size() (n int) {
 	if m.TopicId != 0 {
 		n += 1 + sovGenesis(uint64(m.TopicId))
 	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	l = len(m.ActorId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
 	}
-	if m.Forecasts != nil {
-		l = m.Forecasts.Size()
+	if m.ListeningCoefficient != nil {
+		l = m.ListeningCoefficient.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
 	return n
 }
 
-func (m *TopicIdBlockHeightReputerValueBundles) Size() (n int) {
+func (m *TopicIdActorIdDec) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -4994,17 +6324,48 @@ func (m *TopicIdBlockHeightReputerValueBundles) Size() (n int) {
 	if m.TopicId != 0 {
 		n += 1 + sovGenesis(uint64(m.TopicId))
 	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	l = len(m.ActorId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
 	}
-	if m.ReputerValueBundles != nil {
-		l = m.ReputerValueBundles.Size()
+	l = m.Dec.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *TopicIdAndInt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	l = m.Int.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *TopicIdActorIdInt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	l = len(m.ActorId)
+	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	l = m.Int.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
-func (m *TopicIdBlockHeightValueBundles) Size() (n int) {
+func (m *TopicIdDelegatorReputerDelegatorInfo) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5013,49 +6374,114 @@ func (m *TopicIdBlockHeightValueBundles) Size() (n int) {
 	if m.TopicId != 0 {
 		n += 1 + sovGenesis(uint64(m.TopicId))
 	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.DelegatorInfo != nil {
+		l = m.DelegatorInfo.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *BlockHeightTopicIdReputerStakeRemovalInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	if m.BlockHeight != 0 {
 		n += 1 + sovGenesis(uint64(m.BlockHeight))
 	}
-	if m.ValueBundle != nil {
-		l = m.ValueBundle.Size()
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.StakeRemovalInfo != nil {
+		l = m.StakeRemovalInfo.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
 	return n
 }
 
-func (m *TopicIdAndNonces) Size() (n int) {
+func (m *ActorIdTopicIdBlockHeight) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.ActorId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	if m.TopicId != 0 {
 		n += 1 + sovGenesis(uint64(m.TopicId))
 	}
-	if m.Nonces != nil {
-		l = m.Nonces.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
 	}
 	return n
 }
 
-func (m *TopicIdAndReputerRequestNonces) Size() (n int) {
+func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
 	if m.TopicId != 0 {
 		n += 1 + sovGenesis(uint64(m.TopicId))
 	}
-	if m.ReputerRequestNonces != nil {
-		l = m.ReputerRequestNonces.Size()
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.DelegateStakeRemovalInfo != nil {
+		l = m.DelegateStakeRemovalInfo.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
 	return n
 }
 
-func (m *TopicIdActorIdTimeStampedValue) Size() (n int) {
+func (m *DelegatorReputerTopicIdBlockHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *TopicIdActorIdInference) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5068,14 +6494,14 @@ func (m *TopicIdActorIdTimeStampedValue) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
-	if m.TimestampedValue != nil {
-		l = m.TimestampedValue.Size()
+	if m.Inference != nil {
+		l = m.Inference.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
 	return n
 }
 
-func (m *TopicIdActorIdActorIdTimeStampedValue) Size() (n int) {
+func (m *TopicIdActorIdForecast) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5084,22 +6510,35 @@ func (m *TopicIdActorIdActorIdTimeStampedValue) Size() (n int) {
 	if m.TopicId != 0 {
 		n += 1 + sovGenesis(uint64(m.TopicId))
 	}
-	l = len(m.ActorId1)
+	l = len(m.ActorId)
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
-	l = len(m.ActorId2)
+	if m.Forecast != nil {
+		l = m.Forecast.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *LibP2PKeyAndOffchainNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LibP2PKey)
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
-	if m.TimestampedValue != nil {
-		l = m.TimestampedValue.Size()
+	if m.OffchainNode != nil {
+		l = m.OffchainNode.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
 	return n
 }
 
-func (m *TopicIdTimestampedActorNonce) Size() (n int) {
+func (m *TopicIdAndDec) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5108,23 +6547,330 @@ func (m *TopicIdTimestampedActorNonce) Size() (n int) {
 	if m.TopicId != 0 {
 		n += 1 + sovGenesis(uint64(m.TopicId))
 	}
-	if m.TimestampedActorNonce != nil {
-		l = m.TimestampedActorNonce.Size()
+	l = m.Dec.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *TopicIdBlockHeightInferences) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	if m.Inferences != nil {
+		l = m.Inferences.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
 	return n
 }
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *TopicIdBlockHeightForecasts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	if m.Forecasts != nil {
+		l = m.Forecasts.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
 }
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *TopicIdBlockHeightReputerValueBundles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	if m.ReputerValueBundles != nil {
+		l = m.ReputerValueBundles.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
+
+func (m *TopicIdBlockHeightValueBundles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	if m.ValueBundle != nil {
+		l = m.ValueBundle.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicIdAndNonces) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	if m.Nonces != nil {
+		l = m.Nonces.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicIdAndReputerRequestNonces) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	if m.ReputerRequestNonces != nil {
+		l = m.ReputerRequestNonces.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicIdActorIdTimeStampedValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	l = len(m.ActorId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.TimestampedValue != nil {
+		l = m.TimestampedValue.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicIdActorIdActorIdTimeStampedValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	l = len(m.ActorId1)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ActorId2)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.TimestampedValue != nil {
+		l = m.TimestampedValue.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicIdTimestampedActorNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	if m.TimestampedActorNonce != nil {
+		l = m.TimestampedActorNonce.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicIdHorizonTimestampedActorNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	if m.Horizon != 0 {
+		n += 1 + sovGenesis(uint64(m.Horizon))
+	}
+	if m.TimestampedActorNonce != nil {
+		l = m.TimestampedActorNonce.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicIdHorizonBlockHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	if m.Horizon != 0 {
+		n += 1 + sovGenesis(uint64(m.Horizon))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *TopicIdHorizonBlockHeightReputerValueBundles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	if m.Horizon != 0 {
+		n += 1 + sovGenesis(uint64(m.Horizon))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	if m.ReputerValueBundles != nil {
+		l = m.ReputerValueBundles.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicIdHorizonBlockHeightValueBundles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	if m.Horizon != 0 {
+		n += 1 + sovGenesis(uint64(m.Horizon))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	if m.ValueBundle != nil {
+		l = m.ValueBundle.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicIdHorizonBlockHeightScores) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	if m.Horizon != 0 {
+		n += 1 + sovGenesis(uint64(m.Horizon))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	if m.Scores != nil {
+		l = m.Scores.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicIdBlockHeightNetworkInferenceWeights) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	if m.Weights != nil {
+		l = m.Weights.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicIdBlockHeightRewardBreakdowns) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	if m.RewardBreakdowns != nil {
+		l = m.RewardBreakdowns.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -5152,7 +6898,1373 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoreTeamAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoreTeamAddresses = append(m.CoreTeamAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextTopicId", wireType)
+			}
+			m.NextTopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextTopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topics = append(m.Topics, &TopicIdAndTopic{})
+			if err := m.Topics[len(m.Topics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ActiveTopics = append(m.ActiveTopics, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ActiveTopics) == 0 {
+					m.ActiveTopics = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ActiveTopics = append(m.ActiveTopics, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveTopics", wireType)
+			}
+		case 6:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ChurnableTopics = append(m.ChurnableTopics, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ChurnableTopics) == 0 {
+					m.ChurnableTopics = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ChurnableTopics = append(m.ChurnableTopics, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChurnableTopics", wireType)
+			}
+		case 7:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RewardableTopics = append(m.RewardableTopics, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RewardableTopics) == 0 {
+					m.RewardableTopics = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RewardableTopics = append(m.RewardableTopics, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardableTopics", wireType)
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicWorkers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopicWorkers = append(m.TopicWorkers, &TopicAndActorId{})
+			if err := m.TopicWorkers[len(m.TopicWorkers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicReputers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopicReputers = append(m.TopicReputers, &TopicAndActorId{})
+			if err := m.TopicReputers[len(m.TopicReputers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicRewardNonce", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopicRewardNonce = append(m.TopicRewardNonce, &TopicIdAndBlockHeight{})
+			if err := m.TopicRewardNonce[len(m.TopicRewardNonce)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfererScoresByBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InfererScoresByBlock = append(m.InfererScoresByBlock, &TopicIdBlockHeightScores{})
+			if err := m.InfererScoresByBlock[len(m.InfererScoresByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForecasterScoresByBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForecasterScoresByBlock = append(m.ForecasterScoresByBlock, &TopicIdBlockHeightScores{})
+			if err := m.ForecasterScoresByBlock[len(m.ForecasterScoresByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerScoresByBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReputerScoresByBlock = append(m.ReputerScoresByBlock, &TopicIdBlockHeightScores{})
+			if err := m.ReputerScoresByBlock[len(m.ReputerScoresByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestInfererScoresByWorker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LatestInfererScoresByWorker = append(m.LatestInfererScoresByWorker, &TopicIdActorIdScore{})
+			if err := m.LatestInfererScoresByWorker[len(m.LatestInfererScoresByWorker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestForecasterScoresByWorker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LatestForecasterScoresByWorker = append(m.LatestForecasterScoresByWorker, &TopicIdActorIdScore{})
+			if err := m.LatestForecasterScoresByWorker[len(m.LatestForecasterScoresByWorker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestReputerScoresByReputer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LatestReputerScoresByReputer = append(m.LatestReputerScoresByReputer, &TopicIdActorIdScore{})
+			if err := m.LatestReputerScoresByReputer[len(m.LatestReputerScoresByReputer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerListeningCoefficient", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReputerListeningCoefficient = append(m.ReputerListeningCoefficient, &TopicIdActorIdListeningCoefficient{})
+			if err := m.ReputerListeningCoefficient[len(m.ReputerListeningCoefficient)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousReputerRewardFraction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousReputerRewardFraction = append(m.PreviousReputerRewardFraction, &TopicIdActorIdDec{})
+			if err := m.PreviousReputerRewardFraction[len(m.PreviousReputerRewardFraction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousInferenceRewardFraction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousInferenceRewardFraction = append(m.PreviousInferenceRewardFraction, &TopicIdActorIdDec{})
+			if err := m.PreviousInferenceRewardFraction[len(m.PreviousInferenceRewardFraction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousForecastRewardFraction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousForecastRewardFraction = append(m.PreviousForecastRewardFraction, &TopicIdActorIdDec{})
+			if err := m.PreviousForecastRewardFraction[len(m.PreviousForecastRewardFraction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalStake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicStake", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopicStake = append(m.TopicStake, &TopicIdAndInt{})
+			if err := m.TopicStake[len(m.TopicStake)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeReputerAuthority", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakeReputerAuthority = append(m.StakeReputerAuthority, &TopicIdActorIdInt{})
+			if err := m.StakeReputerAuthority[len(m.StakeReputerAuthority)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeSumFromDelegator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakeSumFromDelegator = append(m.StakeSumFromDelegator, &TopicIdActorIdInt{})
+			if err := m.StakeSumFromDelegator[len(m.StakeSumFromDelegator)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedStakes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatedStakes = append(m.DelegatedStakes, &TopicIdDelegatorReputerDelegatorInfo{})
+			if err := m.DelegatedStakes[len(m.DelegatedStakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeFromDelegatorsUponReputer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakeFromDelegatorsUponReputer = append(m.StakeFromDelegatorsUponReputer, &TopicIdActorIdInt{})
+			if err := m.StakeFromDelegatorsUponReputer[len(m.StakeFromDelegatorsUponReputer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateRewardPerShare", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegateRewardPerShare = append(m.DelegateRewardPerShare, &TopicIdActorIdDec{})
+			if err := m.DelegateRewardPerShare[len(m.DelegateRewardPerShare)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeRemovalsByBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakeRemovalsByBlock = append(m.StakeRemovalsByBlock, &BlockHeightTopicIdReputerStakeRemovalInfo{})
+			if err := m.StakeRemovalsByBlock[len(m.StakeRemovalsByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeRemovalsByActor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakeRemovalsByActor = append(m.StakeRemovalsByActor, &ActorIdTopicIdBlockHeight{})
+			if err := m.StakeRemovalsByActor[len(m.StakeRemovalsByActor)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateStakeRemovalsByBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegateStakeRemovalsByBlock = append(m.DelegateStakeRemovalsByBlock, &BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo{})
+			if err := m.DelegateStakeRemovalsByBlock[len(m.DelegateStakeRemovalsByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateStakeRemovalsByActor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegateStakeRemovalsByActor = append(m.DelegateStakeRemovalsByActor, &DelegatorReputerTopicIdBlockHeight{})
+			if err := m.DelegateStakeRemovalsByActor[len(m.DelegateStakeRemovalsByActor)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inferences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inferences = append(m.Inferences, &TopicIdActorIdInference{})
+			if err := m.Inferences[len(m.Inferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Forecasts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Forecasts = append(m.Forecasts, &TopicIdActorIdForecast{})
+			if err := m.Forecasts[len(m.Forecasts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Workers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Workers = append(m.Workers, &LibP2PKeyAndOffchainNode{})
+			if err := m.Workers[len(m.Workers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reputers = append(m.Reputers, &LibP2PKeyAndOffchainNode{})
+			if err := m.Reputers[len(m.Reputers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicFeeRevenue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopicFeeRevenue = append(m.TopicFeeRevenue, &TopicIdAndInt{})
+			if err := m.TopicFeeRevenue[len(m.TopicFeeRevenue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousTopicWeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousTopicWeight = append(m.PreviousTopicWeight, &TopicIdAndDec{})
+			if err := m.PreviousTopicWeight[len(m.PreviousTopicWeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllInferences", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5179,15 +8291,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AllInferences = append(m.AllInferences, &TopicIdBlockHeightInferences{})
+			if err := m.AllInferences[len(m.AllInferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 39:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoreTeamAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllForecasts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -5197,29 +8310,31 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CoreTeamAddresses = append(m.CoreTeamAddresses, string(dAtA[iNdEx:postIndex]))
+			m.AllForecasts = append(m.AllForecasts, &TopicIdBlockHeightForecasts{})
+			if err := m.AllForecasts[len(m.AllForecasts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextTopicId", wireType)
+		case 40:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllLossBundles", wireType)
 			}
-			m.NextTopicId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -5229,14 +8344,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextTopicId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllLossBundles = append(m.AllLossBundles, &TopicIdBlockHeightReputerValueBundles{})
+			if err := m.AllLossBundles[len(m.AllLossBundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 41:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkLossBundles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5263,242 +8393,218 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topics = append(m.Topics, &TopicIdAndTopic{})
-			if err := m.Topics[len(m.Topics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NetworkLossBundles = append(m.NetworkLossBundles, &TopicIdBlockHeightValueBundles{})
+			if err := m.NetworkLossBundles[len(m.NetworkLossBundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenesis
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+		case 42:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPercentageRewardToStakedReputers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
 				}
-				m.ActiveTopics = append(m.ActiveTopics, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenesis
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthGenesis
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthGenesis
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PreviousPercentageRewardToStakedReputers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 43:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnfulfilledWorkerNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.ActiveTopics) == 0 {
-					m.ActiveTopics = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenesis
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ActiveTopics = append(m.ActiveTopics, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveTopics", wireType)
 			}
-		case 6:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenesis
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnfulfilledWorkerNonces = append(m.UnfulfilledWorkerNonces, &TopicIdAndNonces{})
+			if err := m.UnfulfilledWorkerNonces[len(m.UnfulfilledWorkerNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 44:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnfulfilledReputerNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
 				}
-				m.ChurnableTopics = append(m.ChurnableTopics, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenesis
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthGenesis
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthGenesis
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnfulfilledReputerNonces = append(m.UnfulfilledReputerNonces, &TopicIdAndReputerRequestNonces{})
+			if err := m.UnfulfilledReputerNonces[len(m.UnfulfilledReputerNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 45:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestInfererNetworkRegrets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.ChurnableTopics) == 0 {
-					m.ChurnableTopics = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenesis
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ChurnableTopics = append(m.ChurnableTopics, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChurnableTopics", wireType)
 			}
-		case 7:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenesis
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LatestInfererNetworkRegrets = append(m.LatestInfererNetworkRegrets, &TopicIdActorIdTimeStampedValue{})
+			if err := m.LatestInfererNetworkRegrets[len(m.LatestInfererNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 46:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestForecasterNetworkRegrets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
 				}
-				m.RewardableTopics = append(m.RewardableTopics, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenesis
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthGenesis
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthGenesis
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LatestForecasterNetworkRegrets = append(m.LatestForecasterNetworkRegrets, &TopicIdActorIdTimeStampedValue{})
+			if err := m.LatestForecasterNetworkRegrets[len(m.LatestForecasterNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 47:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestOneInForecasterNetworkRegrets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.RewardableTopics) == 0 {
-					m.RewardableTopics = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenesis
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.RewardableTopics = append(m.RewardableTopics, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardableTopics", wireType)
 			}
-		case 8:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LatestOneInForecasterNetworkRegrets = append(m.LatestOneInForecasterNetworkRegrets, &TopicIdActorIdActorIdTimeStampedValue{})
+			if err := m.LatestOneInForecasterNetworkRegrets[len(m.LatestOneInForecasterNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 48:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicWorkers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestOneInForecasterSelfNetworkRegrets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5525,14 +8631,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicWorkers = append(m.TopicWorkers, &TopicAndActorId{})
-			if err := m.TopicWorkers[len(m.TopicWorkers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LatestOneInForecasterSelfNetworkRegrets = append(m.LatestOneInForecasterSelfNetworkRegrets, &TopicIdActorIdTimeStampedValue{})
+			if err := m.LatestOneInForecasterSelfNetworkRegrets[len(m.LatestOneInForecasterSelfNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 49:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicReputers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicLastWorkerCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5559,14 +8665,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicReputers = append(m.TopicReputers, &TopicAndActorId{})
-			if err := m.TopicReputers[len(m.TopicReputers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopicLastWorkerCommit = append(m.TopicLastWorkerCommit, &TopicIdTimestampedActorNonce{})
+			if err := m.TopicLastWorkerCommit[len(m.TopicLastWorkerCommit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 50:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicRewardNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicLastReputerCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5593,14 +8699,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicRewardNonce = append(m.TopicRewardNonce, &TopicIdAndBlockHeight{})
-			if err := m.TopicRewardNonce[len(m.TopicRewardNonce)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopicLastReputerCommit = append(m.TopicLastReputerCommit, &TopicIdTimestampedActorNonce{})
+			if err := m.TopicLastReputerCommit[len(m.TopicLastReputerCommit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 51:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InfererScoresByBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicLastWorkerPayload", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5627,14 +8733,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InfererScoresByBlock = append(m.InfererScoresByBlock, &TopicIdBlockHeightScores{})
-			if err := m.InfererScoresByBlock[len(m.InfererScoresByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopicLastWorkerPayload = append(m.TopicLastWorkerPayload, &TopicIdTimestampedActorNonce{})
+			if err := m.TopicLastWorkerPayload[len(m.TopicLastWorkerPayload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 52:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForecasterScoresByBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicLastReputerPayload", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5661,14 +8767,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForecasterScoresByBlock = append(m.ForecasterScoresByBlock, &TopicIdBlockHeightScores{})
-			if err := m.ForecasterScoresByBlock[len(m.ForecasterScoresByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopicLastReputerPayload = append(m.TopicLastReputerPayload, &TopicIdTimestampedActorNonce{})
+			if err := m.TopicLastReputerPayload[len(m.TopicLastReputerPayload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 53:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReputerScoresByBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicWorkerAllowlist", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5695,14 +8801,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReputerScoresByBlock = append(m.ReputerScoresByBlock, &TopicIdBlockHeightScores{})
-			if err := m.ReputerScoresByBlock[len(m.ReputerScoresByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopicWorkerAllowlist = append(m.TopicWorkerAllowlist, &TopicAndActorId{})
+			if err := m.TopicWorkerAllowlist[len(m.TopicWorkerAllowlist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 54:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestInfererScoresByWorker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicReputerAllowlist", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5729,14 +8835,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LatestInfererScoresByWorker = append(m.LatestInfererScoresByWorker, &TopicIdActorIdScore{})
-			if err := m.LatestInfererScoresByWorker[len(m.LatestInfererScoresByWorker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopicReputerAllowlist = append(m.TopicReputerAllowlist, &TopicAndActorId{})
+			if err := m.TopicReputerAllowlist[len(m.TopicReputerAllowlist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 55:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestForecasterScoresByWorker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorRoles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5763,14 +8869,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LatestForecasterScoresByWorker = append(m.LatestForecasterScoresByWorker, &TopicIdActorIdScore{})
-			if err := m.LatestForecasterScoresByWorker[len(m.LatestForecasterScoresByWorker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ActorRoles = append(m.ActorRoles, &ActorIdAndRole{})
+			if err := m.ActorRoles[len(m.ActorRoles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
+		case 56:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestReputerScoresByReputer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerLastActiveHeight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5797,14 +8903,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LatestReputerScoresByReputer = append(m.LatestReputerScoresByReputer, &TopicIdActorIdScore{})
-			if err := m.LatestReputerScoresByReputer[len(m.LatestReputerScoresByReputer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.WorkerLastActiveHeight = append(m.WorkerLastActiveHeight, &ActorIdTopicIdBlockHeight{})
+			if err := m.WorkerLastActiveHeight[len(m.WorkerLastActiveHeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
+		case 57:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReputerListeningCoefficient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerLastActiveHeight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5831,14 +8937,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReputerListeningCoefficient = append(m.ReputerListeningCoefficient, &TopicIdActorIdListeningCoefficient{})
-			if err := m.ReputerListeningCoefficient[len(m.ReputerListeningCoefficient)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReputerLastActiveHeight = append(m.ReputerLastActiveHeight, &ActorIdTopicIdBlockHeight{})
+			if err := m.ReputerLastActiveHeight[len(m.ReputerLastActiveHeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 18:
+		case 58:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousReputerRewardFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicHorizonRewardNonce", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5865,14 +8971,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousReputerRewardFraction = append(m.PreviousReputerRewardFraction, &TopicIdActorIdDec{})
-			if err := m.PreviousReputerRewardFraction[len(m.PreviousReputerRewardFraction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopicHorizonRewardNonce = append(m.TopicHorizonRewardNonce, &TopicIdHorizonBlockHeight{})
+			if err := m.TopicHorizonRewardNonce[len(m.TopicHorizonRewardNonce)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 19:
+		case 59:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousInferenceRewardFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HorizonLossBundles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5899,14 +9005,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousInferenceRewardFraction = append(m.PreviousInferenceRewardFraction, &TopicIdActorIdDec{})
-			if err := m.PreviousInferenceRewardFraction[len(m.PreviousInferenceRewardFraction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.HorizonLossBundles = append(m.HorizonLossBundles, &TopicIdHorizonBlockHeightReputerValueBundles{})
+			if err := m.HorizonLossBundles[len(m.HorizonLossBundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 20:
+		case 60:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousForecastRewardFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HorizonNetworkLossBundles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5933,16 +9039,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousForecastRewardFraction = append(m.PreviousForecastRewardFraction, &TopicIdActorIdDec{})
-			if err := m.PreviousForecastRewardFraction[len(m.PreviousForecastRewardFraction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.HorizonNetworkLossBundles = append(m.HorizonNetworkLossBundles, &TopicIdHorizonBlockHeightValueBundles{})
+			if err := m.HorizonNetworkLossBundles[len(m.HorizonNetworkLossBundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 21:
+		case 61:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalStake", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkInferenceWeights", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -5952,29 +9058,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NetworkInferenceWeights = append(m.NetworkInferenceWeights, &TopicIdBlockHeightNetworkInferenceWeights{})
+			if err := m.NetworkInferenceWeights[len(m.NetworkInferenceWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 22:
+		case 62:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicStake", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardBreakdowns", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6001,14 +9107,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicStake = append(m.TopicStake, &TopicIdAndInt{})
-			if err := m.TopicStake[len(m.TopicStake)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RewardBreakdowns = append(m.RewardBreakdowns, &TopicIdBlockHeightRewardBreakdowns{})
+			if err := m.RewardBreakdowns[len(m.RewardBreakdowns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 23:
+		case 63:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakeReputerAuthority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InfererScoreHistory", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6035,14 +9141,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakeReputerAuthority = append(m.StakeReputerAuthority, &TopicIdActorIdInt{})
-			if err := m.StakeReputerAuthority[len(m.StakeReputerAuthority)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.InfererScoreHistory = append(m.InfererScoreHistory, &Score{})
+			if err := m.InfererScoreHistory[len(m.InfererScoreHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 24:
+		case 64:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakeSumFromDelegator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForecasterScoreHistory", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6069,14 +9175,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakeSumFromDelegator = append(m.StakeSumFromDelegator, &TopicIdActorIdInt{})
-			if err := m.StakeSumFromDelegator[len(m.StakeSumFromDelegator)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ForecasterScoreHistory = append(m.ForecasterScoreHistory, &Score{})
+			if err := m.ForecasterScoreHistory[len(m.ForecasterScoreHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 25:
+		case 65:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedStakes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerScoreHistory", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6103,14 +9209,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatedStakes = append(m.DelegatedStakes, &TopicIdDelegatorReputerDelegatorInfo{})
-			if err := m.DelegatedStakes[len(m.DelegatedStakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReputerScoreHistory = append(m.ReputerScoreHistory, &Score{})
+			if err := m.ReputerScoreHistory[len(m.ReputerScoreHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 26:
+		case 66:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakeFromDelegatorsUponReputer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardHistory", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6137,16 +9243,35 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakeFromDelegatorsUponReputer = append(m.StakeFromDelegatorsUponReputer, &TopicIdActorIdInt{})
-			if err := m.StakeFromDelegatorsUponReputer[len(m.StakeFromDelegatorsUponReputer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RewardHistory = append(m.RewardHistory, &PaidReward{})
+			if err := m.RewardHistory[len(m.RewardHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 27:
+		case 67:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBlockTime", wireType)
+			}
+			m.LastBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastBlockTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 68:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegateRewardPerShare", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlockTime", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -6156,29 +9281,48 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegateRewardPerShare = append(m.DelegateRewardPerShare, &TopicIdActorIdDec{})
-			if err := m.DelegateRewardPerShare[len(m.DelegateRewardPerShare)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AverageBlockTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 28:
+		case 69:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CalibratedBlocksPerMonth", wireType)
+			}
+			m.CalibratedBlocksPerMonth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CalibratedBlocksPerMonth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 70:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakeRemovalsByBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicEpochHeights", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6205,16 +9349,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakeRemovalsByBlock = append(m.StakeRemovalsByBlock, &BlockHeightTopicIdReputerStakeRemovalInfo{})
-			if err := m.StakeRemovalsByBlock[len(m.StakeRemovalsByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopicEpochHeights = append(m.TopicEpochHeights, &TopicIdAndBlockHeight{})
+			if err := m.TopicEpochHeights[len(m.TopicEpochHeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 29:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakeRemovalsByActor", wireType)
+		case 71:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBlocksPerMonthCalibrationHeight", wireType)
 			}
-			var msglen int
+			m.LastBlocksPerMonthCalibrationHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -6224,29 +9368,33 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LastBlocksPerMonthCalibrationHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 72:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPercentageRewardToStakedReputersHeight", wireType)
 			}
-			m.StakeRemovalsByActor = append(m.StakeRemovalsByActor, &ActorIdTopicIdBlockHeight{})
-			if err := m.StakeRemovalsByActor[len(m.StakeRemovalsByActor)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.LastPercentageRewardToStakedReputersHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastPercentageRewardToStakedReputersHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 30:
+		case 73:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegateStakeRemovalsByBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HorizonLastReputerPayload", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6273,14 +9421,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegateStakeRemovalsByBlock = append(m.DelegateStakeRemovalsByBlock, &BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo{})
-			if err := m.DelegateStakeRemovalsByBlock[len(m.DelegateStakeRemovalsByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.HorizonLastReputerPayload = append(m.HorizonLastReputerPayload, &TopicIdHorizonTimestampedActorNonce{})
+			if err := m.HorizonLastReputerPayload[len(m.HorizonLastReputerPayload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 31:
+		case 74:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegateStakeRemovalsByActor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HorizonInfererScoresByBlock", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6307,14 +9455,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegateStakeRemovalsByActor = append(m.DelegateStakeRemovalsByActor, &DelegatorReputerTopicIdBlockHeight{})
-			if err := m.DelegateStakeRemovalsByActor[len(m.DelegateStakeRemovalsByActor)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.HorizonInfererScoresByBlock = append(m.HorizonInfererScoresByBlock, &TopicIdHorizonBlockHeightScores{})
+			if err := m.HorizonInfererScoresByBlock[len(m.HorizonInfererScoresByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 32:
+		case 75:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inferences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HorizonForecasterScoresByBlock", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6341,14 +9489,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Inferences = append(m.Inferences, &TopicIdActorIdInference{})
-			if err := m.Inferences[len(m.Inferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.HorizonForecasterScoresByBlock = append(m.HorizonForecasterScoresByBlock, &TopicIdHorizonBlockHeightScores{})
+			if err := m.HorizonForecasterScoresByBlock[len(m.HorizonForecasterScoresByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 33:
+		case 76:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Forecasts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HorizonReputerScoresByBlock", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6375,14 +9523,83 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Forecasts = append(m.Forecasts, &TopicIdActorIdForecast{})
-			if err := m.Forecasts[len(m.Forecasts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.HorizonReputerScoresByBlock = append(m.HorizonReputerScoresByBlock, &TopicIdHorizonBlockHeightScores{})
+			if err := m.HorizonReputerScoresByBlock[len(m.HorizonReputerScoresByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 34:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicIdAndTopic) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicIdAndTopic: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicIdAndTopic: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Workers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6409,16 +9626,68 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Workers = append(m.Workers, &LibP2PKeyAndOffchainNode{})
-			if err := m.Workers[len(m.Workers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Topic == nil {
+				m.Topic = &Topic{}
+			}
+			if err := m.Topic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 35:
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActorIdAndRole) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActorIdAndRole: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActorIdAndRole: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -6428,31 +9697,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reputers = append(m.Reputers, &LibP2PKeyAndOffchainNode{})
-			if err := m.Reputers[len(m.Reputers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ActorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 36:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicFeeRevenue", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			var msglen int
+			m.Role = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -6462,31 +9729,66 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Role |= Role(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicFeeRevenue = append(m.TopicFeeRevenue, &TopicIdAndInt{})
-			if err := m.TopicFeeRevenue[len(m.TopicFeeRevenue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicAndActorId) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
 			}
-			iNdEx = postIndex
-		case 37:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousTopicWeight", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicAndActorId: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicAndActorId: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -6496,31 +9798,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PreviousTopicWeight = append(m.PreviousTopicWeight, &TopicIdAndDec{})
-			if err := m.PreviousTopicWeight[len(m.PreviousTopicWeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 38:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllInferences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -6530,31 +9817,79 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllInferences = append(m.AllInferences, &TopicIdBlockHeightInferences{})
-			if err := m.AllInferences[len(m.AllInferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ActorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 39:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllForecasts", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			var msglen int
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicIdAndBlockHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicIdAndBlockHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicIdAndBlockHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -6564,31 +9899,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AllForecasts = append(m.AllForecasts, &TopicIdBlockHeightForecasts{})
-			if err := m.AllForecasts[len(m.AllForecasts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 40:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllLossBundles", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var msglen int
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -6598,31 +9918,66 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllLossBundles = append(m.AllLossBundles, &TopicIdBlockHeightReputerValueBundles{})
-			if err := m.AllLossBundles[len(m.AllLossBundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicIdBlockHeightScores) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
 			}
-			iNdEx = postIndex
-		case 41:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkLossBundles", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var msglen int
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicIdBlockHeightScores: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicIdBlockHeightScores: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -6632,31 +9987,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NetworkLossBundles = append(m.NetworkLossBundles, &TopicIdBlockHeightValueBundles{})
-			if err := m.NetworkLossBundles[len(m.NetworkLossBundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 42:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPercentageRewardToStakedReputers", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var stringLen uint64
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -6666,29 +10006,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.PreviousPercentageRewardToStakedReputers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 43:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnfulfilledWorkerNonces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scores", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6715,16 +10040,68 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnfulfilledWorkerNonces = append(m.UnfulfilledWorkerNonces, &TopicIdAndNonces{})
-			if err := m.UnfulfilledWorkerNonces[len(m.UnfulfilledWorkerNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Scores == nil {
+				m.Scores = &Scores{}
+			}
+			if err := m.Scores.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 44:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnfulfilledReputerNonces", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicIdActorIdScore) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicIdActorIdScore: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicIdActorIdScore: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -6734,31 +10111,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.UnfulfilledReputerNonces = append(m.UnfulfilledReputerNonces, &TopicIdAndReputerRequestNonces{})
-			if err := m.UnfulfilledReputerNonces[len(m.UnfulfilledReputerNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 45:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestInfererNetworkRegrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -6768,29 +10130,27 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LatestInfererNetworkRegrets = append(m.LatestInfererNetworkRegrets, &TopicIdActorIdTimeStampedValue{})
-			if err := m.LatestInfererNetworkRegrets[len(m.LatestInfererNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ActorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 46:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestForecasterNetworkRegrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6817,16 +10177,68 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LatestForecasterNetworkRegrets = append(m.LatestForecasterNetworkRegrets, &TopicIdActorIdTimeStampedValue{})
-			if err := m.LatestForecasterNetworkRegrets[len(m.LatestForecasterNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Score == nil {
+				m.Score = &Score{}
+			}
+			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 47:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestOneInForecasterNetworkRegrets", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicIdActorIdListeningCoefficient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicIdActorIdListeningCoefficient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicIdActorIdListeningCoefficient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -6836,31 +10248,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LatestOneInForecasterNetworkRegrets = append(m.LatestOneInForecasterNetworkRegrets, &TopicIdActorIdActorIdTimeStampedValue{})
-			if err := m.LatestOneInForecasterNetworkRegrets[len(m.LatestOneInForecasterNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 48:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestOneInForecasterSelfNetworkRegrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -6870,29 +10267,27 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LatestOneInForecasterSelfNetworkRegrets = append(m.LatestOneInForecasterSelfNetworkRegrets, &TopicIdActorIdTimeStampedValue{})
-			if err := m.LatestOneInForecasterSelfNetworkRegrets[len(m.LatestOneInForecasterSelfNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ActorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 49:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicLastWorkerCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListeningCoefficient", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6919,16 +10314,68 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicLastWorkerCommit = append(m.TopicLastWorkerCommit, &TopicIdTimestampedActorNonce{})
-			if err := m.TopicLastWorkerCommit[len(m.TopicLastWorkerCommit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ListeningCoefficient == nil {
+				m.ListeningCoefficient = &ListeningCoefficient{}
+			}
+			if err := m.ListeningCoefficient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 50:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicLastReputerCommit", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicIdActorIdDec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicIdActorIdDec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicIdActorIdDec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -6938,31 +10385,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TopicLastReputerCommit = append(m.TopicLastReputerCommit, &TopicIdTimestampedActorNonce{})
-			if err := m.TopicLastReputerCommit[len(m.TopicLastReputerCommit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 51:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicLastWorkerPayload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -6972,31 +10404,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicLastWorkerPayload = append(m.TopicLastWorkerPayload, &TopicIdTimestampedActorNonce{})
-			if err := m.TopicLastWorkerPayload[len(m.TopicLastWorkerPayload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ActorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 52:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicLastReputerPayload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dec", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -7006,23 +10436,23 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicLastReputerPayload = append(m.TopicLastReputerPayload, &TopicIdTimestampedActorNonce{})
-			if err := m.TopicLastReputerPayload[len(m.TopicLastReputerPayload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Dec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7047,7 +10477,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdAndTopic) Unmarshal(dAtA []byte) error {
+func (m *TopicIdAndInt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7070,10 +10500,10 @@ func (m *TopicIdAndTopic) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdAndTopic: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdAndInt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdAndTopic: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdAndInt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7097,9 +10527,9 @@ func (m *TopicIdAndTopic) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Int", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -7109,25 +10539,23 @@ func (m *TopicIdAndTopic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Topic == nil {
-				m.Topic = &Topic{}
-			}
-			if err := m.Topic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Int.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7152,7 +10580,7 @@ func (m *TopicIdAndTopic) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicAndActorId) Unmarshal(dAtA []byte) error {
+func (m *TopicIdActorIdInt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7175,10 +10603,10 @@ func (m *TopicAndActorId) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicAndActorId: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdActorIdInt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicAndActorId: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdActorIdInt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7232,6 +10660,40 @@ func (m *TopicAndActorId) Unmarshal(dAtA []byte) error {
 			}
 			m.ActorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Int.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -7253,7 +10715,7 @@ func (m *TopicAndActorId) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdAndBlockHeight) Unmarshal(dAtA []byte) error {
+func (m *TopicIdDelegatorReputerDelegatorInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7276,10 +10738,10 @@ func (m *TopicIdAndBlockHeight) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdAndBlockHeight: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdDelegatorReputerDelegatorInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdAndBlockHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdDelegatorReputerDelegatorInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7301,11 +10763,75 @@ func (m *TopicIdAndBlockHeight) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.BlockHeight = 0
+			m.Reputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorInfo", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -7315,11 +10841,28 @@ func (m *TopicIdAndBlockHeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DelegatorInfo == nil {
+				m.DelegatorInfo = &DelegatorInfo{}
+			}
+			if err := m.DelegatorInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -7341,7 +10884,7 @@ func (m *TopicIdAndBlockHeight) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdBlockHeightScores) Unmarshal(dAtA []byte) error {
+func (m *BlockHeightTopicIdReputerStakeRemovalInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7364,17 +10907,17 @@ func (m *TopicIdBlockHeightScores) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdBlockHeightScores: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlockHeightTopicIdReputerStakeRemovalInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdBlockHeightScores: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlockHeightTopicIdReputerStakeRemovalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.TopicId = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -7384,16 +10927,16 @@ func (m *TopicIdBlockHeightScores) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			m.BlockHeight = 0
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -7403,14 +10946,46 @@ func (m *TopicIdBlockHeightScores) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scores", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeRemovalInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7437,10 +11012,10 @@ func (m *TopicIdBlockHeightScores) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Scores == nil {
-				m.Scores = &Scores{}
+			if m.StakeRemovalInfo == nil {
+				m.StakeRemovalInfo = &StakeRemovalInfo{}
 			}
-			if err := m.Scores.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StakeRemovalInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7465,7 +11040,7 @@ func (m *TopicIdBlockHeightScores) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdActorIdScore) Unmarshal(dAtA []byte) error {
+func (m *ActorIdTopicIdBlockHeight) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7488,32 +11063,13 @@ func (m *TopicIdActorIdScore) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdActorIdScore: wiretype end group for non-group")
+			return fmt.Errorf("proto: ActorIdTopicIdBlockHeight: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdActorIdScore: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ActorIdTopicIdBlockHeight: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
-			}
-			m.TopicId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
 			}
@@ -7545,11 +11101,11 @@ func (m *TopicIdActorIdScore) Unmarshal(dAtA []byte) error {
 			}
 			m.ActorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			var msglen int
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -7559,28 +11115,30 @@ func (m *TopicIdActorIdScore) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Score == nil {
-				m.Score = &Score{}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -7602,7 +11160,7 @@ func (m *TopicIdActorIdScore) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdActorIdListeningCoefficient) Unmarshal(dAtA []byte) error {
+func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7625,13 +11183,32 @@ func (m *TopicIdActorIdListeningCoefficient) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdActorIdListeningCoefficient: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdActorIdListeningCoefficient: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
@@ -7650,9 +11227,9 @@ func (m *TopicIdActorIdListeningCoefficient) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7680,11 +11257,43 @@ func (m *TopicIdActorIdListeningCoefficient) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActorId = string(dAtA[iNdEx:postIndex])
+			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListeningCoefficient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateStakeRemovalInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7711,10 +11320,10 @@ func (m *TopicIdActorIdListeningCoefficient) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ListeningCoefficient == nil {
-				m.ListeningCoefficient = &ListeningCoefficient{}
+			if m.DelegateStakeRemovalInfo == nil {
+				m.DelegateStakeRemovalInfo = &DelegateStakeRemovalInfo{}
 			}
-			if err := m.ListeningCoefficient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DelegateStakeRemovalInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7739,7 +11348,7 @@ func (m *TopicIdActorIdListeningCoefficient) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdActorIdDec) Unmarshal(dAtA []byte) error {
+func (m *DelegatorReputerTopicIdBlockHeight) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7762,17 +11371,17 @@ func (m *TopicIdActorIdDec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdActorIdDec: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegatorReputerTopicIdBlockHeight: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdActorIdDec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegatorReputerTopicIdBlockHeight: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
-			m.TopicId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -7782,14 +11391,27 @@ func (m *TopicIdActorIdDec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7817,13 +11439,13 @@ func (m *TopicIdActorIdDec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActorId = string(dAtA[iNdEx:postIndex])
+			m.Reputer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dec", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			var stringLen uint64
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -7833,26 +11455,30 @@ func (m *TopicIdActorIdDec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			if err := m.Dec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -7874,7 +11500,7 @@ func (m *TopicIdActorIdDec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdAndInt) Unmarshal(dAtA []byte) error {
+func (m *TopicIdActorIdInference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7897,10 +11523,10 @@ func (m *TopicIdAndInt) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdAndInt: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdActorIdInference: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdAndInt: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdActorIdInference: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7924,7 +11550,7 @@ func (m *TopicIdAndInt) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Int", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7952,7 +11578,41 @@ func (m *TopicIdAndInt) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Int.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ActorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inference", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Inference == nil {
+				m.Inference = &Inference{}
+			}
+			if err := m.Inference.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7977,7 +11637,7 @@ func (m *TopicIdAndInt) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdActorIdInt) Unmarshal(dAtA []byte) error {
+func (m *TopicIdActorIdForecast) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8000,10 +11660,10 @@ func (m *TopicIdActorIdInt) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdActorIdInt: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdActorIdForecast: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdActorIdInt: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdActorIdForecast: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8059,9 +11719,9 @@ func (m *TopicIdActorIdInt) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Int", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Forecast", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -8071,23 +11731,25 @@ func (m *TopicIdActorIdInt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Int.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Forecast == nil {
+				m.Forecast = &Forecast{}
+			}
+			if err := m.Forecast.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8112,7 +11774,7 @@ func (m *TopicIdActorIdInt) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdDelegatorReputerDelegatorInfo) Unmarshal(dAtA []byte) error {
+func (m *LibP2PKeyAndOffchainNode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8135,66 +11797,15 @@ func (m *TopicIdDelegatorReputerDelegatorInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdDelegatorReputerDelegatorInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: LibP2pKeyAndOffchainNode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdDelegatorReputerDelegatorInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LibP2pKeyAndOffchainNode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
-			}
-			m.TopicId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Delegator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LibP2PKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8222,11 +11833,11 @@ func (m *TopicIdDelegatorReputerDelegatorInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reputer = string(dAtA[iNdEx:postIndex])
+			m.LibP2PKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OffchainNode", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8253,10 +11864,10 @@ func (m *TopicIdDelegatorReputerDelegatorInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DelegatorInfo == nil {
-				m.DelegatorInfo = &DelegatorInfo{}
+			if m.OffchainNode == nil {
+				m.OffchainNode = &OffchainNode{}
 			}
-			if err := m.DelegatorInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OffchainNode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8281,7 +11892,7 @@ func (m *TopicIdDelegatorReputerDelegatorInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockHeightTopicIdReputerStakeRemovalInfo) Unmarshal(dAtA []byte) error {
+func (m *TopicIdAndDec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8304,32 +11915,13 @@ func (m *BlockHeightTopicIdReputerStakeRemovalInfo) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockHeightTopicIdReputerStakeRemovalInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdAndDec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockHeightTopicIdReputerStakeRemovalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdAndDec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
-			}
-			m.BlockHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
@@ -8348,9 +11940,9 @@ func (m *BlockHeightTopicIdReputerStakeRemovalInfo) Unmarshal(dAtA []byte) error
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dec", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8378,41 +11970,7 @@ func (m *BlockHeightTopicIdReputerStakeRemovalInfo) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reputer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakeRemovalInfo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.StakeRemovalInfo == nil {
-				m.StakeRemovalInfo = &StakeRemovalInfo{}
-			}
-			if err := m.StakeRemovalInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Dec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8437,7 +11995,7 @@ func (m *BlockHeightTopicIdReputerStakeRemovalInfo) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *ActorIdTopicIdBlockHeight) Unmarshal(dAtA []byte) error {
+func (m *TopicIdBlockHeightInferences) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8460,17 +12018,17 @@ func (m *ActorIdTopicIdBlockHeight) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActorIdTopicIdBlockHeight: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdBlockHeightInferences: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActorIdTopicIdBlockHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdBlockHeightInferences: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			var stringLen uint64
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -8480,29 +12038,16 @@ func (m *ActorIdTopicIdBlockHeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ActorId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.TopicId = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -8512,16 +12057,16 @@ func (m *ActorIdTopicIdBlockHeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inferences", wireType)
 			}
-			m.BlockHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -8531,11 +12076,28 @@ func (m *ActorIdTopicIdBlockHeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Inferences == nil {
+				m.Inferences = &Inferences{}
+			}
+			if err := m.Inferences.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -8557,7 +12119,7 @@ func (m *ActorIdTopicIdBlockHeight) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) Unmarshal(dAtA []byte) error {
+func (m *TopicIdBlockHeightForecasts) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8580,32 +12142,13 @@ func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) Unmarshal(d
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdBlockHeightForecasts: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdBlockHeightForecasts: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
-			}
-			m.BlockHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
@@ -8624,43 +12167,11 @@ func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) Unmarshal(d
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Delegator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var stringLen uint64
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -8670,27 +12181,14 @@ func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) Unmarshal(d
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reputer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegateStakeRemovalInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Forecasts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8717,10 +12215,10 @@ func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) Unmarshal(d
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DelegateStakeRemovalInfo == nil {
-				m.DelegateStakeRemovalInfo = &DelegateStakeRemovalInfo{}
+			if m.Forecasts == nil {
+				m.Forecasts = &Forecasts{}
 			}
-			if err := m.DelegateStakeRemovalInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Forecasts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8745,7 +12243,7 @@ func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) Unmarshal(d
 	}
 	return nil
 }
-func (m *DelegatorReputerTopicIdBlockHeight) Unmarshal(dAtA []byte) error {
+func (m *TopicIdBlockHeightReputerValueBundles) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8768,17 +12266,17 @@ func (m *DelegatorReputerTopicIdBlockHeight) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegatorReputerTopicIdBlockHeight: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdBlockHeightReputerValueBundles: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegatorReputerTopicIdBlockHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdBlockHeightReputerValueBundles: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			var stringLen uint64
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -8788,29 +12286,16 @@ func (m *DelegatorReputerTopicIdBlockHeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Delegator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var stringLen uint64
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -8820,29 +12305,16 @@ func (m *DelegatorReputerTopicIdBlockHeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reputer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerValueBundles", wireType)
 			}
-			m.TopicId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -8852,30 +12324,28 @@ func (m *DelegatorReputerTopicIdBlockHeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.BlockHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReputerValueBundles == nil {
+				m.ReputerValueBundles = &ReputerValueBundles{}
+			}
+			if err := m.ReputerValueBundles.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -8897,7 +12367,7 @@ func (m *DelegatorReputerTopicIdBlockHeight) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdActorIdInference) Unmarshal(dAtA []byte) error {
+func (m *TopicIdBlockHeightValueBundles) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8920,10 +12390,10 @@ func (m *TopicIdActorIdInference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdActorIdInference: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdBlockHeightValueBundles: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdActorIdInference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdBlockHeightValueBundles: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8946,40 +12416,27 @@ func (m *TopicIdActorIdInference) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var stringLen uint64
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
 				}
 				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ActorId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inference", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueBundle", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9006,10 +12463,10 @@ func (m *TopicIdActorIdInference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Inference == nil {
-				m.Inference = &Inference{}
+			if m.ValueBundle == nil {
+				m.ValueBundle = &ValueBundle{}
 			}
-			if err := m.Inference.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ValueBundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9034,7 +12491,7 @@ func (m *TopicIdActorIdInference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdActorIdForecast) Unmarshal(dAtA []byte) error {
+func (m *TopicIdAndNonces) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9057,10 +12514,10 @@ func (m *TopicIdActorIdForecast) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdActorIdForecast: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdAndNonces: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdActorIdForecast: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdAndNonces: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9084,39 +12541,7 @@ func (m *TopicIdActorIdForecast) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ActorId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Forecast", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonces", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9143,10 +12568,10 @@ func (m *TopicIdActorIdForecast) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Forecast == nil {
-				m.Forecast = &Forecast{}
+			if m.Nonces == nil {
+				m.Nonces = &Nonces{}
 			}
-			if err := m.Forecast.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Nonces.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9171,7 +12596,7 @@ func (m *TopicIdActorIdForecast) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LibP2PKeyAndOffchainNode) Unmarshal(dAtA []byte) error {
+func (m *TopicIdAndReputerRequestNonces) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9194,17 +12619,17 @@ func (m *LibP2PKeyAndOffchainNode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LibP2pKeyAndOffchainNode: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdAndReputerRequestNonces: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LibP2pKeyAndOffchainNode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdAndReputerRequestNonces: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LibP2PKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			var stringLen uint64
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -9214,27 +12639,14 @@ func (m *LibP2PKeyAndOffchainNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LibP2PKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OffchainNode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerRequestNonces", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9261,10 +12673,10 @@ func (m *LibP2PKeyAndOffchainNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OffchainNode == nil {
-				m.OffchainNode = &OffchainNode{}
+			if m.ReputerRequestNonces == nil {
+				m.ReputerRequestNonces = &ReputerRequestNonces{}
 			}
-			if err := m.OffchainNode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ReputerRequestNonces.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9289,7 +12701,7 @@ func (m *LibP2PKeyAndOffchainNode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdAndDec) Unmarshal(dAtA []byte) error {
+func (m *TopicIdActorIdTimeStampedValue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9312,10 +12724,10 @@ func (m *TopicIdAndDec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdAndDec: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdActorIdTimeStampedValue: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdAndDec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdActorIdTimeStampedValue: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9339,7 +12751,7 @@ func (m *TopicIdAndDec) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9367,7 +12779,41 @@ func (m *TopicIdAndDec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Dec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ActorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampedValue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TimestampedValue == nil {
+				m.TimestampedValue = &TimestampedValue{}
+			}
+			if err := m.TimestampedValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9392,7 +12838,7 @@ func (m *TopicIdAndDec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdBlockHeightInferences) Unmarshal(dAtA []byte) error {
+func (m *TopicIdActorIdActorIdTimeStampedValue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9415,10 +12861,10 @@ func (m *TopicIdBlockHeightInferences) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdBlockHeightInferences: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdActorIdActorIdTimeStampedValue: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdBlockHeightInferences: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdActorIdActorIdTimeStampedValue: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9441,10 +12887,10 @@ func (m *TopicIdBlockHeightInferences) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId1", wireType)
 			}
-			m.BlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -9454,14 +12900,59 @@ func (m *TopicIdBlockHeightInferences) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActorId1 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inferences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId2", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActorId2 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampedValue", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9488,10 +12979,10 @@ func (m *TopicIdBlockHeightInferences) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Inferences == nil {
-				m.Inferences = &Inferences{}
+			if m.TimestampedValue == nil {
+				m.TimestampedValue = &TimestampedValue{}
 			}
-			if err := m.Inferences.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TimestampedValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9516,7 +13007,7 @@ func (m *TopicIdBlockHeightInferences) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdBlockHeightForecasts) Unmarshal(dAtA []byte) error {
+func (m *TopicIdTimestampedActorNonce) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9539,10 +13030,10 @@ func (m *TopicIdBlockHeightForecasts) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdBlockHeightForecasts: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdTimestampedActorNonce: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdBlockHeightForecasts: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdTimestampedActorNonce: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9565,27 +13056,8 @@ func (m *TopicIdBlockHeightForecasts) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
-			}
-			m.BlockHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Forecasts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampedActorNonce", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9612,10 +13084,10 @@ func (m *TopicIdBlockHeightForecasts) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Forecasts == nil {
-				m.Forecasts = &Forecasts{}
+			if m.TimestampedActorNonce == nil {
+				m.TimestampedActorNonce = &TimestampedActorNonce{}
 			}
-			if err := m.Forecasts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TimestampedActorNonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9640,7 +13112,7 @@ func (m *TopicIdBlockHeightForecasts) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdBlockHeightReputerValueBundles) Unmarshal(dAtA []byte) error {
+func (m *TopicIdHorizonTimestampedActorNonce) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9663,10 +13135,10 @@ func (m *TopicIdBlockHeightReputerValueBundles) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdBlockHeightReputerValueBundles: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdHorizonTimestampedActorNonce: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdBlockHeightReputerValueBundles: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdHorizonTimestampedActorNonce: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9690,9 +13162,9 @@ func (m *TopicIdBlockHeightReputerValueBundles) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Horizon", wireType)
 			}
-			m.BlockHeight = 0
+			m.Horizon = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -9702,14 +13174,14 @@ func (m *TopicIdBlockHeightReputerValueBundles) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
+				m.Horizon |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReputerValueBundles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampedActorNonce", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9736,10 +13208,10 @@ func (m *TopicIdBlockHeightReputerValueBundles) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ReputerValueBundles == nil {
-				m.ReputerValueBundles = &ReputerValueBundles{}
+			if m.TimestampedActorNonce == nil {
+				m.TimestampedActorNonce = &TimestampedActorNonce{}
 			}
-			if err := m.ReputerValueBundles.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TimestampedActorNonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9764,7 +13236,7 @@ func (m *TopicIdBlockHeightReputerValueBundles) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdBlockHeightValueBundles) Unmarshal(dAtA []byte) error {
+func (m *TopicIdHorizonBlockHeight) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9787,10 +13259,10 @@ func (m *TopicIdBlockHeightValueBundles) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdBlockHeightValueBundles: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdHorizonBlockHeight: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdBlockHeightValueBundles: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdHorizonBlockHeight: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9814,9 +13286,9 @@ func (m *TopicIdBlockHeightValueBundles) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Horizon", wireType)
 			}
-			m.BlockHeight = 0
+			m.Horizon = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -9826,16 +13298,16 @@ func (m *TopicIdBlockHeightValueBundles) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
+				m.Horizon |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueBundle", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var msglen int
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -9845,28 +13317,11 @@ func (m *TopicIdBlockHeightValueBundles) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ValueBundle == nil {
-				m.ValueBundle = &ValueBundle{}
-			}
-			if err := m.ValueBundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -9888,7 +13343,7 @@ func (m *TopicIdBlockHeightValueBundles) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdAndNonces) Unmarshal(dAtA []byte) error {
+func (m *TopicIdHorizonBlockHeightReputerValueBundles) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9911,10 +13366,10 @@ func (m *TopicIdAndNonces) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdAndNonces: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdHorizonBlockHeightReputerValueBundles: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdAndNonces: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdHorizonBlockHeightReputerValueBundles: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9937,8 +13392,46 @@ func (m *TopicIdAndNonces) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Horizon", wireType)
+			}
+			m.Horizon = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Horizon |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerValueBundles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9965,10 +13458,10 @@ func (m *TopicIdAndNonces) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Nonces == nil {
-				m.Nonces = &Nonces{}
+			if m.ReputerValueBundles == nil {
+				m.ReputerValueBundles = &ReputerValueBundles{}
 			}
-			if err := m.Nonces.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ReputerValueBundles.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9993,7 +13486,7 @@ func (m *TopicIdAndNonces) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdAndReputerRequestNonces) Unmarshal(dAtA []byte) error {
+func (m *TopicIdHorizonBlockHeightValueBundles) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10016,10 +13509,10 @@ func (m *TopicIdAndReputerRequestNonces) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdAndReputerRequestNonces: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdHorizonBlockHeightValueBundles: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdAndReputerRequestNonces: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdHorizonBlockHeightValueBundles: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10042,8 +13535,46 @@ func (m *TopicIdAndReputerRequestNonces) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Horizon", wireType)
+			}
+			m.Horizon = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Horizon |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReputerRequestNonces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueBundle", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10070,10 +13601,10 @@ func (m *TopicIdAndReputerRequestNonces) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ReputerRequestNonces == nil {
-				m.ReputerRequestNonces = &ReputerRequestNonces{}
+			if m.ValueBundle == nil {
+				m.ValueBundle = &ValueBundle{}
 			}
-			if err := m.ReputerRequestNonces.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ValueBundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10098,7 +13629,7 @@ func (m *TopicIdAndReputerRequestNonces) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdActorIdTimeStampedValue) Unmarshal(dAtA []byte) error {
+func (m *TopicIdHorizonBlockHeightScores) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10121,10 +13652,10 @@ func (m *TopicIdActorIdTimeStampedValue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdActorIdTimeStampedValue: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdHorizonBlockHeightScores: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdActorIdTimeStampedValue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdHorizonBlockHeightScores: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10147,10 +13678,10 @@ func (m *TopicIdActorIdTimeStampedValue) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Horizon", wireType)
 			}
-			var stringLen uint64
+			m.Horizon = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -10160,27 +13691,33 @@ func (m *TopicIdActorIdTimeStampedValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Horizon |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ActorId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimestampedValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scores", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10207,10 +13744,10 @@ func (m *TopicIdActorIdTimeStampedValue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TimestampedValue == nil {
-				m.TimestampedValue = &TimestampedValue{}
+			if m.Scores == nil {
+				m.Scores = &Scores{}
 			}
-			if err := m.TimestampedValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Scores.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10235,7 +13772,7 @@ func (m *TopicIdActorIdTimeStampedValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdActorIdActorIdTimeStampedValue) Unmarshal(dAtA []byte) error {
+func (m *TopicIdBlockHeightNetworkInferenceWeights) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10258,10 +13795,10 @@ func (m *TopicIdActorIdActorIdTimeStampedValue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdActorIdActorIdTimeStampedValue: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdBlockHeightNetworkInferenceWeights: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdActorIdActorIdTimeStampedValue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdBlockHeightNetworkInferenceWeights: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10284,10 +13821,10 @@ func (m *TopicIdActorIdActorIdTimeStampedValue) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActorId1", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var stringLen uint64
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -10297,59 +13834,14 @@ func (m *TopicIdActorIdActorIdTimeStampedValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ActorId1 = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActorId2", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ActorId2 = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimestampedValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weights", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10376,10 +13868,10 @@ func (m *TopicIdActorIdActorIdTimeStampedValue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TimestampedValue == nil {
-				m.TimestampedValue = &TimestampedValue{}
+			if m.Weights == nil {
+				m.Weights = &NetworkInferenceWeightsAtBlock{}
 			}
-			if err := m.TimestampedValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Weights.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10404,7 +13896,7 @@ func (m *TopicIdActorIdActorIdTimeStampedValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdTimestampedActorNonce) Unmarshal(dAtA []byte) error {
+func (m *TopicIdBlockHeightRewardBreakdowns) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10427,10 +13919,10 @@ func (m *TopicIdTimestampedActorNonce) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdTimestampedActorNonce: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdBlockHeightRewardBreakdowns: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdTimestampedActorNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdBlockHeightRewardBreakdowns: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10453,8 +13945,27 @@ func (m *TopicIdTimestampedActorNonce) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimestampedActorNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardBreakdowns", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10481,10 +13992,10 @@ func (m *TopicIdTimestampedActorNonce) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TimestampedActorNonce == nil {
-				m.TimestampedActorNonce = &TimestampedActorNonce{}
+			if m.RewardBreakdowns == nil {
+				m.RewardBreakdowns = &RewardBreakdowns{}
 			}
-			if err := m.TimestampedActorNonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RewardBreakdowns.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
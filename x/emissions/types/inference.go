@@ -13,6 +13,11 @@ func (inference *Inference) Validate() error {
 	if inference.Value.IsNaN() {
 		return errors.Wrap(sdkerrors.ErrInvalidRequest, "inference value cannot be NaN")
 	}
+	for _, value := range inference.Values {
+		if value.IsNaN() {
+			return errors.Wrap(sdkerrors.ErrInvalidRequest, "inference value cannot be NaN")
+		}
+	}
 	_, err := sdk.AccAddressFromBech32(inference.Inferer)
 	if err != nil {
 		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid inferer address (%s)", err)
@@ -23,3 +28,16 @@ func (inference *Inference) Validate() error {
 
 	return nil
 }
+
+// Inferences made for the given horizon of the topic
+func (inferences *Inferences) AtHorizon(horizon Horizon) *Inferences {
+	filtered := &Inferences{
+		Inferences: make([]*Inference, 0, len(inferences.Inferences)),
+	}
+	for _, inference := range inferences.Inferences {
+		if inference.Horizon == horizon {
+			filtered.Inferences = append(filtered.Inferences, inference)
+		}
+	}
+	return filtered
+}
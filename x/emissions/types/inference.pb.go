@@ -69,30 +69,175 @@ func (m *RegretInformedWeight) GetWorker() string {
 	return ""
 }
 
+// Regret-informed weights that the network inference of a horizon of a topic was combined with
+type NetworkInferenceWeights struct {
+	TopicId                   uint64                   `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight               int64                    `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Horizon                   uint32                   `protobuf:"varint,3,opt,name=horizon,proto3" json:"horizon,omitempty"`
+	InfererWeights            []*RegretInformedWeight  `protobuf:"bytes,4,rep,name=inferer_weights,json=infererWeights,proto3" json:"inferer_weights,omitempty"`
+	ForecasterWeights         []*RegretInformedWeight  `protobuf:"bytes,5,rep,name=forecaster_weights,json=forecasterWeights,proto3" json:"forecaster_weights,omitempty"`
+	ForecastImpliedInferences []*WorkerAttributedValue `protobuf:"bytes,6,rep,name=forecast_implied_inferences,json=forecastImpliedInferences,proto3" json:"forecast_implied_inferences,omitempty"`
+}
+
+func (m *NetworkInferenceWeights) Reset()         { *m = NetworkInferenceWeights{} }
+func (m *NetworkInferenceWeights) String() string { return proto.CompactTextString(m) }
+func (*NetworkInferenceWeights) ProtoMessage()    {}
+func (*NetworkInferenceWeights) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c7dbaa0382cbd33, []int{1}
+}
+func (m *NetworkInferenceWeights) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NetworkInferenceWeights) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NetworkInferenceWeights.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NetworkInferenceWeights) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetworkInferenceWeights.Merge(m, src)
+}
+func (m *NetworkInferenceWeights) XXX_Size() int {
+	return m.Size()
+}
+func (m *NetworkInferenceWeights) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetworkInferenceWeights.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetworkInferenceWeights proto.InternalMessageInfo
+
+func (m *NetworkInferenceWeights) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *NetworkInferenceWeights) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *NetworkInferenceWeights) GetHorizon() uint32 {
+	if m != nil {
+		return m.Horizon
+	}
+	return 0
+}
+
+func (m *NetworkInferenceWeights) GetInfererWeights() []*RegretInformedWeight {
+	if m != nil {
+		return m.InfererWeights
+	}
+	return nil
+}
+
+func (m *NetworkInferenceWeights) GetForecasterWeights() []*RegretInformedWeight {
+	if m != nil {
+		return m.ForecasterWeights
+	}
+	return nil
+}
+
+func (m *NetworkInferenceWeights) GetForecastImpliedInferences() []*WorkerAttributedValue {
+	if m != nil {
+		return m.ForecastImpliedInferences
+	}
+	return nil
+}
+
+// Weights of the network inferences of every horizon of a topic at a block
+type NetworkInferenceWeightsAtBlock struct {
+	Horizons []*NetworkInferenceWeights `protobuf:"bytes,1,rep,name=horizons,proto3" json:"horizons,omitempty"`
+}
+
+func (m *NetworkInferenceWeightsAtBlock) Reset()         { *m = NetworkInferenceWeightsAtBlock{} }
+func (m *NetworkInferenceWeightsAtBlock) String() string { return proto.CompactTextString(m) }
+func (*NetworkInferenceWeightsAtBlock) ProtoMessage()    {}
+func (*NetworkInferenceWeightsAtBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c7dbaa0382cbd33, []int{2}
+}
+func (m *NetworkInferenceWeightsAtBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NetworkInferenceWeightsAtBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NetworkInferenceWeightsAtBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NetworkInferenceWeightsAtBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetworkInferenceWeightsAtBlock.Merge(m, src)
+}
+func (m *NetworkInferenceWeightsAtBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *NetworkInferenceWeightsAtBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetworkInferenceWeightsAtBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetworkInferenceWeightsAtBlock proto.InternalMessageInfo
+
+func (m *NetworkInferenceWeightsAtBlock) GetHorizons() []*NetworkInferenceWeights {
+	if m != nil {
+		return m.Horizons
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*RegretInformedWeight)(nil), "emissions.v1.RegretInformedWeight")
+	proto.RegisterType((*NetworkInferenceWeights)(nil), "emissions.v1.NetworkInferenceWeights")
+	proto.RegisterType((*NetworkInferenceWeightsAtBlock)(nil), "emissions.v1.NetworkInferenceWeightsAtBlock")
 }
 
 func init() { proto.RegisterFile("emissions/v1/inference.proto", fileDescriptor_2c7dbaa0382cbd33) }
 
 var fileDescriptor_2c7dbaa0382cbd33 = []byte{
-	// 242 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0xcd, 0xcd, 0x2c,
-	0x2e, 0xce, 0xcc, 0xcf, 0x2b, 0xd6, 0x2f, 0x33, 0xd4, 0xcf, 0xcc, 0x4b, 0x4b, 0x2d, 0x4a, 0xcd,
-	0x4b, 0x4e, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x81, 0xcb, 0xea, 0x95, 0x19, 0x4a,
-	0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x25, 0xf4, 0x41, 0x2c, 0x88, 0x1a, 0x29, 0x09, 0x14, 0x13,
-	0xf2, 0xf2, 0xe1, 0xba, 0x95, 0x5a, 0x19, 0xb9, 0x44, 0x82, 0x52, 0xd3, 0x8b, 0x52, 0x4b, 0x3c,
-	0xf3, 0xd2, 0xf2, 0x8b, 0x72, 0x53, 0x53, 0xc2, 0x53, 0x33, 0xd3, 0x33, 0x4a, 0x84, 0xc4, 0xb8,
-	0xd8, 0xca, 0xf3, 0x8b, 0xb2, 0x53, 0x8b, 0x24, 0x18, 0x15, 0x18, 0x35, 0x38, 0x83, 0xa0, 0x3c,
-	0x21, 0x7f, 0x2e, 0xb6, 0x72, 0xb0, 0x0a, 0x09, 0x26, 0x90, 0xb8, 0x93, 0xf9, 0x89, 0x7b, 0xf2,
-	0x0c, 0xb7, 0xee, 0xc9, 0xeb, 0xa7, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea,
-	0x27, 0xe6, 0xe4, 0xe4, 0x17, 0x25, 0xea, 0xe6, 0xa5, 0x96, 0x80, 0x34, 0xc1, 0xb8, 0xc9, 0x19,
-	0x89, 0x99, 0x79, 0xfa, 0xb9, 0x89, 0x25, 0x19, 0x7a, 0x2e, 0xa9, 0xc9, 0x41, 0x50, 0x63, 0xac,
-	0x58, 0x5e, 0x2c, 0x90, 0x67, 0x74, 0x0a, 0x3a, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6,
-	0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39,
-	0x86, 0x28, 0x0b, 0x22, 0x0d, 0xae, 0xd0, 0x47, 0x78, 0xb2, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89,
-	0x0d, 0xec, 0x45, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf8, 0xc5, 0xee, 0xb9, 0x40, 0x01,
-	0x00, 0x00,
+	// 456 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0xeb, 0xb5, 0x74, 0xc3, 0x1b, 0x20, 0xa2, 0x09, 0xbc, 0x82, 0xd2, 0x52, 0x84, 0xd4,
+	0x0b, 0x89, 0x06, 0x07, 0x10, 0xb7, 0x56, 0x1c, 0xa8, 0x90, 0x40, 0xf8, 0xc0, 0x24, 0x2e, 0x51,
+	0xea, 0xbc, 0x26, 0xd6, 0x12, 0x3b, 0xb2, 0xdd, 0x0d, 0xb8, 0x73, 0xe7, 0x23, 0xf0, 0x71, 0x76,
+	0xdc, 0x11, 0x71, 0x98, 0x50, 0x7b, 0xe1, 0x13, 0x70, 0x46, 0xb5, 0x9b, 0x74, 0x95, 0x98, 0xb4,
+	0x5b, 0x9e, 0xdf, 0xfb, 0xff, 0xfc, 0xf7, 0x3f, 0x0f, 0x3f, 0x84, 0x82, 0x6b, 0xcd, 0xa5, 0xd0,
+	0xe1, 0xc9, 0x61, 0xc8, 0xc5, 0x14, 0x14, 0x08, 0x06, 0x41, 0xa9, 0xa4, 0x91, 0xde, 0x5e, 0xdd,
+	0x0d, 0x4e, 0x0e, 0x3b, 0xfb, 0xa9, 0x4c, 0xa5, 0x6d, 0x84, 0xcb, 0x2f, 0x37, 0xd3, 0x21, 0x1b,
+	0x04, 0x21, 0x6b, 0x75, 0xa7, 0xb3, 0xd1, 0x51, 0x50, 0xce, 0x0c, 0x28, 0xd7, 0xeb, 0x7f, 0x43,
+	0x78, 0x9f, 0x42, 0xaa, 0xc0, 0x8c, 0xc5, 0x54, 0xaa, 0x02, 0x92, 0x23, 0xe0, 0x69, 0x66, 0xbc,
+	0x7b, 0xb8, 0x7d, 0x2a, 0xd5, 0x31, 0x28, 0x82, 0x7a, 0x68, 0x70, 0x93, 0xae, 0x2a, 0xef, 0x3d,
+	0x6e, 0x9f, 0xda, 0x09, 0xb2, 0xb5, 0x3c, 0x1f, 0xbd, 0x38, 0xbb, 0xe8, 0x36, 0x7e, 0x5d, 0x74,
+	0xc3, 0x94, 0x9b, 0x6c, 0x36, 0x09, 0x98, 0x2c, 0xc2, 0x38, 0xcf, 0xa5, 0x8a, 0x9f, 0x0a, 0x30,
+	0x4b, 0x51, 0x55, 0xb2, 0x2c, 0xe6, 0x22, 0x2c, 0x62, 0x93, 0x05, 0xaf, 0x81, 0xd1, 0x15, 0xe6,
+	0x55, 0xeb, 0xcf, 0x8f, 0x2e, 0xea, 0xff, 0xdd, 0xc2, 0xf7, 0xdf, 0xb9, 0xf9, 0x71, 0xf5, 0x78,
+	0xe7, 0x44, 0x7b, 0x07, 0x78, 0xc7, 0xc8, 0x92, 0xb3, 0x88, 0x27, 0xd6, 0x4c, 0x8b, 0x6e, 0xdb,
+	0x7a, 0x9c, 0x78, 0x8f, 0xf0, 0xde, 0x24, 0x97, 0xec, 0x38, 0xca, 0xd6, 0x9e, 0x9a, 0x74, 0xd7,
+	0x9e, 0xbd, 0x71, 0x0f, 0x21, 0x78, 0x3b, 0x93, 0x8a, 0x7f, 0x95, 0x82, 0x34, 0x7b, 0x68, 0x70,
+	0x8b, 0x56, 0xa5, 0xf7, 0x16, 0xdf, 0x71, 0x41, 0xab, 0xc8, 0x79, 0xd1, 0xa4, 0xd5, 0x6b, 0x0e,
+	0x76, 0x9f, 0xf5, 0x83, 0xcb, 0x79, 0x07, 0xff, 0xcb, 0x87, 0xde, 0x5e, 0x49, 0x2b, 0x93, 0x1f,
+	0xb0, 0x37, 0x95, 0x0a, 0x58, 0xac, 0xcd, 0x25, 0xde, 0x8d, 0x6b, 0xf3, 0xee, 0xae, 0xd5, 0x15,
+	0x92, 0xe1, 0x07, 0xd5, 0x61, 0xc4, 0x8b, 0x32, 0xe7, 0x90, 0x44, 0xf5, 0x66, 0x68, 0xd2, 0xb6,
+	0xec, 0xc7, 0x9b, 0xec, 0x23, 0xfb, 0x97, 0x86, 0xc6, 0x28, 0x3e, 0x99, 0x19, 0x48, 0x3e, 0xc6,
+	0xf9, 0x0c, 0xe8, 0x41, 0xc5, 0x19, 0x3b, 0x4c, 0x1d, 0xb1, 0xee, 0x33, 0xec, 0x5f, 0x91, 0xfb,
+	0xd0, 0x8c, 0x96, 0x31, 0x7a, 0x43, 0xbc, 0xb3, 0x4a, 0x4c, 0x13, 0x64, 0xef, 0x7c, 0xb2, 0x79,
+	0xe7, 0x15, 0x7a, 0x5a, 0xcb, 0x46, 0xf4, 0x6c, 0xee, 0xa3, 0xf3, 0xb9, 0x8f, 0x7e, 0xcf, 0x7d,
+	0xf4, 0x7d, 0xe1, 0x37, 0xce, 0x17, 0x7e, 0xe3, 0xe7, 0xc2, 0x6f, 0x7c, 0x7a, 0x79, 0xcd, 0xb5,
+	0xf9, 0x1c, 0xae, 0x97, 0xd8, 0x7c, 0x29, 0x41, 0x4f, 0xda, 0x76, 0x81, 0x9f, 0xff, 0x1b, 0x00,
+	0x00, 0xb6, 0xc5, 0x7a, 0x3a, 0x03, 0x00, 0x00,
 }
 
 func (this *RegretInformedWeight) Equal(that interface{}) bool {
@@ -162,6 +307,123 @@ func (m *RegretInformedWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *NetworkInferenceWeights) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NetworkInferenceWeights) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NetworkInferenceWeights) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ForecastImpliedInferences) > 0 {
+		for iNdEx := len(m.ForecastImpliedInferences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForecastImpliedInferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInference(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ForecasterWeights) > 0 {
+		for iNdEx := len(m.ForecasterWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForecasterWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInference(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.InfererWeights) > 0 {
+		for iNdEx := len(m.InfererWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InfererWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInference(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Horizon != 0 {
+		i = encodeVarintInference(dAtA, i, uint64(m.Horizon))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintInference(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintInference(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NetworkInferenceWeightsAtBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NetworkInferenceWeightsAtBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NetworkInferenceWeightsAtBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Horizons) > 0 {
+		for iNdEx := len(m.Horizons) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Horizons[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInference(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintInference(dAtA []byte, offset int, v uint64) int {
 	offset -= sovInference(v)
 	base := offset
@@ -188,6 +450,57 @@ func (m *RegretInformedWeight) Size() (n int) {
 	return n
 }
 
+func (m *NetworkInferenceWeights) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovInference(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovInference(uint64(m.BlockHeight))
+	}
+	if m.Horizon != 0 {
+		n += 1 + sovInference(uint64(m.Horizon))
+	}
+	if len(m.InfererWeights) > 0 {
+		for _, e := range m.InfererWeights {
+			l = e.Size()
+			n += 1 + l + sovInference(uint64(l))
+		}
+	}
+	if len(m.ForecasterWeights) > 0 {
+		for _, e := range m.ForecasterWeights {
+			l = e.Size()
+			n += 1 + l + sovInference(uint64(l))
+		}
+	}
+	if len(m.ForecastImpliedInferences) > 0 {
+		for _, e := range m.ForecastImpliedInferences {
+			l = e.Size()
+			n += 1 + l + sovInference(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NetworkInferenceWeightsAtBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Horizons) > 0 {
+		for _, e := range m.Horizons {
+			l = e.Size()
+			n += 1 + l + sovInference(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovInference(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -310,6 +623,299 @@ func (m *RegretInformedWeight) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *NetworkInferenceWeights) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInference
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetworkInferenceWeights: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetworkInferenceWeights: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInference
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInference
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Horizon", wireType)
+			}
+			m.Horizon = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInference
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Horizon |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfererWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInference
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInference
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInference
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InfererWeights = append(m.InfererWeights, &RegretInformedWeight{})
+			if err := m.InfererWeights[len(m.InfererWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForecasterWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInference
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInference
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInference
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForecasterWeights = append(m.ForecasterWeights, &RegretInformedWeight{})
+			if err := m.ForecasterWeights[len(m.ForecasterWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForecastImpliedInferences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInference
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInference
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInference
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForecastImpliedInferences = append(m.ForecastImpliedInferences, &WorkerAttributedValue{})
+			if err := m.ForecastImpliedInferences[len(m.ForecastImpliedInferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInference(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInference
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetworkInferenceWeightsAtBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInference
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetworkInferenceWeightsAtBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetworkInferenceWeightsAtBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Horizons", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInference
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInference
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInference
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Horizons = append(m.Horizons, &NetworkInferenceWeights{})
+			if err := m.Horizons[len(m.Horizons)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInference(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInference
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipInference(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -11,65 +11,94 @@ const (
 )
 
 var (
-	ParamsKey                                   = collections.NewPrefix(0)
-	TotalStakeKey                               = collections.NewPrefix(1)
-	TopicStakeKey                               = collections.NewPrefix(2)
-	RewardsKey                                  = collections.NewPrefix(3)
-	NextTopicIdKey                              = collections.NewPrefix(4)
-	TopicsKey                                   = collections.NewPrefix(5)
-	TopicWorkersKey                             = collections.NewPrefix(6)
-	TopicReputersKey                            = collections.NewPrefix(7)
-	DelegatorStakeKey                           = collections.NewPrefix(8)
-	DelegateStakePlacementKey                   = collections.NewPrefix(9)
-	TargetStakeKey                              = collections.NewPrefix(10)
-	InferencesKey                               = collections.NewPrefix(11)
-	ForecastsKey                                = collections.NewPrefix(12)
-	WorkerNodesKey                              = collections.NewPrefix(13)
-	ReputerNodesKey                             = collections.NewPrefix(14)
-	LatestInferencesTsKey                       = collections.NewPrefix(15)
-	ActiveTopicsKey                             = collections.NewPrefix(16)
-	AllInferencesKey                            = collections.NewPrefix(17)
-	AllForecastsKey                             = collections.NewPrefix(18)
-	AllLossBundlesKey                           = collections.NewPrefix(19)
-	StakeRemovalKey                             = collections.NewPrefix(20)
-	StakeByReputerAndTopicId                    = collections.NewPrefix(21)
-	DelegateStakeRemovalKey                     = collections.NewPrefix(22)
-	AllTopicStakeSumKey                         = collections.NewPrefix(23)
-	AddressTopicsKey                            = collections.NewPrefix(24)
-	WhitelistAdminsKey                          = collections.NewPrefix(24)
-	ChurnableTopicsKey                          = collections.NewPrefix(25)
-	RewardableTopicsKey                         = collections.NewPrefix(26)
-	NetworkLossBundlesKey                       = collections.NewPrefix(27)
-	NetworkRegretsKey                           = collections.NewPrefix(28)
-	StakeByReputerAndTopicIdKey                 = collections.NewPrefix(29)
-	ReputerScoresKey                            = collections.NewPrefix(30)
-	InferenceScoresKey                          = collections.NewPrefix(31)
-	ForecastScoresKey                           = collections.NewPrefix(32)
-	ReputerListeningCoefficientKey              = collections.NewPrefix(33)
-	InfererNetworkRegretsKey                    = collections.NewPrefix(34)
-	ForecasterNetworkRegretsKey                 = collections.NewPrefix(35)
-	OneInForecasterNetworkRegretsKey            = collections.NewPrefix(36)
-	OneInForecasterSelfNetworkRegretsKey        = collections.NewPrefix(37)
-	UnfulfilledWorkerNoncesKey                  = collections.NewPrefix(38)
-	UnfulfilledReputerNoncesKey                 = collections.NewPrefix(39)
-	FeeRevenueEpochKey                          = collections.NewPrefix(40)
-	TopicFeeRevenueKey                          = collections.NewPrefix(41)
-	PreviousTopicWeightKey                      = collections.NewPrefix(42)
-	PreviousReputerRewardFractionKey            = collections.NewPrefix(43)
-	PreviousInferenceRewardFractionKey          = collections.NewPrefix(44)
-	PreviousForecastRewardFractionKey           = collections.NewPrefix(45)
-	LatestInfererScoresByWorkerKey              = collections.NewPrefix(46)
-	LatestForecasterScoresByWorkerKey           = collections.NewPrefix(47)
-	LatestReputerScoresByReputerKey             = collections.NewPrefix(48)
-	TopicRewardNonceKey                         = collections.NewPrefix(49)
-	DelegateRewardPerShare                      = collections.NewPrefix(50)
-	PreviousPercentageRewardToStakedReputersKey = collections.NewPrefix(51)
-	StakeRemovalsByBlockKey                     = collections.NewPrefix(52)
-	DelegateStakeRemovalsByBlockKey             = collections.NewPrefix(53)
-	StakeRemovalsByActorKey                     = collections.NewPrefix(54)
-	DelegateStakeRemovalsByActorKey             = collections.NewPrefix(55)
-	TopicLastWorkerCommitKey                    = collections.NewPrefix(56)
-	TopicLastReputerCommitKey                   = collections.NewPrefix(57)
-	TopicLastWorkerPayloadKey                   = collections.NewPrefix(58)
-	TopicLastReputerPayloadKey                  = collections.NewPrefix(59)
+	ParamsKey                                     = collections.NewPrefix(0)
+	TotalStakeKey                                 = collections.NewPrefix(1)
+	TopicStakeKey                                 = collections.NewPrefix(2)
+	RewardsKey                                    = collections.NewPrefix(3)
+	NextTopicIdKey                                = collections.NewPrefix(4)
+	TopicsKey                                     = collections.NewPrefix(5)
+	TopicWorkersKey                               = collections.NewPrefix(6)
+	TopicReputersKey                              = collections.NewPrefix(7)
+	DelegatorStakeKey                             = collections.NewPrefix(8)
+	DelegateStakePlacementKey                     = collections.NewPrefix(9)
+	TargetStakeKey                                = collections.NewPrefix(10)
+	InferencesKey                                 = collections.NewPrefix(11)
+	ForecastsKey                                  = collections.NewPrefix(12)
+	WorkerNodesKey                                = collections.NewPrefix(13)
+	ReputerNodesKey                               = collections.NewPrefix(14)
+	LatestInferencesTsKey                         = collections.NewPrefix(15)
+	ActiveTopicsKey                               = collections.NewPrefix(16)
+	AllInferencesKey                              = collections.NewPrefix(17)
+	AllForecastsKey                               = collections.NewPrefix(18)
+	AllLossBundlesKey                             = collections.NewPrefix(19)
+	StakeRemovalKey                               = collections.NewPrefix(20)
+	StakeByReputerAndTopicId                      = collections.NewPrefix(21)
+	DelegateStakeRemovalKey                       = collections.NewPrefix(22)
+	AllTopicStakeSumKey                           = collections.NewPrefix(23)
+	AddressTopicsKey                              = collections.NewPrefix(24)
+	WhitelistAdminsKey                            = collections.NewPrefix(24)
+	ChurnableTopicsKey                            = collections.NewPrefix(25)
+	RewardableTopicsKey                           = collections.NewPrefix(26)
+	NetworkLossBundlesKey                         = collections.NewPrefix(27)
+	NetworkRegretsKey                             = collections.NewPrefix(28)
+	StakeByReputerAndTopicIdKey                   = collections.NewPrefix(29)
+	ReputerScoresKey                              = collections.NewPrefix(30)
+	InferenceScoresKey                            = collections.NewPrefix(31)
+	ForecastScoresKey                             = collections.NewPrefix(32)
+	ReputerListeningCoefficientKey                = collections.NewPrefix(33)
+	InfererNetworkRegretsKey                      = collections.NewPrefix(34)
+	ForecasterNetworkRegretsKey                   = collections.NewPrefix(35)
+	OneInForecasterNetworkRegretsKey              = collections.NewPrefix(36)
+	OneInForecasterSelfNetworkRegretsKey          = collections.NewPrefix(37)
+	UnfulfilledWorkerNoncesKey                    = collections.NewPrefix(38)
+	UnfulfilledReputerNoncesKey                   = collections.NewPrefix(39)
+	FeeRevenueEpochKey                            = collections.NewPrefix(40)
+	TopicFeeRevenueKey                            = collections.NewPrefix(41)
+	PreviousTopicWeightKey                        = collections.NewPrefix(42)
+	PreviousReputerRewardFractionKey              = collections.NewPrefix(43)
+	PreviousInferenceRewardFractionKey            = collections.NewPrefix(44)
+	PreviousForecastRewardFractionKey             = collections.NewPrefix(45)
+	LatestInfererScoresByWorkerKey                = collections.NewPrefix(46)
+	LatestForecasterScoresByWorkerKey             = collections.NewPrefix(47)
+	LatestReputerScoresByReputerKey               = collections.NewPrefix(48)
+	TopicRewardNonceKey                           = collections.NewPrefix(49)
+	DelegateRewardPerShare                        = collections.NewPrefix(50)
+	PreviousPercentageRewardToStakedReputersKey   = collections.NewPrefix(51)
+	StakeRemovalsByBlockKey                       = collections.NewPrefix(52)
+	DelegateStakeRemovalsByBlockKey               = collections.NewPrefix(53)
+	StakeRemovalsByActorKey                       = collections.NewPrefix(54)
+	DelegateStakeRemovalsByActorKey               = collections.NewPrefix(55)
+	TopicLastWorkerCommitKey                      = collections.NewPrefix(56)
+	TopicLastReputerCommitKey                     = collections.NewPrefix(57)
+	TopicLastWorkerPayloadKey                     = collections.NewPrefix(58)
+	TopicLastReputerPayloadKey                    = collections.NewPrefix(59)
+	TopicWorkerAllowlistKey                       = collections.NewPrefix(60)
+	TopicReputerAllowlistKey                      = collections.NewPrefix(61)
+	ActorRolesKey                                 = collections.NewPrefix(62)
+	WorkerLastActiveHeightKey                     = collections.NewPrefix(63)
+	ReputerLastActiveHeightKey                    = collections.NewPrefix(64)
+	TopicHorizonRewardNonceKey                    = collections.NewPrefix(65)
+	HorizonLossBundlesKey                         = collections.NewPrefix(66)
+	HorizonNetworkLossBundlesKey                  = collections.NewPrefix(67)
+	NetworkInferenceWeightsKey                    = collections.NewPrefix(68)
+	RewardBreakdownsKey                           = collections.NewPrefix(69)
+	InfererScoreHistoryKey                        = collections.NewPrefix(70)
+	ForecasterScoreHistoryKey                     = collections.NewPrefix(71)
+	ReputerScoreHistoryKey                        = collections.NewPrefix(72)
+	RewardHistoryKey                              = collections.NewPrefix(73)
+	LastBlockTimeKey                              = collections.NewPrefix(74)
+	AverageBlockTimeKey                           = collections.NewPrefix(75)
+	CalibratedBlocksPerMonthKey                   = collections.NewPrefix(76)
+	WorkerTopicsKey                               = collections.NewPrefix(77)
+	ReputerTopicsKey                              = collections.NewPrefix(78)
+	WorkerNodeKeysKey                             = collections.NewPrefix(79)
+	ReputerNodeKeysKey                            = collections.NewPrefix(80)
+	TopicEpochHeightsKey                          = collections.NewPrefix(81)
+	HorizonLastReputerPayloadKey                  = collections.NewPrefix(82)
+	ActorHistoryBlocksKey                         = collections.NewPrefix(83)
+	LastBlocksPerMonthCalibrationHeightKey        = collections.NewPrefix(84)
+	LastPercentageRewardToStakedReputersHeightKey = collections.NewPrefix(85)
+	HorizonInfererScoresKey                       = collections.NewPrefix(86)
+	HorizonForecasterScoresKey                    = collections.NewPrefix(87)
+	HorizonReputerScoresKey                       = collections.NewPrefix(88)
 )
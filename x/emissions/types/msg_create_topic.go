@@ -46,6 +46,79 @@ func (msg *MsgCreateNewTopic) Validate() error {
 	if msg.Epsilon.Lte(alloraMath.ZeroDec()) {
 		return errors.Wrap(sdkerrors.ErrInvalidRequest, "epsilon must be greater than 0")
 	}
+	if err := ValidateTopicAllowlistMode(msg.AllowlistMode); err != nil {
+		return err
+	}
+	if err := ValidateCombinerType(msg.Combiner); err != nil {
+		return err
+	}
+	if err := ValidateTrimmedMeanTrimFraction(msg.Combiner, msg.TrimmedMeanTrimFraction); err != nil {
+		return err
+	}
+	if err := ValidateLossAggregator(msg.LossAggregator, msg.LossTrimFraction, msg.HuberLossTuningConstant, msg.HuberLossMaxIterations); err != nil {
+		return err
+	}
+	if err := ValidateTopicOutputType(msg.OutputType, msg.OutputDimension, msg.DistributionPooling); err != nil {
+		return err
+	}
+	if err := ValidateHorizons(msg.Horizons, msg.HorizonWeights, msg.GroundTruthLag, msg.EpochLength); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func ValidateCombinerType(combiner CombinerType) error {
+	if _, ok := CombinerType_name[int32(combiner)]; !ok {
+		return errors.Wrapf(ErrInvalidCombiner, "unknown combiner %d", combiner)
+	}
+	return nil
+}
 
+// The trimmed mean combiner drops the fraction from either end of the values, so from 0.5 nothing is left to average.
+// When the trimmed mean is selected the fraction must be positive too, as trimming nothing is a plain mean
+func ValidateTrimmedMeanTrimFraction(combiner CombinerType, trimFraction alloraMath.Dec) error {
+	if trimFraction.IsNegative() || trimFraction.Gte(alloraMath.MustNewDecFromString("0.5")) {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "trimmed mean trim fraction must be at least 0 and less than 0.5")
+	}
+	if combiner == CombinerType_COMBINER_TRIMMED_MEAN && !trimFraction.IsPositive() {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "trimmed mean trim fraction must be greater than 0 with the trimmed mean combiner")
+	}
+	return nil
+}
+
+func ValidateLossAggregatorType(lossAggregator LossAggregatorType) error {
+	if _, ok := LossAggregatorType_name[int32(lossAggregator)]; !ok {
+		return errors.Wrapf(ErrInvalidLossAggregator, "unknown loss aggregator %d", lossAggregator)
+	}
+	return nil
+}
+
+// Validates the loss aggregator of a topic along with its tuning. The trimmed mean drops a positive fraction
+// of the stake from either end of the losses, and the Huber estimator needs a positive tuning constant
+// and between 1 and MaxHuberLossMaxIterations iterations.
+func ValidateLossAggregator(
+	lossAggregator LossAggregatorType,
+	trimFraction alloraMath.Dec,
+	huberTuningConstant alloraMath.Dec,
+	huberMaxIterations uint64,
+) error {
+	if err := ValidateLossAggregatorType(lossAggregator); err != nil {
+		return err
+	}
+	if trimFraction.IsNegative() || trimFraction.Gte(alloraMath.MustNewDecFromString("0.5")) {
+		return errors.Wrap(ErrInvalidLossAggregator, "loss trim fraction must be at least 0 and less than 0.5")
+	}
+	if lossAggregator == LossAggregatorType_LOSS_AGGREGATOR_TRIMMED_MEAN && !trimFraction.IsPositive() {
+		return errors.Wrap(ErrInvalidLossAggregator, "loss trim fraction must be greater than 0 with the trimmed mean loss aggregator")
+	}
+	if lossAggregator == LossAggregatorType_LOSS_AGGREGATOR_HUBER {
+		if !huberTuningConstant.IsPositive() {
+			return errors.Wrap(ErrInvalidLossAggregator, "huber loss tuning constant must be greater than 0")
+		}
+		if huberMaxIterations == 0 || huberMaxIterations > MaxHuberLossMaxIterations {
+			return errors.Wrapf(ErrInvalidLossAggregator, "huber loss max iterations must be between 1 and %d", MaxHuberLossMaxIterations)
+		}
+	}
 	return nil
 }
@@ -2,6 +2,8 @@ package types
 
 import (
 	"cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -25,3 +27,81 @@ func (msg *MsgRegister) Validate() error {
 
 	return nil
 }
+
+func (msg *MsgUpdateNodeInfo) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+
+	if len(msg.LibP2PKey) == 0 {
+		return errors.Wrap(ErrLibP2PKeyRequired, "libP2PKey cannot be empty")
+	}
+	if len(msg.MultiAddress) == 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "multiAddress cannot be empty")
+	}
+
+	return nil
+}
+
+func (msg *MsgRegisterMulti) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if len(msg.TopicIds) == 0 && len(msg.RemoveTopicIds) == 0 {
+		return errors.Wrap(ErrEmptyArray, "no topics to register in or remove the registration from")
+	}
+
+	seen := make(map[TopicId]bool)
+	for _, topicId := range append(append([]TopicId{}, msg.TopicIds...), msg.RemoveTopicIds...) {
+		if seen[topicId] {
+			return errors.Wrapf(ErrDuplicateTopicId, "topic id %d appears more than once", topicId)
+		}
+		seen[topicId] = true
+	}
+
+	if len(msg.TopicIds) > 0 {
+		_, err = sdk.AccAddressFromBech32(msg.Owner)
+		if err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+		}
+		if len(msg.LibP2PKey) == 0 {
+			return errors.Wrap(ErrLibP2PKeyRequired, "libP2PKey cannot be empty")
+		}
+		if len(msg.MultiAddress) == 0 {
+			return errors.Wrap(sdkerrors.ErrInvalidRequest, "multiAddress cannot be empty")
+		}
+	}
+
+	return nil
+}
+
+// Total fee to register in numTopics topics at once: the first topic pays the full
+// registration fee and every other topic pays it minus the discount.
+func CalcMultiRegistrationFee(registrationFee cosmosMath.Int, discount alloraMath.Dec, numTopics uint64) (cosmosMath.Int, error) {
+	if numTopics == 0 {
+		return cosmosMath.ZeroInt(), nil
+	}
+	feeDec, err := alloraMath.NewDecFromSdkInt(registrationFee)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	discountedFee, err := alloraMath.OneDec().Sub(discount)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	discountedFee, err = discountedFee.Mul(feeDec)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	extraTopicsFee, err := discountedFee.Mul(alloraMath.NewDecFromInt64(int64(numTopics - 1)))
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	return registrationFee.Add(extraTopicsFee.SdkIntTrim()), nil
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func ValidateTopicAllowlistMode(mode TopicAllowlistMode) error {
+	if _, ok := TopicAllowlistMode_name[int32(mode)]; !ok {
+		return errors.Wrapf(ErrInvalidTopicAllowlistMode, "unknown mode %d", mode)
+	}
+	return nil
+}
+
+func (msg *MsgUpdateTopicAllowlistMode) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	return ValidateTopicAllowlistMode(msg.AllowlistMode)
+}
+
+func (msg *MsgAddToTopicAllowlist) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+	return nil
+}
+
+func (msg *MsgRemoveFromTopicAllowlist) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+	return nil
+}
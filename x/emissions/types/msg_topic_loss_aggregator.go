@@ -0,0 +1,15 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (msg *MsgUpdateTopicLossAggregator) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	return ValidateLossAggregator(msg.LossAggregator, msg.LossTrimFraction, msg.HuberLossTuningConstant, msg.HuberLossMaxIterations)
+}
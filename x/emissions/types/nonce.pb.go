@@ -115,6 +115,8 @@ type ReputerRequestNonce struct {
 	// the reputers should respond to the reputer request with losses for work
 	// found at this worker nonce
 	ReputerNonce *Nonce `protobuf:"bytes,1,opt,name=reputer_nonce,json=reputerNonce,proto3" json:"reputer_nonce,omitempty"`
+	// index of the topic horizon whose ground truth the losses are computed against
+	Horizon uint32 `protobuf:"varint,2,opt,name=horizon,proto3" json:"horizon,omitempty"`
 }
 
 func (m *ReputerRequestNonce) Reset()         { *m = ReputerRequestNonce{} }
@@ -157,6 +159,13 @@ func (m *ReputerRequestNonce) GetReputerNonce() *Nonce {
 	return nil
 }
 
+func (m *ReputerRequestNonce) GetHorizon() uint32 {
+	if m != nil {
+		return m.Horizon
+	}
+	return 0
+}
+
 type ReputerRequestNonces struct {
 	Nonces []*ReputerRequestNonce `protobuf:"bytes,1,rep,name=nonces,proto3" json:"nonces,omitempty"`
 }
@@ -211,7 +220,7 @@ func init() {
 func init() { proto.RegisterFile("emissions/v1/nonce.proto", fileDescriptor_529b41e523578c99) }
 
 var fileDescriptor_529b41e523578c99 = []byte{
-	// 279 bytes of a gzipped FileDescriptorProto
+	// 295 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x48, 0xcd, 0xcd, 0x2c,
 	0x2e, 0xce, 0xcc, 0xcf, 0x2b, 0xd6, 0x2f, 0x33, 0xd4, 0xcf, 0xcb, 0xcf, 0x4b, 0x4e, 0xd5, 0x2b,
 	0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x81, 0xcb, 0xe8, 0x95, 0x19, 0x4a, 0x89, 0xa4, 0xe7, 0xa7,
@@ -220,16 +229,17 @@ var fileDescriptor_529b41e523578c99 = []byte{
 	0x46, 0x05, 0x46, 0x0d, 0xe6, 0x20, 0x6e, 0xb0, 0x98, 0x07, 0x58, 0xc8, 0x8a, 0xe5, 0xc5, 0x02,
 	0x79, 0x46, 0x25, 0x53, 0x2e, 0x36, 0xb0, 0x8e, 0x62, 0x21, 0x6d, 0x2e, 0x36, 0xb0, 0x75, 0xc5,
 	0x12, 0x8c, 0x0a, 0xcc, 0x1a, 0xdc, 0x46, 0xc2, 0x7a, 0xc8, 0x16, 0xea, 0x81, 0x55, 0x05, 0x41,
-	0x95, 0x28, 0x85, 0x72, 0x09, 0x07, 0xa5, 0x16, 0x94, 0x96, 0xa4, 0x16, 0x05, 0xa5, 0x16, 0x96,
+	0x95, 0x28, 0xe5, 0x73, 0x09, 0x07, 0xa5, 0x16, 0x94, 0x96, 0xa4, 0x16, 0x05, 0xa5, 0x16, 0x96,
 	0xa6, 0x16, 0x97, 0x40, 0xac, 0xb5, 0xe0, 0xe2, 0x2d, 0x82, 0x08, 0xc7, 0x83, 0x15, 0x82, 0xed,
-	0xc5, 0x61, 0x14, 0x0f, 0x54, 0x25, 0x98, 0x07, 0x75, 0x4d, 0x20, 0x97, 0x08, 0x16, 0x63, 0x8b,
-	0x85, 0x2c, 0xd1, 0xdc, 0xa6, 0x88, 0x6a, 0x20, 0x16, 0x3d, 0x30, 0x97, 0x3a, 0x05, 0x9d, 0x78,
-	0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c,
-	0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x45, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92,
-	0x5e, 0x72, 0x7e, 0xae, 0x7e, 0x62, 0x4e, 0x4e, 0x7e, 0x51, 0xa2, 0x6e, 0x5e, 0x6a, 0x49, 0x79,
-	0x7e, 0x51, 0x36, 0x8c, 0x9b, 0x9c, 0x91, 0x98, 0x99, 0xa7, 0x5f, 0xa1, 0x8f, 0x88, 0x93, 0x92,
-	0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0x68, 0x1b, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x1e,
-	0x0e, 0xef, 0x2f, 0xad, 0x01, 0x00, 0x00,
+	0xc5, 0x61, 0x14, 0x0f, 0x54, 0x25, 0x44, 0xa7, 0x04, 0x17, 0x7b, 0x46, 0x7e, 0x51, 0x66, 0x55,
+	0x7e, 0x9e, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x6f, 0x10, 0x8c, 0x0b, 0x75, 0x67, 0x20, 0x97, 0x08,
+	0x16, 0x0b, 0x8b, 0x85, 0x2c, 0xd1, 0x5c, 0xad, 0x88, 0x6a, 0x15, 0x16, 0x3d, 0x30, 0x3f, 0x38,
+	0x05, 0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e,
+	0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x45, 0x7a, 0x66, 0x49,
+	0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x7e, 0x62, 0x4e, 0x4e, 0x7e, 0x51, 0xa2, 0x6e, 0x5e,
+	0x6a, 0x49, 0x79, 0x7e, 0x51, 0x36, 0x8c, 0x9b, 0x9c, 0x91, 0x98, 0x99, 0xa7, 0x5f, 0xa1, 0x8f,
+	0x88, 0xad, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0x3c, 0x18, 0x03, 0x06, 0x00, 0x5f,
+	0x07, 0xbb, 0x86, 0xc7, 0x01, 0x00, 0x00,
 }
 
 func (this *Nonce) Equal(that interface{}) bool {
@@ -278,6 +288,9 @@ func (this *ReputerRequestNonce) Equal(that interface{}) bool {
 	if !this.ReputerNonce.Equal(that1.ReputerNonce) {
 		return false
 	}
+	if this.Horizon != that1.Horizon {
+		return false
+	}
 	return true
 }
 func (m *Nonce) Marshal() (dAtA []byte, err error) {
@@ -365,6 +378,11 @@ func (m *ReputerRequestNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Horizon != 0 {
+		i = encodeVarintNonce(dAtA, i, uint64(m.Horizon))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.ReputerNonce != nil {
 		{
 			size, err := m.ReputerNonce.MarshalToSizedBuffer(dAtA[:i])
@@ -465,6 +483,9 @@ func (m *ReputerRequestNonce) Size() (n int) {
 		l = m.ReputerNonce.Size()
 		n += 1 + l + sovNonce(uint64(l))
 	}
+	if m.Horizon != 0 {
+		n += 1 + sovNonce(uint64(m.Horizon))
+	}
 	return n
 }
 
@@ -707,6 +728,25 @@ func (m *ReputerRequestNonce) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Horizon", wireType)
+			}
+			m.Horizon = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNonce
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Horizon |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNonce(dAtA[iNdEx:])
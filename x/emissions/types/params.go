@@ -51,6 +51,16 @@ func DefaultParams() Params {
 		TopicFeeRevenueDecayRate:            alloraMath.MustNewDecFromString("0.0025"),     // rate at which topic fee revenue decays over time
 		MinEffectiveTopicRevenue:            alloraMath.MustNewDecFromString("0.00000001"), // we no stop dripping from the topic's effective revenue when the topic's effective revenue is below this
 		HalfMaxProcessStakeRemovalsEndBlock: uint64(40),                                    // half of the max number of stake removals to process at the end of the block, set this too big and blocks require too much time to process, slowing down consensus
+		PermissionedTopicCreation:           false,                                         // if true, only addresses with the TOPIC_CREATOR role may create topics
+		MultiRegistrationFeeDiscount:        alloraMath.ZeroDec(),                          // discount on the registration fee of every extra topic registered in at once; 0 => flat fee per topic
+		MaxTopicOutputDimension:             uint64(16),                                    // max number of components of the values of a vector-valued topic; 0 => only scalar-valued topics may be created
+		MaxTopicHorizons:                    uint64(8),                                     // max number of horizons predicted in a topic
+		ActorHistoryRetention:               int64(525960),                                 // number of blocks the score and reward history of an actor is kept for, ~1 month; 0 => no history is kept
+		BlocksPerMonthCalibrationEnabled:    false,                                         // if true, the effective blocks per month follows the observed block time instead of BlocksPerMonth
+		MinBlocksPerMonth:                   uint64(262980),                                // ~10 seconds block time, lower bound of the calibrated blocks per month
+		MaxBlocksPerMonth:                   uint64(2629800),                               // ~1 second block time, upper bound of the calibrated blocks per month
+		MaxBlocksPerMonthChange:             alloraMath.MustNewDecFromString("0.1"),        // calibrated blocks per month moves by at most 10% per recalibration
+		BlockTimeSmoothingDegree:            alloraMath.MustNewDecFromString("0.01"),       // weight of the latest block time in the moving average of the block time
 	}
 }
 
@@ -179,6 +189,27 @@ func (p Params) Validate() error {
 	if err := validateHalfMaxProcessStakeRemovalsEndBlock(p.HalfMaxProcessStakeRemovalsEndBlock); err != nil {
 		return err
 	}
+	if err := validateMultiRegistrationFeeDiscount(p.MultiRegistrationFeeDiscount); err != nil {
+		return err
+	}
+	if err := validateActorHistoryRetention(p.ActorHistoryRetention); err != nil {
+		return err
+	}
+	if err := validateMaxTopicOutputDimension(p.MaxTopicOutputDimension); err != nil {
+		return err
+	}
+	if err := validateMaxTopicHorizons(p.MaxTopicHorizons); err != nil {
+		return err
+	}
+	if err := validateBlocksPerMonthCalibration(
+		p.BlocksPerMonthCalibrationEnabled,
+		p.MinBlocksPerMonth,
+		p.MaxBlocksPerMonth,
+		p.MaxBlocksPerMonthChange,
+		p.BlockTimeSmoothingDegree,
+	); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -531,6 +562,15 @@ func validateHalfMaxProcessStakeRemovalsEndBlock(i uint64) error {
 	return nil
 }
 
+// Discount on the registration fee of every topic after the first one in a multi-topic registration.
+// Should be between 0 and 1.
+func validateMultiRegistrationFeeDiscount(i alloraMath.Dec) error {
+	if !isAlloraDecBetweenZeroAndOneInclusive(i) {
+		return ErrValidationMustBeBetweenZeroAndOne
+	}
+	return nil
+}
+
 // Whether an alloraDec is between the value of [0, 1] inclusive
 func isAlloraDecBetweenZeroAndOneInclusive(a alloraMath.Dec) bool {
 	return a.Gte(alloraMath.ZeroDec()) && a.Lte(alloraMath.OneDec())
@@ -540,3 +580,58 @@ func isAlloraDecBetweenZeroAndOneInclusive(a alloraMath.Dec) bool {
 func isAlloraDecBetweenZeroAndOneExclusive(a alloraMath.Dec) bool {
 	return a.Gt(alloraMath.ZeroDec()) && a.Lt(alloraMath.OneDec())
 }
+
+// Number of blocks the score and reward history of an actor is kept for.
+// Should be non-negative. 0 keeps no history.
+func validateActorHistoryRetention(i BlockHeight) error {
+	if i < 0 {
+		return ErrValidationMustBeGreaterthanZero
+	}
+	return nil
+}
+
+// Max number of components of the values of a vector-valued topic.
+// Every component is synthesized on its own, so it should not exceed MaxTopicOutputDimensionLimit.
+// 0 allows only scalar-valued topics.
+func validateMaxTopicOutputDimension(i uint64) error {
+	if i > MaxTopicOutputDimensionLimit {
+		return fmt.Errorf("max topic output dimension %d must not exceed %d", i, MaxTopicOutputDimensionLimit)
+	}
+	return nil
+}
+
+// Max number of horizons predicted in a topic.
+// Every horizon is scored and rewarded on its own, so it should not exceed MaxTopicHorizonsLimit.
+// 0 allows only topics predicting their ground truth lag.
+func validateMaxTopicHorizons(i uint64) error {
+	if i > MaxTopicHorizonsLimit {
+		return fmt.Errorf("max topic horizons %d must not exceed %d", i, MaxTopicHorizonsLimit)
+	}
+	return nil
+}
+
+// Calibration of the blocks per month from the observed block time.
+// The max change and the smoothing degree should be between 0 and 1.
+// When calibration is enabled, the bounds should be positive with the minimum not exceeding the maximum,
+// and the smoothing degree should be greater than 0 so the average block time can move.
+func validateBlocksPerMonthCalibration(
+	enabled bool,
+	minBlocksPerMonth,
+	maxBlocksPerMonth uint64,
+	maxChange,
+	smoothingDegree alloraMath.Dec,
+) error {
+	if !isAlloraDecBetweenZeroAndOneInclusive(maxChange) || !isAlloraDecBetweenZeroAndOneInclusive(smoothingDegree) {
+		return ErrValidationMustBeBetweenZeroAndOne
+	}
+	if !enabled {
+		return nil
+	}
+	if minBlocksPerMonth == 0 || smoothingDegree.IsZero() {
+		return ErrValidationMustBeGreaterthanZero
+	}
+	if minBlocksPerMonth > maxBlocksPerMonth {
+		return fmt.Errorf("min blocks per month %d must not exceed max blocks per month %d", minBlocksPerMonth, maxBlocksPerMonth)
+	}
+	return nil
+}
@@ -84,6 +84,21 @@ type Params struct {
 	MinEffectiveTopicRevenue github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,41,opt,name=min_effective_topic_revenue,json=minEffectiveTopicRevenue,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"min_effective_topic_revenue"`
 	// effective revenue is below this
 	HalfMaxProcessStakeRemovalsEndBlock uint64 `protobuf:"varint,42,opt,name=half_max_process_stake_removals_end_block,json=halfMaxProcessStakeRemovalsEndBlock,proto3" json:"half_max_process_stake_removals_end_block,omitempty"`
+	// once for DelegateStakeRemovals, so actual max is this number times two
+	PermissionedTopicCreation    bool                                            `protobuf:"varint,43,opt,name=permissioned_topic_creation,json=permissionedTopicCreation,proto3" json:"permissioned_topic_creation,omitempty"`
+	MultiRegistrationFeeDiscount github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,44,opt,name=multi_registration_fee_discount,json=multiRegistrationFeeDiscount,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"multi_registration_fee_discount"`
+	// 0 charges the flat registration fee per topic
+	MaxTopicOutputDimension uint64 `protobuf:"varint,45,opt,name=max_topic_output_dimension,json=maxTopicOutputDimension,proto3" json:"max_topic_output_dimension,omitempty"`
+	// 0 only allows scalar-valued topics to be created, at most 256
+	MaxTopicHorizons      uint64 `protobuf:"varint,46,opt,name=max_topic_horizons,json=maxTopicHorizons,proto3" json:"max_topic_horizons,omitempty"`
+	ActorHistoryRetention int64  `protobuf:"varint,47,opt,name=actor_history_retention,json=actorHistoryRetention,proto3" json:"actor_history_retention,omitempty"`
+	// 0 keeps no history
+	BlocksPerMonthCalibrationEnabled bool `protobuf:"varint,48,opt,name=blocks_per_month_calibration_enabled,json=blocksPerMonthCalibrationEnabled,proto3" json:"blocks_per_month_calibration_enabled,omitempty"`
+	// observed average block time instead of blocks_per_month
+	MinBlocksPerMonth        uint64                                          `protobuf:"varint,49,opt,name=min_blocks_per_month,json=minBlocksPerMonth,proto3" json:"min_blocks_per_month,omitempty"`
+	MaxBlocksPerMonth        uint64                                          `protobuf:"varint,50,opt,name=max_blocks_per_month,json=maxBlocksPerMonth,proto3" json:"max_blocks_per_month,omitempty"`
+	MaxBlocksPerMonthChange  github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,51,opt,name=max_blocks_per_month_change,json=maxBlocksPerMonthChange,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"max_blocks_per_month_change"`
+	BlockTimeSmoothingDegree github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,52,opt,name=block_time_smoothing_degree,json=blockTimeSmoothingDegree,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"block_time_smoothing_degree"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -252,6 +267,55 @@ func (m *Params) GetHalfMaxProcessStakeRemovalsEndBlock() uint64 {
 	return 0
 }
 
+func (m *Params) GetPermissionedTopicCreation() bool {
+	if m != nil {
+		return m.PermissionedTopicCreation
+	}
+	return false
+}
+
+func (m *Params) GetMaxTopicOutputDimension() uint64 {
+	if m != nil {
+		return m.MaxTopicOutputDimension
+	}
+	return 0
+}
+
+func (m *Params) GetMaxTopicHorizons() uint64 {
+	if m != nil {
+		return m.MaxTopicHorizons
+	}
+	return 0
+}
+
+func (m *Params) GetActorHistoryRetention() int64 {
+	if m != nil {
+		return m.ActorHistoryRetention
+	}
+	return 0
+}
+
+func (m *Params) GetBlocksPerMonthCalibrationEnabled() bool {
+	if m != nil {
+		return m.BlocksPerMonthCalibrationEnabled
+	}
+	return false
+}
+
+func (m *Params) GetMinBlocksPerMonth() uint64 {
+	if m != nil {
+		return m.MinBlocksPerMonth
+	}
+	return 0
+}
+
+func (m *Params) GetMaxBlocksPerMonth() uint64 {
+	if m != nil {
+		return m.MaxBlocksPerMonth
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "emissions.v1.Params")
 }
@@ -259,87 +323,102 @@ func init() {
 func init() { proto.RegisterFile("emissions/v1/params.proto", fileDescriptor_f535e53c00d44458) }
 
 var fileDescriptor_f535e53c00d44458 = []byte{
-	// 1270 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x4d, 0x6f, 0x54, 0x37,
-	0x17, 0xce, 0xbc, 0x40, 0x78, 0x31, 0x21, 0x1f, 0x6e, 0x08, 0xce, 0x40, 0x26, 0x29, 0xa1, 0x6d,
-	0x40, 0x25, 0x53, 0xd4, 0x45, 0x3f, 0x17, 0x05, 0x25, 0x41, 0x51, 0x09, 0x8a, 0x6e, 0x52, 0x90,
-	0x68, 0x25, 0xd7, 0xf1, 0x3d, 0x73, 0xc7, 0xca, 0xbd, 0xf6, 0xad, 0xed, 0x99, 0x4c, 0xd8, 0x77,
-	0xdf, 0x9f, 0xd1, 0x65, 0x17, 0xfd, 0x11, 0x2c, 0x51, 0x57, 0x55, 0x17, 0xa8, 0x82, 0x45, 0xff,
-	0x41, 0xd7, 0x95, 0x3f, 0xee, 0x64, 0x26, 0xb4, 0x55, 0xc5, 0xdd, 0x44, 0x19, 0x9f, 0xe7, 0x3c,
-	0xcf, 0xf1, 0x63, 0xfb, 0xd8, 0x17, 0x2d, 0x42, 0x21, 0x8c, 0x11, 0x4a, 0x9a, 0x76, 0xff, 0x4e,
-	0xbb, 0x64, 0x9a, 0x15, 0x66, 0xbd, 0xd4, 0xca, 0x2a, 0x3c, 0x35, 0x0c, 0xad, 0xf7, 0xef, 0x34,
-	0x17, 0xb9, 0x32, 0x85, 0x32, 0xd4, 0xc7, 0xda, 0xe1, 0x47, 0x00, 0x36, 0xe7, 0x58, 0x21, 0xa4,
-	0x6a, 0xfb, 0xbf, 0x71, 0x68, 0x3e, 0x53, 0x99, 0x0a, 0x50, 0xf7, 0x5f, 0x18, 0xbd, 0xfe, 0x67,
-	0x13, 0x4d, 0xee, 0x7a, 0x09, 0x4c, 0xd0, 0xf9, 0x3e, 0x68, 0xc7, 0x4e, 0x1a, 0x2b, 0x8d, 0xb5,
-	0x0b, 0x49, 0xf5, 0x13, 0x7f, 0x82, 0x16, 0x0b, 0x36, 0xa0, 0x06, 0xb4, 0x60, 0xb9, 0x78, 0x0a,
-	0x29, 0x2d, 0x4c, 0x46, 0x73, 0x90, 0x99, 0xed, 0x92, 0xff, 0xad, 0x34, 0xd6, 0xce, 0x24, 0x0b,
-	0x05, 0x1b, 0xec, 0x0d, 0xe3, 0x3b, 0x26, 0x7b, 0xe0, 0xa3, 0x98, 0xa1, 0xd9, 0x42, 0x48, 0x6a,
-	0x55, 0x29, 0x38, 0x3d, 0x02, 0x91, 0x75, 0x2d, 0x39, 0xe3, 0xd8, 0xef, 0x7d, 0xf4, 0xec, 0xc5,
-	0xf2, 0xc4, 0x6f, 0x2f, 0x96, 0xdb, 0x99, 0xb0, 0xdd, 0xde, 0xc1, 0x3a, 0x57, 0x45, 0x9b, 0xe5,
-	0xb9, 0xd2, 0xec, 0xb6, 0x04, 0x7b, 0xa4, 0xf4, 0x61, 0xf5, 0x93, 0x77, 0x99, 0x90, 0xed, 0x82,
-	0xd9, 0xee, 0xfa, 0x06, 0xf0, 0x64, 0xba, 0x10, 0x72, 0xdf, 0xf1, 0x3d, 0xf6, 0x74, 0xb8, 0x8d,
-	0xe6, 0x5d, 0x75, 0x5e, 0xc2, 0xd0, 0x12, 0x34, 0x3d, 0xc8, 0x15, 0x3f, 0x24, 0x67, 0x57, 0x1a,
-	0x6b, 0x67, 0x93, 0xb9, 0x82, 0x0d, 0x3c, 0xda, 0xec, 0x82, 0xbe, 0xe7, 0x02, 0xb8, 0x83, 0x16,
-	0x34, 0x7c, 0xd7, 0x13, 0xda, 0x4d, 0x44, 0x48, 0x51, 0xf4, 0x0a, 0x6a, 0x2c, 0x3b, 0x04, 0x72,
-	0xce, 0x57, 0xf6, 0x41, 0xac, 0xec, 0x72, 0xb0, 0xd4, 0xa4, 0x87, 0xeb, 0x42, 0x05, 0xfd, 0x6d,
-	0x69, 0x7f, 0xf9, 0xf9, 0x36, 0x8a, 0x5e, 0x6f, 0x4b, 0xfb, 0xe3, 0x1f, 0x3f, 0xdd, 0x6a, 0x24,
-	0xf3, 0x15, 0xdf, 0x4e, 0xa0, 0xdb, 0x73, 0x6c, 0xce, 0x36, 0x0d, 0x85, 0xea, 0x43, 0x60, 0xa7,
-	0x29, 0xe4, 0xec, 0x98, 0x1e, 0x09, 0x99, 0xaa, 0x23, 0x32, 0x19, 0x6c, 0x0b, 0x00, 0x8f, 0xdf,
-	0x70, 0xe1, 0xc7, 0x3e, 0x8a, 0xd7, 0x82, 0x6d, 0x50, 0x2a, 0xde, 0xad, 0x8c, 0x3e, 0xef, 0x33,
-	0xdc, 0xec, 0x37, 0xdd, 0x70, 0x34, 0xf8, 0x09, 0x9a, 0x3a, 0x00, 0xcb, 0x28, 0x48, 0xab, 0x55,
-	0x79, 0x4c, 0xfe, 0x5f, 0xcf, 0xdc, 0x8b, 0x8e, 0x6c, 0x33, 0x70, 0xe1, 0x6f, 0xd0, 0xa5, 0x1c,
-	0x98, 0x96, 0x42, 0x66, 0x54, 0x33, 0x0b, 0xe4, 0x42, 0x3d, 0xf2, 0xa9, 0x8a, 0x2d, 0x61, 0x16,
-	0x70, 0x81, 0xdc, 0xa6, 0xa1, 0x99, 0x66, 0xa9, 0x00, 0x69, 0xa9, 0xed, 0x6a, 0x30, 0x5d, 0x95,
-	0xa7, 0x04, 0xd5, 0x93, 0x71, 0xdb, 0xe1, 0x7e, 0x64, 0xdd, 0xaf, 0x48, 0x31, 0x20, 0xec, 0x2c,
-	0x0d, 0x4b, 0xd1, 0xd1, 0x8c, 0x5b, 0xb7, 0xd3, 0x2f, 0xd6, 0x93, 0x72, 0xab, 0xe4, 0x17, 0x6f,
-	0x2b, 0x12, 0xe2, 0x4d, 0xb4, 0xec, 0x66, 0xd5, 0x93, 0x9d, 0x5e, 0xde, 0x11, 0x79, 0x0e, 0x29,
-	0x75, 0xf9, 0xa0, 0xa9, 0xdb, 0x23, 0x60, 0xac, 0x21, 0x97, 0xfc, 0xc6, 0xbc, 0x56, 0xb0, 0xc1,
-	0x57, 0x27, 0xa8, 0xc7, 0x1e, 0x94, 0x44, 0x0c, 0xbe, 0x8f, 0x56, 0x4e, 0xd3, 0x68, 0x28, 0x7b,
-	0x76, 0x94, 0x67, 0xda, 0xf3, 0x2c, 0x8d, 0xf3, 0x24, 0x01, 0x35, 0x24, 0x7a, 0x8a, 0x96, 0xc2,
-	0xe1, 0xd3, 0x70, 0xc4, 0x74, 0x1a, 0xe7, 0x2f, 0x8a, 0x52, 0x69, 0xcb, 0x24, 0x07, 0x32, 0x53,
-	0xcf, 0x81, 0xa6, 0x67, 0x4f, 0x3c, 0xb9, 0x77, 0x62, 0x7b, 0x48, 0x8d, 0xbf, 0x6f, 0xa0, 0xd5,
-	0x31, 0xf1, 0x0e, 0x00, 0xd5, 0xd0, 0x07, 0xd9, 0x1b, 0x2b, 0x61, 0xb6, 0x5e, 0x09, 0xcb, 0x23,
-	0x25, 0x6c, 0x01, 0x24, 0x41, 0x60, 0xa4, 0x0e, 0x40, 0x78, 0xac, 0x0c, 0x96, 0x97, 0x5d, 0x46,
-	0xe6, 0x6a, 0x2e, 0xfd, 0x88, 0xea, 0x5d, 0x47, 0x88, 0x39, 0x9a, 0xb3, 0xcc, 0x1c, 0x8e, 0xab,
-	0xe0, 0x7a, 0x2a, 0x33, 0x8e, 0x71, 0x54, 0xc4, 0x79, 0xda, 0x67, 0xb9, 0x48, 0x99, 0x55, 0xda,
-	0xd0, 0xbe, 0xa1, 0x21, 0xd1, 0x35, 0x3e, 0xee, 0x8e, 0x51, 0x50, 0x27, 0x6f, 0xd5, 0xf4, 0xf4,
-	0x44, 0xe3, 0x91, 0xb9, 0xeb, 0x21, 0xbb, 0x41, 0x20, 0x14, 0x83, 0x3f, 0x47, 0x57, 0xfd, 0x9d,
-	0xc0, 0x8a, 0x32, 0x07, 0x43, 0xad, 0xa2, 0x86, 0xb3, 0x1c, 0xa8, 0xe1, 0x4a, 0x83, 0x21, 0xf3,
-	0x7e, 0x6f, 0x5e, 0x71, 0xb7, 0x42, 0x40, 0xec, 0xab, 0x3d, 0x17, 0xdf, 0xf3, 0x61, 0xfc, 0x29,
-	0x6a, 0xc6, 0x9e, 0x4d, 0x85, 0xec, 0x80, 0x06, 0xed, 0x29, 0x62, 0xed, 0x97, 0x7d, 0xf2, 0x42,
-	0xe8, 0xdc, 0xdb, 0x31, 0xbe, 0xaf, 0xa2, 0xf2, 0x17, 0x68, 0xa9, 0xca, 0xed, 0x28, 0x0d, 0x9c,
-	0x19, 0x3b, 0x9e, 0xbe, 0xe0, 0xd3, 0x17, 0x43, 0xfa, 0xd6, 0x09, 0x64, 0xc8, 0x30, 0xa2, 0x1e,
-	0x0f, 0xd5, 0x68, 0xfa, 0x95, 0x51, 0xf5, 0x78, 0x9c, 0x4e, 0x72, 0x9f, 0xa0, 0x59, 0xae, 0x81,
-	0x59, 0x88, 0x77, 0x5a, 0x07, 0x80, 0x90, 0x37, 0xbc, 0x36, 0xa6, 0x03, 0x93, 0xbf, 0x9e, 0xb6,
-	0x00, 0xf0, 0x67, 0xa8, 0x39, 0xec, 0x86, 0x29, 0x18, 0xbf, 0x9c, 0xae, 0x50, 0xe1, 0x2a, 0x20,
-	0x8b, 0xc1, 0xd2, 0x0a, 0xb1, 0x11, 0x00, 0x3b, 0x6c, 0xb0, 0xed, 0xc2, 0xf8, 0x4b, 0xb4, 0xea,
-	0xb0, 0x1a, 0xac, 0x16, 0x61, 0x41, 0x42, 0x4f, 0xa0, 0x52, 0x49, 0x0e, 0x26, 0x76, 0x21, 0xd2,
-	0xf4, 0xb7, 0x48, 0xab, 0x60, 0x83, 0x24, 0x20, 0xf7, 0xd5, 0x96, 0xc7, 0x3d, 0xf4, 0xb0, 0xd0,
-	0x86, 0xf0, 0x0e, 0xba, 0xf1, 0xaf, 0x64, 0xd1, 0x36, 0x72, 0xd5, 0xb3, 0x2d, 0xff, 0x13, 0x5b,
-	0x74, 0x0f, 0x7f, 0x8d, 0x66, 0x35, 0x64, 0xc2, 0x58, 0xcd, 0x5c, 0x93, 0xf4, 0xa6, 0x5d, 0x7b,
-	0x43, 0xd3, 0x66, 0x46, 0x99, 0x9c, 0x6b, 0xef, 0x23, 0x9c, 0x42, 0x87, 0xf5, 0x72, 0x4b, 0x4b,
-	0x96, 0x01, 0xcd, 0x45, 0x21, 0x2c, 0x59, 0xf2, 0x6e, 0xcd, 0xc6, 0xc8, 0x2e, 0xcb, 0xe0, 0x81,
-	0x1b, 0xc7, 0x37, 0xd0, 0xb4, 0x9b, 0xd9, 0x08, 0xb2, 0xe5, 0x91, 0x53, 0x05, 0x1b, 0x9c, 0xa0,
-	0xdc, 0x1e, 0x3b, 0x75, 0xff, 0x52, 0x0d, 0x5c, 0xe9, 0x34, 0x26, 0x2d, 0xfb, 0x89, 0x2f, 0x8e,
-	0x5f, 0xc6, 0x89, 0x47, 0x04, 0x86, 0x35, 0x34, 0xeb, 0x9f, 0x21, 0xe1, 0x45, 0x52, 0x28, 0x69,
-	0xbb, 0x64, 0xc5, 0x2b, 0x4d, 0x87, 0xf1, 0x5d, 0xd0, 0x3b, 0x6e, 0xd4, 0x75, 0xa7, 0xb2, 0xea,
-	0x19, 0xe1, 0x30, 0xb8, 0x9e, 0xf8, 0x76, 0xcd, 0xee, 0x54, 0x86, 0xfd, 0xba, 0x5d, 0x11, 0xba,
-	0xee, 0x34, 0x94, 0xa9, 0xce, 0x0d, 0xb9, 0x5e, 0xb3, 0x3b, 0x45, 0x95, 0xea, 0x90, 0xb9, 0xe7,
-	0xde, 0x50, 0xa4, 0xda, 0x23, 0xab, 0x35, 0x9f, 0x7b, 0x51, 0xa3, 0xda, 0x4b, 0x80, 0x30, 0x7f,
-	0xdd, 0xae, 0x1b, 0x35, 0xed, 0xe2, 0x7f, 0x63, 0x17, 0x7f, 0xcd, 0xae, 0x77, 0x6a, 0xda, 0xc5,
-	0x4f, 0xd9, 0xf5, 0x10, 0x4d, 0x72, 0x2a, 0x95, 0x2e, 0xc8, 0xbb, 0xf5, 0x98, 0xcf, 0xf1, 0x87,
-	0x4a, 0x17, 0xf8, 0x08, 0x5d, 0x1b, 0x76, 0xa5, 0xe1, 0x45, 0x9b, 0x02, 0x67, 0xc7, 0xe1, 0xfd,
-	0xf6, 0x5e, 0x3d, 0x15, 0x62, 0x63, 0xa7, 0x8a, 0x57, 0xec, 0x86, 0x63, 0xf6, 0x6f, 0xb9, 0x6f,
-	0xd1, 0x0c, 0x94, 0x46, 0xe4, 0x4a, 0x0e, 0x97, 0x7d, 0xad, 0xe6, 0xb2, 0x47, 0xbe, 0x6a, 0xd9,
-	0xfb, 0xe8, 0xaa, 0x3f, 0x91, 0x9d, 0x0e, 0x70, 0x2b, 0xfa, 0x55, 0xfb, 0x8d, 0x93, 0x24, 0x37,
-	0x6b, 0xce, 0xcc, 0x1d, 0xe4, 0x8a, 0x7a, 0x3f, 0x5c, 0xec, 0x9e, 0x18, 0x3f, 0x42, 0x37, 0xbb,
-	0x2c, 0xef, 0xf8, 0x3e, 0x5c, 0x6a, 0xc5, 0xc1, 0x98, 0xf8, 0x86, 0xf2, 0x4f, 0x77, 0x96, 0x1b,
-	0x0a, 0x32, 0x8d, 0x9f, 0x1c, 0xb7, 0xfc, 0x01, 0x5f, 0x75, 0x09, 0x3b, 0x6c, 0xb0, 0x1b, 0xe0,
-	0xfe, 0x55, 0x94, 0x44, 0xf0, 0xa6, 0x4c, 0xfd, 0x47, 0xc8, 0xbd, 0xe4, 0xd9, 0xcb, 0x56, 0xe3,
-	0xf9, 0xcb, 0x56, 0xe3, 0xf7, 0x97, 0xad, 0xc6, 0x0f, 0xaf, 0x5a, 0x13, 0xcf, 0x5f, 0xb5, 0x26,
-	0x7e, 0x7d, 0xd5, 0x9a, 0x78, 0xf2, 0xf1, 0x7f, 0x2c, 0x7e, 0xd0, 0x3e, 0xf9, 0x50, 0xb4, 0xc7,
-	0x25, 0x98, 0x83, 0x49, 0xff, 0x4d, 0xf7, 0xe1, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc9, 0x40,
-	0x13, 0xdd, 0x42, 0x0e, 0x00, 0x00,
+	// 1516 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0x4b, 0x6f, 0x5c, 0xb7,
+	0x15, 0xd6, 0xd4, 0xb6, 0x6c, 0xd3, 0xb2, 0x1e, 0xac, 0x2c, 0x51, 0xaf, 0xd1, 0xd4, 0x52, 0x5b,
+	0xd9, 0xb5, 0x35, 0x76, 0x5d, 0xf4, 0x8d, 0xa2, 0x96, 0x25, 0xd9, 0x42, 0x2d, 0x55, 0xb8, 0x52,
+	0x6d, 0xc0, 0x0d, 0xc0, 0x50, 0xf7, 0x9e, 0x99, 0x21, 0x74, 0x2f, 0x79, 0x43, 0x72, 0x46, 0x23,
+	0x2f, 0xb2, 0xcb, 0x3e, 0x3f, 0x23, 0xcb, 0x2c, 0xf2, 0x23, 0xbc, 0x34, 0xb2, 0x0a, 0xb2, 0x30,
+	0x02, 0x7b, 0x91, 0xff, 0x90, 0x55, 0xc0, 0xc7, 0x9d, 0x87, 0xe4, 0x04, 0x81, 0xef, 0x46, 0xd0,
+	0xe5, 0xf9, 0xce, 0x77, 0x0e, 0x3f, 0x92, 0xe7, 0x90, 0x83, 0xe6, 0x20, 0xe3, 0x5a, 0x73, 0x29,
+	0x74, 0xbd, 0x73, 0xbf, 0x9e, 0x33, 0xc5, 0x32, 0xbd, 0x9e, 0x2b, 0x69, 0x24, 0x1e, 0xeb, 0x99,
+	0xd6, 0x3b, 0xf7, 0xe7, 0xe7, 0x62, 0xa9, 0x33, 0xa9, 0xa9, 0xb3, 0xd5, 0xfd, 0x87, 0x07, 0xce,
+	0x4f, 0xb1, 0x8c, 0x0b, 0x59, 0x77, 0x7f, 0xc3, 0xd0, 0x74, 0x53, 0x36, 0xa5, 0x87, 0xda, 0xff,
+	0xfc, 0xe8, 0xcd, 0x1f, 0x6a, 0x68, 0x74, 0xdf, 0x85, 0xc0, 0x04, 0x5d, 0xee, 0x80, 0xb2, 0xec,
+	0xa4, 0x52, 0xab, 0xac, 0x5d, 0x8d, 0x8a, 0x4f, 0xfc, 0x37, 0x34, 0x97, 0xb1, 0x2e, 0xd5, 0xa0,
+	0x38, 0x4b, 0xf9, 0x4b, 0x48, 0x68, 0xa6, 0x9b, 0x34, 0x05, 0xd1, 0x34, 0x2d, 0xf2, 0xab, 0x5a,
+	0x65, 0xed, 0x42, 0x34, 0x93, 0xb1, 0xee, 0x41, 0xcf, 0xbe, 0xab, 0x9b, 0x4f, 0x9d, 0x15, 0x33,
+	0x34, 0x99, 0x71, 0x41, 0x8d, 0xcc, 0x79, 0x4c, 0x4f, 0x80, 0x37, 0x5b, 0x86, 0x5c, 0xb0, 0xec,
+	0x1b, 0x7f, 0x79, 0xf5, 0x66, 0x79, 0xe4, 0xdb, 0x37, 0xcb, 0xf5, 0x26, 0x37, 0xad, 0xf6, 0xd1,
+	0x7a, 0x2c, 0xb3, 0x3a, 0x4b, 0x53, 0xa9, 0xd8, 0x5d, 0x01, 0xe6, 0x44, 0xaa, 0xe3, 0xe2, 0x33,
+	0x6e, 0x31, 0x2e, 0xea, 0x19, 0x33, 0xad, 0xf5, 0x4d, 0x88, 0xa3, 0xf1, 0x8c, 0x8b, 0x43, 0xcb,
+	0xf7, 0xdc, 0xd1, 0xe1, 0x3a, 0x9a, 0xb6, 0xd9, 0xb9, 0x10, 0x9a, 0xe6, 0xa0, 0xe8, 0x51, 0x2a,
+	0xe3, 0x63, 0x72, 0xb1, 0x56, 0x59, 0xbb, 0x18, 0x4d, 0x65, 0xac, 0xeb, 0xd0, 0x7a, 0x1f, 0xd4,
+	0x86, 0x35, 0xe0, 0x06, 0x9a, 0x51, 0xf0, 0x49, 0x9b, 0x2b, 0x3b, 0x11, 0x2e, 0x78, 0xd6, 0xce,
+	0xa8, 0x36, 0xec, 0x18, 0xc8, 0x25, 0x97, 0xd9, 0xbd, 0x90, 0xd9, 0x0d, 0x2f, 0xa9, 0x4e, 0x8e,
+	0xd7, 0xb9, 0xf4, 0xf1, 0x77, 0x84, 0xf9, 0xfa, 0xab, 0xbb, 0x28, 0x68, 0xbd, 0x23, 0xcc, 0x17,
+	0xdf, 0x7f, 0x79, 0xbb, 0x12, 0x4d, 0x17, 0x7c, 0xbb, 0x9e, 0xee, 0xc0, 0xb2, 0x59, 0xd9, 0x14,
+	0x64, 0xb2, 0x03, 0x9e, 0x9d, 0x26, 0x90, 0xb2, 0x53, 0x7a, 0xc2, 0x45, 0x22, 0x4f, 0xc8, 0xa8,
+	0x97, 0xcd, 0x03, 0x1c, 0x7e, 0xd3, 0x9a, 0x9f, 0x3b, 0x2b, 0x5e, 0xf3, 0xb2, 0x41, 0x2e, 0xe3,
+	0x56, 0x21, 0xf4, 0x65, 0xe7, 0x61, 0x67, 0xbf, 0x65, 0x87, 0x83, 0xc0, 0x2f, 0xd0, 0xd8, 0x11,
+	0x18, 0x46, 0x41, 0x18, 0x25, 0xf3, 0x53, 0x72, 0xa5, 0x9c, 0xb8, 0xd7, 0x2c, 0xd9, 0x96, 0xe7,
+	0xc2, 0x1f, 0xa1, 0xeb, 0x29, 0x30, 0x25, 0xb8, 0x68, 0x52, 0xc5, 0x0c, 0x90, 0xab, 0xe5, 0xc8,
+	0xc7, 0x0a, 0xb6, 0x88, 0x19, 0xc0, 0x19, 0xb2, 0x9b, 0x86, 0x36, 0x15, 0x4b, 0x38, 0x08, 0x43,
+	0x4d, 0x4b, 0x81, 0x6e, 0xc9, 0x34, 0x21, 0xa8, 0x5c, 0x18, 0xbb, 0x1d, 0x1e, 0x07, 0xd6, 0xc3,
+	0x82, 0x14, 0x03, 0xc2, 0x56, 0x52, 0xbf, 0x14, 0x0d, 0xc5, 0x62, 0x63, 0x77, 0xfa, 0xb5, 0x72,
+	0xa1, 0xec, 0x2a, 0xb9, 0xc5, 0xdb, 0x0e, 0x84, 0x78, 0x0b, 0x2d, 0xdb, 0x59, 0xb5, 0x45, 0xa3,
+	0x9d, 0x36, 0x78, 0x9a, 0x42, 0x42, 0xad, 0x3f, 0x28, 0x6a, 0xf7, 0x08, 0x68, 0xa3, 0xc9, 0x75,
+	0xb7, 0x31, 0x17, 0x33, 0xd6, 0xfd, 0x5f, 0x1f, 0xf5, 0xdc, 0x81, 0xa2, 0x80, 0xc1, 0x8f, 0x51,
+	0xed, 0x2c, 0x8d, 0x82, 0xbc, 0x6d, 0x06, 0x79, 0xc6, 0x1d, 0xcf, 0xd2, 0x30, 0x4f, 0xe4, 0x51,
+	0x3d, 0xa2, 0x97, 0x68, 0xc9, 0x1f, 0x3e, 0x05, 0x27, 0x4c, 0x25, 0x61, 0xfe, 0x3c, 0xcb, 0xa5,
+	0x32, 0x4c, 0xc4, 0x40, 0x26, 0xca, 0x29, 0x30, 0xef, 0xd8, 0x23, 0x47, 0xee, 0x94, 0xd8, 0xe9,
+	0x51, 0xe3, 0xcf, 0x2a, 0x68, 0x65, 0x28, 0x78, 0x03, 0x80, 0x2a, 0xe8, 0x80, 0x68, 0x0f, 0xa5,
+	0x30, 0x59, 0x2e, 0x85, 0xe5, 0x81, 0x14, 0xb6, 0x01, 0x22, 0x1f, 0x60, 0x20, 0x0f, 0x40, 0x78,
+	0x28, 0x0d, 0x96, 0xe6, 0x2d, 0x46, 0xa6, 0x4a, 0x2e, 0xfd, 0x40, 0xd4, 0x87, 0x96, 0x10, 0xc7,
+	0x68, 0xca, 0x30, 0x7d, 0x3c, 0x1c, 0x05, 0x97, 0x8b, 0x32, 0x61, 0x19, 0x07, 0x83, 0x58, 0x4d,
+	0x3b, 0x2c, 0xe5, 0x09, 0x33, 0x52, 0x69, 0xda, 0xd1, 0xd4, 0x3b, 0xda, 0xc2, 0x17, 0xdb, 0x63,
+	0xe4, 0xa3, 0x93, 0x5f, 0x97, 0xd4, 0xb4, 0x1f, 0xe3, 0x99, 0x7e, 0xe8, 0x20, 0xfb, 0x3e, 0x80,
+	0x4f, 0x06, 0xff, 0x13, 0x2d, 0xb8, 0x9e, 0xc0, 0xb2, 0x3c, 0x05, 0x4d, 0x8d, 0xa4, 0x3a, 0x66,
+	0x29, 0x50, 0x1d, 0x4b, 0x05, 0x9a, 0x4c, 0xbb, 0xbd, 0x39, 0x6b, 0xbb, 0x82, 0x47, 0x1c, 0xca,
+	0x03, 0x6b, 0x3f, 0x70, 0x66, 0xfc, 0x77, 0x34, 0x1f, 0x6a, 0x36, 0xe5, 0xa2, 0x01, 0x0a, 0x94,
+	0xa3, 0x08, 0xb9, 0xdf, 0x70, 0xce, 0x33, 0xbe, 0x72, 0xef, 0x04, 0xfb, 0xa1, 0x0c, 0x91, 0xff,
+	0x8d, 0x96, 0x0a, 0xdf, 0x86, 0x54, 0x10, 0x33, 0x6d, 0x86, 0xdd, 0x67, 0x9c, 0xfb, 0x9c, 0x77,
+	0xdf, 0xee, 0x43, 0x7a, 0x0c, 0x03, 0xd1, 0xc3, 0xa1, 0x1a, 0x74, 0x9f, 0x1d, 0x8c, 0x1e, 0x8e,
+	0x53, 0xdf, 0xf7, 0x05, 0x9a, 0x8c, 0x15, 0x30, 0x03, 0xa1, 0xa7, 0x35, 0x00, 0x08, 0xf9, 0xc0,
+	0xb6, 0x31, 0xee, 0x99, 0x5c, 0x7b, 0xda, 0x06, 0xc0, 0xff, 0x40, 0xf3, 0xbd, 0x6a, 0x98, 0x80,
+	0x76, 0xcb, 0x69, 0x13, 0xe5, 0x36, 0x03, 0x32, 0xe7, 0x25, 0x2d, 0x10, 0x9b, 0x1e, 0xb0, 0xcb,
+	0xba, 0x3b, 0xd6, 0x8c, 0xff, 0x83, 0x56, 0x2c, 0x56, 0x81, 0x51, 0xdc, 0x2f, 0x88, 0xaf, 0x09,
+	0x54, 0x48, 0x11, 0x83, 0x0e, 0x55, 0x88, 0xcc, 0xbb, 0x2e, 0x52, 0xcd, 0x58, 0x37, 0xf2, 0xc8,
+	0x43, 0xb9, 0xed, 0x70, 0x7b, 0x0e, 0xe6, 0xcb, 0x10, 0xde, 0x45, 0xab, 0x3f, 0x4b, 0x16, 0x64,
+	0x23, 0x0b, 0x8e, 0x6d, 0xf9, 0xa7, 0xd8, 0x82, 0x7a, 0xf8, 0xff, 0x68, 0x52, 0x41, 0x93, 0x6b,
+	0xa3, 0x98, 0x2d, 0x92, 0x4e, 0xb4, 0xc5, 0x0f, 0x14, 0x6d, 0x62, 0x90, 0xc9, 0xaa, 0x76, 0x07,
+	0xe1, 0x04, 0x1a, 0xac, 0x9d, 0x1a, 0x9a, 0xb3, 0x26, 0xd0, 0x94, 0x67, 0xdc, 0x90, 0x25, 0xa7,
+	0xd6, 0x64, 0xb0, 0xec, 0xb3, 0x26, 0x3c, 0xb5, 0xe3, 0x78, 0x15, 0x8d, 0xdb, 0x99, 0x0d, 0x20,
+	0xab, 0x0e, 0x39, 0x96, 0xb1, 0x6e, 0x1f, 0x65, 0xf7, 0xd8, 0x99, 0xfe, 0x4b, 0x15, 0xc4, 0x52,
+	0x25, 0xc1, 0x69, 0xd9, 0x4d, 0x7c, 0x6e, 0xb8, 0x19, 0x47, 0x0e, 0xe1, 0x19, 0xd6, 0xd0, 0xa4,
+	0xbb, 0x86, 0xf8, 0x1b, 0x49, 0x26, 0x85, 0x69, 0x91, 0x9a, 0x8b, 0x34, 0xee, 0xc7, 0xf7, 0x41,
+	0xed, 0xda, 0x51, 0x5b, 0x9d, 0xf2, 0xa2, 0x66, 0xf8, 0xc3, 0x60, 0x6b, 0xe2, 0x6f, 0x4a, 0x56,
+	0xa7, 0xdc, 0xef, 0xd7, 0x9d, 0x82, 0xd0, 0x56, 0xa7, 0x5e, 0x98, 0xe2, 0xdc, 0x90, 0x9b, 0x25,
+	0xab, 0x53, 0x88, 0x52, 0x1c, 0x32, 0x7b, 0xdd, 0xeb, 0x05, 0x29, 0xf6, 0xc8, 0x4a, 0xc9, 0xeb,
+	0x5e, 0x88, 0x51, 0xec, 0x25, 0x40, 0x38, 0x3e, 0x2f, 0xd7, 0x6a, 0x49, 0xb9, 0xe2, 0xf7, 0xc8,
+	0x15, 0x9f, 0x93, 0xeb, 0xb7, 0x25, 0xe5, 0x8a, 0xcf, 0xc8, 0xb5, 0x87, 0x46, 0x63, 0x2a, 0xa4,
+	0xca, 0xc8, 0xef, 0xca, 0x31, 0x5f, 0x8a, 0xf7, 0xa4, 0xca, 0xf0, 0x09, 0x5a, 0xec, 0x55, 0xa5,
+	0x5e, 0xa3, 0x4d, 0x20, 0x66, 0xa7, 0xfe, 0xfe, 0xf6, 0xfb, 0x72, 0x51, 0x88, 0x09, 0x95, 0x2a,
+	0xb4, 0xd8, 0x4d, 0xcb, 0xec, 0xee, 0x72, 0x1f, 0xa3, 0x09, 0xc8, 0x35, 0x4f, 0xa5, 0xe8, 0x2d,
+	0xfb, 0x5a, 0xc9, 0x65, 0x0f, 0x7c, 0xc5, 0xb2, 0x77, 0xd0, 0x82, 0x3b, 0x91, 0x8d, 0x06, 0xc4,
+	0x86, 0x77, 0x8a, 0xf2, 0x1b, 0x26, 0x49, 0x6e, 0x95, 0x9c, 0x99, 0x3d, 0xc8, 0x05, 0xf5, 0xa1,
+	0x6f, 0xec, 0x8e, 0x18, 0x3f, 0x43, 0xb7, 0x5a, 0x2c, 0x6d, 0xb8, 0x3a, 0x9c, 0x2b, 0x19, 0x83,
+	0xd6, 0xe1, 0x0e, 0xe5, 0xae, 0xee, 0x2c, 0xd5, 0x14, 0x44, 0x12, 0x9e, 0x1c, 0xb7, 0xdd, 0x01,
+	0x5f, 0xb1, 0x0e, 0xbb, 0xac, 0xbb, 0xef, 0xe1, 0xee, 0x56, 0x14, 0x05, 0xf0, 0x96, 0x48, 0xfc,
+	0x23, 0xe4, 0x5f, 0x68, 0x21, 0x07, 0x15, 0x9e, 0x73, 0x90, 0x84, 0xe9, 0xb8, 0x86, 0x60, 0xef,
+	0xa5, 0x7f, 0xa8, 0x55, 0xd6, 0xae, 0x44, 0x73, 0x83, 0x10, 0x97, 0xd6, 0xa3, 0x00, 0xc0, 0x9f,
+	0xa2, 0xe5, 0xac, 0x9d, 0x1a, 0x4e, 0xcf, 0x16, 0x56, 0x9a, 0x70, 0x1d, 0xcb, 0xb6, 0x30, 0xe4,
+	0x4e, 0x39, 0x4d, 0x16, 0x1d, 0x7f, 0x34, 0x5c, 0x6d, 0x37, 0x03, 0xb9, 0xed, 0x55, 0xbd, 0x57,
+	0x17, 0x95, 0x6d, 0x93, 0xb7, 0x0d, 0x4d, 0x78, 0x06, 0xc2, 0x3d, 0x20, 0xef, 0xf6, 0xda, 0xbf,
+	0xcb, 0xfa, 0xbf, 0xce, 0xbe, 0x59, 0x98, 0x6d, 0xc9, 0xee, 0x3b, 0xb7, 0xa4, 0xe2, 0x2f, 0xa5,
+	0xd0, 0x64, 0xdd, 0x97, 0xec, 0xc2, 0xe9, 0x49, 0x18, 0xc7, 0x7f, 0x46, 0xb3, 0x2c, 0x36, 0x52,
+	0xd1, 0x16, 0xd7, 0x46, 0xaa, 0x53, 0xdb, 0x96, 0x40, 0x38, 0x99, 0xea, 0xae, 0x0c, 0xdf, 0x70,
+	0xe6, 0x27, 0xde, 0x1a, 0x15, 0x46, 0xbc, 0x87, 0x56, 0xcf, 0x96, 0x60, 0x1a, 0xb3, 0x94, 0x1f,
+	0x05, 0xb1, 0x40, 0xb0, 0xa3, 0x14, 0x12, 0x72, 0xcf, 0x69, 0x5d, 0x1b, 0x2e, 0xcb, 0x8f, 0xfa,
+	0xc0, 0x2d, 0x8f, 0x73, 0x0f, 0x4d, 0x2e, 0xe8, 0xb9, 0xb2, 0x7e, 0x3f, 0x3c, 0x34, 0xb9, 0xd8,
+	0x18, 0xae, 0xec, 0xe1, 0x65, 0x7a, 0xce, 0xe1, 0x8f, 0xbd, 0x97, 0xe9, 0x19, 0x87, 0x36, 0x5a,
+	0x78, 0x9f, 0x03, 0x8d, 0x5b, 0x4c, 0x34, 0x81, 0x3c, 0x28, 0xb7, 0xa0, 0xb3, 0xe7, 0x02, 0x3e,
+	0x72, 0xbc, 0xf6, 0x6c, 0xb9, 0x90, 0xd4, 0xf0, 0x0c, 0xa8, 0xce, 0xa4, 0x34, 0x2d, 0xfb, 0xe6,
+	0x4b, 0xa0, 0xa9, 0x00, 0xc8, 0x9f, 0x4a, 0x9e, 0x2d, 0xc7, 0x7d, 0xc8, 0x33, 0x38, 0x28, 0x98,
+	0x37, 0x1d, 0xf1, 0x46, 0xf4, 0xea, 0x6d, 0xb5, 0xf2, 0xfa, 0x6d, 0xb5, 0xf2, 0xdd, 0xdb, 0x6a,
+	0xe5, 0xf3, 0x77, 0xd5, 0x91, 0xd7, 0xef, 0xaa, 0x23, 0xdf, 0xbc, 0xab, 0x8e, 0xbc, 0xf8, 0xeb,
+	0x2f, 0x0c, 0xd2, 0xad, 0xf7, 0x7f, 0x2c, 0x31, 0xa7, 0x39, 0xe8, 0xa3, 0x51, 0xf7, 0xbb, 0xc6,
+	0x83, 0x1f, 0x07, 0x00, 0x08, 0xbb, 0xf4, 0x46, 0x46, 0x11, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -362,6 +441,101 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.BlockTimeSmoothingDegree.Size()
+		i -= size
+		if _, err := m.BlockTimeSmoothingDegree.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0xa2
+	{
+		size := m.MaxBlocksPerMonthChange.Size()
+		i -= size
+		if _, err := m.MaxBlocksPerMonthChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0x9a
+	if m.MaxBlocksPerMonth != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBlocksPerMonth))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.MinBlocksPerMonth != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinBlocksPerMonth))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.BlocksPerMonthCalibrationEnabled {
+		i--
+		if m.BlocksPerMonthCalibrationEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.ActorHistoryRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ActorHistoryRetention))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.MaxTopicHorizons != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTopicHorizons))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf0
+	}
+	if m.MaxTopicOutputDimension != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTopicOutputDimension))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe8
+	}
+	{
+		size := m.MultiRegistrationFeeDiscount.Size()
+		i -= size
+		if _, err := m.MultiRegistrationFeeDiscount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xe2
+	if m.PermissionedTopicCreation {
+		i--
+		if m.PermissionedTopicCreation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd8
+	}
 	if m.HalfMaxProcessStakeRemovalsEndBlock != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.HalfMaxProcessStakeRemovalsEndBlock))
 		i--
@@ -855,6 +1029,33 @@ func (m *Params) Size() (n int) {
 	if m.HalfMaxProcessStakeRemovalsEndBlock != 0 {
 		n += 2 + sovParams(uint64(m.HalfMaxProcessStakeRemovalsEndBlock))
 	}
+	if m.PermissionedTopicCreation {
+		n += 3
+	}
+	l = m.MultiRegistrationFeeDiscount.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.MaxTopicOutputDimension != 0 {
+		n += 2 + sovParams(uint64(m.MaxTopicOutputDimension))
+	}
+	if m.MaxTopicHorizons != 0 {
+		n += 2 + sovParams(uint64(m.MaxTopicHorizons))
+	}
+	if m.ActorHistoryRetention != 0 {
+		n += 2 + sovParams(uint64(m.ActorHistoryRetention))
+	}
+	if m.BlocksPerMonthCalibrationEnabled {
+		n += 3
+	}
+	if m.MinBlocksPerMonth != 0 {
+		n += 2 + sovParams(uint64(m.MinBlocksPerMonth))
+	}
+	if m.MaxBlocksPerMonth != 0 {
+		n += 2 + sovParams(uint64(m.MaxBlocksPerMonth))
+	}
+	l = m.MaxBlocksPerMonthChange.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.BlockTimeSmoothingDegree.Size()
+	n += 2 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -2015,6 +2216,243 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 43:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermissionedTopicCreation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PermissionedTopicCreation = bool(v != 0)
+		case 44:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiRegistrationFeeDiscount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MultiRegistrationFeeDiscount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 45:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopicOutputDimension", wireType)
+			}
+			m.MaxTopicOutputDimension = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTopicOutputDimension |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 46:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopicHorizons", wireType)
+			}
+			m.MaxTopicHorizons = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTopicHorizons |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 47:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorHistoryRetention", wireType)
+			}
+			m.ActorHistoryRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActorHistoryRetention |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 48:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerMonthCalibrationEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BlocksPerMonthCalibrationEnabled = bool(v != 0)
+		case 49:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBlocksPerMonth", wireType)
+			}
+			m.MinBlocksPerMonth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinBlocksPerMonth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 50:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlocksPerMonth", wireType)
+			}
+			m.MaxBlocksPerMonth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlocksPerMonth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 51:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlocksPerMonthChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBlocksPerMonthChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 52:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeSmoothingDegree", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockTimeSmoothingDegree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
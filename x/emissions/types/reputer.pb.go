@@ -28,6 +28,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type WorkerAttributedValue struct {
 	Worker string                                          `protobuf:"bytes,1,opt,name=worker,proto3" json:"worker,omitempty"`
 	Value  github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,2,opt,name=value,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"value"`
+	// components of the value in topics with an output dimension above 1
+	Values []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,3,rep,name=values,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"values"`
 }
 
 func (m *WorkerAttributedValue) Reset()         { *m = WorkerAttributedValue{} }
@@ -73,6 +75,8 @@ func (m *WorkerAttributedValue) GetWorker() string {
 type WithheldWorkerAttributedValue struct {
 	Worker string                                          `protobuf:"bytes,1,opt,name=worker,proto3" json:"worker,omitempty"`
 	Value  github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,2,opt,name=value,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"value"`
+	// components of the value in topics with an output dimension above 1
+	Values []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,3,rep,name=values,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"values"`
 }
 
 func (m *WithheldWorkerAttributedValue) Reset()         { *m = WithheldWorkerAttributedValue{} }
@@ -141,6 +145,10 @@ type ValueBundle struct {
 	// aka one_in_forecaster_values because equivalent to using only one
 	// forecast-implied inference
 	OneInForecasterValues []*WorkerAttributedValue `protobuf:"bytes,11,rep,name=one_in_forecaster_values,json=oneInForecasterValues,proto3" json:"one_in_forecaster_values,omitempty"`
+	// Components of combined_value and naive_value in topics with an output dimension above 1.
+	// The scalar fields then hold the first component
+	CombinedValues []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,12,rep,name=combined_values,json=combinedValues,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"combined_values"`
+	NaiveValues    []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,13,rep,name=naive_values,json=naiveValues,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"naive_values"`
 }
 
 func (m *ValueBundle) Reset()         { *m = ValueBundle{} }
@@ -356,46 +364,48 @@ func init() {
 func init() { proto.RegisterFile("emissions/v1/reputer.proto", fileDescriptor_87b9bd856742251e) }
 
 var fileDescriptor_87b9bd856742251e = []byte{
-	// 613 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x8d, 0x69, 0x9b, 0x36, 0xe3, 0xb4, 0x02, 0x97, 0x56, 0x6e, 0x45, 0xdd, 0x50, 0x2e, 0x95,
-	0x10, 0xb6, 0x5a, 0x0e, 0x20, 0xc4, 0x85, 0xaa, 0x42, 0x2a, 0x12, 0x1f, 0x5a, 0x01, 0x45, 0x08,
-	0x61, 0xd6, 0xf6, 0x34, 0x59, 0x35, 0xd9, 0x0d, 0xeb, 0x75, 0x68, 0xef, 0x1c, 0x11, 0xe2, 0x27,
-	0xf0, 0x4b, 0x38, 0xf7, 0xd8, 0x23, 0xe2, 0x50, 0xa1, 0xe6, 0xc2, 0xcf, 0x40, 0x5e, 0x3b, 0xc4,
-	0xf9, 0x10, 0x0a, 0xea, 0x85, 0x9b, 0x67, 0x76, 0xe6, 0xbd, 0x99, 0xb7, 0x7e, 0x0b, 0xab, 0xd8,
-	0x62, 0x71, 0xcc, 0x04, 0x8f, 0xbd, 0xce, 0x96, 0x27, 0xb1, 0x9d, 0x28, 0x94, 0x6e, 0x5b, 0x0a,
-	0x25, 0xac, 0xea, 0x9f, 0x33, 0xb7, 0xb3, 0xb5, 0x7a, 0xb5, 0x2e, 0xea, 0x42, 0x1f, 0x78, 0xe9,
-	0x57, 0x56, 0xb3, 0x6a, 0x0f, 0xf4, 0x73, 0xc1, 0x43, 0xcc, 0x4e, 0x36, 0x3e, 0x1a, 0xb0, 0xb4,
-	0x2f, 0xe4, 0x21, 0xca, 0x07, 0x4a, 0x49, 0x16, 0x24, 0x0a, 0xa3, 0x97, 0xb4, 0x99, 0xa0, 0xb5,
-	0x0c, 0xe5, 0x0f, 0xfa, 0xc0, 0x36, 0x6a, 0xc6, 0x66, 0x85, 0xe4, 0x91, 0xf5, 0x18, 0x66, 0x3a,
-	0x69, 0x81, 0x7d, 0x29, 0x4d, 0xef, 0xdc, 0x39, 0x39, 0x5b, 0x2f, 0xfd, 0x38, 0x5b, 0xf7, 0xea,
-	0x4c, 0x35, 0x92, 0xc0, 0x0d, 0x45, 0xcb, 0xa3, 0xcd, 0xa6, 0x90, 0xf4, 0x16, 0x47, 0x95, 0xf6,
-	0xf4, 0xc2, 0xb0, 0x41, 0x19, 0xf7, 0x5a, 0x54, 0x35, 0xdc, 0x5d, 0x0c, 0x49, 0x86, 0x72, 0x6f,
-	0xfa, 0xd7, 0xd7, 0x75, 0x63, 0xe3, 0x93, 0x01, 0x6b, 0xfb, 0x4c, 0x35, 0x1a, 0xd8, 0x8c, 0xfe,
-	0x83, 0x71, 0xbe, 0x95, 0xc1, 0xd4, 0xb4, 0x3b, 0x09, 0x8f, 0x9a, 0x68, 0xad, 0xc0, 0x9c, 0x12,
-	0x6d, 0x16, 0xfa, 0x2c, 0xd2, 0xf4, 0xd3, 0x64, 0x56, 0xc7, 0x7b, 0x91, 0xf5, 0x02, 0x96, 0xf2,
-	0xfb, 0xf0, 0x25, 0xbe, 0x4f, 0x30, 0x56, 0xbe, 0xd6, 0x57, 0xcf, 0x63, 0x6e, 0x5f, 0x77, 0x8b,
-	0xd7, 0xe3, 0x92, 0xac, 0x94, 0x64, 0x95, 0x4f, 0xd2, 0x42, 0xb2, 0x28, 0x47, 0x93, 0x96, 0x0d,
-	0xb3, 0x79, 0xda, 0x9e, 0xd2, 0xfb, 0xf6, 0x42, 0x6b, 0x0d, 0x00, 0x8f, 0x94, 0xa4, 0x7e, 0x44,
-	0x15, 0xb5, 0xa7, 0x6b, 0xc6, 0x66, 0x95, 0x54, 0x74, 0x66, 0x97, 0x2a, 0x6a, 0xbd, 0x85, 0x85,
-	0x50, 0xb4, 0x02, 0xc6, 0x31, 0xf2, 0x33, 0x61, 0x66, 0x2e, 0x26, 0xcc, 0x7c, 0x0f, 0x2e, 0xbb,
-	0x87, 0x47, 0xb0, 0xc0, 0xf8, 0x01, 0x4a, 0x94, 0x19, 0x7c, 0x6c, 0x97, 0x6b, 0x53, 0x9b, 0xe6,
-	0xf6, 0x8d, 0xc1, 0x45, 0xc7, 0x5e, 0x22, 0x99, 0xcf, 0x5b, 0x75, 0x14, 0x5b, 0xcf, 0xe0, 0xca,
-	0x81, 0x90, 0x18, 0xd2, 0x58, 0xf5, 0xe1, 0x66, 0x27, 0x87, 0xbb, 0xdc, 0xef, 0xce, 0x11, 0x5f,
-	0x81, 0xc9, 0x29, 0xeb, 0x60, 0xbe, 0xfa, 0xdc, 0xc5, 0x56, 0x07, 0x8d, 0x95, 0xed, 0xfd, 0x0e,
-	0x96, 0x05, 0x47, 0x5f, 0x24, 0xca, 0x1f, 0xda, 0xbf, 0xa2, 0x07, 0xbe, 0x39, 0x34, 0xf0, 0xdf,
-	0x7e, 0x66, 0xb2, 0x28, 0x38, 0x3e, 0x4d, 0xd4, 0xde, 0x80, 0x1a, 0x07, 0xb0, 0xd2, 0x63, 0x18,
-	0x55, 0x05, 0xfe, 0x9d, 0x64, 0x39, 0x23, 0x79, 0x38, 0xac, 0xd1, 0x1b, 0xb0, 0x53, 0x1e, 0xc6,
-	0xc7, 0xd0, 0x98, 0x93, 0x8b, 0xbf, 0x24, 0x38, 0xee, 0xf1, 0x61, 0xf4, 0xdc, 0x40, 0x9f, 0x0d,
-	0xb0, 0xf2, 0x7f, 0xbd, 0xe8, 0xa3, 0xfb, 0x50, 0xd5, 0x44, 0x7e, 0xa0, 0x63, 0xed, 0x25, 0x73,
-	0x7b, 0x65, 0x90, 0xae, 0xd0, 0x40, 0xcc, 0x4e, 0xa1, 0xfb, 0x1a, 0x54, 0x62, 0x56, 0xe7, 0x54,
-	0x25, 0x32, 0xb3, 0x57, 0x95, 0xf4, 0x13, 0xe9, 0x03, 0xd1, 0x4e, 0x82, 0x43, 0x3c, 0xce, 0x0d,
-	0x93, 0x47, 0xf9, 0x40, 0x87, 0xb0, 0x38, 0x3a, 0x4f, 0x6c, 0x3d, 0xef, 0xbb, 0xb7, 0x38, 0x58,
-	0x6c, 0x1b, 0x5a, 0x88, 0xda, 0x58, 0xf7, 0x16, 0x07, 0xec, 0x99, 0xb7, 0x88, 0xba, 0x43, 0x4e,
-	0xce, 0x1d, 0xe3, 0xf4, 0xdc, 0x31, 0x7e, 0x9e, 0x3b, 0xc6, 0x97, 0xae, 0x53, 0x3a, 0xed, 0x3a,
-	0xa5, 0xef, 0x5d, 0xa7, 0xf4, 0xfa, 0xee, 0x84, 0xbf, 0xe0, 0x91, 0xd7, 0x7f, 0xb1, 0xd5, 0x71,
-	0x1b, 0xe3, 0xa0, 0xac, 0xdf, 0xeb, 0xdb, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xf3, 0xdb, 0x50,
-	0x71, 0x0b, 0x06, 0x00, 0x00,
+	// 652 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x55, 0x51, 0x4f, 0x13, 0x41,
+	0x10, 0xee, 0x59, 0x68, 0xe9, 0x5c, 0x41, 0xdd, 0x0a, 0x39, 0x88, 0x94, 0x8a, 0x2f, 0x24, 0xc6,
+	0x5e, 0xc0, 0x07, 0x8d, 0xf1, 0x45, 0x42, 0x4c, 0x30, 0x51, 0xcc, 0x45, 0xc5, 0x10, 0xe3, 0xb1,
+	0xbd, 0x1b, 0xda, 0x0d, 0xed, 0x6e, 0xdd, 0xdd, 0xab, 0xf0, 0x27, 0x8c, 0x3f, 0xc1, 0x9f, 0xc3,
+	0x23, 0x2f, 0x26, 0xc6, 0x44, 0x62, 0xe0, 0xc5, 0x1f, 0xe1, 0x83, 0xb9, 0xbd, 0xab, 0xbd, 0x52,
+	0x62, 0x30, 0x7d, 0xf3, 0xed, 0x66, 0x76, 0xe7, 0x9b, 0xf9, 0xbe, 0x9d, 0x99, 0x83, 0x05, 0xec,
+	0x30, 0xa5, 0x98, 0xe0, 0xca, 0xed, 0xad, 0xba, 0x12, 0xbb, 0x91, 0x46, 0x59, 0xef, 0x4a, 0xa1,
+	0x05, 0x29, 0xff, 0x39, 0xab, 0xf7, 0x56, 0x17, 0x6e, 0x34, 0x45, 0x53, 0x98, 0x03, 0x37, 0xfe,
+	0x4a, 0xee, 0x2c, 0x38, 0x43, 0xf1, 0x5c, 0xf0, 0x00, 0x93, 0x93, 0xe5, 0x2f, 0x16, 0xcc, 0x6e,
+	0x0b, 0xb9, 0x8f, 0xf2, 0xb1, 0xd6, 0x92, 0x35, 0x22, 0x8d, 0xe1, 0x6b, 0xda, 0x8e, 0x90, 0xcc,
+	0x41, 0xe1, 0x83, 0x39, 0x70, 0xac, 0x9a, 0xb5, 0x52, 0xf2, 0x52, 0x8b, 0x3c, 0x83, 0xc9, 0x5e,
+	0x7c, 0xc1, 0xb9, 0x12, 0xbb, 0xd7, 0xef, 0x1f, 0x9d, 0x2c, 0xe5, 0xbe, 0x9d, 0x2c, 0xb9, 0x4d,
+	0xa6, 0x5b, 0x51, 0xa3, 0x1e, 0x88, 0x8e, 0x4b, 0xdb, 0x6d, 0x21, 0xe9, 0x5d, 0x8e, 0x3a, 0x8e,
+	0xe9, 0x9b, 0x41, 0x8b, 0x32, 0xee, 0x76, 0xa8, 0x6e, 0xd5, 0x37, 0x30, 0xf0, 0x12, 0x14, 0xb2,
+	0x05, 0x05, 0xf3, 0xa1, 0x9c, 0x7c, 0x2d, 0x3f, 0x0e, 0x5e, 0x0a, 0xf3, 0x70, 0xe2, 0xe7, 0xe7,
+	0x25, 0x6b, 0xf9, 0xbb, 0x05, 0x8b, 0xdb, 0x4c, 0xb7, 0x5a, 0xd8, 0x0e, 0xff, 0x47, 0x7e, 0xbf,
+	0x8a, 0x60, 0x1b, 0x1e, 0xeb, 0x11, 0x0f, 0xdb, 0x48, 0xe6, 0x61, 0x4a, 0x8b, 0x2e, 0x0b, 0x7c,
+	0x16, 0x1a, 0x3e, 0x13, 0x5e, 0xd1, 0xd8, 0x9b, 0x21, 0x79, 0x05, 0xb3, 0x69, 0xc7, 0xf8, 0x12,
+	0xdf, 0x47, 0xa8, 0xb4, 0x6f, 0x3a, 0xc0, 0x10, 0xb4, 0xd7, 0x6e, 0xd5, 0xb3, 0x0d, 0x54, 0xf7,
+	0x92, 0xab, 0x5e, 0x72, 0xf3, 0x79, 0x7c, 0xd1, 0xab, 0xc8, 0x51, 0x27, 0x71, 0xa0, 0x98, 0xba,
+	0x9d, 0xbc, 0x11, 0xb0, 0x6f, 0x92, 0x45, 0x00, 0x3c, 0xd0, 0x92, 0xfa, 0x21, 0xd5, 0xd4, 0x99,
+	0xa8, 0x59, 0x2b, 0x65, 0xaf, 0x64, 0x3c, 0x1b, 0x54, 0x53, 0xf2, 0x0e, 0x66, 0x02, 0xd1, 0x69,
+	0x30, 0x8e, 0xa1, 0x9f, 0x28, 0x3d, 0x39, 0x9e, 0xd2, 0xd3, 0x7d, 0xb8, 0xe4, 0x61, 0x9f, 0xc2,
+	0x0c, 0xe3, 0x7b, 0x28, 0x51, 0xfa, 0xa9, 0xf2, 0x85, 0x5a, 0x7e, 0xc5, 0x5e, 0xbb, 0x3d, 0x4c,
+	0xf4, 0xc2, 0xae, 0xf0, 0xa6, 0xd3, 0x50, 0x63, 0x29, 0xf2, 0x02, 0xae, 0xef, 0x09, 0x89, 0x01,
+	0x55, 0x7a, 0x00, 0x57, 0xbc, 0x3c, 0xdc, 0xb5, 0x41, 0x74, 0x8a, 0xf8, 0x06, 0x6c, 0x4e, 0x59,
+	0x0f, 0x53, 0xea, 0x53, 0xe3, 0x51, 0x07, 0x83, 0x95, 0xf0, 0xde, 0x85, 0x39, 0xc1, 0xd1, 0x17,
+	0x91, 0xf6, 0xcf, 0xf1, 0x2f, 0x99, 0x82, 0xef, 0x9c, 0x2b, 0xf8, 0x6f, 0xd3, 0xe1, 0x55, 0x04,
+	0xc7, 0xad, 0x48, 0x6f, 0x0e, 0xa9, 0xb1, 0x07, 0xf3, 0xfd, 0x0c, 0xa3, 0xaa, 0xc0, 0xbf, 0x27,
+	0x99, 0x4b, 0x92, 0x3c, 0x39, 0xaf, 0xd1, 0x5b, 0x70, 0xe2, 0x3c, 0x8c, 0x5f, 0x90, 0xc6, 0xbe,
+	0xbc, 0xf8, 0xb3, 0x82, 0xe3, 0x26, 0x1f, 0x41, 0xdf, 0x85, 0xab, 0xc3, 0xfd, 0xa7, 0x9c, 0xf2,
+	0x78, 0xa3, 0x39, 0x33, 0xd4, 0x80, 0x8a, 0xec, 0x40, 0x39, 0xf3, 0xc6, 0xca, 0x99, 0x1e, 0x0f,
+	0xde, 0x1e, 0x3c, 0x72, 0x7f, 0xfc, 0x3f, 0x5a, 0x40, 0xd2, 0x49, 0xcd, 0x6e, 0x81, 0x47, 0x50,
+	0x36, 0x29, 0xfd, 0x86, 0xb1, 0xcd, 0x26, 0xb0, 0xd7, 0xe6, 0x87, 0xc5, 0xca, 0x04, 0x78, 0x76,
+	0x2f, 0x13, 0x7d, 0x13, 0x4a, 0x8a, 0x35, 0x39, 0xd5, 0x91, 0x4c, 0x96, 0x43, 0xd9, 0x1b, 0x38,
+	0xe2, 0x7d, 0xd9, 0x8d, 0x1a, 0xfb, 0x78, 0x98, 0x8e, 0x7b, 0x6a, 0xa5, 0x05, 0xed, 0x43, 0x65,
+	0xb4, 0x1e, 0x45, 0x5e, 0x0e, 0x76, 0x4f, 0xb6, 0x30, 0xe5, 0x58, 0xe6, 0x19, 0x6b, 0x17, 0xee,
+	0x9e, 0x6c, 0x81, 0x15, 0x39, 0xe2, 0x53, 0xeb, 0xde, 0xd1, 0x69, 0xd5, 0x3a, 0x3e, 0xad, 0x5a,
+	0x3f, 0x4e, 0xab, 0xd6, 0xa7, 0xb3, 0x6a, 0xee, 0xf8, 0xac, 0x9a, 0xfb, 0x7a, 0x56, 0xcd, 0xed,
+	0x3c, 0xb8, 0xa4, 0xb6, 0x07, 0xee, 0xe0, 0x8f, 0xa8, 0x0f, 0xbb, 0xa8, 0x1a, 0x05, 0xf3, 0x3f,
+	0xbc, 0xf7, 0x7b, 0x00, 0x39, 0x28, 0xc6, 0x64, 0x6b, 0x07, 0x00, 0x00,
 }
 
 func (this *WorkerAttributedValue) Equal(that interface{}) bool {
@@ -423,6 +433,14 @@ func (this *WorkerAttributedValue) Equal(that interface{}) bool {
 	if !this.Value.Equal(that1.Value) {
 		return false
 	}
+	if len(this.Values) != len(that1.Values) {
+		return false
+	}
+	for i := range this.Values {
+		if !this.Values[i].Equal(that1.Values[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *WithheldWorkerAttributedValue) Equal(that interface{}) bool {
@@ -450,6 +468,14 @@ func (this *WithheldWorkerAttributedValue) Equal(that interface{}) bool {
 	if !this.Value.Equal(that1.Value) {
 		return false
 	}
+	if len(this.Values) != len(that1.Values) {
+		return false
+	}
+	for i := range this.Values {
+		if !this.Values[i].Equal(that1.Values[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *ValueBundle) Equal(that interface{}) bool {
@@ -529,6 +555,22 @@ func (this *ValueBundle) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.CombinedValues) != len(that1.CombinedValues) {
+		return false
+	}
+	for i := range this.CombinedValues {
+		if !this.CombinedValues[i].Equal(that1.CombinedValues[i]) {
+			return false
+		}
+	}
+	if len(this.NaiveValues) != len(that1.NaiveValues) {
+		return false
+	}
+	for i := range this.NaiveValues {
+		if !this.NaiveValues[i].Equal(that1.NaiveValues[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *ReputerValueBundle) Equal(that interface{}) bool {
@@ -581,6 +623,20 @@ func (m *WorkerAttributedValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Values[iNdEx].Size()
+				i -= size
+				if _, err := m.Values[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintReputer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size := m.Value.Size()
 		i -= size
@@ -621,6 +677,20 @@ func (m *WithheldWorkerAttributedValue) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Values[iNdEx].Size()
+				i -= size
+				if _, err := m.Values[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintReputer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size := m.Value.Size()
 		i -= size
@@ -661,6 +731,34 @@ func (m *ValueBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.NaiveValues) > 0 {
+		for iNdEx := len(m.NaiveValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.NaiveValues[iNdEx].Size()
+				i -= size
+				if _, err := m.NaiveValues[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintReputer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.CombinedValues) > 0 {
+		for iNdEx := len(m.CombinedValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.CombinedValues[iNdEx].Size()
+				i -= size
+				if _, err := m.CombinedValues[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintReputer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if len(m.OneInForecasterValues) > 0 {
 		for iNdEx := len(m.OneInForecasterValues) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -894,6 +992,12 @@ func (m *WorkerAttributedValue) Size() (n int) {
 	}
 	l = m.Value.Size()
 	n += 1 + l + sovReputer(uint64(l))
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.Size()
+			n += 1 + l + sovReputer(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -909,6 +1013,12 @@ func (m *WithheldWorkerAttributedValue) Size() (n int) {
 	}
 	l = m.Value.Size()
 	n += 1 + l + sovReputer(uint64(l))
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.Size()
+			n += 1 + l + sovReputer(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -967,6 +1077,18 @@ func (m *ValueBundle) Size() (n int) {
 			n += 1 + l + sovReputer(uint64(l))
 		}
 	}
+	if len(m.CombinedValues) > 0 {
+		for _, e := range m.CombinedValues {
+			l = e.Size()
+			n += 1 + l + sovReputer(uint64(l))
+		}
+	}
+	if len(m.NaiveValues) > 0 {
+		for _, e := range m.NaiveValues {
+			l = e.Size()
+			n += 1 + l + sovReputer(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1107,6 +1229,42 @@ func (m *WorkerAttributedValue) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReputer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.Values = append(m.Values, v)
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReputer(dAtA[iNdEx:])
@@ -1223,6 +1381,42 @@ func (m *WithheldWorkerAttributedValue) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReputer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.Values = append(m.Values, v)
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReputer(dAtA[iNdEx:])
@@ -1632,6 +1826,78 @@ func (m *ValueBundle) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CombinedValues", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReputer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.CombinedValues = append(m.CombinedValues, v)
+			if err := m.CombinedValues[len(m.CombinedValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NaiveValues", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReputer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.NaiveValues = append(m.NaiveValues, v)
+			if err := m.NaiveValues[len(m.NaiveValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReputer(dAtA[iNdEx:])
@@ -0,0 +1,2326 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: emissions/v1/reward.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_allora_network_allora_chain_math "github.com/allora-network/allora-chain/math"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// How the reward of a horizon of a topic was split between tasks and actors at a reward nonce
+type RewardBreakdown struct {
+	TopicId     uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight int64  `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Horizon     uint32 `protobuf:"varint,3,opt,name=horizon,proto3" json:"horizon,omitempty"`
+	// E_i
+	TopicReward github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,4,opt,name=topic_reward,json=topicReward,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"topic_reward"`
+	// F_i
+	InferenceEntropy github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,5,opt,name=inference_entropy,json=inferenceEntropy,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"inference_entropy"`
+	// G_i
+	ForecastingEntropy github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,6,opt,name=forecasting_entropy,json=forecastingEntropy,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"forecasting_entropy"`
+	// H_i
+	ReputerEntropy github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,7,opt,name=reputer_entropy,json=reputerEntropy,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"reputer_entropy"`
+	Chi            github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,8,opt,name=chi,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"chi"`
+	Gamma          github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,9,opt,name=gamma,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"gamma"`
+	// U_i
+	InferenceTaskReward github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,10,opt,name=inference_task_reward,json=inferenceTaskReward,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"inference_task_reward"`
+	// V_i
+	ForecastingTaskReward github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,11,opt,name=forecasting_task_reward,json=forecastingTaskReward,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"forecasting_task_reward"`
+	// W_i
+	ReputerTaskReward github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,12,opt,name=reputer_task_reward,json=reputerTaskReward,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"reputer_task_reward"`
+	Actors            []*ActorRewardBreakdown                         `protobuf:"bytes,13,rep,name=actors,proto3" json:"actors,omitempty"`
+}
+
+func (m *RewardBreakdown) Reset()         { *m = RewardBreakdown{} }
+func (m *RewardBreakdown) String() string { return proto.CompactTextString(m) }
+func (*RewardBreakdown) ProtoMessage()    {}
+func (*RewardBreakdown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15fe9a36ca4dc2fc, []int{0}
+}
+func (m *RewardBreakdown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardBreakdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardBreakdown.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardBreakdown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardBreakdown.Merge(m, src)
+}
+func (m *RewardBreakdown) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardBreakdown) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardBreakdown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardBreakdown proto.InternalMessageInfo
+
+func (m *RewardBreakdown) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *RewardBreakdown) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *RewardBreakdown) GetHorizon() uint32 {
+	if m != nil {
+		return m.Horizon
+	}
+	return 0
+}
+
+func (m *RewardBreakdown) GetActors() []*ActorRewardBreakdown {
+	if m != nil {
+		return m.Actors
+	}
+	return nil
+}
+
+// Score, reward fraction and payout of an actor for one task.
+// A worker who both inferred and forecasted has one entry per task.
+type ActorRewardBreakdown struct {
+	Address        string                                          `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	ActorType      ActorType                                       `protobuf:"varint,2,opt,name=actor_type,json=actorType,proto3,enum=emissions.v1.ActorType" json:"actor_type,omitempty"`
+	Score          github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,3,opt,name=score,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"score"`
+	RewardFraction github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,4,opt,name=reward_fraction,json=rewardFraction,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"reward_fraction"`
+	// paid to the actor. The share of a reputer's reward owed to its delegators is not included
+	Reward github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,5,opt,name=reward,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"reward"`
+}
+
+func (m *ActorRewardBreakdown) Reset()         { *m = ActorRewardBreakdown{} }
+func (m *ActorRewardBreakdown) String() string { return proto.CompactTextString(m) }
+func (*ActorRewardBreakdown) ProtoMessage()    {}
+func (*ActorRewardBreakdown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15fe9a36ca4dc2fc, []int{1}
+}
+func (m *ActorRewardBreakdown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActorRewardBreakdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActorRewardBreakdown.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActorRewardBreakdown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActorRewardBreakdown.Merge(m, src)
+}
+func (m *ActorRewardBreakdown) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActorRewardBreakdown) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActorRewardBreakdown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActorRewardBreakdown proto.InternalMessageInfo
+
+func (m *ActorRewardBreakdown) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ActorRewardBreakdown) GetActorType() ActorType {
+	if m != nil {
+		return m.ActorType
+	}
+	return ActorType_INFERER
+}
+
+// Reward breakdowns of every horizon of a topic rewarded at a block
+type RewardBreakdowns struct {
+	Horizons []*RewardBreakdown `protobuf:"bytes,1,rep,name=horizons,proto3" json:"horizons,omitempty"`
+}
+
+func (m *RewardBreakdowns) Reset()         { *m = RewardBreakdowns{} }
+func (m *RewardBreakdowns) String() string { return proto.CompactTextString(m) }
+func (*RewardBreakdowns) ProtoMessage()    {}
+func (*RewardBreakdowns) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15fe9a36ca4dc2fc, []int{2}
+}
+func (m *RewardBreakdowns) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardBreakdowns) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardBreakdowns.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardBreakdowns) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardBreakdowns.Merge(m, src)
+}
+func (m *RewardBreakdowns) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardBreakdowns) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardBreakdowns.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardBreakdowns proto.InternalMessageInfo
+
+func (m *RewardBreakdowns) GetHorizons() []*RewardBreakdown {
+	if m != nil {
+		return m.Horizons
+	}
+	return nil
+}
+
+// Reward an actor would be paid for a task of a horizon of a topic
+type ProjectedTaskReward struct {
+	Address string                                          `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Reward  github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,2,opt,name=reward,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"reward"`
+	TopicId uint64                                          `protobuf:"varint,3,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Horizon uint32                                          `protobuf:"varint,4,opt,name=horizon,proto3" json:"horizon,omitempty"`
+	// block of the losses the reward is calculated from
+	RewardNonce int64 `protobuf:"varint,5,opt,name=reward_nonce,json=rewardNonce,proto3" json:"reward_nonce,omitempty"`
+	// ReputerAndDelegator, WorkerInference or WorkerForecast
+	RewardType string `protobuf:"bytes,6,opt,name=reward_type,json=rewardType,proto3" json:"reward_type,omitempty"`
+}
+
+func (m *ProjectedTaskReward) Reset()         { *m = ProjectedTaskReward{} }
+func (m *ProjectedTaskReward) String() string { return proto.CompactTextString(m) }
+func (*ProjectedTaskReward) ProtoMessage()    {}
+func (*ProjectedTaskReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15fe9a36ca4dc2fc, []int{3}
+}
+func (m *ProjectedTaskReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectedTaskReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectedTaskReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProjectedTaskReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectedTaskReward.Merge(m, src)
+}
+func (m *ProjectedTaskReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectedTaskReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectedTaskReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectedTaskReward proto.InternalMessageInfo
+
+func (m *ProjectedTaskReward) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ProjectedTaskReward) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *ProjectedTaskReward) GetHorizon() uint32 {
+	if m != nil {
+		return m.Horizon
+	}
+	return 0
+}
+
+func (m *ProjectedTaskReward) GetRewardNonce() int64 {
+	if m != nil {
+		return m.RewardNonce
+	}
+	return 0
+}
+
+func (m *ProjectedTaskReward) GetRewardType() string {
+	if m != nil {
+		return m.RewardType
+	}
+	return ""
+}
+
+// Reward paid to an actor for a task of a topic
+type PaidReward struct {
+	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	// block at which the reward was paid
+	BlockHeight int64  `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Address     string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// ReputerAndDelegator, WorkerInference or WorkerForecast
+	RewardType string                                          `protobuf:"bytes,4,opt,name=reward_type,json=rewardType,proto3" json:"reward_type,omitempty"`
+	Reward     github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,5,opt,name=reward,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"reward"`
+}
+
+func (m *PaidReward) Reset()         { *m = PaidReward{} }
+func (m *PaidReward) String() string { return proto.CompactTextString(m) }
+func (*PaidReward) ProtoMessage()    {}
+func (*PaidReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15fe9a36ca4dc2fc, []int{4}
+}
+func (m *PaidReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PaidReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PaidReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PaidReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaidReward.Merge(m, src)
+}
+func (m *PaidReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *PaidReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaidReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaidReward proto.InternalMessageInfo
+
+func (m *PaidReward) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *PaidReward) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *PaidReward) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PaidReward) GetRewardType() string {
+	if m != nil {
+		return m.RewardType
+	}
+	return ""
+}
+
+// Rewards paid to an actor for the tasks of a topic at a block
+type PaidRewards struct {
+	Rewards []*PaidReward `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
+}
+
+func (m *PaidRewards) Reset()         { *m = PaidRewards{} }
+func (m *PaidRewards) String() string { return proto.CompactTextString(m) }
+func (*PaidRewards) ProtoMessage()    {}
+func (*PaidRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15fe9a36ca4dc2fc, []int{5}
+}
+func (m *PaidRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PaidRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PaidRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PaidRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaidRewards.Merge(m, src)
+}
+func (m *PaidRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *PaidRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaidRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaidRewards proto.InternalMessageInfo
+
+func (m *PaidRewards) GetRewards() []*PaidReward {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*RewardBreakdown)(nil), "emissions.v1.RewardBreakdown")
+	proto.RegisterType((*ActorRewardBreakdown)(nil), "emissions.v1.ActorRewardBreakdown")
+	proto.RegisterType((*RewardBreakdowns)(nil), "emissions.v1.RewardBreakdowns")
+	proto.RegisterType((*ProjectedTaskReward)(nil), "emissions.v1.ProjectedTaskReward")
+	proto.RegisterType((*PaidReward)(nil), "emissions.v1.PaidReward")
+	proto.RegisterType((*PaidRewards)(nil), "emissions.v1.PaidRewards")
+}
+
+func init() { proto.RegisterFile("emissions/v1/reward.proto", fileDescriptor_15fe9a36ca4dc2fc) }
+
+var fileDescriptor_15fe9a36ca4dc2fc = []byte{
+	// 687 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4b, 0x4f, 0xdb, 0x4e,
+	0x10, 0x8f, 0x71, 0xc8, 0x63, 0x12, 0x5e, 0x1b, 0x10, 0x06, 0xe9, 0x1f, 0x42, 0x4e, 0xb9, 0xfc,
+	0x63, 0x41, 0xa5, 0xbe, 0x6e, 0xa0, 0xb6, 0x2a, 0x07, 0x5a, 0x64, 0x71, 0xe2, 0x92, 0x2e, 0xf6,
+	0x62, 0x6f, 0x43, 0x76, 0xa3, 0xdd, 0x05, 0x4a, 0x3f, 0x45, 0x0f, 0xfd, 0x50, 0x1c, 0x39, 0x55,
+	0x55, 0x0f, 0xa8, 0x82, 0x5b, 0xbf, 0x41, 0x6f, 0x95, 0xbd, 0xb6, 0x63, 0xa7, 0x54, 0xaa, 0x6a,
+	0x6e, 0xde, 0x99, 0xd9, 0xdf, 0x6f, 0x5e, 0x3b, 0x63, 0x58, 0x23, 0x23, 0x2a, 0x25, 0xe5, 0x4c,
+	0xda, 0xe7, 0x5b, 0xb6, 0x20, 0x17, 0x58, 0x78, 0xfd, 0xb1, 0xe0, 0x8a, 0xa3, 0x66, 0xaa, 0xea,
+	0x9f, 0x6f, 0xad, 0x2f, 0xfb, 0xdc, 0xe7, 0x91, 0xc2, 0x0e, 0xbf, 0xb4, 0xcd, 0x7a, 0xfe, 0x3a,
+	0x39, 0x27, 0x4c, 0x49, 0xad, 0xea, 0x7e, 0xae, 0xc1, 0x82, 0x13, 0xe1, 0xed, 0x0a, 0x82, 0x87,
+	0x1e, 0xbf, 0x60, 0x68, 0x0d, 0x6a, 0x8a, 0x8f, 0xa9, 0x3b, 0xa0, 0x9e, 0x65, 0x74, 0x8c, 0x5e,
+	0xd9, 0xa9, 0x46, 0xe7, 0x3d, 0x0f, 0x6d, 0x42, 0xf3, 0xf8, 0x94, 0xbb, 0xc3, 0x41, 0x40, 0xa8,
+	0x1f, 0x28, 0x6b, 0xa6, 0x63, 0xf4, 0x4c, 0xa7, 0x11, 0xc9, 0x5e, 0x47, 0x22, 0x64, 0x41, 0x35,
+	0xe0, 0x82, 0x7e, 0xe4, 0xcc, 0x32, 0x3b, 0x46, 0x6f, 0xce, 0x49, 0x8e, 0xe8, 0x08, 0x9a, 0x1a,
+	0x57, 0x07, 0x60, 0x95, 0x3b, 0x46, 0xaf, 0xbe, 0xfb, 0xe4, 0xea, 0x66, 0xa3, 0xf4, 0xed, 0x66,
+	0xc3, 0xf6, 0xa9, 0x0a, 0xce, 0x8e, 0xfb, 0x2e, 0x1f, 0xd9, 0xf8, 0xf4, 0x94, 0x0b, 0xfc, 0x3f,
+	0x23, 0xea, 0x82, 0x8b, 0x61, 0x72, 0x74, 0x03, 0x4c, 0x99, 0x3d, 0xc2, 0x2a, 0xe8, 0xbf, 0x20,
+	0xae, 0xd3, 0x88, 0xc0, 0xb4, 0xf3, 0xc8, 0x83, 0x25, 0xca, 0x4e, 0x88, 0x20, 0xcc, 0x25, 0x03,
+	0xc2, 0x94, 0xe0, 0xe3, 0x4b, 0x6b, 0xb6, 0x18, 0xc1, 0x62, 0x8a, 0xf8, 0x52, 0x03, 0xa2, 0x00,
+	0x5a, 0x27, 0x5c, 0x10, 0x17, 0x4b, 0x45, 0x99, 0x9f, 0xf2, 0x54, 0x8a, 0xf1, 0xa0, 0x0c, 0x66,
+	0xc2, 0xf4, 0x0e, 0x16, 0x04, 0x19, 0x9f, 0x29, 0x22, 0x52, 0x96, 0x6a, 0x31, 0x96, 0xf9, 0x18,
+	0x2f, 0x61, 0xd8, 0x03, 0xd3, 0x0d, 0xa8, 0x55, 0x2b, 0x86, 0x1a, 0x62, 0xa0, 0x7d, 0x98, 0xf5,
+	0xf1, 0x68, 0x84, 0xad, 0x7a, 0x31, 0x30, 0x8d, 0x82, 0x86, 0xb0, 0x32, 0xa9, 0xa5, 0xc2, 0x72,
+	0x98, 0x34, 0x0c, 0x14, 0x83, 0x6f, 0xa5, 0xa8, 0x87, 0x58, 0x0e, 0xe3, 0xc6, 0xe1, 0xb0, 0x9a,
+	0x2d, 0x69, 0x96, 0xae, 0x51, 0x8c, 0x6e, 0x25, 0x83, 0x9b, 0x21, 0xf4, 0xa1, 0x95, 0x54, 0x36,
+	0x4b, 0xd6, 0x2c, 0x46, 0xb6, 0x14, 0x63, 0x66, 0x88, 0x9e, 0x43, 0x05, 0xbb, 0x8a, 0x0b, 0x69,
+	0xcd, 0x75, 0xcc, 0x5e, 0x63, 0xbb, 0xdb, 0xcf, 0x8e, 0x8a, 0xfe, 0x4e, 0xa8, 0x9b, 0x7a, 0xfa,
+	0x4e, 0x7c, 0xa3, 0xfb, 0x63, 0x06, 0x96, 0xef, 0x33, 0x08, 0x5f, 0x37, 0xf6, 0x3c, 0x41, 0xa4,
+	0x8c, 0x46, 0x43, 0xdd, 0x49, 0x8e, 0xe8, 0x31, 0x40, 0x74, 0x79, 0xa0, 0x2e, 0xc7, 0x24, 0x1a,
+	0x0c, 0xf3, 0xdb, 0xab, 0xf7, 0x50, 0x1e, 0x5e, 0x8e, 0x89, 0x53, 0xc7, 0xc9, 0x67, 0xd8, 0x3c,
+	0xd2, 0xe5, 0x82, 0x58, 0x66, 0xb1, 0x0c, 0x68, 0x14, 0xfd, 0x70, 0x42, 0x9f, 0x07, 0x27, 0x02,
+	0xbb, 0x8a, 0x72, 0x56, 0x74, 0xce, 0xcc, 0x6b, 0xbc, 0x57, 0x31, 0x1c, 0x7a, 0x0b, 0x95, 0xb8,
+	0x66, 0x05, 0xe7, 0x4b, 0x0c, 0xd3, 0xdd, 0x87, 0xc5, 0xa9, 0x34, 0x4b, 0xf4, 0x0c, 0x6a, 0xf1,
+	0xd8, 0x0c, 0x13, 0x1d, 0x96, 0xef, 0xbf, 0x7c, 0x2e, 0xa7, 0x2b, 0x97, 0x9a, 0x77, 0x7f, 0x1a,
+	0xd0, 0x3a, 0x10, 0xfc, 0x3d, 0x71, 0x15, 0xf1, 0x32, 0xfd, 0xf0, 0xe7, 0xd2, 0x4d, 0x22, 0x9a,
+	0x79, 0x90, 0x88, 0x72, 0x1b, 0xc4, 0xcc, 0x6f, 0x90, 0xcc, 0x7a, 0x28, 0xe7, 0xd7, 0xc3, 0x26,
+	0x34, 0xe3, 0xca, 0x31, 0xce, 0x5c, 0x12, 0x65, 0xd7, 0x74, 0x1a, 0x5a, 0xf6, 0x26, 0x14, 0xa1,
+	0x0d, 0x88, 0x8f, 0xba, 0xc9, 0xa2, 0xb9, 0xeb, 0x80, 0x16, 0x85, 0xcd, 0xd4, 0xfd, 0x62, 0x00,
+	0x1c, 0x60, 0xea, 0x39, 0xbf, 0xfb, 0xf1, 0x4f, 0x9b, 0x2c, 0x49, 0x98, 0x99, 0x4f, 0xd8, 0x94,
+	0x1f, 0xe5, 0x69, 0x3f, 0x1e, 0xbe, 0x47, 0x76, 0xa0, 0x31, 0x89, 0x4b, 0xa2, 0x6d, 0xa8, 0x6a,
+	0x45, 0xd2, 0x1d, 0x56, 0xbe, 0x3b, 0x26, 0xb6, 0x4e, 0x62, 0xb8, 0xeb, 0x5c, 0xdd, 0xb6, 0x8d,
+	0xeb, 0xdb, 0xb6, 0xf1, 0xfd, 0xb6, 0x6d, 0x7c, 0xba, 0x6b, 0x97, 0xae, 0xef, 0xda, 0xa5, 0xaf,
+	0x77, 0xed, 0xd2, 0xd1, 0xd3, 0xbf, 0xf4, 0xea, 0x83, 0x9d, 0x92, 0xd8, 0x61, 0xe0, 0xf2, 0xb8,
+	0x12, 0xfd, 0x45, 0x3c, 0xfa, 0x35, 0x00, 0x29, 0xf3, 0x8f, 0x60, 0xa1, 0x08, 0x00, 0x00,
+}
+
+func (m *RewardBreakdown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardBreakdown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardBreakdown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Actors) > 0 {
+		for iNdEx := len(m.Actors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Actors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReward(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	{
+		size := m.ReputerTaskReward.Size()
+		i -= size
+		if _, err := m.ReputerTaskReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintReward(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.ForecastingTaskReward.Size()
+		i -= size
+		if _, err := m.ForecastingTaskReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintReward(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.InferenceTaskReward.Size()
+		i -= size
+		if _, err := m.InferenceTaskReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintReward(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.Gamma.Size()
+		i -= size
+		if _, err := m.Gamma.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintReward(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.Chi.Size()
+		i -= size
+		if _, err := m.Chi.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintReward(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.ReputerEntropy.Size()
+		i -= size
+		if _, err := m.ReputerEntropy.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintReward(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.ForecastingEntropy.Size()
+		i -= size
+		if _, err := m.ForecastingEntropy.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintReward(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.InferenceEntropy.Size()
+		i -= size
+		if _, err := m.InferenceEntropy.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintReward(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TopicReward.Size()
+		i -= size
+		if _, err := m.TopicReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintReward(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Horizon != 0 {
+		i = encodeVarintReward(dAtA, i, uint64(m.Horizon))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintReward(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintReward(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActorRewardBreakdown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActorRewardBreakdown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActorRewardBreakdown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Reward.Size()
+		i -= size
+		if _, err := m.Reward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintReward(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.RewardFraction.Size()
+		i -= size
+		if _, err := m.RewardFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintReward(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Score.Size()
+		i -= size
+		if _, err := m.Score.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintReward(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ActorType != 0 {
+		i = encodeVarintReward(dAtA, i, uint64(m.ActorType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintReward(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardBreakdowns) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardBreakdowns) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardBreakdowns) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Horizons) > 0 {
+		for iNdEx := len(m.Horizons) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Horizons[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReward(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectedTaskReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectedTaskReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectedTaskReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardType) > 0 {
+		i -= len(m.RewardType)
+		copy(dAtA[i:], m.RewardType)
+		i = encodeVarintReward(dAtA, i, uint64(len(m.RewardType)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.RewardNonce != 0 {
+		i = encodeVarintReward(dAtA, i, uint64(m.RewardNonce))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Horizon != 0 {
+		i = encodeVarintReward(dAtA, i, uint64(m.Horizon))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintReward(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Reward.Size()
+		i -= size
+		if _, err := m.Reward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintReward(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintReward(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PaidReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PaidReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PaidReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Reward.Size()
+		i -= size
+		if _, err := m.Reward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintReward(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.RewardType) > 0 {
+		i -= len(m.RewardType)
+		copy(dAtA[i:], m.RewardType)
+		i = encodeVarintReward(dAtA, i, uint64(len(m.RewardType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintReward(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintReward(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintReward(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PaidRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PaidRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PaidRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReward(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintReward(dAtA []byte, offset int, v uint64) int {
+	offset -= sovReward(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RewardBreakdown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovReward(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovReward(uint64(m.BlockHeight))
+	}
+	if m.Horizon != 0 {
+		n += 1 + sovReward(uint64(m.Horizon))
+	}
+	l = m.TopicReward.Size()
+	n += 1 + l + sovReward(uint64(l))
+	l = m.InferenceEntropy.Size()
+	n += 1 + l + sovReward(uint64(l))
+	l = m.ForecastingEntropy.Size()
+	n += 1 + l + sovReward(uint64(l))
+	l = m.ReputerEntropy.Size()
+	n += 1 + l + sovReward(uint64(l))
+	l = m.Chi.Size()
+	n += 1 + l + sovReward(uint64(l))
+	l = m.Gamma.Size()
+	n += 1 + l + sovReward(uint64(l))
+	l = m.InferenceTaskReward.Size()
+	n += 1 + l + sovReward(uint64(l))
+	l = m.ForecastingTaskReward.Size()
+	n += 1 + l + sovReward(uint64(l))
+	l = m.ReputerTaskReward.Size()
+	n += 1 + l + sovReward(uint64(l))
+	if len(m.Actors) > 0 {
+		for _, e := range m.Actors {
+			l = e.Size()
+			n += 1 + l + sovReward(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ActorRewardBreakdown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovReward(uint64(l))
+	}
+	if m.ActorType != 0 {
+		n += 1 + sovReward(uint64(m.ActorType))
+	}
+	l = m.Score.Size()
+	n += 1 + l + sovReward(uint64(l))
+	l = m.RewardFraction.Size()
+	n += 1 + l + sovReward(uint64(l))
+	l = m.Reward.Size()
+	n += 1 + l + sovReward(uint64(l))
+	return n
+}
+
+func (m *RewardBreakdowns) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Horizons) > 0 {
+		for _, e := range m.Horizons {
+			l = e.Size()
+			n += 1 + l + sovReward(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProjectedTaskReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovReward(uint64(l))
+	}
+	l = m.Reward.Size()
+	n += 1 + l + sovReward(uint64(l))
+	if m.TopicId != 0 {
+		n += 1 + sovReward(uint64(m.TopicId))
+	}
+	if m.Horizon != 0 {
+		n += 1 + sovReward(uint64(m.Horizon))
+	}
+	if m.RewardNonce != 0 {
+		n += 1 + sovReward(uint64(m.RewardNonce))
+	}
+	l = len(m.RewardType)
+	if l > 0 {
+		n += 1 + l + sovReward(uint64(l))
+	}
+	return n
+}
+
+func (m *PaidReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovReward(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovReward(uint64(m.BlockHeight))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovReward(uint64(l))
+	}
+	l = len(m.RewardType)
+	if l > 0 {
+		n += 1 + l + sovReward(uint64(l))
+	}
+	l = m.Reward.Size()
+	n += 1 + l + sovReward(uint64(l))
+	return n
+}
+
+func (m *PaidRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovReward(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovReward(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozReward(x uint64) (n int) {
+	return sovReward(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RewardBreakdown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReward
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardBreakdown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardBreakdown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Horizon", wireType)
+			}
+			m.Horizon = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Horizon |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TopicReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceEntropy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InferenceEntropy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForecastingEntropy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ForecastingEntropy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerEntropy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReputerEntropy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chi", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Chi.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gamma", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Gamma.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceTaskReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InferenceTaskReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForecastingTaskReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ForecastingTaskReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerTaskReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReputerTaskReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actors = append(m.Actors, &ActorRewardBreakdown{})
+			if err := m.Actors[len(m.Actors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReward(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReward
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActorRewardBreakdown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReward
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActorRewardBreakdown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActorRewardBreakdown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorType", wireType)
+			}
+			m.ActorType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActorType |= ActorType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReward(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReward
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardBreakdowns) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReward
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardBreakdowns: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardBreakdowns: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Horizons", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Horizons = append(m.Horizons, &RewardBreakdown{})
+			if err := m.Horizons[len(m.Horizons)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReward(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReward
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectedTaskReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReward
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectedTaskReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectedTaskReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Horizon", wireType)
+			}
+			m.Horizon = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Horizon |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardNonce", wireType)
+			}
+			m.RewardNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardNonce |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReward(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReward
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PaidReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReward
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PaidReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PaidReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReward(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReward
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PaidRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReward
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PaidRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PaidRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, &PaidReward{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReward(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReward
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipReward(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowReward
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthReward
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupReward
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthReward
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthReward        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowReward          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupReward = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Every role an address can hold. Core team addresses and legacy whitelist admins hold all of them.
+func AllRoles() []Role {
+	return []Role{
+		Role_PARAMS_ADMIN,
+		Role_TOPIC_CREATOR,
+		Role_WHITELIST_ADMIN,
+	}
+}
+
+func ValidateRole(role Role) error {
+	if _, ok := Role_name[int32(role)]; !ok || role == Role_NO_ROLE {
+		return errors.Wrapf(ErrInvalidRole, "unknown role %d", role)
+	}
+	return nil
+}
+
+func (msg *MsgGrantRole) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+	return ValidateRole(msg.Role)
+}
+
+func (msg *MsgRevokeRole) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+	return ValidateRole(msg.Role)
+}
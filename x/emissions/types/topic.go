@@ -0,0 +1,183 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	alloraMath "github.com/allora-network/allora-chain/math"
+)
+
+// Tolerance on the sum of the probabilities of a submitted distribution
+var ProbabilityDistributionSumTolerance = alloraMath.MustNewDecFromString("0.000001")
+
+// Whether values in the topic are vectors of OutputDimension components rather than scalars
+func (topic *Topic) IsVectorValued() bool {
+	return topic.OutputDimension > 1
+}
+
+// Vector values must have exactly outputDimension components in a vector-valued topic
+// and no components at all in a scalar-valued one
+func ValidateValuesOutputDimension(values []alloraMath.Dec, outputDimension uint64) error {
+	if outputDimension <= 1 {
+		if len(values) != 0 {
+			return errors.Wrapf(ErrInvalidOutputDimension, "scalar-valued topic cannot have %d values", len(values))
+		}
+		return nil
+	}
+	if uint64(len(values)) != outputDimension {
+		return errors.Wrapf(ErrInvalidOutputDimension, "expected %d values, got %d", outputDimension, len(values))
+	}
+	return nil
+}
+
+// Whether values in the topic are probability distributions over OutputDimension classes
+func (topic *Topic) IsProbabilityDistribution() bool {
+	return topic.OutputType == TopicOutputType_OUTPUT_TYPE_PROBABILITY_DISTRIBUTION
+}
+
+// Probabilities must all be non-negative and sum to one, up to ProbabilityDistributionSumTolerance
+func ValidateProbabilityDistribution(values []alloraMath.Dec) error {
+	if len(values) == 0 {
+		return errors.Wrap(ErrInvalidProbabilityDistribution, "distribution cannot be empty")
+	}
+	sum := alloraMath.ZeroDec()
+	for _, value := range values {
+		if value.IsNaN() || value.IsNegative() {
+			return errors.Wrapf(ErrInvalidProbabilityDistribution, "probability %s must be non-negative", value.String())
+		}
+		var err error
+		sum, err = sum.Add(value)
+		if err != nil {
+			return err
+		}
+	}
+	deviation, err := sum.Sub(alloraMath.OneDec())
+	if err != nil {
+		return err
+	}
+	if deviation.Abs().Gt(ProbabilityDistributionSumTolerance) {
+		return errors.Wrapf(ErrInvalidProbabilityDistribution, "probabilities sum to %s instead of 1", sum.String())
+	}
+	return nil
+}
+
+func ValidateTopicOutputType(outputType TopicOutputType, outputDimension uint64, pooling DistributionPooling) error {
+	if _, ok := TopicOutputType_name[int32(outputType)]; !ok {
+		return errors.Wrapf(ErrInvalidTopicOutputType, "unknown output type %d", outputType)
+	}
+	if _, ok := DistributionPooling_name[int32(pooling)]; !ok {
+		return errors.Wrapf(ErrInvalidTopicOutputType, "unknown distribution pooling %d", pooling)
+	}
+	if outputType == TopicOutputType_OUTPUT_TYPE_PROBABILITY_DISTRIBUTION && outputDimension < 2 {
+		return errors.Wrap(ErrInvalidOutputDimension, "probability distributions need an output dimension of at least 2 classes")
+	}
+	return nil
+}
+
+// Ground truth lags of every horizon predicted in the topic.
+// A topic without horizons has a single horizon whose lag is GroundTruthLag.
+func (topic *Topic) GetHorizonLags() []int64 {
+	if len(topic.Horizons) == 0 {
+		return []int64{topic.GroundTruthLag}
+	}
+	return topic.Horizons
+}
+
+func (topic *Topic) NumHorizons() Horizon {
+	return Horizon(len(topic.GetHorizonLags()))
+}
+
+func (topic *Topic) ValidateHorizon(horizon Horizon) error {
+	if horizon >= topic.NumHorizons() {
+		return errors.Wrapf(ErrInvalidHorizon, "horizon %d not in topic %d with %d horizons", horizon, topic.Id, topic.NumHorizons())
+	}
+	return nil
+}
+
+// Ground truth lag of the given horizon
+func (topic *Topic) GetHorizonLag(horizon Horizon) (int64, error) {
+	if err := topic.ValidateHorizon(horizon); err != nil {
+		return 0, err
+	}
+	return topic.GetHorizonLags()[horizon], nil
+}
+
+// Longest ground truth lag among the horizons of the topic
+func (topic *Topic) GetMaxHorizonLag() int64 {
+	maxLag := topic.GroundTruthLag
+	for _, lag := range topic.GetHorizonLags() {
+		maxLag = max(maxLag, lag)
+	}
+	return maxLag
+}
+
+// Shares of the topic reward paid for each horizon, summing to one.
+// Horizons are rewarded equally unless the topic sets HorizonWeights.
+func (topic *Topic) GetHorizonWeights() ([]alloraMath.Dec, error) {
+	numHorizons := int64(topic.NumHorizons())
+	if len(topic.HorizonWeights) == 0 {
+		weight, err := alloraMath.OneDec().Quo(alloraMath.NewDecFromInt64(numHorizons))
+		if err != nil {
+			return nil, err
+		}
+		weights := make([]alloraMath.Dec, numHorizons)
+		for i := range weights {
+			weights[i] = weight
+		}
+		return weights, nil
+	}
+
+	sum, err := alloraMath.SumDecSlice(topic.HorizonWeights)
+	if err != nil {
+		return nil, err
+	}
+	if !sum.IsPositive() {
+		return nil, errors.Wrap(ErrInvalidHorizon, "horizon weights must sum to a positive value")
+	}
+	weights := make([]alloraMath.Dec, 0, len(topic.HorizonWeights))
+	for _, weight := range topic.HorizonWeights {
+		normalized, err := weight.Quo(sum)
+		if err != nil {
+			return nil, err
+		}
+		weights = append(weights, normalized)
+	}
+	return weights, nil
+}
+
+// Horizons must start at the ground truth lag and be strictly increasing.
+// Weights are optional, but if given there must be one non-negative weight per horizon and at least one positive.
+func ValidateHorizons(horizons []int64, horizonWeights []alloraMath.Dec, groundTruthLag int64, epochLength int64) error {
+	if len(horizons) == 0 {
+		if len(horizonWeights) > 0 {
+			return errors.Wrap(ErrInvalidHorizon, "horizon weights given without horizons")
+		}
+		return nil
+	}
+	if horizons[0] != groundTruthLag {
+		return errors.Wrapf(ErrInvalidHorizon, "first horizon %d must be the ground truth lag %d", horizons[0], groundTruthLag)
+	}
+	for i := 1; i < len(horizons); i++ {
+		if horizons[i] <= horizons[i-1] {
+			return errors.Wrap(ErrInvalidHorizon, "horizons must be strictly increasing")
+		}
+	}
+	if horizons[0] < epochLength {
+		return errors.Wrap(ErrInvalidHorizon, "horizons cannot be lower than epoch length")
+	}
+	if len(horizonWeights) == 0 {
+		return nil
+	}
+	if len(horizonWeights) != len(horizons) {
+		return errors.Wrapf(ErrInvalidHorizon, "expected %d horizon weights, got %d", len(horizons), len(horizonWeights))
+	}
+	hasPositiveWeight := false
+	for _, weight := range horizonWeights {
+		if weight.IsNaN() || weight.IsNegative() {
+			return errors.Wrapf(ErrInvalidHorizon, "horizon weight %s must be non-negative", weight.String())
+		}
+		hasPositiveWeight = hasPositiveWeight || weight.IsPositive()
+	}
+	if !hasPositiveWeight {
+		return errors.Wrap(ErrInvalidHorizon, "at least one horizon weight must be positive")
+	}
+	return nil
+}
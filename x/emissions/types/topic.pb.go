@@ -26,6 +26,162 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Controls which actors may participate in a topic. Actors that are not on
+// the topic allowlist of a restricted role cannot register or submit payloads
+// in that role.
+type TopicAllowlistMode int32
+
+const (
+	TopicAllowlistMode_ALLOWLIST_MODE_OPEN                 TopicAllowlistMode = 0
+	TopicAllowlistMode_ALLOWLIST_MODE_WORKERS              TopicAllowlistMode = 1
+	TopicAllowlistMode_ALLOWLIST_MODE_REPUTERS             TopicAllowlistMode = 2
+	TopicAllowlistMode_ALLOWLIST_MODE_WORKERS_AND_REPUTERS TopicAllowlistMode = 3
+)
+
+var TopicAllowlistMode_name = map[int32]string{
+	0: "ALLOWLIST_MODE_OPEN",
+	1: "ALLOWLIST_MODE_WORKERS",
+	2: "ALLOWLIST_MODE_REPUTERS",
+	3: "ALLOWLIST_MODE_WORKERS_AND_REPUTERS",
+}
+
+var TopicAllowlistMode_value = map[string]int32{
+	"ALLOWLIST_MODE_OPEN":                 0,
+	"ALLOWLIST_MODE_WORKERS":              1,
+	"ALLOWLIST_MODE_REPUTERS":             2,
+	"ALLOWLIST_MODE_WORKERS_AND_REPUTERS": 3,
+}
+
+func (x TopicAllowlistMode) String() string {
+	return proto.EnumName(TopicAllowlistMode_name, int32(x))
+}
+
+func (TopicAllowlistMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ae5610c9d5deb158, []int{0}
+}
+
+// Selects the strategy used to combine worker inferences and forecast-implied
+// inferences into network inferences for a topic.
+type CombinerType int32
+
+const (
+	CombinerType_COMBINER_REGRET          CombinerType = 0
+	CombinerType_COMBINER_WEIGHTED_MEDIAN CombinerType = 1
+	CombinerType_COMBINER_TRIMMED_MEAN    CombinerType = 2
+	CombinerType_COMBINER_SCORE_SOFTMAX   CombinerType = 3
+)
+
+var CombinerType_name = map[int32]string{
+	0: "COMBINER_REGRET",
+	1: "COMBINER_WEIGHTED_MEDIAN",
+	2: "COMBINER_TRIMMED_MEAN",
+	3: "COMBINER_SCORE_SOFTMAX",
+}
+
+var CombinerType_value = map[string]int32{
+	"COMBINER_REGRET":          0,
+	"COMBINER_WEIGHTED_MEDIAN": 1,
+	"COMBINER_TRIMMED_MEAN":    2,
+	"COMBINER_SCORE_SOFTMAX":   3,
+}
+
+func (x CombinerType) String() string {
+	return proto.EnumName(CombinerType_name, int32(x))
+}
+
+func (CombinerType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ae5610c9d5deb158, []int{1}
+}
+
+// What the values inferred in a topic represent
+type TopicOutputType int32
+
+const (
+	// real numbers, or vectors of real numbers
+	TopicOutputType_OUTPUT_TYPE_REAL TopicOutputType = 0
+	// probability distributions over output_dimension classes
+	TopicOutputType_OUTPUT_TYPE_PROBABILITY_DISTRIBUTION TopicOutputType = 1
+)
+
+var TopicOutputType_name = map[int32]string{
+	0: "OUTPUT_TYPE_REAL",
+	1: "OUTPUT_TYPE_PROBABILITY_DISTRIBUTION",
+}
+
+var TopicOutputType_value = map[string]int32{
+	"OUTPUT_TYPE_REAL":                     0,
+	"OUTPUT_TYPE_PROBABILITY_DISTRIBUTION": 1,
+}
+
+func (x TopicOutputType) String() string {
+	return proto.EnumName(TopicOutputType_name, int32(x))
+}
+
+func (TopicOutputType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ae5610c9d5deb158, []int{2}
+}
+
+// How the probability distributions of a topic are combined into network inferences
+type DistributionPooling int32
+
+const (
+	// weighted average of the probabilities, renormalised
+	DistributionPooling_POOLING_LINEAR DistributionPooling = 0
+	// weighted average of the log-probabilities, exponentiated and renormalised
+	DistributionPooling_POOLING_LOGARITHMIC DistributionPooling = 1
+)
+
+var DistributionPooling_name = map[int32]string{
+	0: "POOLING_LINEAR",
+	1: "POOLING_LOGARITHMIC",
+}
+
+var DistributionPooling_value = map[string]int32{
+	"POOLING_LINEAR":      0,
+	"POOLING_LOGARITHMIC": 1,
+}
+
+func (x DistributionPooling) String() string {
+	return proto.EnumName(DistributionPooling_name, int32(x))
+}
+
+func (DistributionPooling) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ae5610c9d5deb158, []int{3}
+}
+
+// Selects how the losses reported by reputers are aggregated, weighting each by the reputer's stake,
+// into the consensus losses reputers are scored against
+type LossAggregatorType int32
+
+const (
+	LossAggregatorType_LOSS_AGGREGATOR_STAKE_WEIGHTED_MEAN   LossAggregatorType = 0
+	LossAggregatorType_LOSS_AGGREGATOR_STAKE_WEIGHTED_MEDIAN LossAggregatorType = 1
+	LossAggregatorType_LOSS_AGGREGATOR_TRIMMED_MEAN          LossAggregatorType = 2
+	LossAggregatorType_LOSS_AGGREGATOR_HUBER                 LossAggregatorType = 3
+)
+
+var LossAggregatorType_name = map[int32]string{
+	0: "LOSS_AGGREGATOR_STAKE_WEIGHTED_MEAN",
+	1: "LOSS_AGGREGATOR_STAKE_WEIGHTED_MEDIAN",
+	2: "LOSS_AGGREGATOR_TRIMMED_MEAN",
+	3: "LOSS_AGGREGATOR_HUBER",
+}
+
+var LossAggregatorType_value = map[string]int32{
+	"LOSS_AGGREGATOR_STAKE_WEIGHTED_MEAN":   0,
+	"LOSS_AGGREGATOR_STAKE_WEIGHTED_MEDIAN": 1,
+	"LOSS_AGGREGATOR_TRIMMED_MEAN":          2,
+	"LOSS_AGGREGATOR_HUBER":                 3,
+}
+
+func (x LossAggregatorType) String() string {
+	return proto.EnumName(LossAggregatorType_name, int32(x))
+}
+
+func (LossAggregatorType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ae5610c9d5deb158, []int{4}
+}
+
 type Topic struct {
 	Id              uint64                                          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Creator         string                                          `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
@@ -43,6 +199,37 @@ type Topic struct {
 	AllowNegative   bool                                            `protobuf:"varint,14,opt,name=allow_negative,json=allowNegative,proto3" json:"allow_negative,omitempty"`
 	Epsilon         github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,15,opt,name=epsilon,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"epsilon"`
 	InitialRegret   github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,16,opt,name=initial_regret,json=initialRegret,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"initial_regret"`
+	AllowlistMode   TopicAllowlistMode                              `protobuf:"varint,17,opt,name=allowlist_mode,json=allowlistMode,proto3,enum=emissions.v1.TopicAllowlistMode" json:"allowlist_mode,omitempty"`
+	// number of consecutive epochs without a submission after which a worker is deregistered, 0 disables
+	WorkerInactivityThreshold uint64 `protobuf:"varint,18,opt,name=worker_inactivity_threshold,json=workerInactivityThreshold,proto3" json:"worker_inactivity_threshold,omitempty"`
+	// number of consecutive epochs without a submission after which a reputer is deregistered, 0 disables
+	ReputerInactivityThreshold uint64 `protobuf:"varint,19,opt,name=reputer_inactivity_threshold,json=reputerInactivityThreshold,proto3" json:"reputer_inactivity_threshold,omitempty"`
+	// strategy used to combine inferences into network inferences
+	Combiner CombinerType `protobuf:"varint,20,opt,name=combiner,proto3,enum=emissions.v1.CombinerType" json:"combiner,omitempty"`
+	// number of components of the values inferred in the topic. 0 or 1 means the topic is scalar-valued and
+	// only the scalar `value` fields are used. Above 1, inferences carry a vector of exactly that many `values`
+	// and network inferences are synthesized component-wise
+	OutputDimension uint64          `protobuf:"varint,21,opt,name=output_dimension,json=outputDimension,proto3" json:"output_dimension,omitempty"`
+	OutputType      TopicOutputType `protobuf:"varint,22,opt,name=output_type,json=outputType,proto3,enum=emissions.v1.TopicOutputType" json:"output_type,omitempty"`
+	// only used by topics whose output type is a probability distribution
+	DistributionPooling DistributionPooling `protobuf:"varint,23,opt,name=distribution_pooling,json=distributionPooling,proto3,enum=emissions.v1.DistributionPooling" json:"distribution_pooling,omitempty"`
+	// ground truth lags, in blocks, of every horizon predicted in the topic, starting with ground_truth_lag.
+	// Empty means the topic has a single horizon whose lag is ground_truth_lag
+	Horizons []int64 `protobuf:"varint,24,rep,packed,name=horizons,proto3" json:"horizons,omitempty"`
+	// share of the topic reward paid for each horizon. Empty means every horizon is rewarded equally
+	HorizonWeights []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,25,rep,name=horizon_weights,json=horizonWeights,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"horizon_weights"`
+	// aggregator of the consensus losses reputers are scored against
+	LossAggregator LossAggregatorType `protobuf:"varint,26,opt,name=loss_aggregator,json=lossAggregator,proto3,enum=emissions.v1.LossAggregatorType" json:"loss_aggregator,omitempty"`
+	// fraction of the lowest and of the highest values dropped by the trimmed mean combiner, in [0, 0.5) and positive
+	// when that combiner is selected
+	TrimmedMeanTrimFraction github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,27,opt,name=trimmed_mean_trim_fraction,json=trimmedMeanTrimFraction,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"trimmed_mean_trim_fraction"`
+	// fraction of the total stake trimmed from each end of the reported losses by the trimmed mean loss aggregator,
+	// in [0, 0.5) and positive when that aggregator is selected
+	LossTrimFraction github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,28,opt,name=loss_trim_fraction,json=lossTrimFraction,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"loss_trim_fraction"`
+	// multiple of the scaled median absolute deviation beyond which the Huber loss aggregator downweights losses
+	HuberLossTuningConstant github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,29,opt,name=huber_loss_tuning_constant,json=huberLossTuningConstant,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"huber_loss_tuning_constant"`
+	// maximum number of reweighting iterations of the Huber loss aggregator, at most 100
+	HuberLossMaxIterations uint64 `protobuf:"varint,30,opt,name=huber_loss_max_iterations,json=huberLossMaxIterations,proto3" json:"huber_loss_max_iterations,omitempty"`
 }
 
 func (m *Topic) Reset()         { *m = Topic{} }
@@ -162,6 +349,76 @@ func (m *Topic) GetAllowNegative() bool {
 	return false
 }
 
+func (m *Topic) GetAllowlistMode() TopicAllowlistMode {
+	if m != nil {
+		return m.AllowlistMode
+	}
+	return TopicAllowlistMode_ALLOWLIST_MODE_OPEN
+}
+
+func (m *Topic) GetWorkerInactivityThreshold() uint64 {
+	if m != nil {
+		return m.WorkerInactivityThreshold
+	}
+	return 0
+}
+
+func (m *Topic) GetReputerInactivityThreshold() uint64 {
+	if m != nil {
+		return m.ReputerInactivityThreshold
+	}
+	return 0
+}
+
+func (m *Topic) GetCombiner() CombinerType {
+	if m != nil {
+		return m.Combiner
+	}
+	return CombinerType_COMBINER_REGRET
+}
+
+func (m *Topic) GetOutputDimension() uint64 {
+	if m != nil {
+		return m.OutputDimension
+	}
+	return 0
+}
+
+func (m *Topic) GetOutputType() TopicOutputType {
+	if m != nil {
+		return m.OutputType
+	}
+	return TopicOutputType_OUTPUT_TYPE_REAL
+}
+
+func (m *Topic) GetDistributionPooling() DistributionPooling {
+	if m != nil {
+		return m.DistributionPooling
+	}
+	return DistributionPooling_POOLING_LINEAR
+}
+
+func (m *Topic) GetHorizons() []int64 {
+	if m != nil {
+		return m.Horizons
+	}
+	return nil
+}
+
+func (m *Topic) GetLossAggregator() LossAggregatorType {
+	if m != nil {
+		return m.LossAggregator
+	}
+	return LossAggregatorType_LOSS_AGGREGATOR_STAKE_WEIGHTED_MEAN
+}
+
+func (m *Topic) GetHuberLossMaxIterations() uint64 {
+	if m != nil {
+		return m.HuberLossMaxIterations
+	}
+	return 0
+}
+
 type TopicList struct {
 	Topics []*Topic `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
 }
@@ -267,6 +524,11 @@ func (m *TimestampedActorNonce) GetNonce() *Nonce {
 }
 
 func init() {
+	proto.RegisterEnum("emissions.v1.TopicAllowlistMode", TopicAllowlistMode_name, TopicAllowlistMode_value)
+	proto.RegisterEnum("emissions.v1.CombinerType", CombinerType_name, CombinerType_value)
+	proto.RegisterEnum("emissions.v1.TopicOutputType", TopicOutputType_name, TopicOutputType_value)
+	proto.RegisterEnum("emissions.v1.DistributionPooling", DistributionPooling_name, DistributionPooling_value)
+	proto.RegisterEnum("emissions.v1.LossAggregatorType", LossAggregatorType_name, LossAggregatorType_value)
 	proto.RegisterType((*Topic)(nil), "emissions.v1.Topic")
 	proto.RegisterType((*TopicList)(nil), "emissions.v1.TopicList")
 	proto.RegisterType((*TimestampedActorNonce)(nil), "emissions.v1.TimestampedActorNonce")
@@ -275,46 +537,87 @@ func init() {
 func init() { proto.RegisterFile("emissions/v1/topic.proto", fileDescriptor_ae5610c9d5deb158) }
 
 var fileDescriptor_ae5610c9d5deb158 = []byte{
-	// 619 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x41, 0x6b, 0x13, 0x4f,
-	0x18, 0xc6, 0xb3, 0x4d, 0x93, 0x36, 0x93, 0x34, 0xed, 0x7f, 0xfe, 0x15, 0xc6, 0x82, 0x49, 0x2c,
-	0x88, 0x29, 0xe2, 0x2e, 0xd5, 0x83, 0xc5, 0x5b, 0x8b, 0x82, 0x87, 0x18, 0x70, 0xe9, 0xa9, 0x07,
-	0x97, 0xe9, 0xec, 0x74, 0x76, 0xe8, 0xee, 0xcc, 0x32, 0x33, 0x69, 0xf5, 0xe8, 0x37, 0xf0, 0x23,
-	0xf8, 0x71, 0x7a, 0xec, 0x51, 0x3c, 0x14, 0x69, 0x2f, 0x7e, 0x0b, 0x65, 0xde, 0xdd, 0x34, 0x8a,
-	0x17, 0xa1, 0x97, 0x90, 0xf7, 0xf7, 0x3c, 0x79, 0x5e, 0xde, 0xb0, 0xcf, 0x22, 0xc2, 0x0b, 0x69,
-	0xad, 0xd4, 0xca, 0x46, 0x67, 0xbb, 0x91, 0xd3, 0xa5, 0x64, 0x61, 0x69, 0xb4, 0xd3, 0xb8, 0x77,
-	0xab, 0x84, 0x67, 0xbb, 0x5b, 0xf7, 0x99, 0xb6, 0x85, 0xb6, 0x09, 0x68, 0x51, 0x35, 0x54, 0xc6,
-	0xad, 0xff, 0x68, 0x21, 0x95, 0x8e, 0xe0, 0xb3, 0x46, 0x9b, 0x42, 0x0b, 0x5d, 0x59, 0xfd, 0xb7,
-	0x9a, 0xfe, 0xb9, 0x4b, 0x69, 0xc5, 0x78, 0xa5, 0x6c, 0xff, 0x6c, 0xa1, 0xd6, 0xa1, 0xdf, 0x8d,
-	0xfb, 0x68, 0x49, 0xa6, 0x24, 0x18, 0x05, 0xe3, 0xe5, 0x78, 0x49, 0xa6, 0x98, 0xa0, 0x15, 0x66,
-	0x38, 0x75, 0xda, 0x90, 0xa5, 0x51, 0x30, 0xee, 0xc4, 0xf3, 0x11, 0x6f, 0xa1, 0xd5, 0x82, 0x3b,
-	0x9a, 0x52, 0x47, 0x49, 0x13, 0xa4, 0xdb, 0x19, 0x3f, 0x40, 0x28, 0xd7, 0xd6, 0x26, 0xb9, 0x16,
-	0x92, 0x91, 0x65, 0x50, 0x3b, 0x9e, 0x4c, 0x3c, 0xc0, 0x43, 0xd4, 0x05, 0xb9, 0xe0, 0x2e, 0xd3,
-	0x29, 0x69, 0x81, 0x0e, 0xbf, 0x78, 0x0b, 0x04, 0x3f, 0x46, 0xeb, 0x52, 0x9d, 0x70, 0xc3, 0x15,
-	0xe3, 0x75, 0x48, 0x1b, 0x4c, 0xfd, 0x5b, 0x5c, 0x25, 0xed, 0xa0, 0x8d, 0x85, 0xb1, 0x8e, 0x5b,
-	0x01, 0xe7, 0x22, 0xa0, 0xce, 0x1c, 0xa3, 0x0d, 0x5e, 0x6a, 0x96, 0x25, 0x39, 0xb5, 0x2e, 0xe1,
-	0x2a, 0xe5, 0x29, 0x59, 0x1d, 0x05, 0xe3, 0x66, 0xdc, 0x07, 0x3e, 0xa1, 0xd6, 0xbd, 0xf6, 0x14,
-	0x3f, 0x44, 0xbd, 0xda, 0xc9, 0x95, 0x70, 0x19, 0xe9, 0x80, 0xab, 0x5b, 0xb9, 0x00, 0xf9, 0x30,
-	0x61, 0xf4, 0x4c, 0xa5, 0x89, 0x33, 0x33, 0xe7, 0x33, 0x05, 0x41, 0x55, 0x58, 0xc5, 0x0f, 0x3d,
-	0x9e, 0x50, 0xe1, 0x6f, 0x4d, 0xf9, 0x09, 0x9d, 0xe5, 0x2e, 0xa1, 0x46, 0x90, 0x6e, 0x75, 0x6b,
-	0x8d, 0xf6, 0x8d, 0xc0, 0x53, 0xd4, 0x2e, 0x13, 0xa5, 0x4d, 0x41, 0x7a, 0x5e, 0x3b, 0x78, 0x71,
-	0x71, 0x35, 0x6c, 0x7c, 0xbb, 0x1a, 0x46, 0x42, 0xba, 0x6c, 0x76, 0x1c, 0x32, 0x5d, 0x44, 0x34,
-	0xcf, 0xb5, 0xa1, 0x4f, 0x15, 0x77, 0xe7, 0xda, 0x9c, 0xce, 0x47, 0x96, 0x51, 0xa9, 0xa2, 0x82,
-	0xba, 0x2c, 0x7c, 0xc5, 0x59, 0xdc, 0x2a, 0xa7, 0xda, 0x14, 0xf8, 0x08, 0xf5, 0x68, 0x5e, 0x66,
-	0x34, 0x31, 0x5c, 0x18, 0xee, 0xc8, 0xda, 0xdd, 0x52, 0xbb, 0x10, 0x16, 0x43, 0x16, 0x7e, 0x84,
-	0xfa, 0xde, 0x75, 0x9e, 0x28, 0x2e, 0xa8, 0x93, 0x67, 0x9c, 0xf4, 0x47, 0xc1, 0x78, 0x35, 0x5e,
-	0x03, 0x3a, 0xad, 0x21, 0x7e, 0x87, 0x56, 0x78, 0x69, 0x65, 0xae, 0x15, 0x59, 0xbf, 0xdb, 0xf6,
-	0x79, 0x0e, 0x7e, 0x8f, 0xfa, 0x52, 0x49, 0x27, 0x69, 0x3e, 0xbf, 0x6b, 0xe3, 0x6e, 0xc9, 0x6b,
-	0x75, 0x5c, 0x75, 0xd9, 0xf6, 0x1e, 0xea, 0x40, 0x01, 0x26, 0xd2, 0x3a, 0xfc, 0x04, 0xb5, 0xa1,
-	0x89, 0x96, 0x04, 0xa3, 0xe6, 0xb8, 0xfb, 0xec, 0xff, 0xf0, 0xf7, 0x2e, 0x86, 0x60, 0x8c, 0x6b,
-	0xcb, 0xf6, 0xa7, 0x00, 0xdd, 0x3b, 0x94, 0x05, 0xb7, 0x8e, 0x16, 0x25, 0x4f, 0xf7, 0x99, 0xd3,
-	0x66, 0xea, 0xbb, 0xe5, 0x9f, 0xa3, 0xe3, 0x5c, 0xb3, 0xd3, 0x24, 0xe3, 0x52, 0x64, 0x0e, 0x5a,
-	0xd5, 0x8c, 0xbb, 0xc0, 0xde, 0x00, 0xc2, 0x9b, 0xa8, 0x45, 0xd9, 0xa2, 0x5c, 0xd5, 0x80, 0x77,
-	0x50, 0x0b, 0xda, 0x09, 0xbd, 0xfa, 0x6b, 0x3d, 0x84, 0xc7, 0x95, 0xe3, 0xe5, 0xf2, 0x8f, 0x2f,
-	0xc3, 0xe0, 0x20, 0xbe, 0xb8, 0x1e, 0x04, 0x97, 0xd7, 0x83, 0xe0, 0xfb, 0xf5, 0x20, 0xf8, 0x7c,
-	0x33, 0x68, 0x5c, 0xde, 0x0c, 0x1a, 0x5f, 0x6f, 0x06, 0x8d, 0xa3, 0xbd, 0x7f, 0xfc, 0x5f, 0x3e,
-	0x44, 0x8b, 0x97, 0x83, 0xfb, 0x58, 0x72, 0x7b, 0xdc, 0x86, 0x57, 0xc3, 0xf3, 0x5f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x3c, 0xa0, 0x29, 0xf7, 0xa2, 0x04, 0x00, 0x00,
+	// 1276 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcf, 0x6f, 0xdb, 0x36,
+	0x14, 0xb6, 0xe2, 0x26, 0x4d, 0x98, 0xd4, 0x51, 0x99, 0xa4, 0x61, 0xdc, 0x34, 0x71, 0xbb, 0x15,
+	0x75, 0x33, 0x2c, 0x46, 0x3b, 0x60, 0xeb, 0x76, 0x28, 0x26, 0xc7, 0xaa, 0x23, 0xd4, 0xb6, 0x52,
+	0x59, 0x41, 0xd6, 0x1e, 0xc6, 0x29, 0x12, 0x2b, 0x13, 0xb5, 0x48, 0x83, 0xa2, 0xd3, 0x74, 0xb7,
+	0xfd, 0x05, 0xdb, 0x79, 0xa7, 0x5d, 0xf6, 0xbf, 0xf4, 0xd8, 0xe3, 0xb0, 0x43, 0x31, 0xb4, 0x97,
+	0xfd, 0x19, 0x03, 0x29, 0xd9, 0xf9, 0x39, 0x6c, 0x80, 0x2f, 0x86, 0xde, 0xf7, 0x7d, 0xfa, 0x9e,
+	0xdf, 0x33, 0xf9, 0x9e, 0x01, 0x22, 0x09, 0x4d, 0x53, 0xca, 0x59, 0x5a, 0x3b, 0x7a, 0x50, 0x93,
+	0x7c, 0x40, 0xc3, 0xed, 0x81, 0xe0, 0x92, 0xc3, 0x85, 0x31, 0xb3, 0x7d, 0xf4, 0xa0, 0xbc, 0x16,
+	0xf2, 0x34, 0xe1, 0x29, 0xd6, 0x5c, 0x2d, 0x0b, 0x32, 0x61, 0xf9, 0x7a, 0x90, 0x50, 0xc6, 0x6b,
+	0xfa, 0x33, 0x87, 0x96, 0x63, 0x1e, 0xf3, 0x4c, 0xaa, 0x9e, 0x72, 0xf4, 0x6c, 0x2e, 0xc6, 0x59,
+	0x48, 0x32, 0xe6, 0xce, 0xaf, 0x25, 0x30, 0xed, 0xab, 0xdc, 0xb0, 0x04, 0xa6, 0x68, 0x84, 0x8c,
+	0x8a, 0x51, 0xbd, 0xe2, 0x4d, 0xd1, 0x08, 0x22, 0x70, 0x35, 0x14, 0x24, 0x90, 0x5c, 0xa0, 0xa9,
+	0x8a, 0x51, 0x9d, 0xf3, 0x46, 0x21, 0x2c, 0x83, 0xd9, 0x84, 0xc8, 0x20, 0x0a, 0x64, 0x80, 0x8a,
+	0x9a, 0x1a, 0xc7, 0xf0, 0x16, 0x00, 0x7d, 0x9e, 0xa6, 0xb8, 0xcf, 0x63, 0x1a, 0xa2, 0x2b, 0x9a,
+	0x9d, 0x53, 0x48, 0x4b, 0x01, 0x70, 0x13, 0xcc, 0x6b, 0x3a, 0x21, 0xb2, 0xc7, 0x23, 0x34, 0xad,
+	0x79, 0xfd, 0x46, 0x5b, 0x23, 0xf0, 0x1e, 0x58, 0xa4, 0xec, 0x25, 0x11, 0x84, 0x85, 0x24, 0x37,
+	0x99, 0xd1, 0xa2, 0xd2, 0x18, 0xce, 0x9c, 0xee, 0x03, 0xf3, 0x44, 0x98, 0xdb, 0x5d, 0xd5, 0xca,
+	0x13, 0x83, 0xdc, 0xb3, 0x0a, 0x4c, 0x32, 0xe0, 0x61, 0x0f, 0xf7, 0x83, 0x54, 0x62, 0xc2, 0x22,
+	0x12, 0xa1, 0xd9, 0x8a, 0x51, 0x2d, 0x7a, 0x25, 0x8d, 0xb7, 0x82, 0x54, 0xda, 0x0a, 0x85, 0xb7,
+	0xc1, 0x42, 0xae, 0x24, 0x2c, 0x96, 0x3d, 0x34, 0xa7, 0x55, 0xf3, 0x99, 0x4a, 0x43, 0xca, 0x2c,
+	0x16, 0x7c, 0xc8, 0x22, 0x2c, 0xc5, 0x50, 0x2a, 0xcf, 0x18, 0x81, 0xcc, 0x2c, 0xc3, 0x7d, 0x05,
+	0xb7, 0x82, 0x58, 0xd5, 0x1a, 0x91, 0x97, 0xc1, 0xb0, 0x2f, 0x71, 0x20, 0x62, 0x34, 0x9f, 0xd5,
+	0x9a, 0x43, 0x96, 0x88, 0x61, 0x07, 0xcc, 0x0c, 0x30, 0xe3, 0x22, 0x41, 0x0b, 0x8a, 0xab, 0x7f,
+	0xf5, 0xf6, 0xfd, 0x66, 0xe1, 0xcf, 0xf7, 0x9b, 0xb5, 0x98, 0xca, 0xde, 0xf0, 0x70, 0x3b, 0xe4,
+	0x49, 0x2d, 0xe8, 0xf7, 0xb9, 0x08, 0x3e, 0x67, 0x44, 0xbe, 0xe6, 0xe2, 0xd5, 0x28, 0x0c, 0x7b,
+	0x01, 0x65, 0xb5, 0x24, 0x90, 0xbd, 0xed, 0x06, 0x09, 0xbd, 0xe9, 0x41, 0x87, 0x8b, 0x04, 0xbe,
+	0x00, 0x0b, 0x41, 0x7f, 0xd0, 0x0b, 0xb0, 0x20, 0xb1, 0x20, 0x12, 0x5d, 0x9b, 0xcc, 0x75, 0x5e,
+	0x9b, 0x79, 0xda, 0x0b, 0xde, 0x05, 0x25, 0xa5, 0x7a, 0x8d, 0x19, 0x89, 0x03, 0x49, 0x8f, 0x08,
+	0x2a, 0x55, 0x8c, 0xea, 0xac, 0x77, 0x4d, 0xa3, 0x9d, 0x1c, 0x84, 0xcf, 0xc0, 0x55, 0x32, 0x48,
+	0x69, 0x9f, 0x33, 0xb4, 0x38, 0x59, 0xf6, 0x91, 0x0f, 0xfc, 0x1e, 0x94, 0x28, 0xa3, 0x92, 0x06,
+	0xfd, 0x51, 0x5d, 0xe6, 0x64, 0xce, 0xd7, 0x72, 0xbb, 0xbc, 0xb2, 0x66, 0x5e, 0x59, 0x9f, 0xa6,
+	0x12, 0x27, 0x3c, 0x22, 0xe8, 0x7a, 0xc5, 0xa8, 0x96, 0x1e, 0x56, 0xb6, 0x4f, 0x5f, 0xc3, 0x6d,
+	0x7d, 0x49, 0xac, 0x91, 0xb0, 0xcd, 0x23, 0x92, 0xd7, 0x3e, 0x0a, 0xe1, 0x63, 0x70, 0x53, 0x65,
+	0x25, 0x02, 0x53, 0x16, 0x84, 0x92, 0x1e, 0x51, 0xf9, 0x06, 0xcb, 0x9e, 0x20, 0x69, 0x8f, 0xf7,
+	0x23, 0x04, 0xf5, 0xcd, 0x5a, 0xcb, 0x24, 0xce, 0x58, 0xe1, 0x8f, 0x04, 0xf0, 0x5b, 0xb0, 0x2e,
+	0xc8, 0x60, 0x28, 0xff, 0xcd, 0x60, 0x49, 0x1b, 0x94, 0x73, 0xcd, 0x65, 0x0e, 0x5f, 0x82, 0xd9,
+	0x90, 0x27, 0x87, 0x94, 0x11, 0x81, 0x96, 0x75, 0x11, 0xe5, 0xb3, 0x45, 0xec, 0xe4, 0xac, 0xff,
+	0x66, 0x40, 0xbc, 0xb1, 0x56, 0xdd, 0x25, 0x3e, 0x94, 0x83, 0xa1, 0xc4, 0x11, 0x4d, 0x08, 0x53,
+	0x72, 0xb4, 0xa2, 0xb3, 0x2d, 0x66, 0x78, 0x63, 0x04, 0xc3, 0xc7, 0x60, 0x3e, 0x97, 0xca, 0x37,
+	0x03, 0x82, 0x6e, 0xe8, 0x2c, 0xb7, 0x2e, 0x69, 0x95, 0xab, 0x55, 0x3a, 0x11, 0xe0, 0xe3, 0x67,
+	0xe8, 0x83, 0xe5, 0x88, 0xa6, 0x52, 0xd0, 0xc3, 0xa1, 0xa4, 0x9c, 0xe1, 0x01, 0xe7, 0x7d, 0xca,
+	0x62, 0xb4, 0xaa, 0x8d, 0x6e, 0x9f, 0x35, 0x6a, 0x9c, 0x52, 0xee, 0x65, 0x42, 0x6f, 0x29, 0xba,
+	0x08, 0xaa, 0x89, 0xd4, 0xe3, 0x82, 0xfe, 0xc8, 0x59, 0x8a, 0x50, 0xa5, 0x58, 0x2d, 0x7a, 0xe3,
+	0x18, 0xfe, 0x00, 0x16, 0xf3, 0x67, 0xfc, 0x9a, 0xd0, 0xb8, 0x27, 0x53, 0xb4, 0x56, 0x29, 0x4e,
+	0x72, 0x80, 0x4a, 0xb9, 0xdf, 0x41, 0x66, 0x07, 0x1d, 0xb0, 0xa8, 0x87, 0x5a, 0x10, 0xc7, 0x42,
+	0x5d, 0x04, 0x2e, 0x50, 0xf9, 0xb2, 0x23, 0xd4, 0xe2, 0x69, 0x6a, 0x8d, 0x35, 0xba, 0x35, 0xa5,
+	0xfe, 0x19, 0x0c, 0x4a, 0x50, 0x96, 0x82, 0x26, 0x09, 0x89, 0x70, 0x42, 0x02, 0x86, 0x55, 0x80,
+	0x5f, 0x0a, 0xf5, 0x53, 0x73, 0x86, 0x6e, 0x4e, 0x76, 0xf0, 0x57, 0x73, 0xeb, 0x36, 0x09, 0x98,
+	0x2f, 0x68, 0xf2, 0x24, 0xf7, 0x85, 0x04, 0x40, 0x5d, 0xc0, 0xd9, 0x6c, 0xeb, 0x93, 0x65, 0x33,
+	0x95, 0xe5, 0x99, 0x34, 0x12, 0x94, 0x7b, 0xc3, 0x43, 0x22, 0x70, 0x96, 0x6c, 0xc8, 0x28, 0x8b,
+	0x71, 0xc8, 0x59, 0x2a, 0x03, 0x26, 0xd1, 0xad, 0x09, 0x8b, 0xd3, 0xd6, 0xaa, 0xc5, 0xbe, 0x36,
+	0xde, 0xc9, 0x7d, 0xe1, 0xd7, 0x60, 0xed, 0x54, 0xd6, 0x24, 0x38, 0xc6, 0x54, 0x12, 0x11, 0xa8,
+	0x6f, 0x94, 0xa2, 0x0d, 0x7d, 0xca, 0x6f, 0x8c, 0xdf, 0x6d, 0x07, 0xc7, 0xce, 0x98, 0xbd, 0xf3,
+	0x08, 0xcc, 0xe9, 0xb3, 0xdc, 0xa2, 0xa9, 0x84, 0x9f, 0x81, 0x19, 0xbd, 0xa4, 0x53, 0x64, 0x54,
+	0x8a, 0xd5, 0xf9, 0x87, 0x4b, 0x97, 0x1c, 0x7a, 0x2f, 0x97, 0xdc, 0xf9, 0xc9, 0x00, 0x2b, 0x3e,
+	0x4d, 0x48, 0x2a, 0x83, 0x64, 0x40, 0x22, 0x2b, 0x94, 0x5c, 0x74, 0xd4, 0xda, 0x55, 0x2b, 0xe6,
+	0xb0, 0xcf, 0xc3, 0x57, 0xb8, 0xa7, 0x4f, 0x8f, 0x5e, 0xb8, 0x45, 0x6f, 0x5e, 0x63, 0xbb, 0x1a,
+	0x82, 0xcb, 0x60, 0x3a, 0x08, 0x4f, 0xf6, 0x6e, 0x16, 0xc0, 0xfb, 0x60, 0x5a, 0x2f, 0x6e, 0xbd,
+	0x72, 0x2f, 0xa4, 0xd7, 0xe6, 0x5e, 0xa6, 0xf8, 0xe6, 0xca, 0xdf, 0xbf, 0x6d, 0x1a, 0x5b, 0x3f,
+	0x1b, 0x00, 0x5e, 0x9c, 0x5a, 0x70, 0x15, 0x2c, 0x59, 0xad, 0x96, 0x7b, 0xd0, 0x72, 0xba, 0x3e,
+	0x6e, 0xbb, 0x0d, 0x1b, 0xbb, 0x7b, 0x76, 0xc7, 0x2c, 0xc0, 0x32, 0xb8, 0x71, 0x8e, 0x38, 0x70,
+	0xbd, 0xa7, 0xb6, 0xd7, 0x35, 0x0d, 0x78, 0x13, 0xac, 0x9e, 0xe3, 0x3c, 0x7b, 0x6f, 0xdf, 0x57,
+	0xe4, 0x14, 0xbc, 0x07, 0x3e, 0xb9, 0xfc, 0x45, 0x6c, 0x75, 0x1a, 0x27, 0xc2, 0xe2, 0xd6, 0x31,
+	0x58, 0x38, 0x3d, 0x81, 0xe0, 0x12, 0x58, 0xdc, 0x71, 0xdb, 0x75, 0xa7, 0x63, 0x7b, 0xd8, 0xb3,
+	0x9b, 0x9e, 0xed, 0x9b, 0x05, 0xb8, 0x0e, 0xd0, 0x18, 0x3c, 0xb0, 0x9d, 0xe6, 0xae, 0x6f, 0x37,
+	0x70, 0xdb, 0x6e, 0x38, 0x56, 0xc7, 0x34, 0xe0, 0x1a, 0x58, 0x19, 0xb3, 0xbe, 0xe7, 0xb4, 0xdb,
+	0x9a, 0xb4, 0x3a, 0xe6, 0x94, 0xfa, 0xfe, 0x63, 0xaa, 0xbb, 0xe3, 0x7a, 0x36, 0xee, 0xba, 0x4f,
+	0xfc, 0xb6, 0xf5, 0x9d, 0x59, 0xdc, 0x7a, 0x06, 0x16, 0xcf, 0x4d, 0x25, 0xb8, 0x0c, 0x4c, 0x77,
+	0xdf, 0xdf, 0xdb, 0xf7, 0xb1, 0xff, 0x7c, 0x4f, 0xd5, 0x63, 0xb5, 0xcc, 0x02, 0xac, 0x82, 0x4f,
+	0x4f, 0xa3, 0x7b, 0x9e, 0x5b, 0xb7, 0xea, 0x4e, 0xcb, 0xf1, 0x9f, 0xe3, 0x86, 0xd3, 0xf5, 0x3d,
+	0xa7, 0xbe, 0xef, 0x3b, 0x6e, 0xc7, 0x34, 0xb6, 0xea, 0x60, 0xe9, 0x92, 0xf9, 0x04, 0x21, 0x28,
+	0xed, 0xb9, 0x6e, 0xcb, 0xe9, 0x34, 0x71, 0xcb, 0xe9, 0xd8, 0x96, 0x67, 0x16, 0x54, 0xcb, 0xc7,
+	0x98, 0xdb, 0xb4, 0x3c, 0xc7, 0xdf, 0x6d, 0x3b, 0x3b, 0xa6, 0xb1, 0xf5, 0xbb, 0x01, 0xe0, 0xc5,
+	0xa9, 0xa0, 0x1a, 0xda, 0x72, 0xbb, 0x5d, 0x6c, 0x35, 0x9b, 0x9e, 0xdd, 0xb4, 0x7c, 0xd7, 0xc3,
+	0x5d, 0xdf, 0x7a, 0x6a, 0x9f, 0xee, 0x87, 0xa5, 0x7e, 0xb2, 0xfb, 0xe0, 0xee, 0x7f, 0x0a, 0xf3,
+	0xc6, 0x55, 0xc0, 0xfa, 0x79, 0xe9, 0xb9, 0xfe, 0xad, 0x81, 0x95, 0xf3, 0x8a, 0xdd, 0xfd, 0xba,
+	0xed, 0x99, 0xc5, 0xba, 0xf7, 0xf6, 0xc3, 0x86, 0xf1, 0xee, 0xc3, 0x86, 0xf1, 0xd7, 0x87, 0x0d,
+	0xe3, 0x97, 0x8f, 0x1b, 0x85, 0x77, 0x1f, 0x37, 0x0a, 0x7f, 0x7c, 0xdc, 0x28, 0xbc, 0x78, 0xf4,
+	0x3f, 0xef, 0xe9, 0x71, 0xed, 0xe4, 0x2f, 0xa8, 0xda, 0x1c, 0xe9, 0xe1, 0x8c, 0xfe, 0x03, 0xfa,
+	0xc5, 0x3f, 0x03, 0x00, 0xf6, 0xa8, 0x1d, 0x66, 0x08, 0x0b, 0x00, 0x00,
 }
 
 func (this *TimestampedActorNonce) Equal(that interface{}) bool {
@@ -367,6 +670,142 @@ func (m *Topic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HuberLossMaxIterations != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.HuberLossMaxIterations))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
+	}
+	{
+		size := m.HuberLossTuningConstant.Size()
+		i -= size
+		if _, err := m.HuberLossTuningConstant.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopic(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xea
+	{
+		size := m.LossTrimFraction.Size()
+		i -= size
+		if _, err := m.LossTrimFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopic(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xe2
+	{
+		size := m.TrimmedMeanTrimFraction.Size()
+		i -= size
+		if _, err := m.TrimmedMeanTrimFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopic(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xda
+	if m.LossAggregator != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.LossAggregator))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if len(m.HorizonWeights) > 0 {
+		for iNdEx := len(m.HorizonWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.HorizonWeights[iNdEx].Size()
+				i -= size
+				if _, err := m.HorizonWeights[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTopic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.Horizons) > 0 {
+		dAtA2 := make([]byte, len(m.Horizons)*10)
+		var j1 int
+		for _, num1 := range m.Horizons {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTopic(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.DistributionPooling != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.DistributionPooling))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.OutputType != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.OutputType))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.OutputDimension != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.OutputDimension))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.Combiner != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.Combiner))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.ReputerInactivityThreshold != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.ReputerInactivityThreshold))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.WorkerInactivityThreshold != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.WorkerInactivityThreshold))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.AllowlistMode != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.AllowlistMode))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
 	{
 		size := m.InitialRegret.Size()
 		i -= size
@@ -643,6 +1082,52 @@ func (m *Topic) Size() (n int) {
 	n += 1 + l + sovTopic(uint64(l))
 	l = m.InitialRegret.Size()
 	n += 2 + l + sovTopic(uint64(l))
+	if m.AllowlistMode != 0 {
+		n += 2 + sovTopic(uint64(m.AllowlistMode))
+	}
+	if m.WorkerInactivityThreshold != 0 {
+		n += 2 + sovTopic(uint64(m.WorkerInactivityThreshold))
+	}
+	if m.ReputerInactivityThreshold != 0 {
+		n += 2 + sovTopic(uint64(m.ReputerInactivityThreshold))
+	}
+	if m.Combiner != 0 {
+		n += 2 + sovTopic(uint64(m.Combiner))
+	}
+	if m.OutputDimension != 0 {
+		n += 2 + sovTopic(uint64(m.OutputDimension))
+	}
+	if m.OutputType != 0 {
+		n += 2 + sovTopic(uint64(m.OutputType))
+	}
+	if m.DistributionPooling != 0 {
+		n += 2 + sovTopic(uint64(m.DistributionPooling))
+	}
+	if len(m.Horizons) > 0 {
+		l = 0
+		for _, e := range m.Horizons {
+			l += sovTopic(uint64(e))
+		}
+		n += 2 + sovTopic(uint64(l)) + l
+	}
+	if len(m.HorizonWeights) > 0 {
+		for _, e := range m.HorizonWeights {
+			l = e.Size()
+			n += 2 + l + sovTopic(uint64(l))
+		}
+	}
+	if m.LossAggregator != 0 {
+		n += 2 + sovTopic(uint64(m.LossAggregator))
+	}
+	l = m.TrimmedMeanTrimFraction.Size()
+	n += 2 + l + sovTopic(uint64(l))
+	l = m.LossTrimFraction.Size()
+	n += 2 + l + sovTopic(uint64(l))
+	l = m.HuberLossTuningConstant.Size()
+	n += 2 + l + sovTopic(uint64(l))
+	if m.HuberLossMaxIterations != 0 {
+		n += 2 + sovTopic(uint64(m.HuberLossMaxIterations))
+	}
 	return n
 }
 
@@ -1172,6 +1657,391 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistMode", wireType)
+			}
+			m.AllowlistMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AllowlistMode |= TopicAllowlistMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerInactivityThreshold", wireType)
+			}
+			m.WorkerInactivityThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkerInactivityThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerInactivityThreshold", wireType)
+			}
+			m.ReputerInactivityThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReputerInactivityThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Combiner", wireType)
+			}
+			m.Combiner = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Combiner |= CombinerType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputDimension", wireType)
+			}
+			m.OutputDimension = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutputDimension |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputType", wireType)
+			}
+			m.OutputType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutputType |= TopicOutputType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionPooling", wireType)
+			}
+			m.DistributionPooling = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DistributionPooling |= DistributionPooling(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTopic
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Horizons = append(m.Horizons, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTopic
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTopic
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTopic
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Horizons) == 0 {
+					m.Horizons = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTopic
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Horizons = append(m.Horizons, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Horizons", wireType)
+			}
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HorizonWeights", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.HorizonWeights = append(m.HorizonWeights, v)
+			if err := m.HorizonWeights[len(m.HorizonWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LossAggregator", wireType)
+			}
+			m.LossAggregator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LossAggregator |= LossAggregatorType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrimmedMeanTrimFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TrimmedMeanTrimFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LossTrimFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LossTrimFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HuberLossTuningConstant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HuberLossTuningConstant.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HuberLossMaxIterations", wireType)
+			}
+			m.HuberLossMaxIterations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HuberLossMaxIterations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTopic(dAtA[iNdEx:])
@@ -81,6 +81,16 @@ type OptionalParams struct {
 	EpsilonReputer                      []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,40,rep,name=epsilon_reputer,json=epsilonReputer,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"epsilon_reputer"`
 	MinEffectiveTopicRevenue            []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,41,rep,name=min_effective_topic_revenue,json=minEffectiveTopicRevenue,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"min_effective_topic_revenue"`
 	HalfMaxProcessStakeRemovalsEndBlock []uint64                                          `protobuf:"varint,42,rep,packed,name=half_max_process_stake_removals_end_block,json=halfMaxProcessStakeRemovalsEndBlock,proto3" json:"half_max_process_stake_removals_end_block,omitempty"`
+	PermissionedTopicCreation           []bool                                            `protobuf:"varint,43,rep,packed,name=permissioned_topic_creation,json=permissionedTopicCreation,proto3" json:"permissioned_topic_creation,omitempty"`
+	MultiRegistrationFeeDiscount        []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,44,rep,name=multi_registration_fee_discount,json=multiRegistrationFeeDiscount,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"multi_registration_fee_discount"`
+	MaxTopicOutputDimension             []uint64                                          `protobuf:"varint,45,rep,packed,name=max_topic_output_dimension,json=maxTopicOutputDimension,proto3" json:"max_topic_output_dimension,omitempty"`
+	MaxTopicHorizons                    []uint64                                          `protobuf:"varint,46,rep,packed,name=max_topic_horizons,json=maxTopicHorizons,proto3" json:"max_topic_horizons,omitempty"`
+	ActorHistoryRetention               []int64                                           `protobuf:"varint,47,rep,packed,name=actor_history_retention,json=actorHistoryRetention,proto3" json:"actor_history_retention,omitempty"`
+	BlocksPerMonthCalibrationEnabled    []bool                                            `protobuf:"varint,48,rep,packed,name=blocks_per_month_calibration_enabled,json=blocksPerMonthCalibrationEnabled,proto3" json:"blocks_per_month_calibration_enabled,omitempty"`
+	MinBlocksPerMonth                   []uint64                                          `protobuf:"varint,49,rep,packed,name=min_blocks_per_month,json=minBlocksPerMonth,proto3" json:"min_blocks_per_month,omitempty"`
+	MaxBlocksPerMonth                   []uint64                                          `protobuf:"varint,50,rep,packed,name=max_blocks_per_month,json=maxBlocksPerMonth,proto3" json:"max_blocks_per_month,omitempty"`
+	MaxBlocksPerMonthChange             []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,51,rep,name=max_blocks_per_month_change,json=maxBlocksPerMonthChange,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"max_blocks_per_month_change"`
+	BlockTimeSmoothingDegree            []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,52,rep,name=block_time_smoothing_degree,json=blockTimeSmoothingDegree,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"block_time_smoothing_degree"`
 }
 
 func (m *OptionalParams) Reset()         { *m = OptionalParams{} }
@@ -249,6 +259,55 @@ func (m *OptionalParams) GetHalfMaxProcessStakeRemovalsEndBlock() []uint64 {
 	return nil
 }
 
+func (m *OptionalParams) GetPermissionedTopicCreation() []bool {
+	if m != nil {
+		return m.PermissionedTopicCreation
+	}
+	return nil
+}
+
+func (m *OptionalParams) GetMaxTopicOutputDimension() []uint64 {
+	if m != nil {
+		return m.MaxTopicOutputDimension
+	}
+	return nil
+}
+
+func (m *OptionalParams) GetMaxTopicHorizons() []uint64 {
+	if m != nil {
+		return m.MaxTopicHorizons
+	}
+	return nil
+}
+
+func (m *OptionalParams) GetActorHistoryRetention() []int64 {
+	if m != nil {
+		return m.ActorHistoryRetention
+	}
+	return nil
+}
+
+func (m *OptionalParams) GetBlocksPerMonthCalibrationEnabled() []bool {
+	if m != nil {
+		return m.BlocksPerMonthCalibrationEnabled
+	}
+	return nil
+}
+
+func (m *OptionalParams) GetMinBlocksPerMonth() []uint64 {
+	if m != nil {
+		return m.MinBlocksPerMonth
+	}
+	return nil
+}
+
+func (m *OptionalParams) GetMaxBlocksPerMonth() []uint64 {
+	if m != nil {
+		return m.MaxBlocksPerMonth
+	}
+	return nil
+}
+
 type MsgUpdateParams struct {
 	Sender string          `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	Params *OptionalParams `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
@@ -339,19 +398,33 @@ var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
 type MsgCreateNewTopic struct {
 	// creator is the message sender.
-	Creator         string                                          `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Metadata        string                                          `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	LossLogic       string                                          `protobuf:"bytes,3,opt,name=loss_logic,json=lossLogic,proto3" json:"loss_logic,omitempty"`
-	LossMethod      string                                          `protobuf:"bytes,4,opt,name=loss_method,json=lossMethod,proto3" json:"loss_method,omitempty"`
-	InferenceLogic  string                                          `protobuf:"bytes,5,opt,name=inference_logic,json=inferenceLogic,proto3" json:"inference_logic,omitempty"`
-	InferenceMethod string                                          `protobuf:"bytes,6,opt,name=inference_method,json=inferenceMethod,proto3" json:"inference_method,omitempty"`
-	EpochLength     int64                                           `protobuf:"varint,7,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
-	GroundTruthLag  int64                                           `protobuf:"varint,8,opt,name=ground_truth_lag,json=groundTruthLag,proto3" json:"ground_truth_lag,omitempty"`
-	DefaultArg      string                                          `protobuf:"bytes,9,opt,name=default_arg,json=defaultArg,proto3" json:"default_arg,omitempty"`
-	PNorm           github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,10,opt,name=p_norm,json=pNorm,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"p_norm"`
-	AlphaRegret     github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,11,opt,name=alpha_regret,json=alphaRegret,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"alpha_regret"`
-	AllowNegative   bool                                            `protobuf:"varint,12,opt,name=allow_negative,json=allowNegative,proto3" json:"allow_negative,omitempty"`
-	Epsilon         github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,13,opt,name=epsilon,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"epsilon"`
+	Creator                    string                                            `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Metadata                   string                                            `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	LossLogic                  string                                            `protobuf:"bytes,3,opt,name=loss_logic,json=lossLogic,proto3" json:"loss_logic,omitempty"`
+	LossMethod                 string                                            `protobuf:"bytes,4,opt,name=loss_method,json=lossMethod,proto3" json:"loss_method,omitempty"`
+	InferenceLogic             string                                            `protobuf:"bytes,5,opt,name=inference_logic,json=inferenceLogic,proto3" json:"inference_logic,omitempty"`
+	InferenceMethod            string                                            `protobuf:"bytes,6,opt,name=inference_method,json=inferenceMethod,proto3" json:"inference_method,omitempty"`
+	EpochLength                int64                                             `protobuf:"varint,7,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	GroundTruthLag             int64                                             `protobuf:"varint,8,opt,name=ground_truth_lag,json=groundTruthLag,proto3" json:"ground_truth_lag,omitempty"`
+	DefaultArg                 string                                            `protobuf:"bytes,9,opt,name=default_arg,json=defaultArg,proto3" json:"default_arg,omitempty"`
+	PNorm                      github_com_allora_network_allora_chain_math.Dec   `protobuf:"bytes,10,opt,name=p_norm,json=pNorm,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"p_norm"`
+	AlphaRegret                github_com_allora_network_allora_chain_math.Dec   `protobuf:"bytes,11,opt,name=alpha_regret,json=alphaRegret,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"alpha_regret"`
+	AllowNegative              bool                                              `protobuf:"varint,12,opt,name=allow_negative,json=allowNegative,proto3" json:"allow_negative,omitempty"`
+	Epsilon                    github_com_allora_network_allora_chain_math.Dec   `protobuf:"bytes,13,opt,name=epsilon,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"epsilon"`
+	AllowlistMode              TopicAllowlistMode                                `protobuf:"varint,14,opt,name=allowlist_mode,json=allowlistMode,proto3,enum=emissions.v1.TopicAllowlistMode" json:"allowlist_mode,omitempty"`
+	WorkerInactivityThreshold  uint64                                            `protobuf:"varint,15,opt,name=worker_inactivity_threshold,json=workerInactivityThreshold,proto3" json:"worker_inactivity_threshold,omitempty"`
+	ReputerInactivityThreshold uint64                                            `protobuf:"varint,16,opt,name=reputer_inactivity_threshold,json=reputerInactivityThreshold,proto3" json:"reputer_inactivity_threshold,omitempty"`
+	Combiner                   CombinerType                                      `protobuf:"varint,17,opt,name=combiner,proto3,enum=emissions.v1.CombinerType" json:"combiner,omitempty"`
+	OutputDimension            uint64                                            `protobuf:"varint,18,opt,name=output_dimension,json=outputDimension,proto3" json:"output_dimension,omitempty"`
+	OutputType                 TopicOutputType                                   `protobuf:"varint,19,opt,name=output_type,json=outputType,proto3,enum=emissions.v1.TopicOutputType" json:"output_type,omitempty"`
+	DistributionPooling        DistributionPooling                               `protobuf:"varint,20,opt,name=distribution_pooling,json=distributionPooling,proto3,enum=emissions.v1.DistributionPooling" json:"distribution_pooling,omitempty"`
+	Horizons                   []int64                                           `protobuf:"varint,21,rep,packed,name=horizons,proto3" json:"horizons,omitempty"`
+	HorizonWeights             []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,22,rep,name=horizon_weights,json=horizonWeights,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"horizon_weights"`
+	LossAggregator             LossAggregatorType                                `protobuf:"varint,23,opt,name=loss_aggregator,json=lossAggregator,proto3,enum=emissions.v1.LossAggregatorType" json:"loss_aggregator,omitempty"`
+	TrimmedMeanTrimFraction    github_com_allora_network_allora_chain_math.Dec   `protobuf:"bytes,24,opt,name=trimmed_mean_trim_fraction,json=trimmedMeanTrimFraction,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"trimmed_mean_trim_fraction"`
+	LossTrimFraction           github_com_allora_network_allora_chain_math.Dec   `protobuf:"bytes,25,opt,name=loss_trim_fraction,json=lossTrimFraction,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"loss_trim_fraction"`
+	HuberLossTuningConstant    github_com_allora_network_allora_chain_math.Dec   `protobuf:"bytes,26,opt,name=huber_loss_tuning_constant,json=huberLossTuningConstant,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"huber_loss_tuning_constant"`
+	HuberLossMaxIterations     uint64                                            `protobuf:"varint,27,opt,name=huber_loss_max_iterations,json=huberLossMaxIterations,proto3" json:"huber_loss_max_iterations,omitempty"`
 }
 
 func (m *MsgCreateNewTopic) Reset()         { *m = MsgCreateNewTopic{} }
@@ -457,6 +530,76 @@ func (m *MsgCreateNewTopic) GetAllowNegative() bool {
 	return false
 }
 
+func (m *MsgCreateNewTopic) GetAllowlistMode() TopicAllowlistMode {
+	if m != nil {
+		return m.AllowlistMode
+	}
+	return TopicAllowlistMode_ALLOWLIST_MODE_OPEN
+}
+
+func (m *MsgCreateNewTopic) GetWorkerInactivityThreshold() uint64 {
+	if m != nil {
+		return m.WorkerInactivityThreshold
+	}
+	return 0
+}
+
+func (m *MsgCreateNewTopic) GetReputerInactivityThreshold() uint64 {
+	if m != nil {
+		return m.ReputerInactivityThreshold
+	}
+	return 0
+}
+
+func (m *MsgCreateNewTopic) GetCombiner() CombinerType {
+	if m != nil {
+		return m.Combiner
+	}
+	return CombinerType_COMBINER_REGRET
+}
+
+func (m *MsgCreateNewTopic) GetOutputDimension() uint64 {
+	if m != nil {
+		return m.OutputDimension
+	}
+	return 0
+}
+
+func (m *MsgCreateNewTopic) GetOutputType() TopicOutputType {
+	if m != nil {
+		return m.OutputType
+	}
+	return TopicOutputType_OUTPUT_TYPE_REAL
+}
+
+func (m *MsgCreateNewTopic) GetDistributionPooling() DistributionPooling {
+	if m != nil {
+		return m.DistributionPooling
+	}
+	return DistributionPooling_POOLING_LINEAR
+}
+
+func (m *MsgCreateNewTopic) GetHorizons() []int64 {
+	if m != nil {
+		return m.Horizons
+	}
+	return nil
+}
+
+func (m *MsgCreateNewTopic) GetLossAggregator() LossAggregatorType {
+	if m != nil {
+		return m.LossAggregator
+	}
+	return LossAggregatorType_LOSS_AGGREGATOR_STAKE_WEIGHTED_MEAN
+}
+
+func (m *MsgCreateNewTopic) GetHuberLossMaxIterations() uint64 {
+	if m != nil {
+		return m.HuberLossMaxIterations
+	}
+	return 0
+}
+
 type MsgCreateNewTopicResponse struct {
 	TopicId uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
 }
@@ -957,24 +1100,28 @@ func (m *MsgRemoveRegistrationResponse) GetMessage() string {
 	return ""
 }
 
-type MsgAddStake struct {
-	Sender  string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	TopicId uint64                `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	Amount  cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+type MsgRegisterMulti struct {
+	Sender         string   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	LibP2PKey      string   `protobuf:"bytes,2,opt,name=lib_p2p_key,json=libP2pKey,proto3" json:"lib_p2p_key,omitempty"`
+	MultiAddress   string   `protobuf:"bytes,3,opt,name=multi_address,json=multiAddress,proto3" json:"multi_address,omitempty"`
+	TopicIds       []uint64 `protobuf:"varint,4,rep,packed,name=topic_ids,json=topicIds,proto3" json:"topic_ids,omitempty"`
+	RemoveTopicIds []uint64 `protobuf:"varint,5,rep,packed,name=remove_topic_ids,json=removeTopicIds,proto3" json:"remove_topic_ids,omitempty"`
+	Owner          string   `protobuf:"bytes,6,opt,name=owner,proto3" json:"owner,omitempty"`
+	IsReputer      bool     `protobuf:"varint,7,opt,name=is_reputer,json=isReputer,proto3" json:"is_reputer,omitempty"`
 }
 
-func (m *MsgAddStake) Reset()         { *m = MsgAddStake{} }
-func (m *MsgAddStake) String() string { return proto.CompactTextString(m) }
-func (*MsgAddStake) ProtoMessage()    {}
-func (*MsgAddStake) Descriptor() ([]byte, []int) {
+func (m *MsgRegisterMulti) Reset()         { *m = MsgRegisterMulti{} }
+func (m *MsgRegisterMulti) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterMulti) ProtoMessage()    {}
+func (*MsgRegisterMulti) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8293ea1b0f4b608c, []int{13}
 }
-func (m *MsgAddStake) XXX_Unmarshal(b []byte) error {
+func (m *MsgRegisterMulti) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRegisterMulti) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddStake.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRegisterMulti.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -984,47 +1131,84 @@ func (m *MsgAddStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *MsgAddStake) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddStake.Merge(m, src)
+func (m *MsgRegisterMulti) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterMulti.Merge(m, src)
 }
-func (m *MsgAddStake) XXX_Size() int {
+func (m *MsgRegisterMulti) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddStake) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddStake.DiscardUnknown(m)
+func (m *MsgRegisterMulti) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterMulti.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddStake proto.InternalMessageInfo
+var xxx_messageInfo_MsgRegisterMulti proto.InternalMessageInfo
 
-func (m *MsgAddStake) GetSender() string {
+func (m *MsgRegisterMulti) GetSender() string {
 	if m != nil {
 		return m.Sender
 	}
 	return ""
 }
 
-func (m *MsgAddStake) GetTopicId() uint64 {
+func (m *MsgRegisterMulti) GetLibP2PKey() string {
 	if m != nil {
-		return m.TopicId
+		return m.LibP2PKey
 	}
-	return 0
+	return ""
 }
 
-type MsgAddStakeResponse struct {
+func (m *MsgRegisterMulti) GetMultiAddress() string {
+	if m != nil {
+		return m.MultiAddress
+	}
+	return ""
 }
 
-func (m *MsgAddStakeResponse) Reset()         { *m = MsgAddStakeResponse{} }
-func (m *MsgAddStakeResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgAddStakeResponse) ProtoMessage()    {}
-func (*MsgAddStakeResponse) Descriptor() ([]byte, []int) {
+func (m *MsgRegisterMulti) GetTopicIds() []uint64 {
+	if m != nil {
+		return m.TopicIds
+	}
+	return nil
+}
+
+func (m *MsgRegisterMulti) GetRemoveTopicIds() []uint64 {
+	if m != nil {
+		return m.RemoveTopicIds
+	}
+	return nil
+}
+
+func (m *MsgRegisterMulti) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgRegisterMulti) GetIsReputer() bool {
+	if m != nil {
+		return m.IsReputer
+	}
+	return false
+}
+
+type MsgRegisterMultiResponse struct {
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *MsgRegisterMultiResponse) Reset()         { *m = MsgRegisterMultiResponse{} }
+func (m *MsgRegisterMultiResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterMultiResponse) ProtoMessage()    {}
+func (*MsgRegisterMultiResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8293ea1b0f4b608c, []int{14}
 }
-func (m *MsgAddStakeResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgRegisterMultiResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRegisterMultiResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddStakeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRegisterMultiResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1034,36 +1218,52 @@ func (m *MsgAddStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgAddStakeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddStakeResponse.Merge(m, src)
+func (m *MsgRegisterMultiResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterMultiResponse.Merge(m, src)
 }
-func (m *MsgAddStakeResponse) XXX_Size() int {
+func (m *MsgRegisterMultiResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddStakeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddStakeResponse.DiscardUnknown(m)
+func (m *MsgRegisterMultiResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterMultiResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddStakeResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgRegisterMultiResponse proto.InternalMessageInfo
 
-type MsgRemoveStake struct {
-	Sender  string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	TopicId uint64                `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	Amount  cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+func (m *MsgRegisterMultiResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
 }
 
-func (m *MsgRemoveStake) Reset()         { *m = MsgRemoveStake{} }
-func (m *MsgRemoveStake) String() string { return proto.CompactTextString(m) }
-func (*MsgRemoveStake) ProtoMessage()    {}
-func (*MsgRemoveStake) Descriptor() ([]byte, []int) {
+func (m *MsgRegisterMultiResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type MsgUpdateNodeInfo struct {
+	Sender       string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	LibP2PKey    string `protobuf:"bytes,2,opt,name=lib_p2p_key,json=libP2pKey,proto3" json:"lib_p2p_key,omitempty"`
+	MultiAddress string `protobuf:"bytes,3,opt,name=multi_address,json=multiAddress,proto3" json:"multi_address,omitempty"`
+	Owner        string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	IsReputer    bool   `protobuf:"varint,5,opt,name=is_reputer,json=isReputer,proto3" json:"is_reputer,omitempty"`
+}
+
+func (m *MsgUpdateNodeInfo) Reset()         { *m = MsgUpdateNodeInfo{} }
+func (m *MsgUpdateNodeInfo) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateNodeInfo) ProtoMessage()    {}
+func (*MsgUpdateNodeInfo) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8293ea1b0f4b608c, []int{15}
 }
-func (m *MsgRemoveStake) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpdateNodeInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRemoveStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateNodeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRemoveStake.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateNodeInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1073,47 +1273,70 @@ func (m *MsgRemoveStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *MsgRemoveStake) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRemoveStake.Merge(m, src)
+func (m *MsgUpdateNodeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateNodeInfo.Merge(m, src)
 }
-func (m *MsgRemoveStake) XXX_Size() int {
+func (m *MsgUpdateNodeInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRemoveStake) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRemoveStake.DiscardUnknown(m)
+func (m *MsgUpdateNodeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateNodeInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRemoveStake proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateNodeInfo proto.InternalMessageInfo
 
-func (m *MsgRemoveStake) GetSender() string {
+func (m *MsgUpdateNodeInfo) GetSender() string {
 	if m != nil {
 		return m.Sender
 	}
 	return ""
 }
 
-func (m *MsgRemoveStake) GetTopicId() uint64 {
+func (m *MsgUpdateNodeInfo) GetLibP2PKey() string {
 	if m != nil {
-		return m.TopicId
+		return m.LibP2PKey
 	}
-	return 0
+	return ""
 }
 
-type MsgRemoveStakeResponse struct {
+func (m *MsgUpdateNodeInfo) GetMultiAddress() string {
+	if m != nil {
+		return m.MultiAddress
+	}
+	return ""
 }
 
-func (m *MsgRemoveStakeResponse) Reset()         { *m = MsgRemoveStakeResponse{} }
-func (m *MsgRemoveStakeResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRemoveStakeResponse) ProtoMessage()    {}
-func (*MsgRemoveStakeResponse) Descriptor() ([]byte, []int) {
+func (m *MsgUpdateNodeInfo) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgUpdateNodeInfo) GetIsReputer() bool {
+	if m != nil {
+		return m.IsReputer
+	}
+	return false
+}
+
+type MsgUpdateNodeInfoResponse struct {
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *MsgUpdateNodeInfoResponse) Reset()         { *m = MsgUpdateNodeInfoResponse{} }
+func (m *MsgUpdateNodeInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateNodeInfoResponse) ProtoMessage()    {}
+func (*MsgUpdateNodeInfoResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8293ea1b0f4b608c, []int{16}
 }
-func (m *MsgRemoveStakeResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpdateNodeInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRemoveStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateNodeInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRemoveStakeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateNodeInfoResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1123,35 +1346,50 @@ func (m *MsgRemoveStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *MsgRemoveStakeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRemoveStakeResponse.Merge(m, src)
+func (m *MsgUpdateNodeInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateNodeInfoResponse.Merge(m, src)
 }
-func (m *MsgRemoveStakeResponse) XXX_Size() int {
+func (m *MsgUpdateNodeInfoResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRemoveStakeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRemoveStakeResponse.DiscardUnknown(m)
+func (m *MsgUpdateNodeInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateNodeInfoResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRemoveStakeResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateNodeInfoResponse proto.InternalMessageInfo
 
-type MsgCancelRemoveStake struct {
-	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	TopicId uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+func (m *MsgUpdateNodeInfoResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
 }
 
-func (m *MsgCancelRemoveStake) Reset()         { *m = MsgCancelRemoveStake{} }
-func (m *MsgCancelRemoveStake) String() string { return proto.CompactTextString(m) }
-func (*MsgCancelRemoveStake) ProtoMessage()    {}
-func (*MsgCancelRemoveStake) Descriptor() ([]byte, []int) {
+func (m *MsgUpdateNodeInfoResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type MsgAddStake struct {
+	Sender  string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId uint64                `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Amount  cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *MsgAddStake) Reset()         { *m = MsgAddStake{} }
+func (m *MsgAddStake) String() string { return proto.CompactTextString(m) }
+func (*MsgAddStake) ProtoMessage()    {}
+func (*MsgAddStake) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8293ea1b0f4b608c, []int{17}
 }
-func (m *MsgCancelRemoveStake) XXX_Unmarshal(b []byte) error {
+func (m *MsgAddStake) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCancelRemoveStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAddStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCancelRemoveStake.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAddStake.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1161,8 +1399,185 @@ func (m *MsgCancelRemoveStake) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *MsgCancelRemoveStake) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCancelRemoveStake.Merge(m, src)
+func (m *MsgAddStake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddStake.Merge(m, src)
+}
+func (m *MsgAddStake) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddStake) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddStake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddStake proto.InternalMessageInfo
+
+func (m *MsgAddStake) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgAddStake) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+type MsgAddStakeResponse struct {
+}
+
+func (m *MsgAddStakeResponse) Reset()         { *m = MsgAddStakeResponse{} }
+func (m *MsgAddStakeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddStakeResponse) ProtoMessage()    {}
+func (*MsgAddStakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{18}
+}
+func (m *MsgAddStakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddStakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddStakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddStakeResponse.Merge(m, src)
+}
+func (m *MsgAddStakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddStakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddStakeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddStakeResponse proto.InternalMessageInfo
+
+type MsgRemoveStake struct {
+	Sender  string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId uint64                `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Amount  cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *MsgRemoveStake) Reset()         { *m = MsgRemoveStake{} }
+func (m *MsgRemoveStake) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveStake) ProtoMessage()    {}
+func (*MsgRemoveStake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{19}
+}
+func (m *MsgRemoveStake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveStake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveStake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveStake.Merge(m, src)
+}
+func (m *MsgRemoveStake) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveStake) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveStake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveStake proto.InternalMessageInfo
+
+func (m *MsgRemoveStake) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgRemoveStake) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+type MsgRemoveStakeResponse struct {
+}
+
+func (m *MsgRemoveStakeResponse) Reset()         { *m = MsgRemoveStakeResponse{} }
+func (m *MsgRemoveStakeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveStakeResponse) ProtoMessage()    {}
+func (*MsgRemoveStakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{20}
+}
+func (m *MsgRemoveStakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveStakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveStakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveStakeResponse.Merge(m, src)
+}
+func (m *MsgRemoveStakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveStakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveStakeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveStakeResponse proto.InternalMessageInfo
+
+type MsgCancelRemoveStake struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+}
+
+func (m *MsgCancelRemoveStake) Reset()         { *m = MsgCancelRemoveStake{} }
+func (m *MsgCancelRemoveStake) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRemoveStake) ProtoMessage()    {}
+func (*MsgCancelRemoveStake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{21}
+}
+func (m *MsgCancelRemoveStake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRemoveStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRemoveStake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelRemoveStake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRemoveStake.Merge(m, src)
 }
 func (m *MsgCancelRemoveStake) XXX_Size() int {
 	return m.Size()
@@ -1194,7 +1609,7 @@ func (m *MsgCancelRemoveStakeResponse) Reset()         { *m = MsgCancelRemoveSta
 func (m *MsgCancelRemoveStakeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelRemoveStakeResponse) ProtoMessage()    {}
 func (*MsgCancelRemoveStakeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{18}
+	return fileDescriptor_8293ea1b0f4b608c, []int{22}
 }
 func (m *MsgCancelRemoveStakeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1234,7 +1649,7 @@ func (m *MsgDelegateStake) Reset()         { *m = MsgDelegateStake{} }
 func (m *MsgDelegateStake) String() string { return proto.CompactTextString(m) }
 func (*MsgDelegateStake) ProtoMessage()    {}
 func (*MsgDelegateStake) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{19}
+	return fileDescriptor_8293ea1b0f4b608c, []int{23}
 }
 func (m *MsgDelegateStake) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1291,7 +1706,7 @@ func (m *MsgDelegateStakeResponse) Reset()         { *m = MsgDelegateStakeRespon
 func (m *MsgDelegateStakeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDelegateStakeResponse) ProtoMessage()    {}
 func (*MsgDelegateStakeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{20}
+	return fileDescriptor_8293ea1b0f4b608c, []int{24}
 }
 func (m *MsgDelegateStakeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1331,7 +1746,7 @@ func (m *MsgRemoveDelegateStake) Reset()         { *m = MsgRemoveDelegateStake{}
 func (m *MsgRemoveDelegateStake) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoveDelegateStake) ProtoMessage()    {}
 func (*MsgRemoveDelegateStake) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{21}
+	return fileDescriptor_8293ea1b0f4b608c, []int{25}
 }
 func (m *MsgRemoveDelegateStake) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1388,7 +1803,7 @@ func (m *MsgRemoveDelegateStakeResponse) Reset()         { *m = MsgRemoveDelegat
 func (m *MsgRemoveDelegateStakeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoveDelegateStakeResponse) ProtoMessage()    {}
 func (*MsgRemoveDelegateStakeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{22}
+	return fileDescriptor_8293ea1b0f4b608c, []int{26}
 }
 func (m *MsgRemoveDelegateStakeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1428,7 +1843,7 @@ func (m *MsgCancelRemoveDelegateStake) Reset()         { *m = MsgCancelRemoveDel
 func (m *MsgCancelRemoveDelegateStake) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelRemoveDelegateStake) ProtoMessage()    {}
 func (*MsgCancelRemoveDelegateStake) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{23}
+	return fileDescriptor_8293ea1b0f4b608c, []int{27}
 }
 func (m *MsgCancelRemoveDelegateStake) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1492,7 +1907,7 @@ func (m *MsgCancelRemoveDelegateStakeResponse) Reset()         { *m = MsgCancelR
 func (m *MsgCancelRemoveDelegateStakeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelRemoveDelegateStakeResponse) ProtoMessage()    {}
 func (*MsgCancelRemoveDelegateStakeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{24}
+	return fileDescriptor_8293ea1b0f4b608c, []int{28}
 }
 func (m *MsgCancelRemoveDelegateStakeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1533,7 +1948,7 @@ func (m *MsgFundTopic) Reset()         { *m = MsgFundTopic{} }
 func (m *MsgFundTopic) String() string { return proto.CompactTextString(m) }
 func (*MsgFundTopic) ProtoMessage()    {}
 func (*MsgFundTopic) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{25}
+	return fileDescriptor_8293ea1b0f4b608c, []int{29}
 }
 func (m *MsgFundTopic) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1583,7 +1998,7 @@ func (m *MsgFundTopicResponse) Reset()         { *m = MsgFundTopicResponse{} }
 func (m *MsgFundTopicResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgFundTopicResponse) ProtoMessage()    {}
 func (*MsgFundTopicResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{26}
+	return fileDescriptor_8293ea1b0f4b608c, []int{30}
 }
 func (m *MsgFundTopicResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1621,7 +2036,7 @@ func (m *MsgAddToWhitelistAdmin) Reset()         { *m = MsgAddToWhitelistAdmin{}
 func (m *MsgAddToWhitelistAdmin) String() string { return proto.CompactTextString(m) }
 func (*MsgAddToWhitelistAdmin) ProtoMessage()    {}
 func (*MsgAddToWhitelistAdmin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{27}
+	return fileDescriptor_8293ea1b0f4b608c, []int{31}
 }
 func (m *MsgAddToWhitelistAdmin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1671,7 +2086,7 @@ func (m *MsgAddToWhitelistAdminResponse) Reset()         { *m = MsgAddToWhitelis
 func (m *MsgAddToWhitelistAdminResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAddToWhitelistAdminResponse) ProtoMessage()    {}
 func (*MsgAddToWhitelistAdminResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{28}
+	return fileDescriptor_8293ea1b0f4b608c, []int{32}
 }
 func (m *MsgAddToWhitelistAdminResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1709,7 +2124,7 @@ func (m *MsgRemoveFromWhitelistAdmin) Reset()         { *m = MsgRemoveFromWhitel
 func (m *MsgRemoveFromWhitelistAdmin) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoveFromWhitelistAdmin) ProtoMessage()    {}
 func (*MsgRemoveFromWhitelistAdmin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{29}
+	return fileDescriptor_8293ea1b0f4b608c, []int{33}
 }
 func (m *MsgRemoveFromWhitelistAdmin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1759,7 +2174,7 @@ func (m *MsgRemoveFromWhitelistAdminResponse) Reset()         { *m = MsgRemoveFr
 func (m *MsgRemoveFromWhitelistAdminResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoveFromWhitelistAdminResponse) ProtoMessage()    {}
 func (*MsgRemoveFromWhitelistAdminResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{30}
+	return fileDescriptor_8293ea1b0f4b608c, []int{34}
 }
 func (m *MsgRemoveFromWhitelistAdminResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1788,21 +2203,24 @@ func (m *MsgRemoveFromWhitelistAdminResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRemoveFromWhitelistAdminResponse proto.InternalMessageInfo
 
-type MsgRewardDelegateStakeResponse struct {
+type MsgGrantRole struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Role    Role   `protobuf:"varint,3,opt,name=role,proto3,enum=emissions.v1.Role" json:"role,omitempty"`
 }
 
-func (m *MsgRewardDelegateStakeResponse) Reset()         { *m = MsgRewardDelegateStakeResponse{} }
-func (m *MsgRewardDelegateStakeResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRewardDelegateStakeResponse) ProtoMessage()    {}
-func (*MsgRewardDelegateStakeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{31}
+func (m *MsgGrantRole) Reset()         { *m = MsgGrantRole{} }
+func (m *MsgGrantRole) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantRole) ProtoMessage()    {}
+func (*MsgGrantRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{35}
 }
-func (m *MsgRewardDelegateStakeResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgGrantRole) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRewardDelegateStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgGrantRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRewardDelegateStakeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgGrantRole.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1812,36 +2230,54 @@ func (m *MsgRewardDelegateStakeResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *MsgRewardDelegateStakeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRewardDelegateStakeResponse.Merge(m, src)
+func (m *MsgGrantRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantRole.Merge(m, src)
 }
-func (m *MsgRewardDelegateStakeResponse) XXX_Size() int {
+func (m *MsgGrantRole) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRewardDelegateStakeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRewardDelegateStakeResponse.DiscardUnknown(m)
+func (m *MsgGrantRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantRole.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRewardDelegateStakeResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgGrantRole proto.InternalMessageInfo
 
-type MsgRewardDelegateStake struct {
-	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	TopicId uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	Reputer string `protobuf:"bytes,3,opt,name=reputer,proto3" json:"reputer,omitempty"`
+func (m *MsgGrantRole) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-func (m *MsgRewardDelegateStake) Reset()         { *m = MsgRewardDelegateStake{} }
-func (m *MsgRewardDelegateStake) String() string { return proto.CompactTextString(m) }
-func (*MsgRewardDelegateStake) ProtoMessage()    {}
-func (*MsgRewardDelegateStake) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8293ea1b0f4b608c, []int{32}
+func (m *MsgGrantRole) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
-func (m *MsgRewardDelegateStake) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgGrantRole) GetRole() Role {
+	if m != nil {
+		return m.Role
+	}
+	return Role_NO_ROLE
+}
+
+type MsgGrantRoleResponse struct {
+}
+
+func (m *MsgGrantRoleResponse) Reset()         { *m = MsgGrantRoleResponse{} }
+func (m *MsgGrantRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantRoleResponse) ProtoMessage()    {}
+func (*MsgGrantRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{36}
+}
+func (m *MsgGrantRoleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRewardDelegateStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgGrantRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRewardDelegateStake.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgGrantRoleResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1851,1880 +2287,1950 @@ func (m *MsgRewardDelegateStake) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *MsgRewardDelegateStake) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRewardDelegateStake.Merge(m, src)
+func (m *MsgGrantRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantRoleResponse.Merge(m, src)
 }
-func (m *MsgRewardDelegateStake) XXX_Size() int {
+func (m *MsgGrantRoleResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRewardDelegateStake) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRewardDelegateStake.DiscardUnknown(m)
+func (m *MsgGrantRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantRoleResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRewardDelegateStake proto.InternalMessageInfo
+var xxx_messageInfo_MsgGrantRoleResponse proto.InternalMessageInfo
 
-func (m *MsgRewardDelegateStake) GetSender() string {
-	if m != nil {
-		return m.Sender
-	}
-	return ""
+type MsgRevokeRole struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Role    Role   `protobuf:"varint,3,opt,name=role,proto3,enum=emissions.v1.Role" json:"role,omitempty"`
 }
 
-func (m *MsgRewardDelegateStake) GetTopicId() uint64 {
-	if m != nil {
-		return m.TopicId
+func (m *MsgRevokeRole) Reset()         { *m = MsgRevokeRole{} }
+func (m *MsgRevokeRole) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeRole) ProtoMessage()    {}
+func (*MsgRevokeRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{37}
+}
+func (m *MsgRevokeRole) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeRole.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *MsgRevokeRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeRole.Merge(m, src)
+}
+func (m *MsgRevokeRole) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeRole.DiscardUnknown(m)
 }
 
-func (m *MsgRewardDelegateStake) GetReputer() string {
+var xxx_messageInfo_MsgRevokeRole proto.InternalMessageInfo
+
+func (m *MsgRevokeRole) GetSender() string {
 	if m != nil {
-		return m.Reputer
+		return m.Sender
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*OptionalParams)(nil), "emissions.v1.OptionalParams")
-	proto.RegisterType((*MsgUpdateParams)(nil), "emissions.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "emissions.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgCreateNewTopic)(nil), "emissions.v1.MsgCreateNewTopic")
-	proto.RegisterType((*MsgCreateNewTopicResponse)(nil), "emissions.v1.MsgCreateNewTopicResponse")
-	proto.RegisterType((*MsgInsertBulkReputerPayload)(nil), "emissions.v1.MsgInsertBulkReputerPayload")
-	proto.RegisterType((*MsgInsertBulkReputerPayloadResponse)(nil), "emissions.v1.MsgInsertBulkReputerPayloadResponse")
-	proto.RegisterType((*MsgInsertBulkWorkerPayload)(nil), "emissions.v1.MsgInsertBulkWorkerPayload")
-	proto.RegisterType((*MsgInsertBulkWorkerPayloadResponse)(nil), "emissions.v1.MsgInsertBulkWorkerPayloadResponse")
-	proto.RegisterType((*MsgRegister)(nil), "emissions.v1.MsgRegister")
-	proto.RegisterType((*MsgRegisterResponse)(nil), "emissions.v1.MsgRegisterResponse")
-	proto.RegisterType((*MsgRemoveRegistration)(nil), "emissions.v1.MsgRemoveRegistration")
-	proto.RegisterType((*MsgRemoveRegistrationResponse)(nil), "emissions.v1.MsgRemoveRegistrationResponse")
-	proto.RegisterType((*MsgAddStake)(nil), "emissions.v1.MsgAddStake")
-	proto.RegisterType((*MsgAddStakeResponse)(nil), "emissions.v1.MsgAddStakeResponse")
-	proto.RegisterType((*MsgRemoveStake)(nil), "emissions.v1.MsgRemoveStake")
-	proto.RegisterType((*MsgRemoveStakeResponse)(nil), "emissions.v1.MsgRemoveStakeResponse")
-	proto.RegisterType((*MsgCancelRemoveStake)(nil), "emissions.v1.MsgCancelRemoveStake")
-	proto.RegisterType((*MsgCancelRemoveStakeResponse)(nil), "emissions.v1.MsgCancelRemoveStakeResponse")
-	proto.RegisterType((*MsgDelegateStake)(nil), "emissions.v1.MsgDelegateStake")
-	proto.RegisterType((*MsgDelegateStakeResponse)(nil), "emissions.v1.MsgDelegateStakeResponse")
-	proto.RegisterType((*MsgRemoveDelegateStake)(nil), "emissions.v1.MsgRemoveDelegateStake")
-	proto.RegisterType((*MsgRemoveDelegateStakeResponse)(nil), "emissions.v1.MsgRemoveDelegateStakeResponse")
-	proto.RegisterType((*MsgCancelRemoveDelegateStake)(nil), "emissions.v1.MsgCancelRemoveDelegateStake")
-	proto.RegisterType((*MsgCancelRemoveDelegateStakeResponse)(nil), "emissions.v1.MsgCancelRemoveDelegateStakeResponse")
-	proto.RegisterType((*MsgFundTopic)(nil), "emissions.v1.MsgFundTopic")
-	proto.RegisterType((*MsgFundTopicResponse)(nil), "emissions.v1.MsgFundTopicResponse")
-	proto.RegisterType((*MsgAddToWhitelistAdmin)(nil), "emissions.v1.MsgAddToWhitelistAdmin")
-	proto.RegisterType((*MsgAddToWhitelistAdminResponse)(nil), "emissions.v1.MsgAddToWhitelistAdminResponse")
-	proto.RegisterType((*MsgRemoveFromWhitelistAdmin)(nil), "emissions.v1.MsgRemoveFromWhitelistAdmin")
-	proto.RegisterType((*MsgRemoveFromWhitelistAdminResponse)(nil), "emissions.v1.MsgRemoveFromWhitelistAdminResponse")
-	proto.RegisterType((*MsgRewardDelegateStakeResponse)(nil), "emissions.v1.MsgRewardDelegateStakeResponse")
-	proto.RegisterType((*MsgRewardDelegateStake)(nil), "emissions.v1.MsgRewardDelegateStake")
+func (m *MsgRevokeRole) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("emissions/v1/tx.proto", fileDescriptor_8293ea1b0f4b608c) }
+func (m *MsgRevokeRole) GetRole() Role {
+	if m != nil {
+		return m.Role
+	}
+	return Role_NO_ROLE
+}
 
-var fileDescriptor_8293ea1b0f4b608c = []byte{
-	// 2478 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcb, 0x6f, 0x1c, 0x49,
-	0x19, 0xcf, 0xc4, 0xef, 0x6f, 0xc6, 0xaf, 0xf6, 0x23, 0xed, 0x89, 0x3d, 0x76, 0x6c, 0x67, 0x77,
-	0x1c, 0x76, 0xed, 0x8d, 0x41, 0x3c, 0x02, 0x07, 0x1c, 0x1c, 0xef, 0x5a, 0xc9, 0x18, 0x6f, 0xc7,
-	0x89, 0xa5, 0xb0, 0x52, 0x53, 0xee, 0xae, 0xe9, 0x69, 0xb9, 0xbb, 0xab, 0xa9, 0xea, 0x19, 0xdb,
-	0xcb, 0x05, 0x21, 0x71, 0xe1, 0xc4, 0x09, 0x10, 0x7f, 0x01, 0xc7, 0x3d, 0x20, 0x4e, 0x5c, 0x38,
-	0xb1, 0xc7, 0x15, 0x17, 0x10, 0x87, 0x15, 0x4a, 0x0e, 0xfb, 0x5f, 0x00, 0xaa, 0x47, 0xf7, 0x74,
-	0xcf, 0x2b, 0x56, 0x3a, 0x48, 0x7b, 0x89, 0xd2, 0xf5, 0x7d, 0xf5, 0xfb, 0xbe, 0xef, 0x57, 0xf5,
-	0x3d, 0xca, 0x1a, 0x58, 0xc0, 0xbe, 0xcb, 0x98, 0x4b, 0x02, 0xb6, 0xd3, 0xba, 0xbf, 0x13, 0x5d,
-	0x6e, 0x87, 0x94, 0x44, 0x44, 0x2b, 0x25, 0xcb, 0xdb, 0xad, 0xfb, 0xe5, 0x5b, 0x16, 0x61, 0x3e,
-	0x61, 0x3b, 0x3e, 0x73, 0xb8, 0x96, 0xcf, 0x1c, 0xa9, 0x56, 0x9e, 0x77, 0x88, 0x43, 0xc4, 0x7f,
-	0x77, 0xf8, 0xff, 0xd4, 0xea, 0x2c, 0xf2, 0xdd, 0x80, 0xec, 0x88, 0x7f, 0xd5, 0x92, 0x9e, 0x35,
-	0x73, 0x15, 0x62, 0xa6, 0x24, 0x4b, 0x12, 0xdb, 0x94, 0x28, 0xf2, 0xa3, 0xe7, 0xa6, 0x80, 0x04,
-	0x16, 0x56, 0x92, 0x72, 0x46, 0x42, 0x71, 0xd8, 0x8c, 0x30, 0x8d, 0x01, 0x33, 0xb2, 0x0b, 0x42,
-	0xcf, 0x63, 0xd1, 0xfa, 0x7f, 0xca, 0x30, 0xf5, 0xe3, 0x30, 0x72, 0x49, 0x80, 0xbc, 0x63, 0x44,
-	0x91, 0xcf, 0x34, 0x1d, 0xc6, 0x5a, 0x98, 0x72, 0x75, 0xbd, 0xb0, 0x36, 0x54, 0x9d, 0x30, 0xe2,
-	0x4f, 0xed, 0x7b, 0xb0, 0xe4, 0xa3, 0x4b, 0x93, 0x61, 0xea, 0x22, 0xcf, 0xfd, 0x14, 0xdb, 0xa6,
-	0xcf, 0x1c, 0xd3, 0xc3, 0x81, 0x13, 0x35, 0xf4, 0x9b, 0x6b, 0x43, 0xd5, 0x21, 0x63, 0xd1, 0x47,
-	0x97, 0x4f, 0x13, 0x79, 0x8d, 0x39, 0x4f, 0x84, 0x54, 0x43, 0x30, 0xe3, 0xbb, 0x81, 0x19, 0x91,
-	0xd0, 0xb5, 0xcc, 0x0b, 0xec, 0x3a, 0x8d, 0x48, 0x1f, 0xe2, 0xe8, 0x0f, 0xbf, 0xf3, 0xf9, 0x97,
-	0xab, 0x37, 0xfe, 0xf5, 0xe5, 0xea, 0x8e, 0xe3, 0x46, 0x8d, 0xe6, 0xd9, 0xb6, 0x45, 0xfc, 0x1d,
-	0xe4, 0x79, 0x84, 0xa2, 0xf7, 0x03, 0x1c, 0x71, 0x67, 0xe3, 0x4f, 0xab, 0x81, 0xdc, 0x60, 0xc7,
-	0x47, 0x51, 0x63, 0x7b, 0x1f, 0x5b, 0xc6, 0x94, 0xef, 0x06, 0x27, 0x1c, 0xef, 0x54, 0xc0, 0x69,
-	0x3b, 0x30, 0xcf, 0xbd, 0x13, 0x26, 0x98, 0x19, 0x62, 0x6a, 0x9e, 0x79, 0xc4, 0x3a, 0xd7, 0x87,
-	0xd7, 0x86, 0xaa, 0xc3, 0xc6, 0xac, 0x8f, 0x2e, 0x85, 0x36, 0x3b, 0xc6, 0xf4, 0x21, 0x17, 0x68,
-	0x75, 0x58, 0xa4, 0xf8, 0x67, 0x4d, 0x97, 0xf2, 0x40, 0xdc, 0xc0, 0xf5, 0x9b, 0xbe, 0xc9, 0x22,
-	0x74, 0x8e, 0xf5, 0x11, 0xe1, 0xd9, 0x07, 0xca, 0xb3, 0x05, 0x79, 0x04, 0xcc, 0x3e, 0xdf, 0x76,
-	0x89, 0xb4, 0x7f, 0x18, 0x44, 0x7f, 0xff, 0xd3, 0xfb, 0xa0, 0xce, 0xe6, 0x30, 0x88, 0xfe, 0xf8,
-	0xd5, 0x67, 0xf7, 0x0a, 0xc6, 0x7c, 0x8c, 0x57, 0x93, 0x70, 0x4f, 0x39, 0x1a, 0xa7, 0x8d, 0x62,
-	0x9f, 0xb4, 0xb0, 0x44, 0x37, 0x6d, 0xec, 0xa1, 0x2b, 0xf3, 0xc2, 0x0d, 0x6c, 0x72, 0xa1, 0x8f,
-	0x4a, 0xda, 0xa4, 0x82, 0xd0, 0xdf, 0xe7, 0xe2, 0x53, 0x21, 0xd5, 0xaa, 0x92, 0x36, 0x1c, 0x12,
-	0xab, 0x11, 0x13, 0x3d, 0x26, 0x76, 0xf0, 0xe8, 0x1f, 0xf1, 0x65, 0x45, 0xf0, 0x0b, 0x28, 0x9d,
-	0xe1, 0x08, 0x99, 0x38, 0x88, 0x28, 0x09, 0xaf, 0xf4, 0xf1, 0x7c, 0xe4, 0x16, 0x39, 0xd8, 0x23,
-	0x89, 0xa5, 0x7d, 0x02, 0x93, 0x1e, 0x46, 0x34, 0x70, 0x03, 0xc7, 0xa4, 0x28, 0xc2, 0xfa, 0x44,
-	0x3e, 0xf0, 0x52, 0x8c, 0x66, 0xa0, 0x08, 0x6b, 0x3e, 0xf0, 0x4b, 0x63, 0x3a, 0x14, 0xd9, 0x2e,
-	0x0e, 0x22, 0x33, 0x6a, 0x50, 0xcc, 0x1a, 0xc4, 0xb3, 0x75, 0xc8, 0x67, 0x86, 0x5f, 0x87, 0x0f,
-	0x15, 0xea, 0x49, 0x0c, 0xaa, 0x61, 0xd0, 0x38, 0xa5, 0xf2, 0x28, 0xea, 0x14, 0x59, 0xfc, 0xf2,
-	0xeb, 0xc5, 0x7c, 0xa6, 0xf8, 0x29, 0x89, 0xc3, 0x3b, 0x50, 0x80, 0xda, 0x23, 0x58, 0xe5, 0x51,
-	0x35, 0x83, 0x7a, 0xd3, 0xab, 0xbb, 0x9e, 0x87, 0x6d, 0x53, 0x26, 0x9e, 0xc9, 0xef, 0x08, 0x66,
-	0x11, 0xd3, 0x27, 0xc5, 0xc5, 0x5c, 0xf6, 0xd1, 0xe5, 0xb3, 0xb6, 0xd6, 0xa9, 0x50, 0x32, 0x94,
-	0x8e, 0xf6, 0x21, 0xac, 0x75, 0xc2, 0xa8, 0xdc, 0x6e, 0xe3, 0x4c, 0x09, 0x9c, 0x95, 0x2c, 0x8e,
-	0x21, 0xb5, 0x12, 0xa0, 0x4f, 0x61, 0x45, 0x26, 0x1f, 0xc5, 0x17, 0x88, 0xda, 0x2a, 0x7e, 0xd7,
-	0x0f, 0x09, 0x8d, 0x50, 0x60, 0x61, 0x7d, 0x3a, 0x1f, 0x03, 0x65, 0x81, 0x6e, 0x08, 0x70, 0xc1,
-	0xc4, 0x61, 0x02, 0xad, 0xfd, 0xaa, 0x00, 0x1b, 0x19, 0xe3, 0x75, 0x8c, 0x4d, 0x8a, 0x5b, 0x38,
-	0x68, 0x66, 0x5c, 0x98, 0xc9, 0xe7, 0xc2, 0x6a, 0xca, 0x85, 0x03, 0x8c, 0x0d, 0x69, 0x20, 0xe5,
-	0x07, 0x06, 0x2d, 0xe3, 0x06, 0xf2, 0xc2, 0x06, 0xd2, 0x67, 0x73, 0x1e, 0x7d, 0xca, 0xea, 0x1e,
-	0x07, 0xd4, 0x2c, 0x98, 0x8d, 0x10, 0x3b, 0xcf, 0x5a, 0xd1, 0xf2, 0x59, 0x99, 0xe6, 0x88, 0x69,
-	0x23, 0x9c, 0xd3, 0x16, 0xf2, 0x5c, 0x1b, 0x45, 0x84, 0x32, 0xb3, 0xc5, 0x4c, 0xb9, 0x91, 0x17,
-	0x3e, 0x8b, 0xa7, 0x91, 0xb4, 0xae, 0xcf, 0xe5, 0xe4, 0xb4, 0x6d, 0xe3, 0x39, 0xdb, 0x13, 0x2a,
-	0xc7, 0xd2, 0x80, 0x74, 0x46, 0xfb, 0x01, 0xdc, 0x16, 0x3d, 0x01, 0xf9, 0xa1, 0x87, 0x99, 0x19,
-	0x11, 0x93, 0x59, 0xc8, 0xc3, 0x26, 0xb3, 0x08, 0xc5, 0x4c, 0x9f, 0x17, 0x77, 0xf3, 0x16, 0xef,
-	0x0a, 0x52, 0xe3, 0x84, 0x3c, 0xe5, 0xf2, 0xa7, 0x42, 0xac, 0x3d, 0x80, 0xb2, 0xaa, 0xd9, 0xa6,
-	0x1b, 0xd4, 0x31, 0xc5, 0x54, 0x40, 0x28, 0xdf, 0x17, 0xc4, 0xe6, 0x45, 0x59, 0xb9, 0x0f, 0x95,
-	0xfc, 0x84, 0x28, 0xcb, 0x3f, 0x84, 0x95, 0x78, 0x6f, 0x9d, 0x50, 0x6c, 0x21, 0x16, 0x65, 0xb7,
-	0x2f, 0x8a, 0xed, 0x4b, 0x72, 0xfb, 0x41, 0x5b, 0x25, 0x41, 0x48, 0x59, 0x57, 0x49, 0x95, 0xde,
-	0x7e, 0x2b, 0x6d, 0x5d, 0xa5, 0x53, 0x7b, 0xef, 0x0b, 0x98, 0xb1, 0x28, 0x46, 0x11, 0x56, 0x3d,
-	0xad, 0x8e, 0xb1, 0xae, 0xbf, 0x61, 0xdb, 0x98, 0x92, 0x48, 0xa2, 0x3d, 0x1d, 0x60, 0xac, 0x7d,
-	0x1f, 0xca, 0x49, 0x35, 0xb4, 0x31, 0x13, 0xc7, 0xc9, 0x1d, 0x75, 0xb9, 0x07, 0xfa, 0x92, 0xa4,
-	0x34, 0xd6, 0xd8, 0x97, 0x0a, 0x35, 0x74, 0x79, 0xc8, 0xc5, 0xda, 0x63, 0xd8, 0xe0, 0xba, 0x14,
-	0x47, 0xd4, 0x95, 0x07, 0x22, 0x6b, 0x82, 0x29, 0xa6, 0x05, 0xa6, 0xaa, 0x90, 0x5e, 0x16, 0x5d,
-	0xa4, 0xe2, 0xa3, 0x4b, 0x43, 0x6a, 0x9e, 0x90, 0x03, 0xa1, 0x77, 0x24, 0xd4, 0x64, 0x19, 0xd2,
-	0x6a, 0xb0, 0x39, 0x10, 0x4c, 0xd1, 0xa6, 0xdf, 0x16, 0x68, 0xab, 0xfd, 0xd0, 0x14, 0x7b, 0xda,
-	0x4f, 0x60, 0x86, 0x62, 0xc7, 0x65, 0x11, 0x45, 0xbc, 0x48, 0x0a, 0xd2, 0x96, 0xdf, 0x90, 0xb4,
-	0xe9, 0x34, 0x12, 0x67, 0xed, 0x3d, 0xd0, 0x6c, 0x5c, 0x47, 0x4d, 0x2f, 0x32, 0x43, 0xe4, 0x60,
-	0xd3, 0x73, 0x7d, 0x37, 0xd2, 0x57, 0x04, 0x5b, 0x33, 0x4a, 0x72, 0x8c, 0x1c, 0xfc, 0x84, 0xaf,
-	0x6b, 0x9b, 0x30, 0xc5, 0x23, 0x4b, 0x69, 0x56, 0x84, 0x66, 0xc9, 0x47, 0x97, 0x6d, 0x2d, 0x7e,
-	0xc7, 0x3a, 0xfa, 0xaf, 0x49, 0xb1, 0x45, 0xa8, 0xad, 0x36, 0xad, 0x8a, 0xc0, 0x97, 0xb2, 0xcd,
-	0xd8, 0x10, 0x1a, 0x12, 0xa1, 0x0a, 0x33, 0x62, 0x0c, 0x91, 0x13, 0x89, 0x4f, 0x82, 0xa8, 0xa1,
-	0xaf, 0x09, 0x4b, 0x53, 0x72, 0xfd, 0x18, 0xd3, 0x1a, 0x5f, 0xe5, 0xd5, 0x29, 0x8c, 0x6b, 0x86,
-	0x4c, 0x06, 0x5e, 0x13, 0xef, 0xe4, 0xac, 0x4e, 0xa1, 0xbc, 0xaf, 0x87, 0x31, 0x20, 0xaf, 0x4e,
-	0x89, 0x99, 0x38, 0x6f, 0xf4, 0xf5, 0x9c, 0xd5, 0x49, 0x59, 0x89, 0x93, 0x8c, 0x8f, 0x7b, 0x89,
-	0x91, 0xf8, 0x8e, 0x6c, 0xe4, 0x1c, 0xf7, 0x94, 0x8d, 0xf8, 0x2e, 0x61, 0xd0, 0xac, 0x6e, 0xba,
-	0x36, 0x73, 0xd2, 0x65, 0xf5, 0xa0, 0xcb, 0xea, 0xa2, 0xeb, 0x6e, 0x4e, 0xba, 0xac, 0x0e, 0xba,
-	0x8e, 0x60, 0xd4, 0x32, 0x03, 0x42, 0x7d, 0xfd, 0x9d, 0x7c, 0xc8, 0x23, 0xd6, 0x11, 0xa1, 0xbe,
-	0x76, 0x01, 0xcb, 0x49, 0x55, 0x4a, 0x1a, 0xad, 0x8d, 0x2d, 0x74, 0x25, 0xe7, 0xb7, 0x77, 0xf3,
-	0x59, 0xd1, 0x23, 0x55, 0xa9, 0x54, 0x8b, 0xdd, 0xe7, 0xc8, 0x62, 0x96, 0xfb, 0x29, 0x4c, 0xe3,
-	0x90, 0xb9, 0x1e, 0x09, 0x92, 0x63, 0xaf, 0xe6, 0x3c, 0x76, 0x85, 0x17, 0x1f, 0x7b, 0x0b, 0x6e,
-	0x8b, 0x8c, 0xac, 0xd7, 0xb1, 0x15, 0xb9, 0xad, 0xb8, 0xfc, 0xaa, 0x20, 0xf5, 0xad, 0x9c, 0x91,
-	0xf1, 0x44, 0x8e, 0xa1, 0x4f, 0x64, 0x63, 0x17, 0xc0, 0xda, 0x73, 0xd8, 0x6a, 0x20, 0xaf, 0x2e,
-	0xea, 0x70, 0x48, 0x89, 0x85, 0x19, 0x53, 0x33, 0x94, 0x18, 0xdd, 0x91, 0xc7, 0x4c, 0x1c, 0xd8,
-	0xea, 0xc9, 0x71, 0x4f, 0x24, 0xf8, 0x06, 0xdf, 0x50, 0x43, 0x97, 0xc7, 0x52, 0x5d, 0x4c, 0x45,
-	0x86, 0x52, 0x7e, 0x14, 0xd8, 0xe2, 0x11, 0xb2, 0xee, 0xc1, 0x74, 0x8d, 0x39, 0xcf, 0x42, 0x1b,
-	0x45, 0x58, 0x3d, 0xc0, 0x16, 0x61, 0x94, 0xe1, 0xc0, 0xc6, 0x54, 0x2f, 0xac, 0x15, 0xaa, 0x13,
-	0x86, 0xfa, 0xd2, 0xbe, 0x05, 0xa3, 0xa1, 0xd0, 0xd0, 0x6f, 0xae, 0x15, 0xaa, 0xc5, 0xdd, 0xe5,
-	0xed, 0xf4, 0x93, 0x74, 0x3b, 0xfb, 0x8c, 0x33, 0x94, 0xee, 0x83, 0xe2, 0x2f, 0xbf, 0xfa, 0xec,
-	0x9e, 0x82, 0x58, 0x5f, 0x82, 0x5b, 0x1d, 0xd6, 0x0c, 0xcc, 0x42, 0x12, 0x30, 0xbc, 0xfe, 0xdf,
-	0x61, 0x98, 0xad, 0x31, 0xe7, 0x47, 0xa2, 0x15, 0x1d, 0xe1, 0x0b, 0x11, 0x3d, 0x7f, 0x0c, 0x8a,
-	0xe6, 0x44, 0x62, 0x67, 0xe2, 0x4f, 0xad, 0x0c, 0xe3, 0x3e, 0x8e, 0x90, 0x8d, 0x22, 0x24, 0xfc,
-	0x99, 0x30, 0x92, 0x6f, 0x6d, 0x05, 0xc0, 0x23, 0x8c, 0x99, 0x1e, 0x71, 0x5c, 0x4b, 0x1f, 0x12,
-	0xd2, 0x09, 0xbe, 0xf2, 0x84, 0x2f, 0x68, 0xab, 0x50, 0x14, 0x62, 0x1f, 0x47, 0x0d, 0x62, 0xeb,
-	0xc3, 0x42, 0x2e, 0x76, 0xd4, 0xc4, 0x8a, 0xf6, 0x2e, 0x4c, 0x27, 0x29, 0xad, 0x40, 0x46, 0x84,
-	0xd2, 0x54, 0xb2, 0x2c, 0x91, 0xb6, 0x60, 0xa6, 0xad, 0xa8, 0xe0, 0x46, 0x85, 0x66, 0x1b, 0x40,
-	0x61, 0xde, 0x81, 0x52, 0xc7, 0x33, 0xaa, 0x50, 0x1d, 0x32, 0x8a, 0x38, 0xf5, 0x86, 0xaa, 0xc2,
-	0x8c, 0x43, 0x49, 0x33, 0xb0, 0xcd, 0x88, 0x36, 0xa3, 0x86, 0xe9, 0x21, 0x47, 0x1f, 0x17, 0x6a,
-	0x53, 0x72, 0xfd, 0x84, 0x2f, 0x3f, 0x41, 0x0e, 0x8f, 0x20, 0xee, 0x35, 0x88, 0x3a, 0xfa, 0x84,
-	0x8c, 0x40, 0x2d, 0xed, 0x51, 0x87, 0x67, 0x74, 0x28, 0x33, 0x1a, 0xb8, 0x2c, 0x47, 0x46, 0x87,
-	0x22, 0xa3, 0x5f, 0x40, 0x49, 0xcc, 0x91, 0x26, 0xc5, 0x0e, 0xc5, 0x91, 0x5e, 0xcc, 0x87, 0x5a,
-	0x14, 0x60, 0x86, 0xc0, 0xd2, 0xee, 0xc2, 0x14, 0xd7, 0xba, 0x30, 0x03, 0xec, 0x20, 0x7e, 0xf1,
-	0xf5, 0xd2, 0x5a, 0xa1, 0x3a, 0x6e, 0x4c, 0x8a, 0xd5, 0x23, 0xb5, 0xa8, 0x7d, 0x0c, 0x63, 0x2a,
-	0x17, 0xf5, 0xc9, 0x7c, 0xd6, 0x63, 0x9c, 0x07, 0x25, 0x7e, 0x37, 0xe3, 0x1b, 0xb5, 0xfe, 0x6d,
-	0x58, 0xea, 0xba, 0x80, 0xf1, 0xf5, 0xd4, 0x96, 0x60, 0x5c, 0x66, 0xba, 0x6b, 0x8b, 0x9b, 0x38,
-	0x6c, 0x8c, 0x89, 0xef, 0x43, 0x7b, 0xfd, 0xd7, 0x37, 0xe1, 0x76, 0x8d, 0x39, 0x87, 0x01, 0xc3,
-	0x34, 0x7a, 0xd8, 0xf4, 0xce, 0x55, 0xad, 0x38, 0x46, 0x57, 0x1e, 0x41, 0x76, 0xdf, 0x7c, 0x7a,
-	0x06, 0x0b, 0x1d, 0x6f, 0x29, 0x39, 0xd6, 0xa8, 0xf4, 0xba, 0x93, 0x4d, 0xaf, 0xec, 0x83, 0x4a,
-	0xcc, 0x35, 0xc6, 0x1c, 0xed, 0x5e, 0xcc, 0x78, 0x3a, 0x94, 0xf1, 0x54, 0x3b, 0x69, 0x5b, 0x6c,
-	0x21, 0xaf, 0x89, 0xcd, 0xb3, 0x66, 0x60, 0x7b, 0x98, 0x89, 0xbf, 0x51, 0x14, 0x77, 0xd7, 0x7a,
-	0x5a, 0x7c, 0xce, 0x35, 0x1f, 0x0a, 0xc5, 0xc4, 0x60, 0x6a, 0xad, 0x23, 0xc3, 0xef, 0xc2, 0xc6,
-	0x00, 0x2e, 0x92, 0x6c, 0xff, 0x47, 0x01, 0xca, 0x19, 0x3d, 0x39, 0xf0, 0xbd, 0x8e, 0xb2, 0x2d,
-	0x18, 0x49, 0x53, 0x34, 0x97, 0x75, 0x58, 0x92, 0x22, 0x35, 0x06, 0xd1, 0x70, 0x04, 0x73, 0xea,
-	0x2d, 0xcc, 0xab, 0x45, 0x07, 0x09, 0x95, 0x2c, 0xa6, 0xf4, 0x6b, 0x1f, 0x45, 0x48, 0x51, 0x30,
-	0x7b, 0xd1, 0xb1, 0xd2, 0x41, 0xc0, 0x26, 0xac, 0xf7, 0x0f, 0x2c, 0x89, 0xff, 0x6f, 0x05, 0x28,
-	0xd6, 0x98, 0x63, 0x88, 0x19, 0x12, 0xd3, 0xbe, 0x01, 0x57, 0xa0, 0xe8, 0xb9, 0x67, 0x66, 0xb8,
-	0x1b, 0x9a, 0xe7, 0xf8, 0x4a, 0x15, 0xba, 0x09, 0xcf, 0x3d, 0x3b, 0xde, 0x0d, 0x1f, 0xe3, 0x2b,
-	0x6d, 0x03, 0x26, 0xfd, 0xa6, 0x17, 0xb9, 0x26, 0xb2, 0x6d, 0x8a, 0x19, 0x53, 0xc5, 0xae, 0x24,
-	0x16, 0xf7, 0xe4, 0x5a, 0x86, 0x8a, 0xe1, 0x2c, 0x15, 0xf3, 0x30, 0x42, 0x2e, 0x02, 0x4c, 0x55,
-	0x7d, 0x93, 0x1f, 0xbc, 0x7e, 0xba, 0xed, 0xe1, 0x7a, 0x54, 0x64, 0xe3, 0x84, 0x1b, 0x8f, 0xd1,
-	0xd9, 0x78, 0x0f, 0x61, 0x2e, 0x15, 0x48, 0x92, 0x2f, 0x3a, 0x8c, 0xb1, 0xa6, 0xc5, 0xfb, 0x8e,
-	0x88, 0x68, 0xdc, 0x88, 0x3f, 0xb9, 0xc4, 0xc7, 0x8c, 0x21, 0x07, 0xab, 0x70, 0xe2, 0xcf, 0xf5,
-	0x16, 0x2c, 0x08, 0x28, 0x9f, 0xb4, 0xb0, 0x91, 0x9a, 0xae, 0xfb, 0xb2, 0x93, 0x0e, 0xec, 0x66,
-	0x36, 0xb0, 0x6c, 0x08, 0x43, 0x03, 0x43, 0x78, 0x0a, 0x2b, 0x3d, 0xed, 0xe6, 0x0a, 0xe6, 0x77,
-	0xf2, 0x84, 0xf7, 0x6c, 0xf9, 0xe7, 0x88, 0x37, 0x89, 0xe1, 0x23, 0x18, 0x45, 0x3e, 0x69, 0x06,
-	0x91, 0x3c, 0xd5, 0x37, 0x78, 0xa4, 0xa8, 0xfd, 0xd9, 0x70, 0x17, 0xc4, 0x89, 0xc5, 0x8e, 0x25,
-	0x57, 0xf2, 0x0f, 0x05, 0x98, 0x4a, 0x68, 0xf8, 0xba, 0xf9, 0xac, 0xc3, 0x62, 0xd6, 0xb7, 0xc4,
-	0xed, 0xe7, 0x30, 0xcf, 0xab, 0x36, 0x0a, 0x2c, 0xec, 0xe5, 0xf3, 0x3d, 0x6b, 0xb1, 0x02, 0xcb,
-	0xbd, 0x70, 0x13, 0xbb, 0x7f, 0x2e, 0xc0, 0x4c, 0x8d, 0x39, 0xfb, 0xd8, 0xe3, 0x0d, 0xea, 0xcd,
-	0x09, 0xd3, 0x61, 0x2c, 0x7d, 0x4b, 0x27, 0x8c, 0xf8, 0x33, 0x45, 0xe5, 0xf0, 0xdb, 0xa4, 0xb2,
-	0x0c, 0x7a, 0xa7, 0xdf, 0x49, 0x50, 0x7f, 0x29, 0xa4, 0x78, 0xbe, 0x5e, 0x68, 0x29, 0xff, 0x6f,
-	0x66, 0xfd, 0x1f, 0x50, 0x81, 0xff, 0x4f, 0xa1, 0xad, 0x41, 0xa5, 0xb7, 0xf7, 0x49, 0x80, 0xbf,
-	0x2d, 0x74, 0x1d, 0x6b, 0xee, 0x13, 0x5c, 0x86, 0x09, 0x5b, 0x62, 0x90, 0xf8, 0x0c, 0xdb, 0x0b,
-	0x69, 0x7e, 0x86, 0x33, 0xfc, 0x64, 0x5d, 0x7f, 0x07, 0x36, 0x07, 0xf9, 0x95, 0x04, 0xf0, 0xfb,
-	0x02, 0x94, 0x6a, 0xcc, 0x39, 0xe0, 0xd3, 0xa0, 0x98, 0x90, 0xbf, 0x3e, 0x39, 0xba, 0x28, 0x32,
-	0x31, 0xf1, 0x2c, 0x71, 0xf9, 0x54, 0xdc, 0xa9, 0x3d, 0xdb, 0x3e, 0x21, 0xa7, 0x0d, 0x37, 0xc2,
-	0x9e, 0xcb, 0xa2, 0x3d, 0xdb, 0x77, 0x83, 0x41, 0x77, 0x2a, 0xee, 0x67, 0xea, 0x4e, 0xa9, 0xcf,
-	0x5e, 0xc7, 0xdd, 0x03, 0x38, 0x31, 0xfd, 0x89, 0x98, 0xcc, 0x24, 0x9f, 0x07, 0x94, 0xf8, 0x6f,
-	0xd7, 0xbe, 0x9c, 0x75, 0xfa, 0xa1, 0x27, 0x4e, 0xc4, 0xb7, 0x92, 0x3f, 0xb9, 0x7b, 0x1f, 0x2a,
-	0x55, 0x59, 0xd7, 0xa5, 0xf1, 0x56, 0x0b, 0x4a, 0xc6, 0xf9, 0xdd, 0xbf, 0x96, 0x60, 0xa8, 0xc6,
-	0x1c, 0xed, 0x04, 0x4a, 0x99, 0xd7, 0xdf, 0x4a, 0x76, 0xfe, 0xe9, 0x78, 0xae, 0x95, 0xef, 0x0e,
-	0x14, 0x27, 0x1d, 0xb3, 0x09, 0xb7, 0xfa, 0xcd, 0x76, 0xd5, 0x2e, 0x84, 0x3e, 0x9a, 0xe5, 0x0f,
-	0xae, 0xab, 0x99, 0x98, 0x7d, 0x01, 0x53, 0x1d, 0x0f, 0xc8, 0xd5, 0x2e, 0x8c, 0xac, 0x42, 0xf9,
-	0xdd, 0xd7, 0x28, 0x24, 0xd8, 0x1f, 0xc1, 0x78, 0x32, 0xae, 0x2d, 0x75, 0x6d, 0x8a, 0x45, 0xe5,
-	0x3b, 0x7d, 0x45, 0x09, 0x52, 0x1d, 0xb4, 0x1e, 0x43, 0xce, 0x46, 0x8f, 0x8d, 0x9d, 0x4a, 0xe5,
-	0x6f, 0x5c, 0x43, 0x29, 0xb1, 0x73, 0x09, 0x7a, 0xdf, 0x47, 0xc9, 0xd6, 0x00, 0x6e, 0xb3, 0xaa,
-	0xe5, 0xfb, 0xd7, 0x56, 0x4d, 0x73, 0x95, 0x0c, 0x3e, 0xdd, 0x5c, 0xc5, 0xa2, 0x1e, 0x5c, 0x75,
-	0x4e, 0x25, 0xda, 0xc7, 0x50, 0x4c, 0x77, 0xf5, 0xe5, 0x3e, 0xf1, 0x4b, 0xbc, 0xcd, 0x41, 0xd2,
-	0x04, 0xd2, 0x82, 0xd9, 0xee, 0x71, 0x61, 0xbd, 0xfb, 0x1a, 0x74, 0xea, 0x94, 0xef, 0xbd, 0x5e,
-	0x27, 0x31, 0x72, 0x0a, 0x93, 0xd9, 0x4c, 0xae, 0x74, 0x6d, 0xce, 0xc8, 0xcb, 0xef, 0x0c, 0x96,
-	0x27, 0xc0, 0x2e, 0xcc, 0xf5, 0x2a, 0x14, 0xbd, 0x42, 0xef, 0xd2, 0x2a, 0xbf, 0x77, 0x1d, 0xad,
-	0xac, 0xa9, 0xee, 0x16, 0xd9, 0x8f, 0xe5, 0xd7, 0x9b, 0xea, 0xdb, 0xd6, 0xb4, 0x9f, 0xc3, 0x52,
-	0xff, 0x9e, 0x3c, 0x98, 0xf7, 0xac, 0xd9, 0xdd, 0xeb, 0xeb, 0x26, 0xc6, 0x1f, 0xc3, 0x44, 0xbb,
-	0x9f, 0x96, 0xbb, 0x00, 0x12, 0x59, 0x79, 0xbd, 0xbf, 0x2c, 0x4d, 0x5a, 0xaf, 0x56, 0xb7, 0xd9,
-	0xeb, 0xaa, 0x77, 0x6a, 0xf5, 0x20, 0x6d, 0x40, 0x77, 0xe3, 0xf9, 0xdd, 0xb7, 0xb5, 0x6d, 0xf5,
-	0xa1, 0xbf, 0x5b, 0xb5, 0x47, 0x7e, 0xbf, 0xae, 0xa5, 0x95, 0x47, 0x7e, 0xc1, 0xc7, 0x80, 0x87,
-	0xc6, 0xe7, 0x2f, 0x2b, 0x85, 0x2f, 0x5e, 0x56, 0x0a, 0xff, 0x7e, 0x59, 0x29, 0xfc, 0xe6, 0x55,
-	0xe5, 0xc6, 0x17, 0xaf, 0x2a, 0x37, 0xfe, 0xf9, 0xaa, 0x72, 0xe3, 0xc5, 0x77, 0xaf, 0xf9, 0x37,
-	0x99, 0xcb, 0x9d, 0xf6, 0x6f, 0x43, 0xc4, 0x6f, 0x50, 0xce, 0x46, 0xc5, 0x0f, 0x43, 0xbe, 0xf9,
-	0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9e, 0xc2, 0xd9, 0x49, 0x07, 0x23, 0x00, 0x00,
+type MsgRevokeRoleResponse struct {
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgRevokeRoleResponse) Reset()         { *m = MsgRevokeRoleResponse{} }
+func (m *MsgRevokeRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeRoleResponse) ProtoMessage()    {}
+func (*MsgRevokeRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{38}
+}
+func (m *MsgRevokeRoleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeRoleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeRoleResponse.Merge(m, src)
+}
+func (m *MsgRevokeRoleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeRoleResponse.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgRevokeRoleResponse proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	InsertBulkWorkerPayload(ctx context.Context, in *MsgInsertBulkWorkerPayload, opts ...grpc.CallOption) (*MsgInsertBulkWorkerPayloadResponse, error)
-	CreateNewTopic(ctx context.Context, in *MsgCreateNewTopic, opts ...grpc.CallOption) (*MsgCreateNewTopicResponse, error)
-	Register(ctx context.Context, in *MsgRegister, opts ...grpc.CallOption) (*MsgRegisterResponse, error)
-	RemoveRegistration(ctx context.Context, in *MsgRemoveRegistration, opts ...grpc.CallOption) (*MsgRemoveRegistrationResponse, error)
-	InsertBulkReputerPayload(ctx context.Context, in *MsgInsertBulkReputerPayload, opts ...grpc.CallOption) (*MsgInsertBulkReputerPayloadResponse, error)
-	AddStake(ctx context.Context, in *MsgAddStake, opts ...grpc.CallOption) (*MsgAddStakeResponse, error)
-	RemoveStake(ctx context.Context, in *MsgRemoveStake, opts ...grpc.CallOption) (*MsgRemoveStakeResponse, error)
-	CancelRemoveStake(ctx context.Context, in *MsgCancelRemoveStake, opts ...grpc.CallOption) (*MsgCancelRemoveStakeResponse, error)
-	DelegateStake(ctx context.Context, in *MsgDelegateStake, opts ...grpc.CallOption) (*MsgDelegateStakeResponse, error)
-	RewardDelegateStake(ctx context.Context, in *MsgRewardDelegateStake, opts ...grpc.CallOption) (*MsgRewardDelegateStakeResponse, error)
-	RemoveDelegateStake(ctx context.Context, in *MsgRemoveDelegateStake, opts ...grpc.CallOption) (*MsgRemoveDelegateStakeResponse, error)
-	CancelRemoveDelegateStake(ctx context.Context, in *MsgCancelRemoveDelegateStake, opts ...grpc.CallOption) (*MsgCancelRemoveDelegateStakeResponse, error)
-	FundTopic(ctx context.Context, in *MsgFundTopic, opts ...grpc.CallOption) (*MsgFundTopicResponse, error)
-	AddToWhitelistAdmin(ctx context.Context, in *MsgAddToWhitelistAdmin, opts ...grpc.CallOption) (*MsgAddToWhitelistAdminResponse, error)
-	RemoveFromWhitelistAdmin(ctx context.Context, in *MsgRemoveFromWhitelistAdmin, opts ...grpc.CallOption) (*MsgRemoveFromWhitelistAdminResponse, error)
+type MsgUpdateTopicAllowlistMode struct {
+	Sender        string             `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId       uint64             `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	AllowlistMode TopicAllowlistMode `protobuf:"varint,3,opt,name=allowlist_mode,json=allowlistMode,proto3,enum=emissions.v1.TopicAllowlistMode" json:"allowlist_mode,omitempty"`
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgUpdateTopicAllowlistMode) Reset()         { *m = MsgUpdateTopicAllowlistMode{} }
+func (m *MsgUpdateTopicAllowlistMode) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTopicAllowlistMode) ProtoMessage()    {}
+func (*MsgUpdateTopicAllowlistMode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{39}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgUpdateTopicAllowlistMode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateTopicAllowlistMode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateTopicAllowlistMode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUpdateTopicAllowlistMode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTopicAllowlistMode.Merge(m, src)
+}
+func (m *MsgUpdateTopicAllowlistMode) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateTopicAllowlistMode) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTopicAllowlistMode.DiscardUnknown(m)
 }
 
-func (c *msgClient) InsertBulkWorkerPayload(ctx context.Context, in *MsgInsertBulkWorkerPayload, opts ...grpc.CallOption) (*MsgInsertBulkWorkerPayloadResponse, error) {
-	out := new(MsgInsertBulkWorkerPayloadResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/InsertBulkWorkerPayload", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateTopicAllowlistMode proto.InternalMessageInfo
+
+func (m *MsgUpdateTopicAllowlistMode) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CreateNewTopic(ctx context.Context, in *MsgCreateNewTopic, opts ...grpc.CallOption) (*MsgCreateNewTopicResponse, error) {
-	out := new(MsgCreateNewTopicResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/CreateNewTopic", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateTopicAllowlistMode) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) Register(ctx context.Context, in *MsgRegister, opts ...grpc.CallOption) (*MsgRegisterResponse, error) {
-	out := new(MsgRegisterResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/Register", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateTopicAllowlistMode) GetAllowlistMode() TopicAllowlistMode {
+	if m != nil {
+		return m.AllowlistMode
 	}
-	return out, nil
+	return TopicAllowlistMode_ALLOWLIST_MODE_OPEN
 }
 
-func (c *msgClient) RemoveRegistration(ctx context.Context, in *MsgRemoveRegistration, opts ...grpc.CallOption) (*MsgRemoveRegistrationResponse, error) {
-	out := new(MsgRemoveRegistrationResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/RemoveRegistration", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgUpdateTopicAllowlistModeResponse struct {
 }
 
-func (c *msgClient) InsertBulkReputerPayload(ctx context.Context, in *MsgInsertBulkReputerPayload, opts ...grpc.CallOption) (*MsgInsertBulkReputerPayloadResponse, error) {
-	out := new(MsgInsertBulkReputerPayloadResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/InsertBulkReputerPayload", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateTopicAllowlistModeResponse) Reset()         { *m = MsgUpdateTopicAllowlistModeResponse{} }
+func (m *MsgUpdateTopicAllowlistModeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTopicAllowlistModeResponse) ProtoMessage()    {}
+func (*MsgUpdateTopicAllowlistModeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{40}
+}
+func (m *MsgUpdateTopicAllowlistModeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateTopicAllowlistModeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateTopicAllowlistModeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUpdateTopicAllowlistModeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTopicAllowlistModeResponse.Merge(m, src)
+}
+func (m *MsgUpdateTopicAllowlistModeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateTopicAllowlistModeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTopicAllowlistModeResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) AddStake(ctx context.Context, in *MsgAddStake, opts ...grpc.CallOption) (*MsgAddStakeResponse, error) {
-	out := new(MsgAddStakeResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/AddStake", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateTopicAllowlistModeResponse proto.InternalMessageInfo
+
+type MsgAddToTopicAllowlist struct {
+	Sender    string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId   uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Address   string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	IsReputer bool   `protobuf:"varint,4,opt,name=is_reputer,json=isReputer,proto3" json:"is_reputer,omitempty"`
+}
+
+func (m *MsgAddToTopicAllowlist) Reset()         { *m = MsgAddToTopicAllowlist{} }
+func (m *MsgAddToTopicAllowlist) String() string { return proto.CompactTextString(m) }
+func (*MsgAddToTopicAllowlist) ProtoMessage()    {}
+func (*MsgAddToTopicAllowlist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{41}
+}
+func (m *MsgAddToTopicAllowlist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddToTopicAllowlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddToTopicAllowlist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgAddToTopicAllowlist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddToTopicAllowlist.Merge(m, src)
+}
+func (m *MsgAddToTopicAllowlist) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddToTopicAllowlist) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddToTopicAllowlist.DiscardUnknown(m)
 }
 
-func (c *msgClient) RemoveStake(ctx context.Context, in *MsgRemoveStake, opts ...grpc.CallOption) (*MsgRemoveStakeResponse, error) {
-	out := new(MsgRemoveStakeResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/RemoveStake", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAddToTopicAllowlist proto.InternalMessageInfo
+
+func (m *MsgAddToTopicAllowlist) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CancelRemoveStake(ctx context.Context, in *MsgCancelRemoveStake, opts ...grpc.CallOption) (*MsgCancelRemoveStakeResponse, error) {
-	out := new(MsgCancelRemoveStakeResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/CancelRemoveStake", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAddToTopicAllowlist) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) DelegateStake(ctx context.Context, in *MsgDelegateStake, opts ...grpc.CallOption) (*MsgDelegateStakeResponse, error) {
-	out := new(MsgDelegateStakeResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/DelegateStake", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAddToTopicAllowlist) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RewardDelegateStake(ctx context.Context, in *MsgRewardDelegateStake, opts ...grpc.CallOption) (*MsgRewardDelegateStakeResponse, error) {
-	out := new(MsgRewardDelegateStakeResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/RewardDelegateStake", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAddToTopicAllowlist) GetIsReputer() bool {
+	if m != nil {
+		return m.IsReputer
 	}
-	return out, nil
+	return false
 }
 
-func (c *msgClient) RemoveDelegateStake(ctx context.Context, in *MsgRemoveDelegateStake, opts ...grpc.CallOption) (*MsgRemoveDelegateStakeResponse, error) {
-	out := new(MsgRemoveDelegateStakeResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/RemoveDelegateStake", in, out, opts...)
-	if err != nil {
-		return nil, err
+type MsgAddToTopicAllowlistResponse struct {
+}
+
+func (m *MsgAddToTopicAllowlistResponse) Reset()         { *m = MsgAddToTopicAllowlistResponse{} }
+func (m *MsgAddToTopicAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddToTopicAllowlistResponse) ProtoMessage()    {}
+func (*MsgAddToTopicAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{42}
+}
+func (m *MsgAddToTopicAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddToTopicAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddToTopicAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgAddToTopicAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddToTopicAllowlistResponse.Merge(m, src)
+}
+func (m *MsgAddToTopicAllowlistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddToTopicAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddToTopicAllowlistResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) CancelRemoveDelegateStake(ctx context.Context, in *MsgCancelRemoveDelegateStake, opts ...grpc.CallOption) (*MsgCancelRemoveDelegateStakeResponse, error) {
-	out := new(MsgCancelRemoveDelegateStakeResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/CancelRemoveDelegateStake", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAddToTopicAllowlistResponse proto.InternalMessageInfo
+
+type MsgRemoveFromTopicAllowlist struct {
+	Sender    string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId   uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Address   string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	IsReputer bool   `protobuf:"varint,4,opt,name=is_reputer,json=isReputer,proto3" json:"is_reputer,omitempty"`
+}
+
+func (m *MsgRemoveFromTopicAllowlist) Reset()         { *m = MsgRemoveFromTopicAllowlist{} }
+func (m *MsgRemoveFromTopicAllowlist) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveFromTopicAllowlist) ProtoMessage()    {}
+func (*MsgRemoveFromTopicAllowlist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{43}
+}
+func (m *MsgRemoveFromTopicAllowlist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveFromTopicAllowlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveFromTopicAllowlist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRemoveFromTopicAllowlist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveFromTopicAllowlist.Merge(m, src)
+}
+func (m *MsgRemoveFromTopicAllowlist) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveFromTopicAllowlist) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveFromTopicAllowlist.DiscardUnknown(m)
 }
 
-func (c *msgClient) FundTopic(ctx context.Context, in *MsgFundTopic, opts ...grpc.CallOption) (*MsgFundTopicResponse, error) {
-	out := new(MsgFundTopicResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/FundTopic", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRemoveFromTopicAllowlist proto.InternalMessageInfo
+
+func (m *MsgRemoveFromTopicAllowlist) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) AddToWhitelistAdmin(ctx context.Context, in *MsgAddToWhitelistAdmin, opts ...grpc.CallOption) (*MsgAddToWhitelistAdminResponse, error) {
-	out := new(MsgAddToWhitelistAdminResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/AddToWhitelistAdmin", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveFromTopicAllowlist) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) RemoveFromWhitelistAdmin(ctx context.Context, in *MsgRemoveFromWhitelistAdmin, opts ...grpc.CallOption) (*MsgRemoveFromWhitelistAdminResponse, error) {
-	out := new(MsgRemoveFromWhitelistAdminResponse)
-	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/RemoveFromWhitelistAdmin", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveFromTopicAllowlist) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	InsertBulkWorkerPayload(context.Context, *MsgInsertBulkWorkerPayload) (*MsgInsertBulkWorkerPayloadResponse, error)
-	CreateNewTopic(context.Context, *MsgCreateNewTopic) (*MsgCreateNewTopicResponse, error)
-	Register(context.Context, *MsgRegister) (*MsgRegisterResponse, error)
-	RemoveRegistration(context.Context, *MsgRemoveRegistration) (*MsgRemoveRegistrationResponse, error)
-	InsertBulkReputerPayload(context.Context, *MsgInsertBulkReputerPayload) (*MsgInsertBulkReputerPayloadResponse, error)
-	AddStake(context.Context, *MsgAddStake) (*MsgAddStakeResponse, error)
-	RemoveStake(context.Context, *MsgRemoveStake) (*MsgRemoveStakeResponse, error)
-	CancelRemoveStake(context.Context, *MsgCancelRemoveStake) (*MsgCancelRemoveStakeResponse, error)
-	DelegateStake(context.Context, *MsgDelegateStake) (*MsgDelegateStakeResponse, error)
-	RewardDelegateStake(context.Context, *MsgRewardDelegateStake) (*MsgRewardDelegateStakeResponse, error)
-	RemoveDelegateStake(context.Context, *MsgRemoveDelegateStake) (*MsgRemoveDelegateStakeResponse, error)
-	CancelRemoveDelegateStake(context.Context, *MsgCancelRemoveDelegateStake) (*MsgCancelRemoveDelegateStakeResponse, error)
-	FundTopic(context.Context, *MsgFundTopic) (*MsgFundTopicResponse, error)
-	AddToWhitelistAdmin(context.Context, *MsgAddToWhitelistAdmin) (*MsgAddToWhitelistAdminResponse, error)
-	RemoveFromWhitelistAdmin(context.Context, *MsgRemoveFromWhitelistAdmin) (*MsgRemoveFromWhitelistAdminResponse, error)
+func (m *MsgRemoveFromTopicAllowlist) GetIsReputer() bool {
+	if m != nil {
+		return m.IsReputer
+	}
+	return false
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+type MsgRemoveFromTopicAllowlistResponse struct {
 }
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgRemoveFromTopicAllowlistResponse) Reset()         { *m = MsgRemoveFromTopicAllowlistResponse{} }
+func (m *MsgRemoveFromTopicAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveFromTopicAllowlistResponse) ProtoMessage()    {}
+func (*MsgRemoveFromTopicAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{44}
 }
-func (*UnimplementedMsgServer) InsertBulkWorkerPayload(ctx context.Context, req *MsgInsertBulkWorkerPayload) (*MsgInsertBulkWorkerPayloadResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InsertBulkWorkerPayload not implemented")
+func (m *MsgRemoveFromTopicAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CreateNewTopic(ctx context.Context, req *MsgCreateNewTopic) (*MsgCreateNewTopicResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateNewTopic not implemented")
+func (m *MsgRemoveFromTopicAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveFromTopicAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) Register(ctx context.Context, req *MsgRegister) (*MsgRegisterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+func (m *MsgRemoveFromTopicAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveFromTopicAllowlistResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) RemoveRegistration(ctx context.Context, req *MsgRemoveRegistration) (*MsgRemoveRegistrationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveRegistration not implemented")
+func (m *MsgRemoveFromTopicAllowlistResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) InsertBulkReputerPayload(ctx context.Context, req *MsgInsertBulkReputerPayload) (*MsgInsertBulkReputerPayloadResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InsertBulkReputerPayload not implemented")
+func (m *MsgRemoveFromTopicAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveFromTopicAllowlistResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) AddStake(ctx context.Context, req *MsgAddStake) (*MsgAddStakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddStake not implemented")
+
+var xxx_messageInfo_MsgRemoveFromTopicAllowlistResponse proto.InternalMessageInfo
+
+type MsgRewardDelegateStakeResponse struct {
 }
-func (*UnimplementedMsgServer) RemoveStake(ctx context.Context, req *MsgRemoveStake) (*MsgRemoveStakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveStake not implemented")
+
+func (m *MsgRewardDelegateStakeResponse) Reset()         { *m = MsgRewardDelegateStakeResponse{} }
+func (m *MsgRewardDelegateStakeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRewardDelegateStakeResponse) ProtoMessage()    {}
+func (*MsgRewardDelegateStakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{45}
 }
-func (*UnimplementedMsgServer) CancelRemoveStake(ctx context.Context, req *MsgCancelRemoveStake) (*MsgCancelRemoveStakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelRemoveStake not implemented")
+func (m *MsgRewardDelegateStakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) DelegateStake(ctx context.Context, req *MsgDelegateStake) (*MsgDelegateStakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegateStake not implemented")
+func (m *MsgRewardDelegateStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRewardDelegateStakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) RewardDelegateStake(ctx context.Context, req *MsgRewardDelegateStake) (*MsgRewardDelegateStakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RewardDelegateStake not implemented")
+func (m *MsgRewardDelegateStakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRewardDelegateStakeResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) RemoveDelegateStake(ctx context.Context, req *MsgRemoveDelegateStake) (*MsgRemoveDelegateStakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveDelegateStake not implemented")
+func (m *MsgRewardDelegateStakeResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) CancelRemoveDelegateStake(ctx context.Context, req *MsgCancelRemoveDelegateStake) (*MsgCancelRemoveDelegateStakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelRemoveDelegateStake not implemented")
+func (m *MsgRewardDelegateStakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRewardDelegateStakeResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) FundTopic(ctx context.Context, req *MsgFundTopic) (*MsgFundTopicResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FundTopic not implemented")
+
+var xxx_messageInfo_MsgRewardDelegateStakeResponse proto.InternalMessageInfo
+
+type MsgRewardDelegateStake struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Reputer string `protobuf:"bytes,3,opt,name=reputer,proto3" json:"reputer,omitempty"`
 }
-func (*UnimplementedMsgServer) AddToWhitelistAdmin(ctx context.Context, req *MsgAddToWhitelistAdmin) (*MsgAddToWhitelistAdminResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddToWhitelistAdmin not implemented")
+
+func (m *MsgRewardDelegateStake) Reset()         { *m = MsgRewardDelegateStake{} }
+func (m *MsgRewardDelegateStake) String() string { return proto.CompactTextString(m) }
+func (*MsgRewardDelegateStake) ProtoMessage()    {}
+func (*MsgRewardDelegateStake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{46}
 }
-func (*UnimplementedMsgServer) RemoveFromWhitelistAdmin(ctx context.Context, req *MsgRemoveFromWhitelistAdmin) (*MsgRemoveFromWhitelistAdminResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromWhitelistAdmin not implemented")
+func (m *MsgRewardDelegateStake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgRewardDelegateStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRewardDelegateStake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRewardDelegateStake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRewardDelegateStake.Merge(m, src)
+}
+func (m *MsgRewardDelegateStake) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRewardDelegateStake) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRewardDelegateStake.DiscardUnknown(m)
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+var xxx_messageInfo_MsgRewardDelegateStake proto.InternalMessageInfo
+
+func (m *MsgRewardDelegateStake) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_InsertBulkWorkerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgInsertBulkWorkerPayload)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).InsertBulkWorkerPayload(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Msg/InsertBulkWorkerPayload",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).InsertBulkWorkerPayload(ctx, req.(*MsgInsertBulkWorkerPayload))
+func (m *MsgRewardDelegateStake) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_CreateNewTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateNewTopic)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateNewTopic(ctx, in)
+func (m *MsgRewardDelegateStake) GetReputer() string {
+	if m != nil {
+		return m.Reputer
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Msg/CreateNewTopic",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateNewTopic(ctx, req.(*MsgCreateNewTopic))
-	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegister)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Register(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Msg/Register",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Register(ctx, req.(*MsgRegister))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgUpdateTopicInactivityThresholds struct {
+	Sender                     string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId                    uint64 `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	WorkerInactivityThreshold  uint64 `protobuf:"varint,3,opt,name=worker_inactivity_threshold,json=workerInactivityThreshold,proto3" json:"worker_inactivity_threshold,omitempty"`
+	ReputerInactivityThreshold uint64 `protobuf:"varint,4,opt,name=reputer_inactivity_threshold,json=reputerInactivityThreshold,proto3" json:"reputer_inactivity_threshold,omitempty"`
 }
 
-func _Msg_RemoveRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveRegistration)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveRegistration(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Msg/RemoveRegistration",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveRegistration(ctx, req.(*MsgRemoveRegistration))
+func (m *MsgUpdateTopicInactivityThresholds) Reset()         { *m = MsgUpdateTopicInactivityThresholds{} }
+func (m *MsgUpdateTopicInactivityThresholds) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTopicInactivityThresholds) ProtoMessage()    {}
+func (*MsgUpdateTopicInactivityThresholds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{47}
+}
+func (m *MsgUpdateTopicInactivityThresholds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateTopicInactivityThresholds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateTopicInactivityThresholds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgUpdateTopicInactivityThresholds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTopicInactivityThresholds.Merge(m, src)
+}
+func (m *MsgUpdateTopicInactivityThresholds) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateTopicInactivityThresholds) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTopicInactivityThresholds.DiscardUnknown(m)
 }
 
-func _Msg_InsertBulkReputerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgInsertBulkReputerPayload)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).InsertBulkReputerPayload(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Msg/InsertBulkReputerPayload",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).InsertBulkReputerPayload(ctx, req.(*MsgInsertBulkReputerPayload))
+var xxx_messageInfo_MsgUpdateTopicInactivityThresholds proto.InternalMessageInfo
+
+func (m *MsgUpdateTopicInactivityThresholds) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_AddStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddStake)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddStake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Msg/AddStake",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddStake(ctx, req.(*MsgAddStake))
+func (m *MsgUpdateTopicInactivityThresholds) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_RemoveStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveStake)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveStake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Msg/RemoveStake",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveStake(ctx, req.(*MsgRemoveStake))
+func (m *MsgUpdateTopicInactivityThresholds) GetWorkerInactivityThreshold() uint64 {
+	if m != nil {
+		return m.WorkerInactivityThreshold
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_CancelRemoveStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelRemoveStake)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelRemoveStake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Msg/CancelRemoveStake",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelRemoveStake(ctx, req.(*MsgCancelRemoveStake))
+func (m *MsgUpdateTopicInactivityThresholds) GetReputerInactivityThreshold() uint64 {
+	if m != nil {
+		return m.ReputerInactivityThreshold
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_DelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDelegateStake)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DelegateStake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Msg/DelegateStake",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DelegateStake(ctx, req.(*MsgDelegateStake))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgUpdateTopicInactivityThresholdsResponse struct {
 }
 
-func _Msg_RewardDelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRewardDelegateStake)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RewardDelegateStake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Msg/RewardDelegateStake",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RewardDelegateStake(ctx, req.(*MsgRewardDelegateStake))
+func (m *MsgUpdateTopicInactivityThresholdsResponse) Reset() {
+	*m = MsgUpdateTopicInactivityThresholdsResponse{}
+}
+func (m *MsgUpdateTopicInactivityThresholdsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgUpdateTopicInactivityThresholdsResponse) ProtoMessage() {}
+func (*MsgUpdateTopicInactivityThresholdsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{48}
+}
+func (m *MsgUpdateTopicInactivityThresholdsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateTopicInactivityThresholdsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateTopicInactivityThresholdsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgUpdateTopicInactivityThresholdsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTopicInactivityThresholdsResponse.Merge(m, src)
+}
+func (m *MsgUpdateTopicInactivityThresholdsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateTopicInactivityThresholdsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTopicInactivityThresholdsResponse.DiscardUnknown(m)
 }
 
-func _Msg_RemoveDelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveDelegateStake)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveDelegateStake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Msg/RemoveDelegateStake",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveDelegateStake(ctx, req.(*MsgRemoveDelegateStake))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgUpdateTopicInactivityThresholdsResponse proto.InternalMessageInfo
+
+type MsgUpdateTopicLossAggregator struct {
+	Sender                  string                                          `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TopicId                 uint64                                          `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	LossAggregator          LossAggregatorType                              `protobuf:"varint,3,opt,name=loss_aggregator,json=lossAggregator,proto3,enum=emissions.v1.LossAggregatorType" json:"loss_aggregator,omitempty"`
+	LossTrimFraction        github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,4,opt,name=loss_trim_fraction,json=lossTrimFraction,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"loss_trim_fraction"`
+	HuberLossTuningConstant github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,5,opt,name=huber_loss_tuning_constant,json=huberLossTuningConstant,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"huber_loss_tuning_constant"`
+	HuberLossMaxIterations  uint64                                          `protobuf:"varint,6,opt,name=huber_loss_max_iterations,json=huberLossMaxIterations,proto3" json:"huber_loss_max_iterations,omitempty"`
 }
 
-func _Msg_CancelRemoveDelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelRemoveDelegateStake)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelRemoveDelegateStake(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Msg/CancelRemoveDelegateStake",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelRemoveDelegateStake(ctx, req.(*MsgCancelRemoveDelegateStake))
+func (m *MsgUpdateTopicLossAggregator) Reset()         { *m = MsgUpdateTopicLossAggregator{} }
+func (m *MsgUpdateTopicLossAggregator) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTopicLossAggregator) ProtoMessage()    {}
+func (*MsgUpdateTopicLossAggregator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{49}
+}
+func (m *MsgUpdateTopicLossAggregator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateTopicLossAggregator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateTopicLossAggregator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgUpdateTopicLossAggregator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTopicLossAggregator.Merge(m, src)
+}
+func (m *MsgUpdateTopicLossAggregator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateTopicLossAggregator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTopicLossAggregator.DiscardUnknown(m)
 }
 
-func _Msg_FundTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgFundTopic)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).FundTopic(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Msg/FundTopic",
+var xxx_messageInfo_MsgUpdateTopicLossAggregator proto.InternalMessageInfo
+
+func (m *MsgUpdateTopicLossAggregator) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).FundTopic(ctx, req.(*MsgFundTopic))
+	return ""
+}
+
+func (m *MsgUpdateTopicLossAggregator) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_AddToWhitelistAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddToWhitelistAdmin)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgUpdateTopicLossAggregator) GetLossAggregator() LossAggregatorType {
+	if m != nil {
+		return m.LossAggregator
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddToWhitelistAdmin(ctx, in)
+	return LossAggregatorType_LOSS_AGGREGATOR_STAKE_WEIGHTED_MEAN
+}
+
+func (m *MsgUpdateTopicLossAggregator) GetHuberLossMaxIterations() uint64 {
+	if m != nil {
+		return m.HuberLossMaxIterations
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Msg/AddToWhitelistAdmin",
+	return 0
+}
+
+type MsgUpdateTopicLossAggregatorResponse struct {
+}
+
+func (m *MsgUpdateTopicLossAggregatorResponse) Reset()         { *m = MsgUpdateTopicLossAggregatorResponse{} }
+func (m *MsgUpdateTopicLossAggregatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTopicLossAggregatorResponse) ProtoMessage()    {}
+func (*MsgUpdateTopicLossAggregatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8293ea1b0f4b608c, []int{50}
+}
+func (m *MsgUpdateTopicLossAggregatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateTopicLossAggregatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateTopicLossAggregatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddToWhitelistAdmin(ctx, req.(*MsgAddToWhitelistAdmin))
+}
+func (m *MsgUpdateTopicLossAggregatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTopicLossAggregatorResponse.Merge(m, src)
+}
+func (m *MsgUpdateTopicLossAggregatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateTopicLossAggregatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTopicLossAggregatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateTopicLossAggregatorResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*OptionalParams)(nil), "emissions.v1.OptionalParams")
+	proto.RegisterType((*MsgUpdateParams)(nil), "emissions.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "emissions.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgCreateNewTopic)(nil), "emissions.v1.MsgCreateNewTopic")
+	proto.RegisterType((*MsgCreateNewTopicResponse)(nil), "emissions.v1.MsgCreateNewTopicResponse")
+	proto.RegisterType((*MsgInsertBulkReputerPayload)(nil), "emissions.v1.MsgInsertBulkReputerPayload")
+	proto.RegisterType((*MsgInsertBulkReputerPayloadResponse)(nil), "emissions.v1.MsgInsertBulkReputerPayloadResponse")
+	proto.RegisterType((*MsgInsertBulkWorkerPayload)(nil), "emissions.v1.MsgInsertBulkWorkerPayload")
+	proto.RegisterType((*MsgInsertBulkWorkerPayloadResponse)(nil), "emissions.v1.MsgInsertBulkWorkerPayloadResponse")
+	proto.RegisterType((*MsgRegister)(nil), "emissions.v1.MsgRegister")
+	proto.RegisterType((*MsgRegisterResponse)(nil), "emissions.v1.MsgRegisterResponse")
+	proto.RegisterType((*MsgRemoveRegistration)(nil), "emissions.v1.MsgRemoveRegistration")
+	proto.RegisterType((*MsgRemoveRegistrationResponse)(nil), "emissions.v1.MsgRemoveRegistrationResponse")
+	proto.RegisterType((*MsgRegisterMulti)(nil), "emissions.v1.MsgRegisterMulti")
+	proto.RegisterType((*MsgRegisterMultiResponse)(nil), "emissions.v1.MsgRegisterMultiResponse")
+	proto.RegisterType((*MsgUpdateNodeInfo)(nil), "emissions.v1.MsgUpdateNodeInfo")
+	proto.RegisterType((*MsgUpdateNodeInfoResponse)(nil), "emissions.v1.MsgUpdateNodeInfoResponse")
+	proto.RegisterType((*MsgAddStake)(nil), "emissions.v1.MsgAddStake")
+	proto.RegisterType((*MsgAddStakeResponse)(nil), "emissions.v1.MsgAddStakeResponse")
+	proto.RegisterType((*MsgRemoveStake)(nil), "emissions.v1.MsgRemoveStake")
+	proto.RegisterType((*MsgRemoveStakeResponse)(nil), "emissions.v1.MsgRemoveStakeResponse")
+	proto.RegisterType((*MsgCancelRemoveStake)(nil), "emissions.v1.MsgCancelRemoveStake")
+	proto.RegisterType((*MsgCancelRemoveStakeResponse)(nil), "emissions.v1.MsgCancelRemoveStakeResponse")
+	proto.RegisterType((*MsgDelegateStake)(nil), "emissions.v1.MsgDelegateStake")
+	proto.RegisterType((*MsgDelegateStakeResponse)(nil), "emissions.v1.MsgDelegateStakeResponse")
+	proto.RegisterType((*MsgRemoveDelegateStake)(nil), "emissions.v1.MsgRemoveDelegateStake")
+	proto.RegisterType((*MsgRemoveDelegateStakeResponse)(nil), "emissions.v1.MsgRemoveDelegateStakeResponse")
+	proto.RegisterType((*MsgCancelRemoveDelegateStake)(nil), "emissions.v1.MsgCancelRemoveDelegateStake")
+	proto.RegisterType((*MsgCancelRemoveDelegateStakeResponse)(nil), "emissions.v1.MsgCancelRemoveDelegateStakeResponse")
+	proto.RegisterType((*MsgFundTopic)(nil), "emissions.v1.MsgFundTopic")
+	proto.RegisterType((*MsgFundTopicResponse)(nil), "emissions.v1.MsgFundTopicResponse")
+	proto.RegisterType((*MsgAddToWhitelistAdmin)(nil), "emissions.v1.MsgAddToWhitelistAdmin")
+	proto.RegisterType((*MsgAddToWhitelistAdminResponse)(nil), "emissions.v1.MsgAddToWhitelistAdminResponse")
+	proto.RegisterType((*MsgRemoveFromWhitelistAdmin)(nil), "emissions.v1.MsgRemoveFromWhitelistAdmin")
+	proto.RegisterType((*MsgRemoveFromWhitelistAdminResponse)(nil), "emissions.v1.MsgRemoveFromWhitelistAdminResponse")
+	proto.RegisterType((*MsgGrantRole)(nil), "emissions.v1.MsgGrantRole")
+	proto.RegisterType((*MsgGrantRoleResponse)(nil), "emissions.v1.MsgGrantRoleResponse")
+	proto.RegisterType((*MsgRevokeRole)(nil), "emissions.v1.MsgRevokeRole")
+	proto.RegisterType((*MsgRevokeRoleResponse)(nil), "emissions.v1.MsgRevokeRoleResponse")
+	proto.RegisterType((*MsgUpdateTopicAllowlistMode)(nil), "emissions.v1.MsgUpdateTopicAllowlistMode")
+	proto.RegisterType((*MsgUpdateTopicAllowlistModeResponse)(nil), "emissions.v1.MsgUpdateTopicAllowlistModeResponse")
+	proto.RegisterType((*MsgAddToTopicAllowlist)(nil), "emissions.v1.MsgAddToTopicAllowlist")
+	proto.RegisterType((*MsgAddToTopicAllowlistResponse)(nil), "emissions.v1.MsgAddToTopicAllowlistResponse")
+	proto.RegisterType((*MsgRemoveFromTopicAllowlist)(nil), "emissions.v1.MsgRemoveFromTopicAllowlist")
+	proto.RegisterType((*MsgRemoveFromTopicAllowlistResponse)(nil), "emissions.v1.MsgRemoveFromTopicAllowlistResponse")
+	proto.RegisterType((*MsgRewardDelegateStakeResponse)(nil), "emissions.v1.MsgRewardDelegateStakeResponse")
+	proto.RegisterType((*MsgRewardDelegateStake)(nil), "emissions.v1.MsgRewardDelegateStake")
+	proto.RegisterType((*MsgUpdateTopicInactivityThresholds)(nil), "emissions.v1.MsgUpdateTopicInactivityThresholds")
+	proto.RegisterType((*MsgUpdateTopicInactivityThresholdsResponse)(nil), "emissions.v1.MsgUpdateTopicInactivityThresholdsResponse")
+	proto.RegisterType((*MsgUpdateTopicLossAggregator)(nil), "emissions.v1.MsgUpdateTopicLossAggregator")
+	proto.RegisterType((*MsgUpdateTopicLossAggregatorResponse)(nil), "emissions.v1.MsgUpdateTopicLossAggregatorResponse")
+}
+
+func init() { proto.RegisterFile("emissions/v1/tx.proto", fileDescriptor_8293ea1b0f4b608c) }
+
+var fileDescriptor_8293ea1b0f4b608c = []byte{
+	// 3465 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0xcb, 0x6f, 0x1c, 0xc7,
+	0x99, 0xd7, 0x88, 0x0f, 0x91, 0x1f, 0xdf, 0xcd, 0x57, 0xb3, 0x49, 0x91, 0x23, 0x92, 0x92, 0x28,
+	0x59, 0x26, 0x25, 0xda, 0xf0, 0x7a, 0xbd, 0x0b, 0xc3, 0x94, 0xa8, 0x07, 0x61, 0x0d, 0x4d, 0x8f,
+	0xc6, 0x12, 0xa0, 0x35, 0xd0, 0x5b, 0xec, 0x2e, 0xf6, 0x34, 0xd8, 0xdd, 0x35, 0x5b, 0xd5, 0xc3,
+	0x87, 0x17, 0x58, 0x2c, 0x0c, 0xe4, 0x12, 0x20, 0x40, 0x72, 0x49, 0x82, 0xfc, 0x01, 0x41, 0x8e,
+	0x46, 0x10, 0xe4, 0x94, 0x4b, 0x4e, 0xf1, 0xd1, 0xc8, 0x25, 0x41, 0x0e, 0x46, 0x60, 0x03, 0xf1,
+	0xbf, 0x90, 0x5b, 0x82, 0x7a, 0x74, 0x4d, 0xf7, 0xbc, 0x48, 0xb3, 0x6d, 0xc7, 0x17, 0x81, 0x5d,
+	0xdf, 0xaf, 0x7e, 0xdf, 0xa3, 0xaa, 0xbe, 0xaf, 0xea, 0x1b, 0x08, 0xa6, 0x71, 0xe8, 0x33, 0xe6,
+	0x93, 0x88, 0x6d, 0x1c, 0xdd, 0xdb, 0x88, 0x4f, 0xd6, 0x6b, 0x94, 0xc4, 0xc4, 0x18, 0xd6, 0xc3,
+	0xeb, 0x47, 0xf7, 0xac, 0x59, 0x87, 0xb0, 0x90, 0xb0, 0x8d, 0x90, 0x79, 0x1c, 0x15, 0x32, 0x4f,
+	0xc2, 0xac, 0x29, 0x8f, 0x78, 0x44, 0xfc, 0xb9, 0xc1, 0xff, 0x52, 0xa3, 0x13, 0x28, 0xf4, 0x23,
+	0xb2, 0x21, 0xfe, 0x55, 0x43, 0x66, 0x56, 0xcd, 0x69, 0x0d, 0x33, 0x25, 0x99, 0x93, 0xdc, 0xb6,
+	0x64, 0x91, 0x1f, 0x6d, 0x27, 0x45, 0x24, 0x72, 0xb0, 0x92, 0x58, 0x19, 0x09, 0xc5, 0xb5, 0x7a,
+	0x8c, 0x69, 0x42, 0x98, 0x91, 0x1d, 0x13, 0x7a, 0x88, 0x69, 0x5b, 0xc2, 0x98, 0xd4, 0x7c, 0x47,
+	0x4a, 0x96, 0x3f, 0xbe, 0x06, 0xa3, 0xef, 0xd5, 0x62, 0x9f, 0x44, 0x28, 0xd8, 0x43, 0x14, 0x85,
+	0xcc, 0x30, 0xe1, 0xca, 0x11, 0xa6, 0x1c, 0x6d, 0x16, 0x8a, 0x3d, 0x6b, 0x83, 0xe5, 0xe4, 0xd3,
+	0xf8, 0x77, 0x98, 0x0b, 0xd1, 0x89, 0xcd, 0x30, 0xf5, 0x51, 0xe0, 0x7f, 0x84, 0x5d, 0x3b, 0x64,
+	0x9e, 0x1d, 0xe0, 0xc8, 0x8b, 0xab, 0xe6, 0xe5, 0x62, 0xcf, 0x5a, 0x4f, 0x79, 0x26, 0x44, 0x27,
+	0xcf, 0xb4, 0xbc, 0xc4, 0xbc, 0xa7, 0x42, 0x6a, 0x20, 0x18, 0x0f, 0xfd, 0xc8, 0x16, 0xaa, 0xed,
+	0x63, 0xec, 0x7b, 0xd5, 0xd8, 0xec, 0xe1, 0xec, 0xf7, 0xff, 0xed, 0xd3, 0xcf, 0x97, 0x2e, 0xfd,
+	0xe5, 0xf3, 0xa5, 0x0d, 0xcf, 0x8f, 0xab, 0xf5, 0xfd, 0x75, 0x87, 0x84, 0x1b, 0x28, 0x08, 0x08,
+	0x45, 0xaf, 0x46, 0x38, 0xe6, 0x6e, 0x24, 0x9f, 0x4e, 0x15, 0xf9, 0xd1, 0x46, 0x88, 0xe2, 0xea,
+	0xfa, 0x36, 0x76, 0xca, 0xa3, 0xa1, 0x1f, 0x55, 0x38, 0xdf, 0x0b, 0x41, 0x67, 0x6c, 0xc0, 0x14,
+	0xb7, 0x4e, 0xa8, 0x60, 0x76, 0x0d, 0x53, 0x7b, 0x3f, 0x20, 0xce, 0xa1, 0xd9, 0x5b, 0xec, 0x59,
+	0xeb, 0x2d, 0x4f, 0x84, 0xe8, 0x44, 0xa0, 0xd9, 0x1e, 0xa6, 0xf7, 0xb9, 0xc0, 0x38, 0x80, 0x19,
+	0x8a, 0xff, 0xa7, 0xee, 0x53, 0xee, 0x88, 0x1f, 0xf9, 0x61, 0x3d, 0xb4, 0x59, 0x8c, 0x0e, 0xb1,
+	0xd9, 0x27, 0x2c, 0xbb, 0xab, 0x2c, 0x9b, 0x96, 0x8b, 0xc3, 0xdc, 0xc3, 0x75, 0x9f, 0x48, 0xfd,
+	0x3b, 0x51, 0xfc, 0xc7, 0xdf, 0xbc, 0x0a, 0x6a, 0xd5, 0x76, 0xa2, 0xf8, 0x57, 0x5f, 0x7d, 0x72,
+	0xbb, 0x50, 0x9e, 0x4a, 0xf8, 0x4a, 0x92, 0xee, 0x19, 0x67, 0xe3, 0x61, 0xa3, 0x38, 0x24, 0x47,
+	0x58, 0xb2, 0xdb, 0x2e, 0x0e, 0xd0, 0xa9, 0x7d, 0xec, 0x47, 0x2e, 0x39, 0x36, 0xfb, 0x65, 0xd8,
+	0x24, 0x40, 0xe0, 0xb7, 0xb9, 0xf8, 0x85, 0x90, 0x1a, 0x6b, 0x32, 0x6c, 0xb8, 0x46, 0x9c, 0x6a,
+	0x12, 0xe8, 0x2b, 0x62, 0x06, 0xf7, 0xfe, 0x21, 0x1f, 0x56, 0x01, 0x7e, 0x09, 0xc3, 0xfb, 0x38,
+	0x46, 0x36, 0x8e, 0x62, 0x4a, 0x6a, 0xa7, 0xe6, 0x40, 0xbe, 0xe0, 0x0e, 0x71, 0xb2, 0x87, 0x92,
+	0xcb, 0xf8, 0x10, 0x46, 0x02, 0x8c, 0x68, 0xe4, 0x47, 0x9e, 0x4d, 0x51, 0x8c, 0xcd, 0xc1, 0x7c,
+	0xe4, 0xc3, 0x09, 0x5b, 0x19, 0xc5, 0xd8, 0x08, 0x81, 0x6f, 0x1a, 0xdb, 0xa3, 0xc8, 0xf5, 0x71,
+	0x14, 0xdb, 0x71, 0x95, 0x62, 0x56, 0x25, 0x81, 0x6b, 0x42, 0x3e, 0x35, 0x7c, 0x3b, 0x3c, 0x56,
+	0xac, 0x95, 0x84, 0xd4, 0xc0, 0x60, 0xf0, 0x90, 0xca, 0xa5, 0x38, 0xa0, 0xc8, 0xe1, 0x9b, 0xdf,
+	0x1c, 0xca, 0xa7, 0x8a, 0xaf, 0x92, 0x58, 0xbc, 0x47, 0x8a, 0xd0, 0x78, 0x08, 0x4b, 0xdc, 0xab,
+	0x7a, 0x74, 0x50, 0x0f, 0x0e, 0xfc, 0x20, 0xc0, 0xae, 0x2d, 0x8f, 0xa4, 0xcd, 0xf7, 0x08, 0x66,
+	0x31, 0x33, 0x47, 0xc4, 0xc6, 0x5c, 0x08, 0xd1, 0xc9, 0x07, 0x0d, 0xd4, 0x0b, 0x01, 0x2a, 0x2b,
+	0x8c, 0xf1, 0x18, 0x8a, 0xcd, 0x34, 0xea, 0xd4, 0x37, 0x78, 0x46, 0x05, 0xcf, 0xd5, 0x2c, 0x4f,
+	0x59, 0xa2, 0x34, 0xd1, 0x47, 0x70, 0x55, 0x1e, 0x3e, 0x8a, 0x8f, 0x11, 0x75, 0x95, 0xff, 0x7e,
+	0x58, 0x23, 0x34, 0x46, 0x91, 0x83, 0xcd, 0xb1, 0x7c, 0x11, 0xb0, 0x04, 0x7b, 0x59, 0x90, 0x8b,
+	0x48, 0xec, 0x68, 0x6a, 0xe3, 0x07, 0x05, 0x58, 0xc9, 0x28, 0x3f, 0xc0, 0xd8, 0xa6, 0xf8, 0x08,
+	0x47, 0xf5, 0x8c, 0x09, 0xe3, 0xf9, 0x4c, 0x58, 0x4a, 0x99, 0xf0, 0x08, 0xe3, 0xb2, 0x54, 0x90,
+	0xb2, 0x03, 0x83, 0x91, 0x31, 0x03, 0x05, 0xb5, 0x2a, 0x32, 0x27, 0x72, 0x2e, 0x7d, 0x4a, 0xeb,
+	0x16, 0x27, 0x34, 0x1c, 0x98, 0x88, 0x11, 0x3b, 0xcc, 0x6a, 0x31, 0xf2, 0x69, 0x19, 0xe3, 0x8c,
+	0x69, 0x25, 0x3c, 0xa6, 0x47, 0x28, 0xf0, 0x5d, 0x14, 0x13, 0xca, 0xec, 0x23, 0x66, 0xcb, 0x89,
+	0x3c, 0xf1, 0x39, 0xfc, 0x18, 0x49, 0xed, 0xe6, 0x64, 0xce, 0x98, 0x36, 0x74, 0x3c, 0x67, 0x5b,
+	0x02, 0xb2, 0x27, 0x15, 0x48, 0x63, 0x8c, 0xff, 0x84, 0x79, 0x51, 0x13, 0x50, 0x58, 0x0b, 0x30,
+	0xb3, 0x63, 0x62, 0x33, 0x07, 0x05, 0xd8, 0x66, 0x0e, 0xa1, 0x98, 0x99, 0x53, 0x62, 0x6f, 0xce,
+	0xf2, 0xaa, 0x20, 0x11, 0x15, 0xf2, 0x8c, 0xcb, 0x9f, 0x09, 0xb1, 0xf1, 0x16, 0x58, 0x2a, 0x67,
+	0xdb, 0x7e, 0x74, 0x80, 0x29, 0xa6, 0x82, 0x42, 0xd9, 0x3e, 0x2d, 0x26, 0xcf, 0xc8, 0xcc, 0xbd,
+	0xa3, 0xe4, 0x15, 0xa2, 0x34, 0xbf, 0x03, 0x57, 0x93, 0xb9, 0x07, 0x84, 0x62, 0x07, 0xb1, 0x38,
+	0x3b, 0x7d, 0x46, 0x4c, 0x9f, 0x93, 0xd3, 0x1f, 0x35, 0x20, 0x9a, 0x21, 0xa5, 0x5d, 0x1d, 0xaa,
+	0xf4, 0xf4, 0xd9, 0xb4, 0x76, 0x75, 0x9c, 0x1a, 0x73, 0x5f, 0xc2, 0xb8, 0x43, 0x31, 0x8a, 0xb1,
+	0xaa, 0x69, 0x07, 0x18, 0x9b, 0xe6, 0x05, 0xcb, 0xc6, 0xa8, 0x64, 0x12, 0xe5, 0xe9, 0x11, 0xc6,
+	0xc6, 0x7f, 0x80, 0xa5, 0xb3, 0xa1, 0x8b, 0x99, 0x58, 0x4e, 0x6e, 0xa8, 0xcf, 0x2d, 0x30, 0xe7,
+	0x64, 0x48, 0x13, 0xc4, 0xb6, 0x04, 0x94, 0xd0, 0xc9, 0x0e, 0x17, 0x1b, 0xef, 0xc2, 0x0a, 0xc7,
+	0x52, 0x1c, 0x53, 0x5f, 0x2e, 0x88, 0xcc, 0x09, 0xb6, 0xb8, 0x47, 0x30, 0x95, 0x85, 0x4c, 0x4b,
+	0x54, 0x91, 0xc5, 0x10, 0x9d, 0x94, 0x25, 0xb2, 0x42, 0x1e, 0x09, 0xdc, 0xae, 0x80, 0xc9, 0x34,
+	0x64, 0x94, 0x60, 0xb5, 0x2b, 0x99, 0x0a, 0x9b, 0x39, 0x2f, 0xd8, 0x96, 0x3a, 0xb1, 0xa9, 0xe8,
+	0x19, 0xff, 0x05, 0xe3, 0x14, 0x7b, 0x3e, 0x8b, 0x29, 0xe2, 0x49, 0x52, 0x04, 0x6d, 0xe1, 0x82,
+	0x41, 0x1b, 0x4b, 0x33, 0xf1, 0xa8, 0xdd, 0x01, 0xc3, 0xc5, 0x07, 0xa8, 0x1e, 0xc4, 0x76, 0x0d,
+	0x79, 0xd8, 0x0e, 0xfc, 0xd0, 0x8f, 0xcd, 0xab, 0x22, 0x5a, 0xe3, 0x4a, 0xb2, 0x87, 0x3c, 0xfc,
+	0x94, 0x8f, 0x1b, 0xab, 0x30, 0xca, 0x3d, 0x4b, 0x21, 0x17, 0x05, 0x72, 0x38, 0x44, 0x27, 0x0d,
+	0x14, 0xdf, 0x63, 0x4d, 0xf5, 0xd7, 0xa6, 0xd8, 0x21, 0xd4, 0x55, 0x93, 0x96, 0x84, 0xe3, 0x73,
+	0xd9, 0x62, 0x5c, 0x16, 0x08, 0xc9, 0xb0, 0x06, 0xe3, 0xe2, 0x1a, 0x22, 0x6f, 0x24, 0x21, 0x89,
+	0xe2, 0xaa, 0x59, 0x14, 0x9a, 0x46, 0xe5, 0xf8, 0x1e, 0xa6, 0x25, 0x3e, 0xca, 0xb3, 0x53, 0x2d,
+	0xc9, 0x19, 0xf2, 0x30, 0xf0, 0x9c, 0x78, 0x2d, 0x67, 0x76, 0xaa, 0xc9, 0xfd, 0xba, 0x93, 0x10,
+	0xf2, 0xec, 0xa4, 0xd5, 0x24, 0xe7, 0xc6, 0x5c, 0xce, 0x99, 0x9d, 0x94, 0x96, 0xe4, 0x90, 0xf1,
+	0xeb, 0x9e, 0x56, 0x92, 0xec, 0x91, 0x95, 0x9c, 0xd7, 0x3d, 0xa5, 0x23, 0xd9, 0x4b, 0x18, 0x0c,
+	0xa7, 0x35, 0x5c, 0xab, 0x39, 0xc3, 0xe5, 0xb4, 0x09, 0x97, 0xd3, 0x12, 0xae, 0xeb, 0x39, 0xc3,
+	0xe5, 0x34, 0x85, 0x6b, 0x17, 0xfa, 0x1d, 0x3b, 0x22, 0x34, 0x34, 0x6f, 0xe4, 0x63, 0xee, 0x73,
+	0x76, 0x09, 0x0d, 0x8d, 0x63, 0x58, 0xd0, 0x59, 0x49, 0x17, 0x5a, 0x17, 0x3b, 0xe8, 0x54, 0xde,
+	0xdf, 0x6e, 0xe6, 0xd3, 0x62, 0xc6, 0x2a, 0x53, 0xa9, 0x12, 0xbb, 0xcd, 0x99, 0xc5, 0x5d, 0xee,
+	0xbf, 0x61, 0x0c, 0xd7, 0x98, 0x1f, 0x90, 0x48, 0x2f, 0xfb, 0x5a, 0xce, 0x65, 0x57, 0x7c, 0xc9,
+	0xb2, 0x1f, 0xc1, 0xbc, 0x38, 0x91, 0x07, 0x07, 0xd8, 0x89, 0xfd, 0xa3, 0x24, 0xfd, 0x2a, 0x27,
+	0xcd, 0x5b, 0x39, 0x3d, 0xe3, 0x07, 0x39, 0xa1, 0xae, 0xc8, 0xc2, 0x2e, 0x88, 0x8d, 0xe7, 0x70,
+	0xab, 0x8a, 0x82, 0x03, 0x91, 0x87, 0x6b, 0x94, 0x38, 0x98, 0x31, 0x75, 0x87, 0x12, 0x57, 0x77,
+	0x14, 0x30, 0x1b, 0x47, 0xae, 0x7a, 0x72, 0xdc, 0x16, 0x07, 0x7c, 0x85, 0x4f, 0x28, 0xa1, 0x93,
+	0x3d, 0x09, 0x17, 0xb7, 0xa2, 0xb2, 0x02, 0x3f, 0x8c, 0x5c, 0xf9, 0x08, 0x79, 0x1b, 0xe6, 0x6b,
+	0x98, 0xaa, 0xe7, 0x19, 0x76, 0x95, 0x3b, 0xa2, 0x20, 0xf0, 0x7b, 0xe9, 0x2b, 0xc5, 0x9e, 0xb5,
+	0x81, 0xf2, 0x5c, 0x1a, 0x22, 0xcc, 0x7a, 0xa0, 0x00, 0xc6, 0xff, 0xc1, 0x52, 0x58, 0x0f, 0x62,
+	0xdf, 0x6e, 0x4e, 0xac, 0xb6, 0xeb, 0x33, 0x87, 0xd4, 0xa3, 0xd8, 0xbc, 0x93, 0x2f, 0x26, 0x0b,
+	0x82, 0xbf, 0x9c, 0xcd, 0xb6, 0xdb, 0x8a, 0x9c, 0xd7, 0x2a, 0xfd, 0xea, 0xb2, 0x49, 0x3d, 0xae,
+	0xd5, 0x63, 0xdb, 0xf5, 0x43, 0x1c, 0x89, 0x07, 0xe4, 0xab, 0xba, 0xfc, 0x0b, 0xab, 0xdf, 0x13,
+	0xf2, 0xed, 0x44, 0xcc, 0x53, 0x76, 0x63, 0x72, 0x95, 0x50, 0xff, 0x23, 0x12, 0x31, 0x73, 0x5d,
+	0xa6, 0xec, 0x64, 0xd2, 0x13, 0x35, 0x6e, 0xbc, 0x01, 0xb3, 0xc8, 0x89, 0x09, 0xb5, 0xab, 0x3e,
+	0x8b, 0x09, 0x3d, 0xe5, 0x65, 0x09, 0x47, 0x22, 0x4c, 0x1b, 0x22, 0x0d, 0x4f, 0x0b, 0xf1, 0x13,
+	0x29, 0x2d, 0x27, 0x42, 0x63, 0x17, 0x56, 0x9b, 0x53, 0xb0, 0xed, 0xa0, 0xc0, 0xdf, 0x57, 0xc1,
+	0xc2, 0x11, 0xda, 0x0f, 0xb0, 0x6b, 0xde, 0x15, 0xb1, 0x2e, 0x66, 0xd3, 0xf2, 0x83, 0x06, 0xf0,
+	0xa1, 0xc4, 0x89, 0x87, 0xa6, 0x1f, 0xd9, 0x2d, 0x69, 0xfd, 0x9e, 0x7a, 0x68, 0xfa, 0xd1, 0xfd,
+	0x6c, 0x66, 0x57, 0x2f, 0xd3, 0x96, 0x09, 0x9b, 0xfa, 0x65, 0xda, 0x34, 0xa1, 0x0e, 0xf3, 0xed,
+	0x26, 0xd8, 0x4e, 0x15, 0x45, 0x1e, 0x36, 0x5f, 0xcb, 0xb7, 0xa0, 0xb3, 0x2d, 0x0a, 0x1f, 0x08,
+	0x5e, 0x7e, 0xb6, 0x84, 0x4a, 0x3b, 0xf6, 0x43, 0x6c, 0xb3, 0x90, 0x90, 0xb8, 0xca, 0xdf, 0x7c,
+	0x2e, 0xf6, 0x28, 0xc6, 0xe6, 0xeb, 0x39, 0xcf, 0x96, 0xe0, 0xae, 0xf8, 0x21, 0x7e, 0x96, 0x30,
+	0x6f, 0x0b, 0xe2, 0xe5, 0x00, 0xc6, 0x4a, 0xcc, 0xfb, 0xa0, 0xe6, 0xa2, 0x18, 0xab, 0x26, 0xc4,
+	0x0c, 0xf4, 0x33, 0x1c, 0xb9, 0x98, 0x9a, 0x85, 0x62, 0x61, 0x6d, 0xb0, 0xac, 0xbe, 0x8c, 0xd7,
+	0xa1, 0xbf, 0x26, 0x10, 0xe6, 0xe5, 0x62, 0x61, 0x6d, 0x68, 0x73, 0x61, 0x3d, 0xdd, 0xb0, 0x59,
+	0xcf, 0xb6, 0x32, 0xca, 0x0a, 0xfb, 0xd6, 0xd0, 0xc7, 0x5f, 0x7d, 0x72, 0x5b, 0x51, 0x2c, 0xcf,
+	0xc1, 0x6c, 0x93, 0xb6, 0x32, 0x66, 0x35, 0x12, 0x31, 0xbc, 0xfc, 0x8b, 0x11, 0x98, 0x28, 0x31,
+	0x4f, 0x1c, 0x2e, 0xbc, 0x8b, 0x8f, 0xc5, 0xfe, 0xe3, 0x0d, 0x11, 0x71, 0x1e, 0x49, 0x62, 0x4c,
+	0xf2, 0x69, 0x58, 0x30, 0x10, 0xe2, 0x18, 0xb9, 0x28, 0x46, 0xc2, 0x9e, 0xc1, 0xb2, 0xfe, 0x36,
+	0xae, 0x02, 0x04, 0x84, 0x31, 0x3b, 0x20, 0x9e, 0xef, 0x98, 0x3d, 0x42, 0x3a, 0xc8, 0x47, 0x9e,
+	0xf2, 0x01, 0x63, 0x09, 0x86, 0x84, 0x38, 0xc4, 0x71, 0x95, 0xb8, 0x66, 0xaf, 0x90, 0x8b, 0x19,
+	0x25, 0x31, 0x62, 0xdc, 0x84, 0x31, 0x5d, 0xd6, 0x14, 0x49, 0x9f, 0x00, 0x8d, 0xea, 0x61, 0xc9,
+	0x74, 0x0b, 0xc6, 0x1b, 0x40, 0x45, 0xd7, 0x2f, 0x90, 0x0d, 0x02, 0xc5, 0x79, 0x0d, 0x86, 0x9b,
+	0x5a, 0x09, 0x85, 0xb5, 0x9e, 0xf2, 0x10, 0x4e, 0xf5, 0x11, 0xd6, 0x60, 0xdc, 0xa3, 0xa4, 0x1e,
+	0xb9, 0x76, 0x4c, 0xeb, 0x71, 0xd5, 0x0e, 0x90, 0x67, 0x0e, 0x08, 0xd8, 0xa8, 0x1c, 0xaf, 0xf0,
+	0xe1, 0xa7, 0xc8, 0xe3, 0x1e, 0x24, 0xf7, 0x2d, 0x44, 0x3d, 0x73, 0x50, 0x7a, 0xa0, 0x86, 0xb6,
+	0xa8, 0xc7, 0xab, 0x5a, 0x4d, 0x56, 0x35, 0x28, 0x16, 0xf2, 0xec, 0x9c, 0xbe, 0x9a, 0xa8, 0x6a,
+	0x2f, 0x61, 0x58, 0xbc, 0xa5, 0x78, 0xaa, 0xa3, 0x38, 0x36, 0x87, 0xf2, 0xb1, 0x0e, 0x09, 0xb2,
+	0xb2, 0xe0, 0x32, 0xae, 0xc3, 0x28, 0x47, 0x1d, 0xdb, 0x11, 0xf6, 0x10, 0x4f, 0xfe, 0xe6, 0x70,
+	0xb1, 0xb0, 0x36, 0x50, 0x1e, 0x11, 0xa3, 0xbb, 0x6a, 0xd0, 0x78, 0x1f, 0xae, 0xa8, 0x7a, 0x64,
+	0x8e, 0xe4, 0xd3, 0x9e, 0xf0, 0x18, 0x8f, 0x95, 0xe6, 0xc0, 0x67, 0xb1, 0x1d, 0x12, 0x17, 0x9b,
+	0xa3, 0xc5, 0xc2, 0xda, 0xe8, 0x66, 0x31, 0xbb, 0xb3, 0xc5, 0x56, 0xdc, 0x4a, 0x80, 0x25, 0xe2,
+	0x62, 0x65, 0x5b, 0xf2, 0xc9, 0x2b, 0x89, 0xea, 0x30, 0xf8, 0x11, 0xe2, 0x05, 0xcc, 0x8f, 0x4f,
+	0x53, 0xcd, 0x94, 0xb1, 0x62, 0x81, 0xbf, 0x86, 0x24, 0x64, 0x47, 0x23, 0x1a, 0x8d, 0x91, 0x77,
+	0x60, 0x21, 0x69, 0x2d, 0xb4, 0x25, 0x18, 0x17, 0x04, 0x96, 0xc2, 0xb4, 0x63, 0x78, 0x03, 0x06,
+	0x1c, 0x12, 0xee, 0xfb, 0x11, 0xa6, 0xe6, 0x84, 0x70, 0xc2, 0xca, 0x3a, 0xf1, 0x40, 0x49, 0x2b,
+	0xa7, 0x35, 0x5c, 0xd6, 0x58, 0xbe, 0x83, 0x5b, 0x2a, 0x87, 0x21, 0xb4, 0x8d, 0x91, 0xa6, 0x8a,
+	0xf1, 0x36, 0x0c, 0x29, 0x28, 0xef, 0xa5, 0x9a, 0x93, 0x42, 0xcb, 0xd5, 0x36, 0xa1, 0x92, 0xa5,
+	0x46, 0x28, 0x02, 0xa2, 0xff, 0x36, 0x2a, 0x30, 0xe5, 0xf2, 0x3a, 0xe6, 0xef, 0xd7, 0x45, 0xee,
+	0xaf, 0x11, 0x12, 0xf8, 0x91, 0x67, 0x4e, 0x09, 0xa2, 0x6b, 0x59, 0xa2, 0xed, 0x14, 0x72, 0x4f,
+	0x02, 0xcb, 0x93, 0x6e, 0xeb, 0x20, 0xcf, 0x03, 0xba, 0x7a, 0x4d, 0x8b, 0x52, 0xa4, 0xbf, 0xf9,
+	0x95, 0x48, 0xfd, 0xad, 0xfa, 0x9e, 0x4c, 0x3c, 0x4c, 0xf3, 0x5c, 0x89, 0x14, 0x9f, 0xec, 0x7b,
+	0x32, 0x63, 0x07, 0xc6, 0x44, 0x2a, 0x41, 0x9e, 0x47, 0xf9, 0x46, 0x25, 0xd4, 0x9c, 0x6d, 0xb7,
+	0x85, 0x9e, 0x12, 0xc6, 0xb6, 0x34, 0x46, 0x84, 0x66, 0x34, 0xc8, 0x8c, 0x19, 0x31, 0x58, 0x31,
+	0xf5, 0xc3, 0x90, 0x77, 0x44, 0x31, 0x8a, 0x6c, 0xfe, 0xd1, 0x68, 0x92, 0x99, 0xf9, 0xb6, 0xfc,
+	0xac, 0xa2, 0x2e, 0x61, 0x14, 0x55, 0xa8, 0x1f, 0xea, 0x5e, 0x19, 0x06, 0x43, 0x38, 0x90, 0xd5,
+	0x36, 0x97, 0x4f, 0xdb, 0x38, 0xa7, 0xcc, 0xa8, 0x89, 0xc1, 0xaa, 0xd6, 0xf7, 0x31, 0xb5, 0xa5,
+	0xb2, 0xba, 0xe8, 0x67, 0x3a, 0x24, 0x62, 0x31, 0x8a, 0x62, 0xd3, 0xca, 0xe9, 0x9c, 0xa0, 0xe6,
+	0x21, 0xae, 0x08, 0xe2, 0x07, 0x8a, 0x97, 0x77, 0x7f, 0x53, 0x5a, 0x93, 0x67, 0xbc, 0xb8, 0x50,
+	0x30, 0x73, 0x5e, 0xec, 0xf2, 0x19, 0x3d, 0x57, 0xbd, 0xe2, 0xa5, 0xf4, 0xad, 0x61, 0x5e, 0xb6,
+	0x92, 0x62, 0xb3, 0xfc, 0x06, 0xcc, 0xb5, 0xd4, 0xa6, 0xa4, 0x72, 0x19, 0x73, 0x30, 0x20, 0x6f,
+	0x51, 0xbe, 0x2b, 0x8a, 0x54, 0x6f, 0xf9, 0x8a, 0xf8, 0xde, 0x71, 0x97, 0x7f, 0x78, 0x19, 0xe6,
+	0x4b, 0xcc, 0xdb, 0x89, 0x18, 0xa6, 0xf1, 0xfd, 0x7a, 0x70, 0xa8, 0xae, 0xd2, 0x7b, 0xe8, 0x34,
+	0x20, 0xc8, 0xed, 0x58, 0x6a, 0x3f, 0x80, 0xe9, 0xa6, 0x56, 0xa3, 0x7c, 0xf5, 0xab, 0xca, 0xdb,
+	0x74, 0x56, 0xb2, 0xfd, 0x46, 0xf1, 0xec, 0x2f, 0x4f, 0xd2, 0xd6, 0xc1, 0x8c, 0xa5, 0x3d, 0x19,
+	0x4b, 0x8d, 0x4a, 0x43, 0xe3, 0x11, 0x0a, 0xea, 0xd8, 0xde, 0xaf, 0x47, 0x6e, 0x80, 0x99, 0x68,
+	0xe1, 0x0f, 0x6d, 0x16, 0xdb, 0x6a, 0x7c, 0xce, 0x91, 0xf7, 0x05, 0x50, 0x2b, 0x4c, 0x8d, 0x35,
+	0x15, 0xff, 0xeb, 0xb0, 0xd2, 0x25, 0x16, 0xfa, 0x22, 0xf0, 0xa7, 0x02, 0x58, 0x19, 0x9c, 0xec,
+	0x87, 0x9c, 0x15, 0xb2, 0x5b, 0xd0, 0x97, 0x0e, 0xd1, 0x64, 0xd6, 0x60, 0x19, 0x94, 0xbe, 0xe8,
+	0xac, 0x30, 0xec, 0xc2, 0xa4, 0x4a, 0xe4, 0xfc, 0x22, 0xd1, 0x14, 0x84, 0xc5, 0x2c, 0xa7, 0xb4,
+	0x6b, 0x1b, 0xc5, 0x48, 0x85, 0x60, 0xe2, 0xb8, 0x69, 0xa4, 0x29, 0x00, 0xab, 0xb0, 0xdc, 0xd9,
+	0x31, 0xed, 0xff, 0x1f, 0x0a, 0x30, 0x54, 0x62, 0x9e, 0xbc, 0xf4, 0x63, 0xda, 0xd1, 0xe1, 0x45,
+	0x18, 0x0a, 0xfc, 0x7d, 0xbb, 0xb6, 0x59, 0xb3, 0x0f, 0xf1, 0xa9, 0xba, 0x03, 0x0d, 0x06, 0xfe,
+	0xfe, 0xde, 0x66, 0xed, 0x5d, 0x7c, 0x6a, 0xac, 0xc0, 0x88, 0x7c, 0x9d, 0x20, 0xd7, 0xa5, 0x98,
+	0x31, 0x75, 0x0f, 0x1a, 0x16, 0x83, 0x5b, 0x72, 0x2c, 0x13, 0x8a, 0xde, 0x6c, 0x28, 0xa6, 0xa0,
+	0x8f, 0x1c, 0xf3, 0x72, 0x22, 0xaf, 0x3e, 0xf2, 0x83, 0x5f, 0xad, 0xfc, 0x46, 0xef, 0xa9, 0x5f,
+	0x14, 0xea, 0x41, 0x3f, 0xe9, 0x32, 0x65, 0xfd, 0xdd, 0x81, 0xc9, 0x94, 0x23, 0xfa, 0xbc, 0x98,
+	0x70, 0x85, 0xd5, 0x1d, 0x87, 0x9b, 0x54, 0x10, 0xf3, 0x93, 0x4f, 0x2e, 0x09, 0x31, 0x63, 0xc8,
+	0xc3, 0xca, 0x9d, 0xe4, 0x73, 0xf9, 0x08, 0xa6, 0x05, 0x15, 0xff, 0xa5, 0x26, 0xfd, 0x1c, 0xea,
+	0x18, 0x9d, 0xb4, 0x63, 0x97, 0xb3, 0x8e, 0x65, 0x5d, 0xe8, 0xe9, 0xea, 0xc2, 0x33, 0xb8, 0xda,
+	0x56, 0x6f, 0x2e, 0x67, 0xfe, 0x5e, 0x80, 0xf1, 0x54, 0x60, 0x4a, 0x7c, 0x41, 0xbe, 0xdd, 0x65,
+	0x9e, 0x87, 0xc1, 0x24, 0x1a, 0x4c, 0xfd, 0x28, 0x37, 0xa0, 0xc2, 0xc1, 0xf8, 0xb5, 0x53, 0xfd,
+	0x46, 0xd6, 0xc0, 0xf4, 0xc9, 0x36, 0x99, 0x1c, 0xaf, 0x24, 0x48, 0xbd, 0x25, 0xfa, 0x3b, 0x6f,
+	0x89, 0x2b, 0x5d, 0xe3, 0xb9, 0x0b, 0x66, 0xb3, 0xe7, 0xb9, 0x42, 0xf9, 0xeb, 0x02, 0x4c, 0xe8,
+	0x17, 0xc5, 0x2e, 0x71, 0xf1, 0x4e, 0x74, 0x40, 0xbe, 0xdd, 0x58, 0xea, 0x20, 0xf4, 0x76, 0x0e,
+	0x42, 0x5f, 0xd7, 0x20, 0xbc, 0x07, 0x73, 0x2d, 0x36, 0xe7, 0x8a, 0xc2, 0xcf, 0x64, 0xca, 0xd8,
+	0x72, 0xe5, 0xcf, 0x3f, 0x17, 0x39, 0x14, 0x4f, 0xa0, 0x1f, 0x85, 0xa2, 0x65, 0x21, 0x7c, 0xbe,
+	0x40, 0x53, 0x58, 0xcd, 0xcf, 0xba, 0x3a, 0x0d, 0x93, 0x29, 0xc3, 0x1a, 0x8f, 0xbd, 0x02, 0x8c,
+	0xea, 0x73, 0xf5, 0x7d, 0xb3, 0xd9, 0x84, 0x99, 0xac, 0x6d, 0xda, 0xec, 0xe7, 0x30, 0xc5, 0xaf,
+	0x01, 0xfc, 0x07, 0xad, 0x20, 0x9f, 0xed, 0x59, 0x8d, 0x8b, 0xb0, 0xd0, 0x8e, 0x57, 0xeb, 0xfd,
+	0xad, 0x4c, 0x18, 0xdb, 0x38, 0xe0, 0x77, 0xc5, 0x8b, 0x07, 0xcc, 0x84, 0x2b, 0xe9, 0xb4, 0x37,
+	0x58, 0x4e, 0x3e, 0x53, 0xa1, 0xec, 0xfd, 0x26, 0x43, 0x69, 0x81, 0xd9, 0x6c, 0xb7, 0x76, 0xea,
+	0x77, 0x85, 0x54, 0x9c, 0xcf, 0xe7, 0x5a, 0xca, 0xfe, 0xcb, 0x59, 0xfb, 0xbb, 0x94, 0xf4, 0x6f,
+	0xc9, 0xb5, 0x22, 0x2c, 0xb6, 0xb7, 0x5e, 0x3b, 0xf8, 0xd3, 0x42, 0xcb, 0xb2, 0xe6, 0x5e, 0xc1,
+	0x05, 0x18, 0x74, 0x25, 0x07, 0x49, 0xd6, 0xb0, 0x31, 0x90, 0x8e, 0x4f, 0x6f, 0x26, 0x3e, 0x59,
+	0xd3, 0x6f, 0xc0, 0x6a, 0x37, 0xbb, 0xb4, 0x03, 0x3f, 0x2f, 0xc0, 0x70, 0x89, 0x79, 0x8f, 0x78,
+	0xe7, 0x41, 0x74, 0x63, 0xbe, 0x3f, 0x67, 0x74, 0x06, 0xa6, 0xd2, 0x96, 0x69, 0x93, 0x5f, 0x88,
+	0x3d, 0xb5, 0xe5, 0xba, 0x15, 0xf2, 0xa2, 0xea, 0xc7, 0x38, 0xf0, 0x59, 0xbc, 0xe5, 0x86, 0x7e,
+	0xd4, 0x6d, 0x4f, 0x25, 0xd9, 0x5e, 0xed, 0x29, 0xf5, 0xd9, 0x6e, 0xb9, 0xdb, 0x10, 0x6b, 0xd5,
+	0x1f, 0x8a, 0xab, 0xbe, 0x8c, 0xe7, 0x23, 0x4a, 0xc2, 0x6f, 0x56, 0xbf, 0xbc, 0x3c, 0x77, 0x62,
+	0xd7, 0x46, 0xd4, 0xc5, 0x8a, 0x3d, 0xa6, 0x28, 0x8a, 0xcb, 0x24, 0xc0, 0x5f, 0x5f, 0xab, 0x71,
+	0x03, 0x7a, 0x29, 0x09, 0xb0, 0x58, 0xae, 0xd1, 0x4d, 0xa3, 0xe9, 0xde, 0x4f, 0x02, 0x5c, 0x16,
+	0xf2, 0x76, 0xcb, 0xa1, 0xd5, 0x6a, 0x73, 0x8e, 0x60, 0x44, 0x58, 0x7d, 0x44, 0x0e, 0xf1, 0x77,
+	0x69, 0xcf, 0x2c, 0x4c, 0x67, 0xf4, 0x6a, 0x83, 0x7e, 0x59, 0x80, 0x79, 0x5d, 0x7b, 0x5b, 0xfb,
+	0x3a, 0x17, 0xd9, 0xe1, 0xad, 0x4d, 0xa4, 0x9e, 0x0b, 0x35, 0x91, 0xda, 0xad, 0x77, 0x27, 0x3b,
+	0xb5, 0x3f, 0x3f, 0x29, 0x34, 0x36, 0x7c, 0x16, 0x76, 0xc1, 0xfa, 0x90, 0xbd, 0xf9, 0xe8, 0x55,
+	0xc8, 0x5e, 0x6f, 0x7a, 0xbb, 0x5e, 0x6f, 0x52, 0x47, 0x25, 0x6b, 0x52, 0x3a, 0x33, 0x66, 0xcf,
+	0xca, 0xf7, 0xc5, 0xf4, 0xe6, 0x53, 0xd6, 0xc1, 0xfe, 0x24, 0xf7, 0xf3, 0x1f, 0x12, 0xdb, 0xa7,
+	0x4e, 0x0a, 0x33, 0xed, 0x11, 0xdf, 0x68, 0xd9, 0xce, 0x1a, 0xff, 0xb7, 0x02, 0x2c, 0x67, 0xf7,
+	0x4c, 0x9b, 0x46, 0x21, 0xbb, 0x88, 0x01, 0x67, 0xb4, 0x37, 0x7b, 0xf2, 0xb6, 0x37, 0x7b, 0xcf,
+	0x6a, 0x6f, 0x66, 0x1d, 0xbd, 0x03, 0xb7, 0xcf, 0xf6, 0x53, 0x2f, 0xc5, 0xef, 0x7b, 0x60, 0x21,
+	0x0b, 0xcf, 0x36, 0xe3, 0x2e, 0x12, 0x90, 0x36, 0x6d, 0xbf, 0x9e, 0x0b, 0xb6, 0xfd, 0xda, 0x37,
+	0xe0, 0x7a, 0xbf, 0xdb, 0x06, 0x5c, 0xdf, 0xbf, 0xa2, 0x01, 0xd7, 0xdf, 0xb5, 0x01, 0xd7, 0xe6,
+	0xc6, 0xd2, 0x71, 0x09, 0x93, 0xb5, 0xde, 0xfc, 0xc7, 0x24, 0xf4, 0x94, 0x98, 0x67, 0x54, 0x60,
+	0x38, 0xf3, 0x93, 0x56, 0x53, 0x97, 0xba, 0xe9, 0x37, 0x28, 0xeb, 0x7a, 0x57, 0xb1, 0x7e, 0x9a,
+	0xd5, 0x61, 0xb6, 0x53, 0x57, 0x6a, 0xad, 0x85, 0xa1, 0x03, 0xd2, 0xba, 0x7b, 0x5e, 0xa4, 0x56,
+	0xfb, 0x12, 0x46, 0x9b, 0x7e, 0x15, 0x5b, 0x6a, 0xe1, 0xc8, 0x02, 0xac, 0x9b, 0x67, 0x00, 0x34,
+	0xf7, 0x13, 0x18, 0xd0, 0x8d, 0xa6, 0xb9, 0x96, 0x49, 0x89, 0xc8, 0xba, 0xd6, 0x51, 0xa4, 0x99,
+	0x0e, 0xc0, 0x68, 0xd3, 0x9e, 0x59, 0x69, 0x33, 0xb1, 0x19, 0x64, 0xbd, 0x72, 0x0e, 0x90, 0xd6,
+	0xf3, 0x02, 0x46, 0xb2, 0x8d, 0x93, 0xc5, 0x8e, 0xb6, 0x09, 0xb9, 0x75, 0xa3, 0xbb, 0x3c, 0x1d,
+	0xe6, 0xa6, 0x36, 0xc2, 0x52, 0x87, 0x6d, 0x91, 0x00, 0xac, 0x9b, 0x67, 0x00, 0x34, 0xf7, 0x09,
+	0x98, 0x1d, 0x7b, 0xc0, 0xb7, 0xba, 0x6c, 0x88, 0x2c, 0xd4, 0xba, 0x77, 0x6e, 0x68, 0x7a, 0x81,
+	0x75, 0x5b, 0xa0, 0x75, 0x81, 0x13, 0x91, 0x75, 0xad, 0xa3, 0x48, 0x33, 0xbd, 0x0f, 0x43, 0xe9,
+	0x37, 0xef, 0x42, 0x87, 0x45, 0x93, 0x7c, 0xab, 0xdd, 0xa4, 0x9a, 0xd2, 0x81, 0x89, 0xd6, 0xc7,
+	0xf4, 0x72, 0xeb, 0xde, 0x6d, 0xc6, 0x58, 0xb7, 0xcf, 0xc6, 0xa4, 0x37, 0x4c, 0xb6, 0x02, 0xb7,
+	0x6e, 0x98, 0x8c, 0xdc, 0xba, 0xd1, 0x5d, 0xae, 0x89, 0x7d, 0x98, 0x6c, 0x57, 0xe0, 0xdb, 0xb9,
+	0xde, 0x82, 0xb2, 0xee, 0x9c, 0x07, 0x95, 0x55, 0xd5, 0xfa, 0x80, 0xec, 0x14, 0xe5, 0xb3, 0x55,
+	0x75, 0x7c, 0xf4, 0x19, 0xff, 0x0b, 0x73, 0x9d, 0x5f, 0xac, 0xdd, 0xe3, 0x9e, 0x55, 0xbb, 0x79,
+	0x7e, 0xac, 0x56, 0xfe, 0x2e, 0x0c, 0x36, 0x5e, 0x9b, 0x56, 0x0b, 0x81, 0x96, 0x59, 0xcb, 0x9d,
+	0x65, 0xe9, 0xa0, 0xb5, 0x7b, 0x08, 0xae, 0xb6, 0xdb, 0xea, 0xcd, 0x28, 0xeb, 0xce, 0x79, 0x50,
+	0xe9, 0xf3, 0xdd, 0xf1, 0xe1, 0x77, 0xab, 0x43, 0xf8, 0x5b, 0xa1, 0xd6, 0xbd, 0x73, 0x43, 0xd3,
+	0x11, 0x6b, 0xbc, 0xf6, 0x5a, 0x23, 0xa6, 0x65, 0xd6, 0x72, 0x67, 0x99, 0x26, 0xdb, 0x05, 0x48,
+	0xbd, 0xd5, 0xe6, 0xdb, 0x58, 0x93, 0x08, 0xad, 0x95, 0x2e, 0xc2, 0x74, 0x58, 0x3a, 0xbe, 0xb4,
+	0x6e, 0x75, 0xc8, 0x9d, 0xad, 0x50, 0xeb, 0xde, 0xb9, 0xa1, 0x2d, 0x6b, 0x9f, 0x85, 0x74, 0x5a,
+	0xfb, 0x2c, 0xca, 0xba, 0x73, 0x1e, 0x54, 0xfb, 0xb5, 0x6f, 0xd2, 0xd7, 0x6d, 0xed, 0x9b, 0x94,
+	0xde, 0x3b, 0x37, 0x54, 0x6b, 0xfe, 0x51, 0x01, 0x96, 0xce, 0xba, 0xed, 0xdf, 0xed, 0x16, 0xbb,
+	0x76, 0x33, 0xac, 0x37, 0xbf, 0xee, 0x8c, 0x74, 0xea, 0xe8, 0x7c, 0xcb, 0xbe, 0xdd, 0x8d, 0x36,
+	0x8b, 0xb5, 0x36, 0xcf, 0x8f, 0x4d, 0x94, 0x5b, 0x7d, 0xff, 0xcf, 0xbb, 0x45, 0xf7, 0xcb, 0x9f,
+	0x7e, 0xb1, 0x58, 0xf8, 0xec, 0x8b, 0xc5, 0xc2, 0x5f, 0xbf, 0x58, 0x2c, 0xfc, 0xf8, 0xcb, 0xc5,
+	0x4b, 0x9f, 0x7d, 0xb9, 0x78, 0xe9, 0xcf, 0x5f, 0x2e, 0x5e, 0x7a, 0xf9, 0xe6, 0x39, 0x6f, 0xb5,
+	0x27, 0x1b, 0x5a, 0xb9, 0xfc, 0x4f, 0x63, 0xfb, 0xfd, 0xe2, 0xff, 0x6b, 0xbd, 0xf6, 0xcf, 0x01,
+	0x00, 0x59, 0x9a, 0xb7, 0x34, 0xb8, 0x36, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	InsertBulkWorkerPayload(ctx context.Context, in *MsgInsertBulkWorkerPayload, opts ...grpc.CallOption) (*MsgInsertBulkWorkerPayloadResponse, error)
+	CreateNewTopic(ctx context.Context, in *MsgCreateNewTopic, opts ...grpc.CallOption) (*MsgCreateNewTopicResponse, error)
+	Register(ctx context.Context, in *MsgRegister, opts ...grpc.CallOption) (*MsgRegisterResponse, error)
+	RemoveRegistration(ctx context.Context, in *MsgRemoveRegistration, opts ...grpc.CallOption) (*MsgRemoveRegistrationResponse, error)
+	RegisterMulti(ctx context.Context, in *MsgRegisterMulti, opts ...grpc.CallOption) (*MsgRegisterMultiResponse, error)
+	UpdateNodeInfo(ctx context.Context, in *MsgUpdateNodeInfo, opts ...grpc.CallOption) (*MsgUpdateNodeInfoResponse, error)
+	InsertBulkReputerPayload(ctx context.Context, in *MsgInsertBulkReputerPayload, opts ...grpc.CallOption) (*MsgInsertBulkReputerPayloadResponse, error)
+	AddStake(ctx context.Context, in *MsgAddStake, opts ...grpc.CallOption) (*MsgAddStakeResponse, error)
+	RemoveStake(ctx context.Context, in *MsgRemoveStake, opts ...grpc.CallOption) (*MsgRemoveStakeResponse, error)
+	CancelRemoveStake(ctx context.Context, in *MsgCancelRemoveStake, opts ...grpc.CallOption) (*MsgCancelRemoveStakeResponse, error)
+	DelegateStake(ctx context.Context, in *MsgDelegateStake, opts ...grpc.CallOption) (*MsgDelegateStakeResponse, error)
+	RewardDelegateStake(ctx context.Context, in *MsgRewardDelegateStake, opts ...grpc.CallOption) (*MsgRewardDelegateStakeResponse, error)
+	RemoveDelegateStake(ctx context.Context, in *MsgRemoveDelegateStake, opts ...grpc.CallOption) (*MsgRemoveDelegateStakeResponse, error)
+	CancelRemoveDelegateStake(ctx context.Context, in *MsgCancelRemoveDelegateStake, opts ...grpc.CallOption) (*MsgCancelRemoveDelegateStakeResponse, error)
+	FundTopic(ctx context.Context, in *MsgFundTopic, opts ...grpc.CallOption) (*MsgFundTopicResponse, error)
+	AddToWhitelistAdmin(ctx context.Context, in *MsgAddToWhitelistAdmin, opts ...grpc.CallOption) (*MsgAddToWhitelistAdminResponse, error)
+	RemoveFromWhitelistAdmin(ctx context.Context, in *MsgRemoveFromWhitelistAdmin, opts ...grpc.CallOption) (*MsgRemoveFromWhitelistAdminResponse, error)
+	GrantRole(ctx context.Context, in *MsgGrantRole, opts ...grpc.CallOption) (*MsgGrantRoleResponse, error)
+	RevokeRole(ctx context.Context, in *MsgRevokeRole, opts ...grpc.CallOption) (*MsgRevokeRoleResponse, error)
+	UpdateTopicAllowlistMode(ctx context.Context, in *MsgUpdateTopicAllowlistMode, opts ...grpc.CallOption) (*MsgUpdateTopicAllowlistModeResponse, error)
+	AddToTopicAllowlist(ctx context.Context, in *MsgAddToTopicAllowlist, opts ...grpc.CallOption) (*MsgAddToTopicAllowlistResponse, error)
+	RemoveFromTopicAllowlist(ctx context.Context, in *MsgRemoveFromTopicAllowlist, opts ...grpc.CallOption) (*MsgRemoveFromTopicAllowlistResponse, error)
+	UpdateTopicInactivityThresholds(ctx context.Context, in *MsgUpdateTopicInactivityThresholds, opts ...grpc.CallOption) (*MsgUpdateTopicInactivityThresholdsResponse, error)
+	UpdateTopicLossAggregator(ctx context.Context, in *MsgUpdateTopicLossAggregator, opts ...grpc.CallOption) (*MsgUpdateTopicLossAggregatorResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_RemoveFromWhitelistAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveFromWhitelistAdmin)
-	if err := dec(in); err != nil {
+func (c *msgClient) InsertBulkWorkerPayload(ctx context.Context, in *MsgInsertBulkWorkerPayload, opts ...grpc.CallOption) (*MsgInsertBulkWorkerPayloadResponse, error) {
+	out := new(MsgInsertBulkWorkerPayloadResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/InsertBulkWorkerPayload", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveFromWhitelistAdmin(ctx, in)
+	return out, nil
+}
+
+func (c *msgClient) CreateNewTopic(ctx context.Context, in *MsgCreateNewTopic, opts ...grpc.CallOption) (*MsgCreateNewTopicResponse, error) {
+	out := new(MsgCreateNewTopicResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/CreateNewTopic", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/emissions.v1.Msg/RemoveFromWhitelistAdmin",
+	return out, nil
+}
+
+func (c *msgClient) Register(ctx context.Context, in *MsgRegister, opts ...grpc.CallOption) (*MsgRegisterResponse, error) {
+	out := new(MsgRegisterResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/Register", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveFromWhitelistAdmin(ctx, req.(*MsgRemoveFromWhitelistAdmin))
+	return out, nil
+}
+
+func (c *msgClient) RemoveRegistration(ctx context.Context, in *MsgRemoveRegistration, opts ...grpc.CallOption) (*MsgRemoveRegistrationResponse, error) {
+	out := new(MsgRemoveRegistrationResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/RemoveRegistration", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "emissions.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "InsertBulkWorkerPayload",
-			Handler:    _Msg_InsertBulkWorkerPayload_Handler,
-		},
-		{
-			MethodName: "CreateNewTopic",
-			Handler:    _Msg_CreateNewTopic_Handler,
-		},
-		{
-			MethodName: "Register",
-			Handler:    _Msg_Register_Handler,
-		},
-		{
-			MethodName: "RemoveRegistration",
-			Handler:    _Msg_RemoveRegistration_Handler,
-		},
-		{
-			MethodName: "InsertBulkReputerPayload",
-			Handler:    _Msg_InsertBulkReputerPayload_Handler,
-		},
-		{
-			MethodName: "AddStake",
-			Handler:    _Msg_AddStake_Handler,
-		},
-		{
-			MethodName: "RemoveStake",
-			Handler:    _Msg_RemoveStake_Handler,
-		},
-		{
-			MethodName: "CancelRemoveStake",
-			Handler:    _Msg_CancelRemoveStake_Handler,
-		},
-		{
-			MethodName: "DelegateStake",
-			Handler:    _Msg_DelegateStake_Handler,
-		},
-		{
-			MethodName: "RewardDelegateStake",
-			Handler:    _Msg_RewardDelegateStake_Handler,
-		},
-		{
-			MethodName: "RemoveDelegateStake",
-			Handler:    _Msg_RemoveDelegateStake_Handler,
-		},
-		{
-			MethodName: "CancelRemoveDelegateStake",
-			Handler:    _Msg_CancelRemoveDelegateStake_Handler,
-		},
-		{
-			MethodName: "FundTopic",
-			Handler:    _Msg_FundTopic_Handler,
-		},
-		{
-			MethodName: "AddToWhitelistAdmin",
-			Handler:    _Msg_AddToWhitelistAdmin_Handler,
-		},
-		{
-			MethodName: "RemoveFromWhitelistAdmin",
-			Handler:    _Msg_RemoveFromWhitelistAdmin_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "emissions/v1/tx.proto",
+func (c *msgClient) RegisterMulti(ctx context.Context, in *MsgRegisterMulti, opts ...grpc.CallOption) (*MsgRegisterMultiResponse, error) {
+	out := new(MsgRegisterMultiResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/RegisterMulti", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *OptionalParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateNodeInfo(ctx context.Context, in *MsgUpdateNodeInfo, opts ...grpc.CallOption) (*MsgUpdateNodeInfoResponse, error) {
+	out := new(MsgUpdateNodeInfoResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/UpdateNodeInfo", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *OptionalParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) InsertBulkReputerPayload(ctx context.Context, in *MsgInsertBulkReputerPayload, opts ...grpc.CallOption) (*MsgInsertBulkReputerPayloadResponse, error) {
+	out := new(MsgInsertBulkReputerPayloadResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/InsertBulkReputerPayload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *OptionalParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.HalfMaxProcessStakeRemovalsEndBlock) > 0 {
-		dAtA2 := make([]byte, len(m.HalfMaxProcessStakeRemovalsEndBlock)*10)
-		var j1 int
-		for _, num := range m.HalfMaxProcessStakeRemovalsEndBlock {
-			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA2[j1] = uint8(num)
-			j1++
-		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintTx(dAtA, i, uint64(j1))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0xd2
+func (c *msgClient) AddStake(ctx context.Context, in *MsgAddStake, opts ...grpc.CallOption) (*MsgAddStakeResponse, error) {
+	out := new(MsgAddStakeResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/AddStake", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.MinEffectiveTopicRevenue) > 0 {
-		for iNdEx := len(m.MinEffectiveTopicRevenue) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.MinEffectiveTopicRevenue[iNdEx].Size()
-				i -= size
-				if _, err := m.MinEffectiveTopicRevenue[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xca
-		}
+	return out, nil
+}
+
+func (c *msgClient) RemoveStake(ctx context.Context, in *MsgRemoveStake, opts ...grpc.CallOption) (*MsgRemoveStakeResponse, error) {
+	out := new(MsgRemoveStakeResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/RemoveStake", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.EpsilonReputer) > 0 {
-		for iNdEx := len(m.EpsilonReputer) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.EpsilonReputer[iNdEx].Size()
-				i -= size
-				if _, err := m.EpsilonReputer[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xc2
-		}
+	return out, nil
+}
+
+func (c *msgClient) CancelRemoveStake(ctx context.Context, in *MsgCancelRemoveStake, opts ...grpc.CallOption) (*MsgCancelRemoveStakeResponse, error) {
+	out := new(MsgCancelRemoveStakeResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/CancelRemoveStake", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.TopicFeeRevenueDecayRate) > 0 {
-		for iNdEx := len(m.TopicFeeRevenueDecayRate) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.TopicFeeRevenueDecayRate[iNdEx].Size()
-				i -= size
-				if _, err := m.TopicFeeRevenueDecayRate[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xba
-		}
+	return out, nil
+}
+
+func (c *msgClient) DelegateStake(ctx context.Context, in *MsgDelegateStake, opts ...grpc.CallOption) (*MsgDelegateStakeResponse, error) {
+	out := new(MsgDelegateStakeResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/DelegateStake", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.CNorm) > 0 {
-		for iNdEx := len(m.CNorm) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.CNorm[iNdEx].Size()
-				i -= size
-				if _, err := m.CNorm[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xb2
-		}
+	return out, nil
+}
+
+func (c *msgClient) RewardDelegateStake(ctx context.Context, in *MsgRewardDelegateStake, opts ...grpc.CallOption) (*MsgRewardDelegateStakeResponse, error) {
+	out := new(MsgRewardDelegateStakeResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/RewardDelegateStake", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.CRewardForecast) > 0 {
-		for iNdEx := len(m.CRewardForecast) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.CRewardForecast[iNdEx].Size()
-				i -= size
-				if _, err := m.CRewardForecast[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xaa
-		}
+	return out, nil
+}
+
+func (c *msgClient) RemoveDelegateStake(ctx context.Context, in *MsgRemoveDelegateStake, opts ...grpc.CallOption) (*MsgRemoveDelegateStakeResponse, error) {
+	out := new(MsgRemoveDelegateStakeResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/RemoveDelegateStake", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.CRewardInference) > 0 {
-		for iNdEx := len(m.CRewardInference) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.CRewardInference[iNdEx].Size()
-				i -= size
-				if _, err := m.CRewardInference[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xa2
-		}
+	return out, nil
+}
+
+func (c *msgClient) CancelRemoveDelegateStake(ctx context.Context, in *MsgCancelRemoveDelegateStake, opts ...grpc.CallOption) (*MsgCancelRemoveDelegateStakeResponse, error) {
+	out := new(MsgCancelRemoveDelegateStakeResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/CancelRemoveDelegateStake", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PRewardReputer) > 0 {
-		for iNdEx := len(m.PRewardReputer) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.PRewardReputer[iNdEx].Size()
-				i -= size
-				if _, err := m.PRewardReputer[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0x9a
-		}
+	return out, nil
+}
+
+func (c *msgClient) FundTopic(ctx context.Context, in *MsgFundTopic, opts ...grpc.CallOption) (*MsgFundTopicResponse, error) {
+	out := new(MsgFundTopicResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/FundTopic", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PRewardForecast) > 0 {
-		for iNdEx := len(m.PRewardForecast) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.PRewardForecast[iNdEx].Size()
-				i -= size
-				if _, err := m.PRewardForecast[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0x92
-		}
+	return out, nil
+}
+
+func (c *msgClient) AddToWhitelistAdmin(ctx context.Context, in *MsgAddToWhitelistAdmin, opts ...grpc.CallOption) (*MsgAddToWhitelistAdminResponse, error) {
+	out := new(MsgAddToWhitelistAdminResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/AddToWhitelistAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PRewardInference) > 0 {
-		for iNdEx := len(m.PRewardInference) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.PRewardInference[iNdEx].Size()
-				i -= size
-				if _, err := m.PRewardInference[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0x8a
-		}
+	return out, nil
+}
+
+func (c *msgClient) RemoveFromWhitelistAdmin(ctx context.Context, in *MsgRemoveFromWhitelistAdmin, opts ...grpc.CallOption) (*MsgRemoveFromWhitelistAdminResponse, error) {
+	out := new(MsgRemoveFromWhitelistAdminResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/RemoveFromWhitelistAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.BlocksPerMonth) > 0 {
-		dAtA4 := make([]byte, len(m.BlocksPerMonth)*10)
-		var j3 int
-		for _, num := range m.BlocksPerMonth {
-			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j3++
-			}
-			dAtA4[j3] = uint8(num)
-			j3++
-		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintTx(dAtA, i, uint64(j3))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0x82
+	return out, nil
+}
+
+func (c *msgClient) GrantRole(ctx context.Context, in *MsgGrantRole, opts ...grpc.CallOption) (*MsgGrantRoleResponse, error) {
+	out := new(MsgGrantRoleResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/GrantRole", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.MinEpochLengthRecordLimit) > 0 {
-		dAtA6 := make([]byte, len(m.MinEpochLengthRecordLimit)*10)
-		var j5 int
-		for _, num1 := range m.MinEpochLengthRecordLimit {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j5++
-			}
-			dAtA6[j5] = uint8(num)
-			j5++
-		}
-		i -= j5
-		copy(dAtA[i:], dAtA6[:j5])
-		i = encodeVarintTx(dAtA, i, uint64(j5))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xfa
+	return out, nil
+}
+
+func (c *msgClient) RevokeRole(ctx context.Context, in *MsgRevokeRole, opts ...grpc.CallOption) (*MsgRevokeRoleResponse, error) {
+	out := new(MsgRevokeRoleResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/RevokeRole", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.MaxPageLimit) > 0 {
-		dAtA8 := make([]byte, len(m.MaxPageLimit)*10)
-		var j7 int
-		for _, num := range m.MaxPageLimit {
-			for num >= 1<<7 {
-				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j7++
-			}
-			dAtA8[j7] = uint8(num)
-			j7++
-		}
-		i -= j7
-		copy(dAtA[i:], dAtA8[:j7])
-		i = encodeVarintTx(dAtA, i, uint64(j7))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xf2
+	return out, nil
+}
+
+func (c *msgClient) UpdateTopicAllowlistMode(ctx context.Context, in *MsgUpdateTopicAllowlistMode, opts ...grpc.CallOption) (*MsgUpdateTopicAllowlistModeResponse, error) {
+	out := new(MsgUpdateTopicAllowlistModeResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/UpdateTopicAllowlistMode", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.DefaultPageLimit) > 0 {
-		dAtA10 := make([]byte, len(m.DefaultPageLimit)*10)
-		var j9 int
-		for _, num := range m.DefaultPageLimit {
-			for num >= 1<<7 {
-				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j9++
-			}
-			dAtA10[j9] = uint8(num)
-			j9++
-		}
-		i -= j9
-		copy(dAtA[i:], dAtA10[:j9])
-		i = encodeVarintTx(dAtA, i, uint64(j9))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xea
+	return out, nil
+}
+
+func (c *msgClient) AddToTopicAllowlist(ctx context.Context, in *MsgAddToTopicAllowlist, opts ...grpc.CallOption) (*MsgAddToTopicAllowlistResponse, error) {
+	out := new(MsgAddToTopicAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/AddToTopicAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.RegistrationFee) > 0 {
-		for iNdEx := len(m.RegistrationFee) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.RegistrationFee[iNdEx].Size()
-				i -= size
-				if _, err := m.RegistrationFee[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xe2
-		}
+	return out, nil
+}
+
+func (c *msgClient) RemoveFromTopicAllowlist(ctx context.Context, in *MsgRemoveFromTopicAllowlist, opts ...grpc.CallOption) (*MsgRemoveFromTopicAllowlistResponse, error) {
+	out := new(MsgRemoveFromTopicAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/RemoveFromTopicAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.MaxRetriesToFulfilNoncesReputer) > 0 {
-		dAtA12 := make([]byte, len(m.MaxRetriesToFulfilNoncesReputer)*10)
-		var j11 int
-		for _, num1 := range m.MaxRetriesToFulfilNoncesReputer {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j11++
-			}
-			dAtA12[j11] = uint8(num)
-			j11++
-		}
-		i -= j11
-		copy(dAtA[i:], dAtA12[:j11])
-		i = encodeVarintTx(dAtA, i, uint64(j11))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xda
+	return out, nil
+}
+
+func (c *msgClient) UpdateTopicInactivityThresholds(ctx context.Context, in *MsgUpdateTopicInactivityThresholds, opts ...grpc.CallOption) (*MsgUpdateTopicInactivityThresholdsResponse, error) {
+	out := new(MsgUpdateTopicInactivityThresholdsResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/UpdateTopicInactivityThresholds", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.MaxRetriesToFulfilNoncesWorker) > 0 {
-		dAtA14 := make([]byte, len(m.MaxRetriesToFulfilNoncesWorker)*10)
-		var j13 int
-		for _, num1 := range m.MaxRetriesToFulfilNoncesWorker {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j13++
-			}
-			dAtA14[j13] = uint8(num)
-			j13++
-		}
-		i -= j13
-		copy(dAtA[i:], dAtA14[:j13])
-		i = encodeVarintTx(dAtA, i, uint64(j13))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xd2
+	return out, nil
+}
+
+func (c *msgClient) UpdateTopicLossAggregator(ctx context.Context, in *MsgUpdateTopicLossAggregator, opts ...grpc.CallOption) (*MsgUpdateTopicLossAggregatorResponse, error) {
+	out := new(MsgUpdateTopicLossAggregatorResponse)
+	err := c.cc.Invoke(ctx, "/emissions.v1.Msg/UpdateTopicLossAggregator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.GradientDescentMaxIters) > 0 {
-		dAtA16 := make([]byte, len(m.GradientDescentMaxIters)*10)
-		var j15 int
-		for _, num := range m.GradientDescentMaxIters {
-			for num >= 1<<7 {
-				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j15++
-			}
-			dAtA16[j15] = uint8(num)
-			j15++
-		}
-		i -= j15
-		copy(dAtA[i:], dAtA16[:j15])
-		i = encodeVarintTx(dAtA, i, uint64(j15))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xca
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	InsertBulkWorkerPayload(context.Context, *MsgInsertBulkWorkerPayload) (*MsgInsertBulkWorkerPayloadResponse, error)
+	CreateNewTopic(context.Context, *MsgCreateNewTopic) (*MsgCreateNewTopicResponse, error)
+	Register(context.Context, *MsgRegister) (*MsgRegisterResponse, error)
+	RemoveRegistration(context.Context, *MsgRemoveRegistration) (*MsgRemoveRegistrationResponse, error)
+	RegisterMulti(context.Context, *MsgRegisterMulti) (*MsgRegisterMultiResponse, error)
+	UpdateNodeInfo(context.Context, *MsgUpdateNodeInfo) (*MsgUpdateNodeInfoResponse, error)
+	InsertBulkReputerPayload(context.Context, *MsgInsertBulkReputerPayload) (*MsgInsertBulkReputerPayloadResponse, error)
+	AddStake(context.Context, *MsgAddStake) (*MsgAddStakeResponse, error)
+	RemoveStake(context.Context, *MsgRemoveStake) (*MsgRemoveStakeResponse, error)
+	CancelRemoveStake(context.Context, *MsgCancelRemoveStake) (*MsgCancelRemoveStakeResponse, error)
+	DelegateStake(context.Context, *MsgDelegateStake) (*MsgDelegateStakeResponse, error)
+	RewardDelegateStake(context.Context, *MsgRewardDelegateStake) (*MsgRewardDelegateStakeResponse, error)
+	RemoveDelegateStake(context.Context, *MsgRemoveDelegateStake) (*MsgRemoveDelegateStakeResponse, error)
+	CancelRemoveDelegateStake(context.Context, *MsgCancelRemoveDelegateStake) (*MsgCancelRemoveDelegateStakeResponse, error)
+	FundTopic(context.Context, *MsgFundTopic) (*MsgFundTopicResponse, error)
+	AddToWhitelistAdmin(context.Context, *MsgAddToWhitelistAdmin) (*MsgAddToWhitelistAdminResponse, error)
+	RemoveFromWhitelistAdmin(context.Context, *MsgRemoveFromWhitelistAdmin) (*MsgRemoveFromWhitelistAdminResponse, error)
+	GrantRole(context.Context, *MsgGrantRole) (*MsgGrantRoleResponse, error)
+	RevokeRole(context.Context, *MsgRevokeRole) (*MsgRevokeRoleResponse, error)
+	UpdateTopicAllowlistMode(context.Context, *MsgUpdateTopicAllowlistMode) (*MsgUpdateTopicAllowlistModeResponse, error)
+	AddToTopicAllowlist(context.Context, *MsgAddToTopicAllowlist) (*MsgAddToTopicAllowlistResponse, error)
+	RemoveFromTopicAllowlist(context.Context, *MsgRemoveFromTopicAllowlist) (*MsgRemoveFromTopicAllowlistResponse, error)
+	UpdateTopicInactivityThresholds(context.Context, *MsgUpdateTopicInactivityThresholds) (*MsgUpdateTopicInactivityThresholdsResponse, error)
+	UpdateTopicLossAggregator(context.Context, *MsgUpdateTopicLossAggregator) (*MsgUpdateTopicLossAggregatorResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) InsertBulkWorkerPayload(ctx context.Context, req *MsgInsertBulkWorkerPayload) (*MsgInsertBulkWorkerPayloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertBulkWorkerPayload not implemented")
+}
+func (*UnimplementedMsgServer) CreateNewTopic(ctx context.Context, req *MsgCreateNewTopic) (*MsgCreateNewTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNewTopic not implemented")
+}
+func (*UnimplementedMsgServer) Register(ctx context.Context, req *MsgRegister) (*MsgRegisterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+}
+func (*UnimplementedMsgServer) RemoveRegistration(ctx context.Context, req *MsgRemoveRegistration) (*MsgRemoveRegistrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRegistration not implemented")
+}
+func (*UnimplementedMsgServer) RegisterMulti(ctx context.Context, req *MsgRegisterMulti) (*MsgRegisterMultiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterMulti not implemented")
+}
+func (*UnimplementedMsgServer) UpdateNodeInfo(ctx context.Context, req *MsgUpdateNodeInfo) (*MsgUpdateNodeInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNodeInfo not implemented")
+}
+func (*UnimplementedMsgServer) InsertBulkReputerPayload(ctx context.Context, req *MsgInsertBulkReputerPayload) (*MsgInsertBulkReputerPayloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertBulkReputerPayload not implemented")
+}
+func (*UnimplementedMsgServer) AddStake(ctx context.Context, req *MsgAddStake) (*MsgAddStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddStake not implemented")
+}
+func (*UnimplementedMsgServer) RemoveStake(ctx context.Context, req *MsgRemoveStake) (*MsgRemoveStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveStake not implemented")
+}
+func (*UnimplementedMsgServer) CancelRemoveStake(ctx context.Context, req *MsgCancelRemoveStake) (*MsgCancelRemoveStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRemoveStake not implemented")
+}
+func (*UnimplementedMsgServer) DelegateStake(ctx context.Context, req *MsgDelegateStake) (*MsgDelegateStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateStake not implemented")
+}
+func (*UnimplementedMsgServer) RewardDelegateStake(ctx context.Context, req *MsgRewardDelegateStake) (*MsgRewardDelegateStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardDelegateStake not implemented")
+}
+func (*UnimplementedMsgServer) RemoveDelegateStake(ctx context.Context, req *MsgRemoveDelegateStake) (*MsgRemoveDelegateStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDelegateStake not implemented")
+}
+func (*UnimplementedMsgServer) CancelRemoveDelegateStake(ctx context.Context, req *MsgCancelRemoveDelegateStake) (*MsgCancelRemoveDelegateStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRemoveDelegateStake not implemented")
+}
+func (*UnimplementedMsgServer) FundTopic(ctx context.Context, req *MsgFundTopic) (*MsgFundTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundTopic not implemented")
+}
+func (*UnimplementedMsgServer) AddToWhitelistAdmin(ctx context.Context, req *MsgAddToWhitelistAdmin) (*MsgAddToWhitelistAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToWhitelistAdmin not implemented")
+}
+func (*UnimplementedMsgServer) RemoveFromWhitelistAdmin(ctx context.Context, req *MsgRemoveFromWhitelistAdmin) (*MsgRemoveFromWhitelistAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromWhitelistAdmin not implemented")
+}
+func (*UnimplementedMsgServer) GrantRole(ctx context.Context, req *MsgGrantRole) (*MsgGrantRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantRole not implemented")
+}
+func (*UnimplementedMsgServer) RevokeRole(ctx context.Context, req *MsgRevokeRole) (*MsgRevokeRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeRole not implemented")
+}
+func (*UnimplementedMsgServer) UpdateTopicAllowlistMode(ctx context.Context, req *MsgUpdateTopicAllowlistMode) (*MsgUpdateTopicAllowlistModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTopicAllowlistMode not implemented")
+}
+func (*UnimplementedMsgServer) AddToTopicAllowlist(ctx context.Context, req *MsgAddToTopicAllowlist) (*MsgAddToTopicAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToTopicAllowlist not implemented")
+}
+func (*UnimplementedMsgServer) RemoveFromTopicAllowlist(ctx context.Context, req *MsgRemoveFromTopicAllowlist) (*MsgRemoveFromTopicAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromTopicAllowlist not implemented")
+}
+func (*UnimplementedMsgServer) UpdateTopicInactivityThresholds(ctx context.Context, req *MsgUpdateTopicInactivityThresholds) (*MsgUpdateTopicInactivityThresholdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTopicInactivityThresholds not implemented")
+}
+func (*UnimplementedMsgServer) UpdateTopicLossAggregator(ctx context.Context, req *MsgUpdateTopicLossAggregator) (*MsgUpdateTopicLossAggregatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTopicLossAggregator not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.CreateTopicFee) > 0 {
-		for iNdEx := len(m.CreateTopicFee) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.CreateTopicFee[iNdEx].Size()
-				i -= size
-				if _, err := m.CreateTopicFee[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xc2
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
-	if len(m.MaxTopReputersToReward) > 0 {
-		dAtA18 := make([]byte, len(m.MaxTopReputersToReward)*10)
-		var j17 int
-		for _, num := range m.MaxTopReputersToReward {
-			for num >= 1<<7 {
-				dAtA18[j17] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j17++
-			}
-			dAtA18[j17] = uint8(num)
-			j17++
-		}
-		i -= j17
-		copy(dAtA[i:], dAtA18[:j17])
-		i = encodeVarintTx(dAtA, i, uint64(j17))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xba
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/UpdateParams",
 	}
-	if len(m.MaxTopForecastersToReward) > 0 {
-		dAtA20 := make([]byte, len(m.MaxTopForecastersToReward)*10)
-		var j19 int
-		for _, num := range m.MaxTopForecastersToReward {
-			for num >= 1<<7 {
-				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j19++
-			}
-			dAtA20[j19] = uint8(num)
-			j19++
-		}
-		i -= j19
-		copy(dAtA[i:], dAtA20[:j19])
-		i = encodeVarintTx(dAtA, i, uint64(j19))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb2
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
 	}
-	if len(m.MaxTopInferersToReward) > 0 {
-		dAtA22 := make([]byte, len(m.MaxTopInferersToReward)*10)
-		var j21 int
-		for _, num := range m.MaxTopInferersToReward {
-			for num >= 1<<7 {
-				dAtA22[j21] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j21++
-			}
-			dAtA22[j21] = uint8(num)
-			j21++
-		}
-		i -= j21
-		copy(dAtA[i:], dAtA22[:j21])
-		i = encodeVarintTx(dAtA, i, uint64(j21))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xaa
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_InsertBulkWorkerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInsertBulkWorkerPayload)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.MaxSamplesToScaleScores) > 0 {
-		dAtA24 := make([]byte, len(m.MaxSamplesToScaleScores)*10)
-		var j23 int
-		for _, num := range m.MaxSamplesToScaleScores {
-			for num >= 1<<7 {
-				dAtA24[j23] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j23++
-			}
-			dAtA24[j23] = uint8(num)
-			j23++
-		}
-		i -= j23
-		copy(dAtA[i:], dAtA24[:j23])
-		i = encodeVarintTx(dAtA, i, uint64(j23))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa2
+	if interceptor == nil {
+		return srv.(MsgServer).InsertBulkWorkerPayload(ctx, in)
 	}
-	if len(m.ValidatorsVsAlloraPercentReward) > 0 {
-		for iNdEx := len(m.ValidatorsVsAlloraPercentReward) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.ValidatorsVsAlloraPercentReward[iNdEx].Size()
-				i -= size
-				if _, err := m.ValidatorsVsAlloraPercentReward[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x9a
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/InsertBulkWorkerPayload",
 	}
-	if len(m.TaskRewardAlpha) > 0 {
-		for iNdEx := len(m.TaskRewardAlpha) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.TaskRewardAlpha[iNdEx].Size()
-				i -= size
-				if _, err := m.TaskRewardAlpha[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x92
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InsertBulkWorkerPayload(ctx, req.(*MsgInsertBulkWorkerPayload))
 	}
-	if len(m.TopicRewardAlpha) > 0 {
-		for iNdEx := len(m.TopicRewardAlpha) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.TopicRewardAlpha[iNdEx].Size()
-				i -= size
-				if _, err := m.TopicRewardAlpha[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x8a
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateNewTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateNewTopic)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.TopicRewardFeeRevenueImportance) > 0 {
-		for iNdEx := len(m.TopicRewardFeeRevenueImportance) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.TopicRewardFeeRevenueImportance[iNdEx].Size()
-				i -= size
-				if _, err := m.TopicRewardFeeRevenueImportance[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x82
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateNewTopic(ctx, in)
 	}
-	if len(m.TopicRewardStakeImportance) > 0 {
-		for iNdEx := len(m.TopicRewardStakeImportance) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.TopicRewardStakeImportance[iNdEx].Size()
-				i -= size
-				if _, err := m.TopicRewardStakeImportance[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x7a
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/CreateNewTopic",
 	}
-	if len(m.MaxUnfulfilledReputerRequests) > 0 {
-		dAtA26 := make([]byte, len(m.MaxUnfulfilledReputerRequests)*10)
-		var j25 int
-		for _, num := range m.MaxUnfulfilledReputerRequests {
-			for num >= 1<<7 {
-				dAtA26[j25] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j25++
-			}
-			dAtA26[j25] = uint8(num)
-			j25++
-		}
-		i -= j25
-		copy(dAtA[i:], dAtA26[:j25])
-		i = encodeVarintTx(dAtA, i, uint64(j25))
-		i--
-		dAtA[i] = 0x72
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateNewTopic(ctx, req.(*MsgCreateNewTopic))
 	}
-	if len(m.MaxUnfulfilledWorkerRequests) > 0 {
-		dAtA28 := make([]byte, len(m.MaxUnfulfilledWorkerRequests)*10)
-		var j27 int
-		for _, num := range m.MaxUnfulfilledWorkerRequests {
-			for num >= 1<<7 {
-				dAtA28[j27] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j27++
-			}
-			dAtA28[j27] = uint8(num)
-			j27++
-		}
-		i -= j27
-		copy(dAtA[i:], dAtA28[:j27])
-		i = encodeVarintTx(dAtA, i, uint64(j27))
-		i--
-		dAtA[i] = 0x6a
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegister)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.MinStakeFraction) > 0 {
-		for iNdEx := len(m.MinStakeFraction) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.MinStakeFraction[iNdEx].Size()
-				i -= size
-				if _, err := m.MinStakeFraction[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x5a
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).Register(ctx, in)
 	}
-	if len(m.MaxGradientThreshold) > 0 {
-		for iNdEx := len(m.MaxGradientThreshold) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.MaxGradientThreshold[iNdEx].Size()
-				i -= size
-				if _, err := m.MaxGradientThreshold[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x52
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/Register",
 	}
-	if len(m.LearningRate) > 0 {
-		for iNdEx := len(m.LearningRate) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.LearningRate[iNdEx].Size()
-				i -= size
-				if _, err := m.LearningRate[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Register(ctx, req.(*MsgRegister))
 	}
-	if len(m.BetaEntropy) > 0 {
-		for iNdEx := len(m.BetaEntropy) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.BetaEntropy[iNdEx].Size()
-				i -= size
-				if _, err := m.BetaEntropy[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x42
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveRegistration)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.MinEpochLength) > 0 {
-		dAtA30 := make([]byte, len(m.MinEpochLength)*10)
-		var j29 int
-		for _, num1 := range m.MinEpochLength {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA30[j29] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j29++
-			}
-			dAtA30[j29] = uint8(num)
-			j29++
-		}
-		i -= j29
-		copy(dAtA[i:], dAtA30[:j29])
-		i = encodeVarintTx(dAtA, i, uint64(j29))
-		i--
-		dAtA[i] = 0x3a
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveRegistration(ctx, in)
 	}
-	if len(m.RemoveStakeDelayWindow) > 0 {
-		dAtA32 := make([]byte, len(m.RemoveStakeDelayWindow)*10)
-		var j31 int
-		for _, num1 := range m.RemoveStakeDelayWindow {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA32[j31] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j31++
-			}
-			dAtA32[j31] = uint8(num)
-			j31++
-		}
-		i -= j31
-		copy(dAtA[i:], dAtA32[:j31])
-		i = encodeVarintTx(dAtA, i, uint64(j31))
-		i--
-		dAtA[i] = 0x32
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/RemoveRegistration",
 	}
-	if len(m.RequiredMinimumStake) > 0 {
-		for iNdEx := len(m.RequiredMinimumStake) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.RequiredMinimumStake[iNdEx].Size()
-				i -= size
-				if _, err := m.RequiredMinimumStake[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveRegistration(ctx, req.(*MsgRemoveRegistration))
 	}
-	if len(m.MaxTopicsPerBlock) > 0 {
-		dAtA34 := make([]byte, len(m.MaxTopicsPerBlock)*10)
-		var j33 int
-		for _, num := range m.MaxTopicsPerBlock {
-			for num >= 1<<7 {
-				dAtA34[j33] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j33++
-			}
-			dAtA34[j33] = uint8(num)
-			j33++
-		}
-		i -= j33
-		copy(dAtA[i:], dAtA34[:j33])
-		i = encodeVarintTx(dAtA, i, uint64(j33))
-		i--
-		dAtA[i] = 0x22
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterMulti_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterMulti)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.MinTopicWeight) > 0 {
-		for iNdEx := len(m.MinTopicWeight) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.MinTopicWeight[iNdEx].Size()
-				i -= size
-				if _, err := m.MinTopicWeight[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterMulti(ctx, in)
 	}
-	if len(m.MaxSerializedMsgLength) > 0 {
-		dAtA36 := make([]byte, len(m.MaxSerializedMsgLength)*10)
-		var j35 int
-		for _, num1 := range m.MaxSerializedMsgLength {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA36[j35] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j35++
-			}
-			dAtA36[j35] = uint8(num)
-			j35++
-		}
-		i -= j35
-		copy(dAtA[i:], dAtA36[:j35])
-		i = encodeVarintTx(dAtA, i, uint64(j35))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/RegisterMulti",
 	}
-	if len(m.Version) > 0 {
-		for iNdEx := len(m.Version) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Version[iNdEx])
-			copy(dAtA[i:], m.Version[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Version[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterMulti(ctx, req.(*MsgRegisterMulti))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdateNodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateNodeInfo)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateNodeInfo(ctx, in)
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/UpdateNodeInfo",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateNodeInfo(ctx, req.(*MsgUpdateNodeInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_InsertBulkReputerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInsertBulkReputerPayload)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).InsertBulkReputerPayload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/InsertBulkReputerPayload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InsertBulkReputerPayload(ctx, req.(*MsgInsertBulkReputerPayload))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_AddStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddStake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/AddStake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddStake(ctx, req.(*MsgAddStake))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateNewTopic) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RemoveStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveStake)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/RemoveStake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveStake(ctx, req.(*MsgRemoveStake))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateNewTopic) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_CancelRemoveStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRemoveStake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelRemoveStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/CancelRemoveStake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelRemoveStake(ctx, req.(*MsgCancelRemoveStake))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateNewTopic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Epsilon.Size()
-		i -= size
-		if _, err := m.Epsilon.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x6a
-	if m.AllowNegative {
-		i--
-		if m.AllowNegative {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x60
+func _Msg_DelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateStake)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	{
-		size := m.AlphaRegret.Size()
-		i -= size
-		if _, err := m.AlphaRegret.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateStake(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x5a
-	{
-		size := m.PNorm.Size()
-		i -= size
-		if _, err := m.PNorm.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/DelegateStake",
 	}
-	i--
-	dAtA[i] = 0x52
-	if len(m.DefaultArg) > 0 {
-		i -= len(m.DefaultArg)
-		copy(dAtA[i:], m.DefaultArg)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DefaultArg)))
-		i--
-		dAtA[i] = 0x4a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateStake(ctx, req.(*MsgDelegateStake))
 	}
-	if m.GroundTruthLag != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GroundTruthLag))
-		i--
-		dAtA[i] = 0x40
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RewardDelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRewardDelegateStake)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.EpochLength != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.EpochLength))
-		i--
-		dAtA[i] = 0x38
+	if interceptor == nil {
+		return srv.(MsgServer).RewardDelegateStake(ctx, in)
 	}
-	if len(m.InferenceMethod) > 0 {
-		i -= len(m.InferenceMethod)
-		copy(dAtA[i:], m.InferenceMethod)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceMethod)))
-		i--
-		dAtA[i] = 0x32
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/RewardDelegateStake",
 	}
-	if len(m.InferenceLogic) > 0 {
-		i -= len(m.InferenceLogic)
-		copy(dAtA[i:], m.InferenceLogic)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceLogic)))
-		i--
-		dAtA[i] = 0x2a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RewardDelegateStake(ctx, req.(*MsgRewardDelegateStake))
 	}
-	if len(m.LossMethod) > 0 {
-		i -= len(m.LossMethod)
-		copy(dAtA[i:], m.LossMethod)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.LossMethod)))
-		i--
-		dAtA[i] = 0x22
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveDelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveDelegateStake)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.LossLogic) > 0 {
-		i -= len(m.LossLogic)
-		copy(dAtA[i:], m.LossLogic)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.LossLogic)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveDelegateStake(ctx, in)
 	}
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/RemoveDelegateStake",
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveDelegateStake(ctx, req.(*MsgRemoveDelegateStake))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateNewTopicResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CancelRemoveDelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRemoveDelegateStake)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).CancelRemoveDelegateStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/CancelRemoveDelegateStake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelRemoveDelegateStake(ctx, req.(*MsgCancelRemoveDelegateStake))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateNewTopicResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_FundTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundTopic)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/FundTopic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundTopic(ctx, req.(*MsgFundTopic))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateNewTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
+func _Msg_AddToWhitelistAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddToWhitelistAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).AddToWhitelistAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/AddToWhitelistAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddToWhitelistAdmin(ctx, req.(*MsgAddToWhitelistAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInsertBulkReputerPayload) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RemoveFromWhitelistAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveFromWhitelistAdmin)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveFromWhitelistAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/RemoveFromWhitelistAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveFromWhitelistAdmin(ctx, req.(*MsgRemoveFromWhitelistAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInsertBulkReputerPayload) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_GrantRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/GrantRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantRole(ctx, req.(*MsgGrantRole))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInsertBulkReputerPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ReputerValueBundles) > 0 {
-		for iNdEx := len(m.ReputerValueBundles) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ReputerValueBundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+func _Msg_RevokeRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeRole)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeRole(ctx, in)
 	}
-	if m.ReputerRequestNonce != nil {
-		{
-			size, err := m.ReputerRequestNonce.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/RevokeRole",
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeRole(ctx, req.(*MsgRevokeRole))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInsertBulkReputerPayloadResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdateTopicAllowlistMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTopicAllowlistMode)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTopicAllowlistMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/UpdateTopicAllowlistMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTopicAllowlistMode(ctx, req.(*MsgUpdateTopicAllowlistMode))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInsertBulkReputerPayloadResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_AddToTopicAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddToTopicAllowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddToTopicAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/AddToTopicAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddToTopicAllowlist(ctx, req.(*MsgAddToTopicAllowlist))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInsertBulkReputerPayloadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_RemoveFromTopicAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveFromTopicAllowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveFromTopicAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/RemoveFromTopicAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveFromTopicAllowlist(ctx, req.(*MsgRemoveFromTopicAllowlist))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInsertBulkWorkerPayload) Marshal() (dAtA []byte, err error) {
+func _Msg_UpdateTopicInactivityThresholds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTopicInactivityThresholds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTopicInactivityThresholds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/UpdateTopicInactivityThresholds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTopicInactivityThresholds(ctx, req.(*MsgUpdateTopicInactivityThresholds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateTopicLossAggregator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTopicLossAggregator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTopicLossAggregator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emissions.v1.Msg/UpdateTopicLossAggregator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTopicLossAggregator(ctx, req.(*MsgUpdateTopicLossAggregator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "emissions.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "InsertBulkWorkerPayload",
+			Handler:    _Msg_InsertBulkWorkerPayload_Handler,
+		},
+		{
+			MethodName: "CreateNewTopic",
+			Handler:    _Msg_CreateNewTopic_Handler,
+		},
+		{
+			MethodName: "Register",
+			Handler:    _Msg_Register_Handler,
+		},
+		{
+			MethodName: "RemoveRegistration",
+			Handler:    _Msg_RemoveRegistration_Handler,
+		},
+		{
+			MethodName: "RegisterMulti",
+			Handler:    _Msg_RegisterMulti_Handler,
+		},
+		{
+			MethodName: "UpdateNodeInfo",
+			Handler:    _Msg_UpdateNodeInfo_Handler,
+		},
+		{
+			MethodName: "InsertBulkReputerPayload",
+			Handler:    _Msg_InsertBulkReputerPayload_Handler,
+		},
+		{
+			MethodName: "AddStake",
+			Handler:    _Msg_AddStake_Handler,
+		},
+		{
+			MethodName: "RemoveStake",
+			Handler:    _Msg_RemoveStake_Handler,
+		},
+		{
+			MethodName: "CancelRemoveStake",
+			Handler:    _Msg_CancelRemoveStake_Handler,
+		},
+		{
+			MethodName: "DelegateStake",
+			Handler:    _Msg_DelegateStake_Handler,
+		},
+		{
+			MethodName: "RewardDelegateStake",
+			Handler:    _Msg_RewardDelegateStake_Handler,
+		},
+		{
+			MethodName: "RemoveDelegateStake",
+			Handler:    _Msg_RemoveDelegateStake_Handler,
+		},
+		{
+			MethodName: "CancelRemoveDelegateStake",
+			Handler:    _Msg_CancelRemoveDelegateStake_Handler,
+		},
+		{
+			MethodName: "FundTopic",
+			Handler:    _Msg_FundTopic_Handler,
+		},
+		{
+			MethodName: "AddToWhitelistAdmin",
+			Handler:    _Msg_AddToWhitelistAdmin_Handler,
+		},
+		{
+			MethodName: "RemoveFromWhitelistAdmin",
+			Handler:    _Msg_RemoveFromWhitelistAdmin_Handler,
+		},
+		{
+			MethodName: "GrantRole",
+			Handler:    _Msg_GrantRole_Handler,
+		},
+		{
+			MethodName: "RevokeRole",
+			Handler:    _Msg_RevokeRole_Handler,
+		},
+		{
+			MethodName: "UpdateTopicAllowlistMode",
+			Handler:    _Msg_UpdateTopicAllowlistMode_Handler,
+		},
+		{
+			MethodName: "AddToTopicAllowlist",
+			Handler:    _Msg_AddToTopicAllowlist_Handler,
+		},
+		{
+			MethodName: "RemoveFromTopicAllowlist",
+			Handler:    _Msg_RemoveFromTopicAllowlist_Handler,
+		},
+		{
+			MethodName: "UpdateTopicInactivityThresholds",
+			Handler:    _Msg_UpdateTopicInactivityThresholds_Handler,
+		},
+		{
+			MethodName: "UpdateTopicLossAggregator",
+			Handler:    _Msg_UpdateTopicLossAggregator_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "emissions/v1/tx.proto",
+}
+
+func (m *OptionalParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3734,688 +4240,900 @@ func (m *MsgInsertBulkWorkerPayload) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgInsertBulkWorkerPayload) MarshalTo(dAtA []byte) (int, error) {
+func (m *OptionalParams) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgInsertBulkWorkerPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OptionalParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.WorkerDataBundles) > 0 {
-		for iNdEx := len(m.WorkerDataBundles) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.BlockTimeSmoothingDegree) > 0 {
+		for iNdEx := len(m.BlockTimeSmoothingDegree) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.WorkerDataBundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
+				size := m.BlockTimeSmoothingDegree[iNdEx].Size()
+				i -= size
+				if _, err := m.BlockTimeSmoothingDegree[iNdEx].MarshalTo(dAtA[i:]); err != nil {
 					return 0, err
 				}
-				i -= size
 				i = encodeVarintTx(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xa2
 		}
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x18
+	if len(m.MaxBlocksPerMonthChange) > 0 {
+		for iNdEx := len(m.MaxBlocksPerMonthChange) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.MaxBlocksPerMonthChange[iNdEx].Size()
+				i -= size
+				if _, err := m.MaxBlocksPerMonthChange[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x9a
+		}
 	}
-	if m.Nonce != nil {
-		{
-			size, err := m.Nonce.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.MaxBlocksPerMonth) > 0 {
+		dAtA2 := make([]byte, len(m.MaxBlocksPerMonth)*10)
+		var j1 int
+		for _, num := range m.MaxBlocksPerMonth {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
 			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+			dAtA2[j1] = uint8(num)
+			j1++
 		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTx(dAtA, i, uint64(j1))
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		dAtA[i] = 0x3
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x92
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgInsertBulkWorkerPayloadResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgInsertBulkWorkerPayloadResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgInsertBulkWorkerPayloadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgRegister) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRegister) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRegister) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsReputer {
-		i--
-		if m.IsReputer {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.MinBlocksPerMonth) > 0 {
+		dAtA4 := make([]byte, len(m.MinBlocksPerMonth)*10)
+		var j3 int
+		for _, num := range m.MinBlocksPerMonth {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
 		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintTx(dAtA, i, uint64(j3))
 		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		dAtA[i] = 0x3
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x8a
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+	if len(m.BlocksPerMonthCalibrationEnabled) > 0 {
+		for iNdEx := len(m.BlocksPerMonthCalibrationEnabled) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if m.BlocksPerMonthCalibrationEnabled[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BlocksPerMonthCalibrationEnabled)))
 		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.MultiAddress) > 0 {
-		i -= len(m.MultiAddress)
-		copy(dAtA[i:], m.MultiAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MultiAddress)))
+		dAtA[i] = 0x3
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x82
 	}
-	if len(m.LibP2PKey) > 0 {
-		i -= len(m.LibP2PKey)
-		copy(dAtA[i:], m.LibP2PKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.LibP2PKey)))
+	if len(m.ActorHistoryRetention) > 0 {
+		dAtA6 := make([]byte, len(m.ActorHistoryRetention)*10)
+		var j5 int
+		for _, num1 := range m.ActorHistoryRetention {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintTx(dAtA, i, uint64(j5))
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		dAtA[i] = 0x2
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgRegisterResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		dAtA[i] = 0xfa
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRegisterResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRegisterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Message)))
+	if len(m.MaxTopicHorizons) > 0 {
+		dAtA8 := make([]byte, len(m.MaxTopicHorizons)*10)
+		var j7 int
+		for _, num := range m.MaxTopicHorizons {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintTx(dAtA, i, uint64(j7))
 		i--
-		dAtA[i] = 0x12
-	}
-	if m.Success {
+		dAtA[i] = 0x2
 		i--
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		dAtA[i] = 0xf2
+	}
+	if len(m.MaxTopicOutputDimension) > 0 {
+		dAtA10 := make([]byte, len(m.MaxTopicOutputDimension)*10)
+		var j9 int
+		for _, num := range m.MaxTopicOutputDimension {
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
 		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintTx(dAtA, i, uint64(j9))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xea
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgRemoveRegistration) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.MultiRegistrationFeeDiscount) > 0 {
+		for iNdEx := len(m.MultiRegistrationFeeDiscount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.MultiRegistrationFeeDiscount[iNdEx].Size()
+				i -= size
+				if _, err := m.MultiRegistrationFeeDiscount[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xe2
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRemoveRegistration) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRemoveRegistration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsReputer {
-		i--
-		if m.IsReputer {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.PermissionedTopicCreation) > 0 {
+		for iNdEx := len(m.PermissionedTopicCreation) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if m.PermissionedTopicCreation[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
 		}
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PermissionedTopicCreation)))
 		i--
-		dAtA[i] = 0x18
-	}
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		dAtA[i] = 0x2
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0xda
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+	if len(m.HalfMaxProcessStakeRemovalsEndBlock) > 0 {
+		dAtA12 := make([]byte, len(m.HalfMaxProcessStakeRemovalsEndBlock)*10)
+		var j11 int
+		for _, num := range m.HalfMaxProcessStakeRemovalsEndBlock {
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
+		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintTx(dAtA, i, uint64(j11))
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgRemoveRegistrationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd2
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRemoveRegistrationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRemoveRegistrationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Message)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Success {
-		i--
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.MinEffectiveTopicRevenue) > 0 {
+		for iNdEx := len(m.MinEffectiveTopicRevenue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.MinEffectiveTopicRevenue[iNdEx].Size()
+				i -= size
+				if _, err := m.MinEffectiveTopicRevenue[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xca
 		}
-		i--
-		dAtA[i] = 0x8
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgAddStake) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.EpsilonReputer) > 0 {
+		for iNdEx := len(m.EpsilonReputer) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.EpsilonReputer[iNdEx].Size()
+				i -= size
+				if _, err := m.EpsilonReputer[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xc2
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgAddStake) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgAddStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.TopicFeeRevenueDecayRate) > 0 {
+		for iNdEx := len(m.TopicFeeRevenueDecayRate) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.TopicFeeRevenueDecayRate[iNdEx].Size()
+				i -= size
+				if _, err := m.TopicFeeRevenueDecayRate[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xba
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
+	if len(m.CNorm) > 0 {
+		for iNdEx := len(m.CNorm) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.CNorm[iNdEx].Size()
+				i -= size
+				if _, err := m.CNorm[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xb2
+		}
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.CRewardForecast) > 0 {
+		for iNdEx := len(m.CRewardForecast) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.CRewardForecast[iNdEx].Size()
+				i -= size
+				if _, err := m.CRewardForecast[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xaa
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgAddStakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.CRewardInference) > 0 {
+		for iNdEx := len(m.CRewardInference) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.CRewardInference[iNdEx].Size()
+				i -= size
+				if _, err := m.CRewardInference[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xa2
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgAddStakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgAddStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgRemoveStake) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.PRewardReputer) > 0 {
+		for iNdEx := len(m.PRewardReputer) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.PRewardReputer[iNdEx].Size()
+				i -= size
+				if _, err := m.PRewardReputer[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x9a
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRemoveStake) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRemoveStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.PRewardForecast) > 0 {
+		for iNdEx := len(m.PRewardForecast) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.PRewardForecast[iNdEx].Size()
+				i -= size
+				if _, err := m.PRewardForecast[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x92
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
+	if len(m.PRewardInference) > 0 {
+		for iNdEx := len(m.PRewardInference) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.PRewardInference[iNdEx].Size()
+				i -= size
+				if _, err := m.PRewardInference[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x8a
+		}
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+	if len(m.BlocksPerMonth) > 0 {
+		dAtA14 := make([]byte, len(m.BlocksPerMonth)*10)
+		var j13 int
+		for _, num := range m.BlocksPerMonth {
+			for num >= 1<<7 {
+				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j13++
+			}
+			dAtA14[j13] = uint8(num)
+			j13++
+		}
+		i -= j13
+		copy(dAtA[i:], dAtA14[:j13])
+		i = encodeVarintTx(dAtA, i, uint64(j13))
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgRemoveStakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRemoveStakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRemoveStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgCancelRemoveStake) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCancelRemoveStake) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgCancelRemoveStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		dAtA[i] = 0x2
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x82
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+	if len(m.MinEpochLengthRecordLimit) > 0 {
+		dAtA16 := make([]byte, len(m.MinEpochLengthRecordLimit)*10)
+		var j15 int
+		for _, num1 := range m.MinEpochLengthRecordLimit {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j15++
+			}
+			dAtA16[j15] = uint8(num)
+			j15++
+		}
+		i -= j15
+		copy(dAtA[i:], dAtA16[:j15])
+		i = encodeVarintTx(dAtA, i, uint64(j15))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgCancelRemoveStakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.MaxPageLimit) > 0 {
+		dAtA18 := make([]byte, len(m.MaxPageLimit)*10)
+		var j17 int
+		for _, num := range m.MaxPageLimit {
+			for num >= 1<<7 {
+				dAtA18[j17] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j17++
+			}
+			dAtA18[j17] = uint8(num)
+			j17++
+		}
+		i -= j17
+		copy(dAtA[i:], dAtA18[:j17])
+		i = encodeVarintTx(dAtA, i, uint64(j17))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCancelRemoveStakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgCancelRemoveStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgDelegateStake) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.DefaultPageLimit) > 0 {
+		dAtA20 := make([]byte, len(m.DefaultPageLimit)*10)
+		var j19 int
+		for _, num := range m.DefaultPageLimit {
+			for num >= 1<<7 {
+				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j19++
+			}
+			dAtA20[j19] = uint8(num)
+			j19++
+		}
+		i -= j19
+		copy(dAtA[i:], dAtA20[:j19])
+		i = encodeVarintTx(dAtA, i, uint64(j19))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgDelegateStake) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgDelegateStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.RegistrationFee) > 0 {
+		for iNdEx := len(m.RegistrationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.RegistrationFee[iNdEx].Size()
+				i -= size
+				if _, err := m.RegistrationFee[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Reputer) > 0 {
-		i -= len(m.Reputer)
-		copy(dAtA[i:], m.Reputer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reputer)))
+	if len(m.MaxRetriesToFulfilNoncesReputer) > 0 {
+		dAtA22 := make([]byte, len(m.MaxRetriesToFulfilNoncesReputer)*10)
+		var j21 int
+		for _, num1 := range m.MaxRetriesToFulfilNoncesReputer {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA22[j21] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j21++
+			}
+			dAtA22[j21] = uint8(num)
+			j21++
+		}
+		i -= j21
+		copy(dAtA[i:], dAtA22[:j21])
+		i = encodeVarintTx(dAtA, i, uint64(j21))
 		i--
-		dAtA[i] = 0x1a
-	}
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0xda
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+	if len(m.MaxRetriesToFulfilNoncesWorker) > 0 {
+		dAtA24 := make([]byte, len(m.MaxRetriesToFulfilNoncesWorker)*10)
+		var j23 int
+		for _, num1 := range m.MaxRetriesToFulfilNoncesWorker {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA24[j23] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j23++
+			}
+			dAtA24[j23] = uint8(num)
+			j23++
+		}
+		i -= j23
+		copy(dAtA[i:], dAtA24[:j23])
+		i = encodeVarintTx(dAtA, i, uint64(j23))
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgDelegateStakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgDelegateStakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgDelegateStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgRemoveDelegateStake) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.GradientDescentMaxIters) > 0 {
+		dAtA26 := make([]byte, len(m.GradientDescentMaxIters)*10)
+		var j25 int
+		for _, num := range m.GradientDescentMaxIters {
+			for num >= 1<<7 {
+				dAtA26[j25] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j25++
+			}
+			dAtA26[j25] = uint8(num)
+			j25++
+		}
+		i -= j25
+		copy(dAtA[i:], dAtA26[:j25])
+		i = encodeVarintTx(dAtA, i, uint64(j25))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRemoveDelegateStake) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRemoveDelegateStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.CreateTopicFee) > 0 {
+		for iNdEx := len(m.CreateTopicFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.CreateTopicFee[iNdEx].Size()
+				i -= size
+				if _, err := m.CreateTopicFee[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x22
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+	if len(m.MaxTopReputersToReward) > 0 {
+		dAtA28 := make([]byte, len(m.MaxTopReputersToReward)*10)
+		var j27 int
+		for _, num := range m.MaxTopReputersToReward {
+			for num >= 1<<7 {
+				dAtA28[j27] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j27++
+			}
+			dAtA28[j27] = uint8(num)
+			j27++
+		}
+		i -= j27
+		copy(dAtA[i:], dAtA28[:j27])
+		i = encodeVarintTx(dAtA, i, uint64(j27))
 		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Reputer) > 0 {
-		i -= len(m.Reputer)
-		copy(dAtA[i:], m.Reputer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reputer)))
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0xba
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+	if len(m.MaxTopForecastersToReward) > 0 {
+		dAtA30 := make([]byte, len(m.MaxTopForecastersToReward)*10)
+		var j29 int
+		for _, num := range m.MaxTopForecastersToReward {
+			for num >= 1<<7 {
+				dAtA30[j29] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j29++
+			}
+			dAtA30[j29] = uint8(num)
+			j29++
+		}
+		i -= j29
+		copy(dAtA[i:], dAtA30[:j29])
+		i = encodeVarintTx(dAtA, i, uint64(j29))
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgRemoveDelegateStakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRemoveDelegateStakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRemoveDelegateStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgCancelRemoveDelegateStake) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCancelRemoveDelegateStake) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgCancelRemoveDelegateStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reputer) > 0 {
-		i -= len(m.Reputer)
-		copy(dAtA[i:], m.Reputer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reputer)))
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0xb2
 	}
-	if len(m.Delegator) > 0 {
-		i -= len(m.Delegator)
-		copy(dAtA[i:], m.Delegator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+	if len(m.MaxTopInferersToReward) > 0 {
+		dAtA32 := make([]byte, len(m.MaxTopInferersToReward)*10)
+		var j31 int
+		for _, num := range m.MaxTopInferersToReward {
+			for num >= 1<<7 {
+				dAtA32[j31] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j31++
+			}
+			dAtA32[j31] = uint8(num)
+			j31++
+		}
+		i -= j31
+		copy(dAtA[i:], dAtA32[:j31])
+		i = encodeVarintTx(dAtA, i, uint64(j31))
 		i--
-		dAtA[i] = 0x1a
-	}
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0xaa
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+	if len(m.MaxSamplesToScaleScores) > 0 {
+		dAtA34 := make([]byte, len(m.MaxSamplesToScaleScores)*10)
+		var j33 int
+		for _, num := range m.MaxSamplesToScaleScores {
+			for num >= 1<<7 {
+				dAtA34[j33] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j33++
+			}
+			dAtA34[j33] = uint8(num)
+			j33++
+		}
+		i -= j33
+		copy(dAtA[i:], dAtA34[:j33])
+		i = encodeVarintTx(dAtA, i, uint64(j33))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgCancelRemoveDelegateStakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.ValidatorsVsAlloraPercentReward) > 0 {
+		for iNdEx := len(m.ValidatorsVsAlloraPercentReward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.ValidatorsVsAlloraPercentReward[iNdEx].Size()
+				i -= size
+				if _, err := m.ValidatorsVsAlloraPercentReward[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCancelRemoveDelegateStakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgCancelRemoveDelegateStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if len(m.TaskRewardAlpha) > 0 {
+		for iNdEx := len(m.TaskRewardAlpha) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.TaskRewardAlpha[iNdEx].Size()
+				i -= size
+				if _, err := m.TaskRewardAlpha[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.TopicRewardAlpha) > 0 {
+		for iNdEx := len(m.TopicRewardAlpha) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.TopicRewardAlpha[iNdEx].Size()
+				i -= size
+				if _, err := m.TopicRewardAlpha[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.TopicRewardFeeRevenueImportance) > 0 {
+		for iNdEx := len(m.TopicRewardFeeRevenueImportance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.TopicRewardFeeRevenueImportance[iNdEx].Size()
+				i -= size
+				if _, err := m.TopicRewardFeeRevenueImportance[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.TopicRewardStakeImportance) > 0 {
+		for iNdEx := len(m.TopicRewardStakeImportance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.TopicRewardStakeImportance[iNdEx].Size()
+				i -= size
+				if _, err := m.TopicRewardStakeImportance[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.MaxUnfulfilledReputerRequests) > 0 {
+		dAtA36 := make([]byte, len(m.MaxUnfulfilledReputerRequests)*10)
+		var j35 int
+		for _, num := range m.MaxUnfulfilledReputerRequests {
+			for num >= 1<<7 {
+				dAtA36[j35] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j35++
+			}
+			dAtA36[j35] = uint8(num)
+			j35++
+		}
+		i -= j35
+		copy(dAtA[i:], dAtA36[:j35])
+		i = encodeVarintTx(dAtA, i, uint64(j35))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.MaxUnfulfilledWorkerRequests) > 0 {
+		dAtA38 := make([]byte, len(m.MaxUnfulfilledWorkerRequests)*10)
+		var j37 int
+		for _, num := range m.MaxUnfulfilledWorkerRequests {
+			for num >= 1<<7 {
+				dAtA38[j37] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j37++
+			}
+			dAtA38[j37] = uint8(num)
+			j37++
+		}
+		i -= j37
+		copy(dAtA[i:], dAtA38[:j37])
+		i = encodeVarintTx(dAtA, i, uint64(j37))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.MinStakeFraction) > 0 {
+		for iNdEx := len(m.MinStakeFraction) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.MinStakeFraction[iNdEx].Size()
+				i -= size
+				if _, err := m.MinStakeFraction[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.MaxGradientThreshold) > 0 {
+		for iNdEx := len(m.MaxGradientThreshold) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.MaxGradientThreshold[iNdEx].Size()
+				i -= size
+				if _, err := m.MaxGradientThreshold[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.LearningRate) > 0 {
+		for iNdEx := len(m.LearningRate) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.LearningRate[iNdEx].Size()
+				i -= size
+				if _, err := m.LearningRate[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.BetaEntropy) > 0 {
+		for iNdEx := len(m.BetaEntropy) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.BetaEntropy[iNdEx].Size()
+				i -= size
+				if _, err := m.BetaEntropy[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.MinEpochLength) > 0 {
+		dAtA40 := make([]byte, len(m.MinEpochLength)*10)
+		var j39 int
+		for _, num1 := range m.MinEpochLength {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA40[j39] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j39++
+			}
+			dAtA40[j39] = uint8(num)
+			j39++
+		}
+		i -= j39
+		copy(dAtA[i:], dAtA40[:j39])
+		i = encodeVarintTx(dAtA, i, uint64(j39))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.RemoveStakeDelayWindow) > 0 {
+		dAtA42 := make([]byte, len(m.RemoveStakeDelayWindow)*10)
+		var j41 int
+		for _, num1 := range m.RemoveStakeDelayWindow {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA42[j41] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j41++
+			}
+			dAtA42[j41] = uint8(num)
+			j41++
+		}
+		i -= j41
+		copy(dAtA[i:], dAtA42[:j41])
+		i = encodeVarintTx(dAtA, i, uint64(j41))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RequiredMinimumStake) > 0 {
+		for iNdEx := len(m.RequiredMinimumStake) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.RequiredMinimumStake[iNdEx].Size()
+				i -= size
+				if _, err := m.RequiredMinimumStake[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.MaxTopicsPerBlock) > 0 {
+		dAtA44 := make([]byte, len(m.MaxTopicsPerBlock)*10)
+		var j43 int
+		for _, num := range m.MaxTopicsPerBlock {
+			for num >= 1<<7 {
+				dAtA44[j43] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j43++
+			}
+			dAtA44[j43] = uint8(num)
+			j43++
+		}
+		i -= j43
+		copy(dAtA[i:], dAtA44[:j43])
+		i = encodeVarintTx(dAtA, i, uint64(j43))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MinTopicWeight) > 0 {
+		for iNdEx := len(m.MinTopicWeight) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.MinTopicWeight[iNdEx].Size()
+				i -= size
+				if _, err := m.MinTopicWeight[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MaxSerializedMsgLength) > 0 {
+		dAtA46 := make([]byte, len(m.MaxSerializedMsgLength)*10)
+		var j45 int
+		for _, num1 := range m.MaxSerializedMsgLength {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA46[j45] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j45++
+			}
+			dAtA46[j45] = uint8(num)
+			j45++
+		}
+		i -= j45
+		copy(dAtA[i:], dAtA46[:j45])
+		i = encodeVarintTx(dAtA, i, uint64(j45))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Version) > 0 {
+		for iNdEx := len(m.Version) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Version[iNdEx])
+			copy(dAtA[i:], m.Version[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Version[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgFundTopic) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4425,30 +5143,27 @@ func (m *MsgFundTopic) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgFundTopic) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgFundTopic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.TopicId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
@@ -4460,7 +5175,7 @@ func (m *MsgFundTopic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgFundTopicResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4470,12 +5185,12 @@ func (m *MsgFundTopicResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgFundTopicResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgFundTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4483,7 +5198,7 @@ func (m *MsgFundTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddToWhitelistAdmin) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateNewTopic) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4493,34 +5208,251 @@ func (m *MsgAddToWhitelistAdmin) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAddToWhitelistAdmin) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateNewTopic) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddToWhitelistAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateNewTopic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+	if m.HuberLossMaxIterations != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.HuberLossMaxIterations))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	{
+		size := m.HuberLossTuningConstant.Size()
+		i -= size
+		if _, err := m.HuberLossTuningConstant.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xd2
+	{
+		size := m.LossTrimFraction.Size()
+		i -= size
+		if _, err := m.LossTrimFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xca
+	{
+		size := m.TrimmedMeanTrimFraction.Size()
+		i -= size
+		if _, err := m.TrimmedMeanTrimFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xc2
+	if m.LossAggregator != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LossAggregator))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if len(m.HorizonWeights) > 0 {
+		for iNdEx := len(m.HorizonWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.HorizonWeights[iNdEx].Size()
+				i -= size
+				if _, err := m.HorizonWeights[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.Horizons) > 0 {
+		dAtA49 := make([]byte, len(m.Horizons)*10)
+		var j48 int
+		for _, num1 := range m.Horizons {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA49[j48] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j48++
+			}
+			dAtA49[j48] = uint8(num)
+			j48++
+		}
+		i -= j48
+		copy(dAtA[i:], dAtA49[:j48])
+		i = encodeVarintTx(dAtA, i, uint64(j48))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.DistributionPooling != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DistributionPooling))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.OutputType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OutputType))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.OutputDimension != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OutputDimension))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.Combiner != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Combiner))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.ReputerInactivityThreshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ReputerInactivityThreshold))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.WorkerInactivityThreshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.WorkerInactivityThreshold))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.AllowlistMode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AllowlistMode))
+		i--
+		dAtA[i] = 0x70
+	}
+	{
+		size := m.Epsilon.Size()
+		i -= size
+		if _, err := m.Epsilon.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if m.AllowNegative {
+		i--
+		if m.AllowNegative {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size := m.AlphaRegret.Size()
+		i -= size
+		if _, err := m.AlphaRegret.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.PNorm.Size()
+		i -= size
+		if _, err := m.PNorm.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.DefaultArg) > 0 {
+		i -= len(m.DefaultArg)
+		copy(dAtA[i:], m.DefaultArg)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DefaultArg)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.GroundTruthLag != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GroundTruthLag))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.EpochLength != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.InferenceMethod) > 0 {
+		i -= len(m.InferenceMethod)
+		copy(dAtA[i:], m.InferenceMethod)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceMethod)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.InferenceLogic) > 0 {
+		i -= len(m.InferenceLogic)
+		copy(dAtA[i:], m.InferenceLogic)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceLogic)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.LossMethod) > 0 {
+		i -= len(m.LossMethod)
+		copy(dAtA[i:], m.LossMethod)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LossMethod)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.LossLogic) > 0 {
+		i -= len(m.LossLogic)
+		copy(dAtA[i:], m.LossLogic)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LossLogic)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddToWhitelistAdminResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateNewTopicResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4530,20 +5462,25 @@ func (m *MsgAddToWhitelistAdminResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAddToWhitelistAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateNewTopicResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddToWhitelistAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateNewTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveFromWhitelistAdmin) Marshal() (dAtA []byte, err error) {
+func (m *MsgInsertBulkReputerPayload) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4553,20 +5490,44 @@ func (m *MsgRemoveFromWhitelistAdmin) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRemoveFromWhitelistAdmin) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgInsertBulkReputerPayload) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRemoveFromWhitelistAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgInsertBulkReputerPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+	if len(m.ReputerValueBundles) > 0 {
+		for iNdEx := len(m.ReputerValueBundles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReputerValueBundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ReputerRequestNonce != nil {
+		{
+			size, err := m.ReputerRequestNonce.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x12
 	}
@@ -4580,7 +5541,7 @@ func (m *MsgRemoveFromWhitelistAdmin) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveFromWhitelistAdminResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgInsertBulkReputerPayloadResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4590,12 +5551,12 @@ func (m *MsgRemoveFromWhitelistAdminResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgRemoveFromWhitelistAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgInsertBulkReputerPayloadResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRemoveFromWhitelistAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgInsertBulkReputerPayloadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4603,7 +5564,7 @@ func (m *MsgRemoveFromWhitelistAdminResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRewardDelegateStakeResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgInsertBulkWorkerPayload) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4613,50 +5574,135 @@ func (m *MsgRewardDelegateStakeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRewardDelegateStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgInsertBulkWorkerPayload) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRewardDelegateStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgInsertBulkWorkerPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgRewardDelegateStake) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+	if len(m.WorkerDataBundles) > 0 {
+		for iNdEx := len(m.WorkerDataBundles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WorkerDataBundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Nonce != nil {
+		{
+			size, err := m.Nonce.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
 
-func (m *MsgRewardDelegateStake) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgInsertBulkWorkerPayloadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInsertBulkWorkerPayloadResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRewardDelegateStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgInsertBulkWorkerPayloadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Reputer) > 0 {
-		i -= len(m.Reputer)
-		copy(dAtA[i:], m.Reputer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reputer)))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegister) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegister) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegister) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsReputer {
 		i--
-		dAtA[i] = 0x1a
+		if m.IsReputer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x2a
 	}
 	if m.TopicId != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x20
+	}
+	if len(m.MultiAddress) > 0 {
+		i -= len(m.MultiAddress)
+		copy(dAtA[i:], m.MultiAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MultiAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.LibP2PKey) > 0 {
+		i -= len(m.LibP2PKey)
+		copy(dAtA[i:], m.LibP2PKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LibP2PKey)))
+		i--
+		dAtA[i] = 0x12
 	}
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
@@ -4668,821 +5714,5813 @@ func (m *MsgRewardDelegateStake) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgRegisterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *OptionalParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgRegisterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Version) > 0 {
-		for _, s := range m.Version {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.MaxSerializedMsgLength) > 0 {
-		l = 0
-		for _, e := range m.MaxSerializedMsgLength {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
-	}
-	if len(m.MinTopicWeight) > 0 {
-		for _, e := range m.MinTopicWeight {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.MaxTopicsPerBlock) > 0 {
-		l = 0
-		for _, e := range m.MaxTopicsPerBlock {
-			l += sovTx(uint64(e))
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		n += 1 + sovTx(uint64(l)) + l
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.RequiredMinimumStake) > 0 {
-		for _, e := range m.RequiredMinimumStake {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveRegistration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.RemoveStakeDelayWindow) > 0 {
-		l = 0
-		for _, e := range m.RemoveStakeDelayWindow {
-			l += sovTx(uint64(e))
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveRegistration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRegistration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsReputer {
+		i--
+		if m.IsReputer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		n += 1 + sovTx(uint64(l)) + l
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.MinEpochLength) > 0 {
-		l = 0
-		for _, e := range m.MinEpochLength {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.BetaEntropy) > 0 {
-		for _, e := range m.BetaEntropy {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.LearningRate) > 0 {
-		for _, e := range m.LearningRate {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveRegistrationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.MaxGradientThreshold) > 0 {
-		for _, e := range m.MaxGradientThreshold {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveRegistrationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRegistrationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.MinStakeFraction) > 0 {
-		for _, e := range m.MinStakeFraction {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.MaxUnfulfilledWorkerRequests) > 0 {
-		l = 0
-		for _, e := range m.MaxUnfulfilledWorkerRequests {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterMulti) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.MaxUnfulfilledReputerRequests) > 0 {
-		l = 0
-		for _, e := range m.MaxUnfulfilledReputerRequests {
-			l += sovTx(uint64(e))
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterMulti) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterMulti) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsReputer {
+		i--
+		if m.IsReputer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		n += 1 + sovTx(uint64(l)) + l
+		i--
+		dAtA[i] = 0x38
 	}
-	if len(m.TopicRewardStakeImportance) > 0 {
-		for _, e := range m.TopicRewardStakeImportance {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if len(m.TopicRewardFeeRevenueImportance) > 0 {
-		for _, e := range m.TopicRewardFeeRevenueImportance {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
+	if len(m.RemoveTopicIds) > 0 {
+		dAtA53 := make([]byte, len(m.RemoveTopicIds)*10)
+		var j52 int
+		for _, num := range m.RemoveTopicIds {
+			for num >= 1<<7 {
+				dAtA53[j52] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j52++
+			}
+			dAtA53[j52] = uint8(num)
+			j52++
 		}
+		i -= j52
+		copy(dAtA[i:], dAtA53[:j52])
+		i = encodeVarintTx(dAtA, i, uint64(j52))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if len(m.TopicRewardAlpha) > 0 {
-		for _, e := range m.TopicRewardAlpha {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
+	if len(m.TopicIds) > 0 {
+		dAtA55 := make([]byte, len(m.TopicIds)*10)
+		var j54 int
+		for _, num := range m.TopicIds {
+			for num >= 1<<7 {
+				dAtA55[j54] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j54++
+			}
+			dAtA55[j54] = uint8(num)
+			j54++
 		}
+		i -= j54
+		copy(dAtA[i:], dAtA55[:j54])
+		i = encodeVarintTx(dAtA, i, uint64(j54))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.TaskRewardAlpha) > 0 {
-		for _, e := range m.TaskRewardAlpha {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
+	if len(m.MultiAddress) > 0 {
+		i -= len(m.MultiAddress)
+		copy(dAtA[i:], m.MultiAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MultiAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.ValidatorsVsAlloraPercentReward) > 0 {
-		for _, e := range m.ValidatorsVsAlloraPercentReward {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
+	if len(m.LibP2PKey) > 0 {
+		i -= len(m.LibP2PKey)
+		copy(dAtA[i:], m.LibP2PKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LibP2PKey)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.MaxSamplesToScaleScores) > 0 {
-		l = 0
-		for _, e := range m.MaxSamplesToScaleScores {
-			l += sovTx(uint64(e))
-		}
-		n += 2 + sovTx(uint64(l)) + l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.MaxTopInferersToReward) > 0 {
-		l = 0
-		for _, e := range m.MaxTopInferersToReward {
-			l += sovTx(uint64(e))
-		}
-		n += 2 + sovTx(uint64(l)) + l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterMultiResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.MaxTopForecastersToReward) > 0 {
-		l = 0
-		for _, e := range m.MaxTopForecastersToReward {
-			l += sovTx(uint64(e))
-		}
-		n += 2 + sovTx(uint64(l)) + l
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterMultiResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterMultiResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.MaxTopReputersToReward) > 0 {
-		l = 0
-		for _, e := range m.MaxTopReputersToReward {
-			l += sovTx(uint64(e))
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		n += 2 + sovTx(uint64(l)) + l
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.CreateTopicFee) > 0 {
-		for _, e := range m.CreateTopicFee {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateNodeInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.GradientDescentMaxIters) > 0 {
-		l = 0
-		for _, e := range m.GradientDescentMaxIters {
-			l += sovTx(uint64(e))
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateNodeInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateNodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsReputer {
+		i--
+		if m.IsReputer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		n += 2 + sovTx(uint64(l)) + l
+		i--
+		dAtA[i] = 0x28
 	}
-	if len(m.MaxRetriesToFulfilNoncesWorker) > 0 {
-		l = 0
-		for _, e := range m.MaxRetriesToFulfilNoncesWorker {
-			l += sovTx(uint64(e))
-		}
-		n += 2 + sovTx(uint64(l)) + l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.MaxRetriesToFulfilNoncesReputer) > 0 {
-		l = 0
-		for _, e := range m.MaxRetriesToFulfilNoncesReputer {
-			l += sovTx(uint64(e))
-		}
-		n += 2 + sovTx(uint64(l)) + l
+	if len(m.MultiAddress) > 0 {
+		i -= len(m.MultiAddress)
+		copy(dAtA[i:], m.MultiAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MultiAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.RegistrationFee) > 0 {
-		for _, e := range m.RegistrationFee {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
+	if len(m.LibP2PKey) > 0 {
+		i -= len(m.LibP2PKey)
+		copy(dAtA[i:], m.LibP2PKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LibP2PKey)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.DefaultPageLimit) > 0 {
-		l = 0
-		for _, e := range m.DefaultPageLimit {
-			l += sovTx(uint64(e))
-		}
-		n += 2 + sovTx(uint64(l)) + l
-	}
-	if len(m.MaxPageLimit) > 0 {
-		l = 0
-		for _, e := range m.MaxPageLimit {
-			l += sovTx(uint64(e))
-		}
-		n += 2 + sovTx(uint64(l)) + l
-	}
-	if len(m.MinEpochLengthRecordLimit) > 0 {
-		l = 0
-		for _, e := range m.MinEpochLengthRecordLimit {
-			l += sovTx(uint64(e))
-		}
-		n += 2 + sovTx(uint64(l)) + l
-	}
-	if len(m.BlocksPerMonth) > 0 {
-		l = 0
-		for _, e := range m.BlocksPerMonth {
-			l += sovTx(uint64(e))
-		}
-		n += 2 + sovTx(uint64(l)) + l
-	}
-	if len(m.PRewardInference) > 0 {
-		for _, e := range m.PRewardInference {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.PRewardForecast) > 0 {
-		for _, e := range m.PRewardForecast {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.PRewardReputer) > 0 {
-		for _, e := range m.PRewardReputer {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.CRewardInference) > 0 {
-		for _, e := range m.CRewardInference {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.CRewardForecast) > 0 {
-		for _, e := range m.CRewardForecast {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.CNorm) > 0 {
-		for _, e := range m.CNorm {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.TopicFeeRevenueDecayRate) > 0 {
-		for _, e := range m.TopicFeeRevenueDecayRate {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.EpsilonReputer) > 0 {
-		for _, e := range m.EpsilonReputer {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.MinEffectiveTopicRevenue) > 0 {
-		for _, e := range m.MinEffectiveTopicRevenue {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.HalfMaxProcessStakeRemovalsEndBlock) > 0 {
-		l = 0
-		for _, e := range m.HalfMaxProcessStakeRemovalsEndBlock {
-			l += sovTx(uint64(e))
-		}
-		n += 2 + sovTx(uint64(l)) + l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateNodeInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateNodeInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateNodeInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCreateNewTopic) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgAddStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.LossLogic)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.LossMethod)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.InferenceLogic)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.InferenceMethod)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.EpochLength != 0 {
-		n += 1 + sovTx(uint64(m.EpochLength))
-	}
-	if m.GroundTruthLag != 0 {
-		n += 1 + sovTx(uint64(m.GroundTruthLag))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.DefaultArg)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.PNorm.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.AlphaRegret.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.AllowNegative {
-		n += 2
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.Epsilon.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateNewTopicResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgInsertBulkReputerPayload) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemoveStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ReputerRequestNonce != nil {
-		l = m.ReputerRequestNonce.Size()
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x1a
 	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.ReputerValueBundles) > 0 {
-		for _, e := range m.ReputerValueBundles {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgInsertBulkReputerPayloadResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemoveStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgInsertBulkWorkerPayload) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelRemoveStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRemoveStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRemoveStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Nonce != nil {
-		l = m.Nonce.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
 	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.WorkerDataBundles) > 0 {
-		for _, e := range m.WorkerDataBundles {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgInsertBulkWorkerPayloadResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelRemoveStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRemoveStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRemoveStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegister) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDelegateStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.LibP2PKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.MultiAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.IsReputer {
-		n += 2
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Success {
-		n += 2
-	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgDelegateStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgRemoveRegistration) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
-	}
-	if m.IsReputer {
-		n += 2
-	}
-	return n
+func (m *MsgDelegateStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRemoveRegistrationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDelegateStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Success {
-		n += 2
-	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddStake) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
+func (m *MsgRemoveDelegateStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAddStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgRemoveDelegateStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRemoveStake) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRemoveDelegateStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x22
 	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemoveDelegateStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCancelRemoveStake) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
-	}
-	return n
+func (m *MsgRemoveDelegateStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCancelRemoveStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRemoveDelegateStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDelegateStake) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelRemoveDelegateStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRemoveDelegateStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRemoveDelegateStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Reputer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDelegateStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelRemoveDelegateStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRemoveDelegateStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRemoveDelegateStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveDelegateStake) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgFundTopic) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundTopic) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundTopic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Reputer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x1a
 	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveDelegateStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgFundTopicResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundTopicResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelRemoveDelegateStake) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddToWhitelistAdmin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddToWhitelistAdmin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddToWhitelistAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
-	}
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Reputer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelRemoveDelegateStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddToWhitelistAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddToWhitelistAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddToWhitelistAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgFundTopic) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemoveFromWhitelistAdmin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveFromWhitelistAdmin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveFromWhitelistAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgFundTopicResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemoveFromWhitelistAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveFromWhitelistAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveFromWhitelistAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddToWhitelistAdmin) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgGrantRole) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantRole) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Role != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddToWhitelistAdminResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgGrantRoleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantRoleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveFromWhitelistAdmin) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRevokeRole) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeRole) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Role != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeRoleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeRoleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTopicAllowlistMode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTopicAllowlistMode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTopicAllowlistMode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AllowlistMode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AllowlistMode))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTopicAllowlistModeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTopicAllowlistModeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTopicAllowlistModeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddToTopicAllowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddToTopicAllowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddToTopicAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsReputer {
+		i--
+		if m.IsReputer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddToTopicAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddToTopicAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddToTopicAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveFromTopicAllowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveFromTopicAllowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveFromTopicAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsReputer {
+		i--
+		if m.IsReputer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveFromTopicAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveFromTopicAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveFromTopicAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRewardDelegateStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRewardDelegateStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRewardDelegateStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRewardDelegateStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRewardDelegateStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRewardDelegateStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTopicInactivityThresholds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTopicInactivityThresholds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTopicInactivityThresholds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReputerInactivityThreshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ReputerInactivityThreshold))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WorkerInactivityThreshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.WorkerInactivityThreshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTopicInactivityThresholdsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTopicInactivityThresholdsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTopicInactivityThresholdsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTopicLossAggregator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTopicLossAggregator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTopicLossAggregator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HuberLossMaxIterations != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.HuberLossMaxIterations))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.HuberLossTuningConstant.Size()
+		i -= size
+		if _, err := m.HuberLossTuningConstant.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.LossTrimFraction.Size()
+		i -= size
+		if _, err := m.LossTrimFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.LossAggregator != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LossAggregator))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTopicLossAggregatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTopicLossAggregatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTopicLossAggregatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *OptionalParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Version) > 0 {
+		for _, s := range m.Version {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MaxSerializedMsgLength) > 0 {
+		l = 0
+		for _, e := range m.MaxSerializedMsgLength {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.MinTopicWeight) > 0 {
+		for _, e := range m.MinTopicWeight {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MaxTopicsPerBlock) > 0 {
+		l = 0
+		for _, e := range m.MaxTopicsPerBlock {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.RequiredMinimumStake) > 0 {
+		for _, e := range m.RequiredMinimumStake {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RemoveStakeDelayWindow) > 0 {
+		l = 0
+		for _, e := range m.RemoveStakeDelayWindow {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.MinEpochLength) > 0 {
+		l = 0
+		for _, e := range m.MinEpochLength {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.BetaEntropy) > 0 {
+		for _, e := range m.BetaEntropy {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.LearningRate) > 0 {
+		for _, e := range m.LearningRate {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MaxGradientThreshold) > 0 {
+		for _, e := range m.MaxGradientThreshold {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MinStakeFraction) > 0 {
+		for _, e := range m.MinStakeFraction {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MaxUnfulfilledWorkerRequests) > 0 {
+		l = 0
+		for _, e := range m.MaxUnfulfilledWorkerRequests {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.MaxUnfulfilledReputerRequests) > 0 {
+		l = 0
+		for _, e := range m.MaxUnfulfilledReputerRequests {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.TopicRewardStakeImportance) > 0 {
+		for _, e := range m.TopicRewardStakeImportance {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.TopicRewardFeeRevenueImportance) > 0 {
+		for _, e := range m.TopicRewardFeeRevenueImportance {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.TopicRewardAlpha) > 0 {
+		for _, e := range m.TopicRewardAlpha {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.TaskRewardAlpha) > 0 {
+		for _, e := range m.TaskRewardAlpha {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ValidatorsVsAlloraPercentReward) > 0 {
+		for _, e := range m.ValidatorsVsAlloraPercentReward {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MaxSamplesToScaleScores) > 0 {
+		l = 0
+		for _, e := range m.MaxSamplesToScaleScores {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.MaxTopInferersToReward) > 0 {
+		l = 0
+		for _, e := range m.MaxTopInferersToReward {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.MaxTopForecastersToReward) > 0 {
+		l = 0
+		for _, e := range m.MaxTopForecastersToReward {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.MaxTopReputersToReward) > 0 {
+		l = 0
+		for _, e := range m.MaxTopReputersToReward {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.CreateTopicFee) > 0 {
+		for _, e := range m.CreateTopicFee {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.GradientDescentMaxIters) > 0 {
+		l = 0
+		for _, e := range m.GradientDescentMaxIters {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.MaxRetriesToFulfilNoncesWorker) > 0 {
+		l = 0
+		for _, e := range m.MaxRetriesToFulfilNoncesWorker {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.MaxRetriesToFulfilNoncesReputer) > 0 {
+		l = 0
+		for _, e := range m.MaxRetriesToFulfilNoncesReputer {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.RegistrationFee) > 0 {
+		for _, e := range m.RegistrationFee {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.DefaultPageLimit) > 0 {
+		l = 0
+		for _, e := range m.DefaultPageLimit {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.MaxPageLimit) > 0 {
+		l = 0
+		for _, e := range m.MaxPageLimit {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.MinEpochLengthRecordLimit) > 0 {
+		l = 0
+		for _, e := range m.MinEpochLengthRecordLimit {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.BlocksPerMonth) > 0 {
+		l = 0
+		for _, e := range m.BlocksPerMonth {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.PRewardInference) > 0 {
+		for _, e := range m.PRewardInference {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.PRewardForecast) > 0 {
+		for _, e := range m.PRewardForecast {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.PRewardReputer) > 0 {
+		for _, e := range m.PRewardReputer {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.CRewardInference) > 0 {
+		for _, e := range m.CRewardInference {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.CRewardForecast) > 0 {
+		for _, e := range m.CRewardForecast {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.CNorm) > 0 {
+		for _, e := range m.CNorm {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.TopicFeeRevenueDecayRate) > 0 {
+		for _, e := range m.TopicFeeRevenueDecayRate {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.EpsilonReputer) > 0 {
+		for _, e := range m.EpsilonReputer {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MinEffectiveTopicRevenue) > 0 {
+		for _, e := range m.MinEffectiveTopicRevenue {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.HalfMaxProcessStakeRemovalsEndBlock) > 0 {
+		l = 0
+		for _, e := range m.HalfMaxProcessStakeRemovalsEndBlock {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.PermissionedTopicCreation) > 0 {
+		n += 2 + sovTx(uint64(len(m.PermissionedTopicCreation))) + len(m.PermissionedTopicCreation)*1
+	}
+	if len(m.MultiRegistrationFeeDiscount) > 0 {
+		for _, e := range m.MultiRegistrationFeeDiscount {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.MaxTopicOutputDimension) > 0 {
+		l = 0
+		for _, e := range m.MaxTopicOutputDimension {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.MaxTopicHorizons) > 0 {
+		l = 0
+		for _, e := range m.MaxTopicHorizons {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.ActorHistoryRetention) > 0 {
+		l = 0
+		for _, e := range m.ActorHistoryRetention {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.BlocksPerMonthCalibrationEnabled) > 0 {
+		n += 2 + sovTx(uint64(len(m.BlocksPerMonthCalibrationEnabled))) + len(m.BlocksPerMonthCalibrationEnabled)*1
+	}
+	if len(m.MinBlocksPerMonth) > 0 {
+		l = 0
+		for _, e := range m.MinBlocksPerMonth {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.MaxBlocksPerMonth) > 0 {
+		l = 0
+		for _, e := range m.MaxBlocksPerMonth {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.MaxBlocksPerMonthChange) > 0 {
+		for _, e := range m.MaxBlocksPerMonthChange {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.BlockTimeSmoothingDegree) > 0 {
+		for _, e := range m.BlockTimeSmoothingDegree {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateNewTopic) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LossLogic)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LossMethod)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.InferenceLogic)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.InferenceMethod)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EpochLength != 0 {
+		n += 1 + sovTx(uint64(m.EpochLength))
+	}
+	if m.GroundTruthLag != 0 {
+		n += 1 + sovTx(uint64(m.GroundTruthLag))
+	}
+	l = len(m.DefaultArg)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.PNorm.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.AlphaRegret.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.AllowNegative {
+		n += 2
+	}
+	l = m.Epsilon.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.AllowlistMode != 0 {
+		n += 1 + sovTx(uint64(m.AllowlistMode))
+	}
+	if m.WorkerInactivityThreshold != 0 {
+		n += 1 + sovTx(uint64(m.WorkerInactivityThreshold))
+	}
+	if m.ReputerInactivityThreshold != 0 {
+		n += 2 + sovTx(uint64(m.ReputerInactivityThreshold))
+	}
+	if m.Combiner != 0 {
+		n += 2 + sovTx(uint64(m.Combiner))
+	}
+	if m.OutputDimension != 0 {
+		n += 2 + sovTx(uint64(m.OutputDimension))
+	}
+	if m.OutputType != 0 {
+		n += 2 + sovTx(uint64(m.OutputType))
+	}
+	if m.DistributionPooling != 0 {
+		n += 2 + sovTx(uint64(m.DistributionPooling))
+	}
+	if len(m.Horizons) > 0 {
+		l = 0
+		for _, e := range m.Horizons {
+			l += sovTx(uint64(e))
+		}
+		n += 2 + sovTx(uint64(l)) + l
+	}
+	if len(m.HorizonWeights) > 0 {
+		for _, e := range m.HorizonWeights {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if m.LossAggregator != 0 {
+		n += 2 + sovTx(uint64(m.LossAggregator))
+	}
+	l = m.TrimmedMeanTrimFraction.Size()
+	n += 2 + l + sovTx(uint64(l))
+	l = m.LossTrimFraction.Size()
+	n += 2 + l + sovTx(uint64(l))
+	l = m.HuberLossTuningConstant.Size()
+	n += 2 + l + sovTx(uint64(l))
+	if m.HuberLossMaxIterations != 0 {
+		n += 2 + sovTx(uint64(m.HuberLossMaxIterations))
+	}
+	return n
+}
+
+func (m *MsgCreateNewTopicResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	return n
+}
+
+func (m *MsgInsertBulkReputerPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ReputerRequestNonce != nil {
+		l = m.ReputerRequestNonce.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	if len(m.ReputerValueBundles) > 0 {
+		for _, e := range m.ReputerValueBundles {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgInsertBulkReputerPayloadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgInsertBulkWorkerPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Nonce != nil {
+		l = m.Nonce.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	if len(m.WorkerDataBundles) > 0 {
+		for _, e := range m.WorkerDataBundles {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgInsertBulkWorkerPayloadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegister) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LibP2PKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MultiAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.IsReputer {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgRegisterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveRegistration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	if m.IsReputer {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgRemoveRegistrationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterMulti) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LibP2PKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MultiAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.TopicIds) > 0 {
+		l = 0
+		for _, e := range m.TopicIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.RemoveTopicIds) > 0 {
+		l = 0
+		for _, e := range m.RemoveTopicIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.IsReputer {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgRegisterMultiResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateNodeInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LibP2PKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MultiAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.IsReputer {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgUpdateNodeInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAddStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRemoveStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelRemoveStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	return n
+}
+
+func (m *MsgCancelRemoveStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDelegateStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDelegateStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveDelegateStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRemoveDelegateStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelRemoveDelegateStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelRemoveDelegateStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFundTopic) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgFundTopicResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddToWhitelistAdmin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddToWhitelistAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveFromWhitelistAdmin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveFromWhitelistAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGrantRole) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovTx(uint64(m.Role))
+	}
+	return n
+}
+
+func (m *MsgGrantRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeRole) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovTx(uint64(m.Role))
+	}
+	return n
+}
+
+func (m *MsgRevokeRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateTopicAllowlistMode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	if m.AllowlistMode != 0 {
+		n += 1 + sovTx(uint64(m.AllowlistMode))
+	}
+	return n
+}
+
+func (m *MsgUpdateTopicAllowlistModeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddToTopicAllowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.IsReputer {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgAddToTopicAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveFromTopicAllowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.IsReputer {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgRemoveFromTopicAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRewardDelegateStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRewardDelegateStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateTopicInactivityThresholds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	if m.WorkerInactivityThreshold != 0 {
+		n += 1 + sovTx(uint64(m.WorkerInactivityThreshold))
+	}
+	if m.ReputerInactivityThreshold != 0 {
+		n += 1 + sovTx(uint64(m.ReputerInactivityThreshold))
+	}
+	return n
+}
+
+func (m *MsgUpdateTopicInactivityThresholdsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateTopicLossAggregator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTx(uint64(m.TopicId))
+	}
+	if m.LossAggregator != 0 {
+		n += 1 + sovTx(uint64(m.LossAggregator))
+	}
+	l = m.LossTrimFraction.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.HuberLossTuningConstant.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.HuberLossMaxIterations != 0 {
+		n += 1 + sovTx(uint64(m.HuberLossMaxIterations))
+	}
+	return n
+}
+
+func (m *MsgUpdateTopicLossAggregatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *OptionalParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OptionalParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OptionalParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = append(m.Version, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxSerializedMsgLength = append(m.MaxSerializedMsgLength, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxSerializedMsgLength) == 0 {
+					m.MaxSerializedMsgLength = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxSerializedMsgLength = append(m.MaxSerializedMsgLength, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSerializedMsgLength", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTopicWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.MinTopicWeight = append(m.MinTopicWeight, v)
+			if err := m.MinTopicWeight[len(m.MinTopicWeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxTopicsPerBlock = append(m.MaxTopicsPerBlock, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxTopicsPerBlock) == 0 {
+					m.MaxTopicsPerBlock = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxTopicsPerBlock = append(m.MaxTopicsPerBlock, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopicsPerBlock", wireType)
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredMinimumStake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.RequiredMinimumStake = append(m.RequiredMinimumStake, v)
+			if err := m.RequiredMinimumStake[len(m.RequiredMinimumStake)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RemoveStakeDelayWindow = append(m.RemoveStakeDelayWindow, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RemoveStakeDelayWindow) == 0 {
+					m.RemoveStakeDelayWindow = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RemoveStakeDelayWindow = append(m.RemoveStakeDelayWindow, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveStakeDelayWindow", wireType)
+			}
+		case 7:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MinEpochLength = append(m.MinEpochLength, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MinEpochLength) == 0 {
+					m.MinEpochLength = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MinEpochLength = append(m.MinEpochLength, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinEpochLength", wireType)
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BetaEntropy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.BetaEntropy = append(m.BetaEntropy, v)
+			if err := m.BetaEntropy[len(m.BetaEntropy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LearningRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.LearningRate = append(m.LearningRate, v)
+			if err := m.LearningRate[len(m.LearningRate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGradientThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.MaxGradientThreshold = append(m.MaxGradientThreshold, v)
+			if err := m.MaxGradientThreshold[len(m.MaxGradientThreshold)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStakeFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.MinStakeFraction = append(m.MinStakeFraction, v)
+			if err := m.MinStakeFraction[len(m.MinStakeFraction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxUnfulfilledWorkerRequests = append(m.MaxUnfulfilledWorkerRequests, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxUnfulfilledWorkerRequests) == 0 {
+					m.MaxUnfulfilledWorkerRequests = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxUnfulfilledWorkerRequests = append(m.MaxUnfulfilledWorkerRequests, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnfulfilledWorkerRequests", wireType)
+			}
+		case 14:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxUnfulfilledReputerRequests = append(m.MaxUnfulfilledReputerRequests, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxUnfulfilledReputerRequests) == 0 {
+					m.MaxUnfulfilledReputerRequests = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxUnfulfilledReputerRequests = append(m.MaxUnfulfilledReputerRequests, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnfulfilledReputerRequests", wireType)
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicRewardStakeImportance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.TopicRewardStakeImportance = append(m.TopicRewardStakeImportance, v)
+			if err := m.TopicRewardStakeImportance[len(m.TopicRewardStakeImportance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicRewardFeeRevenueImportance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.TopicRewardFeeRevenueImportance = append(m.TopicRewardFeeRevenueImportance, v)
+			if err := m.TopicRewardFeeRevenueImportance[len(m.TopicRewardFeeRevenueImportance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicRewardAlpha", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.TopicRewardAlpha = append(m.TopicRewardAlpha, v)
+			if err := m.TopicRewardAlpha[len(m.TopicRewardAlpha)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskRewardAlpha", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.TaskRewardAlpha = append(m.TaskRewardAlpha, v)
+			if err := m.TaskRewardAlpha[len(m.TaskRewardAlpha)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsVsAlloraPercentReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.ValidatorsVsAlloraPercentReward = append(m.ValidatorsVsAlloraPercentReward, v)
+			if err := m.ValidatorsVsAlloraPercentReward[len(m.ValidatorsVsAlloraPercentReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxSamplesToScaleScores = append(m.MaxSamplesToScaleScores, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxSamplesToScaleScores) == 0 {
+					m.MaxSamplesToScaleScores = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxSamplesToScaleScores = append(m.MaxSamplesToScaleScores, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSamplesToScaleScores", wireType)
+			}
+		case 21:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxTopInferersToReward = append(m.MaxTopInferersToReward, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxTopInferersToReward) == 0 {
+					m.MaxTopInferersToReward = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxTopInferersToReward = append(m.MaxTopInferersToReward, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopInferersToReward", wireType)
+			}
+		case 22:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxTopForecastersToReward = append(m.MaxTopForecastersToReward, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxTopForecastersToReward) == 0 {
+					m.MaxTopForecastersToReward = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxTopForecastersToReward = append(m.MaxTopForecastersToReward, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopForecastersToReward", wireType)
+			}
+		case 23:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxTopReputersToReward = append(m.MaxTopReputersToReward, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxTopReputersToReward) == 0 {
+					m.MaxTopReputersToReward = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxTopReputersToReward = append(m.MaxTopReputersToReward, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopReputersToReward", wireType)
+			}
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTopicFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.CreateTopicFee = append(m.CreateTopicFee, v)
+			if err := m.CreateTopicFee[len(m.CreateTopicFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.GradientDescentMaxIters = append(m.GradientDescentMaxIters, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.GradientDescentMaxIters) == 0 {
+					m.GradientDescentMaxIters = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.GradientDescentMaxIters = append(m.GradientDescentMaxIters, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field GradientDescentMaxIters", wireType)
+			}
+		case 26:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxRetriesToFulfilNoncesWorker = append(m.MaxRetriesToFulfilNoncesWorker, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxRetriesToFulfilNoncesWorker) == 0 {
+					m.MaxRetriesToFulfilNoncesWorker = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxRetriesToFulfilNoncesWorker = append(m.MaxRetriesToFulfilNoncesWorker, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetriesToFulfilNoncesWorker", wireType)
+			}
+		case 27:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxRetriesToFulfilNoncesReputer = append(m.MaxRetriesToFulfilNoncesReputer, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxRetriesToFulfilNoncesReputer) == 0 {
+					m.MaxRetriesToFulfilNoncesReputer = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxRetriesToFulfilNoncesReputer = append(m.MaxRetriesToFulfilNoncesReputer, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetriesToFulfilNoncesReputer", wireType)
+			}
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.RegistrationFee = append(m.RegistrationFee, v)
+			if err := m.RegistrationFee[len(m.RegistrationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.DefaultPageLimit = append(m.DefaultPageLimit, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.DefaultPageLimit) == 0 {
+					m.DefaultPageLimit = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DefaultPageLimit = append(m.DefaultPageLimit, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultPageLimit", wireType)
+			}
+		case 30:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxPageLimit = append(m.MaxPageLimit, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxPageLimit) == 0 {
+					m.MaxPageLimit = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxPageLimit = append(m.MaxPageLimit, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPageLimit", wireType)
+			}
+		case 31:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MinEpochLengthRecordLimit = append(m.MinEpochLengthRecordLimit, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MinEpochLengthRecordLimit) == 0 {
+					m.MinEpochLengthRecordLimit = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MinEpochLengthRecordLimit = append(m.MinEpochLengthRecordLimit, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinEpochLengthRecordLimit", wireType)
+			}
+		case 32:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BlocksPerMonth = append(m.BlocksPerMonth, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.BlocksPerMonth) == 0 {
+					m.BlocksPerMonth = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BlocksPerMonth = append(m.BlocksPerMonth, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerMonth", wireType)
+			}
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PRewardInference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.PRewardInference = append(m.PRewardInference, v)
+			if err := m.PRewardInference[len(m.PRewardInference)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PRewardForecast", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.PRewardForecast = append(m.PRewardForecast, v)
+			if err := m.PRewardForecast[len(m.PRewardForecast)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PRewardReputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.PRewardReputer = append(m.PRewardReputer, v)
+			if err := m.PRewardReputer[len(m.PRewardReputer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CRewardInference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.CRewardInference = append(m.CRewardInference, v)
+			if err := m.CRewardInference[len(m.CRewardInference)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CRewardForecast", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.CRewardForecast = append(m.CRewardForecast, v)
+			if err := m.CRewardForecast[len(m.CRewardForecast)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CNorm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.CNorm = append(m.CNorm, v)
+			if err := m.CNorm[len(m.CNorm)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 39:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicFeeRevenueDecayRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.TopicFeeRevenueDecayRate = append(m.TopicFeeRevenueDecayRate, v)
+			if err := m.TopicFeeRevenueDecayRate[len(m.TopicFeeRevenueDecayRate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 40:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpsilonReputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.EpsilonReputer = append(m.EpsilonReputer, v)
+			if err := m.EpsilonReputer[len(m.EpsilonReputer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinEffectiveTopicRevenue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.MinEffectiveTopicRevenue = append(m.MinEffectiveTopicRevenue, v)
+			if err := m.MinEffectiveTopicRevenue[len(m.MinEffectiveTopicRevenue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 42:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.HalfMaxProcessStakeRemovalsEndBlock = append(m.HalfMaxProcessStakeRemovalsEndBlock, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.HalfMaxProcessStakeRemovalsEndBlock) == 0 {
+					m.HalfMaxProcessStakeRemovalsEndBlock = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.HalfMaxProcessStakeRemovalsEndBlock = append(m.HalfMaxProcessStakeRemovalsEndBlock, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalfMaxProcessStakeRemovalsEndBlock", wireType)
+			}
+		case 43:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PermissionedTopicCreation = append(m.PermissionedTopicCreation, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.PermissionedTopicCreation) == 0 {
+					m.PermissionedTopicCreation = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PermissionedTopicCreation = append(m.PermissionedTopicCreation, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermissionedTopicCreation", wireType)
+			}
+		case 44:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiRegistrationFeeDiscount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.MultiRegistrationFeeDiscount = append(m.MultiRegistrationFeeDiscount, v)
+			if err := m.MultiRegistrationFeeDiscount[len(m.MultiRegistrationFeeDiscount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 45:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxTopicOutputDimension = append(m.MaxTopicOutputDimension, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxTopicOutputDimension) == 0 {
+					m.MaxTopicOutputDimension = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxTopicOutputDimension = append(m.MaxTopicOutputDimension, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopicOutputDimension", wireType)
+			}
+		case 46:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxTopicHorizons = append(m.MaxTopicHorizons, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxTopicHorizons) == 0 {
+					m.MaxTopicHorizons = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxTopicHorizons = append(m.MaxTopicHorizons, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopicHorizons", wireType)
+			}
+		case 47:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ActorHistoryRetention = append(m.ActorHistoryRetention, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ActorHistoryRetention) == 0 {
+					m.ActorHistoryRetention = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ActorHistoryRetention = append(m.ActorHistoryRetention, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorHistoryRetention", wireType)
+			}
+		case 48:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BlocksPerMonthCalibrationEnabled = append(m.BlocksPerMonthCalibrationEnabled, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.BlocksPerMonthCalibrationEnabled) == 0 {
+					m.BlocksPerMonthCalibrationEnabled = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BlocksPerMonthCalibrationEnabled = append(m.BlocksPerMonthCalibrationEnabled, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerMonthCalibrationEnabled", wireType)
+			}
+		case 49:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MinBlocksPerMonth = append(m.MinBlocksPerMonth, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MinBlocksPerMonth) == 0 {
+					m.MinBlocksPerMonth = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MinBlocksPerMonth = append(m.MinBlocksPerMonth, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBlocksPerMonth", wireType)
+			}
+		case 50:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MaxBlocksPerMonth = append(m.MaxBlocksPerMonth, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxBlocksPerMonth) == 0 {
+					m.MaxBlocksPerMonth = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxBlocksPerMonth = append(m.MaxBlocksPerMonth, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlocksPerMonth", wireType)
+			}
+		case 51:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlocksPerMonthChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.MaxBlocksPerMonthChange = append(m.MaxBlocksPerMonthChange, v)
+			if err := m.MaxBlocksPerMonthChange[len(m.MaxBlocksPerMonthChange)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 52:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeSmoothingDegree", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.BlockTimeSmoothingDegree = append(m.BlockTimeSmoothingDegree, v)
+			if err := m.BlockTimeSmoothingDegree[len(m.BlockTimeSmoothingDegree)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &OptionalParams{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgRemoveFromWhitelistAdminResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgRewardDelegateStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *MsgRewardDelegateStake) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.TopicId != 0 {
-		n += 1 + sovTx(uint64(m.TopicId))
-	}
-	l = len(m.Reputer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *OptionalParams) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateNewTopic) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5505,15 +11543,15 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OptionalParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateNewTopic: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OptionalParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateNewTopic: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5541,87 +11579,75 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = append(m.Version, string(dAtA[iNdEx:postIndex]))
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				m.MaxSerializedMsgLength = append(m.MaxSerializedMsgLength, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LossLogic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.MaxSerializedMsgLength) == 0 {
-					m.MaxSerializedMsgLength = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MaxSerializedMsgLength = append(m.MaxSerializedMsgLength, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSerializedMsgLength", wireType)
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LossLogic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinTopicWeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LossMethod", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5649,91 +11675,11 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.MinTopicWeight = append(m.MinTopicWeight, v)
-			if err := m.MinTopicWeight[len(m.MinTopicWeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LossMethod = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.MaxTopicsPerBlock = append(m.MaxTopicsPerBlock, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.MaxTopicsPerBlock) == 0 {
-					m.MaxTopicsPerBlock = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MaxTopicsPerBlock = append(m.MaxTopicsPerBlock, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopicsPerBlock", wireType)
-			}
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiredMinimumStake", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceLogic", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5761,167 +11707,81 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.RequiredMinimumStake = append(m.RequiredMinimumStake, v)
-			if err := m.RequiredMinimumStake[len(m.RequiredMinimumStake)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.InferenceLogic = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.RemoveStakeDelayWindow = append(m.RemoveStakeDelayWindow, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceMethod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.RemoveStakeDelayWindow) == 0 {
-					m.RemoveStakeDelayWindow = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.RemoveStakeDelayWindow = append(m.RemoveStakeDelayWindow, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoveStakeDelayWindow", wireType)
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InferenceMethod = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 7:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.MinEpochLength = append(m.MinEpochLength, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroundTruthLag", wireType)
+			}
+			m.GroundTruthLag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.MinEpochLength) == 0 {
-					m.MinEpochLength = make([]int64, 0, elementCount)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MinEpochLength = append(m.MinEpochLength, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroundTruthLag |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinEpochLength", wireType)
 			}
-		case 8:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BetaEntropy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultArg", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5949,15 +11809,11 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.BetaEntropy = append(m.BetaEntropy, v)
-			if err := m.BetaEntropy[len(m.BetaEntropy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DefaultArg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LearningRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PNorm", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5985,15 +11841,13 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.LearningRate = append(m.LearningRate, v)
-			if err := m.LearningRate[len(m.LearningRate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PNorm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxGradientThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AlphaRegret", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6021,15 +11875,33 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.MaxGradientThreshold = append(m.MaxGradientThreshold, v)
-			if err := m.MaxGradientThreshold[len(m.MaxGradientThreshold)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AlphaRegret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowNegative", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowNegative = bool(v != 0)
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinStakeFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epsilon", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6057,91 +11929,146 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.MinStakeFraction = append(m.MinStakeFraction, v)
-			if err := m.MinStakeFraction[len(m.MinStakeFraction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Epsilon.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistMode", wireType)
+			}
+			m.AllowlistMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				m.MaxUnfulfilledWorkerRequests = append(m.MaxUnfulfilledWorkerRequests, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AllowlistMode |= TopicAllowlistMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerInactivityThreshold", wireType)
+			}
+			m.WorkerInactivityThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkerInactivityThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.MaxUnfulfilledWorkerRequests) == 0 {
-					m.MaxUnfulfilledWorkerRequests = make([]uint64, 0, elementCount)
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerInactivityThreshold", wireType)
+			}
+			m.ReputerInactivityThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MaxUnfulfilledWorkerRequests = append(m.MaxUnfulfilledWorkerRequests, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReputerInactivityThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnfulfilledWorkerRequests", wireType)
 			}
-		case 14:
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Combiner", wireType)
+			}
+			m.Combiner = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Combiner |= CombinerType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputDimension", wireType)
+			}
+			m.OutputDimension = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutputDimension |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputType", wireType)
+			}
+			m.OutputType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutputType |= TopicOutputType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionPooling", wireType)
+			}
+			m.DistributionPooling = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DistributionPooling |= DistributionPooling(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
 			if wireType == 0 {
-				var v uint64
+				var v int64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return ErrIntOverflowTx
@@ -6151,12 +12078,12 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= uint64(b&0x7F) << shift
+					v |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				m.MaxUnfulfilledReputerRequests = append(m.MaxUnfulfilledReputerRequests, v)
+				m.Horizons = append(m.Horizons, v)
 			} else if wireType == 2 {
 				var packedLen int
 				for shift := uint(0); ; shift += 7 {
@@ -6191,11 +12118,11 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 					}
 				}
 				elementCount = count
-				if elementCount != 0 && len(m.MaxUnfulfilledReputerRequests) == 0 {
-					m.MaxUnfulfilledReputerRequests = make([]uint64, 0, elementCount)
+				if elementCount != 0 && len(m.Horizons) == 0 {
+					m.Horizons = make([]int64, 0, elementCount)
 				}
 				for iNdEx < postIndex {
-					var v uint64
+					var v int64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowTx
@@ -6205,19 +12132,19 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						v |= uint64(b&0x7F) << shift
+						v |= int64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					m.MaxUnfulfilledReputerRequests = append(m.MaxUnfulfilledReputerRequests, v)
+					m.Horizons = append(m.Horizons, v)
 				}
 			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnfulfilledReputerRequests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Horizons", wireType)
 			}
-		case 15:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicRewardStakeImportance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HorizonWeights", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6246,14 +12173,273 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v github_com_allora_network_allora_chain_math.Dec
-			m.TopicRewardStakeImportance = append(m.TopicRewardStakeImportance, v)
-			if err := m.TopicRewardStakeImportance[len(m.TopicRewardStakeImportance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.HorizonWeights = append(m.HorizonWeights, v)
+			if err := m.HorizonWeights[len(m.HorizonWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LossAggregator", wireType)
+			}
+			m.LossAggregator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LossAggregator |= LossAggregatorType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrimmedMeanTrimFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TrimmedMeanTrimFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LossTrimFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LossTrimFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HuberLossTuningConstant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HuberLossTuningConstant.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HuberLossMaxIterations", wireType)
+			}
+			m.HuberLossMaxIterations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HuberLossMaxIterations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateNewTopicResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateNewTopicResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateNewTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInsertBulkReputerPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInsertBulkReputerPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInsertBulkReputerPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicRewardFeeRevenueImportance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6281,17 +12467,13 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.TopicRewardFeeRevenueImportance = append(m.TopicRewardFeeRevenueImportance, v)
-			if err := m.TopicRewardFeeRevenueImportance[len(m.TopicRewardFeeRevenueImportance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicRewardAlpha", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerRequestNonce", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6301,33 +12483,33 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.TopicRewardAlpha = append(m.TopicRewardAlpha, v)
-			if err := m.TopicRewardAlpha[len(m.TopicRewardAlpha)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ReputerRequestNonce == nil {
+				m.ReputerRequestNonce = &ReputerRequestNonce{}
+			}
+			if err := m.ReputerRequestNonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 18:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskRewardAlpha", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			var stringLen uint64
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6337,33 +12519,16 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.TaskRewardAlpha = append(m.TaskRewardAlpha, v)
-			if err := m.TaskRewardAlpha[len(m.TaskRewardAlpha)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 19:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsVsAlloraPercentReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerValueBundles", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6373,337 +12538,218 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.ValidatorsVsAlloraPercentReward = append(m.ValidatorsVsAlloraPercentReward, v)
-			if err := m.ValidatorsVsAlloraPercentReward[len(m.ValidatorsVsAlloraPercentReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReputerValueBundles = append(m.ReputerValueBundles, &ReputerValueBundle{})
+			if err := m.ReputerValueBundles[len(m.ReputerValueBundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 20:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.MaxSamplesToScaleScores = append(m.MaxSamplesToScaleScores, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.MaxSamplesToScaleScores) == 0 {
-					m.MaxSamplesToScaleScores = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MaxSamplesToScaleScores = append(m.MaxSamplesToScaleScores, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSamplesToScaleScores", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInsertBulkReputerPayloadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInsertBulkReputerPayloadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInsertBulkReputerPayloadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInsertBulkWorkerPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInsertBulkWorkerPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInsertBulkWorkerPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-		case 21:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.MaxTopInferersToReward = append(m.MaxTopInferersToReward, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.MaxTopInferersToReward) == 0 {
-					m.MaxTopInferersToReward = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MaxTopInferersToReward = append(m.MaxTopInferersToReward, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopInferersToReward", wireType)
 			}
-		case 22:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.MaxTopForecastersToReward = append(m.MaxTopForecastersToReward, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.MaxTopForecastersToReward) == 0 {
-					m.MaxTopForecastersToReward = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MaxTopForecastersToReward = append(m.MaxTopForecastersToReward, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopForecastersToReward", wireType)
 			}
-		case 23:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.MaxTopReputersToReward = append(m.MaxTopReputersToReward, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Nonce == nil {
+				m.Nonce = &Nonce{}
+			}
+			if err := m.Nonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.MaxTopReputersToReward) == 0 {
-					m.MaxTopReputersToReward = make([]uint64, 0, elementCount)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MaxTopReputersToReward = append(m.MaxTopReputersToReward, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopReputersToReward", wireType)
 			}
-		case 24:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateTopicFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerDataBundles", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6713,259 +12759,129 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.CreateTopicFee = append(m.CreateTopicFee, v)
-			if err := m.CreateTopicFee[len(m.CreateTopicFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.WorkerDataBundles = append(m.WorkerDataBundles, &WorkerDataBundle{})
+			if err := m.WorkerDataBundles[len(m.WorkerDataBundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 25:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.GradientDescentMaxIters = append(m.GradientDescentMaxIters, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.GradientDescentMaxIters) == 0 {
-					m.GradientDescentMaxIters = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.GradientDescentMaxIters = append(m.GradientDescentMaxIters, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field GradientDescentMaxIters", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-		case 26:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.MaxRetriesToFulfilNoncesWorker = append(m.MaxRetriesToFulfilNoncesWorker, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.MaxRetriesToFulfilNoncesWorker) == 0 {
-					m.MaxRetriesToFulfilNoncesWorker = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MaxRetriesToFulfilNoncesWorker = append(m.MaxRetriesToFulfilNoncesWorker, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetriesToFulfilNoncesWorker", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInsertBulkWorkerPayloadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInsertBulkWorkerPayloadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInsertBulkWorkerPayloadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 27:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.MaxRetriesToFulfilNoncesReputer = append(m.MaxRetriesToFulfilNoncesReputer, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.MaxRetriesToFulfilNoncesReputer) == 0 {
-					m.MaxRetriesToFulfilNoncesReputer = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MaxRetriesToFulfilNoncesReputer = append(m.MaxRetriesToFulfilNoncesReputer, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetriesToFulfilNoncesReputer", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegister) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-		case 28:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegister: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegister: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6993,319 +12909,216 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.RegistrationFee = append(m.RegistrationFee, v)
-			if err := m.RegistrationFee[len(m.RegistrationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 29:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.DefaultPageLimit = append(m.DefaultPageLimit, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LibP2PKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.DefaultPageLimit) == 0 {
-					m.DefaultPageLimit = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.DefaultPageLimit = append(m.DefaultPageLimit, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultPageLimit", wireType)
 			}
-		case 30:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.MaxPageLimit = append(m.MaxPageLimit, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LibP2PKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.MaxPageLimit) == 0 {
-					m.MaxPageLimit = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MaxPageLimit = append(m.MaxPageLimit, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPageLimit", wireType)
 			}
-		case 31:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MultiAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				m.MinEpochLengthRecordLimit = append(m.MinEpochLengthRecordLimit, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.MinEpochLengthRecordLimit) == 0 {
-					m.MinEpochLengthRecordLimit = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.MinEpochLengthRecordLimit = append(m.MinEpochLengthRecordLimit, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinEpochLengthRecordLimit", wireType)
 			}
-		case 32:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsReputer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				m.BlocksPerMonth = append(m.BlocksPerMonth, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			}
+			m.IsReputer = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.BlocksPerMonth) == 0 {
-					m.BlocksPerMonth = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.BlocksPerMonth = append(m.BlocksPerMonth, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerMonth", wireType)
 			}
-		case 33:
+			m.Success = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PRewardInference", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7333,15 +13146,61 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.PRewardInference = append(m.PRewardInference, v)
-			if err := m.PRewardInference[len(m.PRewardInference)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 34:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveRegistration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveRegistration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveRegistration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PRewardForecast", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7369,17 +13228,13 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.PRewardForecast = append(m.PRewardForecast, v)
-			if err := m.PRewardForecast[len(m.PRewardForecast)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 35:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PRewardReputer", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			var stringLen uint64
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7389,33 +13244,16 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.PRewardReputer = append(m.PRewardReputer, v)
-			if err := m.PRewardReputer[len(m.PRewardReputer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 36:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CRewardInference", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsReputer", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7425,33 +13263,67 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+			m.IsReputer = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.CRewardInference = append(m.CRewardInference, v)
-			if err := m.CRewardInference[len(m.CRewardInference)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveRegistrationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
-		case 37:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CRewardForecast", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var stringLen uint64
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveRegistrationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveRegistrationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7461,31 +13333,15 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.CRewardForecast = append(m.CRewardForecast, v)
-			if err := m.CRewardForecast[len(m.CRewardForecast)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 38:
+			m.Success = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CNorm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7513,15 +13369,61 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.CNorm = append(m.CNorm, v)
-			if err := m.CNorm[len(m.CNorm)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 39:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterMulti) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterMulti: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterMulti: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicFeeRevenueDecayRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7549,15 +13451,11 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.TopicFeeRevenueDecayRate = append(m.TopicFeeRevenueDecayRate, v)
-			if err := m.TopicFeeRevenueDecayRate[len(m.TopicFeeRevenueDecayRate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 40:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpsilonReputer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LibP2PKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7585,15 +13483,11 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.EpsilonReputer = append(m.EpsilonReputer, v)
-			if err := m.EpsilonReputer[len(m.EpsilonReputer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LibP2PKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 41:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinEffectiveTopicRevenue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7621,13 +13515,9 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.MinEffectiveTopicRevenue = append(m.MinEffectiveTopicRevenue, v)
-			if err := m.MinEffectiveTopicRevenue[len(m.MinEffectiveTopicRevenue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MultiAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 42:
+		case 4:
 			if wireType == 0 {
 				var v uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7644,7 +13534,7 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 						break
 					}
 				}
-				m.HalfMaxProcessStakeRemovalsEndBlock = append(m.HalfMaxProcessStakeRemovalsEndBlock, v)
+				m.TopicIds = append(m.TopicIds, v)
 			} else if wireType == 2 {
 				var packedLen int
 				for shift := uint(0); ; shift += 7 {
@@ -7679,8 +13569,8 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 					}
 				}
 				elementCount = count
-				if elementCount != 0 && len(m.HalfMaxProcessStakeRemovalsEndBlock) == 0 {
-					m.HalfMaxProcessStakeRemovalsEndBlock = make([]uint64, 0, elementCount)
+				if elementCount != 0 && len(m.TopicIds) == 0 {
+					m.TopicIds = make([]uint64, 0, elementCount)
 				}
 				for iNdEx < postIndex {
 					var v uint64
@@ -7698,64 +13588,90 @@ func (m *OptionalParams) Unmarshal(dAtA []byte) error {
 							break
 						}
 					}
-					m.HalfMaxProcessStakeRemovalsEndBlock = append(m.HalfMaxProcessStakeRemovalsEndBlock, v)
+					m.TopicIds = append(m.TopicIds, v)
 				}
 			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field HalfMaxProcessStakeRemovalsEndBlock", wireType)
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicIds", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RemoveTopicIds = append(m.RemoveTopicIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RemoveTopicIds) == 0 {
+					m.RemoveTopicIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RemoveTopicIds = append(m.RemoveTopicIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveTopicIds", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7783,44 +13699,28 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Params == nil {
-				m.Params = &OptionalParams{}
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsReputer", wireType)
 			}
-			iNdEx = postIndex
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsReputer = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7842,7 +13742,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterMultiResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7865,12 +13765,64 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterMultiResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterMultiResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7892,7 +13844,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateNewTopic) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateNodeInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7915,15 +13867,15 @@ func (m *MsgCreateNewTopic) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateNewTopic: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateNodeInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateNewTopic: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateNodeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7951,11 +13903,11 @@ func (m *MsgCreateNewTopic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LibP2PKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7983,11 +13935,11 @@ func (m *MsgCreateNewTopic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			m.LibP2PKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LossLogic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8015,11 +13967,11 @@ func (m *MsgCreateNewTopic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LossLogic = string(dAtA[iNdEx:postIndex])
+			m.MultiAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LossMethod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8047,13 +13999,13 @@ func (m *MsgCreateNewTopic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LossMethod = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InferenceLogic", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsReputer", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8063,27 +14015,85 @@ func (m *MsgCreateNewTopic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+			m.IsReputer = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InferenceLogic = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateNodeInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateNodeInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateNodeInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InferenceMethod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8111,49 +14121,61 @@ func (m *MsgCreateNewTopic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InferenceMethod = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.EpochLength = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EpochLength |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroundTruthLag", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.GroundTruthLag = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GroundTruthLag |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddStake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-		case 9:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddStake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddStake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultArg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8181,11 +14203,30 @@ func (m *MsgCreateNewTopic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DefaultArg = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PNorm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8213,13 +14254,113 @@ func (m *MsgCreateNewTopic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PNorm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddStakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddStakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveStake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveStake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveStake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AlphaRegret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8247,15 +14388,13 @@ func (m *MsgCreateNewTopic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AlphaRegret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowNegative", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			var v int
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8265,15 +14404,14 @@ func (m *MsgCreateNewTopic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllowNegative = bool(v != 0)
-		case 13:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epsilon", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8301,7 +14439,7 @@ func (m *MsgCreateNewTopic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Epsilon.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8326,7 +14464,7 @@ func (m *MsgCreateNewTopic) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateNewTopicResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveStakeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8349,31 +14487,12 @@ func (m *MsgCreateNewTopicResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateNewTopicResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveStakeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateNewTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
-			}
-			m.TopicId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8395,7 +14514,7 @@ func (m *MsgCreateNewTopicResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInsertBulkReputerPayload) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelRemoveStake) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8418,10 +14537,10 @@ func (m *MsgInsertBulkReputerPayload) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInsertBulkReputerPayload: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelRemoveStake: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInsertBulkReputerPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelRemoveStake: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8457,42 +14576,6 @@ func (m *MsgInsertBulkReputerPayload) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReputerRequestNonce", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ReputerRequestNonce == nil {
-				m.ReputerRequestNonce = &ReputerRequestNonce{}
-			}
-			if err := m.ReputerRequestNonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
@@ -8508,43 +14591,9 @@ func (m *MsgInsertBulkReputerPayload) Unmarshal(dAtA []byte) error {
 				iNdEx++
 				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReputerValueBundles", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ReputerValueBundles = append(m.ReputerValueBundles, &ReputerValueBundle{})
-			if err := m.ReputerValueBundles[len(m.ReputerValueBundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8566,7 +14615,7 @@ func (m *MsgInsertBulkReputerPayload) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInsertBulkReputerPayloadResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelRemoveStakeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8589,10 +14638,10 @@ func (m *MsgInsertBulkReputerPayloadResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInsertBulkReputerPayloadResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelRemoveStakeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInsertBulkReputerPayloadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelRemoveStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8616,7 +14665,7 @@ func (m *MsgInsertBulkReputerPayloadResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInsertBulkWorkerPayload) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateStake) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8639,10 +14688,10 @@ func (m *MsgInsertBulkWorkerPayload) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInsertBulkWorkerPayload: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateStake: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInsertBulkWorkerPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateStake: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8678,10 +14727,10 @@ func (m *MsgInsertBulkWorkerPayload) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			var msglen int
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8691,33 +14740,16 @@ func (m *MsgInsertBulkWorkerPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Nonce == nil {
-				m.Nonce = &Nonce{}
-			}
-			if err := m.Nonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
 			}
-			m.TopicId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8727,16 +14759,29 @@ func (m *MsgInsertBulkWorkerPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerDataBundles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8746,23 +14791,23 @@ func (m *MsgInsertBulkWorkerPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkerDataBundles = append(m.WorkerDataBundles, &WorkerDataBundle{})
-			if err := m.WorkerDataBundles[len(m.WorkerDataBundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8787,7 +14832,7 @@ func (m *MsgInsertBulkWorkerPayload) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInsertBulkWorkerPayloadResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateStakeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8810,10 +14855,10 @@ func (m *MsgInsertBulkWorkerPayloadResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInsertBulkWorkerPayloadResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateStakeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInsertBulkWorkerPayloadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8837,7 +14882,7 @@ func (m *MsgInsertBulkWorkerPayloadResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegister) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveDelegateStake) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8860,10 +14905,10 @@ func (m *MsgRegister) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegister: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveDelegateStake: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegister: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveDelegateStake: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8900,7 +14945,7 @@ func (m *MsgRegister) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LibP2PKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8928,41 +14973,9 @@ func (m *MsgRegister) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LibP2PKey = string(dAtA[iNdEx:postIndex])
+			m.Reputer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultiAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MultiAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
@@ -8981,9 +14994,9 @@ func (m *MsgRegister) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9011,28 +15024,10 @@ func (m *MsgRegister) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsReputer", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.IsReputer = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9054,7 +15049,7 @@ func (m *MsgRegister) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveDelegateStakeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9077,64 +15072,12 @@ func (m *MsgRegisterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveDelegateStakeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveDelegateStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Success = bool(v != 0)
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9156,7 +15099,7 @@ func (m *MsgRegisterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveRegistration) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelRemoveDelegateStake) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9179,15 +15122,66 @@ func (m *MsgRemoveRegistration) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveRegistration: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelRemoveDelegateStake: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveRegistration: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelRemoveDelegateStake: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9215,13 +15209,13 @@ func (m *MsgRemoveRegistration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
 			}
-			m.TopicId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9231,31 +15225,24 @@ func (m *MsgRemoveRegistration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsReputer", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.IsReputer = bool(v != 0)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9277,7 +15264,7 @@ func (m *MsgRemoveRegistration) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveRegistrationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelRemoveDelegateStakeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9300,64 +15287,12 @@ func (m *MsgRemoveRegistrationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveRegistrationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelRemoveDelegateStakeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveRegistrationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelRemoveDelegateStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Success = bool(v != 0)
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9379,7 +15314,7 @@ func (m *MsgRemoveRegistrationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddStake) Unmarshal(dAtA []byte) error {
+func (m *MsgFundTopic) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9402,10 +15337,10 @@ func (m *MsgAddStake) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddStake: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundTopic: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddStake: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundTopic: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9514,7 +15449,7 @@ func (m *MsgAddStake) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFundTopicResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9537,10 +15472,10 @@ func (m *MsgAddStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundTopicResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9564,7 +15499,7 @@ func (m *MsgAddStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveStake) Unmarshal(dAtA []byte) error {
+func (m *MsgAddToWhitelistAdmin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9587,10 +15522,10 @@ func (m *MsgRemoveStake) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveStake: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddToWhitelistAdmin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveStake: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddToWhitelistAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9626,27 +15561,8 @@ func (m *MsgRemoveStake) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
-			}
-			m.TopicId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9674,9 +15590,7 @@ func (m *MsgRemoveStake) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9699,7 +15613,7 @@ func (m *MsgRemoveStake) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddToWhitelistAdminResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9722,10 +15636,10 @@ func (m *MsgRemoveStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddToWhitelistAdminResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddToWhitelistAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9749,7 +15663,7 @@ func (m *MsgRemoveStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelRemoveStake) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveFromWhitelistAdmin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9772,10 +15686,10 @@ func (m *MsgCancelRemoveStake) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelRemoveStake: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveFromWhitelistAdmin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelRemoveStake: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveFromWhitelistAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9811,10 +15725,10 @@ func (m *MsgCancelRemoveStake) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.TopicId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9824,11 +15738,24 @@ func (m *MsgCancelRemoveStake) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9850,7 +15777,7 @@ func (m *MsgCancelRemoveStake) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelRemoveStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveFromWhitelistAdminResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9873,10 +15800,10 @@ func (m *MsgCancelRemoveStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelRemoveStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveFromWhitelistAdminResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelRemoveStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveFromWhitelistAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9900,7 +15827,7 @@ func (m *MsgCancelRemoveStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateStake) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantRole) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9917,72 +15844,21 @@ func (m *MsgDelegateStake) Unmarshal(dAtA []byte) error {
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateStake: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateStake: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
-			}
-			m.TopicId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				break
 			}
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantRole: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantRole: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10010,11 +15886,11 @@ func (m *MsgDelegateStake) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reputer = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10042,10 +15918,27 @@ func (m *MsgDelegateStake) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= Role(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10067,7 +15960,7 @@ func (m *MsgDelegateStake) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantRoleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10090,10 +15983,10 @@ func (m *MsgDelegateStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantRoleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10117,7 +16010,7 @@ func (m *MsgDelegateStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveDelegateStake) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeRole) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10140,10 +16033,10 @@ func (m *MsgRemoveDelegateStake) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveDelegateStake: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeRole: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveDelegateStake: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeRole: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10180,7 +16073,7 @@ func (m *MsgRemoveDelegateStake) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10208,32 +16101,13 @@ func (m *MsgRemoveDelegateStake) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reputer = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
-			}
-			m.TopicId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			var stringLen uint64
+			m.Role = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10243,26 +16117,11 @@ func (m *MsgRemoveDelegateStake) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Role |= Role(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10284,7 +16143,7 @@ func (m *MsgRemoveDelegateStake) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveDelegateStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeRoleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10307,10 +16166,10 @@ func (m *MsgRemoveDelegateStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveDelegateStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeRoleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveDelegateStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10334,7 +16193,7 @@ func (m *MsgRemoveDelegateStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelRemoveDelegateStake) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateTopicAllowlistMode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10357,10 +16216,10 @@ func (m *MsgCancelRemoveDelegateStake) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelRemoveDelegateStake: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateTopicAllowlistMode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelRemoveDelegateStake: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateTopicAllowlistMode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10415,42 +16274,10 @@ func (m *MsgCancelRemoveDelegateStake) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Delegator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistMode", wireType)
 			}
-			var stringLen uint64
+			m.AllowlistMode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10460,24 +16287,11 @@ func (m *MsgCancelRemoveDelegateStake) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AllowlistMode |= TopicAllowlistMode(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reputer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10499,7 +16313,7 @@ func (m *MsgCancelRemoveDelegateStake) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelRemoveDelegateStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateTopicAllowlistModeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10522,10 +16336,10 @@ func (m *MsgCancelRemoveDelegateStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelRemoveDelegateStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateTopicAllowlistModeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelRemoveDelegateStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateTopicAllowlistModeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10549,7 +16363,7 @@ func (m *MsgCancelRemoveDelegateStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFundTopic) Unmarshal(dAtA []byte) error {
+func (m *MsgAddToTopicAllowlist) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10572,10 +16386,10 @@ func (m *MsgFundTopic) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundTopic: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddToTopicAllowlist: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundTopic: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddToTopicAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10631,7 +16445,7 @@ func (m *MsgFundTopic) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10659,10 +16473,28 @@ func (m *MsgFundTopic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsReputer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsReputer = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10684,7 +16516,7 @@ func (m *MsgFundTopic) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFundTopicResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddToTopicAllowlistResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10707,10 +16539,10 @@ func (m *MsgFundTopicResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundTopicResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddToTopicAllowlistResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddToTopicAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10734,7 +16566,7 @@ func (m *MsgFundTopicResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddToWhitelistAdmin) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveFromTopicAllowlist) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10757,10 +16589,10 @@ func (m *MsgAddToWhitelistAdmin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddToWhitelistAdmin: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveFromTopicAllowlist: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddToWhitelistAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveFromTopicAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10796,6 +16628,25 @@ func (m *MsgAddToWhitelistAdmin) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
@@ -10825,8 +16676,78 @@ func (m *MsgAddToWhitelistAdmin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsReputer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsReputer = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveFromTopicAllowlistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveFromTopicAllowlistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveFromTopicAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10848,7 +16769,7 @@ func (m *MsgAddToWhitelistAdmin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddToWhitelistAdminResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRewardDelegateStakeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10871,10 +16792,10 @@ func (m *MsgAddToWhitelistAdminResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddToWhitelistAdminResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRewardDelegateStakeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddToWhitelistAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRewardDelegateStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10898,7 +16819,7 @@ func (m *MsgAddToWhitelistAdminResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveFromWhitelistAdmin) Unmarshal(dAtA []byte) error {
+func (m *MsgRewardDelegateStake) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10921,10 +16842,10 @@ func (m *MsgRemoveFromWhitelistAdmin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveFromWhitelistAdmin: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRewardDelegateStake: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveFromWhitelistAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRewardDelegateStake: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10960,8 +16881,27 @@ func (m *MsgRemoveFromWhitelistAdmin) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10989,7 +16929,7 @@ func (m *MsgRemoveFromWhitelistAdmin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Reputer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11012,7 +16952,7 @@ func (m *MsgRemoveFromWhitelistAdmin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveFromWhitelistAdminResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateTopicInactivityThresholds) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11035,12 +16975,101 @@ func (m *MsgRemoveFromWhitelistAdminResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveFromWhitelistAdminResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateTopicInactivityThresholds: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveFromWhitelistAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateTopicInactivityThresholds: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerInactivityThreshold", wireType)
+			}
+			m.WorkerInactivityThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkerInactivityThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerInactivityThreshold", wireType)
+			}
+			m.ReputerInactivityThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReputerInactivityThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -11062,7 +17091,7 @@ func (m *MsgRemoveFromWhitelistAdminResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRewardDelegateStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateTopicInactivityThresholdsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11085,10 +17114,10 @@ func (m *MsgRewardDelegateStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRewardDelegateStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateTopicInactivityThresholdsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRewardDelegateStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateTopicInactivityThresholdsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -11112,7 +17141,7 @@ func (m *MsgRewardDelegateStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRewardDelegateStake) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateTopicLossAggregator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11135,10 +17164,10 @@ func (m *MsgRewardDelegateStake) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRewardDelegateStake: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateTopicLossAggregator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRewardDelegateStake: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateTopicLossAggregator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11193,8 +17222,27 @@ func (m *MsgRewardDelegateStake) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LossAggregator", wireType)
+			}
+			m.LossAggregator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LossAggregator |= LossAggregatorType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LossTrimFraction", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11222,8 +17270,113 @@ func (m *MsgRewardDelegateStake) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reputer = string(dAtA[iNdEx:postIndex])
+			if err := m.LossTrimFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HuberLossTuningConstant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HuberLossTuningConstant.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HuberLossMaxIterations", wireType)
+			}
+			m.HuberLossMaxIterations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HuberLossMaxIterations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateTopicLossAggregatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateTopicLossAggregatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateTopicLossAggregatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
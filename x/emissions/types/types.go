@@ -2,3 +2,20 @@ package types
 
 type BlockHeight = int64
 type TopicId = uint64
+type Horizon = uint32
+
+// Average number of seconds in a month, 365.25 days / 12
+const SecondsPerMonth uint64 = 2629800
+
+// Most times the current average block time a single block may add to the moving average,
+// so that a halt or a long gap between blocks does not drag the average far off
+const MaxElapsedBlockTimeFactor int64 = 10
+
+// Upper bound of the MaxTopicOutputDimension param
+const MaxTopicOutputDimensionLimit uint64 = 256
+
+// Upper bound of the MaxTopicHorizons param
+const MaxTopicHorizonsLimit uint64 = 32
+
+// Most iterations the Huber loss aggregator of a topic may run for each loss, bounding the work it adds to a block
+const MaxHuberLossMaxIterations uint64 = 100
@@ -26,6 +26,40 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Permissions that can be granted to an address. Whitelist admins manage role
+// assignments, params admins update module params and topic creators may create
+// topics while topic creation is permissioned.
+type Role int32
+
+const (
+	Role_NO_ROLE         Role = 0
+	Role_PARAMS_ADMIN    Role = 1
+	Role_TOPIC_CREATOR   Role = 2
+	Role_WHITELIST_ADMIN Role = 3
+)
+
+var Role_name = map[int32]string{
+	0: "NO_ROLE",
+	1: "PARAMS_ADMIN",
+	2: "TOPIC_CREATOR",
+	3: "WHITELIST_ADMIN",
+}
+
+var Role_value = map[string]int32{
+	"NO_ROLE":         0,
+	"PARAMS_ADMIN":    1,
+	"TOPIC_CREATOR":   2,
+	"WHITELIST_ADMIN": 3,
+}
+
+func (x Role) String() string {
+	return proto.EnumName(Role_name, int32(x))
+}
+
+func (Role) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_32ba9f7333c0b525, []int{0}
+}
+
 type SimpleCursorPaginationRequest struct {
 	Key   []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	Limit uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
@@ -160,6 +194,7 @@ func (m *ListeningCoefficient) XXX_DiscardUnknown() {
 var xxx_messageInfo_ListeningCoefficient proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterEnum("emissions.v1.Role", Role_name, Role_value)
 	proto.RegisterType((*SimpleCursorPaginationRequest)(nil), "emissions.v1.SimpleCursorPaginationRequest")
 	proto.RegisterType((*SimpleCursorPaginationResponse)(nil), "emissions.v1.SimpleCursorPaginationResponse")
 	proto.RegisterType((*ListeningCoefficient)(nil), "emissions.v1.ListeningCoefficient")
@@ -168,27 +203,32 @@ func init() {
 func init() { proto.RegisterFile("emissions/v1/types.proto", fileDescriptor_32ba9f7333c0b525) }
 
 var fileDescriptor_32ba9f7333c0b525 = []byte{
-	// 315 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x3f, 0x4f, 0x32, 0x41,
-	0x10, 0x87, 0xef, 0xde, 0xd7, 0xbf, 0x2b, 0x85, 0x5e, 0x28, 0x80, 0xc4, 0x85, 0x50, 0xd1, 0x78,
-	0x1b, 0x62, 0xa1, 0x89, 0x1d, 0x98, 0x58, 0x68, 0x61, 0xce, 0x4a, 0x1b, 0xb2, 0x5c, 0x86, 0x63,
-	0xc2, 0xed, 0xce, 0x71, 0xbb, 0x20, 0x7c, 0x0b, 0x3f, 0x16, 0x25, 0xa5, 0xb1, 0x20, 0x06, 0xbe,
-	0x88, 0xe1, 0x4e, 0x3d, 0x1b, 0x13, 0x9b, 0xcd, 0x3c, 0x33, 0xb3, 0x4f, 0x31, 0x3f, 0x56, 0x01,
-	0x85, 0xc6, 0x20, 0x69, 0x23, 0xa6, 0x6d, 0x61, 0xe7, 0x09, 0x18, 0x3f, 0x49, 0xc9, 0x92, 0x57,
-	0xfa, 0x9e, 0xf8, 0xd3, 0x76, 0xad, 0x1a, 0x92, 0x51, 0x64, 0x7a, 0xd9, 0x4c, 0xe4, 0x90, 0x2f,
-	0xd6, 0x4e, 0xa4, 0x42, 0x4d, 0x22, 0x7b, 0x3f, 0x5b, 0xe5, 0x88, 0x22, 0xca, 0x57, 0xb7, 0x55,
-	0xde, 0x6d, 0xde, 0xb0, 0xd3, 0x07, 0x54, 0x49, 0x0c, 0xdd, 0x49, 0x6a, 0x28, 0xbd, 0x97, 0x11,
-	0x6a, 0x69, 0x91, 0x74, 0x00, 0xe3, 0x09, 0x18, 0xeb, 0x1d, 0xb3, 0xff, 0x23, 0x98, 0x57, 0xdc,
-	0x86, 0xdb, 0x2a, 0x05, 0xdb, 0xd2, 0x2b, 0xb3, 0xdd, 0x18, 0x15, 0xda, 0xca, 0xbf, 0x86, 0xdb,
-	0xda, 0x09, 0x72, 0x68, 0x5e, 0x31, 0xfe, 0x9b, 0xc8, 0x24, 0xa4, 0x0d, 0x78, 0x55, 0x76, 0xa0,
-	0x61, 0x66, 0x7b, 0x85, 0x6e, 0x7f, 0xcb, 0xb7, 0x30, 0x6f, 0x8e, 0x59, 0xf9, 0x0e, 0x8d, 0x05,
-	0x8d, 0x3a, 0xea, 0x12, 0x0c, 0x06, 0x18, 0x22, 0x68, 0xeb, 0x3d, 0xb2, 0xa3, 0xb0, 0xc0, 0xec,
-	0xd7, 0x61, 0xe7, 0x62, 0xb1, 0xaa, 0x3b, 0x6f, 0xab, 0xba, 0x88, 0xd0, 0x0e, 0x27, 0x7d, 0x3f,
-	0x24, 0x25, 0x64, 0x1c, 0x53, 0x2a, 0xcf, 0x34, 0xd8, 0x67, 0x4a, 0x47, 0x5f, 0x18, 0x0e, 0x25,
-	0x6a, 0xa1, 0xa4, 0x1d, 0xfa, 0xd7, 0x10, 0x06, 0x3f, 0x5d, 0x9d, 0x60, 0xb1, 0xe6, 0xee, 0x72,
-	0xcd, 0xdd, 0xf7, 0x35, 0x77, 0x5f, 0x36, 0xdc, 0x59, 0x6e, 0xb8, 0xf3, 0xba, 0xe1, 0xce, 0xd3,
-	0xe5, 0x1f, 0xbd, 0x33, 0x51, 0xe4, 0x94, 0x85, 0xd4, 0xdf, 0xcb, 0x6e, 0x7a, 0xfe, 0x11, 0x00,
-	0x00, 0xff, 0xff, 0xcc, 0x62, 0xab, 0xb0, 0xc1, 0x01, 0x00, 0x00,
+	// 385 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xc1, 0x8f, 0xd2, 0x40,
+	0x14, 0xc6, 0xdb, 0xdd, 0xd5, 0xd5, 0x59, 0x8c, 0xdd, 0x91, 0x03, 0xbb, 0x89, 0xdd, 0x0d, 0x27,
+	0x62, 0x62, 0x27, 0xc4, 0x83, 0x26, 0x9e, 0x4a, 0x21, 0xda, 0x08, 0x94, 0x0c, 0x4d, 0x8c, 0x5e,
+	0x9a, 0xd2, 0x0c, 0x65, 0x42, 0x3b, 0xaf, 0x74, 0x06, 0x84, 0xff, 0xc2, 0x3f, 0x8b, 0x23, 0x47,
+	0xe3, 0x81, 0x18, 0xf8, 0x47, 0x0c, 0x2d, 0x8a, 0x17, 0x93, 0xbd, 0x4c, 0xde, 0xef, 0x7d, 0xdf,
+	0x7c, 0x79, 0xc9, 0x87, 0x6a, 0x2c, 0xe5, 0x52, 0x72, 0x10, 0x92, 0x2c, 0x9a, 0x44, 0xad, 0x32,
+	0x26, 0xad, 0x2c, 0x07, 0x05, 0xb8, 0xf2, 0x57, 0xb1, 0x16, 0xcd, 0xdb, 0x9b, 0x08, 0x64, 0x0a,
+	0x32, 0x28, 0x34, 0x52, 0x42, 0x69, 0xbc, 0xbd, 0x0e, 0x53, 0x2e, 0x80, 0x14, 0xef, 0x71, 0x55,
+	0x8d, 0x21, 0x86, 0xd2, 0x7a, 0x98, 0xca, 0x6d, 0xfd, 0x03, 0x7a, 0x39, 0xe4, 0x69, 0x96, 0x30,
+	0x67, 0x9e, 0x4b, 0xc8, 0x07, 0x61, 0xcc, 0x45, 0xa8, 0x38, 0x08, 0xca, 0x66, 0x73, 0x26, 0x15,
+	0x36, 0xd0, 0xf9, 0x94, 0xad, 0x6a, 0xfa, 0xbd, 0xde, 0xa8, 0xd0, 0xc3, 0x88, 0xab, 0xe8, 0x51,
+	0xc2, 0x53, 0xae, 0x6a, 0x67, 0xf7, 0x7a, 0xe3, 0x82, 0x96, 0x50, 0x7f, 0x8f, 0xcc, 0xff, 0x05,
+	0xc9, 0x0c, 0x84, 0x64, 0xf8, 0x06, 0x3d, 0x11, 0x6c, 0xa9, 0x82, 0x53, 0xdc, 0xe5, 0x81, 0x3f,
+	0xb1, 0x55, 0x7d, 0x86, 0xaa, 0x5d, 0x2e, 0x15, 0x13, 0x5c, 0xc4, 0x0e, 0xb0, 0xf1, 0x98, 0x47,
+	0x9c, 0x09, 0x85, 0xbf, 0xa0, 0xab, 0xe8, 0x84, 0xc5, 0xaf, 0xa7, 0xad, 0xb7, 0xeb, 0xed, 0x9d,
+	0xf6, 0x73, 0x7b, 0x47, 0x62, 0xae, 0x26, 0xf3, 0x91, 0x15, 0x41, 0x4a, 0xc2, 0x24, 0x81, 0x3c,
+	0x7c, 0x2d, 0x98, 0xfa, 0x06, 0xf9, 0xf4, 0x0f, 0x46, 0x93, 0x90, 0x0b, 0x92, 0x86, 0x6a, 0x62,
+	0xb5, 0x59, 0x44, 0xff, 0xcd, 0x7a, 0xd5, 0x43, 0x17, 0x14, 0x12, 0x86, 0xaf, 0xd0, 0x65, 0xdf,
+	0x0b, 0xa8, 0xd7, 0xed, 0x18, 0x1a, 0x36, 0x50, 0x65, 0x60, 0x53, 0xbb, 0x37, 0x0c, 0xec, 0x76,
+	0xcf, 0xed, 0x1b, 0x3a, 0xbe, 0x46, 0xcf, 0x7c, 0x6f, 0xe0, 0x3a, 0x81, 0x43, 0x3b, 0xb6, 0xef,
+	0x51, 0xe3, 0x0c, 0xbf, 0x40, 0xcf, 0x3f, 0x7f, 0x74, 0xfd, 0x4e, 0xd7, 0x1d, 0xfa, 0x47, 0xdf,
+	0x79, 0x8b, 0xae, 0x77, 0xa6, 0xbe, 0xd9, 0x99, 0xfa, 0xaf, 0x9d, 0xa9, 0x7f, 0xdf, 0x9b, 0xda,
+	0x66, 0x6f, 0x6a, 0x3f, 0xf6, 0xa6, 0xf6, 0xf5, 0xdd, 0x03, 0xcf, 0x5c, 0x92, 0x53, 0xed, 0x45,
+	0xe7, 0xa3, 0xc7, 0x45, 0x45, 0x6f, 0x7e, 0x0f, 0x00, 0xf5, 0x89, 0x53, 0x45, 0x10, 0x02, 0x00,
+	0x00,
 }
 
 func (m *SimpleCursorPaginationRequest) Marshal() (dAtA []byte, err error) {
@@ -77,6 +77,10 @@ type Inference struct {
 	Value       github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,4,opt,name=value,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"value"`
 	ExtraData   []byte                                          `protobuf:"bytes,5,opt,name=extra_data,json=extraData,proto3" json:"extra_data,omitempty"`
 	Proof       string                                          `protobuf:"bytes,6,opt,name=proof,proto3" json:"proof,omitempty"`
+	// components of the inference in topics with an output dimension above 1
+	Values []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,7,rep,name=values,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"values"`
+	// index of the topic horizon the inference is made for
+	Horizon uint32 `protobuf:"varint,8,opt,name=horizon,proto3" json:"horizon,omitempty"`
 }
 
 func (m *Inference) Reset()         { *m = Inference{} }
@@ -147,6 +151,13 @@ func (m *Inference) GetProof() string {
 	return ""
 }
 
+func (m *Inference) GetHorizon() uint32 {
+	if m != nil {
+		return m.Horizon
+	}
+	return 0
+}
+
 type Inferences struct {
 	Inferences []*Inference `protobuf:"bytes,1,rep,name=inferences,proto3" json:"inferences,omitempty"`
 }
@@ -194,6 +205,9 @@ func (m *Inferences) GetInferences() []*Inference {
 type ForecastElement struct {
 	Inferer string                                          `protobuf:"bytes,1,opt,name=inferer,proto3" json:"inferer,omitempty"`
 	Value   github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,2,opt,name=value,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"value"`
+	// optional per-component forecasted losses in topics with an output dimension above 1.
+	// If empty, `value` is used as the forecasted loss of every component
+	Values []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,3,rep,name=values,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"values"`
 }
 
 func (m *ForecastElement) Reset()         { *m = ForecastElement{} }
@@ -242,6 +256,8 @@ type Forecast struct {
 	Forecaster       string             `protobuf:"bytes,3,opt,name=forecaster,proto3" json:"forecaster,omitempty"`
 	ForecastElements []*ForecastElement `protobuf:"bytes,4,rep,name=forecast_elements,json=forecastElements,proto3" json:"forecast_elements,omitempty"`
 	ExtraData        []byte             `protobuf:"bytes,5,opt,name=extra_data,json=extraData,proto3" json:"extra_data,omitempty"`
+	// index of the topic horizon whose inference losses are forecasted
+	Horizon uint32 `protobuf:"varint,6,opt,name=horizon,proto3" json:"horizon,omitempty"`
 }
 
 func (m *Forecast) Reset()         { *m = Forecast{} }
@@ -312,6 +328,13 @@ func (m *Forecast) GetExtraData() []byte {
 	return nil
 }
 
+func (m *Forecast) GetHorizon() uint32 {
+	if m != nil {
+		return m.Horizon
+	}
+	return 0
+}
+
 type Forecasts struct {
 	Forecasts []*Forecast `protobuf:"bytes,1,rep,name=forecasts,proto3" json:"forecasts,omitempty"`
 }
@@ -535,46 +558,49 @@ func init() {
 func init() { proto.RegisterFile("emissions/v1/worker.proto", fileDescriptor_f697c93e4bd2e89e) }
 
 var fileDescriptor_f697c93e4bd2e89e = []byte{
-	// 617 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x4f, 0xd4, 0x40,
-	0x14, 0xde, 0x81, 0xe5, 0xc7, 0x3e, 0x36, 0x11, 0x46, 0x02, 0x85, 0x84, 0xb2, 0x6e, 0x42, 0xb2,
-	0x17, 0xb7, 0x01, 0x35, 0x18, 0x6f, 0x12, 0x30, 0x62, 0xa2, 0x89, 0xa3, 0xd1, 0xc4, 0x4b, 0x33,
-	0xdb, 0x9d, 0xdd, 0x9d, 0xd0, 0x76, 0x9a, 0xce, 0x2c, 0x3f, 0x6e, 0x9c, 0x3d, 0xf9, 0x27, 0xf8,
-	0xe7, 0x70, 0xe4, 0xa8, 0x1e, 0x88, 0x81, 0x8b, 0x17, 0xff, 0x07, 0xd3, 0xe9, 0xb4, 0x65, 0x8b,
-	0xab, 0x26, 0x72, 0xeb, 0x7b, 0xf3, 0xf5, 0xbd, 0xef, 0x7b, 0xdf, 0xcc, 0x83, 0x15, 0x16, 0x70,
-	0x29, 0xb9, 0x08, 0xa5, 0x73, 0xb8, 0xe9, 0x1c, 0x89, 0xf8, 0x80, 0xc5, 0xed, 0x28, 0x16, 0x4a,
-	0xe0, 0x7a, 0x7e, 0xd4, 0x3e, 0xdc, 0x5c, 0x5d, 0xec, 0x8b, 0xbe, 0xd0, 0x07, 0x4e, 0xf2, 0x95,
-	0x62, 0x9a, 0x1f, 0x11, 0xcc, 0xbf, 0xe5, 0x01, 0x93, 0x8a, 0x06, 0x11, 0xeb, 0xbe, 0xa3, 0xfe,
-	0x90, 0xe1, 0x7b, 0x50, 0xef, 0xf8, 0xc2, 0x3b, 0x70, 0x07, 0x8c, 0xf7, 0x07, 0xca, 0x42, 0x0d,
-	0xd4, 0x9a, 0x24, 0x73, 0x3a, 0xf7, 0x5c, 0xa7, 0xf0, 0x4b, 0x98, 0x3a, 0x4c, 0xb0, 0xd6, 0x44,
-	0x03, 0xb5, 0x6a, 0x3b, 0xdb, 0x67, 0x17, 0xeb, 0x95, 0x6f, 0x17, 0xeb, 0x4e, 0x9f, 0xab, 0xc1,
-	0xb0, 0xd3, 0xf6, 0x44, 0xe0, 0x50, 0xdf, 0x17, 0x31, 0xbd, 0x1f, 0x32, 0x95, 0xb0, 0xca, 0x42,
-	0x6f, 0x40, 0x79, 0xe8, 0x04, 0x54, 0x0d, 0xda, 0xbb, 0xcc, 0x23, 0x69, 0x95, 0x27, 0xd5, 0x1f,
-	0x9f, 0xd7, 0x51, 0xf3, 0x27, 0x82, 0xda, 0x7e, 0xd8, 0x63, 0x31, 0x0b, 0x3d, 0x86, 0x57, 0x60,
-	0x56, 0x89, 0x88, 0x7b, 0x2e, 0xef, 0x6a, 0x06, 0x55, 0x32, 0xa3, 0xe3, 0xfd, 0xee, 0x0d, 0x82,
-	0x13, 0x37, 0x09, 0x5a, 0x30, 0xc3, 0x75, 0xa9, 0xd8, 0x9a, 0x4c, 0x28, 0x92, 0x2c, 0x2c, 0xa8,
-	0x57, 0x6f, 0x83, 0x3a, 0x5e, 0x03, 0x60, 0xc7, 0x2a, 0xa6, 0x6e, 0x97, 0x2a, 0x6a, 0x4d, 0x35,
-	0x50, 0xab, 0x4e, 0x6a, 0x3a, 0xb3, 0x4b, 0x15, 0xc5, 0x8b, 0x30, 0x15, 0xc5, 0x42, 0xf4, 0xac,
-	0x69, 0xcd, 0x22, 0x0d, 0x8c, 0xde, 0x3d, 0x80, 0x5c, 0xae, 0xc4, 0xdb, 0x00, 0x3c, 0x8f, 0x2c,
-	0xd4, 0x98, 0x6c, 0xcd, 0x6d, 0x2d, 0xb7, 0xaf, 0x7b, 0xd8, 0xce, 0xd1, 0xe4, 0x1a, 0xb4, 0x79,
-	0x8a, 0xe0, 0xce, 0x33, 0x11, 0x33, 0x8f, 0x4a, 0xb5, 0xe7, 0xb3, 0x80, 0x85, 0x23, 0xf2, 0xd1,
-	0x18, 0xf9, 0xb7, 0xe9, 0xdc, 0x57, 0x04, 0xb3, 0x19, 0x85, 0xff, 0x34, 0xce, 0x06, 0xe8, 0x99,
-	0x4a, 0xb9, 0x77, 0xd7, 0x32, 0xf8, 0x05, 0x2c, 0x64, 0x91, 0xcb, 0x52, 0xb5, 0xd2, 0xaa, 0xea,
-	0x69, 0xad, 0x8d, 0x4e, 0xab, 0x34, 0x13, 0x32, 0xdf, 0x1b, 0x4d, 0xc8, 0xbf, 0x78, 0x67, 0xb4,
-	0x3d, 0x85, 0x5a, 0x56, 0x49, 0xe2, 0x87, 0x50, 0xcb, 0xaa, 0x64, 0x1e, 0x2d, 0xfd, 0xbe, 0x2b,
-	0x29, 0x80, 0xc9, 0x2b, 0x5b, 0xce, 0xbd, 0xcb, 0x00, 0x3b, 0xc3, 0xb0, 0xeb, 0x33, 0xfc, 0x08,
-	0x6a, 0xb9, 0x97, 0x7a, 0x5c, 0x7f, 0x70, 0xbd, 0x40, 0xe2, 0x2d, 0x98, 0xcd, 0xea, 0xeb, 0x29,
-	0x8e, 0xe7, 0x91, 0xe3, 0x8c, 0x9e, 0xd3, 0x09, 0x98, 0x7f, 0xaf, 0xf7, 0x44, 0x22, 0xd2, 0xb0,
-	0x58, 0x82, 0xe9, 0x74, 0x77, 0x98, 0xeb, 0x62, 0x22, 0xec, 0xc1, 0x6a, 0xde, 0xd3, 0xcd, 0x05,
-	0xb9, 0x1d, 0xfd, 0x97, 0x69, 0xbc, 0x31, 0x86, 0xee, 0xa8, 0x50, 0x62, 0xf1, 0xf2, 0x81, 0x34,
-	0xcd, 0x5f, 0xc3, 0x46, 0x71, 0x9d, 0x6f, 0x74, 0x71, 0x25, 0xef, 0x87, 0x54, 0x0d, 0x63, 0xa6,
-	0x6f, 0x43, 0x9d, 0x34, 0x0b, 0x70, 0xa9, 0xd2, 0x9b, 0x0c, 0x99, 0xe8, 0x89, 0x86, 0x9d, 0x03,
-	0x76, 0x92, 0xbe, 0x72, 0x62, 0x22, 0x33, 0x02, 0x0f, 0x16, 0xca, 0x13, 0x90, 0xf8, 0x15, 0xdc,
-	0x4d, 0x45, 0xeb, 0xdb, 0x60, 0x9a, 0x67, 0x26, 0xdb, 0xa3, 0x1a, 0xcb, 0x7f, 0x93, 0x85, 0xa3,
-	0x72, 0xbd, 0x1d, 0x72, 0x76, 0x69, 0xa3, 0xf3, 0x4b, 0x1b, 0x7d, 0xbf, 0xb4, 0xd1, 0xa7, 0x2b,
-	0xbb, 0x72, 0x7e, 0x65, 0x57, 0xbe, 0x5c, 0xd9, 0x95, 0x0f, 0x8f, 0xff, 0xf1, 0xad, 0x1d, 0x3b,
-	0xc5, 0x72, 0x57, 0x27, 0x11, 0x93, 0x9d, 0x69, 0xbd, 0xb5, 0x1f, 0xfc, 0x0a, 0x00, 0x00, 0xff,
-	0xff, 0x0e, 0x57, 0xf0, 0x7a, 0xf6, 0x05, 0x00, 0x00,
+	// 658 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x4f, 0x13, 0x4f,
+	0x18, 0xee, 0xb4, 0xa5, 0xb4, 0x2f, 0xfd, 0xe5, 0x07, 0x23, 0x81, 0x85, 0x84, 0xa5, 0x36, 0x21,
+	0xe9, 0xc5, 0x6e, 0x40, 0x0d, 0xc6, 0x9b, 0x04, 0x8c, 0x98, 0xa8, 0x71, 0x34, 0x9a, 0x78, 0xd9,
+	0x4c, 0xb7, 0xd3, 0x76, 0x42, 0xbb, 0xd3, 0xec, 0x4c, 0xf9, 0xe3, 0xc9, 0xb3, 0x27, 0x3f, 0x82,
+	0x1f, 0x87, 0x23, 0xde, 0x88, 0x07, 0x62, 0xe0, 0xe2, 0xdd, 0x2f, 0x60, 0x76, 0x76, 0x66, 0xfb,
+	0x07, 0x50, 0x13, 0xb8, 0xf1, 0xbe, 0xf3, 0xcc, 0xfb, 0x3e, 0xcf, 0xc3, 0xd3, 0x59, 0x58, 0x62,
+	0x3d, 0x2e, 0x25, 0x17, 0xa1, 0xf4, 0xf6, 0xd7, 0xbd, 0x03, 0x11, 0xed, 0xb1, 0xa8, 0xde, 0x8f,
+	0x84, 0x12, 0xb8, 0x9c, 0x1e, 0xd5, 0xf7, 0xd7, 0x97, 0xe7, 0xdb, 0xa2, 0x2d, 0xf4, 0x81, 0x17,
+	0xff, 0x95, 0x60, 0xaa, 0x9f, 0x11, 0xcc, 0xbe, 0xe5, 0x3d, 0x26, 0x15, 0xed, 0xf5, 0x59, 0xf3,
+	0x1d, 0xed, 0x0e, 0x18, 0xbe, 0x0b, 0xe5, 0x46, 0x57, 0x04, 0x7b, 0x7e, 0x87, 0xf1, 0x76, 0x47,
+	0x39, 0xa8, 0x82, 0x6a, 0x39, 0x32, 0xa3, 0x7b, 0xcf, 0x74, 0x0b, 0xbf, 0x80, 0xa9, 0xfd, 0x18,
+	0xeb, 0x64, 0x2b, 0xa8, 0x56, 0xda, 0xda, 0x3c, 0x3e, 0x5b, 0xcd, 0x7c, 0x3f, 0x5b, 0xf5, 0xda,
+	0x5c, 0x75, 0x06, 0x8d, 0x7a, 0x20, 0x7a, 0x1e, 0xed, 0x76, 0x45, 0x44, 0xef, 0x85, 0x4c, 0xc5,
+	0xac, 0x6c, 0x19, 0x74, 0x28, 0x0f, 0xbd, 0x1e, 0x55, 0x9d, 0xfa, 0x36, 0x0b, 0x48, 0x32, 0xe5,
+	0x71, 0xfe, 0xe7, 0xd7, 0x55, 0x54, 0x3d, 0xcd, 0x42, 0x69, 0x37, 0x6c, 0xb1, 0x88, 0x85, 0x01,
+	0xc3, 0x4b, 0x50, 0x54, 0xa2, 0xcf, 0x03, 0x9f, 0x37, 0x35, 0x83, 0x3c, 0x99, 0xd6, 0xf5, 0x6e,
+	0xf3, 0x12, 0xc1, 0xec, 0x65, 0x82, 0x0e, 0x4c, 0x73, 0x3d, 0x2a, 0x72, 0x72, 0x31, 0x45, 0x62,
+	0xcb, 0x21, 0xf5, 0xfc, 0x6d, 0x50, 0xc7, 0x2b, 0x00, 0xec, 0x50, 0x45, 0xd4, 0x6f, 0x52, 0x45,
+	0x9d, 0xa9, 0x0a, 0xaa, 0x95, 0x49, 0x49, 0x77, 0xb6, 0xa9, 0xa2, 0x78, 0x1e, 0xa6, 0xfa, 0x91,
+	0x10, 0x2d, 0xa7, 0xa0, 0x59, 0x24, 0x05, 0x7e, 0x05, 0x05, 0x7d, 0x5b, 0x3a, 0xd3, 0x95, 0xdc,
+	0x4d, 0x48, 0x98, 0x31, 0xb1, 0xdc, 0x8e, 0x88, 0xf8, 0x47, 0x11, 0x3a, 0xc5, 0x0a, 0xaa, 0xfd,
+	0x47, 0x6c, 0x69, 0xac, 0xdd, 0x01, 0x48, 0x9d, 0x95, 0x78, 0x13, 0x80, 0xa7, 0x95, 0x83, 0x2a,
+	0xb9, 0xda, 0xcc, 0xc6, 0x62, 0x7d, 0x34, 0x2e, 0xf5, 0x14, 0x4d, 0x46, 0xa0, 0xd5, 0x6f, 0x08,
+	0xfe, 0x7f, 0x2a, 0x22, 0x16, 0x50, 0xa9, 0x76, 0xba, 0xac, 0xc7, 0xc2, 0x31, 0xa7, 0xd1, 0x35,
+	0x4e, 0xdf, 0x4a, 0x48, 0x46, 0x4c, 0xcb, 0xdd, 0x8a, 0x69, 0xc6, 0x9a, 0x5f, 0x08, 0x8a, 0x56,
+	0xd3, 0x0d, 0x43, 0xe7, 0x02, 0xb4, 0xcc, 0xa4, 0x34, 0x77, 0x23, 0x1d, 0xfc, 0x1c, 0xe6, 0x6c,
+	0xe5, 0xb3, 0xc4, 0x3e, 0xe9, 0xe4, 0xb5, 0xfd, 0x2b, 0xe3, 0xf6, 0x4f, 0x98, 0x4c, 0x66, 0x5b,
+	0xe3, 0x0d, 0xf9, 0xb7, 0xdc, 0x8d, 0x04, 0xa2, 0x70, 0x55, 0x20, 0x9e, 0x40, 0xc9, 0xee, 0x90,
+	0xf8, 0x01, 0x94, 0xec, 0x7c, 0x1b, 0x87, 0x85, 0xab, 0xf9, 0x90, 0x21, 0x30, 0x7e, 0x3b, 0x16,
+	0xd3, 0x98, 0x58, 0xc0, 0xd6, 0x20, 0x6c, 0x76, 0x19, 0x7e, 0x08, 0xa5, 0x34, 0x36, 0xda, 0xc8,
+	0x3f, 0x04, 0x6c, 0x88, 0xc4, 0x1b, 0x50, 0xb4, 0xf3, 0xb5, 0xbf, 0xd7, 0xf3, 0x48, 0x71, 0x46,
+	0xcf, 0xa7, 0x2c, 0xcc, 0xbe, 0xd7, 0xaf, 0x5f, 0x2c, 0xdf, 0xb0, 0x58, 0x80, 0x42, 0xf2, 0x22,
+	0x9a, 0x64, 0x9a, 0x0a, 0x07, 0xb0, 0x9c, 0xee, 0xf4, 0x53, 0x41, 0x7e, 0x43, 0xdf, 0x32, 0x8b,
+	0xd7, 0xae, 0xa1, 0x3b, 0x2e, 0x94, 0x38, 0x7c, 0xf2, 0x40, 0x9a, 0xe5, 0xaf, 0x61, 0x2d, 0x3d,
+	0x93, 0x97, 0xb6, 0xf8, 0x92, 0xb7, 0x43, 0xaa, 0x06, 0x11, 0xd3, 0x39, 0x29, 0x93, 0xea, 0x10,
+	0x3c, 0x31, 0xe9, 0x8d, 0x45, 0xc6, 0x7a, 0xfa, 0x83, 0xc6, 0x1e, 0x3b, 0x4a, 0xde, 0x2e, 0x62,
+	0x2a, 0x63, 0x41, 0x00, 0x73, 0x93, 0x0e, 0x48, 0xfc, 0x12, 0xee, 0x24, 0xa2, 0x75, 0x4e, 0xcc,
+	0x72, 0xfb, 0x4f, 0x76, 0xc7, 0x35, 0x4e, 0xde, 0x26, 0x73, 0x07, 0x93, 0xf3, 0xb6, 0xc8, 0xf1,
+	0xb9, 0x8b, 0x4e, 0xce, 0x5d, 0xf4, 0xe3, 0xdc, 0x45, 0x5f, 0x2e, 0xdc, 0xcc, 0xc9, 0x85, 0x9b,
+	0x39, 0xbd, 0x70, 0x33, 0x1f, 0x1e, 0xfd, 0xe3, 0xcf, 0xf0, 0xd0, 0x1b, 0x7e, 0xb2, 0xd4, 0x51,
+	0x9f, 0xc9, 0x46, 0x41, 0x7f, 0x8b, 0xee, 0xff, 0x1e, 0x00, 0x5a, 0x80, 0x1b, 0xac, 0xcc, 0x06,
+	0x00, 0x00,
 }
 
 func (this *TimestampedValue) Equal(that interface{}) bool {
@@ -641,6 +667,17 @@ func (this *Inference) Equal(that interface{}) bool {
 	if this.Proof != that1.Proof {
 		return false
 	}
+	if len(this.Values) != len(that1.Values) {
+		return false
+	}
+	for i := range this.Values {
+		if !this.Values[i].Equal(that1.Values[i]) {
+			return false
+		}
+	}
+	if this.Horizon != that1.Horizon {
+		return false
+	}
 	return true
 }
 func (this *ForecastElement) Equal(that interface{}) bool {
@@ -668,6 +705,14 @@ func (this *ForecastElement) Equal(that interface{}) bool {
 	if !this.Value.Equal(that1.Value) {
 		return false
 	}
+	if len(this.Values) != len(that1.Values) {
+		return false
+	}
+	for i := range this.Values {
+		if !this.Values[i].Equal(that1.Values[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *Forecast) Equal(that interface{}) bool {
@@ -709,6 +754,9 @@ func (this *Forecast) Equal(that interface{}) bool {
 	if !bytes.Equal(this.ExtraData, that1.ExtraData) {
 		return false
 	}
+	if this.Horizon != that1.Horizon {
+		return false
+	}
 	return true
 }
 func (this *InferenceForecastBundle) Equal(that interface{}) bool {
@@ -829,6 +877,25 @@ func (m *Inference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Horizon != 0 {
+		i = encodeVarintWorker(dAtA, i, uint64(m.Horizon))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Values[iNdEx].Size()
+				i -= size
+				if _, err := m.Values[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintWorker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.Proof) > 0 {
 		i -= len(m.Proof)
 		copy(dAtA[i:], m.Proof)
@@ -930,6 +997,20 @@ func (m *ForecastElement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Values[iNdEx].Size()
+				i -= size
+				if _, err := m.Values[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintWorker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size := m.Value.Size()
 		i -= size
@@ -970,6 +1051,11 @@ func (m *Forecast) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Horizon != 0 {
+		i = encodeVarintWorker(dAtA, i, uint64(m.Horizon))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.ExtraData) > 0 {
 		i -= len(m.ExtraData)
 		copy(dAtA[i:], m.ExtraData)
@@ -1239,6 +1325,15 @@ func (m *Inference) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovWorker(uint64(l))
 	}
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.Size()
+			n += 1 + l + sovWorker(uint64(l))
+		}
+	}
+	if m.Horizon != 0 {
+		n += 1 + sovWorker(uint64(m.Horizon))
+	}
 	return n
 }
 
@@ -1269,6 +1364,12 @@ func (m *ForecastElement) Size() (n int) {
 	}
 	l = m.Value.Size()
 	n += 1 + l + sovWorker(uint64(l))
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.Size()
+			n += 1 + l + sovWorker(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1298,6 +1399,9 @@ func (m *Forecast) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovWorker(uint64(l))
 	}
+	if m.Horizon != 0 {
+		n += 1 + sovWorker(uint64(m.Horizon))
+	}
 	return n
 }
 
@@ -1681,6 +1785,61 @@ func (m *Inference) Unmarshal(dAtA []byte) error {
 			}
 			m.Proof = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.Values = append(m.Values, v)
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Horizon", wireType)
+			}
+			m.Horizon = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Horizon |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorker(dAtA[iNdEx:])
@@ -1881,6 +2040,42 @@ func (m *ForecastElement) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.Values = append(m.Values, v)
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorker(dAtA[iNdEx:])
@@ -2069,6 +2264,25 @@ func (m *Forecast) Unmarshal(dAtA []byte) error {
 				m.ExtraData = []byte{}
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Horizon", wireType)
+			}
+			m.Horizon = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Horizon |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorker(dAtA[iNdEx:])
@@ -15,12 +15,70 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
+
+type _GenesisState_10_list struct {
+	list *[]*ValidatorsSplitRecord
+}
+
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorsSplitRecord)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorsSplitRecord)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	v := new(ValidatorsSplitRecord)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := new(ValidatorsSplitRecord)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_GenesisState                                                protoreflect.MessageDescriptor
 	fd_GenesisState_params                                         protoreflect.FieldDescriptor
 	fd_GenesisState_previous_reward_emission_per_unit_staked_token protoreflect.FieldDescriptor
 	fd_GenesisState_previous_block_emission                        protoreflect.FieldDescriptor
 	fd_GenesisState_ecosystem_tokens_minted                        protoreflect.FieldDescriptor
+	fd_GenesisState_latest_emission_info                           protoreflect.FieldDescriptor
+	fd_GenesisState_total_fees_burned                              protoreflect.FieldDescriptor
+	fd_GenesisState_total_fees_to_community_pool                   protoreflect.FieldDescriptor
+	fd_GenesisState_previous_ecosystem_balance                     protoreflect.FieldDescriptor
+	fd_GenesisState_last_emission_update_height                    protoreflect.FieldDescriptor
+	fd_GenesisState_validators_split_history                       protoreflect.FieldDescriptor
+	fd_GenesisState_emission_month_index                           protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -30,6 +88,13 @@ func init() {
 	fd_GenesisState_previous_reward_emission_per_unit_staked_token = md_GenesisState.Fields().ByName("previous_reward_emission_per_unit_staked_token")
 	fd_GenesisState_previous_block_emission = md_GenesisState.Fields().ByName("previous_block_emission")
 	fd_GenesisState_ecosystem_tokens_minted = md_GenesisState.Fields().ByName("ecosystem_tokens_minted")
+	fd_GenesisState_latest_emission_info = md_GenesisState.Fields().ByName("latest_emission_info")
+	fd_GenesisState_total_fees_burned = md_GenesisState.Fields().ByName("total_fees_burned")
+	fd_GenesisState_total_fees_to_community_pool = md_GenesisState.Fields().ByName("total_fees_to_community_pool")
+	fd_GenesisState_previous_ecosystem_balance = md_GenesisState.Fields().ByName("previous_ecosystem_balance")
+	fd_GenesisState_last_emission_update_height = md_GenesisState.Fields().ByName("last_emission_update_height")
+	fd_GenesisState_validators_split_history = md_GenesisState.Fields().ByName("validators_split_history")
+	fd_GenesisState_emission_month_index = md_GenesisState.Fields().ByName("emission_month_index")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -121,6 +186,48 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.LatestEmissionInfo != nil {
+		value := protoreflect.ValueOfMessage(x.LatestEmissionInfo.ProtoReflect())
+		if !f(fd_GenesisState_latest_emission_info, value) {
+			return
+		}
+	}
+	if x.TotalFeesBurned != "" {
+		value := protoreflect.ValueOfString(x.TotalFeesBurned)
+		if !f(fd_GenesisState_total_fees_burned, value) {
+			return
+		}
+	}
+	if x.TotalFeesToCommunityPool != "" {
+		value := protoreflect.ValueOfString(x.TotalFeesToCommunityPool)
+		if !f(fd_GenesisState_total_fees_to_community_pool, value) {
+			return
+		}
+	}
+	if x.PreviousEcosystemBalance != "" {
+		value := protoreflect.ValueOfString(x.PreviousEcosystemBalance)
+		if !f(fd_GenesisState_previous_ecosystem_balance, value) {
+			return
+		}
+	}
+	if x.LastEmissionUpdateHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastEmissionUpdateHeight)
+		if !f(fd_GenesisState_last_emission_update_height, value) {
+			return
+		}
+	}
+	if len(x.ValidatorsSplitHistory) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.ValidatorsSplitHistory})
+		if !f(fd_GenesisState_validators_split_history, value) {
+			return
+		}
+	}
+	if x.EmissionMonthIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EmissionMonthIndex)
+		if !f(fd_GenesisState_emission_month_index, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -144,6 +251,20 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return x.PreviousBlockEmission != ""
 	case "mint.v1beta1.GenesisState.ecosystem_tokens_minted":
 		return x.EcosystemTokensMinted != ""
+	case "mint.v1beta1.GenesisState.latest_emission_info":
+		return x.LatestEmissionInfo != nil
+	case "mint.v1beta1.GenesisState.total_fees_burned":
+		return x.TotalFeesBurned != ""
+	case "mint.v1beta1.GenesisState.total_fees_to_community_pool":
+		return x.TotalFeesToCommunityPool != ""
+	case "mint.v1beta1.GenesisState.previous_ecosystem_balance":
+		return x.PreviousEcosystemBalance != ""
+	case "mint.v1beta1.GenesisState.last_emission_update_height":
+		return x.LastEmissionUpdateHeight != int64(0)
+	case "mint.v1beta1.GenesisState.validators_split_history":
+		return len(x.ValidatorsSplitHistory) != 0
+	case "mint.v1beta1.GenesisState.emission_month_index":
+		return x.EmissionMonthIndex != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.GenesisState"))
@@ -168,6 +289,20 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.PreviousBlockEmission = ""
 	case "mint.v1beta1.GenesisState.ecosystem_tokens_minted":
 		x.EcosystemTokensMinted = ""
+	case "mint.v1beta1.GenesisState.latest_emission_info":
+		x.LatestEmissionInfo = nil
+	case "mint.v1beta1.GenesisState.total_fees_burned":
+		x.TotalFeesBurned = ""
+	case "mint.v1beta1.GenesisState.total_fees_to_community_pool":
+		x.TotalFeesToCommunityPool = ""
+	case "mint.v1beta1.GenesisState.previous_ecosystem_balance":
+		x.PreviousEcosystemBalance = ""
+	case "mint.v1beta1.GenesisState.last_emission_update_height":
+		x.LastEmissionUpdateHeight = int64(0)
+	case "mint.v1beta1.GenesisState.validators_split_history":
+		x.ValidatorsSplitHistory = nil
+	case "mint.v1beta1.GenesisState.emission_month_index":
+		x.EmissionMonthIndex = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.GenesisState"))
@@ -196,6 +331,30 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "mint.v1beta1.GenesisState.ecosystem_tokens_minted":
 		value := x.EcosystemTokensMinted
 		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.GenesisState.latest_emission_info":
+		value := x.LatestEmissionInfo
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "mint.v1beta1.GenesisState.total_fees_burned":
+		value := x.TotalFeesBurned
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.GenesisState.total_fees_to_community_pool":
+		value := x.TotalFeesToCommunityPool
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.GenesisState.previous_ecosystem_balance":
+		value := x.PreviousEcosystemBalance
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.GenesisState.last_emission_update_height":
+		value := x.LastEmissionUpdateHeight
+		return protoreflect.ValueOfInt64(value)
+	case "mint.v1beta1.GenesisState.validators_split_history":
+		if len(x.ValidatorsSplitHistory) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.ValidatorsSplitHistory}
+		return protoreflect.ValueOfList(listValue)
+	case "mint.v1beta1.GenesisState.emission_month_index":
+		value := x.EmissionMonthIndex
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.GenesisState"))
@@ -224,6 +383,22 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		x.PreviousBlockEmission = value.Interface().(string)
 	case "mint.v1beta1.GenesisState.ecosystem_tokens_minted":
 		x.EcosystemTokensMinted = value.Interface().(string)
+	case "mint.v1beta1.GenesisState.latest_emission_info":
+		x.LatestEmissionInfo = value.Message().Interface().(*EmissionInfo)
+	case "mint.v1beta1.GenesisState.total_fees_burned":
+		x.TotalFeesBurned = value.Interface().(string)
+	case "mint.v1beta1.GenesisState.total_fees_to_community_pool":
+		x.TotalFeesToCommunityPool = value.Interface().(string)
+	case "mint.v1beta1.GenesisState.previous_ecosystem_balance":
+		x.PreviousEcosystemBalance = value.Interface().(string)
+	case "mint.v1beta1.GenesisState.last_emission_update_height":
+		x.LastEmissionUpdateHeight = value.Int()
+	case "mint.v1beta1.GenesisState.validators_split_history":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.ValidatorsSplitHistory = *clv.list
+	case "mint.v1beta1.GenesisState.emission_month_index":
+		x.EmissionMonthIndex = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.GenesisState"))
@@ -249,12 +424,33 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 			x.Params = new(Params)
 		}
 		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "mint.v1beta1.GenesisState.latest_emission_info":
+		if x.LatestEmissionInfo == nil {
+			x.LatestEmissionInfo = new(EmissionInfo)
+		}
+		return protoreflect.ValueOfMessage(x.LatestEmissionInfo.ProtoReflect())
+	case "mint.v1beta1.GenesisState.validators_split_history":
+		if x.ValidatorsSplitHistory == nil {
+			x.ValidatorsSplitHistory = []*ValidatorsSplitRecord{}
+		}
+		value := &_GenesisState_10_list{list: &x.ValidatorsSplitHistory}
+		return protoreflect.ValueOfList(value)
 	case "mint.v1beta1.GenesisState.previous_reward_emission_per_unit_staked_token":
 		panic(fmt.Errorf("field previous_reward_emission_per_unit_staked_token of message mint.v1beta1.GenesisState is not mutable"))
 	case "mint.v1beta1.GenesisState.previous_block_emission":
 		panic(fmt.Errorf("field previous_block_emission of message mint.v1beta1.GenesisState is not mutable"))
 	case "mint.v1beta1.GenesisState.ecosystem_tokens_minted":
 		panic(fmt.Errorf("field ecosystem_tokens_minted of message mint.v1beta1.GenesisState is not mutable"))
+	case "mint.v1beta1.GenesisState.total_fees_burned":
+		panic(fmt.Errorf("field total_fees_burned of message mint.v1beta1.GenesisState is not mutable"))
+	case "mint.v1beta1.GenesisState.total_fees_to_community_pool":
+		panic(fmt.Errorf("field total_fees_to_community_pool of message mint.v1beta1.GenesisState is not mutable"))
+	case "mint.v1beta1.GenesisState.previous_ecosystem_balance":
+		panic(fmt.Errorf("field previous_ecosystem_balance of message mint.v1beta1.GenesisState is not mutable"))
+	case "mint.v1beta1.GenesisState.last_emission_update_height":
+		panic(fmt.Errorf("field last_emission_update_height of message mint.v1beta1.GenesisState is not mutable"))
+	case "mint.v1beta1.GenesisState.emission_month_index":
+		panic(fmt.Errorf("field emission_month_index of message mint.v1beta1.GenesisState is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.GenesisState"))
@@ -277,6 +473,22 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfString("")
 	case "mint.v1beta1.GenesisState.ecosystem_tokens_minted":
 		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.GenesisState.latest_emission_info":
+		m := new(EmissionInfo)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "mint.v1beta1.GenesisState.total_fees_burned":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.GenesisState.total_fees_to_community_pool":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.GenesisState.previous_ecosystem_balance":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.GenesisState.last_emission_update_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "mint.v1beta1.GenesisState.validators_split_history":
+		list := []*ValidatorsSplitRecord{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
+	case "mint.v1beta1.GenesisState.emission_month_index":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.GenesisState"))
@@ -362,6 +574,34 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.LatestEmissionInfo != nil {
+			l = options.Size(x.LatestEmissionInfo)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TotalFeesBurned)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TotalFeesToCommunityPool)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PreviousEcosystemBalance)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LastEmissionUpdateHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastEmissionUpdateHeight))
+		}
+		if len(x.ValidatorsSplitHistory) > 0 {
+			for _, e := range x.ValidatorsSplitHistory {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.EmissionMonthIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.EmissionMonthIndex))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -391,6 +631,67 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.EmissionMonthIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EmissionMonthIndex))
+			i--
+			dAtA[i] = 0x58
+		}
+		if len(x.ValidatorsSplitHistory) > 0 {
+			for iNdEx := len(x.ValidatorsSplitHistory) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ValidatorsSplitHistory[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if x.LastEmissionUpdateHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastEmissionUpdateHeight))
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.PreviousEcosystemBalance) > 0 {
+			i -= len(x.PreviousEcosystemBalance)
+			copy(dAtA[i:], x.PreviousEcosystemBalance)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PreviousEcosystemBalance)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.TotalFeesToCommunityPool) > 0 {
+			i -= len(x.TotalFeesToCommunityPool)
+			copy(dAtA[i:], x.TotalFeesToCommunityPool)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TotalFeesToCommunityPool)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.TotalFeesBurned) > 0 {
+			i -= len(x.TotalFeesBurned)
+			copy(dAtA[i:], x.TotalFeesBurned)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TotalFeesBurned)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.LatestEmissionInfo != nil {
+			encoded, err := options.Marshal(x.LatestEmissionInfo)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
 		if len(x.EcosystemTokensMinted) > 0 {
 			i -= len(x.EcosystemTokensMinted)
 			copy(dAtA[i:], x.EcosystemTokensMinted)
@@ -607,6 +908,210 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				}
 				x.EcosystemTokensMinted = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LatestEmissionInfo", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.LatestEmissionInfo == nil {
+					x.LatestEmissionInfo = &EmissionInfo{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LatestEmissionInfo); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalFeesBurned", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TotalFeesBurned = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalFeesToCommunityPool", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TotalFeesToCommunityPool = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousEcosystemBalance", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PreviousEcosystemBalance = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastEmissionUpdateHeight", wireType)
+				}
+				x.LastEmissionUpdateHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastEmissionUpdateHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorsSplitHistory", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorsSplitHistory = append(x.ValidatorsSplitHistory, &ValidatorsSplitRecord{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ValidatorsSplitHistory[len(x.ValidatorsSplitHistory)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EmissionMonthIndex", wireType)
+				}
+				x.EmissionMonthIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EmissionMonthIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -668,6 +1173,20 @@ type GenesisState struct {
 	PreviousBlockEmission                    string `protobuf:"bytes,3,opt,name=previous_block_emission,json=previousBlockEmission,proto3" json:"previous_block_emission,omitempty"`
 	// number of tokens minted into the ecosystem treasury
 	EcosystemTokensMinted string `protobuf:"bytes,4,opt,name=ecosystem_tokens_minted,json=ecosystemTokensMinted,proto3" json:"ecosystem_tokens_minted,omitempty"`
+	// intermediate values of the latest monthly update of the emission rate, if any
+	LatestEmissionInfo *EmissionInfo `protobuf:"bytes,5,opt,name=latest_emission_info,json=latestEmissionInfo,proto3" json:"latest_emission_info,omitempty"`
+	// fees paid into the ecosystem treasury that were burned
+	TotalFeesBurned string `protobuf:"bytes,6,opt,name=total_fees_burned,json=totalFeesBurned,proto3" json:"total_fees_burned,omitempty"`
+	// fees paid into the ecosystem treasury that were sent to the community pool
+	TotalFeesToCommunityPool string `protobuf:"bytes,7,opt,name=total_fees_to_community_pool,json=totalFeesToCommunityPool,proto3" json:"total_fees_to_community_pool,omitempty"`
+	// balance of the ecosystem treasury left by the previous block, anything above it is new fees
+	PreviousEcosystemBalance string `protobuf:"bytes,8,opt,name=previous_ecosystem_balance,json=previousEcosystemBalance,proto3" json:"previous_ecosystem_balance,omitempty"`
+	// height of the block that last updated the emission rate, 0 if it was never updated
+	LastEmissionUpdateHeight int64 `protobuf:"varint,9,opt,name=last_emission_update_height,json=lastEmissionUpdateHeight,proto3" json:"last_emission_update_height,omitempty"`
+	// splits chosen by the validators split controller in the latest blocks
+	ValidatorsSplitHistory []*ValidatorsSplitRecord `protobuf:"bytes,10,rep,name=validators_split_history,json=validatorsSplitHistory,proto3" json:"validators_split_history,omitempty"`
+	// month of the schedule of the emission model that the next emission update opens
+	EmissionMonthIndex uint64 `protobuf:"varint,11,opt,name=emission_month_index,json=emissionMonthIndex,proto3" json:"emission_month_index,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -718,6 +1237,55 @@ func (x *GenesisState) GetEcosystemTokensMinted() string {
 	return ""
 }
 
+func (x *GenesisState) GetLatestEmissionInfo() *EmissionInfo {
+	if x != nil {
+		return x.LatestEmissionInfo
+	}
+	return nil
+}
+
+func (x *GenesisState) GetTotalFeesBurned() string {
+	if x != nil {
+		return x.TotalFeesBurned
+	}
+	return ""
+}
+
+func (x *GenesisState) GetTotalFeesToCommunityPool() string {
+	if x != nil {
+		return x.TotalFeesToCommunityPool
+	}
+	return ""
+}
+
+func (x *GenesisState) GetPreviousEcosystemBalance() string {
+	if x != nil {
+		return x.PreviousEcosystemBalance
+	}
+	return ""
+}
+
+func (x *GenesisState) GetLastEmissionUpdateHeight() int64 {
+	if x != nil {
+		return x.LastEmissionUpdateHeight
+	}
+	return 0
+}
+
+func (x *GenesisState) GetValidatorsSplitHistory() []*ValidatorsSplitRecord {
+	if x != nil {
+		return x.ValidatorsSplitHistory
+	}
+	return nil
+}
+
+func (x *GenesisState) GetEmissionMonthIndex() uint64 {
+	if x != nil {
+		return x.EmissionMonthIndex
+	}
+	return 0
+}
+
 var File_mint_v1beta1_genesis_proto protoreflect.FileDescriptor
 
 var file_mint_v1beta1_genesis_proto_rawDesc = []byte{
@@ -729,7 +1297,7 @@ var file_mint_v1beta1_genesis_proto_rawDesc = []byte{
 	0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69,
-	0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbc, 0x03, 0x0a, 0x0c, 0x47, 0x65, 0x6e,
+	0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa5, 0x08, 0x0a, 0x0c, 0x47, 0x65, 0x6e,
 	0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x70, 0x61, 0x72,
 	0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x69, 0x6e, 0x74,
 	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42,
@@ -757,19 +1325,58 @@ var file_mint_v1beta1_genesis_proto_rawDesc = []byte{
 	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d,
 	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
 	0x52, 0x15, 0x65, 0x63, 0x6f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x73, 0x4d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x42, 0xbd, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e,
-	0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0c, 0x47, 0x65,
-	0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4a, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x6d, 0x69, 0x6e,
-	0x74, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x58, 0x58, 0xaa, 0x02,
-	0x0c, 0x4d, 0x69, 0x6e, 0x74, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x0c,
-	0x4d, 0x69, 0x6e, 0x74, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x18, 0x4d,
-	0x69, 0x6e, 0x74, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x4d, 0x69, 0x6e, 0x74, 0x3a, 0x3a,
-	0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x4d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x12, 0x4c, 0x0a, 0x14, 0x6c, 0x61, 0x74, 0x65, 0x73,
+	0x74, 0x5f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x12, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x5c, 0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66,
+	0x65, 0x65, 0x73, 0x5f, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2,
+	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x73, 0x42, 0x75, 0x72,
+	0x6e, 0x65, 0x64, 0x12, 0x70, 0x0a, 0x1c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x65, 0x65,
+	0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x5f, 0x70,
+	0x6f, 0x6f, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x18, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x46, 0x65, 0x65, 0x73, 0x54, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74,
+	0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x6e, 0x0a, 0x1a, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
+	0x73, 0x5f, 0x65, 0x63, 0x6f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x18, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x45, 0x63, 0x6f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x1b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x18, 0x6c, 0x61, 0x73, 0x74,
+	0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x68, 0x0a, 0x18, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x5f, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x53, 0x70, 0x6c, 0x69, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x42, 0x09, 0xc8, 0xde, 0x1f,
+	0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x16, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x30,
+	0x0a, 0x14, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x65, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x42, 0xbd, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f,
+	0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x6d, 0x69, 0x6e, 0x74, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0xa2, 0x02, 0x03, 0x4d, 0x58, 0x58, 0xaa, 0x02, 0x0c, 0x4d, 0x69, 0x6e, 0x74, 0x2e, 0x56,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x0c, 0x4d, 0x69, 0x6e, 0x74, 0x5c, 0x56, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x18, 0x4d, 0x69, 0x6e, 0x74, 0x5c, 0x56, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x0d, 0x4d, 0x69, 0x6e, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -786,16 +1393,20 @@ func file_mint_v1beta1_genesis_proto_rawDescGZIP() []byte {
 
 var file_mint_v1beta1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_mint_v1beta1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: mint.v1beta1.GenesisState
-	(*Params)(nil),       // 1: mint.v1beta1.Params
+	(*GenesisState)(nil),          // 0: mint.v1beta1.GenesisState
+	(*Params)(nil),                // 1: mint.v1beta1.Params
+	(*EmissionInfo)(nil),          // 2: mint.v1beta1.EmissionInfo
+	(*ValidatorsSplitRecord)(nil), // 3: mint.v1beta1.ValidatorsSplitRecord
 }
 var file_mint_v1beta1_genesis_proto_depIdxs = []int32{
 	1, // 0: mint.v1beta1.GenesisState.params:type_name -> mint.v1beta1.Params
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2, // 1: mint.v1beta1.GenesisState.latest_emission_info:type_name -> mint.v1beta1.EmissionInfo
+	3, // 2: mint.v1beta1.GenesisState.validators_split_history:type_name -> mint.v1beta1.ValidatorsSplitRecord
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_mint_v1beta1_genesis_proto_init() }
@@ -2,6 +2,7 @@
 package mintv1beta1
 
 import (
+	v1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
@@ -1585,144 +1586,8170 @@ func (x *fastReflection_QueryInflationResponse) ProtoMethods() *protoiface.Metho
 	}
 }
 
+var (
+	md_QueryVestingTranchesRequest protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_mint_v1beta1_query_proto_init()
+	md_QueryVestingTranchesRequest = File_mint_v1beta1_query_proto.Messages().ByName("QueryVestingTranchesRequest")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryVestingTranchesRequest)(nil)
+
+type fastReflection_QueryVestingTranchesRequest QueryVestingTranchesRequest
+
+func (x *QueryVestingTranchesRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryVestingTranchesRequest)(x)
+}
+
+func (x *QueryVestingTranchesRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_mint_v1beta1_query_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryVestingTranchesRequest_messageType fastReflection_QueryVestingTranchesRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryVestingTranchesRequest_messageType{}
+
+type fastReflection_QueryVestingTranchesRequest_messageType struct{}
+
+func (x fastReflection_QueryVestingTranchesRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryVestingTranchesRequest)(nil)
+}
+func (x fastReflection_QueryVestingTranchesRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryVestingTranchesRequest)
+}
+func (x fastReflection_QueryVestingTranchesRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVestingTranchesRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryVestingTranchesRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVestingTranchesRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryVestingTranchesRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryVestingTranchesRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryVestingTranchesRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryVestingTranchesRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryVestingTranchesRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryVestingTranchesRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryVestingTranchesRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryVestingTranchesRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryVestingTranchesRequest"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryVestingTranchesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVestingTranchesRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryVestingTranchesRequest"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryVestingTranchesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryVestingTranchesRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryVestingTranchesRequest"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryVestingTranchesRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVestingTranchesRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryVestingTranchesRequest"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryVestingTranchesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVestingTranchesRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryVestingTranchesRequest"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryVestingTranchesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryVestingTranchesRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryVestingTranchesRequest"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryVestingTranchesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryVestingTranchesRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mint.v1beta1.QueryVestingTranchesRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryVestingTranchesRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVestingTranchesRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryVestingTranchesRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryVestingTranchesRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryVestingTranchesRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryVestingTranchesRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryVestingTranchesRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVestingTranchesRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVestingTranchesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_VestingTrancheStatus          protoreflect.MessageDescriptor
+	fd_VestingTrancheStatus_tranche  protoreflect.FieldDescriptor
+	fd_VestingTrancheStatus_total    protoreflect.FieldDescriptor
+	fd_VestingTrancheStatus_locked   protoreflect.FieldDescriptor
+	fd_VestingTrancheStatus_unlocked protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mint_v1beta1_query_proto_init()
+	md_VestingTrancheStatus = File_mint_v1beta1_query_proto.Messages().ByName("VestingTrancheStatus")
+	fd_VestingTrancheStatus_tranche = md_VestingTrancheStatus.Fields().ByName("tranche")
+	fd_VestingTrancheStatus_total = md_VestingTrancheStatus.Fields().ByName("total")
+	fd_VestingTrancheStatus_locked = md_VestingTrancheStatus.Fields().ByName("locked")
+	fd_VestingTrancheStatus_unlocked = md_VestingTrancheStatus.Fields().ByName("unlocked")
+}
+
+var _ protoreflect.Message = (*fastReflection_VestingTrancheStatus)(nil)
+
+type fastReflection_VestingTrancheStatus VestingTrancheStatus
+
+func (x *VestingTrancheStatus) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VestingTrancheStatus)(x)
+}
+
+func (x *VestingTrancheStatus) slowProtoReflect() protoreflect.Message {
+	mi := &file_mint_v1beta1_query_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_VestingTrancheStatus_messageType fastReflection_VestingTrancheStatus_messageType
+var _ protoreflect.MessageType = fastReflection_VestingTrancheStatus_messageType{}
+
+type fastReflection_VestingTrancheStatus_messageType struct{}
+
+func (x fastReflection_VestingTrancheStatus_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VestingTrancheStatus)(nil)
+}
+func (x fastReflection_VestingTrancheStatus_messageType) New() protoreflect.Message {
+	return new(fastReflection_VestingTrancheStatus)
+}
+func (x fastReflection_VestingTrancheStatus_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VestingTrancheStatus
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_VestingTrancheStatus) Descriptor() protoreflect.MessageDescriptor {
+	return md_VestingTrancheStatus
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_VestingTrancheStatus) Type() protoreflect.MessageType {
+	return _fastReflection_VestingTrancheStatus_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_VestingTrancheStatus) New() protoreflect.Message {
+	return new(fastReflection_VestingTrancheStatus)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_VestingTrancheStatus) Interface() protoreflect.ProtoMessage {
+	return (*VestingTrancheStatus)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_VestingTrancheStatus) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Tranche != nil {
+		value := protoreflect.ValueOfMessage(x.Tranche.ProtoReflect())
+		if !f(fd_VestingTrancheStatus_tranche, value) {
+			return
+		}
+	}
+	if x.Total != "" {
+		value := protoreflect.ValueOfString(x.Total)
+		if !f(fd_VestingTrancheStatus_total, value) {
+			return
+		}
+	}
+	if x.Locked != "" {
+		value := protoreflect.ValueOfString(x.Locked)
+		if !f(fd_VestingTrancheStatus_locked, value) {
+			return
+		}
+	}
+	if x.Unlocked != "" {
+		value := protoreflect.ValueOfString(x.Unlocked)
+		if !f(fd_VestingTrancheStatus_unlocked, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_VestingTrancheStatus) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mint.v1beta1.VestingTrancheStatus.tranche":
+		return x.Tranche != nil
+	case "mint.v1beta1.VestingTrancheStatus.total":
+		return x.Total != ""
+	case "mint.v1beta1.VestingTrancheStatus.locked":
+		return x.Locked != ""
+	case "mint.v1beta1.VestingTrancheStatus.unlocked":
+		return x.Unlocked != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.VestingTrancheStatus"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.VestingTrancheStatus does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VestingTrancheStatus) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mint.v1beta1.VestingTrancheStatus.tranche":
+		x.Tranche = nil
+	case "mint.v1beta1.VestingTrancheStatus.total":
+		x.Total = ""
+	case "mint.v1beta1.VestingTrancheStatus.locked":
+		x.Locked = ""
+	case "mint.v1beta1.VestingTrancheStatus.unlocked":
+		x.Unlocked = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.VestingTrancheStatus"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.VestingTrancheStatus does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_VestingTrancheStatus) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mint.v1beta1.VestingTrancheStatus.tranche":
+		value := x.Tranche
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "mint.v1beta1.VestingTrancheStatus.total":
+		value := x.Total
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.VestingTrancheStatus.locked":
+		value := x.Locked
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.VestingTrancheStatus.unlocked":
+		value := x.Unlocked
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.VestingTrancheStatus"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.VestingTrancheStatus does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VestingTrancheStatus) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mint.v1beta1.VestingTrancheStatus.tranche":
+		x.Tranche = value.Message().Interface().(*VestingTranche)
+	case "mint.v1beta1.VestingTrancheStatus.total":
+		x.Total = value.Interface().(string)
+	case "mint.v1beta1.VestingTrancheStatus.locked":
+		x.Locked = value.Interface().(string)
+	case "mint.v1beta1.VestingTrancheStatus.unlocked":
+		x.Unlocked = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.VestingTrancheStatus"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.VestingTrancheStatus does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VestingTrancheStatus) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mint.v1beta1.VestingTrancheStatus.tranche":
+		if x.Tranche == nil {
+			x.Tranche = new(VestingTranche)
+		}
+		return protoreflect.ValueOfMessage(x.Tranche.ProtoReflect())
+	case "mint.v1beta1.VestingTrancheStatus.total":
+		panic(fmt.Errorf("field total of message mint.v1beta1.VestingTrancheStatus is not mutable"))
+	case "mint.v1beta1.VestingTrancheStatus.locked":
+		panic(fmt.Errorf("field locked of message mint.v1beta1.VestingTrancheStatus is not mutable"))
+	case "mint.v1beta1.VestingTrancheStatus.unlocked":
+		panic(fmt.Errorf("field unlocked of message mint.v1beta1.VestingTrancheStatus is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.VestingTrancheStatus"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.VestingTrancheStatus does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_VestingTrancheStatus) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mint.v1beta1.VestingTrancheStatus.tranche":
+		m := new(VestingTranche)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "mint.v1beta1.VestingTrancheStatus.total":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.VestingTrancheStatus.locked":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.VestingTrancheStatus.unlocked":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.VestingTrancheStatus"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.VestingTrancheStatus does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_VestingTrancheStatus) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mint.v1beta1.VestingTrancheStatus", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_VestingTrancheStatus) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VestingTrancheStatus) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_VestingTrancheStatus) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_VestingTrancheStatus) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*VestingTrancheStatus)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Tranche != nil {
+			l = options.Size(x.Tranche)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Total)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Locked)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Unlocked)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*VestingTrancheStatus)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Unlocked) > 0 {
+			i -= len(x.Unlocked)
+			copy(dAtA[i:], x.Unlocked)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Unlocked)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Locked) > 0 {
+			i -= len(x.Locked)
+			copy(dAtA[i:], x.Locked)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Locked)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Total) > 0 {
+			i -= len(x.Total)
+			copy(dAtA[i:], x.Total)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Total)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Tranche != nil {
+			encoded, err := options.Marshal(x.Tranche)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*VestingTrancheStatus)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VestingTrancheStatus: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VestingTrancheStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tranche", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Tranche == nil {
+					x.Tranche = &VestingTranche{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Tranche); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Total = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Locked = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Unlocked", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Unlocked = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryVestingTranchesResponse_2_list)(nil)
+
+type _QueryVestingTranchesResponse_2_list struct {
+	list *[]*VestingTrancheStatus
+}
+
+func (x *_QueryVestingTranchesResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryVestingTranchesResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryVestingTranchesResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VestingTrancheStatus)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryVestingTranchesResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VestingTrancheStatus)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryVestingTranchesResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(VestingTrancheStatus)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryVestingTranchesResponse_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryVestingTranchesResponse_2_list) NewElement() protoreflect.Value {
+	v := new(VestingTrancheStatus)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryVestingTranchesResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryVestingTranchesResponse              protoreflect.MessageDescriptor
+	fd_QueryVestingTranchesResponse_block_height protoreflect.FieldDescriptor
+	fd_QueryVestingTranchesResponse_tranches     protoreflect.FieldDescriptor
+	fd_QueryVestingTranchesResponse_total_locked protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mint_v1beta1_query_proto_init()
+	md_QueryVestingTranchesResponse = File_mint_v1beta1_query_proto.Messages().ByName("QueryVestingTranchesResponse")
+	fd_QueryVestingTranchesResponse_block_height = md_QueryVestingTranchesResponse.Fields().ByName("block_height")
+	fd_QueryVestingTranchesResponse_tranches = md_QueryVestingTranchesResponse.Fields().ByName("tranches")
+	fd_QueryVestingTranchesResponse_total_locked = md_QueryVestingTranchesResponse.Fields().ByName("total_locked")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryVestingTranchesResponse)(nil)
+
+type fastReflection_QueryVestingTranchesResponse QueryVestingTranchesResponse
+
+func (x *QueryVestingTranchesResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryVestingTranchesResponse)(x)
+}
+
+func (x *QueryVestingTranchesResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_mint_v1beta1_query_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryVestingTranchesResponse_messageType fastReflection_QueryVestingTranchesResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryVestingTranchesResponse_messageType{}
+
+type fastReflection_QueryVestingTranchesResponse_messageType struct{}
+
+func (x fastReflection_QueryVestingTranchesResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryVestingTranchesResponse)(nil)
+}
+func (x fastReflection_QueryVestingTranchesResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryVestingTranchesResponse)
+}
+func (x fastReflection_QueryVestingTranchesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVestingTranchesResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryVestingTranchesResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVestingTranchesResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryVestingTranchesResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryVestingTranchesResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryVestingTranchesResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryVestingTranchesResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryVestingTranchesResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryVestingTranchesResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryVestingTranchesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockHeight)
+		if !f(fd_QueryVestingTranchesResponse_block_height, value) {
+			return
+		}
+	}
+	if len(x.Tranches) != 0 {
+		value := protoreflect.ValueOfList(&_QueryVestingTranchesResponse_2_list{list: &x.Tranches})
+		if !f(fd_QueryVestingTranchesResponse_tranches, value) {
+			return
+		}
+	}
+	if x.TotalLocked != "" {
+		value := protoreflect.ValueOfString(x.TotalLocked)
+		if !f(fd_QueryVestingTranchesResponse_total_locked, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryVestingTranchesResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mint.v1beta1.QueryVestingTranchesResponse.block_height":
+		return x.BlockHeight != int64(0)
+	case "mint.v1beta1.QueryVestingTranchesResponse.tranches":
+		return len(x.Tranches) != 0
+	case "mint.v1beta1.QueryVestingTranchesResponse.total_locked":
+		return x.TotalLocked != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryVestingTranchesResponse"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryVestingTranchesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVestingTranchesResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mint.v1beta1.QueryVestingTranchesResponse.block_height":
+		x.BlockHeight = int64(0)
+	case "mint.v1beta1.QueryVestingTranchesResponse.tranches":
+		x.Tranches = nil
+	case "mint.v1beta1.QueryVestingTranchesResponse.total_locked":
+		x.TotalLocked = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryVestingTranchesResponse"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryVestingTranchesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryVestingTranchesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mint.v1beta1.QueryVestingTranchesResponse.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "mint.v1beta1.QueryVestingTranchesResponse.tranches":
+		if len(x.Tranches) == 0 {
+			return protoreflect.ValueOfList(&_QueryVestingTranchesResponse_2_list{})
+		}
+		listValue := &_QueryVestingTranchesResponse_2_list{list: &x.Tranches}
+		return protoreflect.ValueOfList(listValue)
+	case "mint.v1beta1.QueryVestingTranchesResponse.total_locked":
+		value := x.TotalLocked
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryVestingTranchesResponse"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryVestingTranchesResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVestingTranchesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mint.v1beta1.QueryVestingTranchesResponse.block_height":
+		x.BlockHeight = value.Int()
+	case "mint.v1beta1.QueryVestingTranchesResponse.tranches":
+		lv := value.List()
+		clv := lv.(*_QueryVestingTranchesResponse_2_list)
+		x.Tranches = *clv.list
+	case "mint.v1beta1.QueryVestingTranchesResponse.total_locked":
+		x.TotalLocked = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryVestingTranchesResponse"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryVestingTranchesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVestingTranchesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mint.v1beta1.QueryVestingTranchesResponse.tranches":
+		if x.Tranches == nil {
+			x.Tranches = []*VestingTrancheStatus{}
+		}
+		value := &_QueryVestingTranchesResponse_2_list{list: &x.Tranches}
+		return protoreflect.ValueOfList(value)
+	case "mint.v1beta1.QueryVestingTranchesResponse.block_height":
+		panic(fmt.Errorf("field block_height of message mint.v1beta1.QueryVestingTranchesResponse is not mutable"))
+	case "mint.v1beta1.QueryVestingTranchesResponse.total_locked":
+		panic(fmt.Errorf("field total_locked of message mint.v1beta1.QueryVestingTranchesResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryVestingTranchesResponse"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryVestingTranchesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryVestingTranchesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mint.v1beta1.QueryVestingTranchesResponse.block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "mint.v1beta1.QueryVestingTranchesResponse.tranches":
+		list := []*VestingTrancheStatus{}
+		return protoreflect.ValueOfList(&_QueryVestingTranchesResponse_2_list{list: &list})
+	case "mint.v1beta1.QueryVestingTranchesResponse.total_locked":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryVestingTranchesResponse"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryVestingTranchesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryVestingTranchesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mint.v1beta1.QueryVestingTranchesResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryVestingTranchesResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVestingTranchesResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryVestingTranchesResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryVestingTranchesResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryVestingTranchesResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		}
+		if len(x.Tranches) > 0 {
+			for _, e := range x.Tranches {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.TotalLocked)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryVestingTranchesResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.TotalLocked) > 0 {
+			i -= len(x.TotalLocked)
+			copy(dAtA[i:], x.TotalLocked)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TotalLocked)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Tranches) > 0 {
+			for iNdEx := len(x.Tranches) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Tranches[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryVestingTranchesResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVestingTranchesResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVestingTranchesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tranches", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Tranches = append(x.Tranches, &VestingTrancheStatus{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Tranches[len(x.Tranches)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalLocked", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TotalLocked = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryEmissionInfoRequest protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_mint_v1beta1_query_proto_init()
+	md_QueryEmissionInfoRequest = File_mint_v1beta1_query_proto.Messages().ByName("QueryEmissionInfoRequest")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryEmissionInfoRequest)(nil)
+
+type fastReflection_QueryEmissionInfoRequest QueryEmissionInfoRequest
+
+func (x *QueryEmissionInfoRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryEmissionInfoRequest)(x)
+}
+
+func (x *QueryEmissionInfoRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_mint_v1beta1_query_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryEmissionInfoRequest_messageType fastReflection_QueryEmissionInfoRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryEmissionInfoRequest_messageType{}
+
+type fastReflection_QueryEmissionInfoRequest_messageType struct{}
+
+func (x fastReflection_QueryEmissionInfoRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryEmissionInfoRequest)(nil)
+}
+func (x fastReflection_QueryEmissionInfoRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryEmissionInfoRequest)
+}
+func (x fastReflection_QueryEmissionInfoRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEmissionInfoRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryEmissionInfoRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEmissionInfoRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryEmissionInfoRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryEmissionInfoRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryEmissionInfoRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryEmissionInfoRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryEmissionInfoRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryEmissionInfoRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryEmissionInfoRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryEmissionInfoRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryEmissionInfoRequest"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryEmissionInfoRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEmissionInfoRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryEmissionInfoRequest"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryEmissionInfoRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryEmissionInfoRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryEmissionInfoRequest"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryEmissionInfoRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEmissionInfoRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryEmissionInfoRequest"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryEmissionInfoRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEmissionInfoRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryEmissionInfoRequest"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryEmissionInfoRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryEmissionInfoRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryEmissionInfoRequest"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryEmissionInfoRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryEmissionInfoRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mint.v1beta1.QueryEmissionInfoRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryEmissionInfoRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEmissionInfoRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryEmissionInfoRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryEmissionInfoRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryEmissionInfoRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryEmissionInfoRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryEmissionInfoRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEmissionInfoRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEmissionInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryEmissionInfoResponse                                 protoreflect.MessageDescriptor
+	fd_QueryEmissionInfoResponse_latest_emission_info            protoreflect.FieldDescriptor
+	fd_QueryEmissionInfoResponse_block_height                    protoreflect.FieldDescriptor
+	fd_QueryEmissionInfoResponse_ecosystem_balance               protoreflect.FieldDescriptor
+	fd_QueryEmissionInfoResponse_ecosystem_tokens_minted         protoreflect.FieldDescriptor
+	fd_QueryEmissionInfoResponse_ecosystem_mint_cap              protoreflect.FieldDescriptor
+	fd_QueryEmissionInfoResponse_ecosystem_mint_supply_remaining protoreflect.FieldDescriptor
+	fd_QueryEmissionInfoResponse_block_emission                  protoreflect.FieldDescriptor
+	fd_QueryEmissionInfoResponse_next_emission_update_height     protoreflect.FieldDescriptor
+	fd_QueryEmissionInfoResponse_last_emission_update_height     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mint_v1beta1_query_proto_init()
+	md_QueryEmissionInfoResponse = File_mint_v1beta1_query_proto.Messages().ByName("QueryEmissionInfoResponse")
+	fd_QueryEmissionInfoResponse_latest_emission_info = md_QueryEmissionInfoResponse.Fields().ByName("latest_emission_info")
+	fd_QueryEmissionInfoResponse_block_height = md_QueryEmissionInfoResponse.Fields().ByName("block_height")
+	fd_QueryEmissionInfoResponse_ecosystem_balance = md_QueryEmissionInfoResponse.Fields().ByName("ecosystem_balance")
+	fd_QueryEmissionInfoResponse_ecosystem_tokens_minted = md_QueryEmissionInfoResponse.Fields().ByName("ecosystem_tokens_minted")
+	fd_QueryEmissionInfoResponse_ecosystem_mint_cap = md_QueryEmissionInfoResponse.Fields().ByName("ecosystem_mint_cap")
+	fd_QueryEmissionInfoResponse_ecosystem_mint_supply_remaining = md_QueryEmissionInfoResponse.Fields().ByName("ecosystem_mint_supply_remaining")
+	fd_QueryEmissionInfoResponse_block_emission = md_QueryEmissionInfoResponse.Fields().ByName("block_emission")
+	fd_QueryEmissionInfoResponse_next_emission_update_height = md_QueryEmissionInfoResponse.Fields().ByName("next_emission_update_height")
+	fd_QueryEmissionInfoResponse_last_emission_update_height = md_QueryEmissionInfoResponse.Fields().ByName("last_emission_update_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryEmissionInfoResponse)(nil)
+
+type fastReflection_QueryEmissionInfoResponse QueryEmissionInfoResponse
+
+func (x *QueryEmissionInfoResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryEmissionInfoResponse)(x)
+}
+
+func (x *QueryEmissionInfoResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_mint_v1beta1_query_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryEmissionInfoResponse_messageType fastReflection_QueryEmissionInfoResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryEmissionInfoResponse_messageType{}
+
+type fastReflection_QueryEmissionInfoResponse_messageType struct{}
+
+func (x fastReflection_QueryEmissionInfoResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryEmissionInfoResponse)(nil)
+}
+func (x fastReflection_QueryEmissionInfoResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryEmissionInfoResponse)
+}
+func (x fastReflection_QueryEmissionInfoResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEmissionInfoResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryEmissionInfoResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEmissionInfoResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryEmissionInfoResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryEmissionInfoResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryEmissionInfoResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryEmissionInfoResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryEmissionInfoResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryEmissionInfoResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryEmissionInfoResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LatestEmissionInfo != nil {
+		value := protoreflect.ValueOfMessage(x.LatestEmissionInfo.ProtoReflect())
+		if !f(fd_QueryEmissionInfoResponse_latest_emission_info, value) {
+			return
+		}
+	}
+	if x.BlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockHeight)
+		if !f(fd_QueryEmissionInfoResponse_block_height, value) {
+			return
+		}
+	}
+	if x.EcosystemBalance != "" {
+		value := protoreflect.ValueOfString(x.EcosystemBalance)
+		if !f(fd_QueryEmissionInfoResponse_ecosystem_balance, value) {
+			return
+		}
+	}
+	if x.EcosystemTokensMinted != "" {
+		value := protoreflect.ValueOfString(x.EcosystemTokensMinted)
+		if !f(fd_QueryEmissionInfoResponse_ecosystem_tokens_minted, value) {
+			return
+		}
+	}
+	if x.EcosystemMintCap != "" {
+		value := protoreflect.ValueOfString(x.EcosystemMintCap)
+		if !f(fd_QueryEmissionInfoResponse_ecosystem_mint_cap, value) {
+			return
+		}
+	}
+	if x.EcosystemMintSupplyRemaining != "" {
+		value := protoreflect.ValueOfString(x.EcosystemMintSupplyRemaining)
+		if !f(fd_QueryEmissionInfoResponse_ecosystem_mint_supply_remaining, value) {
+			return
+		}
+	}
+	if x.BlockEmission != "" {
+		value := protoreflect.ValueOfString(x.BlockEmission)
+		if !f(fd_QueryEmissionInfoResponse_block_emission, value) {
+			return
+		}
+	}
+	if x.NextEmissionUpdateHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.NextEmissionUpdateHeight)
+		if !f(fd_QueryEmissionInfoResponse_next_emission_update_height, value) {
+			return
+		}
+	}
+	if x.LastEmissionUpdateHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastEmissionUpdateHeight)
+		if !f(fd_QueryEmissionInfoResponse_last_emission_update_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryEmissionInfoResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mint.v1beta1.QueryEmissionInfoResponse.latest_emission_info":
+		return x.LatestEmissionInfo != nil
+	case "mint.v1beta1.QueryEmissionInfoResponse.block_height":
+		return x.BlockHeight != int64(0)
+	case "mint.v1beta1.QueryEmissionInfoResponse.ecosystem_balance":
+		return x.EcosystemBalance != ""
+	case "mint.v1beta1.QueryEmissionInfoResponse.ecosystem_tokens_minted":
+		return x.EcosystemTokensMinted != ""
+	case "mint.v1beta1.QueryEmissionInfoResponse.ecosystem_mint_cap":
+		return x.EcosystemMintCap != ""
+	case "mint.v1beta1.QueryEmissionInfoResponse.ecosystem_mint_supply_remaining":
+		return x.EcosystemMintSupplyRemaining != ""
+	case "mint.v1beta1.QueryEmissionInfoResponse.block_emission":
+		return x.BlockEmission != ""
+	case "mint.v1beta1.QueryEmissionInfoResponse.next_emission_update_height":
+		return x.NextEmissionUpdateHeight != int64(0)
+	case "mint.v1beta1.QueryEmissionInfoResponse.last_emission_update_height":
+		return x.LastEmissionUpdateHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryEmissionInfoResponse"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryEmissionInfoResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEmissionInfoResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mint.v1beta1.QueryEmissionInfoResponse.latest_emission_info":
+		x.LatestEmissionInfo = nil
+	case "mint.v1beta1.QueryEmissionInfoResponse.block_height":
+		x.BlockHeight = int64(0)
+	case "mint.v1beta1.QueryEmissionInfoResponse.ecosystem_balance":
+		x.EcosystemBalance = ""
+	case "mint.v1beta1.QueryEmissionInfoResponse.ecosystem_tokens_minted":
+		x.EcosystemTokensMinted = ""
+	case "mint.v1beta1.QueryEmissionInfoResponse.ecosystem_mint_cap":
+		x.EcosystemMintCap = ""
+	case "mint.v1beta1.QueryEmissionInfoResponse.ecosystem_mint_supply_remaining":
+		x.EcosystemMintSupplyRemaining = ""
+	case "mint.v1beta1.QueryEmissionInfoResponse.block_emission":
+		x.BlockEmission = ""
+	case "mint.v1beta1.QueryEmissionInfoResponse.next_emission_update_height":
+		x.NextEmissionUpdateHeight = int64(0)
+	case "mint.v1beta1.QueryEmissionInfoResponse.last_emission_update_height":
+		x.LastEmissionUpdateHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryEmissionInfoResponse"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryEmissionInfoResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryEmissionInfoResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mint.v1beta1.QueryEmissionInfoResponse.latest_emission_info":
+		value := x.LatestEmissionInfo
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "mint.v1beta1.QueryEmissionInfoResponse.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "mint.v1beta1.QueryEmissionInfoResponse.ecosystem_balance":
+		value := x.EcosystemBalance
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.QueryEmissionInfoResponse.ecosystem_tokens_minted":
+		value := x.EcosystemTokensMinted
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.QueryEmissionInfoResponse.ecosystem_mint_cap":
+		value := x.EcosystemMintCap
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.QueryEmissionInfoResponse.ecosystem_mint_supply_remaining":
+		value := x.EcosystemMintSupplyRemaining
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.QueryEmissionInfoResponse.block_emission":
+		value := x.BlockEmission
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.QueryEmissionInfoResponse.next_emission_update_height":
+		value := x.NextEmissionUpdateHeight
+		return protoreflect.ValueOfInt64(value)
+	case "mint.v1beta1.QueryEmissionInfoResponse.last_emission_update_height":
+		value := x.LastEmissionUpdateHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryEmissionInfoResponse"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryEmissionInfoResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEmissionInfoResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mint.v1beta1.QueryEmissionInfoResponse.latest_emission_info":
+		x.LatestEmissionInfo = value.Message().Interface().(*EmissionInfo)
+	case "mint.v1beta1.QueryEmissionInfoResponse.block_height":
+		x.BlockHeight = value.Int()
+	case "mint.v1beta1.QueryEmissionInfoResponse.ecosystem_balance":
+		x.EcosystemBalance = value.Interface().(string)
+	case "mint.v1beta1.QueryEmissionInfoResponse.ecosystem_tokens_minted":
+		x.EcosystemTokensMinted = value.Interface().(string)
+	case "mint.v1beta1.QueryEmissionInfoResponse.ecosystem_mint_cap":
+		x.EcosystemMintCap = value.Interface().(string)
+	case "mint.v1beta1.QueryEmissionInfoResponse.ecosystem_mint_supply_remaining":
+		x.EcosystemMintSupplyRemaining = value.Interface().(string)
+	case "mint.v1beta1.QueryEmissionInfoResponse.block_emission":
+		x.BlockEmission = value.Interface().(string)
+	case "mint.v1beta1.QueryEmissionInfoResponse.next_emission_update_height":
+		x.NextEmissionUpdateHeight = value.Int()
+	case "mint.v1beta1.QueryEmissionInfoResponse.last_emission_update_height":
+		x.LastEmissionUpdateHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryEmissionInfoResponse"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryEmissionInfoResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEmissionInfoResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mint.v1beta1.QueryEmissionInfoResponse.latest_emission_info":
+		if x.LatestEmissionInfo == nil {
+			x.LatestEmissionInfo = new(EmissionInfo)
+		}
+		return protoreflect.ValueOfMessage(x.LatestEmissionInfo.ProtoReflect())
+	case "mint.v1beta1.QueryEmissionInfoResponse.block_height":
+		panic(fmt.Errorf("field block_height of message mint.v1beta1.QueryEmissionInfoResponse is not mutable"))
+	case "mint.v1beta1.QueryEmissionInfoResponse.ecosystem_balance":
+		panic(fmt.Errorf("field ecosystem_balance of message mint.v1beta1.QueryEmissionInfoResponse is not mutable"))
+	case "mint.v1beta1.QueryEmissionInfoResponse.ecosystem_tokens_minted":
+		panic(fmt.Errorf("field ecosystem_tokens_minted of message mint.v1beta1.QueryEmissionInfoResponse is not mutable"))
+	case "mint.v1beta1.QueryEmissionInfoResponse.ecosystem_mint_cap":
+		panic(fmt.Errorf("field ecosystem_mint_cap of message mint.v1beta1.QueryEmissionInfoResponse is not mutable"))
+	case "mint.v1beta1.QueryEmissionInfoResponse.ecosystem_mint_supply_remaining":
+		panic(fmt.Errorf("field ecosystem_mint_supply_remaining of message mint.v1beta1.QueryEmissionInfoResponse is not mutable"))
+	case "mint.v1beta1.QueryEmissionInfoResponse.block_emission":
+		panic(fmt.Errorf("field block_emission of message mint.v1beta1.QueryEmissionInfoResponse is not mutable"))
+	case "mint.v1beta1.QueryEmissionInfoResponse.next_emission_update_height":
+		panic(fmt.Errorf("field next_emission_update_height of message mint.v1beta1.QueryEmissionInfoResponse is not mutable"))
+	case "mint.v1beta1.QueryEmissionInfoResponse.last_emission_update_height":
+		panic(fmt.Errorf("field last_emission_update_height of message mint.v1beta1.QueryEmissionInfoResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryEmissionInfoResponse"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryEmissionInfoResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryEmissionInfoResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mint.v1beta1.QueryEmissionInfoResponse.latest_emission_info":
+		m := new(EmissionInfo)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "mint.v1beta1.QueryEmissionInfoResponse.block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "mint.v1beta1.QueryEmissionInfoResponse.ecosystem_balance":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.QueryEmissionInfoResponse.ecosystem_tokens_minted":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.QueryEmissionInfoResponse.ecosystem_mint_cap":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.QueryEmissionInfoResponse.ecosystem_mint_supply_remaining":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.QueryEmissionInfoResponse.block_emission":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.QueryEmissionInfoResponse.next_emission_update_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "mint.v1beta1.QueryEmissionInfoResponse.last_emission_update_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryEmissionInfoResponse"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryEmissionInfoResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryEmissionInfoResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mint.v1beta1.QueryEmissionInfoResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryEmissionInfoResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEmissionInfoResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryEmissionInfoResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryEmissionInfoResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryEmissionInfoResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.LatestEmissionInfo != nil {
+			l = options.Size(x.LatestEmissionInfo)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		}
+		l = len(x.EcosystemBalance)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.EcosystemTokensMinted)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.EcosystemMintCap)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.EcosystemMintSupplyRemaining)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BlockEmission)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.NextEmissionUpdateHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextEmissionUpdateHeight))
+		}
+		if x.LastEmissionUpdateHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastEmissionUpdateHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryEmissionInfoResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.LastEmissionUpdateHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastEmissionUpdateHeight))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.NextEmissionUpdateHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextEmissionUpdateHeight))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.BlockEmission) > 0 {
+			i -= len(x.BlockEmission)
+			copy(dAtA[i:], x.BlockEmission)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlockEmission)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.EcosystemMintSupplyRemaining) > 0 {
+			i -= len(x.EcosystemMintSupplyRemaining)
+			copy(dAtA[i:], x.EcosystemMintSupplyRemaining)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EcosystemMintSupplyRemaining)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.EcosystemMintCap) > 0 {
+			i -= len(x.EcosystemMintCap)
+			copy(dAtA[i:], x.EcosystemMintCap)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EcosystemMintCap)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.EcosystemTokensMinted) > 0 {
+			i -= len(x.EcosystemTokensMinted)
+			copy(dAtA[i:], x.EcosystemTokensMinted)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EcosystemTokensMinted)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.EcosystemBalance) > 0 {
+			i -= len(x.EcosystemBalance)
+			copy(dAtA[i:], x.EcosystemBalance)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EcosystemBalance)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.LatestEmissionInfo != nil {
+			encoded, err := options.Marshal(x.LatestEmissionInfo)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryEmissionInfoResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEmissionInfoResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEmissionInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LatestEmissionInfo", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.LatestEmissionInfo == nil {
+					x.LatestEmissionInfo = &EmissionInfo{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LatestEmissionInfo); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EcosystemBalance", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EcosystemBalance = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EcosystemTokensMinted", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EcosystemTokensMinted = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EcosystemMintCap", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EcosystemMintCap = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EcosystemMintSupplyRemaining", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EcosystemMintSupplyRemaining = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockEmission", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BlockEmission = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextEmissionUpdateHeight", wireType)
+				}
+				x.NextEmissionUpdateHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextEmissionUpdateHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastEmissionUpdateHeight", wireType)
+				}
+				x.LastEmissionUpdateHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastEmissionUpdateHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryProjectEmissionsRequest                  protoreflect.MessageDescriptor
+	fd_QueryProjectEmissionsRequest_months           protoreflect.FieldDescriptor
+	fd_QueryProjectEmissionsRequest_network_staked   protoreflect.FieldDescriptor
+	fd_QueryProjectEmissionsRequest_reputers_percent protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mint_v1beta1_query_proto_init()
+	md_QueryProjectEmissionsRequest = File_mint_v1beta1_query_proto.Messages().ByName("QueryProjectEmissionsRequest")
+	fd_QueryProjectEmissionsRequest_months = md_QueryProjectEmissionsRequest.Fields().ByName("months")
+	fd_QueryProjectEmissionsRequest_network_staked = md_QueryProjectEmissionsRequest.Fields().ByName("network_staked")
+	fd_QueryProjectEmissionsRequest_reputers_percent = md_QueryProjectEmissionsRequest.Fields().ByName("reputers_percent")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryProjectEmissionsRequest)(nil)
+
+type fastReflection_QueryProjectEmissionsRequest QueryProjectEmissionsRequest
+
+func (x *QueryProjectEmissionsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryProjectEmissionsRequest)(x)
+}
+
+func (x *QueryProjectEmissionsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_mint_v1beta1_query_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryProjectEmissionsRequest_messageType fastReflection_QueryProjectEmissionsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryProjectEmissionsRequest_messageType{}
+
+type fastReflection_QueryProjectEmissionsRequest_messageType struct{}
+
+func (x fastReflection_QueryProjectEmissionsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryProjectEmissionsRequest)(nil)
+}
+func (x fastReflection_QueryProjectEmissionsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryProjectEmissionsRequest)
+}
+func (x fastReflection_QueryProjectEmissionsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProjectEmissionsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryProjectEmissionsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProjectEmissionsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryProjectEmissionsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryProjectEmissionsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryProjectEmissionsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryProjectEmissionsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryProjectEmissionsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryProjectEmissionsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryProjectEmissionsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Months != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Months)
+		if !f(fd_QueryProjectEmissionsRequest_months, value) {
+			return
+		}
+	}
+	if x.NetworkStaked != "" {
+		value := protoreflect.ValueOfString(x.NetworkStaked)
+		if !f(fd_QueryProjectEmissionsRequest_network_staked, value) {
+			return
+		}
+	}
+	if x.ReputersPercent != "" {
+		value := protoreflect.ValueOfString(x.ReputersPercent)
+		if !f(fd_QueryProjectEmissionsRequest_reputers_percent, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryProjectEmissionsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mint.v1beta1.QueryProjectEmissionsRequest.months":
+		return x.Months != uint64(0)
+	case "mint.v1beta1.QueryProjectEmissionsRequest.network_staked":
+		return x.NetworkStaked != ""
+	case "mint.v1beta1.QueryProjectEmissionsRequest.reputers_percent":
+		return x.ReputersPercent != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryProjectEmissionsRequest"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryProjectEmissionsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryProjectEmissionsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mint.v1beta1.QueryProjectEmissionsRequest.months":
+		x.Months = uint64(0)
+	case "mint.v1beta1.QueryProjectEmissionsRequest.network_staked":
+		x.NetworkStaked = ""
+	case "mint.v1beta1.QueryProjectEmissionsRequest.reputers_percent":
+		x.ReputersPercent = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryProjectEmissionsRequest"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryProjectEmissionsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryProjectEmissionsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mint.v1beta1.QueryProjectEmissionsRequest.months":
+		value := x.Months
+		return protoreflect.ValueOfUint64(value)
+	case "mint.v1beta1.QueryProjectEmissionsRequest.network_staked":
+		value := x.NetworkStaked
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.QueryProjectEmissionsRequest.reputers_percent":
+		value := x.ReputersPercent
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryProjectEmissionsRequest"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryProjectEmissionsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryProjectEmissionsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mint.v1beta1.QueryProjectEmissionsRequest.months":
+		x.Months = value.Uint()
+	case "mint.v1beta1.QueryProjectEmissionsRequest.network_staked":
+		x.NetworkStaked = value.Interface().(string)
+	case "mint.v1beta1.QueryProjectEmissionsRequest.reputers_percent":
+		x.ReputersPercent = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryProjectEmissionsRequest"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryProjectEmissionsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryProjectEmissionsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mint.v1beta1.QueryProjectEmissionsRequest.months":
+		panic(fmt.Errorf("field months of message mint.v1beta1.QueryProjectEmissionsRequest is not mutable"))
+	case "mint.v1beta1.QueryProjectEmissionsRequest.network_staked":
+		panic(fmt.Errorf("field network_staked of message mint.v1beta1.QueryProjectEmissionsRequest is not mutable"))
+	case "mint.v1beta1.QueryProjectEmissionsRequest.reputers_percent":
+		panic(fmt.Errorf("field reputers_percent of message mint.v1beta1.QueryProjectEmissionsRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryProjectEmissionsRequest"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryProjectEmissionsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryProjectEmissionsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mint.v1beta1.QueryProjectEmissionsRequest.months":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "mint.v1beta1.QueryProjectEmissionsRequest.network_staked":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.QueryProjectEmissionsRequest.reputers_percent":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryProjectEmissionsRequest"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryProjectEmissionsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryProjectEmissionsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mint.v1beta1.QueryProjectEmissionsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryProjectEmissionsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryProjectEmissionsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryProjectEmissionsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryProjectEmissionsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryProjectEmissionsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Months != 0 {
+			n += 1 + runtime.Sov(uint64(x.Months))
+		}
+		l = len(x.NetworkStaked)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ReputersPercent)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryProjectEmissionsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ReputersPercent) > 0 {
+			i -= len(x.ReputersPercent)
+			copy(dAtA[i:], x.ReputersPercent)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReputersPercent)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.NetworkStaked) > 0 {
+			i -= len(x.NetworkStaked)
+			copy(dAtA[i:], x.NetworkStaked)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NetworkStaked)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Months != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Months))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryProjectEmissionsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProjectEmissionsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProjectEmissionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Months", wireType)
+				}
+				x.Months = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Months |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NetworkStaked", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NetworkStaked = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReputersPercent", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReputersPercent = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ProjectedEmission                                                     protoreflect.MessageDescriptor
+	fd_ProjectedEmission_month                                               protoreflect.FieldDescriptor
+	fd_ProjectedEmission_block_height                                        protoreflect.FieldDescriptor
+	fd_ProjectedEmission_network_staked                                      protoreflect.FieldDescriptor
+	fd_ProjectedEmission_total_supply                                        protoreflect.FieldDescriptor
+	fd_ProjectedEmission_locked_supply                                       protoreflect.FieldDescriptor
+	fd_ProjectedEmission_circulating_supply                                  protoreflect.FieldDescriptor
+	fd_ProjectedEmission_ecosystem_mint_supply_remaining                     protoreflect.FieldDescriptor
+	fd_ProjectedEmission_target_reward_emission_per_unit_staked_token        protoreflect.FieldDescriptor
+	fd_ProjectedEmission_capped_target_reward_emission_per_unit_staked_token protoreflect.FieldDescriptor
+	fd_ProjectedEmission_reward_emission_per_unit_staked_token               protoreflect.FieldDescriptor
+	fd_ProjectedEmission_emission_per_month                                  protoreflect.FieldDescriptor
+	fd_ProjectedEmission_tokens_minted                                       protoreflect.FieldDescriptor
+	fd_ProjectedEmission_cumulative_tokens_minted                            protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mint_v1beta1_query_proto_init()
+	md_ProjectedEmission = File_mint_v1beta1_query_proto.Messages().ByName("ProjectedEmission")
+	fd_ProjectedEmission_month = md_ProjectedEmission.Fields().ByName("month")
+	fd_ProjectedEmission_block_height = md_ProjectedEmission.Fields().ByName("block_height")
+	fd_ProjectedEmission_network_staked = md_ProjectedEmission.Fields().ByName("network_staked")
+	fd_ProjectedEmission_total_supply = md_ProjectedEmission.Fields().ByName("total_supply")
+	fd_ProjectedEmission_locked_supply = md_ProjectedEmission.Fields().ByName("locked_supply")
+	fd_ProjectedEmission_circulating_supply = md_ProjectedEmission.Fields().ByName("circulating_supply")
+	fd_ProjectedEmission_ecosystem_mint_supply_remaining = md_ProjectedEmission.Fields().ByName("ecosystem_mint_supply_remaining")
+	fd_ProjectedEmission_target_reward_emission_per_unit_staked_token = md_ProjectedEmission.Fields().ByName("target_reward_emission_per_unit_staked_token")
+	fd_ProjectedEmission_capped_target_reward_emission_per_unit_staked_token = md_ProjectedEmission.Fields().ByName("capped_target_reward_emission_per_unit_staked_token")
+	fd_ProjectedEmission_reward_emission_per_unit_staked_token = md_ProjectedEmission.Fields().ByName("reward_emission_per_unit_staked_token")
+	fd_ProjectedEmission_emission_per_month = md_ProjectedEmission.Fields().ByName("emission_per_month")
+	fd_ProjectedEmission_tokens_minted = md_ProjectedEmission.Fields().ByName("tokens_minted")
+	fd_ProjectedEmission_cumulative_tokens_minted = md_ProjectedEmission.Fields().ByName("cumulative_tokens_minted")
+}
+
+var _ protoreflect.Message = (*fastReflection_ProjectedEmission)(nil)
+
+type fastReflection_ProjectedEmission ProjectedEmission
+
+func (x *ProjectedEmission) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ProjectedEmission)(x)
+}
+
+func (x *ProjectedEmission) slowProtoReflect() protoreflect.Message {
+	mi := &file_mint_v1beta1_query_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ProjectedEmission_messageType fastReflection_ProjectedEmission_messageType
+var _ protoreflect.MessageType = fastReflection_ProjectedEmission_messageType{}
+
+type fastReflection_ProjectedEmission_messageType struct{}
+
+func (x fastReflection_ProjectedEmission_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ProjectedEmission)(nil)
+}
+func (x fastReflection_ProjectedEmission_messageType) New() protoreflect.Message {
+	return new(fastReflection_ProjectedEmission)
+}
+func (x fastReflection_ProjectedEmission_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProjectedEmission
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ProjectedEmission) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProjectedEmission
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ProjectedEmission) Type() protoreflect.MessageType {
+	return _fastReflection_ProjectedEmission_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ProjectedEmission) New() protoreflect.Message {
+	return new(fastReflection_ProjectedEmission)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ProjectedEmission) Interface() protoreflect.ProtoMessage {
+	return (*ProjectedEmission)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ProjectedEmission) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Month != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Month)
+		if !f(fd_ProjectedEmission_month, value) {
+			return
+		}
+	}
+	if x.BlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockHeight)
+		if !f(fd_ProjectedEmission_block_height, value) {
+			return
+		}
+	}
+	if x.NetworkStaked != "" {
+		value := protoreflect.ValueOfString(x.NetworkStaked)
+		if !f(fd_ProjectedEmission_network_staked, value) {
+			return
+		}
+	}
+	if x.TotalSupply != "" {
+		value := protoreflect.ValueOfString(x.TotalSupply)
+		if !f(fd_ProjectedEmission_total_supply, value) {
+			return
+		}
+	}
+	if x.LockedSupply != "" {
+		value := protoreflect.ValueOfString(x.LockedSupply)
+		if !f(fd_ProjectedEmission_locked_supply, value) {
+			return
+		}
+	}
+	if x.CirculatingSupply != "" {
+		value := protoreflect.ValueOfString(x.CirculatingSupply)
+		if !f(fd_ProjectedEmission_circulating_supply, value) {
+			return
+		}
+	}
+	if x.EcosystemMintSupplyRemaining != "" {
+		value := protoreflect.ValueOfString(x.EcosystemMintSupplyRemaining)
+		if !f(fd_ProjectedEmission_ecosystem_mint_supply_remaining, value) {
+			return
+		}
+	}
+	if x.TargetRewardEmissionPerUnitStakedToken != "" {
+		value := protoreflect.ValueOfString(x.TargetRewardEmissionPerUnitStakedToken)
+		if !f(fd_ProjectedEmission_target_reward_emission_per_unit_staked_token, value) {
+			return
+		}
+	}
+	if x.CappedTargetRewardEmissionPerUnitStakedToken != "" {
+		value := protoreflect.ValueOfString(x.CappedTargetRewardEmissionPerUnitStakedToken)
+		if !f(fd_ProjectedEmission_capped_target_reward_emission_per_unit_staked_token, value) {
+			return
+		}
+	}
+	if x.RewardEmissionPerUnitStakedToken != "" {
+		value := protoreflect.ValueOfString(x.RewardEmissionPerUnitStakedToken)
+		if !f(fd_ProjectedEmission_reward_emission_per_unit_staked_token, value) {
+			return
+		}
+	}
+	if x.EmissionPerMonth != "" {
+		value := protoreflect.ValueOfString(x.EmissionPerMonth)
+		if !f(fd_ProjectedEmission_emission_per_month, value) {
+			return
+		}
+	}
+	if x.TokensMinted != "" {
+		value := protoreflect.ValueOfString(x.TokensMinted)
+		if !f(fd_ProjectedEmission_tokens_minted, value) {
+			return
+		}
+	}
+	if x.CumulativeTokensMinted != "" {
+		value := protoreflect.ValueOfString(x.CumulativeTokensMinted)
+		if !f(fd_ProjectedEmission_cumulative_tokens_minted, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ProjectedEmission) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mint.v1beta1.ProjectedEmission.month":
+		return x.Month != uint64(0)
+	case "mint.v1beta1.ProjectedEmission.block_height":
+		return x.BlockHeight != int64(0)
+	case "mint.v1beta1.ProjectedEmission.network_staked":
+		return x.NetworkStaked != ""
+	case "mint.v1beta1.ProjectedEmission.total_supply":
+		return x.TotalSupply != ""
+	case "mint.v1beta1.ProjectedEmission.locked_supply":
+		return x.LockedSupply != ""
+	case "mint.v1beta1.ProjectedEmission.circulating_supply":
+		return x.CirculatingSupply != ""
+	case "mint.v1beta1.ProjectedEmission.ecosystem_mint_supply_remaining":
+		return x.EcosystemMintSupplyRemaining != ""
+	case "mint.v1beta1.ProjectedEmission.target_reward_emission_per_unit_staked_token":
+		return x.TargetRewardEmissionPerUnitStakedToken != ""
+	case "mint.v1beta1.ProjectedEmission.capped_target_reward_emission_per_unit_staked_token":
+		return x.CappedTargetRewardEmissionPerUnitStakedToken != ""
+	case "mint.v1beta1.ProjectedEmission.reward_emission_per_unit_staked_token":
+		return x.RewardEmissionPerUnitStakedToken != ""
+	case "mint.v1beta1.ProjectedEmission.emission_per_month":
+		return x.EmissionPerMonth != ""
+	case "mint.v1beta1.ProjectedEmission.tokens_minted":
+		return x.TokensMinted != ""
+	case "mint.v1beta1.ProjectedEmission.cumulative_tokens_minted":
+		return x.CumulativeTokensMinted != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.ProjectedEmission"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.ProjectedEmission does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProjectedEmission) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mint.v1beta1.ProjectedEmission.month":
+		x.Month = uint64(0)
+	case "mint.v1beta1.ProjectedEmission.block_height":
+		x.BlockHeight = int64(0)
+	case "mint.v1beta1.ProjectedEmission.network_staked":
+		x.NetworkStaked = ""
+	case "mint.v1beta1.ProjectedEmission.total_supply":
+		x.TotalSupply = ""
+	case "mint.v1beta1.ProjectedEmission.locked_supply":
+		x.LockedSupply = ""
+	case "mint.v1beta1.ProjectedEmission.circulating_supply":
+		x.CirculatingSupply = ""
+	case "mint.v1beta1.ProjectedEmission.ecosystem_mint_supply_remaining":
+		x.EcosystemMintSupplyRemaining = ""
+	case "mint.v1beta1.ProjectedEmission.target_reward_emission_per_unit_staked_token":
+		x.TargetRewardEmissionPerUnitStakedToken = ""
+	case "mint.v1beta1.ProjectedEmission.capped_target_reward_emission_per_unit_staked_token":
+		x.CappedTargetRewardEmissionPerUnitStakedToken = ""
+	case "mint.v1beta1.ProjectedEmission.reward_emission_per_unit_staked_token":
+		x.RewardEmissionPerUnitStakedToken = ""
+	case "mint.v1beta1.ProjectedEmission.emission_per_month":
+		x.EmissionPerMonth = ""
+	case "mint.v1beta1.ProjectedEmission.tokens_minted":
+		x.TokensMinted = ""
+	case "mint.v1beta1.ProjectedEmission.cumulative_tokens_minted":
+		x.CumulativeTokensMinted = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.ProjectedEmission"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.ProjectedEmission does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ProjectedEmission) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mint.v1beta1.ProjectedEmission.month":
+		value := x.Month
+		return protoreflect.ValueOfUint64(value)
+	case "mint.v1beta1.ProjectedEmission.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "mint.v1beta1.ProjectedEmission.network_staked":
+		value := x.NetworkStaked
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.ProjectedEmission.total_supply":
+		value := x.TotalSupply
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.ProjectedEmission.locked_supply":
+		value := x.LockedSupply
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.ProjectedEmission.circulating_supply":
+		value := x.CirculatingSupply
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.ProjectedEmission.ecosystem_mint_supply_remaining":
+		value := x.EcosystemMintSupplyRemaining
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.ProjectedEmission.target_reward_emission_per_unit_staked_token":
+		value := x.TargetRewardEmissionPerUnitStakedToken
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.ProjectedEmission.capped_target_reward_emission_per_unit_staked_token":
+		value := x.CappedTargetRewardEmissionPerUnitStakedToken
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.ProjectedEmission.reward_emission_per_unit_staked_token":
+		value := x.RewardEmissionPerUnitStakedToken
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.ProjectedEmission.emission_per_month":
+		value := x.EmissionPerMonth
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.ProjectedEmission.tokens_minted":
+		value := x.TokensMinted
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.ProjectedEmission.cumulative_tokens_minted":
+		value := x.CumulativeTokensMinted
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.ProjectedEmission"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.ProjectedEmission does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProjectedEmission) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mint.v1beta1.ProjectedEmission.month":
+		x.Month = value.Uint()
+	case "mint.v1beta1.ProjectedEmission.block_height":
+		x.BlockHeight = value.Int()
+	case "mint.v1beta1.ProjectedEmission.network_staked":
+		x.NetworkStaked = value.Interface().(string)
+	case "mint.v1beta1.ProjectedEmission.total_supply":
+		x.TotalSupply = value.Interface().(string)
+	case "mint.v1beta1.ProjectedEmission.locked_supply":
+		x.LockedSupply = value.Interface().(string)
+	case "mint.v1beta1.ProjectedEmission.circulating_supply":
+		x.CirculatingSupply = value.Interface().(string)
+	case "mint.v1beta1.ProjectedEmission.ecosystem_mint_supply_remaining":
+		x.EcosystemMintSupplyRemaining = value.Interface().(string)
+	case "mint.v1beta1.ProjectedEmission.target_reward_emission_per_unit_staked_token":
+		x.TargetRewardEmissionPerUnitStakedToken = value.Interface().(string)
+	case "mint.v1beta1.ProjectedEmission.capped_target_reward_emission_per_unit_staked_token":
+		x.CappedTargetRewardEmissionPerUnitStakedToken = value.Interface().(string)
+	case "mint.v1beta1.ProjectedEmission.reward_emission_per_unit_staked_token":
+		x.RewardEmissionPerUnitStakedToken = value.Interface().(string)
+	case "mint.v1beta1.ProjectedEmission.emission_per_month":
+		x.EmissionPerMonth = value.Interface().(string)
+	case "mint.v1beta1.ProjectedEmission.tokens_minted":
+		x.TokensMinted = value.Interface().(string)
+	case "mint.v1beta1.ProjectedEmission.cumulative_tokens_minted":
+		x.CumulativeTokensMinted = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.ProjectedEmission"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.ProjectedEmission does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProjectedEmission) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mint.v1beta1.ProjectedEmission.month":
+		panic(fmt.Errorf("field month of message mint.v1beta1.ProjectedEmission is not mutable"))
+	case "mint.v1beta1.ProjectedEmission.block_height":
+		panic(fmt.Errorf("field block_height of message mint.v1beta1.ProjectedEmission is not mutable"))
+	case "mint.v1beta1.ProjectedEmission.network_staked":
+		panic(fmt.Errorf("field network_staked of message mint.v1beta1.ProjectedEmission is not mutable"))
+	case "mint.v1beta1.ProjectedEmission.total_supply":
+		panic(fmt.Errorf("field total_supply of message mint.v1beta1.ProjectedEmission is not mutable"))
+	case "mint.v1beta1.ProjectedEmission.locked_supply":
+		panic(fmt.Errorf("field locked_supply of message mint.v1beta1.ProjectedEmission is not mutable"))
+	case "mint.v1beta1.ProjectedEmission.circulating_supply":
+		panic(fmt.Errorf("field circulating_supply of message mint.v1beta1.ProjectedEmission is not mutable"))
+	case "mint.v1beta1.ProjectedEmission.ecosystem_mint_supply_remaining":
+		panic(fmt.Errorf("field ecosystem_mint_supply_remaining of message mint.v1beta1.ProjectedEmission is not mutable"))
+	case "mint.v1beta1.ProjectedEmission.target_reward_emission_per_unit_staked_token":
+		panic(fmt.Errorf("field target_reward_emission_per_unit_staked_token of message mint.v1beta1.ProjectedEmission is not mutable"))
+	case "mint.v1beta1.ProjectedEmission.capped_target_reward_emission_per_unit_staked_token":
+		panic(fmt.Errorf("field capped_target_reward_emission_per_unit_staked_token of message mint.v1beta1.ProjectedEmission is not mutable"))
+	case "mint.v1beta1.ProjectedEmission.reward_emission_per_unit_staked_token":
+		panic(fmt.Errorf("field reward_emission_per_unit_staked_token of message mint.v1beta1.ProjectedEmission is not mutable"))
+	case "mint.v1beta1.ProjectedEmission.emission_per_month":
+		panic(fmt.Errorf("field emission_per_month of message mint.v1beta1.ProjectedEmission is not mutable"))
+	case "mint.v1beta1.ProjectedEmission.tokens_minted":
+		panic(fmt.Errorf("field tokens_minted of message mint.v1beta1.ProjectedEmission is not mutable"))
+	case "mint.v1beta1.ProjectedEmission.cumulative_tokens_minted":
+		panic(fmt.Errorf("field cumulative_tokens_minted of message mint.v1beta1.ProjectedEmission is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.ProjectedEmission"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.ProjectedEmission does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ProjectedEmission) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mint.v1beta1.ProjectedEmission.month":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "mint.v1beta1.ProjectedEmission.block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "mint.v1beta1.ProjectedEmission.network_staked":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.ProjectedEmission.total_supply":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.ProjectedEmission.locked_supply":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.ProjectedEmission.circulating_supply":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.ProjectedEmission.ecosystem_mint_supply_remaining":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.ProjectedEmission.target_reward_emission_per_unit_staked_token":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.ProjectedEmission.capped_target_reward_emission_per_unit_staked_token":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.ProjectedEmission.reward_emission_per_unit_staked_token":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.ProjectedEmission.emission_per_month":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.ProjectedEmission.tokens_minted":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.ProjectedEmission.cumulative_tokens_minted":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.ProjectedEmission"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.ProjectedEmission does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ProjectedEmission) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mint.v1beta1.ProjectedEmission", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ProjectedEmission) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProjectedEmission) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ProjectedEmission) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ProjectedEmission) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ProjectedEmission)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Month != 0 {
+			n += 1 + runtime.Sov(uint64(x.Month))
+		}
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		}
+		l = len(x.NetworkStaked)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TotalSupply)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LockedSupply)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CirculatingSupply)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.EcosystemMintSupplyRemaining)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TargetRewardEmissionPerUnitStakedToken)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CappedTargetRewardEmissionPerUnitStakedToken)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RewardEmissionPerUnitStakedToken)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.EmissionPerMonth)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TokensMinted)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CumulativeTokensMinted)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ProjectedEmission)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.CumulativeTokensMinted) > 0 {
+			i -= len(x.CumulativeTokensMinted)
+			copy(dAtA[i:], x.CumulativeTokensMinted)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CumulativeTokensMinted)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if len(x.TokensMinted) > 0 {
+			i -= len(x.TokensMinted)
+			copy(dAtA[i:], x.TokensMinted)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TokensMinted)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if len(x.EmissionPerMonth) > 0 {
+			i -= len(x.EmissionPerMonth)
+			copy(dAtA[i:], x.EmissionPerMonth)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EmissionPerMonth)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.RewardEmissionPerUnitStakedToken) > 0 {
+			i -= len(x.RewardEmissionPerUnitStakedToken)
+			copy(dAtA[i:], x.RewardEmissionPerUnitStakedToken)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RewardEmissionPerUnitStakedToken)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.CappedTargetRewardEmissionPerUnitStakedToken) > 0 {
+			i -= len(x.CappedTargetRewardEmissionPerUnitStakedToken)
+			copy(dAtA[i:], x.CappedTargetRewardEmissionPerUnitStakedToken)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CappedTargetRewardEmissionPerUnitStakedToken)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.TargetRewardEmissionPerUnitStakedToken) > 0 {
+			i -= len(x.TargetRewardEmissionPerUnitStakedToken)
+			copy(dAtA[i:], x.TargetRewardEmissionPerUnitStakedToken)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TargetRewardEmissionPerUnitStakedToken)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.EcosystemMintSupplyRemaining) > 0 {
+			i -= len(x.EcosystemMintSupplyRemaining)
+			copy(dAtA[i:], x.EcosystemMintSupplyRemaining)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EcosystemMintSupplyRemaining)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.CirculatingSupply) > 0 {
+			i -= len(x.CirculatingSupply)
+			copy(dAtA[i:], x.CirculatingSupply)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CirculatingSupply)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.LockedSupply) > 0 {
+			i -= len(x.LockedSupply)
+			copy(dAtA[i:], x.LockedSupply)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LockedSupply)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.TotalSupply) > 0 {
+			i -= len(x.TotalSupply)
+			copy(dAtA[i:], x.TotalSupply)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TotalSupply)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.NetworkStaked) > 0 {
+			i -= len(x.NetworkStaked)
+			copy(dAtA[i:], x.NetworkStaked)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NetworkStaked)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Month != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Month))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ProjectedEmission)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProjectedEmission: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProjectedEmission: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Month", wireType)
+				}
+				x.Month = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Month |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NetworkStaked", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NetworkStaked = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TotalSupply = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockedSupply", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LockedSupply = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CirculatingSupply", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CirculatingSupply = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EcosystemMintSupplyRemaining", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EcosystemMintSupplyRemaining = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetRewardEmissionPerUnitStakedToken", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TargetRewardEmissionPerUnitStakedToken = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CappedTargetRewardEmissionPerUnitStakedToken", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CappedTargetRewardEmissionPerUnitStakedToken = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardEmissionPerUnitStakedToken", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RewardEmissionPerUnitStakedToken = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EmissionPerMonth", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EmissionPerMonth = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TokensMinted", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TokensMinted = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CumulativeTokensMinted", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CumulativeTokensMinted = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryProjectEmissionsResponse_1_list)(nil)
+
+type _QueryProjectEmissionsResponse_1_list struct {
+	list *[]*ProjectedEmission
+}
+
+func (x *_QueryProjectEmissionsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryProjectEmissionsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryProjectEmissionsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProjectedEmission)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryProjectEmissionsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProjectedEmission)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryProjectEmissionsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(ProjectedEmission)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryProjectEmissionsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryProjectEmissionsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(ProjectedEmission)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryProjectEmissionsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryProjectEmissionsResponse        protoreflect.MessageDescriptor
+	fd_QueryProjectEmissionsResponse_months protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mint_v1beta1_query_proto_init()
+	md_QueryProjectEmissionsResponse = File_mint_v1beta1_query_proto.Messages().ByName("QueryProjectEmissionsResponse")
+	fd_QueryProjectEmissionsResponse_months = md_QueryProjectEmissionsResponse.Fields().ByName("months")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryProjectEmissionsResponse)(nil)
+
+type fastReflection_QueryProjectEmissionsResponse QueryProjectEmissionsResponse
+
+func (x *QueryProjectEmissionsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryProjectEmissionsResponse)(x)
+}
+
+func (x *QueryProjectEmissionsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_mint_v1beta1_query_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryProjectEmissionsResponse_messageType fastReflection_QueryProjectEmissionsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryProjectEmissionsResponse_messageType{}
+
+type fastReflection_QueryProjectEmissionsResponse_messageType struct{}
+
+func (x fastReflection_QueryProjectEmissionsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryProjectEmissionsResponse)(nil)
+}
+func (x fastReflection_QueryProjectEmissionsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryProjectEmissionsResponse)
+}
+func (x fastReflection_QueryProjectEmissionsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProjectEmissionsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryProjectEmissionsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProjectEmissionsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryProjectEmissionsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryProjectEmissionsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryProjectEmissionsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryProjectEmissionsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryProjectEmissionsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryProjectEmissionsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryProjectEmissionsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Months) != 0 {
+		value := protoreflect.ValueOfList(&_QueryProjectEmissionsResponse_1_list{list: &x.Months})
+		if !f(fd_QueryProjectEmissionsResponse_months, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryProjectEmissionsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mint.v1beta1.QueryProjectEmissionsResponse.months":
+		return len(x.Months) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryProjectEmissionsResponse"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryProjectEmissionsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryProjectEmissionsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mint.v1beta1.QueryProjectEmissionsResponse.months":
+		x.Months = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryProjectEmissionsResponse"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryProjectEmissionsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryProjectEmissionsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mint.v1beta1.QueryProjectEmissionsResponse.months":
+		if len(x.Months) == 0 {
+			return protoreflect.ValueOfList(&_QueryProjectEmissionsResponse_1_list{})
+		}
+		listValue := &_QueryProjectEmissionsResponse_1_list{list: &x.Months}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryProjectEmissionsResponse"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryProjectEmissionsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryProjectEmissionsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mint.v1beta1.QueryProjectEmissionsResponse.months":
+		lv := value.List()
+		clv := lv.(*_QueryProjectEmissionsResponse_1_list)
+		x.Months = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryProjectEmissionsResponse"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryProjectEmissionsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryProjectEmissionsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mint.v1beta1.QueryProjectEmissionsResponse.months":
+		if x.Months == nil {
+			x.Months = []*ProjectedEmission{}
+		}
+		value := &_QueryProjectEmissionsResponse_1_list{list: &x.Months}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryProjectEmissionsResponse"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryProjectEmissionsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryProjectEmissionsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mint.v1beta1.QueryProjectEmissionsResponse.months":
+		list := []*ProjectedEmission{}
+		return protoreflect.ValueOfList(&_QueryProjectEmissionsResponse_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryProjectEmissionsResponse"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryProjectEmissionsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryProjectEmissionsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mint.v1beta1.QueryProjectEmissionsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryProjectEmissionsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryProjectEmissionsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryProjectEmissionsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryProjectEmissionsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryProjectEmissionsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Months) > 0 {
+			for _, e := range x.Months {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryProjectEmissionsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Months) > 0 {
+			for iNdEx := len(x.Months) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Months[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryProjectEmissionsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProjectEmissionsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProjectEmissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Months", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Months = append(x.Months, &ProjectedEmission{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Months[len(x.Months)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryFeeSplitRequest protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_mint_v1beta1_query_proto_init()
+	md_QueryFeeSplitRequest = File_mint_v1beta1_query_proto.Messages().ByName("QueryFeeSplitRequest")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryFeeSplitRequest)(nil)
+
+type fastReflection_QueryFeeSplitRequest QueryFeeSplitRequest
+
+func (x *QueryFeeSplitRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryFeeSplitRequest)(x)
+}
+
+func (x *QueryFeeSplitRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_mint_v1beta1_query_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryFeeSplitRequest_messageType fastReflection_QueryFeeSplitRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryFeeSplitRequest_messageType{}
+
+type fastReflection_QueryFeeSplitRequest_messageType struct{}
+
+func (x fastReflection_QueryFeeSplitRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryFeeSplitRequest)(nil)
+}
+func (x fastReflection_QueryFeeSplitRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryFeeSplitRequest)
+}
+func (x fastReflection_QueryFeeSplitRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryFeeSplitRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryFeeSplitRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryFeeSplitRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryFeeSplitRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryFeeSplitRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryFeeSplitRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryFeeSplitRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryFeeSplitRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryFeeSplitRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryFeeSplitRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryFeeSplitRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryFeeSplitRequest"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryFeeSplitRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryFeeSplitRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryFeeSplitRequest"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryFeeSplitRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryFeeSplitRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryFeeSplitRequest"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryFeeSplitRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryFeeSplitRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryFeeSplitRequest"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryFeeSplitRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryFeeSplitRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryFeeSplitRequest"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryFeeSplitRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryFeeSplitRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryFeeSplitRequest"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryFeeSplitRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryFeeSplitRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mint.v1beta1.QueryFeeSplitRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryFeeSplitRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryFeeSplitRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryFeeSplitRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryFeeSplitRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryFeeSplitRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryFeeSplitRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryFeeSplitRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFeeSplitRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFeeSplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryFeeSplitResponse                              protoreflect.MessageDescriptor
+	fd_QueryFeeSplitResponse_fee_burn_fraction            protoreflect.FieldDescriptor
+	fd_QueryFeeSplitResponse_fee_community_pool_fraction  protoreflect.FieldDescriptor
+	fd_QueryFeeSplitResponse_total_fees_burned            protoreflect.FieldDescriptor
+	fd_QueryFeeSplitResponse_total_fees_to_community_pool protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mint_v1beta1_query_proto_init()
+	md_QueryFeeSplitResponse = File_mint_v1beta1_query_proto.Messages().ByName("QueryFeeSplitResponse")
+	fd_QueryFeeSplitResponse_fee_burn_fraction = md_QueryFeeSplitResponse.Fields().ByName("fee_burn_fraction")
+	fd_QueryFeeSplitResponse_fee_community_pool_fraction = md_QueryFeeSplitResponse.Fields().ByName("fee_community_pool_fraction")
+	fd_QueryFeeSplitResponse_total_fees_burned = md_QueryFeeSplitResponse.Fields().ByName("total_fees_burned")
+	fd_QueryFeeSplitResponse_total_fees_to_community_pool = md_QueryFeeSplitResponse.Fields().ByName("total_fees_to_community_pool")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryFeeSplitResponse)(nil)
+
+type fastReflection_QueryFeeSplitResponse QueryFeeSplitResponse
+
+func (x *QueryFeeSplitResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryFeeSplitResponse)(x)
+}
+
+func (x *QueryFeeSplitResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_mint_v1beta1_query_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryFeeSplitResponse_messageType fastReflection_QueryFeeSplitResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryFeeSplitResponse_messageType{}
+
+type fastReflection_QueryFeeSplitResponse_messageType struct{}
+
+func (x fastReflection_QueryFeeSplitResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryFeeSplitResponse)(nil)
+}
+func (x fastReflection_QueryFeeSplitResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryFeeSplitResponse)
+}
+func (x fastReflection_QueryFeeSplitResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryFeeSplitResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryFeeSplitResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryFeeSplitResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryFeeSplitResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryFeeSplitResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryFeeSplitResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryFeeSplitResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryFeeSplitResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryFeeSplitResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryFeeSplitResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.FeeBurnFraction != "" {
+		value := protoreflect.ValueOfString(x.FeeBurnFraction)
+		if !f(fd_QueryFeeSplitResponse_fee_burn_fraction, value) {
+			return
+		}
+	}
+	if x.FeeCommunityPoolFraction != "" {
+		value := protoreflect.ValueOfString(x.FeeCommunityPoolFraction)
+		if !f(fd_QueryFeeSplitResponse_fee_community_pool_fraction, value) {
+			return
+		}
+	}
+	if x.TotalFeesBurned != "" {
+		value := protoreflect.ValueOfString(x.TotalFeesBurned)
+		if !f(fd_QueryFeeSplitResponse_total_fees_burned, value) {
+			return
+		}
+	}
+	if x.TotalFeesToCommunityPool != "" {
+		value := protoreflect.ValueOfString(x.TotalFeesToCommunityPool)
+		if !f(fd_QueryFeeSplitResponse_total_fees_to_community_pool, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryFeeSplitResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mint.v1beta1.QueryFeeSplitResponse.fee_burn_fraction":
+		return x.FeeBurnFraction != ""
+	case "mint.v1beta1.QueryFeeSplitResponse.fee_community_pool_fraction":
+		return x.FeeCommunityPoolFraction != ""
+	case "mint.v1beta1.QueryFeeSplitResponse.total_fees_burned":
+		return x.TotalFeesBurned != ""
+	case "mint.v1beta1.QueryFeeSplitResponse.total_fees_to_community_pool":
+		return x.TotalFeesToCommunityPool != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryFeeSplitResponse"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryFeeSplitResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryFeeSplitResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mint.v1beta1.QueryFeeSplitResponse.fee_burn_fraction":
+		x.FeeBurnFraction = ""
+	case "mint.v1beta1.QueryFeeSplitResponse.fee_community_pool_fraction":
+		x.FeeCommunityPoolFraction = ""
+	case "mint.v1beta1.QueryFeeSplitResponse.total_fees_burned":
+		x.TotalFeesBurned = ""
+	case "mint.v1beta1.QueryFeeSplitResponse.total_fees_to_community_pool":
+		x.TotalFeesToCommunityPool = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryFeeSplitResponse"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryFeeSplitResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryFeeSplitResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mint.v1beta1.QueryFeeSplitResponse.fee_burn_fraction":
+		value := x.FeeBurnFraction
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.QueryFeeSplitResponse.fee_community_pool_fraction":
+		value := x.FeeCommunityPoolFraction
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.QueryFeeSplitResponse.total_fees_burned":
+		value := x.TotalFeesBurned
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.QueryFeeSplitResponse.total_fees_to_community_pool":
+		value := x.TotalFeesToCommunityPool
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryFeeSplitResponse"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryFeeSplitResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryFeeSplitResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mint.v1beta1.QueryFeeSplitResponse.fee_burn_fraction":
+		x.FeeBurnFraction = value.Interface().(string)
+	case "mint.v1beta1.QueryFeeSplitResponse.fee_community_pool_fraction":
+		x.FeeCommunityPoolFraction = value.Interface().(string)
+	case "mint.v1beta1.QueryFeeSplitResponse.total_fees_burned":
+		x.TotalFeesBurned = value.Interface().(string)
+	case "mint.v1beta1.QueryFeeSplitResponse.total_fees_to_community_pool":
+		x.TotalFeesToCommunityPool = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryFeeSplitResponse"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryFeeSplitResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryFeeSplitResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mint.v1beta1.QueryFeeSplitResponse.fee_burn_fraction":
+		panic(fmt.Errorf("field fee_burn_fraction of message mint.v1beta1.QueryFeeSplitResponse is not mutable"))
+	case "mint.v1beta1.QueryFeeSplitResponse.fee_community_pool_fraction":
+		panic(fmt.Errorf("field fee_community_pool_fraction of message mint.v1beta1.QueryFeeSplitResponse is not mutable"))
+	case "mint.v1beta1.QueryFeeSplitResponse.total_fees_burned":
+		panic(fmt.Errorf("field total_fees_burned of message mint.v1beta1.QueryFeeSplitResponse is not mutable"))
+	case "mint.v1beta1.QueryFeeSplitResponse.total_fees_to_community_pool":
+		panic(fmt.Errorf("field total_fees_to_community_pool of message mint.v1beta1.QueryFeeSplitResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryFeeSplitResponse"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryFeeSplitResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryFeeSplitResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mint.v1beta1.QueryFeeSplitResponse.fee_burn_fraction":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.QueryFeeSplitResponse.fee_community_pool_fraction":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.QueryFeeSplitResponse.total_fees_burned":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.QueryFeeSplitResponse.total_fees_to_community_pool":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryFeeSplitResponse"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryFeeSplitResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryFeeSplitResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mint.v1beta1.QueryFeeSplitResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryFeeSplitResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryFeeSplitResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryFeeSplitResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryFeeSplitResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryFeeSplitResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.FeeBurnFraction)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.FeeCommunityPoolFraction)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TotalFeesBurned)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TotalFeesToCommunityPool)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryFeeSplitResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.TotalFeesToCommunityPool) > 0 {
+			i -= len(x.TotalFeesToCommunityPool)
+			copy(dAtA[i:], x.TotalFeesToCommunityPool)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TotalFeesToCommunityPool)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.TotalFeesBurned) > 0 {
+			i -= len(x.TotalFeesBurned)
+			copy(dAtA[i:], x.TotalFeesBurned)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TotalFeesBurned)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.FeeCommunityPoolFraction) > 0 {
+			i -= len(x.FeeCommunityPoolFraction)
+			copy(dAtA[i:], x.FeeCommunityPoolFraction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FeeCommunityPoolFraction)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.FeeBurnFraction) > 0 {
+			i -= len(x.FeeBurnFraction)
+			copy(dAtA[i:], x.FeeBurnFraction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FeeBurnFraction)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryFeeSplitResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFeeSplitResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFeeSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeBurnFraction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeBurnFraction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeCommunityPoolFraction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeCommunityPoolFraction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalFeesBurned", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TotalFeesBurned = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalFeesToCommunityPool", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TotalFeesToCommunityPool = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryValidatorsSplitRequest            protoreflect.MessageDescriptor
+	fd_QueryValidatorsSplitRequest_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mint_v1beta1_query_proto_init()
+	md_QueryValidatorsSplitRequest = File_mint_v1beta1_query_proto.Messages().ByName("QueryValidatorsSplitRequest")
+	fd_QueryValidatorsSplitRequest_pagination = md_QueryValidatorsSplitRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryValidatorsSplitRequest)(nil)
+
+type fastReflection_QueryValidatorsSplitRequest QueryValidatorsSplitRequest
+
+func (x *QueryValidatorsSplitRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryValidatorsSplitRequest)(x)
+}
+
+func (x *QueryValidatorsSplitRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_mint_v1beta1_query_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryValidatorsSplitRequest_messageType fastReflection_QueryValidatorsSplitRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryValidatorsSplitRequest_messageType{}
+
+type fastReflection_QueryValidatorsSplitRequest_messageType struct{}
+
+func (x fastReflection_QueryValidatorsSplitRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryValidatorsSplitRequest)(nil)
+}
+func (x fastReflection_QueryValidatorsSplitRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorsSplitRequest)
+}
+func (x fastReflection_QueryValidatorsSplitRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorsSplitRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryValidatorsSplitRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorsSplitRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryValidatorsSplitRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryValidatorsSplitRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryValidatorsSplitRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorsSplitRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryValidatorsSplitRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryValidatorsSplitRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryValidatorsSplitRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryValidatorsSplitRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryValidatorsSplitRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mint.v1beta1.QueryValidatorsSplitRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryValidatorsSplitRequest"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryValidatorsSplitRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorsSplitRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mint.v1beta1.QueryValidatorsSplitRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryValidatorsSplitRequest"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryValidatorsSplitRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryValidatorsSplitRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mint.v1beta1.QueryValidatorsSplitRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryValidatorsSplitRequest"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryValidatorsSplitRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorsSplitRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mint.v1beta1.QueryValidatorsSplitRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryValidatorsSplitRequest"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryValidatorsSplitRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorsSplitRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mint.v1beta1.QueryValidatorsSplitRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryValidatorsSplitRequest"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryValidatorsSplitRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryValidatorsSplitRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mint.v1beta1.QueryValidatorsSplitRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryValidatorsSplitRequest"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryValidatorsSplitRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryValidatorsSplitRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mint.v1beta1.QueryValidatorsSplitRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryValidatorsSplitRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorsSplitRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryValidatorsSplitRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryValidatorsSplitRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryValidatorsSplitRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryValidatorsSplitRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryValidatorsSplitRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorsSplitRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorsSplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryValidatorsSplitResponse_6_list)(nil)
+
+type _QueryValidatorsSplitResponse_6_list struct {
+	list *[]*ValidatorsSplitRecord
+}
+
+func (x *_QueryValidatorsSplitResponse_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryValidatorsSplitResponse_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryValidatorsSplitResponse_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorsSplitRecord)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryValidatorsSplitResponse_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorsSplitRecord)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryValidatorsSplitResponse_6_list) AppendMutable() protoreflect.Value {
+	v := new(ValidatorsSplitRecord)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryValidatorsSplitResponse_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryValidatorsSplitResponse_6_list) NewElement() protoreflect.Value {
+	v := new(ValidatorsSplitRecord)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryValidatorsSplitResponse_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryValidatorsSplitResponse                          protoreflect.MessageDescriptor
+	fd_QueryValidatorsSplitResponse_controller_enabled       protoreflect.FieldDescriptor
+	fd_QueryValidatorsSplitResponse_validators_percent       protoreflect.FieldDescriptor
+	fd_QueryValidatorsSplitResponse_cosmos_validators_staked protoreflect.FieldDescriptor
+	fd_QueryValidatorsSplitResponse_reputers_staked          protoreflect.FieldDescriptor
+	fd_QueryValidatorsSplitResponse_validators_stake_ratio   protoreflect.FieldDescriptor
+	fd_QueryValidatorsSplitResponse_history                  protoreflect.FieldDescriptor
+	fd_QueryValidatorsSplitResponse_pagination               protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mint_v1beta1_query_proto_init()
+	md_QueryValidatorsSplitResponse = File_mint_v1beta1_query_proto.Messages().ByName("QueryValidatorsSplitResponse")
+	fd_QueryValidatorsSplitResponse_controller_enabled = md_QueryValidatorsSplitResponse.Fields().ByName("controller_enabled")
+	fd_QueryValidatorsSplitResponse_validators_percent = md_QueryValidatorsSplitResponse.Fields().ByName("validators_percent")
+	fd_QueryValidatorsSplitResponse_cosmos_validators_staked = md_QueryValidatorsSplitResponse.Fields().ByName("cosmos_validators_staked")
+	fd_QueryValidatorsSplitResponse_reputers_staked = md_QueryValidatorsSplitResponse.Fields().ByName("reputers_staked")
+	fd_QueryValidatorsSplitResponse_validators_stake_ratio = md_QueryValidatorsSplitResponse.Fields().ByName("validators_stake_ratio")
+	fd_QueryValidatorsSplitResponse_history = md_QueryValidatorsSplitResponse.Fields().ByName("history")
+	fd_QueryValidatorsSplitResponse_pagination = md_QueryValidatorsSplitResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryValidatorsSplitResponse)(nil)
+
+type fastReflection_QueryValidatorsSplitResponse QueryValidatorsSplitResponse
+
+func (x *QueryValidatorsSplitResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryValidatorsSplitResponse)(x)
+}
+
+func (x *QueryValidatorsSplitResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_mint_v1beta1_query_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryValidatorsSplitResponse_messageType fastReflection_QueryValidatorsSplitResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryValidatorsSplitResponse_messageType{}
+
+type fastReflection_QueryValidatorsSplitResponse_messageType struct{}
+
+func (x fastReflection_QueryValidatorsSplitResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryValidatorsSplitResponse)(nil)
+}
+func (x fastReflection_QueryValidatorsSplitResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorsSplitResponse)
+}
+func (x fastReflection_QueryValidatorsSplitResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorsSplitResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryValidatorsSplitResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorsSplitResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryValidatorsSplitResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryValidatorsSplitResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryValidatorsSplitResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorsSplitResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryValidatorsSplitResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryValidatorsSplitResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryValidatorsSplitResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ControllerEnabled != false {
+		value := protoreflect.ValueOfBool(x.ControllerEnabled)
+		if !f(fd_QueryValidatorsSplitResponse_controller_enabled, value) {
+			return
+		}
+	}
+	if x.ValidatorsPercent != "" {
+		value := protoreflect.ValueOfString(x.ValidatorsPercent)
+		if !f(fd_QueryValidatorsSplitResponse_validators_percent, value) {
+			return
+		}
+	}
+	if x.CosmosValidatorsStaked != "" {
+		value := protoreflect.ValueOfString(x.CosmosValidatorsStaked)
+		if !f(fd_QueryValidatorsSplitResponse_cosmos_validators_staked, value) {
+			return
+		}
+	}
+	if x.ReputersStaked != "" {
+		value := protoreflect.ValueOfString(x.ReputersStaked)
+		if !f(fd_QueryValidatorsSplitResponse_reputers_staked, value) {
+			return
+		}
+	}
+	if x.ValidatorsStakeRatio != "" {
+		value := protoreflect.ValueOfString(x.ValidatorsStakeRatio)
+		if !f(fd_QueryValidatorsSplitResponse_validators_stake_ratio, value) {
+			return
+		}
+	}
+	if len(x.History) != 0 {
+		value := protoreflect.ValueOfList(&_QueryValidatorsSplitResponse_6_list{list: &x.History})
+		if !f(fd_QueryValidatorsSplitResponse_history, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryValidatorsSplitResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryValidatorsSplitResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mint.v1beta1.QueryValidatorsSplitResponse.controller_enabled":
+		return x.ControllerEnabled != false
+	case "mint.v1beta1.QueryValidatorsSplitResponse.validators_percent":
+		return x.ValidatorsPercent != ""
+	case "mint.v1beta1.QueryValidatorsSplitResponse.cosmos_validators_staked":
+		return x.CosmosValidatorsStaked != ""
+	case "mint.v1beta1.QueryValidatorsSplitResponse.reputers_staked":
+		return x.ReputersStaked != ""
+	case "mint.v1beta1.QueryValidatorsSplitResponse.validators_stake_ratio":
+		return x.ValidatorsStakeRatio != ""
+	case "mint.v1beta1.QueryValidatorsSplitResponse.history":
+		return len(x.History) != 0
+	case "mint.v1beta1.QueryValidatorsSplitResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryValidatorsSplitResponse"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryValidatorsSplitResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorsSplitResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mint.v1beta1.QueryValidatorsSplitResponse.controller_enabled":
+		x.ControllerEnabled = false
+	case "mint.v1beta1.QueryValidatorsSplitResponse.validators_percent":
+		x.ValidatorsPercent = ""
+	case "mint.v1beta1.QueryValidatorsSplitResponse.cosmos_validators_staked":
+		x.CosmosValidatorsStaked = ""
+	case "mint.v1beta1.QueryValidatorsSplitResponse.reputers_staked":
+		x.ReputersStaked = ""
+	case "mint.v1beta1.QueryValidatorsSplitResponse.validators_stake_ratio":
+		x.ValidatorsStakeRatio = ""
+	case "mint.v1beta1.QueryValidatorsSplitResponse.history":
+		x.History = nil
+	case "mint.v1beta1.QueryValidatorsSplitResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryValidatorsSplitResponse"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryValidatorsSplitResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryValidatorsSplitResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mint.v1beta1.QueryValidatorsSplitResponse.controller_enabled":
+		value := x.ControllerEnabled
+		return protoreflect.ValueOfBool(value)
+	case "mint.v1beta1.QueryValidatorsSplitResponse.validators_percent":
+		value := x.ValidatorsPercent
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.QueryValidatorsSplitResponse.cosmos_validators_staked":
+		value := x.CosmosValidatorsStaked
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.QueryValidatorsSplitResponse.reputers_staked":
+		value := x.ReputersStaked
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.QueryValidatorsSplitResponse.validators_stake_ratio":
+		value := x.ValidatorsStakeRatio
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.QueryValidatorsSplitResponse.history":
+		if len(x.History) == 0 {
+			return protoreflect.ValueOfList(&_QueryValidatorsSplitResponse_6_list{})
+		}
+		listValue := &_QueryValidatorsSplitResponse_6_list{list: &x.History}
+		return protoreflect.ValueOfList(listValue)
+	case "mint.v1beta1.QueryValidatorsSplitResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryValidatorsSplitResponse"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryValidatorsSplitResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorsSplitResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mint.v1beta1.QueryValidatorsSplitResponse.controller_enabled":
+		x.ControllerEnabled = value.Bool()
+	case "mint.v1beta1.QueryValidatorsSplitResponse.validators_percent":
+		x.ValidatorsPercent = value.Interface().(string)
+	case "mint.v1beta1.QueryValidatorsSplitResponse.cosmos_validators_staked":
+		x.CosmosValidatorsStaked = value.Interface().(string)
+	case "mint.v1beta1.QueryValidatorsSplitResponse.reputers_staked":
+		x.ReputersStaked = value.Interface().(string)
+	case "mint.v1beta1.QueryValidatorsSplitResponse.validators_stake_ratio":
+		x.ValidatorsStakeRatio = value.Interface().(string)
+	case "mint.v1beta1.QueryValidatorsSplitResponse.history":
+		lv := value.List()
+		clv := lv.(*_QueryValidatorsSplitResponse_6_list)
+		x.History = *clv.list
+	case "mint.v1beta1.QueryValidatorsSplitResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryValidatorsSplitResponse"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryValidatorsSplitResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorsSplitResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mint.v1beta1.QueryValidatorsSplitResponse.history":
+		if x.History == nil {
+			x.History = []*ValidatorsSplitRecord{}
+		}
+		value := &_QueryValidatorsSplitResponse_6_list{list: &x.History}
+		return protoreflect.ValueOfList(value)
+	case "mint.v1beta1.QueryValidatorsSplitResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "mint.v1beta1.QueryValidatorsSplitResponse.controller_enabled":
+		panic(fmt.Errorf("field controller_enabled of message mint.v1beta1.QueryValidatorsSplitResponse is not mutable"))
+	case "mint.v1beta1.QueryValidatorsSplitResponse.validators_percent":
+		panic(fmt.Errorf("field validators_percent of message mint.v1beta1.QueryValidatorsSplitResponse is not mutable"))
+	case "mint.v1beta1.QueryValidatorsSplitResponse.cosmos_validators_staked":
+		panic(fmt.Errorf("field cosmos_validators_staked of message mint.v1beta1.QueryValidatorsSplitResponse is not mutable"))
+	case "mint.v1beta1.QueryValidatorsSplitResponse.reputers_staked":
+		panic(fmt.Errorf("field reputers_staked of message mint.v1beta1.QueryValidatorsSplitResponse is not mutable"))
+	case "mint.v1beta1.QueryValidatorsSplitResponse.validators_stake_ratio":
+		panic(fmt.Errorf("field validators_stake_ratio of message mint.v1beta1.QueryValidatorsSplitResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryValidatorsSplitResponse"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryValidatorsSplitResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryValidatorsSplitResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mint.v1beta1.QueryValidatorsSplitResponse.controller_enabled":
+		return protoreflect.ValueOfBool(false)
+	case "mint.v1beta1.QueryValidatorsSplitResponse.validators_percent":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.QueryValidatorsSplitResponse.cosmos_validators_staked":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.QueryValidatorsSplitResponse.reputers_staked":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.QueryValidatorsSplitResponse.validators_stake_ratio":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.QueryValidatorsSplitResponse.history":
+		list := []*ValidatorsSplitRecord{}
+		return protoreflect.ValueOfList(&_QueryValidatorsSplitResponse_6_list{list: &list})
+	case "mint.v1beta1.QueryValidatorsSplitResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.QueryValidatorsSplitResponse"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.QueryValidatorsSplitResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryValidatorsSplitResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mint.v1beta1.QueryValidatorsSplitResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryValidatorsSplitResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryValidatorsSplitResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryValidatorsSplitResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryValidatorsSplitResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryValidatorsSplitResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ControllerEnabled {
+			n += 2
+		}
+		l = len(x.ValidatorsPercent)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CosmosValidatorsStaked)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ReputersStaked)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ValidatorsStakeRatio)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.History) > 0 {
+			for _, e := range x.History {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryValidatorsSplitResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.History) > 0 {
+			for iNdEx := len(x.History) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.History[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.ValidatorsStakeRatio) > 0 {
+			i -= len(x.ValidatorsStakeRatio)
+			copy(dAtA[i:], x.ValidatorsStakeRatio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorsStakeRatio)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.ReputersStaked) > 0 {
+			i -= len(x.ReputersStaked)
+			copy(dAtA[i:], x.ReputersStaked)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReputersStaked)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.CosmosValidatorsStaked) > 0 {
+			i -= len(x.CosmosValidatorsStaked)
+			copy(dAtA[i:], x.CosmosValidatorsStaked)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CosmosValidatorsStaked)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.ValidatorsPercent) > 0 {
+			i -= len(x.ValidatorsPercent)
+			copy(dAtA[i:], x.ValidatorsPercent)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorsPercent)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.ControllerEnabled {
+			i--
+			if x.ControllerEnabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryValidatorsSplitResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorsSplitResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorsSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ControllerEnabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.ControllerEnabled = bool(v != 0)
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorsPercent", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorsPercent = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CosmosValidatorsStaked", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CosmosValidatorsStaked = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReputersStaked", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReputersStaked = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorsStakeRatio", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorsStakeRatio = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.History = append(x.History, &ValidatorsSplitRecord{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.History[len(x.History)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.27.0
 // 	protoc        (unknown)
 // source: mint/v1beta1/query.proto
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryParamsRequest) Reset() {
+	*x = QueryParamsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mint_v1beta1_query_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return file_mint_v1beta1_query_proto_rawDescGZIP(), []int{0}
+}
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// params defines the parameters of the module.
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *QueryParamsResponse) Reset() {
+	*x = QueryParamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mint_v1beta1_query_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return file_mint_v1beta1_query_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueryParamsResponse) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// QueryInflationRequest is the request type for the Query/Inflation RPC method.
+type QueryInflationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryInflationRequest) Reset() {
+	*x = QueryInflationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mint_v1beta1_query_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryInflationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryInflationRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryInflationRequest.ProtoReflect.Descriptor instead.
+func (*QueryInflationRequest) Descriptor() ([]byte, []int) {
+	return file_mint_v1beta1_query_proto_rawDescGZIP(), []int{2}
+}
+
+// QueryInflationResponse is the response type for the Query/Inflation RPC
+// method.
+type QueryInflationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// inflation is the current minting inflation value.
+	Inflation []byte `protobuf:"bytes,1,opt,name=inflation,proto3" json:"inflation,omitempty"`
+}
+
+func (x *QueryInflationResponse) Reset() {
+	*x = QueryInflationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mint_v1beta1_query_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryInflationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryInflationResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryInflationResponse.ProtoReflect.Descriptor instead.
+func (*QueryInflationResponse) Descriptor() ([]byte, []int) {
+	return file_mint_v1beta1_query_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QueryInflationResponse) GetInflation() []byte {
+	if x != nil {
+		return x.Inflation
+	}
+	return nil
+}
+
+// QueryVestingTranchesRequest is the request type for the Query/VestingTranches RPC method.
+type QueryVestingTranchesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryVestingTranchesRequest) Reset() {
+	*x = QueryVestingTranchesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mint_v1beta1_query_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryVestingTranchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryVestingTranchesRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryVestingTranchesRequest.ProtoReflect.Descriptor instead.
+func (*QueryVestingTranchesRequest) Descriptor() ([]byte, []int) {
+	return file_mint_v1beta1_query_proto_rawDescGZIP(), []int{4}
+}
+
+// VestingTrancheStatus is the amount of tokens of a vesting tranche that are locked and unlocked.
+type VestingTrancheStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tranche  *VestingTranche `protobuf:"bytes,1,opt,name=tranche,proto3" json:"tranche,omitempty"`
+	Total    string          `protobuf:"bytes,2,opt,name=total,proto3" json:"total,omitempty"`
+	Locked   string          `protobuf:"bytes,3,opt,name=locked,proto3" json:"locked,omitempty"`
+	Unlocked string          `protobuf:"bytes,4,opt,name=unlocked,proto3" json:"unlocked,omitempty"`
+}
+
+func (x *VestingTrancheStatus) Reset() {
+	*x = VestingTrancheStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mint_v1beta1_query_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VestingTrancheStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VestingTrancheStatus) ProtoMessage() {}
+
+// Deprecated: Use VestingTrancheStatus.ProtoReflect.Descriptor instead.
+func (*VestingTrancheStatus) Descriptor() ([]byte, []int) {
+	return file_mint_v1beta1_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *VestingTrancheStatus) GetTranche() *VestingTranche {
+	if x != nil {
+		return x.Tranche
+	}
+	return nil
+}
+
+func (x *VestingTrancheStatus) GetTotal() string {
+	if x != nil {
+		return x.Total
+	}
+	return ""
+}
+
+func (x *VestingTrancheStatus) GetLocked() string {
+	if x != nil {
+		return x.Locked
+	}
+	return ""
+}
+
+func (x *VestingTrancheStatus) GetUnlocked() string {
+	if x != nil {
+		return x.Unlocked
+	}
+	return ""
+}
+
+// QueryVestingTranchesResponse is the response type for the Query/VestingTranches RPC method.
+type QueryVestingTranchesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockHeight int64                   `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Tranches    []*VestingTrancheStatus `protobuf:"bytes,2,rep,name=tranches,proto3" json:"tranches,omitempty"`
+	// sum of the locked amounts of all tranches
+	TotalLocked string `protobuf:"bytes,3,opt,name=total_locked,json=totalLocked,proto3" json:"total_locked,omitempty"`
+}
+
+func (x *QueryVestingTranchesResponse) Reset() {
+	*x = QueryVestingTranchesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mint_v1beta1_query_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryVestingTranchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryVestingTranchesResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryVestingTranchesResponse.ProtoReflect.Descriptor instead.
+func (*QueryVestingTranchesResponse) Descriptor() ([]byte, []int) {
+	return file_mint_v1beta1_query_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QueryVestingTranchesResponse) GetBlockHeight() int64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *QueryVestingTranchesResponse) GetTranches() []*VestingTrancheStatus {
+	if x != nil {
+		return x.Tranches
+	}
+	return nil
+}
+
+func (x *QueryVestingTranchesResponse) GetTotalLocked() string {
+	if x != nil {
+		return x.TotalLocked
+	}
+	return ""
+}
+
+// QueryEmissionInfoRequest is the request type for the Query/EmissionInfo RPC method.
+type QueryEmissionInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryEmissionInfoRequest) Reset() {
+	*x = QueryEmissionInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mint_v1beta1_query_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryEmissionInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryEmissionInfoRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryEmissionInfoRequest.ProtoReflect.Descriptor instead.
+func (*QueryEmissionInfoRequest) Descriptor() ([]byte, []int) {
+	return file_mint_v1beta1_query_proto_rawDescGZIP(), []int{7}
+}
+
+// QueryEmissionInfoResponse is the response type for the Query/EmissionInfo RPC method.
+type QueryEmissionInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// nil until the emission rate has been updated
+	LatestEmissionInfo *EmissionInfo `protobuf:"bytes,1,opt,name=latest_emission_info,json=latestEmissionInfo,proto3" json:"latest_emission_info,omitempty"`
+	BlockHeight        int64         `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// current balance of the ecosystem treasury
+	EcosystemBalance string `protobuf:"bytes,3,opt,name=ecosystem_balance,json=ecosystemBalance,proto3" json:"ecosystem_balance,omitempty"`
+	// tokens minted into the ecosystem treasury so far
+	EcosystemTokensMinted string `protobuf:"bytes,4,opt,name=ecosystem_tokens_minted,json=ecosystemTokensMinted,proto3" json:"ecosystem_tokens_minted,omitempty"`
+	// maximum number of tokens the ecosystem treasury may mint
+	EcosystemMintCap             string `protobuf:"bytes,5,opt,name=ecosystem_mint_cap,json=ecosystemMintCap,proto3" json:"ecosystem_mint_cap,omitempty"`
+	EcosystemMintSupplyRemaining string `protobuf:"bytes,6,opt,name=ecosystem_mint_supply_remaining,json=ecosystemMintSupplyRemaining,proto3" json:"ecosystem_mint_supply_remaining,omitempty"`
+	// tokens emitted per block until the next update
+	BlockEmission string `protobuf:"bytes,7,opt,name=block_emission,json=blockEmission,proto3" json:"block_emission,omitempty"`
+	// block at which the emission rate is next updated, 0 if it is never updated
+	NextEmissionUpdateHeight int64 `protobuf:"varint,8,opt,name=next_emission_update_height,json=nextEmissionUpdateHeight,proto3" json:"next_emission_update_height,omitempty"`
+	// block at which the emission rate was last updated, 0 if it was never updated
+	LastEmissionUpdateHeight int64 `protobuf:"varint,9,opt,name=last_emission_update_height,json=lastEmissionUpdateHeight,proto3" json:"last_emission_update_height,omitempty"`
+}
+
+func (x *QueryEmissionInfoResponse) Reset() {
+	*x = QueryEmissionInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mint_v1beta1_query_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryEmissionInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryEmissionInfoResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryEmissionInfoResponse.ProtoReflect.Descriptor instead.
+func (*QueryEmissionInfoResponse) Descriptor() ([]byte, []int) {
+	return file_mint_v1beta1_query_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QueryEmissionInfoResponse) GetLatestEmissionInfo() *EmissionInfo {
+	if x != nil {
+		return x.LatestEmissionInfo
+	}
+	return nil
+}
+
+func (x *QueryEmissionInfoResponse) GetBlockHeight() int64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *QueryEmissionInfoResponse) GetEcosystemBalance() string {
+	if x != nil {
+		return x.EcosystemBalance
+	}
+	return ""
+}
+
+func (x *QueryEmissionInfoResponse) GetEcosystemTokensMinted() string {
+	if x != nil {
+		return x.EcosystemTokensMinted
+	}
+	return ""
+}
+
+func (x *QueryEmissionInfoResponse) GetEcosystemMintCap() string {
+	if x != nil {
+		return x.EcosystemMintCap
+	}
+	return ""
+}
+
+func (x *QueryEmissionInfoResponse) GetEcosystemMintSupplyRemaining() string {
+	if x != nil {
+		return x.EcosystemMintSupplyRemaining
+	}
+	return ""
+}
+
+func (x *QueryEmissionInfoResponse) GetBlockEmission() string {
+	if x != nil {
+		return x.BlockEmission
+	}
+	return ""
+}
+
+func (x *QueryEmissionInfoResponse) GetNextEmissionUpdateHeight() int64 {
+	if x != nil {
+		return x.NextEmissionUpdateHeight
+	}
+	return 0
+}
+
+func (x *QueryEmissionInfoResponse) GetLastEmissionUpdateHeight() int64 {
+	if x != nil {
+		return x.LastEmissionUpdateHeight
+	}
+	return 0
+}
+
+// QueryProjectEmissionsRequest is the request type for the Query/ProjectEmissions RPC method.
+type QueryProjectEmissionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// number of months to project, 12 if unset
+	Months uint64 `protobuf:"varint,1,opt,name=months,proto3" json:"months,omitempty"`
+	// tokens staked by validators and reputers, the current amount if unset
+	NetworkStaked string `protobuf:"bytes,2,opt,name=network_staked,json=networkStaked,proto3" json:"network_staked,omitempty"`
+	// share of the rewards paid to staked reputers, the previous share if unset
+	ReputersPercent string `protobuf:"bytes,3,opt,name=reputers_percent,json=reputersPercent,proto3" json:"reputers_percent,omitempty"`
+}
+
+func (x *QueryProjectEmissionsRequest) Reset() {
+	*x = QueryProjectEmissionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mint_v1beta1_query_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryProjectEmissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryProjectEmissionsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryProjectEmissionsRequest.ProtoReflect.Descriptor instead.
+func (*QueryProjectEmissionsRequest) Descriptor() ([]byte, []int) {
+	return file_mint_v1beta1_query_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *QueryProjectEmissionsRequest) GetMonths() uint64 {
+	if x != nil {
+		return x.Months
+	}
+	return 0
+}
+
+func (x *QueryProjectEmissionsRequest) GetNetworkStaked() string {
+	if x != nil {
+		return x.NetworkStaked
+	}
+	return ""
+}
+
+func (x *QueryProjectEmissionsRequest) GetReputersPercent() string {
+	if x != nil {
+		return x.ReputersPercent
+	}
+	return ""
+}
+
+// ProjectedEmission is the projected monthly update of the emission rate at the start of a month.
+type ProjectedEmission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// months from now, starting at 1
+	Month uint64 `protobuf:"varint,1,opt,name=month,proto3" json:"month,omitempty"`
+	// block at which the emission rate is updated
+	BlockHeight                                  int64  `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	NetworkStaked                                string `protobuf:"bytes,3,opt,name=network_staked,json=networkStaked,proto3" json:"network_staked,omitempty"`
+	TotalSupply                                  string `protobuf:"bytes,4,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty"`
+	LockedSupply                                 string `protobuf:"bytes,5,opt,name=locked_supply,json=lockedSupply,proto3" json:"locked_supply,omitempty"`
+	CirculatingSupply                            string `protobuf:"bytes,6,opt,name=circulating_supply,json=circulatingSupply,proto3" json:"circulating_supply,omitempty"`
+	EcosystemMintSupplyRemaining                 string `protobuf:"bytes,7,opt,name=ecosystem_mint_supply_remaining,json=ecosystemMintSupplyRemaining,proto3" json:"ecosystem_mint_supply_remaining,omitempty"`
+	TargetRewardEmissionPerUnitStakedToken       string `protobuf:"bytes,8,opt,name=target_reward_emission_per_unit_staked_token,json=targetRewardEmissionPerUnitStakedToken,proto3" json:"target_reward_emission_per_unit_staked_token,omitempty"`
+	CappedTargetRewardEmissionPerUnitStakedToken string `protobuf:"bytes,9,opt,name=capped_target_reward_emission_per_unit_staked_token,json=cappedTargetRewardEmissionPerUnitStakedToken,proto3" json:"capped_target_reward_emission_per_unit_staked_token,omitempty"`
+	RewardEmissionPerUnitStakedToken             string `protobuf:"bytes,10,opt,name=reward_emission_per_unit_staked_token,json=rewardEmissionPerUnitStakedToken,proto3" json:"reward_emission_per_unit_staked_token,omitempty"`
+	EmissionPerMonth                             string `protobuf:"bytes,11,opt,name=emission_per_month,json=emissionPerMonth,proto3" json:"emission_per_month,omitempty"`
+	// tokens minted into the ecosystem treasury during the month
+	TokensMinted string `protobuf:"bytes,12,opt,name=tokens_minted,json=tokensMinted,proto3" json:"tokens_minted,omitempty"`
+	// tokens minted into the ecosystem treasury from now to the end of the month
+	CumulativeTokensMinted string `protobuf:"bytes,13,opt,name=cumulative_tokens_minted,json=cumulativeTokensMinted,proto3" json:"cumulative_tokens_minted,omitempty"`
+}
+
+func (x *ProjectedEmission) Reset() {
+	*x = ProjectedEmission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mint_v1beta1_query_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProjectedEmission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectedEmission) ProtoMessage() {}
+
+// Deprecated: Use ProjectedEmission.ProtoReflect.Descriptor instead.
+func (*ProjectedEmission) Descriptor() ([]byte, []int) {
+	return file_mint_v1beta1_query_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ProjectedEmission) GetMonth() uint64 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *ProjectedEmission) GetBlockHeight() int64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *ProjectedEmission) GetNetworkStaked() string {
+	if x != nil {
+		return x.NetworkStaked
+	}
+	return ""
+}
+
+func (x *ProjectedEmission) GetTotalSupply() string {
+	if x != nil {
+		return x.TotalSupply
+	}
+	return ""
+}
+
+func (x *ProjectedEmission) GetLockedSupply() string {
+	if x != nil {
+		return x.LockedSupply
+	}
+	return ""
+}
+
+func (x *ProjectedEmission) GetCirculatingSupply() string {
+	if x != nil {
+		return x.CirculatingSupply
+	}
+	return ""
+}
+
+func (x *ProjectedEmission) GetEcosystemMintSupplyRemaining() string {
+	if x != nil {
+		return x.EcosystemMintSupplyRemaining
+	}
+	return ""
+}
+
+func (x *ProjectedEmission) GetTargetRewardEmissionPerUnitStakedToken() string {
+	if x != nil {
+		return x.TargetRewardEmissionPerUnitStakedToken
+	}
+	return ""
+}
+
+func (x *ProjectedEmission) GetCappedTargetRewardEmissionPerUnitStakedToken() string {
+	if x != nil {
+		return x.CappedTargetRewardEmissionPerUnitStakedToken
+	}
+	return ""
+}
+
+func (x *ProjectedEmission) GetRewardEmissionPerUnitStakedToken() string {
+	if x != nil {
+		return x.RewardEmissionPerUnitStakedToken
+	}
+	return ""
+}
+
+func (x *ProjectedEmission) GetEmissionPerMonth() string {
+	if x != nil {
+		return x.EmissionPerMonth
+	}
+	return ""
+}
 
-// QueryParamsRequest is the request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
+func (x *ProjectedEmission) GetTokensMinted() string {
+	if x != nil {
+		return x.TokensMinted
+	}
+	return ""
+}
+
+func (x *ProjectedEmission) GetCumulativeTokensMinted() string {
+	if x != nil {
+		return x.CumulativeTokensMinted
+	}
+	return ""
+}
+
+// QueryProjectEmissionsResponse is the response type for the Query/ProjectEmissions RPC method.
+type QueryProjectEmissionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Months []*ProjectedEmission `protobuf:"bytes,1,rep,name=months,proto3" json:"months,omitempty"`
 }
 
-func (x *QueryParamsRequest) Reset() {
-	*x = QueryParamsRequest{}
+func (x *QueryProjectEmissionsResponse) Reset() {
+	*x = QueryProjectEmissionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mint_v1beta1_query_proto_msgTypes[0]
+		mi := &file_mint_v1beta1_query_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryParamsRequest) String() string {
+func (x *QueryProjectEmissionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryParamsRequest) ProtoMessage() {}
+func (*QueryProjectEmissionsResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return file_mint_v1beta1_query_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use QueryProjectEmissionsResponse.ProtoReflect.Descriptor instead.
+func (*QueryProjectEmissionsResponse) Descriptor() ([]byte, []int) {
+	return file_mint_v1beta1_query_proto_rawDescGZIP(), []int{11}
 }
 
-// QueryParamsResponse is the response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
+func (x *QueryProjectEmissionsResponse) GetMonths() []*ProjectedEmission {
+	if x != nil {
+		return x.Months
+	}
+	return nil
+}
+
+// QueryFeeSplitRequest is the request type for the Query/FeeSplit RPC method.
+type QueryFeeSplitRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	// params defines the parameters of the module.
-	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+func (x *QueryFeeSplitRequest) Reset() {
+	*x = QueryFeeSplitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mint_v1beta1_query_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *QueryParamsResponse) Reset() {
-	*x = QueryParamsResponse{}
+func (x *QueryFeeSplitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryFeeSplitRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryFeeSplitRequest.ProtoReflect.Descriptor instead.
+func (*QueryFeeSplitRequest) Descriptor() ([]byte, []int) {
+	return file_mint_v1beta1_query_proto_rawDescGZIP(), []int{12}
+}
+
+// QueryFeeSplitResponse is the response type for the Query/FeeSplit RPC method.
+type QueryFeeSplitResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FeeBurnFraction          string `protobuf:"bytes,1,opt,name=fee_burn_fraction,json=feeBurnFraction,proto3" json:"fee_burn_fraction,omitempty"`
+	FeeCommunityPoolFraction string `protobuf:"bytes,2,opt,name=fee_community_pool_fraction,json=feeCommunityPoolFraction,proto3" json:"fee_community_pool_fraction,omitempty"`
+	TotalFeesBurned          string `protobuf:"bytes,3,opt,name=total_fees_burned,json=totalFeesBurned,proto3" json:"total_fees_burned,omitempty"`
+	TotalFeesToCommunityPool string `protobuf:"bytes,4,opt,name=total_fees_to_community_pool,json=totalFeesToCommunityPool,proto3" json:"total_fees_to_community_pool,omitempty"`
+}
+
+func (x *QueryFeeSplitResponse) Reset() {
+	*x = QueryFeeSplitResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mint_v1beta1_query_proto_msgTypes[1]
+		mi := &file_mint_v1beta1_query_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryParamsResponse) String() string {
+func (x *QueryFeeSplitResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryParamsResponse) ProtoMessage() {}
+func (*QueryFeeSplitResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return file_mint_v1beta1_query_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use QueryFeeSplitResponse.ProtoReflect.Descriptor instead.
+func (*QueryFeeSplitResponse) Descriptor() ([]byte, []int) {
+	return file_mint_v1beta1_query_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *QueryParamsResponse) GetParams() *Params {
+func (x *QueryFeeSplitResponse) GetFeeBurnFraction() string {
 	if x != nil {
-		return x.Params
+		return x.FeeBurnFraction
 	}
-	return nil
+	return ""
 }
 
-// QueryInflationRequest is the request type for the Query/Inflation RPC method.
-type QueryInflationRequest struct {
+func (x *QueryFeeSplitResponse) GetFeeCommunityPoolFraction() string {
+	if x != nil {
+		return x.FeeCommunityPoolFraction
+	}
+	return ""
+}
+
+func (x *QueryFeeSplitResponse) GetTotalFeesBurned() string {
+	if x != nil {
+		return x.TotalFeesBurned
+	}
+	return ""
+}
+
+func (x *QueryFeeSplitResponse) GetTotalFeesToCommunityPool() string {
+	if x != nil {
+		return x.TotalFeesToCommunityPool
+	}
+	return ""
+}
+
+// QueryValidatorsSplitRequest is the request type for the Query/ValidatorsSplit RPC method.
+type QueryValidatorsSplitRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// pages through the history, oldest first
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryInflationRequest) Reset() {
-	*x = QueryInflationRequest{}
+func (x *QueryValidatorsSplitRequest) Reset() {
+	*x = QueryValidatorsSplitRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mint_v1beta1_query_proto_msgTypes[2]
+		mi := &file_mint_v1beta1_query_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryInflationRequest) String() string {
+func (x *QueryValidatorsSplitRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryInflationRequest) ProtoMessage() {}
+func (*QueryValidatorsSplitRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryInflationRequest.ProtoReflect.Descriptor instead.
-func (*QueryInflationRequest) Descriptor() ([]byte, []int) {
-	return file_mint_v1beta1_query_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use QueryValidatorsSplitRequest.ProtoReflect.Descriptor instead.
+func (*QueryValidatorsSplitRequest) Descriptor() ([]byte, []int) {
+	return file_mint_v1beta1_query_proto_rawDescGZIP(), []int{14}
 }
 
-// QueryInflationResponse is the response type for the Query/Inflation RPC
-// method.
-type QueryInflationResponse struct {
+func (x *QueryValidatorsSplitRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryValidatorsSplitResponse is the response type for the Query/ValidatorsSplit RPC method.
+type QueryValidatorsSplitResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// inflation is the current minting inflation value.
-	Inflation []byte `protobuf:"bytes,1,opt,name=inflation,proto3" json:"inflation,omitempty"`
+	ControllerEnabled bool `protobuf:"varint,1,opt,name=controller_enabled,json=controllerEnabled,proto3" json:"controller_enabled,omitempty"`
+	// fraction of the block emission paid to cosmos validators by the latest block
+	ValidatorsPercent string `protobuf:"bytes,2,opt,name=validators_percent,json=validatorsPercent,proto3" json:"validators_percent,omitempty"`
+	// tokens bonded to cosmos validators now
+	CosmosValidatorsStaked string `protobuf:"bytes,3,opt,name=cosmos_validators_staked,json=cosmosValidatorsStaked,proto3" json:"cosmos_validators_staked,omitempty"`
+	// tokens staked by reputers now
+	ReputersStaked string `protobuf:"bytes,4,opt,name=reputers_staked,json=reputersStaked,proto3" json:"reputers_staked,omitempty"`
+	// cosmos validators staked / (cosmos validators staked + reputers staked) now
+	ValidatorsStakeRatio string `protobuf:"bytes,5,opt,name=validators_stake_ratio,json=validatorsStakeRatio,proto3" json:"validators_stake_ratio,omitempty"`
+	// splits chosen by the controller in the latest blocks, oldest first
+	History    []*ValidatorsSplitRecord `protobuf:"bytes,6,rep,name=history,proto3" json:"history,omitempty"`
+	Pagination *v1beta1.PageResponse    `protobuf:"bytes,7,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryInflationResponse) Reset() {
-	*x = QueryInflationResponse{}
+func (x *QueryValidatorsSplitResponse) Reset() {
+	*x = QueryValidatorsSplitResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mint_v1beta1_query_proto_msgTypes[3]
+		mi := &file_mint_v1beta1_query_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryInflationResponse) String() string {
+func (x *QueryValidatorsSplitResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryInflationResponse) ProtoMessage() {}
+func (*QueryValidatorsSplitResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryInflationResponse.ProtoReflect.Descriptor instead.
-func (*QueryInflationResponse) Descriptor() ([]byte, []int) {
-	return file_mint_v1beta1_query_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use QueryValidatorsSplitResponse.ProtoReflect.Descriptor instead.
+func (*QueryValidatorsSplitResponse) Descriptor() ([]byte, []int) {
+	return file_mint_v1beta1_query_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *QueryInflationResponse) GetInflation() []byte {
+func (x *QueryValidatorsSplitResponse) GetControllerEnabled() bool {
 	if x != nil {
-		return x.Inflation
+		return x.ControllerEnabled
+	}
+	return false
+}
+
+func (x *QueryValidatorsSplitResponse) GetValidatorsPercent() string {
+	if x != nil {
+		return x.ValidatorsPercent
+	}
+	return ""
+}
+
+func (x *QueryValidatorsSplitResponse) GetCosmosValidatorsStaked() string {
+	if x != nil {
+		return x.CosmosValidatorsStaked
+	}
+	return ""
+}
+
+func (x *QueryValidatorsSplitResponse) GetReputersStaked() string {
+	if x != nil {
+		return x.ReputersStaked
+	}
+	return ""
+}
+
+func (x *QueryValidatorsSplitResponse) GetValidatorsStakeRatio() string {
+	if x != nil {
+		return x.ValidatorsStakeRatio
+	}
+	return ""
+}
+
+func (x *QueryValidatorsSplitResponse) GetHistory() []*ValidatorsSplitRecord {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *QueryValidatorsSplitResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
 	}
 	return nil
 }
@@ -1740,49 +9767,357 @@ var file_mint_v1beta1_query_proto_rawDesc = []byte{
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d,
 	0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4e, 0x0a, 0x13, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x37, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
-	0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x6e, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a,
-	0x09, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
-	0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x32, 0xed, 0x01, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x6b, 0x0a,
-	0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x20, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x69, 0x6e, 0x74,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73,
+	0x65, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4e, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a,
+	0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49,
+	0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x6e, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x09, 0x69, 0x6e, 0x66,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x36, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
+	0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x1d, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x54,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xb9,
+	0x02, 0x0a, 0x14, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x41, 0x0a, 0x07, 0x74, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x54,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x07, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x12, 0x46, 0x0a, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x48, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e,
+	0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x4c, 0x0a, 0x08,
+	0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x08, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x22, 0xe1, 0x01, 0x0a, 0x1c, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x49,
+	0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x08, 0x74, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x12, 0x53, 0x0a, 0x0c, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4,
+	0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x22, 0x1a,
+	0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x85, 0x06, 0x0a, 0x19, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x14, 0x6c, 0x61, 0x74, 0x65,
+	0x73, 0x74, 0x5f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x12, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x5d, 0x0a, 0x11, 0x65, 0x63, 0x6f,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e,
+	0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x10, 0x65, 0x63, 0x6f, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x68, 0x0a, 0x17, 0x65, 0x63, 0x6f, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x6d, 0x69, 0x6e,
+	0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x15, 0x65, 0x63, 0x6f,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x4d, 0x69, 0x6e, 0x74,
+	0x65, 0x64, 0x12, 0x5e, 0x0a, 0x12, 0x65, 0x63, 0x6f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f,
+	0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x63, 0x61, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x10, 0x65, 0x63, 0x6f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4d, 0x69, 0x6e, 0x74, 0x43,
+	0x61, 0x70, 0x12, 0x77, 0x0a, 0x1f, 0x65, 0x63, 0x6f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f,
+	0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x5f, 0x72, 0x65, 0x6d, 0x61,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x1c, 0x65,
+	0x63, 0x6f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4d, 0x69, 0x6e, 0x74, 0x53, 0x75, 0x70, 0x70,
+	0x6c, 0x79, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x57, 0x0a, 0x0e, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49,
+	0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x1b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x65, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x18, 0x6e, 0x65, 0x78, 0x74, 0x45,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x3d, 0x0a, 0x1b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x18, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x22, 0x88, 0x01, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x74, 0x61, 0x6b,
+	0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65,
+	0x70, 0x75, 0x74, 0x65, 0x72, 0x73, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0xa5, 0x0a,
+	0x0a, 0x11, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x57, 0x0a, 0x0e,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e,
+	0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53,
+	0x74, 0x61, 0x6b, 0x65, 0x64, 0x12, 0x53, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73,
+	0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x55, 0x0a, 0x0d, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74,
+	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x53, 0x75, 0x70, 0x70, 0x6c,
+	0x79, 0x12, 0x5f, 0x0a, 0x12, 0x63, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x11, 0x63, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x70, 0x70,
+	0x6c, 0x79, 0x12, 0x77, 0x0a, 0x1f, 0x65, 0x63, 0x6f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f,
+	0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x5f, 0x72, 0x65, 0x6d, 0x61,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x1c, 0x65,
+	0x63, 0x6f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4d, 0x69, 0x6e, 0x74, 0x53, 0x75, 0x70, 0x70,
+	0x6c, 0x79, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x9a, 0x01, 0x0a, 0x2c,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x65, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f,
+	0x73, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x10, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x26, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x45, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x55, 0x6e, 0x69, 0x74, 0x53, 0x74, 0x61,
+	0x6b, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0xa7, 0x01, 0x0a, 0x33, 0x63, 0x61, 0x70,
+	0x70, 0x65, 0x64, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x5f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x75,
+	0x6e, 0x69, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x10, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x2c, 0x63, 0x61, 0x70, 0x70, 0x65, 0x64, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x50, 0x65, 0x72, 0x55, 0x6e, 0x69, 0x74, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x8d, 0x01, 0x0a, 0x25, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x65, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f,
+	0x73, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x10, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x20, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x50, 0x65, 0x72, 0x55, 0x6e, 0x69, 0x74, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x5e, 0x0a, 0x12, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x10, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x4d, 0x6f, 0x6e,
+	0x74, 0x68, 0x12, 0x55, 0x0a, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x6d, 0x69, 0x6e,
+	0x74, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x4d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x12, 0x6a, 0x0a, 0x18, 0x63, 0x75, 0x6d,
+	0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x6d,
+	0x69, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x16, 0x63,
+	0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x4d,
+	0x69, 0x6e, 0x74, 0x65, 0x64, 0x22, 0x63, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x06, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x06, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x46, 0x65, 0x65, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0xce, 0x03, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x65, 0x65, 0x53,
+	0x70, 0x6c, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x11,
+	0x66, 0x65, 0x65, 0x5f, 0x62, 0x75, 0x72, 0x6e, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x10,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x66, 0x65, 0x65, 0x42, 0x75, 0x72, 0x6e, 0x46, 0x72,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x7b, 0x0a, 0x1b, 0x66, 0x65, 0x65, 0x5f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x66, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63,
+	0xd2, 0xb4, 0x2d, 0x10, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x18, 0x66, 0x65, 0x65, 0x43, 0x6f,
+	0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x46, 0x72, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x5c, 0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x65, 0x65,
+	0x73, 0x5f, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x73, 0x42, 0x75, 0x72, 0x6e, 0x65,
+	0x64, 0x12, 0x70, 0x0a, 0x1c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x5f,
+	0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f,
+	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x18, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x46, 0x65, 0x65, 0x73, 0x54, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x50,
+	0x6f, 0x6f, 0x6c, 0x22, 0x65, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x88, 0x05, 0x0a, 0x1c, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x53, 0x70,
+	0x6c, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x6b, 0x0a, 0x12, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x10, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x6a, 0x0a, 0x18, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x5f, 0x73, 0x74, 0x61,
+	0x6b, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x16, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x53, 0x74, 0x61,
+	0x6b, 0x65, 0x64, 0x12, 0x59, 0x0a, 0x0f, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x73, 0x5f,
+	0x73, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e,
+	0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x12, 0x72,
+	0x0a, 0x16, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x5f, 0x73, 0x74, 0x61,
+	0x6b, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79,
+	0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x10, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x4c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x14, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x61, 0x74,
+	0x69, 0x6f, 0x12, 0x48, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x53, 0x70, 0x6c,
+	0x69, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x47, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0xb0, 0x07, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12,
+	0x6b, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x20, 0x2e, 0x6d, 0x69, 0x6e, 0x74,
 	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x77, 0x0a, 0x09, 0x49, 0x6e,
-	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6d,
-	0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x6d, 0x69, 0x6e,
-	0x74, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x42, 0xbb, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x69, 0x6e, 0x74,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78,
-	0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x6d, 0x69, 0x6e, 0x74, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x58, 0x58, 0xaa, 0x02, 0x0c, 0x4d, 0x69, 0x6e, 0x74, 0x2e,
-	0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x0c, 0x4d, 0x69, 0x6e, 0x74, 0x5c, 0x56,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x18, 0x4d, 0x69, 0x6e, 0x74, 0x5c, 0x56, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x0d, 0x4d, 0x69, 0x6e, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x69,
+	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x77, 0x0a, 0x09,
+	0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x6d, 0x69, 0x6e, 0x74,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e,
+	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
+	0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x6d,
+	0x69, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x69, 0x6e, 0x66, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x90, 0x01, 0x0a, 0x0f, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x54, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x12, 0x29, 0x2e, 0x6d, 0x69, 0x6e, 0x74,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x54, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x2f,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f,
+	0x74, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x12, 0x84, 0x01, 0x0a, 0x0c, 0x45, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x26, 0x2e, 0x6d, 0x69, 0x6e, 0x74,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x27, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x12,
+	0x9d, 0x01, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x45, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2b, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x45, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x65, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x7d, 0x12,
+	0x74, 0x0a, 0x08, 0x46, 0x65, 0x65, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x22, 0x2e, 0x6d, 0x69,
+	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x46, 0x65, 0x65, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x46, 0x65, 0x65, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x6d,
+	0x69, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x66, 0x65, 0x65, 0x5f,
+	0x73, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x90, 0x01, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x73, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x29, 0x2e, 0x6d, 0x69, 0x6e, 0x74,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x2f,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x5f, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x42, 0xbb, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d,
+	0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0a, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4a, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d,
+	0x69, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x6d, 0x69, 0x6e, 0x74,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x58, 0x58, 0xaa, 0x02, 0x0c,
+	0x4d, 0x69, 0x6e, 0x74, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x0c, 0x4d,
+	0x69, 0x6e, 0x74, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x18, 0x4d, 0x69,
+	0x6e, 0x74, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x4d, 0x69, 0x6e, 0x74, 0x3a, 0x3a, 0x56,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1797,25 +10132,59 @@ func file_mint_v1beta1_query_proto_rawDescGZIP() []byte {
 	return file_mint_v1beta1_query_proto_rawDescData
 }
 
-var file_mint_v1beta1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_mint_v1beta1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_mint_v1beta1_query_proto_goTypes = []interface{}{
-	(*QueryParamsRequest)(nil),     // 0: mint.v1beta1.QueryParamsRequest
-	(*QueryParamsResponse)(nil),    // 1: mint.v1beta1.QueryParamsResponse
-	(*QueryInflationRequest)(nil),  // 2: mint.v1beta1.QueryInflationRequest
-	(*QueryInflationResponse)(nil), // 3: mint.v1beta1.QueryInflationResponse
-	(*Params)(nil),                 // 4: mint.v1beta1.Params
+	(*QueryParamsRequest)(nil),            // 0: mint.v1beta1.QueryParamsRequest
+	(*QueryParamsResponse)(nil),           // 1: mint.v1beta1.QueryParamsResponse
+	(*QueryInflationRequest)(nil),         // 2: mint.v1beta1.QueryInflationRequest
+	(*QueryInflationResponse)(nil),        // 3: mint.v1beta1.QueryInflationResponse
+	(*QueryVestingTranchesRequest)(nil),   // 4: mint.v1beta1.QueryVestingTranchesRequest
+	(*VestingTrancheStatus)(nil),          // 5: mint.v1beta1.VestingTrancheStatus
+	(*QueryVestingTranchesResponse)(nil),  // 6: mint.v1beta1.QueryVestingTranchesResponse
+	(*QueryEmissionInfoRequest)(nil),      // 7: mint.v1beta1.QueryEmissionInfoRequest
+	(*QueryEmissionInfoResponse)(nil),     // 8: mint.v1beta1.QueryEmissionInfoResponse
+	(*QueryProjectEmissionsRequest)(nil),  // 9: mint.v1beta1.QueryProjectEmissionsRequest
+	(*ProjectedEmission)(nil),             // 10: mint.v1beta1.ProjectedEmission
+	(*QueryProjectEmissionsResponse)(nil), // 11: mint.v1beta1.QueryProjectEmissionsResponse
+	(*QueryFeeSplitRequest)(nil),          // 12: mint.v1beta1.QueryFeeSplitRequest
+	(*QueryFeeSplitResponse)(nil),         // 13: mint.v1beta1.QueryFeeSplitResponse
+	(*QueryValidatorsSplitRequest)(nil),   // 14: mint.v1beta1.QueryValidatorsSplitRequest
+	(*QueryValidatorsSplitResponse)(nil),  // 15: mint.v1beta1.QueryValidatorsSplitResponse
+	(*Params)(nil),                        // 16: mint.v1beta1.Params
+	(*VestingTranche)(nil),                // 17: mint.v1beta1.VestingTranche
+	(*EmissionInfo)(nil),                  // 18: mint.v1beta1.EmissionInfo
+	(*v1beta1.PageRequest)(nil),           // 19: cosmos.base.query.v1beta1.PageRequest
+	(*ValidatorsSplitRecord)(nil),         // 20: mint.v1beta1.ValidatorsSplitRecord
+	(*v1beta1.PageResponse)(nil),          // 21: cosmos.base.query.v1beta1.PageResponse
 }
 var file_mint_v1beta1_query_proto_depIdxs = []int32{
-	4, // 0: mint.v1beta1.QueryParamsResponse.params:type_name -> mint.v1beta1.Params
-	0, // 1: mint.v1beta1.Query.Params:input_type -> mint.v1beta1.QueryParamsRequest
-	2, // 2: mint.v1beta1.Query.Inflation:input_type -> mint.v1beta1.QueryInflationRequest
-	1, // 3: mint.v1beta1.Query.Params:output_type -> mint.v1beta1.QueryParamsResponse
-	3, // 4: mint.v1beta1.Query.Inflation:output_type -> mint.v1beta1.QueryInflationResponse
-	3, // [3:5] is the sub-list for method output_type
-	1, // [1:3] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	16, // 0: mint.v1beta1.QueryParamsResponse.params:type_name -> mint.v1beta1.Params
+	17, // 1: mint.v1beta1.VestingTrancheStatus.tranche:type_name -> mint.v1beta1.VestingTranche
+	5,  // 2: mint.v1beta1.QueryVestingTranchesResponse.tranches:type_name -> mint.v1beta1.VestingTrancheStatus
+	18, // 3: mint.v1beta1.QueryEmissionInfoResponse.latest_emission_info:type_name -> mint.v1beta1.EmissionInfo
+	10, // 4: mint.v1beta1.QueryProjectEmissionsResponse.months:type_name -> mint.v1beta1.ProjectedEmission
+	19, // 5: mint.v1beta1.QueryValidatorsSplitRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	20, // 6: mint.v1beta1.QueryValidatorsSplitResponse.history:type_name -> mint.v1beta1.ValidatorsSplitRecord
+	21, // 7: mint.v1beta1.QueryValidatorsSplitResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	0,  // 8: mint.v1beta1.Query.Params:input_type -> mint.v1beta1.QueryParamsRequest
+	2,  // 9: mint.v1beta1.Query.Inflation:input_type -> mint.v1beta1.QueryInflationRequest
+	4,  // 10: mint.v1beta1.Query.VestingTranches:input_type -> mint.v1beta1.QueryVestingTranchesRequest
+	7,  // 11: mint.v1beta1.Query.EmissionInfo:input_type -> mint.v1beta1.QueryEmissionInfoRequest
+	9,  // 12: mint.v1beta1.Query.ProjectEmissions:input_type -> mint.v1beta1.QueryProjectEmissionsRequest
+	12, // 13: mint.v1beta1.Query.FeeSplit:input_type -> mint.v1beta1.QueryFeeSplitRequest
+	14, // 14: mint.v1beta1.Query.ValidatorsSplit:input_type -> mint.v1beta1.QueryValidatorsSplitRequest
+	1,  // 15: mint.v1beta1.Query.Params:output_type -> mint.v1beta1.QueryParamsResponse
+	3,  // 16: mint.v1beta1.Query.Inflation:output_type -> mint.v1beta1.QueryInflationResponse
+	6,  // 17: mint.v1beta1.Query.VestingTranches:output_type -> mint.v1beta1.QueryVestingTranchesResponse
+	8,  // 18: mint.v1beta1.Query.EmissionInfo:output_type -> mint.v1beta1.QueryEmissionInfoResponse
+	11, // 19: mint.v1beta1.Query.ProjectEmissions:output_type -> mint.v1beta1.QueryProjectEmissionsResponse
+	13, // 20: mint.v1beta1.Query.FeeSplit:output_type -> mint.v1beta1.QueryFeeSplitResponse
+	15, // 21: mint.v1beta1.Query.ValidatorsSplit:output_type -> mint.v1beta1.QueryValidatorsSplitResponse
+	15, // [15:22] is the sub-list for method output_type
+	8,  // [8:15] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_mint_v1beta1_query_proto_init() }
@@ -1873,6 +10242,150 @@ func file_mint_v1beta1_query_proto_init() {
 				return nil
 			}
 		}
+		file_mint_v1beta1_query_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryVestingTranchesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mint_v1beta1_query_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VestingTrancheStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mint_v1beta1_query_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryVestingTranchesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mint_v1beta1_query_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryEmissionInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mint_v1beta1_query_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryEmissionInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mint_v1beta1_query_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryProjectEmissionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mint_v1beta1_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectedEmission); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mint_v1beta1_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryProjectEmissionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mint_v1beta1_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryFeeSplitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mint_v1beta1_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryFeeSplitResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mint_v1beta1_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryValidatorsSplitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mint_v1beta1_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryValidatorsSplitResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1880,7 +10393,7 @@ func file_mint_v1beta1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mint_v1beta1_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,8 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName    = "/mint.v1beta1.Query/Params"
-	Query_Inflation_FullMethodName = "/mint.v1beta1.Query/Inflation"
+	Query_Params_FullMethodName           = "/mint.v1beta1.Query/Params"
+	Query_Inflation_FullMethodName        = "/mint.v1beta1.Query/Inflation"
+	Query_VestingTranches_FullMethodName  = "/mint.v1beta1.Query/VestingTranches"
+	Query_EmissionInfo_FullMethodName     = "/mint.v1beta1.Query/EmissionInfo"
+	Query_ProjectEmissions_FullMethodName = "/mint.v1beta1.Query/ProjectEmissions"
+	Query_FeeSplit_FullMethodName         = "/mint.v1beta1.Query/FeeSplit"
+	Query_ValidatorsSplit_FullMethodName  = "/mint.v1beta1.Query/ValidatorsSplit"
 )
 
 // QueryClient is the client API for Query service.
@@ -31,6 +36,19 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// Inflation returns the current minting inflation value.
 	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
+	// VestingTranches returns the locked and unlocked amounts of every vesting tranche at the current block.
+	VestingTranches(ctx context.Context, in *QueryVestingTranchesRequest, opts ...grpc.CallOption) (*QueryVestingTranchesResponse, error)
+	// EmissionInfo returns the intermediate values of the latest monthly update of the emission rate
+	// along with the current state of the ecosystem treasury.
+	EmissionInfo(ctx context.Context, in *QueryEmissionInfoRequest, opts ...grpc.CallOption) (*QueryEmissionInfoResponse, error)
+	// ProjectEmissions projects the monthly emission rate updates of the coming months under the current params.
+	ProjectEmissions(ctx context.Context, in *QueryProjectEmissionsRequest, opts ...grpc.CallOption) (*QueryProjectEmissionsResponse, error)
+	// FeeSplit returns the fractions of the fees paid into the ecosystem treasury that are burned and sent to
+	// the community pool, and the totals burned and sent so far.
+	FeeSplit(ctx context.Context, in *QueryFeeSplitRequest, opts ...grpc.CallOption) (*QueryFeeSplitResponse, error)
+	// ValidatorsSplit returns the fraction of the block emission paid to cosmos validators, the stake it is
+	// chosen from when the validators split controller is enabled, and a page of the splits of the latest blocks.
+	ValidatorsSplit(ctx context.Context, in *QueryValidatorsSplitRequest, opts ...grpc.CallOption) (*QueryValidatorsSplitResponse, error)
 }
 
 type queryClient struct {
@@ -59,6 +77,51 @@ func (c *queryClient) Inflation(ctx context.Context, in *QueryInflationRequest,
 	return out, nil
 }
 
+func (c *queryClient) VestingTranches(ctx context.Context, in *QueryVestingTranchesRequest, opts ...grpc.CallOption) (*QueryVestingTranchesResponse, error) {
+	out := new(QueryVestingTranchesResponse)
+	err := c.cc.Invoke(ctx, Query_VestingTranches_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EmissionInfo(ctx context.Context, in *QueryEmissionInfoRequest, opts ...grpc.CallOption) (*QueryEmissionInfoResponse, error) {
+	out := new(QueryEmissionInfoResponse)
+	err := c.cc.Invoke(ctx, Query_EmissionInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProjectEmissions(ctx context.Context, in *QueryProjectEmissionsRequest, opts ...grpc.CallOption) (*QueryProjectEmissionsResponse, error) {
+	out := new(QueryProjectEmissionsResponse)
+	err := c.cc.Invoke(ctx, Query_ProjectEmissions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeSplit(ctx context.Context, in *QueryFeeSplitRequest, opts ...grpc.CallOption) (*QueryFeeSplitResponse, error) {
+	out := new(QueryFeeSplitResponse)
+	err := c.cc.Invoke(ctx, Query_FeeSplit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorsSplit(ctx context.Context, in *QueryValidatorsSplitRequest, opts ...grpc.CallOption) (*QueryValidatorsSplitResponse, error) {
+	out := new(QueryValidatorsSplitResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorsSplit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -67,6 +130,19 @@ type QueryServer interface {
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// Inflation returns the current minting inflation value.
 	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
+	// VestingTranches returns the locked and unlocked amounts of every vesting tranche at the current block.
+	VestingTranches(context.Context, *QueryVestingTranchesRequest) (*QueryVestingTranchesResponse, error)
+	// EmissionInfo returns the intermediate values of the latest monthly update of the emission rate
+	// along with the current state of the ecosystem treasury.
+	EmissionInfo(context.Context, *QueryEmissionInfoRequest) (*QueryEmissionInfoResponse, error)
+	// ProjectEmissions projects the monthly emission rate updates of the coming months under the current params.
+	ProjectEmissions(context.Context, *QueryProjectEmissionsRequest) (*QueryProjectEmissionsResponse, error)
+	// FeeSplit returns the fractions of the fees paid into the ecosystem treasury that are burned and sent to
+	// the community pool, and the totals burned and sent so far.
+	FeeSplit(context.Context, *QueryFeeSplitRequest) (*QueryFeeSplitResponse, error)
+	// ValidatorsSplit returns the fraction of the block emission paid to cosmos validators, the stake it is
+	// chosen from when the validators split controller is enabled, and a page of the splits of the latest blocks.
+	ValidatorsSplit(context.Context, *QueryValidatorsSplitRequest) (*QueryValidatorsSplitResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -80,6 +156,21 @@ func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*Q
 func (UnimplementedQueryServer) Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Inflation not implemented")
 }
+func (UnimplementedQueryServer) VestingTranches(context.Context, *QueryVestingTranchesRequest) (*QueryVestingTranchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingTranches not implemented")
+}
+func (UnimplementedQueryServer) EmissionInfo(context.Context, *QueryEmissionInfoRequest) (*QueryEmissionInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmissionInfo not implemented")
+}
+func (UnimplementedQueryServer) ProjectEmissions(context.Context, *QueryProjectEmissionsRequest) (*QueryProjectEmissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectEmissions not implemented")
+}
+func (UnimplementedQueryServer) FeeSplit(context.Context, *QueryFeeSplitRequest) (*QueryFeeSplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeSplit not implemented")
+}
+func (UnimplementedQueryServer) ValidatorsSplit(context.Context, *QueryValidatorsSplitRequest) (*QueryValidatorsSplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorsSplit not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -129,6 +220,96 @@ func _Query_Inflation_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VestingTranches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingTranchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingTranches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VestingTranches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingTranches(ctx, req.(*QueryVestingTranchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EmissionInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEmissionInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EmissionInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EmissionInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EmissionInfo(ctx, req.(*QueryEmissionInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProjectEmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectEmissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectEmissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProjectEmissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectEmissions(ctx, req.(*QueryProjectEmissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeSplitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeSplit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FeeSplit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeSplit(ctx, req.(*QueryFeeSplitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorsSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorsSplitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorsSplit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorsSplit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorsSplit(ctx, req.(*QueryValidatorsSplitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -144,6 +325,26 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Inflation",
 			Handler:    _Query_Inflation_Handler,
 		},
+		{
+			MethodName: "VestingTranches",
+			Handler:    _Query_VestingTranches_Handler,
+		},
+		{
+			MethodName: "EmissionInfo",
+			Handler:    _Query_EmissionInfo_Handler,
+		},
+		{
+			MethodName: "ProjectEmissions",
+			Handler:    _Query_ProjectEmissions_Handler,
+		},
+		{
+			MethodName: "FeeSplit",
+			Handler:    _Query_FeeSplit_Handler,
+		},
+		{
+			MethodName: "ValidatorsSplit",
+			Handler:    _Query_ValidatorsSplit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "mint/v1beta1/query.proto",
@@ -15,6 +15,103 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Params_11_list)(nil)
+
+type _Params_11_list struct {
+	list *[]*VestingTranche
+}
+
+func (x *_Params_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VestingTranche)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VestingTranche)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_11_list) AppendMutable() protoreflect.Value {
+	v := new(VestingTranche)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_11_list) NewElement() protoreflect.Value {
+	v := new(VestingTranche)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_15_list)(nil)
+
+type _Params_15_list struct {
+	list *[]string
+}
+
+func (x *_Params_15_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_15_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_15_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_15_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_15_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field FixedScheduleMonthlyEmissions as it is not of Message kind"))
+}
+
+func (x *_Params_15_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_15_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_15_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_Params                                             protoreflect.MessageDescriptor
 	fd_Params_mint_denom                                  protoreflect.FieldDescriptor
@@ -27,6 +124,19 @@ var (
 	fd_Params_investors_percent_of_total_supply           protoreflect.FieldDescriptor
 	fd_Params_team_percent_of_total_supply                protoreflect.FieldDescriptor
 	fd_Params_maximum_monthly_percentage_yield            protoreflect.FieldDescriptor
+	fd_Params_vesting_tranches                            protoreflect.FieldDescriptor
+	fd_Params_fee_burn_fraction                           protoreflect.FieldDescriptor
+	fd_Params_fee_community_pool_fraction                 protoreflect.FieldDescriptor
+	fd_Params_emission_model                              protoreflect.FieldDescriptor
+	fd_Params_fixed_schedule_monthly_emissions            protoreflect.FieldDescriptor
+	fd_Params_halving_initial_monthly_emission            protoreflect.FieldDescriptor
+	fd_Params_halving_interval_months                     protoreflect.FieldDescriptor
+	fd_Params_validators_split_controller_enabled         protoreflect.FieldDescriptor
+	fd_Params_min_validators_percent                      protoreflect.FieldDescriptor
+	fd_Params_max_validators_percent                      protoreflect.FieldDescriptor
+	fd_Params_target_validators_stake_ratio               protoreflect.FieldDescriptor
+	fd_Params_validators_percent_adjustment_rate          protoreflect.FieldDescriptor
+	fd_Params_validators_split_history_length             protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -42,6 +152,19 @@ func init() {
 	fd_Params_investors_percent_of_total_supply = md_Params.Fields().ByName("investors_percent_of_total_supply")
 	fd_Params_team_percent_of_total_supply = md_Params.Fields().ByName("team_percent_of_total_supply")
 	fd_Params_maximum_monthly_percentage_yield = md_Params.Fields().ByName("maximum_monthly_percentage_yield")
+	fd_Params_vesting_tranches = md_Params.Fields().ByName("vesting_tranches")
+	fd_Params_fee_burn_fraction = md_Params.Fields().ByName("fee_burn_fraction")
+	fd_Params_fee_community_pool_fraction = md_Params.Fields().ByName("fee_community_pool_fraction")
+	fd_Params_emission_model = md_Params.Fields().ByName("emission_model")
+	fd_Params_fixed_schedule_monthly_emissions = md_Params.Fields().ByName("fixed_schedule_monthly_emissions")
+	fd_Params_halving_initial_monthly_emission = md_Params.Fields().ByName("halving_initial_monthly_emission")
+	fd_Params_halving_interval_months = md_Params.Fields().ByName("halving_interval_months")
+	fd_Params_validators_split_controller_enabled = md_Params.Fields().ByName("validators_split_controller_enabled")
+	fd_Params_min_validators_percent = md_Params.Fields().ByName("min_validators_percent")
+	fd_Params_max_validators_percent = md_Params.Fields().ByName("max_validators_percent")
+	fd_Params_target_validators_stake_ratio = md_Params.Fields().ByName("target_validators_stake_ratio")
+	fd_Params_validators_percent_adjustment_rate = md_Params.Fields().ByName("validators_percent_adjustment_rate")
+	fd_Params_validators_split_history_length = md_Params.Fields().ByName("validators_split_history_length")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -169,6 +292,84 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if len(x.VestingTranches) != 0 {
+		value := protoreflect.ValueOfList(&_Params_11_list{list: &x.VestingTranches})
+		if !f(fd_Params_vesting_tranches, value) {
+			return
+		}
+	}
+	if x.FeeBurnFraction != "" {
+		value := protoreflect.ValueOfString(x.FeeBurnFraction)
+		if !f(fd_Params_fee_burn_fraction, value) {
+			return
+		}
+	}
+	if x.FeeCommunityPoolFraction != "" {
+		value := protoreflect.ValueOfString(x.FeeCommunityPoolFraction)
+		if !f(fd_Params_fee_community_pool_fraction, value) {
+			return
+		}
+	}
+	if x.EmissionModel != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.EmissionModel))
+		if !f(fd_Params_emission_model, value) {
+			return
+		}
+	}
+	if len(x.FixedScheduleMonthlyEmissions) != 0 {
+		value := protoreflect.ValueOfList(&_Params_15_list{list: &x.FixedScheduleMonthlyEmissions})
+		if !f(fd_Params_fixed_schedule_monthly_emissions, value) {
+			return
+		}
+	}
+	if x.HalvingInitialMonthlyEmission != "" {
+		value := protoreflect.ValueOfString(x.HalvingInitialMonthlyEmission)
+		if !f(fd_Params_halving_initial_monthly_emission, value) {
+			return
+		}
+	}
+	if x.HalvingIntervalMonths != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.HalvingIntervalMonths)
+		if !f(fd_Params_halving_interval_months, value) {
+			return
+		}
+	}
+	if x.ValidatorsSplitControllerEnabled != false {
+		value := protoreflect.ValueOfBool(x.ValidatorsSplitControllerEnabled)
+		if !f(fd_Params_validators_split_controller_enabled, value) {
+			return
+		}
+	}
+	if x.MinValidatorsPercent != "" {
+		value := protoreflect.ValueOfString(x.MinValidatorsPercent)
+		if !f(fd_Params_min_validators_percent, value) {
+			return
+		}
+	}
+	if x.MaxValidatorsPercent != "" {
+		value := protoreflect.ValueOfString(x.MaxValidatorsPercent)
+		if !f(fd_Params_max_validators_percent, value) {
+			return
+		}
+	}
+	if x.TargetValidatorsStakeRatio != "" {
+		value := protoreflect.ValueOfString(x.TargetValidatorsStakeRatio)
+		if !f(fd_Params_target_validators_stake_ratio, value) {
+			return
+		}
+	}
+	if x.ValidatorsPercentAdjustmentRate != "" {
+		value := protoreflect.ValueOfString(x.ValidatorsPercentAdjustmentRate)
+		if !f(fd_Params_validators_percent_adjustment_rate, value) {
+			return
+		}
+	}
+	if x.ValidatorsSplitHistoryLength != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ValidatorsSplitHistoryLength)
+		if !f(fd_Params_validators_split_history_length, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -204,6 +405,32 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.TeamPercentOfTotalSupply != ""
 	case "mint.v1beta1.Params.maximum_monthly_percentage_yield":
 		return x.MaximumMonthlyPercentageYield != ""
+	case "mint.v1beta1.Params.vesting_tranches":
+		return len(x.VestingTranches) != 0
+	case "mint.v1beta1.Params.fee_burn_fraction":
+		return x.FeeBurnFraction != ""
+	case "mint.v1beta1.Params.fee_community_pool_fraction":
+		return x.FeeCommunityPoolFraction != ""
+	case "mint.v1beta1.Params.emission_model":
+		return x.EmissionModel != 0
+	case "mint.v1beta1.Params.fixed_schedule_monthly_emissions":
+		return len(x.FixedScheduleMonthlyEmissions) != 0
+	case "mint.v1beta1.Params.halving_initial_monthly_emission":
+		return x.HalvingInitialMonthlyEmission != ""
+	case "mint.v1beta1.Params.halving_interval_months":
+		return x.HalvingIntervalMonths != uint64(0)
+	case "mint.v1beta1.Params.validators_split_controller_enabled":
+		return x.ValidatorsSplitControllerEnabled != false
+	case "mint.v1beta1.Params.min_validators_percent":
+		return x.MinValidatorsPercent != ""
+	case "mint.v1beta1.Params.max_validators_percent":
+		return x.MaxValidatorsPercent != ""
+	case "mint.v1beta1.Params.target_validators_stake_ratio":
+		return x.TargetValidatorsStakeRatio != ""
+	case "mint.v1beta1.Params.validators_percent_adjustment_rate":
+		return x.ValidatorsPercentAdjustmentRate != ""
+	case "mint.v1beta1.Params.validators_split_history_length":
+		return x.ValidatorsSplitHistoryLength != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.Params"))
@@ -240,6 +467,32 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.TeamPercentOfTotalSupply = ""
 	case "mint.v1beta1.Params.maximum_monthly_percentage_yield":
 		x.MaximumMonthlyPercentageYield = ""
+	case "mint.v1beta1.Params.vesting_tranches":
+		x.VestingTranches = nil
+	case "mint.v1beta1.Params.fee_burn_fraction":
+		x.FeeBurnFraction = ""
+	case "mint.v1beta1.Params.fee_community_pool_fraction":
+		x.FeeCommunityPoolFraction = ""
+	case "mint.v1beta1.Params.emission_model":
+		x.EmissionModel = 0
+	case "mint.v1beta1.Params.fixed_schedule_monthly_emissions":
+		x.FixedScheduleMonthlyEmissions = nil
+	case "mint.v1beta1.Params.halving_initial_monthly_emission":
+		x.HalvingInitialMonthlyEmission = ""
+	case "mint.v1beta1.Params.halving_interval_months":
+		x.HalvingIntervalMonths = uint64(0)
+	case "mint.v1beta1.Params.validators_split_controller_enabled":
+		x.ValidatorsSplitControllerEnabled = false
+	case "mint.v1beta1.Params.min_validators_percent":
+		x.MinValidatorsPercent = ""
+	case "mint.v1beta1.Params.max_validators_percent":
+		x.MaxValidatorsPercent = ""
+	case "mint.v1beta1.Params.target_validators_stake_ratio":
+		x.TargetValidatorsStakeRatio = ""
+	case "mint.v1beta1.Params.validators_percent_adjustment_rate":
+		x.ValidatorsPercentAdjustmentRate = ""
+	case "mint.v1beta1.Params.validators_split_history_length":
+		x.ValidatorsSplitHistoryLength = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.Params"))
@@ -286,6 +539,51 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "mint.v1beta1.Params.maximum_monthly_percentage_yield":
 		value := x.MaximumMonthlyPercentageYield
 		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.Params.vesting_tranches":
+		if len(x.VestingTranches) == 0 {
+			return protoreflect.ValueOfList(&_Params_11_list{})
+		}
+		listValue := &_Params_11_list{list: &x.VestingTranches}
+		return protoreflect.ValueOfList(listValue)
+	case "mint.v1beta1.Params.fee_burn_fraction":
+		value := x.FeeBurnFraction
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.Params.fee_community_pool_fraction":
+		value := x.FeeCommunityPoolFraction
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.Params.emission_model":
+		value := x.EmissionModel
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "mint.v1beta1.Params.fixed_schedule_monthly_emissions":
+		if len(x.FixedScheduleMonthlyEmissions) == 0 {
+			return protoreflect.ValueOfList(&_Params_15_list{})
+		}
+		listValue := &_Params_15_list{list: &x.FixedScheduleMonthlyEmissions}
+		return protoreflect.ValueOfList(listValue)
+	case "mint.v1beta1.Params.halving_initial_monthly_emission":
+		value := x.HalvingInitialMonthlyEmission
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.Params.halving_interval_months":
+		value := x.HalvingIntervalMonths
+		return protoreflect.ValueOfUint64(value)
+	case "mint.v1beta1.Params.validators_split_controller_enabled":
+		value := x.ValidatorsSplitControllerEnabled
+		return protoreflect.ValueOfBool(value)
+	case "mint.v1beta1.Params.min_validators_percent":
+		value := x.MinValidatorsPercent
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.Params.max_validators_percent":
+		value := x.MaxValidatorsPercent
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.Params.target_validators_stake_ratio":
+		value := x.TargetValidatorsStakeRatio
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.Params.validators_percent_adjustment_rate":
+		value := x.ValidatorsPercentAdjustmentRate
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.Params.validators_split_history_length":
+		value := x.ValidatorsSplitHistoryLength
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.Params"))
@@ -326,6 +624,36 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.TeamPercentOfTotalSupply = value.Interface().(string)
 	case "mint.v1beta1.Params.maximum_monthly_percentage_yield":
 		x.MaximumMonthlyPercentageYield = value.Interface().(string)
+	case "mint.v1beta1.Params.vesting_tranches":
+		lv := value.List()
+		clv := lv.(*_Params_11_list)
+		x.VestingTranches = *clv.list
+	case "mint.v1beta1.Params.fee_burn_fraction":
+		x.FeeBurnFraction = value.Interface().(string)
+	case "mint.v1beta1.Params.fee_community_pool_fraction":
+		x.FeeCommunityPoolFraction = value.Interface().(string)
+	case "mint.v1beta1.Params.emission_model":
+		x.EmissionModel = (EmissionModelType)(value.Enum())
+	case "mint.v1beta1.Params.fixed_schedule_monthly_emissions":
+		lv := value.List()
+		clv := lv.(*_Params_15_list)
+		x.FixedScheduleMonthlyEmissions = *clv.list
+	case "mint.v1beta1.Params.halving_initial_monthly_emission":
+		x.HalvingInitialMonthlyEmission = value.Interface().(string)
+	case "mint.v1beta1.Params.halving_interval_months":
+		x.HalvingIntervalMonths = value.Uint()
+	case "mint.v1beta1.Params.validators_split_controller_enabled":
+		x.ValidatorsSplitControllerEnabled = value.Bool()
+	case "mint.v1beta1.Params.min_validators_percent":
+		x.MinValidatorsPercent = value.Interface().(string)
+	case "mint.v1beta1.Params.max_validators_percent":
+		x.MaxValidatorsPercent = value.Interface().(string)
+	case "mint.v1beta1.Params.target_validators_stake_ratio":
+		x.TargetValidatorsStakeRatio = value.Interface().(string)
+	case "mint.v1beta1.Params.validators_percent_adjustment_rate":
+		x.ValidatorsPercentAdjustmentRate = value.Interface().(string)
+	case "mint.v1beta1.Params.validators_split_history_length":
+		x.ValidatorsSplitHistoryLength = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.Params"))
@@ -346,6 +674,18 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "mint.v1beta1.Params.vesting_tranches":
+		if x.VestingTranches == nil {
+			x.VestingTranches = []*VestingTranche{}
+		}
+		value := &_Params_11_list{list: &x.VestingTranches}
+		return protoreflect.ValueOfList(value)
+	case "mint.v1beta1.Params.fixed_schedule_monthly_emissions":
+		if x.FixedScheduleMonthlyEmissions == nil {
+			x.FixedScheduleMonthlyEmissions = []string{}
+		}
+		value := &_Params_15_list{list: &x.FixedScheduleMonthlyEmissions}
+		return protoreflect.ValueOfList(value)
 	case "mint.v1beta1.Params.mint_denom":
 		panic(fmt.Errorf("field mint_denom of message mint.v1beta1.Params is not mutable"))
 	case "mint.v1beta1.Params.max_supply":
@@ -366,6 +706,28 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field team_percent_of_total_supply of message mint.v1beta1.Params is not mutable"))
 	case "mint.v1beta1.Params.maximum_monthly_percentage_yield":
 		panic(fmt.Errorf("field maximum_monthly_percentage_yield of message mint.v1beta1.Params is not mutable"))
+	case "mint.v1beta1.Params.fee_burn_fraction":
+		panic(fmt.Errorf("field fee_burn_fraction of message mint.v1beta1.Params is not mutable"))
+	case "mint.v1beta1.Params.fee_community_pool_fraction":
+		panic(fmt.Errorf("field fee_community_pool_fraction of message mint.v1beta1.Params is not mutable"))
+	case "mint.v1beta1.Params.emission_model":
+		panic(fmt.Errorf("field emission_model of message mint.v1beta1.Params is not mutable"))
+	case "mint.v1beta1.Params.halving_initial_monthly_emission":
+		panic(fmt.Errorf("field halving_initial_monthly_emission of message mint.v1beta1.Params is not mutable"))
+	case "mint.v1beta1.Params.halving_interval_months":
+		panic(fmt.Errorf("field halving_interval_months of message mint.v1beta1.Params is not mutable"))
+	case "mint.v1beta1.Params.validators_split_controller_enabled":
+		panic(fmt.Errorf("field validators_split_controller_enabled of message mint.v1beta1.Params is not mutable"))
+	case "mint.v1beta1.Params.min_validators_percent":
+		panic(fmt.Errorf("field min_validators_percent of message mint.v1beta1.Params is not mutable"))
+	case "mint.v1beta1.Params.max_validators_percent":
+		panic(fmt.Errorf("field max_validators_percent of message mint.v1beta1.Params is not mutable"))
+	case "mint.v1beta1.Params.target_validators_stake_ratio":
+		panic(fmt.Errorf("field target_validators_stake_ratio of message mint.v1beta1.Params is not mutable"))
+	case "mint.v1beta1.Params.validators_percent_adjustment_rate":
+		panic(fmt.Errorf("field validators_percent_adjustment_rate of message mint.v1beta1.Params is not mutable"))
+	case "mint.v1beta1.Params.validators_split_history_length":
+		panic(fmt.Errorf("field validators_split_history_length of message mint.v1beta1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.Params"))
@@ -399,6 +761,34 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfString("")
 	case "mint.v1beta1.Params.maximum_monthly_percentage_yield":
 		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.Params.vesting_tranches":
+		list := []*VestingTranche{}
+		return protoreflect.ValueOfList(&_Params_11_list{list: &list})
+	case "mint.v1beta1.Params.fee_burn_fraction":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.Params.fee_community_pool_fraction":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.Params.emission_model":
+		return protoreflect.ValueOfEnum(0)
+	case "mint.v1beta1.Params.fixed_schedule_monthly_emissions":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_15_list{list: &list})
+	case "mint.v1beta1.Params.halving_initial_monthly_emission":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.Params.halving_interval_months":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "mint.v1beta1.Params.validators_split_controller_enabled":
+		return protoreflect.ValueOfBool(false)
+	case "mint.v1beta1.Params.min_validators_percent":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.Params.max_validators_percent":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.Params.target_validators_stake_ratio":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.Params.validators_percent_adjustment_rate":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.Params.validators_split_history_length":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.Params"))
@@ -508,6 +898,58 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.VestingTranches) > 0 {
+			for _, e := range x.VestingTranches {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.FeeBurnFraction)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.FeeCommunityPoolFraction)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EmissionModel != 0 {
+			n += 1 + runtime.Sov(uint64(x.EmissionModel))
+		}
+		if len(x.FixedScheduleMonthlyEmissions) > 0 {
+			for _, s := range x.FixedScheduleMonthlyEmissions {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.HalvingInitialMonthlyEmission)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.HalvingIntervalMonths != 0 {
+			n += 2 + runtime.Sov(uint64(x.HalvingIntervalMonths))
+		}
+		if x.ValidatorsSplitControllerEnabled {
+			n += 3
+		}
+		l = len(x.MinValidatorsPercent)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxValidatorsPercent)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TargetValidatorsStakeRatio)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ValidatorsPercentAdjustmentRate)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.ValidatorsSplitHistoryLength != 0 {
+			n += 2 + runtime.Sov(uint64(x.ValidatorsSplitHistoryLength))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -537,6 +979,121 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.ValidatorsSplitHistoryLength != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ValidatorsSplitHistoryLength))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb8
+		}
+		if len(x.ValidatorsPercentAdjustmentRate) > 0 {
+			i -= len(x.ValidatorsPercentAdjustmentRate)
+			copy(dAtA[i:], x.ValidatorsPercentAdjustmentRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorsPercentAdjustmentRate)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+		if len(x.TargetValidatorsStakeRatio) > 0 {
+			i -= len(x.TargetValidatorsStakeRatio)
+			copy(dAtA[i:], x.TargetValidatorsStakeRatio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TargetValidatorsStakeRatio)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+		if len(x.MaxValidatorsPercent) > 0 {
+			i -= len(x.MaxValidatorsPercent)
+			copy(dAtA[i:], x.MaxValidatorsPercent)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxValidatorsPercent)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+		if len(x.MinValidatorsPercent) > 0 {
+			i -= len(x.MinValidatorsPercent)
+			copy(dAtA[i:], x.MinValidatorsPercent)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinValidatorsPercent)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+		if x.ValidatorsSplitControllerEnabled {
+			i--
+			if x.ValidatorsSplitControllerEnabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x90
+		}
+		if x.HalvingIntervalMonths != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.HalvingIntervalMonths))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x88
+		}
+		if len(x.HalvingInitialMonthlyEmission) > 0 {
+			i -= len(x.HalvingInitialMonthlyEmission)
+			copy(dAtA[i:], x.HalvingInitialMonthlyEmission)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HalvingInitialMonthlyEmission)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+		if len(x.FixedScheduleMonthlyEmissions) > 0 {
+			for iNdEx := len(x.FixedScheduleMonthlyEmissions) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.FixedScheduleMonthlyEmissions[iNdEx])
+				copy(dAtA[i:], x.FixedScheduleMonthlyEmissions[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FixedScheduleMonthlyEmissions[iNdEx])))
+				i--
+				dAtA[i] = 0x7a
+			}
+		}
+		if x.EmissionModel != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EmissionModel))
+			i--
+			dAtA[i] = 0x70
+		}
+		if len(x.FeeCommunityPoolFraction) > 0 {
+			i -= len(x.FeeCommunityPoolFraction)
+			copy(dAtA[i:], x.FeeCommunityPoolFraction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FeeCommunityPoolFraction)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if len(x.FeeBurnFraction) > 0 {
+			i -= len(x.FeeBurnFraction)
+			copy(dAtA[i:], x.FeeBurnFraction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FeeBurnFraction)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if len(x.VestingTranches) > 0 {
+			for iNdEx := len(x.VestingTranches) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.VestingTranches[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
 		if len(x.MaximumMonthlyPercentageYield) > 0 {
 			i -= len(x.MaximumMonthlyPercentageYield)
 			copy(dAtA[i:], x.MaximumMonthlyPercentageYield)
@@ -976,168 +1533,3840 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				}
 				x.MaximumMonthlyPercentageYield = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VestingTranches", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VestingTranches = append(x.VestingTranches, &VestingTranche{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VestingTranches[len(x.VestingTranches)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeBurnFraction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.FeeBurnFraction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeCommunityPoolFraction", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: mint/v1beta1/types.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-// Params defines the parameters for the x/mint module.
-type Params struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeCommunityPoolFraction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EmissionModel", wireType)
+				}
+				x.EmissionModel = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EmissionModel |= EmissionModelType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FixedScheduleMonthlyEmissions", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FixedScheduleMonthlyEmissions = append(x.FixedScheduleMonthlyEmissions, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HalvingInitialMonthlyEmission", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HalvingInitialMonthlyEmission = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 17:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HalvingIntervalMonths", wireType)
+				}
+				x.HalvingIntervalMonths = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.HalvingIntervalMonths |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 18:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorsSplitControllerEnabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.ValidatorsSplitControllerEnabled = bool(v != 0)
+			case 19:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinValidatorsPercent", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinValidatorsPercent = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 20:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorsPercent", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxValidatorsPercent = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 21:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetValidatorsStakeRatio", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TargetValidatorsStakeRatio = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 22:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorsPercentAdjustmentRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorsPercentAdjustmentRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 23:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorsSplitHistoryLength", wireType)
+				}
+				x.ValidatorsSplitHistoryLength = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ValidatorsSplitHistoryLength |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_VestingTranche                         protoreflect.MessageDescriptor
+	fd_VestingTranche_name                    protoreflect.FieldDescriptor
+	fd_VestingTranche_percent_of_total_supply protoreflect.FieldDescriptor
+	fd_VestingTranche_start_height            protoreflect.FieldDescriptor
+	fd_VestingTranche_cliff_months            protoreflect.FieldDescriptor
+	fd_VestingTranche_duration_months         protoreflect.FieldDescriptor
+	fd_VestingTranche_curve                   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mint_v1beta1_types_proto_init()
+	md_VestingTranche = File_mint_v1beta1_types_proto.Messages().ByName("VestingTranche")
+	fd_VestingTranche_name = md_VestingTranche.Fields().ByName("name")
+	fd_VestingTranche_percent_of_total_supply = md_VestingTranche.Fields().ByName("percent_of_total_supply")
+	fd_VestingTranche_start_height = md_VestingTranche.Fields().ByName("start_height")
+	fd_VestingTranche_cliff_months = md_VestingTranche.Fields().ByName("cliff_months")
+	fd_VestingTranche_duration_months = md_VestingTranche.Fields().ByName("duration_months")
+	fd_VestingTranche_curve = md_VestingTranche.Fields().ByName("curve")
+}
+
+var _ protoreflect.Message = (*fastReflection_VestingTranche)(nil)
+
+type fastReflection_VestingTranche VestingTranche
+
+func (x *VestingTranche) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VestingTranche)(x)
+}
+
+func (x *VestingTranche) slowProtoReflect() protoreflect.Message {
+	mi := &file_mint_v1beta1_types_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_VestingTranche_messageType fastReflection_VestingTranche_messageType
+var _ protoreflect.MessageType = fastReflection_VestingTranche_messageType{}
+
+type fastReflection_VestingTranche_messageType struct{}
+
+func (x fastReflection_VestingTranche_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VestingTranche)(nil)
+}
+func (x fastReflection_VestingTranche_messageType) New() protoreflect.Message {
+	return new(fastReflection_VestingTranche)
+}
+func (x fastReflection_VestingTranche_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VestingTranche
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_VestingTranche) Descriptor() protoreflect.MessageDescriptor {
+	return md_VestingTranche
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_VestingTranche) Type() protoreflect.MessageType {
+	return _fastReflection_VestingTranche_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_VestingTranche) New() protoreflect.Message {
+	return new(fastReflection_VestingTranche)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_VestingTranche) Interface() protoreflect.ProtoMessage {
+	return (*VestingTranche)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_VestingTranche) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_VestingTranche_name, value) {
+			return
+		}
+	}
+	if x.PercentOfTotalSupply != "" {
+		value := protoreflect.ValueOfString(x.PercentOfTotalSupply)
+		if !f(fd_VestingTranche_percent_of_total_supply, value) {
+			return
+		}
+	}
+	if x.StartHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.StartHeight)
+		if !f(fd_VestingTranche_start_height, value) {
+			return
+		}
+	}
+	if x.CliffMonths != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CliffMonths)
+		if !f(fd_VestingTranche_cliff_months, value) {
+			return
+		}
+	}
+	if x.DurationMonths != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DurationMonths)
+		if !f(fd_VestingTranche_duration_months, value) {
+			return
+		}
+	}
+	if x.Curve != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Curve))
+		if !f(fd_VestingTranche_curve, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_VestingTranche) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mint.v1beta1.VestingTranche.name":
+		return x.Name != ""
+	case "mint.v1beta1.VestingTranche.percent_of_total_supply":
+		return x.PercentOfTotalSupply != ""
+	case "mint.v1beta1.VestingTranche.start_height":
+		return x.StartHeight != uint64(0)
+	case "mint.v1beta1.VestingTranche.cliff_months":
+		return x.CliffMonths != uint64(0)
+	case "mint.v1beta1.VestingTranche.duration_months":
+		return x.DurationMonths != uint64(0)
+	case "mint.v1beta1.VestingTranche.curve":
+		return x.Curve != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.VestingTranche"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.VestingTranche does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VestingTranche) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mint.v1beta1.VestingTranche.name":
+		x.Name = ""
+	case "mint.v1beta1.VestingTranche.percent_of_total_supply":
+		x.PercentOfTotalSupply = ""
+	case "mint.v1beta1.VestingTranche.start_height":
+		x.StartHeight = uint64(0)
+	case "mint.v1beta1.VestingTranche.cliff_months":
+		x.CliffMonths = uint64(0)
+	case "mint.v1beta1.VestingTranche.duration_months":
+		x.DurationMonths = uint64(0)
+	case "mint.v1beta1.VestingTranche.curve":
+		x.Curve = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.VestingTranche"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.VestingTranche does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_VestingTranche) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mint.v1beta1.VestingTranche.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.VestingTranche.percent_of_total_supply":
+		value := x.PercentOfTotalSupply
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.VestingTranche.start_height":
+		value := x.StartHeight
+		return protoreflect.ValueOfUint64(value)
+	case "mint.v1beta1.VestingTranche.cliff_months":
+		value := x.CliffMonths
+		return protoreflect.ValueOfUint64(value)
+	case "mint.v1beta1.VestingTranche.duration_months":
+		value := x.DurationMonths
+		return protoreflect.ValueOfUint64(value)
+	case "mint.v1beta1.VestingTranche.curve":
+		value := x.Curve
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.VestingTranche"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.VestingTranche does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VestingTranche) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mint.v1beta1.VestingTranche.name":
+		x.Name = value.Interface().(string)
+	case "mint.v1beta1.VestingTranche.percent_of_total_supply":
+		x.PercentOfTotalSupply = value.Interface().(string)
+	case "mint.v1beta1.VestingTranche.start_height":
+		x.StartHeight = value.Uint()
+	case "mint.v1beta1.VestingTranche.cliff_months":
+		x.CliffMonths = value.Uint()
+	case "mint.v1beta1.VestingTranche.duration_months":
+		x.DurationMonths = value.Uint()
+	case "mint.v1beta1.VestingTranche.curve":
+		x.Curve = (VestingCurveType)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.VestingTranche"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.VestingTranche does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VestingTranche) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mint.v1beta1.VestingTranche.name":
+		panic(fmt.Errorf("field name of message mint.v1beta1.VestingTranche is not mutable"))
+	case "mint.v1beta1.VestingTranche.percent_of_total_supply":
+		panic(fmt.Errorf("field percent_of_total_supply of message mint.v1beta1.VestingTranche is not mutable"))
+	case "mint.v1beta1.VestingTranche.start_height":
+		panic(fmt.Errorf("field start_height of message mint.v1beta1.VestingTranche is not mutable"))
+	case "mint.v1beta1.VestingTranche.cliff_months":
+		panic(fmt.Errorf("field cliff_months of message mint.v1beta1.VestingTranche is not mutable"))
+	case "mint.v1beta1.VestingTranche.duration_months":
+		panic(fmt.Errorf("field duration_months of message mint.v1beta1.VestingTranche is not mutable"))
+	case "mint.v1beta1.VestingTranche.curve":
+		panic(fmt.Errorf("field curve of message mint.v1beta1.VestingTranche is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.VestingTranche"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.VestingTranche does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_VestingTranche) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mint.v1beta1.VestingTranche.name":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.VestingTranche.percent_of_total_supply":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.VestingTranche.start_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "mint.v1beta1.VestingTranche.cliff_months":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "mint.v1beta1.VestingTranche.duration_months":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "mint.v1beta1.VestingTranche.curve":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.VestingTranche"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.VestingTranche does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_VestingTranche) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mint.v1beta1.VestingTranche", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_VestingTranche) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VestingTranche) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_VestingTranche) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_VestingTranche) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*VestingTranche)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PercentOfTotalSupply)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.StartHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.StartHeight))
+		}
+		if x.CliffMonths != 0 {
+			n += 1 + runtime.Sov(uint64(x.CliffMonths))
+		}
+		if x.DurationMonths != 0 {
+			n += 1 + runtime.Sov(uint64(x.DurationMonths))
+		}
+		if x.Curve != 0 {
+			n += 1 + runtime.Sov(uint64(x.Curve))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*VestingTranche)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Curve != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Curve))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.DurationMonths != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DurationMonths))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.CliffMonths != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CliffMonths))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.StartHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartHeight))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.PercentOfTotalSupply) > 0 {
+			i -= len(x.PercentOfTotalSupply)
+			copy(dAtA[i:], x.PercentOfTotalSupply)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PercentOfTotalSupply)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*VestingTranche)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VestingTranche: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VestingTranche: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Name = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PercentOfTotalSupply", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PercentOfTotalSupply = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+				}
+				x.StartHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StartHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CliffMonths", wireType)
+				}
+				x.CliffMonths = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CliffMonths |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DurationMonths", wireType)
+				}
+				x.DurationMonths = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DurationMonths |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Curve", wireType)
+				}
+				x.Curve = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Curve |= VestingCurveType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EmissionInfo                                                     protoreflect.MessageDescriptor
+	fd_EmissionInfo_block_height                                        protoreflect.FieldDescriptor
+	fd_EmissionInfo_blocks_per_month                                    protoreflect.FieldDescriptor
+	fd_EmissionInfo_network_staked                                      protoreflect.FieldDescriptor
+	fd_EmissionInfo_total_supply                                        protoreflect.FieldDescriptor
+	fd_EmissionInfo_locked_supply                                       protoreflect.FieldDescriptor
+	fd_EmissionInfo_circulating_supply                                  protoreflect.FieldDescriptor
+	fd_EmissionInfo_ecosystem_mint_supply_remaining                     protoreflect.FieldDescriptor
+	fd_EmissionInfo_target_reward_emission_per_unit_staked_token        protoreflect.FieldDescriptor
+	fd_EmissionInfo_reputers_percent                                    protoreflect.FieldDescriptor
+	fd_EmissionInfo_validators_percent                                  protoreflect.FieldDescriptor
+	fd_EmissionInfo_maximum_monthly_emission_per_unit_staked_token      protoreflect.FieldDescriptor
+	fd_EmissionInfo_capped_target_reward_emission_per_unit_staked_token protoreflect.FieldDescriptor
+	fd_EmissionInfo_previous_reward_emission_per_unit_staked_token      protoreflect.FieldDescriptor
+	fd_EmissionInfo_reward_emission_per_unit_staked_token               protoreflect.FieldDescriptor
+	fd_EmissionInfo_emission_per_month                                  protoreflect.FieldDescriptor
+	fd_EmissionInfo_block_emission                                      protoreflect.FieldDescriptor
+	fd_EmissionInfo_ecosystem_balance                                   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mint_v1beta1_types_proto_init()
+	md_EmissionInfo = File_mint_v1beta1_types_proto.Messages().ByName("EmissionInfo")
+	fd_EmissionInfo_block_height = md_EmissionInfo.Fields().ByName("block_height")
+	fd_EmissionInfo_blocks_per_month = md_EmissionInfo.Fields().ByName("blocks_per_month")
+	fd_EmissionInfo_network_staked = md_EmissionInfo.Fields().ByName("network_staked")
+	fd_EmissionInfo_total_supply = md_EmissionInfo.Fields().ByName("total_supply")
+	fd_EmissionInfo_locked_supply = md_EmissionInfo.Fields().ByName("locked_supply")
+	fd_EmissionInfo_circulating_supply = md_EmissionInfo.Fields().ByName("circulating_supply")
+	fd_EmissionInfo_ecosystem_mint_supply_remaining = md_EmissionInfo.Fields().ByName("ecosystem_mint_supply_remaining")
+	fd_EmissionInfo_target_reward_emission_per_unit_staked_token = md_EmissionInfo.Fields().ByName("target_reward_emission_per_unit_staked_token")
+	fd_EmissionInfo_reputers_percent = md_EmissionInfo.Fields().ByName("reputers_percent")
+	fd_EmissionInfo_validators_percent = md_EmissionInfo.Fields().ByName("validators_percent")
+	fd_EmissionInfo_maximum_monthly_emission_per_unit_staked_token = md_EmissionInfo.Fields().ByName("maximum_monthly_emission_per_unit_staked_token")
+	fd_EmissionInfo_capped_target_reward_emission_per_unit_staked_token = md_EmissionInfo.Fields().ByName("capped_target_reward_emission_per_unit_staked_token")
+	fd_EmissionInfo_previous_reward_emission_per_unit_staked_token = md_EmissionInfo.Fields().ByName("previous_reward_emission_per_unit_staked_token")
+	fd_EmissionInfo_reward_emission_per_unit_staked_token = md_EmissionInfo.Fields().ByName("reward_emission_per_unit_staked_token")
+	fd_EmissionInfo_emission_per_month = md_EmissionInfo.Fields().ByName("emission_per_month")
+	fd_EmissionInfo_block_emission = md_EmissionInfo.Fields().ByName("block_emission")
+	fd_EmissionInfo_ecosystem_balance = md_EmissionInfo.Fields().ByName("ecosystem_balance")
+}
+
+var _ protoreflect.Message = (*fastReflection_EmissionInfo)(nil)
+
+type fastReflection_EmissionInfo EmissionInfo
+
+func (x *EmissionInfo) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EmissionInfo)(x)
+}
+
+func (x *EmissionInfo) slowProtoReflect() protoreflect.Message {
+	mi := &file_mint_v1beta1_types_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EmissionInfo_messageType fastReflection_EmissionInfo_messageType
+var _ protoreflect.MessageType = fastReflection_EmissionInfo_messageType{}
+
+type fastReflection_EmissionInfo_messageType struct{}
+
+func (x fastReflection_EmissionInfo_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EmissionInfo)(nil)
+}
+func (x fastReflection_EmissionInfo_messageType) New() protoreflect.Message {
+	return new(fastReflection_EmissionInfo)
+}
+func (x fastReflection_EmissionInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EmissionInfo
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EmissionInfo) Descriptor() protoreflect.MessageDescriptor {
+	return md_EmissionInfo
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EmissionInfo) Type() protoreflect.MessageType {
+	return _fastReflection_EmissionInfo_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EmissionInfo) New() protoreflect.Message {
+	return new(fastReflection_EmissionInfo)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EmissionInfo) Interface() protoreflect.ProtoMessage {
+	return (*EmissionInfo)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EmissionInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockHeight)
+		if !f(fd_EmissionInfo_block_height, value) {
+			return
+		}
+	}
+	if x.BlocksPerMonth != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlocksPerMonth)
+		if !f(fd_EmissionInfo_blocks_per_month, value) {
+			return
+		}
+	}
+	if x.NetworkStaked != "" {
+		value := protoreflect.ValueOfString(x.NetworkStaked)
+		if !f(fd_EmissionInfo_network_staked, value) {
+			return
+		}
+	}
+	if x.TotalSupply != "" {
+		value := protoreflect.ValueOfString(x.TotalSupply)
+		if !f(fd_EmissionInfo_total_supply, value) {
+			return
+		}
+	}
+	if x.LockedSupply != "" {
+		value := protoreflect.ValueOfString(x.LockedSupply)
+		if !f(fd_EmissionInfo_locked_supply, value) {
+			return
+		}
+	}
+	if x.CirculatingSupply != "" {
+		value := protoreflect.ValueOfString(x.CirculatingSupply)
+		if !f(fd_EmissionInfo_circulating_supply, value) {
+			return
+		}
+	}
+	if x.EcosystemMintSupplyRemaining != "" {
+		value := protoreflect.ValueOfString(x.EcosystemMintSupplyRemaining)
+		if !f(fd_EmissionInfo_ecosystem_mint_supply_remaining, value) {
+			return
+		}
+	}
+	if x.TargetRewardEmissionPerUnitStakedToken != "" {
+		value := protoreflect.ValueOfString(x.TargetRewardEmissionPerUnitStakedToken)
+		if !f(fd_EmissionInfo_target_reward_emission_per_unit_staked_token, value) {
+			return
+		}
+	}
+	if x.ReputersPercent != "" {
+		value := protoreflect.ValueOfString(x.ReputersPercent)
+		if !f(fd_EmissionInfo_reputers_percent, value) {
+			return
+		}
+	}
+	if x.ValidatorsPercent != "" {
+		value := protoreflect.ValueOfString(x.ValidatorsPercent)
+		if !f(fd_EmissionInfo_validators_percent, value) {
+			return
+		}
+	}
+	if x.MaximumMonthlyEmissionPerUnitStakedToken != "" {
+		value := protoreflect.ValueOfString(x.MaximumMonthlyEmissionPerUnitStakedToken)
+		if !f(fd_EmissionInfo_maximum_monthly_emission_per_unit_staked_token, value) {
+			return
+		}
+	}
+	if x.CappedTargetRewardEmissionPerUnitStakedToken != "" {
+		value := protoreflect.ValueOfString(x.CappedTargetRewardEmissionPerUnitStakedToken)
+		if !f(fd_EmissionInfo_capped_target_reward_emission_per_unit_staked_token, value) {
+			return
+		}
+	}
+	if x.PreviousRewardEmissionPerUnitStakedToken != "" {
+		value := protoreflect.ValueOfString(x.PreviousRewardEmissionPerUnitStakedToken)
+		if !f(fd_EmissionInfo_previous_reward_emission_per_unit_staked_token, value) {
+			return
+		}
+	}
+	if x.RewardEmissionPerUnitStakedToken != "" {
+		value := protoreflect.ValueOfString(x.RewardEmissionPerUnitStakedToken)
+		if !f(fd_EmissionInfo_reward_emission_per_unit_staked_token, value) {
+			return
+		}
+	}
+	if x.EmissionPerMonth != "" {
+		value := protoreflect.ValueOfString(x.EmissionPerMonth)
+		if !f(fd_EmissionInfo_emission_per_month, value) {
+			return
+		}
+	}
+	if x.BlockEmission != "" {
+		value := protoreflect.ValueOfString(x.BlockEmission)
+		if !f(fd_EmissionInfo_block_emission, value) {
+			return
+		}
+	}
+	if x.EcosystemBalance != "" {
+		value := protoreflect.ValueOfString(x.EcosystemBalance)
+		if !f(fd_EmissionInfo_ecosystem_balance, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EmissionInfo) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mint.v1beta1.EmissionInfo.block_height":
+		return x.BlockHeight != int64(0)
+	case "mint.v1beta1.EmissionInfo.blocks_per_month":
+		return x.BlocksPerMonth != uint64(0)
+	case "mint.v1beta1.EmissionInfo.network_staked":
+		return x.NetworkStaked != ""
+	case "mint.v1beta1.EmissionInfo.total_supply":
+		return x.TotalSupply != ""
+	case "mint.v1beta1.EmissionInfo.locked_supply":
+		return x.LockedSupply != ""
+	case "mint.v1beta1.EmissionInfo.circulating_supply":
+		return x.CirculatingSupply != ""
+	case "mint.v1beta1.EmissionInfo.ecosystem_mint_supply_remaining":
+		return x.EcosystemMintSupplyRemaining != ""
+	case "mint.v1beta1.EmissionInfo.target_reward_emission_per_unit_staked_token":
+		return x.TargetRewardEmissionPerUnitStakedToken != ""
+	case "mint.v1beta1.EmissionInfo.reputers_percent":
+		return x.ReputersPercent != ""
+	case "mint.v1beta1.EmissionInfo.validators_percent":
+		return x.ValidatorsPercent != ""
+	case "mint.v1beta1.EmissionInfo.maximum_monthly_emission_per_unit_staked_token":
+		return x.MaximumMonthlyEmissionPerUnitStakedToken != ""
+	case "mint.v1beta1.EmissionInfo.capped_target_reward_emission_per_unit_staked_token":
+		return x.CappedTargetRewardEmissionPerUnitStakedToken != ""
+	case "mint.v1beta1.EmissionInfo.previous_reward_emission_per_unit_staked_token":
+		return x.PreviousRewardEmissionPerUnitStakedToken != ""
+	case "mint.v1beta1.EmissionInfo.reward_emission_per_unit_staked_token":
+		return x.RewardEmissionPerUnitStakedToken != ""
+	case "mint.v1beta1.EmissionInfo.emission_per_month":
+		return x.EmissionPerMonth != ""
+	case "mint.v1beta1.EmissionInfo.block_emission":
+		return x.BlockEmission != ""
+	case "mint.v1beta1.EmissionInfo.ecosystem_balance":
+		return x.EcosystemBalance != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.EmissionInfo"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.EmissionInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EmissionInfo) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mint.v1beta1.EmissionInfo.block_height":
+		x.BlockHeight = int64(0)
+	case "mint.v1beta1.EmissionInfo.blocks_per_month":
+		x.BlocksPerMonth = uint64(0)
+	case "mint.v1beta1.EmissionInfo.network_staked":
+		x.NetworkStaked = ""
+	case "mint.v1beta1.EmissionInfo.total_supply":
+		x.TotalSupply = ""
+	case "mint.v1beta1.EmissionInfo.locked_supply":
+		x.LockedSupply = ""
+	case "mint.v1beta1.EmissionInfo.circulating_supply":
+		x.CirculatingSupply = ""
+	case "mint.v1beta1.EmissionInfo.ecosystem_mint_supply_remaining":
+		x.EcosystemMintSupplyRemaining = ""
+	case "mint.v1beta1.EmissionInfo.target_reward_emission_per_unit_staked_token":
+		x.TargetRewardEmissionPerUnitStakedToken = ""
+	case "mint.v1beta1.EmissionInfo.reputers_percent":
+		x.ReputersPercent = ""
+	case "mint.v1beta1.EmissionInfo.validators_percent":
+		x.ValidatorsPercent = ""
+	case "mint.v1beta1.EmissionInfo.maximum_monthly_emission_per_unit_staked_token":
+		x.MaximumMonthlyEmissionPerUnitStakedToken = ""
+	case "mint.v1beta1.EmissionInfo.capped_target_reward_emission_per_unit_staked_token":
+		x.CappedTargetRewardEmissionPerUnitStakedToken = ""
+	case "mint.v1beta1.EmissionInfo.previous_reward_emission_per_unit_staked_token":
+		x.PreviousRewardEmissionPerUnitStakedToken = ""
+	case "mint.v1beta1.EmissionInfo.reward_emission_per_unit_staked_token":
+		x.RewardEmissionPerUnitStakedToken = ""
+	case "mint.v1beta1.EmissionInfo.emission_per_month":
+		x.EmissionPerMonth = ""
+	case "mint.v1beta1.EmissionInfo.block_emission":
+		x.BlockEmission = ""
+	case "mint.v1beta1.EmissionInfo.ecosystem_balance":
+		x.EcosystemBalance = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.EmissionInfo"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.EmissionInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EmissionInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mint.v1beta1.EmissionInfo.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "mint.v1beta1.EmissionInfo.blocks_per_month":
+		value := x.BlocksPerMonth
+		return protoreflect.ValueOfUint64(value)
+	case "mint.v1beta1.EmissionInfo.network_staked":
+		value := x.NetworkStaked
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.EmissionInfo.total_supply":
+		value := x.TotalSupply
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.EmissionInfo.locked_supply":
+		value := x.LockedSupply
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.EmissionInfo.circulating_supply":
+		value := x.CirculatingSupply
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.EmissionInfo.ecosystem_mint_supply_remaining":
+		value := x.EcosystemMintSupplyRemaining
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.EmissionInfo.target_reward_emission_per_unit_staked_token":
+		value := x.TargetRewardEmissionPerUnitStakedToken
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.EmissionInfo.reputers_percent":
+		value := x.ReputersPercent
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.EmissionInfo.validators_percent":
+		value := x.ValidatorsPercent
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.EmissionInfo.maximum_monthly_emission_per_unit_staked_token":
+		value := x.MaximumMonthlyEmissionPerUnitStakedToken
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.EmissionInfo.capped_target_reward_emission_per_unit_staked_token":
+		value := x.CappedTargetRewardEmissionPerUnitStakedToken
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.EmissionInfo.previous_reward_emission_per_unit_staked_token":
+		value := x.PreviousRewardEmissionPerUnitStakedToken
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.EmissionInfo.reward_emission_per_unit_staked_token":
+		value := x.RewardEmissionPerUnitStakedToken
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.EmissionInfo.emission_per_month":
+		value := x.EmissionPerMonth
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.EmissionInfo.block_emission":
+		value := x.BlockEmission
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.EmissionInfo.ecosystem_balance":
+		value := x.EcosystemBalance
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.EmissionInfo"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.EmissionInfo does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EmissionInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mint.v1beta1.EmissionInfo.block_height":
+		x.BlockHeight = value.Int()
+	case "mint.v1beta1.EmissionInfo.blocks_per_month":
+		x.BlocksPerMonth = value.Uint()
+	case "mint.v1beta1.EmissionInfo.network_staked":
+		x.NetworkStaked = value.Interface().(string)
+	case "mint.v1beta1.EmissionInfo.total_supply":
+		x.TotalSupply = value.Interface().(string)
+	case "mint.v1beta1.EmissionInfo.locked_supply":
+		x.LockedSupply = value.Interface().(string)
+	case "mint.v1beta1.EmissionInfo.circulating_supply":
+		x.CirculatingSupply = value.Interface().(string)
+	case "mint.v1beta1.EmissionInfo.ecosystem_mint_supply_remaining":
+		x.EcosystemMintSupplyRemaining = value.Interface().(string)
+	case "mint.v1beta1.EmissionInfo.target_reward_emission_per_unit_staked_token":
+		x.TargetRewardEmissionPerUnitStakedToken = value.Interface().(string)
+	case "mint.v1beta1.EmissionInfo.reputers_percent":
+		x.ReputersPercent = value.Interface().(string)
+	case "mint.v1beta1.EmissionInfo.validators_percent":
+		x.ValidatorsPercent = value.Interface().(string)
+	case "mint.v1beta1.EmissionInfo.maximum_monthly_emission_per_unit_staked_token":
+		x.MaximumMonthlyEmissionPerUnitStakedToken = value.Interface().(string)
+	case "mint.v1beta1.EmissionInfo.capped_target_reward_emission_per_unit_staked_token":
+		x.CappedTargetRewardEmissionPerUnitStakedToken = value.Interface().(string)
+	case "mint.v1beta1.EmissionInfo.previous_reward_emission_per_unit_staked_token":
+		x.PreviousRewardEmissionPerUnitStakedToken = value.Interface().(string)
+	case "mint.v1beta1.EmissionInfo.reward_emission_per_unit_staked_token":
+		x.RewardEmissionPerUnitStakedToken = value.Interface().(string)
+	case "mint.v1beta1.EmissionInfo.emission_per_month":
+		x.EmissionPerMonth = value.Interface().(string)
+	case "mint.v1beta1.EmissionInfo.block_emission":
+		x.BlockEmission = value.Interface().(string)
+	case "mint.v1beta1.EmissionInfo.ecosystem_balance":
+		x.EcosystemBalance = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.EmissionInfo"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.EmissionInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EmissionInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mint.v1beta1.EmissionInfo.block_height":
+		panic(fmt.Errorf("field block_height of message mint.v1beta1.EmissionInfo is not mutable"))
+	case "mint.v1beta1.EmissionInfo.blocks_per_month":
+		panic(fmt.Errorf("field blocks_per_month of message mint.v1beta1.EmissionInfo is not mutable"))
+	case "mint.v1beta1.EmissionInfo.network_staked":
+		panic(fmt.Errorf("field network_staked of message mint.v1beta1.EmissionInfo is not mutable"))
+	case "mint.v1beta1.EmissionInfo.total_supply":
+		panic(fmt.Errorf("field total_supply of message mint.v1beta1.EmissionInfo is not mutable"))
+	case "mint.v1beta1.EmissionInfo.locked_supply":
+		panic(fmt.Errorf("field locked_supply of message mint.v1beta1.EmissionInfo is not mutable"))
+	case "mint.v1beta1.EmissionInfo.circulating_supply":
+		panic(fmt.Errorf("field circulating_supply of message mint.v1beta1.EmissionInfo is not mutable"))
+	case "mint.v1beta1.EmissionInfo.ecosystem_mint_supply_remaining":
+		panic(fmt.Errorf("field ecosystem_mint_supply_remaining of message mint.v1beta1.EmissionInfo is not mutable"))
+	case "mint.v1beta1.EmissionInfo.target_reward_emission_per_unit_staked_token":
+		panic(fmt.Errorf("field target_reward_emission_per_unit_staked_token of message mint.v1beta1.EmissionInfo is not mutable"))
+	case "mint.v1beta1.EmissionInfo.reputers_percent":
+		panic(fmt.Errorf("field reputers_percent of message mint.v1beta1.EmissionInfo is not mutable"))
+	case "mint.v1beta1.EmissionInfo.validators_percent":
+		panic(fmt.Errorf("field validators_percent of message mint.v1beta1.EmissionInfo is not mutable"))
+	case "mint.v1beta1.EmissionInfo.maximum_monthly_emission_per_unit_staked_token":
+		panic(fmt.Errorf("field maximum_monthly_emission_per_unit_staked_token of message mint.v1beta1.EmissionInfo is not mutable"))
+	case "mint.v1beta1.EmissionInfo.capped_target_reward_emission_per_unit_staked_token":
+		panic(fmt.Errorf("field capped_target_reward_emission_per_unit_staked_token of message mint.v1beta1.EmissionInfo is not mutable"))
+	case "mint.v1beta1.EmissionInfo.previous_reward_emission_per_unit_staked_token":
+		panic(fmt.Errorf("field previous_reward_emission_per_unit_staked_token of message mint.v1beta1.EmissionInfo is not mutable"))
+	case "mint.v1beta1.EmissionInfo.reward_emission_per_unit_staked_token":
+		panic(fmt.Errorf("field reward_emission_per_unit_staked_token of message mint.v1beta1.EmissionInfo is not mutable"))
+	case "mint.v1beta1.EmissionInfo.emission_per_month":
+		panic(fmt.Errorf("field emission_per_month of message mint.v1beta1.EmissionInfo is not mutable"))
+	case "mint.v1beta1.EmissionInfo.block_emission":
+		panic(fmt.Errorf("field block_emission of message mint.v1beta1.EmissionInfo is not mutable"))
+	case "mint.v1beta1.EmissionInfo.ecosystem_balance":
+		panic(fmt.Errorf("field ecosystem_balance of message mint.v1beta1.EmissionInfo is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.EmissionInfo"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.EmissionInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EmissionInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mint.v1beta1.EmissionInfo.block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "mint.v1beta1.EmissionInfo.blocks_per_month":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "mint.v1beta1.EmissionInfo.network_staked":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.EmissionInfo.total_supply":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.EmissionInfo.locked_supply":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.EmissionInfo.circulating_supply":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.EmissionInfo.ecosystem_mint_supply_remaining":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.EmissionInfo.target_reward_emission_per_unit_staked_token":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.EmissionInfo.reputers_percent":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.EmissionInfo.validators_percent":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.EmissionInfo.maximum_monthly_emission_per_unit_staked_token":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.EmissionInfo.capped_target_reward_emission_per_unit_staked_token":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.EmissionInfo.previous_reward_emission_per_unit_staked_token":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.EmissionInfo.reward_emission_per_unit_staked_token":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.EmissionInfo.emission_per_month":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.EmissionInfo.block_emission":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.EmissionInfo.ecosystem_balance":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.EmissionInfo"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.EmissionInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EmissionInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mint.v1beta1.EmissionInfo", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EmissionInfo) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EmissionInfo) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EmissionInfo) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EmissionInfo) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EmissionInfo)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		}
+		if x.BlocksPerMonth != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlocksPerMonth))
+		}
+		l = len(x.NetworkStaked)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TotalSupply)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LockedSupply)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CirculatingSupply)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.EcosystemMintSupplyRemaining)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TargetRewardEmissionPerUnitStakedToken)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ReputersPercent)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ValidatorsPercent)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaximumMonthlyEmissionPerUnitStakedToken)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CappedTargetRewardEmissionPerUnitStakedToken)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PreviousRewardEmissionPerUnitStakedToken)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RewardEmissionPerUnitStakedToken)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.EmissionPerMonth)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BlockEmission)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.EcosystemBalance)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EmissionInfo)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.EcosystemBalance) > 0 {
+			i -= len(x.EcosystemBalance)
+			copy(dAtA[i:], x.EcosystemBalance)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EcosystemBalance)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if len(x.BlockEmission) > 0 {
+			i -= len(x.BlockEmission)
+			copy(dAtA[i:], x.BlockEmission)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlockEmission)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+		if len(x.EmissionPerMonth) > 0 {
+			i -= len(x.EmissionPerMonth)
+			copy(dAtA[i:], x.EmissionPerMonth)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EmissionPerMonth)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if len(x.RewardEmissionPerUnitStakedToken) > 0 {
+			i -= len(x.RewardEmissionPerUnitStakedToken)
+			copy(dAtA[i:], x.RewardEmissionPerUnitStakedToken)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RewardEmissionPerUnitStakedToken)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if len(x.PreviousRewardEmissionPerUnitStakedToken) > 0 {
+			i -= len(x.PreviousRewardEmissionPerUnitStakedToken)
+			copy(dAtA[i:], x.PreviousRewardEmissionPerUnitStakedToken)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PreviousRewardEmissionPerUnitStakedToken)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if len(x.CappedTargetRewardEmissionPerUnitStakedToken) > 0 {
+			i -= len(x.CappedTargetRewardEmissionPerUnitStakedToken)
+			copy(dAtA[i:], x.CappedTargetRewardEmissionPerUnitStakedToken)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CappedTargetRewardEmissionPerUnitStakedToken)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if len(x.MaximumMonthlyEmissionPerUnitStakedToken) > 0 {
+			i -= len(x.MaximumMonthlyEmissionPerUnitStakedToken)
+			copy(dAtA[i:], x.MaximumMonthlyEmissionPerUnitStakedToken)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaximumMonthlyEmissionPerUnitStakedToken)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.ValidatorsPercent) > 0 {
+			i -= len(x.ValidatorsPercent)
+			copy(dAtA[i:], x.ValidatorsPercent)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorsPercent)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.ReputersPercent) > 0 {
+			i -= len(x.ReputersPercent)
+			copy(dAtA[i:], x.ReputersPercent)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReputersPercent)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.TargetRewardEmissionPerUnitStakedToken) > 0 {
+			i -= len(x.TargetRewardEmissionPerUnitStakedToken)
+			copy(dAtA[i:], x.TargetRewardEmissionPerUnitStakedToken)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TargetRewardEmissionPerUnitStakedToken)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.EcosystemMintSupplyRemaining) > 0 {
+			i -= len(x.EcosystemMintSupplyRemaining)
+			copy(dAtA[i:], x.EcosystemMintSupplyRemaining)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EcosystemMintSupplyRemaining)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.CirculatingSupply) > 0 {
+			i -= len(x.CirculatingSupply)
+			copy(dAtA[i:], x.CirculatingSupply)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CirculatingSupply)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.LockedSupply) > 0 {
+			i -= len(x.LockedSupply)
+			copy(dAtA[i:], x.LockedSupply)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LockedSupply)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.TotalSupply) > 0 {
+			i -= len(x.TotalSupply)
+			copy(dAtA[i:], x.TotalSupply)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TotalSupply)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.NetworkStaked) > 0 {
+			i -= len(x.NetworkStaked)
+			copy(dAtA[i:], x.NetworkStaked)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NetworkStaked)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.BlocksPerMonth != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlocksPerMonth))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EmissionInfo)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EmissionInfo: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EmissionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlocksPerMonth", wireType)
+				}
+				x.BlocksPerMonth = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlocksPerMonth |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NetworkStaked", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NetworkStaked = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TotalSupply = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockedSupply", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LockedSupply = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CirculatingSupply", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CirculatingSupply = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EcosystemMintSupplyRemaining", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EcosystemMintSupplyRemaining = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetRewardEmissionPerUnitStakedToken", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TargetRewardEmissionPerUnitStakedToken = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReputersPercent", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReputersPercent = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorsPercent", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorsPercent = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaximumMonthlyEmissionPerUnitStakedToken", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaximumMonthlyEmissionPerUnitStakedToken = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CappedTargetRewardEmissionPerUnitStakedToken", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CappedTargetRewardEmissionPerUnitStakedToken = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousRewardEmissionPerUnitStakedToken", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PreviousRewardEmissionPerUnitStakedToken = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardEmissionPerUnitStakedToken", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RewardEmissionPerUnitStakedToken = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EmissionPerMonth", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EmissionPerMonth = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockEmission", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BlockEmission = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EcosystemBalance", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EcosystemBalance = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ValidatorsSplitRecord                          protoreflect.MessageDescriptor
+	fd_ValidatorsSplitRecord_block_height             protoreflect.FieldDescriptor
+	fd_ValidatorsSplitRecord_cosmos_validators_staked protoreflect.FieldDescriptor
+	fd_ValidatorsSplitRecord_reputers_staked          protoreflect.FieldDescriptor
+	fd_ValidatorsSplitRecord_validators_stake_ratio   protoreflect.FieldDescriptor
+	fd_ValidatorsSplitRecord_validators_percent       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mint_v1beta1_types_proto_init()
+	md_ValidatorsSplitRecord = File_mint_v1beta1_types_proto.Messages().ByName("ValidatorsSplitRecord")
+	fd_ValidatorsSplitRecord_block_height = md_ValidatorsSplitRecord.Fields().ByName("block_height")
+	fd_ValidatorsSplitRecord_cosmos_validators_staked = md_ValidatorsSplitRecord.Fields().ByName("cosmos_validators_staked")
+	fd_ValidatorsSplitRecord_reputers_staked = md_ValidatorsSplitRecord.Fields().ByName("reputers_staked")
+	fd_ValidatorsSplitRecord_validators_stake_ratio = md_ValidatorsSplitRecord.Fields().ByName("validators_stake_ratio")
+	fd_ValidatorsSplitRecord_validators_percent = md_ValidatorsSplitRecord.Fields().ByName("validators_percent")
+}
+
+var _ protoreflect.Message = (*fastReflection_ValidatorsSplitRecord)(nil)
+
+type fastReflection_ValidatorsSplitRecord ValidatorsSplitRecord
+
+func (x *ValidatorsSplitRecord) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorsSplitRecord)(x)
+}
+
+func (x *ValidatorsSplitRecord) slowProtoReflect() protoreflect.Message {
+	mi := &file_mint_v1beta1_types_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ValidatorsSplitRecord_messageType fastReflection_ValidatorsSplitRecord_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorsSplitRecord_messageType{}
+
+type fastReflection_ValidatorsSplitRecord_messageType struct{}
+
+func (x fastReflection_ValidatorsSplitRecord_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorsSplitRecord)(nil)
+}
+func (x fastReflection_ValidatorsSplitRecord_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorsSplitRecord)
+}
+func (x fastReflection_ValidatorsSplitRecord_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorsSplitRecord
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ValidatorsSplitRecord) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorsSplitRecord
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ValidatorsSplitRecord) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorsSplitRecord_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ValidatorsSplitRecord) New() protoreflect.Message {
+	return new(fastReflection_ValidatorsSplitRecord)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ValidatorsSplitRecord) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorsSplitRecord)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ValidatorsSplitRecord) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockHeight)
+		if !f(fd_ValidatorsSplitRecord_block_height, value) {
+			return
+		}
+	}
+	if x.CosmosValidatorsStaked != "" {
+		value := protoreflect.ValueOfString(x.CosmosValidatorsStaked)
+		if !f(fd_ValidatorsSplitRecord_cosmos_validators_staked, value) {
+			return
+		}
+	}
+	if x.ReputersStaked != "" {
+		value := protoreflect.ValueOfString(x.ReputersStaked)
+		if !f(fd_ValidatorsSplitRecord_reputers_staked, value) {
+			return
+		}
+	}
+	if x.ValidatorsStakeRatio != "" {
+		value := protoreflect.ValueOfString(x.ValidatorsStakeRatio)
+		if !f(fd_ValidatorsSplitRecord_validators_stake_ratio, value) {
+			return
+		}
+	}
+	if x.ValidatorsPercent != "" {
+		value := protoreflect.ValueOfString(x.ValidatorsPercent)
+		if !f(fd_ValidatorsSplitRecord_validators_percent, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ValidatorsSplitRecord) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mint.v1beta1.ValidatorsSplitRecord.block_height":
+		return x.BlockHeight != int64(0)
+	case "mint.v1beta1.ValidatorsSplitRecord.cosmos_validators_staked":
+		return x.CosmosValidatorsStaked != ""
+	case "mint.v1beta1.ValidatorsSplitRecord.reputers_staked":
+		return x.ReputersStaked != ""
+	case "mint.v1beta1.ValidatorsSplitRecord.validators_stake_ratio":
+		return x.ValidatorsStakeRatio != ""
+	case "mint.v1beta1.ValidatorsSplitRecord.validators_percent":
+		return x.ValidatorsPercent != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.ValidatorsSplitRecord"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.ValidatorsSplitRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorsSplitRecord) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mint.v1beta1.ValidatorsSplitRecord.block_height":
+		x.BlockHeight = int64(0)
+	case "mint.v1beta1.ValidatorsSplitRecord.cosmos_validators_staked":
+		x.CosmosValidatorsStaked = ""
+	case "mint.v1beta1.ValidatorsSplitRecord.reputers_staked":
+		x.ReputersStaked = ""
+	case "mint.v1beta1.ValidatorsSplitRecord.validators_stake_ratio":
+		x.ValidatorsStakeRatio = ""
+	case "mint.v1beta1.ValidatorsSplitRecord.validators_percent":
+		x.ValidatorsPercent = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.ValidatorsSplitRecord"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.ValidatorsSplitRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ValidatorsSplitRecord) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mint.v1beta1.ValidatorsSplitRecord.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "mint.v1beta1.ValidatorsSplitRecord.cosmos_validators_staked":
+		value := x.CosmosValidatorsStaked
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.ValidatorsSplitRecord.reputers_staked":
+		value := x.ReputersStaked
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.ValidatorsSplitRecord.validators_stake_ratio":
+		value := x.ValidatorsStakeRatio
+		return protoreflect.ValueOfString(value)
+	case "mint.v1beta1.ValidatorsSplitRecord.validators_percent":
+		value := x.ValidatorsPercent
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.ValidatorsSplitRecord"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.ValidatorsSplitRecord does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorsSplitRecord) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mint.v1beta1.ValidatorsSplitRecord.block_height":
+		x.BlockHeight = value.Int()
+	case "mint.v1beta1.ValidatorsSplitRecord.cosmos_validators_staked":
+		x.CosmosValidatorsStaked = value.Interface().(string)
+	case "mint.v1beta1.ValidatorsSplitRecord.reputers_staked":
+		x.ReputersStaked = value.Interface().(string)
+	case "mint.v1beta1.ValidatorsSplitRecord.validators_stake_ratio":
+		x.ValidatorsStakeRatio = value.Interface().(string)
+	case "mint.v1beta1.ValidatorsSplitRecord.validators_percent":
+		x.ValidatorsPercent = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.ValidatorsSplitRecord"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.ValidatorsSplitRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorsSplitRecord) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mint.v1beta1.ValidatorsSplitRecord.block_height":
+		panic(fmt.Errorf("field block_height of message mint.v1beta1.ValidatorsSplitRecord is not mutable"))
+	case "mint.v1beta1.ValidatorsSplitRecord.cosmos_validators_staked":
+		panic(fmt.Errorf("field cosmos_validators_staked of message mint.v1beta1.ValidatorsSplitRecord is not mutable"))
+	case "mint.v1beta1.ValidatorsSplitRecord.reputers_staked":
+		panic(fmt.Errorf("field reputers_staked of message mint.v1beta1.ValidatorsSplitRecord is not mutable"))
+	case "mint.v1beta1.ValidatorsSplitRecord.validators_stake_ratio":
+		panic(fmt.Errorf("field validators_stake_ratio of message mint.v1beta1.ValidatorsSplitRecord is not mutable"))
+	case "mint.v1beta1.ValidatorsSplitRecord.validators_percent":
+		panic(fmt.Errorf("field validators_percent of message mint.v1beta1.ValidatorsSplitRecord is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.ValidatorsSplitRecord"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.ValidatorsSplitRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ValidatorsSplitRecord) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mint.v1beta1.ValidatorsSplitRecord.block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "mint.v1beta1.ValidatorsSplitRecord.cosmos_validators_staked":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.ValidatorsSplitRecord.reputers_staked":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.ValidatorsSplitRecord.validators_stake_ratio":
+		return protoreflect.ValueOfString("")
+	case "mint.v1beta1.ValidatorsSplitRecord.validators_percent":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mint.v1beta1.ValidatorsSplitRecord"))
+		}
+		panic(fmt.Errorf("message mint.v1beta1.ValidatorsSplitRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ValidatorsSplitRecord) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mint.v1beta1.ValidatorsSplitRecord", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ValidatorsSplitRecord) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorsSplitRecord) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ValidatorsSplitRecord) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ValidatorsSplitRecord) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ValidatorsSplitRecord)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		}
+		l = len(x.CosmosValidatorsStaked)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ReputersStaked)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ValidatorsStakeRatio)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ValidatorsPercent)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorsSplitRecord)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ValidatorsPercent) > 0 {
+			i -= len(x.ValidatorsPercent)
+			copy(dAtA[i:], x.ValidatorsPercent)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorsPercent)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.ValidatorsStakeRatio) > 0 {
+			i -= len(x.ValidatorsStakeRatio)
+			copy(dAtA[i:], x.ValidatorsStakeRatio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValidatorsStakeRatio)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.ReputersStaked) > 0 {
+			i -= len(x.ReputersStaked)
+			copy(dAtA[i:], x.ReputersStaked)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReputersStaked)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.CosmosValidatorsStaked) > 0 {
+			i -= len(x.CosmosValidatorsStaked)
+			copy(dAtA[i:], x.CosmosValidatorsStaked)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CosmosValidatorsStaked)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorsSplitRecord)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorsSplitRecord: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorsSplitRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CosmosValidatorsStaked", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CosmosValidatorsStaked = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReputersStaked", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReputersStaked = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorsStakeRatio", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorsStakeRatio = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorsPercent", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorsPercent = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: mint/v1beta1/types.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// How the emission of every month is calculated at the monthly update of the emission rate
+type EmissionModelType int32
+
+const (
+	// the EMA-smoothed target emission per unit staked token, capped by the maximum monthly percentage yield
+	EmissionModelType_EMISSION_MODEL_STAKED_TOKEN_YIELD EmissionModelType = 0
+	// an explicit amount of tokens for every month
+	EmissionModelType_EMISSION_MODEL_FIXED_SCHEDULE EmissionModelType = 1
+	// a monthly amount of tokens halved every halving interval
+	EmissionModelType_EMISSION_MODEL_HALVING EmissionModelType = 2
+)
+
+// Enum value maps for EmissionModelType.
+var (
+	EmissionModelType_name = map[int32]string{
+		0: "EMISSION_MODEL_STAKED_TOKEN_YIELD",
+		1: "EMISSION_MODEL_FIXED_SCHEDULE",
+		2: "EMISSION_MODEL_HALVING",
+	}
+	EmissionModelType_value = map[string]int32{
+		"EMISSION_MODEL_STAKED_TOKEN_YIELD": 0,
+		"EMISSION_MODEL_FIXED_SCHEDULE":     1,
+		"EMISSION_MODEL_HALVING":            2,
+	}
+)
+
+func (x EmissionModelType) Enum() *EmissionModelType {
+	p := new(EmissionModelType)
+	*p = x
+	return p
+}
+
+func (x EmissionModelType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EmissionModelType) Descriptor() protoreflect.EnumDescriptor {
+	return file_mint_v1beta1_types_proto_enumTypes[0].Descriptor()
+}
+
+func (EmissionModelType) Type() protoreflect.EnumType {
+	return &file_mint_v1beta1_types_proto_enumTypes[0]
+}
+
+func (x EmissionModelType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EmissionModelType.Descriptor instead.
+func (EmissionModelType) EnumDescriptor() ([]byte, []int) {
+	return file_mint_v1beta1_types_proto_rawDescGZIP(), []int{0}
+}
+
+// How the locked tokens of a vesting tranche unlock between the cliff and the end of the vesting
+type VestingCurveType int32
+
+const (
+	// an equal part of the tranche unlocks at the start of every month
+	VestingCurveType_VESTING_CURVE_MONTHLY_LINEAR VestingCurveType = 0
+	// an equal part of the tranche unlocks every block
+	VestingCurveType_VESTING_CURVE_BLOCK_LINEAR VestingCurveType = 1
+)
+
+// Enum value maps for VestingCurveType.
+var (
+	VestingCurveType_name = map[int32]string{
+		0: "VESTING_CURVE_MONTHLY_LINEAR",
+		1: "VESTING_CURVE_BLOCK_LINEAR",
+	}
+	VestingCurveType_value = map[string]int32{
+		"VESTING_CURVE_MONTHLY_LINEAR": 0,
+		"VESTING_CURVE_BLOCK_LINEAR":   1,
+	}
+)
+
+func (x VestingCurveType) Enum() *VestingCurveType {
+	p := new(VestingCurveType)
+	*p = x
+	return p
+}
+
+func (x VestingCurveType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VestingCurveType) Descriptor() protoreflect.EnumDescriptor {
+	return file_mint_v1beta1_types_proto_enumTypes[1].Descriptor()
+}
+
+func (VestingCurveType) Type() protoreflect.EnumType {
+	return &file_mint_v1beta1_types_proto_enumTypes[1]
+}
+
+func (x VestingCurveType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VestingCurveType.Descriptor instead.
+func (VestingCurveType) EnumDescriptor() ([]byte, []int) {
+	return file_mint_v1beta1_types_proto_rawDescGZIP(), []int{1}
+}
+
+// Params defines the parameters for the x/mint module.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// type of coin to mint
+	MintDenom string `protobuf:"bytes,1,opt,name=mint_denom,json=mintDenom,proto3" json:"mint_denom,omitempty"`
+	// maximum total supply of the coin
+	MaxSupply string `protobuf:"bytes,2,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
+	// ecosystem treasury fraction ideally emitted per unit time
+	FEmission string `protobuf:"bytes,3,opt,name=f_emission,json=fEmission,proto3" json:"f_emission,omitempty"`
+	// one month exponential moving average smoothing factor, alpha_e in the paper
+	OneMonthSmoothingDegree string `protobuf:"bytes,4,opt,name=one_month_smoothing_degree,json=oneMonthSmoothingDegree,proto3" json:"one_month_smoothing_degree,omitempty"`
+	// percentage of the total supply is reserved and locked in the ecosystem treasury
+	EcosystemTreasuryPercentOfTotalSupply string `protobuf:"bytes,5,opt,name=ecosystem_treasury_percent_of_total_supply,json=ecosystemTreasuryPercentOfTotalSupply,proto3" json:"ecosystem_treasury_percent_of_total_supply,omitempty"`
+	// percentage of the total supply that is unlocked and usable in the foundation treasury
+	FoundationTreasuryPercentOfTotalSupply string `protobuf:"bytes,6,opt,name=foundation_treasury_percent_of_total_supply,json=foundationTreasuryPercentOfTotalSupply,proto3" json:"foundation_treasury_percent_of_total_supply,omitempty"`
+	// percentage of the total supply that is unlocked and usable by partipicants at the genesis
+	ParticipantsPercentOfTotalSupply string `protobuf:"bytes,7,opt,name=participants_percent_of_total_supply,json=participantsPercentOfTotalSupply,proto3" json:"participants_percent_of_total_supply,omitempty"`
+	// percentage of the total supply that is locked in the investors bucket at the genesis
+	InvestorsPercentOfTotalSupply string `protobuf:"bytes,8,opt,name=investors_percent_of_total_supply,json=investorsPercentOfTotalSupply,proto3" json:"investors_percent_of_total_supply,omitempty"`
+	// percentage of the total supply that is locked in the team bucket at the genesis
+	TeamPercentOfTotalSupply string `protobuf:"bytes,9,opt,name=team_percent_of_total_supply,json=teamPercentOfTotalSupply,proto3" json:"team_percent_of_total_supply,omitempty"`
+	// The capped max monthly percentage yield (like %APY)
+	MaximumMonthlyPercentageYield string `protobuf:"bytes,10,opt,name=maximum_monthly_percentage_yield,json=maximumMonthlyPercentageYield,proto3" json:"maximum_monthly_percentage_yield,omitempty"`
+	// vesting schedules of the tokens locked at genesis, which are not circulating until they unlock
+	VestingTranches []*VestingTranche `protobuf:"bytes,11,rep,name=vesting_tranches,json=vestingTranches,proto3" json:"vesting_tranches,omitempty"`
+	// fraction of the fees paid into the ecosystem treasury that is burned
+	FeeBurnFraction string `protobuf:"bytes,12,opt,name=fee_burn_fraction,json=feeBurnFraction,proto3" json:"fee_burn_fraction,omitempty"`
+	// fraction of the fees paid into the ecosystem treasury that is sent to the community pool
+	FeeCommunityPoolFraction string `protobuf:"bytes,13,opt,name=fee_community_pool_fraction,json=feeCommunityPoolFraction,proto3" json:"fee_community_pool_fraction,omitempty"`
+	// how the emission of every month is calculated
+	EmissionModel EmissionModelType `protobuf:"varint,14,opt,name=emission_model,json=emissionModel,proto3,enum=mint.v1beta1.EmissionModelType" json:"emission_model,omitempty"`
+	// tokens emitted in every month of the fixed schedule emission model, starting from the first month of the chain.
+	// Nothing is emitted after the last month of the schedule
+	FixedScheduleMonthlyEmissions []string `protobuf:"bytes,15,rep,name=fixed_schedule_monthly_emissions,json=fixedScheduleMonthlyEmissions,proto3" json:"fixed_schedule_monthly_emissions,omitempty"`
+	// tokens emitted in every month of the first halving interval of the halving emission model
+	HalvingInitialMonthlyEmission string `protobuf:"bytes,16,opt,name=halving_initial_monthly_emission,json=halvingInitialMonthlyEmission,proto3" json:"halving_initial_monthly_emission,omitempty"`
+	// number of months after which the monthly emission of the halving emission model is halved
+	HalvingIntervalMonths uint64 `protobuf:"varint,17,opt,name=halving_interval_months,json=halvingIntervalMonths,proto3" json:"halving_interval_months,omitempty"`
+	// whether the split of the block emission between cosmos validators and allora participants is adjusted
+	// every block from the ratio of cosmos validator stake to reputer stake, rather than fixed by the
+	// validators_vs_allora_percent_reward emissions param
+	ValidatorsSplitControllerEnabled bool `protobuf:"varint,18,opt,name=validators_split_controller_enabled,json=validatorsSplitControllerEnabled,proto3" json:"validators_split_controller_enabled,omitempty"`
+	// lowest fraction of the block emission the controller pays to cosmos validators
+	MinValidatorsPercent string `protobuf:"bytes,19,opt,name=min_validators_percent,json=minValidatorsPercent,proto3" json:"min_validators_percent,omitempty"`
+	// highest fraction of the block emission the controller pays to cosmos validators
+	MaxValidatorsPercent string `protobuf:"bytes,20,opt,name=max_validators_percent,json=maxValidatorsPercent,proto3" json:"max_validators_percent,omitempty"`
+	// fraction of the stake of cosmos validators and reputers the controller steers cosmos validators towards
+	TargetValidatorsStakeRatio string `protobuf:"bytes,21,opt,name=target_validators_stake_ratio,json=targetValidatorsStakeRatio,proto3" json:"target_validators_stake_ratio,omitempty"`
+	// fraction of the gap between the target and the actual validators stake ratio added to the validators percent every block
+	ValidatorsPercentAdjustmentRate string `protobuf:"bytes,22,opt,name=validators_percent_adjustment_rate,json=validatorsPercentAdjustmentRate,proto3" json:"validators_percent_adjustment_rate,omitempty"`
+	// number of blocks of the validators split history kept in state
+	ValidatorsSplitHistoryLength uint64 `protobuf:"varint,23,opt,name=validators_split_history_length,json=validatorsSplitHistoryLength,proto3" json:"validators_split_history_length,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mint_v1beta1_types_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_mint_v1beta1_types_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetMintDenom() string {
+	if x != nil {
+		return x.MintDenom
+	}
+	return ""
+}
+
+func (x *Params) GetMaxSupply() string {
+	if x != nil {
+		return x.MaxSupply
+	}
+	return ""
+}
+
+func (x *Params) GetFEmission() string {
+	if x != nil {
+		return x.FEmission
+	}
+	return ""
+}
+
+func (x *Params) GetOneMonthSmoothingDegree() string {
+	if x != nil {
+		return x.OneMonthSmoothingDegree
+	}
+	return ""
+}
+
+func (x *Params) GetEcosystemTreasuryPercentOfTotalSupply() string {
+	if x != nil {
+		return x.EcosystemTreasuryPercentOfTotalSupply
+	}
+	return ""
+}
+
+func (x *Params) GetFoundationTreasuryPercentOfTotalSupply() string {
+	if x != nil {
+		return x.FoundationTreasuryPercentOfTotalSupply
+	}
+	return ""
+}
+
+func (x *Params) GetParticipantsPercentOfTotalSupply() string {
+	if x != nil {
+		return x.ParticipantsPercentOfTotalSupply
+	}
+	return ""
+}
+
+func (x *Params) GetInvestorsPercentOfTotalSupply() string {
+	if x != nil {
+		return x.InvestorsPercentOfTotalSupply
+	}
+	return ""
+}
+
+func (x *Params) GetTeamPercentOfTotalSupply() string {
+	if x != nil {
+		return x.TeamPercentOfTotalSupply
+	}
+	return ""
+}
+
+func (x *Params) GetMaximumMonthlyPercentageYield() string {
+	if x != nil {
+		return x.MaximumMonthlyPercentageYield
+	}
+	return ""
+}
+
+func (x *Params) GetVestingTranches() []*VestingTranche {
+	if x != nil {
+		return x.VestingTranches
+	}
+	return nil
+}
+
+func (x *Params) GetFeeBurnFraction() string {
+	if x != nil {
+		return x.FeeBurnFraction
+	}
+	return ""
+}
+
+func (x *Params) GetFeeCommunityPoolFraction() string {
+	if x != nil {
+		return x.FeeCommunityPoolFraction
+	}
+	return ""
+}
+
+func (x *Params) GetEmissionModel() EmissionModelType {
+	if x != nil {
+		return x.EmissionModel
+	}
+	return EmissionModelType_EMISSION_MODEL_STAKED_TOKEN_YIELD
+}
+
+func (x *Params) GetFixedScheduleMonthlyEmissions() []string {
+	if x != nil {
+		return x.FixedScheduleMonthlyEmissions
+	}
+	return nil
+}
 
-	// type of coin to mint
-	MintDenom string `protobuf:"bytes,1,opt,name=mint_denom,json=mintDenom,proto3" json:"mint_denom,omitempty"`
-	// maximum total supply of the coin
-	MaxSupply string `protobuf:"bytes,2,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
-	// ecosystem treasury fraction ideally emitted per unit time
-	FEmission string `protobuf:"bytes,3,opt,name=f_emission,json=fEmission,proto3" json:"f_emission,omitempty"`
-	// one month exponential moving average smoothing factor, alpha_e in the paper
-	OneMonthSmoothingDegree string `protobuf:"bytes,4,opt,name=one_month_smoothing_degree,json=oneMonthSmoothingDegree,proto3" json:"one_month_smoothing_degree,omitempty"`
-	// percentage of the total supply is reserved and locked in the ecosystem treasury
-	EcosystemTreasuryPercentOfTotalSupply string `protobuf:"bytes,5,opt,name=ecosystem_treasury_percent_of_total_supply,json=ecosystemTreasuryPercentOfTotalSupply,proto3" json:"ecosystem_treasury_percent_of_total_supply,omitempty"`
-	// percentage of the total supply that is unlocked and usable in the foundation treasury
-	FoundationTreasuryPercentOfTotalSupply string `protobuf:"bytes,6,opt,name=foundation_treasury_percent_of_total_supply,json=foundationTreasuryPercentOfTotalSupply,proto3" json:"foundation_treasury_percent_of_total_supply,omitempty"`
-	// percentage of the total supply that is unlocked and usable by partipicants at the genesis
-	ParticipantsPercentOfTotalSupply string `protobuf:"bytes,7,opt,name=participants_percent_of_total_supply,json=participantsPercentOfTotalSupply,proto3" json:"participants_percent_of_total_supply,omitempty"`
-	// percentage of the total supply that is locked in the investors bucket at the genesis
-	InvestorsPercentOfTotalSupply string `protobuf:"bytes,8,opt,name=investors_percent_of_total_supply,json=investorsPercentOfTotalSupply,proto3" json:"investors_percent_of_total_supply,omitempty"`
-	// percentage of the total supply that is locked in the team bucket at the genesis
-	TeamPercentOfTotalSupply string `protobuf:"bytes,9,opt,name=team_percent_of_total_supply,json=teamPercentOfTotalSupply,proto3" json:"team_percent_of_total_supply,omitempty"`
-	// The capped max monthly percentage yield (like %APY)
-	MaximumMonthlyPercentageYield string `protobuf:"bytes,10,opt,name=maximum_monthly_percentage_yield,json=maximumMonthlyPercentageYield,proto3" json:"maximum_monthly_percentage_yield,omitempty"`
+func (x *Params) GetHalvingInitialMonthlyEmission() string {
+	if x != nil {
+		return x.HalvingInitialMonthlyEmission
+	}
+	return ""
 }
 
-func (x *Params) Reset() {
-	*x = Params{}
+func (x *Params) GetHalvingIntervalMonths() uint64 {
+	if x != nil {
+		return x.HalvingIntervalMonths
+	}
+	return 0
+}
+
+func (x *Params) GetValidatorsSplitControllerEnabled() bool {
+	if x != nil {
+		return x.ValidatorsSplitControllerEnabled
+	}
+	return false
+}
+
+func (x *Params) GetMinValidatorsPercent() string {
+	if x != nil {
+		return x.MinValidatorsPercent
+	}
+	return ""
+}
+
+func (x *Params) GetMaxValidatorsPercent() string {
+	if x != nil {
+		return x.MaxValidatorsPercent
+	}
+	return ""
+}
+
+func (x *Params) GetTargetValidatorsStakeRatio() string {
+	if x != nil {
+		return x.TargetValidatorsStakeRatio
+	}
+	return ""
+}
+
+func (x *Params) GetValidatorsPercentAdjustmentRate() string {
+	if x != nil {
+		return x.ValidatorsPercentAdjustmentRate
+	}
+	return ""
+}
+
+func (x *Params) GetValidatorsSplitHistoryLength() uint64 {
+	if x != nil {
+		return x.ValidatorsSplitHistoryLength
+	}
+	return 0
+}
+
+// A share of the total supply locked from a start height, of which nothing unlocks before the cliff
+// and everything is unlocked after the duration, both counted in months from the start height
+type VestingTranche struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// percentage of the total supply locked in the tranche
+	PercentOfTotalSupply string           `protobuf:"bytes,2,opt,name=percent_of_total_supply,json=percentOfTotalSupply,proto3" json:"percent_of_total_supply,omitempty"`
+	StartHeight          uint64           `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	CliffMonths          uint64           `protobuf:"varint,4,opt,name=cliff_months,json=cliffMonths,proto3" json:"cliff_months,omitempty"`
+	DurationMonths       uint64           `protobuf:"varint,5,opt,name=duration_months,json=durationMonths,proto3" json:"duration_months,omitempty"`
+	Curve                VestingCurveType `protobuf:"varint,6,opt,name=curve,proto3,enum=mint.v1beta1.VestingCurveType" json:"curve,omitempty"`
+}
+
+func (x *VestingTranche) Reset() {
+	*x = VestingTranche{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mint_v1beta1_types_proto_msgTypes[0]
+		mi := &file_mint_v1beta1_types_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Params) String() string {
+func (x *VestingTranche) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Params) ProtoMessage() {}
+func (*VestingTranche) ProtoMessage() {}
 
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
-	return file_mint_v1beta1_types_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use VestingTranche.ProtoReflect.Descriptor instead.
+func (*VestingTranche) Descriptor() ([]byte, []int) {
+	return file_mint_v1beta1_types_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *Params) GetMintDenom() string {
+func (x *VestingTranche) GetName() string {
 	if x != nil {
-		return x.MintDenom
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Params) GetMaxSupply() string {
+func (x *VestingTranche) GetPercentOfTotalSupply() string {
 	if x != nil {
-		return x.MaxSupply
+		return x.PercentOfTotalSupply
 	}
 	return ""
 }
 
-func (x *Params) GetFEmission() string {
+func (x *VestingTranche) GetStartHeight() uint64 {
 	if x != nil {
-		return x.FEmission
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *VestingTranche) GetCliffMonths() uint64 {
+	if x != nil {
+		return x.CliffMonths
+	}
+	return 0
+}
+
+func (x *VestingTranche) GetDurationMonths() uint64 {
+	if x != nil {
+		return x.DurationMonths
+	}
+	return 0
+}
+
+func (x *VestingTranche) GetCurve() VestingCurveType {
+	if x != nil {
+		return x.Curve
+	}
+	return VestingCurveType_VESTING_CURVE_MONTHLY_LINEAR
+}
+
+// Intermediate values of the monthly update of the emission rate, recorded when it runs
+type EmissionInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// block at which the emission rate was updated
+	BlockHeight    int64  `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlocksPerMonth uint64 `protobuf:"varint,2,opt,name=blocks_per_month,json=blocksPerMonth,proto3" json:"blocks_per_month,omitempty"`
+	// N_{staked,i}, tokens staked by cosmos validators and reputers
+	NetworkStaked string `protobuf:"bytes,3,opt,name=network_staked,json=networkStaked,proto3" json:"network_staked,omitempty"`
+	// N_{total,i}
+	TotalSupply string `protobuf:"bytes,4,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty"`
+	// tokens still locked in vesting tranches
+	LockedSupply string `protobuf:"bytes,5,opt,name=locked_supply,json=lockedSupply,proto3" json:"locked_supply,omitempty"`
+	// N_{circ,i}
+	CirculatingSupply string `protobuf:"bytes,6,opt,name=circulating_supply,json=circulatingSupply,proto3" json:"circulating_supply,omitempty"`
+	// T_{total,i}, tokens the ecosystem treasury may still mint
+	EcosystemMintSupplyRemaining string `protobuf:"bytes,7,opt,name=ecosystem_mint_supply_remaining,json=ecosystemMintSupplyRemaining,proto3" json:"ecosystem_mint_supply_remaining,omitempty"`
+	// ^e_i, before the cap
+	TargetRewardEmissionPerUnitStakedToken string `protobuf:"bytes,8,opt,name=target_reward_emission_per_unit_staked_token,json=targetRewardEmissionPerUnitStakedToken,proto3" json:"target_reward_emission_per_unit_staked_token,omitempty"`
+	// share of the previous rewards paid to staked reputers
+	ReputersPercent string `protobuf:"bytes,9,opt,name=reputers_percent,json=reputersPercent,proto3" json:"reputers_percent,omitempty"`
+	// share of the emission paid to cosmos validators
+	ValidatorsPercent string `protobuf:"bytes,10,opt,name=validators_percent,json=validatorsPercent,proto3" json:"validators_percent,omitempty"`
+	// ^e_{max,i}
+	MaximumMonthlyEmissionPerUnitStakedToken string `protobuf:"bytes,11,opt,name=maximum_monthly_emission_per_unit_staked_token,json=maximumMonthlyEmissionPerUnitStakedToken,proto3" json:"maximum_monthly_emission_per_unit_staked_token,omitempty"`
+	// ^e_i, capped by ^e_{max,i}
+	CappedTargetRewardEmissionPerUnitStakedToken string `protobuf:"bytes,12,opt,name=capped_target_reward_emission_per_unit_staked_token,json=cappedTargetRewardEmissionPerUnitStakedToken,proto3" json:"capped_target_reward_emission_per_unit_staked_token,omitempty"`
+	// e_{i-1}
+	PreviousRewardEmissionPerUnitStakedToken string `protobuf:"bytes,13,opt,name=previous_reward_emission_per_unit_staked_token,json=previousRewardEmissionPerUnitStakedToken,proto3" json:"previous_reward_emission_per_unit_staked_token,omitempty"`
+	// e_i, the exponential moving average of the capped target
+	RewardEmissionPerUnitStakedToken string `protobuf:"bytes,14,opt,name=reward_emission_per_unit_staked_token,json=rewardEmissionPerUnitStakedToken,proto3" json:"reward_emission_per_unit_staked_token,omitempty"`
+	// E_i
+	EmissionPerMonth string `protobuf:"bytes,15,opt,name=emission_per_month,json=emissionPerMonth,proto3" json:"emission_per_month,omitempty"`
+	// E_i divided by the blocks per month
+	BlockEmission string `protobuf:"bytes,16,opt,name=block_emission,json=blockEmission,proto3" json:"block_emission,omitempty"`
+	// balance of the ecosystem treasury before minting
+	EcosystemBalance string `protobuf:"bytes,17,opt,name=ecosystem_balance,json=ecosystemBalance,proto3" json:"ecosystem_balance,omitempty"`
+}
+
+func (x *EmissionInfo) Reset() {
+	*x = EmissionInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mint_v1beta1_types_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmissionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmissionInfo) ProtoMessage() {}
+
+// Deprecated: Use EmissionInfo.ProtoReflect.Descriptor instead.
+func (*EmissionInfo) Descriptor() ([]byte, []int) {
+	return file_mint_v1beta1_types_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EmissionInfo) GetBlockHeight() int64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *EmissionInfo) GetBlocksPerMonth() uint64 {
+	if x != nil {
+		return x.BlocksPerMonth
+	}
+	return 0
+}
+
+func (x *EmissionInfo) GetNetworkStaked() string {
+	if x != nil {
+		return x.NetworkStaked
 	}
 	return ""
 }
 
-func (x *Params) GetOneMonthSmoothingDegree() string {
+func (x *EmissionInfo) GetTotalSupply() string {
 	if x != nil {
-		return x.OneMonthSmoothingDegree
+		return x.TotalSupply
 	}
 	return ""
 }
 
-func (x *Params) GetEcosystemTreasuryPercentOfTotalSupply() string {
+func (x *EmissionInfo) GetLockedSupply() string {
 	if x != nil {
-		return x.EcosystemTreasuryPercentOfTotalSupply
+		return x.LockedSupply
 	}
 	return ""
 }
 
-func (x *Params) GetFoundationTreasuryPercentOfTotalSupply() string {
+func (x *EmissionInfo) GetCirculatingSupply() string {
 	if x != nil {
-		return x.FoundationTreasuryPercentOfTotalSupply
+		return x.CirculatingSupply
 	}
 	return ""
 }
 
-func (x *Params) GetParticipantsPercentOfTotalSupply() string {
+func (x *EmissionInfo) GetEcosystemMintSupplyRemaining() string {
 	if x != nil {
-		return x.ParticipantsPercentOfTotalSupply
+		return x.EcosystemMintSupplyRemaining
 	}
 	return ""
 }
 
-func (x *Params) GetInvestorsPercentOfTotalSupply() string {
+func (x *EmissionInfo) GetTargetRewardEmissionPerUnitStakedToken() string {
 	if x != nil {
-		return x.InvestorsPercentOfTotalSupply
+		return x.TargetRewardEmissionPerUnitStakedToken
 	}
 	return ""
 }
 
-func (x *Params) GetTeamPercentOfTotalSupply() string {
+func (x *EmissionInfo) GetReputersPercent() string {
 	if x != nil {
-		return x.TeamPercentOfTotalSupply
+		return x.ReputersPercent
 	}
 	return ""
 }
 
-func (x *Params) GetMaximumMonthlyPercentageYield() string {
+func (x *EmissionInfo) GetValidatorsPercent() string {
 	if x != nil {
-		return x.MaximumMonthlyPercentageYield
+		return x.ValidatorsPercent
+	}
+	return ""
+}
+
+func (x *EmissionInfo) GetMaximumMonthlyEmissionPerUnitStakedToken() string {
+	if x != nil {
+		return x.MaximumMonthlyEmissionPerUnitStakedToken
+	}
+	return ""
+}
+
+func (x *EmissionInfo) GetCappedTargetRewardEmissionPerUnitStakedToken() string {
+	if x != nil {
+		return x.CappedTargetRewardEmissionPerUnitStakedToken
+	}
+	return ""
+}
+
+func (x *EmissionInfo) GetPreviousRewardEmissionPerUnitStakedToken() string {
+	if x != nil {
+		return x.PreviousRewardEmissionPerUnitStakedToken
+	}
+	return ""
+}
+
+func (x *EmissionInfo) GetRewardEmissionPerUnitStakedToken() string {
+	if x != nil {
+		return x.RewardEmissionPerUnitStakedToken
+	}
+	return ""
+}
+
+func (x *EmissionInfo) GetEmissionPerMonth() string {
+	if x != nil {
+		return x.EmissionPerMonth
+	}
+	return ""
+}
+
+func (x *EmissionInfo) GetBlockEmission() string {
+	if x != nil {
+		return x.BlockEmission
+	}
+	return ""
+}
+
+func (x *EmissionInfo) GetEcosystemBalance() string {
+	if x != nil {
+		return x.EcosystemBalance
+	}
+	return ""
+}
+
+// the split of a block emission between cosmos validators and allora participants chosen by the validators
+// split controller, with the stake it was chosen from
+type ValidatorsSplitRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockHeight int64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// tokens bonded to cosmos validators
+	CosmosValidatorsStaked string `protobuf:"bytes,2,opt,name=cosmos_validators_staked,json=cosmosValidatorsStaked,proto3" json:"cosmos_validators_staked,omitempty"`
+	// tokens staked by reputers
+	ReputersStaked string `protobuf:"bytes,3,opt,name=reputers_staked,json=reputersStaked,proto3" json:"reputers_staked,omitempty"`
+	// cosmos validators staked / (cosmos validators staked + reputers staked)
+	ValidatorsStakeRatio string `protobuf:"bytes,4,opt,name=validators_stake_ratio,json=validatorsStakeRatio,proto3" json:"validators_stake_ratio,omitempty"`
+	// fraction of the block emission paid to cosmos validators
+	ValidatorsPercent string `protobuf:"bytes,5,opt,name=validators_percent,json=validatorsPercent,proto3" json:"validators_percent,omitempty"`
+}
+
+func (x *ValidatorsSplitRecord) Reset() {
+	*x = ValidatorsSplitRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mint_v1beta1_types_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorsSplitRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorsSplitRecord) ProtoMessage() {}
+
+// Deprecated: Use ValidatorsSplitRecord.ProtoReflect.Descriptor instead.
+func (*ValidatorsSplitRecord) Descriptor() ([]byte, []int) {
+	return file_mint_v1beta1_types_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ValidatorsSplitRecord) GetBlockHeight() int64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *ValidatorsSplitRecord) GetCosmosValidatorsStaked() string {
+	if x != nil {
+		return x.CosmosValidatorsStaked
+	}
+	return ""
+}
+
+func (x *ValidatorsSplitRecord) GetReputersStaked() string {
+	if x != nil {
+		return x.ReputersStaked
+	}
+	return ""
+}
+
+func (x *ValidatorsSplitRecord) GetValidatorsStakeRatio() string {
+	if x != nil {
+		return x.ValidatorsStakeRatio
+	}
+	return ""
+}
+
+func (x *ValidatorsSplitRecord) GetValidatorsPercent() string {
+	if x != nil {
+		return x.ValidatorsPercent
 	}
 	return ""
 }
@@ -1151,7 +5380,7 @@ var file_mint_v1beta1_types_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19,
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f,
-	0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc5, 0x09, 0x0a,
+	0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x81, 0x14, 0x0a,
 	0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x74, 0x5f,
 	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x69, 0x6e,
 	0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x4f, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x75,
@@ -1226,21 +5455,285 @@ var file_mint_v1beta1_types_proto_rawDesc = []byte{
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8,
 	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x1d, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x4d, 0x6f, 0x6e,
 	0x74, 0x68, 0x6c, 0x79, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x59, 0x69,
-	0x65, 0x6c, 0x64, 0x3a, 0x1f, 0x8a, 0xe7, 0xb0, 0x2a, 0x1a, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61,
-	0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x42, 0xbb, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x69, 0x6e,
-	0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
-	0x78, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x2f,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x6d, 0x69, 0x6e, 0x74, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x58, 0x58, 0xaa, 0x02, 0x0c, 0x4d, 0x69, 0x6e, 0x74,
-	0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x0c, 0x4d, 0x69, 0x6e, 0x74, 0x5c,
-	0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x18, 0x4d, 0x69, 0x6e, 0x74, 0x5c, 0x56,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x0d, 0x4d, 0x69, 0x6e, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x6c, 0x64, 0x12, 0x52, 0x0a, 0x10, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x74,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x56, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f,
+	0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x54,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x12, 0x68, 0x0a, 0x11, 0x66, 0x65, 0x65, 0x5f, 0x62,
+	0x75, 0x72, 0x6e, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x10, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x0f, 0x66, 0x65, 0x65, 0x42, 0x75, 0x72, 0x6e, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x7b, 0x0a, 0x1b, 0x66, 0x65, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69,
+	0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x10, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x18, 0x66, 0x65, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69,
+	0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x46,
+	0x0a, 0x0e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0d, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x79, 0x0a, 0x20, 0x66, 0x69, 0x78, 0x65, 0x64, 0x5f,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79,
+	0x5f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x09,
+	0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2,
+	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x1d, 0x66, 0x69, 0x78, 0x65, 0x64, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x79, 0x0a, 0x20, 0x68, 0x61, 0x6c, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x6c, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x5f, 0x65, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x1d, 0x68,
+	0x61, 0x6c, 0x76, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x4d, 0x6f, 0x6e,
+	0x74, 0x68, 0x6c, 0x79, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x17,
+	0x68, 0x61, 0x6c, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x68,
+	0x61, 0x6c, 0x76, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x6f,
+	0x6e, 0x74, 0x68, 0x73, 0x12, 0x4d, 0x0a, 0x23, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x5f, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x53, 0x70, 0x6c,
+	0x69, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x45, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x12, 0x72, 0x0a, 0x16, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x13, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x10, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x14, 0x6d, 0x69, 0x6e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x72, 0x0a, 0x16, 0x6d, 0x61, 0x78, 0x5f, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x10,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x73, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x7f, 0x0a, 0x1d, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x15, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x10, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x1a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x89, 0x01, 0x0a,
+	0x22, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4,
+	0x2d, 0x10, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
+	0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x1f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x45, 0x0a, 0x1f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x5f, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x68, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x17, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x1c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x53, 0x70, 0x6c,
+	0x69, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x3a,
+	0x1f, 0x8a, 0xe7, 0xb0, 0x2a, 0x1a, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2f, 0x78, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x22, 0xbe, 0x02, 0x0a, 0x0e, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x73, 0x0a, 0x17, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x5f, 0x6f, 0x66, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x75, 0x70, 0x70,
+	0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d,
+	0x10, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
+	0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x14, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x4f,
+	0x66, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x21, 0x0a, 0x0c,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x69, 0x66, 0x66, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x6c, 0x69, 0x66, 0x66, 0x4d, 0x6f, 0x6e, 0x74,
+	0x68, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d,
+	0x6f, 0x6e, 0x74, 0x68, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x12, 0x34, 0x0a, 0x05, 0x63,
+	0x75, 0x72, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6d, 0x69, 0x6e,
+	0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x43, 0x75, 0x72, 0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x63, 0x75, 0x72, 0x76,
+	0x65, 0x22, 0xc1, 0x0e, 0x0a, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f,
+	0x70, 0x65, 0x72, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x50, 0x65, 0x72, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x12,
+	0x57, 0x0a, 0x0e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x12, 0x53, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x55, 0x0a,
+	0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e,
+	0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x53, 0x75,
+	0x70, 0x70, 0x6c, 0x79, 0x12, 0x5f, 0x0a, 0x12, 0x63, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2,
+	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x11, 0x63, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x53,
+	0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x77, 0x0a, 0x1f, 0x65, 0x63, 0x6f, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x5f, 0x72,
+	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x1c, 0x65, 0x63, 0x6f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4d, 0x69, 0x6e, 0x74, 0x53,
+	0x75, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x9a,
+	0x01, 0x0a, 0x2c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x5f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x75, 0x6e,
+	0x69, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x10, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x26, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x55, 0x6e, 0x69, 0x74,
+	0x53, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x67, 0x0a, 0x10, 0x72,
+	0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x10, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x73, 0x50, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x12, 0x6b, 0x0a, 0x12, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x10, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x11,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x12, 0x9e, 0x01, 0x0a, 0x2e, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x6d, 0x6f,
+	0x6e, 0x74, 0x68, 0x6c, 0x79, 0x5f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2,
+	0xb4, 0x2d, 0x10, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79,
+	0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x28, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75,
+	0x6d, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x50, 0x65, 0x72, 0x55, 0x6e, 0x69, 0x74, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0xa7, 0x01, 0x0a, 0x33, 0x63, 0x61, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x65, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x73, 0x74,
+	0x61, 0x6b, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x10, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x2c,
+	0x63, 0x61, 0x70, 0x70, 0x65, 0x64, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x55, 0x6e, 0x69,
+	0x74, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x9e, 0x01, 0x0a,
+	0x2e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x5f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x75, 0x6e,
+	0x69, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x10, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x28, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x55, 0x6e,
+	0x69, 0x74, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x8d, 0x01,
+	0x0a, 0x25, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65,
+	0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
+	0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x10, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x4c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x20, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x55, 0x6e,
+	0x69, 0x74, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x5e, 0x0a,
+	0x12, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6d, 0x6f,
+	0x6e, 0x74, 0x68, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x10, 0x65, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x57, 0x0a,
+	0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49,
+	0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x5d, 0x0a, 0x11, 0x65, 0x63, 0x6f, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74,
+	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x10, 0x65, 0x63, 0x6f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xe2, 0x03, 0x0a, 0x15, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x73, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x6a, 0x0a, 0x18, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e,
+	0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x12, 0x59,
+	0x0a, 0x0f, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x75, 0x74,
+	0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x12, 0x72, 0x0a, 0x16, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4,
+	0x2d, 0x10, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
+	0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x14, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x6b, 0x0a,
+	0x12, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4,
+	0x2d, 0x10, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
+	0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x2a, 0x79, 0x0a, 0x11, 0x45, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x25, 0x0a, 0x21, 0x45, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45,
+	0x4c, 0x5f, 0x53, 0x54, 0x41, 0x4b, 0x45, 0x44, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x59,
+	0x49, 0x45, 0x4c, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x45, 0x4d, 0x49, 0x53, 0x53, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x5f, 0x46, 0x49, 0x58, 0x45, 0x44, 0x5f, 0x53,
+	0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x4d, 0x49,
+	0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x5f, 0x48, 0x41, 0x4c, 0x56,
+	0x49, 0x4e, 0x47, 0x10, 0x02, 0x2a, 0x54, 0x0a, 0x10, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x43, 0x75, 0x72, 0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x56, 0x45, 0x53,
+	0x54, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x55, 0x52, 0x56, 0x45, 0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48,
+	0x4c, 0x59, 0x5f, 0x4c, 0x49, 0x4e, 0x45, 0x41, 0x52, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x56,
+	0x45, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x55, 0x52, 0x56, 0x45, 0x5f, 0x42, 0x4c, 0x4f,
+	0x43, 0x4b, 0x5f, 0x4c, 0x49, 0x4e, 0x45, 0x41, 0x52, 0x10, 0x01, 0x42, 0xbb, 0x01, 0x0a, 0x10,
+	0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x42, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4a,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72,
+	0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61,
+	0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x6d,
+	0x69, 0x6e, 0x74, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x58, 0x58,
+	0xaa, 0x02, 0x0c, 0x4d, 0x69, 0x6e, 0x74, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca,
+	0x02, 0x0c, 0x4d, 0x69, 0x6e, 0x74, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02,
+	0x18, 0x4d, 0x69, 0x6e, 0x74, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x4d, 0x69, 0x6e, 0x74,
+	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -1255,16 +5748,25 @@ func file_mint_v1beta1_types_proto_rawDescGZIP() []byte {
 	return file_mint_v1beta1_types_proto_rawDescData
 }
 
-var file_mint_v1beta1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_mint_v1beta1_types_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_mint_v1beta1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_mint_v1beta1_types_proto_goTypes = []interface{}{
-	(*Params)(nil), // 0: mint.v1beta1.Params
+	(EmissionModelType)(0),        // 0: mint.v1beta1.EmissionModelType
+	(VestingCurveType)(0),         // 1: mint.v1beta1.VestingCurveType
+	(*Params)(nil),                // 2: mint.v1beta1.Params
+	(*VestingTranche)(nil),        // 3: mint.v1beta1.VestingTranche
+	(*EmissionInfo)(nil),          // 4: mint.v1beta1.EmissionInfo
+	(*ValidatorsSplitRecord)(nil), // 5: mint.v1beta1.ValidatorsSplitRecord
 }
 var file_mint_v1beta1_types_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	3, // 0: mint.v1beta1.Params.vesting_tranches:type_name -> mint.v1beta1.VestingTranche
+	0, // 1: mint.v1beta1.Params.emission_model:type_name -> mint.v1beta1.EmissionModelType
+	1, // 2: mint.v1beta1.VestingTranche.curve:type_name -> mint.v1beta1.VestingCurveType
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_mint_v1beta1_types_proto_init() }
@@ -1285,19 +5787,56 @@ func file_mint_v1beta1_types_proto_init() {
 				return nil
 			}
 		}
+		file_mint_v1beta1_types_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VestingTranche); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mint_v1beta1_types_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmissionInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mint_v1beta1_types_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorsSplitRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mint_v1beta1_types_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_mint_v1beta1_types_proto_goTypes,
 		DependencyIndexes: file_mint_v1beta1_types_proto_depIdxs,
+		EnumInfos:         file_mint_v1beta1_types_proto_enumTypes,
 		MessageInfos:      file_mint_v1beta1_types_proto_msgTypes,
 	}.Build()
 	File_mint_v1beta1_types_proto = out.File
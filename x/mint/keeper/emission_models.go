@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/x/mint/types"
+)
+
+// the state of the network an emission model computes the emission rate of a month from
+type EmissionModelInput struct {
+	Params                                   types.Params
+	MonthIndex                               uint64
+	BlocksPerMonth                           uint64
+	NetworkStaked                            math.Int
+	CirculatingSupply                        math.Int
+	EcosystemMintSupplyRemaining             math.Int
+	ReputersPercent                          math.LegacyDec
+	ValidatorsPercent                        math.LegacyDec
+	PreviousRewardEmissionPerUnitStakedToken math.LegacyDec
+}
+
+// the emission rate of a month, with the intermediate values an emission model computed it from
+type EmissionModelOutput struct {
+	TargetRewardEmissionPerUnitStakedToken       math.LegacyDec
+	MaximumMonthlyEmissionPerUnitStakedToken     math.LegacyDec
+	CappedTargetRewardEmissionPerUnitStakedToken math.LegacyDec
+	RewardEmissionPerUnitStakedToken             math.LegacyDec
+	EmissionPerMonth                             math.Int
+}
+
+// An EmissionModel decides how many tokens the ecosystem treasury emits in the coming month.
+// It is run by BeginBlocker on every monthly update of the emission rate
+// and by the emission projection for every projected month
+type EmissionModel interface {
+	GetMonthlyEmission(input EmissionModelInput) (EmissionModelOutput, error)
+}
+
+// returns the emission model selected by the params
+func GetEmissionModel(modelType types.EmissionModelType) (EmissionModel, error) {
+	switch modelType {
+	case types.EmissionModelType_EMISSION_MODEL_STAKED_TOKEN_YIELD:
+		return StakedTokenYieldEmissionModel{}, nil
+	case types.EmissionModelType_EMISSION_MODEL_FIXED_SCHEDULE:
+		return FixedScheduleEmissionModel{}, nil
+	case types.EmissionModelType_EMISSION_MODEL_HALVING:
+		return HalvingEmissionModel{}, nil
+	default:
+		return nil, errors.Wrapf(types.ErrUnknownEmissionModel, "emission model %d", modelType)
+	}
+}
+
+// the number of whole months of blocks gone by since the first block of the chain,
+// which is what the fixed schedule and halving models indexed their emissions by
+// before the month index was kept in state
+func GetEmissionMonthIndexAtHeight(blockHeight int64, blocksPerMonth uint64) uint64 {
+	if blockHeight <= 1 || blocksPerMonth == 0 {
+		return 0
+	}
+	return uint64(blockHeight-1) / blocksPerMonth
+}
+
+// The default model: targets a yield per unit staked token out of what the ecosystem
+// treasury can still mint, capped by the maximum monthly percentage yield
+// and smoothed month to month with an exponential moving average
+type StakedTokenYieldEmissionModel struct{}
+
+func (StakedTokenYieldEmissionModel) GetMonthlyEmission(input EmissionModelInput) (EmissionModelOutput, error) {
+	targetRewardEmissionPerUnitStakedToken, err := GetTargetRewardEmissionPerUnitStakedToken(
+		input.Params.FEmission,
+		input.EcosystemMintSupplyRemaining,
+		input.NetworkStaked,
+		input.CirculatingSupply,
+		input.Params.MaxSupply,
+	)
+	if err != nil {
+		return EmissionModelOutput{}, err
+	}
+	maximumMonthlyEmissionPerUnitStakedToken := GetMaximumMonthlyEmissionPerUnitStakedToken(
+		input.Params.MaximumMonthlyPercentageYield,
+		input.ReputersPercent,
+		input.ValidatorsPercent,
+	)
+	cappedTargetRewardEmissionPerUnitStakedToken := GetCappedTargetEmissionPerUnitStakedToken(
+		targetRewardEmissionPerUnitStakedToken,
+		maximumMonthlyEmissionPerUnitStakedToken,
+	)
+	rewardEmissionPerUnitStakedToken := GetExponentialMovingAverage(
+		cappedTargetRewardEmissionPerUnitStakedToken,
+		input.Params.OneMonthSmoothingDegree,
+		input.PreviousRewardEmissionPerUnitStakedToken,
+	)
+	return EmissionModelOutput{
+		TargetRewardEmissionPerUnitStakedToken:       targetRewardEmissionPerUnitStakedToken,
+		MaximumMonthlyEmissionPerUnitStakedToken:     maximumMonthlyEmissionPerUnitStakedToken,
+		CappedTargetRewardEmissionPerUnitStakedToken: cappedTargetRewardEmissionPerUnitStakedToken,
+		RewardEmissionPerUnitStakedToken:             rewardEmissionPerUnitStakedToken,
+		EmissionPerMonth:                             GetTotalEmissionPerMonth(rewardEmissionPerUnitStakedToken, input.NetworkStaked),
+	}, nil
+}
+
+// Emits the amount the params schedule for the current month,
+// and nothing once the schedule has run out
+type FixedScheduleEmissionModel struct{}
+
+func (FixedScheduleEmissionModel) GetMonthlyEmission(input EmissionModelInput) (EmissionModelOutput, error) {
+	schedule := input.Params.FixedScheduleMonthlyEmissions
+	month := input.MonthIndex
+	emissionPerMonth := math.ZeroInt()
+	if month < uint64(len(schedule)) {
+		emissionPerMonth = schedule[month]
+	}
+	return getScheduledEmissionModelOutput(emissionPerMonth, input), nil
+}
+
+// Emits the initial monthly emission of the params,
+// halved every time the halving interval has gone by
+type HalvingEmissionModel struct{}
+
+func (HalvingEmissionModel) GetMonthlyEmission(input EmissionModelInput) (EmissionModelOutput, error) {
+	if input.Params.HalvingIntervalMonths == 0 {
+		return EmissionModelOutput{}, errors.Wrap(types.ErrZeroDenominator, "halving interval is zero")
+	}
+	emissionPerMonth := input.Params.HalvingInitialMonthlyEmission
+	if emissionPerMonth.IsNil() {
+		emissionPerMonth = math.ZeroInt()
+	}
+	halvings := input.MonthIndex / input.Params.HalvingIntervalMonths
+	// past the bit length of the emission every further halving leaves nothing,
+	// so stop there rather than shifting by an arbitrarily large amount
+	if halvings >= uint64(emissionPerMonth.BigInt().BitLen()) {
+		emissionPerMonth = math.ZeroInt()
+	} else {
+		emissionPerMonth = math.NewIntFromBigInt(emissionPerMonth.BigInt().Rsh(emissionPerMonth.BigInt(), uint(halvings)))
+	}
+	return getScheduledEmissionModelOutput(emissionPerMonth, input), nil
+}
+
+// The models that schedule an amount per month emit at most what the ecosystem treasury
+// can still mint, and report the emission per unit staked token it works out to
+// as their target, capped target and reward emission alike, so that the exponential
+// moving average picks up from it if the chain switches back to the default model
+func getScheduledEmissionModelOutput(emissionPerMonth math.Int, input EmissionModelInput) EmissionModelOutput {
+	if !input.EcosystemMintSupplyRemaining.IsNil() && emissionPerMonth.GT(input.EcosystemMintSupplyRemaining) {
+		emissionPerMonth = input.EcosystemMintSupplyRemaining
+	}
+	if emissionPerMonth.IsNegative() {
+		emissionPerMonth = math.ZeroInt()
+	}
+	rewardEmissionPerUnitStakedToken := math.LegacyZeroDec()
+	if input.NetworkStaked.IsPositive() {
+		rewardEmissionPerUnitStakedToken = emissionPerMonth.ToLegacyDec().Quo(input.NetworkStaked.ToLegacyDec())
+	}
+	return EmissionModelOutput{
+		TargetRewardEmissionPerUnitStakedToken:       rewardEmissionPerUnitStakedToken,
+		MaximumMonthlyEmissionPerUnitStakedToken:     math.LegacyZeroDec(),
+		CappedTargetRewardEmissionPerUnitStakedToken: rewardEmissionPerUnitStakedToken,
+		RewardEmissionPerUnitStakedToken:             rewardEmissionPerUnitStakedToken,
+		EmissionPerMonth:                             emissionPerMonth,
+	}
+}
@@ -0,0 +1,203 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/x/mint/keeper"
+	"github.com/allora-network/allora-chain/x/mint/types"
+)
+
+func emissionModelTestInput(params types.Params, monthIndex uint64) keeper.EmissionModelInput {
+	return keeper.EmissionModelInput{
+		Params:                                   params,
+		MonthIndex:                               monthIndex,
+		BlocksPerMonth:                           1000,
+		NetworkStaked:                            math.NewInt(1000),
+		CirculatingSupply:                        params.MaxSupply.QuoRaw(2),
+		EcosystemMintSupplyRemaining:             params.GetEcosystemMintSupplyCap(),
+		ReputersPercent:                          math.LegacyMustNewDecFromStr("0.5"),
+		ValidatorsPercent:                        math.LegacyMustNewDecFromStr("0.25"),
+		PreviousRewardEmissionPerUnitStakedToken: math.LegacyMustNewDecFromStr("0.001"),
+	}
+}
+
+func (s *IntegrationTestSuite) TestGetEmissionModel() {
+	for modelType, expected := range map[types.EmissionModelType]keeper.EmissionModel{
+		types.EmissionModelType_EMISSION_MODEL_STAKED_TOKEN_YIELD: keeper.StakedTokenYieldEmissionModel{},
+		types.EmissionModelType_EMISSION_MODEL_FIXED_SCHEDULE:     keeper.FixedScheduleEmissionModel{},
+		types.EmissionModelType_EMISSION_MODEL_HALVING:            keeper.HalvingEmissionModel{},
+	} {
+		model, err := keeper.GetEmissionModel(modelType)
+		s.Require().NoError(err)
+		s.Require().Equal(expected, model)
+	}
+	_, err := keeper.GetEmissionModel(types.EmissionModelType(42))
+	s.Require().ErrorIs(err, types.ErrUnknownEmissionModel)
+}
+
+func (s *IntegrationTestSuite) TestGetEmissionMonthIndexAtHeight() {
+	s.Require().Equal(uint64(0), keeper.GetEmissionMonthIndexAtHeight(0, 1000))
+	s.Require().Equal(uint64(0), keeper.GetEmissionMonthIndexAtHeight(1, 1000))
+	s.Require().Equal(uint64(0), keeper.GetEmissionMonthIndexAtHeight(1000, 1000))
+	s.Require().Equal(uint64(1), keeper.GetEmissionMonthIndexAtHeight(1001, 1000))
+	s.Require().Equal(uint64(2), keeper.GetEmissionMonthIndexAtHeight(2500, 1000))
+	s.Require().Equal(uint64(0), keeper.GetEmissionMonthIndexAtHeight(2500, 0))
+}
+
+func (s *IntegrationTestSuite) TestStakedTokenYieldEmissionModel() {
+	params := types.DefaultParams()
+	input := emissionModelTestInput(params, 0)
+
+	output, err := keeper.StakedTokenYieldEmissionModel{}.GetMonthlyEmission(input)
+	s.Require().NoError(err)
+
+	target, err := keeper.GetTargetRewardEmissionPerUnitStakedToken(
+		params.FEmission, input.EcosystemMintSupplyRemaining, input.NetworkStaked, input.CirculatingSupply, params.MaxSupply,
+	)
+	s.Require().NoError(err)
+	maximum := keeper.GetMaximumMonthlyEmissionPerUnitStakedToken(
+		params.MaximumMonthlyPercentageYield, input.ReputersPercent, input.ValidatorsPercent,
+	)
+	capped := keeper.GetCappedTargetEmissionPerUnitStakedToken(target, maximum)
+	e := keeper.GetExponentialMovingAverage(capped, params.OneMonthSmoothingDegree, input.PreviousRewardEmissionPerUnitStakedToken)
+	s.Require().Equal(target, output.TargetRewardEmissionPerUnitStakedToken)
+	s.Require().Equal(maximum, output.MaximumMonthlyEmissionPerUnitStakedToken)
+	s.Require().Equal(capped, output.CappedTargetRewardEmissionPerUnitStakedToken)
+	s.Require().Equal(e, output.RewardEmissionPerUnitStakedToken)
+	s.Require().Equal(keeper.GetTotalEmissionPerMonth(e, input.NetworkStaked), output.EmissionPerMonth)
+
+	// nothing staked, no target yield
+	input.NetworkStaked = math.ZeroInt()
+	_, err = keeper.StakedTokenYieldEmissionModel{}.GetMonthlyEmission(input)
+	s.Require().ErrorIs(err, types.ErrZeroDenominator)
+}
+
+func (s *IntegrationTestSuite) TestFixedScheduleEmissionModel() {
+	params := types.DefaultParams()
+	params.EmissionModel = types.EmissionModelType_EMISSION_MODEL_FIXED_SCHEDULE
+	params.FixedScheduleMonthlyEmissions = []math.Int{math.NewInt(3000), math.NewInt(2000), math.NewInt(1000)}
+
+	for _, tc := range []struct {
+		monthIndex uint64
+		expected   math.Int
+	}{
+		{0, math.NewInt(3000)},
+		{1, math.NewInt(2000)},
+		{2, math.NewInt(1000)},
+		// the schedule has run out
+		{3, math.ZeroInt()},
+		{100, math.ZeroInt()},
+	} {
+		output, err := keeper.FixedScheduleEmissionModel{}.GetMonthlyEmission(emissionModelTestInput(params, tc.monthIndex))
+		s.Require().NoError(err)
+		s.Require().Equal(tc.expected, output.EmissionPerMonth, "month %d", tc.monthIndex)
+		// 1000 tokens staked
+		perUnit := tc.expected.ToLegacyDec().QuoInt64(1000)
+		s.Require().Equal(perUnit, output.RewardEmissionPerUnitStakedToken)
+		s.Require().Equal(perUnit, output.TargetRewardEmissionPerUnitStakedToken)
+		s.Require().Equal(perUnit, output.CappedTargetRewardEmissionPerUnitStakedToken)
+	}
+
+	// never more than the ecosystem treasury can still mint
+	input := emissionModelTestInput(params, 0)
+	input.EcosystemMintSupplyRemaining = math.NewInt(500)
+	output, err := keeper.FixedScheduleEmissionModel{}.GetMonthlyEmission(input)
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(500), output.EmissionPerMonth)
+
+	// nothing staked still emits the scheduled amount
+	input = emissionModelTestInput(params, 0)
+	input.NetworkStaked = math.ZeroInt()
+	output, err = keeper.FixedScheduleEmissionModel{}.GetMonthlyEmission(input)
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(3000), output.EmissionPerMonth)
+	s.Require().True(output.RewardEmissionPerUnitStakedToken.IsZero())
+}
+
+func (s *IntegrationTestSuite) TestHalvingEmissionModel() {
+	params := types.DefaultParams()
+	params.EmissionModel = types.EmissionModelType_EMISSION_MODEL_HALVING
+	params.HalvingInitialMonthlyEmission = math.NewInt(8000)
+	params.HalvingIntervalMonths = 2
+
+	for _, tc := range []struct {
+		monthIndex uint64
+		expected   math.Int
+	}{
+		{0, math.NewInt(8000)},
+		{1, math.NewInt(8000)},
+		{2, math.NewInt(4000)},
+		{4, math.NewInt(2000)},
+		{6, math.NewInt(1000)},
+		// halved down to nothing
+		{28, math.ZeroInt()},
+		{1 << 62, math.ZeroInt()},
+	} {
+		output, err := keeper.HalvingEmissionModel{}.GetMonthlyEmission(emissionModelTestInput(params, tc.monthIndex))
+		s.Require().NoError(err)
+		s.Require().Equal(tc.expected, output.EmissionPerMonth, "month %d", tc.monthIndex)
+		s.Require().Equal(tc.expected.ToLegacyDec().QuoInt64(1000), output.RewardEmissionPerUnitStakedToken)
+	}
+
+	params.HalvingIntervalMonths = 0
+	_, err := keeper.HalvingEmissionModel{}.GetMonthlyEmission(emissionModelTestInput(params, 0))
+	s.Require().ErrorIs(err, types.ErrZeroDenominator)
+}
+
+func (s *IntegrationTestSuite) TestGetEmissionProjectionFixedSchedule() {
+	params := types.DefaultParams()
+	params.EmissionModel = types.EmissionModelType_EMISSION_MODEL_FIXED_SCHEDULE
+	params.FixedScheduleMonthlyEmissions = []math.Int{math.NewInt(3000000), math.NewInt(2000000)}
+	bpm := uint64(1000)
+	networkStaked := math.NewInt(1e6)
+
+	projection, err := keeper.GetEmissionProjection(
+		params,
+		bpm,
+		1,
+		0,
+		3,
+		networkStaked,
+		params.MaxSupply.QuoRaw(2),
+		math.ZeroInt(),
+		math.LegacyMustNewDecFromStr("0.001"),
+		math.LegacyMustNewDecFromStr("0.5"),
+		math.LegacyMustNewDecFromStr("0.25"),
+	)
+	s.Require().NoError(err)
+	s.Require().Len(projection, 3)
+	s.Require().Equal(math.NewInt(3000000), projection[0].EmissionPerMonth)
+	s.Require().Equal(math.LegacyNewDec(3), projection[0].RewardEmissionPerUnitStakedToken)
+	s.Require().Equal(math.NewInt(3000000), projection[0].TokensMinted)
+	s.Require().Equal(math.NewInt(2000000), projection[1].EmissionPerMonth)
+	s.Require().Equal(math.NewInt(5000000), projection[1].CumulativeTokensMinted)
+	s.Require().True(projection[2].EmissionPerMonth.IsZero())
+	s.Require().True(projection[2].TokensMinted.IsZero())
+}
+
+func (s *IntegrationTestSuite) TestGetEmissionProjectionHalving() {
+	params := types.DefaultParams()
+	params.EmissionModel = types.EmissionModelType_EMISSION_MODEL_HALVING
+	params.HalvingInitialMonthlyEmission = math.NewInt(4000000)
+	params.HalvingIntervalMonths = 1
+	bpm := uint64(1000)
+
+	projection, err := keeper.GetEmissionProjection(
+		params,
+		bpm,
+		1,
+		0,
+		3,
+		math.NewInt(1e6),
+		params.MaxSupply.QuoRaw(2),
+		math.ZeroInt(),
+		math.LegacyMustNewDecFromStr("0.001"),
+		math.LegacyMustNewDecFromStr("0.5"),
+		math.LegacyMustNewDecFromStr("0.25"),
+	)
+	s.Require().NoError(err)
+	s.Require().Len(projection, 3)
+	s.Require().Equal(math.NewInt(4000000), projection[0].EmissionPerMonth)
+	s.Require().Equal(math.NewInt(2000000), projection[1].EmissionPerMonth)
+	s.Require().Equal(math.NewInt(1000000), projection[2].EmissionPerMonth)
+	s.Require().Equal(math.NewInt(7000000), projection[2].CumulativeTokensMinted)
+}
@@ -11,40 +11,63 @@ import (
 // return the uncirculating supply, i.e. tokens on a vesting schedule
 // these tokens will be custodied by a centralized actor off chain.
 // this function returns the circulating supply based off of what
-// the agreements off chain say were supposed to happen for token lockup
+// the agreements off chain say were supposed to happen for token lockup,
+// as recorded by the vesting tranches of the params
 func GetLockedTokenSupply(
 	blocksPerMonth uint64,
 	blockHeight math.Int,
 	params types.Params,
 ) math.Int {
-	// foundation is unlocked from genesis
-	// participants are unlocked from genesis
-	// investors and team tokens are locked on a 1 year cliff three year vesting schedule
-	blocksInAYear := math.NewIntFromUint64(blocksPerMonth * 12)
-	blocksInThreeYears := blocksInAYear.Mul(math.NewInt(3))
-	maxSupply := params.MaxSupply.ToLegacyDec()
-	percentInvestors := params.InvestorsPercentOfTotalSupply
-	percentTeam := params.TeamPercentOfTotalSupply
-	fullInvestors := percentInvestors.Mul(maxSupply).TruncateInt()
-	fullTeam := percentTeam.Mul(maxSupply).TruncateInt()
-	var investors, team math.Int
-	if blockHeight.LT(blocksInAYear) {
-		// less than a year, completely locked
-		investors = fullInvestors
-		team = fullTeam
-	} else if blockHeight.GTE(blocksInAYear) && blockHeight.LT(blocksInThreeYears) {
-		// between 1 and 3 years, investors and team tokens are vesting and partially unlocked
-		thirtySix := math.LegacyNewDec(36)
-		monthsUnlocked := blockHeight.Quo(math.NewIntFromUint64(blocksPerMonth)).ToLegacyDec()
-		monthsLocked := thirtySix.Sub(monthsUnlocked)
-		investors = monthsLocked.Quo(thirtySix).Mul(fullInvestors.ToLegacyDec()).TruncateInt()
-		team = monthsLocked.Quo(thirtySix).Mul(fullTeam.ToLegacyDec()).TruncateInt()
-	} else {
-		// greater than 3 years, all investor, team tokens are unlocked
-		investors = math.ZeroInt()
-		team = math.ZeroInt()
+	locked := math.ZeroInt()
+	for _, tranche := range params.VestingTranches {
+		locked = locked.Add(GetVestingTrancheLockedAmount(blocksPerMonth, blockHeight, params.MaxSupply, tranche))
 	}
-	return investors.Add(team)
+	return locked
+}
+
+// the full amount of tokens of a vesting tranche, locked or not
+func GetVestingTrancheTotalAmount(maxSupply math.Int, tranche types.VestingTranche) math.Int {
+	return tranche.PercentOfTotalSupply.Mul(maxSupply.ToLegacyDec()).TruncateInt()
+}
+
+// the amount of tokens of a vesting tranche still locked at a block height.
+// the tranche is fully locked before its start height and until its cliff,
+// then unlocks linearly, by month or by block, until it is fully unlocked
+// at the end of its duration
+func GetVestingTrancheLockedAmount(
+	blocksPerMonth uint64,
+	blockHeight math.Int,
+	maxSupply math.Int,
+	tranche types.VestingTranche,
+) math.Int {
+	full := GetVestingTrancheTotalAmount(maxSupply, tranche)
+	startHeight := math.NewIntFromUint64(tranche.StartHeight)
+	if blockHeight.LT(startHeight) {
+		return full
+	}
+	elapsedBlocks := blockHeight.Sub(startHeight)
+	bpm := math.NewIntFromUint64(blocksPerMonth)
+	cliffBlocks := bpm.Mul(math.NewIntFromUint64(tranche.CliffMonths))
+	durationBlocks := bpm.Mul(math.NewIntFromUint64(tranche.DurationMonths))
+	if elapsedBlocks.LT(cliffBlocks) {
+		// before the cliff, completely locked
+		return full
+	}
+	if elapsedBlocks.GTE(durationBlocks) {
+		// after the duration, completely unlocked
+		return math.ZeroInt()
+	}
+	// between the cliff and the end of the duration, partially unlocked
+	var unlocked, duration math.LegacyDec
+	switch tranche.Curve {
+	case types.VestingCurveType_VESTING_CURVE_BLOCK_LINEAR:
+		unlocked = elapsedBlocks.ToLegacyDec()
+		duration = durationBlocks.ToLegacyDec()
+	default:
+		unlocked = elapsedBlocks.Quo(bpm).ToLegacyDec()
+		duration = math.NewIntFromUint64(tranche.DurationMonths).ToLegacyDec()
+	}
+	return duration.Sub(unlocked).Quo(duration).Mul(full.ToLegacyDec()).TruncateInt()
 }
 
 // helper function to get the number of staked tokens on the network
@@ -174,3 +197,106 @@ func GetExponentialMovingAverage(
 		Mul(previousRewardEmissionPerUnitStakedToken)
 	return firstTerm.Add(secondTerm)
 }
+
+// whether BeginBlocker updates the emission rate at blockHeight, i.e. whether the emission rate
+// was never updated or a month of blocks has gone by since its last update.
+// Comparing against the last update rather than aligning on multiples of blocksPerMonth
+// means no update is skipped or doubled when blocksPerMonth changes or genesis is
+// exported and imported at an arbitrary height. A last update ahead of blockHeight, as left by a
+// zero height export, makes the update due straight away
+func IsEmissionUpdateHeight(lastUpdateHeight, blockHeight int64, blocksPerMonth uint64) bool {
+	return lastUpdateHeight <= 0 || lastUpdateHeight > blockHeight || blockHeight >= lastUpdateHeight+int64(blocksPerMonth)
+}
+
+// the first block after blockHeight at which BeginBlocker updates the emission rate,
+// or 0 if the emission rate is never updated
+func GetNextEmissionUpdateHeight(lastUpdateHeight, blockHeight int64, blocksPerMonth uint64) int64 {
+	if blocksPerMonth == 0 || blockHeight < 0 {
+		return 0
+	}
+	next := lastUpdateHeight + int64(blocksPerMonth)
+	if lastUpdateHeight <= 0 || lastUpdateHeight > blockHeight || next <= blockHeight {
+		return blockHeight + 1
+	}
+	return next
+}
+
+// Projects the monthly updates of the emission rate at the given number of block heights,
+// spaced a month apart from firstUpdateHeight and opening the months of the schedule of the
+// emission model from firstMonthIndex on, with the same emission model as BeginBlocker.
+// The staked tokens and the share of the rewards paid to reputers are assumed to stay constant,
+// and no fees to be paid into the ecosystem treasury, so that every emitted token is minted.
+func GetEmissionProjection(
+	params types.Params,
+	blocksPerMonth uint64,
+	firstUpdateHeight int64,
+	firstMonthIndex uint64,
+	months uint64,
+	networkStaked math.Int,
+	totalSupply math.Int,
+	ecosystemTokensMinted math.Int,
+	previousRewardEmissionPerUnitStakedToken math.LegacyDec,
+	reputersPercent math.LegacyDec,
+	validatorsPercent math.LegacyDec,
+) ([]types.ProjectedEmission, error) {
+	if blocksPerMonth == 0 {
+		return nil, errors.Wrap(types.ErrZeroDenominator, "blocks per month is zero")
+	}
+	ecosystemMintCap := params.GetEcosystemMintSupplyCap()
+	emissionModel, err := GetEmissionModel(params.EmissionModel)
+	if err != nil {
+		return nil, err
+	}
+	cumulativeTokensMinted := math.ZeroInt()
+	projection := make([]types.ProjectedEmission, 0, months)
+	for month := uint64(1); month <= months; month++ {
+		blockHeight := firstUpdateHeight + int64((month-1)*blocksPerMonth)
+		ecosystemMintSupplyRemaining := ecosystemMintCap.Sub(ecosystemTokensMinted)
+		lockedSupply := GetLockedTokenSupply(blocksPerMonth, math.NewInt(blockHeight), params)
+		circulatingSupply := totalSupply.Sub(lockedSupply)
+		if circulatingSupply.IsNegative() {
+			circulatingSupply = math.ZeroInt()
+		}
+		monthlyEmission, err := emissionModel.GetMonthlyEmission(EmissionModelInput{
+			Params:                                   params,
+			MonthIndex:                               firstMonthIndex + month - 1,
+			BlocksPerMonth:                           blocksPerMonth,
+			NetworkStaked:                            networkStaked,
+			CirculatingSupply:                        circulatingSupply,
+			EcosystemMintSupplyRemaining:             ecosystemMintSupplyRemaining,
+			ReputersPercent:                          reputersPercent,
+			ValidatorsPercent:                        validatorsPercent,
+			PreviousRewardEmissionPerUnitStakedToken: previousRewardEmissionPerUnitStakedToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		emissionPerMonth := monthlyEmission.EmissionPerMonth
+		// every block of the month emits the truncated block emission, minted up to the cap
+		tokensMinted := emissionPerMonth.Quo(math.NewIntFromUint64(blocksPerMonth)).
+			Mul(math.NewIntFromUint64(blocksPerMonth))
+		if tokensMinted.GT(ecosystemMintSupplyRemaining) {
+			tokensMinted = ecosystemMintSupplyRemaining
+		}
+		cumulativeTokensMinted = cumulativeTokensMinted.Add(tokensMinted)
+		projection = append(projection, types.ProjectedEmission{
+			Month:                                  month,
+			BlockHeight:                            blockHeight,
+			NetworkStaked:                          networkStaked,
+			TotalSupply:                            totalSupply,
+			LockedSupply:                           lockedSupply,
+			CirculatingSupply:                      circulatingSupply,
+			EcosystemMintSupplyRemaining:           ecosystemMintSupplyRemaining,
+			TargetRewardEmissionPerUnitStakedToken: monthlyEmission.TargetRewardEmissionPerUnitStakedToken,
+			CappedTargetRewardEmissionPerUnitStakedToken: monthlyEmission.CappedTargetRewardEmissionPerUnitStakedToken,
+			RewardEmissionPerUnitStakedToken:             monthlyEmission.RewardEmissionPerUnitStakedToken,
+			EmissionPerMonth:                             emissionPerMonth,
+			TokensMinted:                                 tokensMinted,
+			CumulativeTokensMinted:                       cumulativeTokensMinted,
+		})
+		previousRewardEmissionPerUnitStakedToken = monthlyEmission.RewardEmissionPerUnitStakedToken
+		ecosystemTokensMinted = ecosystemTokensMinted.Add(tokensMinted)
+		totalSupply = totalSupply.Add(tokensMinted)
+	}
+	return projection, nil
+}
@@ -105,6 +105,147 @@ func (s *IntegrationTestSuite) TestNumberLockedTokensAfterVest() {
 	s.Require().True(result.Equal(math.ZeroInt()))
 }
 
+func (s *IntegrationTestSuite) TestNumberLockedTokensTrancheStartHeight() {
+	params := types.DefaultParams()
+	bpm := uint64(1000)
+	tranche := types.VestingTranche{
+		Name:                 "late",
+		PercentOfTotalSupply: math.LegacyMustNewDecFromStr("0.1"),
+		StartHeight:          bpm * 24,
+		CliffMonths:          6,
+		DurationMonths:       12,
+		Curve:                types.VestingCurveType_VESTING_CURVE_MONTHLY_LINEAR,
+	}
+	params.VestingTranches = []types.VestingTranche{tranche}
+	full := math.LegacyMustNewDecFromStr("0.1").Mul(params.MaxSupply.ToLegacyDec()).TruncateInt()
+
+	// locked before the start height and until the cliff
+	s.Require().Equal(full, keeper.GetLockedTokenSupply(bpm, math.NewInt(0), params))
+	s.Require().Equal(full, keeper.GetLockedTokenSupply(bpm, math.NewIntFromUint64(bpm*30-1), params))
+	// 9 of 12 months unlocked, the last partial month still locked
+	expected := math.LegacyNewDec(3).Quo(math.LegacyNewDec(12)).Mul(full.ToLegacyDec()).TruncateInt()
+	result := keeper.GetLockedTokenSupply(bpm, math.NewIntFromUint64(bpm*33+bpm/2), params)
+	s.Require().True(result.Equal(expected), "expected %s, got %s", expected, result)
+	// unlocked after the duration
+	s.Require().True(keeper.GetLockedTokenSupply(bpm, math.NewIntFromUint64(bpm*36), params).IsZero())
+}
+
+func (s *IntegrationTestSuite) TestNumberLockedTokensTrancheBlockLinear() {
+	params := types.DefaultParams()
+	bpm := uint64(1000)
+	tranche := types.VestingTranche{
+		Name:                 "linear",
+		PercentOfTotalSupply: math.LegacyMustNewDecFromStr("0.2"),
+		StartHeight:          0,
+		CliffMonths:          0,
+		DurationMonths:       10,
+		Curve:                types.VestingCurveType_VESTING_CURVE_BLOCK_LINEAR,
+	}
+	full := keeper.GetVestingTrancheTotalAmount(params.MaxSupply, tranche)
+
+	locked := keeper.GetVestingTrancheLockedAmount(bpm, math.NewInt(2500), params.MaxSupply, tranche)
+	expected := math.LegacyNewDec(7500).Quo(math.LegacyNewDec(10000)).Mul(full.ToLegacyDec()).TruncateInt()
+	s.Require().True(locked.Equal(expected), "expected %s, got %s", expected, locked)
+	s.Require().True(keeper.GetVestingTrancheLockedAmount(bpm, math.NewInt(10000), params.MaxSupply, tranche).IsZero())
+}
+
+func (s *IntegrationTestSuite) TestGetNextEmissionUpdateHeight() {
+	// never updated, the next block updates
+	s.Require().Equal(int64(1), keeper.GetNextEmissionUpdateHeight(0, 0, 100))
+	s.Require().Equal(int64(51), keeper.GetNextEmissionUpdateHeight(0, 50, 100))
+	s.Require().Equal(int64(101), keeper.GetNextEmissionUpdateHeight(1, 1, 100))
+	s.Require().Equal(int64(101), keeper.GetNextEmissionUpdateHeight(1, 100, 100))
+	s.Require().Equal(int64(201), keeper.GetNextEmissionUpdateHeight(101, 101, 100))
+	// not aligned on multiples of blocks per month
+	s.Require().Equal(int64(137), keeper.GetNextEmissionUpdateHeight(37, 90, 100))
+	// blocks per month shrank below the blocks elapsed since the last update
+	s.Require().Equal(int64(91), keeper.GetNextEmissionUpdateHeight(37, 90, 20))
+	s.Require().Equal(int64(0), keeper.GetNextEmissionUpdateHeight(101, 101, 0))
+	// restarted from a zero height export taken after the last update
+	s.Require().Equal(int64(2), keeper.GetNextEmissionUpdateHeight(5000, 1, 100))
+}
+
+func (s *IntegrationTestSuite) TestIsEmissionUpdateHeight() {
+	s.Require().True(keeper.IsEmissionUpdateHeight(0, 1, 100))
+	s.Require().True(keeper.IsEmissionUpdateHeight(0, 57, 100), "never updated")
+	s.Require().False(keeper.IsEmissionUpdateHeight(57, 156, 100))
+	s.Require().True(keeper.IsEmissionUpdateHeight(57, 157, 100))
+	s.Require().True(keeper.IsEmissionUpdateHeight(57, 300, 100), "missed heights are caught up on")
+	s.Require().True(keeper.IsEmissionUpdateHeight(5000, 1, 100), "restarted from a zero height export")
+	s.Require().False(keeper.IsEmissionUpdateHeight(1, 2, 100))
+}
+
+func (s *IntegrationTestSuite) TestGetEmissionProjection() {
+	params := types.DefaultParams()
+	bpm := uint64(1000)
+	networkStaked := math.NewInt(1e18)
+	totalSupply := params.MaxSupply.QuoRaw(2)
+	previous := math.LegacyMustNewDecFromStr("0.001")
+	reputersPercent := math.LegacyMustNewDecFromStr("0.5")
+	validatorsPercent := math.LegacyMustNewDecFromStr("0.25")
+
+	projection, err := keeper.GetEmissionProjection(
+		params, bpm, 1, 0, 3, networkStaked, totalSupply, math.ZeroInt(), previous, reputersPercent, validatorsPercent,
+	)
+	s.Require().NoError(err)
+	s.Require().Len(projection, 3)
+
+	ecosystemMintCap := params.MaxSupply.ToLegacyDec().Mul(params.EcosystemTreasuryPercentOfTotalSupply).TruncateInt()
+	lockedSupply := keeper.GetLockedTokenSupply(bpm, math.NewInt(1), params)
+	target, err := keeper.GetTargetRewardEmissionPerUnitStakedToken(
+		params.FEmission, ecosystemMintCap, networkStaked, totalSupply.Sub(lockedSupply), params.MaxSupply,
+	)
+	s.Require().NoError(err)
+	capped := keeper.GetCappedTargetEmissionPerUnitStakedToken(
+		target,
+		keeper.GetMaximumMonthlyEmissionPerUnitStakedToken(params.MaximumMonthlyPercentageYield, reputersPercent, validatorsPercent),
+	)
+	e := keeper.GetExponentialMovingAverage(capped, params.OneMonthSmoothingDegree, previous)
+	first := projection[0]
+	s.Require().Equal(uint64(1), first.Month)
+	s.Require().Equal(int64(1), first.BlockHeight)
+	s.Require().Equal(lockedSupply, first.LockedSupply)
+	s.Require().Equal(ecosystemMintCap, first.EcosystemMintSupplyRemaining)
+	s.Require().Equal(e, first.RewardEmissionPerUnitStakedToken)
+	s.Require().Equal(keeper.GetTotalEmissionPerMonth(e, networkStaked), first.EmissionPerMonth)
+	s.Require().Equal(first.EmissionPerMonth.QuoRaw(int64(bpm)).MulRaw(int64(bpm)), first.TokensMinted)
+
+	// every month starts from the supply and emission rate left by the previous one
+	for i := 1; i < len(projection); i++ {
+		s.Require().Equal(projection[i-1].BlockHeight+int64(bpm), projection[i].BlockHeight)
+		s.Require().Equal(projection[i-1].TotalSupply.Add(projection[i-1].TokensMinted), projection[i].TotalSupply)
+		s.Require().Equal(projection[i-1].EcosystemMintSupplyRemaining.Sub(projection[i-1].TokensMinted), projection[i].EcosystemMintSupplyRemaining)
+		s.Require().Equal(projection[i-1].CumulativeTokensMinted.Add(projection[i].TokensMinted), projection[i].CumulativeTokensMinted)
+	}
+}
+
+func (s *IntegrationTestSuite) TestGetEmissionProjectionStopsMintingAtEcosystemCap() {
+	params := types.DefaultParams()
+	bpm := uint64(1000)
+	ecosystemMintCap := params.MaxSupply.ToLegacyDec().Mul(params.EcosystemTreasuryPercentOfTotalSupply).TruncateInt()
+	// a little is left to mint, and the emission rate is high enough to mint it all in the first month
+	alreadyMinted := ecosystemMintCap.SubRaw(5000)
+
+	projection, err := keeper.GetEmissionProjection(
+		params,
+		bpm,
+		1,
+		0,
+		2,
+		math.NewInt(1e18),
+		params.MaxSupply.QuoRaw(2),
+		alreadyMinted,
+		math.LegacyMustNewDecFromStr("0.5"),
+		math.LegacyMustNewDecFromStr("0.5"),
+		math.LegacyMustNewDecFromStr("0.25"),
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(5000), projection[0].TokensMinted)
+	s.Require().True(projection[1].EcosystemMintSupplyRemaining.IsZero())
+	s.Require().True(projection[1].TokensMinted.IsZero())
+	s.Require().Equal(math.NewInt(5000), projection[1].CumulativeTokensMinted)
+}
+
 func (s *IntegrationTestSuite) TestTargetRewardEmissionPerUnitStakedTokenSimple() {
 	// ^e_i = ((f_e*T_{total,i}) / N_{staked,i}) * (N_{circ,i} / N_{total,i})
 	// using some random sample values
@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
+	"cosmossdk.io/collections"
 	"github.com/allora-network/allora-chain/x/mint/types"
 )
 
@@ -29,6 +31,38 @@ func (keeper Keeper) InitGenesis(ctx context.Context, ak types.AccountKeeper, da
 		panic(err)
 	}
 
+	if err := keeper.TotalFeesBurned.Set(ctx, data.TotalFeesBurned); err != nil {
+		panic(err)
+	}
+
+	if err := keeper.TotalFeesToCommunityPool.Set(ctx, data.TotalFeesToCommunityPool); err != nil {
+		panic(err)
+	}
+
+	if err := keeper.PreviousEcosystemBalance.Set(ctx, data.PreviousEcosystemBalance); err != nil {
+		panic(err)
+	}
+
+	if err := keeper.LastEmissionUpdateHeight.Set(ctx, data.LastEmissionUpdateHeight); err != nil {
+		panic(err)
+	}
+
+	if err := keeper.EmissionMonthIndex.Set(ctx, data.EmissionMonthIndex); err != nil {
+		panic(err)
+	}
+
+	for _, record := range data.ValidatorsSplitHistory {
+		if err := keeper.ValidatorsSplitHistory.Set(ctx, record.BlockHeight, record); err != nil {
+			panic(err)
+		}
+	}
+
+	if data.LatestEmissionInfo != nil {
+		if err := keeper.LatestEmissionInfo.Set(ctx, *data.LatestEmissionInfo); err != nil {
+			panic(err)
+		}
+	}
+
 	ak.GetModuleAccount(ctx, types.ModuleName)
 }
 
@@ -54,10 +88,55 @@ func (keeper Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	totalFeesBurned, err := keeper.TotalFeesBurned.Get(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	totalFeesToCommunityPool, err := keeper.TotalFeesToCommunityPool.Get(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	previousEcosystemBalance, err := keeper.PreviousEcosystemBalance.Get(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	lastEmissionUpdateHeight, err := keeper.GetLastEmissionUpdateHeight(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	validatorsSplitHistory, err := keeper.GetValidatorsSplitHistory(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	emissionMonthIndex, err := keeper.GetEmissionMonthIndex(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	var latestEmissionInfo *types.EmissionInfo
+	emissionInfo, err := keeper.LatestEmissionInfo.Get(ctx)
+	if err == nil {
+		latestEmissionInfo = &emissionInfo
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		panic(err)
+	}
+
 	return types.NewGenesisState(
 		params,
 		previousRewardEmissionPerUnitStakedToken,
 		previousBlockEmission,
 		ecosystemTokensMinted,
+		latestEmissionInfo,
+		totalFeesBurned,
+		totalFeesToCommunityPool,
+		previousEcosystemBalance,
+		lastEmissionUpdateHeight,
+		validatorsSplitHistory,
+		emissionMonthIndex,
 	)
 }
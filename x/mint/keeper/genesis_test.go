@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"cosmossdk.io/collections"
@@ -52,12 +53,13 @@ func (s *GenesisTestSuite) SetupTest() {
 	stakingKeeper := minttestutil.NewMockStakingKeeper(ctrl)
 	accountKeeper := minttestutil.NewMockAccountKeeper(ctrl)
 	bankKeeper := minttestutil.NewMockBankKeeper(ctrl)
+	distrKeeper := minttestutil.NewMockDistributionKeeper(ctrl)
 	emissionsKeeper := minttestutil.NewMockEmissionsKeeper(ctrl)
 	s.accountKeeper = accountKeeper
 	accountKeeper.EXPECT().GetModuleAddress(minterAcc.Name).Return(minterAcc.GetAddress())
 	accountKeeper.EXPECT().GetModuleAccount(s.sdkCtx, minterAcc.Name).Return(minterAcc)
 
-	s.keeper = keeper.NewKeeper(s.cdc, runtime.NewKVStoreService(key), stakingKeeper, accountKeeper, bankKeeper, emissionsKeeper, "")
+	s.keeper = keeper.NewKeeper(s.cdc, runtime.NewKVStoreService(key), stakingKeeper, accountKeeper, bankKeeper, distrKeeper, emissionsKeeper, "")
 }
 
 func (s *GenesisTestSuite) TestImportExportGenesis() {
@@ -78,10 +80,46 @@ func (s *GenesisTestSuite) TestImportExportGenesis() {
 		defaultParams.InvestorsPercentOfTotalSupply,
 		defaultParams.TeamPercentOfTotalSupply,
 		defaultParams.MaximumMonthlyPercentageYield,
+		defaultParams.VestingTranches,
+		defaultParams.FeeBurnFraction,
+		defaultParams.FeeCommunityPoolFraction,
+		types.EmissionModelType_EMISSION_MODEL_HALVING,
+		[]math.Int{math.NewInt(300), math.NewInt(200)},
+		math.NewInt(1000),
+		48,
+		true,
+		math.LegacyMustNewDecFromStr("0.2"),
+		math.LegacyMustNewDecFromStr("0.4"),
+		defaultParams.TargetValidatorsStakeRatio,
+		defaultParams.ValidatorsPercentAdjustmentRate,
+		10,
 	)
 	genesisState.PreviousRewardEmissionPerUnitStakedToken = types.DefaultPreviousRewardEmissionPerUnitStakedToken()
 	genesisState.PreviousBlockEmission = types.DefaultPreviousBlockEmission()
 	genesisState.EcosystemTokensMinted = types.DefaultEcosystemTokensMinted()
+	genesisState.LastEmissionUpdateHeight = 1
+	genesisState.ValidatorsSplitHistory = []types.ValidatorsSplitRecord{
+		{
+			BlockHeight:            1,
+			CosmosValidatorsStaked: math.NewInt(100),
+			ReputersStaked:         math.NewInt(300),
+			ValidatorsStakeRatio:   math.LegacyMustNewDecFromStr("0.25"),
+			ValidatorsPercent:      math.LegacyMustNewDecFromStr("0.3"),
+		},
+		{
+			BlockHeight:            2,
+			CosmosValidatorsStaked: math.NewInt(100),
+			ReputersStaked:         math.NewInt(300),
+			ValidatorsStakeRatio:   math.LegacyMustNewDecFromStr("0.25"),
+			ValidatorsPercent:      math.LegacyMustNewDecFromStr("0.31"),
+		},
+	}
+	genesisState.LatestEmissionInfo = &types.EmissionInfo{
+		BlockHeight:      1,
+		BlocksPerMonth:   100,
+		EmissionPerMonth: math.NewInt(1000),
+		BlockEmission:    math.NewInt(10),
+	}
 
 	s.keeper.InitGenesis(s.sdkCtx, s.accountKeeper, genesisState)
 
@@ -108,4 +146,67 @@ func (s *GenesisTestSuite) TestImportExportGenesis() {
 	s.Require().Equal(genesisState.Params, genesisState2.Params)
 	s.Require().True(genesisState.PreviousRewardEmissionPerUnitStakedToken.Equal(genesisState2.PreviousRewardEmissionPerUnitStakedToken))
 	s.Require().True(genesisState.EcosystemTokensMinted.Equal(genesisState2.EcosystemTokensMinted))
+	s.Require().Equal(genesisState.LastEmissionUpdateHeight, genesisState2.LastEmissionUpdateHeight)
+	s.Require().Equal(genesisState.ValidatorsSplitHistory, genesisState2.ValidatorsSplitHistory)
+	s.Require().NotNil(genesisState2.LatestEmissionInfo)
+	s.Require().Equal(genesisState.LatestEmissionInfo.BlockHeight, genesisState2.LatestEmissionInfo.BlockHeight)
+	s.Require().Equal(genesisState.LatestEmissionInfo.BlocksPerMonth, genesisState2.LatestEmissionInfo.BlocksPerMonth)
+	s.Require().True(genesisState.LatestEmissionInfo.EmissionPerMonth.Equal(genesisState2.LatestEmissionInfo.EmissionPerMonth))
+	s.Require().True(genesisState.LatestEmissionInfo.BlockEmission.Equal(genesisState2.LatestEmissionInfo.BlockEmission))
+}
+
+func TestValidateGenesis(t *testing.T) {
+	require := require.New(t)
+	require.NoError(types.ValidateGenesis(*types.DefaultGenesisState()))
+
+	ecosystemMintCap := types.DefaultParams().GetEcosystemMintSupplyCap()
+	genesisState := types.DefaultGenesisState()
+	genesisState.EcosystemTokensMinted = ecosystemMintCap
+	require.NoError(types.ValidateGenesis(*genesisState))
+
+	// more minted into the ecosystem treasury than its share of the max supply
+	genesisState.EcosystemTokensMinted = ecosystemMintCap.AddRaw(1)
+	require.ErrorIs(types.ValidateGenesis(*genesisState), types.ErrInvalidEcosystemTokensMinted)
+
+	genesisState = types.DefaultGenesisState()
+	genesisState.EcosystemTokensMinted = math.Int{}
+	require.ErrorIs(types.ValidateGenesis(*genesisState), types.ErrInvalidEcosystemTokensMinted)
+
+	genesisState = types.DefaultGenesisState()
+	genesisState.PreviousBlockEmission = math.NewInt(-1)
+	require.ErrorIs(types.ValidateGenesis(*genesisState), types.ErrInvalidPreviousBlockEmission)
+
+	genesisState = types.DefaultGenesisState()
+	genesisState.TotalFeesBurned = math.Int{}
+	require.ErrorIs(types.ValidateGenesis(*genesisState), types.ErrInvalidFeeAccounting)
+
+	genesisState = types.DefaultGenesisState()
+	genesisState.LastEmissionUpdateHeight = -1
+	require.ErrorIs(types.ValidateGenesis(*genesisState), types.ErrInvalidLastEmissionUpdateHeight)
+
+	record := types.ValidatorsSplitRecord{
+		BlockHeight:            5,
+		CosmosValidatorsStaked: math.NewInt(100),
+		ReputersStaked:         math.NewInt(100),
+		ValidatorsStakeRatio:   math.LegacyMustNewDecFromStr("0.5"),
+		ValidatorsPercent:      math.LegacyMustNewDecFromStr("0.25"),
+	}
+	genesisState = types.DefaultGenesisState()
+	genesisState.ValidatorsSplitHistory = []types.ValidatorsSplitRecord{record}
+	require.NoError(types.ValidateGenesis(*genesisState))
+	// recorded twice at the same height
+	genesisState.ValidatorsSplitHistory = []types.ValidatorsSplitRecord{record, record}
+	require.ErrorIs(types.ValidateGenesis(*genesisState), types.ErrInvalidValidatorsSplitHistory)
+	invalidRecord := record
+	invalidRecord.ReputersStaked = math.NewInt(-1)
+	genesisState.ValidatorsSplitHistory = []types.ValidatorsSplitRecord{invalidRecord}
+	require.ErrorIs(types.ValidateGenesis(*genesisState), types.ErrInvalidValidatorsSplitHistory)
+	invalidRecord = record
+	invalidRecord.ValidatorsPercent = math.LegacyMustNewDecFromStr("1.5")
+	genesisState.ValidatorsSplitHistory = []types.ValidatorsSplitRecord{invalidRecord}
+	require.ErrorIs(types.ValidateGenesis(*genesisState), types.ErrInvalidValidatorsSplitHistory)
+
+	genesisState = types.DefaultGenesisState()
+	genesisState.Params.TeamPercentOfTotalSupply = math.LegacyMustNewDecFromStr("0.2")
+	require.Error(types.ValidateGenesis(*genesisState))
 }
@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/x/mint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers the mint module invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "ecosystem-tokens-minted-within-cap", EcosystemTokensMintedWithinCapInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "total-supply-within-max-supply", TotalSupplyWithinMaxSupplyInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "supply-percentages-sum-to-one", SupplyPercentagesSumToOneInvariant(k))
+}
+
+// AllInvariants is a convience function to run all invariants in the mint module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		if res, stop := EcosystemTokensMintedWithinCapInvariant(k)(ctx); stop {
+			return res, stop
+		}
+		if res, stop := TotalSupplyWithinMaxSupplyInvariant(k)(ctx); stop {
+			return res, stop
+		}
+		if res, stop := SupplyPercentagesSumToOneInvariant(k)(ctx); stop {
+			return res, stop
+		}
+		return "", false
+	}
+}
+
+// EcosystemTokensMintedWithinCapInvariant checks that the tokens minted
+// into the ecosystem treasury never exceed its share of the max supply.
+func EcosystemTokensMintedWithinCapInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			panic(fmt.Sprintf("failed to get params: %v", err))
+		}
+		ecosystemTokensMinted, err := k.EcosystemTokensMinted.Get(ctx)
+		if err != nil {
+			panic(fmt.Sprintf("failed to get ecosystem tokens minted: %v", err))
+		}
+		ecosystemMintCap := params.GetEcosystemMintSupplyCap()
+		broken := ecosystemTokensMinted.GT(ecosystemMintCap)
+		return sdk.FormatInvariant(
+			types.ModuleName,
+			"ecosystem tokens minted within ecosystem mint cap",
+			fmt.Sprintf("EcosystemTokensMinted: %s | MaxSupply * EcosystemTreasuryPercentOfTotalSupply: %s",
+				ecosystemTokensMinted.String(),
+				ecosystemMintCap.String(),
+			),
+		), broken
+	}
+}
+
+// TotalSupplyWithinMaxSupplyInvariant checks that the total supply
+// of the mint denom never exceeds the max supply.
+func TotalSupplyWithinMaxSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			panic(fmt.Sprintf("failed to get params: %v", err))
+		}
+		totalSupply := k.bankKeeper.GetSupply(ctx, params.MintDenom).Amount
+		broken := totalSupply.GT(params.MaxSupply)
+		return sdk.FormatInvariant(
+			types.ModuleName,
+			"total supply within max supply",
+			fmt.Sprintf("TotalSupply: %s | MaxSupply: %s",
+				totalSupply.String(),
+				params.MaxSupply.String(),
+			),
+		), broken
+	}
+}
+
+// SupplyPercentagesSumToOneInvariant checks that the ecosystem treasury, foundation treasury,
+// participants, investors and team shares of the total supply add up to exactly one.
+func SupplyPercentagesSumToOneInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			panic(fmt.Sprintf("failed to get params: %v", err))
+		}
+		sum := params.EcosystemTreasuryPercentOfTotalSupply.
+			Add(params.FoundationTreasuryPercentOfTotalSupply).
+			Add(params.ParticipantsPercentOfTotalSupply).
+			Add(params.InvestorsPercentOfTotalSupply).
+			Add(params.TeamPercentOfTotalSupply)
+		broken := !sum.Equal(math.LegacyOneDec())
+		return sdk.FormatInvariant(
+			types.ModuleName,
+			"supply percentages sum to one",
+			fmt.Sprintf("Ecosystem: %s | Foundation: %s | Participants: %s | Investors: %s | Team: %s | Sum: %s",
+				params.EcosystemTreasuryPercentOfTotalSupply.String(),
+				params.FoundationTreasuryPercentOfTotalSupply.String(),
+				params.ParticipantsPercentOfTotalSupply.String(),
+				params.InvestorsPercentOfTotalSupply.String(),
+				params.TeamPercentOfTotalSupply.String(),
+				sum.String(),
+			),
+		), broken
+	}
+}
@@ -0,0 +1,42 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/x/mint/keeper"
+	"github.com/allora-network/allora-chain/x/mint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *IntegrationTestSuite) TestAllInvariantsHold() {
+	params := types.DefaultParams()
+	s.Require().NoError(s.mintKeeper.EcosystemTokensMinted.Set(s.ctx, params.GetEcosystemMintSupplyCap()))
+	s.bankKeeper.EXPECT().GetSupply(s.ctx, params.MintDenom).Return(sdk.NewCoin(params.MintDenom, params.MaxSupply))
+
+	_, broken := keeper.AllInvariants(s.mintKeeper)(s.ctx)
+	s.Require().False(broken)
+}
+
+func (s *IntegrationTestSuite) TestEcosystemTokensMintedWithinCapInvariantBroken() {
+	params := types.DefaultParams()
+	s.Require().NoError(s.mintKeeper.EcosystemTokensMinted.Set(s.ctx, params.GetEcosystemMintSupplyCap().AddRaw(1)))
+
+	msg, broken := keeper.EcosystemTokensMintedWithinCapInvariant(s.mintKeeper)(s.ctx)
+	s.Require().True(broken, msg)
+}
+
+func (s *IntegrationTestSuite) TestTotalSupplyWithinMaxSupplyInvariantBroken() {
+	params := types.DefaultParams()
+	s.bankKeeper.EXPECT().GetSupply(s.ctx, params.MintDenom).Return(sdk.NewCoin(params.MintDenom, params.MaxSupply.AddRaw(1)))
+
+	msg, broken := keeper.TotalSupplyWithinMaxSupplyInvariant(s.mintKeeper)(s.ctx)
+	s.Require().True(broken, msg)
+}
+
+func (s *IntegrationTestSuite) TestSupplyPercentagesSumToOneInvariantBroken() {
+	params := types.DefaultParams()
+	params.TeamPercentOfTotalSupply = params.TeamPercentOfTotalSupply.Add(math.LegacyMustNewDecFromStr("0.01"))
+	s.Require().NoError(s.mintKeeper.Params.Set(s.ctx, params))
+
+	msg, broken := keeper.SupplyPercentagesSumToOneInvariant(s.mintKeeper)(s.ctx)
+	s.Require().True(broken, msg)
+}
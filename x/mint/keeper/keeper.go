@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"cosmossdk.io/collections"
@@ -24,6 +25,7 @@ type Keeper struct {
 	accountKeeper    types.AccountKeeper
 	stakingKeeper    types.StakingKeeper
 	bankKeeper       types.BankKeeper
+	distrKeeper      types.DistributionKeeper
 	emissionsKeeper  types.EmissionsKeeper
 	feeCollectorName string
 
@@ -32,6 +34,13 @@ type Keeper struct {
 	PreviousRewardEmissionPerUnitStakedToken collections.Item[math.LegacyDec]
 	PreviousBlockEmission                    collections.Item[math.Int]
 	EcosystemTokensMinted                    collections.Item[math.Int]
+	LatestEmissionInfo                       collections.Item[types.EmissionInfo]
+	TotalFeesBurned                          collections.Item[math.Int]
+	TotalFeesToCommunityPool                 collections.Item[math.Int]
+	PreviousEcosystemBalance                 collections.Item[math.Int]
+	LastEmissionUpdateHeight                 collections.Item[int64]
+	ValidatorsSplitHistory                   collections.Map[int64, types.ValidatorsSplitRecord]
+	EmissionMonthIndex                       collections.Item[uint64]
 }
 
 // NewKeeper creates a new mint Keeper instance
@@ -41,6 +50,7 @@ func NewKeeper(
 	sk types.StakingKeeper,
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
+	dk types.DistributionKeeper,
 	ek types.EmissionsKeeper,
 	feeCollectorName string,
 ) Keeper {
@@ -56,12 +66,20 @@ func NewKeeper(
 		stakingKeeper:                            sk,
 		accountKeeper:                            ak,
 		bankKeeper:                               bk,
+		distrKeeper:                              dk,
 		emissionsKeeper:                          ek,
 		feeCollectorName:                         feeCollectorName,
 		Params:                                   collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		PreviousRewardEmissionPerUnitStakedToken: collections.NewItem(sb, types.PreviousRewardEmissionPerUnitStakedTokenKey, "previousrewardsemissionsperunitstakedtoken", alloraMath.LegacyDecValue),
 		PreviousBlockEmission:                    collections.NewItem(sb, types.PreviousBlockEmissionKey, "previousblockemission", sdk.IntValue),
 		EcosystemTokensMinted:                    collections.NewItem(sb, types.EcosystemTokensMintedKey, "ecosystemtokensminted", sdk.IntValue),
+		LatestEmissionInfo:                       collections.NewItem(sb, types.LatestEmissionInfoKey, "latestemissioninfo", codec.CollValue[types.EmissionInfo](cdc)),
+		TotalFeesBurned:                          collections.NewItem(sb, types.TotalFeesBurnedKey, "totalfeesburned", sdk.IntValue),
+		TotalFeesToCommunityPool:                 collections.NewItem(sb, types.TotalFeesToCommunityPoolKey, "totalfeestocommunitypool", sdk.IntValue),
+		PreviousEcosystemBalance:                 collections.NewItem(sb, types.PreviousEcosystemBalanceKey, "previousecosystembalance", sdk.IntValue),
+		LastEmissionUpdateHeight:                 collections.NewItem(sb, types.LastEmissionUpdateHeightKey, "lastemissionupdateheight", collections.Int64Value),
+		ValidatorsSplitHistory:                   collections.NewMap(sb, types.ValidatorsSplitHistoryKey, "validatorssplithistory", collections.Int64Key, codec.CollValue[types.ValidatorsSplitRecord](cdc)),
+		EmissionMonthIndex:                       collections.NewItem(sb, types.EmissionMonthIndexKey, "emissionmonthindex", collections.Uint64Value),
 	}
 
 	schema, err := sb.Build()
@@ -89,6 +107,24 @@ func (k Keeper) AddEcosystemTokensMinted(ctx context.Context, minted math.Int) e
 	return k.EcosystemTokensMinted.Set(ctx, newTotal)
 }
 
+// Increases the ledger of the fees paid into the ecosystem treasury that were burned
+func (k Keeper) AddTotalFeesBurned(ctx context.Context, burned math.Int) error {
+	curr, err := k.TotalFeesBurned.Get(ctx)
+	if err != nil {
+		return err
+	}
+	return k.TotalFeesBurned.Set(ctx, curr.Add(burned))
+}
+
+// Increases the ledger of the fees paid into the ecosystem treasury that were sent to the community pool
+func (k Keeper) AddTotalFeesToCommunityPool(ctx context.Context, shared math.Int) error {
+	curr, err := k.TotalFeesToCommunityPool.Get(ctx)
+	if err != nil {
+		return err
+	}
+	return k.TotalFeesToCommunityPool.Set(ctx, curr.Add(shared))
+}
+
 /// STAKING KEEPER RELATED FUNCTIONS
 
 // StakingTokenSupply implements an alias call to the underlying staking keeper's
@@ -125,6 +161,28 @@ func (k Keeper) MoveCoinsFromMintToEcosystem(ctx context.Context, mintedCoins sd
 	)
 }
 
+// BurnCoinsFromEcosystem burns tokens held by the ecosystem account,
+// moving them to the mint module which has permissions to burn them.
+func (k Keeper) BurnCoinsFromEcosystem(ctx context.Context, coins sdk.Coins) error {
+	if coins.Empty() {
+		return nil
+	}
+	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.EcosystemModuleName, types.ModuleName, coins)
+	if err != nil {
+		return err
+	}
+	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins)
+}
+
+// FundCommunityPoolFromEcosystem sends tokens held by the ecosystem account
+// to the community pool of the distribution module.
+func (k Keeper) FundCommunityPoolFromEcosystem(ctx context.Context, coins sdk.Coins) error {
+	if coins.Empty() {
+		return nil
+	}
+	return k.distrKeeper.FundCommunityPool(ctx, coins, k.accountKeeper.GetModuleAddress(types.EcosystemModuleName))
+}
+
 // PayValidatorsFromEcosystem sends funds from the ecosystem
 // treasury account to the cosmos network validators rewards account (fee collector)
 // PayValidatorsFromEcosystem to be used in BeginBlocker.
@@ -169,6 +227,31 @@ func (k Keeper) GetEcosystemBalance(ctx context.Context, mintDenom string) (math
 	return k.bankKeeper.GetBalance(ctx, ecosystemAddr, mintDenom).Amount, nil
 }
 
+// returns the height of the block that last updated the emission rate, or 0 if it was never updated
+func (k Keeper) GetLastEmissionUpdateHeight(ctx context.Context) (int64, error) {
+	lastUpdateHeight, err := k.LastEmissionUpdateHeight.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return lastUpdateHeight, nil
+}
+
+// returns the month of the schedule of the emission model that the next emission update opens,
+// i.e. the number of emission updates made since the emission model was selected
+func (k Keeper) GetEmissionMonthIndex(ctx context.Context) (uint64, error) {
+	monthIndex, err := k.EmissionMonthIndex.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return monthIndex, nil
+}
+
 // Params getter
 func (k Keeper) GetParams(ctx context.Context) (types.Params, error) {
 	return k.Params.Get(ctx)
@@ -194,16 +277,13 @@ func (k Keeper) GetPreviousPercentageRewardToStakedReputers(ctx context.Context)
 	return stakedPercent.SdkLegacyDec(), nil
 }
 
-// wrapper around emissions keeper call to get the number of blocks expected in a month
+// wrapper around emissions keeper call to get the number of blocks expected in a month,
+// calibrated from the observed block time when the emissions module is configured to
 func (k Keeper) GetParamsBlocksPerMonth(ctx context.Context) (uint64, error) {
-	emissionsParams, err := k.emissionsKeeper.GetParams(ctx)
-	if err != nil {
-		return 0, err
-	}
-	return emissionsParams.BlocksPerMonth, nil
+	return k.emissionsKeeper.GetBlocksPerMonth(ctx)
 }
 
-// wrapper around emissions keeper call to get if whitelist admin
-func (k Keeper) IsWhitelistAdmin(ctx context.Context, admin string) (bool, error) {
-	return k.emissionsKeeper.IsWhitelistAdmin(ctx, admin)
+// wrapper around emissions keeper call to get if params admin
+func (k Keeper) IsParamsAdmin(ctx context.Context, admin string) (bool, error) {
+	return k.emissionsKeeper.IsParamsAdmin(ctx, admin)
 }
@@ -28,8 +28,10 @@ type IntegrationTestSuite struct {
 	mintKeeper      keeper.Keeper
 	ctx             sdk.Context
 	msgServer       types.MsgServer
+	accountKeeper   *minttestutil.MockAccountKeeper
 	stakingKeeper   *minttestutil.MockStakingKeeper
 	bankKeeper      *minttestutil.MockBankKeeper
+	distrKeeper     *minttestutil.MockDistributionKeeper
 	emissionsKeeper *minttestutil.MockEmissionsKeeper
 	adminPrivateKey secp256k1.PrivKey
 	adminAddr       string
@@ -51,6 +53,7 @@ func (s *IntegrationTestSuite) SetupTest() {
 	accountKeeper := minttestutil.NewMockAccountKeeper(ctrl)
 	bankKeeper := minttestutil.NewMockBankKeeper(ctrl)
 	stakingKeeper := minttestutil.NewMockStakingKeeper(ctrl)
+	distrKeeper := minttestutil.NewMockDistributionKeeper(ctrl)
 	emissionsKeeper := minttestutil.NewMockEmissionsKeeper(ctrl)
 
 	accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(sdk.AccAddress{})
@@ -61,11 +64,14 @@ func (s *IntegrationTestSuite) SetupTest() {
 		stakingKeeper,
 		accountKeeper,
 		bankKeeper,
+		distrKeeper,
 		emissionsKeeper,
 		authtypes.FeeCollectorName,
 	)
+	s.accountKeeper = accountKeeper
 	s.stakingKeeper = stakingKeeper
 	s.bankKeeper = bankKeeper
+	s.distrKeeper = distrKeeper
 	s.emissionsKeeper = emissionsKeeper
 
 	// Setup a sender address
@@ -1,7 +1,13 @@
 package keeper
 
 import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/x/mint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 // Migrator is a struct for handling in-place state migrations.
@@ -23,3 +29,159 @@ func NewMigrator(k Keeper) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return nil
 }
+
+// Migrate2to3 migrates the x/mint module state from the consensus version 2 to
+// version 3. Specifically, it replaces the hard-coded vesting schedule of the
+// investors and team tokens with vesting tranches in the params, so that the
+// locked token supply is unchanged.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	params.VestingTranches = types.DefaultVestingTranches(
+		params.InvestorsPercentOfTotalSupply,
+		params.TeamPercentOfTotalSupply,
+	)
+	if err := params.ValidateVestingTranches(); err != nil {
+		return err
+	}
+	return m.keeper.Params.Set(ctx, params)
+}
+
+// Migrate3to4 migrates the x/mint module state from the consensus version 3 to
+// version 4. Specifically, it keeps every fee paid into the ecosystem treasury
+// offsetting minting, starts the ledgers of the fees burned and sent to the
+// community pool, records what the ecosystem treasury holds as paid before the
+// fees were split, and lets the mint module account burn the fees it is sent.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	params.FeeBurnFraction = math.LegacyZeroDec()
+	params.FeeCommunityPoolFraction = math.LegacyZeroDec()
+	if err := params.ValidateFeeFractions(); err != nil {
+		return err
+	}
+	if err := m.keeper.Params.Set(ctx, params); err != nil {
+		return err
+	}
+
+	if err := m.keeper.TotalFeesBurned.Set(ctx, math.ZeroInt()); err != nil {
+		return err
+	}
+	if err := m.keeper.TotalFeesToCommunityPool.Set(ctx, math.ZeroInt()); err != nil {
+		return err
+	}
+	// what the ecosystem treasury holds now was paid before the fees were split
+	ecosystemBalance, err := m.keeper.GetEcosystemBalance(ctx, params.MintDenom)
+	if err != nil {
+		return err
+	}
+	if err := m.keeper.PreviousEcosystemBalance.Set(ctx, ecosystemBalance); err != nil {
+		return err
+	}
+
+	mintAccount := m.keeper.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	if mintAccount != nil && !mintAccount.HasPermission(authtypes.Burner) {
+		baseAccount := authtypes.NewBaseAccount(
+			mintAccount.GetAddress(),
+			mintAccount.GetPubKey(),
+			mintAccount.GetAccountNumber(),
+			mintAccount.GetSequence(),
+		)
+		m.keeper.accountKeeper.SetModuleAccount(
+			ctx,
+			authtypes.NewModuleAccount(baseAccount, types.ModuleName, authtypes.Minter, authtypes.Burner),
+		)
+	}
+	return nil
+}
+
+// Migrate4to5 migrates the x/mint module state from the consensus version 4 to
+// version 5. Specifically, it records the height of the last emission update,
+// which version 4 left implicit by updating on the first block of every
+// blocksPerMonth-aligned month. It is the height of the latest stored emission
+// update, or else the last aligned month start before the current block.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	emissionInfo, err := m.keeper.LatestEmissionInfo.Get(ctx)
+	if err == nil {
+		return m.keeper.LastEmissionUpdateHeight.Set(ctx, emissionInfo.BlockHeight)
+	}
+	if !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	blocksPerMonth, err := m.keeper.GetParamsBlocksPerMonth(ctx)
+	if err != nil {
+		return err
+	}
+	previousHeight := ctx.BlockHeight() - 1
+	if previousHeight < 1 || blocksPerMonth == 0 {
+		return nil
+	}
+	lastUpdateHeight := previousHeight - (previousHeight-1)%int64(blocksPerMonth)
+	return m.keeper.LastEmissionUpdateHeight.Set(ctx, lastUpdateHeight)
+}
+
+// Migrate5to6 migrates the x/mint module state from the consensus version 5 to
+// version 6. Specifically, it sets the emission model params added in version 6
+// to their defaults, which keep emitting from the target emission per unit staked token.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	defaultParams := types.DefaultParams()
+	params.EmissionModel = defaultParams.EmissionModel
+	params.FixedScheduleMonthlyEmissions = defaultParams.FixedScheduleMonthlyEmissions
+	params.HalvingInitialMonthlyEmission = defaultParams.HalvingInitialMonthlyEmission
+	params.HalvingIntervalMonths = defaultParams.HalvingIntervalMonths
+	if err := params.ValidateEmissionModel(); err != nil {
+		return err
+	}
+	return m.keeper.Params.Set(ctx, params)
+}
+
+// Migrate6to7 migrates the x/mint module state from the consensus version 6 to
+// version 7. Specifically, it sets the validators split controller params added
+// in version 7 to their defaults, which leave the controller disabled and the
+// split between validators and Allora unchanged.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	defaultParams := types.DefaultParams()
+	params.ValidatorsSplitControllerEnabled = defaultParams.ValidatorsSplitControllerEnabled
+	params.MinValidatorsPercent = defaultParams.MinValidatorsPercent
+	params.MaxValidatorsPercent = defaultParams.MaxValidatorsPercent
+	params.TargetValidatorsStakeRatio = defaultParams.TargetValidatorsStakeRatio
+	params.ValidatorsPercentAdjustmentRate = defaultParams.ValidatorsPercentAdjustmentRate
+	params.ValidatorsSplitHistoryLength = defaultParams.ValidatorsSplitHistoryLength
+	if err := params.ValidateValidatorsSplitController(); err != nil {
+		return err
+	}
+	return m.keeper.Params.Set(ctx, params)
+}
+
+// Migrate7to8 migrates the x/mint module state from the consensus version 7 to
+// version 8. Specifically, it records the month of the schedule of the emission
+// model that the next emission update opens, which version 7 derived from the
+// block height and the blocks per month. It is the month after the one the
+// last emission update opened, or the first month if there was no update yet.
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	lastUpdateHeight, err := m.keeper.GetLastEmissionUpdateHeight(ctx)
+	if err != nil {
+		return err
+	}
+	if lastUpdateHeight <= 0 {
+		return m.keeper.EmissionMonthIndex.Set(ctx, 0)
+	}
+	blocksPerMonth, err := m.keeper.GetParamsBlocksPerMonth(ctx)
+	if err != nil {
+		return err
+	}
+	return m.keeper.EmissionMonthIndex.Set(ctx, GetEmissionMonthIndexAtHeight(lastUpdateHeight, blocksPerMonth)+1)
+}
@@ -0,0 +1,188 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/x/mint/keeper"
+	"github.com/allora-network/allora-chain/x/mint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/golang/mock/gomock"
+)
+
+func (s *IntegrationTestSuite) TestMigrate2to3SetsVestingTranchesFromStoredParams() {
+	params := types.DefaultParams()
+	params.VestingTranches = nil
+	err := s.mintKeeper.Params.Set(s.ctx, params)
+	s.Require().NoError(err)
+
+	err = keeper.NewMigrator(s.mintKeeper).Migrate2to3(s.ctx)
+	s.Require().NoError(err)
+
+	migrated, err := s.mintKeeper.Params.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(types.DefaultVestingTranches(
+		params.InvestorsPercentOfTotalSupply,
+		params.TeamPercentOfTotalSupply,
+	), migrated.VestingTranches)
+}
+
+func (s *IntegrationTestSuite) TestMigrate3to4SplitsNoFeesAndLetsTheMintAccountBurn() {
+	params := types.DefaultParams()
+	params.FeeBurnFraction = math.LegacyDec{}
+	params.FeeCommunityPoolFraction = math.LegacyDec{}
+	err := s.mintKeeper.Params.Set(s.ctx, params)
+	s.Require().NoError(err)
+
+	ecosystemAddr := authtypes.NewModuleAddress(types.EcosystemModuleName)
+	s.accountKeeper.EXPECT().GetModuleAddress(types.EcosystemModuleName).Return(ecosystemAddr)
+	s.bankKeeper.EXPECT().GetBalance(s.ctx, ecosystemAddr, params.MintDenom).
+		Return(sdk.NewCoin(params.MintDenom, math.NewInt(500)))
+	s.accountKeeper.EXPECT().GetModuleAccount(s.ctx, types.ModuleName).
+		Return(authtypes.NewEmptyModuleAccount(types.ModuleName, authtypes.Minter))
+	var migratedAccount sdk.ModuleAccountI
+	s.accountKeeper.EXPECT().SetModuleAccount(s.ctx, gomock.Any()).
+		Do(func(_ interface{}, account sdk.ModuleAccountI) { migratedAccount = account })
+
+	err = keeper.NewMigrator(s.mintKeeper).Migrate3to4(s.ctx)
+	s.Require().NoError(err)
+
+	migrated, err := s.mintKeeper.Params.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().True(migrated.FeeBurnFraction.IsZero())
+	s.Require().True(migrated.FeeCommunityPoolFraction.IsZero())
+
+	previousEcosystemBalance, err := s.mintKeeper.PreviousEcosystemBalance.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(500), previousEcosystemBalance)
+	totalFeesBurned, err := s.mintKeeper.TotalFeesBurned.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().True(totalFeesBurned.IsZero())
+	totalFeesToCommunityPool, err := s.mintKeeper.TotalFeesToCommunityPool.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().True(totalFeesToCommunityPool.IsZero())
+
+	s.Require().NotNil(migratedAccount)
+	s.Require().True(migratedAccount.HasPermission(authtypes.Minter))
+	s.Require().True(migratedAccount.HasPermission(authtypes.Burner))
+}
+
+func (s *IntegrationTestSuite) TestMigrate4to5() {
+	bpm := uint64(100)
+	s.emissionsKeeper.EXPECT().GetBlocksPerMonth(gomock.Any()).Return(bpm, nil).Times(2)
+
+	// without a stored emission update, the last aligned month start before the current block
+	ctx := s.ctx.WithBlockHeight(257)
+	err := keeper.NewMigrator(s.mintKeeper).Migrate4to5(ctx)
+	s.Require().NoError(err)
+	lastUpdateHeight, err := s.mintKeeper.LastEmissionUpdateHeight.Get(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(201), lastUpdateHeight)
+
+	// the current block still updates if it starts an aligned month
+	ctx = s.ctx.WithBlockHeight(301)
+	err = keeper.NewMigrator(s.mintKeeper).Migrate4to5(ctx)
+	s.Require().NoError(err)
+	lastUpdateHeight, err = s.mintKeeper.LastEmissionUpdateHeight.Get(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(201), lastUpdateHeight)
+	s.Require().True(keeper.IsEmissionUpdateHeight(lastUpdateHeight, 301, bpm))
+
+	// the stored emission update wins
+	err = s.mintKeeper.LatestEmissionInfo.Set(ctx, types.EmissionInfo{BlockHeight: 250})
+	s.Require().NoError(err)
+	err = keeper.NewMigrator(s.mintKeeper).Migrate4to5(ctx)
+	s.Require().NoError(err)
+	lastUpdateHeight, err = s.mintKeeper.LastEmissionUpdateHeight.Get(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(250), lastUpdateHeight)
+}
+
+func (s *IntegrationTestSuite) TestMigrate5to6SetsEmissionModelParamsToDefaults() {
+	// version 5 params know nothing of the emission models, nor of the validators split controller added later
+	params := types.DefaultParams()
+	params.HalvingInitialMonthlyEmission = math.Int{}
+	params.MinValidatorsPercent = math.LegacyDec{}
+	params.MaxValidatorsPercent = math.LegacyDec{}
+	params.TargetValidatorsStakeRatio = math.LegacyDec{}
+	params.ValidatorsPercentAdjustmentRate = math.LegacyDec{}
+	params.ValidatorsSplitHistoryLength = 0
+	err := s.mintKeeper.Params.Set(s.ctx, params)
+	s.Require().NoError(err)
+
+	err = keeper.NewMigrator(s.mintKeeper).Migrate5to6(s.ctx)
+	s.Require().NoError(err)
+	params, err = s.mintKeeper.Params.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().NoError(params.ValidateEmissionModel())
+	defaultParams := types.DefaultParams()
+	s.Require().Equal(defaultParams.EmissionModel, params.EmissionModel)
+	s.Require().Empty(params.FixedScheduleMonthlyEmissions)
+	s.Require().True(params.HalvingInitialMonthlyEmission.IsZero())
+	s.Require().Equal(defaultParams.HalvingIntervalMonths, params.HalvingIntervalMonths)
+	// the validators split controller params are left to their own migration
+	s.Require().Zero(params.ValidatorsSplitHistoryLength)
+}
+
+func (s *IntegrationTestSuite) TestMigrate6to7SetsValidatorsSplitControllerParamsToDefaults() {
+	// version 6 params know nothing of the validators split controller
+	params := types.DefaultParams()
+	params.MinValidatorsPercent = math.LegacyDec{}
+	params.MaxValidatorsPercent = math.LegacyDec{}
+	params.TargetValidatorsStakeRatio = math.LegacyDec{}
+	params.ValidatorsPercentAdjustmentRate = math.LegacyDec{}
+	params.ValidatorsSplitHistoryLength = 0
+	err := s.mintKeeper.Params.Set(s.ctx, params)
+	s.Require().NoError(err)
+
+	err = keeper.NewMigrator(s.mintKeeper).Migrate6to7(s.ctx)
+	s.Require().NoError(err)
+	params, err = s.mintKeeper.Params.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().NoError(params.Validate())
+	defaultParams := types.DefaultParams()
+	s.Require().False(params.ValidatorsSplitControllerEnabled)
+	s.Require().Equal(defaultParams.MinValidatorsPercent, params.MinValidatorsPercent)
+	s.Require().Equal(defaultParams.MaxValidatorsPercent, params.MaxValidatorsPercent)
+	s.Require().Equal(defaultParams.TargetValidatorsStakeRatio, params.TargetValidatorsStakeRatio)
+	s.Require().Equal(defaultParams.ValidatorsPercentAdjustmentRate, params.ValidatorsPercentAdjustmentRate)
+	s.Require().Equal(defaultParams.ValidatorsSplitHistoryLength, params.ValidatorsSplitHistoryLength)
+}
+
+// Migrations run one after the other in a single upgrade, each before the params of the later versions are set
+func (s *IntegrationTestSuite) TestMigrationsFromVersion2() {
+	params := types.DefaultParams()
+	params.VestingTranches = nil
+	params.FeeBurnFraction = math.LegacyDec{}
+	params.FeeCommunityPoolFraction = math.LegacyDec{}
+	params.HalvingInitialMonthlyEmission = math.Int{}
+	params.MinValidatorsPercent = math.LegacyDec{}
+	params.MaxValidatorsPercent = math.LegacyDec{}
+	params.TargetValidatorsStakeRatio = math.LegacyDec{}
+	params.ValidatorsPercentAdjustmentRate = math.LegacyDec{}
+	params.ValidatorsSplitHistoryLength = 0
+	err := s.mintKeeper.Params.Set(s.ctx, params)
+	s.Require().NoError(err)
+
+	ecosystemAddr := authtypes.NewModuleAddress(types.EcosystemModuleName)
+	s.accountKeeper.EXPECT().GetModuleAddress(types.EcosystemModuleName).Return(ecosystemAddr)
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), ecosystemAddr, params.MintDenom).
+		Return(sdk.NewCoin(params.MintDenom, math.ZeroInt()))
+	s.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).
+		Return(authtypes.NewEmptyModuleAccount(types.ModuleName, authtypes.Minter, authtypes.Burner))
+	s.emissionsKeeper.EXPECT().GetBlocksPerMonth(gomock.Any()).Return(uint64(100), nil)
+
+	ctx := s.ctx.WithBlockHeight(10)
+	migrator := keeper.NewMigrator(s.mintKeeper)
+	s.Require().NoError(migrator.Migrate2to3(ctx))
+	s.Require().NoError(migrator.Migrate3to4(ctx))
+	s.Require().NoError(migrator.Migrate4to5(ctx))
+	s.Require().NoError(migrator.Migrate5to6(ctx))
+	s.Require().NoError(migrator.Migrate6to7(ctx))
+
+	params, err = s.mintKeeper.Params.Get(ctx)
+	s.Require().NoError(err)
+	s.Require().NoError(params.Validate())
+	lastUpdateHeight, err := s.mintKeeper.LastEmissionUpdateHeight.Get(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(1), lastUpdateHeight)
+}
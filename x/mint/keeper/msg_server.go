@@ -23,18 +23,29 @@ func NewMsgServerImpl(k Keeper) types.MsgServer {
 
 // UpdateParams updates the params.
 func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
-	isAdmin, err := ms.IsWhitelistAdmin(ctx, msg.Sender)
+	isAdmin, err := ms.IsParamsAdmin(ctx, msg.Sender)
 	if err != nil {
 		return nil, err
 	}
 	if !isAdmin {
-		return nil, errors.Wrapf(types.ErrUnauthorized, " %s not whitelist admin for mint update params", msg.Sender)
+		return nil, errors.Wrapf(types.ErrUnauthorized, " %s not params admin for mint update params", msg.Sender)
 	}
 
 	if err := msg.Params.Validate(); err != nil {
 		return nil, err
 	}
 
+	// a newly selected emission model starts from the first month of its schedule
+	params, err := ms.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if params.EmissionModel != msg.Params.EmissionModel {
+		if err := ms.EmissionMonthIndex.Set(ctx, 0); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := ms.Params.Set(ctx, msg.Params); err != nil {
 		return nil, err
 	}
@@ -17,13 +17,36 @@ func (s *IntegrationTestSuite) TestUpdateParams() {
 		Sender: s.adminAddr,
 		Params: params,
 	}
-	s.emissionsKeeper.EXPECT().IsWhitelistAdmin(s.ctx, s.adminAddr).Return(true, nil)
+	s.emissionsKeeper.EXPECT().IsParamsAdmin(s.ctx, s.adminAddr).Return(true, nil)
 	resp, err := s.msgServer.UpdateParams(s.ctx, request)
 	s.Require().NoError(err)
 	s.Require().NotNil(resp)
 	s.Require().Equal(&types.MsgUpdateParamsResponse{}, resp)
 }
 
+func (s *IntegrationTestSuite) TestUpdateParamsNewEmissionModelRestartsMonthIndex() {
+	err := s.mintKeeper.EmissionMonthIndex.Set(s.ctx, 5)
+	s.Require().NoError(err)
+	s.emissionsKeeper.EXPECT().IsParamsAdmin(s.ctx, s.adminAddr).Return(true, nil).AnyTimes()
+
+	// the same emission model carries on with its schedule
+	params := types.DefaultParams()
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Sender: s.adminAddr, Params: params})
+	s.Require().NoError(err)
+	monthIndex, err := s.mintKeeper.GetEmissionMonthIndex(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(5), monthIndex)
+
+	params.EmissionModel = types.EmissionModelType_EMISSION_MODEL_HALVING
+	params.HalvingInitialMonthlyEmission = sdkmath.NewInt(1000)
+	params.HalvingIntervalMonths = 12
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Sender: s.adminAddr, Params: params})
+	s.Require().NoError(err)
+	monthIndex, err = s.mintKeeper.GetEmissionMonthIndex(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(0), monthIndex)
+}
+
 func (s *IntegrationTestSuite) TestUpdateParamsInvalidSigner() {
 	// Setup a non-whitelisted sender address
 	nonAdminPrivateKey := secp256k1.GenPrivKey()
@@ -35,7 +58,7 @@ func (s *IntegrationTestSuite) TestUpdateParamsInvalidSigner() {
 		Params: params,
 	}
 
-	s.emissionsKeeper.EXPECT().IsWhitelistAdmin(s.ctx, nonAdminAddr).Return(false, nil)
+	s.emissionsKeeper.EXPECT().IsParamsAdmin(s.ctx, nonAdminAddr).Return(false, nil)
 	resp, err := s.msgServer.UpdateParams(s.ctx, request)
 	s.Require().ErrorIs(
 		err,
@@ -52,7 +75,7 @@ func (s *IntegrationTestSuite) TestUpdateParamsNonAddressSigner() {
 		Sender: notAnAddress,
 		Params: defaultParams,
 	}
-	s.emissionsKeeper.EXPECT().IsWhitelistAdmin(s.ctx, notAnAddress).Return(false, fmt.Errorf("error key encode:"))
+	s.emissionsKeeper.EXPECT().IsParamsAdmin(s.ctx, notAnAddress).Return(false, fmt.Errorf("error key encode:"))
 	resp, err := s.msgServer.UpdateParams(s.ctx, request)
 	s.Require().Error(err)
 	s.Require().Nil(resp)
@@ -65,7 +88,7 @@ func (s *IntegrationTestSuite) TestUpdateParamsInvalidParamsMintDenom() {
 		Sender: s.adminAddr,
 		Params: params,
 	}
-	s.emissionsKeeper.EXPECT().IsWhitelistAdmin(s.ctx, s.adminAddr).Return(true, nil)
+	s.emissionsKeeper.EXPECT().IsParamsAdmin(s.ctx, s.adminAddr).Return(true, nil)
 	resp, err := s.msgServer.UpdateParams(s.ctx, request)
 	s.Require().Error(err)
 	s.Require().Nil(resp)
@@ -78,7 +101,7 @@ func (s *IntegrationTestSuite) TestUpdateParamsInvalidParamsMaxSupply() {
 		Sender: s.adminAddr,
 		Params: params,
 	}
-	s.emissionsKeeper.EXPECT().IsWhitelistAdmin(s.ctx, s.adminAddr).Return(true, nil)
+	s.emissionsKeeper.EXPECT().IsParamsAdmin(s.ctx, s.adminAddr).Return(true, nil)
 	resp, err := s.msgServer.UpdateParams(s.ctx, request)
 	s.Require().Error(err)
 	s.Require().Nil(resp)
@@ -91,7 +114,7 @@ func (s *IntegrationTestSuite) TestUpdateParamsInvalidParamsFEmission() {
 		Sender: s.adminAddr,
 		Params: params,
 	}
-	s.emissionsKeeper.EXPECT().IsWhitelistAdmin(s.ctx, s.adminAddr).Return(true, nil)
+	s.emissionsKeeper.EXPECT().IsParamsAdmin(s.ctx, s.adminAddr).Return(true, nil)
 	resp, err := s.msgServer.UpdateParams(s.ctx, request)
 	s.Require().Error(err)
 	s.Require().Nil(resp)
@@ -104,7 +127,7 @@ func (s *IntegrationTestSuite) TestUpdateParamsInvalidParamsOneMonthSmoothingDeg
 		Sender: s.adminAddr,
 		Params: params,
 	}
-	s.emissionsKeeper.EXPECT().IsWhitelistAdmin(s.ctx, s.adminAddr).Return(true, nil)
+	s.emissionsKeeper.EXPECT().IsParamsAdmin(s.ctx, s.adminAddr).Return(true, nil)
 	resp, err := s.msgServer.UpdateParams(s.ctx, request)
 	s.Require().Error(err)
 	s.Require().Nil(resp)
@@ -117,7 +140,7 @@ func (s *IntegrationTestSuite) TestUpdateParamsInvalidParamsEcosystemTreasuryPer
 		Sender: s.adminAddr,
 		Params: params,
 	}
-	s.emissionsKeeper.EXPECT().IsWhitelistAdmin(s.ctx, s.adminAddr).Return(true, nil)
+	s.emissionsKeeper.EXPECT().IsParamsAdmin(s.ctx, s.adminAddr).Return(true, nil)
 	resp, err := s.msgServer.UpdateParams(s.ctx, request)
 	s.Require().Error(err)
 	s.Require().Nil(resp)
@@ -130,7 +153,7 @@ func (s *IntegrationTestSuite) TestUpdateParamsInvalidParamsFoundationTreasuryPe
 		Sender: s.adminAddr,
 		Params: params,
 	}
-	s.emissionsKeeper.EXPECT().IsWhitelistAdmin(s.ctx, s.adminAddr).Return(true, nil)
+	s.emissionsKeeper.EXPECT().IsParamsAdmin(s.ctx, s.adminAddr).Return(true, nil)
 	resp, err := s.msgServer.UpdateParams(s.ctx, request)
 	s.Require().Error(err)
 	s.Require().Nil(resp)
@@ -143,7 +166,7 @@ func (s *IntegrationTestSuite) TestUpdateParamsInvalidParamsParticipantsPercentO
 		Sender: s.adminAddr,
 		Params: params,
 	}
-	s.emissionsKeeper.EXPECT().IsWhitelistAdmin(s.ctx, s.adminAddr).Return(true, nil)
+	s.emissionsKeeper.EXPECT().IsParamsAdmin(s.ctx, s.adminAddr).Return(true, nil)
 	resp, err := s.msgServer.UpdateParams(s.ctx, request)
 	s.Require().Error(err)
 	s.Require().Nil(resp)
@@ -156,7 +179,7 @@ func (s *IntegrationTestSuite) TestUpdateParamsInvalidParamsInvestorsPercentOfTo
 		Sender: s.adminAddr,
 		Params: params,
 	}
-	s.emissionsKeeper.EXPECT().IsWhitelistAdmin(s.ctx, s.adminAddr).Return(true, nil)
+	s.emissionsKeeper.EXPECT().IsParamsAdmin(s.ctx, s.adminAddr).Return(true, nil)
 	resp, err := s.msgServer.UpdateParams(s.ctx, request)
 	s.Require().Error(err)
 	s.Require().Nil(resp)
@@ -168,7 +191,7 @@ func (s *IntegrationTestSuite) TestUpdateParamsInvalidParamsTeamPercentOfTotalSu
 		Sender: s.adminAddr,
 		Params: params,
 	}
-	s.emissionsKeeper.EXPECT().IsWhitelistAdmin(s.ctx, s.adminAddr).Return(true, nil)
+	s.emissionsKeeper.EXPECT().IsParamsAdmin(s.ctx, s.adminAddr).Return(true, nil)
 	resp, err := s.msgServer.UpdateParams(s.ctx, request)
 	s.Require().Error(err)
 	s.Require().Nil(resp)
@@ -180,8 +203,138 @@ func (s *IntegrationTestSuite) TestUpdateParamsInvalidParamsMaximumMonthlyPercen
 		Sender: s.adminAddr,
 		Params: params,
 	}
-	s.emissionsKeeper.EXPECT().IsWhitelistAdmin(s.ctx, s.adminAddr).Return(true, nil)
+	s.emissionsKeeper.EXPECT().IsParamsAdmin(s.ctx, s.adminAddr).Return(true, nil)
+	resp, err := s.msgServer.UpdateParams(s.ctx, request)
+	s.Require().Error(err)
+	s.Require().Nil(resp)
+}
+
+func (s *IntegrationTestSuite) TestUpdateParamsInvalidParamsVestingTranches() {
+	for _, tranches := range [][]types.VestingTranche{
+		{{Name: "", PercentOfTotalSupply: sdkmath.LegacyMustNewDecFromStr("0.1"), DurationMonths: 12}},
+		{
+			{Name: "team", PercentOfTotalSupply: sdkmath.LegacyMustNewDecFromStr("0.1"), DurationMonths: 12},
+			{Name: "team", PercentOfTotalSupply: sdkmath.LegacyMustNewDecFromStr("0.1"), DurationMonths: 12},
+		},
+		{{Name: "team", PercentOfTotalSupply: sdkmath.LegacyMustNewDecFromStr("0.1"), DurationMonths: 0}},
+		{{Name: "team", PercentOfTotalSupply: sdkmath.LegacyMustNewDecFromStr("0.1"), CliffMonths: 13, DurationMonths: 12}},
+		{{Name: "team", PercentOfTotalSupply: sdkmath.LegacyMustNewDecFromStr("0.1"), DurationMonths: 12, Curve: types.VestingCurveType(42)}},
+		// more than the supply not reserved for the ecosystem treasury
+		{{Name: "team", PercentOfTotalSupply: sdkmath.LegacyMustNewDecFromStr("0.7"), DurationMonths: 12}},
+	} {
+		params := types.DefaultParams()
+		params.VestingTranches = tranches
+		request := &types.MsgUpdateParams{
+			Sender: s.adminAddr,
+			Params: params,
+		}
+		s.emissionsKeeper.EXPECT().IsParamsAdmin(s.ctx, s.adminAddr).Return(true, nil)
+		resp, err := s.msgServer.UpdateParams(s.ctx, request)
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	}
+}
+
+func (s *IntegrationTestSuite) TestUpdateParamsInvalidParamsFeeSplit() {
+	params := types.DefaultParams()
+	params.FeeBurnFraction = sdkmath.LegacyMustNewDecFromStr("0.6")
+	params.FeeCommunityPoolFraction = sdkmath.LegacyMustNewDecFromStr("0.5")
+	request := &types.MsgUpdateParams{
+		Sender: s.adminAddr,
+		Params: params,
+	}
+	s.emissionsKeeper.EXPECT().IsParamsAdmin(s.ctx, s.adminAddr).Return(true, nil)
 	resp, err := s.msgServer.UpdateParams(s.ctx, request)
 	s.Require().Error(err)
 	s.Require().Nil(resp)
 }
+
+func (s *IntegrationTestSuite) TestUpdateParamsEmissionModel() {
+	params := types.DefaultParams()
+	params.EmissionModel = types.EmissionModelType_EMISSION_MODEL_HALVING
+	params.HalvingInitialMonthlyEmission = sdkmath.NewInt(1000)
+	params.HalvingIntervalMonths = 48
+	request := &types.MsgUpdateParams{
+		Sender: s.adminAddr,
+		Params: params,
+	}
+	s.emissionsKeeper.EXPECT().IsParamsAdmin(s.ctx, s.adminAddr).Return(true, nil)
+	_, err := s.msgServer.UpdateParams(s.ctx, request)
+	s.Require().NoError(err)
+	actualParams, err := s.mintKeeper.Params.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(params.EmissionModel, actualParams.EmissionModel)
+	s.Require().Equal(params.HalvingInitialMonthlyEmission, actualParams.HalvingInitialMonthlyEmission)
+	s.Require().Equal(params.HalvingIntervalMonths, actualParams.HalvingIntervalMonths)
+}
+
+func (s *IntegrationTestSuite) TestUpdateParamsInvalidParamsEmissionModel() {
+	for _, modify := range []func(*types.Params){
+		func(p *types.Params) { p.EmissionModel = types.EmissionModelType(42) },
+		// a fixed schedule without any month
+		func(p *types.Params) {
+			p.EmissionModel = types.EmissionModelType_EMISSION_MODEL_FIXED_SCHEDULE
+		},
+		func(p *types.Params) {
+			p.EmissionModel = types.EmissionModelType_EMISSION_MODEL_FIXED_SCHEDULE
+			p.FixedScheduleMonthlyEmissions = []sdkmath.Int{sdkmath.NewInt(10), sdkmath.NewInt(-1)}
+		},
+		// negative amounts are rejected even for a model that is not selected
+		func(p *types.Params) {
+			p.FixedScheduleMonthlyEmissions = []sdkmath.Int{sdkmath.NewInt(-1)}
+		},
+		func(p *types.Params) {
+			p.HalvingInitialMonthlyEmission = sdkmath.NewInt(-1)
+		},
+		func(p *types.Params) {
+			p.EmissionModel = types.EmissionModelType_EMISSION_MODEL_HALVING
+			p.HalvingIntervalMonths = 12
+		},
+		func(p *types.Params) {
+			p.EmissionModel = types.EmissionModelType_EMISSION_MODEL_HALVING
+			p.HalvingInitialMonthlyEmission = sdkmath.NewInt(1000)
+		},
+	} {
+		params := types.DefaultParams()
+		modify(&params)
+		request := &types.MsgUpdateParams{
+			Sender: s.adminAddr,
+			Params: params,
+		}
+		s.emissionsKeeper.EXPECT().IsParamsAdmin(s.ctx, s.adminAddr).Return(true, nil)
+		resp, err := s.msgServer.UpdateParams(s.ctx, request)
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	}
+}
+
+func (s *IntegrationTestSuite) TestUpdateParamsInvalidParamsValidatorsSplitController() {
+	for _, modify := range []func(*types.Params){
+		func(p *types.Params) { p.MinValidatorsPercent = sdkmath.LegacyMustNewDecFromStr("-0.1") },
+		func(p *types.Params) { p.MaxValidatorsPercent = sdkmath.LegacyMustNewDecFromStr("1.1") },
+		func(p *types.Params) { p.TargetValidatorsStakeRatio = sdkmath.LegacyDec{} },
+		func(p *types.Params) { p.ValidatorsPercentAdjustmentRate = sdkmath.LegacyMustNewDecFromStr("2") },
+		func(p *types.Params) {
+			p.MinValidatorsPercent = sdkmath.LegacyMustNewDecFromStr("0.6")
+			p.MaxValidatorsPercent = sdkmath.LegacyMustNewDecFromStr("0.4")
+		},
+		// the controller picks up from the split of the previous block
+		func(p *types.Params) {
+			p.ValidatorsSplitControllerEnabled = true
+			p.ValidatorsSplitHistoryLength = 0
+		},
+		func(p *types.Params) { p.ValidatorsSplitHistoryLength = types.MaxValidatorsSplitHistoryLength + 1 },
+		func(p *types.Params) { p.ValidatorsSplitHistoryLength = ^uint64(0) },
+	} {
+		params := types.DefaultParams()
+		modify(&params)
+		request := &types.MsgUpdateParams{
+			Sender: s.adminAddr,
+			Params: params,
+		}
+		s.emissionsKeeper.EXPECT().IsParamsAdmin(s.ctx, s.adminAddr).Return(true, nil)
+		resp, err := s.msgServer.UpdateParams(s.ctx, request)
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	}
+}
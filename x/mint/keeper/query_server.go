@@ -2,9 +2,14 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	"github.com/allora-network/allora-chain/x/mint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 var _ types.QueryServer = queryServer{}
@@ -53,3 +58,243 @@ func (q queryServer) Inflation(ctx context.Context, _ *types.QueryInflationReque
 	}
 	return &ret, nil
 }
+
+// VestingTranches returns the locked and unlocked amounts of every vesting tranche at the current block.
+func (q queryServer) VestingTranches(ctx context.Context, _ *types.QueryVestingTranchesRequest) (*types.QueryVestingTranchesResponse, error) {
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	blocksPerMonth, err := q.k.GetParamsBlocksPerMonth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	blockHeight := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	tranches := make([]types.VestingTrancheStatus, 0, len(params.VestingTranches))
+	totalLocked := math.ZeroInt()
+	for _, tranche := range params.VestingTranches {
+		total := GetVestingTrancheTotalAmount(params.MaxSupply, tranche)
+		locked := GetVestingTrancheLockedAmount(blocksPerMonth, math.NewInt(blockHeight), params.MaxSupply, tranche)
+		tranches = append(tranches, types.VestingTrancheStatus{
+			Tranche:  tranche,
+			Total:    total,
+			Locked:   locked,
+			Unlocked: total.Sub(locked),
+		})
+		totalLocked = totalLocked.Add(locked)
+	}
+	return &types.QueryVestingTranchesResponse{
+		BlockHeight: blockHeight,
+		Tranches:    tranches,
+		TotalLocked: totalLocked,
+	}, nil
+}
+
+// EmissionInfo returns the intermediate values of the latest monthly update of the emission rate
+// along with the current state of the ecosystem treasury.
+func (q queryServer) EmissionInfo(ctx context.Context, _ *types.QueryEmissionInfoRequest) (*types.QueryEmissionInfoResponse, error) {
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var latestEmissionInfo *types.EmissionInfo
+	emissionInfo, err := q.k.LatestEmissionInfo.Get(ctx)
+	if err == nil {
+		latestEmissionInfo = &emissionInfo
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+	ecosystemBalance, err := q.k.GetEcosystemBalance(ctx, params.MintDenom)
+	if err != nil {
+		return nil, err
+	}
+	ecosystemTokensMinted, err := q.k.EcosystemTokensMinted.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	blockEmission, err := q.k.PreviousBlockEmission.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	blocksPerMonth, err := q.k.GetParamsBlocksPerMonth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lastEmissionUpdateHeight, err := q.k.GetLastEmissionUpdateHeight(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ecosystemMintCap := params.GetEcosystemMintSupplyCap()
+	blockHeight := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	return &types.QueryEmissionInfoResponse{
+		LatestEmissionInfo:           latestEmissionInfo,
+		BlockHeight:                  blockHeight,
+		EcosystemBalance:             ecosystemBalance,
+		EcosystemTokensMinted:        ecosystemTokensMinted,
+		EcosystemMintCap:             ecosystemMintCap,
+		EcosystemMintSupplyRemaining: ecosystemMintCap.Sub(ecosystemTokensMinted),
+		BlockEmission:                blockEmission,
+		NextEmissionUpdateHeight:     GetNextEmissionUpdateHeight(lastEmissionUpdateHeight, blockHeight, blocksPerMonth),
+		LastEmissionUpdateHeight:     lastEmissionUpdateHeight,
+	}, nil
+}
+
+// ProjectEmissions projects the monthly emission rate updates of the coming months under the current params,
+// optionally overriding the staked tokens and the share of the rewards paid to staked reputers.
+func (q queryServer) ProjectEmissions(ctx context.Context, req *types.QueryProjectEmissionsRequest) (*types.QueryProjectEmissionsResponse, error) {
+	months := req.Months
+	if months == 0 {
+		months = types.DefaultProjectedEmissionMonths
+	}
+	if months > types.MaxProjectedEmissionMonths {
+		return nil, errorsmod.Wrapf(types.ErrInvalidEmissionProjection,
+			"cannot project more than %d months: %d", types.MaxProjectedEmissionMonths, months)
+	}
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	blocksPerMonth, err := q.k.GetParamsBlocksPerMonth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lastEmissionUpdateHeight, err := q.k.GetLastEmissionUpdateHeight(ctx)
+	if err != nil {
+		return nil, err
+	}
+	firstUpdateHeight := GetNextEmissionUpdateHeight(
+		lastEmissionUpdateHeight,
+		sdk.UnwrapSDKContext(ctx).BlockHeight(),
+		blocksPerMonth,
+	)
+	if firstUpdateHeight == 0 {
+		return nil, errorsmod.Wrapf(types.ErrInvalidEmissionProjection,
+			"the emission rate is never updated with %d blocks per month", blocksPerMonth)
+	}
+
+	var networkStaked math.Int
+	if req.NetworkStaked != "" {
+		var ok bool
+		networkStaked, ok = math.NewIntFromString(req.NetworkStaked)
+		if !ok || !networkStaked.IsPositive() {
+			return nil, errorsmod.Wrapf(types.ErrInvalidEmissionProjection,
+				"network staked must be a positive integer: %s", req.NetworkStaked)
+		}
+	} else {
+		networkStaked, err = GetNumStakedTokens(ctx, q.k)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var reputersPercent math.LegacyDec
+	if req.ReputersPercent != "" {
+		reputersPercent, err = math.LegacyNewDecFromStr(req.ReputersPercent)
+		if err != nil || reputersPercent.IsNegative() || reputersPercent.GT(math.LegacyOneDec()) {
+			return nil, errorsmod.Wrapf(types.ErrInvalidEmissionProjection,
+				"reputers percent must be between 0 and 1: %s", req.ReputersPercent)
+		}
+	} else {
+		reputersPercent, err = q.k.GetPreviousPercentageRewardToStakedReputers(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	validatorsPercent, err := q.k.GetValidatorsPercentReward(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	ecosystemTokensMinted, err := q.k.EcosystemTokensMinted.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	previousRewardEmissionPerUnitStakedToken, err := q.k.PreviousRewardEmissionPerUnitStakedToken.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	monthIndex, err := q.k.GetEmissionMonthIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	projection, err := GetEmissionProjection(
+		params,
+		blocksPerMonth,
+		firstUpdateHeight,
+		monthIndex,
+		months,
+		networkStaked,
+		q.k.GetTotalCurrTokenSupply(ctx).Amount,
+		ecosystemTokensMinted,
+		previousRewardEmissionPerUnitStakedToken,
+		reputersPercent,
+		validatorsPercent,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryProjectEmissionsResponse{Months: projection}, nil
+}
+
+// FeeSplit returns the fractions of the fees paid into the ecosystem treasury that are burned and sent to
+// the community pool, and the totals burned and sent so far.
+func (q queryServer) FeeSplit(ctx context.Context, _ *types.QueryFeeSplitRequest) (*types.QueryFeeSplitResponse, error) {
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	totalFeesBurned, err := q.k.TotalFeesBurned.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	totalFeesToCommunityPool, err := q.k.TotalFeesToCommunityPool.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryFeeSplitResponse{
+		FeeBurnFraction:          params.FeeBurnFraction,
+		FeeCommunityPoolFraction: params.FeeCommunityPoolFraction,
+		TotalFeesBurned:          totalFeesBurned,
+		TotalFeesToCommunityPool: totalFeesToCommunityPool,
+	}, nil
+}
+
+// ValidatorsSplit returns the fraction of the block emission paid to cosmos validators, the stake it is
+// chosen from when the validators split controller is enabled, and a page of the splits of the latest blocks.
+func (q queryServer) ValidatorsSplit(ctx context.Context, req *types.QueryValidatorsSplitRequest) (*types.QueryValidatorsSplitResponse, error) {
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	validatorsPercent, err := q.k.GetValidatorsPercentReward(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	cosmosValidatorsStaked, err := q.k.CosmosValidatorStakedSupply(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reputersStaked, err := q.k.emissionsKeeper.GetTotalStake(ctx)
+	if err != nil {
+		return nil, err
+	}
+	history, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.ValidatorsSplitHistory,
+		req.Pagination,
+		func(_ int64, record types.ValidatorsSplitRecord) (types.ValidatorsSplitRecord, error) {
+			return record, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryValidatorsSplitResponse{
+		ControllerEnabled:      params.ValidatorsSplitControllerEnabled,
+		ValidatorsPercent:      validatorsPercent,
+		CosmosValidatorsStaked: cosmosValidatorsStaked,
+		ReputersStaked:         reputersStaked,
+		ValidatorsStakeRatio:   GetValidatorsStakeRatio(cosmosValidatorsStaked, reputersStaked),
+		History:                history,
+		Pagination:             pageRes,
+	}, nil
+}
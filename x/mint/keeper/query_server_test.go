@@ -7,6 +7,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/allora-network/allora-chain/x/mint/keeper"
 	mint "github.com/allora-network/allora-chain/x/mint/module"
@@ -41,6 +42,7 @@ func (suite *MintTestSuite) SetupTest() {
 	accountKeeper := minttestutil.NewMockAccountKeeper(ctrl)
 	bankKeeper := minttestutil.NewMockBankKeeper(ctrl)
 	stakingKeeper := minttestutil.NewMockStakingKeeper(ctrl)
+	distrKeeper := minttestutil.NewMockDistributionKeeper(ctrl)
 	emissionsKeeper := minttestutil.NewMockEmissionsKeeper(ctrl)
 
 	accountKeeper.EXPECT().GetModuleAddress("mint").Return(sdk.AccAddress{})
@@ -51,6 +53,7 @@ func (suite *MintTestSuite) SetupTest() {
 		stakingKeeper,
 		accountKeeper,
 		bankKeeper,
+		distrKeeper,
 		emissionsKeeper,
 		authtypes.FeeCollectorName,
 	)
@@ -71,6 +74,30 @@ func (suite *MintTestSuite) TestGRPCParams() {
 	suite.Require().Equal(params.Params, kparams)
 }
 
+func (s *IntegrationTestSuite) TestVestingTranchesQuery() {
+	bpm := uint64(1000)
+	s.emissionsKeeper.EXPECT().GetBlocksPerMonth(gomock.Any()).Return(bpm, nil)
+	ctx := s.ctx.WithBlockHeight(int64(bpm*13 + 1))
+	params := types.DefaultParams()
+
+	resp, err := keeper.NewQueryServerImpl(s.mintKeeper).VestingTranches(ctx, &types.QueryVestingTranchesRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(ctx.BlockHeight(), resp.BlockHeight)
+	s.Require().Len(resp.Tranches, 2)
+	totalLocked := math.ZeroInt()
+	for i, status := range resp.Tranches {
+		s.Require().Equal(params.VestingTranches[i], status.Tranche)
+		full := status.Tranche.PercentOfTotalSupply.Mul(params.MaxSupply.ToLegacyDec()).TruncateInt()
+		locked := math.LegacyNewDec(23).Quo(math.LegacyNewDec(36)).Mul(full.ToLegacyDec()).TruncateInt()
+		s.Require().Equal(full, status.Total)
+		s.Require().Equal(locked, status.Locked)
+		s.Require().Equal(full.Sub(locked), status.Unlocked)
+		totalLocked = totalLocked.Add(locked)
+	}
+	s.Require().Equal(totalLocked, resp.TotalLocked)
+	s.Require().Equal(keeper.GetLockedTokenSupply(bpm, math.NewInt(ctx.BlockHeight()), params), resp.TotalLocked)
+}
+
 func TestMintTestSuite(t *testing.T) {
 	suite.Run(t, new(MintTestSuite))
 }
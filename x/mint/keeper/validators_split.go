@@ -0,0 +1,191 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/x/mint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// the share of the stake of cosmos validators and reputers held by cosmos validators,
+// or zero if nothing is staked
+// r = N_{validators} / (N_{validators} + N_{reputers})
+func GetValidatorsStakeRatio(cosmosValidatorsStaked, reputersStaked math.Int) math.LegacyDec {
+	totalStaked := cosmosValidatorsStaked.Add(reputersStaked)
+	if !totalStaked.IsPositive() {
+		return math.LegacyZeroDec()
+	}
+	return cosmosValidatorsStaked.ToLegacyDec().Quo(totalStaked.ToLegacyDec())
+}
+
+// The validators split controller moves the fraction of the block emission paid to cosmos
+// validators towards the security budget that is short of stake:
+// v_i = clamp(v_{i-1} + k * (r_target - r_i), v_min, v_max)
+// where r_i is the validators stake ratio. Validators holding less than the target share of the stake
+// are paid more to attract it, and less when they hold more of it, which leaves more to reputers
+func GetAdjustedValidatorsPercent(
+	previousValidatorsPercent math.LegacyDec,
+	validatorsStakeRatio math.LegacyDec,
+	targetValidatorsStakeRatio math.LegacyDec,
+	adjustmentRate math.LegacyDec,
+	minValidatorsPercent math.LegacyDec,
+	maxValidatorsPercent math.LegacyDec,
+) math.LegacyDec {
+	adjustment := adjustmentRate.Mul(targetValidatorsStakeRatio.Sub(validatorsStakeRatio))
+	return clampValidatorsPercent(previousValidatorsPercent.Add(adjustment), minValidatorsPercent, maxValidatorsPercent)
+}
+
+func clampValidatorsPercent(validatorsPercent, minValidatorsPercent, maxValidatorsPercent math.LegacyDec) math.LegacyDec {
+	if validatorsPercent.LT(minValidatorsPercent) {
+		return minValidatorsPercent
+	}
+	if validatorsPercent.GT(maxValidatorsPercent) {
+		return maxValidatorsPercent
+	}
+	return validatorsPercent
+}
+
+// returns the latest split chosen by the validators split controller, if it ever ran
+func (k Keeper) GetLatestValidatorsSplitRecord(ctx context.Context) (types.ValidatorsSplitRecord, bool, error) {
+	iter, err := k.ValidatorsSplitHistory.Iterate(ctx, new(collections.Range[int64]).Descending())
+	if err != nil {
+		return types.ValidatorsSplitRecord{}, false, err
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return types.ValidatorsSplitRecord{}, false, nil
+	}
+	record, err := iter.Value()
+	if err != nil {
+		return types.ValidatorsSplitRecord{}, false, err
+	}
+	return record, true, nil
+}
+
+// the fraction of the block emission paid to cosmos validators by the latest block:
+// the split chosen by the validators split controller when it is enabled,
+// else the validators_vs_allora_percent_reward emissions param
+func (k Keeper) GetValidatorsPercentReward(ctx context.Context, params types.Params) (math.LegacyDec, error) {
+	if params.ValidatorsSplitControllerEnabled {
+		record, found, err := k.GetLatestValidatorsSplitRecord(ctx)
+		if err != nil {
+			return math.LegacyDec{}, err
+		}
+		if found {
+			return record.ValidatorsPercent, nil
+		}
+	}
+	validatorsPercent, err := k.GetValidatorsVsAlloraPercentReward(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	return validatorsPercent.SdkLegacyDec(), nil
+}
+
+// Returns the fraction of the block emission paid to cosmos validators in this block.
+// When the validators split controller is enabled, it adjusts the split of the previous block
+// from the current stake, records it in the history and prunes the history to its configured length.
+// The controller starts from the validators_vs_allora_percent_reward emissions param
+// whenever it did not run in the previous block
+func (k Keeper) UpdateValidatorsPercentReward(ctx context.Context, params types.Params) (math.LegacyDec, error) {
+	if !params.ValidatorsSplitControllerEnabled {
+		validatorsPercent, err := k.GetValidatorsVsAlloraPercentReward(ctx)
+		if err != nil {
+			return math.LegacyDec{}, err
+		}
+		return validatorsPercent.SdkLegacyDec(), nil
+	}
+	blockHeight := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	cosmosValidatorsStaked, err := k.CosmosValidatorStakedSupply(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	reputersStaked, err := k.emissionsKeeper.GetTotalStake(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	latest, found, err := k.GetLatestValidatorsSplitRecord(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	var previousValidatorsPercent math.LegacyDec
+	if found && latest.BlockHeight == blockHeight-1 {
+		previousValidatorsPercent = latest.ValidatorsPercent
+	} else {
+		paramValidatorsPercent, err := k.GetValidatorsVsAlloraPercentReward(ctx)
+		if err != nil {
+			return math.LegacyDec{}, err
+		}
+		previousValidatorsPercent = paramValidatorsPercent.SdkLegacyDec()
+	}
+
+	validatorsStakeRatio := GetValidatorsStakeRatio(cosmosValidatorsStaked, reputersStaked)
+	var validatorsPercent math.LegacyDec
+	if cosmosValidatorsStaked.Add(reputersStaked).IsPositive() {
+		validatorsPercent = GetAdjustedValidatorsPercent(
+			previousValidatorsPercent,
+			validatorsStakeRatio,
+			params.TargetValidatorsStakeRatio,
+			params.ValidatorsPercentAdjustmentRate,
+			params.MinValidatorsPercent,
+			params.MaxValidatorsPercent,
+		)
+	} else {
+		// nothing staked yet, so nothing to steer by
+		validatorsPercent = clampValidatorsPercent(
+			previousValidatorsPercent,
+			params.MinValidatorsPercent,
+			params.MaxValidatorsPercent,
+		)
+	}
+
+	err = k.ValidatorsSplitHistory.Set(ctx, blockHeight, types.ValidatorsSplitRecord{
+		BlockHeight:            blockHeight,
+		CosmosValidatorsStaked: cosmosValidatorsStaked,
+		ReputersStaked:         reputersStaked,
+		ValidatorsStakeRatio:   validatorsStakeRatio,
+		ValidatorsPercent:      validatorsPercent,
+	})
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	if err := k.PruneValidatorsSplitHistory(ctx, blockHeight, params.ValidatorsSplitHistoryLength); err != nil {
+		return math.LegacyDec{}, err
+	}
+	return validatorsPercent, nil
+}
+
+// removes the splits recorded before the latest historyLength blocks up to blockHeight
+func (k Keeper) PruneValidatorsSplitHistory(ctx context.Context, blockHeight int64, historyLength uint64) error {
+	if blockHeight <= 0 || historyLength >= uint64(blockHeight) {
+		// every block up to blockHeight is within the history
+		return nil
+	}
+	oldestKeptHeight := blockHeight - int64(historyLength) + 1
+	iter, err := k.ValidatorsSplitHistory.Iterate(ctx, new(collections.Range[int64]).EndExclusive(oldestKeptHeight))
+	if err != nil {
+		return err
+	}
+	heights, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+	for _, height := range heights {
+		if err := k.ValidatorsSplitHistory.Remove(ctx, height); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// returns every recorded split, oldest first
+func (k Keeper) GetValidatorsSplitHistory(ctx context.Context) ([]types.ValidatorsSplitRecord, error) {
+	iter, err := k.ValidatorsSplitHistory.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
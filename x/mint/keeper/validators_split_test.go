@@ -0,0 +1,161 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/allora-network/allora-chain/x/mint/keeper"
+	"github.com/allora-network/allora-chain/x/mint/types"
+	"github.com/golang/mock/gomock"
+)
+
+func (s *IntegrationTestSuite) TestGetValidatorsStakeRatio() {
+	s.Require().Equal(math.LegacyMustNewDecFromStr("0.25"), keeper.GetValidatorsStakeRatio(math.NewInt(100), math.NewInt(300)))
+	s.Require().Equal(math.LegacyOneDec(), keeper.GetValidatorsStakeRatio(math.NewInt(100), math.ZeroInt()))
+	s.Require().True(keeper.GetValidatorsStakeRatio(math.ZeroInt(), math.ZeroInt()).IsZero())
+}
+
+func (s *IntegrationTestSuite) TestGetAdjustedValidatorsPercent() {
+	minPercent := math.LegacyMustNewDecFromStr("0.1")
+	maxPercent := math.LegacyMustNewDecFromStr("0.5")
+	target := math.LegacyMustNewDecFromStr("0.5")
+	rate := math.LegacyMustNewDecFromStr("0.1")
+
+	// validators hold less than the target share of the stake, so they are paid more
+	// 0.25 + 0.1 * (0.5 - 0.25) = 0.275
+	adjusted := keeper.GetAdjustedValidatorsPercent(
+		math.LegacyMustNewDecFromStr("0.25"), math.LegacyMustNewDecFromStr("0.25"), target, rate, minPercent, maxPercent,
+	)
+	s.Require().Equal(math.LegacyMustNewDecFromStr("0.275"), adjusted)
+
+	// validators hold more than the target share of the stake, so reputers are paid more
+	// 0.25 + 0.1 * (0.5 - 0.75) = 0.225
+	adjusted = keeper.GetAdjustedValidatorsPercent(
+		math.LegacyMustNewDecFromStr("0.25"), math.LegacyMustNewDecFromStr("0.75"), target, rate, minPercent, maxPercent,
+	)
+	s.Require().Equal(math.LegacyMustNewDecFromStr("0.225"), adjusted)
+
+	// on target, unchanged
+	adjusted = keeper.GetAdjustedValidatorsPercent(
+		math.LegacyMustNewDecFromStr("0.25"), target, target, rate, minPercent, maxPercent,
+	)
+	s.Require().Equal(math.LegacyMustNewDecFromStr("0.25"), adjusted)
+
+	// never out of bounds
+	adjusted = keeper.GetAdjustedValidatorsPercent(
+		math.LegacyMustNewDecFromStr("0.49"), math.LegacyZeroDec(), target, rate, minPercent, maxPercent,
+	)
+	s.Require().Equal(maxPercent, adjusted)
+	adjusted = keeper.GetAdjustedValidatorsPercent(
+		math.LegacyMustNewDecFromStr("0.11"), math.LegacyOneDec(), target, rate, minPercent, maxPercent,
+	)
+	s.Require().Equal(minPercent, adjusted)
+}
+
+func (s *IntegrationTestSuite) TestUpdateValidatorsPercentRewardDisabled() {
+	emissionsParams := emissionstypes.DefaultParams()
+	s.emissionsKeeper.EXPECT().GetParams(s.ctx).Return(emissionsParams, nil)
+
+	validatorsPercent, err := s.mintKeeper.UpdateValidatorsPercentReward(s.ctx, types.DefaultParams())
+	s.Require().NoError(err)
+	s.Require().Equal(emissionsParams.ValidatorsVsAlloraPercentReward.SdkLegacyDec(), validatorsPercent)
+	history, err := s.mintKeeper.GetValidatorsSplitHistory(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(history)
+}
+
+func (s *IntegrationTestSuite) TestUpdateValidatorsPercentReward() {
+	params := types.DefaultParams()
+	params.ValidatorsSplitControllerEnabled = true
+	params.ValidatorsPercentAdjustmentRate = math.LegacyMustNewDecFromStr("0.1")
+	params.ValidatorsSplitHistoryLength = 2
+	emissionsParams := emissionstypes.DefaultParams()
+	emissionsParams.ValidatorsVsAlloraPercentReward = alloraMath.MustNewDecFromString("0.25")
+	// validators hold a quarter of the stake, half of the target
+	s.stakingKeeper.EXPECT().TotalBondedTokens(gomock.Any()).Return(math.NewInt(100), nil).AnyTimes()
+	s.emissionsKeeper.EXPECT().GetTotalStake(gomock.Any()).Return(math.NewInt(300), nil).AnyTimes()
+	s.emissionsKeeper.EXPECT().GetParams(gomock.Any()).Return(emissionsParams, nil).AnyTimes()
+
+	// the controller starts from the emissions param
+	// 0.25 + 0.1 * (0.5 - 0.25) = 0.275
+	s.ctx = s.ctx.WithBlockHeight(10)
+	validatorsPercent, err := s.mintKeeper.UpdateValidatorsPercentReward(s.ctx, params)
+	s.Require().NoError(err)
+	s.Require().Equal(math.LegacyMustNewDecFromStr("0.275"), validatorsPercent)
+
+	// then picks up from the previous block
+	// 0.275 + 0.1 * (0.5 - 0.25) = 0.3
+	s.ctx = s.ctx.WithBlockHeight(11)
+	validatorsPercent, err = s.mintKeeper.UpdateValidatorsPercentReward(s.ctx, params)
+	s.Require().NoError(err)
+	s.Require().Equal(math.LegacyMustNewDecFromStr("0.3"), validatorsPercent)
+	latestValidatorsPercent, err := s.mintKeeper.GetValidatorsPercentReward(s.ctx, params)
+	s.Require().NoError(err)
+	s.Require().Equal(validatorsPercent, latestValidatorsPercent)
+
+	// only the latest two blocks are kept
+	s.ctx = s.ctx.WithBlockHeight(12)
+	_, err = s.mintKeeper.UpdateValidatorsPercentReward(s.ctx, params)
+	s.Require().NoError(err)
+	history, err := s.mintKeeper.GetValidatorsSplitHistory(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Len(history, 2)
+	s.Require().Equal(int64(11), history[0].BlockHeight)
+	s.Require().Equal(int64(12), history[1].BlockHeight)
+	s.Require().Equal(math.NewInt(100), history[1].CosmosValidatorsStaked)
+	s.Require().Equal(math.NewInt(300), history[1].ReputersStaked)
+	s.Require().Equal(math.LegacyMustNewDecFromStr("0.25"), history[1].ValidatorsStakeRatio)
+	s.Require().Equal(math.LegacyMustNewDecFromStr("0.325"), history[1].ValidatorsPercent)
+
+	// after a gap, the controller starts over from the emissions param
+	s.ctx = s.ctx.WithBlockHeight(20)
+	validatorsPercent, err = s.mintKeeper.UpdateValidatorsPercentReward(s.ctx, params)
+	s.Require().NoError(err)
+	s.Require().Equal(math.LegacyMustNewDecFromStr("0.275"), validatorsPercent)
+
+	// disabled, the emissions param decides again even though there is history
+	params.ValidatorsSplitControllerEnabled = false
+	validatorsPercent, err = s.mintKeeper.GetValidatorsPercentReward(s.ctx, params)
+	s.Require().NoError(err)
+	s.Require().Equal(math.LegacyMustNewDecFromStr("0.25"), validatorsPercent)
+}
+
+func (s *IntegrationTestSuite) TestPruneValidatorsSplitHistoryLongerThanChain() {
+	for height := int64(1); height <= 3; height++ {
+		err := s.mintKeeper.ValidatorsSplitHistory.Set(s.ctx, height, types.ValidatorsSplitRecord{BlockHeight: height})
+		s.Require().NoError(err)
+	}
+
+	// a history longer than the chain keeps every block
+	for _, historyLength := range []uint64{3, 4, types.MaxValidatorsSplitHistoryLength, ^uint64(0)} {
+		err := s.mintKeeper.PruneValidatorsSplitHistory(s.ctx, 3, historyLength)
+		s.Require().NoError(err)
+		history, err := s.mintKeeper.GetValidatorsSplitHistory(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Len(history, 3)
+	}
+
+	err := s.mintKeeper.PruneValidatorsSplitHistory(s.ctx, 3, 1)
+	s.Require().NoError(err)
+	history, err := s.mintKeeper.GetValidatorsSplitHistory(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Len(history, 1)
+	s.Require().Equal(int64(3), history[0].BlockHeight)
+}
+
+func (s *IntegrationTestSuite) TestUpdateValidatorsPercentRewardNothingStaked() {
+	params := types.DefaultParams()
+	params.ValidatorsSplitControllerEnabled = true
+	params.MinValidatorsPercent = math.LegacyMustNewDecFromStr("0.3")
+	emissionsParams := emissionstypes.DefaultParams()
+	emissionsParams.ValidatorsVsAlloraPercentReward = alloraMath.MustNewDecFromString("0.25")
+	s.stakingKeeper.EXPECT().TotalBondedTokens(gomock.Any()).Return(math.ZeroInt(), nil)
+	s.emissionsKeeper.EXPECT().GetTotalStake(gomock.Any()).Return(math.ZeroInt(), nil)
+	s.emissionsKeeper.EXPECT().GetParams(gomock.Any()).Return(emissionsParams, nil)
+
+	// nothing to steer by, but still within bounds
+	s.ctx = s.ctx.WithBlockHeight(1)
+	validatorsPercent, err := s.mintKeeper.UpdateValidatorsPercentReward(s.ctx, params)
+	s.Require().NoError(err)
+	s.Require().Equal(params.MinValidatorsPercent, validatorsPercent)
+}
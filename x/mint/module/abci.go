@@ -21,10 +21,34 @@ func GetEmissionPerMonth(
 	emissionPerUnitStakedToken math.LegacyDec,
 	err error,
 ) {
+	emissionInfo, err := GetEmissionInfo(
+		ctx,
+		k,
+		blocksPerMonth,
+		params,
+		ecosystemMintSupplyRemaining,
+		validatorsPercent,
+	)
+	if err != nil {
+		return math.Int{}, math.LegacyDec{}, err
+	}
+	return emissionInfo.EmissionPerMonth, emissionInfo.RewardEmissionPerUnitStakedToken, nil
+}
+
+// Calculates the emission rate for the coming month with the emission model selected by the params,
+// recording every intermediate value of the calculation. The ecosystem balance is left for the caller to fill in.
+func GetEmissionInfo(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	blocksPerMonth uint64,
+	params types.Params,
+	ecosystemMintSupplyRemaining math.Int,
+	validatorsPercent math.LegacyDec,
+) (types.EmissionInfo, error) {
 	// Get the expected amount of emissions this block
 	networkStaked, err := keeper.GetNumStakedTokens(ctx, k)
 	if err != nil {
-		return math.Int{}, math.LegacyDec{}, err
+		return types.EmissionInfo{}, err
 	}
 	totalSupply := k.GetTotalCurrTokenSupply(ctx).Amount
 	lockedSupply := keeper.GetLockedTokenSupply(
@@ -41,6 +65,7 @@ func GetEmissionPerMonth(
 	// N_{circ,i} = circulatingSupply
 	// N_{total,i} = totalSupply
 	ctx.Logger().Info("Emission Per Unit Staked Token Calculation",
+		"emissionModel", params.EmissionModel.String(),
 		"FEmission", params.FEmission.String(),
 		"ecosystemMintSupplyRemaining", ecosystemMintSupplyRemaining.String(),
 		"networkStaked", networkStaked.String(),
@@ -48,41 +73,58 @@ func GetEmissionPerMonth(
 		"totalSupply", totalSupply.String(),
 		"lockedSupply", lockedSupply.String(),
 	)
-	targetRewardEmissionPerUnitStakedToken,
-		err := keeper.GetTargetRewardEmissionPerUnitStakedToken(
-		params.FEmission,
-		ecosystemMintSupplyRemaining,
-		networkStaked,
-		circulatingSupply,
-		params.MaxSupply,
-	)
-	if err != nil {
-		return math.Int{}, math.LegacyDec{}, err
-	}
 	reputersPercent, err := k.GetPreviousPercentageRewardToStakedReputers(ctx)
 	if err != nil {
-		return math.Int{}, math.LegacyDec{}, err
+		return types.EmissionInfo{}, err
 	}
-	maximumMonthlyEmissionPerUnitStakedToken := keeper.GetMaximumMonthlyEmissionPerUnitStakedToken(
-		params.MaximumMonthlyPercentageYield,
-		reputersPercent,
-		validatorsPercent,
-	)
-	targetRewardEmissionPerUnitStakedToken = keeper.GetCappedTargetEmissionPerUnitStakedToken(
-		targetRewardEmissionPerUnitStakedToken,
-		maximumMonthlyEmissionPerUnitStakedToken,
-	)
 	previousRewardEmissionPerUnitStakedToken, err := k.PreviousRewardEmissionPerUnitStakedToken.Get(ctx)
 	if err != nil {
-		return math.Int{}, math.LegacyDec{}, err
+		return types.EmissionInfo{}, err
 	}
-	emissionPerUnitStakedToken = keeper.GetExponentialMovingAverage(
-		targetRewardEmissionPerUnitStakedToken,
-		params.OneMonthSmoothingDegree,
-		previousRewardEmissionPerUnitStakedToken,
-	)
-	emissionPerMonth = keeper.GetTotalEmissionPerMonth(emissionPerUnitStakedToken, networkStaked)
-	return emissionPerMonth, emissionPerUnitStakedToken, nil
+	emissionModel, err := keeper.GetEmissionModel(params.EmissionModel)
+	if err != nil {
+		return types.EmissionInfo{}, err
+	}
+	monthIndex, err := k.GetEmissionMonthIndex(ctx)
+	if err != nil {
+		return types.EmissionInfo{}, err
+	}
+	monthlyEmission, err := emissionModel.GetMonthlyEmission(keeper.EmissionModelInput{
+		Params:                                   params,
+		MonthIndex:                               monthIndex,
+		BlocksPerMonth:                           blocksPerMonth,
+		NetworkStaked:                            networkStaked,
+		CirculatingSupply:                        circulatingSupply,
+		EcosystemMintSupplyRemaining:             ecosystemMintSupplyRemaining,
+		ReputersPercent:                          reputersPercent,
+		ValidatorsPercent:                        validatorsPercent,
+		PreviousRewardEmissionPerUnitStakedToken: previousRewardEmissionPerUnitStakedToken,
+	})
+	if err != nil {
+		return types.EmissionInfo{}, err
+	}
+	emissionPerMonth := monthlyEmission.EmissionPerMonth
+	// emission/block = (emission/month) / (block/month)
+	blockEmission := emissionPerMonth.Quo(math.NewIntFromUint64(blocksPerMonth))
+	return types.EmissionInfo{
+		BlockHeight:                              ctx.BlockHeight(),
+		BlocksPerMonth:                           blocksPerMonth,
+		NetworkStaked:                            networkStaked,
+		TotalSupply:                              totalSupply,
+		LockedSupply:                             lockedSupply,
+		CirculatingSupply:                        circulatingSupply,
+		EcosystemMintSupplyRemaining:             ecosystemMintSupplyRemaining,
+		TargetRewardEmissionPerUnitStakedToken:   monthlyEmission.TargetRewardEmissionPerUnitStakedToken,
+		ReputersPercent:                          reputersPercent,
+		ValidatorsPercent:                        validatorsPercent,
+		MaximumMonthlyEmissionPerUnitStakedToken: monthlyEmission.MaximumMonthlyEmissionPerUnitStakedToken,
+		CappedTargetRewardEmissionPerUnitStakedToken: monthlyEmission.CappedTargetRewardEmissionPerUnitStakedToken,
+		PreviousRewardEmissionPerUnitStakedToken:     previousRewardEmissionPerUnitStakedToken,
+		RewardEmissionPerUnitStakedToken:             monthlyEmission.RewardEmissionPerUnitStakedToken,
+		EmissionPerMonth:                             emissionPerMonth,
+		BlockEmission:                                blockEmission,
+		EcosystemBalance:                             math.ZeroInt(),
+	}, nil
 }
 
 // How many tokens are left that the ecosystem bucket is allowed to mint?
@@ -97,9 +139,49 @@ func GetEcosystemMintSupplyRemaining(
 		return math.Int{}, err
 	}
 	// check that you are allowed to mint more tokens and we haven't hit the max supply
-	ecosystemMaxSupply := math.LegacyNewDecFromInt(params.MaxSupply).
-		Mul(params.EcosystemTreasuryPercentOfTotalSupply).TruncateInt()
-	return ecosystemMaxSupply.Sub(ecosystemTokensAlreadyMinted), nil
+	return params.GetEcosystemMintSupplyCap().Sub(ecosystemTokensAlreadyMinted), nil
+}
+
+// Burns and sends to the community pool their fractions of the fees paid into the ecosystem treasury
+// since the previous block, i.e. of its balance above what the previous block left in it.
+// Returns the balance left in the ecosystem treasury.
+func SplitEcosystemFees(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	params types.Params,
+	ecosystemBalance math.Int,
+) (math.Int, error) {
+	previousEcosystemBalance, err := k.PreviousEcosystemBalance.Get(ctx)
+	if err != nil {
+		return math.Int{}, err
+	}
+	fees := ecosystemBalance.Sub(previousEcosystemBalance)
+	if !fees.IsPositive() {
+		return ecosystemBalance, nil
+	}
+	burned := params.FeeBurnFraction.MulInt(fees).TruncateInt()
+	shared := params.FeeCommunityPoolFraction.MulInt(fees).TruncateInt()
+	if burned.IsPositive() {
+		err = k.BurnCoinsFromEcosystem(ctx, sdk.NewCoins(sdk.NewCoin(params.MintDenom, burned)))
+		if err != nil {
+			return math.Int{}, err
+		}
+		err = k.AddTotalFeesBurned(ctx, burned)
+		if err != nil {
+			return math.Int{}, err
+		}
+	}
+	if shared.IsPositive() {
+		err = k.FundCommunityPoolFromEcosystem(ctx, sdk.NewCoins(sdk.NewCoin(params.MintDenom, shared)))
+		if err != nil {
+			return math.Int{}, err
+		}
+		err = k.AddTotalFeesToCommunityPool(ctx, shared)
+		if err != nil {
+			return math.Int{}, err
+		}
+	}
+	return ecosystemBalance.Sub(burned).Sub(shared), nil
 }
 
 func BeginBlocker(ctx context.Context, k keeper.Keeper) error {
@@ -114,6 +196,11 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper) error {
 	if err != nil {
 		return err
 	}
+	// take the burned and shared fractions of the fees collected since the previous block
+	ecosystemBalance, err = SplitEcosystemFees(sdkCtx, k, params, ecosystemBalance)
+	if err != nil {
+		return err
+	}
 
 	blockHeight := sdkCtx.BlockHeight()
 
@@ -127,18 +214,23 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper) error {
 	}
 	updateEmission := false
 	var e_i math.LegacyDec
+	var emissionInfo types.EmissionInfo
 	blocksPerMonth, err := k.GetParamsBlocksPerMonth(ctx)
 	if err != nil {
 		return err
 	}
-	vPercentADec, err := k.GetValidatorsVsAlloraPercentReward(ctx)
+	// the split between cosmos validators and allora participants, adjusted to the stake when so configured
+	vPercent, err := k.UpdateValidatorsPercentReward(ctx, params)
+	if err != nil {
+		return err
+	}
+	lastEmissionUpdateHeight, err := k.GetLastEmissionUpdateHeight(ctx)
 	if err != nil {
 		return err
 	}
-	vPercent := vPercentADec.SdkLegacyDec()
 	// every month on the first block of the month, update the emissions rate
-	if uint64(blockHeight)%blocksPerMonth == 1 { // easier to test when genesis starts at 1
-		emissionPerMonth, emissionPerUnitStakedToken, err := GetEmissionPerMonth(
+	if keeper.IsEmissionUpdateHeight(lastEmissionUpdateHeight, blockHeight, blocksPerMonth) {
+		emissionInfo, err = GetEmissionInfo(
 			sdkCtx,
 			k,
 			blocksPerMonth,
@@ -149,14 +241,13 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper) error {
 		if err != nil {
 			return err
 		}
-		// emission/block = (emission/month) / (block/month)
-		blockEmission = emissionPerMonth.
-			Quo(math.NewIntFromUint64(blocksPerMonth))
-		e_i = emissionPerUnitStakedToken
+		emissionInfo.EcosystemBalance = ecosystemBalance
+		blockEmission = emissionInfo.BlockEmission
+		e_i = emissionInfo.RewardEmissionPerUnitStakedToken
 		updateEmission = true
 		k.Logger(ctx).Info("Emissions Update",
 			"emissionPerUnitStakedToken", e_i.String(),
-			"emissionPerMonth", emissionPerMonth.String(),
+			"emissionPerMonth", emissionInfo.EmissionPerMonth.String(),
 			"blockEmission", blockEmission.String(),
 		)
 
@@ -203,6 +294,25 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper) error {
 		// set the previous emissions to this block's emissions
 		k.PreviousRewardEmissionPerUnitStakedToken.Set(ctx, e_i)
 		k.PreviousBlockEmission.Set(ctx, blockEmission)
+		if err := k.LatestEmissionInfo.Set(ctx, emissionInfo); err != nil {
+			return err
+		}
+		if err := k.LastEmissionUpdateHeight.Set(ctx, blockHeight); err != nil {
+			return err
+		}
+		// the next update opens the following month of the schedule
+		monthIndex, err := k.GetEmissionMonthIndex(ctx)
+		if err != nil {
+			return err
+		}
+		if err := k.EmissionMonthIndex.Set(ctx, monthIndex+1); err != nil {
+			return err
+		}
+	}
+	// remember what is left so that only fees paid from now on are split next block
+	ecosystemBalance, err = k.GetEcosystemBalance(ctx, params.MintDenom)
+	if err != nil {
+		return err
 	}
-	return nil
+	return k.PreviousEcosystemBalance.Set(ctx, ecosystemBalance)
 }
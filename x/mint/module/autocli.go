@@ -20,6 +20,34 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "inflation",
 					Short:     "Query the current minting inflation value",
 				},
+				{
+					RpcMethod: "VestingTranches",
+					Use:       "vesting-tranches",
+					Short:     "Query the locked and unlocked amounts of every vesting tranche at the current block",
+				},
+				{
+					RpcMethod: "EmissionInfo",
+					Use:       "emission-info",
+					Short:     "Query the intermediate values of the latest emission rate update and the state of the ecosystem treasury",
+				},
+				{
+					RpcMethod: "ProjectEmissions",
+					Use:       "project-emissions [months]",
+					Short:     "Project the monthly emission rate updates of the coming months, optionally overriding the staked tokens and the share of rewards paid to reputers with --network-staked and --reputers-percent",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "months", Optional: true},
+					},
+				},
+				{
+					RpcMethod: "FeeSplit",
+					Use:       "fee-split",
+					Short:     "Query the fractions of fees burned and sent to the community pool, and the totals so far",
+				},
+				{
+					RpcMethod: "ValidatorsSplit",
+					Use:       "validators-split",
+					Short:     "Query the fraction of the block emission paid to cosmos validators and its recent history",
+				},
 			},
 		},
 	}
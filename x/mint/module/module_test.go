@@ -26,10 +26,13 @@ import (
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
@@ -53,6 +56,7 @@ type MintModuleTestSuite struct {
 	appModule       mint.AppModule
 	emissionsKeeper emissionskeeper.Keeper
 	mintKeeper      keeper.Keeper
+	distrKeeper     distrkeeper.Keeper
 	PKS             []cryptotypes.PubKey
 }
 
@@ -63,14 +67,20 @@ func (s *MintModuleTestSuite) SetupTest() {
 	key := storetypes.NewKVStoreKey(types.StoreKey)
 	storeService := runtime.NewKVStoreService(key)
 	encCfg := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, staking.AppModuleBasic{}, bank.AppModuleBasic{}, mint.AppModuleBasic{})
-	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
-	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+	// the distribution module has its own store, its keys would collide with those of the other modules
+	distrKey := storetypes.NewKVStoreKey(distrtypes.StoreKey)
+	ctx := testutil.DefaultContextWithKeys(
+		map[string]*storetypes.KVStoreKey{types.StoreKey: key, distrtypes.StoreKey: distrKey},
+		map[string]*storetypes.TransientStoreKey{"transient_test": storetypes.NewTransientStoreKey("transient_test")},
+		nil,
+	).WithHeaderInfo(header.Info{Time: time.Now()})
 
 	maccPerms := map[string][]string{
 		"fee_collector":                         nil,
 		thirdParty:                              {"minter"},
 		"ecosystem":                             {"burner", "minter", "staking"},
-		"mint":                                  {"minter"},
+		"mint":                                  {"minter", "burner"},
+		distrtypes.ModuleName:                   nil,
 		emissionstypes.AlloraRewardsAccountName: nil,
 		emissionstypes.AlloraPendingRewardForDelegatorAccountName: nil,
 		emissionstypes.AlloraStakingAccountName:                   {"burner", "minter", "staking"},
@@ -125,12 +135,22 @@ func (s *MintModuleTestSuite) SetupTest() {
 		"fee_collector",
 	)
 
+	distrKeeper := distrkeeper.NewKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(distrKey),
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
 	mintKeeper := keeper.NewKeeper(
 		encCfg.Codec,
 		storeService,
 		stakingKeeper,
 		accountKeeper,
 		bankKeeper,
+		distrKeeper,
 		emissionsKeeper,
 		authtypes.FeeCollectorName,
 	)
@@ -141,6 +161,9 @@ func (s *MintModuleTestSuite) SetupTest() {
 	s.stakingKeeper = stakingKeeper
 	s.emissionsKeeper = emissionsKeeper
 	s.mintKeeper = mintKeeper
+	s.distrKeeper = distrKeeper
+	err := distrKeeper.FeePool.Set(ctx, distrtypes.InitialFeePool())
+	s.Require().NoError(err)
 
 	emissionsModule := emissions.NewAppModule(encCfg.Codec, s.emissionsKeeper)
 	emissionsDefaultGenesis := emissionsModule.DefaultGenesis(encCfg.Codec)
@@ -530,3 +553,403 @@ func (s *MintModuleTestSuite) TestInflationRateAsMorePeopleStakeGoesUp() {
 		ecosystemTokensMintedDelta1.String(),
 	)
 }
+
+func (s *MintModuleTestSuite) TestBeginBlockerStoresEmissionInfo() {
+	s.ctx = s.ctx.WithBlockHeight(1)
+	stake, ok := cosmosMath.NewIntFromString("40000000000000000000")
+	s.Require().True(ok)
+	err := s.emissionsKeeper.AddReputerStake(s.ctx, 0, sdk.AccAddress(s.PKS[0].Address()).String(), stake)
+	s.Require().NoError(err)
+	spareCoins, ok := cosmosMath.NewIntFromString("500000000000000000000000000")
+	s.Require().True(ok)
+	err = s.bankKeeper.MintCoins(s.ctx, thirdParty, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, spareCoins)))
+	s.Require().NoError(err)
+
+	queryServer := keeper.NewQueryServerImpl(s.mintKeeper)
+	resp, err := queryServer.EmissionInfo(s.ctx, &types.QueryEmissionInfoRequest{})
+	s.Require().NoError(err)
+	s.Require().Nil(resp.LatestEmissionInfo)
+
+	params, err := s.mintKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	blocksPerMonth, err := s.mintKeeper.GetParamsBlocksPerMonth(s.ctx)
+	s.Require().NoError(err)
+	ecosystemMintSupplyRemaining, err := mint.GetEcosystemMintSupplyRemaining(s.ctx, s.mintKeeper, params)
+	s.Require().NoError(err)
+	vPercent, err := s.mintKeeper.GetValidatorsVsAlloraPercentReward(s.ctx)
+	s.Require().NoError(err)
+	emissionPerMonth, emissionPerUnitStakedToken, err := mint.GetEmissionPerMonth(
+		s.ctx,
+		s.mintKeeper,
+		blocksPerMonth,
+		params,
+		ecosystemMintSupplyRemaining,
+		vPercent.SdkLegacyDec(),
+	)
+	s.Require().NoError(err)
+
+	err = mint.BeginBlocker(s.ctx, s.mintKeeper)
+	s.Require().NoError(err)
+
+	info, err := s.mintKeeper.LatestEmissionInfo.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(1), info.BlockHeight)
+	s.Require().Equal(blocksPerMonth, info.BlocksPerMonth)
+	s.Require().Equal(stake, info.NetworkStaked)
+	s.Require().Equal(info.TotalSupply.Sub(info.LockedSupply), info.CirculatingSupply)
+	s.Require().Equal(ecosystemMintSupplyRemaining, info.EcosystemMintSupplyRemaining)
+	s.Require().True(info.CappedTargetRewardEmissionPerUnitStakedToken.LTE(info.MaximumMonthlyEmissionPerUnitStakedToken))
+	s.Require().Equal(emissionPerMonth, info.EmissionPerMonth)
+	s.Require().Equal(emissionPerUnitStakedToken, info.RewardEmissionPerUnitStakedToken)
+	s.Require().Equal(emissionPerMonth.Quo(cosmosMath.NewIntFromUint64(blocksPerMonth)), info.BlockEmission)
+	s.Require().True(info.EcosystemBalance.IsZero())
+
+	resp, err = queryServer.EmissionInfo(s.ctx, &types.QueryEmissionInfoRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(info, *resp.LatestEmissionInfo)
+	s.Require().Equal(int64(1), resp.BlockHeight)
+	s.Require().Equal(info.BlockEmission, resp.BlockEmission)
+	s.Require().Equal(resp.EcosystemMintCap.Sub(resp.EcosystemTokensMinted), resp.EcosystemMintSupplyRemaining)
+	s.Require().True(resp.EcosystemTokensMinted.IsPositive())
+	s.Require().Equal(int64(1+blocksPerMonth), resp.NextEmissionUpdateHeight)
+	s.Require().Equal(int64(1), resp.LastEmissionUpdateHeight)
+}
+
+func (s *MintModuleTestSuite) TestBeginBlockerUpdatesEmissionAMonthAfterLastUpdate() {
+	stake, ok := cosmosMath.NewIntFromString("40000000000000000000")
+	s.Require().True(ok)
+	err := s.emissionsKeeper.AddReputerStake(s.ctx, 0, sdk.AccAddress(s.PKS[0].Address()).String(), stake)
+	s.Require().NoError(err)
+	spareCoins, ok := cosmosMath.NewIntFromString("500000000000000000000000000")
+	s.Require().True(ok)
+	err = s.bankKeeper.MintCoins(s.ctx, thirdParty, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, spareCoins)))
+	s.Require().NoError(err)
+	blocksPerMonth, err := s.mintKeeper.GetParamsBlocksPerMonth(s.ctx)
+	s.Require().NoError(err)
+
+	// a chain whose state was imported at a height not aligned on blocks per month
+	// updates right away since the emission rate was never updated
+	lastUpdateHeight := int64(blocksPerMonth) + 37
+	s.ctx = s.ctx.WithBlockHeight(lastUpdateHeight)
+	err = mint.BeginBlocker(s.ctx, s.mintKeeper)
+	s.Require().NoError(err)
+	storedHeight, err := s.mintKeeper.LastEmissionUpdateHeight.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(lastUpdateHeight, storedHeight)
+
+	// the aligned month start is no longer an update height
+	s.ctx = s.ctx.WithBlockHeight(int64(2*blocksPerMonth) + 1)
+	err = mint.BeginBlocker(s.ctx, s.mintKeeper)
+	s.Require().NoError(err)
+	storedHeight, err = s.mintKeeper.LastEmissionUpdateHeight.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(lastUpdateHeight, storedHeight)
+
+	// a halt skipping the height a month after the last update does not skip the update
+	s.ctx = s.ctx.WithBlockHeight(lastUpdateHeight + int64(blocksPerMonth) + 5)
+	err = mint.BeginBlocker(s.ctx, s.mintKeeper)
+	s.Require().NoError(err)
+	storedHeight, err = s.mintKeeper.LastEmissionUpdateHeight.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(s.ctx.BlockHeight(), storedHeight)
+	info, err := s.mintKeeper.LatestEmissionInfo.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(s.ctx.BlockHeight(), info.BlockHeight)
+}
+
+func (s *MintModuleTestSuite) TestBeginBlockerFixedScheduleEmissionModel() {
+	stake, ok := cosmosMath.NewIntFromString("40000000000000000000")
+	s.Require().True(ok)
+	err := s.emissionsKeeper.AddReputerStake(s.ctx, 0, sdk.AccAddress(s.PKS[0].Address()).String(), stake)
+	s.Require().NoError(err)
+	blocksPerMonth, err := s.mintKeeper.GetParamsBlocksPerMonth(s.ctx)
+	s.Require().NoError(err)
+	params, err := s.mintKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	monthlyEmission := cosmosMath.NewIntFromUint64(blocksPerMonth).MulRaw(1000)
+	params.EmissionModel = types.EmissionModelType_EMISSION_MODEL_FIXED_SCHEDULE
+	params.FixedScheduleMonthlyEmissions = []cosmosMath.Int{monthlyEmission, monthlyEmission.QuoRaw(2)}
+	err = s.mintKeeper.Params.Set(s.ctx, params)
+	s.Require().NoError(err)
+
+	// the projection follows the schedule, then runs out
+	s.ctx = s.ctx.WithBlockHeight(0)
+	resp, err := keeper.NewQueryServerImpl(s.mintKeeper).ProjectEmissions(s.ctx, &types.QueryProjectEmissionsRequest{Months: 3})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Months, 3)
+	s.Require().Equal(monthlyEmission, resp.Months[0].EmissionPerMonth)
+	s.Require().Equal(monthlyEmission.QuoRaw(2), resp.Months[1].EmissionPerMonth)
+	s.Require().True(resp.Months[2].EmissionPerMonth.IsZero())
+
+	// and so does BeginBlocker
+	s.ctx = s.ctx.WithBlockHeight(1)
+	err = mint.BeginBlocker(s.ctx, s.mintKeeper)
+	s.Require().NoError(err)
+	info, err := s.mintKeeper.LatestEmissionInfo.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(monthlyEmission, info.EmissionPerMonth)
+	s.Require().Equal(cosmosMath.NewInt(1000), info.BlockEmission)
+	blockEmission, err := s.mintKeeper.PreviousBlockEmission.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(1000), blockEmission)
+	inflation, err := keeper.NewQueryServerImpl(s.mintKeeper).Inflation(s.ctx, &types.QueryInflationRequest{})
+	s.Require().NoError(err)
+	s.Require().True(inflation.Inflation.IsPositive())
+
+	// the next month emits the second amount of the schedule
+	s.ctx = s.ctx.WithBlockHeight(int64(blocksPerMonth) + 1)
+	err = mint.BeginBlocker(s.ctx, s.mintKeeper)
+	s.Require().NoError(err)
+	blockEmission, err = s.mintKeeper.PreviousBlockEmission.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(500), blockEmission)
+}
+
+func (s *MintModuleTestSuite) TestBeginBlockerEmissionMonthIndex() {
+	stake, ok := cosmosMath.NewIntFromString("40000000000000000000")
+	s.Require().True(ok)
+	err := s.emissionsKeeper.AddReputerStake(s.ctx, 0, sdk.AccAddress(s.PKS[0].Address()).String(), stake)
+	s.Require().NoError(err)
+	blocksPerMonth, err := s.mintKeeper.GetParamsBlocksPerMonth(s.ctx)
+	s.Require().NoError(err)
+
+	// two months of the staked token yield model
+	for _, height := range []int64{1, int64(blocksPerMonth) + 1} {
+		s.ctx = s.ctx.WithBlockHeight(height)
+		err = mint.BeginBlocker(s.ctx, s.mintKeeper)
+		s.Require().NoError(err)
+	}
+	monthIndex, err := s.mintKeeper.GetEmissionMonthIndex(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), monthIndex)
+
+	// switching to a fixed schedule mid-life starts from its first month
+	admin := sdk.AccAddress(s.PKS[1].Address()).String()
+	err = s.emissionsKeeper.AddWhitelistAdmin(s.ctx, admin)
+	s.Require().NoError(err)
+	params, err := s.mintKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	monthlyEmission := cosmosMath.NewIntFromUint64(blocksPerMonth).MulRaw(1000)
+	params.EmissionModel = types.EmissionModelType_EMISSION_MODEL_FIXED_SCHEDULE
+	params.FixedScheduleMonthlyEmissions = []cosmosMath.Int{monthlyEmission, monthlyEmission.QuoRaw(2)}
+	_, err = keeper.NewMsgServerImpl(s.mintKeeper).UpdateParams(s.ctx, &types.MsgUpdateParams{Sender: admin, Params: params})
+	s.Require().NoError(err)
+	monthIndex, err = s.mintKeeper.GetEmissionMonthIndex(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(0), monthIndex)
+
+	lastUpdateHeight := int64(2*blocksPerMonth) + 1
+	s.ctx = s.ctx.WithBlockHeight(lastUpdateHeight)
+	err = mint.BeginBlocker(s.ctx, s.mintKeeper)
+	s.Require().NoError(err)
+	info, err := s.mintKeeper.LatestEmissionInfo.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(monthlyEmission, info.EmissionPerMonth)
+
+	// halving the blocks per month opens the next month of the schedule, not one further along
+	emissionsParams, err := s.emissionsKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	emissionsParams.BlocksPerMonth = blocksPerMonth / 2
+	err = s.emissionsKeeper.SetParams(s.ctx, emissionsParams)
+	s.Require().NoError(err)
+	s.ctx = s.ctx.WithBlockHeight(lastUpdateHeight + int64(blocksPerMonth/2))
+	err = mint.BeginBlocker(s.ctx, s.mintKeeper)
+	s.Require().NoError(err)
+	info, err = s.mintKeeper.LatestEmissionInfo.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(monthlyEmission.QuoRaw(2), info.EmissionPerMonth)
+	monthIndex, err = s.mintKeeper.GetEmissionMonthIndex(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), monthIndex)
+}
+
+func (s *MintModuleTestSuite) TestBeginBlockerValidatorsSplitController() {
+	stake, ok := cosmosMath.NewIntFromString("40000000000000000000")
+	s.Require().True(ok)
+	err := s.emissionsKeeper.AddReputerStake(s.ctx, 0, sdk.AccAddress(s.PKS[0].Address()).String(), stake)
+	s.Require().NoError(err)
+	spareCoins, ok := cosmosMath.NewIntFromString("500000000000000000000000000")
+	s.Require().True(ok)
+	err = s.bankKeeper.MintCoins(s.ctx, thirdParty, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, spareCoins)))
+	s.Require().NoError(err)
+	params, err := s.mintKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	params.ValidatorsSplitControllerEnabled = true
+	params.ValidatorsPercentAdjustmentRate = cosmosMath.LegacyMustNewDecFromStr("0.1")
+	err = s.mintKeeper.Params.Set(s.ctx, params)
+	s.Require().NoError(err)
+	paramValidatorsPercent, err := s.mintKeeper.GetValidatorsVsAlloraPercentReward(s.ctx)
+	s.Require().NoError(err)
+
+	queryServer := keeper.NewQueryServerImpl(s.mintKeeper)
+	for height := int64(1); height <= 3; height++ {
+		s.ctx = s.ctx.WithBlockHeight(height)
+		err = mint.BeginBlocker(s.ctx, s.mintKeeper)
+		s.Require().NoError(err)
+	}
+	resp, err := queryServer.ValidatorsSplit(s.ctx, &types.QueryValidatorsSplitRequest{})
+	s.Require().NoError(err)
+	s.Require().True(resp.ControllerEnabled)
+	s.Require().Len(resp.History, 3)
+	s.Require().Equal(int64(3), resp.History[2].BlockHeight)
+	s.Require().Equal(resp.History[2].ValidatorsPercent, resp.ValidatorsPercent)
+	s.Require().Equal(stake, resp.ReputersStaked)
+	s.Require().Equal(
+		keeper.GetValidatorsStakeRatio(resp.CosmosValidatorsStaked, resp.ReputersStaked),
+		resp.ValidatorsStakeRatio,
+	)
+	// every block moves the split from the previous one, within bounds
+	for i := 1; i < len(resp.History); i++ {
+		expected := keeper.GetAdjustedValidatorsPercent(
+			resp.History[i-1].ValidatorsPercent,
+			resp.History[i].ValidatorsStakeRatio,
+			params.TargetValidatorsStakeRatio,
+			params.ValidatorsPercentAdjustmentRate,
+			params.MinValidatorsPercent,
+			params.MaxValidatorsPercent,
+		)
+		s.Require().Equal(expected, resp.History[i].ValidatorsPercent)
+	}
+	s.Require().False(resp.ValidatorsPercent.Equal(paramValidatorsPercent.SdkLegacyDec()))
+	// the emission update of the first block used the split the controller chose for it
+	info, err := s.mintKeeper.LatestEmissionInfo.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(resp.History[0].ValidatorsPercent, info.ValidatorsPercent)
+}
+
+func (s *MintModuleTestSuite) TestValidatorsSplitQueryPaginatesHistory() {
+	for height := int64(1); height <= 5; height++ {
+		err := s.mintKeeper.ValidatorsSplitHistory.Set(s.ctx, height, types.ValidatorsSplitRecord{
+			BlockHeight:            height,
+			CosmosValidatorsStaked: cosmosMath.ZeroInt(),
+			ReputersStaked:         cosmosMath.ZeroInt(),
+			ValidatorsStakeRatio:   cosmosMath.LegacyZeroDec(),
+			ValidatorsPercent:      cosmosMath.LegacyZeroDec(),
+		})
+		s.Require().NoError(err)
+	}
+
+	queryServer := keeper.NewQueryServerImpl(s.mintKeeper)
+	var heights []int64
+	var nextKey []byte
+	for page := 0; page < 3; page++ {
+		resp, err := queryServer.ValidatorsSplit(s.ctx, &types.QueryValidatorsSplitRequest{
+			Pagination: &query.PageRequest{Key: nextKey, Limit: 2},
+		})
+		s.Require().NoError(err)
+		s.Require().LessOrEqual(len(resp.History), 2)
+		for _, record := range resp.History {
+			heights = append(heights, record.BlockHeight)
+		}
+		nextKey = resp.Pagination.NextKey
+	}
+	s.Require().Nil(nextKey)
+	s.Require().Equal([]int64{1, 2, 3, 4, 5}, heights)
+}
+
+func (s *MintModuleTestSuite) TestProjectEmissionsMatchesEmissionUpdate() {
+	stake, ok := cosmosMath.NewIntFromString("40000000000000000000")
+	s.Require().True(ok)
+	err := s.emissionsKeeper.AddReputerStake(s.ctx, 0, sdk.AccAddress(s.PKS[0].Address()).String(), stake)
+	s.Require().NoError(err)
+	spareCoins, ok := cosmosMath.NewIntFromString("500000000000000000000000000")
+	s.Require().True(ok)
+	err = s.bankKeeper.MintCoins(s.ctx, thirdParty, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, spareCoins)))
+	s.Require().NoError(err)
+
+	queryServer := keeper.NewQueryServerImpl(s.mintKeeper)
+	s.ctx = s.ctx.WithBlockHeight(0)
+	resp, err := queryServer.ProjectEmissions(s.ctx, &types.QueryProjectEmissionsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Months, types.DefaultProjectedEmissionMonths)
+	first := resp.Months[0]
+	s.Require().Equal(int64(1), first.BlockHeight)
+	s.Require().Equal(stake, first.NetworkStaked)
+
+	// the first projected month is the emission update of the next BeginBlocker
+	s.ctx = s.ctx.WithBlockHeight(1)
+	params, err := s.mintKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	blocksPerMonth, err := s.mintKeeper.GetParamsBlocksPerMonth(s.ctx)
+	s.Require().NoError(err)
+	ecosystemMintSupplyRemaining, err := mint.GetEcosystemMintSupplyRemaining(s.ctx, s.mintKeeper, params)
+	s.Require().NoError(err)
+	vPercent, err := s.mintKeeper.GetValidatorsVsAlloraPercentReward(s.ctx)
+	s.Require().NoError(err)
+	emissionPerMonth, emissionPerUnitStakedToken, err := mint.GetEmissionPerMonth(
+		s.ctx,
+		s.mintKeeper,
+		blocksPerMonth,
+		params,
+		ecosystemMintSupplyRemaining,
+		vPercent.SdkLegacyDec(),
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(emissionPerMonth, first.EmissionPerMonth)
+	s.Require().Equal(emissionPerUnitStakedToken, first.RewardEmissionPerUnitStakedToken)
+
+	// staking more lowers the target emission per unit staked token
+	s.ctx = s.ctx.WithBlockHeight(0)
+	resp, err = queryServer.ProjectEmissions(s.ctx, &types.QueryProjectEmissionsRequest{
+		Months:          24,
+		NetworkStaked:   stake.MulRaw(1000).String(),
+		ReputersPercent: "0.5",
+	})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Months, 24)
+	s.Require().True(resp.Months[0].TargetRewardEmissionPerUnitStakedToken.LT(first.TargetRewardEmissionPerUnitStakedToken))
+
+	_, err = queryServer.ProjectEmissions(s.ctx, &types.QueryProjectEmissionsRequest{Months: types.MaxProjectedEmissionMonths + 1})
+	s.Require().ErrorIs(err, types.ErrInvalidEmissionProjection)
+	_, err = queryServer.ProjectEmissions(s.ctx, &types.QueryProjectEmissionsRequest{NetworkStaked: "-1"})
+	s.Require().ErrorIs(err, types.ErrInvalidEmissionProjection)
+	_, err = queryServer.ProjectEmissions(s.ctx, &types.QueryProjectEmissionsRequest{ReputersPercent: "1.5"})
+	s.Require().ErrorIs(err, types.ErrInvalidEmissionProjection)
+}
+
+func (s *MintModuleTestSuite) TestBeginBlockerSplitsNewFees() {
+	params, err := s.mintKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	params.FeeBurnFraction = cosmosMath.LegacyMustNewDecFromStr("0.2")
+	params.FeeCommunityPoolFraction = cosmosMath.LegacyMustNewDecFromStr("0.3")
+	err = s.mintKeeper.Params.Set(s.ctx, params)
+	s.Require().NoError(err)
+	// the emission rate was updated at the first block and no emission is paid out since
+	err = s.mintKeeper.LastEmissionUpdateHeight.Set(s.ctx, 1)
+	s.Require().NoError(err)
+	s.ctx = s.ctx.WithBlockHeight(2)
+
+	// fees are paid into the ecosystem treasury
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, cosmosMath.NewInt(1000)))
+	err = s.bankKeeper.MintCoins(s.ctx, thirdParty, fees)
+	s.Require().NoError(err)
+	err = s.bankKeeper.SendCoinsFromModuleToModule(s.ctx, thirdParty, types.EcosystemModuleName, fees)
+	s.Require().NoError(err)
+	ecosystemAddress := s.accountKeeper.GetModuleAddress(types.EcosystemModuleName)
+	supplyBefore := s.bankKeeper.GetSupply(s.ctx, sdk.DefaultBondDenom).Amount
+
+	err = mint.BeginBlocker(s.ctx, s.mintKeeper)
+	s.Require().NoError(err)
+
+	s.Require().Equal(supplyBefore.SubRaw(200), s.bankKeeper.GetSupply(s.ctx, sdk.DefaultBondDenom).Amount)
+	s.Require().Equal(cosmosMath.NewInt(500), s.bankKeeper.GetBalance(s.ctx, ecosystemAddress, sdk.DefaultBondDenom).Amount)
+	communityPool, err := s.distrKeeper.FeePool.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.LegacyNewDec(300), communityPool.CommunityPool.AmountOf(sdk.DefaultBondDenom))
+	previousEcosystemBalance, err := s.mintKeeper.PreviousEcosystemBalance.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(500), previousEcosystemBalance)
+
+	// what is left is not split again
+	s.ctx = s.ctx.WithBlockHeight(3)
+	err = mint.BeginBlocker(s.ctx, s.mintKeeper)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(500), s.bankKeeper.GetBalance(s.ctx, ecosystemAddress, sdk.DefaultBondDenom).Amount)
+
+	resp, err := keeper.NewQueryServerImpl(s.mintKeeper).FeeSplit(s.ctx, &types.QueryFeeSplitRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(params.FeeBurnFraction, resp.FeeBurnFraction)
+	s.Require().Equal(params.FeeCommunityPoolFraction, resp.FeeCommunityPoolFraction)
+	s.Require().Equal(cosmosMath.NewInt(200), resp.TotalFeesBurned)
+	s.Require().Equal(cosmosMath.NewInt(300), resp.TotalFeesToCommunityPool)
+}
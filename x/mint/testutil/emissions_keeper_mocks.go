@@ -60,6 +60,19 @@ func (mr *MockEmissionsKeeperMockRecorder) GetParams(ctx interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParams", reflect.TypeOf((*MockEmissionsKeeper)(nil).GetParams), ctx)
 }
 
+func (mr *MockEmissionsKeeperMockRecorder) GetBlocksPerMonth(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlocksPerMonth", reflect.TypeOf((*MockEmissionsKeeper)(nil).GetBlocksPerMonth), ctx)
+}
+
+func (m *MockEmissionsKeeper) GetBlocksPerMonth(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlocksPerMonth", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 func (mr *MockEmissionsKeeperMockRecorder) GetParamsBlocksPerMonth(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParamsBlocksPerMonth", reflect.TypeOf((*MockEmissionsKeeper)(nil).GetParamsBlocksPerMonth), ctx)
@@ -86,14 +99,14 @@ func (m *MockEmissionsKeeper) GetPreviousPercentageRewardToStakedReputers(ctx co
 	return ret0, ret1
 }
 
-func (mr *MockEmissionsKeeperMockRecorder) IsWhitelistAdmin(ctx interface{}, admin interface{}) *gomock.Call {
+func (mr *MockEmissionsKeeperMockRecorder) IsParamsAdmin(ctx interface{}, admin interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWhitelistAdmin", reflect.TypeOf((*MockEmissionsKeeper)(nil).IsWhitelistAdmin), ctx, admin)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsParamsAdmin", reflect.TypeOf((*MockEmissionsKeeper)(nil).IsParamsAdmin), ctx, admin)
 }
 
-func (m *MockEmissionsKeeper) IsWhitelistAdmin(ctx context.Context, admin string) (bool, error) {
+func (m *MockEmissionsKeeper) IsParamsAdmin(ctx context.Context, admin string) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsWhitelistAdmin", ctx, admin)
+	ret := m.ctrl.Call(m, "IsParamsAdmin", ctx, admin)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
@@ -8,4 +8,10 @@ var (
 	ErrInvalidPreviousRewardEmissionPerUnitStakedToken = errors.Register(ModuleName, 3, "invalid previous reward")
 	ErrInvalidEcosystemTokensMinted                    = errors.Register(ModuleName, 4, "invalid ecosystem tokens minted")
 	ErrZeroDenominator                                 = errors.Register(ModuleName, 5, "zero denominator")
+	ErrInvalidEmissionProjection                       = errors.Register(ModuleName, 6, "invalid emission projection")
+	ErrInvalidFeeAccounting                            = errors.Register(ModuleName, 7, "invalid fee accounting")
+	ErrInvalidPreviousBlockEmission                    = errors.Register(ModuleName, 8, "invalid previous block emission")
+	ErrInvalidLastEmissionUpdateHeight                 = errors.Register(ModuleName, 9, "invalid last emission update height")
+	ErrUnknownEmissionModel                            = errors.Register(ModuleName, 10, "unknown emission model")
+	ErrInvalidValidatorsSplitHistory                   = errors.Register(ModuleName, 11, "invalid validators split history")
 )
@@ -1,6 +1,9 @@
 package types
 
-import "cosmossdk.io/math"
+import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+)
 
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(
@@ -8,12 +11,26 @@ func NewGenesisState(
 	previousRewardEmissionPerUnitStakedToken math.LegacyDec,
 	previousBlockEmission math.Int,
 	ecosystemTokensMinted math.Int,
+	latestEmissionInfo *EmissionInfo,
+	totalFeesBurned math.Int,
+	totalFeesToCommunityPool math.Int,
+	previousEcosystemBalance math.Int,
+	lastEmissionUpdateHeight int64,
+	validatorsSplitHistory []ValidatorsSplitRecord,
+	emissionMonthIndex uint64,
 ) *GenesisState {
 	return &GenesisState{
 		Params:                                   params,
 		PreviousRewardEmissionPerUnitStakedToken: previousRewardEmissionPerUnitStakedToken,
 		PreviousBlockEmission:                    previousBlockEmission,
 		EcosystemTokensMinted:                    ecosystemTokensMinted,
+		LatestEmissionInfo:                       latestEmissionInfo,
+		TotalFeesBurned:                          totalFeesBurned,
+		TotalFeesToCommunityPool:                 totalFeesToCommunityPool,
+		PreviousEcosystemBalance:                 previousEcosystemBalance,
+		LastEmissionUpdateHeight:                 lastEmissionUpdateHeight,
+		ValidatorsSplitHistory:                   validatorsSplitHistory,
+		EmissionMonthIndex:                       emissionMonthIndex,
 	}
 }
 
@@ -24,6 +41,9 @@ func DefaultGenesisState() *GenesisState {
 		PreviousRewardEmissionPerUnitStakedToken: DefaultPreviousRewardEmissionPerUnitStakedToken(),
 		PreviousBlockEmission:                    DefaultPreviousBlockEmission(),
 		EcosystemTokensMinted:                    DefaultEcosystemTokensMinted(),
+		TotalFeesBurned:                          math.ZeroInt(),
+		TotalFeesToCommunityPool:                 math.ZeroInt(),
+		PreviousEcosystemBalance:                 math.ZeroInt(),
 	}
 }
 
@@ -34,13 +54,71 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
-	if data.PreviousRewardEmissionPerUnitStakedToken.IsNegative() {
+	if data.PreviousRewardEmissionPerUnitStakedToken.IsNil() ||
+		data.PreviousRewardEmissionPerUnitStakedToken.IsNegative() {
 		return ErrInvalidPreviousRewardEmissionPerUnitStakedToken
 	}
 
-	if data.EcosystemTokensMinted.IsNegative() {
+	if data.PreviousBlockEmission.IsNil() || data.PreviousBlockEmission.IsNegative() {
+		return ErrInvalidPreviousBlockEmission
+	}
+
+	if data.EcosystemTokensMinted.IsNil() || data.EcosystemTokensMinted.IsNegative() {
 		return ErrInvalidEcosystemTokensMinted
 	}
 
+	// the ecosystem treasury can never have been minted more than its share of the max supply
+	ecosystemMintCap := data.Params.GetEcosystemMintSupplyCap()
+	if data.EcosystemTokensMinted.GT(ecosystemMintCap) {
+		return errors.Wrapf(
+			ErrInvalidEcosystemTokensMinted,
+			"ecosystem tokens minted %s exceed the ecosystem mint cap %s",
+			data.EcosystemTokensMinted,
+			ecosystemMintCap,
+		)
+	}
+
+	if data.TotalFeesBurned.IsNil() || data.TotalFeesBurned.IsNegative() ||
+		data.TotalFeesToCommunityPool.IsNil() || data.TotalFeesToCommunityPool.IsNegative() ||
+		data.PreviousEcosystemBalance.IsNil() || data.PreviousEcosystemBalance.IsNegative() {
+		return ErrInvalidFeeAccounting
+	}
+
+	if data.LastEmissionUpdateHeight < 0 {
+		return ErrInvalidLastEmissionUpdateHeight
+	}
+
+	if err := validateValidatorsSplitHistory(data.ValidatorsSplitHistory); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// the recorded splits are ordered by strictly increasing block height,
+// with fractions for the ratio and percent and no negative stake
+func validateValidatorsSplitHistory(history []ValidatorsSplitRecord) error {
+	previousHeight := int64(0)
+	for _, record := range history {
+		if record.BlockHeight <= previousHeight {
+			return errors.Wrapf(
+				ErrInvalidValidatorsSplitHistory,
+				"block height %d is not positive and after the previous record at %d",
+				record.BlockHeight,
+				previousHeight,
+			)
+		}
+		previousHeight = record.BlockHeight
+		if record.CosmosValidatorsStaked.IsNil() || record.CosmosValidatorsStaked.IsNegative() ||
+			record.ReputersStaked.IsNil() || record.ReputersStaked.IsNegative() {
+			return errors.Wrapf(ErrInvalidValidatorsSplitHistory, "negative stake at block height %d", record.BlockHeight)
+		}
+		if err := validateAFractionValue(record.ValidatorsStakeRatio); err != nil {
+			return errors.Wrapf(ErrInvalidValidatorsSplitHistory, "block height %d: %s", record.BlockHeight, err)
+		}
+		if err := validateAFractionValue(record.ValidatorsPercent); err != nil {
+			return errors.Wrapf(ErrInvalidValidatorsSplitHistory, "block height %d: %s", record.BlockHeight, err)
+		}
+	}
 	return nil
 }
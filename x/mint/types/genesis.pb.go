@@ -35,6 +35,20 @@ type GenesisState struct {
 	PreviousBlockEmission                    cosmossdk_io_math.Int       `protobuf:"bytes,3,opt,name=previous_block_emission,json=previousBlockEmission,proto3,customtype=cosmossdk.io/math.Int" json:"previous_block_emission"`
 	// number of tokens minted into the ecosystem treasury
 	EcosystemTokensMinted cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=ecosystem_tokens_minted,json=ecosystemTokensMinted,proto3,customtype=cosmossdk.io/math.Int" json:"ecosystem_tokens_minted"`
+	// intermediate values of the latest monthly update of the emission rate, if any
+	LatestEmissionInfo *EmissionInfo `protobuf:"bytes,5,opt,name=latest_emission_info,json=latestEmissionInfo,proto3" json:"latest_emission_info,omitempty"`
+	// fees paid into the ecosystem treasury that were burned
+	TotalFeesBurned cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=total_fees_burned,json=totalFeesBurned,proto3,customtype=cosmossdk.io/math.Int" json:"total_fees_burned"`
+	// fees paid into the ecosystem treasury that were sent to the community pool
+	TotalFeesToCommunityPool cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=total_fees_to_community_pool,json=totalFeesToCommunityPool,proto3,customtype=cosmossdk.io/math.Int" json:"total_fees_to_community_pool"`
+	// balance of the ecosystem treasury left by the previous block, anything above it is new fees
+	PreviousEcosystemBalance cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=previous_ecosystem_balance,json=previousEcosystemBalance,proto3,customtype=cosmossdk.io/math.Int" json:"previous_ecosystem_balance"`
+	// height of the block that last updated the emission rate, 0 if it was never updated
+	LastEmissionUpdateHeight int64 `protobuf:"varint,9,opt,name=last_emission_update_height,json=lastEmissionUpdateHeight,proto3" json:"last_emission_update_height,omitempty"`
+	// splits chosen by the validators split controller in the latest blocks
+	ValidatorsSplitHistory []ValidatorsSplitRecord `protobuf:"bytes,10,rep,name=validators_split_history,json=validatorsSplitHistory,proto3" json:"validators_split_history"`
+	// month of the schedule of the emission model that the next emission update opens
+	EmissionMonthIndex uint64 `protobuf:"varint,11,opt,name=emission_month_index,json=emissionMonthIndex,proto3" json:"emission_month_index,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,6 +91,34 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetLatestEmissionInfo() *EmissionInfo {
+	if m != nil {
+		return m.LatestEmissionInfo
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastEmissionUpdateHeight() int64 {
+	if m != nil {
+		return m.LastEmissionUpdateHeight
+	}
+	return 0
+}
+
+func (m *GenesisState) GetValidatorsSplitHistory() []ValidatorsSplitRecord {
+	if m != nil {
+		return m.ValidatorsSplitHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEmissionMonthIndex() uint64 {
+	if m != nil {
+		return m.EmissionMonthIndex
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "mint.v1beta1.GenesisState")
 }
@@ -84,34 +126,48 @@ func init() {
 func init() { proto.RegisterFile("mint/v1beta1/genesis.proto", fileDescriptor_1dfa75836a5d5f23) }
 
 var fileDescriptor_1dfa75836a5d5f23 = []byte{
-	// 425 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x31, 0x6b, 0x14, 0x41,
-	0x14, 0xc7, 0x6f, 0x8c, 0x04, 0xb2, 0xa6, 0xd0, 0x23, 0xc1, 0xf5, 0x84, 0x4d, 0xb0, 0x3a, 0x84,
-	0x9b, 0x31, 0xa6, 0xb0, 0xb1, 0x3a, 0x14, 0x09, 0x18, 0x08, 0x89, 0x36, 0x36, 0xc3, 0xdc, 0xde,
-	0x63, 0x6f, 0xd8, 0x9b, 0x79, 0xcb, 0xcc, 0xbb, 0xc4, 0xfb, 0x16, 0x7e, 0x02, 0x6b, 0x4b, 0x8b,
-	0x94, 0x7e, 0x80, 0x94, 0x21, 0x95, 0x58, 0x04, 0xb9, 0x2b, 0xfc, 0x1a, 0xb2, 0x33, 0x73, 0x87,
-	0x62, 0x67, 0xb3, 0xec, 0xcc, 0xff, 0xbd, 0xff, 0xef, 0xff, 0x86, 0x97, 0xf5, 0x8c, 0xb6, 0x24,
-	0xce, 0x0f, 0x46, 0x40, 0xea, 0x40, 0x54, 0x60, 0xc1, 0x6b, 0xcf, 0x1b, 0x87, 0x84, 0xdd, 0xed,
-	0x56, 0xe3, 0x49, 0xeb, 0xed, 0x54, 0x58, 0x61, 0x10, 0x44, 0xfb, 0x17, 0x6b, 0x7a, 0xf9, 0x5f,
-	0xfd, 0x34, 0x6f, 0x20, 0x75, 0xf7, 0x1e, 0x95, 0xe8, 0x0d, 0x7a, 0x19, 0x5b, 0xe2, 0x21, 0x49,
-	0x0f, 0x94, 0xd1, 0x16, 0x45, 0xf8, 0xc6, 0xab, 0x27, 0xdf, 0x36, 0xb2, 0xed, 0x37, 0x91, 0x7e,
-	0x46, 0x8a, 0xa0, 0xfb, 0x22, 0xdb, 0x6c, 0x94, 0x53, 0xc6, 0xe7, 0x6c, 0x9f, 0xf5, 0xef, 0x3d,
-	0xdf, 0xe1, 0x7f, 0xa6, 0xe1, 0x27, 0x41, 0x1b, 0x6e, 0x5d, 0xdd, 0xee, 0x75, 0xbe, 0xfc, 0xfa,
-	0xfa, 0x94, 0x9d, 0xa6, 0xf2, 0xee, 0x67, 0x96, 0xf1, 0xc6, 0xc1, 0xb9, 0xc6, 0x99, 0x97, 0x0e,
-	0x2e, 0x94, 0x1b, 0x4b, 0x30, 0xda, 0x7b, 0x8d, 0x56, 0x36, 0xe0, 0xe4, 0xcc, 0x6a, 0x92, 0x9e,
-	0x54, 0x0d, 0x63, 0x49, 0x58, 0x83, 0xcd, 0xef, 0xec, 0xb3, 0xfe, 0xd6, 0xf0, 0x65, 0xeb, 0xf5,
-	0xe3, 0x76, 0xef, 0x71, 0xcc, 0xea, 0xc7, 0x35, 0xd7, 0x28, 0x8c, 0xa2, 0x09, 0x7f, 0x0b, 0x95,
-	0x2a, 0xe7, 0xaf, 0xa0, 0xbc, 0xb9, 0x1c, 0xdc, 0x4f, 0xa3, 0xac, 0xef, 0x22, 0xbe, 0xbf, 0x62,
-	0x9e, 0x06, 0xe4, 0xeb, 0x44, 0x3c, 0x01, 0xf7, 0xde, 0x6a, 0x3a, 0x0b, 0xb8, 0x77, 0x2d, 0xad,
-	0x3b, 0xc9, 0x1e, 0xae, 0xf3, 0x8d, 0xa6, 0x58, 0xd6, 0xeb, 0x78, 0xf9, 0x46, 0x08, 0xf2, 0x2c,
-	0x05, 0xd9, 0xfd, 0x37, 0xc8, 0x91, 0xa5, 0x9b, 0xcb, 0x41, 0x96, 0x22, 0x1c, 0x59, 0x8a, 0xf0,
-	0xdd, 0x95, 0xe1, 0xb0, 0xf5, 0x5b, 0xb1, 0x5b, 0x12, 0x94, 0xe8, 0xe7, 0x9e, 0xc0, 0xc4, 0x51,
-	0xbd, 0x6c, 0x5f, 0x11, 0xc6, 0xf9, 0xdd, 0xff, 0x25, 0xad, 0x0d, 0xc3, 0x30, 0xfe, 0x38, 0xd8,
-	0x0d, 0x8f, 0xaf, 0x16, 0x05, 0xbb, 0x5e, 0x14, 0xec, 0xe7, 0xa2, 0x60, 0x9f, 0x96, 0x45, 0xe7,
-	0x7a, 0x59, 0x74, 0xbe, 0x2f, 0x8b, 0xce, 0x87, 0xc3, 0x4a, 0xd3, 0x64, 0x36, 0xe2, 0x25, 0x1a,
-	0xa1, 0xa6, 0x53, 0x74, 0x6a, 0x60, 0x81, 0x2e, 0xd0, 0xd5, 0xab, 0x63, 0x39, 0x51, 0xda, 0x8a,
-	0x8f, 0x22, 0x6c, 0x52, 0xd8, 0xa0, 0xd1, 0x66, 0x58, 0x8a, 0xc3, 0xdf, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xeb, 0x0b, 0x9a, 0x69, 0x9e, 0x02, 0x00, 0x00,
+	// 646 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x4f, 0x4f, 0x13, 0x4f,
+	0x18, 0xc7, 0xbb, 0x3f, 0xf8, 0x21, 0x0c, 0x24, 0xca, 0xa6, 0xe8, 0x58, 0x4c, 0x69, 0xf4, 0xd2,
+	0x98, 0xb0, 0xcb, 0x9f, 0x83, 0x17, 0xbd, 0x54, 0x51, 0x9a, 0x40, 0x42, 0x0a, 0x78, 0x30, 0x26,
+	0x93, 0xe9, 0xee, 0x43, 0x77, 0xd2, 0xdd, 0x79, 0x36, 0x3b, 0xd3, 0x42, 0xdf, 0x85, 0xaf, 0xc0,
+	0x9b, 0x89, 0x47, 0x0f, 0xbc, 0x08, 0x8e, 0x84, 0x93, 0xf1, 0x40, 0x0c, 0x1c, 0x7c, 0x1b, 0x66,
+	0x67, 0xff, 0xb4, 0x8d, 0xb7, 0x5e, 0x9a, 0xce, 0x7c, 0x9f, 0xe7, 0xfb, 0xf9, 0xce, 0x3c, 0xed,
+	0x90, 0x5a, 0x24, 0xa4, 0x76, 0x87, 0xdb, 0x5d, 0xd0, 0x7c, 0xdb, 0xed, 0x81, 0x04, 0x25, 0x94,
+	0x13, 0x27, 0xa8, 0xd1, 0x5e, 0x49, 0x35, 0x27, 0xd7, 0x6a, 0xd5, 0x1e, 0xf6, 0xd0, 0x08, 0x6e,
+	0xfa, 0x2d, 0xab, 0xa9, 0xd1, 0xa9, 0x7e, 0x3d, 0x8a, 0x21, 0xef, 0xae, 0x3d, 0xf5, 0x50, 0x45,
+	0xa8, 0x58, 0xd6, 0x92, 0x2d, 0x72, 0x69, 0x95, 0x47, 0x42, 0xa2, 0x6b, 0x3e, 0xb3, 0xad, 0xe7,
+	0xdf, 0x16, 0xc9, 0xca, 0x87, 0x8c, 0x7e, 0xac, 0xb9, 0x06, 0xfb, 0x15, 0x59, 0x88, 0x79, 0xc2,
+	0x23, 0x45, 0xad, 0x86, 0xd5, 0x5c, 0xde, 0xa9, 0x3a, 0x93, 0x69, 0x9c, 0x23, 0xa3, 0xb5, 0x96,
+	0xae, 0x6e, 0x37, 0x2a, 0xdf, 0xff, 0xfc, 0x78, 0x69, 0x75, 0xf2, 0x72, 0xfb, 0xab, 0x45, 0x9c,
+	0x38, 0x81, 0xa1, 0xc0, 0x81, 0x62, 0x09, 0x9c, 0xf3, 0xc4, 0x67, 0x10, 0x09, 0xa5, 0x04, 0x4a,
+	0x16, 0x43, 0xc2, 0x06, 0x52, 0x68, 0xa6, 0x34, 0xef, 0x83, 0xcf, 0x34, 0xf6, 0x41, 0xd2, 0xff,
+	0x1a, 0x56, 0x73, 0xa9, 0xf5, 0x3a, 0xf5, 0xfa, 0x75, 0xbb, 0xb1, 0x9e, 0x65, 0x55, 0x7e, 0xdf,
+	0x11, 0xe8, 0x46, 0x5c, 0x07, 0xce, 0x01, 0xf4, 0xb8, 0x37, 0x7a, 0x07, 0xde, 0xcd, 0xe5, 0xe6,
+	0xa3, 0xfc, 0x28, 0xe5, 0x5e, 0x86, 0x6f, 0x16, 0xcc, 0x8e, 0x41, 0xee, 0xe5, 0xc4, 0x23, 0x48,
+	0x4e, 0xa5, 0xd0, 0xc7, 0x06, 0x77, 0x92, 0xd2, 0xec, 0x80, 0x3c, 0x29, 0xf3, 0x75, 0x43, 0xf4,
+	0xfa, 0x65, 0x3c, 0x3a, 0x67, 0x82, 0x6c, 0xe5, 0x41, 0xd6, 0xfe, 0x0d, 0xd2, 0x96, 0xfa, 0xe6,
+	0x72, 0x93, 0xe4, 0x11, 0xda, 0x52, 0x67, 0xf0, 0xb5, 0xc2, 0xb0, 0x95, 0xfa, 0x15, 0xec, 0x94,
+	0x04, 0x1e, 0xaa, 0x91, 0xd2, 0x10, 0x65, 0x47, 0x55, 0x2c, 0xbd, 0x45, 0xf0, 0xe9, 0xfc, 0xac,
+	0xa4, 0xd2, 0xd0, 0x1c, 0x46, 0x1d, 0x1a, 0x3b, 0xfb, 0x80, 0x54, 0x43, 0xae, 0x41, 0xe9, 0xf1,
+	0x55, 0x0b, 0x79, 0x86, 0xf4, 0x7f, 0x33, 0xbb, 0xda, 0xf4, 0xec, 0x8a, 0x7c, 0x6d, 0x79, 0x86,
+	0x1d, 0x3b, 0xeb, 0x9b, 0xdc, 0xb3, 0x3f, 0x93, 0x55, 0x8d, 0x9a, 0x87, 0xec, 0x0c, 0x40, 0xb1,
+	0xee, 0x20, 0x91, 0xe0, 0xd3, 0x85, 0x19, 0x13, 0x3f, 0x34, 0x56, 0xef, 0x01, 0x54, 0xcb, 0x18,
+	0xd9, 0x31, 0x79, 0x36, 0xe1, 0xae, 0x91, 0x79, 0x18, 0x45, 0xe9, 0x8f, 0x62, 0xc4, 0x62, 0xc4,
+	0x90, 0x3e, 0x98, 0x11, 0x44, 0x4b, 0xd0, 0x09, 0xbe, 0x2d, 0x2c, 0x8f, 0x10, 0x43, 0x5b, 0x92,
+	0x5a, 0x39, 0xf1, 0xf1, 0x40, 0xba, 0x3c, 0xe4, 0xd2, 0x03, 0xba, 0x38, 0x2b, 0xaf, 0xf0, 0xdc,
+	0x2b, 0x2c, 0x5b, 0x99, 0xa3, 0xfd, 0x86, 0xac, 0x87, 0x7c, 0x72, 0x16, 0x83, 0xd8, 0xe7, 0x1a,
+	0x58, 0x00, 0xa2, 0x17, 0x68, 0xba, 0xd4, 0xb0, 0x9a, 0x73, 0x1d, 0x1a, 0xf2, 0xf1, 0xb5, 0x9f,
+	0x9a, 0x82, 0x7d, 0xa3, 0xdb, 0x01, 0xa1, 0x43, 0x1e, 0x0a, 0x9f, 0x6b, 0x4c, 0x14, 0x53, 0x71,
+	0x28, 0x34, 0x0b, 0x84, 0xd2, 0x98, 0x8c, 0x28, 0x69, 0xcc, 0x35, 0x97, 0x77, 0x5e, 0x4c, 0x0f,
+	0xf4, 0x63, 0x59, 0x7d, 0x9c, 0x16, 0x77, 0xc0, 0xc3, 0xc4, 0x9f, 0xfc, 0x6f, 0x3e, 0x1e, 0x4e,
+	0x57, 0xec, 0x67, 0x6e, 0xf6, 0x16, 0xa9, 0x96, 0x19, 0x23, 0x94, 0x3a, 0x60, 0x42, 0xfa, 0x70,
+	0x41, 0x97, 0x1b, 0x56, 0x73, 0xbe, 0x63, 0x17, 0xda, 0x61, 0x2a, 0xb5, 0x53, 0xa5, 0x75, 0x78,
+	0x75, 0x57, 0xb7, 0xae, 0xef, 0xea, 0xd6, 0xef, 0xbb, 0xba, 0xf5, 0xe5, 0xbe, 0x5e, 0xb9, 0xbe,
+	0xaf, 0x57, 0x7e, 0xde, 0xd7, 0x2b, 0x9f, 0x76, 0x7b, 0x42, 0x07, 0x83, 0xae, 0xe3, 0x61, 0xe4,
+	0xf2, 0x30, 0xc4, 0x84, 0x6f, 0x4a, 0xd0, 0xe7, 0x98, 0xf4, 0x8b, 0xa5, 0x17, 0x70, 0x21, 0xdd,
+	0x0b, 0xd7, 0x3c, 0x59, 0xe6, 0xa9, 0xea, 0x2e, 0x98, 0xd7, 0x67, 0xf7, 0xef, 0x00, 0xfd, 0xfd,
+	0xbd, 0x55, 0x07, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -134,6 +190,72 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EmissionMonthIndex != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EmissionMonthIndex))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.ValidatorsSplitHistory) > 0 {
+		for iNdEx := len(m.ValidatorsSplitHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorsSplitHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.LastEmissionUpdateHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastEmissionUpdateHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.PreviousEcosystemBalance.Size()
+		i -= size
+		if _, err := m.PreviousEcosystemBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.TotalFeesToCommunityPool.Size()
+		i -= size
+		if _, err := m.TotalFeesToCommunityPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.TotalFeesBurned.Size()
+		i -= size
+		if _, err := m.TotalFeesBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.LatestEmissionInfo != nil {
+		{
+			size, err := m.LatestEmissionInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size := m.EcosystemTokensMinted.Size()
 		i -= size
@@ -202,6 +324,28 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.EcosystemTokensMinted.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.LatestEmissionInfo != nil {
+		l = m.LatestEmissionInfo.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.TotalFeesBurned.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.TotalFeesToCommunityPool.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.PreviousEcosystemBalance.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.LastEmissionUpdateHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.LastEmissionUpdateHeight))
+	}
+	if len(m.ValidatorsSplitHistory) > 0 {
+		for _, e := range m.ValidatorsSplitHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.EmissionMonthIndex != 0 {
+		n += 1 + sovGenesis(uint64(m.EmissionMonthIndex))
+	}
 	return n
 }
 
@@ -375,6 +519,216 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestEmissionInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LatestEmissionInfo == nil {
+				m.LatestEmissionInfo = &EmissionInfo{}
+			}
+			if err := m.LatestEmissionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFeesBurned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalFeesBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFeesToCommunityPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalFeesToCommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousEcosystemBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PreviousEcosystemBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEmissionUpdateHeight", wireType)
+			}
+			m.LastEmissionUpdateHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastEmissionUpdateHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsSplitHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorsSplitHistory = append(m.ValidatorsSplitHistory, ValidatorsSplitRecord{})
+			if err := m.ValidatorsSplitHistory[len(m.ValidatorsSplitHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionMonthIndex", wireType)
+			}
+			m.EmissionMonthIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EmissionMonthIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
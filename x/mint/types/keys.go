@@ -11,6 +11,13 @@ var (
 	PreviousRewardEmissionPerUnitStakedTokenKey = collections.NewPrefix(139)
 	PreviousBlockEmissionKey                    = collections.NewPrefix(140)
 	EcosystemTokensMintedKey                    = collections.NewPrefix(141)
+	LatestEmissionInfoKey                       = collections.NewPrefix(142)
+	TotalFeesBurnedKey                          = collections.NewPrefix(143)
+	TotalFeesToCommunityPoolKey                 = collections.NewPrefix(144)
+	PreviousEcosystemBalanceKey                 = collections.NewPrefix(145)
+	LastEmissionUpdateHeightKey                 = collections.NewPrefix(146)
+	ValidatorsSplitHistoryKey                   = collections.NewPrefix(147)
+	EmissionMonthIndexKey                       = collections.NewPrefix(148)
 )
 
 const (
@@ -26,4 +33,11 @@ const (
 	// It should be synced with the gov module's name if it is ever changed.
 	// See: https://github.com/cosmos/cosmos-sdk/blob/b62a28aac041829da5ded4aeacfcd7a42873d1c8/x/gov/types/keys.go#L9
 	GovModuleName = "gov"
+
+	// number of months projected by the ProjectEmissions query when unset
+	DefaultProjectedEmissionMonths = 12
+	// maximum number of months projected by the ProjectEmissions query
+	MaxProjectedEmissionMonths = 120
+	// maximum number of blocks of validators split history kept by the validators split controller
+	MaxValidatorsSplitHistoryLength = 1_000_000
 )
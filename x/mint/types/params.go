@@ -22,6 +22,19 @@ func NewParams(
 	investorsPercentOfTotalSupply math.LegacyDec,
 	teamPercentOfTotalSupply math.LegacyDec,
 	maxMonthlyPercentageYield math.LegacyDec,
+	vestingTranches []VestingTranche,
+	feeBurnFraction math.LegacyDec,
+	feeCommunityPoolFraction math.LegacyDec,
+	emissionModel EmissionModelType,
+	fixedScheduleMonthlyEmissions []math.Int,
+	halvingInitialMonthlyEmission math.Int,
+	halvingIntervalMonths uint64,
+	validatorsSplitControllerEnabled bool,
+	minValidatorsPercent math.LegacyDec,
+	maxValidatorsPercent math.LegacyDec,
+	targetValidatorsStakeRatio math.LegacyDec,
+	validatorsPercentAdjustmentRate math.LegacyDec,
+	validatorsSplitHistoryLength uint64,
 ) Params {
 	return Params{
 		MintDenom:                              mintDenom,
@@ -34,6 +47,19 @@ func NewParams(
 		InvestorsPercentOfTotalSupply:          investorsPercentOfTotalSupply,
 		TeamPercentOfTotalSupply:               teamPercentOfTotalSupply,
 		MaximumMonthlyPercentageYield:          maxMonthlyPercentageYield,
+		VestingTranches:                        vestingTranches,
+		FeeBurnFraction:                        feeBurnFraction,
+		FeeCommunityPoolFraction:               feeCommunityPoolFraction,
+		EmissionModel:                          emissionModel,
+		FixedScheduleMonthlyEmissions:          fixedScheduleMonthlyEmissions,
+		HalvingInitialMonthlyEmission:          halvingInitialMonthlyEmission,
+		HalvingIntervalMonths:                  halvingIntervalMonths,
+		ValidatorsSplitControllerEnabled:       validatorsSplitControllerEnabled,
+		MinValidatorsPercent:                   minValidatorsPercent,
+		MaxValidatorsPercent:                   maxValidatorsPercent,
+		TargetValidatorsStakeRatio:             targetValidatorsStakeRatio,
+		ValidatorsPercentAdjustmentRate:        validatorsPercentAdjustmentRate,
+		ValidatorsSplitHistoryLength:           validatorsSplitHistoryLength,
 	}
 }
 
@@ -43,6 +69,8 @@ func DefaultParams() Params {
 	if !ok {
 		panic("failed to parse max supply")
 	}
+	investorsPercentOfTotalSupply := math.LegacyMustNewDecFromStr("0.3105") // 31.05%
+	teamPercentOfTotalSupply := math.LegacyMustNewDecFromStr("0.175")       // 17.5%
 	return Params{
 		MintDenom:                              sdk.DefaultBondDenom,
 		MaxSupply:                              maxSupply,                              // 1 billion allo * 1e18 (exponent) = 1e27 uallo
@@ -51,9 +79,48 @@ func DefaultParams() Params {
 		EcosystemTreasuryPercentOfTotalSupply:  math.LegacyMustNewDecFromStr("0.3595"), // 35.95%
 		FoundationTreasuryPercentOfTotalSupply: math.LegacyMustNewDecFromStr("0.1"),    // 10%
 		ParticipantsPercentOfTotalSupply:       math.LegacyMustNewDecFromStr("0.055"),  // 5.5%
-		InvestorsPercentOfTotalSupply:          math.LegacyMustNewDecFromStr("0.3105"), // 31.05%
-		TeamPercentOfTotalSupply:               math.LegacyMustNewDecFromStr("0.175"),  // 17.5%
+		InvestorsPercentOfTotalSupply:          investorsPercentOfTotalSupply,
+		TeamPercentOfTotalSupply:               teamPercentOfTotalSupply,
 		MaximumMonthlyPercentageYield:          math.LegacyMustNewDecFromStr("0.0095"), // .95% per month
+		VestingTranches:                        DefaultVestingTranches(investorsPercentOfTotalSupply, teamPercentOfTotalSupply),
+		FeeBurnFraction:                        math.LegacyZeroDec(), // all fees offset minting
+		FeeCommunityPoolFraction:               math.LegacyZeroDec(),
+		EmissionModel:                          EmissionModelType_EMISSION_MODEL_STAKED_TOKEN_YIELD,
+		FixedScheduleMonthlyEmissions:          []math.Int{},   // only used by the fixed schedule emission model
+		HalvingInitialMonthlyEmission:          math.ZeroInt(), // only used by the halving emission model
+		HalvingIntervalMonths:                  0,
+		ValidatorsSplitControllerEnabled:       false, // the validators_vs_allora_percent_reward emissions param fixes the split
+		MinValidatorsPercent:                   math.LegacyMustNewDecFromStr("0.1"),
+		MaxValidatorsPercent:                   math.LegacyMustNewDecFromStr("0.5"),
+		TargetValidatorsStakeRatio:             math.LegacyMustNewDecFromStr("0.5"),
+		ValidatorsPercentAdjustmentRate:        math.LegacyMustNewDecFromStr("0.00001"),
+		ValidatorsSplitHistoryLength:           1000,
+	}
+}
+
+// investors and team tokens are locked from genesis on a 1 year cliff three year vesting schedule,
+// unlocking monthly
+func DefaultVestingTranches(
+	investorsPercentOfTotalSupply math.LegacyDec,
+	teamPercentOfTotalSupply math.LegacyDec,
+) []VestingTranche {
+	return []VestingTranche{
+		{
+			Name:                 "investors",
+			PercentOfTotalSupply: investorsPercentOfTotalSupply,
+			StartHeight:          0,
+			CliffMonths:          12,
+			DurationMonths:       36,
+			Curve:                VestingCurveType_VESTING_CURVE_MONTHLY_LINEAR,
+		},
+		{
+			Name:                 "team",
+			PercentOfTotalSupply: teamPercentOfTotalSupply,
+			StartHeight:          0,
+			CliffMonths:          12,
+			DurationMonths:       36,
+			Curve:                VestingCurveType_VESTING_CURVE_MONTHLY_LINEAR,
+		},
 	}
 }
 
@@ -72,6 +139,11 @@ func DefaultEcosystemTokensMinted() math.Int {
 	return math.ZeroInt()
 }
 
+// Maximum number of tokens that may ever be minted into the ecosystem treasury
+func (p Params) GetEcosystemMintSupplyCap() math.Int {
+	return p.MaxSupply.ToLegacyDec().Mul(p.EcosystemTreasuryPercentOfTotalSupply).TruncateInt()
+}
+
 // Validate does the sanity check on the params.
 func (p Params) Validate() error {
 	if err := validateMintDenom(p.MintDenom); err != nil {
@@ -113,9 +185,71 @@ func (p Params) Validate() error {
 	if err := validateAFractionValue(p.MaximumMonthlyPercentageYield); err != nil {
 		return err
 	}
+	if err := p.ValidateVestingTranches(); err != nil {
+		return err
+	}
+	if err := p.ValidateFeeFractions(); err != nil {
+		return err
+	}
+	if err := p.ValidateEmissionModel(); err != nil {
+		return err
+	}
+	if err := p.ValidateValidatorsSplitController(); err != nil {
+		return err
+	}
 	return nil
 }
 
+// ValidateVestingTranches validates the vesting tranches against the share of the total supply
+// reserved for the ecosystem treasury. Migrations setting the tranches validate them alone, as
+// the params added by later versions are not set yet.
+func (p Params) ValidateVestingTranches() error {
+	return validateVestingTranches(p.VestingTranches, p.EcosystemTreasuryPercentOfTotalSupply)
+}
+
+// ValidateFeeFractions validates the fractions of the fees paid into the ecosystem treasury
+// that are burned and sent to the community pool, which cannot add up to more than 100 percent
+func (p Params) ValidateFeeFractions() error {
+	if err := validateAFractionValue(p.FeeBurnFraction); err != nil {
+		return err
+	}
+	if err := validateAFractionValue(p.FeeCommunityPoolFraction); err != nil {
+		return err
+	}
+	if p.FeeBurnFraction.Add(p.FeeCommunityPoolFraction).GT(math.LegacyOneDec()) {
+		return fmt.Errorf(
+			"fee burn and community pool fractions add up to more than 100 percent: %s %s",
+			p.FeeBurnFraction,
+			p.FeeCommunityPoolFraction,
+		)
+	}
+	return nil
+}
+
+// ValidateEmissionModel validates the emission model and the monthly emissions of the
+// fixed schedule and halving models
+func (p Params) ValidateEmissionModel() error {
+	return validateEmissionModel(
+		p.EmissionModel,
+		p.FixedScheduleMonthlyEmissions,
+		p.HalvingInitialMonthlyEmission,
+		p.HalvingIntervalMonths,
+	)
+}
+
+// ValidateValidatorsSplitController validates the bounds, target and adjustment rate of the
+// controller of the share of the emission paid to validators
+func (p Params) ValidateValidatorsSplitController() error {
+	return validateValidatorsSplitController(
+		p.ValidatorsSplitControllerEnabled,
+		p.MinValidatorsPercent,
+		p.MaxValidatorsPercent,
+		p.TargetValidatorsStakeRatio,
+		p.ValidatorsPercentAdjustmentRate,
+		p.ValidatorsSplitHistoryLength,
+	)
+}
+
 func validateMintDenom(i interface{}) error {
 	v, ok := i.(string)
 	if !ok {
@@ -192,3 +326,121 @@ func validateTokenSupplyAddsTo100Percent(
 	}
 	return nil
 }
+
+// tranches must have unique names and valid schedules, and cannot lock the tokens
+// reserved for the ecosystem treasury, which are minted over time instead
+func validateVestingTranches(tranches []VestingTranche, ecosystem math.LegacyDec) error {
+	names := make(map[string]struct{}, len(tranches))
+	total := math.LegacyZeroDec()
+	for _, tranche := range tranches {
+		if strings.TrimSpace(tranche.Name) == "" {
+			return errors.New("vesting tranche name cannot be blank")
+		}
+		if _, ok := names[tranche.Name]; ok {
+			return fmt.Errorf("duplicate vesting tranche name: %s", tranche.Name)
+		}
+		names[tranche.Name] = struct{}{}
+		if err := validateAFractionValue(tranche.PercentOfTotalSupply); err != nil {
+			return fmt.Errorf("vesting tranche %s: %w", tranche.Name, err)
+		}
+		if tranche.DurationMonths == 0 {
+			return fmt.Errorf("vesting tranche %s: duration must be positive", tranche.Name)
+		}
+		if tranche.CliffMonths > tranche.DurationMonths {
+			return fmt.Errorf(
+				"vesting tranche %s: cliff of %d months is longer than the duration of %d months",
+				tranche.Name,
+				tranche.CliffMonths,
+				tranche.DurationMonths,
+			)
+		}
+		if _, ok := VestingCurveType_name[int32(tranche.Curve)]; !ok {
+			return fmt.Errorf("vesting tranche %s: unknown curve type %d", tranche.Name, tranche.Curve)
+		}
+		total = total.Add(tranche.PercentOfTotalSupply)
+	}
+	if !ecosystem.IsNil() && total.GT(math.LegacyOneDec().Sub(ecosystem)) {
+		return fmt.Errorf(
+			"vesting tranches lock %s of the total supply, more than the %s not reserved for the ecosystem treasury",
+			total,
+			math.LegacyOneDec().Sub(ecosystem),
+		)
+	}
+	return nil
+}
+
+// the selected emission model must be known and configured. The amounts of every model
+// cannot be negative even when it is not selected, so that it can be switched to safely
+func validateEmissionModel(
+	model EmissionModelType,
+	fixedScheduleMonthlyEmissions []math.Int,
+	halvingInitialMonthlyEmission math.Int,
+	halvingIntervalMonths uint64,
+) error {
+	if _, ok := EmissionModelType_name[int32(model)]; !ok {
+		return fmt.Errorf("unknown emission model %d", model)
+	}
+	for month, emission := range fixedScheduleMonthlyEmissions {
+		if emission.IsNil() || emission.IsNegative() {
+			return fmt.Errorf("fixed schedule emission of month %d must be non-negative: %s", month, emission)
+		}
+	}
+	if !halvingInitialMonthlyEmission.IsNil() && halvingInitialMonthlyEmission.IsNegative() {
+		return fmt.Errorf("halving initial monthly emission must be non-negative: %s", halvingInitialMonthlyEmission)
+	}
+	switch model {
+	case EmissionModelType_EMISSION_MODEL_FIXED_SCHEDULE:
+		if len(fixedScheduleMonthlyEmissions) == 0 {
+			return errors.New("fixed schedule emission model requires at least one monthly emission")
+		}
+	case EmissionModelType_EMISSION_MODEL_HALVING:
+		if halvingInitialMonthlyEmission.IsNil() || !halvingInitialMonthlyEmission.IsPositive() {
+			return fmt.Errorf("halving emission model requires a positive initial monthly emission: %s", halvingInitialMonthlyEmission)
+		}
+		if halvingIntervalMonths == 0 {
+			return errors.New("halving emission model requires a positive halving interval")
+		}
+	}
+	return nil
+}
+
+// the bounds, target and rate of the validators split controller are fractions, with the min
+// not above the max. When enabled, the controller picks up from the split of the previous block
+// so it needs to keep at least one block of history
+func validateValidatorsSplitController(
+	enabled bool,
+	minValidatorsPercent math.LegacyDec,
+	maxValidatorsPercent math.LegacyDec,
+	targetValidatorsStakeRatio math.LegacyDec,
+	validatorsPercentAdjustmentRate math.LegacyDec,
+	historyLength uint64,
+) error {
+	for _, v := range []math.LegacyDec{
+		minValidatorsPercent,
+		maxValidatorsPercent,
+		targetValidatorsStakeRatio,
+		validatorsPercentAdjustmentRate,
+	} {
+		if err := validateAFractionValue(v); err != nil {
+			return err
+		}
+	}
+	if minValidatorsPercent.GT(maxValidatorsPercent) {
+		return fmt.Errorf(
+			"min validators percent must not be greater than max validators percent: %s %s",
+			minValidatorsPercent,
+			maxValidatorsPercent,
+		)
+	}
+	if enabled && historyLength == 0 {
+		return errors.New("validators split controller requires a validators split history of at least one block")
+	}
+	if historyLength > MaxValidatorsSplitHistoryLength {
+		return fmt.Errorf(
+			"validators split history length must not be greater than %d: %d",
+			MaxValidatorsSplitHistoryLength,
+			historyLength,
+		)
+	}
+	return nil
+}
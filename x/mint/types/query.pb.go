@@ -8,6 +8,7 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -192,363 +193,4072 @@ func (m *QueryInflationResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryInflationResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "mint.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "mint.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryInflationRequest)(nil), "mint.v1beta1.QueryInflationRequest")
-	proto.RegisterType((*QueryInflationResponse)(nil), "mint.v1beta1.QueryInflationResponse")
+// QueryVestingTranchesRequest is the request type for the Query/VestingTranches RPC method.
+type QueryVestingTranchesRequest struct {
 }
 
-func init() { proto.RegisterFile("mint/v1beta1/query.proto", fileDescriptor_b0718dda172d2cb4) }
-
-var fileDescriptor_b0718dda172d2cb4 = []byte{
-	// 414 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x3f, 0xcb, 0xd3, 0x40,
-	0x18, 0x4f, 0x04, 0x0b, 0x3d, 0xdf, 0xc5, 0x33, 0xbe, 0x6f, 0x1b, 0x4b, 0x5a, 0xa3, 0x83, 0x08,
-	0xcd, 0xd1, 0x16, 0x74, 0x2f, 0x5d, 0x04, 0x15, 0x2d, 0x4e, 0x2e, 0x72, 0x8d, 0x67, 0x7a, 0x34,
-	0xb9, 0x27, 0xcd, 0x5d, 0xad, 0x5d, 0xfd, 0x04, 0x82, 0x5f, 0xc2, 0xd1, 0xc1, 0x0f, 0xd1, 0xb1,
-	0xe8, 0x22, 0x0e, 0x45, 0x5a, 0xc1, 0xc9, 0xef, 0x20, 0xb9, 0x4b, 0xaa, 0xa9, 0xd2, 0x25, 0xe4,
-	0x9e, 0xdf, 0x8f, 0xdf, 0x9f, 0xbb, 0x07, 0x35, 0x12, 0x2e, 0x14, 0x79, 0xdd, 0x9b, 0x30, 0x45,
-	0x7b, 0x64, 0xbe, 0x60, 0xd9, 0x2a, 0x48, 0x33, 0x50, 0x80, 0xcf, 0x72, 0x24, 0x28, 0x10, 0xd7,
-	0x89, 0x20, 0x02, 0x0d, 0x90, 0xfc, 0xcf, 0x70, 0xdc, 0x56, 0x04, 0x10, 0xc5, 0x8c, 0xd0, 0x94,
-	0x13, 0x2a, 0x04, 0x28, 0xaa, 0x38, 0x08, 0x59, 0xa0, 0x55, 0x6d, 0xb5, 0x4a, 0x59, 0x89, 0x5c,
-	0xa5, 0x09, 0x17, 0x40, 0xf4, 0xb7, 0x18, 0x35, 0x43, 0x90, 0x09, 0xc8, 0x17, 0xc6, 0xc3, 0x1c,
-	0x0c, 0xe4, 0x3b, 0x08, 0x3f, 0xcd, 0x83, 0x3d, 0xa1, 0x19, 0x4d, 0xe4, 0x98, 0xcd, 0x17, 0x4c,
-	0x2a, 0xff, 0x31, 0xba, 0x56, 0x99, 0xca, 0x14, 0x84, 0x64, 0xf8, 0x3e, 0xaa, 0xa5, 0x7a, 0xd2,
-	0xb0, 0x3b, 0xf6, 0x9d, 0x2b, 0x7d, 0x27, 0xf8, 0xbb, 0x47, 0x60, 0xd8, 0xc3, 0xfa, 0x7a, 0xdb,
-	0xb6, 0x3e, 0xfc, 0xfc, 0x78, 0xd7, 0x1e, 0x17, 0x74, 0xff, 0x02, 0x5d, 0xd7, 0x7a, 0x0f, 0xc4,
-	0xab, 0x58, 0xd7, 0x28, 0x8d, 0x04, 0x3a, 0x3f, 0x06, 0x0a, 0xaf, 0x67, 0xa8, 0xce, 0xcb, 0xa1,
-	0xb6, 0x3b, 0x1b, 0xde, 0xcb, 0x85, 0xbf, 0x6d, 0xdb, 0x37, 0x4c, 0x03, 0xf9, 0x72, 0x16, 0x70,
-	0x20, 0x09, 0x55, 0xd3, 0xe0, 0x21, 0x8b, 0x68, 0xb8, 0x1a, 0xb1, 0xf0, 0xf3, 0xa7, 0x2e, 0x2a,
-	0x0a, 0x8e, 0x58, 0x68, 0x52, 0xfc, 0x11, 0xea, 0xff, 0xb2, 0xd1, 0x65, 0x6d, 0x88, 0x67, 0xa8,
-	0x66, 0xf2, 0xe2, 0x4e, 0xb5, 0xc5, 0xbf, 0xd7, 0xe1, 0xde, 0x3c, 0xc1, 0x30, 0x71, 0xfd, 0xd6,
-	0xdb, 0x2f, 0x3f, 0xde, 0x5f, 0x3a, 0xc7, 0x0e, 0xa9, 0x3c, 0x8c, 0xe9, 0x8f, 0x97, 0xa8, 0x7e,
-	0x68, 0x88, 0x6f, 0xfd, 0x47, 0xed, 0xf8, 0x62, 0xdc, 0xdb, 0xa7, 0x49, 0x85, 0x6b, 0x5b, 0xbb,
-	0x36, 0xf1, 0x45, 0xd5, 0xf5, 0xd0, 0x77, 0xf8, 0x68, 0xbd, 0xf3, 0xec, 0xcd, 0xce, 0xb3, 0xbf,
-	0xef, 0x3c, 0xfb, 0xdd, 0xde, 0xb3, 0x36, 0x7b, 0xcf, 0xfa, 0xba, 0xf7, 0xac, 0xe7, 0x83, 0x88,
-	0xab, 0xe9, 0x62, 0x12, 0x84, 0x90, 0x10, 0x1a, 0xc7, 0x90, 0xd1, 0xae, 0x60, 0x6a, 0x09, 0xd9,
-	0xac, 0x3c, 0x86, 0x53, 0xca, 0x05, 0x79, 0x63, 0xa4, 0xf5, 0x86, 0x4d, 0x6a, 0x7a, 0x69, 0x06,
-	0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xc8, 0x9b, 0x57, 0x31, 0xda, 0x02, 0x00, 0x00,
+func (m *QueryVestingTranchesRequest) Reset()         { *m = QueryVestingTranchesRequest{} }
+func (m *QueryVestingTranchesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingTranchesRequest) ProtoMessage()    {}
+func (*QueryVestingTranchesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0718dda172d2cb4, []int{4}
+}
+func (m *QueryVestingTranchesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingTranchesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingTranchesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVestingTranchesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingTranchesRequest.Merge(m, src)
+}
+func (m *QueryVestingTranchesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingTranchesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingTranchesRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryVestingTranchesRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params returns the total set of minting parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Inflation returns the current minting inflation value.
-	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
+// VestingTrancheStatus is the amount of tokens of a vesting tranche that are locked and unlocked.
+type VestingTrancheStatus struct {
+	Tranche  VestingTranche        `protobuf:"bytes,1,opt,name=tranche,proto3" json:"tranche"`
+	Total    cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=total,proto3,customtype=cosmossdk.io/math.Int" json:"total"`
+	Locked   cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=locked,proto3,customtype=cosmossdk.io/math.Int" json:"locked"`
+	Unlocked cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=unlocked,proto3,customtype=cosmossdk.io/math.Int" json:"unlocked"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *VestingTrancheStatus) Reset()         { *m = VestingTrancheStatus{} }
+func (m *VestingTrancheStatus) String() string { return proto.CompactTextString(m) }
+func (*VestingTrancheStatus) ProtoMessage()    {}
+func (*VestingTrancheStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0718dda172d2cb4, []int{5}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *VestingTrancheStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/mint.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *VestingTrancheStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingTrancheStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *VestingTrancheStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingTrancheStatus.Merge(m, src)
+}
+func (m *VestingTrancheStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingTrancheStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingTrancheStatus.DiscardUnknown(m)
 }
 
-func (c *queryClient) Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error) {
-	out := new(QueryInflationResponse)
-	err := c.cc.Invoke(ctx, "/mint.v1beta1.Query/Inflation", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_VestingTrancheStatus proto.InternalMessageInfo
+
+func (m *VestingTrancheStatus) GetTranche() VestingTranche {
+	if m != nil {
+		return m.Tranche
 	}
-	return out, nil
+	return VestingTranche{}
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params returns the total set of minting parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Inflation returns the current minting inflation value.
-	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
+// QueryVestingTranchesResponse is the response type for the Query/VestingTranches RPC method.
+type QueryVestingTranchesResponse struct {
+	BlockHeight int64                  `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Tranches    []VestingTrancheStatus `protobuf:"bytes,2,rep,name=tranches,proto3" json:"tranches"`
+	// sum of the locked amounts of all tranches
+	TotalLocked cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=total_locked,json=totalLocked,proto3,customtype=cosmossdk.io/math.Int" json:"total_locked"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryVestingTranchesResponse) Reset()         { *m = QueryVestingTranchesResponse{} }
+func (m *QueryVestingTranchesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingTranchesResponse) ProtoMessage()    {}
+func (*QueryVestingTranchesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0718dda172d2cb4, []int{6}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryVestingTranchesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Inflation(ctx context.Context, req *QueryInflationRequest) (*QueryInflationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Inflation not implemented")
+func (m *QueryVestingTranchesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingTranchesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryVestingTranchesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingTranchesResponse.Merge(m, src)
+}
+func (m *QueryVestingTranchesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingTranchesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingTranchesResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mint.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryVestingTranchesResponse proto.InternalMessageInfo
+
+func (m *QueryVestingTranchesResponse) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_Inflation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryInflationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Inflation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mint.v1beta1.Query/Inflation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Inflation(ctx, req.(*QueryInflationRequest))
+func (m *QueryVestingTranchesResponse) GetTranches() []VestingTrancheStatus {
+	if m != nil {
+		return m.Tranches
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "mint.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Inflation",
-			Handler:    _Query_Inflation_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "mint/v1beta1/query.proto",
+// QueryEmissionInfoRequest is the request type for the Query/EmissionInfo RPC method.
+type QueryEmissionInfoRequest struct {
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryEmissionInfoRequest) Reset()         { *m = QueryEmissionInfoRequest{} }
+func (m *QueryEmissionInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEmissionInfoRequest) ProtoMessage()    {}
+func (*QueryEmissionInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0718dda172d2cb4, []int{7}
+}
+func (m *QueryEmissionInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEmissionInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEmissionInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryEmissionInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmissionInfoRequest.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryEmissionInfoRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryEmissionInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmissionInfoRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryEmissionInfoRequest proto.InternalMessageInfo
+
+// QueryEmissionInfoResponse is the response type for the Query/EmissionInfo RPC method.
+type QueryEmissionInfoResponse struct {
+	// nil until the emission rate has been updated
+	LatestEmissionInfo *EmissionInfo `protobuf:"bytes,1,opt,name=latest_emission_info,json=latestEmissionInfo,proto3" json:"latest_emission_info,omitempty"`
+	BlockHeight        int64         `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// current balance of the ecosystem treasury
+	EcosystemBalance cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=ecosystem_balance,json=ecosystemBalance,proto3,customtype=cosmossdk.io/math.Int" json:"ecosystem_balance"`
+	// tokens minted into the ecosystem treasury so far
+	EcosystemTokensMinted cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=ecosystem_tokens_minted,json=ecosystemTokensMinted,proto3,customtype=cosmossdk.io/math.Int" json:"ecosystem_tokens_minted"`
+	// maximum number of tokens the ecosystem treasury may mint
+	EcosystemMintCap             cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=ecosystem_mint_cap,json=ecosystemMintCap,proto3,customtype=cosmossdk.io/math.Int" json:"ecosystem_mint_cap"`
+	EcosystemMintSupplyRemaining cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=ecosystem_mint_supply_remaining,json=ecosystemMintSupplyRemaining,proto3,customtype=cosmossdk.io/math.Int" json:"ecosystem_mint_supply_remaining"`
+	// tokens emitted per block until the next update
+	BlockEmission cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=block_emission,json=blockEmission,proto3,customtype=cosmossdk.io/math.Int" json:"block_emission"`
+	// block at which the emission rate is next updated, 0 if it is never updated
+	NextEmissionUpdateHeight int64 `protobuf:"varint,8,opt,name=next_emission_update_height,json=nextEmissionUpdateHeight,proto3" json:"next_emission_update_height,omitempty"`
+	// block at which the emission rate was last updated, 0 if it was never updated
+	LastEmissionUpdateHeight int64 `protobuf:"varint,9,opt,name=last_emission_update_height,json=lastEmissionUpdateHeight,proto3" json:"last_emission_update_height,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryEmissionInfoResponse) Reset()         { *m = QueryEmissionInfoResponse{} }
+func (m *QueryEmissionInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEmissionInfoResponse) ProtoMessage()    {}
+func (*QueryEmissionInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0718dda172d2cb4, []int{8}
+}
+func (m *QueryEmissionInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEmissionInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEmissionInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryEmissionInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmissionInfoResponse.Merge(m, src)
+}
+func (m *QueryEmissionInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEmissionInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmissionInfoResponse.DiscardUnknown(m)
 }
 
-func (m *QueryInflationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEmissionInfoResponse proto.InternalMessageInfo
+
+func (m *QueryEmissionInfoResponse) GetLatestEmissionInfo() *EmissionInfo {
+	if m != nil {
+		return m.LatestEmissionInfo
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryInflationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryEmissionInfoResponse) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
 }
 
-func (m *QueryInflationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryEmissionInfoResponse) GetNextEmissionUpdateHeight() int64 {
+	if m != nil {
+		return m.NextEmissionUpdateHeight
+	}
+	return 0
 }
 
-func (m *QueryInflationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryEmissionInfoResponse) GetLastEmissionUpdateHeight() int64 {
+	if m != nil {
+		return m.LastEmissionUpdateHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryInflationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryProjectEmissionsRequest is the request type for the Query/ProjectEmissions RPC method.
+type QueryProjectEmissionsRequest struct {
+	// number of months to project, 12 if unset
+	Months uint64 `protobuf:"varint,1,opt,name=months,proto3" json:"months,omitempty"`
+	// tokens staked by validators and reputers, the current amount if unset
+	NetworkStaked string `protobuf:"bytes,2,opt,name=network_staked,json=networkStaked,proto3" json:"network_staked,omitempty"`
+	// share of the rewards paid to staked reputers, the previous share if unset
+	ReputersPercent string `protobuf:"bytes,3,opt,name=reputers_percent,json=reputersPercent,proto3" json:"reputers_percent,omitempty"`
 }
 
-func (m *QueryInflationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Inflation.Size()
-		i -= size
-		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryProjectEmissionsRequest) Reset()         { *m = QueryProjectEmissionsRequest{} }
+func (m *QueryProjectEmissionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectEmissionsRequest) ProtoMessage()    {}
+func (*QueryProjectEmissionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0718dda172d2cb4, []int{9}
+}
+func (m *QueryProjectEmissionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectEmissionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectEmissionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryProjectEmissionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectEmissionsRequest.Merge(m, src)
+}
+func (m *QueryProjectEmissionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectEmissionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectEmissionsRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryProjectEmissionsRequest proto.InternalMessageInfo
+
+func (m *QueryProjectEmissionsRequest) GetMonths() uint64 {
+	if m != nil {
+		return m.Months
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryProjectEmissionsRequest) GetNetworkStaked() string {
+	if m != nil {
+		return m.NetworkStaked
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryProjectEmissionsRequest) GetReputersPercent() string {
+	if m != nil {
+		return m.ReputersPercent
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ""
 }
 
-func (m *QueryInflationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// ProjectedEmission is the projected monthly update of the emission rate at the start of a month.
+type ProjectedEmission struct {
+	// months from now, starting at 1
+	Month uint64 `protobuf:"varint,1,opt,name=month,proto3" json:"month,omitempty"`
+	// block at which the emission rate is updated
+	BlockHeight                                  int64                       `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	NetworkStaked                                cosmossdk_io_math.Int       `protobuf:"bytes,3,opt,name=network_staked,json=networkStaked,proto3,customtype=cosmossdk.io/math.Int" json:"network_staked"`
+	TotalSupply                                  cosmossdk_io_math.Int       `protobuf:"bytes,4,opt,name=total_supply,json=totalSupply,proto3,customtype=cosmossdk.io/math.Int" json:"total_supply"`
+	LockedSupply                                 cosmossdk_io_math.Int       `protobuf:"bytes,5,opt,name=locked_supply,json=lockedSupply,proto3,customtype=cosmossdk.io/math.Int" json:"locked_supply"`
+	CirculatingSupply                            cosmossdk_io_math.Int       `protobuf:"bytes,6,opt,name=circulating_supply,json=circulatingSupply,proto3,customtype=cosmossdk.io/math.Int" json:"circulating_supply"`
+	EcosystemMintSupplyRemaining                 cosmossdk_io_math.Int       `protobuf:"bytes,7,opt,name=ecosystem_mint_supply_remaining,json=ecosystemMintSupplyRemaining,proto3,customtype=cosmossdk.io/math.Int" json:"ecosystem_mint_supply_remaining"`
+	TargetRewardEmissionPerUnitStakedToken       cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=target_reward_emission_per_unit_staked_token,json=targetRewardEmissionPerUnitStakedToken,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"target_reward_emission_per_unit_staked_token"`
+	CappedTargetRewardEmissionPerUnitStakedToken cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=capped_target_reward_emission_per_unit_staked_token,json=cappedTargetRewardEmissionPerUnitStakedToken,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"capped_target_reward_emission_per_unit_staked_token"`
+	RewardEmissionPerUnitStakedToken             cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=reward_emission_per_unit_staked_token,json=rewardEmissionPerUnitStakedToken,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"reward_emission_per_unit_staked_token"`
+	EmissionPerMonth                             cosmossdk_io_math.Int       `protobuf:"bytes,11,opt,name=emission_per_month,json=emissionPerMonth,proto3,customtype=cosmossdk.io/math.Int" json:"emission_per_month"`
+	// tokens minted into the ecosystem treasury during the month
+	TokensMinted cosmossdk_io_math.Int `protobuf:"bytes,12,opt,name=tokens_minted,json=tokensMinted,proto3,customtype=cosmossdk.io/math.Int" json:"tokens_minted"`
+	// tokens minted into the ecosystem treasury from now to the end of the month
+	CumulativeTokensMinted cosmossdk_io_math.Int `protobuf:"bytes,13,opt,name=cumulative_tokens_minted,json=cumulativeTokensMinted,proto3,customtype=cosmossdk.io/math.Int" json:"cumulative_tokens_minted"`
+}
+
+func (m *ProjectedEmission) Reset()         { *m = ProjectedEmission{} }
+func (m *ProjectedEmission) String() string { return proto.CompactTextString(m) }
+func (*ProjectedEmission) ProtoMessage()    {}
+func (*ProjectedEmission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0718dda172d2cb4, []int{10}
+}
+func (m *ProjectedEmission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectedEmission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectedEmission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *ProjectedEmission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectedEmission.Merge(m, src)
+}
+func (m *ProjectedEmission) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectedEmission) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectedEmission.DiscardUnknown(m)
 }
 
-func (m *QueryInflationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_ProjectedEmission proto.InternalMessageInfo
+
+func (m *ProjectedEmission) GetMonth() uint64 {
+	if m != nil {
+		return m.Month
 	}
-	var l int
-	_ = l
-	l = m.Inflation.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *ProjectedEmission) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+// QueryProjectEmissionsResponse is the response type for the Query/ProjectEmissions RPC method.
+type QueryProjectEmissionsResponse struct {
+	Months []ProjectedEmission `protobuf:"bytes,1,rep,name=months,proto3" json:"months"`
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+
+func (m *QueryProjectEmissionsResponse) Reset()         { *m = QueryProjectEmissionsResponse{} }
+func (m *QueryProjectEmissionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectEmissionsResponse) ProtoMessage()    {}
+func (*QueryProjectEmissionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0718dda172d2cb4, []int{11}
+}
+func (m *QueryProjectEmissionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectEmissionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectEmissionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectEmissionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectEmissionsResponse.Merge(m, src)
+}
+func (m *QueryProjectEmissionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectEmissionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectEmissionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectEmissionsResponse proto.InternalMessageInfo
+
+func (m *QueryProjectEmissionsResponse) GetMonths() []ProjectedEmission {
+	if m != nil {
+		return m.Months
+	}
+	return nil
+}
+
+// QueryFeeSplitRequest is the request type for the Query/FeeSplit RPC method.
+type QueryFeeSplitRequest struct {
+}
+
+func (m *QueryFeeSplitRequest) Reset()         { *m = QueryFeeSplitRequest{} }
+func (m *QueryFeeSplitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeSplitRequest) ProtoMessage()    {}
+func (*QueryFeeSplitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0718dda172d2cb4, []int{12}
+}
+func (m *QueryFeeSplitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeSplitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeSplitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeSplitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeSplitRequest.Merge(m, src)
+}
+func (m *QueryFeeSplitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeSplitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeSplitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeSplitRequest proto.InternalMessageInfo
+
+// QueryFeeSplitResponse is the response type for the Query/FeeSplit RPC method.
+type QueryFeeSplitResponse struct {
+	FeeBurnFraction          cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=fee_burn_fraction,json=feeBurnFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_burn_fraction"`
+	FeeCommunityPoolFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=fee_community_pool_fraction,json=feeCommunityPoolFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_community_pool_fraction"`
+	TotalFeesBurned          cosmossdk_io_math.Int       `protobuf:"bytes,3,opt,name=total_fees_burned,json=totalFeesBurned,proto3,customtype=cosmossdk.io/math.Int" json:"total_fees_burned"`
+	TotalFeesToCommunityPool cosmossdk_io_math.Int       `protobuf:"bytes,4,opt,name=total_fees_to_community_pool,json=totalFeesToCommunityPool,proto3,customtype=cosmossdk.io/math.Int" json:"total_fees_to_community_pool"`
+}
+
+func (m *QueryFeeSplitResponse) Reset()         { *m = QueryFeeSplitResponse{} }
+func (m *QueryFeeSplitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeSplitResponse) ProtoMessage()    {}
+func (*QueryFeeSplitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0718dda172d2cb4, []int{13}
+}
+func (m *QueryFeeSplitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeSplitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeSplitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeSplitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeSplitResponse.Merge(m, src)
+}
+func (m *QueryFeeSplitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeSplitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeSplitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeSplitResponse proto.InternalMessageInfo
+
+// QueryValidatorsSplitRequest is the request type for the Query/ValidatorsSplit RPC method.
+type QueryValidatorsSplitRequest struct {
+	// pages through the history, oldest first
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorsSplitRequest) Reset()         { *m = QueryValidatorsSplitRequest{} }
+func (m *QueryValidatorsSplitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorsSplitRequest) ProtoMessage()    {}
+func (*QueryValidatorsSplitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0718dda172d2cb4, []int{14}
+}
+func (m *QueryValidatorsSplitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorsSplitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorsSplitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorsSplitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorsSplitRequest.Merge(m, src)
+}
+func (m *QueryValidatorsSplitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorsSplitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorsSplitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorsSplitRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorsSplitRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryValidatorsSplitResponse is the response type for the Query/ValidatorsSplit RPC method.
+type QueryValidatorsSplitResponse struct {
+	ControllerEnabled bool `protobuf:"varint,1,opt,name=controller_enabled,json=controllerEnabled,proto3" json:"controller_enabled,omitempty"`
+	// fraction of the block emission paid to cosmos validators by the latest block
+	ValidatorsPercent cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=validators_percent,json=validatorsPercent,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validators_percent"`
+	// tokens bonded to cosmos validators now
+	CosmosValidatorsStaked cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=cosmos_validators_staked,json=cosmosValidatorsStaked,proto3,customtype=cosmossdk.io/math.Int" json:"cosmos_validators_staked"`
+	// tokens staked by reputers now
+	ReputersStaked cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=reputers_staked,json=reputersStaked,proto3,customtype=cosmossdk.io/math.Int" json:"reputers_staked"`
+	// cosmos validators staked / (cosmos validators staked + reputers staked) now
+	ValidatorsStakeRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=validators_stake_ratio,json=validatorsStakeRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validators_stake_ratio"`
+	// splits chosen by the controller in the latest blocks, oldest first
+	History    []ValidatorsSplitRecord `protobuf:"bytes,6,rep,name=history,proto3" json:"history"`
+	Pagination *query.PageResponse     `protobuf:"bytes,7,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorsSplitResponse) Reset()         { *m = QueryValidatorsSplitResponse{} }
+func (m *QueryValidatorsSplitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorsSplitResponse) ProtoMessage()    {}
+func (*QueryValidatorsSplitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0718dda172d2cb4, []int{15}
+}
+func (m *QueryValidatorsSplitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorsSplitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorsSplitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorsSplitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorsSplitResponse.Merge(m, src)
+}
+func (m *QueryValidatorsSplitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorsSplitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorsSplitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorsSplitResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorsSplitResponse) GetControllerEnabled() bool {
+	if m != nil {
+		return m.ControllerEnabled
+	}
+	return false
+}
+
+func (m *QueryValidatorsSplitResponse) GetHistory() []ValidatorsSplitRecord {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+func (m *QueryValidatorsSplitResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "mint.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "mint.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryInflationRequest)(nil), "mint.v1beta1.QueryInflationRequest")
+	proto.RegisterType((*QueryInflationResponse)(nil), "mint.v1beta1.QueryInflationResponse")
+	proto.RegisterType((*QueryVestingTranchesRequest)(nil), "mint.v1beta1.QueryVestingTranchesRequest")
+	proto.RegisterType((*VestingTrancheStatus)(nil), "mint.v1beta1.VestingTrancheStatus")
+	proto.RegisterType((*QueryVestingTranchesResponse)(nil), "mint.v1beta1.QueryVestingTranchesResponse")
+	proto.RegisterType((*QueryEmissionInfoRequest)(nil), "mint.v1beta1.QueryEmissionInfoRequest")
+	proto.RegisterType((*QueryEmissionInfoResponse)(nil), "mint.v1beta1.QueryEmissionInfoResponse")
+	proto.RegisterType((*QueryProjectEmissionsRequest)(nil), "mint.v1beta1.QueryProjectEmissionsRequest")
+	proto.RegisterType((*ProjectedEmission)(nil), "mint.v1beta1.ProjectedEmission")
+	proto.RegisterType((*QueryProjectEmissionsResponse)(nil), "mint.v1beta1.QueryProjectEmissionsResponse")
+	proto.RegisterType((*QueryFeeSplitRequest)(nil), "mint.v1beta1.QueryFeeSplitRequest")
+	proto.RegisterType((*QueryFeeSplitResponse)(nil), "mint.v1beta1.QueryFeeSplitResponse")
+	proto.RegisterType((*QueryValidatorsSplitRequest)(nil), "mint.v1beta1.QueryValidatorsSplitRequest")
+	proto.RegisterType((*QueryValidatorsSplitResponse)(nil), "mint.v1beta1.QueryValidatorsSplitResponse")
+}
+
+func init() { proto.RegisterFile("mint/v1beta1/query.proto", fileDescriptor_b0718dda172d2cb4) }
+
+var fileDescriptor_b0718dda172d2cb4 = []byte{
+	// 1604 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6f, 0x1b, 0xb7,
+	0x12, 0xb7, 0xec, 0xf8, 0xdf, 0xd8, 0x8e, 0x2d, 0x3e, 0xd9, 0xd9, 0xc8, 0x8e, 0xed, 0xc8, 0x2f,
+	0x8e, 0xe3, 0x97, 0x48, 0xf9, 0x03, 0xbc, 0x77, 0x79, 0x39, 0x54, 0x49, 0xdc, 0x18, 0x70, 0x0a,
+	0x57, 0xb6, 0x1b, 0xb4, 0x68, 0xbb, 0xa0, 0x56, 0x94, 0xb4, 0xf1, 0x8a, 0xdc, 0x2c, 0x29, 0x3b,
+	0x46, 0xd0, 0x4b, 0xd1, 0x02, 0xb9, 0x14, 0x08, 0xd0, 0x5b, 0x81, 0x1e, 0x8b, 0xf6, 0x98, 0x43,
+	0x2f, 0xfd, 0x06, 0x39, 0x15, 0x41, 0x7b, 0x29, 0x7a, 0x08, 0xda, 0xa4, 0x40, 0xbf, 0x46, 0xb1,
+	0x24, 0x77, 0xb5, 0xbb, 0x56, 0x6c, 0x55, 0x72, 0x2f, 0x82, 0x96, 0x1c, 0xfe, 0x7e, 0x33, 0xc3,
+	0xe1, 0x0c, 0x87, 0x60, 0x34, 0x6c, 0x2a, 0x0a, 0x7b, 0xd7, 0xca, 0x44, 0xe0, 0x6b, 0x85, 0x87,
+	0x4d, 0xe2, 0x1d, 0xe4, 0x5d, 0x8f, 0x09, 0x86, 0xc6, 0xfd, 0x99, 0xbc, 0x9e, 0xc9, 0x66, 0x6a,
+	0xac, 0xc6, 0xe4, 0x44, 0xc1, 0xff, 0xa7, 0x64, 0xb2, 0x73, 0x35, 0xc6, 0x6a, 0x0e, 0x29, 0x60,
+	0xd7, 0x2e, 0x60, 0x4a, 0x99, 0xc0, 0xc2, 0x66, 0x94, 0xeb, 0xd9, 0x38, 0xb6, 0x38, 0x70, 0x49,
+	0x30, 0x93, 0xc6, 0x0d, 0x9b, 0xb2, 0x82, 0xfc, 0xd5, 0x43, 0x67, 0x2d, 0xc6, 0x1b, 0x8c, 0x9b,
+	0x8a, 0x43, 0x7d, 0xe8, 0xa9, 0x55, 0xf5, 0x55, 0x28, 0x63, 0x4e, 0x94, 0x8a, 0x21, 0xa8, 0x8b,
+	0x6b, 0x36, 0x95, 0xa4, 0x4a, 0x36, 0x97, 0x01, 0xf4, 0xae, 0x2f, 0xb1, 0x89, 0x3d, 0xdc, 0xe0,
+	0x25, 0xf2, 0xb0, 0x49, 0xb8, 0xc8, 0xbd, 0x03, 0xff, 0x8a, 0x8d, 0x72, 0x97, 0x51, 0x4e, 0xd0,
+	0xff, 0x60, 0xc8, 0x95, 0x23, 0x46, 0x6a, 0x31, 0xb5, 0x32, 0x76, 0x3d, 0x93, 0x8f, 0xda, 0x9c,
+	0x57, 0xd2, 0xc5, 0xd1, 0xe7, 0x2f, 0x17, 0xfa, 0xbe, 0xfb, 0xf3, 0xd9, 0x6a, 0xaa, 0xa4, 0xc5,
+	0x73, 0x67, 0x60, 0x5a, 0xe2, 0xad, 0xd3, 0xaa, 0x23, 0xd9, 0x03, 0x22, 0x0a, 0x33, 0xc9, 0x09,
+	0xcd, 0xb5, 0x0d, 0xa3, 0x76, 0x30, 0x28, 0xe9, 0xc6, 0x8b, 0xff, 0xf5, 0x81, 0x7f, 0x7d, 0xb9,
+	0x30, 0xab, 0xec, 0xe3, 0x95, 0xdd, 0xbc, 0xcd, 0x0a, 0x0d, 0x2c, 0xea, 0xf9, 0x0d, 0x52, 0xc3,
+	0xd6, 0xc1, 0x6d, 0x62, 0xfd, 0xf4, 0xfd, 0x15, 0xd0, 0xce, 0xb8, 0x4d, 0x2c, 0xa5, 0x45, 0x0b,
+	0x28, 0x77, 0x0e, 0x66, 0x25, 0xdf, 0x7b, 0x84, 0x0b, 0x9b, 0xd6, 0xb6, 0x3d, 0x4c, 0xad, 0x3a,
+	0x09, 0xed, 0xfe, 0xa1, 0x1f, 0x32, 0xf1, 0xa9, 0x2d, 0x81, 0x45, 0x93, 0xa3, 0xb7, 0x60, 0x58,
+	0xa8, 0x01, 0x6d, 0xfa, 0x5c, 0xdc, 0xf4, 0xf8, 0xa2, 0xa8, 0x0b, 0x82, 0x75, 0x68, 0x0d, 0x06,
+	0x05, 0x13, 0xd8, 0x31, 0xfa, 0x17, 0x53, 0x2b, 0xa3, 0xc5, 0xab, 0xda, 0x98, 0xe9, 0xc3, 0xc6,
+	0xac, 0x53, 0x11, 0x31, 0x63, 0x9d, 0x0a, 0x85, 0xa4, 0x96, 0xa3, 0xbb, 0x30, 0xe4, 0x30, 0x6b,
+	0x97, 0x54, 0x8c, 0x81, 0x2e, 0x81, 0xf4, 0x7a, 0xb4, 0x01, 0x23, 0x4d, 0xaa, 0xb1, 0x4e, 0x75,
+	0x89, 0x15, 0x22, 0xe4, 0x7e, 0x4f, 0xc1, 0x5c, 0x7b, 0xdf, 0xea, 0x1d, 0x3d, 0x0f, 0xe3, 0x65,
+	0x5f, 0xd6, 0xac, 0x13, 0xbb, 0x56, 0x17, 0xd2, 0x91, 0x03, 0xa5, 0x31, 0x39, 0x76, 0x57, 0x0e,
+	0xa1, 0x75, 0x18, 0xd1, 0xee, 0xe2, 0x46, 0xff, 0xe2, 0xc0, 0xca, 0xd8, 0xf5, 0xdc, 0x51, 0x7e,
+	0x56, 0x9b, 0x13, 0xf5, 0x76, 0xb8, 0x1c, 0x6d, 0xc1, 0xb8, 0xf4, 0x97, 0xd9, 0xa3, 0xb3, 0xc6,
+	0x24, 0xca, 0x86, 0xb2, 0x31, 0x0b, 0x86, 0x34, 0xf1, 0x4e, 0xc3, 0xe6, 0xdc, 0x66, 0x74, 0x9d,
+	0x56, 0x59, 0x10, 0x3b, 0x9f, 0x0f, 0xc1, 0xd9, 0x36, 0x93, 0xda, 0xf8, 0x0d, 0xc8, 0x38, 0x58,
+	0x10, 0x2e, 0x4c, 0xa2, 0xa7, 0x4d, 0x9b, 0x56, 0x99, 0x8e, 0xa6, 0x6c, 0xdc, 0xca, 0x18, 0x02,
+	0x52, 0xeb, 0xa2, 0x63, 0x87, 0x5c, 0xd9, 0x7f, 0xd8, 0x95, 0x1f, 0x41, 0x9a, 0x58, 0x8c, 0x1f,
+	0x70, 0x41, 0x1a, 0x66, 0x19, 0x3b, 0x98, 0x5a, 0xa4, 0x6b, 0x27, 0x4c, 0x85, 0x50, 0x45, 0x85,
+	0x84, 0xea, 0x70, 0xa6, 0x05, 0x2f, 0xd8, 0x2e, 0xa1, 0xdc, 0xf4, 0x6d, 0xe8, 0x21, 0x94, 0xa6,
+	0x43, 0xc0, 0x6d, 0x89, 0x77, 0x4f, 0xc2, 0xa1, 0x8f, 0x01, 0xb5, 0x98, 0x7c, 0x0a, 0xd3, 0xc2,
+	0xae, 0x31, 0xd8, 0xb3, 0x25, 0x3e, 0xfc, 0x2d, 0xec, 0xa2, 0x7d, 0x58, 0x48, 0xe0, 0xf3, 0xa6,
+	0xeb, 0x3a, 0x07, 0xa6, 0x47, 0x1a, 0xd8, 0xa6, 0x36, 0xad, 0x19, 0x43, 0x5d, 0x92, 0xcd, 0xc5,
+	0xc8, 0xb6, 0x24, 0x6c, 0x29, 0x40, 0x45, 0xf7, 0xe1, 0xb4, 0xda, 0xc4, 0x20, 0x22, 0x8c, 0xe1,
+	0x2e, 0x79, 0x26, 0x24, 0x4e, 0x10, 0x21, 0xe8, 0x26, 0xcc, 0x52, 0xf2, 0x28, 0x12, 0x69, 0x4d,
+	0xb7, 0x82, 0x05, 0x09, 0x82, 0x65, 0x44, 0x06, 0x8b, 0xe1, 0x8b, 0x04, 0x4b, 0x76, 0xa4, 0x80,
+	0x8e, 0x9c, 0x9b, 0x30, 0xeb, 0x60, 0xfe, 0xc6, 0xe5, 0xa3, 0x6a, 0xb9, 0x2f, 0xd2, 0x6e, 0x79,
+	0xee, 0x49, 0x90, 0x07, 0x36, 0x3d, 0xf6, 0x80, 0x58, 0xa1, 0x50, 0x90, 0x64, 0xd1, 0x0c, 0x0c,
+	0x35, 0x18, 0x15, 0x75, 0x55, 0x45, 0x4e, 0x95, 0xf4, 0x17, 0xba, 0x00, 0xa7, 0x29, 0x11, 0xfb,
+	0xcc, 0xdb, 0x35, 0xb9, 0xc0, 0xfe, 0x99, 0x95, 0x99, 0xb2, 0x34, 0xa1, 0x47, 0xb7, 0xe4, 0x20,
+	0xba, 0x04, 0x53, 0x1e, 0x71, 0x9b, 0x82, 0x78, 0xdc, 0x74, 0x89, 0x67, 0x11, 0x2a, 0x54, 0x5c,
+	0x97, 0x26, 0x83, 0xf1, 0x4d, 0x35, 0x9c, 0xfb, 0x06, 0x20, 0xad, 0xb5, 0x20, 0x95, 0xd0, 0x3d,
+	0x19, 0x18, 0x94, 0x8c, 0x9a, 0x5e, 0x7d, 0x74, 0x72, 0xa4, 0xee, 0x1f, 0x52, 0xb0, 0xdb, 0xf3,
+	0x94, 0x30, 0x29, 0xcc, 0x55, 0x2a, 0xf2, 0x8c, 0x53, 0x3d, 0xe5, 0x2a, 0x15, 0x67, 0x68, 0x07,
+	0x26, 0x54, 0xea, 0x0b, 0x50, 0xbb, 0x3d, 0x32, 0xe3, 0x0a, 0x46, 0xc3, 0x9a, 0x80, 0x2c, 0xdb,
+	0xb3, 0x9a, 0x7e, 0x41, 0xa5, 0xb5, 0x00, 0xbb, 0xdb, 0x13, 0x92, 0x8e, 0x60, 0x69, 0x82, 0x0e,
+	0xce, 0xe3, 0xf0, 0x3f, 0x72, 0x1e, 0xbf, 0x4a, 0xc1, 0x65, 0x81, 0xbd, 0x1a, 0x11, 0xa6, 0x47,
+	0xf6, 0xb1, 0x57, 0x69, 0x9d, 0x00, 0x97, 0x78, 0x66, 0x93, 0xda, 0x42, 0xef, 0xbb, 0x4a, 0x78,
+	0xf2, 0x20, 0x8d, 0x16, 0xff, 0xdf, 0xd9, 0xad, 0x64, 0x4a, 0x2b, 0x13, 0x8e, 0x29, 0x95, 0x96,
+	0x15, 0x63, 0x49, 0x12, 0x06, 0x21, 0xba, 0x49, 0xbc, 0x1d, 0x6a, 0x0b, 0x15, 0x1d, 0x32, 0x19,
+	0xa2, 0x6f, 0x53, 0x70, 0xc3, 0xc2, 0xae, 0xeb, 0x93, 0xff, 0x1d, 0x1d, 0x47, 0x4f, 0x40, 0xc7,
+	0xcb, 0x8a, 0x78, 0xbb, 0x33, 0x4d, 0xbf, 0x48, 0xc1, 0x85, 0xce, 0x74, 0x83, 0x13, 0xd0, 0x6d,
+	0xd1, 0x3b, 0x4e, 0x1f, 0xbf, 0x7e, 0x44, 0xf5, 0x50, 0x67, 0x7f, 0xac, 0xeb, 0xfa, 0xd1, 0x62,
+	0xba, 0x27, 0x13, 0xc7, 0x0e, 0x4c, 0xc4, 0xeb, 0xdf, 0x78, 0xb7, 0xe7, 0x4c, 0x44, 0xcb, 0xde,
+	0x03, 0x30, 0xac, 0x66, 0x43, 0x1e, 0x8d, 0x3d, 0x92, 0xa8, 0xb0, 0x13, 0x5d, 0x32, 0xcc, 0xb4,
+	0x10, 0xa3, 0x25, 0x36, 0x67, 0xc1, 0xb9, 0x37, 0x64, 0x6c, 0x7d, 0x7b, 0x29, 0x46, 0x52, 0xb6,
+	0x7f, 0x2b, 0x5b, 0x48, 0x5c, 0xfc, 0x93, 0x39, 0x36, 0xd6, 0x03, 0xa8, 0x95, 0xb9, 0x19, 0xc8,
+	0x48, 0x92, 0x35, 0x42, 0xb6, 0x5c, 0xc7, 0x16, 0xc1, 0xbd, 0xe9, 0xc7, 0x01, 0x98, 0x4e, 0x4c,
+	0x68, 0xd6, 0x3a, 0xa4, 0xab, 0x84, 0x98, 0xe5, 0xa6, 0x47, 0xcd, 0xaa, 0x87, 0xad, 0xb0, 0x15,
+	0xe8, 0x35, 0x68, 0x26, 0xab, 0x84, 0x14, 0x9b, 0x1e, 0x5d, 0xd3, 0xa0, 0xe8, 0x31, 0xcc, 0xfa,
+	0x4c, 0x16, 0x6b, 0x34, 0xfc, 0x30, 0x3d, 0x30, 0x5d, 0xc6, 0x9c, 0x16, 0x67, 0xff, 0x09, 0x70,
+	0x1a, 0x55, 0x42, 0x6e, 0x05, 0xf8, 0x9b, 0x8c, 0x39, 0x21, 0xf9, 0x87, 0x90, 0x56, 0xd9, 0xbf,
+	0x4a, 0x08, 0x97, 0xd6, 0xf6, 0x50, 0x59, 0x26, 0x25, 0xd4, 0x1a, 0x21, 0xbc, 0x28, 0x81, 0x90,
+	0x0b, 0x73, 0x11, 0x74, 0xc1, 0x12, 0x46, 0x76, 0x5d, 0x6b, 0x8c, 0x90, 0x68, 0x9b, 0xc5, 0xec,
+	0xca, 0x91, 0xa0, 0xc7, 0xc2, 0x8e, 0x5d, 0xc1, 0x82, 0x79, 0x3c, 0xba, 0xdf, 0x68, 0x0d, 0xa0,
+	0xd5, 0x85, 0xea, 0xfb, 0xef, 0x72, 0x5e, 0xc3, 0xfb, 0x2d, 0x6b, 0x5e, 0x75, 0xd5, 0xad, 0xae,
+	0xb2, 0x46, 0xf4, 0xda, 0x52, 0x64, 0x65, 0xee, 0xc9, 0x20, 0xcc, 0xb5, 0xe7, 0xd1, 0xe1, 0x73,
+	0x05, 0x90, 0xc5, 0xa8, 0xf0, 0x98, 0xe3, 0x10, 0xcf, 0x24, 0x14, 0x97, 0x1d, 0x52, 0x91, 0x84,
+	0x23, 0xa5, 0x74, 0x6b, 0xe6, 0x8e, 0x9a, 0x40, 0xbb, 0x80, 0xf6, 0x42, 0xa4, 0xf0, 0x66, 0x71,
+	0x12, 0x5b, 0x9f, 0x6e, 0xe1, 0xea, 0x9b, 0x89, 0x3c, 0xdd, 0x52, 0xd6, 0x8c, 0x70, 0xf6, 0x78,
+	0xa9, 0x98, 0x51, 0x03, 0x11, 0x77, 0xa8, 0xdb, 0xc5, 0xfb, 0x10, 0x5e, 0x8c, 0x02, 0x8a, 0x6e,
+	0x37, 0xfd, 0x74, 0x00, 0xa4, 0xa1, 0x3d, 0x98, 0x49, 0xea, 0x6f, 0x7a, 0xfe, 0xf6, 0x18, 0x83,
+	0x27, 0xe0, 0xb7, 0xcc, 0x5e, 0xdc, 0x94, 0x92, 0x8f, 0x8c, 0xee, 0xc2, 0x70, 0xdd, 0xe6, 0x82,
+	0x79, 0xfe, 0xad, 0xc3, 0x4f, 0x46, 0x4b, 0x89, 0x16, 0x31, 0x19, 0x0e, 0x16, 0xf3, 0x2a, 0xb1,
+	0x8e, 0x5c, 0x2f, 0x47, 0x6f, 0xc7, 0x22, 0x71, 0x58, 0x46, 0xe2, 0xc5, 0x63, 0x23, 0x51, 0x45,
+	0x57, 0x34, 0x14, 0xaf, 0x3f, 0x1b, 0x86, 0x41, 0x19, 0x8a, 0x68, 0x17, 0x86, 0xd4, 0x2b, 0x08,
+	0x5a, 0x8c, 0x6b, 0x75, 0xf8, 0x91, 0x25, 0x7b, 0xfe, 0x08, 0x09, 0x45, 0x92, 0x9b, 0xfb, 0xf4,
+	0xe7, 0x3f, 0xbe, 0xec, 0x9f, 0x41, 0x99, 0x42, 0xec, 0x69, 0x48, 0xbd, 0xaa, 0xa0, 0x7d, 0x18,
+	0x0d, 0xdf, 0x4d, 0xd0, 0x52, 0x1b, 0xb4, 0xe4, 0x73, 0x4b, 0xf6, 0xdf, 0x47, 0x0b, 0x69, 0xd6,
+	0x05, 0xc9, 0x7a, 0x16, 0x9d, 0x89, 0xb3, 0x86, 0xaf, 0x28, 0xe8, 0x69, 0x0a, 0x26, 0x13, 0x5d,
+	0x3e, 0xba, 0xd4, 0x06, 0xba, 0xfd, 0x2b, 0x4b, 0x76, 0xb5, 0x13, 0x51, 0xad, 0xcb, 0xb2, 0xd4,
+	0x65, 0x11, 0xcd, 0xc7, 0x75, 0xd9, 0x53, 0xe2, 0x66, 0xd8, 0xee, 0x7f, 0x96, 0x82, 0xf1, 0x58,
+	0x8b, 0xbc, 0xdc, 0x86, 0xa4, 0x4d, 0xdb, 0x9e, 0xbd, 0x78, 0xac, 0x9c, 0xd6, 0x64, 0x49, 0x6a,
+	0x72, 0x0e, 0xcd, 0xc6, 0x35, 0x89, 0xb5, 0xf3, 0xe8, 0xeb, 0x14, 0x4c, 0x25, 0xab, 0x28, 0x6a,
+	0x67, 0xef, 0x1b, 0x9a, 0xa3, 0xec, 0x7f, 0x3a, 0x92, 0xd5, 0x2a, 0x5d, 0x95, 0x2a, 0xad, 0xa2,
+	0x95, 0x44, 0x78, 0x28, 0xf9, 0xf0, 0xfa, 0xc5, 0x0b, 0x8f, 0x55, 0x0d, 0xfe, 0x04, 0x09, 0x18,
+	0x09, 0xca, 0x2c, 0xca, 0xb5, 0xa1, 0x4a, 0x14, 0xe7, 0xec, 0xd2, 0x91, 0x32, 0x47, 0xc7, 0x8b,
+	0x5f, 0x51, 0xb9, 0x64, 0x92, 0xf1, 0x12, 0x3f, 0x96, 0xed, 0xe3, 0xa5, 0x6d, 0xc5, 0xc8, 0xae,
+	0x76, 0x22, 0x7a, 0x4c, 0xbc, 0x44, 0xb2, 0x94, 0x2f, 0x5f, 0xbc, 0xf7, 0xfc, 0xd5, 0x7c, 0xea,
+	0xc5, 0xab, 0xf9, 0xd4, 0x6f, 0xaf, 0xe6, 0x53, 0x4f, 0x5f, 0xcf, 0xf7, 0xbd, 0x78, 0x3d, 0xdf,
+	0xf7, 0xcb, 0xeb, 0xf9, 0xbe, 0x0f, 0x6e, 0xd4, 0x6c, 0x51, 0x6f, 0x96, 0xf3, 0x16, 0x6b, 0x14,
+	0xb0, 0xe3, 0x30, 0x0f, 0x5f, 0xd1, 0xdd, 0x5a, 0xf0, 0x69, 0xd5, 0xb1, 0x4d, 0x0b, 0x8f, 0x14,
+	0x83, 0x7c, 0xa6, 0x2d, 0x0f, 0xc9, 0xd7, 0xd4, 0x1b, 0x7f, 0x0d, 0x00, 0xc9, 0x4b, 0x13, 0x36,
+	0x1f, 0x16, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns the total set of minting parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Inflation returns the current minting inflation value.
+	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
+	// VestingTranches returns the locked and unlocked amounts of every vesting tranche at the current block.
+	VestingTranches(ctx context.Context, in *QueryVestingTranchesRequest, opts ...grpc.CallOption) (*QueryVestingTranchesResponse, error)
+	// EmissionInfo returns the intermediate values of the latest monthly update of the emission rate
+	// along with the current state of the ecosystem treasury.
+	EmissionInfo(ctx context.Context, in *QueryEmissionInfoRequest, opts ...grpc.CallOption) (*QueryEmissionInfoResponse, error)
+	// ProjectEmissions projects the monthly emission rate updates of the coming months under the current params.
+	ProjectEmissions(ctx context.Context, in *QueryProjectEmissionsRequest, opts ...grpc.CallOption) (*QueryProjectEmissionsResponse, error)
+	// FeeSplit returns the fractions of the fees paid into the ecosystem treasury that are burned and sent to
+	// the community pool, and the totals burned and sent so far.
+	FeeSplit(ctx context.Context, in *QueryFeeSplitRequest, opts ...grpc.CallOption) (*QueryFeeSplitResponse, error)
+	// ValidatorsSplit returns the fraction of the block emission paid to cosmos validators, the stake it is
+	// chosen from when the validators split controller is enabled, and a page of the splits of the latest blocks.
+	ValidatorsSplit(ctx context.Context, in *QueryValidatorsSplitRequest, opts ...grpc.CallOption) (*QueryValidatorsSplitResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/mint.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error) {
+	out := new(QueryInflationResponse)
+	err := c.cc.Invoke(ctx, "/mint.v1beta1.Query/Inflation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VestingTranches(ctx context.Context, in *QueryVestingTranchesRequest, opts ...grpc.CallOption) (*QueryVestingTranchesResponse, error) {
+	out := new(QueryVestingTranchesResponse)
+	err := c.cc.Invoke(ctx, "/mint.v1beta1.Query/VestingTranches", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EmissionInfo(ctx context.Context, in *QueryEmissionInfoRequest, opts ...grpc.CallOption) (*QueryEmissionInfoResponse, error) {
+	out := new(QueryEmissionInfoResponse)
+	err := c.cc.Invoke(ctx, "/mint.v1beta1.Query/EmissionInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProjectEmissions(ctx context.Context, in *QueryProjectEmissionsRequest, opts ...grpc.CallOption) (*QueryProjectEmissionsResponse, error) {
+	out := new(QueryProjectEmissionsResponse)
+	err := c.cc.Invoke(ctx, "/mint.v1beta1.Query/ProjectEmissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeSplit(ctx context.Context, in *QueryFeeSplitRequest, opts ...grpc.CallOption) (*QueryFeeSplitResponse, error) {
+	out := new(QueryFeeSplitResponse)
+	err := c.cc.Invoke(ctx, "/mint.v1beta1.Query/FeeSplit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorsSplit(ctx context.Context, in *QueryValidatorsSplitRequest, opts ...grpc.CallOption) (*QueryValidatorsSplitResponse, error) {
+	out := new(QueryValidatorsSplitResponse)
+	err := c.cc.Invoke(ctx, "/mint.v1beta1.Query/ValidatorsSplit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns the total set of minting parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Inflation returns the current minting inflation value.
+	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
+	// VestingTranches returns the locked and unlocked amounts of every vesting tranche at the current block.
+	VestingTranches(context.Context, *QueryVestingTranchesRequest) (*QueryVestingTranchesResponse, error)
+	// EmissionInfo returns the intermediate values of the latest monthly update of the emission rate
+	// along with the current state of the ecosystem treasury.
+	EmissionInfo(context.Context, *QueryEmissionInfoRequest) (*QueryEmissionInfoResponse, error)
+	// ProjectEmissions projects the monthly emission rate updates of the coming months under the current params.
+	ProjectEmissions(context.Context, *QueryProjectEmissionsRequest) (*QueryProjectEmissionsResponse, error)
+	// FeeSplit returns the fractions of the fees paid into the ecosystem treasury that are burned and sent to
+	// the community pool, and the totals burned and sent so far.
+	FeeSplit(context.Context, *QueryFeeSplitRequest) (*QueryFeeSplitResponse, error)
+	// ValidatorsSplit returns the fraction of the block emission paid to cosmos validators, the stake it is
+	// chosen from when the validators split controller is enabled, and a page of the splits of the latest blocks.
+	ValidatorsSplit(context.Context, *QueryValidatorsSplitRequest) (*QueryValidatorsSplitResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Inflation(ctx context.Context, req *QueryInflationRequest) (*QueryInflationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Inflation not implemented")
+}
+func (*UnimplementedQueryServer) VestingTranches(ctx context.Context, req *QueryVestingTranchesRequest) (*QueryVestingTranchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingTranches not implemented")
+}
+func (*UnimplementedQueryServer) EmissionInfo(ctx context.Context, req *QueryEmissionInfoRequest) (*QueryEmissionInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmissionInfo not implemented")
+}
+func (*UnimplementedQueryServer) ProjectEmissions(ctx context.Context, req *QueryProjectEmissionsRequest) (*QueryProjectEmissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectEmissions not implemented")
+}
+func (*UnimplementedQueryServer) FeeSplit(ctx context.Context, req *QueryFeeSplitRequest) (*QueryFeeSplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeSplit not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorsSplit(ctx context.Context, req *QueryValidatorsSplitRequest) (*QueryValidatorsSplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorsSplit not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mint.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Inflation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInflationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Inflation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mint.v1beta1.Query/Inflation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Inflation(ctx, req.(*QueryInflationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VestingTranches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingTranchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingTranches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mint.v1beta1.Query/VestingTranches",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingTranches(ctx, req.(*QueryVestingTranchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EmissionInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEmissionInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EmissionInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mint.v1beta1.Query/EmissionInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EmissionInfo(ctx, req.(*QueryEmissionInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProjectEmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectEmissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectEmissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mint.v1beta1.Query/ProjectEmissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectEmissions(ctx, req.(*QueryProjectEmissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeSplitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeSplit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mint.v1beta1.Query/FeeSplit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeSplit(ctx, req.(*QueryFeeSplitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorsSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorsSplitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorsSplit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mint.v1beta1.Query/ValidatorsSplit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorsSplit(ctx, req.(*QueryValidatorsSplitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "mint.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Inflation",
+			Handler:    _Query_Inflation_Handler,
+		},
+		{
+			MethodName: "VestingTranches",
+			Handler:    _Query_VestingTranches_Handler,
+		},
+		{
+			MethodName: "EmissionInfo",
+			Handler:    _Query_EmissionInfo_Handler,
+		},
+		{
+			MethodName: "ProjectEmissions",
+			Handler:    _Query_ProjectEmissions_Handler,
+		},
+		{
+			MethodName: "FeeSplit",
+			Handler:    _Query_FeeSplit_Handler,
+		},
+		{
+			MethodName: "ValidatorsSplit",
+			Handler:    _Query_ValidatorsSplit_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "mint/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingTranchesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingTranchesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingTranchesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *VestingTrancheStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingTrancheStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingTrancheStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Unlocked.Size()
+		i -= size
+		if _, err := m.Unlocked.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Locked.Size()
+		i -= size
+		if _, err := m.Locked.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Total.Size()
+		i -= size
+		if _, err := m.Total.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Tranche.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingTranchesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingTranchesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingTranchesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalLocked.Size()
+		i -= size
+		if _, err := m.TotalLocked.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Tranches) > 0 {
+		for iNdEx := len(m.Tranches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tranches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEmissionInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmissionInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmissionInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEmissionInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmissionInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmissionInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastEmissionUpdateHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastEmissionUpdateHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.NextEmissionUpdateHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextEmissionUpdateHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.BlockEmission.Size()
+		i -= size
+		if _, err := m.BlockEmission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.EcosystemMintSupplyRemaining.Size()
+		i -= size
+		if _, err := m.EcosystemMintSupplyRemaining.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.EcosystemMintCap.Size()
+		i -= size
+		if _, err := m.EcosystemMintCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.EcosystemTokensMinted.Size()
+		i -= size
+		if _, err := m.EcosystemTokensMinted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.EcosystemBalance.Size()
+		i -= size
+		if _, err := m.EcosystemBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LatestEmissionInfo != nil {
+		{
+			size, err := m.LatestEmissionInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectEmissionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectEmissionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectEmissionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReputersPercent) > 0 {
+		i -= len(m.ReputersPercent)
+		copy(dAtA[i:], m.ReputersPercent)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReputersPercent)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NetworkStaked) > 0 {
+		i -= len(m.NetworkStaked)
+		copy(dAtA[i:], m.NetworkStaked)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NetworkStaked)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Months != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Months))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectedEmission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectedEmission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectedEmission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CumulativeTokensMinted.Size()
+		i -= size
+		if _, err := m.CumulativeTokensMinted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.TokensMinted.Size()
+		i -= size
+		if _, err := m.TokensMinted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.EmissionPerMonth.Size()
+		i -= size
+		if _, err := m.EmissionPerMonth.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.RewardEmissionPerUnitStakedToken.Size()
+		i -= size
+		if _, err := m.RewardEmissionPerUnitStakedToken.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.CappedTargetRewardEmissionPerUnitStakedToken.Size()
+		i -= size
+		if _, err := m.CappedTargetRewardEmissionPerUnitStakedToken.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.TargetRewardEmissionPerUnitStakedToken.Size()
+		i -= size
+		if _, err := m.TargetRewardEmissionPerUnitStakedToken.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.EcosystemMintSupplyRemaining.Size()
+		i -= size
+		if _, err := m.EcosystemMintSupplyRemaining.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.CirculatingSupply.Size()
+		i -= size
+		if _, err := m.CirculatingSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.LockedSupply.Size()
+		i -= size
+		if _, err := m.LockedSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TotalSupply.Size()
+		i -= size
+		if _, err := m.TotalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.NetworkStaked.Size()
+		i -= size
+		if _, err := m.NetworkStaked.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Month != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Month))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectEmissionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectEmissionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectEmissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Months) > 0 {
+		for iNdEx := len(m.Months) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Months[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeSplitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeSplitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeSplitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeSplitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeSplitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeSplitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalFeesToCommunityPool.Size()
+		i -= size
+		if _, err := m.TotalFeesToCommunityPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TotalFeesBurned.Size()
+		i -= size
+		if _, err := m.TotalFeesBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.FeeCommunityPoolFraction.Size()
+		i -= size
+		if _, err := m.FeeCommunityPoolFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.FeeBurnFraction.Size()
+		i -= size
+		if _, err := m.FeeBurnFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorsSplitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorsSplitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorsSplitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorsSplitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorsSplitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorsSplitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size := m.ValidatorsStakeRatio.Size()
+		i -= size
+		if _, err := m.ValidatorsStakeRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.ReputersStaked.Size()
+		i -= size
+		if _, err := m.ReputersStaked.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CosmosValidatorsStaked.Size()
+		i -= size
+		if _, err := m.CosmosValidatorsStaked.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ValidatorsPercent.Size()
+		i -= size
+		if _, err := m.ValidatorsPercent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ControllerEnabled {
+		i--
+		if m.ControllerEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryInflationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInflationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Inflation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVestingTranchesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *VestingTrancheStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Tranche.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Total.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Locked.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Unlocked.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVestingTranchesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	if len(m.Tranches) > 0 {
+		for _, e := range m.Tranches {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TotalLocked.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEmissionInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEmissionInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LatestEmissionInfo != nil {
+		l = m.LatestEmissionInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	l = m.EcosystemBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EcosystemTokensMinted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EcosystemMintCap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EcosystemMintSupplyRemaining.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BlockEmission.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.NextEmissionUpdateHeight != 0 {
+		n += 1 + sovQuery(uint64(m.NextEmissionUpdateHeight))
+	}
+	if m.LastEmissionUpdateHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastEmissionUpdateHeight))
+	}
+	return n
+}
+
+func (m *QueryProjectEmissionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Months != 0 {
+		n += 1 + sovQuery(uint64(m.Months))
+	}
+	l = len(m.NetworkStaked)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ReputersPercent)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ProjectedEmission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Month != 0 {
+		n += 1 + sovQuery(uint64(m.Month))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	l = m.NetworkStaked.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LockedSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CirculatingSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EcosystemMintSupplyRemaining.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TargetRewardEmissionPerUnitStakedToken.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CappedTargetRewardEmissionPerUnitStakedToken.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RewardEmissionPerUnitStakedToken.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EmissionPerMonth.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TokensMinted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CumulativeTokensMinted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProjectEmissionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Months) > 0 {
+		for _, e := range m.Months {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFeeSplitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeSplitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FeeBurnFraction.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.FeeCommunityPoolFraction.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalFeesBurned.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalFeesToCommunityPool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorsSplitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorsSplitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ControllerEnabled {
+		n += 2
+	}
+	l = m.ValidatorsPercent.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CosmosValidatorsStaked.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ReputersStaked.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ValidatorsStakeRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingTranchesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingTranchesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingTranchesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VestingTrancheStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingTrancheStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingTrancheStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tranche", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tranche.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Locked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlocked", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Unlocked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingTranchesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingTranchesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingTranchesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tranches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tranches = append(m.Tranches, VestingTrancheStatus{})
+			if err := m.Tranches[len(m.Tranches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLocked", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalLocked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEmissionInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmissionInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmissionInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEmissionInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmissionInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmissionInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestEmissionInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LatestEmissionInfo == nil {
+				m.LatestEmissionInfo = &EmissionInfo{}
+			}
+			if err := m.LatestEmissionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EcosystemBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemTokensMinted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EcosystemTokensMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemMintCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EcosystemMintCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemMintSupplyRemaining", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EcosystemMintSupplyRemaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockEmission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEmissionUpdateHeight", wireType)
+			}
+			m.NextEmissionUpdateHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextEmissionUpdateHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEmissionUpdateHeight", wireType)
+			}
+			m.LastEmissionUpdateHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastEmissionUpdateHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectEmissionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectEmissionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectEmissionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Months", wireType)
+			}
+			m.Months = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Months |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkStaked", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetworkStaked = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputersPercent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReputersPercent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectedEmission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectedEmission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectedEmission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Month", wireType)
+			}
+			m.Month = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Month |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkStaked", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetworkStaked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LockedSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CirculatingSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CirculatingSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemMintSupplyRemaining", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EcosystemMintSupplyRemaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRewardEmissionPerUnitStakedToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetRewardEmissionPerUnitStakedToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CappedTargetRewardEmissionPerUnitStakedToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CappedTargetRewardEmissionPerUnitStakedToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardEmissionPerUnitStakedToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardEmissionPerUnitStakedToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionPerMonth", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EmissionPerMonth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensMinted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokensMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeTokensMinted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativeTokensMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectEmissionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectEmissionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectEmissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Months", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Months = append(m.Months, ProjectedEmission{})
+			if err := m.Months[len(m.Months)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeSplitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeSplitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeSplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeSplitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeSplitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBurnFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeBurnFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCommunityPoolFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeCommunityPoolFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFeesBurned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.TotalFeesBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFeesToCommunityPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalFeesToCommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -570,7 +4280,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorsSplitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -593,15 +4303,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorsSplitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorsSplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -628,7 +4338,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -653,7 +4366,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInflationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorsSplitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -676,67 +4389,139 @@ func (m *QueryInflationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInflationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorsSplitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInflationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorsSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllerEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ControllerEnabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsPercent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorsPercent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosValidatorsStaked", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.CosmosValidatorsStaked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputersStaked", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.ReputersStaked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInflationResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInflationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsStakeRatio", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -746,22 +4531,93 @@ func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ValidatorsStakeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, ValidatorsSplitRecord{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
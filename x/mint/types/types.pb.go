@@ -26,6 +26,66 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// How the emission of every month is calculated at the monthly update of the emission rate
+type EmissionModelType int32
+
+const (
+	// the EMA-smoothed target emission per unit staked token, capped by the maximum monthly percentage yield
+	EmissionModelType_EMISSION_MODEL_STAKED_TOKEN_YIELD EmissionModelType = 0
+	// an explicit amount of tokens for every month
+	EmissionModelType_EMISSION_MODEL_FIXED_SCHEDULE EmissionModelType = 1
+	// a monthly amount of tokens halved every halving interval
+	EmissionModelType_EMISSION_MODEL_HALVING EmissionModelType = 2
+)
+
+var EmissionModelType_name = map[int32]string{
+	0: "EMISSION_MODEL_STAKED_TOKEN_YIELD",
+	1: "EMISSION_MODEL_FIXED_SCHEDULE",
+	2: "EMISSION_MODEL_HALVING",
+}
+
+var EmissionModelType_value = map[string]int32{
+	"EMISSION_MODEL_STAKED_TOKEN_YIELD": 0,
+	"EMISSION_MODEL_FIXED_SCHEDULE":     1,
+	"EMISSION_MODEL_HALVING":            2,
+}
+
+func (x EmissionModelType) String() string {
+	return proto.EnumName(EmissionModelType_name, int32(x))
+}
+
+func (EmissionModelType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_010015e812760429, []int{0}
+}
+
+// How the locked tokens of a vesting tranche unlock between the cliff and the end of the vesting
+type VestingCurveType int32
+
+const (
+	// an equal part of the tranche unlocks at the start of every month
+	VestingCurveType_VESTING_CURVE_MONTHLY_LINEAR VestingCurveType = 0
+	// an equal part of the tranche unlocks every block
+	VestingCurveType_VESTING_CURVE_BLOCK_LINEAR VestingCurveType = 1
+)
+
+var VestingCurveType_name = map[int32]string{
+	0: "VESTING_CURVE_MONTHLY_LINEAR",
+	1: "VESTING_CURVE_BLOCK_LINEAR",
+}
+
+var VestingCurveType_value = map[string]int32{
+	"VESTING_CURVE_MONTHLY_LINEAR": 0,
+	"VESTING_CURVE_BLOCK_LINEAR":   1,
+}
+
+func (x VestingCurveType) String() string {
+	return proto.EnumName(VestingCurveType_name, int32(x))
+}
+
+func (VestingCurveType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_010015e812760429, []int{1}
+}
+
 // Params defines the parameters for the x/mint module.
 type Params struct {
 	// type of coin to mint
@@ -48,6 +108,35 @@ type Params struct {
 	TeamPercentOfTotalSupply cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=team_percent_of_total_supply,json=teamPercentOfTotalSupply,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"team_percent_of_total_supply"`
 	// The capped max monthly percentage yield (like %APY)
 	MaximumMonthlyPercentageYield cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=maximum_monthly_percentage_yield,json=maximumMonthlyPercentageYield,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"maximum_monthly_percentage_yield"`
+	// vesting schedules of the tokens locked at genesis, which are not circulating until they unlock
+	VestingTranches []VestingTranche `protobuf:"bytes,11,rep,name=vesting_tranches,json=vestingTranches,proto3" json:"vesting_tranches"`
+	// fraction of the fees paid into the ecosystem treasury that is burned
+	FeeBurnFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,12,opt,name=fee_burn_fraction,json=feeBurnFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_burn_fraction"`
+	// fraction of the fees paid into the ecosystem treasury that is sent to the community pool
+	FeeCommunityPoolFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,13,opt,name=fee_community_pool_fraction,json=feeCommunityPoolFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_community_pool_fraction"`
+	// how the emission of every month is calculated
+	EmissionModel EmissionModelType `protobuf:"varint,14,opt,name=emission_model,json=emissionModel,proto3,enum=mint.v1beta1.EmissionModelType" json:"emission_model,omitempty"`
+	// tokens emitted in every month of the fixed schedule emission model, starting from the first month of the chain.
+	// Nothing is emitted after the last month of the schedule
+	FixedScheduleMonthlyEmissions []cosmossdk_io_math.Int `protobuf:"bytes,15,rep,name=fixed_schedule_monthly_emissions,json=fixedScheduleMonthlyEmissions,proto3,customtype=cosmossdk.io/math.Int" json:"fixed_schedule_monthly_emissions"`
+	// tokens emitted in every month of the first halving interval of the halving emission model
+	HalvingInitialMonthlyEmission cosmossdk_io_math.Int `protobuf:"bytes,16,opt,name=halving_initial_monthly_emission,json=halvingInitialMonthlyEmission,proto3,customtype=cosmossdk.io/math.Int" json:"halving_initial_monthly_emission"`
+	// number of months after which the monthly emission of the halving emission model is halved
+	HalvingIntervalMonths uint64 `protobuf:"varint,17,opt,name=halving_interval_months,json=halvingIntervalMonths,proto3" json:"halving_interval_months,omitempty"`
+	// whether the split of the block emission between cosmos validators and allora participants is adjusted
+	// every block from the ratio of cosmos validator stake to reputer stake, rather than fixed by the
+	// validators_vs_allora_percent_reward emissions param
+	ValidatorsSplitControllerEnabled bool `protobuf:"varint,18,opt,name=validators_split_controller_enabled,json=validatorsSplitControllerEnabled,proto3" json:"validators_split_controller_enabled,omitempty"`
+	// lowest fraction of the block emission the controller pays to cosmos validators
+	MinValidatorsPercent cosmossdk_io_math.LegacyDec `protobuf:"bytes,19,opt,name=min_validators_percent,json=minValidatorsPercent,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_validators_percent"`
+	// highest fraction of the block emission the controller pays to cosmos validators
+	MaxValidatorsPercent cosmossdk_io_math.LegacyDec `protobuf:"bytes,20,opt,name=max_validators_percent,json=maxValidatorsPercent,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_validators_percent"`
+	// fraction of the stake of cosmos validators and reputers the controller steers cosmos validators towards
+	TargetValidatorsStakeRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,21,opt,name=target_validators_stake_ratio,json=targetValidatorsStakeRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"target_validators_stake_ratio"`
+	// fraction of the gap between the target and the actual validators stake ratio added to the validators percent every block
+	ValidatorsPercentAdjustmentRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,22,opt,name=validators_percent_adjustment_rate,json=validatorsPercentAdjustmentRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validators_percent_adjustment_rate"`
+	// number of blocks of the validators split history kept in state
+	ValidatorsSplitHistoryLength uint64 `protobuf:"varint,23,opt,name=validators_split_history_length,json=validatorsSplitHistoryLength,proto3" json:"validators_split_history_length,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -90,49 +179,373 @@ func (m *Params) GetMintDenom() string {
 	return ""
 }
 
+func (m *Params) GetVestingTranches() []VestingTranche {
+	if m != nil {
+		return m.VestingTranches
+	}
+	return nil
+}
+
+func (m *Params) GetEmissionModel() EmissionModelType {
+	if m != nil {
+		return m.EmissionModel
+	}
+	return EmissionModelType_EMISSION_MODEL_STAKED_TOKEN_YIELD
+}
+
+func (m *Params) GetHalvingIntervalMonths() uint64 {
+	if m != nil {
+		return m.HalvingIntervalMonths
+	}
+	return 0
+}
+
+func (m *Params) GetValidatorsSplitControllerEnabled() bool {
+	if m != nil {
+		return m.ValidatorsSplitControllerEnabled
+	}
+	return false
+}
+
+func (m *Params) GetValidatorsSplitHistoryLength() uint64 {
+	if m != nil {
+		return m.ValidatorsSplitHistoryLength
+	}
+	return 0
+}
+
+// A share of the total supply locked from a start height, of which nothing unlocks before the cliff
+// and everything is unlocked after the duration, both counted in months from the start height
+type VestingTranche struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// percentage of the total supply locked in the tranche
+	PercentOfTotalSupply cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=percent_of_total_supply,json=percentOfTotalSupply,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"percent_of_total_supply"`
+	StartHeight          uint64                      `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	CliffMonths          uint64                      `protobuf:"varint,4,opt,name=cliff_months,json=cliffMonths,proto3" json:"cliff_months,omitempty"`
+	DurationMonths       uint64                      `protobuf:"varint,5,opt,name=duration_months,json=durationMonths,proto3" json:"duration_months,omitempty"`
+	Curve                VestingCurveType            `protobuf:"varint,6,opt,name=curve,proto3,enum=mint.v1beta1.VestingCurveType" json:"curve,omitempty"`
+}
+
+func (m *VestingTranche) Reset()         { *m = VestingTranche{} }
+func (m *VestingTranche) String() string { return proto.CompactTextString(m) }
+func (*VestingTranche) ProtoMessage()    {}
+func (*VestingTranche) Descriptor() ([]byte, []int) {
+	return fileDescriptor_010015e812760429, []int{1}
+}
+func (m *VestingTranche) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingTranche) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingTranche.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingTranche) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingTranche.Merge(m, src)
+}
+func (m *VestingTranche) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingTranche) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingTranche.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingTranche proto.InternalMessageInfo
+
+func (m *VestingTranche) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *VestingTranche) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *VestingTranche) GetCliffMonths() uint64 {
+	if m != nil {
+		return m.CliffMonths
+	}
+	return 0
+}
+
+func (m *VestingTranche) GetDurationMonths() uint64 {
+	if m != nil {
+		return m.DurationMonths
+	}
+	return 0
+}
+
+func (m *VestingTranche) GetCurve() VestingCurveType {
+	if m != nil {
+		return m.Curve
+	}
+	return VestingCurveType_VESTING_CURVE_MONTHLY_LINEAR
+}
+
+// Intermediate values of the monthly update of the emission rate, recorded when it runs
+type EmissionInfo struct {
+	// block at which the emission rate was updated
+	BlockHeight    int64  `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlocksPerMonth uint64 `protobuf:"varint,2,opt,name=blocks_per_month,json=blocksPerMonth,proto3" json:"blocks_per_month,omitempty"`
+	// N_{staked,i}, tokens staked by cosmos validators and reputers
+	NetworkStaked cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=network_staked,json=networkStaked,proto3,customtype=cosmossdk.io/math.Int" json:"network_staked"`
+	// N_{total,i}
+	TotalSupply cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=total_supply,json=totalSupply,proto3,customtype=cosmossdk.io/math.Int" json:"total_supply"`
+	// tokens still locked in vesting tranches
+	LockedSupply cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=locked_supply,json=lockedSupply,proto3,customtype=cosmossdk.io/math.Int" json:"locked_supply"`
+	// N_{circ,i}
+	CirculatingSupply cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=circulating_supply,json=circulatingSupply,proto3,customtype=cosmossdk.io/math.Int" json:"circulating_supply"`
+	// T_{total,i}, tokens the ecosystem treasury may still mint
+	EcosystemMintSupplyRemaining cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=ecosystem_mint_supply_remaining,json=ecosystemMintSupplyRemaining,proto3,customtype=cosmossdk.io/math.Int" json:"ecosystem_mint_supply_remaining"`
+	// ^e_i, before the cap
+	TargetRewardEmissionPerUnitStakedToken cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=target_reward_emission_per_unit_staked_token,json=targetRewardEmissionPerUnitStakedToken,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"target_reward_emission_per_unit_staked_token"`
+	// share of the previous rewards paid to staked reputers
+	ReputersPercent cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=reputers_percent,json=reputersPercent,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"reputers_percent"`
+	// share of the emission paid to cosmos validators
+	ValidatorsPercent cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=validators_percent,json=validatorsPercent,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validators_percent"`
+	// ^e_{max,i}
+	MaximumMonthlyEmissionPerUnitStakedToken cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=maximum_monthly_emission_per_unit_staked_token,json=maximumMonthlyEmissionPerUnitStakedToken,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"maximum_monthly_emission_per_unit_staked_token"`
+	// ^e_i, capped by ^e_{max,i}
+	CappedTargetRewardEmissionPerUnitStakedToken cosmossdk_io_math.LegacyDec `protobuf:"bytes,12,opt,name=capped_target_reward_emission_per_unit_staked_token,json=cappedTargetRewardEmissionPerUnitStakedToken,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"capped_target_reward_emission_per_unit_staked_token"`
+	// e_{i-1}
+	PreviousRewardEmissionPerUnitStakedToken cosmossdk_io_math.LegacyDec `protobuf:"bytes,13,opt,name=previous_reward_emission_per_unit_staked_token,json=previousRewardEmissionPerUnitStakedToken,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"previous_reward_emission_per_unit_staked_token"`
+	// e_i, the exponential moving average of the capped target
+	RewardEmissionPerUnitStakedToken cosmossdk_io_math.LegacyDec `protobuf:"bytes,14,opt,name=reward_emission_per_unit_staked_token,json=rewardEmissionPerUnitStakedToken,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"reward_emission_per_unit_staked_token"`
+	// E_i
+	EmissionPerMonth cosmossdk_io_math.Int `protobuf:"bytes,15,opt,name=emission_per_month,json=emissionPerMonth,proto3,customtype=cosmossdk.io/math.Int" json:"emission_per_month"`
+	// E_i divided by the blocks per month
+	BlockEmission cosmossdk_io_math.Int `protobuf:"bytes,16,opt,name=block_emission,json=blockEmission,proto3,customtype=cosmossdk.io/math.Int" json:"block_emission"`
+	// balance of the ecosystem treasury before minting
+	EcosystemBalance cosmossdk_io_math.Int `protobuf:"bytes,17,opt,name=ecosystem_balance,json=ecosystemBalance,proto3,customtype=cosmossdk.io/math.Int" json:"ecosystem_balance"`
+}
+
+func (m *EmissionInfo) Reset()         { *m = EmissionInfo{} }
+func (m *EmissionInfo) String() string { return proto.CompactTextString(m) }
+func (*EmissionInfo) ProtoMessage()    {}
+func (*EmissionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_010015e812760429, []int{2}
+}
+func (m *EmissionInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmissionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmissionInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmissionInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionInfo.Merge(m, src)
+}
+func (m *EmissionInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmissionInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmissionInfo proto.InternalMessageInfo
+
+func (m *EmissionInfo) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *EmissionInfo) GetBlocksPerMonth() uint64 {
+	if m != nil {
+		return m.BlocksPerMonth
+	}
+	return 0
+}
+
+// the split of a block emission between cosmos validators and allora participants chosen by the validators
+// split controller, with the stake it was chosen from
+type ValidatorsSplitRecord struct {
+	BlockHeight int64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// tokens bonded to cosmos validators
+	CosmosValidatorsStaked cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=cosmos_validators_staked,json=cosmosValidatorsStaked,proto3,customtype=cosmossdk.io/math.Int" json:"cosmos_validators_staked"`
+	// tokens staked by reputers
+	ReputersStaked cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=reputers_staked,json=reputersStaked,proto3,customtype=cosmossdk.io/math.Int" json:"reputers_staked"`
+	// cosmos validators staked / (cosmos validators staked + reputers staked)
+	ValidatorsStakeRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=validators_stake_ratio,json=validatorsStakeRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validators_stake_ratio"`
+	// fraction of the block emission paid to cosmos validators
+	ValidatorsPercent cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=validators_percent,json=validatorsPercent,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validators_percent"`
+}
+
+func (m *ValidatorsSplitRecord) Reset()         { *m = ValidatorsSplitRecord{} }
+func (m *ValidatorsSplitRecord) String() string { return proto.CompactTextString(m) }
+func (*ValidatorsSplitRecord) ProtoMessage()    {}
+func (*ValidatorsSplitRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_010015e812760429, []int{3}
+}
+func (m *ValidatorsSplitRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorsSplitRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorsSplitRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorsSplitRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorsSplitRecord.Merge(m, src)
+}
+func (m *ValidatorsSplitRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorsSplitRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorsSplitRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorsSplitRecord proto.InternalMessageInfo
+
+func (m *ValidatorsSplitRecord) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("mint.v1beta1.EmissionModelType", EmissionModelType_name, EmissionModelType_value)
+	proto.RegisterEnum("mint.v1beta1.VestingCurveType", VestingCurveType_name, VestingCurveType_value)
 	proto.RegisterType((*Params)(nil), "mint.v1beta1.Params")
+	proto.RegisterType((*VestingTranche)(nil), "mint.v1beta1.VestingTranche")
+	proto.RegisterType((*EmissionInfo)(nil), "mint.v1beta1.EmissionInfo")
+	proto.RegisterType((*ValidatorsSplitRecord)(nil), "mint.v1beta1.ValidatorsSplitRecord")
 }
 
 func init() { proto.RegisterFile("mint/v1beta1/types.proto", fileDescriptor_010015e812760429) }
 
 var fileDescriptor_010015e812760429 = []byte{
-	// 551 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0x73, 0xfc, 0x29, 0xe4, 0xc4, 0x00, 0x16, 0x08, 0x13, 0xa8, 0x1b, 0x10, 0x20, 0x14,
-	0x94, 0x98, 0xaa, 0x1b, 0x62, 0xaa, 0xc2, 0x50, 0x89, 0xa8, 0x51, 0xdb, 0x05, 0x18, 0x4e, 0x17,
-	0xe7, 0xe2, 0x9c, 0xea, 0xbb, 0xd7, 0xf2, 0xbd, 0x29, 0xb1, 0xc4, 0x0a, 0x03, 0x62, 0x60, 0x43,
-	0x7c, 0x03, 0xc6, 0x0e, 0xfd, 0x0a, 0x48, 0x1d, 0xab, 0x4e, 0x88, 0xa1, 0x42, 0xc9, 0xd0, 0xaf,
-	0x81, 0x6c, 0x5f, 0x03, 0x12, 0x09, 0x2c, 0x5e, 0x2c, 0xfb, 0x7d, 0xac, 0xdf, 0xef, 0xd1, 0xc9,
-	0x7e, 0xa9, 0xab, 0xa4, 0x46, 0x7f, 0x6f, 0xb5, 0x27, 0x90, 0xaf, 0xfa, 0x98, 0xc6, 0xc2, 0xb4,
-	0xe2, 0x04, 0x10, 0x9c, 0x2b, 0x59, 0xd2, 0xb2, 0x49, 0xed, 0x7a, 0x08, 0x21, 0xe4, 0x81, 0x9f,
-	0xdd, 0x15, 0xef, 0xd4, 0x6e, 0x05, 0x60, 0x14, 0x18, 0x56, 0x04, 0xc5, 0x83, 0x8d, 0xae, 0x71,
-	0x25, 0x35, 0xf8, 0xf9, 0xb5, 0x18, 0xdd, 0xfb, 0x56, 0xa5, 0x4b, 0x5d, 0x9e, 0x70, 0x65, 0x9c,
-	0x65, 0x4a, 0x33, 0x3c, 0xeb, 0x0b, 0x0d, 0xca, 0x25, 0x75, 0xf2, 0xa8, 0xba, 0x55, 0xcd, 0x26,
-	0xed, 0x6c, 0xe0, 0x6c, 0x52, 0xaa, 0xf8, 0x98, 0x99, 0x51, 0x1c, 0x47, 0xa9, 0x7b, 0x2e, 0x8b,
-	0xd7, 0x9f, 0x1c, 0x9e, 0xac, 0x54, 0x7e, 0x9c, 0xac, 0xdc, 0x28, 0x34, 0xa6, 0xbf, 0xdb, 0x92,
-	0xe0, 0x2b, 0x8e, 0xc3, 0xd6, 0x86, 0xc6, 0xe3, 0x83, 0x26, 0xb5, 0xfe, 0x0d, 0x8d, 0x5f, 0x4f,
-	0xf7, 0x1b, 0x64, 0xab, 0xaa, 0xf8, 0x78, 0x3b, 0x47, 0x38, 0xaf, 0x29, 0x1d, 0x30, 0xa1, 0xa4,
-	0x31, 0x12, 0xb4, 0x7b, 0x3e, 0x07, 0x3e, 0xb3, 0xc0, 0xdb, 0x7f, 0x03, 0x5f, 0x88, 0x90, 0x07,
-	0x69, 0x5b, 0x04, 0xc7, 0x07, 0xcd, 0xab, 0x16, 0x3b, 0x9b, 0x59, 0xf8, 0xe0, 0xb9, 0xc5, 0x39,
-	0x29, 0xad, 0x81, 0x16, 0x4c, 0x81, 0xc6, 0x21, 0x33, 0x0a, 0x00, 0x87, 0x52, 0x87, 0xac, 0x2f,
-	0xc2, 0x44, 0x08, 0xf7, 0x42, 0x09, 0xb2, 0x9b, 0xa0, 0x45, 0x27, 0xc3, 0x6f, 0x9f, 0xd1, 0xdb,
-	0x39, 0xdc, 0xf9, 0x4c, 0x68, 0x43, 0x04, 0x60, 0x52, 0x83, 0x42, 0x31, 0x4c, 0x04, 0x37, 0xa3,
-	0x24, 0x65, 0xb1, 0x48, 0x02, 0xa1, 0x91, 0xc1, 0x80, 0x21, 0x20, 0x8f, 0xce, 0x4e, 0xf2, 0x62,
-	0x09, 0x5d, 0x1e, 0xcc, 0x7c, 0x3b, 0x56, 0xd7, 0x2d, 0x6c, 0x9b, 0x83, 0x9d, 0xcc, 0x65, 0x4f,
-	0xfc, 0x0b, 0xa1, 0x8f, 0x07, 0x30, 0xd2, 0x7d, 0x8e, 0x12, 0xf4, 0xff, 0xab, 0x2d, 0x95, 0x50,
-	0xed, 0xe1, 0x6f, 0xe1, 0x3f, 0xbb, 0x7d, 0x24, 0xf4, 0x7e, 0xcc, 0x13, 0x94, 0x81, 0x8c, 0xb9,
-	0x46, 0xb3, 0xb0, 0xd4, 0xa5, 0x12, 0x4a, 0xd5, 0xff, 0x34, 0xcd, 0xad, 0xf3, 0x9e, 0xd0, 0xbb,
-	0x52, 0xef, 0x09, 0x83, 0x90, 0x2c, 0xee, 0x72, 0xb9, 0x84, 0x2e, 0xcb, 0x33, 0xcd, 0xdc, 0x22,
-	0x6f, 0xe9, 0x1d, 0x14, 0x5c, 0x2d, 0xac, 0x50, 0x2d, 0xa1, 0x82, 0x9b, 0x19, 0xe6, 0xda, 0xdf,
-	0x11, 0x5a, 0x57, 0x7c, 0x2c, 0xd5, 0x48, 0x15, 0xff, 0x52, 0x34, 0xfb, 0x5a, 0x78, 0x28, 0x58,
-	0x2a, 0x45, 0xd4, 0x77, 0x69, 0x19, 0xa7, 0x60, 0x2d, 0x9d, 0x42, 0xd2, 0x9d, 0x39, 0x5e, 0x66,
-	0x8a, 0xa7, 0x2b, 0x1f, 0x4e, 0xf7, 0x1b, 0x35, 0x1e, 0x45, 0x90, 0xf0, 0x66, 0x30, 0xe4, 0x52,
-	0xfb, 0x63, 0x3f, 0x5f, 0x93, 0xc5, 0xf2, 0x5a, 0xef, 0x1c, 0x4e, 0x3c, 0x72, 0x34, 0xf1, 0xc8,
-	0xcf, 0x89, 0x47, 0x3e, 0x4d, 0xbd, 0xca, 0xd1, 0xd4, 0xab, 0x7c, 0x9f, 0x7a, 0x95, 0x57, 0x6b,
-	0xa1, 0xc4, 0xe1, 0xa8, 0xd7, 0x0a, 0x40, 0xf9, 0x16, 0xa0, 0x05, 0xbe, 0x81, 0x64, 0xd7, 0x9f,
-	0xc7, 0xcb, 0xd7, 0x6d, 0x6f, 0x29, 0xdf, 0x8e, 0x6b, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x8e,
-	0x93, 0xc4, 0x89, 0x8b, 0x05, 0x00, 0x00,
+	// 1606 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcf, 0x6f, 0x23, 0x49,
+	0x15, 0x4e, 0x4f, 0x32, 0xb3, 0x9b, 0x4a, 0xe2, 0x38, 0x45, 0x26, 0xd3, 0x64, 0x13, 0xc7, 0x13,
+	0x98, 0xc5, 0x0a, 0x3b, 0x36, 0x3b, 0x83, 0x38, 0x20, 0x2e, 0x93, 0xd8, 0xb3, 0xb1, 0xc6, 0x4e,
+	0xa2, 0xb6, 0x27, 0x30, 0x20, 0x28, 0x55, 0xba, 0xcb, 0x76, 0x6d, 0xba, 0xab, 0xac, 0xea, 0xb2,
+	0x27, 0x16, 0x48, 0x88, 0x03, 0x48, 0x20, 0x90, 0xb8, 0x21, 0x38, 0x70, 0x85, 0xe3, 0x1e, 0xf6,
+	0x8c, 0xc4, 0x6d, 0x8f, 0xab, 0x3d, 0x21, 0x0e, 0x2b, 0x34, 0x73, 0xd8, 0x7f, 0x03, 0x55, 0x55,
+	0x77, 0xfb, 0x67, 0x76, 0x66, 0xbc, 0xbd, 0x97, 0xa8, 0xfd, 0xea, 0xe5, 0xfb, 0xbe, 0xae, 0x7e,
+	0xf5, 0xde, 0xd7, 0x0d, 0xec, 0x80, 0x32, 0x59, 0xea, 0xbf, 0x7f, 0x41, 0x24, 0x7e, 0xbf, 0x24,
+	0x07, 0x5d, 0x12, 0x16, 0xbb, 0x82, 0x4b, 0x0e, 0x57, 0xd5, 0x4a, 0x31, 0x5a, 0xd9, 0xde, 0x6c,
+	0xf3, 0x36, 0xd7, 0x0b, 0x25, 0x75, 0x65, 0x72, 0xb6, 0xbf, 0xe9, 0xf2, 0x30, 0xe0, 0x21, 0x32,
+	0x0b, 0xe6, 0x47, 0xb4, 0xb4, 0x81, 0x03, 0xca, 0x78, 0x49, 0xff, 0x35, 0xa1, 0xfd, 0xdf, 0x6c,
+	0x82, 0x5b, 0x67, 0x58, 0xe0, 0x20, 0x84, 0xbb, 0x00, 0x28, 0x78, 0xe4, 0x11, 0xc6, 0x03, 0xdb,
+	0xca, 0x5b, 0x85, 0x65, 0x67, 0x59, 0x45, 0xca, 0x2a, 0x00, 0x4f, 0x01, 0x08, 0xf0, 0x15, 0x0a,
+	0x7b, 0xdd, 0xae, 0x3f, 0xb0, 0x6f, 0xa8, 0xe5, 0xc3, 0xef, 0x7d, 0xf2, 0xf9, 0xde, 0xc2, 0x7f,
+	0x3f, 0xdf, 0xbb, 0x6d, 0x68, 0x42, 0xef, 0xb2, 0x48, 0x79, 0x29, 0xc0, 0xb2, 0x53, 0xac, 0x32,
+	0xf9, 0xd9, 0xc7, 0xf7, 0x41, 0xc4, 0x5f, 0x65, 0xf2, 0x9f, 0x5f, 0x7c, 0x74, 0x60, 0x39, 0xcb,
+	0x01, 0xbe, 0x6a, 0x68, 0x08, 0xf8, 0x33, 0x00, 0x5a, 0x88, 0x04, 0x34, 0x0c, 0x29, 0x67, 0xf6,
+	0xa2, 0x06, 0xfc, 0x51, 0x04, 0xf8, 0xce, 0x34, 0x60, 0x8d, 0xb4, 0xb1, 0x3b, 0x28, 0x13, 0xf7,
+	0xb3, 0x8f, 0xef, 0x67, 0x23, 0xd8, 0x24, 0x16, 0x81, 0xb7, 0x2a, 0x11, 0x1c, 0x1c, 0x80, 0x6d,
+	0xce, 0x08, 0x0a, 0x38, 0x93, 0x1d, 0x14, 0x06, 0x9c, 0xcb, 0x0e, 0x65, 0x6d, 0xe4, 0x91, 0xb6,
+	0x20, 0xc4, 0x5e, 0x4a, 0x81, 0xec, 0x0e, 0x67, 0xa4, 0xae, 0xe0, 0x1b, 0x31, 0x7a, 0x59, 0x83,
+	0xc3, 0xbf, 0x58, 0xe0, 0x80, 0xb8, 0x3c, 0x1c, 0x84, 0x92, 0x04, 0x48, 0x0a, 0x82, 0xc3, 0x9e,
+	0x18, 0xa0, 0x2e, 0x11, 0x2e, 0x61, 0x12, 0xf1, 0x16, 0x92, 0x5c, 0x62, 0x3f, 0xde, 0xc9, 0x9b,
+	0x29, 0x68, 0xb9, 0x97, 0xf0, 0x35, 0x23, 0xba, 0x33, 0xc3, 0x76, 0xda, 0x6a, 0x2a, 0xae, 0x68,
+	0xc7, 0xff, 0x6a, 0x81, 0xef, 0xb6, 0x78, 0x8f, 0x79, 0x58, 0x52, 0xce, 0x5e, 0x2d, 0xed, 0x56,
+	0x0a, 0xd2, 0xde, 0x1d, 0x12, 0x7e, 0xa9, 0xb6, 0x3f, 0x5a, 0xe0, 0xdb, 0x5d, 0x2c, 0x24, 0x75,
+	0x69, 0x17, 0x33, 0x19, 0x5e, 0x2b, 0xea, 0xad, 0x14, 0x44, 0xe5, 0x47, 0x99, 0x66, 0xca, 0xf9,
+	0x9d, 0x05, 0xee, 0x52, 0xd6, 0x27, 0xa1, 0xe4, 0xe2, 0x7a, 0x2d, 0x6f, 0xa7, 0xa0, 0x65, 0x37,
+	0xa1, 0x99, 0x29, 0xe4, 0x57, 0x60, 0x47, 0x12, 0x1c, 0x5c, 0x2b, 0x61, 0x39, 0x05, 0x09, 0xb6,
+	0x62, 0x98, 0xc9, 0xfe, 0x5b, 0x0b, 0xe4, 0x03, 0x7c, 0x45, 0x83, 0x5e, 0x60, 0xce, 0x92, 0x9f,
+	0x54, 0x0b, 0x6e, 0x13, 0x34, 0xa0, 0xc4, 0xf7, 0x6c, 0x90, 0xc6, 0x2e, 0x44, 0x2c, 0x75, 0x43,
+	0x72, 0x96, 0x70, 0x3c, 0x53, 0x14, 0xd0, 0x01, 0x59, 0xb5, 0x49, 0xea, 0x08, 0x4b, 0x81, 0x99,
+	0xdb, 0x21, 0xa1, 0xbd, 0x92, 0x5f, 0x2c, 0xac, 0x3c, 0xd8, 0x29, 0x8e, 0xf6, 0xc4, 0xe2, 0xb9,
+	0xc9, 0x6a, 0x9a, 0xa4, 0xc3, 0x65, 0x25, 0xca, 0x30, 0xac, 0xf7, 0xc7, 0x96, 0x42, 0xd8, 0x01,
+	0x1b, 0x2d, 0x42, 0xd0, 0x45, 0x4f, 0x30, 0xd4, 0x12, 0xd8, 0x55, 0x25, 0x6a, 0xaf, 0xa6, 0x70,
+	0x2f, 0xeb, 0x2d, 0x42, 0x0e, 0x7b, 0x82, 0x3d, 0x8e, 0x40, 0xe1, 0x2f, 0xc1, 0x3b, 0x8a, 0xc9,
+	0xe5, 0x41, 0xd0, 0x63, 0x54, 0x0e, 0x50, 0x97, 0x73, 0x7f, 0xc8, 0xb9, 0x96, 0xc6, 0x23, 0x6c,
+	0x11, 0x72, 0x14, 0xe3, 0x9f, 0x71, 0xee, 0x27, 0xe4, 0x8f, 0x41, 0x26, 0x6e, 0xb2, 0x28, 0xe0,
+	0x1e, 0xf1, 0xed, 0x4c, 0xde, 0x2a, 0x64, 0x1e, 0xec, 0x8d, 0x6f, 0x5c, 0xdc, 0x39, 0xeb, 0x2a,
+	0xa5, 0x39, 0xe8, 0x12, 0x67, 0x8d, 0x8c, 0x86, 0xe0, 0x00, 0xe4, 0x5b, 0xf4, 0x8a, 0x78, 0x28,
+	0x74, 0x3b, 0xc4, 0xeb, 0xf9, 0x24, 0x29, 0x88, 0x38, 0x2f, 0xb4, 0xd7, 0xf3, 0x8b, 0x73, 0x4d,
+	0x85, 0x5d, 0x8d, 0xdc, 0x88, 0x80, 0xa3, 0x1a, 0x88, 0x15, 0x85, 0x8a, 0xba, 0x83, 0xfd, 0xbe,
+	0x7a, 0xfa, 0x94, 0x51, 0x49, 0xb1, 0x3f, 0xc5, 0x6d, 0x67, 0xe7, 0x1c, 0x48, 0xbb, 0x11, 0x72,
+	0xd5, 0x00, 0x4f, 0x70, 0xc3, 0x1f, 0x80, 0x3b, 0x43, 0x6a, 0x49, 0x44, 0x3f, 0xe6, 0x0e, 0xed,
+	0x8d, 0xbc, 0x55, 0x58, 0x72, 0x6e, 0x27, 0xff, 0x6f, 0x56, 0x35, 0x40, 0x08, 0xeb, 0xe0, 0x5b,
+	0x7d, 0xec, 0x53, 0x0f, 0xeb, 0xfe, 0x11, 0x76, 0x7d, 0x2a, 0x91, 0xcb, 0x99, 0x14, 0xdc, 0xf7,
+	0x89, 0x40, 0x84, 0xe1, 0x0b, 0x9f, 0x78, 0x36, 0xcc, 0x5b, 0x85, 0xb7, 0x9d, 0xfc, 0x30, 0xb5,
+	0xa1, 0x32, 0x8f, 0x92, 0xc4, 0x8a, 0xc9, 0x83, 0x02, 0x6c, 0x05, 0x94, 0xa1, 0x11, 0xc8, 0xe8,
+	0x14, 0xda, 0xdf, 0x48, 0xa1, 0x78, 0x36, 0x03, 0xca, 0xce, 0x13, 0xe8, 0xe8, 0xec, 0x69, 0x4e,
+	0x7c, 0x35, 0x8b, 0x73, 0x33, 0x15, 0x4e, 0x7c, 0x35, 0xcd, 0xf9, 0x6b, 0xb0, 0x2b, 0xb1, 0x68,
+	0x13, 0x39, 0x4a, 0x1b, 0x4a, 0x7c, 0x49, 0x90, 0x50, 0x33, 0xc4, 0xbe, 0x9d, 0x02, 0xf5, 0xb6,
+	0xa1, 0x18, 0xb2, 0x37, 0x14, 0x81, 0xa3, 0xf0, 0xe1, 0xef, 0x2d, 0xb0, 0x3f, 0x7d, 0xc7, 0x08,
+	0x7b, 0x1f, 0xf6, 0x42, 0x19, 0xa8, 0x4b, 0x81, 0x25, 0xb1, 0xb7, 0x52, 0x90, 0xb1, 0xd7, 0x9f,
+	0xbc, 0xfd, 0x47, 0x09, 0x8b, 0x83, 0x25, 0x81, 0x15, 0xb0, 0x37, 0x55, 0x43, 0x1d, 0xaa, 0x46,
+	0xc5, 0x00, 0xf9, 0x84, 0xb5, 0x65, 0xc7, 0xbe, 0xa3, 0x6b, 0x70, 0x67, 0xa2, 0x7e, 0x8e, 0x4d,
+	0x52, 0x4d, 0xe7, 0xfc, 0x70, 0xef, 0x0f, 0x5f, 0x7c, 0x74, 0xb0, 0x8d, 0x7d, 0x9f, 0x0b, 0x7c,
+	0xdf, 0xed, 0x60, 0xca, 0x4a, 0x57, 0x25, 0x6d, 0x31, 0x8d, 0xf1, 0xdb, 0xff, 0xd7, 0x0d, 0x90,
+	0x19, 0xef, 0x9b, 0x10, 0x82, 0x25, 0x86, 0x03, 0x12, 0xb9, 0x40, 0x7d, 0x0d, 0x43, 0x70, 0xe7,
+	0xba, 0x21, 0x74, 0x23, 0x8d, 0x82, 0xe8, 0xce, 0x1a, 0x40, 0x77, 0xc1, 0x6a, 0x28, 0xb1, 0x90,
+	0xa8, 0x43, 0x68, 0xbb, 0x23, 0xb5, 0x4d, 0x5c, 0x72, 0x56, 0x74, 0xec, 0x58, 0x87, 0x54, 0x8a,
+	0xeb, 0xd3, 0x56, 0x2b, 0x3e, 0x97, 0x4b, 0x26, 0x45, 0xc7, 0xa2, 0xd3, 0xf8, 0x1d, 0xb0, 0xee,
+	0xf5, 0x84, 0x71, 0x3d, 0x51, 0xd6, 0x4d, 0x9d, 0x95, 0x89, 0xc3, 0x51, 0xe2, 0xf7, 0xc1, 0x4d,
+	0xb7, 0x27, 0xfa, 0x44, 0x5b, 0x9f, 0xcc, 0x83, 0xdc, 0xcc, 0xe1, 0x72, 0xa4, 0x32, 0x74, 0x8b,
+	0x34, 0xc9, 0xfb, 0xff, 0xce, 0x80, 0xd5, 0xb8, 0x63, 0x54, 0x59, 0x8b, 0x2b, 0x49, 0x17, 0x3e,
+	0x77, 0x2f, 0x63, 0xd5, 0x6a, 0x1b, 0x17, 0x9d, 0x15, 0x1d, 0x8b, 0x54, 0x17, 0x40, 0x56, 0xff,
+	0xd4, 0x35, 0x66, 0x44, 0xe9, 0x6d, 0x5c, 0x72, 0x32, 0x26, 0x7e, 0x46, 0x84, 0x16, 0x05, 0x7f,
+	0x0c, 0x32, 0x8c, 0xc8, 0xe7, 0x5c, 0x5c, 0x9a, 0x93, 0xe0, 0xd9, 0x8b, 0x73, 0xf6, 0xba, 0xb5,
+	0x08, 0x47, 0xd7, 0xbb, 0x07, 0x1b, 0x60, 0x75, 0xec, 0x29, 0x2e, 0xcd, 0x09, 0xbb, 0x22, 0x47,
+	0x1e, 0xd8, 0x53, 0xb0, 0xa6, 0xe4, 0xab, 0x39, 0x31, 0xea, 0x6f, 0xdf, 0x1c, 0x75, 0xd5, 0xc0,
+	0x44, 0xb0, 0x08, 0x40, 0x97, 0x0a, 0xb7, 0xe7, 0x63, 0x6d, 0x02, 0xc6, 0x0c, 0xea, 0x9b, 0x63,
+	0x6f, 0x8c, 0x60, 0x45, 0x04, 0xcf, 0xc1, 0xde, 0xd0, 0xb4, 0xeb, 0xf7, 0x20, 0xc3, 0x81, 0x04,
+	0x09, 0x30, 0x65, 0x94, 0xb5, 0xed, 0xb7, 0xe6, 0x64, 0xdb, 0x49, 0x80, 0xeb, 0x94, 0x49, 0xc3,
+	0xe7, 0xc4, 0xa8, 0xf0, 0x6f, 0x16, 0x78, 0x2f, 0xea, 0x79, 0x82, 0x3c, 0xc7, 0xc2, 0x4b, 0x66,
+	0x9a, 0xae, 0x0c, 0x35, 0xd0, 0xa3, 0xe7, 0x8e, 0x24, 0xbf, 0x24, 0x2c, 0x15, 0xd3, 0xf9, 0xae,
+	0x61, 0x74, 0x34, 0x61, 0x5c, 0xba, 0x67, 0x44, 0x3c, 0x65, 0x54, 0x9a, 0xea, 0x68, 0x2a, 0x2e,
+	0xd8, 0x06, 0x59, 0x41, 0xba, 0x3d, 0x49, 0x46, 0xba, 0x7f, 0x1a, 0x8e, 0x73, 0x3d, 0x46, 0x8d,
+	0x1b, 0xff, 0x25, 0x80, 0x33, 0x06, 0x4d, 0x1a, 0xce, 0x72, 0x63, 0xaa, 0xcd, 0xc2, 0xbf, 0x5b,
+	0xa0, 0x38, 0xe9, 0x6a, 0x5f, 0xb1, 0xe9, 0x2b, 0x29, 0x28, 0x29, 0x8c, 0x7b, 0xdc, 0x2f, 0xd9,
+	0xf6, 0x7f, 0x58, 0xe0, 0xa1, 0x8b, 0xbb, 0x5d, 0x45, 0xff, 0x26, 0xa5, 0x91, 0x86, 0x7b, 0x7d,
+	0xcf, 0x10, 0x37, 0x5f, 0xaf, 0x40, 0xd4, 0x56, 0x76, 0x05, 0xe9, 0x53, 0xde, 0x0b, 0x5f, 0x53,
+	0x64, 0x1a, 0x76, 0xb7, 0x10, 0x73, 0xbe, 0x52, 0xe0, 0x9f, 0x2c, 0x70, 0xef, 0xf5, 0x74, 0x65,
+	0xd2, 0x78, 0xb1, 0x14, 0xaf, 0xd2, 0xf3, 0x0b, 0x00, 0xc7, 0x74, 0x98, 0xce, 0xbf, 0x3e, 0x67,
+	0x6b, 0xc9, 0x92, 0x21, 0x53, 0x32, 0x2d, 0xcc, 0xe8, 0xf9, 0xca, 0xce, 0x78, 0x4d, 0xe3, 0x24,
+	0x4e, 0xf8, 0xe7, 0x60, 0x63, 0xd8, 0x20, 0x2f, 0xb0, 0x8f, 0x99, 0x4b, 0xec, 0x8d, 0x39, 0xb1,
+	0xb3, 0x09, 0xd4, 0xa1, 0x41, 0xda, 0x7f, 0xb1, 0x08, 0x6e, 0x9f, 0x8f, 0xdb, 0x18, 0x87, 0xb8,
+	0x5c, 0x78, 0xaf, 0x33, 0x4c, 0x3f, 0x04, 0xb6, 0x61, 0x98, 0xb2, 0x8d, 0xde, 0xdc, 0x5f, 0xaa,
+	0xb6, 0x4c, 0x60, 0xc2, 0x25, 0x7a, 0xf0, 0x19, 0x48, 0x9a, 0xd7, 0x57, 0x9d, 0xc7, 0x99, 0x18,
+	0x28, 0x82, 0x16, 0x60, 0xeb, 0x1a, 0xdb, 0x9b, 0xc6, 0x07, 0xab, 0xcd, 0xfe, 0x2c, 0xc3, 0x3b,
+	0xbb, 0xf1, 0xde, 0xfc, 0x5a, 0x1a, 0xef, 0xc1, 0x00, 0x6c, 0x4c, 0xbd, 0x67, 0xc2, 0x7b, 0xe0,
+	0x6e, 0xa5, 0x5e, 0x6d, 0x34, 0xaa, 0xa7, 0x27, 0xa8, 0x7e, 0x5a, 0xae, 0xd4, 0x50, 0xa3, 0xf9,
+	0xe8, 0x49, 0xa5, 0x8c, 0x9a, 0xa7, 0x4f, 0x2a, 0x27, 0xe8, 0x59, 0xb5, 0x52, 0x2b, 0x67, 0x17,
+	0xe0, 0x5d, 0xb0, 0x3b, 0x91, 0xf6, 0xb8, 0xfa, 0x93, 0x4a, 0x19, 0x35, 0x8e, 0x8e, 0x2b, 0xe5,
+	0xa7, 0xb5, 0x4a, 0xd6, 0x82, 0xdb, 0x60, 0x6b, 0x22, 0xe5, 0xf8, 0x51, 0xed, 0xbc, 0x7a, 0xf2,
+	0x41, 0xf6, 0xc6, 0x41, 0x13, 0x64, 0x27, 0xed, 0x1b, 0xcc, 0x83, 0x9d, 0xf3, 0x4a, 0xa3, 0x59,
+	0x3d, 0xf9, 0x00, 0x1d, 0x3d, 0x75, 0xce, 0x2b, 0xa8, 0x7e, 0x7a, 0xd2, 0x3c, 0xae, 0x3d, 0x43,
+	0xb5, 0xea, 0x49, 0xe5, 0x91, 0x93, 0x5d, 0x80, 0x39, 0xb0, 0x3d, 0x9e, 0x71, 0x58, 0x3b, 0x3d,
+	0x7a, 0x12, 0xaf, 0x5b, 0x87, 0xf5, 0x4f, 0x5e, 0xe4, 0xac, 0x4f, 0x5f, 0xe4, 0xac, 0xff, 0xbd,
+	0xc8, 0x59, 0x7f, 0x7e, 0x99, 0x5b, 0xf8, 0xf4, 0x65, 0x6e, 0xe1, 0x3f, 0x2f, 0x73, 0x0b, 0x3f,
+	0x7d, 0xd8, 0xa6, 0xb2, 0xd3, 0xbb, 0x28, 0xba, 0x3c, 0x28, 0x45, 0xde, 0x3b, 0x72, 0x5f, 0xa5,
+	0x59, 0x56, 0x5c, 0x7f, 0xe5, 0xbd, 0xb8, 0xa5, 0x3f, 0xca, 0x3e, 0xfc, 0xff, 0x00, 0xbd, 0xf5,
+	0xad, 0xc9, 0x02, 0x16, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -155,6 +568,145 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ValidatorsSplitHistoryLength != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ValidatorsSplitHistoryLength))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	{
+		size := m.ValidatorsPercentAdjustmentRate.Size()
+		i -= size
+		if _, err := m.ValidatorsPercentAdjustmentRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	{
+		size := m.TargetValidatorsStakeRatio.Size()
+		i -= size
+		if _, err := m.TargetValidatorsStakeRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	{
+		size := m.MaxValidatorsPercent.Size()
+		i -= size
+		if _, err := m.MaxValidatorsPercent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	{
+		size := m.MinValidatorsPercent.Size()
+		i -= size
+		if _, err := m.MinValidatorsPercent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	if m.ValidatorsSplitControllerEnabled {
+		i--
+		if m.ValidatorsSplitControllerEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.HalvingIntervalMonths != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.HalvingIntervalMonths))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	{
+		size := m.HalvingInitialMonthlyEmission.Size()
+		i -= size
+		if _, err := m.HalvingInitialMonthlyEmission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if len(m.FixedScheduleMonthlyEmissions) > 0 {
+		for iNdEx := len(m.FixedScheduleMonthlyEmissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.FixedScheduleMonthlyEmissions[iNdEx].Size()
+				i -= size
+				if _, err := m.FixedScheduleMonthlyEmissions[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.EmissionModel != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EmissionModel))
+		i--
+		dAtA[i] = 0x70
+	}
+	{
+		size := m.FeeCommunityPoolFraction.Size()
+		i -= size
+		if _, err := m.FeeCommunityPoolFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.FeeBurnFraction.Size()
+		i -= size
+		if _, err := m.FeeBurnFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if len(m.VestingTranches) > 0 {
+		for iNdEx := len(m.VestingTranches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingTranches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	{
 		size := m.MaximumMonthlyPercentageYield.Size()
 		i -= size
@@ -255,38 +807,353 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *VestingTranche) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *VestingTranche) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingTranche) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MintDenom)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.Curve != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Curve))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = m.MaxSupply.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.FEmission.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.OneMonthSmoothingDegree.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.EcosystemTreasuryPercentOfTotalSupply.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.FoundationTreasuryPercentOfTotalSupply.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.ParticipantsPercentOfTotalSupply.Size()
+	if m.DurationMonths != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DurationMonths))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CliffMonths != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CliffMonths))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.PercentOfTotalSupply.Size()
+		i -= size
+		if _, err := m.PercentOfTotalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EmissionInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmissionInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmissionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.EcosystemBalance.Size()
+		i -= size
+		if _, err := m.EcosystemBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	{
+		size := m.BlockEmission.Size()
+		i -= size
+		if _, err := m.BlockEmission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size := m.EmissionPerMonth.Size()
+		i -= size
+		if _, err := m.EmissionPerMonth.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		size := m.RewardEmissionPerUnitStakedToken.Size()
+		i -= size
+		if _, err := m.RewardEmissionPerUnitStakedToken.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.PreviousRewardEmissionPerUnitStakedToken.Size()
+		i -= size
+		if _, err := m.PreviousRewardEmissionPerUnitStakedToken.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.CappedTargetRewardEmissionPerUnitStakedToken.Size()
+		i -= size
+		if _, err := m.CappedTargetRewardEmissionPerUnitStakedToken.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.MaximumMonthlyEmissionPerUnitStakedToken.Size()
+		i -= size
+		if _, err := m.MaximumMonthlyEmissionPerUnitStakedToken.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.ValidatorsPercent.Size()
+		i -= size
+		if _, err := m.ValidatorsPercent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.ReputersPercent.Size()
+		i -= size
+		if _, err := m.ReputersPercent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.TargetRewardEmissionPerUnitStakedToken.Size()
+		i -= size
+		if _, err := m.TargetRewardEmissionPerUnitStakedToken.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.EcosystemMintSupplyRemaining.Size()
+		i -= size
+		if _, err := m.EcosystemMintSupplyRemaining.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.CirculatingSupply.Size()
+		i -= size
+		if _, err := m.CirculatingSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.LockedSupply.Size()
+		i -= size
+		if _, err := m.LockedSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TotalSupply.Size()
+		i -= size
+		if _, err := m.TotalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.NetworkStaked.Size()
+		i -= size
+		if _, err := m.NetworkStaked.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.BlocksPerMonth != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BlocksPerMonth))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorsSplitRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorsSplitRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorsSplitRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ValidatorsPercent.Size()
+		i -= size
+		if _, err := m.ValidatorsPercent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.ValidatorsStakeRatio.Size()
+		i -= size
+		if _, err := m.ValidatorsStakeRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ReputersStaked.Size()
+		i -= size
+		if _, err := m.ReputersStaked.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CosmosValidatorsStaked.Size()
+		i -= size
+		if _, err := m.CosmosValidatorsStaked.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.BlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MintDenom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.FEmission.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.OneMonthSmoothingDegree.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.EcosystemTreasuryPercentOfTotalSupply.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.FoundationTreasuryPercentOfTotalSupply.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.ParticipantsPercentOfTotalSupply.Size()
 	n += 1 + l + sovTypes(uint64(l))
 	l = m.InvestorsPercentOfTotalSupply.Size()
 	n += 1 + l + sovTypes(uint64(l))
@@ -294,16 +1161,1111 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovTypes(uint64(l))
 	l = m.MaximumMonthlyPercentageYield.Size()
 	n += 1 + l + sovTypes(uint64(l))
+	if len(m.VestingTranches) > 0 {
+		for _, e := range m.VestingTranches {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = m.FeeBurnFraction.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.FeeCommunityPoolFraction.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.EmissionModel != 0 {
+		n += 1 + sovTypes(uint64(m.EmissionModel))
+	}
+	if len(m.FixedScheduleMonthlyEmissions) > 0 {
+		for _, e := range m.FixedScheduleMonthlyEmissions {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = m.HalvingInitialMonthlyEmission.Size()
+	n += 2 + l + sovTypes(uint64(l))
+	if m.HalvingIntervalMonths != 0 {
+		n += 2 + sovTypes(uint64(m.HalvingIntervalMonths))
+	}
+	if m.ValidatorsSplitControllerEnabled {
+		n += 3
+	}
+	l = m.MinValidatorsPercent.Size()
+	n += 2 + l + sovTypes(uint64(l))
+	l = m.MaxValidatorsPercent.Size()
+	n += 2 + l + sovTypes(uint64(l))
+	l = m.TargetValidatorsStakeRatio.Size()
+	n += 2 + l + sovTypes(uint64(l))
+	l = m.ValidatorsPercentAdjustmentRate.Size()
+	n += 2 + l + sovTypes(uint64(l))
+	if m.ValidatorsSplitHistoryLength != 0 {
+		n += 2 + sovTypes(uint64(m.ValidatorsSplitHistoryLength))
+	}
 	return n
 }
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *VestingTranche) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.PercentOfTotalSupply.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.StartHeight != 0 {
+		n += 1 + sovTypes(uint64(m.StartHeight))
+	}
+	if m.CliffMonths != 0 {
+		n += 1 + sovTypes(uint64(m.CliffMonths))
+	}
+	if m.DurationMonths != 0 {
+		n += 1 + sovTypes(uint64(m.DurationMonths))
+	}
+	if m.Curve != 0 {
+		n += 1 + sovTypes(uint64(m.Curve))
+	}
+	return n
+}
+
+func (m *EmissionInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.BlockHeight))
+	}
+	if m.BlocksPerMonth != 0 {
+		n += 1 + sovTypes(uint64(m.BlocksPerMonth))
+	}
+	l = m.NetworkStaked.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.TotalSupply.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.LockedSupply.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.CirculatingSupply.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.EcosystemMintSupplyRemaining.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.TargetRewardEmissionPerUnitStakedToken.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.ReputersPercent.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.ValidatorsPercent.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.MaximumMonthlyEmissionPerUnitStakedToken.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.CappedTargetRewardEmissionPerUnitStakedToken.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.PreviousRewardEmissionPerUnitStakedToken.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.RewardEmissionPerUnitStakedToken.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.EmissionPerMonth.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.BlockEmission.Size()
+	n += 2 + l + sovTypes(uint64(l))
+	l = m.EcosystemBalance.Size()
+	n += 2 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *ValidatorsSplitRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.BlockHeight))
+	}
+	l = m.CosmosValidatorsStaked.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.ReputersStaked.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.ValidatorsStakeRatio.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.ValidatorsPercent.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FEmission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneMonthSmoothingDegree", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OneMonthSmoothingDegree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemTreasuryPercentOfTotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EcosystemTreasuryPercentOfTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FoundationTreasuryPercentOfTotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FoundationTreasuryPercentOfTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantsPercentOfTotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ParticipantsPercentOfTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvestorsPercentOfTotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InvestorsPercentOfTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TeamPercentOfTotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TeamPercentOfTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumMonthlyPercentageYield", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaximumMonthlyPercentageYield.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingTranches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingTranches = append(m.VestingTranches, VestingTranche{})
+			if err := m.VestingTranches[len(m.VestingTranches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBurnFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeBurnFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCommunityPoolFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeCommunityPoolFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionModel", wireType)
+			}
+			m.EmissionModel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EmissionModel |= EmissionModelType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixedScheduleMonthlyEmissions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.FixedScheduleMonthlyEmissions = append(m.FixedScheduleMonthlyEmissions, v)
+			if err := m.FixedScheduleMonthlyEmissions[len(m.FixedScheduleMonthlyEmissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalvingInitialMonthlyEmission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HalvingInitialMonthlyEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalvingIntervalMonths", wireType)
+			}
+			m.HalvingIntervalMonths = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HalvingIntervalMonths |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsSplitControllerEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ValidatorsSplitControllerEnabled = bool(v != 0)
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValidatorsPercent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinValidatorsPercent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorsPercent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxValidatorsPercent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetValidatorsStakeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetValidatorsStakeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsPercentAdjustmentRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorsPercentAdjustmentRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsSplitHistoryLength", wireType)
+			}
+			m.ValidatorsSplitHistoryLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorsSplitHistoryLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VestingTranche) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingTranche: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingTranche: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PercentOfTotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PercentOfTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffMonths", wireType)
+			}
+			m.CliffMonths = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CliffMonths |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationMonths", wireType)
+			}
+			m.DurationMonths = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationMonths |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curve", wireType)
+			}
+			m.Curve = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Curve |= VestingCurveType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *EmissionInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -313,28 +2275,236 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowTypes
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmissionInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmissionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerMonth", wireType)
+			}
+			m.BlocksPerMonth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksPerMonth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkStaked", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetworkStaked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LockedSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CirculatingSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CirculatingSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemMintSupplyRemaining", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.EcosystemMintSupplyRemaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRewardEmissionPerUnitStakedToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -362,11 +2532,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MintDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.TargetRewardEmissionPerUnitStakedToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputersPercent", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -394,13 +2566,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ReputersPercent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FEmission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsPercent", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -428,13 +2600,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ValidatorsPercent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OneMonthSmoothingDegree", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumMonthlyEmissionPerUnitStakedToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -462,13 +2634,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OneMonthSmoothingDegree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaximumMonthlyEmissionPerUnitStakedToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemTreasuryPercentOfTotalSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CappedTargetRewardEmissionPerUnitStakedToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -496,13 +2668,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EcosystemTreasuryPercentOfTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CappedTargetRewardEmissionPerUnitStakedToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FoundationTreasuryPercentOfTotalSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousRewardEmissionPerUnitStakedToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -530,13 +2702,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FoundationTreasuryPercentOfTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PreviousRewardEmissionPerUnitStakedToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantsPercentOfTotalSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardEmissionPerUnitStakedToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -564,13 +2736,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ParticipantsPercentOfTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RewardEmissionPerUnitStakedToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvestorsPercentOfTotalSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionPerMonth", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -598,13 +2770,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InvestorsPercentOfTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EmissionPerMonth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TeamPercentOfTotalSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockEmission", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -632,13 +2804,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TeamPercentOfTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BlockEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaximumMonthlyPercentageYield", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemBalance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -666,7 +2838,212 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaximumMonthlyPercentageYield.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EcosystemBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorsSplitRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorsSplitRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorsSplitRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosValidatorsStaked", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CosmosValidatorsStaked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputersStaked", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReputersStaked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsStakeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorsStakeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsPercent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorsPercent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex